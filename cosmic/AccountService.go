@@ -17,17 +17,70 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
+// AccountServiceIface is the interface implemented by AccountService, and by MockAccountService for tests
+type AccountServiceIface interface {
+	NewCreateAccountParams(accounttype int, email string, firstname string, lastname string, password string, username string) *CreateAccountParams
+	CreateAccount(p *CreateAccountParams) (*CreateAccountResponse, error)
+	CreateAccountWithContext(ctx context.Context, p *CreateAccountParams) (*CreateAccountResponse, error)
+	NewDeleteAccountParams(id string) *DeleteAccountParams
+	DeleteAccount(p *DeleteAccountParams) (*DeleteAccountResponse, error)
+	DeleteAccountWithContext(ctx context.Context, p *DeleteAccountParams) (*DeleteAccountResponse, error)
+	DeleteAccountAsync(ctx context.Context, p *DeleteAccountParams) (*DeleteAccountJob, error)
+	NewDisableAccountParams(lock bool) *DisableAccountParams
+	DisableAccount(p *DisableAccountParams) (*DisableAccountResponse, error)
+	DisableAccountWithContext(ctx context.Context, p *DisableAccountParams) (*DisableAccountResponse, error)
+	DisableAccountAsync(ctx context.Context, p *DisableAccountParams) (*DisableAccountJob, error)
+	NewEnableAccountParams() *EnableAccountParams
+	EnableAccount(p *EnableAccountParams) (*EnableAccountResponse, error)
+	EnableAccountWithContext(ctx context.Context, p *EnableAccountParams) (*EnableAccountResponse, error)
+	NewLockAccountParams(account string, domainid string) *LockAccountParams
+	LockAccount(p *LockAccountParams) (*LockAccountResponse, error)
+	LockAccountWithContext(ctx context.Context, p *LockAccountParams) (*LockAccountResponse, error)
+	NewUpdateAccountParams(newname string) *UpdateAccountParams
+	UpdateAccount(p *UpdateAccountParams) (*UpdateAccountResponse, error)
+	UpdateAccountWithContext(ctx context.Context, p *UpdateAccountParams) (*UpdateAccountResponse, error)
+	NewDeleteAccountFromProjectParams(account string, projectid string) *DeleteAccountFromProjectParams
+	DeleteAccountFromProject(p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error)
+	DeleteAccountFromProjectWithContext(ctx context.Context, p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error)
+	DeleteAccountFromProjectAsync(ctx context.Context, p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectJob, error)
+	NewAddAccountToProjectParams(projectid string) *AddAccountToProjectParams
+	AddAccountToProject(p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error)
+	AddAccountToProjectWithContext(ctx context.Context, p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error)
+	AddAccountToProjectAsync(ctx context.Context, p *AddAccountToProjectParams) (*AddAccountToProjectJob, error)
+	NewListAccountsParams() *ListAccountsParams
+	GetAccountID(name string, opts ...OptionFunc) (string, int, error)
+	GetAccountByName(name string, opts ...OptionFunc) (*Account, int, error)
+	GetAccountByID(id string, opts ...OptionFunc) (*Account, int, error)
+	ListAccounts(p *ListAccountsParams) (*ListAccountsResponse, error)
+	ListAccountsWithContext(ctx context.Context, p *ListAccountsParams) (*ListAccountsResponse, error)
+	NewListAccountsIterator(p *ListAccountsParams, pagesize int) *ListAccountsIterator
+	NewMarkDefaultZoneForAccountParams(account string, domainid string, zoneid string) *MarkDefaultZoneForAccountParams
+	MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountResponse, error)
+	MarkDefaultZoneForAccountWithContext(ctx context.Context, p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountResponse, error)
+	MarkDefaultZoneForAccountAsync(ctx context.Context, p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountJob, error)
+	NewListProjectAccountsParams(projectid string) *ListProjectAccountsParams
+	GetProjectAccountID(keyword string, projectid string, opts ...OptionFunc) (string, int, error)
+	ListProjectAccounts(p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error)
+	ListProjectAccountsWithContext(ctx context.Context, p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error)
+	NewListProjectAccountsIterator(p *ListProjectAccountsParams, pagesize int) *ListProjectAccountsIterator
+}
+
 type CreateAccountParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateAccountParams) command() string {
+	return "createAccount"
+}
+
 func (p *CreateAccountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -188,7 +241,13 @@ func (s *AccountService) NewCreateAccountParams(accounttype int, email string, f
 
 // Creates an account
 func (s *AccountService) CreateAccount(p *CreateAccountParams) (*CreateAccountResponse, error) {
-	resp, err := s.cs.newRequest("createAccount", p.toURLValues())
+	return s.CreateAccountWithContext(context.Background(), p)
+}
+
+// CreateAccountWithContext is the same as CreateAccount, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AccountService) CreateAccountWithContext(ctx context.Context, p *CreateAccountParams) (*CreateAccountResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createAccount", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -277,6 +336,10 @@ type DeleteAccountParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteAccountParams) command() string {
+	return "deleteAccount"
+}
+
 func (p *DeleteAccountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -306,7 +369,13 @@ func (s *AccountService) NewDeleteAccountParams(id string) *DeleteAccountParams
 
 // Deletes a account, and all users associated with this account
 func (s *AccountService) DeleteAccount(p *DeleteAccountParams) (*DeleteAccountResponse, error) {
-	resp, err := s.cs.newRequest("deleteAccount", p.toURLValues())
+	return s.DeleteAccountWithContext(context.Background(), p)
+}
+
+// DeleteAccountWithContext is the same as DeleteAccount, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AccountService) DeleteAccountWithContext(ctx context.Context, p *DeleteAccountParams) (*DeleteAccountResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteAccount", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -318,9 +387,9 @@ func (s *AccountService) DeleteAccount(p *DeleteAccountParams) (*DeleteAccountRe
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteAccount", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -333,6 +402,41 @@ func (s *AccountService) DeleteAccount(p *DeleteAccountParams) (*DeleteAccountRe
 	return &r, nil
 }
 
+// DeleteAccountJob is a handle to a running deleteAccount async job
+type DeleteAccountJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteAccountResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteAccountJob) Result() (*DeleteAccountResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteAccountResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteAccountAsync starts the deleteAccount async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *AccountService) DeleteAccountAsync(ctx context.Context, p *DeleteAccountParams) (*DeleteAccountJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteAccount", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteAccountResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteAccountJob{s.cs.newJobHandle("deleteAccount", r.JobID)}, nil
+}
+
 type DeleteAccountResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -343,6 +447,10 @@ type DisableAccountParams struct {
 	p map[string]interface{}
 }
 
+func (p *DisableAccountParams) command() string {
+	return "disableAccount"
+}
+
 func (p *DisableAccountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -403,7 +511,13 @@ func (s *AccountService) NewDisableAccountParams(lock bool) *DisableAccountParam
 
 // Disables an account
 func (s *AccountService) DisableAccount(p *DisableAccountParams) (*DisableAccountResponse, error) {
-	resp, err := s.cs.newRequest("disableAccount", p.toURLValues())
+	return s.DisableAccountWithContext(context.Background(), p)
+}
+
+// DisableAccountWithContext is the same as DisableAccount, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AccountService) DisableAccountWithContext(ctx context.Context, p *DisableAccountParams) (*DisableAccountResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "disableAccount", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -415,26 +529,56 @@ func (s *AccountService) DisableAccount(p *DisableAccountParams) (*DisableAccoun
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "disableAccount", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "account", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// DisableAccountJob is a handle to a running disableAccount async job
+type DisableAccountJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DisableAccountResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DisableAccountJob) Result() (*DisableAccountResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DisableAccountResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "account", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// DisableAccountAsync starts the disableAccount async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *AccountService) DisableAccountAsync(ctx context.Context, p *DisableAccountParams) (*DisableAccountJob, error) {
+	resp, err := s.cs.newRequest(ctx, "disableAccount", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DisableAccountResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DisableAccountJob{s.cs.newJobHandle("disableAccount", r.JobID)}, nil
+}
+
 type DisableAccountResponse struct {
 	JobID                     string            `json:"jobid,omitempty"`
 	Accountdetails            map[string]string `json:"accountdetails,omitempty"`
@@ -513,6 +657,10 @@ type EnableAccountParams struct {
 	p map[string]interface{}
 }
 
+func (p *EnableAccountParams) command() string {
+	return "enableAccount"
+}
+
 func (p *EnableAccountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -561,7 +709,13 @@ func (s *AccountService) NewEnableAccountParams() *EnableAccountParams {
 
 // Enables an account
 func (s *AccountService) EnableAccount(p *EnableAccountParams) (*EnableAccountResponse, error) {
-	resp, err := s.cs.newRequest("enableAccount", p.toURLValues())
+	return s.EnableAccountWithContext(context.Background(), p)
+}
+
+// EnableAccountWithContext is the same as EnableAccount, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AccountService) EnableAccountWithContext(ctx context.Context, p *EnableAccountParams) (*EnableAccountResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "enableAccount", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -650,6 +804,10 @@ type LockAccountParams struct {
 	p map[string]interface{}
 }
 
+func (p *LockAccountParams) command() string {
+	return "lockAccount"
+}
+
 func (p *LockAccountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -690,7 +848,13 @@ func (s *AccountService) NewLockAccountParams(account string, domainid string) *
 
 // This deprecated function used to locks an account. Look for the API DisableAccount instead
 func (s *AccountService) LockAccount(p *LockAccountParams) (*LockAccountResponse, error) {
-	resp, err := s.cs.newRequest("lockAccount", p.toURLValues())
+	return s.LockAccountWithContext(context.Background(), p)
+}
+
+// LockAccountWithContext is the same as LockAccount, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AccountService) LockAccountWithContext(ctx context.Context, p *LockAccountParams) (*LockAccountResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "lockAccount", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -779,6 +943,10 @@ type UpdateAccountParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateAccountParams) command() string {
+	return "updateAccount"
+}
+
 func (p *UpdateAccountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -863,7 +1031,13 @@ func (s *AccountService) NewUpdateAccountParams(newname string) *UpdateAccountPa
 
 // Updates account information for the authenticated user
 func (s *AccountService) UpdateAccount(p *UpdateAccountParams) (*UpdateAccountResponse, error) {
-	resp, err := s.cs.newRequest("updateAccount", p.toURLValues())
+	return s.UpdateAccountWithContext(context.Background(), p)
+}
+
+// UpdateAccountWithContext is the same as UpdateAccount, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AccountService) UpdateAccountWithContext(ctx context.Context, p *UpdateAccountParams) (*UpdateAccountResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateAccount", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -952,6 +1126,10 @@ type DeleteAccountFromProjectParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteAccountFromProjectParams) command() string {
+	return "deleteAccountFromProject"
+}
+
 func (p *DeleteAccountFromProjectParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -992,7 +1170,13 @@ func (s *AccountService) NewDeleteAccountFromProjectParams(account string, proje
 
 // Deletes account from the project
 func (s *AccountService) DeleteAccountFromProject(p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error) {
-	resp, err := s.cs.newRequest("deleteAccountFromProject", p.toURLValues())
+	return s.DeleteAccountFromProjectWithContext(context.Background(), p)
+}
+
+// DeleteAccountFromProjectWithContext is the same as DeleteAccountFromProject, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AccountService) DeleteAccountFromProjectWithContext(ctx context.Context, p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteAccountFromProject", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1004,9 +1188,9 @@ func (s *AccountService) DeleteAccountFromProject(p *DeleteAccountFromProjectPar
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteAccountFromProject", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -1019,6 +1203,41 @@ func (s *AccountService) DeleteAccountFromProject(p *DeleteAccountFromProjectPar
 	return &r, nil
 }
 
+// DeleteAccountFromProjectJob is a handle to a running deleteAccountFromProject async job
+type DeleteAccountFromProjectJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteAccountFromProjectResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteAccountFromProjectJob) Result() (*DeleteAccountFromProjectResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteAccountFromProjectResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteAccountFromProjectAsync starts the deleteAccountFromProject async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *AccountService) DeleteAccountFromProjectAsync(ctx context.Context, p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteAccountFromProject", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteAccountFromProjectResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteAccountFromProjectJob{s.cs.newJobHandle("deleteAccountFromProject", r.JobID)}, nil
+}
+
 type DeleteAccountFromProjectResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -1029,6 +1248,10 @@ type AddAccountToProjectParams struct {
 	p map[string]interface{}
 }
 
+func (p *AddAccountToProjectParams) command() string {
+	return "addAccountToProject"
+}
+
 func (p *AddAccountToProjectParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1078,7 +1301,13 @@ func (s *AccountService) NewAddAccountToProjectParams(projectid string) *AddAcco
 
 // Adds account to a project
 func (s *AccountService) AddAccountToProject(p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error) {
-	resp, err := s.cs.newRequest("addAccountToProject", p.toURLValues())
+	return s.AddAccountToProjectWithContext(context.Background(), p)
+}
+
+// AddAccountToProjectWithContext is the same as AddAccountToProject, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AccountService) AddAccountToProjectWithContext(ctx context.Context, p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "addAccountToProject", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1090,9 +1319,9 @@ func (s *AccountService) AddAccountToProject(p *AddAccountToProjectParams) (*Add
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "addAccountToProject", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -1105,6 +1334,41 @@ func (s *AccountService) AddAccountToProject(p *AddAccountToProjectParams) (*Add
 	return &r, nil
 }
 
+// AddAccountToProjectJob is a handle to a running addAccountToProject async job
+type AddAccountToProjectJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a AddAccountToProjectResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *AddAccountToProjectJob) Result() (*AddAccountToProjectResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := AddAccountToProjectResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// AddAccountToProjectAsync starts the addAccountToProject async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *AccountService) AddAccountToProjectAsync(ctx context.Context, p *AddAccountToProjectParams) (*AddAccountToProjectJob, error) {
+	resp, err := s.cs.newRequest(ctx, "addAccountToProject", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddAccountToProjectResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &AddAccountToProjectJob{s.cs.newJobHandle("addAccountToProject", r.JobID)}, nil
+}
+
 type AddAccountToProjectResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -1115,6 +1379,10 @@ type ListAccountsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListAccountsParams) command() string {
+	return "listAccounts"
+}
+
 func (p *ListAccountsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1266,7 +1534,7 @@ func (s *AccountService) GetAccountID(name string, opts ...OptionFunc) (string,
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -1280,7 +1548,7 @@ func (s *AccountService) GetAccountID(name string, opts ...OptionFunc) (string,
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -1312,16 +1580,14 @@ func (s *AccountService) GetAccountByID(id string, opts ...OptionFunc) (*Account
 
 	l, err := s.ListAccounts(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -1333,10 +1599,17 @@ func (s *AccountService) GetAccountByID(id string, opts ...OptionFunc) (*Account
 
 // Lists accounts and provides detailed account information for listed accounts
 func (s *AccountService) ListAccounts(p *ListAccountsParams) (*ListAccountsResponse, error) {
+	return s.ListAccountsWithContext(context.Background(), p)
+}
+
+// ListAccountsWithContext is the same as ListAccounts, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AccountService) ListAccountsWithContext(ctx context.Context, p *ListAccountsParams) (*ListAccountsResponse, error) {
 	var r ListAccountsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListAccountsResponse
-		resp, err := s.cs.newRequest("listAccounts", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listAccounts", values)
 		if err != nil {
 			return nil, err
 		}
@@ -1348,15 +1621,91 @@ func (s *AccountService) ListAccounts(p *ListAccountsParams) (*ListAccountsRespo
 		r.Count = l.Count
 		r.Accounts = append(r.Accounts, l.Accounts...)
 
-		if r.Count == len(r.Accounts) {
+		if r.Count == len(r.Accounts) || len(l.Accounts) == 0 {
+			s.cs.observePages("listAccounts", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.Accounts))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.Accounts)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listAccounts", values, len(l.Accounts), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListAccountsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.Accounts = append(r.Accounts, l.Accounts...)
+			}
+
+			if r.Count == len(r.Accounts) {
+				s.cs.observePages("listAccounts", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
 	}
 }
 
+// ListAccountsIterator iterates over the items returned by listAccounts, requesting
+// the pages of the response one at a time while iterating.
+type ListAccountsIterator struct {
+	pager
+	items []*Account
+	index int
+}
+
+// NewListAccountsIterator returns an iterator over the items returned by listAccounts, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *AccountService) NewListAccountsIterator(p *ListAccountsParams, pagesize int) *ListAccountsIterator {
+	return &ListAccountsIterator{pager: newPager(s.cs, "listAccounts", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListAccountsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListAccountsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.Accounts))
+
+	i.items = l.Accounts
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListAccountsIterator) Value() *Account {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
+}
+
 type ListAccountsResponse struct {
 	Count    int        `json:"count"`
 	Accounts []*Account `json:"account"`
@@ -1439,6 +1788,10 @@ type MarkDefaultZoneForAccountParams struct {
 	p map[string]interface{}
 }
 
+func (p *MarkDefaultZoneForAccountParams) command() string {
+	return "markDefaultZoneForAccount"
+}
+
 func (p *MarkDefaultZoneForAccountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1490,7 +1843,13 @@ func (s *AccountService) NewMarkDefaultZoneForAccountParams(account string, doma
 
 // Marks a default zone for this account
 func (s *AccountService) MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountResponse, error) {
-	resp, err := s.cs.newRequest("markDefaultZoneForAccount", p.toURLValues())
+	return s.MarkDefaultZoneForAccountWithContext(context.Background(), p)
+}
+
+// MarkDefaultZoneForAccountWithContext is the same as MarkDefaultZoneForAccount, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AccountService) MarkDefaultZoneForAccountWithContext(ctx context.Context, p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "markDefaultZoneForAccount", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1502,26 +1861,56 @@ func (s *AccountService) MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountP
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "markDefaultZoneForAccount", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "account", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// MarkDefaultZoneForAccountJob is a handle to a running markDefaultZoneForAccount async job
+type MarkDefaultZoneForAccountJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a MarkDefaultZoneForAccountResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *MarkDefaultZoneForAccountJob) Result() (*MarkDefaultZoneForAccountResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := MarkDefaultZoneForAccountResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "account", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// MarkDefaultZoneForAccountAsync starts the markDefaultZoneForAccount async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *AccountService) MarkDefaultZoneForAccountAsync(ctx context.Context, p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountJob, error) {
+	resp, err := s.cs.newRequest(ctx, "markDefaultZoneForAccount", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r MarkDefaultZoneForAccountResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &MarkDefaultZoneForAccountJob{s.cs.newJobHandle("markDefaultZoneForAccount", r.JobID)}, nil
+}
+
 type MarkDefaultZoneForAccountResponse struct {
 	JobID                     string            `json:"jobid,omitempty"`
 	Accountdetails            map[string]string `json:"accountdetails,omitempty"`
@@ -1600,6 +1989,10 @@ type ListProjectAccountsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListProjectAccountsParams) command() string {
+	return "listProjectAccounts"
+}
+
 func (p *ListProjectAccountsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1699,7 +2092,7 @@ func (s *AccountService) GetProjectAccountID(keyword string, projectid string, o
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -1713,15 +2106,22 @@ func (s *AccountService) GetProjectAccountID(keyword string, projectid string, o
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // Lists project's accounts
 func (s *AccountService) ListProjectAccounts(p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error) {
+	return s.ListProjectAccountsWithContext(context.Background(), p)
+}
+
+// ListProjectAccountsWithContext is the same as ListProjectAccounts, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AccountService) ListProjectAccountsWithContext(ctx context.Context, p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error) {
 	var r ListProjectAccountsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListProjectAccountsResponse
-		resp, err := s.cs.newRequest("listProjectAccounts", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listProjectAccounts", values)
 		if err != nil {
 			return nil, err
 		}
@@ -1733,13 +2133,89 @@ func (s *AccountService) ListProjectAccounts(p *ListProjectAccountsParams) (*Lis
 		r.Count = l.Count
 		r.ProjectAccounts = append(r.ProjectAccounts, l.ProjectAccounts...)
 
-		if r.Count == len(r.ProjectAccounts) {
+		if r.Count == len(r.ProjectAccounts) || len(l.ProjectAccounts) == 0 {
+			s.cs.observePages("listProjectAccounts", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.ProjectAccounts))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.ProjectAccounts)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listProjectAccounts", values, len(l.ProjectAccounts), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListProjectAccountsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.ProjectAccounts = append(r.ProjectAccounts, l.ProjectAccounts...)
+			}
+
+			if r.Count == len(r.ProjectAccounts) {
+				s.cs.observePages("listProjectAccounts", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListProjectAccountsIterator iterates over the items returned by listProjectAccounts, requesting
+// the pages of the response one at a time while iterating.
+type ListProjectAccountsIterator struct {
+	pager
+	items []*ProjectAccount
+	index int
+}
+
+// NewListProjectAccountsIterator returns an iterator over the items returned by listProjectAccounts, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *AccountService) NewListProjectAccountsIterator(p *ListProjectAccountsParams, pagesize int) *ListProjectAccountsIterator {
+	return &ListProjectAccountsIterator{pager: newPager(s.cs, "listProjectAccounts", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListProjectAccountsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListProjectAccountsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.ProjectAccounts))
+
+	i.items = l.ProjectAccounts
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListProjectAccountsIterator) Value() *ProjectAccount {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListProjectAccountsResponse struct {
@@ -0,0 +1,325 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockAccountService is a mock of AccountServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockAccountService struct {
+	mockCalls
+
+	CreateAccountFunc                  func(ctx context.Context, p *CreateAccountParams) (*CreateAccountResponse, error)
+	DeleteAccountFunc                  func(ctx context.Context, p *DeleteAccountParams) (*DeleteAccountResponse, error)
+	DeleteAccountAsyncFunc             func(ctx context.Context, p *DeleteAccountParams) (*DeleteAccountJob, error)
+	DisableAccountFunc                 func(ctx context.Context, p *DisableAccountParams) (*DisableAccountResponse, error)
+	DisableAccountAsyncFunc            func(ctx context.Context, p *DisableAccountParams) (*DisableAccountJob, error)
+	EnableAccountFunc                  func(ctx context.Context, p *EnableAccountParams) (*EnableAccountResponse, error)
+	LockAccountFunc                    func(ctx context.Context, p *LockAccountParams) (*LockAccountResponse, error)
+	UpdateAccountFunc                  func(ctx context.Context, p *UpdateAccountParams) (*UpdateAccountResponse, error)
+	DeleteAccountFromProjectFunc       func(ctx context.Context, p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error)
+	DeleteAccountFromProjectAsyncFunc  func(ctx context.Context, p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectJob, error)
+	AddAccountToProjectFunc            func(ctx context.Context, p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error)
+	AddAccountToProjectAsyncFunc       func(ctx context.Context, p *AddAccountToProjectParams) (*AddAccountToProjectJob, error)
+	GetAccountIDFunc                   func(name string, opts ...OptionFunc) (string, int, error)
+	GetAccountByNameFunc               func(name string, opts ...OptionFunc) (*Account, int, error)
+	GetAccountByIDFunc                 func(id string, opts ...OptionFunc) (*Account, int, error)
+	ListAccountsFunc                   func(ctx context.Context, p *ListAccountsParams) (*ListAccountsResponse, error)
+	MarkDefaultZoneForAccountFunc      func(ctx context.Context, p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountResponse, error)
+	MarkDefaultZoneForAccountAsyncFunc func(ctx context.Context, p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountJob, error)
+	GetProjectAccountIDFunc            func(keyword string, projectid string, opts ...OptionFunc) (string, int, error)
+	ListProjectAccountsFunc            func(ctx context.Context, p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error)
+}
+
+var _ AccountServiceIface = &MockAccountService{}
+
+func (m *MockAccountService) NewCreateAccountParams(accounttype int, email string, firstname string, lastname string, password string, username string) *CreateAccountParams {
+	return (&AccountService{}).NewCreateAccountParams(accounttype, email, firstname, lastname, password, username)
+}
+
+func (m *MockAccountService) CreateAccount(p *CreateAccountParams) (*CreateAccountResponse, error) {
+	return m.CreateAccountWithContext(context.Background(), p)
+}
+
+func (m *MockAccountService) CreateAccountWithContext(ctx context.Context, p *CreateAccountParams) (*CreateAccountResponse, error) {
+	m.record("CreateAccount", p)
+	if m.CreateAccountFunc == nil {
+		return nil, notMocked("MockAccountService", "CreateAccount")
+	}
+	return m.CreateAccountFunc(ctx, p)
+}
+
+func (m *MockAccountService) NewDeleteAccountParams(id string) *DeleteAccountParams {
+	return (&AccountService{}).NewDeleteAccountParams(id)
+}
+
+func (m *MockAccountService) DeleteAccount(p *DeleteAccountParams) (*DeleteAccountResponse, error) {
+	return m.DeleteAccountWithContext(context.Background(), p)
+}
+
+func (m *MockAccountService) DeleteAccountWithContext(ctx context.Context, p *DeleteAccountParams) (*DeleteAccountResponse, error) {
+	m.record("DeleteAccount", p)
+	if m.DeleteAccountFunc == nil {
+		return nil, notMocked("MockAccountService", "DeleteAccount")
+	}
+	return m.DeleteAccountFunc(ctx, p)
+}
+
+func (m *MockAccountService) DeleteAccountAsync(ctx context.Context, p *DeleteAccountParams) (*DeleteAccountJob, error) {
+	m.record("DeleteAccountAsync", p)
+	if m.DeleteAccountAsyncFunc == nil {
+		return nil, notMocked("MockAccountService", "DeleteAccountAsync")
+	}
+	return m.DeleteAccountAsyncFunc(ctx, p)
+}
+
+func (m *MockAccountService) NewDisableAccountParams(lock bool) *DisableAccountParams {
+	return (&AccountService{}).NewDisableAccountParams(lock)
+}
+
+func (m *MockAccountService) DisableAccount(p *DisableAccountParams) (*DisableAccountResponse, error) {
+	return m.DisableAccountWithContext(context.Background(), p)
+}
+
+func (m *MockAccountService) DisableAccountWithContext(ctx context.Context, p *DisableAccountParams) (*DisableAccountResponse, error) {
+	m.record("DisableAccount", p)
+	if m.DisableAccountFunc == nil {
+		return nil, notMocked("MockAccountService", "DisableAccount")
+	}
+	return m.DisableAccountFunc(ctx, p)
+}
+
+func (m *MockAccountService) DisableAccountAsync(ctx context.Context, p *DisableAccountParams) (*DisableAccountJob, error) {
+	m.record("DisableAccountAsync", p)
+	if m.DisableAccountAsyncFunc == nil {
+		return nil, notMocked("MockAccountService", "DisableAccountAsync")
+	}
+	return m.DisableAccountAsyncFunc(ctx, p)
+}
+
+func (m *MockAccountService) NewEnableAccountParams() *EnableAccountParams {
+	return (&AccountService{}).NewEnableAccountParams()
+}
+
+func (m *MockAccountService) EnableAccount(p *EnableAccountParams) (*EnableAccountResponse, error) {
+	return m.EnableAccountWithContext(context.Background(), p)
+}
+
+func (m *MockAccountService) EnableAccountWithContext(ctx context.Context, p *EnableAccountParams) (*EnableAccountResponse, error) {
+	m.record("EnableAccount", p)
+	if m.EnableAccountFunc == nil {
+		return nil, notMocked("MockAccountService", "EnableAccount")
+	}
+	return m.EnableAccountFunc(ctx, p)
+}
+
+func (m *MockAccountService) NewLockAccountParams(account string, domainid string) *LockAccountParams {
+	return (&AccountService{}).NewLockAccountParams(account, domainid)
+}
+
+func (m *MockAccountService) LockAccount(p *LockAccountParams) (*LockAccountResponse, error) {
+	return m.LockAccountWithContext(context.Background(), p)
+}
+
+func (m *MockAccountService) LockAccountWithContext(ctx context.Context, p *LockAccountParams) (*LockAccountResponse, error) {
+	m.record("LockAccount", p)
+	if m.LockAccountFunc == nil {
+		return nil, notMocked("MockAccountService", "LockAccount")
+	}
+	return m.LockAccountFunc(ctx, p)
+}
+
+func (m *MockAccountService) NewUpdateAccountParams(newname string) *UpdateAccountParams {
+	return (&AccountService{}).NewUpdateAccountParams(newname)
+}
+
+func (m *MockAccountService) UpdateAccount(p *UpdateAccountParams) (*UpdateAccountResponse, error) {
+	return m.UpdateAccountWithContext(context.Background(), p)
+}
+
+func (m *MockAccountService) UpdateAccountWithContext(ctx context.Context, p *UpdateAccountParams) (*UpdateAccountResponse, error) {
+	m.record("UpdateAccount", p)
+	if m.UpdateAccountFunc == nil {
+		return nil, notMocked("MockAccountService", "UpdateAccount")
+	}
+	return m.UpdateAccountFunc(ctx, p)
+}
+
+func (m *MockAccountService) NewDeleteAccountFromProjectParams(account string, projectid string) *DeleteAccountFromProjectParams {
+	return (&AccountService{}).NewDeleteAccountFromProjectParams(account, projectid)
+}
+
+func (m *MockAccountService) DeleteAccountFromProject(p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error) {
+	return m.DeleteAccountFromProjectWithContext(context.Background(), p)
+}
+
+func (m *MockAccountService) DeleteAccountFromProjectWithContext(ctx context.Context, p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error) {
+	m.record("DeleteAccountFromProject", p)
+	if m.DeleteAccountFromProjectFunc == nil {
+		return nil, notMocked("MockAccountService", "DeleteAccountFromProject")
+	}
+	return m.DeleteAccountFromProjectFunc(ctx, p)
+}
+
+func (m *MockAccountService) DeleteAccountFromProjectAsync(ctx context.Context, p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectJob, error) {
+	m.record("DeleteAccountFromProjectAsync", p)
+	if m.DeleteAccountFromProjectAsyncFunc == nil {
+		return nil, notMocked("MockAccountService", "DeleteAccountFromProjectAsync")
+	}
+	return m.DeleteAccountFromProjectAsyncFunc(ctx, p)
+}
+
+func (m *MockAccountService) NewAddAccountToProjectParams(projectid string) *AddAccountToProjectParams {
+	return (&AccountService{}).NewAddAccountToProjectParams(projectid)
+}
+
+func (m *MockAccountService) AddAccountToProject(p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error) {
+	return m.AddAccountToProjectWithContext(context.Background(), p)
+}
+
+func (m *MockAccountService) AddAccountToProjectWithContext(ctx context.Context, p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error) {
+	m.record("AddAccountToProject", p)
+	if m.AddAccountToProjectFunc == nil {
+		return nil, notMocked("MockAccountService", "AddAccountToProject")
+	}
+	return m.AddAccountToProjectFunc(ctx, p)
+}
+
+func (m *MockAccountService) AddAccountToProjectAsync(ctx context.Context, p *AddAccountToProjectParams) (*AddAccountToProjectJob, error) {
+	m.record("AddAccountToProjectAsync", p)
+	if m.AddAccountToProjectAsyncFunc == nil {
+		return nil, notMocked("MockAccountService", "AddAccountToProjectAsync")
+	}
+	return m.AddAccountToProjectAsyncFunc(ctx, p)
+}
+
+func (m *MockAccountService) NewListAccountsParams() *ListAccountsParams {
+	return (&AccountService{}).NewListAccountsParams()
+}
+
+func (m *MockAccountService) GetAccountID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetAccountID", name, opts)
+	if m.GetAccountIDFunc == nil {
+		return "", -1, notMocked("MockAccountService", "GetAccountID")
+	}
+	return m.GetAccountIDFunc(name, opts...)
+}
+
+func (m *MockAccountService) GetAccountByName(name string, opts ...OptionFunc) (*Account, int, error) {
+	m.record("GetAccountByName", name, opts)
+	if m.GetAccountByNameFunc == nil {
+		return nil, -1, notMocked("MockAccountService", "GetAccountByName")
+	}
+	return m.GetAccountByNameFunc(name, opts...)
+}
+
+func (m *MockAccountService) GetAccountByID(id string, opts ...OptionFunc) (*Account, int, error) {
+	m.record("GetAccountByID", id, opts)
+	if m.GetAccountByIDFunc == nil {
+		return nil, -1, notMocked("MockAccountService", "GetAccountByID")
+	}
+	return m.GetAccountByIDFunc(id, opts...)
+}
+
+func (m *MockAccountService) ListAccounts(p *ListAccountsParams) (*ListAccountsResponse, error) {
+	return m.ListAccountsWithContext(context.Background(), p)
+}
+
+func (m *MockAccountService) ListAccountsWithContext(ctx context.Context, p *ListAccountsParams) (*ListAccountsResponse, error) {
+	m.record("ListAccounts", p)
+	if m.ListAccountsFunc == nil {
+		return nil, notMocked("MockAccountService", "ListAccounts")
+	}
+	return m.ListAccountsFunc(ctx, p)
+}
+
+func (m *MockAccountService) NewListAccountsIterator(p *ListAccountsParams, pagesize int) *ListAccountsIterator {
+	i := &ListAccountsIterator{pager: newPager(nil, "listAccounts", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListAccountsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListAccountsWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockAccountService) NewMarkDefaultZoneForAccountParams(account string, domainid string, zoneid string) *MarkDefaultZoneForAccountParams {
+	return (&AccountService{}).NewMarkDefaultZoneForAccountParams(account, domainid, zoneid)
+}
+
+func (m *MockAccountService) MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountResponse, error) {
+	return m.MarkDefaultZoneForAccountWithContext(context.Background(), p)
+}
+
+func (m *MockAccountService) MarkDefaultZoneForAccountWithContext(ctx context.Context, p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountResponse, error) {
+	m.record("MarkDefaultZoneForAccount", p)
+	if m.MarkDefaultZoneForAccountFunc == nil {
+		return nil, notMocked("MockAccountService", "MarkDefaultZoneForAccount")
+	}
+	return m.MarkDefaultZoneForAccountFunc(ctx, p)
+}
+
+func (m *MockAccountService) MarkDefaultZoneForAccountAsync(ctx context.Context, p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountJob, error) {
+	m.record("MarkDefaultZoneForAccountAsync", p)
+	if m.MarkDefaultZoneForAccountAsyncFunc == nil {
+		return nil, notMocked("MockAccountService", "MarkDefaultZoneForAccountAsync")
+	}
+	return m.MarkDefaultZoneForAccountAsyncFunc(ctx, p)
+}
+
+func (m *MockAccountService) NewListProjectAccountsParams(projectid string) *ListProjectAccountsParams {
+	return (&AccountService{}).NewListProjectAccountsParams(projectid)
+}
+
+func (m *MockAccountService) GetProjectAccountID(keyword string, projectid string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetProjectAccountID", keyword, projectid, opts)
+	if m.GetProjectAccountIDFunc == nil {
+		return "", -1, notMocked("MockAccountService", "GetProjectAccountID")
+	}
+	return m.GetProjectAccountIDFunc(keyword, projectid, opts...)
+}
+
+func (m *MockAccountService) ListProjectAccounts(p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error) {
+	return m.ListProjectAccountsWithContext(context.Background(), p)
+}
+
+func (m *MockAccountService) ListProjectAccountsWithContext(ctx context.Context, p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error) {
+	m.record("ListProjectAccounts", p)
+	if m.ListProjectAccountsFunc == nil {
+		return nil, notMocked("MockAccountService", "ListProjectAccounts")
+	}
+	return m.ListProjectAccountsFunc(ctx, p)
+}
+
+func (m *MockAccountService) NewListProjectAccountsIterator(p *ListProjectAccountsParams, pagesize int) *ListProjectAccountsIterator {
+	i := &ListProjectAccountsIterator{pager: newPager(nil, "listProjectAccounts", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListProjectAccountsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListProjectAccountsWithContext(ctx, c)
+	}
+	return i
+}
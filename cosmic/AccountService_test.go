@@ -0,0 +1,253 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCreateAccountParams(t *testing.T) {
+	p := &CreateAccountParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetAccountdetails(map[string]string{"k": "v"})
+	want.Set("accountdetails[0].key", "k")
+	want.Set("accountdetails[0].value", "v")
+	p.SetAccountid("accountid-value")
+	want.Set("accountid", "accountid-value")
+	p.SetAccounttype(42)
+	want.Set("accounttype", "42")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetEmail("email-value")
+	want.Set("email", "email-value")
+	p.SetFirstname("firstname-value")
+	want.Set("firstname", "firstname-value")
+	p.SetLastname("lastname-value")
+	want.Set("lastname", "lastname-value")
+	p.SetNetworkdomain("networkdomain-value")
+	want.Set("networkdomain", "networkdomain-value")
+	p.SetPassword("password-value")
+	want.Set("password", "password-value")
+	p.SetTimezone("timezone-value")
+	want.Set("timezone", "timezone-value")
+	p.SetUserid("userid-value")
+	want.Set("userid", "userid-value")
+	p.SetUsername("username-value")
+	want.Set("username", "username-value")
+
+	assertParams(t, p, "createAccount", want)
+}
+
+func TestCreateAccountResponse(t *testing.T) {
+	assertDecodes(t, `{"accountdetails":{"key":"value"},"accounttype":42,"cpuavailable":"cpuavailable-value","cpulimit":"cpulimit-value","cputotal":1099511627776,"defaultzoneid":"defaultzoneid-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","ipavailable":"ipavailable-value","iplimit":"iplimit-value","iptotal":1099511627776,"iscleanuprequired":true,"isdefault":true,"memoryavailable":"memoryavailable-value","memorylimit":"memorylimit-value","memorytotal":1099511627776,"name":"name-value","networkavailable":"networkavailable-value","networkdomain":"networkdomain-value","networklimit":"networklimit-value","networktotal":1099511627776,"primarystorageavailable":"primarystorageavailable-value","primarystoragelimit":"primarystoragelimit-value","primarystoragetotal":1099511627776,"projectavailable":"projectavailable-value","projectlimit":"projectlimit-value","projecttotal":1099511627776,"receivedbytes":1099511627776,"secondarystorageavailable":"secondarystorageavailable-value","secondarystoragelimit":"secondarystoragelimit-value","secondarystoragetotal":1099511627776,"sentbytes":1099511627776,"snapshotavailable":"snapshotavailable-value","snapshotlimit":"snapshotlimit-value","snapshottotal":1099511627776,"state":"state-value","templateavailable":"templateavailable-value","templatelimit":"templatelimit-value","templatetotal":1099511627776,"user":[{"account":"account-value","accountid":"accountid-value","accounttype":42,"apikey":"apikey-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","email":"email-value","firstname":"firstname-value","id":"id-value","iscallerchilddomain":true,"isdefault":true,"lastname":"lastname-value","secretkey":"secretkey-value","state":"state-value","timezone":"timezone-value","username":"username-value"}],"vmavailable":"vmavailable-value","vmlimit":"vmlimit-value","vmrunning":42,"vmstopped":42,"vmtotal":1099511627776,"volumeavailable":"volumeavailable-value","volumelimit":"volumelimit-value","volumetotal":1099511627776,"vpcavailable":"vpcavailable-value","vpclimit":"vpclimit-value","vpctotal":1099511627776}`, &CreateAccountResponse{})
+}
+
+func TestDeleteAccountParams(t *testing.T) {
+	p := &DeleteAccountParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteAccount", want)
+}
+
+func TestDeleteAccountResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteAccountResponse{})
+}
+
+func TestDisableAccountParams(t *testing.T) {
+	p := &DisableAccountParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetLock(true)
+	want.Set("lock", "true")
+
+	assertParams(t, p, "disableAccount", want)
+}
+
+func TestDisableAccountResponse(t *testing.T) {
+	assertDecodes(t, `{"accountdetails":{"key":"value"},"accounttype":42,"cpuavailable":"cpuavailable-value","cpulimit":"cpulimit-value","cputotal":1099511627776,"defaultzoneid":"defaultzoneid-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","ipavailable":"ipavailable-value","iplimit":"iplimit-value","iptotal":1099511627776,"iscleanuprequired":true,"isdefault":true,"jobid":"jobid-value","memoryavailable":"memoryavailable-value","memorylimit":"memorylimit-value","memorytotal":1099511627776,"name":"name-value","networkavailable":"networkavailable-value","networkdomain":"networkdomain-value","networklimit":"networklimit-value","networktotal":1099511627776,"primarystorageavailable":"primarystorageavailable-value","primarystoragelimit":"primarystoragelimit-value","primarystoragetotal":1099511627776,"projectavailable":"projectavailable-value","projectlimit":"projectlimit-value","projecttotal":1099511627776,"receivedbytes":1099511627776,"secondarystorageavailable":"secondarystorageavailable-value","secondarystoragelimit":"secondarystoragelimit-value","secondarystoragetotal":1099511627776,"sentbytes":1099511627776,"snapshotavailable":"snapshotavailable-value","snapshotlimit":"snapshotlimit-value","snapshottotal":1099511627776,"state":"state-value","templateavailable":"templateavailable-value","templatelimit":"templatelimit-value","templatetotal":1099511627776,"user":[{"account":"account-value","accountid":"accountid-value","accounttype":42,"apikey":"apikey-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","email":"email-value","firstname":"firstname-value","id":"id-value","iscallerchilddomain":true,"isdefault":true,"lastname":"lastname-value","secretkey":"secretkey-value","state":"state-value","timezone":"timezone-value","username":"username-value"}],"vmavailable":"vmavailable-value","vmlimit":"vmlimit-value","vmrunning":42,"vmstopped":42,"vmtotal":1099511627776,"volumeavailable":"volumeavailable-value","volumelimit":"volumelimit-value","volumetotal":1099511627776,"vpcavailable":"vpcavailable-value","vpclimit":"vpclimit-value","vpctotal":1099511627776}`, &DisableAccountResponse{})
+}
+
+func TestEnableAccountParams(t *testing.T) {
+	p := &EnableAccountParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "enableAccount", want)
+}
+
+func TestEnableAccountResponse(t *testing.T) {
+	assertDecodes(t, `{"accountdetails":{"key":"value"},"accounttype":42,"cpuavailable":"cpuavailable-value","cpulimit":"cpulimit-value","cputotal":1099511627776,"defaultzoneid":"defaultzoneid-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","ipavailable":"ipavailable-value","iplimit":"iplimit-value","iptotal":1099511627776,"iscleanuprequired":true,"isdefault":true,"memoryavailable":"memoryavailable-value","memorylimit":"memorylimit-value","memorytotal":1099511627776,"name":"name-value","networkavailable":"networkavailable-value","networkdomain":"networkdomain-value","networklimit":"networklimit-value","networktotal":1099511627776,"primarystorageavailable":"primarystorageavailable-value","primarystoragelimit":"primarystoragelimit-value","primarystoragetotal":1099511627776,"projectavailable":"projectavailable-value","projectlimit":"projectlimit-value","projecttotal":1099511627776,"receivedbytes":1099511627776,"secondarystorageavailable":"secondarystorageavailable-value","secondarystoragelimit":"secondarystoragelimit-value","secondarystoragetotal":1099511627776,"sentbytes":1099511627776,"snapshotavailable":"snapshotavailable-value","snapshotlimit":"snapshotlimit-value","snapshottotal":1099511627776,"state":"state-value","templateavailable":"templateavailable-value","templatelimit":"templatelimit-value","templatetotal":1099511627776,"user":[{"account":"account-value","accountid":"accountid-value","accounttype":42,"apikey":"apikey-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","email":"email-value","firstname":"firstname-value","id":"id-value","iscallerchilddomain":true,"isdefault":true,"lastname":"lastname-value","secretkey":"secretkey-value","state":"state-value","timezone":"timezone-value","username":"username-value"}],"vmavailable":"vmavailable-value","vmlimit":"vmlimit-value","vmrunning":42,"vmstopped":42,"vmtotal":1099511627776,"volumeavailable":"volumeavailable-value","volumelimit":"volumelimit-value","volumetotal":1099511627776,"vpcavailable":"vpcavailable-value","vpclimit":"vpclimit-value","vpctotal":1099511627776}`, &EnableAccountResponse{})
+}
+
+func TestLockAccountParams(t *testing.T) {
+	p := &LockAccountParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+
+	assertParams(t, p, "lockAccount", want)
+}
+
+func TestLockAccountResponse(t *testing.T) {
+	assertDecodes(t, `{"accountdetails":{"key":"value"},"accounttype":42,"cpuavailable":"cpuavailable-value","cpulimit":"cpulimit-value","cputotal":1099511627776,"defaultzoneid":"defaultzoneid-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","ipavailable":"ipavailable-value","iplimit":"iplimit-value","iptotal":1099511627776,"iscleanuprequired":true,"isdefault":true,"memoryavailable":"memoryavailable-value","memorylimit":"memorylimit-value","memorytotal":1099511627776,"name":"name-value","networkavailable":"networkavailable-value","networkdomain":"networkdomain-value","networklimit":"networklimit-value","networktotal":1099511627776,"primarystorageavailable":"primarystorageavailable-value","primarystoragelimit":"primarystoragelimit-value","primarystoragetotal":1099511627776,"projectavailable":"projectavailable-value","projectlimit":"projectlimit-value","projecttotal":1099511627776,"receivedbytes":1099511627776,"secondarystorageavailable":"secondarystorageavailable-value","secondarystoragelimit":"secondarystoragelimit-value","secondarystoragetotal":1099511627776,"sentbytes":1099511627776,"snapshotavailable":"snapshotavailable-value","snapshotlimit":"snapshotlimit-value","snapshottotal":1099511627776,"state":"state-value","templateavailable":"templateavailable-value","templatelimit":"templatelimit-value","templatetotal":1099511627776,"user":[{"account":"account-value","accountid":"accountid-value","accounttype":42,"apikey":"apikey-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","email":"email-value","firstname":"firstname-value","id":"id-value","iscallerchilddomain":true,"isdefault":true,"lastname":"lastname-value","secretkey":"secretkey-value","state":"state-value","timezone":"timezone-value","username":"username-value"}],"vmavailable":"vmavailable-value","vmlimit":"vmlimit-value","vmrunning":42,"vmstopped":42,"vmtotal":1099511627776,"volumeavailable":"volumeavailable-value","volumelimit":"volumelimit-value","volumetotal":1099511627776,"vpcavailable":"vpcavailable-value","vpclimit":"vpclimit-value","vpctotal":1099511627776}`, &LockAccountResponse{})
+}
+
+func TestUpdateAccountParams(t *testing.T) {
+	p := &UpdateAccountParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetAccountdetails(map[string]string{"k": "v"})
+	want.Set("accountdetails[0].key", "k")
+	want.Set("accountdetails[0].value", "v")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetNetworkdomain("networkdomain-value")
+	want.Set("networkdomain", "networkdomain-value")
+	p.SetNewname("newname-value")
+	want.Set("newname", "newname-value")
+
+	assertParams(t, p, "updateAccount", want)
+}
+
+func TestUpdateAccountResponse(t *testing.T) {
+	assertDecodes(t, `{"accountdetails":{"key":"value"},"accounttype":42,"cpuavailable":"cpuavailable-value","cpulimit":"cpulimit-value","cputotal":1099511627776,"defaultzoneid":"defaultzoneid-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","ipavailable":"ipavailable-value","iplimit":"iplimit-value","iptotal":1099511627776,"iscleanuprequired":true,"isdefault":true,"memoryavailable":"memoryavailable-value","memorylimit":"memorylimit-value","memorytotal":1099511627776,"name":"name-value","networkavailable":"networkavailable-value","networkdomain":"networkdomain-value","networklimit":"networklimit-value","networktotal":1099511627776,"primarystorageavailable":"primarystorageavailable-value","primarystoragelimit":"primarystoragelimit-value","primarystoragetotal":1099511627776,"projectavailable":"projectavailable-value","projectlimit":"projectlimit-value","projecttotal":1099511627776,"receivedbytes":1099511627776,"secondarystorageavailable":"secondarystorageavailable-value","secondarystoragelimit":"secondarystoragelimit-value","secondarystoragetotal":1099511627776,"sentbytes":1099511627776,"snapshotavailable":"snapshotavailable-value","snapshotlimit":"snapshotlimit-value","snapshottotal":1099511627776,"state":"state-value","templateavailable":"templateavailable-value","templatelimit":"templatelimit-value","templatetotal":1099511627776,"user":[{"account":"account-value","accountid":"accountid-value","accounttype":42,"apikey":"apikey-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","email":"email-value","firstname":"firstname-value","id":"id-value","iscallerchilddomain":true,"isdefault":true,"lastname":"lastname-value","secretkey":"secretkey-value","state":"state-value","timezone":"timezone-value","username":"username-value"}],"vmavailable":"vmavailable-value","vmlimit":"vmlimit-value","vmrunning":42,"vmstopped":42,"vmtotal":1099511627776,"volumeavailable":"volumeavailable-value","volumelimit":"volumelimit-value","volumetotal":1099511627776,"vpcavailable":"vpcavailable-value","vpclimit":"vpclimit-value","vpctotal":1099511627776}`, &UpdateAccountResponse{})
+}
+
+func TestDeleteAccountFromProjectParams(t *testing.T) {
+	p := &DeleteAccountFromProjectParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+
+	assertParams(t, p, "deleteAccountFromProject", want)
+}
+
+func TestDeleteAccountFromProjectResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteAccountFromProjectResponse{})
+}
+
+func TestAddAccountToProjectParams(t *testing.T) {
+	p := &AddAccountToProjectParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetEmail("email-value")
+	want.Set("email", "email-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+
+	assertParams(t, p, "addAccountToProject", want)
+}
+
+func TestAddAccountToProjectResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &AddAccountToProjectResponse{})
+}
+
+func TestListAccountsParams(t *testing.T) {
+	p := &ListAccountsParams{}
+	want := url.Values{}
+	p.SetAccounttype(int64(1) << 40)
+	want.Set("accounttype", "1099511627776")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIscleanuprequired(true)
+	want.Set("iscleanuprequired", "true")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetState("state-value")
+	want.Set("state", "state-value")
+
+	assertParams(t, p, "listAccounts", want)
+}
+
+func TestListAccountsResponse(t *testing.T) {
+	assertDecodes(t, `{"account":[{"accountdetails":{"key":"value"},"accounttype":42,"cpuavailable":"cpuavailable-value","cpulimit":"cpulimit-value","cputotal":1099511627776,"defaultzoneid":"defaultzoneid-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","ipavailable":"ipavailable-value","iplimit":"iplimit-value","iptotal":1099511627776,"iscleanuprequired":true,"isdefault":true,"memoryavailable":"memoryavailable-value","memorylimit":"memorylimit-value","memorytotal":1099511627776,"name":"name-value","networkavailable":"networkavailable-value","networkdomain":"networkdomain-value","networklimit":"networklimit-value","networktotal":1099511627776,"primarystorageavailable":"primarystorageavailable-value","primarystoragelimit":"primarystoragelimit-value","primarystoragetotal":1099511627776,"projectavailable":"projectavailable-value","projectlimit":"projectlimit-value","projecttotal":1099511627776,"receivedbytes":1099511627776,"secondarystorageavailable":"secondarystorageavailable-value","secondarystoragelimit":"secondarystoragelimit-value","secondarystoragetotal":1099511627776,"sentbytes":1099511627776,"snapshotavailable":"snapshotavailable-value","snapshotlimit":"snapshotlimit-value","snapshottotal":1099511627776,"state":"state-value","templateavailable":"templateavailable-value","templatelimit":"templatelimit-value","templatetotal":1099511627776,"user":[{"account":"account-value","accountid":"accountid-value","accounttype":42,"apikey":"apikey-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","email":"email-value","firstname":"firstname-value","id":"id-value","iscallerchilddomain":true,"isdefault":true,"lastname":"lastname-value","secretkey":"secretkey-value","state":"state-value","timezone":"timezone-value","username":"username-value"}],"vmavailable":"vmavailable-value","vmlimit":"vmlimit-value","vmrunning":42,"vmstopped":42,"vmtotal":1099511627776,"volumeavailable":"volumeavailable-value","volumelimit":"volumelimit-value","volumetotal":1099511627776,"vpcavailable":"vpcavailable-value","vpclimit":"vpclimit-value","vpctotal":1099511627776}],"count":1}`, &ListAccountsResponse{})
+}
+
+func TestMarkDefaultZoneForAccountParams(t *testing.T) {
+	p := &MarkDefaultZoneForAccountParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "markDefaultZoneForAccount", want)
+}
+
+func TestMarkDefaultZoneForAccountResponse(t *testing.T) {
+	assertDecodes(t, `{"accountdetails":{"key":"value"},"accounttype":42,"cpuavailable":"cpuavailable-value","cpulimit":"cpulimit-value","cputotal":1099511627776,"defaultzoneid":"defaultzoneid-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","ipavailable":"ipavailable-value","iplimit":"iplimit-value","iptotal":1099511627776,"iscleanuprequired":true,"isdefault":true,"jobid":"jobid-value","memoryavailable":"memoryavailable-value","memorylimit":"memorylimit-value","memorytotal":1099511627776,"name":"name-value","networkavailable":"networkavailable-value","networkdomain":"networkdomain-value","networklimit":"networklimit-value","networktotal":1099511627776,"primarystorageavailable":"primarystorageavailable-value","primarystoragelimit":"primarystoragelimit-value","primarystoragetotal":1099511627776,"projectavailable":"projectavailable-value","projectlimit":"projectlimit-value","projecttotal":1099511627776,"receivedbytes":1099511627776,"secondarystorageavailable":"secondarystorageavailable-value","secondarystoragelimit":"secondarystoragelimit-value","secondarystoragetotal":1099511627776,"sentbytes":1099511627776,"snapshotavailable":"snapshotavailable-value","snapshotlimit":"snapshotlimit-value","snapshottotal":1099511627776,"state":"state-value","templateavailable":"templateavailable-value","templatelimit":"templatelimit-value","templatetotal":1099511627776,"user":[{"account":"account-value","accountid":"accountid-value","accounttype":42,"apikey":"apikey-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","email":"email-value","firstname":"firstname-value","id":"id-value","iscallerchilddomain":true,"isdefault":true,"lastname":"lastname-value","secretkey":"secretkey-value","state":"state-value","timezone":"timezone-value","username":"username-value"}],"vmavailable":"vmavailable-value","vmlimit":"vmlimit-value","vmrunning":42,"vmstopped":42,"vmtotal":1099511627776,"volumeavailable":"volumeavailable-value","volumelimit":"volumelimit-value","volumetotal":1099511627776,"vpcavailable":"vpcavailable-value","vpclimit":"vpclimit-value","vpctotal":1099511627776}`, &MarkDefaultZoneForAccountResponse{})
+}
+
+func TestListProjectAccountsParams(t *testing.T) {
+	p := &ListProjectAccountsParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetRole("role-value")
+	want.Set("role", "role-value")
+
+	assertParams(t, p, "listProjectAccounts", want)
+}
+
+func TestListProjectAccountsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"projectaccount":[{"account":"account-value","cpuavailable":"cpuavailable-value","cpulimit":"cpulimit-value","cputotal":1099511627776,"displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","ipavailable":"ipavailable-value","iplimit":"iplimit-value","iptotal":1099511627776,"memoryavailable":"memoryavailable-value","memorylimit":"memorylimit-value","memorytotal":1099511627776,"name":"name-value","networkavailable":"networkavailable-value","networklimit":"networklimit-value","networktotal":1099511627776,"primarystorageavailable":"primarystorageavailable-value","primarystoragelimit":"primarystoragelimit-value","primarystoragetotal":1099511627776,"secondarystorageavailable":"secondarystorageavailable-value","secondarystoragelimit":"secondarystoragelimit-value","secondarystoragetotal":1099511627776,"snapshotavailable":"snapshotavailable-value","snapshotlimit":"snapshotlimit-value","snapshottotal":1099511627776,"state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templateavailable":"templateavailable-value","templatelimit":"templatelimit-value","templatetotal":1099511627776,"vmavailable":"vmavailable-value","vmlimit":"vmlimit-value","vmrunning":42,"vmstopped":42,"vmtotal":1099511627776,"volumeavailable":"volumeavailable-value","volumelimit":"volumelimit-value","volumetotal":1099511627776,"vpcavailable":"vpcavailable-value","vpclimit":"vpclimit-value","vpctotal":1099511627776}]}`, &ListProjectAccountsResponse{})
+}
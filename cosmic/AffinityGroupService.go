@@ -17,17 +17,50 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 )
 
+// AffinityGroupServiceIface is the interface implemented by AffinityGroupService, and by MockAffinityGroupService for tests
+type AffinityGroupServiceIface interface {
+	NewCreateAffinityGroupParams(name string, affinityGroupType string) *CreateAffinityGroupParams
+	CreateAffinityGroup(p *CreateAffinityGroupParams) (*CreateAffinityGroupResponse, error)
+	CreateAffinityGroupWithContext(ctx context.Context, p *CreateAffinityGroupParams) (*CreateAffinityGroupResponse, error)
+	CreateAffinityGroupAsync(ctx context.Context, p *CreateAffinityGroupParams) (*CreateAffinityGroupJob, error)
+	NewDeleteAffinityGroupParams() *DeleteAffinityGroupParams
+	DeleteAffinityGroup(p *DeleteAffinityGroupParams) (*DeleteAffinityGroupResponse, error)
+	DeleteAffinityGroupWithContext(ctx context.Context, p *DeleteAffinityGroupParams) (*DeleteAffinityGroupResponse, error)
+	DeleteAffinityGroupAsync(ctx context.Context, p *DeleteAffinityGroupParams) (*DeleteAffinityGroupJob, error)
+	NewListAffinityGroupTypesParams() *ListAffinityGroupTypesParams
+	ListAffinityGroupTypes(p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error)
+	ListAffinityGroupTypesWithContext(ctx context.Context, p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error)
+	NewListAffinityGroupTypesIterator(p *ListAffinityGroupTypesParams, pagesize int) *ListAffinityGroupTypesIterator
+	NewListAffinityGroupsParams() *ListAffinityGroupsParams
+	GetAffinityGroupID(name string, opts ...OptionFunc) (string, int, error)
+	GetAffinityGroupByName(name string, opts ...OptionFunc) (*AffinityGroup, int, error)
+	GetAffinityGroupByID(id string, opts ...OptionFunc) (*AffinityGroup, int, error)
+	ListAffinityGroups(p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error)
+	ListAffinityGroupsWithContext(ctx context.Context, p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error)
+	NewListAffinityGroupsIterator(p *ListAffinityGroupsParams, pagesize int) *ListAffinityGroupsIterator
+	NewUpdateVMAffinityGroupParams(id string) *UpdateVMAffinityGroupParams
+	UpdateVMAffinityGroup(p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupResponse, error)
+	UpdateVMAffinityGroupWithContext(ctx context.Context, p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupResponse, error)
+	UpdateVMAffinityGroupAsync(ctx context.Context, p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupJob, error)
+}
+
 type CreateAffinityGroupParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateAffinityGroupParams) command() string {
+	return "createAffinityGroup"
+}
+
 func (p *CreateAffinityGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -108,7 +141,13 @@ func (s *AffinityGroupService) NewCreateAffinityGroupParams(name string, affinit
 
 // Creates an affinity/anti-affinity group
 func (s *AffinityGroupService) CreateAffinityGroup(p *CreateAffinityGroupParams) (*CreateAffinityGroupResponse, error) {
-	resp, err := s.cs.newRequest("createAffinityGroup", p.toURLValues())
+	return s.CreateAffinityGroupWithContext(context.Background(), p)
+}
+
+// CreateAffinityGroupWithContext is the same as CreateAffinityGroup, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AffinityGroupService) CreateAffinityGroupWithContext(ctx context.Context, p *CreateAffinityGroupParams) (*CreateAffinityGroupResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createAffinityGroup", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -120,26 +159,56 @@ func (s *AffinityGroupService) CreateAffinityGroup(p *CreateAffinityGroupParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createAffinityGroup", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "affinitygroup", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CreateAffinityGroupJob is a handle to a running createAffinityGroup async job
+type CreateAffinityGroupJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateAffinityGroupResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateAffinityGroupJob) Result() (*CreateAffinityGroupResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateAffinityGroupResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "affinitygroup", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CreateAffinityGroupAsync starts the createAffinityGroup async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *AffinityGroupService) CreateAffinityGroupAsync(ctx context.Context, p *CreateAffinityGroupParams) (*CreateAffinityGroupJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createAffinityGroup", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateAffinityGroupResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateAffinityGroupJob{s.cs.newJobHandle("createAffinityGroup", r.JobID)}, nil
+}
+
 type CreateAffinityGroupResponse struct {
 	JobID             string   `json:"jobid,omitempty"`
 	Account           string   `json:"account,omitempty"`
@@ -158,6 +227,10 @@ type DeleteAffinityGroupParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteAffinityGroupParams) command() string {
+	return "deleteAffinityGroup"
+}
+
 func (p *DeleteAffinityGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -226,7 +299,13 @@ func (s *AffinityGroupService) NewDeleteAffinityGroupParams() *DeleteAffinityGro
 
 // Deletes affinity group
 func (s *AffinityGroupService) DeleteAffinityGroup(p *DeleteAffinityGroupParams) (*DeleteAffinityGroupResponse, error) {
-	resp, err := s.cs.newRequest("deleteAffinityGroup", p.toURLValues())
+	return s.DeleteAffinityGroupWithContext(context.Background(), p)
+}
+
+// DeleteAffinityGroupWithContext is the same as DeleteAffinityGroup, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AffinityGroupService) DeleteAffinityGroupWithContext(ctx context.Context, p *DeleteAffinityGroupParams) (*DeleteAffinityGroupResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteAffinityGroup", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -238,9 +317,9 @@ func (s *AffinityGroupService) DeleteAffinityGroup(p *DeleteAffinityGroupParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteAffinityGroup", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -253,6 +332,41 @@ func (s *AffinityGroupService) DeleteAffinityGroup(p *DeleteAffinityGroupParams)
 	return &r, nil
 }
 
+// DeleteAffinityGroupJob is a handle to a running deleteAffinityGroup async job
+type DeleteAffinityGroupJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteAffinityGroupResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteAffinityGroupJob) Result() (*DeleteAffinityGroupResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteAffinityGroupResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteAffinityGroupAsync starts the deleteAffinityGroup async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *AffinityGroupService) DeleteAffinityGroupAsync(ctx context.Context, p *DeleteAffinityGroupParams) (*DeleteAffinityGroupJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteAffinityGroup", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteAffinityGroupResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteAffinityGroupJob{s.cs.newJobHandle("deleteAffinityGroup", r.JobID)}, nil
+}
+
 type DeleteAffinityGroupResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -263,6 +377,10 @@ type ListAffinityGroupTypesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListAffinityGroupTypesParams) command() string {
+	return "listAffinityGroupTypes"
+}
+
 func (p *ListAffinityGroupTypesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -313,10 +431,17 @@ func (s *AffinityGroupService) NewListAffinityGroupTypesParams() *ListAffinityGr
 
 // Lists affinity group types available
 func (s *AffinityGroupService) ListAffinityGroupTypes(p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error) {
+	return s.ListAffinityGroupTypesWithContext(context.Background(), p)
+}
+
+// ListAffinityGroupTypesWithContext is the same as ListAffinityGroupTypes, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AffinityGroupService) ListAffinityGroupTypesWithContext(ctx context.Context, p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error) {
 	var r ListAffinityGroupTypesResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListAffinityGroupTypesResponse
-		resp, err := s.cs.newRequest("listAffinityGroupTypes", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listAffinityGroupTypes", values)
 		if err != nil {
 			return nil, err
 		}
@@ -328,15 +453,91 @@ func (s *AffinityGroupService) ListAffinityGroupTypes(p *ListAffinityGroupTypesP
 		r.Count = l.Count
 		r.AffinityGroupTypes = append(r.AffinityGroupTypes, l.AffinityGroupTypes...)
 
-		if r.Count == len(r.AffinityGroupTypes) {
+		if r.Count == len(r.AffinityGroupTypes) || len(l.AffinityGroupTypes) == 0 {
+			s.cs.observePages("listAffinityGroupTypes", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.AffinityGroupTypes))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.AffinityGroupTypes)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listAffinityGroupTypes", values, len(l.AffinityGroupTypes), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListAffinityGroupTypesResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.AffinityGroupTypes = append(r.AffinityGroupTypes, l.AffinityGroupTypes...)
+			}
+
+			if r.Count == len(r.AffinityGroupTypes) {
+				s.cs.observePages("listAffinityGroupTypes", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
 	}
 }
 
+// ListAffinityGroupTypesIterator iterates over the items returned by listAffinityGroupTypes, requesting
+// the pages of the response one at a time while iterating.
+type ListAffinityGroupTypesIterator struct {
+	pager
+	items []*AffinityGroupType
+	index int
+}
+
+// NewListAffinityGroupTypesIterator returns an iterator over the items returned by listAffinityGroupTypes, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *AffinityGroupService) NewListAffinityGroupTypesIterator(p *ListAffinityGroupTypesParams, pagesize int) *ListAffinityGroupTypesIterator {
+	return &ListAffinityGroupTypesIterator{pager: newPager(s.cs, "listAffinityGroupTypes", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListAffinityGroupTypesIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListAffinityGroupTypesResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.AffinityGroupTypes))
+
+	i.items = l.AffinityGroupTypes
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListAffinityGroupTypesIterator) Value() *AffinityGroupType {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
+}
+
 type ListAffinityGroupTypesResponse struct {
 	Count              int                  `json:"count"`
 	AffinityGroupTypes []*AffinityGroupType `json:"affinitygrouptype"`
@@ -350,6 +551,10 @@ type ListAffinityGroupsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListAffinityGroupsParams) command() string {
+	return "listAffinityGroups"
+}
+
 func (p *ListAffinityGroupsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -513,7 +718,7 @@ func (s *AffinityGroupService) GetAffinityGroupID(name string, opts ...OptionFun
 	l.Count = len(l.AffinityGroups)
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -527,7 +732,7 @@ func (s *AffinityGroupService) GetAffinityGroupID(name string, opts ...OptionFun
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -559,10 +764,8 @@ func (s *AffinityGroupService) GetAffinityGroupByID(id string, opts ...OptionFun
 
 	l, err := s.ListAffinityGroups(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
@@ -572,7 +775,7 @@ func (s *AffinityGroupService) GetAffinityGroupByID(id string, opts ...OptionFun
 	l.Count = len(l.AffinityGroups)
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -584,10 +787,17 @@ func (s *AffinityGroupService) GetAffinityGroupByID(id string, opts ...OptionFun
 
 // Lists affinity groups
 func (s *AffinityGroupService) ListAffinityGroups(p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error) {
+	return s.ListAffinityGroupsWithContext(context.Background(), p)
+}
+
+// ListAffinityGroupsWithContext is the same as ListAffinityGroups, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AffinityGroupService) ListAffinityGroupsWithContext(ctx context.Context, p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error) {
 	var r ListAffinityGroupsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListAffinityGroupsResponse
-		resp, err := s.cs.newRequest("listAffinityGroups", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listAffinityGroups", values)
 		if err != nil {
 			return nil, err
 		}
@@ -599,15 +809,91 @@ func (s *AffinityGroupService) ListAffinityGroups(p *ListAffinityGroupsParams) (
 		r.Count = l.Count
 		r.AffinityGroups = append(r.AffinityGroups, l.AffinityGroups...)
 
-		if r.Count == len(r.AffinityGroups) {
+		if r.Count == len(r.AffinityGroups) || len(l.AffinityGroups) == 0 {
+			s.cs.observePages("listAffinityGroups", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.AffinityGroups))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.AffinityGroups)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listAffinityGroups", values, len(l.AffinityGroups), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListAffinityGroupsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.AffinityGroups = append(r.AffinityGroups, l.AffinityGroups...)
+			}
+
+			if r.Count == len(r.AffinityGroups) {
+				s.cs.observePages("listAffinityGroups", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
 	}
 }
 
+// ListAffinityGroupsIterator iterates over the items returned by listAffinityGroups, requesting
+// the pages of the response one at a time while iterating.
+type ListAffinityGroupsIterator struct {
+	pager
+	items []*AffinityGroup
+	index int
+}
+
+// NewListAffinityGroupsIterator returns an iterator over the items returned by listAffinityGroups, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *AffinityGroupService) NewListAffinityGroupsIterator(p *ListAffinityGroupsParams, pagesize int) *ListAffinityGroupsIterator {
+	return &ListAffinityGroupsIterator{pager: newPager(s.cs, "listAffinityGroups", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListAffinityGroupsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListAffinityGroupsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.AffinityGroups))
+
+	i.items = l.AffinityGroups
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListAffinityGroupsIterator) Value() *AffinityGroup {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
+}
+
 type ListAffinityGroupsResponse struct {
 	Count          int              `json:"count"`
 	AffinityGroups []*AffinityGroup `json:"affinitygroup"`
@@ -630,6 +916,10 @@ type UpdateVMAffinityGroupParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateVMAffinityGroupParams) command() string {
+	return "updateVMAffinityGroup"
+}
+
 func (p *UpdateVMAffinityGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -681,7 +971,13 @@ func (s *AffinityGroupService) NewUpdateVMAffinityGroupParams(id string) *Update
 
 // Updates the affinity/anti-affinity group associations of a virtual machine. The VM has to be stopped and restarted for the new properties to take effect.
 func (s *AffinityGroupService) UpdateVMAffinityGroup(p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupResponse, error) {
-	resp, err := s.cs.newRequest("updateVMAffinityGroup", p.toURLValues())
+	return s.UpdateVMAffinityGroupWithContext(context.Background(), p)
+}
+
+// UpdateVMAffinityGroupWithContext is the same as UpdateVMAffinityGroup, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AffinityGroupService) UpdateVMAffinityGroupWithContext(ctx context.Context, p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateVMAffinityGroup", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -693,26 +989,56 @@ func (s *AffinityGroupService) UpdateVMAffinityGroup(p *UpdateVMAffinityGroupPar
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateVMAffinityGroup", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// UpdateVMAffinityGroupJob is a handle to a running updateVMAffinityGroup async job
+type UpdateVMAffinityGroupJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateVMAffinityGroupResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateVMAffinityGroupJob) Result() (*UpdateVMAffinityGroupResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateVMAffinityGroupResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// UpdateVMAffinityGroupAsync starts the updateVMAffinityGroup async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *AffinityGroupService) UpdateVMAffinityGroupAsync(ctx context.Context, p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateVMAffinityGroup", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateVMAffinityGroupResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateVMAffinityGroupJob{s.cs.newJobHandle("updateVMAffinityGroup", r.JobID)}, nil
+}
+
 type UpdateVMAffinityGroupResponse struct {
 	JobID         string `json:"jobid,omitempty"`
 	Account       string `json:"account,omitempty"`
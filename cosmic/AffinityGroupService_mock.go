@@ -0,0 +1,196 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockAffinityGroupService is a mock of AffinityGroupServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockAffinityGroupService struct {
+	mockCalls
+
+	CreateAffinityGroupFunc        func(ctx context.Context, p *CreateAffinityGroupParams) (*CreateAffinityGroupResponse, error)
+	CreateAffinityGroupAsyncFunc   func(ctx context.Context, p *CreateAffinityGroupParams) (*CreateAffinityGroupJob, error)
+	DeleteAffinityGroupFunc        func(ctx context.Context, p *DeleteAffinityGroupParams) (*DeleteAffinityGroupResponse, error)
+	DeleteAffinityGroupAsyncFunc   func(ctx context.Context, p *DeleteAffinityGroupParams) (*DeleteAffinityGroupJob, error)
+	ListAffinityGroupTypesFunc     func(ctx context.Context, p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error)
+	GetAffinityGroupIDFunc         func(name string, opts ...OptionFunc) (string, int, error)
+	GetAffinityGroupByNameFunc     func(name string, opts ...OptionFunc) (*AffinityGroup, int, error)
+	GetAffinityGroupByIDFunc       func(id string, opts ...OptionFunc) (*AffinityGroup, int, error)
+	ListAffinityGroupsFunc         func(ctx context.Context, p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error)
+	UpdateVMAffinityGroupFunc      func(ctx context.Context, p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupResponse, error)
+	UpdateVMAffinityGroupAsyncFunc func(ctx context.Context, p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupJob, error)
+}
+
+var _ AffinityGroupServiceIface = &MockAffinityGroupService{}
+
+func (m *MockAffinityGroupService) NewCreateAffinityGroupParams(name string, affinityGroupType string) *CreateAffinityGroupParams {
+	return (&AffinityGroupService{}).NewCreateAffinityGroupParams(name, affinityGroupType)
+}
+
+func (m *MockAffinityGroupService) CreateAffinityGroup(p *CreateAffinityGroupParams) (*CreateAffinityGroupResponse, error) {
+	return m.CreateAffinityGroupWithContext(context.Background(), p)
+}
+
+func (m *MockAffinityGroupService) CreateAffinityGroupWithContext(ctx context.Context, p *CreateAffinityGroupParams) (*CreateAffinityGroupResponse, error) {
+	m.record("CreateAffinityGroup", p)
+	if m.CreateAffinityGroupFunc == nil {
+		return nil, notMocked("MockAffinityGroupService", "CreateAffinityGroup")
+	}
+	return m.CreateAffinityGroupFunc(ctx, p)
+}
+
+func (m *MockAffinityGroupService) CreateAffinityGroupAsync(ctx context.Context, p *CreateAffinityGroupParams) (*CreateAffinityGroupJob, error) {
+	m.record("CreateAffinityGroupAsync", p)
+	if m.CreateAffinityGroupAsyncFunc == nil {
+		return nil, notMocked("MockAffinityGroupService", "CreateAffinityGroupAsync")
+	}
+	return m.CreateAffinityGroupAsyncFunc(ctx, p)
+}
+
+func (m *MockAffinityGroupService) NewDeleteAffinityGroupParams() *DeleteAffinityGroupParams {
+	return (&AffinityGroupService{}).NewDeleteAffinityGroupParams()
+}
+
+func (m *MockAffinityGroupService) DeleteAffinityGroup(p *DeleteAffinityGroupParams) (*DeleteAffinityGroupResponse, error) {
+	return m.DeleteAffinityGroupWithContext(context.Background(), p)
+}
+
+func (m *MockAffinityGroupService) DeleteAffinityGroupWithContext(ctx context.Context, p *DeleteAffinityGroupParams) (*DeleteAffinityGroupResponse, error) {
+	m.record("DeleteAffinityGroup", p)
+	if m.DeleteAffinityGroupFunc == nil {
+		return nil, notMocked("MockAffinityGroupService", "DeleteAffinityGroup")
+	}
+	return m.DeleteAffinityGroupFunc(ctx, p)
+}
+
+func (m *MockAffinityGroupService) DeleteAffinityGroupAsync(ctx context.Context, p *DeleteAffinityGroupParams) (*DeleteAffinityGroupJob, error) {
+	m.record("DeleteAffinityGroupAsync", p)
+	if m.DeleteAffinityGroupAsyncFunc == nil {
+		return nil, notMocked("MockAffinityGroupService", "DeleteAffinityGroupAsync")
+	}
+	return m.DeleteAffinityGroupAsyncFunc(ctx, p)
+}
+
+func (m *MockAffinityGroupService) NewListAffinityGroupTypesParams() *ListAffinityGroupTypesParams {
+	return (&AffinityGroupService{}).NewListAffinityGroupTypesParams()
+}
+
+func (m *MockAffinityGroupService) ListAffinityGroupTypes(p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error) {
+	return m.ListAffinityGroupTypesWithContext(context.Background(), p)
+}
+
+func (m *MockAffinityGroupService) ListAffinityGroupTypesWithContext(ctx context.Context, p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error) {
+	m.record("ListAffinityGroupTypes", p)
+	if m.ListAffinityGroupTypesFunc == nil {
+		return nil, notMocked("MockAffinityGroupService", "ListAffinityGroupTypes")
+	}
+	return m.ListAffinityGroupTypesFunc(ctx, p)
+}
+
+func (m *MockAffinityGroupService) NewListAffinityGroupTypesIterator(p *ListAffinityGroupTypesParams, pagesize int) *ListAffinityGroupTypesIterator {
+	i := &ListAffinityGroupTypesIterator{pager: newPager(nil, "listAffinityGroupTypes", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListAffinityGroupTypesParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListAffinityGroupTypesWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockAffinityGroupService) NewListAffinityGroupsParams() *ListAffinityGroupsParams {
+	return (&AffinityGroupService{}).NewListAffinityGroupsParams()
+}
+
+func (m *MockAffinityGroupService) GetAffinityGroupID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetAffinityGroupID", name, opts)
+	if m.GetAffinityGroupIDFunc == nil {
+		return "", -1, notMocked("MockAffinityGroupService", "GetAffinityGroupID")
+	}
+	return m.GetAffinityGroupIDFunc(name, opts...)
+}
+
+func (m *MockAffinityGroupService) GetAffinityGroupByName(name string, opts ...OptionFunc) (*AffinityGroup, int, error) {
+	m.record("GetAffinityGroupByName", name, opts)
+	if m.GetAffinityGroupByNameFunc == nil {
+		return nil, -1, notMocked("MockAffinityGroupService", "GetAffinityGroupByName")
+	}
+	return m.GetAffinityGroupByNameFunc(name, opts...)
+}
+
+func (m *MockAffinityGroupService) GetAffinityGroupByID(id string, opts ...OptionFunc) (*AffinityGroup, int, error) {
+	m.record("GetAffinityGroupByID", id, opts)
+	if m.GetAffinityGroupByIDFunc == nil {
+		return nil, -1, notMocked("MockAffinityGroupService", "GetAffinityGroupByID")
+	}
+	return m.GetAffinityGroupByIDFunc(id, opts...)
+}
+
+func (m *MockAffinityGroupService) ListAffinityGroups(p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error) {
+	return m.ListAffinityGroupsWithContext(context.Background(), p)
+}
+
+func (m *MockAffinityGroupService) ListAffinityGroupsWithContext(ctx context.Context, p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error) {
+	m.record("ListAffinityGroups", p)
+	if m.ListAffinityGroupsFunc == nil {
+		return nil, notMocked("MockAffinityGroupService", "ListAffinityGroups")
+	}
+	return m.ListAffinityGroupsFunc(ctx, p)
+}
+
+func (m *MockAffinityGroupService) NewListAffinityGroupsIterator(p *ListAffinityGroupsParams, pagesize int) *ListAffinityGroupsIterator {
+	i := &ListAffinityGroupsIterator{pager: newPager(nil, "listAffinityGroups", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListAffinityGroupsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListAffinityGroupsWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockAffinityGroupService) NewUpdateVMAffinityGroupParams(id string) *UpdateVMAffinityGroupParams {
+	return (&AffinityGroupService{}).NewUpdateVMAffinityGroupParams(id)
+}
+
+func (m *MockAffinityGroupService) UpdateVMAffinityGroup(p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupResponse, error) {
+	return m.UpdateVMAffinityGroupWithContext(context.Background(), p)
+}
+
+func (m *MockAffinityGroupService) UpdateVMAffinityGroupWithContext(ctx context.Context, p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupResponse, error) {
+	m.record("UpdateVMAffinityGroup", p)
+	if m.UpdateVMAffinityGroupFunc == nil {
+		return nil, notMocked("MockAffinityGroupService", "UpdateVMAffinityGroup")
+	}
+	return m.UpdateVMAffinityGroupFunc(ctx, p)
+}
+
+func (m *MockAffinityGroupService) UpdateVMAffinityGroupAsync(ctx context.Context, p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupJob, error) {
+	m.record("UpdateVMAffinityGroupAsync", p)
+	if m.UpdateVMAffinityGroupAsyncFunc == nil {
+		return nil, notMocked("MockAffinityGroupService", "UpdateVMAffinityGroupAsync")
+	}
+	return m.UpdateVMAffinityGroupAsyncFunc(ctx, p)
+}
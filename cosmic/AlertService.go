@@ -17,17 +17,44 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 )
 
+// AlertServiceIface is the interface implemented by AlertService, and by MockAlertService for tests
+type AlertServiceIface interface {
+	NewGenerateAlertParams(description string, name string, alertType int) *GenerateAlertParams
+	GenerateAlert(p *GenerateAlertParams) (*GenerateAlertResponse, error)
+	GenerateAlertWithContext(ctx context.Context, p *GenerateAlertParams) (*GenerateAlertResponse, error)
+	GenerateAlertAsync(ctx context.Context, p *GenerateAlertParams) (*GenerateAlertJob, error)
+	NewArchiveAlertsParams() *ArchiveAlertsParams
+	ArchiveAlerts(p *ArchiveAlertsParams) (*ArchiveAlertsResponse, error)
+	ArchiveAlertsWithContext(ctx context.Context, p *ArchiveAlertsParams) (*ArchiveAlertsResponse, error)
+	NewDeleteAlertsParams() *DeleteAlertsParams
+	DeleteAlerts(p *DeleteAlertsParams) (*DeleteAlertsResponse, error)
+	DeleteAlertsWithContext(ctx context.Context, p *DeleteAlertsParams) (*DeleteAlertsResponse, error)
+	NewListAlertsParams() *ListAlertsParams
+	GetAlertID(name string, opts ...OptionFunc) (string, int, error)
+	GetAlertByName(name string, opts ...OptionFunc) (*Alert, int, error)
+	GetAlertByID(id string, opts ...OptionFunc) (*Alert, int, error)
+	ListAlerts(p *ListAlertsParams) (*ListAlertsResponse, error)
+	ListAlertsWithContext(ctx context.Context, p *ListAlertsParams) (*ListAlertsResponse, error)
+	NewListAlertsIterator(p *ListAlertsParams, pagesize int) *ListAlertsIterator
+}
+
 type GenerateAlertParams struct {
 	p map[string]interface{}
 }
 
+func (p *GenerateAlertParams) command() string {
+	return "generateAlert"
+}
+
 func (p *GenerateAlertParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -100,7 +127,13 @@ func (s *AlertService) NewGenerateAlertParams(description string, name string, a
 
 // Generates an alert
 func (s *AlertService) GenerateAlert(p *GenerateAlertParams) (*GenerateAlertResponse, error) {
-	resp, err := s.cs.newRequest("generateAlert", p.toURLValues())
+	return s.GenerateAlertWithContext(context.Background(), p)
+}
+
+// GenerateAlertWithContext is the same as GenerateAlert, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AlertService) GenerateAlertWithContext(ctx context.Context, p *GenerateAlertParams) (*GenerateAlertResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "generateAlert", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -112,9 +145,9 @@ func (s *AlertService) GenerateAlert(p *GenerateAlertParams) (*GenerateAlertResp
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "generateAlert", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -127,6 +160,41 @@ func (s *AlertService) GenerateAlert(p *GenerateAlertParams) (*GenerateAlertResp
 	return &r, nil
 }
 
+// GenerateAlertJob is a handle to a running generateAlert async job
+type GenerateAlertJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a GenerateAlertResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *GenerateAlertJob) Result() (*GenerateAlertResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := GenerateAlertResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// GenerateAlertAsync starts the generateAlert async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *AlertService) GenerateAlertAsync(ctx context.Context, p *GenerateAlertParams) (*GenerateAlertJob, error) {
+	resp, err := s.cs.newRequest(ctx, "generateAlert", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r GenerateAlertResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &GenerateAlertJob{s.cs.newJobHandle("generateAlert", r.JobID)}, nil
+}
+
 type GenerateAlertResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -137,6 +205,10 @@ type ArchiveAlertsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ArchiveAlertsParams) command() string {
+	return "archiveAlerts"
+}
+
 func (p *ArchiveAlertsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -196,7 +268,13 @@ func (s *AlertService) NewArchiveAlertsParams() *ArchiveAlertsParams {
 
 // Archive one or more alerts.
 func (s *AlertService) ArchiveAlerts(p *ArchiveAlertsParams) (*ArchiveAlertsResponse, error) {
-	resp, err := s.cs.newRequest("archiveAlerts", p.toURLValues())
+	return s.ArchiveAlertsWithContext(context.Background(), p)
+}
+
+// ArchiveAlertsWithContext is the same as ArchiveAlerts, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AlertService) ArchiveAlertsWithContext(ctx context.Context, p *ArchiveAlertsParams) (*ArchiveAlertsResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "archiveAlerts", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -217,6 +295,10 @@ type DeleteAlertsParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteAlertsParams) command() string {
+	return "deleteAlerts"
+}
+
 func (p *DeleteAlertsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -276,7 +358,13 @@ func (s *AlertService) NewDeleteAlertsParams() *DeleteAlertsParams {
 
 // Delete one or more alerts.
 func (s *AlertService) DeleteAlerts(p *DeleteAlertsParams) (*DeleteAlertsResponse, error) {
-	resp, err := s.cs.newRequest("deleteAlerts", p.toURLValues())
+	return s.DeleteAlertsWithContext(context.Background(), p)
+}
+
+// DeleteAlertsWithContext is the same as DeleteAlerts, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AlertService) DeleteAlertsWithContext(ctx context.Context, p *DeleteAlertsParams) (*DeleteAlertsResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteAlerts", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -297,6 +385,10 @@ type ListAlertsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListAlertsParams) command() string {
+	return "listAlerts"
+}
+
 func (p *ListAlertsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -394,7 +486,7 @@ func (s *AlertService) GetAlertID(name string, opts ...OptionFunc) (string, int,
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -408,7 +500,7 @@ func (s *AlertService) GetAlertID(name string, opts ...OptionFunc) (string, int,
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -440,16 +532,14 @@ func (s *AlertService) GetAlertByID(id string, opts ...OptionFunc) (*Alert, int,
 
 	l, err := s.ListAlerts(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -461,10 +551,17 @@ func (s *AlertService) GetAlertByID(id string, opts ...OptionFunc) (*Alert, int,
 
 // Lists all alerts.
 func (s *AlertService) ListAlerts(p *ListAlertsParams) (*ListAlertsResponse, error) {
+	return s.ListAlertsWithContext(context.Background(), p)
+}
+
+// ListAlertsWithContext is the same as ListAlerts, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AlertService) ListAlertsWithContext(ctx context.Context, p *ListAlertsParams) (*ListAlertsResponse, error) {
 	var r ListAlertsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListAlertsResponse
-		resp, err := s.cs.newRequest("listAlerts", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listAlerts", values)
 		if err != nil {
 			return nil, err
 		}
@@ -476,13 +573,89 @@ func (s *AlertService) ListAlerts(p *ListAlertsParams) (*ListAlertsResponse, err
 		r.Count = l.Count
 		r.Alerts = append(r.Alerts, l.Alerts...)
 
-		if r.Count == len(r.Alerts) {
+		if r.Count == len(r.Alerts) || len(l.Alerts) == 0 {
+			s.cs.observePages("listAlerts", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.Alerts))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.Alerts)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listAlerts", values, len(l.Alerts), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListAlertsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.Alerts = append(r.Alerts, l.Alerts...)
+			}
+
+			if r.Count == len(r.Alerts) {
+				s.cs.observePages("listAlerts", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListAlertsIterator iterates over the items returned by listAlerts, requesting
+// the pages of the response one at a time while iterating.
+type ListAlertsIterator struct {
+	pager
+	items []*Alert
+	index int
+}
+
+// NewListAlertsIterator returns an iterator over the items returned by listAlerts, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *AlertService) NewListAlertsIterator(p *ListAlertsParams, pagesize int) *ListAlertsIterator {
+	return &ListAlertsIterator{pager: newPager(s.cs, "listAlerts", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListAlertsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListAlertsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.Alerts))
+
+	i.items = l.Alerts
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListAlertsIterator) Value() *Alert {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListAlertsResponse struct {
@@ -0,0 +1,147 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockAlertService is a mock of AlertServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockAlertService struct {
+	mockCalls
+
+	GenerateAlertFunc      func(ctx context.Context, p *GenerateAlertParams) (*GenerateAlertResponse, error)
+	GenerateAlertAsyncFunc func(ctx context.Context, p *GenerateAlertParams) (*GenerateAlertJob, error)
+	ArchiveAlertsFunc      func(ctx context.Context, p *ArchiveAlertsParams) (*ArchiveAlertsResponse, error)
+	DeleteAlertsFunc       func(ctx context.Context, p *DeleteAlertsParams) (*DeleteAlertsResponse, error)
+	GetAlertIDFunc         func(name string, opts ...OptionFunc) (string, int, error)
+	GetAlertByNameFunc     func(name string, opts ...OptionFunc) (*Alert, int, error)
+	GetAlertByIDFunc       func(id string, opts ...OptionFunc) (*Alert, int, error)
+	ListAlertsFunc         func(ctx context.Context, p *ListAlertsParams) (*ListAlertsResponse, error)
+}
+
+var _ AlertServiceIface = &MockAlertService{}
+
+func (m *MockAlertService) NewGenerateAlertParams(description string, name string, alertType int) *GenerateAlertParams {
+	return (&AlertService{}).NewGenerateAlertParams(description, name, alertType)
+}
+
+func (m *MockAlertService) GenerateAlert(p *GenerateAlertParams) (*GenerateAlertResponse, error) {
+	return m.GenerateAlertWithContext(context.Background(), p)
+}
+
+func (m *MockAlertService) GenerateAlertWithContext(ctx context.Context, p *GenerateAlertParams) (*GenerateAlertResponse, error) {
+	m.record("GenerateAlert", p)
+	if m.GenerateAlertFunc == nil {
+		return nil, notMocked("MockAlertService", "GenerateAlert")
+	}
+	return m.GenerateAlertFunc(ctx, p)
+}
+
+func (m *MockAlertService) GenerateAlertAsync(ctx context.Context, p *GenerateAlertParams) (*GenerateAlertJob, error) {
+	m.record("GenerateAlertAsync", p)
+	if m.GenerateAlertAsyncFunc == nil {
+		return nil, notMocked("MockAlertService", "GenerateAlertAsync")
+	}
+	return m.GenerateAlertAsyncFunc(ctx, p)
+}
+
+func (m *MockAlertService) NewArchiveAlertsParams() *ArchiveAlertsParams {
+	return (&AlertService{}).NewArchiveAlertsParams()
+}
+
+func (m *MockAlertService) ArchiveAlerts(p *ArchiveAlertsParams) (*ArchiveAlertsResponse, error) {
+	return m.ArchiveAlertsWithContext(context.Background(), p)
+}
+
+func (m *MockAlertService) ArchiveAlertsWithContext(ctx context.Context, p *ArchiveAlertsParams) (*ArchiveAlertsResponse, error) {
+	m.record("ArchiveAlerts", p)
+	if m.ArchiveAlertsFunc == nil {
+		return nil, notMocked("MockAlertService", "ArchiveAlerts")
+	}
+	return m.ArchiveAlertsFunc(ctx, p)
+}
+
+func (m *MockAlertService) NewDeleteAlertsParams() *DeleteAlertsParams {
+	return (&AlertService{}).NewDeleteAlertsParams()
+}
+
+func (m *MockAlertService) DeleteAlerts(p *DeleteAlertsParams) (*DeleteAlertsResponse, error) {
+	return m.DeleteAlertsWithContext(context.Background(), p)
+}
+
+func (m *MockAlertService) DeleteAlertsWithContext(ctx context.Context, p *DeleteAlertsParams) (*DeleteAlertsResponse, error) {
+	m.record("DeleteAlerts", p)
+	if m.DeleteAlertsFunc == nil {
+		return nil, notMocked("MockAlertService", "DeleteAlerts")
+	}
+	return m.DeleteAlertsFunc(ctx, p)
+}
+
+func (m *MockAlertService) NewListAlertsParams() *ListAlertsParams {
+	return (&AlertService{}).NewListAlertsParams()
+}
+
+func (m *MockAlertService) GetAlertID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetAlertID", name, opts)
+	if m.GetAlertIDFunc == nil {
+		return "", -1, notMocked("MockAlertService", "GetAlertID")
+	}
+	return m.GetAlertIDFunc(name, opts...)
+}
+
+func (m *MockAlertService) GetAlertByName(name string, opts ...OptionFunc) (*Alert, int, error) {
+	m.record("GetAlertByName", name, opts)
+	if m.GetAlertByNameFunc == nil {
+		return nil, -1, notMocked("MockAlertService", "GetAlertByName")
+	}
+	return m.GetAlertByNameFunc(name, opts...)
+}
+
+func (m *MockAlertService) GetAlertByID(id string, opts ...OptionFunc) (*Alert, int, error) {
+	m.record("GetAlertByID", id, opts)
+	if m.GetAlertByIDFunc == nil {
+		return nil, -1, notMocked("MockAlertService", "GetAlertByID")
+	}
+	return m.GetAlertByIDFunc(id, opts...)
+}
+
+func (m *MockAlertService) ListAlerts(p *ListAlertsParams) (*ListAlertsResponse, error) {
+	return m.ListAlertsWithContext(context.Background(), p)
+}
+
+func (m *MockAlertService) ListAlertsWithContext(ctx context.Context, p *ListAlertsParams) (*ListAlertsResponse, error) {
+	m.record("ListAlerts", p)
+	if m.ListAlertsFunc == nil {
+		return nil, notMocked("MockAlertService", "ListAlerts")
+	}
+	return m.ListAlertsFunc(ctx, p)
+}
+
+func (m *MockAlertService) NewListAlertsIterator(p *ListAlertsParams, pagesize int) *ListAlertsIterator {
+	i := &ListAlertsIterator{pager: newPager(nil, "listAlerts", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListAlertsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListAlertsWithContext(ctx, c)
+	}
+	return i
+}
@@ -0,0 +1,104 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestGenerateAlertParams(t *testing.T) {
+	p := &GenerateAlertParams{}
+	want := url.Values{}
+	p.SetDescription("description-value")
+	want.Set("description", "description-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPodid("podid-value")
+	want.Set("podid", "podid-value")
+	p.SetType(42)
+	want.Set("type", "42")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "generateAlert", want)
+}
+
+func TestGenerateAlertResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &GenerateAlertResponse{})
+}
+
+func TestArchiveAlertsParams(t *testing.T) {
+	p := &ArchiveAlertsParams{}
+	want := url.Values{}
+	p.SetEnddate("enddate-value")
+	want.Set("enddate", "enddate-value")
+	p.SetIds([]string{"a", "b"})
+	want.Set("ids", "a,b")
+	p.SetStartdate("startdate-value")
+	want.Set("startdate", "startdate-value")
+	p.SetType("type-value")
+	want.Set("type", "type-value")
+
+	assertParams(t, p, "archiveAlerts", want)
+}
+
+func TestArchiveAlertsResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &ArchiveAlertsResponse{})
+}
+
+func TestDeleteAlertsParams(t *testing.T) {
+	p := &DeleteAlertsParams{}
+	want := url.Values{}
+	p.SetEnddate("enddate-value")
+	want.Set("enddate", "enddate-value")
+	p.SetIds([]string{"a", "b"})
+	want.Set("ids", "a,b")
+	p.SetStartdate("startdate-value")
+	want.Set("startdate", "startdate-value")
+	p.SetType("type-value")
+	want.Set("type", "type-value")
+
+	assertParams(t, p, "deleteAlerts", want)
+}
+
+func TestDeleteAlertsResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &DeleteAlertsResponse{})
+}
+
+func TestListAlertsParams(t *testing.T) {
+	p := &ListAlertsParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetType("type-value")
+	want.Set("type", "type-value")
+
+	assertParams(t, p, "listAlerts", want)
+}
+
+func TestListAlertsResponse(t *testing.T) {
+	assertDecodes(t, `{"alert":[{"description":"description-value","id":"id-value","name":"name-value","sent":"sent-value","type":42}],"count":1}`, &ListAlertsResponse{})
+}
@@ -17,16 +17,31 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
-	"time"
 )
 
+// AsyncjobServiceIface is the interface implemented by AsyncjobService, and by MockAsyncjobService for tests
+type AsyncjobServiceIface interface {
+	NewQueryAsyncJobResultParams(jobid string) *QueryAsyncJobResultParams
+	QueryAsyncJobResult(p *QueryAsyncJobResultParams) (*QueryAsyncJobResultResponse, error)
+	QueryAsyncJobResultWithContext(ctx context.Context, p *QueryAsyncJobResultParams) (*QueryAsyncJobResultResponse, error)
+	NewListAsyncJobsParams() *ListAsyncJobsParams
+	ListAsyncJobs(p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error)
+	ListAsyncJobsWithContext(ctx context.Context, p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error)
+	NewListAsyncJobsIterator(p *ListAsyncJobsParams, pagesize int) *ListAsyncJobsIterator
+}
+
 type QueryAsyncJobResultParams struct {
 	p map[string]interface{}
 }
 
+func (p *QueryAsyncJobResultParams) command() string {
+	return "queryAsyncJobResult"
+}
+
 func (p *QueryAsyncJobResultParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -56,17 +71,13 @@ func (s *AsyncjobService) NewQueryAsyncJobResultParams(jobid string) *QueryAsync
 
 // Retrieves the current status of asynchronous job.
 func (s *AsyncjobService) QueryAsyncJobResult(p *QueryAsyncJobResultParams) (*QueryAsyncJobResultResponse, error) {
-	var resp json.RawMessage
-	var err error
-
-	// We should be able to retry on failure as this call is idempotent
-	for i := 0; i < 3; i++ {
-		resp, err = s.cs.newRequest("queryAsyncJobResult", p.toURLValues())
-		if err == nil {
-			break
-		}
-		time.Sleep(500 * time.Millisecond)
-	}
+	return s.QueryAsyncJobResultWithContext(context.Background(), p)
+}
+
+// QueryAsyncJobResultWithContext is the same as QueryAsyncJobResult, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AsyncjobService) QueryAsyncJobResultWithContext(ctx context.Context, p *QueryAsyncJobResultParams) (*QueryAsyncJobResultResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "queryAsyncJobResult", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -82,6 +93,7 @@ type QueryAsyncJobResultResponse struct {
 	Accountid       string          `json:"accountid,omitempty"`
 	Cmd             string          `json:"cmd,omitempty"`
 	Created         string          `json:"created,omitempty"`
+	Jobid           string          `json:"jobid,omitempty"`
 	Jobinstanceid   string          `json:"jobinstanceid,omitempty"`
 	Jobinstancetype string          `json:"jobinstancetype,omitempty"`
 	Jobprocstatus   int             `json:"jobprocstatus,omitempty"`
@@ -96,6 +108,10 @@ type ListAsyncJobsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListAsyncJobsParams) command() string {
+	return "listAsyncJobs"
+}
+
 func (p *ListAsyncJobsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -198,10 +214,17 @@ func (s *AsyncjobService) NewListAsyncJobsParams() *ListAsyncJobsParams {
 
 // Lists all pending asynchronous jobs for the account.
 func (s *AsyncjobService) ListAsyncJobs(p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error) {
+	return s.ListAsyncJobsWithContext(context.Background(), p)
+}
+
+// ListAsyncJobsWithContext is the same as ListAsyncJobs, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AsyncjobService) ListAsyncJobsWithContext(ctx context.Context, p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error) {
 	var r ListAsyncJobsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListAsyncJobsResponse
-		resp, err := s.cs.newRequest("listAsyncJobs", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listAsyncJobs", values)
 		if err != nil {
 			return nil, err
 		}
@@ -213,13 +236,89 @@ func (s *AsyncjobService) ListAsyncJobs(p *ListAsyncJobsParams) (*ListAsyncJobsR
 		r.Count = l.Count
 		r.AsyncJobs = append(r.AsyncJobs, l.AsyncJobs...)
 
-		if r.Count == len(r.AsyncJobs) {
+		if r.Count == len(r.AsyncJobs) || len(l.AsyncJobs) == 0 {
+			s.cs.observePages("listAsyncJobs", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.AsyncJobs))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.AsyncJobs)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listAsyncJobs", values, len(l.AsyncJobs), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListAsyncJobsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.AsyncJobs = append(r.AsyncJobs, l.AsyncJobs...)
+			}
+
+			if r.Count == len(r.AsyncJobs) {
+				s.cs.observePages("listAsyncJobs", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListAsyncJobsIterator iterates over the items returned by listAsyncJobs, requesting
+// the pages of the response one at a time while iterating.
+type ListAsyncJobsIterator struct {
+	pager
+	items []*AsyncJob
+	index int
+}
+
+// NewListAsyncJobsIterator returns an iterator over the items returned by listAsyncJobs, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *AsyncjobService) NewListAsyncJobsIterator(p *ListAsyncJobsParams, pagesize int) *ListAsyncJobsIterator {
+	return &ListAsyncJobsIterator{pager: newPager(s.cs, "listAsyncJobs", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListAsyncJobsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListAsyncJobsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.AsyncJobs))
+
+	i.items = l.AsyncJobs
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListAsyncJobsIterator) Value() *AsyncJob {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListAsyncJobsResponse struct {
@@ -231,6 +330,7 @@ type AsyncJob struct {
 	Accountid       string          `json:"accountid,omitempty"`
 	Cmd             string          `json:"cmd,omitempty"`
 	Created         string          `json:"created,omitempty"`
+	Jobid           string          `json:"jobid,omitempty"`
 	Jobinstanceid   string          `json:"jobinstanceid,omitempty"`
 	Jobinstancetype string          `json:"jobinstancetype,omitempty"`
 	Jobprocstatus   int             `json:"jobprocstatus,omitempty"`
@@ -0,0 +1,77 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockAsyncjobService is a mock of AsyncjobServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockAsyncjobService struct {
+	mockCalls
+
+	QueryAsyncJobResultFunc func(ctx context.Context, p *QueryAsyncJobResultParams) (*QueryAsyncJobResultResponse, error)
+	ListAsyncJobsFunc       func(ctx context.Context, p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error)
+}
+
+var _ AsyncjobServiceIface = &MockAsyncjobService{}
+
+func (m *MockAsyncjobService) NewQueryAsyncJobResultParams(jobid string) *QueryAsyncJobResultParams {
+	return (&AsyncjobService{}).NewQueryAsyncJobResultParams(jobid)
+}
+
+func (m *MockAsyncjobService) QueryAsyncJobResult(p *QueryAsyncJobResultParams) (*QueryAsyncJobResultResponse, error) {
+	return m.QueryAsyncJobResultWithContext(context.Background(), p)
+}
+
+func (m *MockAsyncjobService) QueryAsyncJobResultWithContext(ctx context.Context, p *QueryAsyncJobResultParams) (*QueryAsyncJobResultResponse, error) {
+	m.record("QueryAsyncJobResult", p)
+	if m.QueryAsyncJobResultFunc == nil {
+		return nil, notMocked("MockAsyncjobService", "QueryAsyncJobResult")
+	}
+	return m.QueryAsyncJobResultFunc(ctx, p)
+}
+
+func (m *MockAsyncjobService) NewListAsyncJobsParams() *ListAsyncJobsParams {
+	return (&AsyncjobService{}).NewListAsyncJobsParams()
+}
+
+func (m *MockAsyncjobService) ListAsyncJobs(p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error) {
+	return m.ListAsyncJobsWithContext(context.Background(), p)
+}
+
+func (m *MockAsyncjobService) ListAsyncJobsWithContext(ctx context.Context, p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error) {
+	m.record("ListAsyncJobs", p)
+	if m.ListAsyncJobsFunc == nil {
+		return nil, notMocked("MockAsyncjobService", "ListAsyncJobs")
+	}
+	return m.ListAsyncJobsFunc(ctx, p)
+}
+
+func (m *MockAsyncjobService) NewListAsyncJobsIterator(p *ListAsyncJobsParams, pagesize int) *ListAsyncJobsIterator {
+	i := &ListAsyncJobsIterator{pager: newPager(nil, "listAsyncJobs", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListAsyncJobsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListAsyncJobsWithContext(ctx, c)
+	}
+	return i
+}
@@ -0,0 +1,62 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestQueryAsyncJobResultParams(t *testing.T) {
+	p := &QueryAsyncJobResultParams{}
+	want := url.Values{}
+	p.SetJobid("jobid-value")
+	want.Set("jobid", "jobid-value")
+
+	assertParams(t, p, "queryAsyncJobResult", want)
+}
+
+func TestQueryAsyncJobResultResponse(t *testing.T) {
+	assertDecodes(t, `{"accountid":"accountid-value","cmd":"cmd-value","created":"created-value","jobid":"jobid-value","jobinstanceid":"jobinstanceid-value","jobinstancetype":"jobinstancetype-value","jobprocstatus":42,"jobresult":{"key":"value"},"jobresultcode":42,"jobresulttype":"jobresulttype-value","jobstatus":42,"userid":"userid-value"}`, &QueryAsyncJobResultResponse{})
+}
+
+func TestListAsyncJobsParams(t *testing.T) {
+	p := &ListAsyncJobsParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetStartdate("startdate-value")
+	want.Set("startdate", "startdate-value")
+
+	assertParams(t, p, "listAsyncJobs", want)
+}
+
+func TestListAsyncJobsResponse(t *testing.T) {
+	assertDecodes(t, `{"asyncjobs":[{"accountid":"accountid-value","cmd":"cmd-value","created":"created-value","jobid":"jobid-value","jobinstanceid":"jobinstanceid-value","jobinstancetype":"jobinstancetype-value","jobprocstatus":42,"jobresult":{"key":"value"},"jobresultcode":42,"jobresulttype":"jobresulttype-value","jobstatus":42,"userid":"userid-value"}],"count":1}`, &ListAsyncJobsResponse{})
+}
@@ -17,16 +17,57 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 )
 
+// AuthenticationServiceIface is the interface implemented by AuthenticationService, and by MockAuthenticationService for tests
+type AuthenticationServiceIface interface {
+	NewLdapCreateAccountParams(accounttype int, username string) *LdapCreateAccountParams
+	LdapCreateAccount(p *LdapCreateAccountParams) (*LdapCreateAccountResponse, error)
+	LdapCreateAccountWithContext(ctx context.Context, p *LdapCreateAccountParams) (*LdapCreateAccountResponse, error)
+	NewListDomainLdapLinkParams(domainid string) *ListDomainLdapLinkParams
+	ListDomainLdapLink(p *ListDomainLdapLinkParams) (*ListDomainLdapLinkResponse, error)
+	ListDomainLdapLinkWithContext(ctx context.Context, p *ListDomainLdapLinkParams) (*ListDomainLdapLinkResponse, error)
+	NewLinkDomainToLdapParams(accounttype int, domainid string, name string, authenticationType string) *LinkDomainToLdapParams
+	LinkDomainToLdap(p *LinkDomainToLdapParams) (*LinkDomainToLdapResponse, error)
+	LinkDomainToLdapWithContext(ctx context.Context, p *LinkDomainToLdapParams) (*LinkDomainToLdapResponse, error)
+	NewAddLdapConfigurationParams(hostname string, port int) *AddLdapConfigurationParams
+	AddLdapConfiguration(p *AddLdapConfigurationParams) (*AddLdapConfigurationResponse, error)
+	AddLdapConfigurationWithContext(ctx context.Context, p *AddLdapConfigurationParams) (*AddLdapConfigurationResponse, error)
+	NewDeleteLdapConfigurationParams(hostname string) *DeleteLdapConfigurationParams
+	DeleteLdapConfiguration(p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error)
+	DeleteLdapConfigurationWithContext(ctx context.Context, p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error)
+	NewListLdapConfigurationsParams() *ListLdapConfigurationsParams
+	ListLdapConfigurations(p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error)
+	ListLdapConfigurationsWithContext(ctx context.Context, p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error)
+	NewListLdapConfigurationsIterator(p *ListLdapConfigurationsParams, pagesize int) *ListLdapConfigurationsIterator
+	NewImportLdapUsersParams(accounttype int) *ImportLdapUsersParams
+	ImportLdapUsers(p *ImportLdapUsersParams) (*ImportLdapUsersResponse, error)
+	ImportLdapUsersWithContext(ctx context.Context, p *ImportLdapUsersParams) (*ImportLdapUsersResponse, error)
+	NewListLdapUsersParams() *ListLdapUsersParams
+	ListLdapUsers(p *ListLdapUsersParams) (*ListLdapUsersResponse, error)
+	ListLdapUsersWithContext(ctx context.Context, p *ListLdapUsersParams) (*ListLdapUsersResponse, error)
+	NewListLdapUsersIterator(p *ListLdapUsersParams, pagesize int) *ListLdapUsersIterator
+	NewLoginParams(password string, username string) *LoginParams
+	Login(p *LoginParams) (*LoginResponse, error)
+	LoginWithContext(ctx context.Context, p *LoginParams) (*LoginResponse, error)
+	NewLogoutParams() *LogoutParams
+	Logout(p *LogoutParams) (*LogoutResponse, error)
+	LogoutWithContext(ctx context.Context, p *LogoutParams) (*LogoutResponse, error)
+}
+
 type LdapCreateAccountParams struct {
 	p map[string]interface{}
 }
 
+func (p *LdapCreateAccountParams) command() string {
+	return "ldapCreateAccount"
+}
+
 func (p *LdapCreateAccountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -143,7 +184,13 @@ func (s *AuthenticationService) NewLdapCreateAccountParams(accounttype int, user
 
 // Creates an account from an LDAP user
 func (s *AuthenticationService) LdapCreateAccount(p *LdapCreateAccountParams) (*LdapCreateAccountResponse, error) {
-	resp, err := s.cs.newRequest("ldapCreateAccount", p.toURLValues())
+	return s.LdapCreateAccountWithContext(context.Background(), p)
+}
+
+// LdapCreateAccountWithContext is the same as LdapCreateAccount, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AuthenticationService) LdapCreateAccountWithContext(ctx context.Context, p *LdapCreateAccountParams) (*LdapCreateAccountResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "ldapCreateAccount", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -232,6 +279,10 @@ type ListDomainLdapLinkParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListDomainLdapLinkParams) command() string {
+	return "listDomainLdapLink"
+}
+
 func (p *ListDomainLdapLinkParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -261,7 +312,13 @@ func (s *AuthenticationService) NewListDomainLdapLinkParams(domainid string) *Li
 
 // list link of domain to group or OU in ldap
 func (s *AuthenticationService) ListDomainLdapLink(p *ListDomainLdapLinkParams) (*ListDomainLdapLinkResponse, error) {
-	resp, err := s.cs.newRequest("listDomainLdapLink", p.toURLValues())
+	return s.ListDomainLdapLinkWithContext(context.Background(), p)
+}
+
+// ListDomainLdapLinkWithContext is the same as ListDomainLdapLink, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AuthenticationService) ListDomainLdapLinkWithContext(ctx context.Context, p *ListDomainLdapLinkParams) (*ListDomainLdapLinkResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "listDomainLdapLink", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -291,6 +348,10 @@ type LinkDomainToLdapParams struct {
 	p map[string]interface{}
 }
 
+func (p *LinkDomainToLdapParams) command() string {
+	return "linkDomainToLdap"
+}
+
 func (p *LinkDomainToLdapParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -364,7 +425,13 @@ func (s *AuthenticationService) NewLinkDomainToLdapParams(accounttype int, domai
 
 // link an existing cloudstack domain to group or OU in ldap
 func (s *AuthenticationService) LinkDomainToLdap(p *LinkDomainToLdapParams) (*LinkDomainToLdapResponse, error) {
-	resp, err := s.cs.newRequest("linkDomainToLdap", p.toURLValues())
+	return s.LinkDomainToLdapWithContext(context.Background(), p)
+}
+
+// LinkDomainToLdapWithContext is the same as LinkDomainToLdap, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AuthenticationService) LinkDomainToLdapWithContext(ctx context.Context, p *LinkDomainToLdapParams) (*LinkDomainToLdapResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "linkDomainToLdap", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -389,6 +456,10 @@ type AddLdapConfigurationParams struct {
 	p map[string]interface{}
 }
 
+func (p *AddLdapConfigurationParams) command() string {
+	return "addLdapConfiguration"
+}
+
 func (p *AddLdapConfigurationParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -430,7 +501,13 @@ func (s *AuthenticationService) NewAddLdapConfigurationParams(hostname string, p
 
 // Add a new Ldap Configuration
 func (s *AuthenticationService) AddLdapConfiguration(p *AddLdapConfigurationParams) (*AddLdapConfigurationResponse, error) {
-	resp, err := s.cs.newRequest("addLdapConfiguration", p.toURLValues())
+	return s.AddLdapConfigurationWithContext(context.Background(), p)
+}
+
+// AddLdapConfigurationWithContext is the same as AddLdapConfiguration, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AuthenticationService) AddLdapConfigurationWithContext(ctx context.Context, p *AddLdapConfigurationParams) (*AddLdapConfigurationResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "addLdapConfiguration", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -451,6 +528,10 @@ type DeleteLdapConfigurationParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteLdapConfigurationParams) command() string {
+	return "deleteLdapConfiguration"
+}
+
 func (p *DeleteLdapConfigurationParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -480,7 +561,13 @@ func (s *AuthenticationService) NewDeleteLdapConfigurationParams(hostname string
 
 // Remove an Ldap Configuration
 func (s *AuthenticationService) DeleteLdapConfiguration(p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error) {
-	resp, err := s.cs.newRequest("deleteLdapConfiguration", p.toURLValues())
+	return s.DeleteLdapConfigurationWithContext(context.Background(), p)
+}
+
+// DeleteLdapConfigurationWithContext is the same as DeleteLdapConfiguration, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AuthenticationService) DeleteLdapConfigurationWithContext(ctx context.Context, p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteLdapConfiguration", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -501,6 +588,10 @@ type ListLdapConfigurationsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListLdapConfigurationsParams) command() string {
+	return "listLdapConfigurations"
+}
+
 func (p *ListLdapConfigurationsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -572,10 +663,17 @@ func (s *AuthenticationService) NewListLdapConfigurationsParams() *ListLdapConfi
 
 // Lists all LDAP configurations
 func (s *AuthenticationService) ListLdapConfigurations(p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error) {
+	return s.ListLdapConfigurationsWithContext(context.Background(), p)
+}
+
+// ListLdapConfigurationsWithContext is the same as ListLdapConfigurations, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AuthenticationService) ListLdapConfigurationsWithContext(ctx context.Context, p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error) {
 	var r ListLdapConfigurationsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListLdapConfigurationsResponse
-		resp, err := s.cs.newRequest("listLdapConfigurations", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listLdapConfigurations", values)
 		if err != nil {
 			return nil, err
 		}
@@ -587,13 +685,89 @@ func (s *AuthenticationService) ListLdapConfigurations(p *ListLdapConfigurations
 		r.Count = l.Count
 		r.LdapConfigurations = append(r.LdapConfigurations, l.LdapConfigurations...)
 
-		if r.Count == len(r.LdapConfigurations) {
+		if r.Count == len(r.LdapConfigurations) || len(l.LdapConfigurations) == 0 {
+			s.cs.observePages("listLdapConfigurations", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.LdapConfigurations))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.LdapConfigurations)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listLdapConfigurations", values, len(l.LdapConfigurations), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListLdapConfigurationsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.LdapConfigurations = append(r.LdapConfigurations, l.LdapConfigurations...)
+			}
+
+			if r.Count == len(r.LdapConfigurations) {
+				s.cs.observePages("listLdapConfigurations", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListLdapConfigurationsIterator iterates over the items returned by listLdapConfigurations, requesting
+// the pages of the response one at a time while iterating.
+type ListLdapConfigurationsIterator struct {
+	pager
+	items []*LdapConfiguration
+	index int
+}
+
+// NewListLdapConfigurationsIterator returns an iterator over the items returned by listLdapConfigurations, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *AuthenticationService) NewListLdapConfigurationsIterator(p *ListLdapConfigurationsParams, pagesize int) *ListLdapConfigurationsIterator {
+	return &ListLdapConfigurationsIterator{pager: newPager(s.cs, "listLdapConfigurations", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListLdapConfigurationsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListLdapConfigurationsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.LdapConfigurations))
+
+	i.items = l.LdapConfigurations
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListLdapConfigurationsIterator) Value() *LdapConfiguration {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListLdapConfigurationsResponse struct {
@@ -610,6 +784,10 @@ type ImportLdapUsersParams struct {
 	p map[string]interface{}
 }
 
+func (p *ImportLdapUsersParams) command() string {
+	return "importLdapUsers"
+}
+
 func (p *ImportLdapUsersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -727,7 +905,13 @@ func (s *AuthenticationService) NewImportLdapUsersParams(accounttype int) *Impor
 
 // Import LDAP users
 func (s *AuthenticationService) ImportLdapUsers(p *ImportLdapUsersParams) (*ImportLdapUsersResponse, error) {
-	resp, err := s.cs.newRequest("importLdapUsers", p.toURLValues())
+	return s.ImportLdapUsersWithContext(context.Background(), p)
+}
+
+// ImportLdapUsersWithContext is the same as ImportLdapUsers, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AuthenticationService) ImportLdapUsersWithContext(ctx context.Context, p *ImportLdapUsersParams) (*ImportLdapUsersResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "importLdapUsers", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -752,6 +936,10 @@ type ListLdapUsersParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListLdapUsersParams) command() string {
+	return "listLdapUsers"
+}
+
 func (p *ListLdapUsersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -812,10 +1000,17 @@ func (s *AuthenticationService) NewListLdapUsersParams() *ListLdapUsersParams {
 
 // Lists all LDAP Users
 func (s *AuthenticationService) ListLdapUsers(p *ListLdapUsersParams) (*ListLdapUsersResponse, error) {
+	return s.ListLdapUsersWithContext(context.Background(), p)
+}
+
+// ListLdapUsersWithContext is the same as ListLdapUsers, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AuthenticationService) ListLdapUsersWithContext(ctx context.Context, p *ListLdapUsersParams) (*ListLdapUsersResponse, error) {
 	var r ListLdapUsersResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListLdapUsersResponse
-		resp, err := s.cs.newRequest("listLdapUsers", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listLdapUsers", values)
 		if err != nil {
 			return nil, err
 		}
@@ -827,15 +1022,91 @@ func (s *AuthenticationService) ListLdapUsers(p *ListLdapUsersParams) (*ListLdap
 		r.Count = l.Count
 		r.LdapUsers = append(r.LdapUsers, l.LdapUsers...)
 
-		if r.Count == len(r.LdapUsers) {
+		if r.Count == len(r.LdapUsers) || len(l.LdapUsers) == 0 {
+			s.cs.observePages("listLdapUsers", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.LdapUsers))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.LdapUsers)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listLdapUsers", values, len(l.LdapUsers), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListLdapUsersResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.LdapUsers = append(r.LdapUsers, l.LdapUsers...)
+			}
+
+			if r.Count == len(r.LdapUsers) {
+				s.cs.observePages("listLdapUsers", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
 	}
 }
 
+// ListLdapUsersIterator iterates over the items returned by listLdapUsers, requesting
+// the pages of the response one at a time while iterating.
+type ListLdapUsersIterator struct {
+	pager
+	items []*LdapUser
+	index int
+}
+
+// NewListLdapUsersIterator returns an iterator over the items returned by listLdapUsers, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *AuthenticationService) NewListLdapUsersIterator(p *ListLdapUsersParams, pagesize int) *ListLdapUsersIterator {
+	return &ListLdapUsersIterator{pager: newPager(s.cs, "listLdapUsers", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListLdapUsersIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListLdapUsersResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.LdapUsers))
+
+	i.items = l.LdapUsers
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListLdapUsersIterator) Value() *LdapUser {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
+}
+
 type ListLdapUsersResponse struct {
 	Count     int         `json:"count"`
 	LdapUsers []*LdapUser `json:"ldapuser"`
@@ -854,6 +1125,10 @@ type LoginParams struct {
 	p map[string]interface{}
 }
 
+func (p *LoginParams) command() string {
+	return "login"
+}
+
 func (p *LoginParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -915,7 +1190,13 @@ func (s *AuthenticationService) NewLoginParams(password string, username string)
 
 // Logs a user into the CloudStack. A successful login attempt will generate a JSESSIONID cookie value that can be passed in subsequent Query command calls until the "logout" command has been issued or the session has expired.
 func (s *AuthenticationService) Login(p *LoginParams) (*LoginResponse, error) {
-	resp, err := s.cs.newRequest("login", p.toURLValues())
+	return s.LoginWithContext(context.Background(), p)
+}
+
+// LoginWithContext is the same as Login, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AuthenticationService) LoginWithContext(ctx context.Context, p *LoginParams) (*LoginResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "login", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -946,6 +1227,10 @@ type LogoutParams struct {
 	p map[string]interface{}
 }
 
+func (p *LogoutParams) command() string {
+	return "logout"
+}
+
 func (p *LogoutParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -964,7 +1249,13 @@ func (s *AuthenticationService) NewLogoutParams() *LogoutParams {
 
 // Logs out the user
 func (s *AuthenticationService) Logout(p *LogoutParams) (*LogoutResponse, error) {
-	resp, err := s.cs.newRequest("logout", p.toURLValues())
+	return s.LogoutWithContext(context.Background(), p)
+}
+
+// LogoutWithContext is the same as Logout, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *AuthenticationService) LogoutWithContext(ctx context.Context, p *LogoutParams) (*LogoutResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "logout", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,227 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockAuthenticationService is a mock of AuthenticationServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockAuthenticationService struct {
+	mockCalls
+
+	LdapCreateAccountFunc       func(ctx context.Context, p *LdapCreateAccountParams) (*LdapCreateAccountResponse, error)
+	ListDomainLdapLinkFunc      func(ctx context.Context, p *ListDomainLdapLinkParams) (*ListDomainLdapLinkResponse, error)
+	LinkDomainToLdapFunc        func(ctx context.Context, p *LinkDomainToLdapParams) (*LinkDomainToLdapResponse, error)
+	AddLdapConfigurationFunc    func(ctx context.Context, p *AddLdapConfigurationParams) (*AddLdapConfigurationResponse, error)
+	DeleteLdapConfigurationFunc func(ctx context.Context, p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error)
+	ListLdapConfigurationsFunc  func(ctx context.Context, p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error)
+	ImportLdapUsersFunc         func(ctx context.Context, p *ImportLdapUsersParams) (*ImportLdapUsersResponse, error)
+	ListLdapUsersFunc           func(ctx context.Context, p *ListLdapUsersParams) (*ListLdapUsersResponse, error)
+	LoginFunc                   func(ctx context.Context, p *LoginParams) (*LoginResponse, error)
+	LogoutFunc                  func(ctx context.Context, p *LogoutParams) (*LogoutResponse, error)
+}
+
+var _ AuthenticationServiceIface = &MockAuthenticationService{}
+
+func (m *MockAuthenticationService) NewLdapCreateAccountParams(accounttype int, username string) *LdapCreateAccountParams {
+	return (&AuthenticationService{}).NewLdapCreateAccountParams(accounttype, username)
+}
+
+func (m *MockAuthenticationService) LdapCreateAccount(p *LdapCreateAccountParams) (*LdapCreateAccountResponse, error) {
+	return m.LdapCreateAccountWithContext(context.Background(), p)
+}
+
+func (m *MockAuthenticationService) LdapCreateAccountWithContext(ctx context.Context, p *LdapCreateAccountParams) (*LdapCreateAccountResponse, error) {
+	m.record("LdapCreateAccount", p)
+	if m.LdapCreateAccountFunc == nil {
+		return nil, notMocked("MockAuthenticationService", "LdapCreateAccount")
+	}
+	return m.LdapCreateAccountFunc(ctx, p)
+}
+
+func (m *MockAuthenticationService) NewListDomainLdapLinkParams(domainid string) *ListDomainLdapLinkParams {
+	return (&AuthenticationService{}).NewListDomainLdapLinkParams(domainid)
+}
+
+func (m *MockAuthenticationService) ListDomainLdapLink(p *ListDomainLdapLinkParams) (*ListDomainLdapLinkResponse, error) {
+	return m.ListDomainLdapLinkWithContext(context.Background(), p)
+}
+
+func (m *MockAuthenticationService) ListDomainLdapLinkWithContext(ctx context.Context, p *ListDomainLdapLinkParams) (*ListDomainLdapLinkResponse, error) {
+	m.record("ListDomainLdapLink", p)
+	if m.ListDomainLdapLinkFunc == nil {
+		return nil, notMocked("MockAuthenticationService", "ListDomainLdapLink")
+	}
+	return m.ListDomainLdapLinkFunc(ctx, p)
+}
+
+func (m *MockAuthenticationService) NewLinkDomainToLdapParams(accounttype int, domainid string, name string, authenticationType string) *LinkDomainToLdapParams {
+	return (&AuthenticationService{}).NewLinkDomainToLdapParams(accounttype, domainid, name, authenticationType)
+}
+
+func (m *MockAuthenticationService) LinkDomainToLdap(p *LinkDomainToLdapParams) (*LinkDomainToLdapResponse, error) {
+	return m.LinkDomainToLdapWithContext(context.Background(), p)
+}
+
+func (m *MockAuthenticationService) LinkDomainToLdapWithContext(ctx context.Context, p *LinkDomainToLdapParams) (*LinkDomainToLdapResponse, error) {
+	m.record("LinkDomainToLdap", p)
+	if m.LinkDomainToLdapFunc == nil {
+		return nil, notMocked("MockAuthenticationService", "LinkDomainToLdap")
+	}
+	return m.LinkDomainToLdapFunc(ctx, p)
+}
+
+func (m *MockAuthenticationService) NewAddLdapConfigurationParams(hostname string, port int) *AddLdapConfigurationParams {
+	return (&AuthenticationService{}).NewAddLdapConfigurationParams(hostname, port)
+}
+
+func (m *MockAuthenticationService) AddLdapConfiguration(p *AddLdapConfigurationParams) (*AddLdapConfigurationResponse, error) {
+	return m.AddLdapConfigurationWithContext(context.Background(), p)
+}
+
+func (m *MockAuthenticationService) AddLdapConfigurationWithContext(ctx context.Context, p *AddLdapConfigurationParams) (*AddLdapConfigurationResponse, error) {
+	m.record("AddLdapConfiguration", p)
+	if m.AddLdapConfigurationFunc == nil {
+		return nil, notMocked("MockAuthenticationService", "AddLdapConfiguration")
+	}
+	return m.AddLdapConfigurationFunc(ctx, p)
+}
+
+func (m *MockAuthenticationService) NewDeleteLdapConfigurationParams(hostname string) *DeleteLdapConfigurationParams {
+	return (&AuthenticationService{}).NewDeleteLdapConfigurationParams(hostname)
+}
+
+func (m *MockAuthenticationService) DeleteLdapConfiguration(p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error) {
+	return m.DeleteLdapConfigurationWithContext(context.Background(), p)
+}
+
+func (m *MockAuthenticationService) DeleteLdapConfigurationWithContext(ctx context.Context, p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error) {
+	m.record("DeleteLdapConfiguration", p)
+	if m.DeleteLdapConfigurationFunc == nil {
+		return nil, notMocked("MockAuthenticationService", "DeleteLdapConfiguration")
+	}
+	return m.DeleteLdapConfigurationFunc(ctx, p)
+}
+
+func (m *MockAuthenticationService) NewListLdapConfigurationsParams() *ListLdapConfigurationsParams {
+	return (&AuthenticationService{}).NewListLdapConfigurationsParams()
+}
+
+func (m *MockAuthenticationService) ListLdapConfigurations(p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error) {
+	return m.ListLdapConfigurationsWithContext(context.Background(), p)
+}
+
+func (m *MockAuthenticationService) ListLdapConfigurationsWithContext(ctx context.Context, p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error) {
+	m.record("ListLdapConfigurations", p)
+	if m.ListLdapConfigurationsFunc == nil {
+		return nil, notMocked("MockAuthenticationService", "ListLdapConfigurations")
+	}
+	return m.ListLdapConfigurationsFunc(ctx, p)
+}
+
+func (m *MockAuthenticationService) NewListLdapConfigurationsIterator(p *ListLdapConfigurationsParams, pagesize int) *ListLdapConfigurationsIterator {
+	i := &ListLdapConfigurationsIterator{pager: newPager(nil, "listLdapConfigurations", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListLdapConfigurationsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListLdapConfigurationsWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockAuthenticationService) NewImportLdapUsersParams(accounttype int) *ImportLdapUsersParams {
+	return (&AuthenticationService{}).NewImportLdapUsersParams(accounttype)
+}
+
+func (m *MockAuthenticationService) ImportLdapUsers(p *ImportLdapUsersParams) (*ImportLdapUsersResponse, error) {
+	return m.ImportLdapUsersWithContext(context.Background(), p)
+}
+
+func (m *MockAuthenticationService) ImportLdapUsersWithContext(ctx context.Context, p *ImportLdapUsersParams) (*ImportLdapUsersResponse, error) {
+	m.record("ImportLdapUsers", p)
+	if m.ImportLdapUsersFunc == nil {
+		return nil, notMocked("MockAuthenticationService", "ImportLdapUsers")
+	}
+	return m.ImportLdapUsersFunc(ctx, p)
+}
+
+func (m *MockAuthenticationService) NewListLdapUsersParams() *ListLdapUsersParams {
+	return (&AuthenticationService{}).NewListLdapUsersParams()
+}
+
+func (m *MockAuthenticationService) ListLdapUsers(p *ListLdapUsersParams) (*ListLdapUsersResponse, error) {
+	return m.ListLdapUsersWithContext(context.Background(), p)
+}
+
+func (m *MockAuthenticationService) ListLdapUsersWithContext(ctx context.Context, p *ListLdapUsersParams) (*ListLdapUsersResponse, error) {
+	m.record("ListLdapUsers", p)
+	if m.ListLdapUsersFunc == nil {
+		return nil, notMocked("MockAuthenticationService", "ListLdapUsers")
+	}
+	return m.ListLdapUsersFunc(ctx, p)
+}
+
+func (m *MockAuthenticationService) NewListLdapUsersIterator(p *ListLdapUsersParams, pagesize int) *ListLdapUsersIterator {
+	i := &ListLdapUsersIterator{pager: newPager(nil, "listLdapUsers", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListLdapUsersParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListLdapUsersWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockAuthenticationService) NewLoginParams(password string, username string) *LoginParams {
+	return (&AuthenticationService{}).NewLoginParams(password, username)
+}
+
+func (m *MockAuthenticationService) Login(p *LoginParams) (*LoginResponse, error) {
+	return m.LoginWithContext(context.Background(), p)
+}
+
+func (m *MockAuthenticationService) LoginWithContext(ctx context.Context, p *LoginParams) (*LoginResponse, error) {
+	m.record("Login", p)
+	if m.LoginFunc == nil {
+		return nil, notMocked("MockAuthenticationService", "Login")
+	}
+	return m.LoginFunc(ctx, p)
+}
+
+func (m *MockAuthenticationService) NewLogoutParams() *LogoutParams {
+	return (&AuthenticationService{}).NewLogoutParams()
+}
+
+func (m *MockAuthenticationService) Logout(p *LogoutParams) (*LogoutResponse, error) {
+	return m.LogoutWithContext(context.Background(), p)
+}
+
+func (m *MockAuthenticationService) LogoutWithContext(ctx context.Context, p *LogoutParams) (*LogoutResponse, error) {
+	m.record("Logout", p)
+	if m.LogoutFunc == nil {
+		return nil, notMocked("MockAuthenticationService", "Logout")
+	}
+	return m.LogoutFunc(ctx, p)
+}
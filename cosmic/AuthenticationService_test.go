@@ -0,0 +1,214 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestLdapCreateAccountParams(t *testing.T) {
+	p := &LdapCreateAccountParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetAccountdetails(map[string]string{"k": "v"})
+	want.Set("accountdetails[0].key", "k")
+	want.Set("accountdetails[0].value", "v")
+	p.SetAccountid("accountid-value")
+	want.Set("accountid", "accountid-value")
+	p.SetAccounttype(42)
+	want.Set("accounttype", "42")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetNetworkdomain("networkdomain-value")
+	want.Set("networkdomain", "networkdomain-value")
+	p.SetTimezone("timezone-value")
+	want.Set("timezone", "timezone-value")
+	p.SetUserid("userid-value")
+	want.Set("userid", "userid-value")
+	p.SetUsername("username-value")
+	want.Set("username", "username-value")
+
+	assertParams(t, p, "ldapCreateAccount", want)
+}
+
+func TestLdapCreateAccountResponse(t *testing.T) {
+	assertDecodes(t, `{"accountdetails":{"key":"value"},"accounttype":42,"cpuavailable":"cpuavailable-value","cpulimit":"cpulimit-value","cputotal":1099511627776,"defaultzoneid":"defaultzoneid-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","ipavailable":"ipavailable-value","iplimit":"iplimit-value","iptotal":1099511627776,"iscleanuprequired":true,"isdefault":true,"memoryavailable":"memoryavailable-value","memorylimit":"memorylimit-value","memorytotal":1099511627776,"name":"name-value","networkavailable":"networkavailable-value","networkdomain":"networkdomain-value","networklimit":"networklimit-value","networktotal":1099511627776,"primarystorageavailable":"primarystorageavailable-value","primarystoragelimit":"primarystoragelimit-value","primarystoragetotal":1099511627776,"projectavailable":"projectavailable-value","projectlimit":"projectlimit-value","projecttotal":1099511627776,"receivedbytes":1099511627776,"secondarystorageavailable":"secondarystorageavailable-value","secondarystoragelimit":"secondarystoragelimit-value","secondarystoragetotal":1099511627776,"sentbytes":1099511627776,"snapshotavailable":"snapshotavailable-value","snapshotlimit":"snapshotlimit-value","snapshottotal":1099511627776,"state":"state-value","templateavailable":"templateavailable-value","templatelimit":"templatelimit-value","templatetotal":1099511627776,"user":[{"account":"account-value","accountid":"accountid-value","accounttype":42,"apikey":"apikey-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","email":"email-value","firstname":"firstname-value","id":"id-value","iscallerchilddomain":true,"isdefault":true,"lastname":"lastname-value","secretkey":"secretkey-value","state":"state-value","timezone":"timezone-value","username":"username-value"}],"vmavailable":"vmavailable-value","vmlimit":"vmlimit-value","vmrunning":42,"vmstopped":42,"vmtotal":1099511627776,"volumeavailable":"volumeavailable-value","volumelimit":"volumelimit-value","volumetotal":1099511627776,"vpcavailable":"vpcavailable-value","vpclimit":"vpclimit-value","vpctotal":1099511627776}`, &LdapCreateAccountResponse{})
+}
+
+func TestListDomainLdapLinkParams(t *testing.T) {
+	p := &ListDomainLdapLinkParams{}
+	want := url.Values{}
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+
+	assertParams(t, p, "listDomainLdapLink", want)
+}
+
+func TestListDomainLdapLinkResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"domainldaplink":[{"accountid":"accountid-value","accounttype":42,"domainid":"domainid-value","ldapenabled":true,"name":"name-value","type":"type-value"}]}`, &ListDomainLdapLinkResponse{})
+}
+
+func TestLinkDomainToLdapParams(t *testing.T) {
+	p := &LinkDomainToLdapParams{}
+	want := url.Values{}
+	p.SetAccounttype(42)
+	want.Set("accounttype", "42")
+	p.SetAdmin("admin-value")
+	want.Set("admin", "admin-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetType("type-value")
+	want.Set("type", "type-value")
+
+	assertParams(t, p, "linkDomainToLdap", want)
+}
+
+func TestLinkDomainToLdapResponse(t *testing.T) {
+	assertDecodes(t, `{"accountid":"accountid-value","accounttype":42,"domainid":"domainid-value","ldapenabled":true,"name":"name-value","type":"type-value"}`, &LinkDomainToLdapResponse{})
+}
+
+func TestAddLdapConfigurationParams(t *testing.T) {
+	p := &AddLdapConfigurationParams{}
+	want := url.Values{}
+	p.SetHostname("hostname-value")
+	want.Set("hostname", "hostname-value")
+	p.SetPort(42)
+	want.Set("port", "42")
+
+	assertParams(t, p, "addLdapConfiguration", want)
+}
+
+func TestAddLdapConfigurationResponse(t *testing.T) {
+	assertDecodes(t, `{"hostname":"hostname-value","port":42}`, &AddLdapConfigurationResponse{})
+}
+
+func TestDeleteLdapConfigurationParams(t *testing.T) {
+	p := &DeleteLdapConfigurationParams{}
+	want := url.Values{}
+	p.SetHostname("hostname-value")
+	want.Set("hostname", "hostname-value")
+
+	assertParams(t, p, "deleteLdapConfiguration", want)
+}
+
+func TestDeleteLdapConfigurationResponse(t *testing.T) {
+	assertDecodes(t, `{"hostname":"hostname-value","port":42}`, &DeleteLdapConfigurationResponse{})
+}
+
+func TestListLdapConfigurationsParams(t *testing.T) {
+	p := &ListLdapConfigurationsParams{}
+	want := url.Values{}
+	p.SetHostname("hostname-value")
+	want.Set("hostname", "hostname-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetPort(42)
+	want.Set("port", "42")
+
+	assertParams(t, p, "listLdapConfigurations", want)
+}
+
+func TestListLdapConfigurationsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"ldapconfiguration":[{"hostname":"hostname-value","port":42}]}`, &ListLdapConfigurationsResponse{})
+}
+
+func TestImportLdapUsersParams(t *testing.T) {
+	p := &ImportLdapUsersParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetAccountdetails(map[string]string{"k": "v"})
+	want.Set("accountdetails[0].key", "k")
+	want.Set("accountdetails[0].value", "v")
+	p.SetAccounttype(42)
+	want.Set("accounttype", "42")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetGroup("group-value")
+	want.Set("group", "group-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetTimezone("timezone-value")
+	want.Set("timezone", "timezone-value")
+
+	assertParams(t, p, "importLdapUsers", want)
+}
+
+func TestImportLdapUsersResponse(t *testing.T) {
+	assertDecodes(t, `{"domain":"domain-value","email":"email-value","firstname":"firstname-value","lastname":"lastname-value","principal":"principal-value","username":"username-value"}`, &ImportLdapUsersResponse{})
+}
+
+func TestListLdapUsersParams(t *testing.T) {
+	p := &ListLdapUsersParams{}
+	want := url.Values{}
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListtype("listtype-value")
+	want.Set("listtype", "listtype-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+
+	assertParams(t, p, "listLdapUsers", want)
+}
+
+func TestListLdapUsersResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"ldapuser":[{"domain":"domain-value","email":"email-value","firstname":"firstname-value","lastname":"lastname-value","principal":"principal-value","username":"username-value"}]}`, &ListLdapUsersResponse{})
+}
+
+func TestLoginParams(t *testing.T) {
+	p := &LoginParams{}
+	want := url.Values{}
+	p.SetDomain("domain-value")
+	want.Set("domain", "domain-value")
+	p.SetDomainId(int64(1) << 40)
+	want.Set("domainId", "1099511627776")
+	p.SetPassword("password-value")
+	want.Set("password", "password-value")
+	p.SetUsername("username-value")
+	want.Set("username", "username-value")
+
+	assertParams(t, p, "login", want)
+}
+
+func TestLoginResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","domainid":"domainid-value","domainname":"domainname-value","firstname":"firstname-value","lastname":"lastname-value","registered":"registered-value","sessionkey":"sessionkey-value","timeout":42,"timezone":"timezone-value","type":"type-value","userid":"userid-value","username":"username-value"}`, &LoginResponse{})
+}
+
+func TestLogoutParams(t *testing.T) {
+	p := &LogoutParams{}
+	want := url.Values{}
+
+	assertParams(t, p, "logout", want)
+}
+
+func TestLogoutResponse(t *testing.T) {
+	assertDecodes(t, `{"description":"description-value"}`, &LogoutResponse{})
+}
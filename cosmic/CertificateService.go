@@ -17,15 +17,28 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
 )
 
+// CertificateServiceIface is the interface implemented by CertificateService, and by MockCertificateService for tests
+type CertificateServiceIface interface {
+	NewUploadCustomCertificateParams(certificate string, domainsuffix string) *UploadCustomCertificateParams
+	UploadCustomCertificate(p *UploadCustomCertificateParams) (*UploadCustomCertificateResponse, error)
+	UploadCustomCertificateWithContext(ctx context.Context, p *UploadCustomCertificateParams) (*UploadCustomCertificateResponse, error)
+	UploadCustomCertificateAsync(ctx context.Context, p *UploadCustomCertificateParams) (*UploadCustomCertificateJob, error)
+}
+
 type UploadCustomCertificateParams struct {
 	p map[string]interface{}
 }
 
+func (p *UploadCustomCertificateParams) command() string {
+	return "uploadCustomCertificate"
+}
+
 func (p *UploadCustomCertificateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -97,7 +110,13 @@ func (s *CertificateService) NewUploadCustomCertificateParams(certificate string
 
 // Uploads a custom certificate for the console proxy VMs to use for SSL. Can be used to upload a single certificate signed by a known CA. Can also be used, through multiple calls, to upload a chain of certificates from CA to the custom certificate itself.
 func (s *CertificateService) UploadCustomCertificate(p *UploadCustomCertificateParams) (*UploadCustomCertificateResponse, error) {
-	resp, err := s.cs.newRequest("uploadCustomCertificate", p.toURLValues())
+	return s.UploadCustomCertificateWithContext(context.Background(), p)
+}
+
+// UploadCustomCertificateWithContext is the same as UploadCustomCertificate, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *CertificateService) UploadCustomCertificateWithContext(ctx context.Context, p *UploadCustomCertificateParams) (*UploadCustomCertificateResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "uploadCustomCertificate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -109,26 +128,56 @@ func (s *CertificateService) UploadCustomCertificate(p *UploadCustomCertificateP
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "uploadCustomCertificate", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "customcertificate", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// UploadCustomCertificateJob is a handle to a running uploadCustomCertificate async job
+type UploadCustomCertificateJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UploadCustomCertificateResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UploadCustomCertificateJob) Result() (*UploadCustomCertificateResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UploadCustomCertificateResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "customcertificate", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// UploadCustomCertificateAsync starts the uploadCustomCertificate async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *CertificateService) UploadCustomCertificateAsync(ctx context.Context, p *UploadCustomCertificateParams) (*UploadCustomCertificateJob, error) {
+	resp, err := s.cs.newRequest(ctx, "uploadCustomCertificate", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UploadCustomCertificateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UploadCustomCertificateJob{s.cs.newJobHandle("uploadCustomCertificate", r.JobID)}, nil
+}
+
 type UploadCustomCertificateResponse struct {
 	JobID   string `json:"jobid,omitempty"`
 	Message string `json:"message,omitempty"`
@@ -0,0 +1,55 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockCertificateService is a mock of CertificateServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockCertificateService struct {
+	mockCalls
+
+	UploadCustomCertificateFunc      func(ctx context.Context, p *UploadCustomCertificateParams) (*UploadCustomCertificateResponse, error)
+	UploadCustomCertificateAsyncFunc func(ctx context.Context, p *UploadCustomCertificateParams) (*UploadCustomCertificateJob, error)
+}
+
+var _ CertificateServiceIface = &MockCertificateService{}
+
+func (m *MockCertificateService) NewUploadCustomCertificateParams(certificate string, domainsuffix string) *UploadCustomCertificateParams {
+	return (&CertificateService{}).NewUploadCustomCertificateParams(certificate, domainsuffix)
+}
+
+func (m *MockCertificateService) UploadCustomCertificate(p *UploadCustomCertificateParams) (*UploadCustomCertificateResponse, error) {
+	return m.UploadCustomCertificateWithContext(context.Background(), p)
+}
+
+func (m *MockCertificateService) UploadCustomCertificateWithContext(ctx context.Context, p *UploadCustomCertificateParams) (*UploadCustomCertificateResponse, error) {
+	m.record("UploadCustomCertificate", p)
+	if m.UploadCustomCertificateFunc == nil {
+		return nil, notMocked("MockCertificateService", "UploadCustomCertificate")
+	}
+	return m.UploadCustomCertificateFunc(ctx, p)
+}
+
+func (m *MockCertificateService) UploadCustomCertificateAsync(ctx context.Context, p *UploadCustomCertificateParams) (*UploadCustomCertificateJob, error) {
+	m.record("UploadCustomCertificateAsync", p)
+	if m.UploadCustomCertificateAsyncFunc == nil {
+		return nil, notMocked("MockCertificateService", "UploadCustomCertificateAsync")
+	}
+	return m.UploadCustomCertificateAsyncFunc(ctx, p)
+}
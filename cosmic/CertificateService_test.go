@@ -0,0 +1,43 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestUploadCustomCertificateParams(t *testing.T) {
+	p := &UploadCustomCertificateParams{}
+	want := url.Values{}
+	p.SetCertificate("certificate-value")
+	want.Set("certificate", "certificate-value")
+	p.SetDomainsuffix("domainsuffix-value")
+	want.Set("domainsuffix", "domainsuffix-value")
+	p.SetId(42)
+	want.Set("id", "42")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPrivatekey("privatekey-value")
+	want.Set("privatekey", "privatekey-value")
+
+	assertParams(t, p, "uploadCustomCertificate", want)
+}
+
+func TestUploadCustomCertificateResponse(t *testing.T) {
+	assertDecodes(t, `{"jobid":"jobid-value","message":"message-value"}`, &UploadCustomCertificateResponse{})
+}
@@ -17,15 +17,36 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
 )
 
+// CloudOpsServiceIface is the interface implemented by CloudOpsService, and by MockCloudOpsService for tests
+type CloudOpsServiceIface interface {
+	NewListHAWorkersParams() *ListHAWorkersParams
+	ListHAWorkers(p *ListHAWorkersParams) (*ListHAWorkersResponse, error)
+	ListHAWorkersWithContext(ctx context.Context, p *ListHAWorkersParams) (*ListHAWorkersResponse, error)
+	NewListHAWorkersIterator(p *ListHAWorkersParams, pagesize int) *ListHAWorkersIterator
+	NewListWhoHasThisIpParams(ipaddress string) *ListWhoHasThisIpParams
+	ListWhoHasThisIp(p *ListWhoHasThisIpParams) (*ListWhoHasThisIpResponse, error)
+	ListWhoHasThisIpWithContext(ctx context.Context, p *ListWhoHasThisIpParams) (*ListWhoHasThisIpResponse, error)
+	NewListWhoHasThisIpIterator(p *ListWhoHasThisIpParams, pagesize int) *ListWhoHasThisIpIterator
+	NewListWhoHasThisMacParams() *ListWhoHasThisMacParams
+	ListWhoHasThisMac(p *ListWhoHasThisMacParams) (*ListWhoHasThisMacResponse, error)
+	ListWhoHasThisMacWithContext(ctx context.Context, p *ListWhoHasThisMacParams) (*ListWhoHasThisMacResponse, error)
+	NewListWhoHasThisMacIterator(p *ListWhoHasThisMacParams, pagesize int) *ListWhoHasThisMacIterator
+}
+
 type ListHAWorkersParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListHAWorkersParams) command() string {
+	return "listHAWorkers"
+}
+
 func (p *ListHAWorkersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -119,10 +140,17 @@ func (s *CloudOpsService) NewListHAWorkersParams() *ListHAWorkersParams {
 
 // Lists all HA workers
 func (s *CloudOpsService) ListHAWorkers(p *ListHAWorkersParams) (*ListHAWorkersResponse, error) {
+	return s.ListHAWorkersWithContext(context.Background(), p)
+}
+
+// ListHAWorkersWithContext is the same as ListHAWorkers, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *CloudOpsService) ListHAWorkersWithContext(ctx context.Context, p *ListHAWorkersParams) (*ListHAWorkersResponse, error) {
 	var r ListHAWorkersResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListHAWorkersResponse
-		resp, err := s.cs.newRequest("listHAWorkers", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listHAWorkers", values)
 		if err != nil {
 			return nil, err
 		}
@@ -134,15 +162,91 @@ func (s *CloudOpsService) ListHAWorkers(p *ListHAWorkersParams) (*ListHAWorkersR
 		r.Count = l.Count
 		r.HAWorkers = append(r.HAWorkers, l.HAWorkers...)
 
-		if r.Count == len(r.HAWorkers) {
+		if r.Count == len(r.HAWorkers) || len(l.HAWorkers) == 0 {
+			s.cs.observePages("listHAWorkers", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.HAWorkers))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.HAWorkers)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listHAWorkers", values, len(l.HAWorkers), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListHAWorkersResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.HAWorkers = append(r.HAWorkers, l.HAWorkers...)
+			}
+
+			if r.Count == len(r.HAWorkers) {
+				s.cs.observePages("listHAWorkers", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
 	}
 }
 
+// ListHAWorkersIterator iterates over the items returned by listHAWorkers, requesting
+// the pages of the response one at a time while iterating.
+type ListHAWorkersIterator struct {
+	pager
+	items []*HAWorker
+	index int
+}
+
+// NewListHAWorkersIterator returns an iterator over the items returned by listHAWorkers, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *CloudOpsService) NewListHAWorkersIterator(p *ListHAWorkersParams, pagesize int) *ListHAWorkersIterator {
+	return &ListHAWorkersIterator{pager: newPager(s.cs, "listHAWorkers", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListHAWorkersIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListHAWorkersResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.HAWorkers))
+
+	i.items = l.HAWorkers
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListHAWorkersIterator) Value() *HAWorker {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
+}
+
 type ListHAWorkersResponse struct {
 	Count     int         `json:"count"`
 	HAWorkers []*HAWorker `json:"haworker"`
@@ -168,6 +272,10 @@ type ListWhoHasThisIpParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListWhoHasThisIpParams) command() string {
+	return "listWhoHasThisIp"
+}
+
 func (p *ListWhoHasThisIpParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -271,10 +379,17 @@ func (s *CloudOpsService) NewListWhoHasThisIpParams(ipaddress string) *ListWhoHa
 
 // Lists all for this IP address
 func (s *CloudOpsService) ListWhoHasThisIp(p *ListWhoHasThisIpParams) (*ListWhoHasThisIpResponse, error) {
+	return s.ListWhoHasThisIpWithContext(context.Background(), p)
+}
+
+// ListWhoHasThisIpWithContext is the same as ListWhoHasThisIp, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *CloudOpsService) ListWhoHasThisIpWithContext(ctx context.Context, p *ListWhoHasThisIpParams) (*ListWhoHasThisIpResponse, error) {
 	var r ListWhoHasThisIpResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListWhoHasThisIpResponse
-		resp, err := s.cs.newRequest("listWhoHasThisIp", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listWhoHasThisIp", values)
 		if err != nil {
 			return nil, err
 		}
@@ -286,13 +401,89 @@ func (s *CloudOpsService) ListWhoHasThisIp(p *ListWhoHasThisIpParams) (*ListWhoH
 		r.Count = l.Count
 		r.WhoHasThisIp = append(r.WhoHasThisIp, l.WhoHasThisIp...)
 
-		if r.Count == len(r.WhoHasThisIp) {
+		if r.Count == len(r.WhoHasThisIp) || len(l.WhoHasThisIp) == 0 {
+			s.cs.observePages("listWhoHasThisIp", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.WhoHasThisIp))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.WhoHasThisIp)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listWhoHasThisIp", values, len(l.WhoHasThisIp), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListWhoHasThisIpResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.WhoHasThisIp = append(r.WhoHasThisIp, l.WhoHasThisIp...)
+			}
+
+			if r.Count == len(r.WhoHasThisIp) {
+				s.cs.observePages("listWhoHasThisIp", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListWhoHasThisIpIterator iterates over the items returned by listWhoHasThisIp, requesting
+// the pages of the response one at a time while iterating.
+type ListWhoHasThisIpIterator struct {
+	pager
+	items []*WhoHasThisIp
+	index int
+}
+
+// NewListWhoHasThisIpIterator returns an iterator over the items returned by listWhoHasThisIp, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *CloudOpsService) NewListWhoHasThisIpIterator(p *ListWhoHasThisIpParams, pagesize int) *ListWhoHasThisIpIterator {
+	return &ListWhoHasThisIpIterator{pager: newPager(s.cs, "listWhoHasThisIp", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListWhoHasThisIpIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListWhoHasThisIpResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.WhoHasThisIp))
+
+	i.items = l.WhoHasThisIp
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListWhoHasThisIpIterator) Value() *WhoHasThisIp {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListWhoHasThisIpResponse struct {
@@ -326,6 +517,10 @@ type ListWhoHasThisMacParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListWhoHasThisMacParams) command() string {
+	return "listWhoHasThisMac"
+}
+
 func (p *ListWhoHasThisMacParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -428,10 +623,17 @@ func (s *CloudOpsService) NewListWhoHasThisMacParams() *ListWhoHasThisMacParams
 
 // Lists all for this MAC address
 func (s *CloudOpsService) ListWhoHasThisMac(p *ListWhoHasThisMacParams) (*ListWhoHasThisMacResponse, error) {
+	return s.ListWhoHasThisMacWithContext(context.Background(), p)
+}
+
+// ListWhoHasThisMacWithContext is the same as ListWhoHasThisMac, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *CloudOpsService) ListWhoHasThisMacWithContext(ctx context.Context, p *ListWhoHasThisMacParams) (*ListWhoHasThisMacResponse, error) {
 	var r ListWhoHasThisMacResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListWhoHasThisMacResponse
-		resp, err := s.cs.newRequest("listWhoHasThisMac", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listWhoHasThisMac", values)
 		if err != nil {
 			return nil, err
 		}
@@ -443,13 +645,89 @@ func (s *CloudOpsService) ListWhoHasThisMac(p *ListWhoHasThisMacParams) (*ListWh
 		r.Count = l.Count
 		r.WhoHasThisMac = append(r.WhoHasThisMac, l.WhoHasThisMac...)
 
-		if r.Count == len(r.WhoHasThisMac) {
+		if r.Count == len(r.WhoHasThisMac) || len(l.WhoHasThisMac) == 0 {
+			s.cs.observePages("listWhoHasThisMac", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.WhoHasThisMac))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.WhoHasThisMac)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listWhoHasThisMac", values, len(l.WhoHasThisMac), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListWhoHasThisMacResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.WhoHasThisMac = append(r.WhoHasThisMac, l.WhoHasThisMac...)
+			}
+
+			if r.Count == len(r.WhoHasThisMac) {
+				s.cs.observePages("listWhoHasThisMac", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListWhoHasThisMacIterator iterates over the items returned by listWhoHasThisMac, requesting
+// the pages of the response one at a time while iterating.
+type ListWhoHasThisMacIterator struct {
+	pager
+	items []*WhoHasThisMac
+	index int
+}
+
+// NewListWhoHasThisMacIterator returns an iterator over the items returned by listWhoHasThisMac, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *CloudOpsService) NewListWhoHasThisMacIterator(p *ListWhoHasThisMacParams, pagesize int) *ListWhoHasThisMacIterator {
+	return &ListWhoHasThisMacIterator{pager: newPager(s.cs, "listWhoHasThisMac", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListWhoHasThisMacIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListWhoHasThisMacResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.WhoHasThisMac))
+
+	i.items = l.WhoHasThisMac
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListWhoHasThisMacIterator) Value() *WhoHasThisMac {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListWhoHasThisMacResponse struct {
@@ -0,0 +1,122 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockCloudOpsService is a mock of CloudOpsServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockCloudOpsService struct {
+	mockCalls
+
+	ListHAWorkersFunc     func(ctx context.Context, p *ListHAWorkersParams) (*ListHAWorkersResponse, error)
+	ListWhoHasThisIpFunc  func(ctx context.Context, p *ListWhoHasThisIpParams) (*ListWhoHasThisIpResponse, error)
+	ListWhoHasThisMacFunc func(ctx context.Context, p *ListWhoHasThisMacParams) (*ListWhoHasThisMacResponse, error)
+}
+
+var _ CloudOpsServiceIface = &MockCloudOpsService{}
+
+func (m *MockCloudOpsService) NewListHAWorkersParams() *ListHAWorkersParams {
+	return (&CloudOpsService{}).NewListHAWorkersParams()
+}
+
+func (m *MockCloudOpsService) ListHAWorkers(p *ListHAWorkersParams) (*ListHAWorkersResponse, error) {
+	return m.ListHAWorkersWithContext(context.Background(), p)
+}
+
+func (m *MockCloudOpsService) ListHAWorkersWithContext(ctx context.Context, p *ListHAWorkersParams) (*ListHAWorkersResponse, error) {
+	m.record("ListHAWorkers", p)
+	if m.ListHAWorkersFunc == nil {
+		return nil, notMocked("MockCloudOpsService", "ListHAWorkers")
+	}
+	return m.ListHAWorkersFunc(ctx, p)
+}
+
+func (m *MockCloudOpsService) NewListHAWorkersIterator(p *ListHAWorkersParams, pagesize int) *ListHAWorkersIterator {
+	i := &ListHAWorkersIterator{pager: newPager(nil, "listHAWorkers", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListHAWorkersParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListHAWorkersWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockCloudOpsService) NewListWhoHasThisIpParams(ipaddress string) *ListWhoHasThisIpParams {
+	return (&CloudOpsService{}).NewListWhoHasThisIpParams(ipaddress)
+}
+
+func (m *MockCloudOpsService) ListWhoHasThisIp(p *ListWhoHasThisIpParams) (*ListWhoHasThisIpResponse, error) {
+	return m.ListWhoHasThisIpWithContext(context.Background(), p)
+}
+
+func (m *MockCloudOpsService) ListWhoHasThisIpWithContext(ctx context.Context, p *ListWhoHasThisIpParams) (*ListWhoHasThisIpResponse, error) {
+	m.record("ListWhoHasThisIp", p)
+	if m.ListWhoHasThisIpFunc == nil {
+		return nil, notMocked("MockCloudOpsService", "ListWhoHasThisIp")
+	}
+	return m.ListWhoHasThisIpFunc(ctx, p)
+}
+
+func (m *MockCloudOpsService) NewListWhoHasThisIpIterator(p *ListWhoHasThisIpParams, pagesize int) *ListWhoHasThisIpIterator {
+	i := &ListWhoHasThisIpIterator{pager: newPager(nil, "listWhoHasThisIp", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListWhoHasThisIpParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListWhoHasThisIpWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockCloudOpsService) NewListWhoHasThisMacParams() *ListWhoHasThisMacParams {
+	return (&CloudOpsService{}).NewListWhoHasThisMacParams()
+}
+
+func (m *MockCloudOpsService) ListWhoHasThisMac(p *ListWhoHasThisMacParams) (*ListWhoHasThisMacResponse, error) {
+	return m.ListWhoHasThisMacWithContext(context.Background(), p)
+}
+
+func (m *MockCloudOpsService) ListWhoHasThisMacWithContext(ctx context.Context, p *ListWhoHasThisMacParams) (*ListWhoHasThisMacResponse, error) {
+	m.record("ListWhoHasThisMac", p)
+	if m.ListWhoHasThisMacFunc == nil {
+		return nil, notMocked("MockCloudOpsService", "ListWhoHasThisMac")
+	}
+	return m.ListWhoHasThisMacFunc(ctx, p)
+}
+
+func (m *MockCloudOpsService) NewListWhoHasThisMacIterator(p *ListWhoHasThisMacParams, pagesize int) *ListWhoHasThisMacIterator {
+	i := &ListWhoHasThisMacIterator{pager: newPager(nil, "listWhoHasThisMac", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListWhoHasThisMacParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListWhoHasThisMacWithContext(ctx, c)
+	}
+	return i
+}
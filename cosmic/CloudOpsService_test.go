@@ -0,0 +1,101 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestListHAWorkersParams(t *testing.T) {
+	p := &ListHAWorkersParams{}
+	want := url.Values{}
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetId(int64(1) << 40)
+	want.Set("id", "1099511627776")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+
+	assertParams(t, p, "listHAWorkers", want)
+}
+
+func TestListHAWorkersResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"haworker":[{"created":"created-value","domainid":"domainid-value","domainname":"domainname-value","hypervisor":"hypervisor-value","id":1099511627776,"managementservername":"managementservername-value","state":"state-value","step":"step-value","taken":"taken-value","type":"type-value","virtualmachineid":"virtualmachineid-value","virtualmachinename":"virtualmachinename-value","virtualmachinestate":"virtualmachinestate-value"}]}`, &ListHAWorkersResponse{})
+}
+
+func TestListWhoHasThisIpParams(t *testing.T) {
+	p := &ListWhoHasThisIpParams{}
+	want := url.Values{}
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetIpaddress("ipaddress-value")
+	want.Set("ipaddress", "ipaddress-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetUuid("uuid-value")
+	want.Set("uuid", "uuid-value")
+
+	assertParams(t, p, "listWhoHasThisIp", want)
+}
+
+func TestListWhoHasThisIpResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"whohasthisip":[{"associatednetworkname":"associatednetworkname-value","associatednetworkuuid":"associatednetworkuuid-value","broadcasturi":"broadcasturi-value","created":"created-value","domainname":"domainname-value","domainuuid":"domainuuid-value","ipaddress":"ipaddress-value","macaddress":"macaddress-value","mode":"mode-value","netmask":"netmask-value","networkname":"networkname-value","networkuuid":"networkuuid-value","state":"state-value","uuid":"uuid-value","virtualmachinename":"virtualmachinename-value","virtualmachinetype":"virtualmachinetype-value","virtualmachineuuid":"virtualmachineuuid-value","vpcname":"vpcname-value","vpcuuid":"vpcuuid-value"}]}`, &ListWhoHasThisIpResponse{})
+}
+
+func TestListWhoHasThisMacParams(t *testing.T) {
+	p := &ListWhoHasThisMacParams{}
+	want := url.Values{}
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetMacaddress("macaddress-value")
+	want.Set("macaddress", "macaddress-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetUuid("uuid-value")
+	want.Set("uuid", "uuid-value")
+
+	assertParams(t, p, "listWhoHasThisMac", want)
+}
+
+func TestListWhoHasThisMacResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"whohasthismac":[{"associatednetworkname":"associatednetworkname-value","associatednetworkuuid":"associatednetworkuuid-value","broadcasturi":"broadcasturi-value","created":"created-value","domainname":"domainname-value","domainuuid":"domainuuid-value","ipaddress":"ipaddress-value","macaddress":"macaddress-value","mode":"mode-value","netmask":"netmask-value","networkname":"networkname-value","networkuuid":"networkuuid-value","state":"state-value","uuid":"uuid-value","virtualmachinename":"virtualmachinename-value","virtualmachinetype":"virtualmachinetype-value","virtualmachineuuid":"virtualmachineuuid-value","vpcname":"vpcname-value","vpcuuid":"vpcuuid-value"}]}`, &ListWhoHasThisMacResponse{})
+}
@@ -17,17 +17,54 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
+// ClusterServiceIface is the interface implemented by ClusterService, and by MockClusterService for tests
+type ClusterServiceIface interface {
+	NewAddClusterParams(clustername string, clustertype string, hypervisor string, podid string, zoneid string) *AddClusterParams
+	AddCluster(p *AddClusterParams) (*AddClusterResponse, error)
+	AddClusterWithContext(ctx context.Context, p *AddClusterParams) (*AddClusterResponse, error)
+	NewDedicateClusterParams(clusterid string, domainid string) *DedicateClusterParams
+	DedicateCluster(p *DedicateClusterParams) (*DedicateClusterResponse, error)
+	DedicateClusterWithContext(ctx context.Context, p *DedicateClusterParams) (*DedicateClusterResponse, error)
+	DedicateClusterAsync(ctx context.Context, p *DedicateClusterParams) (*DedicateClusterJob, error)
+	NewDeleteClusterParams(id string) *DeleteClusterParams
+	DeleteCluster(p *DeleteClusterParams) (*DeleteClusterResponse, error)
+	DeleteClusterWithContext(ctx context.Context, p *DeleteClusterParams) (*DeleteClusterResponse, error)
+	NewUpdateClusterParams(id string) *UpdateClusterParams
+	UpdateCluster(p *UpdateClusterParams) (*UpdateClusterResponse, error)
+	UpdateClusterWithContext(ctx context.Context, p *UpdateClusterParams) (*UpdateClusterResponse, error)
+	NewListClustersParams() *ListClustersParams
+	GetClusterID(name string, opts ...OptionFunc) (string, int, error)
+	GetClusterByName(name string, opts ...OptionFunc) (*Cluster, int, error)
+	GetClusterByID(id string, opts ...OptionFunc) (*Cluster, int, error)
+	ListClusters(p *ListClustersParams) (*ListClustersResponse, error)
+	ListClustersWithContext(ctx context.Context, p *ListClustersParams) (*ListClustersResponse, error)
+	NewListClustersIterator(p *ListClustersParams, pagesize int) *ListClustersIterator
+	NewReleaseDedicatedClusterParams(clusterid string) *ReleaseDedicatedClusterParams
+	ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterResponse, error)
+	ReleaseDedicatedClusterWithContext(ctx context.Context, p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterResponse, error)
+	ReleaseDedicatedClusterAsync(ctx context.Context, p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterJob, error)
+	NewListDedicatedClustersParams() *ListDedicatedClustersParams
+	ListDedicatedClusters(p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error)
+	ListDedicatedClustersWithContext(ctx context.Context, p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error)
+	NewListDedicatedClustersIterator(p *ListDedicatedClustersParams, pagesize int) *ListDedicatedClustersIterator
+}
+
 type AddClusterParams struct {
 	p map[string]interface{}
 }
 
+func (p *AddClusterParams) command() string {
+	return "addCluster"
+}
+
 func (p *AddClusterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -141,7 +178,13 @@ func (s *ClusterService) NewAddClusterParams(clustername string, clustertype str
 
 // Adds a new cluster
 func (s *ClusterService) AddCluster(p *AddClusterParams) (*AddClusterResponse, error) {
-	resp, err := s.cs.newRequest("addCluster", p.toURLValues())
+	return s.AddClusterWithContext(context.Background(), p)
+}
+
+// AddClusterWithContext is the same as AddCluster, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ClusterService) AddClusterWithContext(ctx context.Context, p *AddClusterParams) (*AddClusterResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "addCluster", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -184,6 +227,10 @@ type DedicateClusterParams struct {
 	p map[string]interface{}
 }
 
+func (p *DedicateClusterParams) command() string {
+	return "dedicateCluster"
+}
+
 func (p *DedicateClusterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -234,7 +281,13 @@ func (s *ClusterService) NewDedicateClusterParams(clusterid string, domainid str
 
 // Dedicate an existing cluster
 func (s *ClusterService) DedicateCluster(p *DedicateClusterParams) (*DedicateClusterResponse, error) {
-	resp, err := s.cs.newRequest("dedicateCluster", p.toURLValues())
+	return s.DedicateClusterWithContext(context.Background(), p)
+}
+
+// DedicateClusterWithContext is the same as DedicateCluster, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ClusterService) DedicateClusterWithContext(ctx context.Context, p *DedicateClusterParams) (*DedicateClusterResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "dedicateCluster", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -246,26 +299,56 @@ func (s *ClusterService) DedicateCluster(p *DedicateClusterParams) (*DedicateClu
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "dedicateCluster", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "cluster", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// DedicateClusterJob is a handle to a running dedicateCluster async job
+type DedicateClusterJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DedicateClusterResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DedicateClusterJob) Result() (*DedicateClusterResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DedicateClusterResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "cluster", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// DedicateClusterAsync starts the dedicateCluster async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *ClusterService) DedicateClusterAsync(ctx context.Context, p *DedicateClusterParams) (*DedicateClusterJob, error) {
+	resp, err := s.cs.newRequest(ctx, "dedicateCluster", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DedicateClusterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DedicateClusterJob{s.cs.newJobHandle("dedicateCluster", r.JobID)}, nil
+}
+
 type DedicateClusterResponse struct {
 	JobID           string `json:"jobid,omitempty"`
 	Accountid       string `json:"accountid,omitempty"`
@@ -282,6 +365,10 @@ type DeleteClusterParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteClusterParams) command() string {
+	return "deleteCluster"
+}
+
 func (p *DeleteClusterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -311,7 +398,13 @@ func (s *ClusterService) NewDeleteClusterParams(id string) *DeleteClusterParams
 
 // Deletes a cluster.
 func (s *ClusterService) DeleteCluster(p *DeleteClusterParams) (*DeleteClusterResponse, error) {
-	resp, err := s.cs.newRequest("deleteCluster", p.toURLValues())
+	return s.DeleteClusterWithContext(context.Background(), p)
+}
+
+// DeleteClusterWithContext is the same as DeleteCluster, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ClusterService) DeleteClusterWithContext(ctx context.Context, p *DeleteClusterParams) (*DeleteClusterResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteCluster", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -332,6 +425,10 @@ type UpdateClusterParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateClusterParams) command() string {
+	return "updateCluster"
+}
+
 func (p *UpdateClusterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -411,7 +508,13 @@ func (s *ClusterService) NewUpdateClusterParams(id string) *UpdateClusterParams
 
 // Updates an existing cluster
 func (s *ClusterService) UpdateCluster(p *UpdateClusterParams) (*UpdateClusterResponse, error) {
-	resp, err := s.cs.newRequest("updateCluster", p.toURLValues())
+	return s.UpdateClusterWithContext(context.Background(), p)
+}
+
+// UpdateClusterWithContext is the same as UpdateCluster, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ClusterService) UpdateClusterWithContext(ctx context.Context, p *UpdateClusterParams) (*UpdateClusterResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateCluster", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -454,6 +557,10 @@ type ListClustersParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListClustersParams) command() string {
+	return "listClusters"
+}
+
 func (p *ListClustersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -612,7 +719,7 @@ func (s *ClusterService) GetClusterID(name string, opts ...OptionFunc) (string,
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -626,7 +733,7 @@ func (s *ClusterService) GetClusterID(name string, opts ...OptionFunc) (string,
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -658,16 +765,14 @@ func (s *ClusterService) GetClusterByID(id string, opts ...OptionFunc) (*Cluster
 
 	l, err := s.ListClusters(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -679,10 +784,17 @@ func (s *ClusterService) GetClusterByID(id string, opts ...OptionFunc) (*Cluster
 
 // Lists clusters.
 func (s *ClusterService) ListClusters(p *ListClustersParams) (*ListClustersResponse, error) {
+	return s.ListClustersWithContext(context.Background(), p)
+}
+
+// ListClustersWithContext is the same as ListClusters, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ClusterService) ListClustersWithContext(ctx context.Context, p *ListClustersParams) (*ListClustersResponse, error) {
 	var r ListClustersResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListClustersResponse
-		resp, err := s.cs.newRequest("listClusters", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listClusters", values)
 		if err != nil {
 			return nil, err
 		}
@@ -694,15 +806,91 @@ func (s *ClusterService) ListClusters(p *ListClustersParams) (*ListClustersRespo
 		r.Count = l.Count
 		r.Clusters = append(r.Clusters, l.Clusters...)
 
-		if r.Count == len(r.Clusters) {
+		if r.Count == len(r.Clusters) || len(l.Clusters) == 0 {
+			s.cs.observePages("listClusters", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.Clusters))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.Clusters)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listClusters", values, len(l.Clusters), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListClustersResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.Clusters = append(r.Clusters, l.Clusters...)
+			}
+
+			if r.Count == len(r.Clusters) {
+				s.cs.observePages("listClusters", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
 	}
 }
 
+// ListClustersIterator iterates over the items returned by listClusters, requesting
+// the pages of the response one at a time while iterating.
+type ListClustersIterator struct {
+	pager
+	items []*Cluster
+	index int
+}
+
+// NewListClustersIterator returns an iterator over the items returned by listClusters, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *ClusterService) NewListClustersIterator(p *ListClustersParams, pagesize int) *ListClustersIterator {
+	return &ListClustersIterator{pager: newPager(s.cs, "listClusters", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListClustersIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListClustersResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.Clusters))
+
+	i.items = l.Clusters
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListClustersIterator) Value() *Cluster {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
+}
+
 type ListClustersResponse struct {
 	Count    int        `json:"count"`
 	Clusters []*Cluster `json:"cluster"`
@@ -739,6 +927,10 @@ type ReleaseDedicatedClusterParams struct {
 	p map[string]interface{}
 }
 
+func (p *ReleaseDedicatedClusterParams) command() string {
+	return "releaseDedicatedCluster"
+}
+
 func (p *ReleaseDedicatedClusterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -768,7 +960,13 @@ func (s *ClusterService) NewReleaseDedicatedClusterParams(clusterid string) *Rel
 
 // Release the dedication for cluster
 func (s *ClusterService) ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedCluster", p.toURLValues())
+	return s.ReleaseDedicatedClusterWithContext(context.Background(), p)
+}
+
+// ReleaseDedicatedClusterWithContext is the same as ReleaseDedicatedCluster, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ClusterService) ReleaseDedicatedClusterWithContext(ctx context.Context, p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "releaseDedicatedCluster", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -780,9 +978,9 @@ func (s *ClusterService) ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParam
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "releaseDedicatedCluster", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -795,6 +993,41 @@ func (s *ClusterService) ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParam
 	return &r, nil
 }
 
+// ReleaseDedicatedClusterJob is a handle to a running releaseDedicatedCluster async job
+type ReleaseDedicatedClusterJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a ReleaseDedicatedClusterResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *ReleaseDedicatedClusterJob) Result() (*ReleaseDedicatedClusterResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := ReleaseDedicatedClusterResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ReleaseDedicatedClusterAsync starts the releaseDedicatedCluster async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *ClusterService) ReleaseDedicatedClusterAsync(ctx context.Context, p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterJob, error) {
+	resp, err := s.cs.newRequest(ctx, "releaseDedicatedCluster", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ReleaseDedicatedClusterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &ReleaseDedicatedClusterJob{s.cs.newJobHandle("releaseDedicatedCluster", r.JobID)}, nil
+}
+
 type ReleaseDedicatedClusterResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -805,6 +1038,10 @@ type ListDedicatedClustersParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListDedicatedClustersParams) command() string {
+	return "listDedicatedClusters"
+}
+
 func (p *ListDedicatedClustersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -895,10 +1132,17 @@ func (s *ClusterService) NewListDedicatedClustersParams() *ListDedicatedClusters
 
 // Lists dedicated clusters.
 func (s *ClusterService) ListDedicatedClusters(p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error) {
+	return s.ListDedicatedClustersWithContext(context.Background(), p)
+}
+
+// ListDedicatedClustersWithContext is the same as ListDedicatedClusters, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ClusterService) ListDedicatedClustersWithContext(ctx context.Context, p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error) {
 	var r ListDedicatedClustersResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListDedicatedClustersResponse
-		resp, err := s.cs.newRequest("listDedicatedClusters", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listDedicatedClusters", values)
 		if err != nil {
 			return nil, err
 		}
@@ -910,13 +1154,89 @@ func (s *ClusterService) ListDedicatedClusters(p *ListDedicatedClustersParams) (
 		r.Count = l.Count
 		r.DedicatedClusters = append(r.DedicatedClusters, l.DedicatedClusters...)
 
-		if r.Count == len(r.DedicatedClusters) {
+		if r.Count == len(r.DedicatedClusters) || len(l.DedicatedClusters) == 0 {
+			s.cs.observePages("listDedicatedClusters", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.DedicatedClusters))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.DedicatedClusters)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listDedicatedClusters", values, len(l.DedicatedClusters), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListDedicatedClustersResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.DedicatedClusters = append(r.DedicatedClusters, l.DedicatedClusters...)
+			}
+
+			if r.Count == len(r.DedicatedClusters) {
+				s.cs.observePages("listDedicatedClusters", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListDedicatedClustersIterator iterates over the items returned by listDedicatedClusters, requesting
+// the pages of the response one at a time while iterating.
+type ListDedicatedClustersIterator struct {
+	pager
+	items []*DedicatedCluster
+	index int
+}
+
+// NewListDedicatedClustersIterator returns an iterator over the items returned by listDedicatedClusters, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *ClusterService) NewListDedicatedClustersIterator(p *ListDedicatedClustersParams, pagesize int) *ListDedicatedClustersIterator {
+	return &ListDedicatedClustersIterator{pager: newPager(s.cs, "listDedicatedClusters", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListDedicatedClustersIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListDedicatedClustersResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.DedicatedClusters))
+
+	i.items = l.DedicatedClusters
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListDedicatedClustersIterator) Value() *DedicatedCluster {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListDedicatedClustersResponse struct {
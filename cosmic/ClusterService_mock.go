@@ -0,0 +1,221 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockClusterService is a mock of ClusterServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockClusterService struct {
+	mockCalls
+
+	AddClusterFunc                   func(ctx context.Context, p *AddClusterParams) (*AddClusterResponse, error)
+	DedicateClusterFunc              func(ctx context.Context, p *DedicateClusterParams) (*DedicateClusterResponse, error)
+	DedicateClusterAsyncFunc         func(ctx context.Context, p *DedicateClusterParams) (*DedicateClusterJob, error)
+	DeleteClusterFunc                func(ctx context.Context, p *DeleteClusterParams) (*DeleteClusterResponse, error)
+	UpdateClusterFunc                func(ctx context.Context, p *UpdateClusterParams) (*UpdateClusterResponse, error)
+	GetClusterIDFunc                 func(name string, opts ...OptionFunc) (string, int, error)
+	GetClusterByNameFunc             func(name string, opts ...OptionFunc) (*Cluster, int, error)
+	GetClusterByIDFunc               func(id string, opts ...OptionFunc) (*Cluster, int, error)
+	ListClustersFunc                 func(ctx context.Context, p *ListClustersParams) (*ListClustersResponse, error)
+	ReleaseDedicatedClusterFunc      func(ctx context.Context, p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterResponse, error)
+	ReleaseDedicatedClusterAsyncFunc func(ctx context.Context, p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterJob, error)
+	ListDedicatedClustersFunc        func(ctx context.Context, p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error)
+}
+
+var _ ClusterServiceIface = &MockClusterService{}
+
+func (m *MockClusterService) NewAddClusterParams(clustername string, clustertype string, hypervisor string, podid string, zoneid string) *AddClusterParams {
+	return (&ClusterService{}).NewAddClusterParams(clustername, clustertype, hypervisor, podid, zoneid)
+}
+
+func (m *MockClusterService) AddCluster(p *AddClusterParams) (*AddClusterResponse, error) {
+	return m.AddClusterWithContext(context.Background(), p)
+}
+
+func (m *MockClusterService) AddClusterWithContext(ctx context.Context, p *AddClusterParams) (*AddClusterResponse, error) {
+	m.record("AddCluster", p)
+	if m.AddClusterFunc == nil {
+		return nil, notMocked("MockClusterService", "AddCluster")
+	}
+	return m.AddClusterFunc(ctx, p)
+}
+
+func (m *MockClusterService) NewDedicateClusterParams(clusterid string, domainid string) *DedicateClusterParams {
+	return (&ClusterService{}).NewDedicateClusterParams(clusterid, domainid)
+}
+
+func (m *MockClusterService) DedicateCluster(p *DedicateClusterParams) (*DedicateClusterResponse, error) {
+	return m.DedicateClusterWithContext(context.Background(), p)
+}
+
+func (m *MockClusterService) DedicateClusterWithContext(ctx context.Context, p *DedicateClusterParams) (*DedicateClusterResponse, error) {
+	m.record("DedicateCluster", p)
+	if m.DedicateClusterFunc == nil {
+		return nil, notMocked("MockClusterService", "DedicateCluster")
+	}
+	return m.DedicateClusterFunc(ctx, p)
+}
+
+func (m *MockClusterService) DedicateClusterAsync(ctx context.Context, p *DedicateClusterParams) (*DedicateClusterJob, error) {
+	m.record("DedicateClusterAsync", p)
+	if m.DedicateClusterAsyncFunc == nil {
+		return nil, notMocked("MockClusterService", "DedicateClusterAsync")
+	}
+	return m.DedicateClusterAsyncFunc(ctx, p)
+}
+
+func (m *MockClusterService) NewDeleteClusterParams(id string) *DeleteClusterParams {
+	return (&ClusterService{}).NewDeleteClusterParams(id)
+}
+
+func (m *MockClusterService) DeleteCluster(p *DeleteClusterParams) (*DeleteClusterResponse, error) {
+	return m.DeleteClusterWithContext(context.Background(), p)
+}
+
+func (m *MockClusterService) DeleteClusterWithContext(ctx context.Context, p *DeleteClusterParams) (*DeleteClusterResponse, error) {
+	m.record("DeleteCluster", p)
+	if m.DeleteClusterFunc == nil {
+		return nil, notMocked("MockClusterService", "DeleteCluster")
+	}
+	return m.DeleteClusterFunc(ctx, p)
+}
+
+func (m *MockClusterService) NewUpdateClusterParams(id string) *UpdateClusterParams {
+	return (&ClusterService{}).NewUpdateClusterParams(id)
+}
+
+func (m *MockClusterService) UpdateCluster(p *UpdateClusterParams) (*UpdateClusterResponse, error) {
+	return m.UpdateClusterWithContext(context.Background(), p)
+}
+
+func (m *MockClusterService) UpdateClusterWithContext(ctx context.Context, p *UpdateClusterParams) (*UpdateClusterResponse, error) {
+	m.record("UpdateCluster", p)
+	if m.UpdateClusterFunc == nil {
+		return nil, notMocked("MockClusterService", "UpdateCluster")
+	}
+	return m.UpdateClusterFunc(ctx, p)
+}
+
+func (m *MockClusterService) NewListClustersParams() *ListClustersParams {
+	return (&ClusterService{}).NewListClustersParams()
+}
+
+func (m *MockClusterService) GetClusterID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetClusterID", name, opts)
+	if m.GetClusterIDFunc == nil {
+		return "", -1, notMocked("MockClusterService", "GetClusterID")
+	}
+	return m.GetClusterIDFunc(name, opts...)
+}
+
+func (m *MockClusterService) GetClusterByName(name string, opts ...OptionFunc) (*Cluster, int, error) {
+	m.record("GetClusterByName", name, opts)
+	if m.GetClusterByNameFunc == nil {
+		return nil, -1, notMocked("MockClusterService", "GetClusterByName")
+	}
+	return m.GetClusterByNameFunc(name, opts...)
+}
+
+func (m *MockClusterService) GetClusterByID(id string, opts ...OptionFunc) (*Cluster, int, error) {
+	m.record("GetClusterByID", id, opts)
+	if m.GetClusterByIDFunc == nil {
+		return nil, -1, notMocked("MockClusterService", "GetClusterByID")
+	}
+	return m.GetClusterByIDFunc(id, opts...)
+}
+
+func (m *MockClusterService) ListClusters(p *ListClustersParams) (*ListClustersResponse, error) {
+	return m.ListClustersWithContext(context.Background(), p)
+}
+
+func (m *MockClusterService) ListClustersWithContext(ctx context.Context, p *ListClustersParams) (*ListClustersResponse, error) {
+	m.record("ListClusters", p)
+	if m.ListClustersFunc == nil {
+		return nil, notMocked("MockClusterService", "ListClusters")
+	}
+	return m.ListClustersFunc(ctx, p)
+}
+
+func (m *MockClusterService) NewListClustersIterator(p *ListClustersParams, pagesize int) *ListClustersIterator {
+	i := &ListClustersIterator{pager: newPager(nil, "listClusters", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListClustersParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListClustersWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockClusterService) NewReleaseDedicatedClusterParams(clusterid string) *ReleaseDedicatedClusterParams {
+	return (&ClusterService{}).NewReleaseDedicatedClusterParams(clusterid)
+}
+
+func (m *MockClusterService) ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterResponse, error) {
+	return m.ReleaseDedicatedClusterWithContext(context.Background(), p)
+}
+
+func (m *MockClusterService) ReleaseDedicatedClusterWithContext(ctx context.Context, p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterResponse, error) {
+	m.record("ReleaseDedicatedCluster", p)
+	if m.ReleaseDedicatedClusterFunc == nil {
+		return nil, notMocked("MockClusterService", "ReleaseDedicatedCluster")
+	}
+	return m.ReleaseDedicatedClusterFunc(ctx, p)
+}
+
+func (m *MockClusterService) ReleaseDedicatedClusterAsync(ctx context.Context, p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterJob, error) {
+	m.record("ReleaseDedicatedClusterAsync", p)
+	if m.ReleaseDedicatedClusterAsyncFunc == nil {
+		return nil, notMocked("MockClusterService", "ReleaseDedicatedClusterAsync")
+	}
+	return m.ReleaseDedicatedClusterAsyncFunc(ctx, p)
+}
+
+func (m *MockClusterService) NewListDedicatedClustersParams() *ListDedicatedClustersParams {
+	return (&ClusterService{}).NewListDedicatedClustersParams()
+}
+
+func (m *MockClusterService) ListDedicatedClusters(p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error) {
+	return m.ListDedicatedClustersWithContext(context.Background(), p)
+}
+
+func (m *MockClusterService) ListDedicatedClustersWithContext(ctx context.Context, p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error) {
+	m.record("ListDedicatedClusters", p)
+	if m.ListDedicatedClustersFunc == nil {
+		return nil, notMocked("MockClusterService", "ListDedicatedClusters")
+	}
+	return m.ListDedicatedClustersFunc(ctx, p)
+}
+
+func (m *MockClusterService) NewListDedicatedClustersIterator(p *ListDedicatedClustersParams, pagesize int) *ListDedicatedClustersIterator {
+	i := &ListDedicatedClustersIterator{pager: newPager(nil, "listDedicatedClusters", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListDedicatedClustersParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListDedicatedClustersWithContext(ctx, c)
+	}
+	return i
+}
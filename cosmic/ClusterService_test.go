@@ -0,0 +1,177 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestAddClusterParams(t *testing.T) {
+	p := &AddClusterParams{}
+	want := url.Values{}
+	p.SetAllocationstate("allocationstate-value")
+	want.Set("allocationstate", "allocationstate-value")
+	p.SetClustername("clustername-value")
+	want.Set("clustername", "clustername-value")
+	p.SetClustertype("clustertype-value")
+	want.Set("clustertype", "clustertype-value")
+	p.SetHypervisor("hypervisor-value")
+	want.Set("hypervisor", "hypervisor-value")
+	p.SetPassword("password-value")
+	want.Set("password", "password-value")
+	p.SetPodid("podid-value")
+	want.Set("podid", "podid-value")
+	p.SetUrl("url-value")
+	want.Set("url", "url-value")
+	p.SetUsername("username-value")
+	want.Set("username", "username-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "addCluster", want)
+}
+
+func TestAddClusterResponse(t *testing.T) {
+	assertDecodes(t, `{"allocationstate":"allocationstate-value","capacity":[{"capacitytotal":1099511627776,"capacityused":1099511627776,"clusterid":"clusterid-value","clustername":"clustername-value","percentageallocated":"percentageallocated-value","podid":"podid-value","podname":"podname-value","type":42,"zoneid":"zoneid-value","zonename":"zonename-value"}],"clustertype":"clustertype-value","cpuovercommitratio":"cpuovercommitratio-value","hypervisortype":"hypervisortype-value","id":"id-value","managedstate":"managedstate-value","memoryovercommitratio":"memoryovercommitratio-value","name":"name-value","podid":"podid-value","podname":"podname-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &AddClusterResponse{})
+}
+
+func TestDedicateClusterParams(t *testing.T) {
+	p := &DedicateClusterParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetClusterid("clusterid-value")
+	want.Set("clusterid", "clusterid-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+
+	assertParams(t, p, "dedicateCluster", want)
+}
+
+func TestDedicateClusterResponse(t *testing.T) {
+	assertDecodes(t, `{"accountid":"accountid-value","accountname":"accountname-value","affinitygroupid":"affinitygroupid-value","clusterid":"clusterid-value","clustername":"clustername-value","domainid":"domainid-value","domainname":"domainname-value","id":"id-value","jobid":"jobid-value"}`, &DedicateClusterResponse{})
+}
+
+func TestDeleteClusterParams(t *testing.T) {
+	p := &DeleteClusterParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteCluster", want)
+}
+
+func TestDeleteClusterResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &DeleteClusterResponse{})
+}
+
+func TestUpdateClusterParams(t *testing.T) {
+	p := &UpdateClusterParams{}
+	want := url.Values{}
+	p.SetAllocationstate("allocationstate-value")
+	want.Set("allocationstate", "allocationstate-value")
+	p.SetClustername("clustername-value")
+	want.Set("clustername", "clustername-value")
+	p.SetClustertype("clustertype-value")
+	want.Set("clustertype", "clustertype-value")
+	p.SetHypervisor("hypervisor-value")
+	want.Set("hypervisor", "hypervisor-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetManagedstate("managedstate-value")
+	want.Set("managedstate", "managedstate-value")
+
+	assertParams(t, p, "updateCluster", want)
+}
+
+func TestUpdateClusterResponse(t *testing.T) {
+	assertDecodes(t, `{"allocationstate":"allocationstate-value","capacity":[{"capacitytotal":1099511627776,"capacityused":1099511627776,"clusterid":"clusterid-value","clustername":"clustername-value","percentageallocated":"percentageallocated-value","podid":"podid-value","podname":"podname-value","type":42,"zoneid":"zoneid-value","zonename":"zonename-value"}],"clustertype":"clustertype-value","cpuovercommitratio":"cpuovercommitratio-value","hypervisortype":"hypervisortype-value","id":"id-value","managedstate":"managedstate-value","memoryovercommitratio":"memoryovercommitratio-value","name":"name-value","podid":"podid-value","podname":"podname-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &UpdateClusterResponse{})
+}
+
+func TestListClustersParams(t *testing.T) {
+	p := &ListClustersParams{}
+	want := url.Values{}
+	p.SetAllocationstate("allocationstate-value")
+	want.Set("allocationstate", "allocationstate-value")
+	p.SetClustertype("clustertype-value")
+	want.Set("clustertype", "clustertype-value")
+	p.SetHypervisor("hypervisor-value")
+	want.Set("hypervisor", "hypervisor-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetManagedstate("managedstate-value")
+	want.Set("managedstate", "managedstate-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetPodid("podid-value")
+	want.Set("podid", "podid-value")
+	p.SetShowcapacities(true)
+	want.Set("showcapacities", "true")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listClusters", want)
+}
+
+func TestListClustersResponse(t *testing.T) {
+	assertDecodes(t, `{"cluster":[{"allocationstate":"allocationstate-value","capacity":[{"capacitytotal":1099511627776,"capacityused":1099511627776,"clusterid":"clusterid-value","clustername":"clustername-value","percentageallocated":"percentageallocated-value","podid":"podid-value","podname":"podname-value","type":42,"zoneid":"zoneid-value","zonename":"zonename-value"}],"clustertype":"clustertype-value","cpuovercommitratio":"cpuovercommitratio-value","hypervisortype":"hypervisortype-value","id":"id-value","managedstate":"managedstate-value","memoryovercommitratio":"memoryovercommitratio-value","name":"name-value","podid":"podid-value","podname":"podname-value","zoneid":"zoneid-value","zonename":"zonename-value"}],"count":1}`, &ListClustersResponse{})
+}
+
+func TestReleaseDedicatedClusterParams(t *testing.T) {
+	p := &ReleaseDedicatedClusterParams{}
+	want := url.Values{}
+	p.SetClusterid("clusterid-value")
+	want.Set("clusterid", "clusterid-value")
+
+	assertParams(t, p, "releaseDedicatedCluster", want)
+}
+
+func TestReleaseDedicatedClusterResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &ReleaseDedicatedClusterResponse{})
+}
+
+func TestListDedicatedClustersParams(t *testing.T) {
+	p := &ListDedicatedClustersParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetAffinitygroupid("affinitygroupid-value")
+	want.Set("affinitygroupid", "affinitygroupid-value")
+	p.SetClusterid("clusterid-value")
+	want.Set("clusterid", "clusterid-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+
+	assertParams(t, p, "listDedicatedClusters", want)
+}
+
+func TestListDedicatedClustersResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"dedicatedcluster":[{"accountid":"accountid-value","accountname":"accountname-value","affinitygroupid":"affinitygroupid-value","clusterid":"clusterid-value","clustername":"clustername-value","domainid":"domainid-value","domainname":"domainname-value","id":"id-value"}]}`, &ListDedicatedClustersResponse{})
+}
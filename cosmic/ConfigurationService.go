@@ -17,15 +17,38 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
 )
 
+// ConfigurationServiceIface is the interface implemented by ConfigurationService, and by MockConfigurationService for tests
+type ConfigurationServiceIface interface {
+	NewListCapabilitiesParams() *ListCapabilitiesParams
+	ListCapabilities(p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error)
+	ListCapabilitiesWithContext(ctx context.Context, p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error)
+	NewUpdateConfigurationParams(name string) *UpdateConfigurationParams
+	UpdateConfiguration(p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error)
+	UpdateConfigurationWithContext(ctx context.Context, p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error)
+	NewListConfigurationsParams() *ListConfigurationsParams
+	ListConfigurations(p *ListConfigurationsParams) (*ListConfigurationsResponse, error)
+	ListConfigurationsWithContext(ctx context.Context, p *ListConfigurationsParams) (*ListConfigurationsResponse, error)
+	NewListConfigurationsIterator(p *ListConfigurationsParams, pagesize int) *ListConfigurationsIterator
+	NewListDeploymentPlannersParams() *ListDeploymentPlannersParams
+	ListDeploymentPlanners(p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error)
+	ListDeploymentPlannersWithContext(ctx context.Context, p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error)
+	NewListDeploymentPlannersIterator(p *ListDeploymentPlannersParams, pagesize int) *ListDeploymentPlannersIterator
+}
+
 type ListCapabilitiesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListCapabilitiesParams) command() string {
+	return "listCapabilities"
+}
+
 func (p *ListCapabilitiesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -44,7 +67,13 @@ func (s *ConfigurationService) NewListCapabilitiesParams() *ListCapabilitiesPara
 
 // Lists capabilities
 func (s *ConfigurationService) ListCapabilities(p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error) {
-	resp, err := s.cs.newRequest("listCapabilities", p.toURLValues())
+	return s.ListCapabilitiesWithContext(context.Background(), p)
+}
+
+// ListCapabilitiesWithContext is the same as ListCapabilities, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ConfigurationService) ListCapabilitiesWithContext(ctx context.Context, p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "listCapabilities", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +113,10 @@ type UpdateConfigurationParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateConfigurationParams) command() string {
+	return "updateConfiguration"
+}
+
 func (p *UpdateConfigurationParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -163,7 +196,13 @@ func (s *ConfigurationService) NewUpdateConfigurationParams(name string) *Update
 
 // Updates a configuration.
 func (s *ConfigurationService) UpdateConfiguration(p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error) {
-	resp, err := s.cs.newRequest("updateConfiguration", p.toURLValues())
+	return s.UpdateConfigurationWithContext(context.Background(), p)
+}
+
+// UpdateConfigurationWithContext is the same as UpdateConfiguration, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ConfigurationService) UpdateConfigurationWithContext(ctx context.Context, p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateConfiguration", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -188,6 +227,10 @@ type ListConfigurationsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListConfigurationsParams) command() string {
+	return "listConfigurations"
+}
+
 func (p *ListConfigurationsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -298,10 +341,17 @@ func (s *ConfigurationService) NewListConfigurationsParams() *ListConfigurations
 
 // Lists all configurations.
 func (s *ConfigurationService) ListConfigurations(p *ListConfigurationsParams) (*ListConfigurationsResponse, error) {
+	return s.ListConfigurationsWithContext(context.Background(), p)
+}
+
+// ListConfigurationsWithContext is the same as ListConfigurations, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ConfigurationService) ListConfigurationsWithContext(ctx context.Context, p *ListConfigurationsParams) (*ListConfigurationsResponse, error) {
 	var r ListConfigurationsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListConfigurationsResponse
-		resp, err := s.cs.newRequest("listConfigurations", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listConfigurations", values)
 		if err != nil {
 			return nil, err
 		}
@@ -313,13 +363,89 @@ func (s *ConfigurationService) ListConfigurations(p *ListConfigurationsParams) (
 		r.Count = l.Count
 		r.Configurations = append(r.Configurations, l.Configurations...)
 
-		if r.Count == len(r.Configurations) {
+		if r.Count == len(r.Configurations) || len(l.Configurations) == 0 {
+			s.cs.observePages("listConfigurations", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.Configurations))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.Configurations)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listConfigurations", values, len(l.Configurations), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListConfigurationsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.Configurations = append(r.Configurations, l.Configurations...)
+			}
+
+			if r.Count == len(r.Configurations) {
+				s.cs.observePages("listConfigurations", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListConfigurationsIterator iterates over the items returned by listConfigurations, requesting
+// the pages of the response one at a time while iterating.
+type ListConfigurationsIterator struct {
+	pager
+	items []*Configuration
+	index int
+}
+
+// NewListConfigurationsIterator returns an iterator over the items returned by listConfigurations, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *ConfigurationService) NewListConfigurationsIterator(p *ListConfigurationsParams, pagesize int) *ListConfigurationsIterator {
+	return &ListConfigurationsIterator{pager: newPager(s.cs, "listConfigurations", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListConfigurationsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListConfigurationsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
 	}
+	i.received(l.Count, len(l.Configurations))
+
+	i.items = l.Configurations
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListConfigurationsIterator) Value() *Configuration {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
 }
 
 type ListConfigurationsResponse struct {
@@ -340,6 +466,10 @@ type ListDeploymentPlannersParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListDeploymentPlannersParams) command() string {
+	return "listDeploymentPlanners"
+}
+
 func (p *ListDeploymentPlannersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -390,10 +520,17 @@ func (s *ConfigurationService) NewListDeploymentPlannersParams() *ListDeployment
 
 // Lists all DeploymentPlanners available.
 func (s *ConfigurationService) ListDeploymentPlanners(p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error) {
+	return s.ListDeploymentPlannersWithContext(context.Background(), p)
+}
+
+// ListDeploymentPlannersWithContext is the same as ListDeploymentPlanners, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ConfigurationService) ListDeploymentPlannersWithContext(ctx context.Context, p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error) {
 	var r ListDeploymentPlannersResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListDeploymentPlannersResponse
-		resp, err := s.cs.newRequest("listDeploymentPlanners", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listDeploymentPlanners", values)
 		if err != nil {
 			return nil, err
 		}
@@ -405,13 +542,89 @@ func (s *ConfigurationService) ListDeploymentPlanners(p *ListDeploymentPlannersP
 		r.Count = l.Count
 		r.DeploymentPlanners = append(r.DeploymentPlanners, l.DeploymentPlanners...)
 
-		if r.Count == len(r.DeploymentPlanners) {
+		if r.Count == len(r.DeploymentPlanners) || len(l.DeploymentPlanners) == 0 {
+			s.cs.observePages("listDeploymentPlanners", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.DeploymentPlanners))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.DeploymentPlanners)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listDeploymentPlanners", values, len(l.DeploymentPlanners), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListDeploymentPlannersResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.DeploymentPlanners = append(r.DeploymentPlanners, l.DeploymentPlanners...)
+			}
+
+			if r.Count == len(r.DeploymentPlanners) {
+				s.cs.observePages("listDeploymentPlanners", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListDeploymentPlannersIterator iterates over the items returned by listDeploymentPlanners, requesting
+// the pages of the response one at a time while iterating.
+type ListDeploymentPlannersIterator struct {
+	pager
+	items []*DeploymentPlanner
+	index int
+}
+
+// NewListDeploymentPlannersIterator returns an iterator over the items returned by listDeploymentPlanners, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *ConfigurationService) NewListDeploymentPlannersIterator(p *ListDeploymentPlannersParams, pagesize int) *ListDeploymentPlannersIterator {
+	return &ListDeploymentPlannersIterator{pager: newPager(s.cs, "listDeploymentPlanners", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListDeploymentPlannersIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListDeploymentPlannersResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.DeploymentPlanners))
+
+	i.items = l.DeploymentPlanners
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListDeploymentPlannersIterator) Value() *DeploymentPlanner {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListDeploymentPlannersResponse struct {
@@ -0,0 +1,125 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockConfigurationService is a mock of ConfigurationServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockConfigurationService struct {
+	mockCalls
+
+	ListCapabilitiesFunc       func(ctx context.Context, p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error)
+	UpdateConfigurationFunc    func(ctx context.Context, p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error)
+	ListConfigurationsFunc     func(ctx context.Context, p *ListConfigurationsParams) (*ListConfigurationsResponse, error)
+	ListDeploymentPlannersFunc func(ctx context.Context, p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error)
+}
+
+var _ ConfigurationServiceIface = &MockConfigurationService{}
+
+func (m *MockConfigurationService) NewListCapabilitiesParams() *ListCapabilitiesParams {
+	return (&ConfigurationService{}).NewListCapabilitiesParams()
+}
+
+func (m *MockConfigurationService) ListCapabilities(p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error) {
+	return m.ListCapabilitiesWithContext(context.Background(), p)
+}
+
+func (m *MockConfigurationService) ListCapabilitiesWithContext(ctx context.Context, p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error) {
+	m.record("ListCapabilities", p)
+	if m.ListCapabilitiesFunc == nil {
+		return nil, notMocked("MockConfigurationService", "ListCapabilities")
+	}
+	return m.ListCapabilitiesFunc(ctx, p)
+}
+
+func (m *MockConfigurationService) NewUpdateConfigurationParams(name string) *UpdateConfigurationParams {
+	return (&ConfigurationService{}).NewUpdateConfigurationParams(name)
+}
+
+func (m *MockConfigurationService) UpdateConfiguration(p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error) {
+	return m.UpdateConfigurationWithContext(context.Background(), p)
+}
+
+func (m *MockConfigurationService) UpdateConfigurationWithContext(ctx context.Context, p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error) {
+	m.record("UpdateConfiguration", p)
+	if m.UpdateConfigurationFunc == nil {
+		return nil, notMocked("MockConfigurationService", "UpdateConfiguration")
+	}
+	return m.UpdateConfigurationFunc(ctx, p)
+}
+
+func (m *MockConfigurationService) NewListConfigurationsParams() *ListConfigurationsParams {
+	return (&ConfigurationService{}).NewListConfigurationsParams()
+}
+
+func (m *MockConfigurationService) ListConfigurations(p *ListConfigurationsParams) (*ListConfigurationsResponse, error) {
+	return m.ListConfigurationsWithContext(context.Background(), p)
+}
+
+func (m *MockConfigurationService) ListConfigurationsWithContext(ctx context.Context, p *ListConfigurationsParams) (*ListConfigurationsResponse, error) {
+	m.record("ListConfigurations", p)
+	if m.ListConfigurationsFunc == nil {
+		return nil, notMocked("MockConfigurationService", "ListConfigurations")
+	}
+	return m.ListConfigurationsFunc(ctx, p)
+}
+
+func (m *MockConfigurationService) NewListConfigurationsIterator(p *ListConfigurationsParams, pagesize int) *ListConfigurationsIterator {
+	i := &ListConfigurationsIterator{pager: newPager(nil, "listConfigurations", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListConfigurationsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListConfigurationsWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockConfigurationService) NewListDeploymentPlannersParams() *ListDeploymentPlannersParams {
+	return (&ConfigurationService{}).NewListDeploymentPlannersParams()
+}
+
+func (m *MockConfigurationService) ListDeploymentPlanners(p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error) {
+	return m.ListDeploymentPlannersWithContext(context.Background(), p)
+}
+
+func (m *MockConfigurationService) ListDeploymentPlannersWithContext(ctx context.Context, p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error) {
+	m.record("ListDeploymentPlanners", p)
+	if m.ListDeploymentPlannersFunc == nil {
+		return nil, notMocked("MockConfigurationService", "ListDeploymentPlanners")
+	}
+	return m.ListDeploymentPlannersFunc(ctx, p)
+}
+
+func (m *MockConfigurationService) NewListDeploymentPlannersIterator(p *ListDeploymentPlannersParams, pagesize int) *ListDeploymentPlannersIterator {
+	i := &ListDeploymentPlannersIterator{pager: newPager(nil, "listDeploymentPlanners", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListDeploymentPlannersParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListDeploymentPlannersWithContext(ctx, c)
+	}
+	return i
+}
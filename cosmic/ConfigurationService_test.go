@@ -0,0 +1,102 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestListCapabilitiesParams(t *testing.T) {
+	p := &ListCapabilitiesParams{}
+	want := url.Values{}
+
+	assertParams(t, p, "listCapabilities", want)
+}
+
+func TestListCapabilitiesResponse(t *testing.T) {
+	assertDecodes(t, `{"capability":{"allowusercreateprojects":true,"allowuserexpungerecovervm":true,"allowuserviewdestroyedvm":true,"apilimitinterval":42,"apilimitmax":42,"cloudstackversion":"cloudstackversion-value","cosmic":true,"customdiskofferingmaxsize":1099511627776,"customdiskofferingminsize":1099511627776,"kvmdeploymentsenabled":true,"kvmsnapshotenabled":true,"projectinviterequired":true,"regionsecondaryenabled":true,"supportELB":"supportELB-value","userpublictemplateenabled":true,"xenserverdeploymentsenabled":true},"count":1}`, &ListCapabilitiesResponse{})
+}
+
+func TestUpdateConfigurationParams(t *testing.T) {
+	p := &UpdateConfigurationParams{}
+	want := url.Values{}
+	p.SetAccountid("accountid-value")
+	want.Set("accountid", "accountid-value")
+	p.SetClusterid("clusterid-value")
+	want.Set("clusterid", "clusterid-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetStorageid("storageid-value")
+	want.Set("storageid", "storageid-value")
+	p.SetValue("value-value")
+	want.Set("value", "value-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "updateConfiguration", want)
+}
+
+func TestUpdateConfigurationResponse(t *testing.T) {
+	assertDecodes(t, `{"category":"category-value","description":"description-value","id":1099511627776,"name":"name-value","scope":"scope-value","value":"value-value"}`, &UpdateConfigurationResponse{})
+}
+
+func TestListConfigurationsParams(t *testing.T) {
+	p := &ListConfigurationsParams{}
+	want := url.Values{}
+	p.SetAccountid("accountid-value")
+	want.Set("accountid", "accountid-value")
+	p.SetCategory("category-value")
+	want.Set("category", "category-value")
+	p.SetClusterid("clusterid-value")
+	want.Set("clusterid", "clusterid-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetStorageid("storageid-value")
+	want.Set("storageid", "storageid-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listConfigurations", want)
+}
+
+func TestListConfigurationsResponse(t *testing.T) {
+	assertDecodes(t, `{"configuration":[{"category":"category-value","description":"description-value","id":1099511627776,"name":"name-value","scope":"scope-value","value":"value-value"}],"count":1}`, &ListConfigurationsResponse{})
+}
+
+func TestListDeploymentPlannersParams(t *testing.T) {
+	p := &ListDeploymentPlannersParams{}
+	want := url.Values{}
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+
+	assertParams(t, p, "listDeploymentPlanners", want)
+}
+
+func TestListDeploymentPlannersResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"deploymentplanner":[{"name":"name-value"}]}`, &ListDeploymentPlannersResponse{})
+}
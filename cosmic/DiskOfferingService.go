@@ -17,17 +17,42 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
+// DiskOfferingServiceIface is the interface implemented by DiskOfferingService, and by MockDiskOfferingService for tests
+type DiskOfferingServiceIface interface {
+	NewCreateDiskOfferingParams(displaytext string, name string) *CreateDiskOfferingParams
+	CreateDiskOffering(p *CreateDiskOfferingParams) (*CreateDiskOfferingResponse, error)
+	CreateDiskOfferingWithContext(ctx context.Context, p *CreateDiskOfferingParams) (*CreateDiskOfferingResponse, error)
+	NewDeleteDiskOfferingParams(id string) *DeleteDiskOfferingParams
+	DeleteDiskOffering(p *DeleteDiskOfferingParams) (*DeleteDiskOfferingResponse, error)
+	DeleteDiskOfferingWithContext(ctx context.Context, p *DeleteDiskOfferingParams) (*DeleteDiskOfferingResponse, error)
+	NewUpdateDiskOfferingParams(id string) *UpdateDiskOfferingParams
+	UpdateDiskOffering(p *UpdateDiskOfferingParams) (*UpdateDiskOfferingResponse, error)
+	UpdateDiskOfferingWithContext(ctx context.Context, p *UpdateDiskOfferingParams) (*UpdateDiskOfferingResponse, error)
+	NewListDiskOfferingsParams() *ListDiskOfferingsParams
+	GetDiskOfferingID(name string, opts ...OptionFunc) (string, int, error)
+	GetDiskOfferingByName(name string, opts ...OptionFunc) (*DiskOffering, int, error)
+	GetDiskOfferingByID(id string, opts ...OptionFunc) (*DiskOffering, int, error)
+	ListDiskOfferings(p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error)
+	ListDiskOfferingsWithContext(ctx context.Context, p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error)
+	NewListDiskOfferingsIterator(p *ListDiskOfferingsParams, pagesize int) *ListDiskOfferingsIterator
+}
+
 type CreateDiskOfferingParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateDiskOfferingParams) command() string {
+	return "createDiskOffering"
+}
+
 func (p *CreateDiskOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -251,7 +276,13 @@ func (s *DiskOfferingService) NewCreateDiskOfferingParams(displaytext string, na
 
 // Creates a disk offering.
 func (s *DiskOfferingService) CreateDiskOffering(p *CreateDiskOfferingParams) (*CreateDiskOfferingResponse, error) {
-	resp, err := s.cs.newRequest("createDiskOffering", p.toURLValues())
+	return s.CreateDiskOfferingWithContext(context.Background(), p)
+}
+
+// CreateDiskOfferingWithContext is the same as CreateDiskOffering, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *DiskOfferingService) CreateDiskOfferingWithContext(ctx context.Context, p *CreateDiskOfferingParams) (*CreateDiskOfferingResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createDiskOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -293,6 +324,10 @@ type DeleteDiskOfferingParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteDiskOfferingParams) command() string {
+	return "deleteDiskOffering"
+}
+
 func (p *DeleteDiskOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -322,7 +357,13 @@ func (s *DiskOfferingService) NewDeleteDiskOfferingParams(id string) *DeleteDisk
 
 // Updates a disk offering.
 func (s *DiskOfferingService) DeleteDiskOffering(p *DeleteDiskOfferingParams) (*DeleteDiskOfferingResponse, error) {
-	resp, err := s.cs.newRequest("deleteDiskOffering", p.toURLValues())
+	return s.DeleteDiskOfferingWithContext(context.Background(), p)
+}
+
+// DeleteDiskOfferingWithContext is the same as DeleteDiskOffering, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *DiskOfferingService) DeleteDiskOfferingWithContext(ctx context.Context, p *DeleteDiskOfferingParams) (*DeleteDiskOfferingResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteDiskOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -343,6 +384,10 @@ type UpdateDiskOfferingParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateDiskOfferingParams) command() string {
+	return "updateDiskOffering"
+}
+
 func (p *UpdateDiskOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -414,7 +459,13 @@ func (s *DiskOfferingService) NewUpdateDiskOfferingParams(id string) *UpdateDisk
 
 // Updates a disk offering.
 func (s *DiskOfferingService) UpdateDiskOffering(p *UpdateDiskOfferingParams) (*UpdateDiskOfferingResponse, error) {
-	resp, err := s.cs.newRequest("updateDiskOffering", p.toURLValues())
+	return s.UpdateDiskOfferingWithContext(context.Background(), p)
+}
+
+// UpdateDiskOfferingWithContext is the same as UpdateDiskOffering, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *DiskOfferingService) UpdateDiskOfferingWithContext(ctx context.Context, p *UpdateDiskOfferingParams) (*UpdateDiskOfferingResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateDiskOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -456,6 +507,10 @@ type ListDiskOfferingsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListDiskOfferingsParams) command() string {
+	return "listDiskOfferings"
+}
+
 func (p *ListDiskOfferingsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -575,7 +630,7 @@ func (s *DiskOfferingService) GetDiskOfferingID(name string, opts ...OptionFunc)
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -589,7 +644,7 @@ func (s *DiskOfferingService) GetDiskOfferingID(name string, opts ...OptionFunc)
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -621,16 +676,14 @@ func (s *DiskOfferingService) GetDiskOfferingByID(id string, opts ...OptionFunc)
 
 	l, err := s.ListDiskOfferings(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -642,10 +695,17 @@ func (s *DiskOfferingService) GetDiskOfferingByID(id string, opts ...OptionFunc)
 
 // Lists all available disk offerings.
 func (s *DiskOfferingService) ListDiskOfferings(p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error) {
+	return s.ListDiskOfferingsWithContext(context.Background(), p)
+}
+
+// ListDiskOfferingsWithContext is the same as ListDiskOfferings, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *DiskOfferingService) ListDiskOfferingsWithContext(ctx context.Context, p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error) {
 	var r ListDiskOfferingsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListDiskOfferingsResponse
-		resp, err := s.cs.newRequest("listDiskOfferings", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listDiskOfferings", values)
 		if err != nil {
 			return nil, err
 		}
@@ -657,13 +717,89 @@ func (s *DiskOfferingService) ListDiskOfferings(p *ListDiskOfferingsParams) (*Li
 		r.Count = l.Count
 		r.DiskOfferings = append(r.DiskOfferings, l.DiskOfferings...)
 
-		if r.Count == len(r.DiskOfferings) {
+		if r.Count == len(r.DiskOfferings) || len(l.DiskOfferings) == 0 {
+			s.cs.observePages("listDiskOfferings", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.DiskOfferings))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.DiskOfferings)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listDiskOfferings", values, len(l.DiskOfferings), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListDiskOfferingsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.DiskOfferings = append(r.DiskOfferings, l.DiskOfferings...)
+			}
+
+			if r.Count == len(r.DiskOfferings) {
+				s.cs.observePages("listDiskOfferings", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListDiskOfferingsIterator iterates over the items returned by listDiskOfferings, requesting
+// the pages of the response one at a time while iterating.
+type ListDiskOfferingsIterator struct {
+	pager
+	items []*DiskOffering
+	index int
+}
+
+// NewListDiskOfferingsIterator returns an iterator over the items returned by listDiskOfferings, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *DiskOfferingService) NewListDiskOfferingsIterator(p *ListDiskOfferingsParams, pagesize int) *ListDiskOfferingsIterator {
+	return &ListDiskOfferingsIterator{pager: newPager(s.cs, "listDiskOfferings", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListDiskOfferingsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListDiskOfferingsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.DiskOfferings))
+
+	i.items = l.DiskOfferings
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListDiskOfferingsIterator) Value() *DiskOffering {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListDiskOfferingsResponse struct {
@@ -0,0 +1,138 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockDiskOfferingService is a mock of DiskOfferingServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockDiskOfferingService struct {
+	mockCalls
+
+	CreateDiskOfferingFunc    func(ctx context.Context, p *CreateDiskOfferingParams) (*CreateDiskOfferingResponse, error)
+	DeleteDiskOfferingFunc    func(ctx context.Context, p *DeleteDiskOfferingParams) (*DeleteDiskOfferingResponse, error)
+	UpdateDiskOfferingFunc    func(ctx context.Context, p *UpdateDiskOfferingParams) (*UpdateDiskOfferingResponse, error)
+	GetDiskOfferingIDFunc     func(name string, opts ...OptionFunc) (string, int, error)
+	GetDiskOfferingByNameFunc func(name string, opts ...OptionFunc) (*DiskOffering, int, error)
+	GetDiskOfferingByIDFunc   func(id string, opts ...OptionFunc) (*DiskOffering, int, error)
+	ListDiskOfferingsFunc     func(ctx context.Context, p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error)
+}
+
+var _ DiskOfferingServiceIface = &MockDiskOfferingService{}
+
+func (m *MockDiskOfferingService) NewCreateDiskOfferingParams(displaytext string, name string) *CreateDiskOfferingParams {
+	return (&DiskOfferingService{}).NewCreateDiskOfferingParams(displaytext, name)
+}
+
+func (m *MockDiskOfferingService) CreateDiskOffering(p *CreateDiskOfferingParams) (*CreateDiskOfferingResponse, error) {
+	return m.CreateDiskOfferingWithContext(context.Background(), p)
+}
+
+func (m *MockDiskOfferingService) CreateDiskOfferingWithContext(ctx context.Context, p *CreateDiskOfferingParams) (*CreateDiskOfferingResponse, error) {
+	m.record("CreateDiskOffering", p)
+	if m.CreateDiskOfferingFunc == nil {
+		return nil, notMocked("MockDiskOfferingService", "CreateDiskOffering")
+	}
+	return m.CreateDiskOfferingFunc(ctx, p)
+}
+
+func (m *MockDiskOfferingService) NewDeleteDiskOfferingParams(id string) *DeleteDiskOfferingParams {
+	return (&DiskOfferingService{}).NewDeleteDiskOfferingParams(id)
+}
+
+func (m *MockDiskOfferingService) DeleteDiskOffering(p *DeleteDiskOfferingParams) (*DeleteDiskOfferingResponse, error) {
+	return m.DeleteDiskOfferingWithContext(context.Background(), p)
+}
+
+func (m *MockDiskOfferingService) DeleteDiskOfferingWithContext(ctx context.Context, p *DeleteDiskOfferingParams) (*DeleteDiskOfferingResponse, error) {
+	m.record("DeleteDiskOffering", p)
+	if m.DeleteDiskOfferingFunc == nil {
+		return nil, notMocked("MockDiskOfferingService", "DeleteDiskOffering")
+	}
+	return m.DeleteDiskOfferingFunc(ctx, p)
+}
+
+func (m *MockDiskOfferingService) NewUpdateDiskOfferingParams(id string) *UpdateDiskOfferingParams {
+	return (&DiskOfferingService{}).NewUpdateDiskOfferingParams(id)
+}
+
+func (m *MockDiskOfferingService) UpdateDiskOffering(p *UpdateDiskOfferingParams) (*UpdateDiskOfferingResponse, error) {
+	return m.UpdateDiskOfferingWithContext(context.Background(), p)
+}
+
+func (m *MockDiskOfferingService) UpdateDiskOfferingWithContext(ctx context.Context, p *UpdateDiskOfferingParams) (*UpdateDiskOfferingResponse, error) {
+	m.record("UpdateDiskOffering", p)
+	if m.UpdateDiskOfferingFunc == nil {
+		return nil, notMocked("MockDiskOfferingService", "UpdateDiskOffering")
+	}
+	return m.UpdateDiskOfferingFunc(ctx, p)
+}
+
+func (m *MockDiskOfferingService) NewListDiskOfferingsParams() *ListDiskOfferingsParams {
+	return (&DiskOfferingService{}).NewListDiskOfferingsParams()
+}
+
+func (m *MockDiskOfferingService) GetDiskOfferingID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetDiskOfferingID", name, opts)
+	if m.GetDiskOfferingIDFunc == nil {
+		return "", -1, notMocked("MockDiskOfferingService", "GetDiskOfferingID")
+	}
+	return m.GetDiskOfferingIDFunc(name, opts...)
+}
+
+func (m *MockDiskOfferingService) GetDiskOfferingByName(name string, opts ...OptionFunc) (*DiskOffering, int, error) {
+	m.record("GetDiskOfferingByName", name, opts)
+	if m.GetDiskOfferingByNameFunc == nil {
+		return nil, -1, notMocked("MockDiskOfferingService", "GetDiskOfferingByName")
+	}
+	return m.GetDiskOfferingByNameFunc(name, opts...)
+}
+
+func (m *MockDiskOfferingService) GetDiskOfferingByID(id string, opts ...OptionFunc) (*DiskOffering, int, error) {
+	m.record("GetDiskOfferingByID", id, opts)
+	if m.GetDiskOfferingByIDFunc == nil {
+		return nil, -1, notMocked("MockDiskOfferingService", "GetDiskOfferingByID")
+	}
+	return m.GetDiskOfferingByIDFunc(id, opts...)
+}
+
+func (m *MockDiskOfferingService) ListDiskOfferings(p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error) {
+	return m.ListDiskOfferingsWithContext(context.Background(), p)
+}
+
+func (m *MockDiskOfferingService) ListDiskOfferingsWithContext(ctx context.Context, p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error) {
+	m.record("ListDiskOfferings", p)
+	if m.ListDiskOfferingsFunc == nil {
+		return nil, notMocked("MockDiskOfferingService", "ListDiskOfferings")
+	}
+	return m.ListDiskOfferingsFunc(ctx, p)
+}
+
+func (m *MockDiskOfferingService) NewListDiskOfferingsIterator(p *ListDiskOfferingsParams, pagesize int) *ListDiskOfferingsIterator {
+	i := &ListDiskOfferingsIterator{pager: newPager(nil, "listDiskOfferings", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListDiskOfferingsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListDiskOfferingsWithContext(ctx, c)
+	}
+	return i
+}
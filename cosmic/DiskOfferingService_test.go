@@ -0,0 +1,132 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCreateDiskOfferingParams(t *testing.T) {
+	p := &CreateDiskOfferingParams{}
+	want := url.Values{}
+	p.SetBytesreadrate(int64(1) << 40)
+	want.Set("bytesreadrate", "1099511627776")
+	p.SetByteswriterate(int64(1) << 40)
+	want.Set("byteswriterate", "1099511627776")
+	p.SetCustomized(true)
+	want.Set("customized", "true")
+	p.SetCustomizediops(true)
+	want.Set("customizediops", "true")
+	p.SetDisksize(int64(1) << 40)
+	want.Set("disksize", "1099511627776")
+	p.SetDisplayoffering(true)
+	want.Set("displayoffering", "true")
+	p.SetDisplaytext("displaytext-value")
+	want.Set("displaytext", "displaytext-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetHypervisorsnapshotreserve(42)
+	want.Set("hypervisorsnapshotreserve", "42")
+	p.SetIopsratepergb(true)
+	want.Set("iopsratepergb", "true")
+	p.SetIopsreadrate(int64(1) << 40)
+	want.Set("iopsreadrate", "1099511627776")
+	p.SetIopstotalrate(int64(1) << 40)
+	want.Set("iopstotalrate", "1099511627776")
+	p.SetIopswriterate(int64(1) << 40)
+	want.Set("iopswriterate", "1099511627776")
+	p.SetMaxiops(int64(1) << 40)
+	want.Set("maxiops", "1099511627776")
+	p.SetMiniops(int64(1) << 40)
+	want.Set("miniops", "1099511627776")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetProvisioningtype("provisioningtype-value")
+	want.Set("provisioningtype", "provisioningtype-value")
+	p.SetStoragetype("storagetype-value")
+	want.Set("storagetype", "storagetype-value")
+	p.SetTags("tags-value")
+	want.Set("tags", "tags-value")
+
+	assertParams(t, p, "createDiskOffering", want)
+}
+
+func TestCreateDiskOfferingResponse(t *testing.T) {
+	assertDecodes(t, `{"cacheMode":"cacheMode-value","created":"created-value","diskBytesReadRate":1099511627776,"diskBytesWriteRate":1099511627776,"diskIopsRatePerGb":true,"diskIopsReadRate":1099511627776,"diskIopsTotalRate":1099511627776,"diskIopsWriteRate":1099511627776,"disksize":1099511627776,"displayoffering":true,"displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","hypervisorsnapshotreserve":42,"id":"id-value","iscustomized":true,"iscustomizediops":true,"maxiops":1099511627776,"miniops":1099511627776,"name":"name-value","provisioningtype":"provisioningtype-value","storagetype":"storagetype-value","tags":"tags-value"}`, &CreateDiskOfferingResponse{})
+}
+
+func TestDeleteDiskOfferingParams(t *testing.T) {
+	p := &DeleteDiskOfferingParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteDiskOffering", want)
+}
+
+func TestDeleteDiskOfferingResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &DeleteDiskOfferingResponse{})
+}
+
+func TestUpdateDiskOfferingParams(t *testing.T) {
+	p := &UpdateDiskOfferingParams{}
+	want := url.Values{}
+	p.SetDisplayoffering(true)
+	want.Set("displayoffering", "true")
+	p.SetDisplaytext("displaytext-value")
+	want.Set("displaytext", "displaytext-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetSortkey(42)
+	want.Set("sortkey", "42")
+
+	assertParams(t, p, "updateDiskOffering", want)
+}
+
+func TestUpdateDiskOfferingResponse(t *testing.T) {
+	assertDecodes(t, `{"cacheMode":"cacheMode-value","created":"created-value","diskBytesReadRate":1099511627776,"diskBytesWriteRate":1099511627776,"diskIopsRatePerGb":true,"diskIopsReadRate":1099511627776,"diskIopsTotalRate":1099511627776,"diskIopsWriteRate":1099511627776,"disksize":1099511627776,"displayoffering":true,"displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","hypervisorsnapshotreserve":42,"id":"id-value","iscustomized":true,"iscustomizediops":true,"maxiops":1099511627776,"miniops":1099511627776,"name":"name-value","provisioningtype":"provisioningtype-value","storagetype":"storagetype-value","tags":"tags-value"}`, &UpdateDiskOfferingResponse{})
+}
+
+func TestListDiskOfferingsParams(t *testing.T) {
+	p := &ListDiskOfferingsParams{}
+	want := url.Values{}
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+
+	assertParams(t, p, "listDiskOfferings", want)
+}
+
+func TestListDiskOfferingsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"diskoffering":[{"cacheMode":"cacheMode-value","created":"created-value","diskBytesReadRate":1099511627776,"diskBytesWriteRate":1099511627776,"diskIopsRatePerGb":true,"diskIopsReadRate":1099511627776,"diskIopsTotalRate":1099511627776,"diskIopsWriteRate":1099511627776,"disksize":1099511627776,"displayoffering":true,"displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","hypervisorsnapshotreserve":42,"id":"id-value","iscustomized":true,"iscustomizediops":true,"maxiops":1099511627776,"miniops":1099511627776,"name":"name-value","provisioningtype":"provisioningtype-value","storagetype":"storagetype-value","tags":"tags-value"}]}`, &ListDiskOfferingsResponse{})
+}
@@ -17,17 +17,50 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
+// DomainServiceIface is the interface implemented by DomainService, and by MockDomainService for tests
+type DomainServiceIface interface {
+	NewCreateDomainParams(name string) *CreateDomainParams
+	CreateDomain(p *CreateDomainParams) (*CreateDomainResponse, error)
+	CreateDomainWithContext(ctx context.Context, p *CreateDomainParams) (*CreateDomainResponse, error)
+	NewDeleteDomainParams(id string) *DeleteDomainParams
+	DeleteDomain(p *DeleteDomainParams) (*DeleteDomainResponse, error)
+	DeleteDomainWithContext(ctx context.Context, p *DeleteDomainParams) (*DeleteDomainResponse, error)
+	DeleteDomainAsync(ctx context.Context, p *DeleteDomainParams) (*DeleteDomainJob, error)
+	NewUpdateDomainParams(id string) *UpdateDomainParams
+	UpdateDomain(p *UpdateDomainParams) (*UpdateDomainResponse, error)
+	UpdateDomainWithContext(ctx context.Context, p *UpdateDomainParams) (*UpdateDomainResponse, error)
+	NewListDomainChildrenParams() *ListDomainChildrenParams
+	GetDomainChildrenID(name string, opts ...OptionFunc) (string, int, error)
+	GetDomainChildrenByName(name string, opts ...OptionFunc) (*DomainChildren, int, error)
+	GetDomainChildrenByID(id string, opts ...OptionFunc) (*DomainChildren, int, error)
+	ListDomainChildren(p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error)
+	ListDomainChildrenWithContext(ctx context.Context, p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error)
+	NewListDomainChildrenIterator(p *ListDomainChildrenParams, pagesize int) *ListDomainChildrenIterator
+	NewListDomainsParams() *ListDomainsParams
+	GetDomainID(name string, opts ...OptionFunc) (string, int, error)
+	GetDomainByName(name string, opts ...OptionFunc) (*Domain, int, error)
+	GetDomainByID(id string, opts ...OptionFunc) (*Domain, int, error)
+	ListDomains(p *ListDomainsParams) (*ListDomainsResponse, error)
+	ListDomainsWithContext(ctx context.Context, p *ListDomainsParams) (*ListDomainsResponse, error)
+	NewListDomainsIterator(p *ListDomainsParams, pagesize int) *ListDomainsIterator
+}
+
 type CreateDomainParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateDomainParams) command() string {
+	return "createDomain"
+}
+
 func (p *CreateDomainParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -97,7 +130,13 @@ func (s *DomainService) NewCreateDomainParams(name string) *CreateDomainParams {
 
 // Creates a domain
 func (s *DomainService) CreateDomain(p *CreateDomainParams) (*CreateDomainResponse, error) {
-	resp, err := s.cs.newRequest("createDomain", p.toURLValues())
+	return s.CreateDomainWithContext(context.Background(), p)
+}
+
+// CreateDomainWithContext is the same as CreateDomain, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *DomainService) CreateDomainWithContext(ctx context.Context, p *CreateDomainParams) (*CreateDomainResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createDomain", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -162,6 +201,10 @@ type DeleteDomainParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteDomainParams) command() string {
+	return "deleteDomain"
+}
+
 func (p *DeleteDomainParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -202,7 +245,13 @@ func (s *DomainService) NewDeleteDomainParams(id string) *DeleteDomainParams {
 
 // Deletes a specified domain
 func (s *DomainService) DeleteDomain(p *DeleteDomainParams) (*DeleteDomainResponse, error) {
-	resp, err := s.cs.newRequest("deleteDomain", p.toURLValues())
+	return s.DeleteDomainWithContext(context.Background(), p)
+}
+
+// DeleteDomainWithContext is the same as DeleteDomain, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *DomainService) DeleteDomainWithContext(ctx context.Context, p *DeleteDomainParams) (*DeleteDomainResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteDomain", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -214,9 +263,9 @@ func (s *DomainService) DeleteDomain(p *DeleteDomainParams) (*DeleteDomainRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteDomain", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -229,6 +278,41 @@ func (s *DomainService) DeleteDomain(p *DeleteDomainParams) (*DeleteDomainRespon
 	return &r, nil
 }
 
+// DeleteDomainJob is a handle to a running deleteDomain async job
+type DeleteDomainJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteDomainResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteDomainJob) Result() (*DeleteDomainResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteDomainResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteDomainAsync starts the deleteDomain async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *DomainService) DeleteDomainAsync(ctx context.Context, p *DeleteDomainParams) (*DeleteDomainJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteDomain", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteDomainResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteDomainJob{s.cs.newJobHandle("deleteDomain", r.JobID)}, nil
+}
+
 type DeleteDomainResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -239,6 +323,10 @@ type UpdateDomainParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateDomainParams) command() string {
+	return "updateDomain"
+}
+
 func (p *UpdateDomainParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -298,7 +386,13 @@ func (s *DomainService) NewUpdateDomainParams(id string) *UpdateDomainParams {
 
 // Updates a domain with a new name
 func (s *DomainService) UpdateDomain(p *UpdateDomainParams) (*UpdateDomainResponse, error) {
-	resp, err := s.cs.newRequest("updateDomain", p.toURLValues())
+	return s.UpdateDomainWithContext(context.Background(), p)
+}
+
+// UpdateDomainWithContext is the same as UpdateDomain, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *DomainService) UpdateDomainWithContext(ctx context.Context, p *UpdateDomainParams) (*UpdateDomainResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateDomain", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -363,6 +457,10 @@ type ListDomainChildrenParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListDomainChildrenParams) command() string {
+	return "listDomainChildren"
+}
+
 func (p *ListDomainChildrenParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -472,7 +570,7 @@ func (s *DomainService) GetDomainChildrenID(name string, opts ...OptionFunc) (st
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -486,7 +584,7 @@ func (s *DomainService) GetDomainChildrenID(name string, opts ...OptionFunc) (st
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -518,16 +616,14 @@ func (s *DomainService) GetDomainChildrenByID(id string, opts ...OptionFunc) (*D
 
 	l, err := s.ListDomainChildren(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -539,10 +635,17 @@ func (s *DomainService) GetDomainChildrenByID(id string, opts ...OptionFunc) (*D
 
 // Lists all children domains belonging to a specified domain
 func (s *DomainService) ListDomainChildren(p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error) {
+	return s.ListDomainChildrenWithContext(context.Background(), p)
+}
+
+// ListDomainChildrenWithContext is the same as ListDomainChildren, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *DomainService) ListDomainChildrenWithContext(ctx context.Context, p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error) {
 	var r ListDomainChildrenResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListDomainChildrenResponse
-		resp, err := s.cs.newRequest("listDomainChildren", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listDomainChildren", values)
 		if err != nil {
 			return nil, err
 		}
@@ -554,15 +657,91 @@ func (s *DomainService) ListDomainChildren(p *ListDomainChildrenParams) (*ListDo
 		r.Count = l.Count
 		r.DomainChildren = append(r.DomainChildren, l.DomainChildren...)
 
-		if r.Count == len(r.DomainChildren) {
+		if r.Count == len(r.DomainChildren) || len(l.DomainChildren) == 0 {
+			s.cs.observePages("listDomainChildren", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.DomainChildren))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.DomainChildren)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listDomainChildren", values, len(l.DomainChildren), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListDomainChildrenResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.DomainChildren = append(r.DomainChildren, l.DomainChildren...)
+			}
+
+			if r.Count == len(r.DomainChildren) {
+				s.cs.observePages("listDomainChildren", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
 	}
 }
 
+// ListDomainChildrenIterator iterates over the items returned by listDomainChildren, requesting
+// the pages of the response one at a time while iterating.
+type ListDomainChildrenIterator struct {
+	pager
+	items []*DomainChildren
+	index int
+}
+
+// NewListDomainChildrenIterator returns an iterator over the items returned by listDomainChildren, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *DomainService) NewListDomainChildrenIterator(p *ListDomainChildrenParams, pagesize int) *ListDomainChildrenIterator {
+	return &ListDomainChildrenIterator{pager: newPager(s.cs, "listDomainChildren", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListDomainChildrenIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListDomainChildrenResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.DomainChildren))
+
+	i.items = l.DomainChildren
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListDomainChildrenIterator) Value() *DomainChildren {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
+}
+
 type ListDomainChildrenResponse struct {
 	Count          int               `json:"count"`
 	DomainChildren []*DomainChildren `json:"domainchildren"`
@@ -621,6 +800,10 @@ type ListDomainsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListDomainsParams) command() string {
+	return "listDomains"
+}
+
 func (p *ListDomainsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -730,7 +913,7 @@ func (s *DomainService) GetDomainID(name string, opts ...OptionFunc) (string, in
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -744,7 +927,7 @@ func (s *DomainService) GetDomainID(name string, opts ...OptionFunc) (string, in
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -776,16 +959,14 @@ func (s *DomainService) GetDomainByID(id string, opts ...OptionFunc) (*Domain, i
 
 	l, err := s.ListDomains(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -797,10 +978,17 @@ func (s *DomainService) GetDomainByID(id string, opts ...OptionFunc) (*Domain, i
 
 // Lists domains and provides detailed information for listed domains
 func (s *DomainService) ListDomains(p *ListDomainsParams) (*ListDomainsResponse, error) {
+	return s.ListDomainsWithContext(context.Background(), p)
+}
+
+// ListDomainsWithContext is the same as ListDomains, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *DomainService) ListDomainsWithContext(ctx context.Context, p *ListDomainsParams) (*ListDomainsResponse, error) {
 	var r ListDomainsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListDomainsResponse
-		resp, err := s.cs.newRequest("listDomains", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listDomains", values)
 		if err != nil {
 			return nil, err
 		}
@@ -812,13 +1000,89 @@ func (s *DomainService) ListDomains(p *ListDomainsParams) (*ListDomainsResponse,
 		r.Count = l.Count
 		r.Domains = append(r.Domains, l.Domains...)
 
-		if r.Count == len(r.Domains) {
+		if r.Count == len(r.Domains) || len(l.Domains) == 0 {
+			s.cs.observePages("listDomains", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.Domains))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.Domains)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listDomains", values, len(l.Domains), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListDomainsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.Domains = append(r.Domains, l.Domains...)
+			}
+
+			if r.Count == len(r.Domains) {
+				s.cs.observePages("listDomains", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListDomainsIterator iterates over the items returned by listDomains, requesting
+// the pages of the response one at a time while iterating.
+type ListDomainsIterator struct {
+	pager
+	items []*Domain
+	index int
+}
+
+// NewListDomainsIterator returns an iterator over the items returned by listDomains, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *DomainService) NewListDomainsIterator(p *ListDomainsParams, pagesize int) *ListDomainsIterator {
+	return &ListDomainsIterator{pager: newPager(s.cs, "listDomains", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListDomainsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListDomainsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.Domains))
+
+	i.items = l.Domains
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListDomainsIterator) Value() *Domain {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListDomainsResponse struct {
@@ -0,0 +1,205 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockDomainService is a mock of DomainServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockDomainService struct {
+	mockCalls
+
+	CreateDomainFunc            func(ctx context.Context, p *CreateDomainParams) (*CreateDomainResponse, error)
+	DeleteDomainFunc            func(ctx context.Context, p *DeleteDomainParams) (*DeleteDomainResponse, error)
+	DeleteDomainAsyncFunc       func(ctx context.Context, p *DeleteDomainParams) (*DeleteDomainJob, error)
+	UpdateDomainFunc            func(ctx context.Context, p *UpdateDomainParams) (*UpdateDomainResponse, error)
+	GetDomainChildrenIDFunc     func(name string, opts ...OptionFunc) (string, int, error)
+	GetDomainChildrenByNameFunc func(name string, opts ...OptionFunc) (*DomainChildren, int, error)
+	GetDomainChildrenByIDFunc   func(id string, opts ...OptionFunc) (*DomainChildren, int, error)
+	ListDomainChildrenFunc      func(ctx context.Context, p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error)
+	GetDomainIDFunc             func(name string, opts ...OptionFunc) (string, int, error)
+	GetDomainByNameFunc         func(name string, opts ...OptionFunc) (*Domain, int, error)
+	GetDomainByIDFunc           func(id string, opts ...OptionFunc) (*Domain, int, error)
+	ListDomainsFunc             func(ctx context.Context, p *ListDomainsParams) (*ListDomainsResponse, error)
+}
+
+var _ DomainServiceIface = &MockDomainService{}
+
+func (m *MockDomainService) NewCreateDomainParams(name string) *CreateDomainParams {
+	return (&DomainService{}).NewCreateDomainParams(name)
+}
+
+func (m *MockDomainService) CreateDomain(p *CreateDomainParams) (*CreateDomainResponse, error) {
+	return m.CreateDomainWithContext(context.Background(), p)
+}
+
+func (m *MockDomainService) CreateDomainWithContext(ctx context.Context, p *CreateDomainParams) (*CreateDomainResponse, error) {
+	m.record("CreateDomain", p)
+	if m.CreateDomainFunc == nil {
+		return nil, notMocked("MockDomainService", "CreateDomain")
+	}
+	return m.CreateDomainFunc(ctx, p)
+}
+
+func (m *MockDomainService) NewDeleteDomainParams(id string) *DeleteDomainParams {
+	return (&DomainService{}).NewDeleteDomainParams(id)
+}
+
+func (m *MockDomainService) DeleteDomain(p *DeleteDomainParams) (*DeleteDomainResponse, error) {
+	return m.DeleteDomainWithContext(context.Background(), p)
+}
+
+func (m *MockDomainService) DeleteDomainWithContext(ctx context.Context, p *DeleteDomainParams) (*DeleteDomainResponse, error) {
+	m.record("DeleteDomain", p)
+	if m.DeleteDomainFunc == nil {
+		return nil, notMocked("MockDomainService", "DeleteDomain")
+	}
+	return m.DeleteDomainFunc(ctx, p)
+}
+
+func (m *MockDomainService) DeleteDomainAsync(ctx context.Context, p *DeleteDomainParams) (*DeleteDomainJob, error) {
+	m.record("DeleteDomainAsync", p)
+	if m.DeleteDomainAsyncFunc == nil {
+		return nil, notMocked("MockDomainService", "DeleteDomainAsync")
+	}
+	return m.DeleteDomainAsyncFunc(ctx, p)
+}
+
+func (m *MockDomainService) NewUpdateDomainParams(id string) *UpdateDomainParams {
+	return (&DomainService{}).NewUpdateDomainParams(id)
+}
+
+func (m *MockDomainService) UpdateDomain(p *UpdateDomainParams) (*UpdateDomainResponse, error) {
+	return m.UpdateDomainWithContext(context.Background(), p)
+}
+
+func (m *MockDomainService) UpdateDomainWithContext(ctx context.Context, p *UpdateDomainParams) (*UpdateDomainResponse, error) {
+	m.record("UpdateDomain", p)
+	if m.UpdateDomainFunc == nil {
+		return nil, notMocked("MockDomainService", "UpdateDomain")
+	}
+	return m.UpdateDomainFunc(ctx, p)
+}
+
+func (m *MockDomainService) NewListDomainChildrenParams() *ListDomainChildrenParams {
+	return (&DomainService{}).NewListDomainChildrenParams()
+}
+
+func (m *MockDomainService) GetDomainChildrenID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetDomainChildrenID", name, opts)
+	if m.GetDomainChildrenIDFunc == nil {
+		return "", -1, notMocked("MockDomainService", "GetDomainChildrenID")
+	}
+	return m.GetDomainChildrenIDFunc(name, opts...)
+}
+
+func (m *MockDomainService) GetDomainChildrenByName(name string, opts ...OptionFunc) (*DomainChildren, int, error) {
+	m.record("GetDomainChildrenByName", name, opts)
+	if m.GetDomainChildrenByNameFunc == nil {
+		return nil, -1, notMocked("MockDomainService", "GetDomainChildrenByName")
+	}
+	return m.GetDomainChildrenByNameFunc(name, opts...)
+}
+
+func (m *MockDomainService) GetDomainChildrenByID(id string, opts ...OptionFunc) (*DomainChildren, int, error) {
+	m.record("GetDomainChildrenByID", id, opts)
+	if m.GetDomainChildrenByIDFunc == nil {
+		return nil, -1, notMocked("MockDomainService", "GetDomainChildrenByID")
+	}
+	return m.GetDomainChildrenByIDFunc(id, opts...)
+}
+
+func (m *MockDomainService) ListDomainChildren(p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error) {
+	return m.ListDomainChildrenWithContext(context.Background(), p)
+}
+
+func (m *MockDomainService) ListDomainChildrenWithContext(ctx context.Context, p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error) {
+	m.record("ListDomainChildren", p)
+	if m.ListDomainChildrenFunc == nil {
+		return nil, notMocked("MockDomainService", "ListDomainChildren")
+	}
+	return m.ListDomainChildrenFunc(ctx, p)
+}
+
+func (m *MockDomainService) NewListDomainChildrenIterator(p *ListDomainChildrenParams, pagesize int) *ListDomainChildrenIterator {
+	i := &ListDomainChildrenIterator{pager: newPager(nil, "listDomainChildren", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListDomainChildrenParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListDomainChildrenWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockDomainService) NewListDomainsParams() *ListDomainsParams {
+	return (&DomainService{}).NewListDomainsParams()
+}
+
+func (m *MockDomainService) GetDomainID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetDomainID", name, opts)
+	if m.GetDomainIDFunc == nil {
+		return "", -1, notMocked("MockDomainService", "GetDomainID")
+	}
+	return m.GetDomainIDFunc(name, opts...)
+}
+
+func (m *MockDomainService) GetDomainByName(name string, opts ...OptionFunc) (*Domain, int, error) {
+	m.record("GetDomainByName", name, opts)
+	if m.GetDomainByNameFunc == nil {
+		return nil, -1, notMocked("MockDomainService", "GetDomainByName")
+	}
+	return m.GetDomainByNameFunc(name, opts...)
+}
+
+func (m *MockDomainService) GetDomainByID(id string, opts ...OptionFunc) (*Domain, int, error) {
+	m.record("GetDomainByID", id, opts)
+	if m.GetDomainByIDFunc == nil {
+		return nil, -1, notMocked("MockDomainService", "GetDomainByID")
+	}
+	return m.GetDomainByIDFunc(id, opts...)
+}
+
+func (m *MockDomainService) ListDomains(p *ListDomainsParams) (*ListDomainsResponse, error) {
+	return m.ListDomainsWithContext(context.Background(), p)
+}
+
+func (m *MockDomainService) ListDomainsWithContext(ctx context.Context, p *ListDomainsParams) (*ListDomainsResponse, error) {
+	m.record("ListDomains", p)
+	if m.ListDomainsFunc == nil {
+		return nil, notMocked("MockDomainService", "ListDomains")
+	}
+	return m.ListDomainsFunc(ctx, p)
+}
+
+func (m *MockDomainService) NewListDomainsIterator(p *ListDomainsParams, pagesize int) *ListDomainsIterator {
+	i := &ListDomainsIterator{pager: newPager(nil, "listDomains", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListDomainsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListDomainsWithContext(ctx, c)
+	}
+	return i
+}
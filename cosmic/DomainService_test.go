@@ -0,0 +1,127 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCreateDomainParams(t *testing.T) {
+	p := &CreateDomainParams{}
+	want := url.Values{}
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetEmail("email-value")
+	want.Set("email", "email-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetNetworkdomain("networkdomain-value")
+	want.Set("networkdomain", "networkdomain-value")
+	p.SetParentdomainid("parentdomainid-value")
+	want.Set("parentdomainid", "parentdomainid-value")
+
+	assertParams(t, p, "createDomain", want)
+}
+
+func TestCreateDomainResponse(t *testing.T) {
+	assertDecodes(t, `{"cpuavailable":"cpuavailable-value","cpulimit":"cpulimit-value","cputotal":1099511627776,"email":"email-value","haschild":true,"id":"id-value","ipavailable":"ipavailable-value","iplimit":"iplimit-value","iptotal":1099511627776,"level":42,"memoryavailable":"memoryavailable-value","memorylimit":"memorylimit-value","memorytotal":1099511627776,"name":"name-value","networkavailable":"networkavailable-value","networkdomain":"networkdomain-value","networklimit":"networklimit-value","networktotal":1099511627776,"parentdomainid":"parentdomainid-value","parentdomainname":"parentdomainname-value","path":"path-value","primarystorageavailable":"primarystorageavailable-value","primarystoragelimit":"primarystoragelimit-value","primarystoragetotal":1099511627776,"projectavailable":"projectavailable-value","projectlimit":"projectlimit-value","projecttotal":1099511627776,"secondarystorageavailable":"secondarystorageavailable-value","secondarystoragelimit":"secondarystoragelimit-value","secondarystoragetotal":1099511627776,"snapshotavailable":"snapshotavailable-value","snapshotlimit":"snapshotlimit-value","snapshottotal":1099511627776,"state":"state-value","templateavailable":"templateavailable-value","templatelimit":"templatelimit-value","templatetotal":1099511627776,"vmavailable":"vmavailable-value","vmlimit":"vmlimit-value","vmtotal":1099511627776,"volumeavailable":"volumeavailable-value","volumelimit":"volumelimit-value","volumetotal":1099511627776,"vpcavailable":"vpcavailable-value","vpclimit":"vpclimit-value","vpctotal":1099511627776}`, &CreateDomainResponse{})
+}
+
+func TestDeleteDomainParams(t *testing.T) {
+	p := &DeleteDomainParams{}
+	want := url.Values{}
+	p.SetCleanup(true)
+	want.Set("cleanup", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteDomain", want)
+}
+
+func TestDeleteDomainResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteDomainResponse{})
+}
+
+func TestUpdateDomainParams(t *testing.T) {
+	p := &UpdateDomainParams{}
+	want := url.Values{}
+	p.SetEmail("email-value")
+	want.Set("email", "email-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetNetworkdomain("networkdomain-value")
+	want.Set("networkdomain", "networkdomain-value")
+
+	assertParams(t, p, "updateDomain", want)
+}
+
+func TestUpdateDomainResponse(t *testing.T) {
+	assertDecodes(t, `{"cpuavailable":"cpuavailable-value","cpulimit":"cpulimit-value","cputotal":1099511627776,"email":"email-value","haschild":true,"id":"id-value","ipavailable":"ipavailable-value","iplimit":"iplimit-value","iptotal":1099511627776,"level":42,"memoryavailable":"memoryavailable-value","memorylimit":"memorylimit-value","memorytotal":1099511627776,"name":"name-value","networkavailable":"networkavailable-value","networkdomain":"networkdomain-value","networklimit":"networklimit-value","networktotal":1099511627776,"parentdomainid":"parentdomainid-value","parentdomainname":"parentdomainname-value","path":"path-value","primarystorageavailable":"primarystorageavailable-value","primarystoragelimit":"primarystoragelimit-value","primarystoragetotal":1099511627776,"projectavailable":"projectavailable-value","projectlimit":"projectlimit-value","projecttotal":1099511627776,"secondarystorageavailable":"secondarystorageavailable-value","secondarystoragelimit":"secondarystoragelimit-value","secondarystoragetotal":1099511627776,"snapshotavailable":"snapshotavailable-value","snapshotlimit":"snapshotlimit-value","snapshottotal":1099511627776,"state":"state-value","templateavailable":"templateavailable-value","templatelimit":"templatelimit-value","templatetotal":1099511627776,"vmavailable":"vmavailable-value","vmlimit":"vmlimit-value","vmtotal":1099511627776,"volumeavailable":"volumeavailable-value","volumelimit":"volumelimit-value","volumetotal":1099511627776,"vpcavailable":"vpcavailable-value","vpclimit":"vpclimit-value","vpctotal":1099511627776}`, &UpdateDomainResponse{})
+}
+
+func TestListDomainChildrenParams(t *testing.T) {
+	p := &ListDomainChildrenParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+
+	assertParams(t, p, "listDomainChildren", want)
+}
+
+func TestListDomainChildrenResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"domainchildren":[{"cpuavailable":"cpuavailable-value","cpulimit":"cpulimit-value","cputotal":1099511627776,"email":"email-value","haschild":true,"id":"id-value","ipavailable":"ipavailable-value","iplimit":"iplimit-value","iptotal":1099511627776,"level":42,"memoryavailable":"memoryavailable-value","memorylimit":"memorylimit-value","memorytotal":1099511627776,"name":"name-value","networkavailable":"networkavailable-value","networkdomain":"networkdomain-value","networklimit":"networklimit-value","networktotal":1099511627776,"parentdomainid":"parentdomainid-value","parentdomainname":"parentdomainname-value","path":"path-value","primarystorageavailable":"primarystorageavailable-value","primarystoragelimit":"primarystoragelimit-value","primarystoragetotal":1099511627776,"projectavailable":"projectavailable-value","projectlimit":"projectlimit-value","projecttotal":1099511627776,"secondarystorageavailable":"secondarystorageavailable-value","secondarystoragelimit":"secondarystoragelimit-value","secondarystoragetotal":1099511627776,"snapshotavailable":"snapshotavailable-value","snapshotlimit":"snapshotlimit-value","snapshottotal":1099511627776,"state":"state-value","templateavailable":"templateavailable-value","templatelimit":"templatelimit-value","templatetotal":1099511627776,"vmavailable":"vmavailable-value","vmlimit":"vmlimit-value","vmtotal":1099511627776,"volumeavailable":"volumeavailable-value","volumelimit":"volumelimit-value","volumetotal":1099511627776,"vpcavailable":"vpcavailable-value","vpclimit":"vpclimit-value","vpctotal":1099511627776}]}`, &ListDomainChildrenResponse{})
+}
+
+func TestListDomainsParams(t *testing.T) {
+	p := &ListDomainsParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetLevel(42)
+	want.Set("level", "42")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+
+	assertParams(t, p, "listDomains", want)
+}
+
+func TestListDomainsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"domain":[{"cpuavailable":"cpuavailable-value","cpulimit":"cpulimit-value","cputotal":1099511627776,"email":"email-value","haschild":true,"id":"id-value","ipavailable":"ipavailable-value","iplimit":"iplimit-value","iptotal":1099511627776,"level":42,"memoryavailable":"memoryavailable-value","memorylimit":"memorylimit-value","memorytotal":1099511627776,"name":"name-value","networkavailable":"networkavailable-value","networkdomain":"networkdomain-value","networklimit":"networklimit-value","networktotal":1099511627776,"parentdomainid":"parentdomainid-value","parentdomainname":"parentdomainname-value","path":"path-value","primarystorageavailable":"primarystorageavailable-value","primarystoragelimit":"primarystoragelimit-value","primarystoragetotal":1099511627776,"projectavailable":"projectavailable-value","projectlimit":"projectlimit-value","projecttotal":1099511627776,"secondarystorageavailable":"secondarystorageavailable-value","secondarystoragelimit":"secondarystoragelimit-value","secondarystoragetotal":1099511627776,"snapshotavailable":"snapshotavailable-value","snapshotlimit":"snapshotlimit-value","snapshottotal":1099511627776,"state":"state-value","templateavailable":"templateavailable-value","templatelimit":"templatelimit-value","templatetotal":1099511627776,"vmavailable":"vmavailable-value","vmlimit":"vmlimit-value","vmtotal":1099511627776,"volumeavailable":"volumeavailable-value","volumelimit":"volumelimit-value","volumetotal":1099511627776,"vpcavailable":"vpcavailable-value","vpclimit":"vpclimit-value","vpctotal":1099511627776}]}`, &ListDomainsResponse{})
+}
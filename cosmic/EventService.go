@@ -17,17 +17,41 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 )
 
+// EventServiceIface is the interface implemented by EventService, and by MockEventService for tests
+type EventServiceIface interface {
+	NewListEventTypesParams() *ListEventTypesParams
+	ListEventTypes(p *ListEventTypesParams) (*ListEventTypesResponse, error)
+	ListEventTypesWithContext(ctx context.Context, p *ListEventTypesParams) (*ListEventTypesResponse, error)
+	NewArchiveEventsParams() *ArchiveEventsParams
+	ArchiveEvents(p *ArchiveEventsParams) (*ArchiveEventsResponse, error)
+	ArchiveEventsWithContext(ctx context.Context, p *ArchiveEventsParams) (*ArchiveEventsResponse, error)
+	NewDeleteEventsParams() *DeleteEventsParams
+	DeleteEvents(p *DeleteEventsParams) (*DeleteEventsResponse, error)
+	DeleteEventsWithContext(ctx context.Context, p *DeleteEventsParams) (*DeleteEventsResponse, error)
+	NewListEventsParams() *ListEventsParams
+	GetEventByID(id string, opts ...OptionFunc) (*Event, int, error)
+	ListEvents(p *ListEventsParams) (*ListEventsResponse, error)
+	ListEventsWithContext(ctx context.Context, p *ListEventsParams) (*ListEventsResponse, error)
+	NewListEventsIterator(p *ListEventsParams, pagesize int) *ListEventsIterator
+}
+
 type ListEventTypesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListEventTypesParams) command() string {
+	return "listEventTypes"
+}
+
 func (p *ListEventTypesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -46,7 +70,13 @@ func (s *EventService) NewListEventTypesParams() *ListEventTypesParams {
 
 // List Event Types
 func (s *EventService) ListEventTypes(p *ListEventTypesParams) (*ListEventTypesResponse, error) {
-	resp, err := s.cs.newRequest("listEventTypes", p.toURLValues())
+	return s.ListEventTypesWithContext(context.Background(), p)
+}
+
+// ListEventTypesWithContext is the same as ListEventTypes, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *EventService) ListEventTypesWithContext(ctx context.Context, p *ListEventTypesParams) (*ListEventTypesResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "listEventTypes", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -71,6 +101,10 @@ type ArchiveEventsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ArchiveEventsParams) command() string {
+	return "archiveEvents"
+}
+
 func (p *ArchiveEventsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -130,7 +164,13 @@ func (s *EventService) NewArchiveEventsParams() *ArchiveEventsParams {
 
 // Archive one or more events.
 func (s *EventService) ArchiveEvents(p *ArchiveEventsParams) (*ArchiveEventsResponse, error) {
-	resp, err := s.cs.newRequest("archiveEvents", p.toURLValues())
+	return s.ArchiveEventsWithContext(context.Background(), p)
+}
+
+// ArchiveEventsWithContext is the same as ArchiveEvents, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *EventService) ArchiveEventsWithContext(ctx context.Context, p *ArchiveEventsParams) (*ArchiveEventsResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "archiveEvents", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -151,6 +191,10 @@ type DeleteEventsParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteEventsParams) command() string {
+	return "deleteEvents"
+}
+
 func (p *DeleteEventsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -210,7 +254,13 @@ func (s *EventService) NewDeleteEventsParams() *DeleteEventsParams {
 
 // Delete one or more events.
 func (s *EventService) DeleteEvents(p *DeleteEventsParams) (*DeleteEventsResponse, error) {
-	resp, err := s.cs.newRequest("deleteEvents", p.toURLValues())
+	return s.DeleteEventsWithContext(context.Background(), p)
+}
+
+// DeleteEventsWithContext is the same as DeleteEvents, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *EventService) DeleteEventsWithContext(ctx context.Context, p *DeleteEventsParams) (*DeleteEventsResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteEvents", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -231,6 +281,10 @@ type ListEventsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListEventsParams) command() string {
+	return "listEvents"
+}
+
 func (p *ListEventsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -418,16 +472,14 @@ func (s *EventService) GetEventByID(id string, opts ...OptionFunc) (*Event, int,
 
 	l, err := s.ListEvents(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -439,10 +491,17 @@ func (s *EventService) GetEventByID(id string, opts ...OptionFunc) (*Event, int,
 
 // A command to list events.
 func (s *EventService) ListEvents(p *ListEventsParams) (*ListEventsResponse, error) {
+	return s.ListEventsWithContext(context.Background(), p)
+}
+
+// ListEventsWithContext is the same as ListEvents, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *EventService) ListEventsWithContext(ctx context.Context, p *ListEventsParams) (*ListEventsResponse, error) {
 	var r ListEventsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListEventsResponse
-		resp, err := s.cs.newRequest("listEvents", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listEvents", values)
 		if err != nil {
 			return nil, err
 		}
@@ -454,13 +513,89 @@ func (s *EventService) ListEvents(p *ListEventsParams) (*ListEventsResponse, err
 		r.Count = l.Count
 		r.Events = append(r.Events, l.Events...)
 
-		if r.Count == len(r.Events) {
+		if r.Count == len(r.Events) || len(l.Events) == 0 {
+			s.cs.observePages("listEvents", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.Events))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.Events)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listEvents", values, len(l.Events), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListEventsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.Events = append(r.Events, l.Events...)
+			}
+
+			if r.Count == len(r.Events) {
+				s.cs.observePages("listEvents", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListEventsIterator iterates over the items returned by listEvents, requesting
+// the pages of the response one at a time while iterating.
+type ListEventsIterator struct {
+	pager
+	items []*Event
+	index int
+}
+
+// NewListEventsIterator returns an iterator over the items returned by listEvents, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *EventService) NewListEventsIterator(p *ListEventsParams, pagesize int) *ListEventsIterator {
+	return &ListEventsIterator{pager: newPager(s.cs, "listEvents", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListEventsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListEventsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.Events))
+
+	i.items = l.Events
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListEventsIterator) Value() *Event {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListEventsResponse struct {
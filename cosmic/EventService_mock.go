@@ -0,0 +1,120 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockEventService is a mock of EventServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockEventService struct {
+	mockCalls
+
+	ListEventTypesFunc func(ctx context.Context, p *ListEventTypesParams) (*ListEventTypesResponse, error)
+	ArchiveEventsFunc  func(ctx context.Context, p *ArchiveEventsParams) (*ArchiveEventsResponse, error)
+	DeleteEventsFunc   func(ctx context.Context, p *DeleteEventsParams) (*DeleteEventsResponse, error)
+	GetEventByIDFunc   func(id string, opts ...OptionFunc) (*Event, int, error)
+	ListEventsFunc     func(ctx context.Context, p *ListEventsParams) (*ListEventsResponse, error)
+}
+
+var _ EventServiceIface = &MockEventService{}
+
+func (m *MockEventService) NewListEventTypesParams() *ListEventTypesParams {
+	return (&EventService{}).NewListEventTypesParams()
+}
+
+func (m *MockEventService) ListEventTypes(p *ListEventTypesParams) (*ListEventTypesResponse, error) {
+	return m.ListEventTypesWithContext(context.Background(), p)
+}
+
+func (m *MockEventService) ListEventTypesWithContext(ctx context.Context, p *ListEventTypesParams) (*ListEventTypesResponse, error) {
+	m.record("ListEventTypes", p)
+	if m.ListEventTypesFunc == nil {
+		return nil, notMocked("MockEventService", "ListEventTypes")
+	}
+	return m.ListEventTypesFunc(ctx, p)
+}
+
+func (m *MockEventService) NewArchiveEventsParams() *ArchiveEventsParams {
+	return (&EventService{}).NewArchiveEventsParams()
+}
+
+func (m *MockEventService) ArchiveEvents(p *ArchiveEventsParams) (*ArchiveEventsResponse, error) {
+	return m.ArchiveEventsWithContext(context.Background(), p)
+}
+
+func (m *MockEventService) ArchiveEventsWithContext(ctx context.Context, p *ArchiveEventsParams) (*ArchiveEventsResponse, error) {
+	m.record("ArchiveEvents", p)
+	if m.ArchiveEventsFunc == nil {
+		return nil, notMocked("MockEventService", "ArchiveEvents")
+	}
+	return m.ArchiveEventsFunc(ctx, p)
+}
+
+func (m *MockEventService) NewDeleteEventsParams() *DeleteEventsParams {
+	return (&EventService{}).NewDeleteEventsParams()
+}
+
+func (m *MockEventService) DeleteEvents(p *DeleteEventsParams) (*DeleteEventsResponse, error) {
+	return m.DeleteEventsWithContext(context.Background(), p)
+}
+
+func (m *MockEventService) DeleteEventsWithContext(ctx context.Context, p *DeleteEventsParams) (*DeleteEventsResponse, error) {
+	m.record("DeleteEvents", p)
+	if m.DeleteEventsFunc == nil {
+		return nil, notMocked("MockEventService", "DeleteEvents")
+	}
+	return m.DeleteEventsFunc(ctx, p)
+}
+
+func (m *MockEventService) NewListEventsParams() *ListEventsParams {
+	return (&EventService{}).NewListEventsParams()
+}
+
+func (m *MockEventService) GetEventByID(id string, opts ...OptionFunc) (*Event, int, error) {
+	m.record("GetEventByID", id, opts)
+	if m.GetEventByIDFunc == nil {
+		return nil, -1, notMocked("MockEventService", "GetEventByID")
+	}
+	return m.GetEventByIDFunc(id, opts...)
+}
+
+func (m *MockEventService) ListEvents(p *ListEventsParams) (*ListEventsResponse, error) {
+	return m.ListEventsWithContext(context.Background(), p)
+}
+
+func (m *MockEventService) ListEventsWithContext(ctx context.Context, p *ListEventsParams) (*ListEventsResponse, error) {
+	m.record("ListEvents", p)
+	if m.ListEventsFunc == nil {
+		return nil, notMocked("MockEventService", "ListEvents")
+	}
+	return m.ListEventsFunc(ctx, p)
+}
+
+func (m *MockEventService) NewListEventsIterator(p *ListEventsParams, pagesize int) *ListEventsIterator {
+	i := &ListEventsIterator{pager: newPager(nil, "listEvents", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListEventsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListEventsWithContext(ctx, c)
+	}
+	return i
+}
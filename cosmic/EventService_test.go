@@ -0,0 +1,112 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestListEventTypesParams(t *testing.T) {
+	p := &ListEventTypesParams{}
+	want := url.Values{}
+
+	assertParams(t, p, "listEventTypes", want)
+}
+
+func TestListEventTypesResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"eventtype":[{"name":"name-value"}]}`, &ListEventTypesResponse{})
+}
+
+func TestArchiveEventsParams(t *testing.T) {
+	p := &ArchiveEventsParams{}
+	want := url.Values{}
+	p.SetEnddate("enddate-value")
+	want.Set("enddate", "enddate-value")
+	p.SetIds([]string{"a", "b"})
+	want.Set("ids", "a,b")
+	p.SetStartdate("startdate-value")
+	want.Set("startdate", "startdate-value")
+	p.SetType("type-value")
+	want.Set("type", "type-value")
+
+	assertParams(t, p, "archiveEvents", want)
+}
+
+func TestArchiveEventsResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &ArchiveEventsResponse{})
+}
+
+func TestDeleteEventsParams(t *testing.T) {
+	p := &DeleteEventsParams{}
+	want := url.Values{}
+	p.SetEnddate("enddate-value")
+	want.Set("enddate", "enddate-value")
+	p.SetIds([]string{"a", "b"})
+	want.Set("ids", "a,b")
+	p.SetStartdate("startdate-value")
+	want.Set("startdate", "startdate-value")
+	p.SetType("type-value")
+	want.Set("type", "type-value")
+
+	assertParams(t, p, "deleteEvents", want)
+}
+
+func TestDeleteEventsResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &DeleteEventsResponse{})
+}
+
+func TestListEventsParams(t *testing.T) {
+	p := &ListEventsParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetDuration(42)
+	want.Set("duration", "42")
+	p.SetEnddate("enddate-value")
+	want.Set("enddate", "enddate-value")
+	p.SetEntrytime(42)
+	want.Set("entrytime", "42")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetLevel("level-value")
+	want.Set("level", "level-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetStartdate("startdate-value")
+	want.Set("startdate", "startdate-value")
+	p.SetType("type-value")
+	want.Set("type", "type-value")
+
+	assertParams(t, p, "listEvents", want)
+}
+
+func TestListEventsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"event":[{"account":"account-value","created":"created-value","description":"description-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","level":"level-value","parentid":"parentid-value","project":"project-value","projectid":"projectid-value","state":"state-value","type":"type-value","username":"username-value"}]}`, &ListEventsResponse{})
+}
@@ -17,13 +17,70 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 )
 
+// FirewallServiceIface is the interface implemented by FirewallService, and by MockFirewallService for tests
+type FirewallServiceIface interface {
+	NewCreateEgressFirewallRuleParams(networkid string, protocol string) *CreateEgressFirewallRuleParams
+	CreateEgressFirewallRule(p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error)
+	CreateEgressFirewallRuleWithContext(ctx context.Context, p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error)
+	CreateEgressFirewallRuleAsync(ctx context.Context, p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleJob, error)
+	NewDeleteEgressFirewallRuleParams(id string) *DeleteEgressFirewallRuleParams
+	DeleteEgressFirewallRule(p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error)
+	DeleteEgressFirewallRuleWithContext(ctx context.Context, p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error)
+	DeleteEgressFirewallRuleAsync(ctx context.Context, p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleJob, error)
+	NewUpdateEgressFirewallRuleParams(id string) *UpdateEgressFirewallRuleParams
+	UpdateEgressFirewallRule(p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleResponse, error)
+	UpdateEgressFirewallRuleWithContext(ctx context.Context, p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleResponse, error)
+	UpdateEgressFirewallRuleAsync(ctx context.Context, p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleJob, error)
+	NewListEgressFirewallRulesParams() *ListEgressFirewallRulesParams
+	GetEgressFirewallRuleByID(id string, opts ...OptionFunc) (*EgressFirewallRule, int, error)
+	ListEgressFirewallRules(p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error)
+	ListEgressFirewallRulesWithContext(ctx context.Context, p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error)
+	NewListEgressFirewallRulesIterator(p *ListEgressFirewallRulesParams, pagesize int) *ListEgressFirewallRulesIterator
+	NewCreateFirewallRuleParams(ipaddressid string, protocol string) *CreateFirewallRuleParams
+	CreateFirewallRule(p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error)
+	CreateFirewallRuleWithContext(ctx context.Context, p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error)
+	CreateFirewallRuleAsync(ctx context.Context, p *CreateFirewallRuleParams) (*CreateFirewallRuleJob, error)
+	NewDeleteFirewallRuleParams(id string) *DeleteFirewallRuleParams
+	DeleteFirewallRule(p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error)
+	DeleteFirewallRuleWithContext(ctx context.Context, p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error)
+	DeleteFirewallRuleAsync(ctx context.Context, p *DeleteFirewallRuleParams) (*DeleteFirewallRuleJob, error)
+	NewUpdateFirewallRuleParams(id string) *UpdateFirewallRuleParams
+	UpdateFirewallRule(p *UpdateFirewallRuleParams) (*UpdateFirewallRuleResponse, error)
+	UpdateFirewallRuleWithContext(ctx context.Context, p *UpdateFirewallRuleParams) (*UpdateFirewallRuleResponse, error)
+	UpdateFirewallRuleAsync(ctx context.Context, p *UpdateFirewallRuleParams) (*UpdateFirewallRuleJob, error)
+	NewListFirewallRulesParams() *ListFirewallRulesParams
+	GetFirewallRuleByID(id string, opts ...OptionFunc) (*FirewallRule, int, error)
+	ListFirewallRules(p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error)
+	ListFirewallRulesWithContext(ctx context.Context, p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error)
+	NewListFirewallRulesIterator(p *ListFirewallRulesParams, pagesize int) *ListFirewallRulesIterator
+	NewCreatePortForwardingRuleParams(ipaddressid string, privateport int, protocol string, publicport int, virtualmachineid string) *CreatePortForwardingRuleParams
+	CreatePortForwardingRule(p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error)
+	CreatePortForwardingRuleWithContext(ctx context.Context, p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error)
+	CreatePortForwardingRuleAsync(ctx context.Context, p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleJob, error)
+	NewDeletePortForwardingRuleParams(id string) *DeletePortForwardingRuleParams
+	DeletePortForwardingRule(p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error)
+	DeletePortForwardingRuleWithContext(ctx context.Context, p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error)
+	DeletePortForwardingRuleAsync(ctx context.Context, p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleJob, error)
+	NewUpdatePortForwardingRuleParams(id string) *UpdatePortForwardingRuleParams
+	UpdatePortForwardingRule(p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleResponse, error)
+	UpdatePortForwardingRuleWithContext(ctx context.Context, p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleResponse, error)
+	UpdatePortForwardingRuleAsync(ctx context.Context, p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleJob, error)
+	NewListPortForwardingRulesParams() *ListPortForwardingRulesParams
+	GetPortForwardingRuleByID(id string, opts ...OptionFunc) (*PortForwardingRule, int, error)
+	ListPortForwardingRules(p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error)
+	ListPortForwardingRulesWithContext(ctx context.Context, p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error)
+	NewListPortForwardingRulesIterator(p *ListPortForwardingRulesParams, pagesize int) *ListPortForwardingRulesIterator
+}
+
 // Helper function for maintaining backwards compatibility
 func convertFirewallServiceResponse(b []byte) ([]byte, error) {
 	var raw map[string]interface{}
@@ -78,6 +135,10 @@ type CreateEgressFirewallRuleParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateEgressFirewallRuleParams) command() string {
+	return "createEgressFirewallRule"
+}
+
 func (p *CreateEgressFirewallRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -194,7 +255,13 @@ func (s *FirewallService) NewCreateEgressFirewallRuleParams(networkid string, pr
 
 // Creates a egress firewall rule for a given network
 func (s *FirewallService) CreateEgressFirewallRule(p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("createEgressFirewallRule", p.toURLValues())
+	return s.CreateEgressFirewallRuleWithContext(context.Background(), p)
+}
+
+// CreateEgressFirewallRuleWithContext is the same as CreateEgressFirewallRule, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *FirewallService) CreateEgressFirewallRuleWithContext(ctx context.Context, p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createEgressFirewallRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -206,15 +273,15 @@ func (s *FirewallService) CreateEgressFirewallRule(p *CreateEgressFirewallRulePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createEgressFirewallRule", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
+		b, err = getRawValue(b, "firewallrule")
 		if err != nil {
 			return nil, err
 		}
@@ -231,6 +298,51 @@ func (s *FirewallService) CreateEgressFirewallRule(p *CreateEgressFirewallRulePa
 	return &r, nil
 }
 
+// CreateEgressFirewallRuleJob is a handle to a running createEgressFirewallRule async job
+type CreateEgressFirewallRuleJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateEgressFirewallRuleResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateEgressFirewallRuleJob) Result() (*CreateEgressFirewallRuleResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateEgressFirewallRuleResponse{JobID: j.JobID()}
+	b, err = getRawValue(b, "firewallrule")
+	if err != nil {
+		return nil, err
+	}
+
+	b, err = convertFirewallServiceResponse(b)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// CreateEgressFirewallRuleAsync starts the createEgressFirewallRule async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *FirewallService) CreateEgressFirewallRuleAsync(ctx context.Context, p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createEgressFirewallRule", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateEgressFirewallRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateEgressFirewallRuleJob{s.cs.newJobHandle("createEgressFirewallRule", r.JobID)}, nil
+}
+
 type CreateEgressFirewallRuleResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Cidrlist    string `json:"cidrlist,omitempty"`
@@ -263,6 +375,10 @@ type DeleteEgressFirewallRuleParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteEgressFirewallRuleParams) command() string {
+	return "deleteEgressFirewallRule"
+}
+
 func (p *DeleteEgressFirewallRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -292,7 +408,13 @@ func (s *FirewallService) NewDeleteEgressFirewallRuleParams(id string) *DeleteEg
 
 // Deletes an egress firewall rule
 func (s *FirewallService) DeleteEgressFirewallRule(p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteEgressFirewallRule", p.toURLValues())
+	return s.DeleteEgressFirewallRuleWithContext(context.Background(), p)
+}
+
+// DeleteEgressFirewallRuleWithContext is the same as DeleteEgressFirewallRule, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *FirewallService) DeleteEgressFirewallRuleWithContext(ctx context.Context, p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteEgressFirewallRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -304,19 +426,14 @@ func (s *FirewallService) DeleteEgressFirewallRule(p *DeleteEgressFirewallRulePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteEgressFirewallRule", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = convertFirewallServiceResponse(b)
-		if err != nil {
-			return nil, err
-		}
-
 		if err := json.Unmarshal(b, &r); err != nil {
 			return nil, err
 		}
@@ -324,6 +441,41 @@ func (s *FirewallService) DeleteEgressFirewallRule(p *DeleteEgressFirewallRulePa
 	return &r, nil
 }
 
+// DeleteEgressFirewallRuleJob is a handle to a running deleteEgressFirewallRule async job
+type DeleteEgressFirewallRuleJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteEgressFirewallRuleResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteEgressFirewallRuleJob) Result() (*DeleteEgressFirewallRuleResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteEgressFirewallRuleResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteEgressFirewallRuleAsync starts the deleteEgressFirewallRule async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *FirewallService) DeleteEgressFirewallRuleAsync(ctx context.Context, p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteEgressFirewallRule", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteEgressFirewallRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteEgressFirewallRuleJob{s.cs.newJobHandle("deleteEgressFirewallRule", r.JobID)}, nil
+}
+
 type DeleteEgressFirewallRuleResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -334,6 +486,10 @@ type UpdateEgressFirewallRuleParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateEgressFirewallRuleParams) command() string {
+	return "updateEgressFirewallRule"
+}
+
 func (p *UpdateEgressFirewallRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -384,7 +540,13 @@ func (s *FirewallService) NewUpdateEgressFirewallRuleParams(id string) *UpdateEg
 
 // Updates egress firewall rule
 func (s *FirewallService) UpdateEgressFirewallRule(p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("updateEgressFirewallRule", p.toURLValues())
+	return s.UpdateEgressFirewallRuleWithContext(context.Background(), p)
+}
+
+// UpdateEgressFirewallRuleWithContext is the same as UpdateEgressFirewallRule, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *FirewallService) UpdateEgressFirewallRuleWithContext(ctx context.Context, p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateEgressFirewallRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -396,15 +558,15 @@ func (s *FirewallService) UpdateEgressFirewallRule(p *UpdateEgressFirewallRulePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateEgressFirewallRule", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
+		b, err = getRawValue(b, "firewallrule")
 		if err != nil {
 			return nil, err
 		}
@@ -421,6 +583,51 @@ func (s *FirewallService) UpdateEgressFirewallRule(p *UpdateEgressFirewallRulePa
 	return &r, nil
 }
 
+// UpdateEgressFirewallRuleJob is a handle to a running updateEgressFirewallRule async job
+type UpdateEgressFirewallRuleJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateEgressFirewallRuleResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateEgressFirewallRuleJob) Result() (*UpdateEgressFirewallRuleResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateEgressFirewallRuleResponse{JobID: j.JobID()}
+	b, err = getRawValue(b, "firewallrule")
+	if err != nil {
+		return nil, err
+	}
+
+	b, err = convertFirewallServiceResponse(b)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// UpdateEgressFirewallRuleAsync starts the updateEgressFirewallRule async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *FirewallService) UpdateEgressFirewallRuleAsync(ctx context.Context, p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateEgressFirewallRule", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateEgressFirewallRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateEgressFirewallRuleJob{s.cs.newJobHandle("updateEgressFirewallRule", r.JobID)}, nil
+}
+
 type UpdateEgressFirewallRuleResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Cidrlist    string `json:"cidrlist,omitempty"`
@@ -453,6 +660,10 @@ type ListEgressFirewallRulesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListEgressFirewallRulesParams) command() string {
+	return "listEgressFirewallRules"
+}
+
 func (p *ListEgressFirewallRulesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -624,16 +835,14 @@ func (s *FirewallService) GetEgressFirewallRuleByID(id string, opts ...OptionFun
 
 	l, err := s.ListEgressFirewallRules(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -645,10 +854,17 @@ func (s *FirewallService) GetEgressFirewallRuleByID(id string, opts ...OptionFun
 
 // Lists all egress firewall rules for network ID.
 func (s *FirewallService) ListEgressFirewallRules(p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error) {
+	return s.ListEgressFirewallRulesWithContext(context.Background(), p)
+}
+
+// ListEgressFirewallRulesWithContext is the same as ListEgressFirewallRules, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *FirewallService) ListEgressFirewallRulesWithContext(ctx context.Context, p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error) {
 	var r ListEgressFirewallRulesResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListEgressFirewallRulesResponse
-		resp, err := s.cs.newRequest("listEgressFirewallRules", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listEgressFirewallRules", values)
 		if err != nil {
 			return nil, err
 		}
@@ -660,13 +876,89 @@ func (s *FirewallService) ListEgressFirewallRules(p *ListEgressFirewallRulesPara
 		r.Count = l.Count
 		r.EgressFirewallRules = append(r.EgressFirewallRules, l.EgressFirewallRules...)
 
-		if r.Count == len(r.EgressFirewallRules) {
+		if r.Count == len(r.EgressFirewallRules) || len(l.EgressFirewallRules) == 0 {
+			s.cs.observePages("listEgressFirewallRules", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.EgressFirewallRules))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.EgressFirewallRules)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listEgressFirewallRules", values, len(l.EgressFirewallRules), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListEgressFirewallRulesResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.EgressFirewallRules = append(r.EgressFirewallRules, l.EgressFirewallRules...)
+			}
+
+			if r.Count == len(r.EgressFirewallRules) {
+				s.cs.observePages("listEgressFirewallRules", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListEgressFirewallRulesIterator iterates over the items returned by listEgressFirewallRules, requesting
+// the pages of the response one at a time while iterating.
+type ListEgressFirewallRulesIterator struct {
+	pager
+	items []*EgressFirewallRule
+	index int
+}
+
+// NewListEgressFirewallRulesIterator returns an iterator over the items returned by listEgressFirewallRules, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *FirewallService) NewListEgressFirewallRulesIterator(p *ListEgressFirewallRulesParams, pagesize int) *ListEgressFirewallRulesIterator {
+	return &ListEgressFirewallRulesIterator{pager: newPager(s.cs, "listEgressFirewallRules", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListEgressFirewallRulesIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListEgressFirewallRulesResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.EgressFirewallRules))
+
+	i.items = l.EgressFirewallRules
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListEgressFirewallRulesIterator) Value() *EgressFirewallRule {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListEgressFirewallRulesResponse struct {
@@ -705,6 +997,10 @@ type CreateFirewallRuleParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateFirewallRuleParams) command() string {
+	return "createFirewallRule"
+}
+
 func (p *CreateFirewallRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -821,7 +1117,13 @@ func (s *FirewallService) NewCreateFirewallRuleParams(ipaddressid string, protoc
 
 // Creates a firewall rule for a given IP address
 func (s *FirewallService) CreateFirewallRule(p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("createFirewallRule", p.toURLValues())
+	return s.CreateFirewallRuleWithContext(context.Background(), p)
+}
+
+// CreateFirewallRuleWithContext is the same as CreateFirewallRule, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *FirewallService) CreateFirewallRuleWithContext(ctx context.Context, p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createFirewallRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -833,15 +1135,15 @@ func (s *FirewallService) CreateFirewallRule(p *CreateFirewallRuleParams) (*Crea
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createFirewallRule", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
+		b, err = getRawValue(b, "firewallrule")
 		if err != nil {
 			return nil, err
 		}
@@ -858,6 +1160,51 @@ func (s *FirewallService) CreateFirewallRule(p *CreateFirewallRuleParams) (*Crea
 	return &r, nil
 }
 
+// CreateFirewallRuleJob is a handle to a running createFirewallRule async job
+type CreateFirewallRuleJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateFirewallRuleResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateFirewallRuleJob) Result() (*CreateFirewallRuleResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateFirewallRuleResponse{JobID: j.JobID()}
+	b, err = getRawValue(b, "firewallrule")
+	if err != nil {
+		return nil, err
+	}
+
+	b, err = convertFirewallServiceResponse(b)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// CreateFirewallRuleAsync starts the createFirewallRule async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *FirewallService) CreateFirewallRuleAsync(ctx context.Context, p *CreateFirewallRuleParams) (*CreateFirewallRuleJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createFirewallRule", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateFirewallRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateFirewallRuleJob{s.cs.newJobHandle("createFirewallRule", r.JobID)}, nil
+}
+
 type CreateFirewallRuleResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Cidrlist    string `json:"cidrlist,omitempty"`
@@ -890,6 +1237,10 @@ type DeleteFirewallRuleParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteFirewallRuleParams) command() string {
+	return "deleteFirewallRule"
+}
+
 func (p *DeleteFirewallRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -919,7 +1270,13 @@ func (s *FirewallService) NewDeleteFirewallRuleParams(id string) *DeleteFirewall
 
 // Deletes a firewall rule
 func (s *FirewallService) DeleteFirewallRule(p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteFirewallRule", p.toURLValues())
+	return s.DeleteFirewallRuleWithContext(context.Background(), p)
+}
+
+// DeleteFirewallRuleWithContext is the same as DeleteFirewallRule, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *FirewallService) DeleteFirewallRuleWithContext(ctx context.Context, p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteFirewallRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -931,19 +1288,14 @@ func (s *FirewallService) DeleteFirewallRule(p *DeleteFirewallRuleParams) (*Dele
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteFirewallRule", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = convertFirewallServiceResponse(b)
-		if err != nil {
-			return nil, err
-		}
-
 		if err := json.Unmarshal(b, &r); err != nil {
 			return nil, err
 		}
@@ -951,6 +1303,41 @@ func (s *FirewallService) DeleteFirewallRule(p *DeleteFirewallRuleParams) (*Dele
 	return &r, nil
 }
 
+// DeleteFirewallRuleJob is a handle to a running deleteFirewallRule async job
+type DeleteFirewallRuleJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteFirewallRuleResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteFirewallRuleJob) Result() (*DeleteFirewallRuleResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteFirewallRuleResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteFirewallRuleAsync starts the deleteFirewallRule async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *FirewallService) DeleteFirewallRuleAsync(ctx context.Context, p *DeleteFirewallRuleParams) (*DeleteFirewallRuleJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteFirewallRule", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteFirewallRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteFirewallRuleJob{s.cs.newJobHandle("deleteFirewallRule", r.JobID)}, nil
+}
+
 type DeleteFirewallRuleResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -961,6 +1348,10 @@ type UpdateFirewallRuleParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateFirewallRuleParams) command() string {
+	return "updateFirewallRule"
+}
+
 func (p *UpdateFirewallRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1011,7 +1402,13 @@ func (s *FirewallService) NewUpdateFirewallRuleParams(id string) *UpdateFirewall
 
 // Updates firewall rule
 func (s *FirewallService) UpdateFirewallRule(p *UpdateFirewallRuleParams) (*UpdateFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("updateFirewallRule", p.toURLValues())
+	return s.UpdateFirewallRuleWithContext(context.Background(), p)
+}
+
+// UpdateFirewallRuleWithContext is the same as UpdateFirewallRule, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *FirewallService) UpdateFirewallRuleWithContext(ctx context.Context, p *UpdateFirewallRuleParams) (*UpdateFirewallRuleResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateFirewallRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1023,15 +1420,15 @@ func (s *FirewallService) UpdateFirewallRule(p *UpdateFirewallRuleParams) (*Upda
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateFirewallRule", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
+		b, err = getRawValue(b, "firewallrule")
 		if err != nil {
 			return nil, err
 		}
@@ -1048,6 +1445,51 @@ func (s *FirewallService) UpdateFirewallRule(p *UpdateFirewallRuleParams) (*Upda
 	return &r, nil
 }
 
+// UpdateFirewallRuleJob is a handle to a running updateFirewallRule async job
+type UpdateFirewallRuleJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateFirewallRuleResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateFirewallRuleJob) Result() (*UpdateFirewallRuleResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateFirewallRuleResponse{JobID: j.JobID()}
+	b, err = getRawValue(b, "firewallrule")
+	if err != nil {
+		return nil, err
+	}
+
+	b, err = convertFirewallServiceResponse(b)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// UpdateFirewallRuleAsync starts the updateFirewallRule async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *FirewallService) UpdateFirewallRuleAsync(ctx context.Context, p *UpdateFirewallRuleParams) (*UpdateFirewallRuleJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateFirewallRule", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateFirewallRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateFirewallRuleJob{s.cs.newJobHandle("updateFirewallRule", r.JobID)}, nil
+}
+
 type UpdateFirewallRuleResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Cidrlist    string `json:"cidrlist,omitempty"`
@@ -1080,6 +1522,10 @@ type ListFirewallRulesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListFirewallRulesParams) command() string {
+	return "listFirewallRules"
+}
+
 func (p *ListFirewallRulesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1251,16 +1697,14 @@ func (s *FirewallService) GetFirewallRuleByID(id string, opts ...OptionFunc) (*F
 
 	l, err := s.ListFirewallRules(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -1272,10 +1716,17 @@ func (s *FirewallService) GetFirewallRuleByID(id string, opts ...OptionFunc) (*F
 
 // Lists all firewall rules for an IP address.
 func (s *FirewallService) ListFirewallRules(p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error) {
+	return s.ListFirewallRulesWithContext(context.Background(), p)
+}
+
+// ListFirewallRulesWithContext is the same as ListFirewallRules, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *FirewallService) ListFirewallRulesWithContext(ctx context.Context, p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error) {
 	var r ListFirewallRulesResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListFirewallRulesResponse
-		resp, err := s.cs.newRequest("listFirewallRules", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listFirewallRules", values)
 		if err != nil {
 			return nil, err
 		}
@@ -1287,13 +1738,89 @@ func (s *FirewallService) ListFirewallRules(p *ListFirewallRulesParams) (*ListFi
 		r.Count = l.Count
 		r.FirewallRules = append(r.FirewallRules, l.FirewallRules...)
 
-		if r.Count == len(r.FirewallRules) {
+		if r.Count == len(r.FirewallRules) || len(l.FirewallRules) == 0 {
+			s.cs.observePages("listFirewallRules", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.FirewallRules))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.FirewallRules)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listFirewallRules", values, len(l.FirewallRules), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListFirewallRulesResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.FirewallRules = append(r.FirewallRules, l.FirewallRules...)
+			}
+
+			if r.Count == len(r.FirewallRules) {
+				s.cs.observePages("listFirewallRules", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListFirewallRulesIterator iterates over the items returned by listFirewallRules, requesting
+// the pages of the response one at a time while iterating.
+type ListFirewallRulesIterator struct {
+	pager
+	items []*FirewallRule
+	index int
+}
+
+// NewListFirewallRulesIterator returns an iterator over the items returned by listFirewallRules, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *FirewallService) NewListFirewallRulesIterator(p *ListFirewallRulesParams, pagesize int) *ListFirewallRulesIterator {
+	return &ListFirewallRulesIterator{pager: newPager(s.cs, "listFirewallRules", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListFirewallRulesIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListFirewallRulesResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
 	}
+	i.received(l.Count, len(l.FirewallRules))
+
+	i.items = l.FirewallRules
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListFirewallRulesIterator) Value() *FirewallRule {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
 }
 
 type ListFirewallRulesResponse struct {
@@ -1332,6 +1859,10 @@ type CreatePortForwardingRuleParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreatePortForwardingRuleParams) command() string {
+	return "createPortForwardingRule"
+}
+
 func (p *CreatePortForwardingRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1482,7 +2013,13 @@ func (s *FirewallService) NewCreatePortForwardingRuleParams(ipaddressid string,
 
 // Creates a port forwarding rule
 func (s *FirewallService) CreatePortForwardingRule(p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("createPortForwardingRule", p.toURLValues())
+	return s.CreatePortForwardingRuleWithContext(context.Background(), p)
+}
+
+// CreatePortForwardingRuleWithContext is the same as CreatePortForwardingRule, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *FirewallService) CreatePortForwardingRuleWithContext(ctx context.Context, p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createPortForwardingRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1494,15 +2031,15 @@ func (s *FirewallService) CreatePortForwardingRule(p *CreatePortForwardingRulePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createPortForwardingRule", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
+		b, err = getRawValue(b, "portforwardingrule")
 		if err != nil {
 			return nil, err
 		}
@@ -1519,6 +2056,51 @@ func (s *FirewallService) CreatePortForwardingRule(p *CreatePortForwardingRulePa
 	return &r, nil
 }
 
+// CreatePortForwardingRuleJob is a handle to a running createPortForwardingRule async job
+type CreatePortForwardingRuleJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreatePortForwardingRuleResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreatePortForwardingRuleJob) Result() (*CreatePortForwardingRuleResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreatePortForwardingRuleResponse{JobID: j.JobID()}
+	b, err = getRawValue(b, "portforwardingrule")
+	if err != nil {
+		return nil, err
+	}
+
+	b, err = convertFirewallServiceResponse(b)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// CreatePortForwardingRuleAsync starts the createPortForwardingRule async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *FirewallService) CreatePortForwardingRuleAsync(ctx context.Context, p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createPortForwardingRule", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreatePortForwardingRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreatePortForwardingRuleJob{s.cs.newJobHandle("createPortForwardingRule", r.JobID)}, nil
+}
+
 type CreatePortForwardingRuleResponse struct {
 	JobID          string `json:"jobid,omitempty"`
 	Cidrlist       string `json:"cidrlist,omitempty"`
@@ -1555,6 +2137,10 @@ type DeletePortForwardingRuleParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeletePortForwardingRuleParams) command() string {
+	return "deletePortForwardingRule"
+}
+
 func (p *DeletePortForwardingRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1584,7 +2170,13 @@ func (s *FirewallService) NewDeletePortForwardingRuleParams(id string) *DeletePo
 
 // Deletes a port forwarding rule
 func (s *FirewallService) DeletePortForwardingRule(p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("deletePortForwardingRule", p.toURLValues())
+	return s.DeletePortForwardingRuleWithContext(context.Background(), p)
+}
+
+// DeletePortForwardingRuleWithContext is the same as DeletePortForwardingRule, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *FirewallService) DeletePortForwardingRuleWithContext(ctx context.Context, p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deletePortForwardingRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1596,19 +2188,14 @@ func (s *FirewallService) DeletePortForwardingRule(p *DeletePortForwardingRulePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deletePortForwardingRule", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = convertFirewallServiceResponse(b)
-		if err != nil {
-			return nil, err
-		}
-
 		if err := json.Unmarshal(b, &r); err != nil {
 			return nil, err
 		}
@@ -1616,6 +2203,41 @@ func (s *FirewallService) DeletePortForwardingRule(p *DeletePortForwardingRulePa
 	return &r, nil
 }
 
+// DeletePortForwardingRuleJob is a handle to a running deletePortForwardingRule async job
+type DeletePortForwardingRuleJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeletePortForwardingRuleResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeletePortForwardingRuleJob) Result() (*DeletePortForwardingRuleResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeletePortForwardingRuleResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeletePortForwardingRuleAsync starts the deletePortForwardingRule async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *FirewallService) DeletePortForwardingRuleAsync(ctx context.Context, p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deletePortForwardingRule", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeletePortForwardingRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeletePortForwardingRuleJob{s.cs.newJobHandle("deletePortForwardingRule", r.JobID)}, nil
+}
+
 type DeletePortForwardingRuleResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -1626,6 +2248,10 @@ type UpdatePortForwardingRuleParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdatePortForwardingRuleParams) command() string {
+	return "updatePortForwardingRule"
+}
+
 func (p *UpdatePortForwardingRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1707,7 +2333,13 @@ func (s *FirewallService) NewUpdatePortForwardingRuleParams(id string) *UpdatePo
 
 // Updates a port forwarding rule. Only the private port and the virtual machine can be updated.
 func (s *FirewallService) UpdatePortForwardingRule(p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("updatePortForwardingRule", p.toURLValues())
+	return s.UpdatePortForwardingRuleWithContext(context.Background(), p)
+}
+
+// UpdatePortForwardingRuleWithContext is the same as UpdatePortForwardingRule, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *FirewallService) UpdatePortForwardingRuleWithContext(ctx context.Context, p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updatePortForwardingRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1719,15 +2351,15 @@ func (s *FirewallService) UpdatePortForwardingRule(p *UpdatePortForwardingRulePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updatePortForwardingRule", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
+		b, err = getRawValue(b, "portforwardingrule")
 		if err != nil {
 			return nil, err
 		}
@@ -1744,6 +2376,51 @@ func (s *FirewallService) UpdatePortForwardingRule(p *UpdatePortForwardingRulePa
 	return &r, nil
 }
 
+// UpdatePortForwardingRuleJob is a handle to a running updatePortForwardingRule async job
+type UpdatePortForwardingRuleJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdatePortForwardingRuleResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdatePortForwardingRuleJob) Result() (*UpdatePortForwardingRuleResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdatePortForwardingRuleResponse{JobID: j.JobID()}
+	b, err = getRawValue(b, "portforwardingrule")
+	if err != nil {
+		return nil, err
+	}
+
+	b, err = convertFirewallServiceResponse(b)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// UpdatePortForwardingRuleAsync starts the updatePortForwardingRule async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *FirewallService) UpdatePortForwardingRuleAsync(ctx context.Context, p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updatePortForwardingRule", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdatePortForwardingRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdatePortForwardingRuleJob{s.cs.newJobHandle("updatePortForwardingRule", r.JobID)}, nil
+}
+
 type UpdatePortForwardingRuleResponse struct {
 	JobID          string `json:"jobid,omitempty"`
 	Cidrlist       string `json:"cidrlist,omitempty"`
@@ -1780,6 +2457,10 @@ type ListPortForwardingRulesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListPortForwardingRulesParams) command() string {
+	return "listPortForwardingRules"
+}
+
 func (p *ListPortForwardingRulesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1951,16 +2632,14 @@ func (s *FirewallService) GetPortForwardingRuleByID(id string, opts ...OptionFun
 
 	l, err := s.ListPortForwardingRules(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -1972,10 +2651,17 @@ func (s *FirewallService) GetPortForwardingRuleByID(id string, opts ...OptionFun
 
 // Lists all port forwarding rules for an IP address.
 func (s *FirewallService) ListPortForwardingRules(p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error) {
+	return s.ListPortForwardingRulesWithContext(context.Background(), p)
+}
+
+// ListPortForwardingRulesWithContext is the same as ListPortForwardingRules, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *FirewallService) ListPortForwardingRulesWithContext(ctx context.Context, p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error) {
 	var r ListPortForwardingRulesResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListPortForwardingRulesResponse
-		resp, err := s.cs.newRequest("listPortForwardingRules", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listPortForwardingRules", values)
 		if err != nil {
 			return nil, err
 		}
@@ -1987,13 +2673,89 @@ func (s *FirewallService) ListPortForwardingRules(p *ListPortForwardingRulesPara
 		r.Count = l.Count
 		r.PortForwardingRules = append(r.PortForwardingRules, l.PortForwardingRules...)
 
-		if r.Count == len(r.PortForwardingRules) {
+		if r.Count == len(r.PortForwardingRules) || len(l.PortForwardingRules) == 0 {
+			s.cs.observePages("listPortForwardingRules", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.PortForwardingRules))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.PortForwardingRules)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listPortForwardingRules", values, len(l.PortForwardingRules), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListPortForwardingRulesResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.PortForwardingRules = append(r.PortForwardingRules, l.PortForwardingRules...)
+			}
+
+			if r.Count == len(r.PortForwardingRules) {
+				s.cs.observePages("listPortForwardingRules", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListPortForwardingRulesIterator iterates over the items returned by listPortForwardingRules, requesting
+// the pages of the response one at a time while iterating.
+type ListPortForwardingRulesIterator struct {
+	pager
+	items []*PortForwardingRule
+	index int
+}
+
+// NewListPortForwardingRulesIterator returns an iterator over the items returned by listPortForwardingRules, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *FirewallService) NewListPortForwardingRulesIterator(p *ListPortForwardingRulesParams, pagesize int) *ListPortForwardingRulesIterator {
+	return &ListPortForwardingRulesIterator{pager: newPager(s.cs, "listPortForwardingRules", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListPortForwardingRulesIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListPortForwardingRulesResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.PortForwardingRules))
+
+	i.items = l.PortForwardingRules
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListPortForwardingRulesIterator) Value() *PortForwardingRule {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListPortForwardingRulesResponse struct {
@@ -0,0 +1,383 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockFirewallService is a mock of FirewallServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockFirewallService struct {
+	mockCalls
+
+	CreateEgressFirewallRuleFunc      func(ctx context.Context, p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error)
+	CreateEgressFirewallRuleAsyncFunc func(ctx context.Context, p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleJob, error)
+	DeleteEgressFirewallRuleFunc      func(ctx context.Context, p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error)
+	DeleteEgressFirewallRuleAsyncFunc func(ctx context.Context, p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleJob, error)
+	UpdateEgressFirewallRuleFunc      func(ctx context.Context, p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleResponse, error)
+	UpdateEgressFirewallRuleAsyncFunc func(ctx context.Context, p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleJob, error)
+	GetEgressFirewallRuleByIDFunc     func(id string, opts ...OptionFunc) (*EgressFirewallRule, int, error)
+	ListEgressFirewallRulesFunc       func(ctx context.Context, p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error)
+	CreateFirewallRuleFunc            func(ctx context.Context, p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error)
+	CreateFirewallRuleAsyncFunc       func(ctx context.Context, p *CreateFirewallRuleParams) (*CreateFirewallRuleJob, error)
+	DeleteFirewallRuleFunc            func(ctx context.Context, p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error)
+	DeleteFirewallRuleAsyncFunc       func(ctx context.Context, p *DeleteFirewallRuleParams) (*DeleteFirewallRuleJob, error)
+	UpdateFirewallRuleFunc            func(ctx context.Context, p *UpdateFirewallRuleParams) (*UpdateFirewallRuleResponse, error)
+	UpdateFirewallRuleAsyncFunc       func(ctx context.Context, p *UpdateFirewallRuleParams) (*UpdateFirewallRuleJob, error)
+	GetFirewallRuleByIDFunc           func(id string, opts ...OptionFunc) (*FirewallRule, int, error)
+	ListFirewallRulesFunc             func(ctx context.Context, p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error)
+	CreatePortForwardingRuleFunc      func(ctx context.Context, p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error)
+	CreatePortForwardingRuleAsyncFunc func(ctx context.Context, p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleJob, error)
+	DeletePortForwardingRuleFunc      func(ctx context.Context, p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error)
+	DeletePortForwardingRuleAsyncFunc func(ctx context.Context, p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleJob, error)
+	UpdatePortForwardingRuleFunc      func(ctx context.Context, p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleResponse, error)
+	UpdatePortForwardingRuleAsyncFunc func(ctx context.Context, p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleJob, error)
+	GetPortForwardingRuleByIDFunc     func(id string, opts ...OptionFunc) (*PortForwardingRule, int, error)
+	ListPortForwardingRulesFunc       func(ctx context.Context, p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error)
+}
+
+var _ FirewallServiceIface = &MockFirewallService{}
+
+func (m *MockFirewallService) NewCreateEgressFirewallRuleParams(networkid string, protocol string) *CreateEgressFirewallRuleParams {
+	return (&FirewallService{}).NewCreateEgressFirewallRuleParams(networkid, protocol)
+}
+
+func (m *MockFirewallService) CreateEgressFirewallRule(p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error) {
+	return m.CreateEgressFirewallRuleWithContext(context.Background(), p)
+}
+
+func (m *MockFirewallService) CreateEgressFirewallRuleWithContext(ctx context.Context, p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error) {
+	m.record("CreateEgressFirewallRule", p)
+	if m.CreateEgressFirewallRuleFunc == nil {
+		return nil, notMocked("MockFirewallService", "CreateEgressFirewallRule")
+	}
+	return m.CreateEgressFirewallRuleFunc(ctx, p)
+}
+
+func (m *MockFirewallService) CreateEgressFirewallRuleAsync(ctx context.Context, p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleJob, error) {
+	m.record("CreateEgressFirewallRuleAsync", p)
+	if m.CreateEgressFirewallRuleAsyncFunc == nil {
+		return nil, notMocked("MockFirewallService", "CreateEgressFirewallRuleAsync")
+	}
+	return m.CreateEgressFirewallRuleAsyncFunc(ctx, p)
+}
+
+func (m *MockFirewallService) NewDeleteEgressFirewallRuleParams(id string) *DeleteEgressFirewallRuleParams {
+	return (&FirewallService{}).NewDeleteEgressFirewallRuleParams(id)
+}
+
+func (m *MockFirewallService) DeleteEgressFirewallRule(p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error) {
+	return m.DeleteEgressFirewallRuleWithContext(context.Background(), p)
+}
+
+func (m *MockFirewallService) DeleteEgressFirewallRuleWithContext(ctx context.Context, p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error) {
+	m.record("DeleteEgressFirewallRule", p)
+	if m.DeleteEgressFirewallRuleFunc == nil {
+		return nil, notMocked("MockFirewallService", "DeleteEgressFirewallRule")
+	}
+	return m.DeleteEgressFirewallRuleFunc(ctx, p)
+}
+
+func (m *MockFirewallService) DeleteEgressFirewallRuleAsync(ctx context.Context, p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleJob, error) {
+	m.record("DeleteEgressFirewallRuleAsync", p)
+	if m.DeleteEgressFirewallRuleAsyncFunc == nil {
+		return nil, notMocked("MockFirewallService", "DeleteEgressFirewallRuleAsync")
+	}
+	return m.DeleteEgressFirewallRuleAsyncFunc(ctx, p)
+}
+
+func (m *MockFirewallService) NewUpdateEgressFirewallRuleParams(id string) *UpdateEgressFirewallRuleParams {
+	return (&FirewallService{}).NewUpdateEgressFirewallRuleParams(id)
+}
+
+func (m *MockFirewallService) UpdateEgressFirewallRule(p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleResponse, error) {
+	return m.UpdateEgressFirewallRuleWithContext(context.Background(), p)
+}
+
+func (m *MockFirewallService) UpdateEgressFirewallRuleWithContext(ctx context.Context, p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleResponse, error) {
+	m.record("UpdateEgressFirewallRule", p)
+	if m.UpdateEgressFirewallRuleFunc == nil {
+		return nil, notMocked("MockFirewallService", "UpdateEgressFirewallRule")
+	}
+	return m.UpdateEgressFirewallRuleFunc(ctx, p)
+}
+
+func (m *MockFirewallService) UpdateEgressFirewallRuleAsync(ctx context.Context, p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleJob, error) {
+	m.record("UpdateEgressFirewallRuleAsync", p)
+	if m.UpdateEgressFirewallRuleAsyncFunc == nil {
+		return nil, notMocked("MockFirewallService", "UpdateEgressFirewallRuleAsync")
+	}
+	return m.UpdateEgressFirewallRuleAsyncFunc(ctx, p)
+}
+
+func (m *MockFirewallService) NewListEgressFirewallRulesParams() *ListEgressFirewallRulesParams {
+	return (&FirewallService{}).NewListEgressFirewallRulesParams()
+}
+
+func (m *MockFirewallService) GetEgressFirewallRuleByID(id string, opts ...OptionFunc) (*EgressFirewallRule, int, error) {
+	m.record("GetEgressFirewallRuleByID", id, opts)
+	if m.GetEgressFirewallRuleByIDFunc == nil {
+		return nil, -1, notMocked("MockFirewallService", "GetEgressFirewallRuleByID")
+	}
+	return m.GetEgressFirewallRuleByIDFunc(id, opts...)
+}
+
+func (m *MockFirewallService) ListEgressFirewallRules(p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error) {
+	return m.ListEgressFirewallRulesWithContext(context.Background(), p)
+}
+
+func (m *MockFirewallService) ListEgressFirewallRulesWithContext(ctx context.Context, p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error) {
+	m.record("ListEgressFirewallRules", p)
+	if m.ListEgressFirewallRulesFunc == nil {
+		return nil, notMocked("MockFirewallService", "ListEgressFirewallRules")
+	}
+	return m.ListEgressFirewallRulesFunc(ctx, p)
+}
+
+func (m *MockFirewallService) NewListEgressFirewallRulesIterator(p *ListEgressFirewallRulesParams, pagesize int) *ListEgressFirewallRulesIterator {
+	i := &ListEgressFirewallRulesIterator{pager: newPager(nil, "listEgressFirewallRules", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListEgressFirewallRulesParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListEgressFirewallRulesWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockFirewallService) NewCreateFirewallRuleParams(ipaddressid string, protocol string) *CreateFirewallRuleParams {
+	return (&FirewallService{}).NewCreateFirewallRuleParams(ipaddressid, protocol)
+}
+
+func (m *MockFirewallService) CreateFirewallRule(p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error) {
+	return m.CreateFirewallRuleWithContext(context.Background(), p)
+}
+
+func (m *MockFirewallService) CreateFirewallRuleWithContext(ctx context.Context, p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error) {
+	m.record("CreateFirewallRule", p)
+	if m.CreateFirewallRuleFunc == nil {
+		return nil, notMocked("MockFirewallService", "CreateFirewallRule")
+	}
+	return m.CreateFirewallRuleFunc(ctx, p)
+}
+
+func (m *MockFirewallService) CreateFirewallRuleAsync(ctx context.Context, p *CreateFirewallRuleParams) (*CreateFirewallRuleJob, error) {
+	m.record("CreateFirewallRuleAsync", p)
+	if m.CreateFirewallRuleAsyncFunc == nil {
+		return nil, notMocked("MockFirewallService", "CreateFirewallRuleAsync")
+	}
+	return m.CreateFirewallRuleAsyncFunc(ctx, p)
+}
+
+func (m *MockFirewallService) NewDeleteFirewallRuleParams(id string) *DeleteFirewallRuleParams {
+	return (&FirewallService{}).NewDeleteFirewallRuleParams(id)
+}
+
+func (m *MockFirewallService) DeleteFirewallRule(p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error) {
+	return m.DeleteFirewallRuleWithContext(context.Background(), p)
+}
+
+func (m *MockFirewallService) DeleteFirewallRuleWithContext(ctx context.Context, p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error) {
+	m.record("DeleteFirewallRule", p)
+	if m.DeleteFirewallRuleFunc == nil {
+		return nil, notMocked("MockFirewallService", "DeleteFirewallRule")
+	}
+	return m.DeleteFirewallRuleFunc(ctx, p)
+}
+
+func (m *MockFirewallService) DeleteFirewallRuleAsync(ctx context.Context, p *DeleteFirewallRuleParams) (*DeleteFirewallRuleJob, error) {
+	m.record("DeleteFirewallRuleAsync", p)
+	if m.DeleteFirewallRuleAsyncFunc == nil {
+		return nil, notMocked("MockFirewallService", "DeleteFirewallRuleAsync")
+	}
+	return m.DeleteFirewallRuleAsyncFunc(ctx, p)
+}
+
+func (m *MockFirewallService) NewUpdateFirewallRuleParams(id string) *UpdateFirewallRuleParams {
+	return (&FirewallService{}).NewUpdateFirewallRuleParams(id)
+}
+
+func (m *MockFirewallService) UpdateFirewallRule(p *UpdateFirewallRuleParams) (*UpdateFirewallRuleResponse, error) {
+	return m.UpdateFirewallRuleWithContext(context.Background(), p)
+}
+
+func (m *MockFirewallService) UpdateFirewallRuleWithContext(ctx context.Context, p *UpdateFirewallRuleParams) (*UpdateFirewallRuleResponse, error) {
+	m.record("UpdateFirewallRule", p)
+	if m.UpdateFirewallRuleFunc == nil {
+		return nil, notMocked("MockFirewallService", "UpdateFirewallRule")
+	}
+	return m.UpdateFirewallRuleFunc(ctx, p)
+}
+
+func (m *MockFirewallService) UpdateFirewallRuleAsync(ctx context.Context, p *UpdateFirewallRuleParams) (*UpdateFirewallRuleJob, error) {
+	m.record("UpdateFirewallRuleAsync", p)
+	if m.UpdateFirewallRuleAsyncFunc == nil {
+		return nil, notMocked("MockFirewallService", "UpdateFirewallRuleAsync")
+	}
+	return m.UpdateFirewallRuleAsyncFunc(ctx, p)
+}
+
+func (m *MockFirewallService) NewListFirewallRulesParams() *ListFirewallRulesParams {
+	return (&FirewallService{}).NewListFirewallRulesParams()
+}
+
+func (m *MockFirewallService) GetFirewallRuleByID(id string, opts ...OptionFunc) (*FirewallRule, int, error) {
+	m.record("GetFirewallRuleByID", id, opts)
+	if m.GetFirewallRuleByIDFunc == nil {
+		return nil, -1, notMocked("MockFirewallService", "GetFirewallRuleByID")
+	}
+	return m.GetFirewallRuleByIDFunc(id, opts...)
+}
+
+func (m *MockFirewallService) ListFirewallRules(p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error) {
+	return m.ListFirewallRulesWithContext(context.Background(), p)
+}
+
+func (m *MockFirewallService) ListFirewallRulesWithContext(ctx context.Context, p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error) {
+	m.record("ListFirewallRules", p)
+	if m.ListFirewallRulesFunc == nil {
+		return nil, notMocked("MockFirewallService", "ListFirewallRules")
+	}
+	return m.ListFirewallRulesFunc(ctx, p)
+}
+
+func (m *MockFirewallService) NewListFirewallRulesIterator(p *ListFirewallRulesParams, pagesize int) *ListFirewallRulesIterator {
+	i := &ListFirewallRulesIterator{pager: newPager(nil, "listFirewallRules", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListFirewallRulesParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListFirewallRulesWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockFirewallService) NewCreatePortForwardingRuleParams(ipaddressid string, privateport int, protocol string, publicport int, virtualmachineid string) *CreatePortForwardingRuleParams {
+	return (&FirewallService{}).NewCreatePortForwardingRuleParams(ipaddressid, privateport, protocol, publicport, virtualmachineid)
+}
+
+func (m *MockFirewallService) CreatePortForwardingRule(p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error) {
+	return m.CreatePortForwardingRuleWithContext(context.Background(), p)
+}
+
+func (m *MockFirewallService) CreatePortForwardingRuleWithContext(ctx context.Context, p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error) {
+	m.record("CreatePortForwardingRule", p)
+	if m.CreatePortForwardingRuleFunc == nil {
+		return nil, notMocked("MockFirewallService", "CreatePortForwardingRule")
+	}
+	return m.CreatePortForwardingRuleFunc(ctx, p)
+}
+
+func (m *MockFirewallService) CreatePortForwardingRuleAsync(ctx context.Context, p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleJob, error) {
+	m.record("CreatePortForwardingRuleAsync", p)
+	if m.CreatePortForwardingRuleAsyncFunc == nil {
+		return nil, notMocked("MockFirewallService", "CreatePortForwardingRuleAsync")
+	}
+	return m.CreatePortForwardingRuleAsyncFunc(ctx, p)
+}
+
+func (m *MockFirewallService) NewDeletePortForwardingRuleParams(id string) *DeletePortForwardingRuleParams {
+	return (&FirewallService{}).NewDeletePortForwardingRuleParams(id)
+}
+
+func (m *MockFirewallService) DeletePortForwardingRule(p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error) {
+	return m.DeletePortForwardingRuleWithContext(context.Background(), p)
+}
+
+func (m *MockFirewallService) DeletePortForwardingRuleWithContext(ctx context.Context, p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error) {
+	m.record("DeletePortForwardingRule", p)
+	if m.DeletePortForwardingRuleFunc == nil {
+		return nil, notMocked("MockFirewallService", "DeletePortForwardingRule")
+	}
+	return m.DeletePortForwardingRuleFunc(ctx, p)
+}
+
+func (m *MockFirewallService) DeletePortForwardingRuleAsync(ctx context.Context, p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleJob, error) {
+	m.record("DeletePortForwardingRuleAsync", p)
+	if m.DeletePortForwardingRuleAsyncFunc == nil {
+		return nil, notMocked("MockFirewallService", "DeletePortForwardingRuleAsync")
+	}
+	return m.DeletePortForwardingRuleAsyncFunc(ctx, p)
+}
+
+func (m *MockFirewallService) NewUpdatePortForwardingRuleParams(id string) *UpdatePortForwardingRuleParams {
+	return (&FirewallService{}).NewUpdatePortForwardingRuleParams(id)
+}
+
+func (m *MockFirewallService) UpdatePortForwardingRule(p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleResponse, error) {
+	return m.UpdatePortForwardingRuleWithContext(context.Background(), p)
+}
+
+func (m *MockFirewallService) UpdatePortForwardingRuleWithContext(ctx context.Context, p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleResponse, error) {
+	m.record("UpdatePortForwardingRule", p)
+	if m.UpdatePortForwardingRuleFunc == nil {
+		return nil, notMocked("MockFirewallService", "UpdatePortForwardingRule")
+	}
+	return m.UpdatePortForwardingRuleFunc(ctx, p)
+}
+
+func (m *MockFirewallService) UpdatePortForwardingRuleAsync(ctx context.Context, p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleJob, error) {
+	m.record("UpdatePortForwardingRuleAsync", p)
+	if m.UpdatePortForwardingRuleAsyncFunc == nil {
+		return nil, notMocked("MockFirewallService", "UpdatePortForwardingRuleAsync")
+	}
+	return m.UpdatePortForwardingRuleAsyncFunc(ctx, p)
+}
+
+func (m *MockFirewallService) NewListPortForwardingRulesParams() *ListPortForwardingRulesParams {
+	return (&FirewallService{}).NewListPortForwardingRulesParams()
+}
+
+func (m *MockFirewallService) GetPortForwardingRuleByID(id string, opts ...OptionFunc) (*PortForwardingRule, int, error) {
+	m.record("GetPortForwardingRuleByID", id, opts)
+	if m.GetPortForwardingRuleByIDFunc == nil {
+		return nil, -1, notMocked("MockFirewallService", "GetPortForwardingRuleByID")
+	}
+	return m.GetPortForwardingRuleByIDFunc(id, opts...)
+}
+
+func (m *MockFirewallService) ListPortForwardingRules(p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error) {
+	return m.ListPortForwardingRulesWithContext(context.Background(), p)
+}
+
+func (m *MockFirewallService) ListPortForwardingRulesWithContext(ctx context.Context, p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error) {
+	m.record("ListPortForwardingRules", p)
+	if m.ListPortForwardingRulesFunc == nil {
+		return nil, notMocked("MockFirewallService", "ListPortForwardingRules")
+	}
+	return m.ListPortForwardingRulesFunc(ctx, p)
+}
+
+func (m *MockFirewallService) NewListPortForwardingRulesIterator(p *ListPortForwardingRulesParams, pagesize int) *ListPortForwardingRulesIterator {
+	i := &ListPortForwardingRulesIterator{pager: newPager(nil, "listPortForwardingRules", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListPortForwardingRulesParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListPortForwardingRulesWithContext(ctx, c)
+	}
+	return i
+}
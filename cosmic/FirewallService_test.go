@@ -0,0 +1,325 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCreateEgressFirewallRuleParams(t *testing.T) {
+	p := &CreateEgressFirewallRuleParams{}
+	want := url.Values{}
+	p.SetCidrlist([]string{"a", "b"})
+	want.Set("cidrlist", "a,b")
+	p.SetEndport(42)
+	want.Set("endport", "42")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetIcmpcode(42)
+	want.Set("icmpcode", "42")
+	p.SetIcmptype(42)
+	want.Set("icmptype", "42")
+	p.SetNetworkid("networkid-value")
+	want.Set("networkid", "networkid-value")
+	p.SetProtocol("protocol-value")
+	want.Set("protocol", "protocol-value")
+	p.SetStartport(42)
+	want.Set("startport", "42")
+	p.SetType("type-value")
+	want.Set("type", "type-value")
+
+	assertParams(t, p, "createEgressFirewallRule", want)
+}
+
+func TestCreateEgressFirewallRuleResponse(t *testing.T) {
+	assertDecodes(t, `{"cidrlist":"cidrlist-value","endport":42,"fordisplay":true,"icmpcode":42,"icmptype":42,"id":"id-value","ipaddress":"ipaddress-value","ipaddressid":"ipaddressid-value","jobid":"jobid-value","networkid":"networkid-value","protocol":"protocol-value","startport":42,"state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}]}`, &CreateEgressFirewallRuleResponse{})
+}
+
+func TestDeleteEgressFirewallRuleParams(t *testing.T) {
+	p := &DeleteEgressFirewallRuleParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteEgressFirewallRule", want)
+}
+
+func TestDeleteEgressFirewallRuleResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteEgressFirewallRuleResponse{})
+}
+
+func TestUpdateEgressFirewallRuleParams(t *testing.T) {
+	p := &UpdateEgressFirewallRuleParams{}
+	want := url.Values{}
+	p.SetCustomid("customid-value")
+	want.Set("customid", "customid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "updateEgressFirewallRule", want)
+}
+
+func TestUpdateEgressFirewallRuleResponse(t *testing.T) {
+	assertDecodes(t, `{"cidrlist":"cidrlist-value","endport":42,"fordisplay":true,"icmpcode":42,"icmptype":42,"id":"id-value","ipaddress":"ipaddress-value","ipaddressid":"ipaddressid-value","jobid":"jobid-value","networkid":"networkid-value","protocol":"protocol-value","startport":42,"state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}]}`, &UpdateEgressFirewallRuleResponse{})
+}
+
+func TestListEgressFirewallRulesParams(t *testing.T) {
+	p := &ListEgressFirewallRulesParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIpaddressid("ipaddressid-value")
+	want.Set("ipaddressid", "ipaddressid-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetNetworkid("networkid-value")
+	want.Set("networkid", "networkid-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetTags(map[string]string{"k": "v"})
+	want.Set("tags[0].key", "k")
+	want.Set("tags[0].value", "v")
+
+	assertParams(t, p, "listEgressFirewallRules", want)
+}
+
+func TestListEgressFirewallRulesResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"firewallrule":[{"cidrlist":"cidrlist-value","endport":42,"fordisplay":true,"icmpcode":42,"icmptype":42,"id":"id-value","ipaddress":"ipaddress-value","ipaddressid":"ipaddressid-value","networkid":"networkid-value","protocol":"protocol-value","startport":42,"state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}]}]}`, &ListEgressFirewallRulesResponse{})
+}
+
+func TestCreateFirewallRuleParams(t *testing.T) {
+	p := &CreateFirewallRuleParams{}
+	want := url.Values{}
+	p.SetCidrlist([]string{"a", "b"})
+	want.Set("cidrlist", "a,b")
+	p.SetEndport(42)
+	want.Set("endport", "42")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetIcmpcode(42)
+	want.Set("icmpcode", "42")
+	p.SetIcmptype(42)
+	want.Set("icmptype", "42")
+	p.SetIpaddressid("ipaddressid-value")
+	want.Set("ipaddressid", "ipaddressid-value")
+	p.SetProtocol("protocol-value")
+	want.Set("protocol", "protocol-value")
+	p.SetStartport(42)
+	want.Set("startport", "42")
+	p.SetType("type-value")
+	want.Set("type", "type-value")
+
+	assertParams(t, p, "createFirewallRule", want)
+}
+
+func TestCreateFirewallRuleResponse(t *testing.T) {
+	assertDecodes(t, `{"cidrlist":"cidrlist-value","endport":42,"fordisplay":true,"icmpcode":42,"icmptype":42,"id":"id-value","ipaddress":"ipaddress-value","ipaddressid":"ipaddressid-value","jobid":"jobid-value","networkid":"networkid-value","protocol":"protocol-value","startport":42,"state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}]}`, &CreateFirewallRuleResponse{})
+}
+
+func TestDeleteFirewallRuleParams(t *testing.T) {
+	p := &DeleteFirewallRuleParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteFirewallRule", want)
+}
+
+func TestDeleteFirewallRuleResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteFirewallRuleResponse{})
+}
+
+func TestUpdateFirewallRuleParams(t *testing.T) {
+	p := &UpdateFirewallRuleParams{}
+	want := url.Values{}
+	p.SetCustomid("customid-value")
+	want.Set("customid", "customid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "updateFirewallRule", want)
+}
+
+func TestUpdateFirewallRuleResponse(t *testing.T) {
+	assertDecodes(t, `{"cidrlist":"cidrlist-value","endport":42,"fordisplay":true,"icmpcode":42,"icmptype":42,"id":"id-value","ipaddress":"ipaddress-value","ipaddressid":"ipaddressid-value","jobid":"jobid-value","networkid":"networkid-value","protocol":"protocol-value","startport":42,"state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}]}`, &UpdateFirewallRuleResponse{})
+}
+
+func TestListFirewallRulesParams(t *testing.T) {
+	p := &ListFirewallRulesParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIpaddressid("ipaddressid-value")
+	want.Set("ipaddressid", "ipaddressid-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetNetworkid("networkid-value")
+	want.Set("networkid", "networkid-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetTags(map[string]string{"k": "v"})
+	want.Set("tags[0].key", "k")
+	want.Set("tags[0].value", "v")
+
+	assertParams(t, p, "listFirewallRules", want)
+}
+
+func TestListFirewallRulesResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"firewallrule":[{"cidrlist":"cidrlist-value","endport":42,"fordisplay":true,"icmpcode":42,"icmptype":42,"id":"id-value","ipaddress":"ipaddress-value","ipaddressid":"ipaddressid-value","networkid":"networkid-value","protocol":"protocol-value","startport":42,"state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}]}]}`, &ListFirewallRulesResponse{})
+}
+
+func TestCreatePortForwardingRuleParams(t *testing.T) {
+	p := &CreatePortForwardingRuleParams{}
+	want := url.Values{}
+	p.SetCidrlist([]string{"a", "b"})
+	want.Set("cidrlist", "a,b")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetIpaddressid("ipaddressid-value")
+	want.Set("ipaddressid", "ipaddressid-value")
+	p.SetNetworkid("networkid-value")
+	want.Set("networkid", "networkid-value")
+	p.SetOpenfirewall(true)
+	want.Set("openfirewall", "true")
+	p.SetPrivateendport(42)
+	want.Set("privateendport", "42")
+	p.SetPrivateport(42)
+	want.Set("privateport", "42")
+	p.SetProtocol("protocol-value")
+	want.Set("protocol", "protocol-value")
+	p.SetPublicendport(42)
+	want.Set("publicendport", "42")
+	p.SetPublicport(42)
+	want.Set("publicport", "42")
+	p.SetVirtualmachineid("virtualmachineid-value")
+	want.Set("virtualmachineid", "virtualmachineid-value")
+	p.SetVmguestip("vmguestip-value")
+	want.Set("vmguestip", "vmguestip-value")
+
+	assertParams(t, p, "createPortForwardingRule", want)
+}
+
+func TestCreatePortForwardingRuleResponse(t *testing.T) {
+	assertDecodes(t, `{"cidrlist":"cidrlist-value","fordisplay":true,"id":"id-value","ipaddress":"ipaddress-value","ipaddressid":"ipaddressid-value","jobid":"jobid-value","networkid":"networkid-value","privateendport":"privateendport-value","privateport":"privateport-value","protocol":"protocol-value","publicendport":"publicendport-value","publicport":"publicport-value","state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"virtualmachinedisplayname":"virtualmachinedisplayname-value","virtualmachineid":"virtualmachineid-value","virtualmachinename":"virtualmachinename-value","vmguestip":"vmguestip-value"}`, &CreatePortForwardingRuleResponse{})
+}
+
+func TestDeletePortForwardingRuleParams(t *testing.T) {
+	p := &DeletePortForwardingRuleParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deletePortForwardingRule", want)
+}
+
+func TestDeletePortForwardingRuleResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeletePortForwardingRuleResponse{})
+}
+
+func TestUpdatePortForwardingRuleParams(t *testing.T) {
+	p := &UpdatePortForwardingRuleParams{}
+	want := url.Values{}
+	p.SetCustomid("customid-value")
+	want.Set("customid", "customid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetPrivateport(42)
+	want.Set("privateport", "42")
+	p.SetVirtualmachineid("virtualmachineid-value")
+	want.Set("virtualmachineid", "virtualmachineid-value")
+	p.SetVmguestip("vmguestip-value")
+	want.Set("vmguestip", "vmguestip-value")
+
+	assertParams(t, p, "updatePortForwardingRule", want)
+}
+
+func TestUpdatePortForwardingRuleResponse(t *testing.T) {
+	assertDecodes(t, `{"cidrlist":"cidrlist-value","fordisplay":true,"id":"id-value","ipaddress":"ipaddress-value","ipaddressid":"ipaddressid-value","jobid":"jobid-value","networkid":"networkid-value","privateendport":"privateendport-value","privateport":"privateport-value","protocol":"protocol-value","publicendport":"publicendport-value","publicport":"publicport-value","state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"virtualmachinedisplayname":"virtualmachinedisplayname-value","virtualmachineid":"virtualmachineid-value","virtualmachinename":"virtualmachinename-value","vmguestip":"vmguestip-value"}`, &UpdatePortForwardingRuleResponse{})
+}
+
+func TestListPortForwardingRulesParams(t *testing.T) {
+	p := &ListPortForwardingRulesParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIpaddressid("ipaddressid-value")
+	want.Set("ipaddressid", "ipaddressid-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetNetworkid("networkid-value")
+	want.Set("networkid", "networkid-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetTags(map[string]string{"k": "v"})
+	want.Set("tags[0].key", "k")
+	want.Set("tags[0].value", "v")
+
+	assertParams(t, p, "listPortForwardingRules", want)
+}
+
+func TestListPortForwardingRulesResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"portforwardingrule":[{"cidrlist":"cidrlist-value","fordisplay":true,"id":"id-value","ipaddress":"ipaddress-value","ipaddressid":"ipaddressid-value","networkid":"networkid-value","privateendport":"privateendport-value","privateport":"privateport-value","protocol":"protocol-value","publicendport":"publicendport-value","publicport":"publicport-value","state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"virtualmachinedisplayname":"virtualmachinedisplayname-value","virtualmachineid":"virtualmachineid-value","virtualmachinename":"virtualmachinename-value","vmguestip":"vmguestip-value"}]}`, &ListPortForwardingRulesResponse{})
+}
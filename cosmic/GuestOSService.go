@@ -17,17 +17,67 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
+// GuestOSServiceIface is the interface implemented by GuestOSService, and by MockGuestOSService for tests
+type GuestOSServiceIface interface {
+	NewAddGuestOsParams(oscategoryid string, osdisplayname string) *AddGuestOsParams
+	AddGuestOs(p *AddGuestOsParams) (*AddGuestOsResponse, error)
+	AddGuestOsWithContext(ctx context.Context, p *AddGuestOsParams) (*AddGuestOsResponse, error)
+	AddGuestOsAsync(ctx context.Context, p *AddGuestOsParams) (*AddGuestOsJob, error)
+	NewRemoveGuestOsParams(id string) *RemoveGuestOsParams
+	RemoveGuestOs(p *RemoveGuestOsParams) (*RemoveGuestOsResponse, error)
+	RemoveGuestOsWithContext(ctx context.Context, p *RemoveGuestOsParams) (*RemoveGuestOsResponse, error)
+	RemoveGuestOsAsync(ctx context.Context, p *RemoveGuestOsParams) (*RemoveGuestOsJob, error)
+	NewUpdateGuestOsParams(id string, osdisplayname string) *UpdateGuestOsParams
+	UpdateGuestOs(p *UpdateGuestOsParams) (*UpdateGuestOsResponse, error)
+	UpdateGuestOsWithContext(ctx context.Context, p *UpdateGuestOsParams) (*UpdateGuestOsResponse, error)
+	UpdateGuestOsAsync(ctx context.Context, p *UpdateGuestOsParams) (*UpdateGuestOsJob, error)
+	NewAddGuestOsMappingParams(hypervisor string, hypervisorversion string, osnameforhypervisor string) *AddGuestOsMappingParams
+	AddGuestOsMapping(p *AddGuestOsMappingParams) (*AddGuestOsMappingResponse, error)
+	AddGuestOsMappingWithContext(ctx context.Context, p *AddGuestOsMappingParams) (*AddGuestOsMappingResponse, error)
+	AddGuestOsMappingAsync(ctx context.Context, p *AddGuestOsMappingParams) (*AddGuestOsMappingJob, error)
+	NewListGuestOsMappingParams() *ListGuestOsMappingParams
+	GetGuestOsMappingByID(id string, opts ...OptionFunc) (*GuestOsMapping, int, error)
+	ListGuestOsMapping(p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error)
+	ListGuestOsMappingWithContext(ctx context.Context, p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error)
+	NewListGuestOsMappingIterator(p *ListGuestOsMappingParams, pagesize int) *ListGuestOsMappingIterator
+	NewRemoveGuestOsMappingParams(id string) *RemoveGuestOsMappingParams
+	RemoveGuestOsMapping(p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingResponse, error)
+	RemoveGuestOsMappingWithContext(ctx context.Context, p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingResponse, error)
+	RemoveGuestOsMappingAsync(ctx context.Context, p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingJob, error)
+	NewUpdateGuestOsMappingParams(id string, osnameforhypervisor string) *UpdateGuestOsMappingParams
+	UpdateGuestOsMapping(p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingResponse, error)
+	UpdateGuestOsMappingWithContext(ctx context.Context, p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingResponse, error)
+	UpdateGuestOsMappingAsync(ctx context.Context, p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingJob, error)
+	NewListOsCategoriesParams() *ListOsCategoriesParams
+	GetOsCategoryID(name string, opts ...OptionFunc) (string, int, error)
+	GetOsCategoryByName(name string, opts ...OptionFunc) (*OsCategory, int, error)
+	GetOsCategoryByID(id string, opts ...OptionFunc) (*OsCategory, int, error)
+	ListOsCategories(p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error)
+	ListOsCategoriesWithContext(ctx context.Context, p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error)
+	NewListOsCategoriesIterator(p *ListOsCategoriesParams, pagesize int) *ListOsCategoriesIterator
+	NewListOsTypesParams() *ListOsTypesParams
+	GetOsTypeByID(id string, opts ...OptionFunc) (*OsType, int, error)
+	ListOsTypes(p *ListOsTypesParams) (*ListOsTypesResponse, error)
+	ListOsTypesWithContext(ctx context.Context, p *ListOsTypesParams) (*ListOsTypesResponse, error)
+	NewListOsTypesIterator(p *ListOsTypesParams, pagesize int) *ListOsTypesIterator
+}
+
 type AddGuestOsParams struct {
 	p map[string]interface{}
 }
 
+func (p *AddGuestOsParams) command() string {
+	return "addGuestOs"
+}
+
 func (p *AddGuestOsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -78,7 +128,13 @@ func (s *GuestOSService) NewAddGuestOsParams(oscategoryid string, osdisplayname
 
 // Add a new guest OS type
 func (s *GuestOSService) AddGuestOs(p *AddGuestOsParams) (*AddGuestOsResponse, error) {
-	resp, err := s.cs.newRequest("addGuestOs", p.toURLValues())
+	return s.AddGuestOsWithContext(context.Background(), p)
+}
+
+// AddGuestOsWithContext is the same as AddGuestOs, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *GuestOSService) AddGuestOsWithContext(ctx context.Context, p *AddGuestOsParams) (*AddGuestOsResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "addGuestOs", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -90,26 +146,56 @@ func (s *GuestOSService) AddGuestOs(p *AddGuestOsParams) (*AddGuestOsResponse, e
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "addGuestOs", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "guestos", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// AddGuestOsJob is a handle to a running addGuestOs async job
+type AddGuestOsJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a AddGuestOsResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *AddGuestOsJob) Result() (*AddGuestOsResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := AddGuestOsResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "guestos", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// AddGuestOsAsync starts the addGuestOs async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *GuestOSService) AddGuestOsAsync(ctx context.Context, p *AddGuestOsParams) (*AddGuestOsJob, error) {
+	resp, err := s.cs.newRequest(ctx, "addGuestOs", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddGuestOsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &AddGuestOsJob{s.cs.newJobHandle("addGuestOs", r.JobID)}, nil
+}
+
 type AddGuestOsResponse struct {
 	JobID         string `json:"jobid,omitempty"`
 	Description   string `json:"description,omitempty"`
@@ -122,6 +208,10 @@ type RemoveGuestOsParams struct {
 	p map[string]interface{}
 }
 
+func (p *RemoveGuestOsParams) command() string {
+	return "removeGuestOs"
+}
+
 func (p *RemoveGuestOsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -151,7 +241,13 @@ func (s *GuestOSService) NewRemoveGuestOsParams(id string) *RemoveGuestOsParams
 
 // Removes a Guest OS from listing.
 func (s *GuestOSService) RemoveGuestOs(p *RemoveGuestOsParams) (*RemoveGuestOsResponse, error) {
-	resp, err := s.cs.newRequest("removeGuestOs", p.toURLValues())
+	return s.RemoveGuestOsWithContext(context.Background(), p)
+}
+
+// RemoveGuestOsWithContext is the same as RemoveGuestOs, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *GuestOSService) RemoveGuestOsWithContext(ctx context.Context, p *RemoveGuestOsParams) (*RemoveGuestOsResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "removeGuestOs", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -163,9 +259,9 @@ func (s *GuestOSService) RemoveGuestOs(p *RemoveGuestOsParams) (*RemoveGuestOsRe
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "removeGuestOs", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -178,6 +274,41 @@ func (s *GuestOSService) RemoveGuestOs(p *RemoveGuestOsParams) (*RemoveGuestOsRe
 	return &r, nil
 }
 
+// RemoveGuestOsJob is a handle to a running removeGuestOs async job
+type RemoveGuestOsJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a RemoveGuestOsResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *RemoveGuestOsJob) Result() (*RemoveGuestOsResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := RemoveGuestOsResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// RemoveGuestOsAsync starts the removeGuestOs async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *GuestOSService) RemoveGuestOsAsync(ctx context.Context, p *RemoveGuestOsParams) (*RemoveGuestOsJob, error) {
+	resp, err := s.cs.newRequest(ctx, "removeGuestOs", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RemoveGuestOsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &RemoveGuestOsJob{s.cs.newJobHandle("removeGuestOs", r.JobID)}, nil
+}
+
 type RemoveGuestOsResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -188,6 +319,10 @@ type UpdateGuestOsParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateGuestOsParams) command() string {
+	return "updateGuestOs"
+}
+
 func (p *UpdateGuestOsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -228,7 +363,13 @@ func (s *GuestOSService) NewUpdateGuestOsParams(id string, osdisplayname string)
 
 // Updates the information about Guest OS
 func (s *GuestOSService) UpdateGuestOs(p *UpdateGuestOsParams) (*UpdateGuestOsResponse, error) {
-	resp, err := s.cs.newRequest("updateGuestOs", p.toURLValues())
+	return s.UpdateGuestOsWithContext(context.Background(), p)
+}
+
+// UpdateGuestOsWithContext is the same as UpdateGuestOs, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *GuestOSService) UpdateGuestOsWithContext(ctx context.Context, p *UpdateGuestOsParams) (*UpdateGuestOsResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateGuestOs", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -240,26 +381,56 @@ func (s *GuestOSService) UpdateGuestOs(p *UpdateGuestOsParams) (*UpdateGuestOsRe
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateGuestOs", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "guestos", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// UpdateGuestOsJob is a handle to a running updateGuestOs async job
+type UpdateGuestOsJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateGuestOsResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateGuestOsJob) Result() (*UpdateGuestOsResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateGuestOsResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "guestos", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// UpdateGuestOsAsync starts the updateGuestOs async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *GuestOSService) UpdateGuestOsAsync(ctx context.Context, p *UpdateGuestOsParams) (*UpdateGuestOsJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateGuestOs", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateGuestOsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateGuestOsJob{s.cs.newJobHandle("updateGuestOs", r.JobID)}, nil
+}
+
 type UpdateGuestOsResponse struct {
 	JobID         string `json:"jobid,omitempty"`
 	Description   string `json:"description,omitempty"`
@@ -272,6 +443,10 @@ type AddGuestOsMappingParams struct {
 	p map[string]interface{}
 }
 
+func (p *AddGuestOsMappingParams) command() string {
+	return "addGuestOsMapping"
+}
+
 func (p *AddGuestOsMappingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -343,7 +518,13 @@ func (s *GuestOSService) NewAddGuestOsMappingParams(hypervisor string, hyperviso
 
 // Adds a guest OS name to hypervisor OS name mapping
 func (s *GuestOSService) AddGuestOsMapping(p *AddGuestOsMappingParams) (*AddGuestOsMappingResponse, error) {
-	resp, err := s.cs.newRequest("addGuestOsMapping", p.toURLValues())
+	return s.AddGuestOsMappingWithContext(context.Background(), p)
+}
+
+// AddGuestOsMappingWithContext is the same as AddGuestOsMapping, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *GuestOSService) AddGuestOsMappingWithContext(ctx context.Context, p *AddGuestOsMappingParams) (*AddGuestOsMappingResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "addGuestOsMapping", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -355,26 +536,56 @@ func (s *GuestOSService) AddGuestOsMapping(p *AddGuestOsMappingParams) (*AddGues
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "addGuestOsMapping", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "guestosmapping", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// AddGuestOsMappingJob is a handle to a running addGuestOsMapping async job
+type AddGuestOsMappingJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a AddGuestOsMappingResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *AddGuestOsMappingJob) Result() (*AddGuestOsMappingResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := AddGuestOsMappingResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "guestosmapping", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// AddGuestOsMappingAsync starts the addGuestOsMapping async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *GuestOSService) AddGuestOsMappingAsync(ctx context.Context, p *AddGuestOsMappingParams) (*AddGuestOsMappingJob, error) {
+	resp, err := s.cs.newRequest(ctx, "addGuestOsMapping", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddGuestOsMappingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &AddGuestOsMappingJob{s.cs.newJobHandle("addGuestOsMapping", r.JobID)}, nil
+}
+
 type AddGuestOsMappingResponse struct {
 	JobID               string `json:"jobid,omitempty"`
 	Hypervisor          string `json:"hypervisor,omitempty"`
@@ -390,6 +601,10 @@ type ListGuestOsMappingParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListGuestOsMappingParams) command() string {
+	return "listGuestOsMapping"
+}
+
 func (p *ListGuestOsMappingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -493,16 +708,14 @@ func (s *GuestOSService) GetGuestOsMappingByID(id string, opts ...OptionFunc) (*
 
 	l, err := s.ListGuestOsMapping(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -514,10 +727,17 @@ func (s *GuestOSService) GetGuestOsMappingByID(id string, opts ...OptionFunc) (*
 
 // Lists all available OS mappings for given hypervisor
 func (s *GuestOSService) ListGuestOsMapping(p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error) {
+	return s.ListGuestOsMappingWithContext(context.Background(), p)
+}
+
+// ListGuestOsMappingWithContext is the same as ListGuestOsMapping, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *GuestOSService) ListGuestOsMappingWithContext(ctx context.Context, p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error) {
 	var r ListGuestOsMappingResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListGuestOsMappingResponse
-		resp, err := s.cs.newRequest("listGuestOsMapping", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listGuestOsMapping", values)
 		if err != nil {
 			return nil, err
 		}
@@ -529,13 +749,89 @@ func (s *GuestOSService) ListGuestOsMapping(p *ListGuestOsMappingParams) (*ListG
 		r.Count = l.Count
 		r.GuestOsMapping = append(r.GuestOsMapping, l.GuestOsMapping...)
 
-		if r.Count == len(r.GuestOsMapping) {
+		if r.Count == len(r.GuestOsMapping) || len(l.GuestOsMapping) == 0 {
+			s.cs.observePages("listGuestOsMapping", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.GuestOsMapping))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.GuestOsMapping)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listGuestOsMapping", values, len(l.GuestOsMapping), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListGuestOsMappingResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.GuestOsMapping = append(r.GuestOsMapping, l.GuestOsMapping...)
+			}
+
+			if r.Count == len(r.GuestOsMapping) {
+				s.cs.observePages("listGuestOsMapping", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListGuestOsMappingIterator iterates over the items returned by listGuestOsMapping, requesting
+// the pages of the response one at a time while iterating.
+type ListGuestOsMappingIterator struct {
+	pager
+	items []*GuestOsMapping
+	index int
+}
+
+// NewListGuestOsMappingIterator returns an iterator over the items returned by listGuestOsMapping, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *GuestOSService) NewListGuestOsMappingIterator(p *ListGuestOsMappingParams, pagesize int) *ListGuestOsMappingIterator {
+	return &ListGuestOsMappingIterator{pager: newPager(s.cs, "listGuestOsMapping", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListGuestOsMappingIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListGuestOsMappingResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.GuestOsMapping))
+
+	i.items = l.GuestOsMapping
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListGuestOsMappingIterator) Value() *GuestOsMapping {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListGuestOsMappingResponse struct {
@@ -557,6 +853,10 @@ type RemoveGuestOsMappingParams struct {
 	p map[string]interface{}
 }
 
+func (p *RemoveGuestOsMappingParams) command() string {
+	return "removeGuestOsMapping"
+}
+
 func (p *RemoveGuestOsMappingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -586,7 +886,13 @@ func (s *GuestOSService) NewRemoveGuestOsMappingParams(id string) *RemoveGuestOs
 
 // Removes a Guest OS Mapping.
 func (s *GuestOSService) RemoveGuestOsMapping(p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingResponse, error) {
-	resp, err := s.cs.newRequest("removeGuestOsMapping", p.toURLValues())
+	return s.RemoveGuestOsMappingWithContext(context.Background(), p)
+}
+
+// RemoveGuestOsMappingWithContext is the same as RemoveGuestOsMapping, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *GuestOSService) RemoveGuestOsMappingWithContext(ctx context.Context, p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "removeGuestOsMapping", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -598,9 +904,9 @@ func (s *GuestOSService) RemoveGuestOsMapping(p *RemoveGuestOsMappingParams) (*R
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "removeGuestOsMapping", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -613,6 +919,41 @@ func (s *GuestOSService) RemoveGuestOsMapping(p *RemoveGuestOsMappingParams) (*R
 	return &r, nil
 }
 
+// RemoveGuestOsMappingJob is a handle to a running removeGuestOsMapping async job
+type RemoveGuestOsMappingJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a RemoveGuestOsMappingResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *RemoveGuestOsMappingJob) Result() (*RemoveGuestOsMappingResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := RemoveGuestOsMappingResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// RemoveGuestOsMappingAsync starts the removeGuestOsMapping async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *GuestOSService) RemoveGuestOsMappingAsync(ctx context.Context, p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingJob, error) {
+	resp, err := s.cs.newRequest(ctx, "removeGuestOsMapping", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RemoveGuestOsMappingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &RemoveGuestOsMappingJob{s.cs.newJobHandle("removeGuestOsMapping", r.JobID)}, nil
+}
+
 type RemoveGuestOsMappingResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -623,6 +964,10 @@ type UpdateGuestOsMappingParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateGuestOsMappingParams) command() string {
+	return "updateGuestOsMapping"
+}
+
 func (p *UpdateGuestOsMappingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -663,7 +1008,13 @@ func (s *GuestOSService) NewUpdateGuestOsMappingParams(id string, osnameforhyper
 
 // Updates the information about Guest OS to Hypervisor specific name mapping
 func (s *GuestOSService) UpdateGuestOsMapping(p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingResponse, error) {
-	resp, err := s.cs.newRequest("updateGuestOsMapping", p.toURLValues())
+	return s.UpdateGuestOsMappingWithContext(context.Background(), p)
+}
+
+// UpdateGuestOsMappingWithContext is the same as UpdateGuestOsMapping, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *GuestOSService) UpdateGuestOsMappingWithContext(ctx context.Context, p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateGuestOsMapping", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -675,26 +1026,56 @@ func (s *GuestOSService) UpdateGuestOsMapping(p *UpdateGuestOsMappingParams) (*U
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateGuestOsMapping", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "guestosmapping", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// UpdateGuestOsMappingJob is a handle to a running updateGuestOsMapping async job
+type UpdateGuestOsMappingJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateGuestOsMappingResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateGuestOsMappingJob) Result() (*UpdateGuestOsMappingResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateGuestOsMappingResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "guestosmapping", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// UpdateGuestOsMappingAsync starts the updateGuestOsMapping async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *GuestOSService) UpdateGuestOsMappingAsync(ctx context.Context, p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateGuestOsMapping", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateGuestOsMappingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateGuestOsMappingJob{s.cs.newJobHandle("updateGuestOsMapping", r.JobID)}, nil
+}
+
 type UpdateGuestOsMappingResponse struct {
 	JobID               string `json:"jobid,omitempty"`
 	Hypervisor          string `json:"hypervisor,omitempty"`
@@ -710,6 +1091,10 @@ type ListOsCategoriesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListOsCategoriesParams) command() string {
+	return "listOsCategories"
+}
+
 func (p *ListOsCategoriesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -797,7 +1182,7 @@ func (s *GuestOSService) GetOsCategoryID(name string, opts ...OptionFunc) (strin
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -811,7 +1196,7 @@ func (s *GuestOSService) GetOsCategoryID(name string, opts ...OptionFunc) (strin
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -843,16 +1228,14 @@ func (s *GuestOSService) GetOsCategoryByID(id string, opts ...OptionFunc) (*OsCa
 
 	l, err := s.ListOsCategories(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -864,10 +1247,17 @@ func (s *GuestOSService) GetOsCategoryByID(id string, opts ...OptionFunc) (*OsCa
 
 // Lists all supported OS categories for this cloud.
 func (s *GuestOSService) ListOsCategories(p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error) {
+	return s.ListOsCategoriesWithContext(context.Background(), p)
+}
+
+// ListOsCategoriesWithContext is the same as ListOsCategories, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *GuestOSService) ListOsCategoriesWithContext(ctx context.Context, p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error) {
 	var r ListOsCategoriesResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListOsCategoriesResponse
-		resp, err := s.cs.newRequest("listOsCategories", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listOsCategories", values)
 		if err != nil {
 			return nil, err
 		}
@@ -879,13 +1269,89 @@ func (s *GuestOSService) ListOsCategories(p *ListOsCategoriesParams) (*ListOsCat
 		r.Count = l.Count
 		r.OsCategories = append(r.OsCategories, l.OsCategories...)
 
-		if r.Count == len(r.OsCategories) {
+		if r.Count == len(r.OsCategories) || len(l.OsCategories) == 0 {
+			s.cs.observePages("listOsCategories", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.OsCategories))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.OsCategories)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listOsCategories", values, len(l.OsCategories), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListOsCategoriesResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.OsCategories = append(r.OsCategories, l.OsCategories...)
+			}
+
+			if r.Count == len(r.OsCategories) {
+				s.cs.observePages("listOsCategories", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListOsCategoriesIterator iterates over the items returned by listOsCategories, requesting
+// the pages of the response one at a time while iterating.
+type ListOsCategoriesIterator struct {
+	pager
+	items []*OsCategory
+	index int
+}
+
+// NewListOsCategoriesIterator returns an iterator over the items returned by listOsCategories, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *GuestOSService) NewListOsCategoriesIterator(p *ListOsCategoriesParams, pagesize int) *ListOsCategoriesIterator {
+	return &ListOsCategoriesIterator{pager: newPager(s.cs, "listOsCategories", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListOsCategoriesIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListOsCategoriesResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.OsCategories))
+
+	i.items = l.OsCategories
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListOsCategoriesIterator) Value() *OsCategory {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListOsCategoriesResponse struct {
@@ -902,6 +1368,10 @@ type ListOsTypesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListOsTypesParams) command() string {
+	return "listOsTypes"
+}
+
 func (p *ListOsTypesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -995,16 +1465,14 @@ func (s *GuestOSService) GetOsTypeByID(id string, opts ...OptionFunc) (*OsType,
 
 	l, err := s.ListOsTypes(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -1016,10 +1484,17 @@ func (s *GuestOSService) GetOsTypeByID(id string, opts ...OptionFunc) (*OsType,
 
 // Lists all supported OS types for this cloud.
 func (s *GuestOSService) ListOsTypes(p *ListOsTypesParams) (*ListOsTypesResponse, error) {
+	return s.ListOsTypesWithContext(context.Background(), p)
+}
+
+// ListOsTypesWithContext is the same as ListOsTypes, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *GuestOSService) ListOsTypesWithContext(ctx context.Context, p *ListOsTypesParams) (*ListOsTypesResponse, error) {
 	var r ListOsTypesResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListOsTypesResponse
-		resp, err := s.cs.newRequest("listOsTypes", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listOsTypes", values)
 		if err != nil {
 			return nil, err
 		}
@@ -1031,13 +1506,89 @@ func (s *GuestOSService) ListOsTypes(p *ListOsTypesParams) (*ListOsTypesResponse
 		r.Count = l.Count
 		r.OsTypes = append(r.OsTypes, l.OsTypes...)
 
-		if r.Count == len(r.OsTypes) {
+		if r.Count == len(r.OsTypes) || len(l.OsTypes) == 0 {
+			s.cs.observePages("listOsTypes", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.OsTypes))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.OsTypes)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listOsTypes", values, len(l.OsTypes), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListOsTypesResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.OsTypes = append(r.OsTypes, l.OsTypes...)
+			}
+
+			if r.Count == len(r.OsTypes) {
+				s.cs.observePages("listOsTypes", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListOsTypesIterator iterates over the items returned by listOsTypes, requesting
+// the pages of the response one at a time while iterating.
+type ListOsTypesIterator struct {
+	pager
+	items []*OsType
+	index int
+}
+
+// NewListOsTypesIterator returns an iterator over the items returned by listOsTypes, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *GuestOSService) NewListOsTypesIterator(p *ListOsTypesParams, pagesize int) *ListOsTypesIterator {
+	return &ListOsTypesIterator{pager: newPager(s.cs, "listOsTypes", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListOsTypesIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListOsTypesResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.OsTypes))
+
+	i.items = l.OsTypes
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListOsTypesIterator) Value() *OsType {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListOsTypesResponse struct {
@@ -0,0 +1,323 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockGuestOSService is a mock of GuestOSServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockGuestOSService struct {
+	mockCalls
+
+	AddGuestOsFunc                func(ctx context.Context, p *AddGuestOsParams) (*AddGuestOsResponse, error)
+	AddGuestOsAsyncFunc           func(ctx context.Context, p *AddGuestOsParams) (*AddGuestOsJob, error)
+	RemoveGuestOsFunc             func(ctx context.Context, p *RemoveGuestOsParams) (*RemoveGuestOsResponse, error)
+	RemoveGuestOsAsyncFunc        func(ctx context.Context, p *RemoveGuestOsParams) (*RemoveGuestOsJob, error)
+	UpdateGuestOsFunc             func(ctx context.Context, p *UpdateGuestOsParams) (*UpdateGuestOsResponse, error)
+	UpdateGuestOsAsyncFunc        func(ctx context.Context, p *UpdateGuestOsParams) (*UpdateGuestOsJob, error)
+	AddGuestOsMappingFunc         func(ctx context.Context, p *AddGuestOsMappingParams) (*AddGuestOsMappingResponse, error)
+	AddGuestOsMappingAsyncFunc    func(ctx context.Context, p *AddGuestOsMappingParams) (*AddGuestOsMappingJob, error)
+	GetGuestOsMappingByIDFunc     func(id string, opts ...OptionFunc) (*GuestOsMapping, int, error)
+	ListGuestOsMappingFunc        func(ctx context.Context, p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error)
+	RemoveGuestOsMappingFunc      func(ctx context.Context, p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingResponse, error)
+	RemoveGuestOsMappingAsyncFunc func(ctx context.Context, p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingJob, error)
+	UpdateGuestOsMappingFunc      func(ctx context.Context, p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingResponse, error)
+	UpdateGuestOsMappingAsyncFunc func(ctx context.Context, p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingJob, error)
+	GetOsCategoryIDFunc           func(name string, opts ...OptionFunc) (string, int, error)
+	GetOsCategoryByNameFunc       func(name string, opts ...OptionFunc) (*OsCategory, int, error)
+	GetOsCategoryByIDFunc         func(id string, opts ...OptionFunc) (*OsCategory, int, error)
+	ListOsCategoriesFunc          func(ctx context.Context, p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error)
+	GetOsTypeByIDFunc             func(id string, opts ...OptionFunc) (*OsType, int, error)
+	ListOsTypesFunc               func(ctx context.Context, p *ListOsTypesParams) (*ListOsTypesResponse, error)
+}
+
+var _ GuestOSServiceIface = &MockGuestOSService{}
+
+func (m *MockGuestOSService) NewAddGuestOsParams(oscategoryid string, osdisplayname string) *AddGuestOsParams {
+	return (&GuestOSService{}).NewAddGuestOsParams(oscategoryid, osdisplayname)
+}
+
+func (m *MockGuestOSService) AddGuestOs(p *AddGuestOsParams) (*AddGuestOsResponse, error) {
+	return m.AddGuestOsWithContext(context.Background(), p)
+}
+
+func (m *MockGuestOSService) AddGuestOsWithContext(ctx context.Context, p *AddGuestOsParams) (*AddGuestOsResponse, error) {
+	m.record("AddGuestOs", p)
+	if m.AddGuestOsFunc == nil {
+		return nil, notMocked("MockGuestOSService", "AddGuestOs")
+	}
+	return m.AddGuestOsFunc(ctx, p)
+}
+
+func (m *MockGuestOSService) AddGuestOsAsync(ctx context.Context, p *AddGuestOsParams) (*AddGuestOsJob, error) {
+	m.record("AddGuestOsAsync", p)
+	if m.AddGuestOsAsyncFunc == nil {
+		return nil, notMocked("MockGuestOSService", "AddGuestOsAsync")
+	}
+	return m.AddGuestOsAsyncFunc(ctx, p)
+}
+
+func (m *MockGuestOSService) NewRemoveGuestOsParams(id string) *RemoveGuestOsParams {
+	return (&GuestOSService{}).NewRemoveGuestOsParams(id)
+}
+
+func (m *MockGuestOSService) RemoveGuestOs(p *RemoveGuestOsParams) (*RemoveGuestOsResponse, error) {
+	return m.RemoveGuestOsWithContext(context.Background(), p)
+}
+
+func (m *MockGuestOSService) RemoveGuestOsWithContext(ctx context.Context, p *RemoveGuestOsParams) (*RemoveGuestOsResponse, error) {
+	m.record("RemoveGuestOs", p)
+	if m.RemoveGuestOsFunc == nil {
+		return nil, notMocked("MockGuestOSService", "RemoveGuestOs")
+	}
+	return m.RemoveGuestOsFunc(ctx, p)
+}
+
+func (m *MockGuestOSService) RemoveGuestOsAsync(ctx context.Context, p *RemoveGuestOsParams) (*RemoveGuestOsJob, error) {
+	m.record("RemoveGuestOsAsync", p)
+	if m.RemoveGuestOsAsyncFunc == nil {
+		return nil, notMocked("MockGuestOSService", "RemoveGuestOsAsync")
+	}
+	return m.RemoveGuestOsAsyncFunc(ctx, p)
+}
+
+func (m *MockGuestOSService) NewUpdateGuestOsParams(id string, osdisplayname string) *UpdateGuestOsParams {
+	return (&GuestOSService{}).NewUpdateGuestOsParams(id, osdisplayname)
+}
+
+func (m *MockGuestOSService) UpdateGuestOs(p *UpdateGuestOsParams) (*UpdateGuestOsResponse, error) {
+	return m.UpdateGuestOsWithContext(context.Background(), p)
+}
+
+func (m *MockGuestOSService) UpdateGuestOsWithContext(ctx context.Context, p *UpdateGuestOsParams) (*UpdateGuestOsResponse, error) {
+	m.record("UpdateGuestOs", p)
+	if m.UpdateGuestOsFunc == nil {
+		return nil, notMocked("MockGuestOSService", "UpdateGuestOs")
+	}
+	return m.UpdateGuestOsFunc(ctx, p)
+}
+
+func (m *MockGuestOSService) UpdateGuestOsAsync(ctx context.Context, p *UpdateGuestOsParams) (*UpdateGuestOsJob, error) {
+	m.record("UpdateGuestOsAsync", p)
+	if m.UpdateGuestOsAsyncFunc == nil {
+		return nil, notMocked("MockGuestOSService", "UpdateGuestOsAsync")
+	}
+	return m.UpdateGuestOsAsyncFunc(ctx, p)
+}
+
+func (m *MockGuestOSService) NewAddGuestOsMappingParams(hypervisor string, hypervisorversion string, osnameforhypervisor string) *AddGuestOsMappingParams {
+	return (&GuestOSService{}).NewAddGuestOsMappingParams(hypervisor, hypervisorversion, osnameforhypervisor)
+}
+
+func (m *MockGuestOSService) AddGuestOsMapping(p *AddGuestOsMappingParams) (*AddGuestOsMappingResponse, error) {
+	return m.AddGuestOsMappingWithContext(context.Background(), p)
+}
+
+func (m *MockGuestOSService) AddGuestOsMappingWithContext(ctx context.Context, p *AddGuestOsMappingParams) (*AddGuestOsMappingResponse, error) {
+	m.record("AddGuestOsMapping", p)
+	if m.AddGuestOsMappingFunc == nil {
+		return nil, notMocked("MockGuestOSService", "AddGuestOsMapping")
+	}
+	return m.AddGuestOsMappingFunc(ctx, p)
+}
+
+func (m *MockGuestOSService) AddGuestOsMappingAsync(ctx context.Context, p *AddGuestOsMappingParams) (*AddGuestOsMappingJob, error) {
+	m.record("AddGuestOsMappingAsync", p)
+	if m.AddGuestOsMappingAsyncFunc == nil {
+		return nil, notMocked("MockGuestOSService", "AddGuestOsMappingAsync")
+	}
+	return m.AddGuestOsMappingAsyncFunc(ctx, p)
+}
+
+func (m *MockGuestOSService) NewListGuestOsMappingParams() *ListGuestOsMappingParams {
+	return (&GuestOSService{}).NewListGuestOsMappingParams()
+}
+
+func (m *MockGuestOSService) GetGuestOsMappingByID(id string, opts ...OptionFunc) (*GuestOsMapping, int, error) {
+	m.record("GetGuestOsMappingByID", id, opts)
+	if m.GetGuestOsMappingByIDFunc == nil {
+		return nil, -1, notMocked("MockGuestOSService", "GetGuestOsMappingByID")
+	}
+	return m.GetGuestOsMappingByIDFunc(id, opts...)
+}
+
+func (m *MockGuestOSService) ListGuestOsMapping(p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error) {
+	return m.ListGuestOsMappingWithContext(context.Background(), p)
+}
+
+func (m *MockGuestOSService) ListGuestOsMappingWithContext(ctx context.Context, p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error) {
+	m.record("ListGuestOsMapping", p)
+	if m.ListGuestOsMappingFunc == nil {
+		return nil, notMocked("MockGuestOSService", "ListGuestOsMapping")
+	}
+	return m.ListGuestOsMappingFunc(ctx, p)
+}
+
+func (m *MockGuestOSService) NewListGuestOsMappingIterator(p *ListGuestOsMappingParams, pagesize int) *ListGuestOsMappingIterator {
+	i := &ListGuestOsMappingIterator{pager: newPager(nil, "listGuestOsMapping", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListGuestOsMappingParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListGuestOsMappingWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockGuestOSService) NewRemoveGuestOsMappingParams(id string) *RemoveGuestOsMappingParams {
+	return (&GuestOSService{}).NewRemoveGuestOsMappingParams(id)
+}
+
+func (m *MockGuestOSService) RemoveGuestOsMapping(p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingResponse, error) {
+	return m.RemoveGuestOsMappingWithContext(context.Background(), p)
+}
+
+func (m *MockGuestOSService) RemoveGuestOsMappingWithContext(ctx context.Context, p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingResponse, error) {
+	m.record("RemoveGuestOsMapping", p)
+	if m.RemoveGuestOsMappingFunc == nil {
+		return nil, notMocked("MockGuestOSService", "RemoveGuestOsMapping")
+	}
+	return m.RemoveGuestOsMappingFunc(ctx, p)
+}
+
+func (m *MockGuestOSService) RemoveGuestOsMappingAsync(ctx context.Context, p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingJob, error) {
+	m.record("RemoveGuestOsMappingAsync", p)
+	if m.RemoveGuestOsMappingAsyncFunc == nil {
+		return nil, notMocked("MockGuestOSService", "RemoveGuestOsMappingAsync")
+	}
+	return m.RemoveGuestOsMappingAsyncFunc(ctx, p)
+}
+
+func (m *MockGuestOSService) NewUpdateGuestOsMappingParams(id string, osnameforhypervisor string) *UpdateGuestOsMappingParams {
+	return (&GuestOSService{}).NewUpdateGuestOsMappingParams(id, osnameforhypervisor)
+}
+
+func (m *MockGuestOSService) UpdateGuestOsMapping(p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingResponse, error) {
+	return m.UpdateGuestOsMappingWithContext(context.Background(), p)
+}
+
+func (m *MockGuestOSService) UpdateGuestOsMappingWithContext(ctx context.Context, p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingResponse, error) {
+	m.record("UpdateGuestOsMapping", p)
+	if m.UpdateGuestOsMappingFunc == nil {
+		return nil, notMocked("MockGuestOSService", "UpdateGuestOsMapping")
+	}
+	return m.UpdateGuestOsMappingFunc(ctx, p)
+}
+
+func (m *MockGuestOSService) UpdateGuestOsMappingAsync(ctx context.Context, p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingJob, error) {
+	m.record("UpdateGuestOsMappingAsync", p)
+	if m.UpdateGuestOsMappingAsyncFunc == nil {
+		return nil, notMocked("MockGuestOSService", "UpdateGuestOsMappingAsync")
+	}
+	return m.UpdateGuestOsMappingAsyncFunc(ctx, p)
+}
+
+func (m *MockGuestOSService) NewListOsCategoriesParams() *ListOsCategoriesParams {
+	return (&GuestOSService{}).NewListOsCategoriesParams()
+}
+
+func (m *MockGuestOSService) GetOsCategoryID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetOsCategoryID", name, opts)
+	if m.GetOsCategoryIDFunc == nil {
+		return "", -1, notMocked("MockGuestOSService", "GetOsCategoryID")
+	}
+	return m.GetOsCategoryIDFunc(name, opts...)
+}
+
+func (m *MockGuestOSService) GetOsCategoryByName(name string, opts ...OptionFunc) (*OsCategory, int, error) {
+	m.record("GetOsCategoryByName", name, opts)
+	if m.GetOsCategoryByNameFunc == nil {
+		return nil, -1, notMocked("MockGuestOSService", "GetOsCategoryByName")
+	}
+	return m.GetOsCategoryByNameFunc(name, opts...)
+}
+
+func (m *MockGuestOSService) GetOsCategoryByID(id string, opts ...OptionFunc) (*OsCategory, int, error) {
+	m.record("GetOsCategoryByID", id, opts)
+	if m.GetOsCategoryByIDFunc == nil {
+		return nil, -1, notMocked("MockGuestOSService", "GetOsCategoryByID")
+	}
+	return m.GetOsCategoryByIDFunc(id, opts...)
+}
+
+func (m *MockGuestOSService) ListOsCategories(p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error) {
+	return m.ListOsCategoriesWithContext(context.Background(), p)
+}
+
+func (m *MockGuestOSService) ListOsCategoriesWithContext(ctx context.Context, p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error) {
+	m.record("ListOsCategories", p)
+	if m.ListOsCategoriesFunc == nil {
+		return nil, notMocked("MockGuestOSService", "ListOsCategories")
+	}
+	return m.ListOsCategoriesFunc(ctx, p)
+}
+
+func (m *MockGuestOSService) NewListOsCategoriesIterator(p *ListOsCategoriesParams, pagesize int) *ListOsCategoriesIterator {
+	i := &ListOsCategoriesIterator{pager: newPager(nil, "listOsCategories", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListOsCategoriesParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListOsCategoriesWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockGuestOSService) NewListOsTypesParams() *ListOsTypesParams {
+	return (&GuestOSService{}).NewListOsTypesParams()
+}
+
+func (m *MockGuestOSService) GetOsTypeByID(id string, opts ...OptionFunc) (*OsType, int, error) {
+	m.record("GetOsTypeByID", id, opts)
+	if m.GetOsTypeByIDFunc == nil {
+		return nil, -1, notMocked("MockGuestOSService", "GetOsTypeByID")
+	}
+	return m.GetOsTypeByIDFunc(id, opts...)
+}
+
+func (m *MockGuestOSService) ListOsTypes(p *ListOsTypesParams) (*ListOsTypesResponse, error) {
+	return m.ListOsTypesWithContext(context.Background(), p)
+}
+
+func (m *MockGuestOSService) ListOsTypesWithContext(ctx context.Context, p *ListOsTypesParams) (*ListOsTypesResponse, error) {
+	m.record("ListOsTypes", p)
+	if m.ListOsTypesFunc == nil {
+		return nil, notMocked("MockGuestOSService", "ListOsTypes")
+	}
+	return m.ListOsTypesFunc(ctx, p)
+}
+
+func (m *MockGuestOSService) NewListOsTypesIterator(p *ListOsTypesParams, pagesize int) *ListOsTypesIterator {
+	i := &ListOsTypesIterator{pager: newPager(nil, "listOsTypes", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListOsTypesParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListOsTypesWithContext(ctx, c)
+	}
+	return i
+}
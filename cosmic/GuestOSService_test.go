@@ -0,0 +1,185 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestAddGuestOsParams(t *testing.T) {
+	p := &AddGuestOsParams{}
+	want := url.Values{}
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetOscategoryid("oscategoryid-value")
+	want.Set("oscategoryid", "oscategoryid-value")
+	p.SetOsdisplayname("osdisplayname-value")
+	want.Set("osdisplayname", "osdisplayname-value")
+
+	assertParams(t, p, "addGuestOs", want)
+}
+
+func TestAddGuestOsResponse(t *testing.T) {
+	assertDecodes(t, `{"description":"description-value","id":"id-value","isuserdefined":"isuserdefined-value","jobid":"jobid-value","oscategoryid":"oscategoryid-value"}`, &AddGuestOsResponse{})
+}
+
+func TestRemoveGuestOsParams(t *testing.T) {
+	p := &RemoveGuestOsParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "removeGuestOs", want)
+}
+
+func TestRemoveGuestOsResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &RemoveGuestOsResponse{})
+}
+
+func TestUpdateGuestOsParams(t *testing.T) {
+	p := &UpdateGuestOsParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetOsdisplayname("osdisplayname-value")
+	want.Set("osdisplayname", "osdisplayname-value")
+
+	assertParams(t, p, "updateGuestOs", want)
+}
+
+func TestUpdateGuestOsResponse(t *testing.T) {
+	assertDecodes(t, `{"description":"description-value","id":"id-value","isuserdefined":"isuserdefined-value","jobid":"jobid-value","oscategoryid":"oscategoryid-value"}`, &UpdateGuestOsResponse{})
+}
+
+func TestAddGuestOsMappingParams(t *testing.T) {
+	p := &AddGuestOsMappingParams{}
+	want := url.Values{}
+	p.SetHypervisor("hypervisor-value")
+	want.Set("hypervisor", "hypervisor-value")
+	p.SetHypervisorversion("hypervisorversion-value")
+	want.Set("hypervisorversion", "hypervisorversion-value")
+	p.SetOsdisplayname("osdisplayname-value")
+	want.Set("osdisplayname", "osdisplayname-value")
+	p.SetOsnameforhypervisor("osnameforhypervisor-value")
+	want.Set("osnameforhypervisor", "osnameforhypervisor-value")
+	p.SetOstypeid("ostypeid-value")
+	want.Set("ostypeid", "ostypeid-value")
+
+	assertParams(t, p, "addGuestOsMapping", want)
+}
+
+func TestAddGuestOsMappingResponse(t *testing.T) {
+	assertDecodes(t, `{"hypervisor":"hypervisor-value","hypervisorversion":"hypervisorversion-value","id":"id-value","isuserdefined":"isuserdefined-value","jobid":"jobid-value","osdisplayname":"osdisplayname-value","osnameforhypervisor":"osnameforhypervisor-value","ostypeid":"ostypeid-value"}`, &AddGuestOsMappingResponse{})
+}
+
+func TestListGuestOsMappingParams(t *testing.T) {
+	p := &ListGuestOsMappingParams{}
+	want := url.Values{}
+	p.SetHypervisor("hypervisor-value")
+	want.Set("hypervisor", "hypervisor-value")
+	p.SetHypervisorversion("hypervisorversion-value")
+	want.Set("hypervisorversion", "hypervisorversion-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetOstypeid("ostypeid-value")
+	want.Set("ostypeid", "ostypeid-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+
+	assertParams(t, p, "listGuestOsMapping", want)
+}
+
+func TestListGuestOsMappingResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"guestosmapping":[{"hypervisor":"hypervisor-value","hypervisorversion":"hypervisorversion-value","id":"id-value","isuserdefined":"isuserdefined-value","osdisplayname":"osdisplayname-value","osnameforhypervisor":"osnameforhypervisor-value","ostypeid":"ostypeid-value"}]}`, &ListGuestOsMappingResponse{})
+}
+
+func TestRemoveGuestOsMappingParams(t *testing.T) {
+	p := &RemoveGuestOsMappingParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "removeGuestOsMapping", want)
+}
+
+func TestRemoveGuestOsMappingResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &RemoveGuestOsMappingResponse{})
+}
+
+func TestUpdateGuestOsMappingParams(t *testing.T) {
+	p := &UpdateGuestOsMappingParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetOsnameforhypervisor("osnameforhypervisor-value")
+	want.Set("osnameforhypervisor", "osnameforhypervisor-value")
+
+	assertParams(t, p, "updateGuestOsMapping", want)
+}
+
+func TestUpdateGuestOsMappingResponse(t *testing.T) {
+	assertDecodes(t, `{"hypervisor":"hypervisor-value","hypervisorversion":"hypervisorversion-value","id":"id-value","isuserdefined":"isuserdefined-value","jobid":"jobid-value","osdisplayname":"osdisplayname-value","osnameforhypervisor":"osnameforhypervisor-value","ostypeid":"ostypeid-value"}`, &UpdateGuestOsMappingResponse{})
+}
+
+func TestListOsCategoriesParams(t *testing.T) {
+	p := &ListOsCategoriesParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+
+	assertParams(t, p, "listOsCategories", want)
+}
+
+func TestListOsCategoriesResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"oscategory":[{"id":"id-value","name":"name-value"}]}`, &ListOsCategoriesResponse{})
+}
+
+func TestListOsTypesParams(t *testing.T) {
+	p := &ListOsTypesParams{}
+	want := url.Values{}
+	p.SetDescription("description-value")
+	want.Set("description", "description-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetOscategoryid("oscategoryid-value")
+	want.Set("oscategoryid", "oscategoryid-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+
+	assertParams(t, p, "listOsTypes", want)
+}
+
+func TestListOsTypesResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"ostype":[{"description":"description-value","id":"id-value","isuserdefined":"isuserdefined-value","oscategoryid":"oscategoryid-value"}]}`, &ListOsTypesResponse{})
+}
@@ -17,17 +17,85 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 )
 
+// HostServiceIface is the interface implemented by HostService, and by MockHostService for tests
+type HostServiceIface interface {
+	NewReleaseDedicatedHostParams(hostid string) *ReleaseDedicatedHostParams
+	ReleaseDedicatedHost(p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error)
+	ReleaseDedicatedHostWithContext(ctx context.Context, p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error)
+	ReleaseDedicatedHostAsync(ctx context.Context, p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostJob, error)
+	NewListDedicatedHostsParams() *ListDedicatedHostsParams
+	ListDedicatedHosts(p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error)
+	ListDedicatedHostsWithContext(ctx context.Context, p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error)
+	NewListDedicatedHostsIterator(p *ListDedicatedHostsParams, pagesize int) *ListDedicatedHostsIterator
+	NewAddHostParams(hypervisor string, password string, podid string, url string, username string, zoneid string) *AddHostParams
+	AddHost(p *AddHostParams) (*AddHostResponse, error)
+	AddHostWithContext(ctx context.Context, p *AddHostParams) (*AddHostResponse, error)
+	NewDedicateHostParams(domainid string, hostid string) *DedicateHostParams
+	DedicateHost(p *DedicateHostParams) (*DedicateHostResponse, error)
+	DedicateHostWithContext(ctx context.Context, p *DedicateHostParams) (*DedicateHostResponse, error)
+	DedicateHostAsync(ctx context.Context, p *DedicateHostParams) (*DedicateHostJob, error)
+	NewDeleteHostParams(id string) *DeleteHostParams
+	DeleteHost(p *DeleteHostParams) (*DeleteHostResponse, error)
+	DeleteHostWithContext(ctx context.Context, p *DeleteHostParams) (*DeleteHostResponse, error)
+	NewReconnectHostParams(id string) *ReconnectHostParams
+	ReconnectHost(p *ReconnectHostParams) (*ReconnectHostResponse, error)
+	ReconnectHostWithContext(ctx context.Context, p *ReconnectHostParams) (*ReconnectHostResponse, error)
+	ReconnectHostAsync(ctx context.Context, p *ReconnectHostParams) (*ReconnectHostJob, error)
+	NewUpdateHostParams(id string) *UpdateHostParams
+	UpdateHost(p *UpdateHostParams) (*UpdateHostResponse, error)
+	UpdateHostWithContext(ctx context.Context, p *UpdateHostParams) (*UpdateHostResponse, error)
+	NewPrepareHostForMaintenanceParams(id string) *PrepareHostForMaintenanceParams
+	PrepareHostForMaintenance(p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceResponse, error)
+	PrepareHostForMaintenanceWithContext(ctx context.Context, p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceResponse, error)
+	PrepareHostForMaintenanceAsync(ctx context.Context, p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceJob, error)
+	NewCancelHostMaintenanceParams(id string) *CancelHostMaintenanceParams
+	CancelHostMaintenance(p *CancelHostMaintenanceParams) (*CancelHostMaintenanceResponse, error)
+	CancelHostMaintenanceWithContext(ctx context.Context, p *CancelHostMaintenanceParams) (*CancelHostMaintenanceResponse, error)
+	CancelHostMaintenanceAsync(ctx context.Context, p *CancelHostMaintenanceParams) (*CancelHostMaintenanceJob, error)
+	NewUpdateHostPasswordParams(password string, username string) *UpdateHostPasswordParams
+	UpdateHostPassword(p *UpdateHostPasswordParams) (*UpdateHostPasswordResponse, error)
+	UpdateHostPasswordWithContext(ctx context.Context, p *UpdateHostPasswordParams) (*UpdateHostPasswordResponse, error)
+	NewReleaseHostReservationParams(id string) *ReleaseHostReservationParams
+	ReleaseHostReservation(p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error)
+	ReleaseHostReservationWithContext(ctx context.Context, p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error)
+	ReleaseHostReservationAsync(ctx context.Context, p *ReleaseHostReservationParams) (*ReleaseHostReservationJob, error)
+	NewListHostTagsParams() *ListHostTagsParams
+	GetHostTagID(keyword string, opts ...OptionFunc) (string, int, error)
+	ListHostTags(p *ListHostTagsParams) (*ListHostTagsResponse, error)
+	ListHostTagsWithContext(ctx context.Context, p *ListHostTagsParams) (*ListHostTagsResponse, error)
+	NewListHostTagsIterator(p *ListHostTagsParams, pagesize int) *ListHostTagsIterator
+	NewListHostsParams() *ListHostsParams
+	GetHostID(name string, opts ...OptionFunc) (string, int, error)
+	GetHostByName(name string, opts ...OptionFunc) (*Host, int, error)
+	GetHostByID(id string, opts ...OptionFunc) (*Host, int, error)
+	ListHosts(p *ListHostsParams) (*ListHostsResponse, error)
+	ListHostsWithContext(ctx context.Context, p *ListHostsParams) (*ListHostsResponse, error)
+	NewListHostsIterator(p *ListHostsParams, pagesize int) *ListHostsIterator
+	NewFindHostsForMigrationParams(virtualmachineid string) *FindHostsForMigrationParams
+	FindHostsForMigration(p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error)
+	FindHostsForMigrationWithContext(ctx context.Context, p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error)
+	NewAddSecondaryStorageParams(url string) *AddSecondaryStorageParams
+	AddSecondaryStorage(p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error)
+	AddSecondaryStorageWithContext(ctx context.Context, p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error)
+}
+
 type ReleaseDedicatedHostParams struct {
 	p map[string]interface{}
 }
 
+func (p *ReleaseDedicatedHostParams) command() string {
+	return "releaseDedicatedHost"
+}
+
 func (p *ReleaseDedicatedHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -57,7 +125,13 @@ func (s *HostService) NewReleaseDedicatedHostParams(hostid string) *ReleaseDedic
 
 // Release the dedication for host
 func (s *HostService) ReleaseDedicatedHost(p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedHost", p.toURLValues())
+	return s.ReleaseDedicatedHostWithContext(context.Background(), p)
+}
+
+// ReleaseDedicatedHostWithContext is the same as ReleaseDedicatedHost, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *HostService) ReleaseDedicatedHostWithContext(ctx context.Context, p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "releaseDedicatedHost", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -69,9 +143,9 @@ func (s *HostService) ReleaseDedicatedHost(p *ReleaseDedicatedHostParams) (*Rele
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "releaseDedicatedHost", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -84,6 +158,41 @@ func (s *HostService) ReleaseDedicatedHost(p *ReleaseDedicatedHostParams) (*Rele
 	return &r, nil
 }
 
+// ReleaseDedicatedHostJob is a handle to a running releaseDedicatedHost async job
+type ReleaseDedicatedHostJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a ReleaseDedicatedHostResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *ReleaseDedicatedHostJob) Result() (*ReleaseDedicatedHostResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := ReleaseDedicatedHostResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ReleaseDedicatedHostAsync starts the releaseDedicatedHost async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *HostService) ReleaseDedicatedHostAsync(ctx context.Context, p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostJob, error) {
+	resp, err := s.cs.newRequest(ctx, "releaseDedicatedHost", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ReleaseDedicatedHostResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &ReleaseDedicatedHostJob{s.cs.newJobHandle("releaseDedicatedHost", r.JobID)}, nil
+}
+
 type ReleaseDedicatedHostResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -94,6 +203,10 @@ type ListDedicatedHostsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListDedicatedHostsParams) command() string {
+	return "listDedicatedHosts"
+}
+
 func (p *ListDedicatedHostsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -184,10 +297,17 @@ func (s *HostService) NewListDedicatedHostsParams() *ListDedicatedHostsParams {
 
 // Lists dedicated hosts.
 func (s *HostService) ListDedicatedHosts(p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error) {
+	return s.ListDedicatedHostsWithContext(context.Background(), p)
+}
+
+// ListDedicatedHostsWithContext is the same as ListDedicatedHosts, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *HostService) ListDedicatedHostsWithContext(ctx context.Context, p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error) {
 	var r ListDedicatedHostsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListDedicatedHostsResponse
-		resp, err := s.cs.newRequest("listDedicatedHosts", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listDedicatedHosts", values)
 		if err != nil {
 			return nil, err
 		}
@@ -199,13 +319,89 @@ func (s *HostService) ListDedicatedHosts(p *ListDedicatedHostsParams) (*ListDedi
 		r.Count = l.Count
 		r.DedicatedHosts = append(r.DedicatedHosts, l.DedicatedHosts...)
 
-		if r.Count == len(r.DedicatedHosts) {
+		if r.Count == len(r.DedicatedHosts) || len(l.DedicatedHosts) == 0 {
+			s.cs.observePages("listDedicatedHosts", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.DedicatedHosts))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.DedicatedHosts)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listDedicatedHosts", values, len(l.DedicatedHosts), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListDedicatedHostsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.DedicatedHosts = append(r.DedicatedHosts, l.DedicatedHosts...)
+			}
+
+			if r.Count == len(r.DedicatedHosts) {
+				s.cs.observePages("listDedicatedHosts", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListDedicatedHostsIterator iterates over the items returned by listDedicatedHosts, requesting
+// the pages of the response one at a time while iterating.
+type ListDedicatedHostsIterator struct {
+	pager
+	items []*DedicatedHost
+	index int
+}
+
+// NewListDedicatedHostsIterator returns an iterator over the items returned by listDedicatedHosts, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *HostService) NewListDedicatedHostsIterator(p *ListDedicatedHostsParams, pagesize int) *ListDedicatedHostsIterator {
+	return &ListDedicatedHostsIterator{pager: newPager(s.cs, "listDedicatedHosts", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListDedicatedHostsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListDedicatedHostsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
 	}
+	i.received(l.Count, len(l.DedicatedHosts))
+
+	i.items = l.DedicatedHosts
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListDedicatedHostsIterator) Value() *DedicatedHost {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
 }
 
 type ListDedicatedHostsResponse struct {
@@ -228,6 +424,10 @@ type AddHostParams struct {
 	p map[string]interface{}
 }
 
+func (p *AddHostParams) command() string {
+	return "addHost"
+}
+
 func (p *AddHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -353,7 +553,13 @@ func (s *HostService) NewAddHostParams(hypervisor string, password string, podid
 
 // Adds a new host.
 func (s *HostService) AddHost(p *AddHostParams) (*AddHostResponse, error) {
-	resp, err := s.cs.newRequest("addHost", p.toURLValues())
+	return s.AddHostWithContext(context.Background(), p)
+}
+
+// AddHostWithContext is the same as AddHost, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *HostService) AddHostWithContext(ctx context.Context, p *AddHostParams) (*AddHostResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "addHost", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -436,6 +642,10 @@ type DedicateHostParams struct {
 	p map[string]interface{}
 }
 
+func (p *DedicateHostParams) command() string {
+	return "dedicateHost"
+}
+
 func (p *DedicateHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -486,7 +696,13 @@ func (s *HostService) NewDedicateHostParams(domainid string, hostid string) *Ded
 
 // Dedicates a host.
 func (s *HostService) DedicateHost(p *DedicateHostParams) (*DedicateHostResponse, error) {
-	resp, err := s.cs.newRequest("dedicateHost", p.toURLValues())
+	return s.DedicateHostWithContext(context.Background(), p)
+}
+
+// DedicateHostWithContext is the same as DedicateHost, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *HostService) DedicateHostWithContext(ctx context.Context, p *DedicateHostParams) (*DedicateHostResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "dedicateHost", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -498,26 +714,56 @@ func (s *HostService) DedicateHost(p *DedicateHostParams) (*DedicateHostResponse
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "dedicateHost", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "host", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// DedicateHostJob is a handle to a running dedicateHost async job
+type DedicateHostJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DedicateHostResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DedicateHostJob) Result() (*DedicateHostResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DedicateHostResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "host", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// DedicateHostAsync starts the dedicateHost async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *HostService) DedicateHostAsync(ctx context.Context, p *DedicateHostParams) (*DedicateHostJob, error) {
+	resp, err := s.cs.newRequest(ctx, "dedicateHost", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DedicateHostResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DedicateHostJob{s.cs.newJobHandle("dedicateHost", r.JobID)}, nil
+}
+
 type DedicateHostResponse struct {
 	JobID           string `json:"jobid,omitempty"`
 	Accountid       string `json:"accountid,omitempty"`
@@ -534,6 +780,10 @@ type DeleteHostParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteHostParams) command() string {
+	return "deleteHost"
+}
+
 func (p *DeleteHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -585,7 +835,13 @@ func (s *HostService) NewDeleteHostParams(id string) *DeleteHostParams {
 
 // Deletes a host.
 func (s *HostService) DeleteHost(p *DeleteHostParams) (*DeleteHostResponse, error) {
-	resp, err := s.cs.newRequest("deleteHost", p.toURLValues())
+	return s.DeleteHostWithContext(context.Background(), p)
+}
+
+// DeleteHostWithContext is the same as DeleteHost, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *HostService) DeleteHostWithContext(ctx context.Context, p *DeleteHostParams) (*DeleteHostResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteHost", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -606,6 +862,10 @@ type ReconnectHostParams struct {
 	p map[string]interface{}
 }
 
+func (p *ReconnectHostParams) command() string {
+	return "reconnectHost"
+}
+
 func (p *ReconnectHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -635,7 +895,13 @@ func (s *HostService) NewReconnectHostParams(id string) *ReconnectHostParams {
 
 // Reconnects a host.
 func (s *HostService) ReconnectHost(p *ReconnectHostParams) (*ReconnectHostResponse, error) {
-	resp, err := s.cs.newRequest("reconnectHost", p.toURLValues())
+	return s.ReconnectHostWithContext(context.Background(), p)
+}
+
+// ReconnectHostWithContext is the same as ReconnectHost, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *HostService) ReconnectHostWithContext(ctx context.Context, p *ReconnectHostParams) (*ReconnectHostResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "reconnectHost", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -647,26 +913,56 @@ func (s *HostService) ReconnectHost(p *ReconnectHostParams) (*ReconnectHostRespo
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "reconnectHost", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "host", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// ReconnectHostJob is a handle to a running reconnectHost async job
+type ReconnectHostJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a ReconnectHostResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *ReconnectHostJob) Result() (*ReconnectHostResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := ReconnectHostResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "host", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// ReconnectHostAsync starts the reconnectHost async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *HostService) ReconnectHostAsync(ctx context.Context, p *ReconnectHostParams) (*ReconnectHostJob, error) {
+	resp, err := s.cs.newRequest(ctx, "reconnectHost", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ReconnectHostResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &ReconnectHostJob{s.cs.newJobHandle("reconnectHost", r.JobID)}, nil
+}
+
 type ReconnectHostResponse struct {
 	JobID                   string            `json:"jobid,omitempty"`
 	Accountid               string            `json:"accountid,omitempty"`
@@ -739,6 +1035,10 @@ type UpdateHostParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateHostParams) command() string {
+	return "updateHost"
+}
+
 func (p *UpdateHostParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -809,7 +1109,13 @@ func (s *HostService) NewUpdateHostParams(id string) *UpdateHostParams {
 
 // Updates a host.
 func (s *HostService) UpdateHost(p *UpdateHostParams) (*UpdateHostResponse, error) {
-	resp, err := s.cs.newRequest("updateHost", p.toURLValues())
+	return s.UpdateHostWithContext(context.Background(), p)
+}
+
+// UpdateHostWithContext is the same as UpdateHost, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *HostService) UpdateHostWithContext(ctx context.Context, p *UpdateHostParams) (*UpdateHostResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateHost", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -892,6 +1198,10 @@ type PrepareHostForMaintenanceParams struct {
 	p map[string]interface{}
 }
 
+func (p *PrepareHostForMaintenanceParams) command() string {
+	return "prepareHostForMaintenance"
+}
+
 func (p *PrepareHostForMaintenanceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -921,7 +1231,13 @@ func (s *HostService) NewPrepareHostForMaintenanceParams(id string) *PrepareHost
 
 // Prepares a host for maintenance.
 func (s *HostService) PrepareHostForMaintenance(p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceResponse, error) {
-	resp, err := s.cs.newRequest("prepareHostForMaintenance", p.toURLValues())
+	return s.PrepareHostForMaintenanceWithContext(context.Background(), p)
+}
+
+// PrepareHostForMaintenanceWithContext is the same as PrepareHostForMaintenance, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *HostService) PrepareHostForMaintenanceWithContext(ctx context.Context, p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "prepareHostForMaintenance", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -933,26 +1249,56 @@ func (s *HostService) PrepareHostForMaintenance(p *PrepareHostForMaintenancePara
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "prepareHostForMaintenance", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "host", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// PrepareHostForMaintenanceJob is a handle to a running prepareHostForMaintenance async job
+type PrepareHostForMaintenanceJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a PrepareHostForMaintenanceResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *PrepareHostForMaintenanceJob) Result() (*PrepareHostForMaintenanceResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := PrepareHostForMaintenanceResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "host", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// PrepareHostForMaintenanceAsync starts the prepareHostForMaintenance async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *HostService) PrepareHostForMaintenanceAsync(ctx context.Context, p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceJob, error) {
+	resp, err := s.cs.newRequest(ctx, "prepareHostForMaintenance", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r PrepareHostForMaintenanceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &PrepareHostForMaintenanceJob{s.cs.newJobHandle("prepareHostForMaintenance", r.JobID)}, nil
+}
+
 type PrepareHostForMaintenanceResponse struct {
 	JobID                   string            `json:"jobid,omitempty"`
 	Accountid               string            `json:"accountid,omitempty"`
@@ -1025,6 +1371,10 @@ type CancelHostMaintenanceParams struct {
 	p map[string]interface{}
 }
 
+func (p *CancelHostMaintenanceParams) command() string {
+	return "cancelHostMaintenance"
+}
+
 func (p *CancelHostMaintenanceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1054,7 +1404,13 @@ func (s *HostService) NewCancelHostMaintenanceParams(id string) *CancelHostMaint
 
 // Cancels host maintenance.
 func (s *HostService) CancelHostMaintenance(p *CancelHostMaintenanceParams) (*CancelHostMaintenanceResponse, error) {
-	resp, err := s.cs.newRequest("cancelHostMaintenance", p.toURLValues())
+	return s.CancelHostMaintenanceWithContext(context.Background(), p)
+}
+
+// CancelHostMaintenanceWithContext is the same as CancelHostMaintenance, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *HostService) CancelHostMaintenanceWithContext(ctx context.Context, p *CancelHostMaintenanceParams) (*CancelHostMaintenanceResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "cancelHostMaintenance", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1066,26 +1422,56 @@ func (s *HostService) CancelHostMaintenance(p *CancelHostMaintenanceParams) (*Ca
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "cancelHostMaintenance", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "host", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CancelHostMaintenanceJob is a handle to a running cancelHostMaintenance async job
+type CancelHostMaintenanceJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CancelHostMaintenanceResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CancelHostMaintenanceJob) Result() (*CancelHostMaintenanceResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CancelHostMaintenanceResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "host", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CancelHostMaintenanceAsync starts the cancelHostMaintenance async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *HostService) CancelHostMaintenanceAsync(ctx context.Context, p *CancelHostMaintenanceParams) (*CancelHostMaintenanceJob, error) {
+	resp, err := s.cs.newRequest(ctx, "cancelHostMaintenance", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CancelHostMaintenanceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CancelHostMaintenanceJob{s.cs.newJobHandle("cancelHostMaintenance", r.JobID)}, nil
+}
+
 type CancelHostMaintenanceResponse struct {
 	JobID                   string            `json:"jobid,omitempty"`
 	Accountid               string            `json:"accountid,omitempty"`
@@ -1158,6 +1544,10 @@ type UpdateHostPasswordParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateHostPasswordParams) command() string {
+	return "updateHostPassword"
+}
+
 func (p *UpdateHostPasswordParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1229,7 +1619,13 @@ func (s *HostService) NewUpdateHostPasswordParams(password string, username stri
 
 // Update password of a host/pool on management server.
 func (s *HostService) UpdateHostPassword(p *UpdateHostPasswordParams) (*UpdateHostPasswordResponse, error) {
-	resp, err := s.cs.newRequest("updateHostPassword", p.toURLValues())
+	return s.UpdateHostPasswordWithContext(context.Background(), p)
+}
+
+// UpdateHostPasswordWithContext is the same as UpdateHostPassword, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *HostService) UpdateHostPasswordWithContext(ctx context.Context, p *UpdateHostPasswordParams) (*UpdateHostPasswordResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateHostPassword", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1250,6 +1646,10 @@ type ReleaseHostReservationParams struct {
 	p map[string]interface{}
 }
 
+func (p *ReleaseHostReservationParams) command() string {
+	return "releaseHostReservation"
+}
+
 func (p *ReleaseHostReservationParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1279,7 +1679,13 @@ func (s *HostService) NewReleaseHostReservationParams(id string) *ReleaseHostRes
 
 // Releases host reservation.
 func (s *HostService) ReleaseHostReservation(p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error) {
-	resp, err := s.cs.newRequest("releaseHostReservation", p.toURLValues())
+	return s.ReleaseHostReservationWithContext(context.Background(), p)
+}
+
+// ReleaseHostReservationWithContext is the same as ReleaseHostReservation, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *HostService) ReleaseHostReservationWithContext(ctx context.Context, p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "releaseHostReservation", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1291,9 +1697,9 @@ func (s *HostService) ReleaseHostReservation(p *ReleaseHostReservationParams) (*
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "releaseHostReservation", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -1306,6 +1712,41 @@ func (s *HostService) ReleaseHostReservation(p *ReleaseHostReservationParams) (*
 	return &r, nil
 }
 
+// ReleaseHostReservationJob is a handle to a running releaseHostReservation async job
+type ReleaseHostReservationJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a ReleaseHostReservationResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *ReleaseHostReservationJob) Result() (*ReleaseHostReservationResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := ReleaseHostReservationResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ReleaseHostReservationAsync starts the releaseHostReservation async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *HostService) ReleaseHostReservationAsync(ctx context.Context, p *ReleaseHostReservationParams) (*ReleaseHostReservationJob, error) {
+	resp, err := s.cs.newRequest(ctx, "releaseHostReservation", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ReleaseHostReservationResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &ReleaseHostReservationJob{s.cs.newJobHandle("releaseHostReservation", r.JobID)}, nil
+}
+
 type ReleaseHostReservationResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -1316,6 +1757,10 @@ type ListHostTagsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListHostTagsParams) command() string {
+	return "listHostTags"
+}
+
 func (p *ListHostTagsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1383,7 +1828,7 @@ func (s *HostService) GetHostTagID(keyword string, opts ...OptionFunc) (string,
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -1397,15 +1842,22 @@ func (s *HostService) GetHostTagID(keyword string, opts ...OptionFunc) (string,
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // Lists host tags
 func (s *HostService) ListHostTags(p *ListHostTagsParams) (*ListHostTagsResponse, error) {
+	return s.ListHostTagsWithContext(context.Background(), p)
+}
+
+// ListHostTagsWithContext is the same as ListHostTags, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *HostService) ListHostTagsWithContext(ctx context.Context, p *ListHostTagsParams) (*ListHostTagsResponse, error) {
 	var r ListHostTagsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListHostTagsResponse
-		resp, err := s.cs.newRequest("listHostTags", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listHostTags", values)
 		if err != nil {
 			return nil, err
 		}
@@ -1417,13 +1869,89 @@ func (s *HostService) ListHostTags(p *ListHostTagsParams) (*ListHostTagsResponse
 		r.Count = l.Count
 		r.HostTags = append(r.HostTags, l.HostTags...)
 
-		if r.Count == len(r.HostTags) {
+		if r.Count == len(r.HostTags) || len(l.HostTags) == 0 {
+			s.cs.observePages("listHostTags", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.HostTags))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.HostTags)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listHostTags", values, len(l.HostTags), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListHostTagsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.HostTags = append(r.HostTags, l.HostTags...)
+			}
+
+			if r.Count == len(r.HostTags) {
+				s.cs.observePages("listHostTags", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListHostTagsIterator iterates over the items returned by listHostTags, requesting
+// the pages of the response one at a time while iterating.
+type ListHostTagsIterator struct {
+	pager
+	items []*HostTag
+	index int
+}
+
+// NewListHostTagsIterator returns an iterator over the items returned by listHostTags, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *HostService) NewListHostTagsIterator(p *ListHostTagsParams, pagesize int) *ListHostTagsIterator {
+	return &ListHostTagsIterator{pager: newPager(s.cs, "listHostTags", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListHostTagsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
 	}
+
+	var l ListHostTagsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.HostTags))
+
+	i.items = l.HostTags
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListHostTagsIterator) Value() *HostTag {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
 }
 
 type ListHostTagsResponse struct {
@@ -1441,6 +1969,10 @@ type ListHostsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListHostsParams) command() string {
+	return "listHosts"
+}
+
 func (p *ListHostsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1630,7 +2162,7 @@ func (s *HostService) GetHostID(name string, opts ...OptionFunc) (string, int, e
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -1644,7 +2176,7 @@ func (s *HostService) GetHostID(name string, opts ...OptionFunc) (string, int, e
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -1676,16 +2208,14 @@ func (s *HostService) GetHostByID(id string, opts ...OptionFunc) (*Host, int, er
 
 	l, err := s.ListHosts(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -1697,10 +2227,17 @@ func (s *HostService) GetHostByID(id string, opts ...OptionFunc) (*Host, int, er
 
 // Lists hosts.
 func (s *HostService) ListHosts(p *ListHostsParams) (*ListHostsResponse, error) {
+	return s.ListHostsWithContext(context.Background(), p)
+}
+
+// ListHostsWithContext is the same as ListHosts, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *HostService) ListHostsWithContext(ctx context.Context, p *ListHostsParams) (*ListHostsResponse, error) {
 	var r ListHostsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListHostsResponse
-		resp, err := s.cs.newRequest("listHosts", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listHosts", values)
 		if err != nil {
 			return nil, err
 		}
@@ -1712,13 +2249,89 @@ func (s *HostService) ListHosts(p *ListHostsParams) (*ListHostsResponse, error)
 		r.Count = l.Count
 		r.Hosts = append(r.Hosts, l.Hosts...)
 
-		if r.Count == len(r.Hosts) {
+		if r.Count == len(r.Hosts) || len(l.Hosts) == 0 {
+			s.cs.observePages("listHosts", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.Hosts))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.Hosts)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listHosts", values, len(l.Hosts), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListHostsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.Hosts = append(r.Hosts, l.Hosts...)
+			}
+
+			if r.Count == len(r.Hosts) {
+				s.cs.observePages("listHosts", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListHostsIterator iterates over the items returned by listHosts, requesting
+// the pages of the response one at a time while iterating.
+type ListHostsIterator struct {
+	pager
+	items []*Host
+	index int
+}
+
+// NewListHostsIterator returns an iterator over the items returned by listHosts, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *HostService) NewListHostsIterator(p *ListHostsParams, pagesize int) *ListHostsIterator {
+	return &ListHostsIterator{pager: newPager(s.cs, "listHosts", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListHostsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListHostsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.Hosts))
+
+	i.items = l.Hosts
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListHostsIterator) Value() *Host {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListHostsResponse struct {
@@ -1797,6 +2410,10 @@ type FindHostsForMigrationParams struct {
 	p map[string]interface{}
 }
 
+func (p *FindHostsForMigrationParams) command() string {
+	return "findHostsForMigration"
+}
+
 func (p *FindHostsForMigrationParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1858,7 +2475,13 @@ func (s *HostService) NewFindHostsForMigrationParams(virtualmachineid string) *F
 
 // Find hosts suitable for migrating a virtual machine.
 func (s *HostService) FindHostsForMigration(p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error) {
-	resp, err := s.cs.newRequest("findHostsForMigration", p.toURLValues())
+	return s.FindHostsForMigrationWithContext(context.Background(), p)
+}
+
+// FindHostsForMigrationWithContext is the same as FindHostsForMigration, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *HostService) FindHostsForMigrationWithContext(ctx context.Context, p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "findHostsForMigration", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1927,6 +2550,10 @@ type AddSecondaryStorageParams struct {
 	p map[string]interface{}
 }
 
+func (p *AddSecondaryStorageParams) command() string {
+	return "addSecondaryStorage"
+}
+
 func (p *AddSecondaryStorageParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1966,7 +2593,13 @@ func (s *HostService) NewAddSecondaryStorageParams(url string) *AddSecondaryStor
 
 // Adds secondary storage.
 func (s *HostService) AddSecondaryStorage(p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error) {
-	resp, err := s.cs.newRequest("addSecondaryStorage", p.toURLValues())
+	return s.AddSecondaryStorageWithContext(context.Background(), p)
+}
+
+// AddSecondaryStorageWithContext is the same as AddSecondaryStorage, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *HostService) AddSecondaryStorageWithContext(ctx context.Context, p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "addSecondaryStorage", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
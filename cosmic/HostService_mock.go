@@ -0,0 +1,416 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockHostService is a mock of HostServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockHostService struct {
+	mockCalls
+
+	ReleaseDedicatedHostFunc           func(ctx context.Context, p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error)
+	ReleaseDedicatedHostAsyncFunc      func(ctx context.Context, p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostJob, error)
+	ListDedicatedHostsFunc             func(ctx context.Context, p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error)
+	AddHostFunc                        func(ctx context.Context, p *AddHostParams) (*AddHostResponse, error)
+	DedicateHostFunc                   func(ctx context.Context, p *DedicateHostParams) (*DedicateHostResponse, error)
+	DedicateHostAsyncFunc              func(ctx context.Context, p *DedicateHostParams) (*DedicateHostJob, error)
+	DeleteHostFunc                     func(ctx context.Context, p *DeleteHostParams) (*DeleteHostResponse, error)
+	ReconnectHostFunc                  func(ctx context.Context, p *ReconnectHostParams) (*ReconnectHostResponse, error)
+	ReconnectHostAsyncFunc             func(ctx context.Context, p *ReconnectHostParams) (*ReconnectHostJob, error)
+	UpdateHostFunc                     func(ctx context.Context, p *UpdateHostParams) (*UpdateHostResponse, error)
+	PrepareHostForMaintenanceFunc      func(ctx context.Context, p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceResponse, error)
+	PrepareHostForMaintenanceAsyncFunc func(ctx context.Context, p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceJob, error)
+	CancelHostMaintenanceFunc          func(ctx context.Context, p *CancelHostMaintenanceParams) (*CancelHostMaintenanceResponse, error)
+	CancelHostMaintenanceAsyncFunc     func(ctx context.Context, p *CancelHostMaintenanceParams) (*CancelHostMaintenanceJob, error)
+	UpdateHostPasswordFunc             func(ctx context.Context, p *UpdateHostPasswordParams) (*UpdateHostPasswordResponse, error)
+	ReleaseHostReservationFunc         func(ctx context.Context, p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error)
+	ReleaseHostReservationAsyncFunc    func(ctx context.Context, p *ReleaseHostReservationParams) (*ReleaseHostReservationJob, error)
+	GetHostTagIDFunc                   func(keyword string, opts ...OptionFunc) (string, int, error)
+	ListHostTagsFunc                   func(ctx context.Context, p *ListHostTagsParams) (*ListHostTagsResponse, error)
+	GetHostIDFunc                      func(name string, opts ...OptionFunc) (string, int, error)
+	GetHostByNameFunc                  func(name string, opts ...OptionFunc) (*Host, int, error)
+	GetHostByIDFunc                    func(id string, opts ...OptionFunc) (*Host, int, error)
+	ListHostsFunc                      func(ctx context.Context, p *ListHostsParams) (*ListHostsResponse, error)
+	FindHostsForMigrationFunc          func(ctx context.Context, p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error)
+	AddSecondaryStorageFunc            func(ctx context.Context, p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error)
+}
+
+var _ HostServiceIface = &MockHostService{}
+
+func (m *MockHostService) NewReleaseDedicatedHostParams(hostid string) *ReleaseDedicatedHostParams {
+	return (&HostService{}).NewReleaseDedicatedHostParams(hostid)
+}
+
+func (m *MockHostService) ReleaseDedicatedHost(p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error) {
+	return m.ReleaseDedicatedHostWithContext(context.Background(), p)
+}
+
+func (m *MockHostService) ReleaseDedicatedHostWithContext(ctx context.Context, p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error) {
+	m.record("ReleaseDedicatedHost", p)
+	if m.ReleaseDedicatedHostFunc == nil {
+		return nil, notMocked("MockHostService", "ReleaseDedicatedHost")
+	}
+	return m.ReleaseDedicatedHostFunc(ctx, p)
+}
+
+func (m *MockHostService) ReleaseDedicatedHostAsync(ctx context.Context, p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostJob, error) {
+	m.record("ReleaseDedicatedHostAsync", p)
+	if m.ReleaseDedicatedHostAsyncFunc == nil {
+		return nil, notMocked("MockHostService", "ReleaseDedicatedHostAsync")
+	}
+	return m.ReleaseDedicatedHostAsyncFunc(ctx, p)
+}
+
+func (m *MockHostService) NewListDedicatedHostsParams() *ListDedicatedHostsParams {
+	return (&HostService{}).NewListDedicatedHostsParams()
+}
+
+func (m *MockHostService) ListDedicatedHosts(p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error) {
+	return m.ListDedicatedHostsWithContext(context.Background(), p)
+}
+
+func (m *MockHostService) ListDedicatedHostsWithContext(ctx context.Context, p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error) {
+	m.record("ListDedicatedHosts", p)
+	if m.ListDedicatedHostsFunc == nil {
+		return nil, notMocked("MockHostService", "ListDedicatedHosts")
+	}
+	return m.ListDedicatedHostsFunc(ctx, p)
+}
+
+func (m *MockHostService) NewListDedicatedHostsIterator(p *ListDedicatedHostsParams, pagesize int) *ListDedicatedHostsIterator {
+	i := &ListDedicatedHostsIterator{pager: newPager(nil, "listDedicatedHosts", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListDedicatedHostsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListDedicatedHostsWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockHostService) NewAddHostParams(hypervisor string, password string, podid string, url string, username string, zoneid string) *AddHostParams {
+	return (&HostService{}).NewAddHostParams(hypervisor, password, podid, url, username, zoneid)
+}
+
+func (m *MockHostService) AddHost(p *AddHostParams) (*AddHostResponse, error) {
+	return m.AddHostWithContext(context.Background(), p)
+}
+
+func (m *MockHostService) AddHostWithContext(ctx context.Context, p *AddHostParams) (*AddHostResponse, error) {
+	m.record("AddHost", p)
+	if m.AddHostFunc == nil {
+		return nil, notMocked("MockHostService", "AddHost")
+	}
+	return m.AddHostFunc(ctx, p)
+}
+
+func (m *MockHostService) NewDedicateHostParams(domainid string, hostid string) *DedicateHostParams {
+	return (&HostService{}).NewDedicateHostParams(domainid, hostid)
+}
+
+func (m *MockHostService) DedicateHost(p *DedicateHostParams) (*DedicateHostResponse, error) {
+	return m.DedicateHostWithContext(context.Background(), p)
+}
+
+func (m *MockHostService) DedicateHostWithContext(ctx context.Context, p *DedicateHostParams) (*DedicateHostResponse, error) {
+	m.record("DedicateHost", p)
+	if m.DedicateHostFunc == nil {
+		return nil, notMocked("MockHostService", "DedicateHost")
+	}
+	return m.DedicateHostFunc(ctx, p)
+}
+
+func (m *MockHostService) DedicateHostAsync(ctx context.Context, p *DedicateHostParams) (*DedicateHostJob, error) {
+	m.record("DedicateHostAsync", p)
+	if m.DedicateHostAsyncFunc == nil {
+		return nil, notMocked("MockHostService", "DedicateHostAsync")
+	}
+	return m.DedicateHostAsyncFunc(ctx, p)
+}
+
+func (m *MockHostService) NewDeleteHostParams(id string) *DeleteHostParams {
+	return (&HostService{}).NewDeleteHostParams(id)
+}
+
+func (m *MockHostService) DeleteHost(p *DeleteHostParams) (*DeleteHostResponse, error) {
+	return m.DeleteHostWithContext(context.Background(), p)
+}
+
+func (m *MockHostService) DeleteHostWithContext(ctx context.Context, p *DeleteHostParams) (*DeleteHostResponse, error) {
+	m.record("DeleteHost", p)
+	if m.DeleteHostFunc == nil {
+		return nil, notMocked("MockHostService", "DeleteHost")
+	}
+	return m.DeleteHostFunc(ctx, p)
+}
+
+func (m *MockHostService) NewReconnectHostParams(id string) *ReconnectHostParams {
+	return (&HostService{}).NewReconnectHostParams(id)
+}
+
+func (m *MockHostService) ReconnectHost(p *ReconnectHostParams) (*ReconnectHostResponse, error) {
+	return m.ReconnectHostWithContext(context.Background(), p)
+}
+
+func (m *MockHostService) ReconnectHostWithContext(ctx context.Context, p *ReconnectHostParams) (*ReconnectHostResponse, error) {
+	m.record("ReconnectHost", p)
+	if m.ReconnectHostFunc == nil {
+		return nil, notMocked("MockHostService", "ReconnectHost")
+	}
+	return m.ReconnectHostFunc(ctx, p)
+}
+
+func (m *MockHostService) ReconnectHostAsync(ctx context.Context, p *ReconnectHostParams) (*ReconnectHostJob, error) {
+	m.record("ReconnectHostAsync", p)
+	if m.ReconnectHostAsyncFunc == nil {
+		return nil, notMocked("MockHostService", "ReconnectHostAsync")
+	}
+	return m.ReconnectHostAsyncFunc(ctx, p)
+}
+
+func (m *MockHostService) NewUpdateHostParams(id string) *UpdateHostParams {
+	return (&HostService{}).NewUpdateHostParams(id)
+}
+
+func (m *MockHostService) UpdateHost(p *UpdateHostParams) (*UpdateHostResponse, error) {
+	return m.UpdateHostWithContext(context.Background(), p)
+}
+
+func (m *MockHostService) UpdateHostWithContext(ctx context.Context, p *UpdateHostParams) (*UpdateHostResponse, error) {
+	m.record("UpdateHost", p)
+	if m.UpdateHostFunc == nil {
+		return nil, notMocked("MockHostService", "UpdateHost")
+	}
+	return m.UpdateHostFunc(ctx, p)
+}
+
+func (m *MockHostService) NewPrepareHostForMaintenanceParams(id string) *PrepareHostForMaintenanceParams {
+	return (&HostService{}).NewPrepareHostForMaintenanceParams(id)
+}
+
+func (m *MockHostService) PrepareHostForMaintenance(p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceResponse, error) {
+	return m.PrepareHostForMaintenanceWithContext(context.Background(), p)
+}
+
+func (m *MockHostService) PrepareHostForMaintenanceWithContext(ctx context.Context, p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceResponse, error) {
+	m.record("PrepareHostForMaintenance", p)
+	if m.PrepareHostForMaintenanceFunc == nil {
+		return nil, notMocked("MockHostService", "PrepareHostForMaintenance")
+	}
+	return m.PrepareHostForMaintenanceFunc(ctx, p)
+}
+
+func (m *MockHostService) PrepareHostForMaintenanceAsync(ctx context.Context, p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceJob, error) {
+	m.record("PrepareHostForMaintenanceAsync", p)
+	if m.PrepareHostForMaintenanceAsyncFunc == nil {
+		return nil, notMocked("MockHostService", "PrepareHostForMaintenanceAsync")
+	}
+	return m.PrepareHostForMaintenanceAsyncFunc(ctx, p)
+}
+
+func (m *MockHostService) NewCancelHostMaintenanceParams(id string) *CancelHostMaintenanceParams {
+	return (&HostService{}).NewCancelHostMaintenanceParams(id)
+}
+
+func (m *MockHostService) CancelHostMaintenance(p *CancelHostMaintenanceParams) (*CancelHostMaintenanceResponse, error) {
+	return m.CancelHostMaintenanceWithContext(context.Background(), p)
+}
+
+func (m *MockHostService) CancelHostMaintenanceWithContext(ctx context.Context, p *CancelHostMaintenanceParams) (*CancelHostMaintenanceResponse, error) {
+	m.record("CancelHostMaintenance", p)
+	if m.CancelHostMaintenanceFunc == nil {
+		return nil, notMocked("MockHostService", "CancelHostMaintenance")
+	}
+	return m.CancelHostMaintenanceFunc(ctx, p)
+}
+
+func (m *MockHostService) CancelHostMaintenanceAsync(ctx context.Context, p *CancelHostMaintenanceParams) (*CancelHostMaintenanceJob, error) {
+	m.record("CancelHostMaintenanceAsync", p)
+	if m.CancelHostMaintenanceAsyncFunc == nil {
+		return nil, notMocked("MockHostService", "CancelHostMaintenanceAsync")
+	}
+	return m.CancelHostMaintenanceAsyncFunc(ctx, p)
+}
+
+func (m *MockHostService) NewUpdateHostPasswordParams(password string, username string) *UpdateHostPasswordParams {
+	return (&HostService{}).NewUpdateHostPasswordParams(password, username)
+}
+
+func (m *MockHostService) UpdateHostPassword(p *UpdateHostPasswordParams) (*UpdateHostPasswordResponse, error) {
+	return m.UpdateHostPasswordWithContext(context.Background(), p)
+}
+
+func (m *MockHostService) UpdateHostPasswordWithContext(ctx context.Context, p *UpdateHostPasswordParams) (*UpdateHostPasswordResponse, error) {
+	m.record("UpdateHostPassword", p)
+	if m.UpdateHostPasswordFunc == nil {
+		return nil, notMocked("MockHostService", "UpdateHostPassword")
+	}
+	return m.UpdateHostPasswordFunc(ctx, p)
+}
+
+func (m *MockHostService) NewReleaseHostReservationParams(id string) *ReleaseHostReservationParams {
+	return (&HostService{}).NewReleaseHostReservationParams(id)
+}
+
+func (m *MockHostService) ReleaseHostReservation(p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error) {
+	return m.ReleaseHostReservationWithContext(context.Background(), p)
+}
+
+func (m *MockHostService) ReleaseHostReservationWithContext(ctx context.Context, p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error) {
+	m.record("ReleaseHostReservation", p)
+	if m.ReleaseHostReservationFunc == nil {
+		return nil, notMocked("MockHostService", "ReleaseHostReservation")
+	}
+	return m.ReleaseHostReservationFunc(ctx, p)
+}
+
+func (m *MockHostService) ReleaseHostReservationAsync(ctx context.Context, p *ReleaseHostReservationParams) (*ReleaseHostReservationJob, error) {
+	m.record("ReleaseHostReservationAsync", p)
+	if m.ReleaseHostReservationAsyncFunc == nil {
+		return nil, notMocked("MockHostService", "ReleaseHostReservationAsync")
+	}
+	return m.ReleaseHostReservationAsyncFunc(ctx, p)
+}
+
+func (m *MockHostService) NewListHostTagsParams() *ListHostTagsParams {
+	return (&HostService{}).NewListHostTagsParams()
+}
+
+func (m *MockHostService) GetHostTagID(keyword string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetHostTagID", keyword, opts)
+	if m.GetHostTagIDFunc == nil {
+		return "", -1, notMocked("MockHostService", "GetHostTagID")
+	}
+	return m.GetHostTagIDFunc(keyword, opts...)
+}
+
+func (m *MockHostService) ListHostTags(p *ListHostTagsParams) (*ListHostTagsResponse, error) {
+	return m.ListHostTagsWithContext(context.Background(), p)
+}
+
+func (m *MockHostService) ListHostTagsWithContext(ctx context.Context, p *ListHostTagsParams) (*ListHostTagsResponse, error) {
+	m.record("ListHostTags", p)
+	if m.ListHostTagsFunc == nil {
+		return nil, notMocked("MockHostService", "ListHostTags")
+	}
+	return m.ListHostTagsFunc(ctx, p)
+}
+
+func (m *MockHostService) NewListHostTagsIterator(p *ListHostTagsParams, pagesize int) *ListHostTagsIterator {
+	i := &ListHostTagsIterator{pager: newPager(nil, "listHostTags", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListHostTagsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListHostTagsWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockHostService) NewListHostsParams() *ListHostsParams {
+	return (&HostService{}).NewListHostsParams()
+}
+
+func (m *MockHostService) GetHostID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetHostID", name, opts)
+	if m.GetHostIDFunc == nil {
+		return "", -1, notMocked("MockHostService", "GetHostID")
+	}
+	return m.GetHostIDFunc(name, opts...)
+}
+
+func (m *MockHostService) GetHostByName(name string, opts ...OptionFunc) (*Host, int, error) {
+	m.record("GetHostByName", name, opts)
+	if m.GetHostByNameFunc == nil {
+		return nil, -1, notMocked("MockHostService", "GetHostByName")
+	}
+	return m.GetHostByNameFunc(name, opts...)
+}
+
+func (m *MockHostService) GetHostByID(id string, opts ...OptionFunc) (*Host, int, error) {
+	m.record("GetHostByID", id, opts)
+	if m.GetHostByIDFunc == nil {
+		return nil, -1, notMocked("MockHostService", "GetHostByID")
+	}
+	return m.GetHostByIDFunc(id, opts...)
+}
+
+func (m *MockHostService) ListHosts(p *ListHostsParams) (*ListHostsResponse, error) {
+	return m.ListHostsWithContext(context.Background(), p)
+}
+
+func (m *MockHostService) ListHostsWithContext(ctx context.Context, p *ListHostsParams) (*ListHostsResponse, error) {
+	m.record("ListHosts", p)
+	if m.ListHostsFunc == nil {
+		return nil, notMocked("MockHostService", "ListHosts")
+	}
+	return m.ListHostsFunc(ctx, p)
+}
+
+func (m *MockHostService) NewListHostsIterator(p *ListHostsParams, pagesize int) *ListHostsIterator {
+	i := &ListHostsIterator{pager: newPager(nil, "listHosts", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListHostsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListHostsWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockHostService) NewFindHostsForMigrationParams(virtualmachineid string) *FindHostsForMigrationParams {
+	return (&HostService{}).NewFindHostsForMigrationParams(virtualmachineid)
+}
+
+func (m *MockHostService) FindHostsForMigration(p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error) {
+	return m.FindHostsForMigrationWithContext(context.Background(), p)
+}
+
+func (m *MockHostService) FindHostsForMigrationWithContext(ctx context.Context, p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error) {
+	m.record("FindHostsForMigration", p)
+	if m.FindHostsForMigrationFunc == nil {
+		return nil, notMocked("MockHostService", "FindHostsForMigration")
+	}
+	return m.FindHostsForMigrationFunc(ctx, p)
+}
+
+func (m *MockHostService) NewAddSecondaryStorageParams(url string) *AddSecondaryStorageParams {
+	return (&HostService{}).NewAddSecondaryStorageParams(url)
+}
+
+func (m *MockHostService) AddSecondaryStorage(p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error) {
+	return m.AddSecondaryStorageWithContext(context.Background(), p)
+}
+
+func (m *MockHostService) AddSecondaryStorageWithContext(ctx context.Context, p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error) {
+	m.record("AddSecondaryStorage", p)
+	if m.AddSecondaryStorageFunc == nil {
+		return nil, notMocked("MockHostService", "AddSecondaryStorage")
+	}
+	return m.AddSecondaryStorageFunc(ctx, p)
+}
@@ -0,0 +1,311 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestReleaseDedicatedHostParams(t *testing.T) {
+	p := &ReleaseDedicatedHostParams{}
+	want := url.Values{}
+	p.SetHostid("hostid-value")
+	want.Set("hostid", "hostid-value")
+
+	assertParams(t, p, "releaseDedicatedHost", want)
+}
+
+func TestReleaseDedicatedHostResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &ReleaseDedicatedHostResponse{})
+}
+
+func TestListDedicatedHostsParams(t *testing.T) {
+	p := &ListDedicatedHostsParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetAffinitygroupid("affinitygroupid-value")
+	want.Set("affinitygroupid", "affinitygroupid-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetHostid("hostid-value")
+	want.Set("hostid", "hostid-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+
+	assertParams(t, p, "listDedicatedHosts", want)
+}
+
+func TestListDedicatedHostsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"dedicatedhost":[{"accountid":"accountid-value","accountname":"accountname-value","affinitygroupid":"affinitygroupid-value","domainid":"domainid-value","domainname":"domainname-value","hostid":"hostid-value","hostname":"hostname-value","id":"id-value"}]}`, &ListDedicatedHostsResponse{})
+}
+
+func TestAddHostParams(t *testing.T) {
+	p := &AddHostParams{}
+	want := url.Values{}
+	p.SetAllocationstate("allocationstate-value")
+	want.Set("allocationstate", "allocationstate-value")
+	p.SetClusterid("clusterid-value")
+	want.Set("clusterid", "clusterid-value")
+	p.SetClustername("clustername-value")
+	want.Set("clustername", "clustername-value")
+	p.SetHosttags([]string{"a", "b"})
+	want.Set("hosttags", "a,b")
+	p.SetHypervisor("hypervisor-value")
+	want.Set("hypervisor", "hypervisor-value")
+	p.SetPassword("password-value")
+	want.Set("password", "password-value")
+	p.SetPodid("podid-value")
+	want.Set("podid", "podid-value")
+	p.SetUrl("url-value")
+	want.Set("url", "url-value")
+	p.SetUsername("username-value")
+	want.Set("username", "username-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "addHost", want)
+}
+
+func TestAddHostResponse(t *testing.T) {
+	assertDecodes(t, `{"accountid":"accountid-value","accountname":"accountname-value","affinitygroupid":"affinitygroupid-value","affinitygroupname":"affinitygroupname-value","averageload":1099511627776,"capabilities":"capabilities-value","clusterid":"clusterid-value","clustername":"clustername-value","clustertype":"clustertype-value","cpuallocated":"cpuallocated-value","cpunumber":42,"cpusockets":42,"cpuused":"cpuused-value","cpuwithoverprovisioning":"cpuwithoverprovisioning-value","created":"created-value","dedicated":true,"details":{"key":"value"},"disconnected":"disconnected-value","disksizeallocated":1099511627776,"disksizetotal":1099511627776,"domainid":"domainid-value","domainname":"domainname-value","events":"events-value","gpugroup":[{"gpugroupname":"gpugroupname-value","vgpu":[{"maxcapacity":1099511627776,"maxheads":1099511627776,"maxresolutionx":1099511627776,"maxresolutiony":1099511627776,"maxvgpuperpgpu":1099511627776,"remainingcapacity":1099511627776,"vgputype":"vgputype-value","videoram":1099511627776}]}],"hahost":true,"hasenoughcapacity":true,"hosttags":"hosttags-value","hypervisor":"hypervisor-value","hypervisorversion":"hypervisorversion-value","id":"id-value","ipaddress":"ipaddress-value","islocalstorageactive":true,"lastpinged":"lastpinged-value","managementserverid":1099511627776,"memoryallocated":1099511627776,"memorytotal":1099511627776,"memoryused":1099511627776,"name":"name-value","networkkbsread":1099511627776,"networkkbswrite":1099511627776,"oscategoryid":"oscategoryid-value","oscategoryname":"oscategoryname-value","podid":"podid-value","podname":"podname-value","removed":"removed-value","resourcestate":"resourcestate-value","state":"state-value","suitableformigration":true,"type":"type-value","version":"version-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &AddHostResponse{})
+}
+
+func TestDedicateHostParams(t *testing.T) {
+	p := &DedicateHostParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetHostid("hostid-value")
+	want.Set("hostid", "hostid-value")
+
+	assertParams(t, p, "dedicateHost", want)
+}
+
+func TestDedicateHostResponse(t *testing.T) {
+	assertDecodes(t, `{"accountid":"accountid-value","accountname":"accountname-value","affinitygroupid":"affinitygroupid-value","domainid":"domainid-value","domainname":"domainname-value","hostid":"hostid-value","hostname":"hostname-value","id":"id-value","jobid":"jobid-value"}`, &DedicateHostResponse{})
+}
+
+func TestDeleteHostParams(t *testing.T) {
+	p := &DeleteHostParams{}
+	want := url.Values{}
+	p.SetForced(true)
+	want.Set("forced", "true")
+	p.SetForcedestroylocalstorage(true)
+	want.Set("forcedestroylocalstorage", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteHost", want)
+}
+
+func TestDeleteHostResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &DeleteHostResponse{})
+}
+
+func TestReconnectHostParams(t *testing.T) {
+	p := &ReconnectHostParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "reconnectHost", want)
+}
+
+func TestReconnectHostResponse(t *testing.T) {
+	assertDecodes(t, `{"accountid":"accountid-value","accountname":"accountname-value","affinitygroupid":"affinitygroupid-value","affinitygroupname":"affinitygroupname-value","averageload":1099511627776,"capabilities":"capabilities-value","clusterid":"clusterid-value","clustername":"clustername-value","clustertype":"clustertype-value","cpuallocated":"cpuallocated-value","cpunumber":42,"cpusockets":42,"cpuused":"cpuused-value","cpuwithoverprovisioning":"cpuwithoverprovisioning-value","created":"created-value","dedicated":true,"details":{"key":"value"},"disconnected":"disconnected-value","disksizeallocated":1099511627776,"disksizetotal":1099511627776,"domainid":"domainid-value","domainname":"domainname-value","events":"events-value","gpugroup":[{"gpugroupname":"gpugroupname-value","vgpu":[{"maxcapacity":1099511627776,"maxheads":1099511627776,"maxresolutionx":1099511627776,"maxresolutiony":1099511627776,"maxvgpuperpgpu":1099511627776,"remainingcapacity":1099511627776,"vgputype":"vgputype-value","videoram":1099511627776}]}],"hahost":true,"hasenoughcapacity":true,"hosttags":"hosttags-value","hypervisor":"hypervisor-value","hypervisorversion":"hypervisorversion-value","id":"id-value","ipaddress":"ipaddress-value","islocalstorageactive":true,"jobid":"jobid-value","lastpinged":"lastpinged-value","managementserverid":1099511627776,"memoryallocated":1099511627776,"memorytotal":1099511627776,"memoryused":1099511627776,"name":"name-value","networkkbsread":1099511627776,"networkkbswrite":1099511627776,"oscategoryid":"oscategoryid-value","oscategoryname":"oscategoryname-value","podid":"podid-value","podname":"podname-value","removed":"removed-value","resourcestate":"resourcestate-value","state":"state-value","suitableformigration":true,"type":"type-value","version":"version-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &ReconnectHostResponse{})
+}
+
+func TestUpdateHostParams(t *testing.T) {
+	p := &UpdateHostParams{}
+	want := url.Values{}
+	p.SetAllocationstate("allocationstate-value")
+	want.Set("allocationstate", "allocationstate-value")
+	p.SetHosttags([]string{"a", "b"})
+	want.Set("hosttags", "a,b")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetOscategoryid("oscategoryid-value")
+	want.Set("oscategoryid", "oscategoryid-value")
+	p.SetUrl("url-value")
+	want.Set("url", "url-value")
+
+	assertParams(t, p, "updateHost", want)
+}
+
+func TestUpdateHostResponse(t *testing.T) {
+	assertDecodes(t, `{"accountid":"accountid-value","accountname":"accountname-value","affinitygroupid":"affinitygroupid-value","affinitygroupname":"affinitygroupname-value","averageload":1099511627776,"capabilities":"capabilities-value","clusterid":"clusterid-value","clustername":"clustername-value","clustertype":"clustertype-value","cpuallocated":"cpuallocated-value","cpunumber":42,"cpusockets":42,"cpuused":"cpuused-value","cpuwithoverprovisioning":"cpuwithoverprovisioning-value","created":"created-value","dedicated":true,"details":{"key":"value"},"disconnected":"disconnected-value","disksizeallocated":1099511627776,"disksizetotal":1099511627776,"domainid":"domainid-value","domainname":"domainname-value","events":"events-value","gpugroup":[{"gpugroupname":"gpugroupname-value","vgpu":[{"maxcapacity":1099511627776,"maxheads":1099511627776,"maxresolutionx":1099511627776,"maxresolutiony":1099511627776,"maxvgpuperpgpu":1099511627776,"remainingcapacity":1099511627776,"vgputype":"vgputype-value","videoram":1099511627776}]}],"hahost":true,"hasenoughcapacity":true,"hosttags":"hosttags-value","hypervisor":"hypervisor-value","hypervisorversion":"hypervisorversion-value","id":"id-value","ipaddress":"ipaddress-value","islocalstorageactive":true,"lastpinged":"lastpinged-value","managementserverid":1099511627776,"memoryallocated":1099511627776,"memorytotal":1099511627776,"memoryused":1099511627776,"name":"name-value","networkkbsread":1099511627776,"networkkbswrite":1099511627776,"oscategoryid":"oscategoryid-value","oscategoryname":"oscategoryname-value","podid":"podid-value","podname":"podname-value","removed":"removed-value","resourcestate":"resourcestate-value","state":"state-value","suitableformigration":true,"type":"type-value","version":"version-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &UpdateHostResponse{})
+}
+
+func TestPrepareHostForMaintenanceParams(t *testing.T) {
+	p := &PrepareHostForMaintenanceParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "prepareHostForMaintenance", want)
+}
+
+func TestPrepareHostForMaintenanceResponse(t *testing.T) {
+	assertDecodes(t, `{"accountid":"accountid-value","accountname":"accountname-value","affinitygroupid":"affinitygroupid-value","affinitygroupname":"affinitygroupname-value","averageload":1099511627776,"capabilities":"capabilities-value","clusterid":"clusterid-value","clustername":"clustername-value","clustertype":"clustertype-value","cpuallocated":"cpuallocated-value","cpunumber":42,"cpusockets":42,"cpuused":"cpuused-value","cpuwithoverprovisioning":"cpuwithoverprovisioning-value","created":"created-value","dedicated":true,"details":{"key":"value"},"disconnected":"disconnected-value","disksizeallocated":1099511627776,"disksizetotal":1099511627776,"domainid":"domainid-value","domainname":"domainname-value","events":"events-value","gpugroup":[{"gpugroupname":"gpugroupname-value","vgpu":[{"maxcapacity":1099511627776,"maxheads":1099511627776,"maxresolutionx":1099511627776,"maxresolutiony":1099511627776,"maxvgpuperpgpu":1099511627776,"remainingcapacity":1099511627776,"vgputype":"vgputype-value","videoram":1099511627776}]}],"hahost":true,"hasenoughcapacity":true,"hosttags":"hosttags-value","hypervisor":"hypervisor-value","hypervisorversion":"hypervisorversion-value","id":"id-value","ipaddress":"ipaddress-value","islocalstorageactive":true,"jobid":"jobid-value","lastpinged":"lastpinged-value","managementserverid":1099511627776,"memoryallocated":1099511627776,"memorytotal":1099511627776,"memoryused":1099511627776,"name":"name-value","networkkbsread":1099511627776,"networkkbswrite":1099511627776,"oscategoryid":"oscategoryid-value","oscategoryname":"oscategoryname-value","podid":"podid-value","podname":"podname-value","removed":"removed-value","resourcestate":"resourcestate-value","state":"state-value","suitableformigration":true,"type":"type-value","version":"version-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &PrepareHostForMaintenanceResponse{})
+}
+
+func TestCancelHostMaintenanceParams(t *testing.T) {
+	p := &CancelHostMaintenanceParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "cancelHostMaintenance", want)
+}
+
+func TestCancelHostMaintenanceResponse(t *testing.T) {
+	assertDecodes(t, `{"accountid":"accountid-value","accountname":"accountname-value","affinitygroupid":"affinitygroupid-value","affinitygroupname":"affinitygroupname-value","averageload":1099511627776,"capabilities":"capabilities-value","clusterid":"clusterid-value","clustername":"clustername-value","clustertype":"clustertype-value","cpuallocated":"cpuallocated-value","cpunumber":42,"cpusockets":42,"cpuused":"cpuused-value","cpuwithoverprovisioning":"cpuwithoverprovisioning-value","created":"created-value","dedicated":true,"details":{"key":"value"},"disconnected":"disconnected-value","disksizeallocated":1099511627776,"disksizetotal":1099511627776,"domainid":"domainid-value","domainname":"domainname-value","events":"events-value","gpugroup":[{"gpugroupname":"gpugroupname-value","vgpu":[{"maxcapacity":1099511627776,"maxheads":1099511627776,"maxresolutionx":1099511627776,"maxresolutiony":1099511627776,"maxvgpuperpgpu":1099511627776,"remainingcapacity":1099511627776,"vgputype":"vgputype-value","videoram":1099511627776}]}],"hahost":true,"hasenoughcapacity":true,"hosttags":"hosttags-value","hypervisor":"hypervisor-value","hypervisorversion":"hypervisorversion-value","id":"id-value","ipaddress":"ipaddress-value","islocalstorageactive":true,"jobid":"jobid-value","lastpinged":"lastpinged-value","managementserverid":1099511627776,"memoryallocated":1099511627776,"memorytotal":1099511627776,"memoryused":1099511627776,"name":"name-value","networkkbsread":1099511627776,"networkkbswrite":1099511627776,"oscategoryid":"oscategoryid-value","oscategoryname":"oscategoryname-value","podid":"podid-value","podname":"podname-value","removed":"removed-value","resourcestate":"resourcestate-value","state":"state-value","suitableformigration":true,"type":"type-value","version":"version-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &CancelHostMaintenanceResponse{})
+}
+
+func TestUpdateHostPasswordParams(t *testing.T) {
+	p := &UpdateHostPasswordParams{}
+	want := url.Values{}
+	p.SetClusterid("clusterid-value")
+	want.Set("clusterid", "clusterid-value")
+	p.SetHostid("hostid-value")
+	want.Set("hostid", "hostid-value")
+	p.SetPassword("password-value")
+	want.Set("password", "password-value")
+	p.SetUpdate_passwd_on_host(true)
+	want.Set("update_passwd_on_host", "true")
+	p.SetUsername("username-value")
+	want.Set("username", "username-value")
+
+	assertParams(t, p, "updateHostPassword", want)
+}
+
+func TestUpdateHostPasswordResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &UpdateHostPasswordResponse{})
+}
+
+func TestReleaseHostReservationParams(t *testing.T) {
+	p := &ReleaseHostReservationParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "releaseHostReservation", want)
+}
+
+func TestReleaseHostReservationResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &ReleaseHostReservationResponse{})
+}
+
+func TestListHostTagsParams(t *testing.T) {
+	p := &ListHostTagsParams{}
+	want := url.Values{}
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+
+	assertParams(t, p, "listHostTags", want)
+}
+
+func TestListHostTagsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"hosttag":[{"hostid":1099511627776,"id":"id-value","name":"name-value"}]}`, &ListHostTagsResponse{})
+}
+
+func TestListHostsParams(t *testing.T) {
+	p := &ListHostsParams{}
+	want := url.Values{}
+	p.SetClusterid("clusterid-value")
+	want.Set("clusterid", "clusterid-value")
+	p.SetDetails([]string{"a", "b"})
+	want.Set("details", "a,b")
+	p.SetHahost(true)
+	want.Set("hahost", "true")
+	p.SetHypervisor("hypervisor-value")
+	want.Set("hypervisor", "hypervisor-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetPodid("podid-value")
+	want.Set("podid", "podid-value")
+	p.SetResourcestate("resourcestate-value")
+	want.Set("resourcestate", "resourcestate-value")
+	p.SetState("state-value")
+	want.Set("state", "state-value")
+	p.SetType("type-value")
+	want.Set("type", "type-value")
+	p.SetVirtualmachineid("virtualmachineid-value")
+	want.Set("virtualmachineid", "virtualmachineid-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listHosts", want)
+}
+
+func TestListHostsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"host":[{"accountid":"accountid-value","accountname":"accountname-value","affinitygroupid":"affinitygroupid-value","affinitygroupname":"affinitygroupname-value","averageload":1099511627776,"capabilities":"capabilities-value","clusterid":"clusterid-value","clustername":"clustername-value","clustertype":"clustertype-value","cpuallocated":"cpuallocated-value","cpunumber":42,"cpusockets":42,"cpuused":"cpuused-value","cpuwithoverprovisioning":"cpuwithoverprovisioning-value","created":"created-value","dedicated":true,"details":{"key":"value"},"disconnected":"disconnected-value","disksizeallocated":1099511627776,"disksizetotal":1099511627776,"domainid":"domainid-value","domainname":"domainname-value","events":"events-value","gpugroup":[{"gpugroupname":"gpugroupname-value","vgpu":[{"maxcapacity":1099511627776,"maxheads":1099511627776,"maxresolutionx":1099511627776,"maxresolutiony":1099511627776,"maxvgpuperpgpu":1099511627776,"remainingcapacity":1099511627776,"vgputype":"vgputype-value","videoram":1099511627776}]}],"hahost":true,"hasenoughcapacity":true,"hosttags":"hosttags-value","hypervisor":"hypervisor-value","hypervisorversion":"hypervisorversion-value","id":"id-value","ipaddress":"ipaddress-value","islocalstorageactive":true,"lastpinged":"lastpinged-value","managementserverid":1099511627776,"memoryallocated":1099511627776,"memorytotal":1099511627776,"memoryused":1099511627776,"name":"name-value","networkkbsread":1099511627776,"networkkbswrite":1099511627776,"oscategoryid":"oscategoryid-value","oscategoryname":"oscategoryname-value","podid":"podid-value","podname":"podname-value","removed":"removed-value","resourcestate":"resourcestate-value","state":"state-value","suitableformigration":true,"type":"type-value","version":"version-value","zoneid":"zoneid-value","zonename":"zonename-value"}]}`, &ListHostsResponse{})
+}
+
+func TestFindHostsForMigrationParams(t *testing.T) {
+	p := &FindHostsForMigrationParams{}
+	want := url.Values{}
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetVirtualmachineid("virtualmachineid-value")
+	want.Set("virtualmachineid", "virtualmachineid-value")
+
+	assertParams(t, p, "findHostsForMigration", want)
+}
+
+func TestFindHostsForMigrationResponse(t *testing.T) {
+	assertDecodes(t, `{"accountid":"accountid-value","accountname":"accountname-value","affinitygroupid":"affinitygroupid-value","affinitygroupname":"affinitygroupname-value","averageload":1099511627776,"capabilities":"capabilities-value","clusterid":"clusterid-value","clustername":"clustername-value","clustertype":"clustertype-value","cpuallocated":"cpuallocated-value","cpunumber":42,"cpuused":"cpuused-value","cpuwithoverprovisioning":"cpuwithoverprovisioning-value","created":"created-value","dedicated":true,"disconnected":"disconnected-value","disksizeallocated":1099511627776,"disksizetotal":1099511627776,"domainid":"domainid-value","domainname":"domainname-value","events":"events-value","hahost":true,"hasenoughcapacity":true,"hosttags":"hosttags-value","hypervisor":"hypervisor-value","hypervisorversion":"hypervisorversion-value","id":"id-value","ipaddress":"ipaddress-value","islocalstorageactive":true,"lastpinged":"lastpinged-value","managementserverid":1099511627776,"memoryallocated":1099511627776,"memorytotal":1099511627776,"memoryused":1099511627776,"name":"name-value","networkkbsread":1099511627776,"networkkbswrite":1099511627776,"oscategoryid":"oscategoryid-value","oscategoryname":"oscategoryname-value","podid":"podid-value","podname":"podname-value","removed":"removed-value","requiresStorageMotion":true,"resourcestate":"resourcestate-value","state":"state-value","suitableformigration":true,"type":"type-value","version":"version-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &FindHostsForMigrationResponse{})
+}
+
+func TestAddSecondaryStorageParams(t *testing.T) {
+	p := &AddSecondaryStorageParams{}
+	want := url.Values{}
+	p.SetUrl("url-value")
+	want.Set("url", "url-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "addSecondaryStorage", want)
+}
+
+func TestAddSecondaryStorageResponse(t *testing.T) {
+	assertDecodes(t, `{"details":["a","b"],"id":"id-value","name":"name-value","protocol":"protocol-value","providername":"providername-value","scope":"scope-value","url":"url-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &AddSecondaryStorageResponse{})
+}
@@ -17,17 +17,37 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
+// HypervisorServiceIface is the interface implemented by HypervisorService, and by MockHypervisorService for tests
+type HypervisorServiceIface interface {
+	NewListHypervisorCapabilitiesParams() *ListHypervisorCapabilitiesParams
+	GetHypervisorCapabilityByID(id string, opts ...OptionFunc) (*HypervisorCapability, int, error)
+	ListHypervisorCapabilities(p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error)
+	ListHypervisorCapabilitiesWithContext(ctx context.Context, p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error)
+	NewListHypervisorCapabilitiesIterator(p *ListHypervisorCapabilitiesParams, pagesize int) *ListHypervisorCapabilitiesIterator
+	NewUpdateHypervisorCapabilitiesParams() *UpdateHypervisorCapabilitiesParams
+	UpdateHypervisorCapabilities(p *UpdateHypervisorCapabilitiesParams) (*UpdateHypervisorCapabilitiesResponse, error)
+	UpdateHypervisorCapabilitiesWithContext(ctx context.Context, p *UpdateHypervisorCapabilitiesParams) (*UpdateHypervisorCapabilitiesResponse, error)
+	NewListHypervisorsParams() *ListHypervisorsParams
+	ListHypervisors(p *ListHypervisorsParams) (*ListHypervisorsResponse, error)
+	ListHypervisorsWithContext(ctx context.Context, p *ListHypervisorsParams) (*ListHypervisorsResponse, error)
+}
+
 type ListHypervisorCapabilitiesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListHypervisorCapabilitiesParams) command() string {
+	return "listHypervisorCapabilities"
+}
+
 func (p *ListHypervisorCapabilitiesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -111,16 +131,14 @@ func (s *HypervisorService) GetHypervisorCapabilityByID(id string, opts ...Optio
 
 	l, err := s.ListHypervisorCapabilities(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -132,10 +150,17 @@ func (s *HypervisorService) GetHypervisorCapabilityByID(id string, opts ...Optio
 
 // Lists all hypervisor capabilities.
 func (s *HypervisorService) ListHypervisorCapabilities(p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error) {
+	return s.ListHypervisorCapabilitiesWithContext(context.Background(), p)
+}
+
+// ListHypervisorCapabilitiesWithContext is the same as ListHypervisorCapabilities, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *HypervisorService) ListHypervisorCapabilitiesWithContext(ctx context.Context, p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error) {
 	var r ListHypervisorCapabilitiesResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListHypervisorCapabilitiesResponse
-		resp, err := s.cs.newRequest("listHypervisorCapabilities", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listHypervisorCapabilities", values)
 		if err != nil {
 			return nil, err
 		}
@@ -147,13 +172,89 @@ func (s *HypervisorService) ListHypervisorCapabilities(p *ListHypervisorCapabili
 		r.Count = l.Count
 		r.HypervisorCapabilities = append(r.HypervisorCapabilities, l.HypervisorCapabilities...)
 
-		if r.Count == len(r.HypervisorCapabilities) {
+		if r.Count == len(r.HypervisorCapabilities) || len(l.HypervisorCapabilities) == 0 {
+			s.cs.observePages("listHypervisorCapabilities", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.HypervisorCapabilities))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.HypervisorCapabilities)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listHypervisorCapabilities", values, len(l.HypervisorCapabilities), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListHypervisorCapabilitiesResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.HypervisorCapabilities = append(r.HypervisorCapabilities, l.HypervisorCapabilities...)
+			}
+
+			if r.Count == len(r.HypervisorCapabilities) {
+				s.cs.observePages("listHypervisorCapabilities", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListHypervisorCapabilitiesIterator iterates over the items returned by listHypervisorCapabilities, requesting
+// the pages of the response one at a time while iterating.
+type ListHypervisorCapabilitiesIterator struct {
+	pager
+	items []*HypervisorCapability
+	index int
+}
+
+// NewListHypervisorCapabilitiesIterator returns an iterator over the items returned by listHypervisorCapabilities, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *HypervisorService) NewListHypervisorCapabilitiesIterator(p *ListHypervisorCapabilitiesParams, pagesize int) *ListHypervisorCapabilitiesIterator {
+	return &ListHypervisorCapabilitiesIterator{pager: newPager(s.cs, "listHypervisorCapabilities", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListHypervisorCapabilitiesIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListHypervisorCapabilitiesResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.HypervisorCapabilities))
+
+	i.items = l.HypervisorCapabilities
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListHypervisorCapabilitiesIterator) Value() *HypervisorCapability {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListHypervisorCapabilitiesResponse struct {
@@ -175,6 +276,10 @@ type UpdateHypervisorCapabilitiesParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateHypervisorCapabilitiesParams) command() string {
+	return "updateHypervisorCapabilities"
+}
+
 func (p *UpdateHypervisorCapabilitiesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -214,7 +319,13 @@ func (s *HypervisorService) NewUpdateHypervisorCapabilitiesParams() *UpdateHyper
 
 // Updates a hypervisor capabilities.
 func (s *HypervisorService) UpdateHypervisorCapabilities(p *UpdateHypervisorCapabilitiesParams) (*UpdateHypervisorCapabilitiesResponse, error) {
-	resp, err := s.cs.newRequest("updateHypervisorCapabilities", p.toURLValues())
+	return s.UpdateHypervisorCapabilitiesWithContext(context.Background(), p)
+}
+
+// UpdateHypervisorCapabilitiesWithContext is the same as UpdateHypervisorCapabilities, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *HypervisorService) UpdateHypervisorCapabilitiesWithContext(ctx context.Context, p *UpdateHypervisorCapabilitiesParams) (*UpdateHypervisorCapabilitiesResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateHypervisorCapabilities", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -240,6 +351,10 @@ type ListHypervisorsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListHypervisorsParams) command() string {
+	return "listHypervisors"
+}
+
 func (p *ListHypervisorsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -268,7 +383,13 @@ func (s *HypervisorService) NewListHypervisorsParams() *ListHypervisorsParams {
 
 // List hypervisors
 func (s *HypervisorService) ListHypervisors(p *ListHypervisorsParams) (*ListHypervisorsResponse, error) {
-	resp, err := s.cs.newRequest("listHypervisors", p.toURLValues())
+	return s.ListHypervisorsWithContext(context.Background(), p)
+}
+
+// ListHypervisorsWithContext is the same as ListHypervisors, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *HypervisorService) ListHypervisorsWithContext(ctx context.Context, p *ListHypervisorsParams) (*ListHypervisorsResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "listHypervisors", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,103 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockHypervisorService is a mock of HypervisorServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockHypervisorService struct {
+	mockCalls
+
+	GetHypervisorCapabilityByIDFunc  func(id string, opts ...OptionFunc) (*HypervisorCapability, int, error)
+	ListHypervisorCapabilitiesFunc   func(ctx context.Context, p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error)
+	UpdateHypervisorCapabilitiesFunc func(ctx context.Context, p *UpdateHypervisorCapabilitiesParams) (*UpdateHypervisorCapabilitiesResponse, error)
+	ListHypervisorsFunc              func(ctx context.Context, p *ListHypervisorsParams) (*ListHypervisorsResponse, error)
+}
+
+var _ HypervisorServiceIface = &MockHypervisorService{}
+
+func (m *MockHypervisorService) NewListHypervisorCapabilitiesParams() *ListHypervisorCapabilitiesParams {
+	return (&HypervisorService{}).NewListHypervisorCapabilitiesParams()
+}
+
+func (m *MockHypervisorService) GetHypervisorCapabilityByID(id string, opts ...OptionFunc) (*HypervisorCapability, int, error) {
+	m.record("GetHypervisorCapabilityByID", id, opts)
+	if m.GetHypervisorCapabilityByIDFunc == nil {
+		return nil, -1, notMocked("MockHypervisorService", "GetHypervisorCapabilityByID")
+	}
+	return m.GetHypervisorCapabilityByIDFunc(id, opts...)
+}
+
+func (m *MockHypervisorService) ListHypervisorCapabilities(p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error) {
+	return m.ListHypervisorCapabilitiesWithContext(context.Background(), p)
+}
+
+func (m *MockHypervisorService) ListHypervisorCapabilitiesWithContext(ctx context.Context, p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error) {
+	m.record("ListHypervisorCapabilities", p)
+	if m.ListHypervisorCapabilitiesFunc == nil {
+		return nil, notMocked("MockHypervisorService", "ListHypervisorCapabilities")
+	}
+	return m.ListHypervisorCapabilitiesFunc(ctx, p)
+}
+
+func (m *MockHypervisorService) NewListHypervisorCapabilitiesIterator(p *ListHypervisorCapabilitiesParams, pagesize int) *ListHypervisorCapabilitiesIterator {
+	i := &ListHypervisorCapabilitiesIterator{pager: newPager(nil, "listHypervisorCapabilities", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListHypervisorCapabilitiesParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListHypervisorCapabilitiesWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockHypervisorService) NewUpdateHypervisorCapabilitiesParams() *UpdateHypervisorCapabilitiesParams {
+	return (&HypervisorService{}).NewUpdateHypervisorCapabilitiesParams()
+}
+
+func (m *MockHypervisorService) UpdateHypervisorCapabilities(p *UpdateHypervisorCapabilitiesParams) (*UpdateHypervisorCapabilitiesResponse, error) {
+	return m.UpdateHypervisorCapabilitiesWithContext(context.Background(), p)
+}
+
+func (m *MockHypervisorService) UpdateHypervisorCapabilitiesWithContext(ctx context.Context, p *UpdateHypervisorCapabilitiesParams) (*UpdateHypervisorCapabilitiesResponse, error) {
+	m.record("UpdateHypervisorCapabilities", p)
+	if m.UpdateHypervisorCapabilitiesFunc == nil {
+		return nil, notMocked("MockHypervisorService", "UpdateHypervisorCapabilities")
+	}
+	return m.UpdateHypervisorCapabilitiesFunc(ctx, p)
+}
+
+func (m *MockHypervisorService) NewListHypervisorsParams() *ListHypervisorsParams {
+	return (&HypervisorService{}).NewListHypervisorsParams()
+}
+
+func (m *MockHypervisorService) ListHypervisors(p *ListHypervisorsParams) (*ListHypervisorsResponse, error) {
+	return m.ListHypervisorsWithContext(context.Background(), p)
+}
+
+func (m *MockHypervisorService) ListHypervisorsWithContext(ctx context.Context, p *ListHypervisorsParams) (*ListHypervisorsResponse, error) {
+	m.record("ListHypervisors", p)
+	if m.ListHypervisorsFunc == nil {
+		return nil, notMocked("MockHypervisorService", "ListHypervisors")
+	}
+	return m.ListHypervisorsFunc(ctx, p)
+}
@@ -0,0 +1,71 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestListHypervisorCapabilitiesParams(t *testing.T) {
+	p := &ListHypervisorCapabilitiesParams{}
+	want := url.Values{}
+	p.SetHypervisor("hypervisor-value")
+	want.Set("hypervisor", "hypervisor-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+
+	assertParams(t, p, "listHypervisorCapabilities", want)
+}
+
+func TestListHypervisorCapabilitiesResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"hypervisorcapability":[{"hypervisor":"hypervisor-value","hypervisorversion":"hypervisorversion-value","id":"id-value","maxdatavolumeslimit":42,"maxguestslimit":1099511627776,"maxhostspercluster":42,"storagemotionenabled":true}]}`, &ListHypervisorCapabilitiesResponse{})
+}
+
+func TestUpdateHypervisorCapabilitiesParams(t *testing.T) {
+	p := &UpdateHypervisorCapabilitiesParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetMaxguestslimit(int64(1) << 40)
+	want.Set("maxguestslimit", "1099511627776")
+
+	assertParams(t, p, "updateHypervisorCapabilities", want)
+}
+
+func TestUpdateHypervisorCapabilitiesResponse(t *testing.T) {
+	assertDecodes(t, `{"hypervisor":"hypervisor-value","hypervisorversion":"hypervisorversion-value","id":"id-value","maxdatavolumeslimit":42,"maxguestslimit":1099511627776,"maxhostspercluster":42,"storagemotionenabled":true}`, &UpdateHypervisorCapabilitiesResponse{})
+}
+
+func TestListHypervisorsParams(t *testing.T) {
+	p := &ListHypervisorsParams{}
+	want := url.Values{}
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listHypervisors", want)
+}
+
+func TestListHypervisorsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"hypervisor":[{"name":"name-value"}]}`, &ListHypervisorsResponse{})
+}
@@ -17,17 +17,67 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 )
 
+// ISOServiceIface is the interface implemented by ISOService, and by MockISOService for tests
+type ISOServiceIface interface {
+	NewAttachIsoParams(id string, virtualmachineid string) *AttachIsoParams
+	AttachIso(p *AttachIsoParams) (*AttachIsoResponse, error)
+	AttachIsoWithContext(ctx context.Context, p *AttachIsoParams) (*AttachIsoResponse, error)
+	AttachIsoAsync(ctx context.Context, p *AttachIsoParams) (*AttachIsoJob, error)
+	NewCopyIsoParams(destzoneid string, id string) *CopyIsoParams
+	CopyIso(p *CopyIsoParams) (*CopyIsoResponse, error)
+	CopyIsoWithContext(ctx context.Context, p *CopyIsoParams) (*CopyIsoResponse, error)
+	CopyIsoAsync(ctx context.Context, p *CopyIsoParams) (*CopyIsoJob, error)
+	NewDeleteIsoParams(id string) *DeleteIsoParams
+	DeleteIso(p *DeleteIsoParams) (*DeleteIsoResponse, error)
+	DeleteIsoWithContext(ctx context.Context, p *DeleteIsoParams) (*DeleteIsoResponse, error)
+	DeleteIsoAsync(ctx context.Context, p *DeleteIsoParams) (*DeleteIsoJob, error)
+	NewDetachIsoParams(virtualmachineid string) *DetachIsoParams
+	DetachIso(p *DetachIsoParams) (*DetachIsoResponse, error)
+	DetachIsoWithContext(ctx context.Context, p *DetachIsoParams) (*DetachIsoResponse, error)
+	DetachIsoAsync(ctx context.Context, p *DetachIsoParams) (*DetachIsoJob, error)
+	NewExtractIsoParams(id string, mode string) *ExtractIsoParams
+	ExtractIso(p *ExtractIsoParams) (*ExtractIsoResponse, error)
+	ExtractIsoWithContext(ctx context.Context, p *ExtractIsoParams) (*ExtractIsoResponse, error)
+	ExtractIsoAsync(ctx context.Context, p *ExtractIsoParams) (*ExtractIsoJob, error)
+	NewRegisterIsoParams(displaytext string, name string, url string, zoneid string) *RegisterIsoParams
+	RegisterIso(p *RegisterIsoParams) (*RegisterIsoResponse, error)
+	RegisterIsoWithContext(ctx context.Context, p *RegisterIsoParams) (*RegisterIsoResponse, error)
+	NewUpdateIsoParams(id string) *UpdateIsoParams
+	UpdateIso(p *UpdateIsoParams) (*UpdateIsoResponse, error)
+	UpdateIsoWithContext(ctx context.Context, p *UpdateIsoParams) (*UpdateIsoResponse, error)
+	NewListIsoPermissionsParams(id string) *ListIsoPermissionsParams
+	GetIsoPermissionByID(id string, opts ...OptionFunc) (*IsoPermission, int, error)
+	ListIsoPermissions(p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error)
+	ListIsoPermissionsWithContext(ctx context.Context, p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error)
+	NewUpdateIsoPermissionsParams(id string) *UpdateIsoPermissionsParams
+	UpdateIsoPermissions(p *UpdateIsoPermissionsParams) (*UpdateIsoPermissionsResponse, error)
+	UpdateIsoPermissionsWithContext(ctx context.Context, p *UpdateIsoPermissionsParams) (*UpdateIsoPermissionsResponse, error)
+	NewListIsosParams() *ListIsosParams
+	GetIsoID(name string, isofilter string, zoneid string, opts ...OptionFunc) (string, int, error)
+	GetIsoByName(name string, isofilter string, zoneid string, opts ...OptionFunc) (*Iso, int, error)
+	GetIsoByID(id string, opts ...OptionFunc) (*Iso, int, error)
+	ListIsos(p *ListIsosParams) (*ListIsosResponse, error)
+	ListIsosWithContext(ctx context.Context, p *ListIsosParams) (*ListIsosResponse, error)
+	NewListIsosIterator(p *ListIsosParams, pagesize int) *ListIsosIterator
+}
+
 type AttachIsoParams struct {
 	p map[string]interface{}
 }
 
+func (p *AttachIsoParams) command() string {
+	return "attachIso"
+}
+
 func (p *AttachIsoParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -68,7 +118,13 @@ func (s *ISOService) NewAttachIsoParams(id string, virtualmachineid string) *Att
 
 // Attaches an ISO to a virtual machine.
 func (s *ISOService) AttachIso(p *AttachIsoParams) (*AttachIsoResponse, error) {
-	resp, err := s.cs.newRequest("attachIso", p.toURLValues())
+	return s.AttachIsoWithContext(context.Background(), p)
+}
+
+// AttachIsoWithContext is the same as AttachIso, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ISOService) AttachIsoWithContext(ctx context.Context, p *AttachIsoParams) (*AttachIsoResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "attachIso", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -80,26 +136,56 @@ func (s *ISOService) AttachIso(p *AttachIsoParams) (*AttachIsoResponse, error) {
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "attachIso", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// AttachIsoJob is a handle to a running attachIso async job
+type AttachIsoJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a AttachIsoResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *AttachIsoJob) Result() (*AttachIsoResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := AttachIsoResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// AttachIsoAsync starts the attachIso async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *ISOService) AttachIsoAsync(ctx context.Context, p *AttachIsoParams) (*AttachIsoJob, error) {
+	resp, err := s.cs.newRequest(ctx, "attachIso", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AttachIsoResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &AttachIsoJob{s.cs.newJobHandle("attachIso", r.JobID)}, nil
+}
+
 type AttachIsoResponse struct {
 	JobID         string `json:"jobid,omitempty"`
 	Account       string `json:"account,omitempty"`
@@ -217,6 +303,10 @@ type CopyIsoParams struct {
 	p map[string]interface{}
 }
 
+func (p *CopyIsoParams) command() string {
+	return "copyIso"
+}
+
 func (p *CopyIsoParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -267,7 +357,13 @@ func (s *ISOService) NewCopyIsoParams(destzoneid string, id string) *CopyIsoPara
 
 // Copies an iso from one zone to another.
 func (s *ISOService) CopyIso(p *CopyIsoParams) (*CopyIsoResponse, error) {
-	resp, err := s.cs.newRequest("copyIso", p.toURLValues())
+	return s.CopyIsoWithContext(context.Background(), p)
+}
+
+// CopyIsoWithContext is the same as CopyIso, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ISOService) CopyIsoWithContext(ctx context.Context, p *CopyIsoParams) (*CopyIsoResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "copyIso", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -279,26 +375,56 @@ func (s *ISOService) CopyIso(p *CopyIsoParams) (*CopyIsoResponse, error) {
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "copyIso", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "iso", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CopyIsoJob is a handle to a running copyIso async job
+type CopyIsoJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CopyIsoResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CopyIsoJob) Result() (*CopyIsoResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CopyIsoResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "iso", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CopyIsoAsync starts the copyIso async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *ISOService) CopyIsoAsync(ctx context.Context, p *CopyIsoParams) (*CopyIsoJob, error) {
+	resp, err := s.cs.newRequest(ctx, "copyIso", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CopyIsoResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CopyIsoJob{s.cs.newJobHandle("copyIso", r.JobID)}, nil
+}
+
 type CopyIsoResponse struct {
 	JobID                 string            `json:"jobid,omitempty"`
 	Account               string            `json:"account,omitempty"`
@@ -360,6 +486,10 @@ type DeleteIsoParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteIsoParams) command() string {
+	return "deleteIso"
+}
+
 func (p *DeleteIsoParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -399,7 +529,13 @@ func (s *ISOService) NewDeleteIsoParams(id string) *DeleteIsoParams {
 
 // Deletes an ISO file.
 func (s *ISOService) DeleteIso(p *DeleteIsoParams) (*DeleteIsoResponse, error) {
-	resp, err := s.cs.newRequest("deleteIso", p.toURLValues())
+	return s.DeleteIsoWithContext(context.Background(), p)
+}
+
+// DeleteIsoWithContext is the same as DeleteIso, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ISOService) DeleteIsoWithContext(ctx context.Context, p *DeleteIsoParams) (*DeleteIsoResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteIso", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -411,9 +547,9 @@ func (s *ISOService) DeleteIso(p *DeleteIsoParams) (*DeleteIsoResponse, error) {
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteIso", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -426,6 +562,41 @@ func (s *ISOService) DeleteIso(p *DeleteIsoParams) (*DeleteIsoResponse, error) {
 	return &r, nil
 }
 
+// DeleteIsoJob is a handle to a running deleteIso async job
+type DeleteIsoJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteIsoResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteIsoJob) Result() (*DeleteIsoResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteIsoResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteIsoAsync starts the deleteIso async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *ISOService) DeleteIsoAsync(ctx context.Context, p *DeleteIsoParams) (*DeleteIsoJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteIso", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteIsoResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteIsoJob{s.cs.newJobHandle("deleteIso", r.JobID)}, nil
+}
+
 type DeleteIsoResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -436,6 +607,10 @@ type DetachIsoParams struct {
 	p map[string]interface{}
 }
 
+func (p *DetachIsoParams) command() string {
+	return "detachIso"
+}
+
 func (p *DetachIsoParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -465,7 +640,13 @@ func (s *ISOService) NewDetachIsoParams(virtualmachineid string) *DetachIsoParam
 
 // Detaches any ISO file (if any) currently attached to a virtual machine.
 func (s *ISOService) DetachIso(p *DetachIsoParams) (*DetachIsoResponse, error) {
-	resp, err := s.cs.newRequest("detachIso", p.toURLValues())
+	return s.DetachIsoWithContext(context.Background(), p)
+}
+
+// DetachIsoWithContext is the same as DetachIso, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ISOService) DetachIsoWithContext(ctx context.Context, p *DetachIsoParams) (*DetachIsoResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "detachIso", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -477,26 +658,56 @@ func (s *ISOService) DetachIso(p *DetachIsoParams) (*DetachIsoResponse, error) {
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "detachIso", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// DetachIsoJob is a handle to a running detachIso async job
+type DetachIsoJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DetachIsoResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DetachIsoJob) Result() (*DetachIsoResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DetachIsoResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// DetachIsoAsync starts the detachIso async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *ISOService) DetachIsoAsync(ctx context.Context, p *DetachIsoParams) (*DetachIsoJob, error) {
+	resp, err := s.cs.newRequest(ctx, "detachIso", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DetachIsoResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DetachIsoJob{s.cs.newJobHandle("detachIso", r.JobID)}, nil
+}
+
 type DetachIsoResponse struct {
 	JobID         string `json:"jobid,omitempty"`
 	Account       string `json:"account,omitempty"`
@@ -614,6 +825,10 @@ type ExtractIsoParams struct {
 	p map[string]interface{}
 }
 
+func (p *ExtractIsoParams) command() string {
+	return "extractIso"
+}
+
 func (p *ExtractIsoParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -674,7 +889,13 @@ func (s *ISOService) NewExtractIsoParams(id string, mode string) *ExtractIsoPara
 
 // Extracts an ISO
 func (s *ISOService) ExtractIso(p *ExtractIsoParams) (*ExtractIsoResponse, error) {
-	resp, err := s.cs.newRequest("extractIso", p.toURLValues())
+	return s.ExtractIsoWithContext(context.Background(), p)
+}
+
+// ExtractIsoWithContext is the same as ExtractIso, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ISOService) ExtractIsoWithContext(ctx context.Context, p *ExtractIsoParams) (*ExtractIsoResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "extractIso", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -686,26 +907,56 @@ func (s *ISOService) ExtractIso(p *ExtractIsoParams) (*ExtractIsoResponse, error
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "extractIso", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "iso", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// ExtractIsoJob is a handle to a running extractIso async job
+type ExtractIsoJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a ExtractIsoResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *ExtractIsoJob) Result() (*ExtractIsoResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := ExtractIsoResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "iso", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// ExtractIsoAsync starts the extractIso async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *ISOService) ExtractIsoAsync(ctx context.Context, p *ExtractIsoParams) (*ExtractIsoJob, error) {
+	resp, err := s.cs.newRequest(ctx, "extractIso", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ExtractIsoResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &ExtractIsoJob{s.cs.newJobHandle("extractIso", r.JobID)}, nil
+}
+
 type ExtractIsoResponse struct {
 	JobID            string `json:"jobid,omitempty"`
 	Accountid        string `json:"accountid,omitempty"`
@@ -728,6 +979,10 @@ type RegisterIsoParams struct {
 	p map[string]interface{}
 }
 
+func (p *RegisterIsoParams) command() string {
+	return "registerIso"
+}
+
 func (p *RegisterIsoParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -935,7 +1190,13 @@ func (s *ISOService) NewRegisterIsoParams(displaytext string, name string, url s
 
 // Registers an existing ISO into the CloudStack Cloud.
 func (s *ISOService) RegisterIso(p *RegisterIsoParams) (*RegisterIsoResponse, error) {
-	resp, err := s.cs.newRequest("registerIso", p.toURLValues())
+	return s.RegisterIsoWithContext(context.Background(), p)
+}
+
+// RegisterIsoWithContext is the same as RegisterIso, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ISOService) RegisterIsoWithContext(ctx context.Context, p *RegisterIsoParams) (*RegisterIsoResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "registerIso", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1007,6 +1268,10 @@ type UpdateIsoParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateIsoParams) command() string {
+	return "updateIso"
+}
+
 func (p *UpdateIsoParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1206,7 +1471,13 @@ func (s *ISOService) NewUpdateIsoParams(id string) *UpdateIsoParams {
 
 // Updates an ISO file.
 func (s *ISOService) UpdateIso(p *UpdateIsoParams) (*UpdateIsoResponse, error) {
-	resp, err := s.cs.newRequest("updateIso", p.toURLValues())
+	return s.UpdateIsoWithContext(context.Background(), p)
+}
+
+// UpdateIsoWithContext is the same as UpdateIso, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ISOService) UpdateIsoWithContext(ctx context.Context, p *UpdateIsoParams) (*UpdateIsoResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateIso", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1278,6 +1549,10 @@ type ListIsoPermissionsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListIsoPermissionsParams) command() string {
+	return "listIsoPermissions"
+}
+
 func (p *ListIsoPermissionsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1320,16 +1595,14 @@ func (s *ISOService) GetIsoPermissionByID(id string, opts ...OptionFunc) (*IsoPe
 
 	l, err := s.ListIsoPermissions(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -1341,7 +1614,13 @@ func (s *ISOService) GetIsoPermissionByID(id string, opts ...OptionFunc) (*IsoPe
 
 // List ISO visibility and all accounts that have permissions to view this ISO.
 func (s *ISOService) ListIsoPermissions(p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error) {
-	resp, err := s.cs.newRequest("listIsoPermissions", p.toURLValues())
+	return s.ListIsoPermissionsWithContext(context.Background(), p)
+}
+
+// ListIsoPermissionsWithContext is the same as ListIsoPermissions, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ISOService) ListIsoPermissionsWithContext(ctx context.Context, p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "listIsoPermissions", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1370,6 +1649,10 @@ type UpdateIsoPermissionsParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateIsoPermissionsParams) command() string {
+	return "updateIsoPermissions"
+}
+
 func (p *UpdateIsoPermissionsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1464,7 +1747,13 @@ func (s *ISOService) NewUpdateIsoPermissionsParams(id string) *UpdateIsoPermissi
 
 // Updates ISO permissions
 func (s *ISOService) UpdateIsoPermissions(p *UpdateIsoPermissionsParams) (*UpdateIsoPermissionsResponse, error) {
-	resp, err := s.cs.newRequest("updateIsoPermissions", p.toURLValues())
+	return s.UpdateIsoPermissionsWithContext(context.Background(), p)
+}
+
+// UpdateIsoPermissionsWithContext is the same as UpdateIsoPermissions, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ISOService) UpdateIsoPermissionsWithContext(ctx context.Context, p *UpdateIsoPermissionsParams) (*UpdateIsoPermissionsResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateIsoPermissions", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1485,6 +1774,10 @@ type ListIsosParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListIsosParams) command() string {
+	return "listIsos"
+}
+
 func (p *ListIsosParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1715,7 +2008,7 @@ func (s *ISOService) GetIsoID(name string, isofilter string, zoneid string, opts
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -1729,7 +2022,7 @@ func (s *ISOService) GetIsoID(name string, isofilter string, zoneid string, opts
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -1761,16 +2054,14 @@ func (s *ISOService) GetIsoByID(id string, opts ...OptionFunc) (*Iso, int, error
 
 	l, err := s.ListIsos(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -1782,10 +2073,17 @@ func (s *ISOService) GetIsoByID(id string, opts ...OptionFunc) (*Iso, int, error
 
 // Lists all available ISO files.
 func (s *ISOService) ListIsos(p *ListIsosParams) (*ListIsosResponse, error) {
+	return s.ListIsosWithContext(context.Background(), p)
+}
+
+// ListIsosWithContext is the same as ListIsos, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ISOService) ListIsosWithContext(ctx context.Context, p *ListIsosParams) (*ListIsosResponse, error) {
 	var r ListIsosResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListIsosResponse
-		resp, err := s.cs.newRequest("listIsos", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listIsos", values)
 		if err != nil {
 			return nil, err
 		}
@@ -1797,13 +2095,89 @@ func (s *ISOService) ListIsos(p *ListIsosParams) (*ListIsosResponse, error) {
 		r.Count = l.Count
 		r.Isos = append(r.Isos, l.Isos...)
 
-		if r.Count == len(r.Isos) {
+		if r.Count == len(r.Isos) || len(l.Isos) == 0 {
+			s.cs.observePages("listIsos", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.Isos))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.Isos)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listIsos", values, len(l.Isos), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListIsosResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.Isos = append(r.Isos, l.Isos...)
+			}
+
+			if r.Count == len(r.Isos) {
+				s.cs.observePages("listIsos", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListIsosIterator iterates over the items returned by listIsos, requesting
+// the pages of the response one at a time while iterating.
+type ListIsosIterator struct {
+	pager
+	items []*Iso
+	index int
+}
+
+// NewListIsosIterator returns an iterator over the items returned by listIsos, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *ISOService) NewListIsosIterator(p *ListIsosParams, pagesize int) *ListIsosIterator {
+	return &ListIsosIterator{pager: newPager(s.cs, "listIsos", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListIsosIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListIsosResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.Isos))
+
+	i.items = l.Isos
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListIsosIterator) Value() *Iso {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListIsosResponse struct {
@@ -0,0 +1,294 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockISOService is a mock of ISOServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockISOService struct {
+	mockCalls
+
+	AttachIsoFunc            func(ctx context.Context, p *AttachIsoParams) (*AttachIsoResponse, error)
+	AttachIsoAsyncFunc       func(ctx context.Context, p *AttachIsoParams) (*AttachIsoJob, error)
+	CopyIsoFunc              func(ctx context.Context, p *CopyIsoParams) (*CopyIsoResponse, error)
+	CopyIsoAsyncFunc         func(ctx context.Context, p *CopyIsoParams) (*CopyIsoJob, error)
+	DeleteIsoFunc            func(ctx context.Context, p *DeleteIsoParams) (*DeleteIsoResponse, error)
+	DeleteIsoAsyncFunc       func(ctx context.Context, p *DeleteIsoParams) (*DeleteIsoJob, error)
+	DetachIsoFunc            func(ctx context.Context, p *DetachIsoParams) (*DetachIsoResponse, error)
+	DetachIsoAsyncFunc       func(ctx context.Context, p *DetachIsoParams) (*DetachIsoJob, error)
+	ExtractIsoFunc           func(ctx context.Context, p *ExtractIsoParams) (*ExtractIsoResponse, error)
+	ExtractIsoAsyncFunc      func(ctx context.Context, p *ExtractIsoParams) (*ExtractIsoJob, error)
+	RegisterIsoFunc          func(ctx context.Context, p *RegisterIsoParams) (*RegisterIsoResponse, error)
+	UpdateIsoFunc            func(ctx context.Context, p *UpdateIsoParams) (*UpdateIsoResponse, error)
+	GetIsoPermissionByIDFunc func(id string, opts ...OptionFunc) (*IsoPermission, int, error)
+	ListIsoPermissionsFunc   func(ctx context.Context, p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error)
+	UpdateIsoPermissionsFunc func(ctx context.Context, p *UpdateIsoPermissionsParams) (*UpdateIsoPermissionsResponse, error)
+	GetIsoIDFunc             func(name string, isofilter string, zoneid string, opts ...OptionFunc) (string, int, error)
+	GetIsoByNameFunc         func(name string, isofilter string, zoneid string, opts ...OptionFunc) (*Iso, int, error)
+	GetIsoByIDFunc           func(id string, opts ...OptionFunc) (*Iso, int, error)
+	ListIsosFunc             func(ctx context.Context, p *ListIsosParams) (*ListIsosResponse, error)
+}
+
+var _ ISOServiceIface = &MockISOService{}
+
+func (m *MockISOService) NewAttachIsoParams(id string, virtualmachineid string) *AttachIsoParams {
+	return (&ISOService{}).NewAttachIsoParams(id, virtualmachineid)
+}
+
+func (m *MockISOService) AttachIso(p *AttachIsoParams) (*AttachIsoResponse, error) {
+	return m.AttachIsoWithContext(context.Background(), p)
+}
+
+func (m *MockISOService) AttachIsoWithContext(ctx context.Context, p *AttachIsoParams) (*AttachIsoResponse, error) {
+	m.record("AttachIso", p)
+	if m.AttachIsoFunc == nil {
+		return nil, notMocked("MockISOService", "AttachIso")
+	}
+	return m.AttachIsoFunc(ctx, p)
+}
+
+func (m *MockISOService) AttachIsoAsync(ctx context.Context, p *AttachIsoParams) (*AttachIsoJob, error) {
+	m.record("AttachIsoAsync", p)
+	if m.AttachIsoAsyncFunc == nil {
+		return nil, notMocked("MockISOService", "AttachIsoAsync")
+	}
+	return m.AttachIsoAsyncFunc(ctx, p)
+}
+
+func (m *MockISOService) NewCopyIsoParams(destzoneid string, id string) *CopyIsoParams {
+	return (&ISOService{}).NewCopyIsoParams(destzoneid, id)
+}
+
+func (m *MockISOService) CopyIso(p *CopyIsoParams) (*CopyIsoResponse, error) {
+	return m.CopyIsoWithContext(context.Background(), p)
+}
+
+func (m *MockISOService) CopyIsoWithContext(ctx context.Context, p *CopyIsoParams) (*CopyIsoResponse, error) {
+	m.record("CopyIso", p)
+	if m.CopyIsoFunc == nil {
+		return nil, notMocked("MockISOService", "CopyIso")
+	}
+	return m.CopyIsoFunc(ctx, p)
+}
+
+func (m *MockISOService) CopyIsoAsync(ctx context.Context, p *CopyIsoParams) (*CopyIsoJob, error) {
+	m.record("CopyIsoAsync", p)
+	if m.CopyIsoAsyncFunc == nil {
+		return nil, notMocked("MockISOService", "CopyIsoAsync")
+	}
+	return m.CopyIsoAsyncFunc(ctx, p)
+}
+
+func (m *MockISOService) NewDeleteIsoParams(id string) *DeleteIsoParams {
+	return (&ISOService{}).NewDeleteIsoParams(id)
+}
+
+func (m *MockISOService) DeleteIso(p *DeleteIsoParams) (*DeleteIsoResponse, error) {
+	return m.DeleteIsoWithContext(context.Background(), p)
+}
+
+func (m *MockISOService) DeleteIsoWithContext(ctx context.Context, p *DeleteIsoParams) (*DeleteIsoResponse, error) {
+	m.record("DeleteIso", p)
+	if m.DeleteIsoFunc == nil {
+		return nil, notMocked("MockISOService", "DeleteIso")
+	}
+	return m.DeleteIsoFunc(ctx, p)
+}
+
+func (m *MockISOService) DeleteIsoAsync(ctx context.Context, p *DeleteIsoParams) (*DeleteIsoJob, error) {
+	m.record("DeleteIsoAsync", p)
+	if m.DeleteIsoAsyncFunc == nil {
+		return nil, notMocked("MockISOService", "DeleteIsoAsync")
+	}
+	return m.DeleteIsoAsyncFunc(ctx, p)
+}
+
+func (m *MockISOService) NewDetachIsoParams(virtualmachineid string) *DetachIsoParams {
+	return (&ISOService{}).NewDetachIsoParams(virtualmachineid)
+}
+
+func (m *MockISOService) DetachIso(p *DetachIsoParams) (*DetachIsoResponse, error) {
+	return m.DetachIsoWithContext(context.Background(), p)
+}
+
+func (m *MockISOService) DetachIsoWithContext(ctx context.Context, p *DetachIsoParams) (*DetachIsoResponse, error) {
+	m.record("DetachIso", p)
+	if m.DetachIsoFunc == nil {
+		return nil, notMocked("MockISOService", "DetachIso")
+	}
+	return m.DetachIsoFunc(ctx, p)
+}
+
+func (m *MockISOService) DetachIsoAsync(ctx context.Context, p *DetachIsoParams) (*DetachIsoJob, error) {
+	m.record("DetachIsoAsync", p)
+	if m.DetachIsoAsyncFunc == nil {
+		return nil, notMocked("MockISOService", "DetachIsoAsync")
+	}
+	return m.DetachIsoAsyncFunc(ctx, p)
+}
+
+func (m *MockISOService) NewExtractIsoParams(id string, mode string) *ExtractIsoParams {
+	return (&ISOService{}).NewExtractIsoParams(id, mode)
+}
+
+func (m *MockISOService) ExtractIso(p *ExtractIsoParams) (*ExtractIsoResponse, error) {
+	return m.ExtractIsoWithContext(context.Background(), p)
+}
+
+func (m *MockISOService) ExtractIsoWithContext(ctx context.Context, p *ExtractIsoParams) (*ExtractIsoResponse, error) {
+	m.record("ExtractIso", p)
+	if m.ExtractIsoFunc == nil {
+		return nil, notMocked("MockISOService", "ExtractIso")
+	}
+	return m.ExtractIsoFunc(ctx, p)
+}
+
+func (m *MockISOService) ExtractIsoAsync(ctx context.Context, p *ExtractIsoParams) (*ExtractIsoJob, error) {
+	m.record("ExtractIsoAsync", p)
+	if m.ExtractIsoAsyncFunc == nil {
+		return nil, notMocked("MockISOService", "ExtractIsoAsync")
+	}
+	return m.ExtractIsoAsyncFunc(ctx, p)
+}
+
+func (m *MockISOService) NewRegisterIsoParams(displaytext string, name string, url string, zoneid string) *RegisterIsoParams {
+	return (&ISOService{}).NewRegisterIsoParams(displaytext, name, url, zoneid)
+}
+
+func (m *MockISOService) RegisterIso(p *RegisterIsoParams) (*RegisterIsoResponse, error) {
+	return m.RegisterIsoWithContext(context.Background(), p)
+}
+
+func (m *MockISOService) RegisterIsoWithContext(ctx context.Context, p *RegisterIsoParams) (*RegisterIsoResponse, error) {
+	m.record("RegisterIso", p)
+	if m.RegisterIsoFunc == nil {
+		return nil, notMocked("MockISOService", "RegisterIso")
+	}
+	return m.RegisterIsoFunc(ctx, p)
+}
+
+func (m *MockISOService) NewUpdateIsoParams(id string) *UpdateIsoParams {
+	return (&ISOService{}).NewUpdateIsoParams(id)
+}
+
+func (m *MockISOService) UpdateIso(p *UpdateIsoParams) (*UpdateIsoResponse, error) {
+	return m.UpdateIsoWithContext(context.Background(), p)
+}
+
+func (m *MockISOService) UpdateIsoWithContext(ctx context.Context, p *UpdateIsoParams) (*UpdateIsoResponse, error) {
+	m.record("UpdateIso", p)
+	if m.UpdateIsoFunc == nil {
+		return nil, notMocked("MockISOService", "UpdateIso")
+	}
+	return m.UpdateIsoFunc(ctx, p)
+}
+
+func (m *MockISOService) NewListIsoPermissionsParams(id string) *ListIsoPermissionsParams {
+	return (&ISOService{}).NewListIsoPermissionsParams(id)
+}
+
+func (m *MockISOService) GetIsoPermissionByID(id string, opts ...OptionFunc) (*IsoPermission, int, error) {
+	m.record("GetIsoPermissionByID", id, opts)
+	if m.GetIsoPermissionByIDFunc == nil {
+		return nil, -1, notMocked("MockISOService", "GetIsoPermissionByID")
+	}
+	return m.GetIsoPermissionByIDFunc(id, opts...)
+}
+
+func (m *MockISOService) ListIsoPermissions(p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error) {
+	return m.ListIsoPermissionsWithContext(context.Background(), p)
+}
+
+func (m *MockISOService) ListIsoPermissionsWithContext(ctx context.Context, p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error) {
+	m.record("ListIsoPermissions", p)
+	if m.ListIsoPermissionsFunc == nil {
+		return nil, notMocked("MockISOService", "ListIsoPermissions")
+	}
+	return m.ListIsoPermissionsFunc(ctx, p)
+}
+
+func (m *MockISOService) NewUpdateIsoPermissionsParams(id string) *UpdateIsoPermissionsParams {
+	return (&ISOService{}).NewUpdateIsoPermissionsParams(id)
+}
+
+func (m *MockISOService) UpdateIsoPermissions(p *UpdateIsoPermissionsParams) (*UpdateIsoPermissionsResponse, error) {
+	return m.UpdateIsoPermissionsWithContext(context.Background(), p)
+}
+
+func (m *MockISOService) UpdateIsoPermissionsWithContext(ctx context.Context, p *UpdateIsoPermissionsParams) (*UpdateIsoPermissionsResponse, error) {
+	m.record("UpdateIsoPermissions", p)
+	if m.UpdateIsoPermissionsFunc == nil {
+		return nil, notMocked("MockISOService", "UpdateIsoPermissions")
+	}
+	return m.UpdateIsoPermissionsFunc(ctx, p)
+}
+
+func (m *MockISOService) NewListIsosParams() *ListIsosParams {
+	return (&ISOService{}).NewListIsosParams()
+}
+
+func (m *MockISOService) GetIsoID(name string, isofilter string, zoneid string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetIsoID", name, isofilter, zoneid, opts)
+	if m.GetIsoIDFunc == nil {
+		return "", -1, notMocked("MockISOService", "GetIsoID")
+	}
+	return m.GetIsoIDFunc(name, isofilter, zoneid, opts...)
+}
+
+func (m *MockISOService) GetIsoByName(name string, isofilter string, zoneid string, opts ...OptionFunc) (*Iso, int, error) {
+	m.record("GetIsoByName", name, isofilter, zoneid, opts)
+	if m.GetIsoByNameFunc == nil {
+		return nil, -1, notMocked("MockISOService", "GetIsoByName")
+	}
+	return m.GetIsoByNameFunc(name, isofilter, zoneid, opts...)
+}
+
+func (m *MockISOService) GetIsoByID(id string, opts ...OptionFunc) (*Iso, int, error) {
+	m.record("GetIsoByID", id, opts)
+	if m.GetIsoByIDFunc == nil {
+		return nil, -1, notMocked("MockISOService", "GetIsoByID")
+	}
+	return m.GetIsoByIDFunc(id, opts...)
+}
+
+func (m *MockISOService) ListIsos(p *ListIsosParams) (*ListIsosResponse, error) {
+	return m.ListIsosWithContext(context.Background(), p)
+}
+
+func (m *MockISOService) ListIsosWithContext(ctx context.Context, p *ListIsosParams) (*ListIsosResponse, error) {
+	m.record("ListIsos", p)
+	if m.ListIsosFunc == nil {
+		return nil, notMocked("MockISOService", "ListIsos")
+	}
+	return m.ListIsosFunc(ctx, p)
+}
+
+func (m *MockISOService) NewListIsosIterator(p *ListIsosParams, pagesize int) *ListIsosIterator {
+	i := &ListIsosIterator{pager: newPager(nil, "listIsos", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListIsosParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListIsosWithContext(ctx, c)
+	}
+	return i
+}
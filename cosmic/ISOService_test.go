@@ -0,0 +1,280 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestAttachIsoParams(t *testing.T) {
+	p := &AttachIsoParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetVirtualmachineid("virtualmachineid-value")
+	want.Set("virtualmachineid", "virtualmachineid-value")
+
+	assertParams(t, p, "attachIso", want)
+}
+
+func TestAttachIsoResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","affinitygroup":[{"account":"account-value","description":"description-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","name":"name-value","project":"project-value","projectid":"projectid-value","type":"type-value","virtualmachineIds":["a","b"]}],"bootmenutimeout":1099511627776,"cpunumber":42,"cpuused":"cpuused-value","created":"created-value","details":{"key":"value"},"diskioread":1099511627776,"diskiowrite":1099511627776,"diskkbsread":1099511627776,"diskkbswrite":1099511627776,"diskofferingid":"diskofferingid-value","diskofferingname":"diskofferingname-value","displayname":"displayname-value","displayvm":true,"domain":"domain-value","domainid":"domainid-value","forvirtualnetwork":true,"group":"group-value","groupid":"groupid-value","guestosid":"guestosid-value","haenable":true,"hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","instancename":"instancename-value","isdynamicallyscalable":true,"isodisplaytext":"isodisplaytext-value","isoid":"isoid-value","isoname":"isoname-value","jobid":"jobid-value","keypair":"keypair-value","laststartdate":"laststartdate-value","laststartversion":"laststartversion-value","maintenancepolicy":"maintenancepolicy-value","manufacturerstring":"manufacturerstring-value","memory":42,"name":"name-value","networkkbsread":1099511627776,"networkkbswrite":1099511627776,"nic":[{"broadcasturi":"broadcasturi-value","gateway":"gateway-value","id":"id-value","ip6address":"ip6address-value","ip6cidr":"ip6cidr-value","ip6gateway":"ip6gateway-value","ipaddress":"ipaddress-value","isdefault":true,"isolationuri":"isolationuri-value","macaddress":"macaddress-value","netmask":"netmask-value","networkid":"networkid-value","networkname":"networkname-value","secondaryip":[{"id":"id-value","ipaddress":"ipaddress-value"}],"traffictype":"traffictype-value","type":"type-value","virtualmachineid":"virtualmachineid-value"}],"optimisefor":"optimisefor-value","ostypeid":1099511627776,"password":"password-value","passwordenabled":true,"project":"project-value","projectid":"projectid-value","publicip":"publicip-value","publicipid":"publicipid-value","restartrequired":true,"rootdevicecontroller":"rootdevicecontroller-value","rootdeviceid":1099511627776,"rootdevicetype":"rootdevicetype-value","serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","servicestate":"servicestate-value","state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templatedisplaytext":"templatedisplaytext-value","templateid":"templateid-value","templatename":"templatename-value","userid":"userid-value","username":"username-value","vgpu":"vgpu-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &AttachIsoResponse{})
+}
+
+func TestCopyIsoParams(t *testing.T) {
+	p := &CopyIsoParams{}
+	want := url.Values{}
+	p.SetDestzoneid("destzoneid-value")
+	want.Set("destzoneid", "destzoneid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetSourcezoneid("sourcezoneid-value")
+	want.Set("sourcezoneid", "sourcezoneid-value")
+
+	assertParams(t, p, "copyIso", want)
+}
+
+func TestCopyIsoResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","accountid":"accountid-value","bootable":true,"checksum":"checksum-value","cpuflags":"cpuflags-value","created":"created-value","crossZones":true,"details":{"key":"value"},"displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","format":"format-value","hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","isdynamicallyscalable":true,"isextractable":true,"isfeatured":true,"ispublic":true,"isready":true,"jobid":"jobid-value","maclearning":"maclearning-value","maintenancepolicy":"maintenancepolicy-value","manufacturerstring":"manufacturerstring-value","name":"name-value","optimisefor":"optimisefor-value","ostypeid":"ostypeid-value","ostypename":"ostypename-value","passwordenabled":true,"project":"project-value","projectid":"projectid-value","removed":"removed-value","size":1099511627776,"sourcetemplateid":"sourcetemplateid-value","sshkeyenabled":true,"status":"status-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templatetag":"templatetag-value","templatetype":"templatetype-value","url":"url-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &CopyIsoResponse{})
+}
+
+func TestDeleteIsoParams(t *testing.T) {
+	p := &DeleteIsoParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "deleteIso", want)
+}
+
+func TestDeleteIsoResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteIsoResponse{})
+}
+
+func TestDetachIsoParams(t *testing.T) {
+	p := &DetachIsoParams{}
+	want := url.Values{}
+	p.SetVirtualmachineid("virtualmachineid-value")
+	want.Set("virtualmachineid", "virtualmachineid-value")
+
+	assertParams(t, p, "detachIso", want)
+}
+
+func TestDetachIsoResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","affinitygroup":[{"account":"account-value","description":"description-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","name":"name-value","project":"project-value","projectid":"projectid-value","type":"type-value","virtualmachineIds":["a","b"]}],"bootmenutimeout":1099511627776,"cpunumber":42,"cpuused":"cpuused-value","created":"created-value","details":{"key":"value"},"diskioread":1099511627776,"diskiowrite":1099511627776,"diskkbsread":1099511627776,"diskkbswrite":1099511627776,"diskofferingid":"diskofferingid-value","diskofferingname":"diskofferingname-value","displayname":"displayname-value","displayvm":true,"domain":"domain-value","domainid":"domainid-value","forvirtualnetwork":true,"group":"group-value","groupid":"groupid-value","guestosid":"guestosid-value","haenable":true,"hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","instancename":"instancename-value","isdynamicallyscalable":true,"isodisplaytext":"isodisplaytext-value","isoid":"isoid-value","isoname":"isoname-value","jobid":"jobid-value","keypair":"keypair-value","laststartdate":"laststartdate-value","laststartversion":"laststartversion-value","maintenancepolicy":"maintenancepolicy-value","manufacturerstring":"manufacturerstring-value","memory":42,"name":"name-value","networkkbsread":1099511627776,"networkkbswrite":1099511627776,"nic":[{"broadcasturi":"broadcasturi-value","gateway":"gateway-value","id":"id-value","ip6address":"ip6address-value","ip6cidr":"ip6cidr-value","ip6gateway":"ip6gateway-value","ipaddress":"ipaddress-value","isdefault":true,"isolationuri":"isolationuri-value","macaddress":"macaddress-value","netmask":"netmask-value","networkid":"networkid-value","networkname":"networkname-value","secondaryip":[{"id":"id-value","ipaddress":"ipaddress-value"}],"traffictype":"traffictype-value","type":"type-value","virtualmachineid":"virtualmachineid-value"}],"optimisefor":"optimisefor-value","ostypeid":1099511627776,"password":"password-value","passwordenabled":true,"project":"project-value","projectid":"projectid-value","publicip":"publicip-value","publicipid":"publicipid-value","restartrequired":true,"rootdevicecontroller":"rootdevicecontroller-value","rootdeviceid":1099511627776,"rootdevicetype":"rootdevicetype-value","serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","servicestate":"servicestate-value","state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templatedisplaytext":"templatedisplaytext-value","templateid":"templateid-value","templatename":"templatename-value","userid":"userid-value","username":"username-value","vgpu":"vgpu-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &DetachIsoResponse{})
+}
+
+func TestExtractIsoParams(t *testing.T) {
+	p := &ExtractIsoParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetMode("mode-value")
+	want.Set("mode", "mode-value")
+	p.SetUrl("url-value")
+	want.Set("url", "url-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "extractIso", want)
+}
+
+func TestExtractIsoResponse(t *testing.T) {
+	assertDecodes(t, `{"accountid":"accountid-value","created":"created-value","extractId":"extractId-value","extractMode":"extractMode-value","id":"id-value","jobid":"jobid-value","name":"name-value","resultstring":"resultstring-value","state":"state-value","status":"status-value","storagetype":"storagetype-value","uploadpercentage":42,"url":"url-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &ExtractIsoResponse{})
+}
+
+func TestRegisterIsoParams(t *testing.T) {
+	p := &RegisterIsoParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetBootable(true)
+	want.Set("bootable", "true")
+	p.SetChecksum("checksum-value")
+	want.Set("checksum", "checksum-value")
+	p.SetDisplaytext("displaytext-value")
+	want.Set("displaytext", "displaytext-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetImagestoreuuid("imagestoreuuid-value")
+	want.Set("imagestoreuuid", "imagestoreuuid-value")
+	p.SetIsdynamicallyscalable(true)
+	want.Set("isdynamicallyscalable", "true")
+	p.SetIsextractable(true)
+	want.Set("isextractable", "true")
+	p.SetIsfeatured(true)
+	want.Set("isfeatured", "true")
+	p.SetIspublic(true)
+	want.Set("ispublic", "true")
+	p.SetMaintenancepolicy("maintenancepolicy-value")
+	want.Set("maintenancepolicy", "maintenancepolicy-value")
+	p.SetManufacturerstring("manufacturerstring-value")
+	want.Set("manufacturerstring", "manufacturerstring-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetOptimisefor("optimisefor-value")
+	want.Set("optimisefor", "optimisefor-value")
+	p.SetOstypeid("ostypeid-value")
+	want.Set("ostypeid", "ostypeid-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetUrl("url-value")
+	want.Set("url", "url-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "registerIso", want)
+}
+
+func TestRegisterIsoResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","accountid":"accountid-value","bootable":true,"checksum":"checksum-value","cpuflags":"cpuflags-value","created":"created-value","crossZones":true,"details":{"key":"value"},"displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","format":"format-value","hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","isdynamicallyscalable":true,"isextractable":true,"isfeatured":true,"ispublic":true,"isready":true,"maclearning":"maclearning-value","maintenancepolicy":"maintenancepolicy-value","manufacturerstring":"manufacturerstring-value","name":"name-value","optimisefor":"optimisefor-value","ostypeid":"ostypeid-value","ostypename":"ostypename-value","passwordenabled":true,"project":"project-value","projectid":"projectid-value","removed":"removed-value","size":1099511627776,"sourcetemplateid":"sourcetemplateid-value","sshkeyenabled":true,"status":"status-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templatetag":"templatetag-value","templatetype":"templatetype-value","url":"url-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &RegisterIsoResponse{})
+}
+
+func TestUpdateIsoParams(t *testing.T) {
+	p := &UpdateIsoParams{}
+	want := url.Values{}
+	p.SetBootable(true)
+	want.Set("bootable", "true")
+	p.SetCpuflags("cpuflags-value")
+	want.Set("cpuflags", "cpuflags-value")
+	p.SetDetails(map[string]string{"k": "v"})
+	want.Set("details[0].key", "k")
+	want.Set("details[0].value", "v")
+	p.SetDisplaytext("displaytext-value")
+	want.Set("displaytext", "displaytext-value")
+	p.SetFormat("format-value")
+	want.Set("format", "format-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsdynamicallyscalable(true)
+	want.Set("isdynamicallyscalable", "true")
+	p.SetIsrouting(true)
+	want.Set("isrouting", "true")
+	p.SetMaclearning("maclearning-value")
+	want.Set("maclearning", "maclearning-value")
+	p.SetMaintenancepolicy("maintenancepolicy-value")
+	want.Set("maintenancepolicy", "maintenancepolicy-value")
+	p.SetManufacturerstring("manufacturerstring-value")
+	want.Set("manufacturerstring", "manufacturerstring-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetOptimisefor("optimisefor-value")
+	want.Set("optimisefor", "optimisefor-value")
+	p.SetOstypeid("ostypeid-value")
+	want.Set("ostypeid", "ostypeid-value")
+	p.SetPasswordenabled(true)
+	want.Set("passwordenabled", "true")
+	p.SetSortkey(42)
+	want.Set("sortkey", "42")
+	p.SetUrl("url-value")
+	want.Set("url", "url-value")
+
+	assertParams(t, p, "updateIso", want)
+}
+
+func TestUpdateIsoResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","accountid":"accountid-value","bootable":true,"checksum":"checksum-value","cpuflags":"cpuflags-value","created":"created-value","crossZones":true,"details":{"key":"value"},"displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","format":"format-value","hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","isdynamicallyscalable":true,"isextractable":true,"isfeatured":true,"ispublic":true,"isready":true,"maclearning":"maclearning-value","maintenancepolicy":"maintenancepolicy-value","manufacturerstring":"manufacturerstring-value","name":"name-value","optimisefor":"optimisefor-value","ostypeid":"ostypeid-value","ostypename":"ostypename-value","passwordenabled":true,"project":"project-value","projectid":"projectid-value","removed":"removed-value","size":1099511627776,"sourcetemplateid":"sourcetemplateid-value","sshkeyenabled":true,"status":"status-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templatetag":"templatetag-value","templatetype":"templatetype-value","url":"url-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &UpdateIsoResponse{})
+}
+
+func TestListIsoPermissionsParams(t *testing.T) {
+	p := &ListIsoPermissionsParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "listIsoPermissions", want)
+}
+
+func TestListIsoPermissionsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"isopermission":[{"account":["a","b"],"domainid":"domainid-value","id":"id-value","ispublic":true,"projectids":["a","b"]}]}`, &ListIsoPermissionsResponse{})
+}
+
+func TestUpdateIsoPermissionsParams(t *testing.T) {
+	p := &UpdateIsoPermissionsParams{}
+	want := url.Values{}
+	p.SetAccounts([]string{"a", "b"})
+	want.Set("accounts", "a,b")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsextractable(true)
+	want.Set("isextractable", "true")
+	p.SetIsfeatured(true)
+	want.Set("isfeatured", "true")
+	p.SetIspublic(true)
+	want.Set("ispublic", "true")
+	p.SetOp("op-value")
+	want.Set("op", "op-value")
+	p.SetProjectids([]string{"a", "b"})
+	want.Set("projectids", "a,b")
+
+	assertParams(t, p, "updateIsoPermissions", want)
+}
+
+func TestUpdateIsoPermissionsResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &UpdateIsoPermissionsResponse{})
+}
+
+func TestListIsosParams(t *testing.T) {
+	p := &ListIsosParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetBootable(true)
+	want.Set("bootable", "true")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetHypervisor("hypervisor-value")
+	want.Set("hypervisor", "hypervisor-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsofilter("isofilter-value")
+	want.Set("isofilter", "isofilter-value")
+	p.SetIspublic(true)
+	want.Set("ispublic", "true")
+	p.SetIsready(true)
+	want.Set("isready", "true")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetShowremoved(true)
+	want.Set("showremoved", "true")
+	p.SetTags(map[string]string{"k": "v"})
+	want.Set("tags[0].key", "k")
+	want.Set("tags[0].value", "v")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listIsos", want)
+}
+
+func TestListIsosResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"iso":[{"account":"account-value","accountid":"accountid-value","bootable":true,"checksum":"checksum-value","cpuflags":"cpuflags-value","created":"created-value","crossZones":true,"details":{"key":"value"},"displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","format":"format-value","hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","isdynamicallyscalable":true,"isextractable":true,"isfeatured":true,"ispublic":true,"isready":true,"maclearning":"maclearning-value","maintenancepolicy":"maintenancepolicy-value","manufacturerstring":"manufacturerstring-value","name":"name-value","optimisefor":"optimisefor-value","ostypeid":"ostypeid-value","ostypename":"ostypename-value","passwordenabled":true,"project":"project-value","projectid":"projectid-value","removed":"removed-value","size":1099511627776,"sourcetemplateid":"sourcetemplateid-value","sshkeyenabled":true,"status":"status-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templatetag":"templatetag-value","templatetype":"templatetype-value","url":"url-value","zoneid":"zoneid-value","zonename":"zonename-value"}]}`, &ListIsosResponse{})
+}
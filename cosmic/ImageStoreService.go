@@ -17,17 +17,52 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
+// ImageStoreServiceIface is the interface implemented by ImageStoreService, and by MockImageStoreService for tests
+type ImageStoreServiceIface interface {
+	NewAddImageStoreParams(provider string) *AddImageStoreParams
+	AddImageStore(p *AddImageStoreParams) (*AddImageStoreResponse, error)
+	AddImageStoreWithContext(ctx context.Context, p *AddImageStoreParams) (*AddImageStoreResponse, error)
+	NewDeleteImageStoreParams(id string) *DeleteImageStoreParams
+	DeleteImageStore(p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error)
+	DeleteImageStoreWithContext(ctx context.Context, p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error)
+	NewListImageStoresParams() *ListImageStoresParams
+	GetImageStoreID(name string, opts ...OptionFunc) (string, int, error)
+	GetImageStoreByName(name string, opts ...OptionFunc) (*ImageStore, int, error)
+	GetImageStoreByID(id string, opts ...OptionFunc) (*ImageStore, int, error)
+	ListImageStores(p *ListImageStoresParams) (*ListImageStoresResponse, error)
+	ListImageStoresWithContext(ctx context.Context, p *ListImageStoresParams) (*ListImageStoresResponse, error)
+	NewListImageStoresIterator(p *ListImageStoresParams, pagesize int) *ListImageStoresIterator
+	NewCreateSecondaryStagingStoreParams(url string) *CreateSecondaryStagingStoreParams
+	CreateSecondaryStagingStore(p *CreateSecondaryStagingStoreParams) (*CreateSecondaryStagingStoreResponse, error)
+	CreateSecondaryStagingStoreWithContext(ctx context.Context, p *CreateSecondaryStagingStoreParams) (*CreateSecondaryStagingStoreResponse, error)
+	NewDeleteSecondaryStagingStoreParams(id string) *DeleteSecondaryStagingStoreParams
+	DeleteSecondaryStagingStore(p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error)
+	DeleteSecondaryStagingStoreWithContext(ctx context.Context, p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error)
+	NewListSecondaryStagingStoresParams() *ListSecondaryStagingStoresParams
+	GetSecondaryStagingStoreID(name string, opts ...OptionFunc) (string, int, error)
+	GetSecondaryStagingStoreByName(name string, opts ...OptionFunc) (*SecondaryStagingStore, int, error)
+	GetSecondaryStagingStoreByID(id string, opts ...OptionFunc) (*SecondaryStagingStore, int, error)
+	ListSecondaryStagingStores(p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error)
+	ListSecondaryStagingStoresWithContext(ctx context.Context, p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error)
+	NewListSecondaryStagingStoresIterator(p *ListSecondaryStagingStoresParams, pagesize int) *ListSecondaryStagingStoresIterator
+}
+
 type AddImageStoreParams struct {
 	p map[string]interface{}
 }
 
+func (p *AddImageStoreParams) command() string {
+	return "addImageStore"
+}
+
 func (p *AddImageStoreParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -102,7 +137,13 @@ func (s *ImageStoreService) NewAddImageStoreParams(provider string) *AddImageSto
 
 // Adds backup image store.
 func (s *ImageStoreService) AddImageStore(p *AddImageStoreParams) (*AddImageStoreResponse, error) {
-	resp, err := s.cs.newRequest("addImageStore", p.toURLValues())
+	return s.AddImageStoreWithContext(context.Background(), p)
+}
+
+// AddImageStoreWithContext is the same as AddImageStore, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ImageStoreService) AddImageStoreWithContext(ctx context.Context, p *AddImageStoreParams) (*AddImageStoreResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "addImageStore", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -130,6 +171,10 @@ type DeleteImageStoreParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteImageStoreParams) command() string {
+	return "deleteImageStore"
+}
+
 func (p *DeleteImageStoreParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -159,7 +204,13 @@ func (s *ImageStoreService) NewDeleteImageStoreParams(id string) *DeleteImageSto
 
 // Deletes an image store or Secondary Storage.
 func (s *ImageStoreService) DeleteImageStore(p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error) {
-	resp, err := s.cs.newRequest("deleteImageStore", p.toURLValues())
+	return s.DeleteImageStoreWithContext(context.Background(), p)
+}
+
+// DeleteImageStoreWithContext is the same as DeleteImageStore, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ImageStoreService) DeleteImageStoreWithContext(ctx context.Context, p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteImageStore", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -180,6 +231,10 @@ type ListImageStoresParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListImageStoresParams) command() string {
+	return "listImageStores"
+}
+
 func (p *ListImageStoresParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -297,7 +352,7 @@ func (s *ImageStoreService) GetImageStoreID(name string, opts ...OptionFunc) (st
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -311,7 +366,7 @@ func (s *ImageStoreService) GetImageStoreID(name string, opts ...OptionFunc) (st
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -343,16 +398,14 @@ func (s *ImageStoreService) GetImageStoreByID(id string, opts ...OptionFunc) (*I
 
 	l, err := s.ListImageStores(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -364,10 +417,17 @@ func (s *ImageStoreService) GetImageStoreByID(id string, opts ...OptionFunc) (*I
 
 // Lists image stores.
 func (s *ImageStoreService) ListImageStores(p *ListImageStoresParams) (*ListImageStoresResponse, error) {
+	return s.ListImageStoresWithContext(context.Background(), p)
+}
+
+// ListImageStoresWithContext is the same as ListImageStores, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ImageStoreService) ListImageStoresWithContext(ctx context.Context, p *ListImageStoresParams) (*ListImageStoresResponse, error) {
 	var r ListImageStoresResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListImageStoresResponse
-		resp, err := s.cs.newRequest("listImageStores", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listImageStores", values)
 		if err != nil {
 			return nil, err
 		}
@@ -379,13 +439,89 @@ func (s *ImageStoreService) ListImageStores(p *ListImageStoresParams) (*ListImag
 		r.Count = l.Count
 		r.ImageStores = append(r.ImageStores, l.ImageStores...)
 
-		if r.Count == len(r.ImageStores) {
+		if r.Count == len(r.ImageStores) || len(l.ImageStores) == 0 {
+			s.cs.observePages("listImageStores", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.ImageStores))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.ImageStores)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listImageStores", values, len(l.ImageStores), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListImageStoresResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.ImageStores = append(r.ImageStores, l.ImageStores...)
+			}
+
+			if r.Count == len(r.ImageStores) {
+				s.cs.observePages("listImageStores", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListImageStoresIterator iterates over the items returned by listImageStores, requesting
+// the pages of the response one at a time while iterating.
+type ListImageStoresIterator struct {
+	pager
+	items []*ImageStore
+	index int
+}
+
+// NewListImageStoresIterator returns an iterator over the items returned by listImageStores, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *ImageStoreService) NewListImageStoresIterator(p *ListImageStoresParams, pagesize int) *ListImageStoresIterator {
+	return &ListImageStoresIterator{pager: newPager(s.cs, "listImageStores", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListImageStoresIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListImageStoresResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.ImageStores))
+
+	i.items = l.ImageStores
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListImageStoresIterator) Value() *ImageStore {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListImageStoresResponse struct {
@@ -409,6 +545,10 @@ type CreateSecondaryStagingStoreParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateSecondaryStagingStoreParams) command() string {
+	return "createSecondaryStagingStore"
+}
+
 func (p *CreateSecondaryStagingStoreParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -483,7 +623,13 @@ func (s *ImageStoreService) NewCreateSecondaryStagingStoreParams(url string) *Cr
 
 // create secondary staging store.
 func (s *ImageStoreService) CreateSecondaryStagingStore(p *CreateSecondaryStagingStoreParams) (*CreateSecondaryStagingStoreResponse, error) {
-	resp, err := s.cs.newRequest("createSecondaryStagingStore", p.toURLValues())
+	return s.CreateSecondaryStagingStoreWithContext(context.Background(), p)
+}
+
+// CreateSecondaryStagingStoreWithContext is the same as CreateSecondaryStagingStore, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ImageStoreService) CreateSecondaryStagingStoreWithContext(ctx context.Context, p *CreateSecondaryStagingStoreParams) (*CreateSecondaryStagingStoreResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createSecondaryStagingStore", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -511,6 +657,10 @@ type DeleteSecondaryStagingStoreParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteSecondaryStagingStoreParams) command() string {
+	return "deleteSecondaryStagingStore"
+}
+
 func (p *DeleteSecondaryStagingStoreParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -540,7 +690,13 @@ func (s *ImageStoreService) NewDeleteSecondaryStagingStoreParams(id string) *Del
 
 // Deletes a secondary staging store .
 func (s *ImageStoreService) DeleteSecondaryStagingStore(p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error) {
-	resp, err := s.cs.newRequest("deleteSecondaryStagingStore", p.toURLValues())
+	return s.DeleteSecondaryStagingStoreWithContext(context.Background(), p)
+}
+
+// DeleteSecondaryStagingStoreWithContext is the same as DeleteSecondaryStagingStore, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ImageStoreService) DeleteSecondaryStagingStoreWithContext(ctx context.Context, p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteSecondaryStagingStore", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -561,6 +717,10 @@ type ListSecondaryStagingStoresParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListSecondaryStagingStoresParams) command() string {
+	return "listSecondaryStagingStores"
+}
+
 func (p *ListSecondaryStagingStoresParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -678,7 +838,7 @@ func (s *ImageStoreService) GetSecondaryStagingStoreID(name string, opts ...Opti
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -692,7 +852,7 @@ func (s *ImageStoreService) GetSecondaryStagingStoreID(name string, opts ...Opti
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -724,16 +884,14 @@ func (s *ImageStoreService) GetSecondaryStagingStoreByID(id string, opts ...Opti
 
 	l, err := s.ListSecondaryStagingStores(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -745,10 +903,17 @@ func (s *ImageStoreService) GetSecondaryStagingStoreByID(id string, opts ...Opti
 
 // Lists secondary staging stores.
 func (s *ImageStoreService) ListSecondaryStagingStores(p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error) {
+	return s.ListSecondaryStagingStoresWithContext(context.Background(), p)
+}
+
+// ListSecondaryStagingStoresWithContext is the same as ListSecondaryStagingStores, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ImageStoreService) ListSecondaryStagingStoresWithContext(ctx context.Context, p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error) {
 	var r ListSecondaryStagingStoresResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListSecondaryStagingStoresResponse
-		resp, err := s.cs.newRequest("listSecondaryStagingStores", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listSecondaryStagingStores", values)
 		if err != nil {
 			return nil, err
 		}
@@ -760,13 +925,89 @@ func (s *ImageStoreService) ListSecondaryStagingStores(p *ListSecondaryStagingSt
 		r.Count = l.Count
 		r.SecondaryStagingStores = append(r.SecondaryStagingStores, l.SecondaryStagingStores...)
 
-		if r.Count == len(r.SecondaryStagingStores) {
+		if r.Count == len(r.SecondaryStagingStores) || len(l.SecondaryStagingStores) == 0 {
+			s.cs.observePages("listSecondaryStagingStores", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.SecondaryStagingStores))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.SecondaryStagingStores)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listSecondaryStagingStores", values, len(l.SecondaryStagingStores), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListSecondaryStagingStoresResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.SecondaryStagingStores = append(r.SecondaryStagingStores, l.SecondaryStagingStores...)
+			}
+
+			if r.Count == len(r.SecondaryStagingStores) {
+				s.cs.observePages("listSecondaryStagingStores", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListSecondaryStagingStoresIterator iterates over the items returned by listSecondaryStagingStores, requesting
+// the pages of the response one at a time while iterating.
+type ListSecondaryStagingStoresIterator struct {
+	pager
+	items []*SecondaryStagingStore
+	index int
+}
+
+// NewListSecondaryStagingStoresIterator returns an iterator over the items returned by listSecondaryStagingStores, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *ImageStoreService) NewListSecondaryStagingStoresIterator(p *ListSecondaryStagingStoresParams, pagesize int) *ListSecondaryStagingStoresIterator {
+	return &ListSecondaryStagingStoresIterator{pager: newPager(s.cs, "listSecondaryStagingStores", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListSecondaryStagingStoresIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListSecondaryStagingStoresResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.SecondaryStagingStores))
+
+	i.items = l.SecondaryStagingStores
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListSecondaryStagingStoresIterator) Value() *SecondaryStagingStore {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListSecondaryStagingStoresResponse struct {
@@ -0,0 +1,213 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockImageStoreService is a mock of ImageStoreServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockImageStoreService struct {
+	mockCalls
+
+	AddImageStoreFunc                  func(ctx context.Context, p *AddImageStoreParams) (*AddImageStoreResponse, error)
+	DeleteImageStoreFunc               func(ctx context.Context, p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error)
+	GetImageStoreIDFunc                func(name string, opts ...OptionFunc) (string, int, error)
+	GetImageStoreByNameFunc            func(name string, opts ...OptionFunc) (*ImageStore, int, error)
+	GetImageStoreByIDFunc              func(id string, opts ...OptionFunc) (*ImageStore, int, error)
+	ListImageStoresFunc                func(ctx context.Context, p *ListImageStoresParams) (*ListImageStoresResponse, error)
+	CreateSecondaryStagingStoreFunc    func(ctx context.Context, p *CreateSecondaryStagingStoreParams) (*CreateSecondaryStagingStoreResponse, error)
+	DeleteSecondaryStagingStoreFunc    func(ctx context.Context, p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error)
+	GetSecondaryStagingStoreIDFunc     func(name string, opts ...OptionFunc) (string, int, error)
+	GetSecondaryStagingStoreByNameFunc func(name string, opts ...OptionFunc) (*SecondaryStagingStore, int, error)
+	GetSecondaryStagingStoreByIDFunc   func(id string, opts ...OptionFunc) (*SecondaryStagingStore, int, error)
+	ListSecondaryStagingStoresFunc     func(ctx context.Context, p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error)
+}
+
+var _ ImageStoreServiceIface = &MockImageStoreService{}
+
+func (m *MockImageStoreService) NewAddImageStoreParams(provider string) *AddImageStoreParams {
+	return (&ImageStoreService{}).NewAddImageStoreParams(provider)
+}
+
+func (m *MockImageStoreService) AddImageStore(p *AddImageStoreParams) (*AddImageStoreResponse, error) {
+	return m.AddImageStoreWithContext(context.Background(), p)
+}
+
+func (m *MockImageStoreService) AddImageStoreWithContext(ctx context.Context, p *AddImageStoreParams) (*AddImageStoreResponse, error) {
+	m.record("AddImageStore", p)
+	if m.AddImageStoreFunc == nil {
+		return nil, notMocked("MockImageStoreService", "AddImageStore")
+	}
+	return m.AddImageStoreFunc(ctx, p)
+}
+
+func (m *MockImageStoreService) NewDeleteImageStoreParams(id string) *DeleteImageStoreParams {
+	return (&ImageStoreService{}).NewDeleteImageStoreParams(id)
+}
+
+func (m *MockImageStoreService) DeleteImageStore(p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error) {
+	return m.DeleteImageStoreWithContext(context.Background(), p)
+}
+
+func (m *MockImageStoreService) DeleteImageStoreWithContext(ctx context.Context, p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error) {
+	m.record("DeleteImageStore", p)
+	if m.DeleteImageStoreFunc == nil {
+		return nil, notMocked("MockImageStoreService", "DeleteImageStore")
+	}
+	return m.DeleteImageStoreFunc(ctx, p)
+}
+
+func (m *MockImageStoreService) NewListImageStoresParams() *ListImageStoresParams {
+	return (&ImageStoreService{}).NewListImageStoresParams()
+}
+
+func (m *MockImageStoreService) GetImageStoreID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetImageStoreID", name, opts)
+	if m.GetImageStoreIDFunc == nil {
+		return "", -1, notMocked("MockImageStoreService", "GetImageStoreID")
+	}
+	return m.GetImageStoreIDFunc(name, opts...)
+}
+
+func (m *MockImageStoreService) GetImageStoreByName(name string, opts ...OptionFunc) (*ImageStore, int, error) {
+	m.record("GetImageStoreByName", name, opts)
+	if m.GetImageStoreByNameFunc == nil {
+		return nil, -1, notMocked("MockImageStoreService", "GetImageStoreByName")
+	}
+	return m.GetImageStoreByNameFunc(name, opts...)
+}
+
+func (m *MockImageStoreService) GetImageStoreByID(id string, opts ...OptionFunc) (*ImageStore, int, error) {
+	m.record("GetImageStoreByID", id, opts)
+	if m.GetImageStoreByIDFunc == nil {
+		return nil, -1, notMocked("MockImageStoreService", "GetImageStoreByID")
+	}
+	return m.GetImageStoreByIDFunc(id, opts...)
+}
+
+func (m *MockImageStoreService) ListImageStores(p *ListImageStoresParams) (*ListImageStoresResponse, error) {
+	return m.ListImageStoresWithContext(context.Background(), p)
+}
+
+func (m *MockImageStoreService) ListImageStoresWithContext(ctx context.Context, p *ListImageStoresParams) (*ListImageStoresResponse, error) {
+	m.record("ListImageStores", p)
+	if m.ListImageStoresFunc == nil {
+		return nil, notMocked("MockImageStoreService", "ListImageStores")
+	}
+	return m.ListImageStoresFunc(ctx, p)
+}
+
+func (m *MockImageStoreService) NewListImageStoresIterator(p *ListImageStoresParams, pagesize int) *ListImageStoresIterator {
+	i := &ListImageStoresIterator{pager: newPager(nil, "listImageStores", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListImageStoresParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListImageStoresWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockImageStoreService) NewCreateSecondaryStagingStoreParams(url string) *CreateSecondaryStagingStoreParams {
+	return (&ImageStoreService{}).NewCreateSecondaryStagingStoreParams(url)
+}
+
+func (m *MockImageStoreService) CreateSecondaryStagingStore(p *CreateSecondaryStagingStoreParams) (*CreateSecondaryStagingStoreResponse, error) {
+	return m.CreateSecondaryStagingStoreWithContext(context.Background(), p)
+}
+
+func (m *MockImageStoreService) CreateSecondaryStagingStoreWithContext(ctx context.Context, p *CreateSecondaryStagingStoreParams) (*CreateSecondaryStagingStoreResponse, error) {
+	m.record("CreateSecondaryStagingStore", p)
+	if m.CreateSecondaryStagingStoreFunc == nil {
+		return nil, notMocked("MockImageStoreService", "CreateSecondaryStagingStore")
+	}
+	return m.CreateSecondaryStagingStoreFunc(ctx, p)
+}
+
+func (m *MockImageStoreService) NewDeleteSecondaryStagingStoreParams(id string) *DeleteSecondaryStagingStoreParams {
+	return (&ImageStoreService{}).NewDeleteSecondaryStagingStoreParams(id)
+}
+
+func (m *MockImageStoreService) DeleteSecondaryStagingStore(p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error) {
+	return m.DeleteSecondaryStagingStoreWithContext(context.Background(), p)
+}
+
+func (m *MockImageStoreService) DeleteSecondaryStagingStoreWithContext(ctx context.Context, p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error) {
+	m.record("DeleteSecondaryStagingStore", p)
+	if m.DeleteSecondaryStagingStoreFunc == nil {
+		return nil, notMocked("MockImageStoreService", "DeleteSecondaryStagingStore")
+	}
+	return m.DeleteSecondaryStagingStoreFunc(ctx, p)
+}
+
+func (m *MockImageStoreService) NewListSecondaryStagingStoresParams() *ListSecondaryStagingStoresParams {
+	return (&ImageStoreService{}).NewListSecondaryStagingStoresParams()
+}
+
+func (m *MockImageStoreService) GetSecondaryStagingStoreID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetSecondaryStagingStoreID", name, opts)
+	if m.GetSecondaryStagingStoreIDFunc == nil {
+		return "", -1, notMocked("MockImageStoreService", "GetSecondaryStagingStoreID")
+	}
+	return m.GetSecondaryStagingStoreIDFunc(name, opts...)
+}
+
+func (m *MockImageStoreService) GetSecondaryStagingStoreByName(name string, opts ...OptionFunc) (*SecondaryStagingStore, int, error) {
+	m.record("GetSecondaryStagingStoreByName", name, opts)
+	if m.GetSecondaryStagingStoreByNameFunc == nil {
+		return nil, -1, notMocked("MockImageStoreService", "GetSecondaryStagingStoreByName")
+	}
+	return m.GetSecondaryStagingStoreByNameFunc(name, opts...)
+}
+
+func (m *MockImageStoreService) GetSecondaryStagingStoreByID(id string, opts ...OptionFunc) (*SecondaryStagingStore, int, error) {
+	m.record("GetSecondaryStagingStoreByID", id, opts)
+	if m.GetSecondaryStagingStoreByIDFunc == nil {
+		return nil, -1, notMocked("MockImageStoreService", "GetSecondaryStagingStoreByID")
+	}
+	return m.GetSecondaryStagingStoreByIDFunc(id, opts...)
+}
+
+func (m *MockImageStoreService) ListSecondaryStagingStores(p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error) {
+	return m.ListSecondaryStagingStoresWithContext(context.Background(), p)
+}
+
+func (m *MockImageStoreService) ListSecondaryStagingStoresWithContext(ctx context.Context, p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error) {
+	m.record("ListSecondaryStagingStores", p)
+	if m.ListSecondaryStagingStoresFunc == nil {
+		return nil, notMocked("MockImageStoreService", "ListSecondaryStagingStores")
+	}
+	return m.ListSecondaryStagingStoresFunc(ctx, p)
+}
+
+func (m *MockImageStoreService) NewListSecondaryStagingStoresIterator(p *ListSecondaryStagingStoresParams, pagesize int) *ListSecondaryStagingStoresIterator {
+	i := &ListSecondaryStagingStoresIterator{pager: newPager(nil, "listSecondaryStagingStores", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListSecondaryStagingStoresParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListSecondaryStagingStoresWithContext(ctx, c)
+	}
+	return i
+}
@@ -0,0 +1,146 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestAddImageStoreParams(t *testing.T) {
+	p := &AddImageStoreParams{}
+	want := url.Values{}
+	p.SetDetails(map[string]string{"k": "v"})
+	want.Set("details[0].key", "k")
+	want.Set("details[0].value", "v")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetProvider("provider-value")
+	want.Set("provider", "provider-value")
+	p.SetUrl("url-value")
+	want.Set("url", "url-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "addImageStore", want)
+}
+
+func TestAddImageStoreResponse(t *testing.T) {
+	assertDecodes(t, `{"details":["a","b"],"id":"id-value","name":"name-value","protocol":"protocol-value","providername":"providername-value","scope":"scope-value","url":"url-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &AddImageStoreResponse{})
+}
+
+func TestDeleteImageStoreParams(t *testing.T) {
+	p := &DeleteImageStoreParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteImageStore", want)
+}
+
+func TestDeleteImageStoreResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &DeleteImageStoreResponse{})
+}
+
+func TestListImageStoresParams(t *testing.T) {
+	p := &ListImageStoresParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProtocol("protocol-value")
+	want.Set("protocol", "protocol-value")
+	p.SetProvider("provider-value")
+	want.Set("provider", "provider-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listImageStores", want)
+}
+
+func TestListImageStoresResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"imagestore":[{"details":["a","b"],"id":"id-value","name":"name-value","protocol":"protocol-value","providername":"providername-value","scope":"scope-value","url":"url-value","zoneid":"zoneid-value","zonename":"zonename-value"}]}`, &ListImageStoresResponse{})
+}
+
+func TestCreateSecondaryStagingStoreParams(t *testing.T) {
+	p := &CreateSecondaryStagingStoreParams{}
+	want := url.Values{}
+	p.SetDetails(map[string]string{"k": "v"})
+	want.Set("details[0].key", "k")
+	want.Set("details[0].value", "v")
+	p.SetProvider("provider-value")
+	want.Set("provider", "provider-value")
+	p.SetScope("scope-value")
+	want.Set("scope", "scope-value")
+	p.SetUrl("url-value")
+	want.Set("url", "url-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "createSecondaryStagingStore", want)
+}
+
+func TestCreateSecondaryStagingStoreResponse(t *testing.T) {
+	assertDecodes(t, `{"details":["a","b"],"id":"id-value","name":"name-value","protocol":"protocol-value","providername":"providername-value","scope":"scope-value","url":"url-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &CreateSecondaryStagingStoreResponse{})
+}
+
+func TestDeleteSecondaryStagingStoreParams(t *testing.T) {
+	p := &DeleteSecondaryStagingStoreParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteSecondaryStagingStore", want)
+}
+
+func TestDeleteSecondaryStagingStoreResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &DeleteSecondaryStagingStoreResponse{})
+}
+
+func TestListSecondaryStagingStoresParams(t *testing.T) {
+	p := &ListSecondaryStagingStoresParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProtocol("protocol-value")
+	want.Set("protocol", "protocol-value")
+	p.SetProvider("provider-value")
+	want.Set("provider", "provider-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listSecondaryStagingStores", want)
+}
+
+func TestListSecondaryStagingStoresResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"secondarystagingstore":[{"details":["a","b"],"id":"id-value","name":"name-value","protocol":"protocol-value","providername":"providername-value","scope":"scope-value","url":"url-value","zoneid":"zoneid-value","zonename":"zonename-value"}]}`, &ListSecondaryStagingStoresResponse{})
+}
@@ -17,15 +17,40 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
 )
 
+// LimitServiceIface is the interface implemented by LimitService, and by MockLimitService for tests
+type LimitServiceIface interface {
+	NewGetApiLimitParams() *GetApiLimitParams
+	GetApiLimit(p *GetApiLimitParams) (*GetApiLimitResponse, error)
+	GetApiLimitWithContext(ctx context.Context, p *GetApiLimitParams) (*GetApiLimitResponse, error)
+	NewResetApiLimitParams() *ResetApiLimitParams
+	ResetApiLimit(p *ResetApiLimitParams) (*ResetApiLimitResponse, error)
+	ResetApiLimitWithContext(ctx context.Context, p *ResetApiLimitParams) (*ResetApiLimitResponse, error)
+	NewUpdateResourceCountParams(domainid string) *UpdateResourceCountParams
+	UpdateResourceCount(p *UpdateResourceCountParams) (*UpdateResourceCountResponse, error)
+	UpdateResourceCountWithContext(ctx context.Context, p *UpdateResourceCountParams) (*UpdateResourceCountResponse, error)
+	NewUpdateResourceLimitParams(resourcetype int) *UpdateResourceLimitParams
+	UpdateResourceLimit(p *UpdateResourceLimitParams) (*UpdateResourceLimitResponse, error)
+	UpdateResourceLimitWithContext(ctx context.Context, p *UpdateResourceLimitParams) (*UpdateResourceLimitResponse, error)
+	NewListResourceLimitsParams() *ListResourceLimitsParams
+	ListResourceLimits(p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error)
+	ListResourceLimitsWithContext(ctx context.Context, p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error)
+	NewListResourceLimitsIterator(p *ListResourceLimitsParams, pagesize int) *ListResourceLimitsIterator
+}
+
 type GetApiLimitParams struct {
 	p map[string]interface{}
 }
 
+func (p *GetApiLimitParams) command() string {
+	return "getApiLimit"
+}
+
 func (p *GetApiLimitParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -44,7 +69,13 @@ func (s *LimitService) NewGetApiLimitParams() *GetApiLimitParams {
 
 // Get API limit count for the caller
 func (s *LimitService) GetApiLimit(p *GetApiLimitParams) (*GetApiLimitResponse, error) {
-	resp, err := s.cs.newRequest("getApiLimit", p.toURLValues())
+	return s.GetApiLimitWithContext(context.Background(), p)
+}
+
+// GetApiLimitWithContext is the same as GetApiLimit, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LimitService) GetApiLimitWithContext(ctx context.Context, p *GetApiLimitParams) (*GetApiLimitResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "getApiLimit", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +99,10 @@ type ResetApiLimitParams struct {
 	p map[string]interface{}
 }
 
+func (p *ResetApiLimitParams) command() string {
+	return "resetApiLimit"
+}
+
 func (p *ResetApiLimitParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -96,7 +131,13 @@ func (s *LimitService) NewResetApiLimitParams() *ResetApiLimitParams {
 
 // Reset api count
 func (s *LimitService) ResetApiLimit(p *ResetApiLimitParams) (*ResetApiLimitResponse, error) {
-	resp, err := s.cs.newRequest("resetApiLimit", p.toURLValues())
+	return s.ResetApiLimitWithContext(context.Background(), p)
+}
+
+// ResetApiLimitWithContext is the same as ResetApiLimit, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LimitService) ResetApiLimitWithContext(ctx context.Context, p *ResetApiLimitParams) (*ResetApiLimitResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "resetApiLimit", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -120,6 +161,10 @@ type UpdateResourceCountParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateResourceCountParams) command() string {
+	return "updateResourceCount"
+}
+
 func (p *UpdateResourceCountParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -180,7 +225,13 @@ func (s *LimitService) NewUpdateResourceCountParams(domainid string) *UpdateReso
 
 // Recalculate and update resource count for an account or domain.
 func (s *LimitService) UpdateResourceCount(p *UpdateResourceCountParams) (*UpdateResourceCountResponse, error) {
-	resp, err := s.cs.newRequest("updateResourceCount", p.toURLValues())
+	return s.UpdateResourceCountWithContext(context.Background(), p)
+}
+
+// UpdateResourceCountWithContext is the same as UpdateResourceCount, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LimitService) UpdateResourceCountWithContext(ctx context.Context, p *UpdateResourceCountParams) (*UpdateResourceCountResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateResourceCount", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -206,6 +257,10 @@ type UpdateResourceLimitParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateResourceLimitParams) command() string {
+	return "updateResourceLimit"
+}
+
 func (p *UpdateResourceLimitParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -277,7 +332,13 @@ func (s *LimitService) NewUpdateResourceLimitParams(resourcetype int) *UpdateRes
 
 // Updates resource limits for an account or domain.
 func (s *LimitService) UpdateResourceLimit(p *UpdateResourceLimitParams) (*UpdateResourceLimitResponse, error) {
-	resp, err := s.cs.newRequest("updateResourceLimit", p.toURLValues())
+	return s.UpdateResourceLimitWithContext(context.Background(), p)
+}
+
+// UpdateResourceLimitWithContext is the same as UpdateResourceLimit, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LimitService) UpdateResourceLimitWithContext(ctx context.Context, p *UpdateResourceLimitParams) (*UpdateResourceLimitResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateResourceLimit", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -303,6 +364,10 @@ type ListResourceLimitsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListResourceLimitsParams) command() string {
+	return "listResourceLimits"
+}
+
 func (p *ListResourceLimitsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -427,10 +492,17 @@ func (s *LimitService) NewListResourceLimitsParams() *ListResourceLimitsParams {
 
 // Lists resource limits.
 func (s *LimitService) ListResourceLimits(p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error) {
+	return s.ListResourceLimitsWithContext(context.Background(), p)
+}
+
+// ListResourceLimitsWithContext is the same as ListResourceLimits, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LimitService) ListResourceLimitsWithContext(ctx context.Context, p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error) {
 	var r ListResourceLimitsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListResourceLimitsResponse
-		resp, err := s.cs.newRequest("listResourceLimits", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listResourceLimits", values)
 		if err != nil {
 			return nil, err
 		}
@@ -442,13 +514,89 @@ func (s *LimitService) ListResourceLimits(p *ListResourceLimitsParams) (*ListRes
 		r.Count = l.Count
 		r.ResourceLimits = append(r.ResourceLimits, l.ResourceLimits...)
 
-		if r.Count == len(r.ResourceLimits) {
+		if r.Count == len(r.ResourceLimits) || len(l.ResourceLimits) == 0 {
+			s.cs.observePages("listResourceLimits", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.ResourceLimits))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.ResourceLimits)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listResourceLimits", values, len(l.ResourceLimits), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListResourceLimitsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.ResourceLimits = append(r.ResourceLimits, l.ResourceLimits...)
+			}
+
+			if r.Count == len(r.ResourceLimits) {
+				s.cs.observePages("listResourceLimits", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListResourceLimitsIterator iterates over the items returned by listResourceLimits, requesting
+// the pages of the response one at a time while iterating.
+type ListResourceLimitsIterator struct {
+	pager
+	items []*ResourceLimit
+	index int
+}
+
+// NewListResourceLimitsIterator returns an iterator over the items returned by listResourceLimits, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *LimitService) NewListResourceLimitsIterator(p *ListResourceLimitsParams, pagesize int) *ListResourceLimitsIterator {
+	return &ListResourceLimitsIterator{pager: newPager(s.cs, "listResourceLimits", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListResourceLimitsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListResourceLimitsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.ResourceLimits))
+
+	i.items = l.ResourceLimits
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListResourceLimitsIterator) Value() *ResourceLimit {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListResourceLimitsResponse struct {
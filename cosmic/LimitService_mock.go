@@ -0,0 +1,128 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockLimitService is a mock of LimitServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockLimitService struct {
+	mockCalls
+
+	GetApiLimitFunc         func(ctx context.Context, p *GetApiLimitParams) (*GetApiLimitResponse, error)
+	ResetApiLimitFunc       func(ctx context.Context, p *ResetApiLimitParams) (*ResetApiLimitResponse, error)
+	UpdateResourceCountFunc func(ctx context.Context, p *UpdateResourceCountParams) (*UpdateResourceCountResponse, error)
+	UpdateResourceLimitFunc func(ctx context.Context, p *UpdateResourceLimitParams) (*UpdateResourceLimitResponse, error)
+	ListResourceLimitsFunc  func(ctx context.Context, p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error)
+}
+
+var _ LimitServiceIface = &MockLimitService{}
+
+func (m *MockLimitService) NewGetApiLimitParams() *GetApiLimitParams {
+	return (&LimitService{}).NewGetApiLimitParams()
+}
+
+func (m *MockLimitService) GetApiLimit(p *GetApiLimitParams) (*GetApiLimitResponse, error) {
+	return m.GetApiLimitWithContext(context.Background(), p)
+}
+
+func (m *MockLimitService) GetApiLimitWithContext(ctx context.Context, p *GetApiLimitParams) (*GetApiLimitResponse, error) {
+	m.record("GetApiLimit", p)
+	if m.GetApiLimitFunc == nil {
+		return nil, notMocked("MockLimitService", "GetApiLimit")
+	}
+	return m.GetApiLimitFunc(ctx, p)
+}
+
+func (m *MockLimitService) NewResetApiLimitParams() *ResetApiLimitParams {
+	return (&LimitService{}).NewResetApiLimitParams()
+}
+
+func (m *MockLimitService) ResetApiLimit(p *ResetApiLimitParams) (*ResetApiLimitResponse, error) {
+	return m.ResetApiLimitWithContext(context.Background(), p)
+}
+
+func (m *MockLimitService) ResetApiLimitWithContext(ctx context.Context, p *ResetApiLimitParams) (*ResetApiLimitResponse, error) {
+	m.record("ResetApiLimit", p)
+	if m.ResetApiLimitFunc == nil {
+		return nil, notMocked("MockLimitService", "ResetApiLimit")
+	}
+	return m.ResetApiLimitFunc(ctx, p)
+}
+
+func (m *MockLimitService) NewUpdateResourceCountParams(domainid string) *UpdateResourceCountParams {
+	return (&LimitService{}).NewUpdateResourceCountParams(domainid)
+}
+
+func (m *MockLimitService) UpdateResourceCount(p *UpdateResourceCountParams) (*UpdateResourceCountResponse, error) {
+	return m.UpdateResourceCountWithContext(context.Background(), p)
+}
+
+func (m *MockLimitService) UpdateResourceCountWithContext(ctx context.Context, p *UpdateResourceCountParams) (*UpdateResourceCountResponse, error) {
+	m.record("UpdateResourceCount", p)
+	if m.UpdateResourceCountFunc == nil {
+		return nil, notMocked("MockLimitService", "UpdateResourceCount")
+	}
+	return m.UpdateResourceCountFunc(ctx, p)
+}
+
+func (m *MockLimitService) NewUpdateResourceLimitParams(resourcetype int) *UpdateResourceLimitParams {
+	return (&LimitService{}).NewUpdateResourceLimitParams(resourcetype)
+}
+
+func (m *MockLimitService) UpdateResourceLimit(p *UpdateResourceLimitParams) (*UpdateResourceLimitResponse, error) {
+	return m.UpdateResourceLimitWithContext(context.Background(), p)
+}
+
+func (m *MockLimitService) UpdateResourceLimitWithContext(ctx context.Context, p *UpdateResourceLimitParams) (*UpdateResourceLimitResponse, error) {
+	m.record("UpdateResourceLimit", p)
+	if m.UpdateResourceLimitFunc == nil {
+		return nil, notMocked("MockLimitService", "UpdateResourceLimit")
+	}
+	return m.UpdateResourceLimitFunc(ctx, p)
+}
+
+func (m *MockLimitService) NewListResourceLimitsParams() *ListResourceLimitsParams {
+	return (&LimitService{}).NewListResourceLimitsParams()
+}
+
+func (m *MockLimitService) ListResourceLimits(p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error) {
+	return m.ListResourceLimitsWithContext(context.Background(), p)
+}
+
+func (m *MockLimitService) ListResourceLimitsWithContext(ctx context.Context, p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error) {
+	m.record("ListResourceLimits", p)
+	if m.ListResourceLimitsFunc == nil {
+		return nil, notMocked("MockLimitService", "ListResourceLimits")
+	}
+	return m.ListResourceLimitsFunc(ctx, p)
+}
+
+func (m *MockLimitService) NewListResourceLimitsIterator(p *ListResourceLimitsParams, pagesize int) *ListResourceLimitsIterator {
+	i := &ListResourceLimitsIterator{pager: newPager(nil, "listResourceLimits", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListResourceLimitsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListResourceLimitsWithContext(ctx, c)
+	}
+	return i
+}
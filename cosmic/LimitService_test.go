@@ -0,0 +1,117 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestGetApiLimitParams(t *testing.T) {
+	p := &GetApiLimitParams{}
+	want := url.Values{}
+
+	assertParams(t, p, "getApiLimit", want)
+}
+
+func TestGetApiLimitResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","accountid":"accountid-value","apiAllowed":42,"apiIssued":42,"expireAfter":1099511627776}`, &GetApiLimitResponse{})
+}
+
+func TestResetApiLimitParams(t *testing.T) {
+	p := &ResetApiLimitParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+
+	assertParams(t, p, "resetApiLimit", want)
+}
+
+func TestResetApiLimitResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","accountid":"accountid-value","apiAllowed":42,"apiIssued":42,"expireAfter":1099511627776}`, &ResetApiLimitResponse{})
+}
+
+func TestUpdateResourceCountParams(t *testing.T) {
+	p := &UpdateResourceCountParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetResourcetype(42)
+	want.Set("resourcetype", "42")
+
+	assertParams(t, p, "updateResourceCount", want)
+}
+
+func TestUpdateResourceCountResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","domain":"domain-value","domainid":"domainid-value","project":"project-value","projectid":"projectid-value","resourcecount":1099511627776,"resourcetype":"resourcetype-value"}`, &UpdateResourceCountResponse{})
+}
+
+func TestUpdateResourceLimitParams(t *testing.T) {
+	p := &UpdateResourceLimitParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetMax(int64(1) << 40)
+	want.Set("max", "1099511627776")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetResourcetype(42)
+	want.Set("resourcetype", "42")
+
+	assertParams(t, p, "updateResourceLimit", want)
+}
+
+func TestUpdateResourceLimitResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","domain":"domain-value","domainid":"domainid-value","max":1099511627776,"project":"project-value","projectid":"projectid-value","resourcetype":"resourcetype-value"}`, &UpdateResourceLimitResponse{})
+}
+
+func TestListResourceLimitsParams(t *testing.T) {
+	p := &ListResourceLimitsParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetId(int64(1) << 40)
+	want.Set("id", "1099511627776")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetResourcetype(42)
+	want.Set("resourcetype", "42")
+
+	assertParams(t, p, "listResourceLimits", want)
+}
+
+func TestListResourceLimitsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"resourcelimit":[{"account":"account-value","domain":"domain-value","domainid":"domainid-value","max":1099511627776,"project":"project-value","projectid":"projectid-value","resourcetype":"resourcetype-value"}]}`, &ListResourceLimitsResponse{})
+}
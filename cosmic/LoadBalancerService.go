@@ -17,17 +17,110 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 )
 
+// LoadBalancerServiceIface is the interface implemented by LoadBalancerService, and by MockLoadBalancerService for tests
+type LoadBalancerServiceIface interface {
+	NewRemoveCertFromLoadBalancerParams(lbruleid string) *RemoveCertFromLoadBalancerParams
+	RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerResponse, error)
+	RemoveCertFromLoadBalancerWithContext(ctx context.Context, p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerResponse, error)
+	RemoveCertFromLoadBalancerAsync(ctx context.Context, p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerJob, error)
+	NewAssignCertToLoadBalancerParams(certid string, lbruleid string) *AssignCertToLoadBalancerParams
+	AssignCertToLoadBalancer(p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error)
+	AssignCertToLoadBalancerWithContext(ctx context.Context, p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error)
+	AssignCertToLoadBalancerAsync(ctx context.Context, p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerJob, error)
+	NewRemoveFromLoadBalancerRuleParams(id string) *RemoveFromLoadBalancerRuleParams
+	RemoveFromLoadBalancerRule(p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleResponse, error)
+	RemoveFromLoadBalancerRuleWithContext(ctx context.Context, p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleResponse, error)
+	RemoveFromLoadBalancerRuleAsync(ctx context.Context, p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleJob, error)
+	NewListLBHealthCheckPoliciesParams() *ListLBHealthCheckPoliciesParams
+	GetLBHealthCheckPolicyByID(id string, opts ...OptionFunc) (*LBHealthCheckPolicy, int, error)
+	ListLBHealthCheckPolicies(p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error)
+	ListLBHealthCheckPoliciesWithContext(ctx context.Context, p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error)
+	NewListLBHealthCheckPoliciesIterator(p *ListLBHealthCheckPoliciesParams, pagesize int) *ListLBHealthCheckPoliciesIterator
+	NewCreateLBHealthCheckPolicyParams(lbruleid string) *CreateLBHealthCheckPolicyParams
+	CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error)
+	CreateLBHealthCheckPolicyWithContext(ctx context.Context, p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error)
+	CreateLBHealthCheckPolicyAsync(ctx context.Context, p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyJob, error)
+	NewDeleteLBHealthCheckPolicyParams(id string) *DeleteLBHealthCheckPolicyParams
+	DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error)
+	DeleteLBHealthCheckPolicyWithContext(ctx context.Context, p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error)
+	DeleteLBHealthCheckPolicyAsync(ctx context.Context, p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyJob, error)
+	NewUpdateLBHealthCheckPolicyParams(id string) *UpdateLBHealthCheckPolicyParams
+	UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyResponse, error)
+	UpdateLBHealthCheckPolicyWithContext(ctx context.Context, p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyResponse, error)
+	UpdateLBHealthCheckPolicyAsync(ctx context.Context, p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyJob, error)
+	NewListLBStickinessPoliciesParams() *ListLBStickinessPoliciesParams
+	GetLBStickinessPolicyByID(id string, opts ...OptionFunc) (*LBStickinessPolicy, int, error)
+	ListLBStickinessPolicies(p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error)
+	ListLBStickinessPoliciesWithContext(ctx context.Context, p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error)
+	NewListLBStickinessPoliciesIterator(p *ListLBStickinessPoliciesParams, pagesize int) *ListLBStickinessPoliciesIterator
+	NewCreateLBStickinessPolicyParams(lbruleid string, methodname string, name string) *CreateLBStickinessPolicyParams
+	CreateLBStickinessPolicy(p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error)
+	CreateLBStickinessPolicyWithContext(ctx context.Context, p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error)
+	CreateLBStickinessPolicyAsync(ctx context.Context, p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyJob, error)
+	NewDeleteLBStickinessPolicyParams(id string) *DeleteLBStickinessPolicyParams
+	DeleteLBStickinessPolicy(p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error)
+	DeleteLBStickinessPolicyWithContext(ctx context.Context, p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error)
+	DeleteLBStickinessPolicyAsync(ctx context.Context, p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyJob, error)
+	NewUpdateLBStickinessPolicyParams(id string) *UpdateLBStickinessPolicyParams
+	UpdateLBStickinessPolicy(p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyResponse, error)
+	UpdateLBStickinessPolicyWithContext(ctx context.Context, p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyResponse, error)
+	UpdateLBStickinessPolicyAsync(ctx context.Context, p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyJob, error)
+	NewCreateLoadBalancerRuleParams(algorithm string, name string, privateport int, publicport int) *CreateLoadBalancerRuleParams
+	CreateLoadBalancerRule(p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error)
+	CreateLoadBalancerRuleWithContext(ctx context.Context, p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error)
+	CreateLoadBalancerRuleAsync(ctx context.Context, p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleJob, error)
+	NewDeleteLoadBalancerRuleParams(id string) *DeleteLoadBalancerRuleParams
+	DeleteLoadBalancerRule(p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error)
+	DeleteLoadBalancerRuleWithContext(ctx context.Context, p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error)
+	DeleteLoadBalancerRuleAsync(ctx context.Context, p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleJob, error)
+	NewUpdateLoadBalancerRuleParams(id string) *UpdateLoadBalancerRuleParams
+	UpdateLoadBalancerRule(p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleResponse, error)
+	UpdateLoadBalancerRuleWithContext(ctx context.Context, p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleResponse, error)
+	UpdateLoadBalancerRuleAsync(ctx context.Context, p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleJob, error)
+	NewListLoadBalancerRuleInstancesParams(id string) *ListLoadBalancerRuleInstancesParams
+	GetLoadBalancerRuleInstanceByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error)
+	ListLoadBalancerRuleInstances(p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error)
+	ListLoadBalancerRuleInstancesWithContext(ctx context.Context, p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error)
+	NewListLoadBalancerRuleInstancesIterator(p *ListLoadBalancerRuleInstancesParams, pagesize int) *ListLoadBalancerRuleInstancesIterator
+	NewListLoadBalancerRulesParams() *ListLoadBalancerRulesParams
+	GetLoadBalancerRuleID(name string, opts ...OptionFunc) (string, int, error)
+	GetLoadBalancerRuleByName(name string, opts ...OptionFunc) (*LoadBalancerRule, int, error)
+	GetLoadBalancerRuleByID(id string, opts ...OptionFunc) (*LoadBalancerRule, int, error)
+	ListLoadBalancerRules(p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error)
+	ListLoadBalancerRulesWithContext(ctx context.Context, p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error)
+	NewListLoadBalancerRulesIterator(p *ListLoadBalancerRulesParams, pagesize int) *ListLoadBalancerRulesIterator
+	NewDeleteSslCertParams(id string) *DeleteSslCertParams
+	DeleteSslCert(p *DeleteSslCertParams) (*DeleteSslCertResponse, error)
+	DeleteSslCertWithContext(ctx context.Context, p *DeleteSslCertParams) (*DeleteSslCertResponse, error)
+	NewUploadSslCertParams(certificate string, privatekey string) *UploadSslCertParams
+	UploadSslCert(p *UploadSslCertParams) (*UploadSslCertResponse, error)
+	UploadSslCertWithContext(ctx context.Context, p *UploadSslCertParams) (*UploadSslCertResponse, error)
+	NewListSslCertsParams() *ListSslCertsParams
+	ListSslCerts(p *ListSslCertsParams) (*ListSslCertsResponse, error)
+	ListSslCertsWithContext(ctx context.Context, p *ListSslCertsParams) (*ListSslCertsResponse, error)
+	NewAssignToLoadBalancerRuleParams(id string) *AssignToLoadBalancerRuleParams
+	AssignToLoadBalancerRule(p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error)
+	AssignToLoadBalancerRuleWithContext(ctx context.Context, p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error)
+	AssignToLoadBalancerRuleAsync(ctx context.Context, p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleJob, error)
+}
+
 type RemoveCertFromLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
+func (p *RemoveCertFromLoadBalancerParams) command() string {
+	return "removeCertFromLoadBalancer"
+}
+
 func (p *RemoveCertFromLoadBalancerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -57,7 +150,13 @@ func (s *LoadBalancerService) NewRemoveCertFromLoadBalancerParams(lbruleid strin
 
 // Removes a certificate from a load balancer rule
 func (s *LoadBalancerService) RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("removeCertFromLoadBalancer", p.toURLValues())
+	return s.RemoveCertFromLoadBalancerWithContext(context.Background(), p)
+}
+
+// RemoveCertFromLoadBalancerWithContext is the same as RemoveCertFromLoadBalancer, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LoadBalancerService) RemoveCertFromLoadBalancerWithContext(ctx context.Context, p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "removeCertFromLoadBalancer", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -69,9 +168,9 @@ func (s *LoadBalancerService) RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "removeCertFromLoadBalancer", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -84,6 +183,41 @@ func (s *LoadBalancerService) RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBa
 	return &r, nil
 }
 
+// RemoveCertFromLoadBalancerJob is a handle to a running removeCertFromLoadBalancer async job
+type RemoveCertFromLoadBalancerJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a RemoveCertFromLoadBalancerResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *RemoveCertFromLoadBalancerJob) Result() (*RemoveCertFromLoadBalancerResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := RemoveCertFromLoadBalancerResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// RemoveCertFromLoadBalancerAsync starts the removeCertFromLoadBalancer async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *LoadBalancerService) RemoveCertFromLoadBalancerAsync(ctx context.Context, p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerJob, error) {
+	resp, err := s.cs.newRequest(ctx, "removeCertFromLoadBalancer", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RemoveCertFromLoadBalancerResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &RemoveCertFromLoadBalancerJob{s.cs.newJobHandle("removeCertFromLoadBalancer", r.JobID)}, nil
+}
+
 type RemoveCertFromLoadBalancerResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -94,6 +228,10 @@ type AssignCertToLoadBalancerParams struct {
 	p map[string]interface{}
 }
 
+func (p *AssignCertToLoadBalancerParams) command() string {
+	return "assignCertToLoadBalancer"
+}
+
 func (p *AssignCertToLoadBalancerParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -134,7 +272,13 @@ func (s *LoadBalancerService) NewAssignCertToLoadBalancerParams(certid string, l
 
 // Assigns a certificate to a load balancer rule
 func (s *LoadBalancerService) AssignCertToLoadBalancer(p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("assignCertToLoadBalancer", p.toURLValues())
+	return s.AssignCertToLoadBalancerWithContext(context.Background(), p)
+}
+
+// AssignCertToLoadBalancerWithContext is the same as AssignCertToLoadBalancer, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LoadBalancerService) AssignCertToLoadBalancerWithContext(ctx context.Context, p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "assignCertToLoadBalancer", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -146,9 +290,9 @@ func (s *LoadBalancerService) AssignCertToLoadBalancer(p *AssignCertToLoadBalanc
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "assignCertToLoadBalancer", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -161,6 +305,41 @@ func (s *LoadBalancerService) AssignCertToLoadBalancer(p *AssignCertToLoadBalanc
 	return &r, nil
 }
 
+// AssignCertToLoadBalancerJob is a handle to a running assignCertToLoadBalancer async job
+type AssignCertToLoadBalancerJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a AssignCertToLoadBalancerResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *AssignCertToLoadBalancerJob) Result() (*AssignCertToLoadBalancerResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := AssignCertToLoadBalancerResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// AssignCertToLoadBalancerAsync starts the assignCertToLoadBalancer async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *LoadBalancerService) AssignCertToLoadBalancerAsync(ctx context.Context, p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerJob, error) {
+	resp, err := s.cs.newRequest(ctx, "assignCertToLoadBalancer", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AssignCertToLoadBalancerResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &AssignCertToLoadBalancerJob{s.cs.newJobHandle("assignCertToLoadBalancer", r.JobID)}, nil
+}
+
 type AssignCertToLoadBalancerResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -171,6 +350,10 @@ type RemoveFromLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+func (p *RemoveFromLoadBalancerRuleParams) command() string {
+	return "removeFromLoadBalancerRule"
+}
+
 func (p *RemoveFromLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -226,7 +409,13 @@ func (s *LoadBalancerService) NewRemoveFromLoadBalancerRuleParams(id string) *Re
 
 // Removes a virtual machine or a list of virtual machines from a load balancer rule.
 func (s *LoadBalancerService) RemoveFromLoadBalancerRule(p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("removeFromLoadBalancerRule", p.toURLValues())
+	return s.RemoveFromLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// RemoveFromLoadBalancerRuleWithContext is the same as RemoveFromLoadBalancerRule, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LoadBalancerService) RemoveFromLoadBalancerRuleWithContext(ctx context.Context, p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "removeFromLoadBalancerRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -238,9 +427,9 @@ func (s *LoadBalancerService) RemoveFromLoadBalancerRule(p *RemoveFromLoadBalanc
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "removeFromLoadBalancerRule", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -253,6 +442,41 @@ func (s *LoadBalancerService) RemoveFromLoadBalancerRule(p *RemoveFromLoadBalanc
 	return &r, nil
 }
 
+// RemoveFromLoadBalancerRuleJob is a handle to a running removeFromLoadBalancerRule async job
+type RemoveFromLoadBalancerRuleJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a RemoveFromLoadBalancerRuleResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *RemoveFromLoadBalancerRuleJob) Result() (*RemoveFromLoadBalancerRuleResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := RemoveFromLoadBalancerRuleResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// RemoveFromLoadBalancerRuleAsync starts the removeFromLoadBalancerRule async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *LoadBalancerService) RemoveFromLoadBalancerRuleAsync(ctx context.Context, p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleJob, error) {
+	resp, err := s.cs.newRequest(ctx, "removeFromLoadBalancerRule", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RemoveFromLoadBalancerRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &RemoveFromLoadBalancerRuleJob{s.cs.newJobHandle("removeFromLoadBalancerRule", r.JobID)}, nil
+}
+
 type RemoveFromLoadBalancerRuleResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -263,6 +487,10 @@ type ListLBHealthCheckPoliciesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListLBHealthCheckPoliciesParams) command() string {
+	return "listLBHealthCheckPolicies"
+}
+
 func (p *ListLBHealthCheckPoliciesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -357,16 +585,14 @@ func (s *LoadBalancerService) GetLBHealthCheckPolicyByID(id string, opts ...Opti
 
 	l, err := s.ListLBHealthCheckPolicies(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -378,10 +604,17 @@ func (s *LoadBalancerService) GetLBHealthCheckPolicyByID(id string, opts ...Opti
 
 // Lists load balancer health check policies.
 func (s *LoadBalancerService) ListLBHealthCheckPolicies(p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error) {
+	return s.ListLBHealthCheckPoliciesWithContext(context.Background(), p)
+}
+
+// ListLBHealthCheckPoliciesWithContext is the same as ListLBHealthCheckPolicies, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LoadBalancerService) ListLBHealthCheckPoliciesWithContext(ctx context.Context, p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error) {
 	var r ListLBHealthCheckPoliciesResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListLBHealthCheckPoliciesResponse
-		resp, err := s.cs.newRequest("listLBHealthCheckPolicies", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listLBHealthCheckPolicies", values)
 		if err != nil {
 			return nil, err
 		}
@@ -393,15 +626,91 @@ func (s *LoadBalancerService) ListLBHealthCheckPolicies(p *ListLBHealthCheckPoli
 		r.Count = l.Count
 		r.LBHealthCheckPolicies = append(r.LBHealthCheckPolicies, l.LBHealthCheckPolicies...)
 
-		if r.Count == len(r.LBHealthCheckPolicies) {
+		if r.Count == len(r.LBHealthCheckPolicies) || len(l.LBHealthCheckPolicies) == 0 {
+			s.cs.observePages("listLBHealthCheckPolicies", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.LBHealthCheckPolicies))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.LBHealthCheckPolicies)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listLBHealthCheckPolicies", values, len(l.LBHealthCheckPolicies), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListLBHealthCheckPoliciesResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.LBHealthCheckPolicies = append(r.LBHealthCheckPolicies, l.LBHealthCheckPolicies...)
+			}
+
+			if r.Count == len(r.LBHealthCheckPolicies) {
+				s.cs.observePages("listLBHealthCheckPolicies", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
 	}
 }
 
+// ListLBHealthCheckPoliciesIterator iterates over the items returned by listLBHealthCheckPolicies, requesting
+// the pages of the response one at a time while iterating.
+type ListLBHealthCheckPoliciesIterator struct {
+	pager
+	items []*LBHealthCheckPolicy
+	index int
+}
+
+// NewListLBHealthCheckPoliciesIterator returns an iterator over the items returned by listLBHealthCheckPolicies, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *LoadBalancerService) NewListLBHealthCheckPoliciesIterator(p *ListLBHealthCheckPoliciesParams, pagesize int) *ListLBHealthCheckPoliciesIterator {
+	return &ListLBHealthCheckPoliciesIterator{pager: newPager(s.cs, "listLBHealthCheckPolicies", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListLBHealthCheckPoliciesIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListLBHealthCheckPoliciesResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.LBHealthCheckPolicies))
+
+	i.items = l.LBHealthCheckPolicies
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListLBHealthCheckPoliciesIterator) Value() *LBHealthCheckPolicy {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
+}
+
 type ListLBHealthCheckPoliciesResponse struct {
 	Count                 int                    `json:"count"`
 	LBHealthCheckPolicies []*LBHealthCheckPolicy `json:"lbhealthcheckpolicy"`
@@ -430,6 +739,10 @@ type CreateLBHealthCheckPolicyParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateLBHealthCheckPolicyParams) command() string {
+	return "createLBHealthCheckPolicy"
+}
+
 func (p *CreateLBHealthCheckPolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -534,7 +847,13 @@ func (s *LoadBalancerService) NewCreateLBHealthCheckPolicyParams(lbruleid string
 
 // Creates a load balancer health check policy
 func (s *LoadBalancerService) CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error) {
-	resp, err := s.cs.newRequest("createLBHealthCheckPolicy", p.toURLValues())
+	return s.CreateLBHealthCheckPolicyWithContext(context.Background(), p)
+}
+
+// CreateLBHealthCheckPolicyWithContext is the same as CreateLBHealthCheckPolicy, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LoadBalancerService) CreateLBHealthCheckPolicyWithContext(ctx context.Context, p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createLBHealthCheckPolicy", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -546,26 +865,56 @@ func (s *LoadBalancerService) CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPo
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createLBHealthCheckPolicy", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "healthcheckpolicies", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CreateLBHealthCheckPolicyJob is a handle to a running createLBHealthCheckPolicy async job
+type CreateLBHealthCheckPolicyJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateLBHealthCheckPolicyResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateLBHealthCheckPolicyJob) Result() (*CreateLBHealthCheckPolicyResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateLBHealthCheckPolicyResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "healthcheckpolicies", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CreateLBHealthCheckPolicyAsync starts the createLBHealthCheckPolicy async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *LoadBalancerService) CreateLBHealthCheckPolicyAsync(ctx context.Context, p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createLBHealthCheckPolicy", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateLBHealthCheckPolicyResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateLBHealthCheckPolicyJob{s.cs.newJobHandle("createLBHealthCheckPolicy", r.JobID)}, nil
+}
+
 type CreateLBHealthCheckPolicyResponse struct {
 	JobID             string `json:"jobid,omitempty"`
 	Account           string `json:"account,omitempty"`
@@ -590,6 +939,10 @@ type DeleteLBHealthCheckPolicyParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteLBHealthCheckPolicyParams) command() string {
+	return "deleteLBHealthCheckPolicy"
+}
+
 func (p *DeleteLBHealthCheckPolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -619,7 +972,13 @@ func (s *LoadBalancerService) NewDeleteLBHealthCheckPolicyParams(id string) *Del
 
 // Deletes a load balancer health check policy.
 func (s *LoadBalancerService) DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error) {
-	resp, err := s.cs.newRequest("deleteLBHealthCheckPolicy", p.toURLValues())
+	return s.DeleteLBHealthCheckPolicyWithContext(context.Background(), p)
+}
+
+// DeleteLBHealthCheckPolicyWithContext is the same as DeleteLBHealthCheckPolicy, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LoadBalancerService) DeleteLBHealthCheckPolicyWithContext(ctx context.Context, p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteLBHealthCheckPolicy", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -631,9 +990,9 @@ func (s *LoadBalancerService) DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPo
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteLBHealthCheckPolicy", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -646,6 +1005,41 @@ func (s *LoadBalancerService) DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPo
 	return &r, nil
 }
 
+// DeleteLBHealthCheckPolicyJob is a handle to a running deleteLBHealthCheckPolicy async job
+type DeleteLBHealthCheckPolicyJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteLBHealthCheckPolicyResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteLBHealthCheckPolicyJob) Result() (*DeleteLBHealthCheckPolicyResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteLBHealthCheckPolicyResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteLBHealthCheckPolicyAsync starts the deleteLBHealthCheckPolicy async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *LoadBalancerService) DeleteLBHealthCheckPolicyAsync(ctx context.Context, p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteLBHealthCheckPolicy", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteLBHealthCheckPolicyResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteLBHealthCheckPolicyJob{s.cs.newJobHandle("deleteLBHealthCheckPolicy", r.JobID)}, nil
+}
+
 type DeleteLBHealthCheckPolicyResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -656,6 +1050,10 @@ type UpdateLBHealthCheckPolicyParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateLBHealthCheckPolicyParams) command() string {
+	return "updateLBHealthCheckPolicy"
+}
+
 func (p *UpdateLBHealthCheckPolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -706,7 +1104,13 @@ func (s *LoadBalancerService) NewUpdateLBHealthCheckPolicyParams(id string) *Upd
 
 // Updates load balancer health check policy
 func (s *LoadBalancerService) UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyResponse, error) {
-	resp, err := s.cs.newRequest("updateLBHealthCheckPolicy", p.toURLValues())
+	return s.UpdateLBHealthCheckPolicyWithContext(context.Background(), p)
+}
+
+// UpdateLBHealthCheckPolicyWithContext is the same as UpdateLBHealthCheckPolicy, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LoadBalancerService) UpdateLBHealthCheckPolicyWithContext(ctx context.Context, p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateLBHealthCheckPolicy", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -718,26 +1122,56 @@ func (s *LoadBalancerService) UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPo
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateLBHealthCheckPolicy", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "healthcheckpolicies", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// UpdateLBHealthCheckPolicyJob is a handle to a running updateLBHealthCheckPolicy async job
+type UpdateLBHealthCheckPolicyJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateLBHealthCheckPolicyResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateLBHealthCheckPolicyJob) Result() (*UpdateLBHealthCheckPolicyResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateLBHealthCheckPolicyResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "healthcheckpolicies", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// UpdateLBHealthCheckPolicyAsync starts the updateLBHealthCheckPolicy async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *LoadBalancerService) UpdateLBHealthCheckPolicyAsync(ctx context.Context, p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateLBHealthCheckPolicy", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateLBHealthCheckPolicyResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateLBHealthCheckPolicyJob{s.cs.newJobHandle("updateLBHealthCheckPolicy", r.JobID)}, nil
+}
+
 type UpdateLBHealthCheckPolicyResponse struct {
 	JobID             string `json:"jobid,omitempty"`
 	Account           string `json:"account,omitempty"`
@@ -762,6 +1196,10 @@ type ListLBStickinessPoliciesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListLBStickinessPoliciesParams) command() string {
+	return "listLBStickinessPolicies"
+}
+
 func (p *ListLBStickinessPoliciesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -856,16 +1294,14 @@ func (s *LoadBalancerService) GetLBStickinessPolicyByID(id string, opts ...Optio
 
 	l, err := s.ListLBStickinessPolicies(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -877,10 +1313,17 @@ func (s *LoadBalancerService) GetLBStickinessPolicyByID(id string, opts ...Optio
 
 // Lists load balancer stickiness policies.
 func (s *LoadBalancerService) ListLBStickinessPolicies(p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error) {
+	return s.ListLBStickinessPoliciesWithContext(context.Background(), p)
+}
+
+// ListLBStickinessPoliciesWithContext is the same as ListLBStickinessPolicies, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LoadBalancerService) ListLBStickinessPoliciesWithContext(ctx context.Context, p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error) {
 	var r ListLBStickinessPoliciesResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListLBStickinessPoliciesResponse
-		resp, err := s.cs.newRequest("listLBStickinessPolicies", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listLBStickinessPolicies", values)
 		if err != nil {
 			return nil, err
 		}
@@ -892,13 +1335,89 @@ func (s *LoadBalancerService) ListLBStickinessPolicies(p *ListLBStickinessPolici
 		r.Count = l.Count
 		r.LBStickinessPolicies = append(r.LBStickinessPolicies, l.LBStickinessPolicies...)
 
-		if r.Count == len(r.LBStickinessPolicies) {
+		if r.Count == len(r.LBStickinessPolicies) || len(l.LBStickinessPolicies) == 0 {
+			s.cs.observePages("listLBStickinessPolicies", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.LBStickinessPolicies))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.LBStickinessPolicies)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listLBStickinessPolicies", values, len(l.LBStickinessPolicies), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListLBStickinessPoliciesResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.LBStickinessPolicies = append(r.LBStickinessPolicies, l.LBStickinessPolicies...)
+			}
+
+			if r.Count == len(r.LBStickinessPolicies) {
+				s.cs.observePages("listLBStickinessPolicies", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListLBStickinessPoliciesIterator iterates over the items returned by listLBStickinessPolicies, requesting
+// the pages of the response one at a time while iterating.
+type ListLBStickinessPoliciesIterator struct {
+	pager
+	items []*LBStickinessPolicy
+	index int
+}
+
+// NewListLBStickinessPoliciesIterator returns an iterator over the items returned by listLBStickinessPolicies, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *LoadBalancerService) NewListLBStickinessPoliciesIterator(p *ListLBStickinessPoliciesParams, pagesize int) *ListLBStickinessPoliciesIterator {
+	return &ListLBStickinessPoliciesIterator{pager: newPager(s.cs, "listLBStickinessPolicies", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListLBStickinessPoliciesIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
 	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListLBStickinessPoliciesResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.LBStickinessPolicies))
+
+	i.items = l.LBStickinessPolicies
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListLBStickinessPoliciesIterator) Value() *LBStickinessPolicy {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
 }
 
 type ListLBStickinessPoliciesResponse struct {
@@ -930,6 +1449,10 @@ type CreateLBStickinessPolicyParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateLBStickinessPolicyParams) command() string {
+	return "createLBStickinessPolicy"
+}
+
 func (p *CreateLBStickinessPolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1017,7 +1540,13 @@ func (s *LoadBalancerService) NewCreateLBStickinessPolicyParams(lbruleid string,
 
 // Creates a load balancer stickiness policy
 func (s *LoadBalancerService) CreateLBStickinessPolicy(p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error) {
-	resp, err := s.cs.newRequest("createLBStickinessPolicy", p.toURLValues())
+	return s.CreateLBStickinessPolicyWithContext(context.Background(), p)
+}
+
+// CreateLBStickinessPolicyWithContext is the same as CreateLBStickinessPolicy, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LoadBalancerService) CreateLBStickinessPolicyWithContext(ctx context.Context, p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createLBStickinessPolicy", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1029,26 +1558,56 @@ func (s *LoadBalancerService) CreateLBStickinessPolicy(p *CreateLBStickinessPoli
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createLBStickinessPolicy", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "stickinesspolicies", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CreateLBStickinessPolicyJob is a handle to a running createLBStickinessPolicy async job
+type CreateLBStickinessPolicyJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateLBStickinessPolicyResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateLBStickinessPolicyJob) Result() (*CreateLBStickinessPolicyResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateLBStickinessPolicyResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "stickinesspolicies", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CreateLBStickinessPolicyAsync starts the createLBStickinessPolicy async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *LoadBalancerService) CreateLBStickinessPolicyAsync(ctx context.Context, p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createLBStickinessPolicy", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateLBStickinessPolicyResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateLBStickinessPolicyJob{s.cs.newJobHandle("createLBStickinessPolicy", r.JobID)}, nil
+}
+
 type CreateLBStickinessPolicyResponse struct {
 	JobID            string `json:"jobid,omitempty"`
 	Account          string `json:"account,omitempty"`
@@ -1074,6 +1633,10 @@ type DeleteLBStickinessPolicyParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteLBStickinessPolicyParams) command() string {
+	return "deleteLBStickinessPolicy"
+}
+
 func (p *DeleteLBStickinessPolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1103,7 +1666,13 @@ func (s *LoadBalancerService) NewDeleteLBStickinessPolicyParams(id string) *Dele
 
 // Deletes a load balancer stickiness policy.
 func (s *LoadBalancerService) DeleteLBStickinessPolicy(p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error) {
-	resp, err := s.cs.newRequest("deleteLBStickinessPolicy", p.toURLValues())
+	return s.DeleteLBStickinessPolicyWithContext(context.Background(), p)
+}
+
+// DeleteLBStickinessPolicyWithContext is the same as DeleteLBStickinessPolicy, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LoadBalancerService) DeleteLBStickinessPolicyWithContext(ctx context.Context, p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteLBStickinessPolicy", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1115,9 +1684,9 @@ func (s *LoadBalancerService) DeleteLBStickinessPolicy(p *DeleteLBStickinessPoli
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteLBStickinessPolicy", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -1130,6 +1699,41 @@ func (s *LoadBalancerService) DeleteLBStickinessPolicy(p *DeleteLBStickinessPoli
 	return &r, nil
 }
 
+// DeleteLBStickinessPolicyJob is a handle to a running deleteLBStickinessPolicy async job
+type DeleteLBStickinessPolicyJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteLBStickinessPolicyResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteLBStickinessPolicyJob) Result() (*DeleteLBStickinessPolicyResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteLBStickinessPolicyResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteLBStickinessPolicyAsync starts the deleteLBStickinessPolicy async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *LoadBalancerService) DeleteLBStickinessPolicyAsync(ctx context.Context, p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteLBStickinessPolicy", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteLBStickinessPolicyResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteLBStickinessPolicyJob{s.cs.newJobHandle("deleteLBStickinessPolicy", r.JobID)}, nil
+}
+
 type DeleteLBStickinessPolicyResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -1140,6 +1744,10 @@ type UpdateLBStickinessPolicyParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateLBStickinessPolicyParams) command() string {
+	return "updateLBStickinessPolicy"
+}
+
 func (p *UpdateLBStickinessPolicyParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1190,7 +1798,13 @@ func (s *LoadBalancerService) NewUpdateLBStickinessPolicyParams(id string) *Upda
 
 // Updates load balancer stickiness policy
 func (s *LoadBalancerService) UpdateLBStickinessPolicy(p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyResponse, error) {
-	resp, err := s.cs.newRequest("updateLBStickinessPolicy", p.toURLValues())
+	return s.UpdateLBStickinessPolicyWithContext(context.Background(), p)
+}
+
+// UpdateLBStickinessPolicyWithContext is the same as UpdateLBStickinessPolicy, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LoadBalancerService) UpdateLBStickinessPolicyWithContext(ctx context.Context, p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateLBStickinessPolicy", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1202,26 +1816,56 @@ func (s *LoadBalancerService) UpdateLBStickinessPolicy(p *UpdateLBStickinessPoli
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateLBStickinessPolicy", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "stickinesspolicies", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// UpdateLBStickinessPolicyJob is a handle to a running updateLBStickinessPolicy async job
+type UpdateLBStickinessPolicyJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateLBStickinessPolicyResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateLBStickinessPolicyJob) Result() (*UpdateLBStickinessPolicyResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateLBStickinessPolicyResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "stickinesspolicies", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// UpdateLBStickinessPolicyAsync starts the updateLBStickinessPolicy async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *LoadBalancerService) UpdateLBStickinessPolicyAsync(ctx context.Context, p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateLBStickinessPolicy", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateLBStickinessPolicyResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateLBStickinessPolicyJob{s.cs.newJobHandle("updateLBStickinessPolicy", r.JobID)}, nil
+}
+
 type UpdateLBStickinessPolicyResponse struct {
 	JobID            string `json:"jobid,omitempty"`
 	Account          string `json:"account,omitempty"`
@@ -1247,6 +1891,10 @@ type CreateLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateLoadBalancerRuleParams) command() string {
+	return "createLoadBalancerRule"
+}
+
 func (p *CreateLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1436,7 +2084,13 @@ func (s *LoadBalancerService) NewCreateLoadBalancerRuleParams(algorithm string,
 
 // Creates a load balancer rule
 func (s *LoadBalancerService) CreateLoadBalancerRule(p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("createLoadBalancerRule", p.toURLValues())
+	return s.CreateLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// CreateLoadBalancerRuleWithContext is the same as CreateLoadBalancerRule, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LoadBalancerService) CreateLoadBalancerRuleWithContext(ctx context.Context, p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createLoadBalancerRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1448,26 +2102,56 @@ func (s *LoadBalancerService) CreateLoadBalancerRule(p *CreateLoadBalancerRulePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createLoadBalancerRule", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "loadbalancerrule", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CreateLoadBalancerRuleJob is a handle to a running createLoadBalancerRule async job
+type CreateLoadBalancerRuleJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateLoadBalancerRuleResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateLoadBalancerRuleJob) Result() (*CreateLoadBalancerRuleResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateLoadBalancerRuleResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "loadbalancerrule", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CreateLoadBalancerRuleAsync starts the createLoadBalancerRule async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *LoadBalancerService) CreateLoadBalancerRuleAsync(ctx context.Context, p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createLoadBalancerRule", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateLoadBalancerRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateLoadBalancerRuleJob{s.cs.newJobHandle("createLoadBalancerRule", r.JobID)}, nil
+}
+
 type CreateLoadBalancerRuleResponse struct {
 	JobID         string `json:"jobid,omitempty"`
 	Account       string `json:"account,omitempty"`
@@ -1509,6 +2193,10 @@ type DeleteLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteLoadBalancerRuleParams) command() string {
+	return "deleteLoadBalancerRule"
+}
+
 func (p *DeleteLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1538,7 +2226,13 @@ func (s *LoadBalancerService) NewDeleteLoadBalancerRuleParams(id string) *Delete
 
 // Deletes a load balancer rule.
 func (s *LoadBalancerService) DeleteLoadBalancerRule(p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteLoadBalancerRule", p.toURLValues())
+	return s.DeleteLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// DeleteLoadBalancerRuleWithContext is the same as DeleteLoadBalancerRule, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LoadBalancerService) DeleteLoadBalancerRuleWithContext(ctx context.Context, p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteLoadBalancerRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1550,9 +2244,9 @@ func (s *LoadBalancerService) DeleteLoadBalancerRule(p *DeleteLoadBalancerRulePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteLoadBalancerRule", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -1565,6 +2259,41 @@ func (s *LoadBalancerService) DeleteLoadBalancerRule(p *DeleteLoadBalancerRulePa
 	return &r, nil
 }
 
+// DeleteLoadBalancerRuleJob is a handle to a running deleteLoadBalancerRule async job
+type DeleteLoadBalancerRuleJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteLoadBalancerRuleResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteLoadBalancerRuleJob) Result() (*DeleteLoadBalancerRuleResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteLoadBalancerRuleResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteLoadBalancerRuleAsync starts the deleteLoadBalancerRule async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *LoadBalancerService) DeleteLoadBalancerRuleAsync(ctx context.Context, p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteLoadBalancerRule", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteLoadBalancerRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteLoadBalancerRuleJob{s.cs.newJobHandle("deleteLoadBalancerRule", r.JobID)}, nil
+}
+
 type DeleteLoadBalancerRuleResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -1575,6 +2304,10 @@ type UpdateLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateLoadBalancerRuleParams) command() string {
+	return "updateLoadBalancerRule"
+}
+
 func (p *UpdateLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1677,7 +2410,13 @@ func (s *LoadBalancerService) NewUpdateLoadBalancerRuleParams(id string) *Update
 
 // Updates load balancer
 func (s *LoadBalancerService) UpdateLoadBalancerRule(p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("updateLoadBalancerRule", p.toURLValues())
+	return s.UpdateLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// UpdateLoadBalancerRuleWithContext is the same as UpdateLoadBalancerRule, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LoadBalancerService) UpdateLoadBalancerRuleWithContext(ctx context.Context, p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateLoadBalancerRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1689,26 +2428,56 @@ func (s *LoadBalancerService) UpdateLoadBalancerRule(p *UpdateLoadBalancerRulePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateLoadBalancerRule", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "loadbalancerrule", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// UpdateLoadBalancerRuleJob is a handle to a running updateLoadBalancerRule async job
+type UpdateLoadBalancerRuleJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateLoadBalancerRuleResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateLoadBalancerRuleJob) Result() (*UpdateLoadBalancerRuleResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateLoadBalancerRuleResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "loadbalancerrule", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// UpdateLoadBalancerRuleAsync starts the updateLoadBalancerRule async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *LoadBalancerService) UpdateLoadBalancerRuleAsync(ctx context.Context, p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateLoadBalancerRule", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateLoadBalancerRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateLoadBalancerRuleJob{s.cs.newJobHandle("updateLoadBalancerRule", r.JobID)}, nil
+}
+
 type UpdateLoadBalancerRuleResponse struct {
 	JobID         string `json:"jobid,omitempty"`
 	Account       string `json:"account,omitempty"`
@@ -1750,6 +2519,10 @@ type ListLoadBalancerRuleInstancesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListLoadBalancerRuleInstancesParams) command() string {
+	return "listLoadBalancerRuleInstances"
+}
+
 func (p *ListLoadBalancerRuleInstancesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1846,16 +2619,14 @@ func (s *LoadBalancerService) GetLoadBalancerRuleInstanceByID(id string, opts ..
 
 	l, err := s.ListLoadBalancerRuleInstances(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -1867,10 +2638,17 @@ func (s *LoadBalancerService) GetLoadBalancerRuleInstanceByID(id string, opts ..
 
 // List all virtual machine instances that are assigned to a load balancer rule.
 func (s *LoadBalancerService) ListLoadBalancerRuleInstances(p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error) {
+	return s.ListLoadBalancerRuleInstancesWithContext(context.Background(), p)
+}
+
+// ListLoadBalancerRuleInstancesWithContext is the same as ListLoadBalancerRuleInstances, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LoadBalancerService) ListLoadBalancerRuleInstancesWithContext(ctx context.Context, p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error) {
 	var r ListLoadBalancerRuleInstancesResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListLoadBalancerRuleInstancesResponse
-		resp, err := s.cs.newRequest("listLoadBalancerRuleInstances", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listLoadBalancerRuleInstances", values)
 		if err != nil {
 			return nil, err
 		}
@@ -1882,15 +2660,91 @@ func (s *LoadBalancerService) ListLoadBalancerRuleInstances(p *ListLoadBalancerR
 		r.Count = l.Count
 		r.LoadBalancerRuleInstances = append(r.LoadBalancerRuleInstances, l.LoadBalancerRuleInstances...)
 
-		if r.Count == len(r.LoadBalancerRuleInstances) {
+		if r.Count == len(r.LoadBalancerRuleInstances) || len(l.LoadBalancerRuleInstances) == 0 {
+			s.cs.observePages("listLoadBalancerRuleInstances", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.LoadBalancerRuleInstances))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.LoadBalancerRuleInstances)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listLoadBalancerRuleInstances", values, len(l.LoadBalancerRuleInstances), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListLoadBalancerRuleInstancesResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.LoadBalancerRuleInstances = append(r.LoadBalancerRuleInstances, l.LoadBalancerRuleInstances...)
+			}
+
+			if r.Count == len(r.LoadBalancerRuleInstances) {
+				s.cs.observePages("listLoadBalancerRuleInstances", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
 	}
 }
 
+// ListLoadBalancerRuleInstancesIterator iterates over the items returned by listLoadBalancerRuleInstances, requesting
+// the pages of the response one at a time while iterating.
+type ListLoadBalancerRuleInstancesIterator struct {
+	pager
+	items []*VirtualMachine
+	index int
+}
+
+// NewListLoadBalancerRuleInstancesIterator returns an iterator over the items returned by listLoadBalancerRuleInstances, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *LoadBalancerService) NewListLoadBalancerRuleInstancesIterator(p *ListLoadBalancerRuleInstancesParams, pagesize int) *ListLoadBalancerRuleInstancesIterator {
+	return &ListLoadBalancerRuleInstancesIterator{pager: newPager(s.cs, "listLoadBalancerRuleInstances", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListLoadBalancerRuleInstancesIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListLoadBalancerRuleInstancesResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.LoadBalancerRuleInstances))
+
+	i.items = l.LoadBalancerRuleInstances
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListLoadBalancerRuleInstancesIterator) Value() *VirtualMachine {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
+}
+
 type ListLoadBalancerRuleInstancesResponse struct {
 	Count                     int                         `json:"count"`
 	LBRuleVMIDIPs             []*LoadBalancerRuleInstance `json:"lbrulevmidip,omitempty"`
@@ -1906,6 +2760,10 @@ type ListLoadBalancerRulesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListLoadBalancerRulesParams) command() string {
+	return "listLoadBalancerRules"
+}
+
 func (p *ListLoadBalancerRulesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2111,7 +2969,7 @@ func (s *LoadBalancerService) GetLoadBalancerRuleID(name string, opts ...OptionF
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -2125,7 +2983,7 @@ func (s *LoadBalancerService) GetLoadBalancerRuleID(name string, opts ...OptionF
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -2157,16 +3015,14 @@ func (s *LoadBalancerService) GetLoadBalancerRuleByID(id string, opts ...OptionF
 
 	l, err := s.ListLoadBalancerRules(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -2178,10 +3034,17 @@ func (s *LoadBalancerService) GetLoadBalancerRuleByID(id string, opts ...OptionF
 
 // Lists load balancer rules.
 func (s *LoadBalancerService) ListLoadBalancerRules(p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error) {
+	return s.ListLoadBalancerRulesWithContext(context.Background(), p)
+}
+
+// ListLoadBalancerRulesWithContext is the same as ListLoadBalancerRules, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LoadBalancerService) ListLoadBalancerRulesWithContext(ctx context.Context, p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error) {
 	var r ListLoadBalancerRulesResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListLoadBalancerRulesResponse
-		resp, err := s.cs.newRequest("listLoadBalancerRules", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listLoadBalancerRules", values)
 		if err != nil {
 			return nil, err
 		}
@@ -2193,15 +3056,91 @@ func (s *LoadBalancerService) ListLoadBalancerRules(p *ListLoadBalancerRulesPara
 		r.Count = l.Count
 		r.LoadBalancerRules = append(r.LoadBalancerRules, l.LoadBalancerRules...)
 
-		if r.Count == len(r.LoadBalancerRules) {
+		if r.Count == len(r.LoadBalancerRules) || len(l.LoadBalancerRules) == 0 {
+			s.cs.observePages("listLoadBalancerRules", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.LoadBalancerRules))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.LoadBalancerRules)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listLoadBalancerRules", values, len(l.LoadBalancerRules), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListLoadBalancerRulesResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.LoadBalancerRules = append(r.LoadBalancerRules, l.LoadBalancerRules...)
+			}
+
+			if r.Count == len(r.LoadBalancerRules) {
+				s.cs.observePages("listLoadBalancerRules", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
 	}
 }
 
+// ListLoadBalancerRulesIterator iterates over the items returned by listLoadBalancerRules, requesting
+// the pages of the response one at a time while iterating.
+type ListLoadBalancerRulesIterator struct {
+	pager
+	items []*LoadBalancerRule
+	index int
+}
+
+// NewListLoadBalancerRulesIterator returns an iterator over the items returned by listLoadBalancerRules, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *LoadBalancerService) NewListLoadBalancerRulesIterator(p *ListLoadBalancerRulesParams, pagesize int) *ListLoadBalancerRulesIterator {
+	return &ListLoadBalancerRulesIterator{pager: newPager(s.cs, "listLoadBalancerRules", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListLoadBalancerRulesIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListLoadBalancerRulesResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.LoadBalancerRules))
+
+	i.items = l.LoadBalancerRules
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListLoadBalancerRulesIterator) Value() *LoadBalancerRule {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
+}
+
 type ListLoadBalancerRulesResponse struct {
 	Count             int                 `json:"count"`
 	LoadBalancerRules []*LoadBalancerRule `json:"loadbalancerrule"`
@@ -2247,6 +3186,10 @@ type DeleteSslCertParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteSslCertParams) command() string {
+	return "deleteSslCert"
+}
+
 func (p *DeleteSslCertParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2276,7 +3219,13 @@ func (s *LoadBalancerService) NewDeleteSslCertParams(id string) *DeleteSslCertPa
 
 // Delete a certificate to CloudStack
 func (s *LoadBalancerService) DeleteSslCert(p *DeleteSslCertParams) (*DeleteSslCertResponse, error) {
-	resp, err := s.cs.newRequest("deleteSslCert", p.toURLValues())
+	return s.DeleteSslCertWithContext(context.Background(), p)
+}
+
+// DeleteSslCertWithContext is the same as DeleteSslCert, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LoadBalancerService) DeleteSslCertWithContext(ctx context.Context, p *DeleteSslCertParams) (*DeleteSslCertResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteSslCert", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2297,6 +3246,10 @@ type UploadSslCertParams struct {
 	p map[string]interface{}
 }
 
+func (p *UploadSslCertParams) command() string {
+	return "uploadSslCert"
+}
+
 func (p *UploadSslCertParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2387,7 +3340,13 @@ func (s *LoadBalancerService) NewUploadSslCertParams(certificate string, private
 
 // Upload a certificate to CloudStack
 func (s *LoadBalancerService) UploadSslCert(p *UploadSslCertParams) (*UploadSslCertResponse, error) {
-	resp, err := s.cs.newRequest("uploadSslCert", p.toURLValues())
+	return s.UploadSslCertWithContext(context.Background(), p)
+}
+
+// UploadSslCertWithContext is the same as UploadSslCert, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LoadBalancerService) UploadSslCertWithContext(ctx context.Context, p *UploadSslCertParams) (*UploadSslCertResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "uploadSslCert", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2416,6 +3375,10 @@ type ListSslCertsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListSslCertsParams) command() string {
+	return "listSslCerts"
+}
+
 func (p *ListSslCertsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2474,7 +3437,13 @@ func (s *LoadBalancerService) NewListSslCertsParams() *ListSslCertsParams {
 
 // Lists SSL certificates
 func (s *LoadBalancerService) ListSslCerts(p *ListSslCertsParams) (*ListSslCertsResponse, error) {
-	resp, err := s.cs.newRequest("listSslCerts", p.toURLValues())
+	return s.ListSslCertsWithContext(context.Background(), p)
+}
+
+// ListSslCertsWithContext is the same as ListSslCerts, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LoadBalancerService) ListSslCertsWithContext(ctx context.Context, p *ListSslCertsParams) (*ListSslCertsResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "listSslCerts", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2508,6 +3477,10 @@ type AssignToLoadBalancerRuleParams struct {
 	p map[string]interface{}
 }
 
+func (p *AssignToLoadBalancerRuleParams) command() string {
+	return "assignToLoadBalancerRule"
+}
+
 func (p *AssignToLoadBalancerRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2563,7 +3536,13 @@ func (s *LoadBalancerService) NewAssignToLoadBalancerRuleParams(id string) *Assi
 
 // Assigns virtual machine or a list of virtual machines to a load balancer rule.
 func (s *LoadBalancerService) AssignToLoadBalancerRule(p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("assignToLoadBalancerRule", p.toURLValues())
+	return s.AssignToLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// AssignToLoadBalancerRuleWithContext is the same as AssignToLoadBalancerRule, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *LoadBalancerService) AssignToLoadBalancerRuleWithContext(ctx context.Context, p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "assignToLoadBalancerRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2575,9 +3554,9 @@ func (s *LoadBalancerService) AssignToLoadBalancerRule(p *AssignToLoadBalancerRu
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "assignToLoadBalancerRule", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -2590,6 +3569,41 @@ func (s *LoadBalancerService) AssignToLoadBalancerRule(p *AssignToLoadBalancerRu
 	return &r, nil
 }
 
+// AssignToLoadBalancerRuleJob is a handle to a running assignToLoadBalancerRule async job
+type AssignToLoadBalancerRuleJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a AssignToLoadBalancerRuleResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *AssignToLoadBalancerRuleJob) Result() (*AssignToLoadBalancerRuleResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := AssignToLoadBalancerRuleResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// AssignToLoadBalancerRuleAsync starts the assignToLoadBalancerRule async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *LoadBalancerService) AssignToLoadBalancerRuleAsync(ctx context.Context, p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleJob, error) {
+	resp, err := s.cs.newRequest(ctx, "assignToLoadBalancerRule", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AssignToLoadBalancerRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &AssignToLoadBalancerRuleJob{s.cs.newJobHandle("assignToLoadBalancerRule", r.JobID)}, nil
+}
+
 type AssignToLoadBalancerRuleResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -0,0 +1,596 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockLoadBalancerService is a mock of LoadBalancerServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockLoadBalancerService struct {
+	mockCalls
+
+	RemoveCertFromLoadBalancerFunc      func(ctx context.Context, p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerResponse, error)
+	RemoveCertFromLoadBalancerAsyncFunc func(ctx context.Context, p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerJob, error)
+	AssignCertToLoadBalancerFunc        func(ctx context.Context, p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error)
+	AssignCertToLoadBalancerAsyncFunc   func(ctx context.Context, p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerJob, error)
+	RemoveFromLoadBalancerRuleFunc      func(ctx context.Context, p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleResponse, error)
+	RemoveFromLoadBalancerRuleAsyncFunc func(ctx context.Context, p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleJob, error)
+	GetLBHealthCheckPolicyByIDFunc      func(id string, opts ...OptionFunc) (*LBHealthCheckPolicy, int, error)
+	ListLBHealthCheckPoliciesFunc       func(ctx context.Context, p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error)
+	CreateLBHealthCheckPolicyFunc       func(ctx context.Context, p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error)
+	CreateLBHealthCheckPolicyAsyncFunc  func(ctx context.Context, p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyJob, error)
+	DeleteLBHealthCheckPolicyFunc       func(ctx context.Context, p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error)
+	DeleteLBHealthCheckPolicyAsyncFunc  func(ctx context.Context, p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyJob, error)
+	UpdateLBHealthCheckPolicyFunc       func(ctx context.Context, p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyResponse, error)
+	UpdateLBHealthCheckPolicyAsyncFunc  func(ctx context.Context, p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyJob, error)
+	GetLBStickinessPolicyByIDFunc       func(id string, opts ...OptionFunc) (*LBStickinessPolicy, int, error)
+	ListLBStickinessPoliciesFunc        func(ctx context.Context, p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error)
+	CreateLBStickinessPolicyFunc        func(ctx context.Context, p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error)
+	CreateLBStickinessPolicyAsyncFunc   func(ctx context.Context, p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyJob, error)
+	DeleteLBStickinessPolicyFunc        func(ctx context.Context, p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error)
+	DeleteLBStickinessPolicyAsyncFunc   func(ctx context.Context, p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyJob, error)
+	UpdateLBStickinessPolicyFunc        func(ctx context.Context, p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyResponse, error)
+	UpdateLBStickinessPolicyAsyncFunc   func(ctx context.Context, p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyJob, error)
+	CreateLoadBalancerRuleFunc          func(ctx context.Context, p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error)
+	CreateLoadBalancerRuleAsyncFunc     func(ctx context.Context, p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleJob, error)
+	DeleteLoadBalancerRuleFunc          func(ctx context.Context, p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error)
+	DeleteLoadBalancerRuleAsyncFunc     func(ctx context.Context, p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleJob, error)
+	UpdateLoadBalancerRuleFunc          func(ctx context.Context, p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleResponse, error)
+	UpdateLoadBalancerRuleAsyncFunc     func(ctx context.Context, p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleJob, error)
+	GetLoadBalancerRuleInstanceByIDFunc func(id string, opts ...OptionFunc) (*VirtualMachine, int, error)
+	ListLoadBalancerRuleInstancesFunc   func(ctx context.Context, p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error)
+	GetLoadBalancerRuleIDFunc           func(name string, opts ...OptionFunc) (string, int, error)
+	GetLoadBalancerRuleByNameFunc       func(name string, opts ...OptionFunc) (*LoadBalancerRule, int, error)
+	GetLoadBalancerRuleByIDFunc         func(id string, opts ...OptionFunc) (*LoadBalancerRule, int, error)
+	ListLoadBalancerRulesFunc           func(ctx context.Context, p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error)
+	DeleteSslCertFunc                   func(ctx context.Context, p *DeleteSslCertParams) (*DeleteSslCertResponse, error)
+	UploadSslCertFunc                   func(ctx context.Context, p *UploadSslCertParams) (*UploadSslCertResponse, error)
+	ListSslCertsFunc                    func(ctx context.Context, p *ListSslCertsParams) (*ListSslCertsResponse, error)
+	AssignToLoadBalancerRuleFunc        func(ctx context.Context, p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error)
+	AssignToLoadBalancerRuleAsyncFunc   func(ctx context.Context, p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleJob, error)
+}
+
+var _ LoadBalancerServiceIface = &MockLoadBalancerService{}
+
+func (m *MockLoadBalancerService) NewRemoveCertFromLoadBalancerParams(lbruleid string) *RemoveCertFromLoadBalancerParams {
+	return (&LoadBalancerService{}).NewRemoveCertFromLoadBalancerParams(lbruleid)
+}
+
+func (m *MockLoadBalancerService) RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerResponse, error) {
+	return m.RemoveCertFromLoadBalancerWithContext(context.Background(), p)
+}
+
+func (m *MockLoadBalancerService) RemoveCertFromLoadBalancerWithContext(ctx context.Context, p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerResponse, error) {
+	m.record("RemoveCertFromLoadBalancer", p)
+	if m.RemoveCertFromLoadBalancerFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "RemoveCertFromLoadBalancer")
+	}
+	return m.RemoveCertFromLoadBalancerFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) RemoveCertFromLoadBalancerAsync(ctx context.Context, p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerJob, error) {
+	m.record("RemoveCertFromLoadBalancerAsync", p)
+	if m.RemoveCertFromLoadBalancerAsyncFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "RemoveCertFromLoadBalancerAsync")
+	}
+	return m.RemoveCertFromLoadBalancerAsyncFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) NewAssignCertToLoadBalancerParams(certid string, lbruleid string) *AssignCertToLoadBalancerParams {
+	return (&LoadBalancerService{}).NewAssignCertToLoadBalancerParams(certid, lbruleid)
+}
+
+func (m *MockLoadBalancerService) AssignCertToLoadBalancer(p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error) {
+	return m.AssignCertToLoadBalancerWithContext(context.Background(), p)
+}
+
+func (m *MockLoadBalancerService) AssignCertToLoadBalancerWithContext(ctx context.Context, p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error) {
+	m.record("AssignCertToLoadBalancer", p)
+	if m.AssignCertToLoadBalancerFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "AssignCertToLoadBalancer")
+	}
+	return m.AssignCertToLoadBalancerFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) AssignCertToLoadBalancerAsync(ctx context.Context, p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerJob, error) {
+	m.record("AssignCertToLoadBalancerAsync", p)
+	if m.AssignCertToLoadBalancerAsyncFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "AssignCertToLoadBalancerAsync")
+	}
+	return m.AssignCertToLoadBalancerAsyncFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) NewRemoveFromLoadBalancerRuleParams(id string) *RemoveFromLoadBalancerRuleParams {
+	return (&LoadBalancerService{}).NewRemoveFromLoadBalancerRuleParams(id)
+}
+
+func (m *MockLoadBalancerService) RemoveFromLoadBalancerRule(p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleResponse, error) {
+	return m.RemoveFromLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+func (m *MockLoadBalancerService) RemoveFromLoadBalancerRuleWithContext(ctx context.Context, p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleResponse, error) {
+	m.record("RemoveFromLoadBalancerRule", p)
+	if m.RemoveFromLoadBalancerRuleFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "RemoveFromLoadBalancerRule")
+	}
+	return m.RemoveFromLoadBalancerRuleFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) RemoveFromLoadBalancerRuleAsync(ctx context.Context, p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleJob, error) {
+	m.record("RemoveFromLoadBalancerRuleAsync", p)
+	if m.RemoveFromLoadBalancerRuleAsyncFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "RemoveFromLoadBalancerRuleAsync")
+	}
+	return m.RemoveFromLoadBalancerRuleAsyncFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) NewListLBHealthCheckPoliciesParams() *ListLBHealthCheckPoliciesParams {
+	return (&LoadBalancerService{}).NewListLBHealthCheckPoliciesParams()
+}
+
+func (m *MockLoadBalancerService) GetLBHealthCheckPolicyByID(id string, opts ...OptionFunc) (*LBHealthCheckPolicy, int, error) {
+	m.record("GetLBHealthCheckPolicyByID", id, opts)
+	if m.GetLBHealthCheckPolicyByIDFunc == nil {
+		return nil, -1, notMocked("MockLoadBalancerService", "GetLBHealthCheckPolicyByID")
+	}
+	return m.GetLBHealthCheckPolicyByIDFunc(id, opts...)
+}
+
+func (m *MockLoadBalancerService) ListLBHealthCheckPolicies(p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error) {
+	return m.ListLBHealthCheckPoliciesWithContext(context.Background(), p)
+}
+
+func (m *MockLoadBalancerService) ListLBHealthCheckPoliciesWithContext(ctx context.Context, p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error) {
+	m.record("ListLBHealthCheckPolicies", p)
+	if m.ListLBHealthCheckPoliciesFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "ListLBHealthCheckPolicies")
+	}
+	return m.ListLBHealthCheckPoliciesFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) NewListLBHealthCheckPoliciesIterator(p *ListLBHealthCheckPoliciesParams, pagesize int) *ListLBHealthCheckPoliciesIterator {
+	i := &ListLBHealthCheckPoliciesIterator{pager: newPager(nil, "listLBHealthCheckPolicies", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListLBHealthCheckPoliciesParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListLBHealthCheckPoliciesWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockLoadBalancerService) NewCreateLBHealthCheckPolicyParams(lbruleid string) *CreateLBHealthCheckPolicyParams {
+	return (&LoadBalancerService{}).NewCreateLBHealthCheckPolicyParams(lbruleid)
+}
+
+func (m *MockLoadBalancerService) CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error) {
+	return m.CreateLBHealthCheckPolicyWithContext(context.Background(), p)
+}
+
+func (m *MockLoadBalancerService) CreateLBHealthCheckPolicyWithContext(ctx context.Context, p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error) {
+	m.record("CreateLBHealthCheckPolicy", p)
+	if m.CreateLBHealthCheckPolicyFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "CreateLBHealthCheckPolicy")
+	}
+	return m.CreateLBHealthCheckPolicyFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) CreateLBHealthCheckPolicyAsync(ctx context.Context, p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyJob, error) {
+	m.record("CreateLBHealthCheckPolicyAsync", p)
+	if m.CreateLBHealthCheckPolicyAsyncFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "CreateLBHealthCheckPolicyAsync")
+	}
+	return m.CreateLBHealthCheckPolicyAsyncFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) NewDeleteLBHealthCheckPolicyParams(id string) *DeleteLBHealthCheckPolicyParams {
+	return (&LoadBalancerService{}).NewDeleteLBHealthCheckPolicyParams(id)
+}
+
+func (m *MockLoadBalancerService) DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error) {
+	return m.DeleteLBHealthCheckPolicyWithContext(context.Background(), p)
+}
+
+func (m *MockLoadBalancerService) DeleteLBHealthCheckPolicyWithContext(ctx context.Context, p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error) {
+	m.record("DeleteLBHealthCheckPolicy", p)
+	if m.DeleteLBHealthCheckPolicyFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "DeleteLBHealthCheckPolicy")
+	}
+	return m.DeleteLBHealthCheckPolicyFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) DeleteLBHealthCheckPolicyAsync(ctx context.Context, p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyJob, error) {
+	m.record("DeleteLBHealthCheckPolicyAsync", p)
+	if m.DeleteLBHealthCheckPolicyAsyncFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "DeleteLBHealthCheckPolicyAsync")
+	}
+	return m.DeleteLBHealthCheckPolicyAsyncFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) NewUpdateLBHealthCheckPolicyParams(id string) *UpdateLBHealthCheckPolicyParams {
+	return (&LoadBalancerService{}).NewUpdateLBHealthCheckPolicyParams(id)
+}
+
+func (m *MockLoadBalancerService) UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyResponse, error) {
+	return m.UpdateLBHealthCheckPolicyWithContext(context.Background(), p)
+}
+
+func (m *MockLoadBalancerService) UpdateLBHealthCheckPolicyWithContext(ctx context.Context, p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyResponse, error) {
+	m.record("UpdateLBHealthCheckPolicy", p)
+	if m.UpdateLBHealthCheckPolicyFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "UpdateLBHealthCheckPolicy")
+	}
+	return m.UpdateLBHealthCheckPolicyFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) UpdateLBHealthCheckPolicyAsync(ctx context.Context, p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyJob, error) {
+	m.record("UpdateLBHealthCheckPolicyAsync", p)
+	if m.UpdateLBHealthCheckPolicyAsyncFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "UpdateLBHealthCheckPolicyAsync")
+	}
+	return m.UpdateLBHealthCheckPolicyAsyncFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) NewListLBStickinessPoliciesParams() *ListLBStickinessPoliciesParams {
+	return (&LoadBalancerService{}).NewListLBStickinessPoliciesParams()
+}
+
+func (m *MockLoadBalancerService) GetLBStickinessPolicyByID(id string, opts ...OptionFunc) (*LBStickinessPolicy, int, error) {
+	m.record("GetLBStickinessPolicyByID", id, opts)
+	if m.GetLBStickinessPolicyByIDFunc == nil {
+		return nil, -1, notMocked("MockLoadBalancerService", "GetLBStickinessPolicyByID")
+	}
+	return m.GetLBStickinessPolicyByIDFunc(id, opts...)
+}
+
+func (m *MockLoadBalancerService) ListLBStickinessPolicies(p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error) {
+	return m.ListLBStickinessPoliciesWithContext(context.Background(), p)
+}
+
+func (m *MockLoadBalancerService) ListLBStickinessPoliciesWithContext(ctx context.Context, p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error) {
+	m.record("ListLBStickinessPolicies", p)
+	if m.ListLBStickinessPoliciesFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "ListLBStickinessPolicies")
+	}
+	return m.ListLBStickinessPoliciesFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) NewListLBStickinessPoliciesIterator(p *ListLBStickinessPoliciesParams, pagesize int) *ListLBStickinessPoliciesIterator {
+	i := &ListLBStickinessPoliciesIterator{pager: newPager(nil, "listLBStickinessPolicies", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListLBStickinessPoliciesParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListLBStickinessPoliciesWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockLoadBalancerService) NewCreateLBStickinessPolicyParams(lbruleid string, methodname string, name string) *CreateLBStickinessPolicyParams {
+	return (&LoadBalancerService{}).NewCreateLBStickinessPolicyParams(lbruleid, methodname, name)
+}
+
+func (m *MockLoadBalancerService) CreateLBStickinessPolicy(p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error) {
+	return m.CreateLBStickinessPolicyWithContext(context.Background(), p)
+}
+
+func (m *MockLoadBalancerService) CreateLBStickinessPolicyWithContext(ctx context.Context, p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error) {
+	m.record("CreateLBStickinessPolicy", p)
+	if m.CreateLBStickinessPolicyFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "CreateLBStickinessPolicy")
+	}
+	return m.CreateLBStickinessPolicyFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) CreateLBStickinessPolicyAsync(ctx context.Context, p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyJob, error) {
+	m.record("CreateLBStickinessPolicyAsync", p)
+	if m.CreateLBStickinessPolicyAsyncFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "CreateLBStickinessPolicyAsync")
+	}
+	return m.CreateLBStickinessPolicyAsyncFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) NewDeleteLBStickinessPolicyParams(id string) *DeleteLBStickinessPolicyParams {
+	return (&LoadBalancerService{}).NewDeleteLBStickinessPolicyParams(id)
+}
+
+func (m *MockLoadBalancerService) DeleteLBStickinessPolicy(p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error) {
+	return m.DeleteLBStickinessPolicyWithContext(context.Background(), p)
+}
+
+func (m *MockLoadBalancerService) DeleteLBStickinessPolicyWithContext(ctx context.Context, p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error) {
+	m.record("DeleteLBStickinessPolicy", p)
+	if m.DeleteLBStickinessPolicyFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "DeleteLBStickinessPolicy")
+	}
+	return m.DeleteLBStickinessPolicyFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) DeleteLBStickinessPolicyAsync(ctx context.Context, p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyJob, error) {
+	m.record("DeleteLBStickinessPolicyAsync", p)
+	if m.DeleteLBStickinessPolicyAsyncFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "DeleteLBStickinessPolicyAsync")
+	}
+	return m.DeleteLBStickinessPolicyAsyncFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) NewUpdateLBStickinessPolicyParams(id string) *UpdateLBStickinessPolicyParams {
+	return (&LoadBalancerService{}).NewUpdateLBStickinessPolicyParams(id)
+}
+
+func (m *MockLoadBalancerService) UpdateLBStickinessPolicy(p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyResponse, error) {
+	return m.UpdateLBStickinessPolicyWithContext(context.Background(), p)
+}
+
+func (m *MockLoadBalancerService) UpdateLBStickinessPolicyWithContext(ctx context.Context, p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyResponse, error) {
+	m.record("UpdateLBStickinessPolicy", p)
+	if m.UpdateLBStickinessPolicyFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "UpdateLBStickinessPolicy")
+	}
+	return m.UpdateLBStickinessPolicyFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) UpdateLBStickinessPolicyAsync(ctx context.Context, p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyJob, error) {
+	m.record("UpdateLBStickinessPolicyAsync", p)
+	if m.UpdateLBStickinessPolicyAsyncFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "UpdateLBStickinessPolicyAsync")
+	}
+	return m.UpdateLBStickinessPolicyAsyncFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) NewCreateLoadBalancerRuleParams(algorithm string, name string, privateport int, publicport int) *CreateLoadBalancerRuleParams {
+	return (&LoadBalancerService{}).NewCreateLoadBalancerRuleParams(algorithm, name, privateport, publicport)
+}
+
+func (m *MockLoadBalancerService) CreateLoadBalancerRule(p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error) {
+	return m.CreateLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+func (m *MockLoadBalancerService) CreateLoadBalancerRuleWithContext(ctx context.Context, p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error) {
+	m.record("CreateLoadBalancerRule", p)
+	if m.CreateLoadBalancerRuleFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "CreateLoadBalancerRule")
+	}
+	return m.CreateLoadBalancerRuleFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) CreateLoadBalancerRuleAsync(ctx context.Context, p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleJob, error) {
+	m.record("CreateLoadBalancerRuleAsync", p)
+	if m.CreateLoadBalancerRuleAsyncFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "CreateLoadBalancerRuleAsync")
+	}
+	return m.CreateLoadBalancerRuleAsyncFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) NewDeleteLoadBalancerRuleParams(id string) *DeleteLoadBalancerRuleParams {
+	return (&LoadBalancerService{}).NewDeleteLoadBalancerRuleParams(id)
+}
+
+func (m *MockLoadBalancerService) DeleteLoadBalancerRule(p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error) {
+	return m.DeleteLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+func (m *MockLoadBalancerService) DeleteLoadBalancerRuleWithContext(ctx context.Context, p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error) {
+	m.record("DeleteLoadBalancerRule", p)
+	if m.DeleteLoadBalancerRuleFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "DeleteLoadBalancerRule")
+	}
+	return m.DeleteLoadBalancerRuleFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) DeleteLoadBalancerRuleAsync(ctx context.Context, p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleJob, error) {
+	m.record("DeleteLoadBalancerRuleAsync", p)
+	if m.DeleteLoadBalancerRuleAsyncFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "DeleteLoadBalancerRuleAsync")
+	}
+	return m.DeleteLoadBalancerRuleAsyncFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) NewUpdateLoadBalancerRuleParams(id string) *UpdateLoadBalancerRuleParams {
+	return (&LoadBalancerService{}).NewUpdateLoadBalancerRuleParams(id)
+}
+
+func (m *MockLoadBalancerService) UpdateLoadBalancerRule(p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleResponse, error) {
+	return m.UpdateLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+func (m *MockLoadBalancerService) UpdateLoadBalancerRuleWithContext(ctx context.Context, p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleResponse, error) {
+	m.record("UpdateLoadBalancerRule", p)
+	if m.UpdateLoadBalancerRuleFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "UpdateLoadBalancerRule")
+	}
+	return m.UpdateLoadBalancerRuleFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) UpdateLoadBalancerRuleAsync(ctx context.Context, p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleJob, error) {
+	m.record("UpdateLoadBalancerRuleAsync", p)
+	if m.UpdateLoadBalancerRuleAsyncFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "UpdateLoadBalancerRuleAsync")
+	}
+	return m.UpdateLoadBalancerRuleAsyncFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) NewListLoadBalancerRuleInstancesParams(id string) *ListLoadBalancerRuleInstancesParams {
+	return (&LoadBalancerService{}).NewListLoadBalancerRuleInstancesParams(id)
+}
+
+func (m *MockLoadBalancerService) GetLoadBalancerRuleInstanceByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error) {
+	m.record("GetLoadBalancerRuleInstanceByID", id, opts)
+	if m.GetLoadBalancerRuleInstanceByIDFunc == nil {
+		return nil, -1, notMocked("MockLoadBalancerService", "GetLoadBalancerRuleInstanceByID")
+	}
+	return m.GetLoadBalancerRuleInstanceByIDFunc(id, opts...)
+}
+
+func (m *MockLoadBalancerService) ListLoadBalancerRuleInstances(p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error) {
+	return m.ListLoadBalancerRuleInstancesWithContext(context.Background(), p)
+}
+
+func (m *MockLoadBalancerService) ListLoadBalancerRuleInstancesWithContext(ctx context.Context, p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error) {
+	m.record("ListLoadBalancerRuleInstances", p)
+	if m.ListLoadBalancerRuleInstancesFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "ListLoadBalancerRuleInstances")
+	}
+	return m.ListLoadBalancerRuleInstancesFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) NewListLoadBalancerRuleInstancesIterator(p *ListLoadBalancerRuleInstancesParams, pagesize int) *ListLoadBalancerRuleInstancesIterator {
+	i := &ListLoadBalancerRuleInstancesIterator{pager: newPager(nil, "listLoadBalancerRuleInstances", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListLoadBalancerRuleInstancesParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListLoadBalancerRuleInstancesWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockLoadBalancerService) NewListLoadBalancerRulesParams() *ListLoadBalancerRulesParams {
+	return (&LoadBalancerService{}).NewListLoadBalancerRulesParams()
+}
+
+func (m *MockLoadBalancerService) GetLoadBalancerRuleID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetLoadBalancerRuleID", name, opts)
+	if m.GetLoadBalancerRuleIDFunc == nil {
+		return "", -1, notMocked("MockLoadBalancerService", "GetLoadBalancerRuleID")
+	}
+	return m.GetLoadBalancerRuleIDFunc(name, opts...)
+}
+
+func (m *MockLoadBalancerService) GetLoadBalancerRuleByName(name string, opts ...OptionFunc) (*LoadBalancerRule, int, error) {
+	m.record("GetLoadBalancerRuleByName", name, opts)
+	if m.GetLoadBalancerRuleByNameFunc == nil {
+		return nil, -1, notMocked("MockLoadBalancerService", "GetLoadBalancerRuleByName")
+	}
+	return m.GetLoadBalancerRuleByNameFunc(name, opts...)
+}
+
+func (m *MockLoadBalancerService) GetLoadBalancerRuleByID(id string, opts ...OptionFunc) (*LoadBalancerRule, int, error) {
+	m.record("GetLoadBalancerRuleByID", id, opts)
+	if m.GetLoadBalancerRuleByIDFunc == nil {
+		return nil, -1, notMocked("MockLoadBalancerService", "GetLoadBalancerRuleByID")
+	}
+	return m.GetLoadBalancerRuleByIDFunc(id, opts...)
+}
+
+func (m *MockLoadBalancerService) ListLoadBalancerRules(p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error) {
+	return m.ListLoadBalancerRulesWithContext(context.Background(), p)
+}
+
+func (m *MockLoadBalancerService) ListLoadBalancerRulesWithContext(ctx context.Context, p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error) {
+	m.record("ListLoadBalancerRules", p)
+	if m.ListLoadBalancerRulesFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "ListLoadBalancerRules")
+	}
+	return m.ListLoadBalancerRulesFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) NewListLoadBalancerRulesIterator(p *ListLoadBalancerRulesParams, pagesize int) *ListLoadBalancerRulesIterator {
+	i := &ListLoadBalancerRulesIterator{pager: newPager(nil, "listLoadBalancerRules", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListLoadBalancerRulesParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListLoadBalancerRulesWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockLoadBalancerService) NewDeleteSslCertParams(id string) *DeleteSslCertParams {
+	return (&LoadBalancerService{}).NewDeleteSslCertParams(id)
+}
+
+func (m *MockLoadBalancerService) DeleteSslCert(p *DeleteSslCertParams) (*DeleteSslCertResponse, error) {
+	return m.DeleteSslCertWithContext(context.Background(), p)
+}
+
+func (m *MockLoadBalancerService) DeleteSslCertWithContext(ctx context.Context, p *DeleteSslCertParams) (*DeleteSslCertResponse, error) {
+	m.record("DeleteSslCert", p)
+	if m.DeleteSslCertFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "DeleteSslCert")
+	}
+	return m.DeleteSslCertFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) NewUploadSslCertParams(certificate string, privatekey string) *UploadSslCertParams {
+	return (&LoadBalancerService{}).NewUploadSslCertParams(certificate, privatekey)
+}
+
+func (m *MockLoadBalancerService) UploadSslCert(p *UploadSslCertParams) (*UploadSslCertResponse, error) {
+	return m.UploadSslCertWithContext(context.Background(), p)
+}
+
+func (m *MockLoadBalancerService) UploadSslCertWithContext(ctx context.Context, p *UploadSslCertParams) (*UploadSslCertResponse, error) {
+	m.record("UploadSslCert", p)
+	if m.UploadSslCertFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "UploadSslCert")
+	}
+	return m.UploadSslCertFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) NewListSslCertsParams() *ListSslCertsParams {
+	return (&LoadBalancerService{}).NewListSslCertsParams()
+}
+
+func (m *MockLoadBalancerService) ListSslCerts(p *ListSslCertsParams) (*ListSslCertsResponse, error) {
+	return m.ListSslCertsWithContext(context.Background(), p)
+}
+
+func (m *MockLoadBalancerService) ListSslCertsWithContext(ctx context.Context, p *ListSslCertsParams) (*ListSslCertsResponse, error) {
+	m.record("ListSslCerts", p)
+	if m.ListSslCertsFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "ListSslCerts")
+	}
+	return m.ListSslCertsFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) NewAssignToLoadBalancerRuleParams(id string) *AssignToLoadBalancerRuleParams {
+	return (&LoadBalancerService{}).NewAssignToLoadBalancerRuleParams(id)
+}
+
+func (m *MockLoadBalancerService) AssignToLoadBalancerRule(p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error) {
+	return m.AssignToLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+func (m *MockLoadBalancerService) AssignToLoadBalancerRuleWithContext(ctx context.Context, p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error) {
+	m.record("AssignToLoadBalancerRule", p)
+	if m.AssignToLoadBalancerRuleFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "AssignToLoadBalancerRule")
+	}
+	return m.AssignToLoadBalancerRuleFunc(ctx, p)
+}
+
+func (m *MockLoadBalancerService) AssignToLoadBalancerRuleAsync(ctx context.Context, p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleJob, error) {
+	m.record("AssignToLoadBalancerRuleAsync", p)
+	if m.AssignToLoadBalancerRuleAsyncFunc == nil {
+		return nil, notMocked("MockLoadBalancerService", "AssignToLoadBalancerRuleAsync")
+	}
+	return m.AssignToLoadBalancerRuleAsyncFunc(ctx, p)
+}
@@ -0,0 +1,450 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestRemoveCertFromLoadBalancerParams(t *testing.T) {
+	p := &RemoveCertFromLoadBalancerParams{}
+	want := url.Values{}
+	p.SetLbruleid("lbruleid-value")
+	want.Set("lbruleid", "lbruleid-value")
+
+	assertParams(t, p, "removeCertFromLoadBalancer", want)
+}
+
+func TestRemoveCertFromLoadBalancerResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &RemoveCertFromLoadBalancerResponse{})
+}
+
+func TestAssignCertToLoadBalancerParams(t *testing.T) {
+	p := &AssignCertToLoadBalancerParams{}
+	want := url.Values{}
+	p.SetCertid("certid-value")
+	want.Set("certid", "certid-value")
+	p.SetLbruleid("lbruleid-value")
+	want.Set("lbruleid", "lbruleid-value")
+
+	assertParams(t, p, "assignCertToLoadBalancer", want)
+}
+
+func TestAssignCertToLoadBalancerResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &AssignCertToLoadBalancerResponse{})
+}
+
+func TestRemoveFromLoadBalancerRuleParams(t *testing.T) {
+	p := &RemoveFromLoadBalancerRuleParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetVirtualmachineids([]string{"a", "b"})
+	want.Set("virtualmachineids", "a,b")
+	p.SetVmidipmap(map[string]string{"k": "v"})
+	want.Set("vmidipmap[0].key", "k")
+	want.Set("vmidipmap[0].value", "v")
+
+	assertParams(t, p, "removeFromLoadBalancerRule", want)
+}
+
+func TestRemoveFromLoadBalancerRuleResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &RemoveFromLoadBalancerRuleResponse{})
+}
+
+func TestListLBHealthCheckPoliciesParams(t *testing.T) {
+	p := &ListLBHealthCheckPoliciesParams{}
+	want := url.Values{}
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetLbruleid("lbruleid-value")
+	want.Set("lbruleid", "lbruleid-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+
+	assertParams(t, p, "listLBHealthCheckPolicies", want)
+}
+
+func TestListLBHealthCheckPoliciesResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"lbhealthcheckpolicy":[{"account":"account-value","domain":"domain-value","domainid":"domainid-value","healthcheckpolicy":[{"description":"description-value","fordisplay":true,"healthcheckinterval":42,"healthcheckthresshold":42,"id":"id-value","pingpath":"pingpath-value","responsetime":42,"state":"state-value","unhealthcheckthresshold":42}],"lbruleid":"lbruleid-value","zoneid":"zoneid-value"}]}`, &ListLBHealthCheckPoliciesResponse{})
+}
+
+func TestCreateLBHealthCheckPolicyParams(t *testing.T) {
+	p := &CreateLBHealthCheckPolicyParams{}
+	want := url.Values{}
+	p.SetDescription("description-value")
+	want.Set("description", "description-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetHealthythreshold(42)
+	want.Set("healthythreshold", "42")
+	p.SetIntervaltime(42)
+	want.Set("intervaltime", "42")
+	p.SetLbruleid("lbruleid-value")
+	want.Set("lbruleid", "lbruleid-value")
+	p.SetPingpath("pingpath-value")
+	want.Set("pingpath", "pingpath-value")
+	p.SetResponsetimeout(42)
+	want.Set("responsetimeout", "42")
+	p.SetUnhealthythreshold(42)
+	want.Set("unhealthythreshold", "42")
+
+	assertParams(t, p, "createLBHealthCheckPolicy", want)
+}
+
+func TestCreateLBHealthCheckPolicyResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","domain":"domain-value","domainid":"domainid-value","healthcheckpolicy":[{"description":"description-value","fordisplay":true,"healthcheckinterval":42,"healthcheckthresshold":42,"id":"id-value","pingpath":"pingpath-value","responsetime":42,"state":"state-value","unhealthcheckthresshold":42}],"jobid":"jobid-value","lbruleid":"lbruleid-value","zoneid":"zoneid-value"}`, &CreateLBHealthCheckPolicyResponse{})
+}
+
+func TestDeleteLBHealthCheckPolicyParams(t *testing.T) {
+	p := &DeleteLBHealthCheckPolicyParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteLBHealthCheckPolicy", want)
+}
+
+func TestDeleteLBHealthCheckPolicyResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteLBHealthCheckPolicyResponse{})
+}
+
+func TestUpdateLBHealthCheckPolicyParams(t *testing.T) {
+	p := &UpdateLBHealthCheckPolicyParams{}
+	want := url.Values{}
+	p.SetCustomid("customid-value")
+	want.Set("customid", "customid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "updateLBHealthCheckPolicy", want)
+}
+
+func TestUpdateLBHealthCheckPolicyResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","domain":"domain-value","domainid":"domainid-value","healthcheckpolicy":[{"description":"description-value","fordisplay":true,"healthcheckinterval":42,"healthcheckthresshold":42,"id":"id-value","pingpath":"pingpath-value","responsetime":42,"state":"state-value","unhealthcheckthresshold":42}],"jobid":"jobid-value","lbruleid":"lbruleid-value","zoneid":"zoneid-value"}`, &UpdateLBHealthCheckPolicyResponse{})
+}
+
+func TestListLBStickinessPoliciesParams(t *testing.T) {
+	p := &ListLBStickinessPoliciesParams{}
+	want := url.Values{}
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetLbruleid("lbruleid-value")
+	want.Set("lbruleid", "lbruleid-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+
+	assertParams(t, p, "listLBStickinessPolicies", want)
+}
+
+func TestListLBStickinessPoliciesResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"lbstickinesspolicy":[{"account":"account-value","description":"description-value","domain":"domain-value","domainid":"domainid-value","lbruleid":"lbruleid-value","name":"name-value","state":"state-value","stickinesspolicy":[{"description":"description-value","fordisplay":true,"id":"id-value","methodname":"methodname-value","name":"name-value","params":{"key":"value"},"state":"state-value"}],"zoneid":"zoneid-value"}]}`, &ListLBStickinessPoliciesResponse{})
+}
+
+func TestCreateLBStickinessPolicyParams(t *testing.T) {
+	p := &CreateLBStickinessPolicyParams{}
+	want := url.Values{}
+	p.SetDescription("description-value")
+	want.Set("description", "description-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetLbruleid("lbruleid-value")
+	want.Set("lbruleid", "lbruleid-value")
+	p.SetMethodname("methodname-value")
+	want.Set("methodname", "methodname-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetParam(map[string]string{"k": "v"})
+	want.Set("param[0].key", "k")
+	want.Set("param[0].value", "v")
+
+	assertParams(t, p, "createLBStickinessPolicy", want)
+}
+
+func TestCreateLBStickinessPolicyResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","description":"description-value","domain":"domain-value","domainid":"domainid-value","jobid":"jobid-value","lbruleid":"lbruleid-value","name":"name-value","state":"state-value","stickinesspolicy":[{"description":"description-value","fordisplay":true,"id":"id-value","methodname":"methodname-value","name":"name-value","params":{"key":"value"},"state":"state-value"}],"zoneid":"zoneid-value"}`, &CreateLBStickinessPolicyResponse{})
+}
+
+func TestDeleteLBStickinessPolicyParams(t *testing.T) {
+	p := &DeleteLBStickinessPolicyParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteLBStickinessPolicy", want)
+}
+
+func TestDeleteLBStickinessPolicyResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteLBStickinessPolicyResponse{})
+}
+
+func TestUpdateLBStickinessPolicyParams(t *testing.T) {
+	p := &UpdateLBStickinessPolicyParams{}
+	want := url.Values{}
+	p.SetCustomid("customid-value")
+	want.Set("customid", "customid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "updateLBStickinessPolicy", want)
+}
+
+func TestUpdateLBStickinessPolicyResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","description":"description-value","domain":"domain-value","domainid":"domainid-value","jobid":"jobid-value","lbruleid":"lbruleid-value","name":"name-value","state":"state-value","stickinesspolicy":[{"description":"description-value","fordisplay":true,"id":"id-value","methodname":"methodname-value","name":"name-value","params":{"key":"value"},"state":"state-value"}],"zoneid":"zoneid-value"}`, &UpdateLBStickinessPolicyResponse{})
+}
+
+func TestCreateLoadBalancerRuleParams(t *testing.T) {
+	p := &CreateLoadBalancerRuleParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetAlgorithm("algorithm-value")
+	want.Set("algorithm", "algorithm-value")
+	p.SetCidrlist([]string{"a", "b"})
+	want.Set("cidrlist", "a,b")
+	p.SetClienttimeout(42)
+	want.Set("clienttimeout", "42")
+	p.SetDescription("description-value")
+	want.Set("description", "description-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetNetworkid("networkid-value")
+	want.Set("networkid", "networkid-value")
+	p.SetOpenfirewall(true)
+	want.Set("openfirewall", "true")
+	p.SetPrivateport(42)
+	want.Set("privateport", "42")
+	p.SetProtocol("protocol-value")
+	want.Set("protocol", "protocol-value")
+	p.SetPublicipid("publicipid-value")
+	want.Set("publicipid", "publicipid-value")
+	p.SetPublicport(42)
+	want.Set("publicport", "42")
+	p.SetServertimeout(42)
+	want.Set("servertimeout", "42")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "createLoadBalancerRule", want)
+}
+
+func TestCreateLoadBalancerRuleResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","algorithm":"algorithm-value","cidrlist":"cidrlist-value","clienttimeout":42,"description":"description-value","domain":"domain-value","domainid":"domainid-value","fordisplay":true,"id":"id-value","jobid":"jobid-value","name":"name-value","networkid":"networkid-value","privateport":"privateport-value","project":"project-value","projectid":"projectid-value","protocol":"protocol-value","publicip":"publicip-value","publicipid":"publicipid-value","publicport":"publicport-value","servertimeout":42,"state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"zoneid":"zoneid-value"}`, &CreateLoadBalancerRuleResponse{})
+}
+
+func TestDeleteLoadBalancerRuleParams(t *testing.T) {
+	p := &DeleteLoadBalancerRuleParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteLoadBalancerRule", want)
+}
+
+func TestDeleteLoadBalancerRuleResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteLoadBalancerRuleResponse{})
+}
+
+func TestUpdateLoadBalancerRuleParams(t *testing.T) {
+	p := &UpdateLoadBalancerRuleParams{}
+	want := url.Values{}
+	p.SetAlgorithm("algorithm-value")
+	want.Set("algorithm", "algorithm-value")
+	p.SetClienttimeout(42)
+	want.Set("clienttimeout", "42")
+	p.SetCustomid("customid-value")
+	want.Set("customid", "customid-value")
+	p.SetDescription("description-value")
+	want.Set("description", "description-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetServertimeout(42)
+	want.Set("servertimeout", "42")
+
+	assertParams(t, p, "updateLoadBalancerRule", want)
+}
+
+func TestUpdateLoadBalancerRuleResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","algorithm":"algorithm-value","cidrlist":"cidrlist-value","clienttimeout":42,"description":"description-value","domain":"domain-value","domainid":"domainid-value","fordisplay":true,"id":"id-value","jobid":"jobid-value","name":"name-value","networkid":"networkid-value","privateport":"privateport-value","project":"project-value","projectid":"projectid-value","protocol":"protocol-value","publicip":"publicip-value","publicipid":"publicipid-value","publicport":"publicport-value","servertimeout":42,"state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"zoneid":"zoneid-value"}`, &UpdateLoadBalancerRuleResponse{})
+}
+
+func TestListLoadBalancerRuleInstancesParams(t *testing.T) {
+	p := &ListLoadBalancerRuleInstancesParams{}
+	want := url.Values{}
+	p.SetApplied(true)
+	want.Set("applied", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetLbvmips(true)
+	want.Set("lbvmips", "true")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+
+	assertParams(t, p, "listLoadBalancerRuleInstances", want)
+}
+
+func TestListLoadBalancerRuleInstancesResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"lbrulevmidip":[{"lbvmipaddresses":["a","b"],"loadbalancerruleinstance":{"id":"id-value"}}]}`, &ListLoadBalancerRuleInstancesResponse{})
+}
+
+func TestListLoadBalancerRulesParams(t *testing.T) {
+	p := &ListLoadBalancerRulesParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetNetworkid("networkid-value")
+	want.Set("networkid", "networkid-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetPublicipid("publicipid-value")
+	want.Set("publicipid", "publicipid-value")
+	p.SetTags(map[string]string{"k": "v"})
+	want.Set("tags[0].key", "k")
+	want.Set("tags[0].value", "v")
+	p.SetVirtualmachineid("virtualmachineid-value")
+	want.Set("virtualmachineid", "virtualmachineid-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listLoadBalancerRules", want)
+}
+
+func TestListLoadBalancerRulesResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"loadbalancerrule":[{"account":"account-value","algorithm":"algorithm-value","cidrlist":"cidrlist-value","clienttimeout":42,"description":"description-value","domain":"domain-value","domainid":"domainid-value","fordisplay":true,"id":"id-value","name":"name-value","networkid":"networkid-value","privateport":"privateport-value","project":"project-value","projectid":"projectid-value","protocol":"protocol-value","publicip":"publicip-value","publicipid":"publicipid-value","publicport":"publicport-value","servertimeout":42,"state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"zoneid":"zoneid-value"}]}`, &ListLoadBalancerRulesResponse{})
+}
+
+func TestDeleteSslCertParams(t *testing.T) {
+	p := &DeleteSslCertParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteSslCert", want)
+}
+
+func TestDeleteSslCertResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &DeleteSslCertResponse{})
+}
+
+func TestUploadSslCertParams(t *testing.T) {
+	p := &UploadSslCertParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetCertchain("certchain-value")
+	want.Set("certchain", "certchain-value")
+	p.SetCertificate("certificate-value")
+	want.Set("certificate", "certificate-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetPassword("password-value")
+	want.Set("password", "password-value")
+	p.SetPrivatekey("privatekey-value")
+	want.Set("privatekey", "privatekey-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+
+	assertParams(t, p, "uploadSslCert", want)
+}
+
+func TestUploadSslCertResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","certchain":"certchain-value","certificate":"certificate-value","domain":"domain-value","domainid":"domainid-value","fingerprint":"fingerprint-value","id":"id-value","loadbalancerrulelist":["a","b"],"project":"project-value","projectid":"projectid-value"}`, &UploadSslCertResponse{})
+}
+
+func TestListSslCertsParams(t *testing.T) {
+	p := &ListSslCertsParams{}
+	want := url.Values{}
+	p.SetAccountid("accountid-value")
+	want.Set("accountid", "accountid-value")
+	p.SetCertid("certid-value")
+	want.Set("certid", "certid-value")
+	p.SetLbruleid("lbruleid-value")
+	want.Set("lbruleid", "lbruleid-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+
+	assertParams(t, p, "listSslCerts", want)
+}
+
+func TestListSslCertsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"sslcert":[{"account":"account-value","certchain":"certchain-value","certificate":"certificate-value","domain":"domain-value","domainid":"domainid-value","fingerprint":"fingerprint-value","id":"id-value","loadbalancerrulelist":["a","b"],"project":"project-value","projectid":"projectid-value"}]}`, &ListSslCertsResponse{})
+}
+
+func TestAssignToLoadBalancerRuleParams(t *testing.T) {
+	p := &AssignToLoadBalancerRuleParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetVirtualmachineids([]string{"a", "b"})
+	want.Set("virtualmachineids", "a,b")
+	p.SetVmidipmap(map[string]string{"k": "v"})
+	want.Set("vmidipmap[0].key", "k")
+	want.Set("vmidipmap[0].value", "v")
+
+	assertParams(t, p, "assignToLoadBalancerRule", want)
+}
+
+func TestAssignToLoadBalancerRuleResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &AssignToLoadBalancerRuleResponse{})
+}
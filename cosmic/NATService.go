@@ -17,17 +17,47 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 )
 
+// NATServiceIface is the interface implemented by NATService, and by MockNATService for tests
+type NATServiceIface interface {
+	NewCreateIpForwardingRuleParams(ipaddressid string, protocol string, startport int) *CreateIpForwardingRuleParams
+	CreateIpForwardingRule(p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleResponse, error)
+	CreateIpForwardingRuleWithContext(ctx context.Context, p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleResponse, error)
+	CreateIpForwardingRuleAsync(ctx context.Context, p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleJob, error)
+	NewDeleteIpForwardingRuleParams(id string) *DeleteIpForwardingRuleParams
+	DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleResponse, error)
+	DeleteIpForwardingRuleWithContext(ctx context.Context, p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleResponse, error)
+	DeleteIpForwardingRuleAsync(ctx context.Context, p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleJob, error)
+	NewListIpForwardingRulesParams() *ListIpForwardingRulesParams
+	GetIpForwardingRuleByID(id string, opts ...OptionFunc) (*IpForwardingRule, int, error)
+	ListIpForwardingRules(p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error)
+	ListIpForwardingRulesWithContext(ctx context.Context, p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error)
+	NewListIpForwardingRulesIterator(p *ListIpForwardingRulesParams, pagesize int) *ListIpForwardingRulesIterator
+	NewDisableStaticNatParams(ipaddressid string) *DisableStaticNatParams
+	DisableStaticNat(p *DisableStaticNatParams) (*DisableStaticNatResponse, error)
+	DisableStaticNatWithContext(ctx context.Context, p *DisableStaticNatParams) (*DisableStaticNatResponse, error)
+	DisableStaticNatAsync(ctx context.Context, p *DisableStaticNatParams) (*DisableStaticNatJob, error)
+	NewEnableStaticNatParams(ipaddressid string, virtualmachineid string) *EnableStaticNatParams
+	EnableStaticNat(p *EnableStaticNatParams) (*EnableStaticNatResponse, error)
+	EnableStaticNatWithContext(ctx context.Context, p *EnableStaticNatParams) (*EnableStaticNatResponse, error)
+}
+
 type CreateIpForwardingRuleParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateIpForwardingRuleParams) command() string {
+	return "createIpForwardingRule"
+}
+
 func (p *CreateIpForwardingRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -113,7 +143,13 @@ func (s *NATService) NewCreateIpForwardingRuleParams(ipaddressid string, protoco
 
 // Creates an IP forwarding rule
 func (s *NATService) CreateIpForwardingRule(p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("createIpForwardingRule", p.toURLValues())
+	return s.CreateIpForwardingRuleWithContext(context.Background(), p)
+}
+
+// CreateIpForwardingRuleWithContext is the same as CreateIpForwardingRule, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NATService) CreateIpForwardingRuleWithContext(ctx context.Context, p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createIpForwardingRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -125,26 +161,56 @@ func (s *NATService) CreateIpForwardingRule(p *CreateIpForwardingRuleParams) (*C
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createIpForwardingRule", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "ipforwardingrule", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CreateIpForwardingRuleJob is a handle to a running createIpForwardingRule async job
+type CreateIpForwardingRuleJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateIpForwardingRuleResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateIpForwardingRuleJob) Result() (*CreateIpForwardingRuleResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateIpForwardingRuleResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "ipforwardingrule", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CreateIpForwardingRuleAsync starts the createIpForwardingRule async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NATService) CreateIpForwardingRuleAsync(ctx context.Context, p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createIpForwardingRule", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateIpForwardingRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateIpForwardingRuleJob{s.cs.newJobHandle("createIpForwardingRule", r.JobID)}, nil
+}
+
 type CreateIpForwardingRuleResponse struct {
 	JobID          string `json:"jobid,omitempty"`
 	Cidrlist       string `json:"cidrlist,omitempty"`
@@ -181,6 +247,10 @@ type DeleteIpForwardingRuleParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteIpForwardingRuleParams) command() string {
+	return "deleteIpForwardingRule"
+}
+
 func (p *DeleteIpForwardingRuleParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -210,7 +280,13 @@ func (s *NATService) NewDeleteIpForwardingRuleParams(id string) *DeleteIpForward
 
 // Deletes an IP forwarding rule
 func (s *NATService) DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteIpForwardingRule", p.toURLValues())
+	return s.DeleteIpForwardingRuleWithContext(context.Background(), p)
+}
+
+// DeleteIpForwardingRuleWithContext is the same as DeleteIpForwardingRule, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NATService) DeleteIpForwardingRuleWithContext(ctx context.Context, p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteIpForwardingRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -222,9 +298,9 @@ func (s *NATService) DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams) (*D
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteIpForwardingRule", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -237,6 +313,41 @@ func (s *NATService) DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams) (*D
 	return &r, nil
 }
 
+// DeleteIpForwardingRuleJob is a handle to a running deleteIpForwardingRule async job
+type DeleteIpForwardingRuleJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteIpForwardingRuleResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteIpForwardingRuleJob) Result() (*DeleteIpForwardingRuleResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteIpForwardingRuleResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteIpForwardingRuleAsync starts the deleteIpForwardingRule async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NATService) DeleteIpForwardingRuleAsync(ctx context.Context, p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteIpForwardingRule", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteIpForwardingRuleResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteIpForwardingRuleJob{s.cs.newJobHandle("deleteIpForwardingRule", r.JobID)}, nil
+}
+
 type DeleteIpForwardingRuleResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -247,6 +358,10 @@ type ListIpForwardingRulesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListIpForwardingRulesParams) command() string {
+	return "listIpForwardingRules"
+}
+
 func (p *ListIpForwardingRulesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -392,16 +507,14 @@ func (s *NATService) GetIpForwardingRuleByID(id string, opts ...OptionFunc) (*Ip
 
 	l, err := s.ListIpForwardingRules(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -413,10 +526,17 @@ func (s *NATService) GetIpForwardingRuleByID(id string, opts ...OptionFunc) (*Ip
 
 // List the IP forwarding rules
 func (s *NATService) ListIpForwardingRules(p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error) {
+	return s.ListIpForwardingRulesWithContext(context.Background(), p)
+}
+
+// ListIpForwardingRulesWithContext is the same as ListIpForwardingRules, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NATService) ListIpForwardingRulesWithContext(ctx context.Context, p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error) {
 	var r ListIpForwardingRulesResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListIpForwardingRulesResponse
-		resp, err := s.cs.newRequest("listIpForwardingRules", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listIpForwardingRules", values)
 		if err != nil {
 			return nil, err
 		}
@@ -428,13 +548,89 @@ func (s *NATService) ListIpForwardingRules(p *ListIpForwardingRulesParams) (*Lis
 		r.Count = l.Count
 		r.IpForwardingRules = append(r.IpForwardingRules, l.IpForwardingRules...)
 
-		if r.Count == len(r.IpForwardingRules) {
+		if r.Count == len(r.IpForwardingRules) || len(l.IpForwardingRules) == 0 {
+			s.cs.observePages("listIpForwardingRules", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.IpForwardingRules))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.IpForwardingRules)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listIpForwardingRules", values, len(l.IpForwardingRules), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListIpForwardingRulesResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.IpForwardingRules = append(r.IpForwardingRules, l.IpForwardingRules...)
+			}
+
+			if r.Count == len(r.IpForwardingRules) {
+				s.cs.observePages("listIpForwardingRules", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListIpForwardingRulesIterator iterates over the items returned by listIpForwardingRules, requesting
+// the pages of the response one at a time while iterating.
+type ListIpForwardingRulesIterator struct {
+	pager
+	items []*IpForwardingRule
+	index int
+}
+
+// NewListIpForwardingRulesIterator returns an iterator over the items returned by listIpForwardingRules, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *NATService) NewListIpForwardingRulesIterator(p *ListIpForwardingRulesParams, pagesize int) *ListIpForwardingRulesIterator {
+	return &ListIpForwardingRulesIterator{pager: newPager(s.cs, "listIpForwardingRules", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListIpForwardingRulesIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
 	}
+
+	var l ListIpForwardingRulesResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.IpForwardingRules))
+
+	i.items = l.IpForwardingRules
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListIpForwardingRulesIterator) Value() *IpForwardingRule {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
 }
 
 type ListIpForwardingRulesResponse struct {
@@ -477,6 +673,10 @@ type DisableStaticNatParams struct {
 	p map[string]interface{}
 }
 
+func (p *DisableStaticNatParams) command() string {
+	return "disableStaticNat"
+}
+
 func (p *DisableStaticNatParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -506,7 +706,13 @@ func (s *NATService) NewDisableStaticNatParams(ipaddressid string) *DisableStati
 
 // Disables static rule for given IP address
 func (s *NATService) DisableStaticNat(p *DisableStaticNatParams) (*DisableStaticNatResponse, error) {
-	resp, err := s.cs.newRequest("disableStaticNat", p.toURLValues())
+	return s.DisableStaticNatWithContext(context.Background(), p)
+}
+
+// DisableStaticNatWithContext is the same as DisableStaticNat, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NATService) DisableStaticNatWithContext(ctx context.Context, p *DisableStaticNatParams) (*DisableStaticNatResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "disableStaticNat", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -518,9 +724,9 @@ func (s *NATService) DisableStaticNat(p *DisableStaticNatParams) (*DisableStatic
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "disableStaticNat", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -533,6 +739,41 @@ func (s *NATService) DisableStaticNat(p *DisableStaticNatParams) (*DisableStatic
 	return &r, nil
 }
 
+// DisableStaticNatJob is a handle to a running disableStaticNat async job
+type DisableStaticNatJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DisableStaticNatResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DisableStaticNatJob) Result() (*DisableStaticNatResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DisableStaticNatResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DisableStaticNatAsync starts the disableStaticNat async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NATService) DisableStaticNatAsync(ctx context.Context, p *DisableStaticNatParams) (*DisableStaticNatJob, error) {
+	resp, err := s.cs.newRequest(ctx, "disableStaticNat", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DisableStaticNatResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DisableStaticNatJob{s.cs.newJobHandle("disableStaticNat", r.JobID)}, nil
+}
+
 type DisableStaticNatResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -543,6 +784,10 @@ type EnableStaticNatParams struct {
 	p map[string]interface{}
 }
 
+func (p *EnableStaticNatParams) command() string {
+	return "enableStaticNat"
+}
+
 func (p *EnableStaticNatParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -603,7 +848,13 @@ func (s *NATService) NewEnableStaticNatParams(ipaddressid string, virtualmachine
 
 // Enables static NAT for given IP address
 func (s *NATService) EnableStaticNat(p *EnableStaticNatParams) (*EnableStaticNatResponse, error) {
-	resp, err := s.cs.newRequest("enableStaticNat", p.toURLValues())
+	return s.EnableStaticNatWithContext(context.Background(), p)
+}
+
+// EnableStaticNatWithContext is the same as EnableStaticNat, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NATService) EnableStaticNatWithContext(ctx context.Context, p *EnableStaticNatParams) (*EnableStaticNatResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "enableStaticNat", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,164 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockNATService is a mock of NATServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockNATService struct {
+	mockCalls
+
+	CreateIpForwardingRuleFunc      func(ctx context.Context, p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleResponse, error)
+	CreateIpForwardingRuleAsyncFunc func(ctx context.Context, p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleJob, error)
+	DeleteIpForwardingRuleFunc      func(ctx context.Context, p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleResponse, error)
+	DeleteIpForwardingRuleAsyncFunc func(ctx context.Context, p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleJob, error)
+	GetIpForwardingRuleByIDFunc     func(id string, opts ...OptionFunc) (*IpForwardingRule, int, error)
+	ListIpForwardingRulesFunc       func(ctx context.Context, p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error)
+	DisableStaticNatFunc            func(ctx context.Context, p *DisableStaticNatParams) (*DisableStaticNatResponse, error)
+	DisableStaticNatAsyncFunc       func(ctx context.Context, p *DisableStaticNatParams) (*DisableStaticNatJob, error)
+	EnableStaticNatFunc             func(ctx context.Context, p *EnableStaticNatParams) (*EnableStaticNatResponse, error)
+}
+
+var _ NATServiceIface = &MockNATService{}
+
+func (m *MockNATService) NewCreateIpForwardingRuleParams(ipaddressid string, protocol string, startport int) *CreateIpForwardingRuleParams {
+	return (&NATService{}).NewCreateIpForwardingRuleParams(ipaddressid, protocol, startport)
+}
+
+func (m *MockNATService) CreateIpForwardingRule(p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleResponse, error) {
+	return m.CreateIpForwardingRuleWithContext(context.Background(), p)
+}
+
+func (m *MockNATService) CreateIpForwardingRuleWithContext(ctx context.Context, p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleResponse, error) {
+	m.record("CreateIpForwardingRule", p)
+	if m.CreateIpForwardingRuleFunc == nil {
+		return nil, notMocked("MockNATService", "CreateIpForwardingRule")
+	}
+	return m.CreateIpForwardingRuleFunc(ctx, p)
+}
+
+func (m *MockNATService) CreateIpForwardingRuleAsync(ctx context.Context, p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleJob, error) {
+	m.record("CreateIpForwardingRuleAsync", p)
+	if m.CreateIpForwardingRuleAsyncFunc == nil {
+		return nil, notMocked("MockNATService", "CreateIpForwardingRuleAsync")
+	}
+	return m.CreateIpForwardingRuleAsyncFunc(ctx, p)
+}
+
+func (m *MockNATService) NewDeleteIpForwardingRuleParams(id string) *DeleteIpForwardingRuleParams {
+	return (&NATService{}).NewDeleteIpForwardingRuleParams(id)
+}
+
+func (m *MockNATService) DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleResponse, error) {
+	return m.DeleteIpForwardingRuleWithContext(context.Background(), p)
+}
+
+func (m *MockNATService) DeleteIpForwardingRuleWithContext(ctx context.Context, p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleResponse, error) {
+	m.record("DeleteIpForwardingRule", p)
+	if m.DeleteIpForwardingRuleFunc == nil {
+		return nil, notMocked("MockNATService", "DeleteIpForwardingRule")
+	}
+	return m.DeleteIpForwardingRuleFunc(ctx, p)
+}
+
+func (m *MockNATService) DeleteIpForwardingRuleAsync(ctx context.Context, p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleJob, error) {
+	m.record("DeleteIpForwardingRuleAsync", p)
+	if m.DeleteIpForwardingRuleAsyncFunc == nil {
+		return nil, notMocked("MockNATService", "DeleteIpForwardingRuleAsync")
+	}
+	return m.DeleteIpForwardingRuleAsyncFunc(ctx, p)
+}
+
+func (m *MockNATService) NewListIpForwardingRulesParams() *ListIpForwardingRulesParams {
+	return (&NATService{}).NewListIpForwardingRulesParams()
+}
+
+func (m *MockNATService) GetIpForwardingRuleByID(id string, opts ...OptionFunc) (*IpForwardingRule, int, error) {
+	m.record("GetIpForwardingRuleByID", id, opts)
+	if m.GetIpForwardingRuleByIDFunc == nil {
+		return nil, -1, notMocked("MockNATService", "GetIpForwardingRuleByID")
+	}
+	return m.GetIpForwardingRuleByIDFunc(id, opts...)
+}
+
+func (m *MockNATService) ListIpForwardingRules(p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error) {
+	return m.ListIpForwardingRulesWithContext(context.Background(), p)
+}
+
+func (m *MockNATService) ListIpForwardingRulesWithContext(ctx context.Context, p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error) {
+	m.record("ListIpForwardingRules", p)
+	if m.ListIpForwardingRulesFunc == nil {
+		return nil, notMocked("MockNATService", "ListIpForwardingRules")
+	}
+	return m.ListIpForwardingRulesFunc(ctx, p)
+}
+
+func (m *MockNATService) NewListIpForwardingRulesIterator(p *ListIpForwardingRulesParams, pagesize int) *ListIpForwardingRulesIterator {
+	i := &ListIpForwardingRulesIterator{pager: newPager(nil, "listIpForwardingRules", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListIpForwardingRulesParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListIpForwardingRulesWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockNATService) NewDisableStaticNatParams(ipaddressid string) *DisableStaticNatParams {
+	return (&NATService{}).NewDisableStaticNatParams(ipaddressid)
+}
+
+func (m *MockNATService) DisableStaticNat(p *DisableStaticNatParams) (*DisableStaticNatResponse, error) {
+	return m.DisableStaticNatWithContext(context.Background(), p)
+}
+
+func (m *MockNATService) DisableStaticNatWithContext(ctx context.Context, p *DisableStaticNatParams) (*DisableStaticNatResponse, error) {
+	m.record("DisableStaticNat", p)
+	if m.DisableStaticNatFunc == nil {
+		return nil, notMocked("MockNATService", "DisableStaticNat")
+	}
+	return m.DisableStaticNatFunc(ctx, p)
+}
+
+func (m *MockNATService) DisableStaticNatAsync(ctx context.Context, p *DisableStaticNatParams) (*DisableStaticNatJob, error) {
+	m.record("DisableStaticNatAsync", p)
+	if m.DisableStaticNatAsyncFunc == nil {
+		return nil, notMocked("MockNATService", "DisableStaticNatAsync")
+	}
+	return m.DisableStaticNatAsyncFunc(ctx, p)
+}
+
+func (m *MockNATService) NewEnableStaticNatParams(ipaddressid string, virtualmachineid string) *EnableStaticNatParams {
+	return (&NATService{}).NewEnableStaticNatParams(ipaddressid, virtualmachineid)
+}
+
+func (m *MockNATService) EnableStaticNat(p *EnableStaticNatParams) (*EnableStaticNatResponse, error) {
+	return m.EnableStaticNatWithContext(context.Background(), p)
+}
+
+func (m *MockNATService) EnableStaticNatWithContext(ctx context.Context, p *EnableStaticNatParams) (*EnableStaticNatResponse, error) {
+	m.record("EnableStaticNat", p)
+	if m.EnableStaticNatFunc == nil {
+		return nil, notMocked("MockNATService", "EnableStaticNat")
+	}
+	return m.EnableStaticNatFunc(ctx, p)
+}
@@ -0,0 +1,123 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCreateIpForwardingRuleParams(t *testing.T) {
+	p := &CreateIpForwardingRuleParams{}
+	want := url.Values{}
+	p.SetCidrlist([]string{"a", "b"})
+	want.Set("cidrlist", "a,b")
+	p.SetEndport(42)
+	want.Set("endport", "42")
+	p.SetIpaddressid("ipaddressid-value")
+	want.Set("ipaddressid", "ipaddressid-value")
+	p.SetOpenfirewall(true)
+	want.Set("openfirewall", "true")
+	p.SetProtocol("protocol-value")
+	want.Set("protocol", "protocol-value")
+	p.SetStartport(42)
+	want.Set("startport", "42")
+
+	assertParams(t, p, "createIpForwardingRule", want)
+}
+
+func TestCreateIpForwardingRuleResponse(t *testing.T) {
+	assertDecodes(t, `{"cidrlist":"cidrlist-value","fordisplay":true,"id":"id-value","ipaddress":"ipaddress-value","ipaddressid":"ipaddressid-value","jobid":"jobid-value","networkid":"networkid-value","privateendport":"privateendport-value","privateport":"privateport-value","protocol":"protocol-value","publicendport":"publicendport-value","publicport":"publicport-value","state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"virtualmachinedisplayname":"virtualmachinedisplayname-value","virtualmachineid":"virtualmachineid-value","virtualmachinename":"virtualmachinename-value","vmguestip":"vmguestip-value"}`, &CreateIpForwardingRuleResponse{})
+}
+
+func TestDeleteIpForwardingRuleParams(t *testing.T) {
+	p := &DeleteIpForwardingRuleParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteIpForwardingRule", want)
+}
+
+func TestDeleteIpForwardingRuleResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteIpForwardingRuleResponse{})
+}
+
+func TestListIpForwardingRulesParams(t *testing.T) {
+	p := &ListIpForwardingRulesParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIpaddressid("ipaddressid-value")
+	want.Set("ipaddressid", "ipaddressid-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetVirtualmachineid("virtualmachineid-value")
+	want.Set("virtualmachineid", "virtualmachineid-value")
+
+	assertParams(t, p, "listIpForwardingRules", want)
+}
+
+func TestListIpForwardingRulesResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"ipforwardingrule":[{"cidrlist":"cidrlist-value","fordisplay":true,"id":"id-value","ipaddress":"ipaddress-value","ipaddressid":"ipaddressid-value","networkid":"networkid-value","privateendport":"privateendport-value","privateport":"privateport-value","protocol":"protocol-value","publicendport":"publicendport-value","publicport":"publicport-value","state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"virtualmachinedisplayname":"virtualmachinedisplayname-value","virtualmachineid":"virtualmachineid-value","virtualmachinename":"virtualmachinename-value","vmguestip":"vmguestip-value"}]}`, &ListIpForwardingRulesResponse{})
+}
+
+func TestDisableStaticNatParams(t *testing.T) {
+	p := &DisableStaticNatParams{}
+	want := url.Values{}
+	p.SetIpaddressid("ipaddressid-value")
+	want.Set("ipaddressid", "ipaddressid-value")
+
+	assertParams(t, p, "disableStaticNat", want)
+}
+
+func TestDisableStaticNatResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DisableStaticNatResponse{})
+}
+
+func TestEnableStaticNatParams(t *testing.T) {
+	p := &EnableStaticNatParams{}
+	want := url.Values{}
+	p.SetIpaddressid("ipaddressid-value")
+	want.Set("ipaddressid", "ipaddressid-value")
+	p.SetNetworkid("networkid-value")
+	want.Set("networkid", "networkid-value")
+	p.SetVirtualmachineid("virtualmachineid-value")
+	want.Set("virtualmachineid", "virtualmachineid-value")
+	p.SetVmguestip("vmguestip-value")
+	want.Set("vmguestip", "vmguestip-value")
+
+	assertParams(t, p, "enableStaticNat", want)
+}
+
+func TestEnableStaticNatResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &EnableStaticNatResponse{})
+}
@@ -17,17 +17,67 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 )
 
+// NetworkACLServiceIface is the interface implemented by NetworkACLService, and by MockNetworkACLService for tests
+type NetworkACLServiceIface interface {
+	NewCreateNetworkACLParams(protocol string) *CreateNetworkACLParams
+	CreateNetworkACL(p *CreateNetworkACLParams) (*CreateNetworkACLResponse, error)
+	CreateNetworkACLWithContext(ctx context.Context, p *CreateNetworkACLParams) (*CreateNetworkACLResponse, error)
+	CreateNetworkACLAsync(ctx context.Context, p *CreateNetworkACLParams) (*CreateNetworkACLJob, error)
+	NewDeleteNetworkACLParams(id string) *DeleteNetworkACLParams
+	DeleteNetworkACL(p *DeleteNetworkACLParams) (*DeleteNetworkACLResponse, error)
+	DeleteNetworkACLWithContext(ctx context.Context, p *DeleteNetworkACLParams) (*DeleteNetworkACLResponse, error)
+	DeleteNetworkACLAsync(ctx context.Context, p *DeleteNetworkACLParams) (*DeleteNetworkACLJob, error)
+	NewUpdateNetworkACLItemParams(id string) *UpdateNetworkACLItemParams
+	UpdateNetworkACLItem(p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemResponse, error)
+	UpdateNetworkACLItemWithContext(ctx context.Context, p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemResponse, error)
+	UpdateNetworkACLItemAsync(ctx context.Context, p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemJob, error)
+	NewCreateNetworkACLListParams(name string, vpcid string) *CreateNetworkACLListParams
+	CreateNetworkACLList(p *CreateNetworkACLListParams) (*CreateNetworkACLListResponse, error)
+	CreateNetworkACLListWithContext(ctx context.Context, p *CreateNetworkACLListParams) (*CreateNetworkACLListResponse, error)
+	CreateNetworkACLListAsync(ctx context.Context, p *CreateNetworkACLListParams) (*CreateNetworkACLListJob, error)
+	NewDeleteNetworkACLListParams(id string) *DeleteNetworkACLListParams
+	DeleteNetworkACLList(p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error)
+	DeleteNetworkACLListWithContext(ctx context.Context, p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error)
+	DeleteNetworkACLListAsync(ctx context.Context, p *DeleteNetworkACLListParams) (*DeleteNetworkACLListJob, error)
+	NewReplaceNetworkACLListParams(aclid string) *ReplaceNetworkACLListParams
+	ReplaceNetworkACLList(p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListResponse, error)
+	ReplaceNetworkACLListWithContext(ctx context.Context, p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListResponse, error)
+	ReplaceNetworkACLListAsync(ctx context.Context, p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListJob, error)
+	NewUpdateNetworkACLListParams(id string) *UpdateNetworkACLListParams
+	UpdateNetworkACLList(p *UpdateNetworkACLListParams) (*UpdateNetworkACLListResponse, error)
+	UpdateNetworkACLListWithContext(ctx context.Context, p *UpdateNetworkACLListParams) (*UpdateNetworkACLListResponse, error)
+	UpdateNetworkACLListAsync(ctx context.Context, p *UpdateNetworkACLListParams) (*UpdateNetworkACLListJob, error)
+	NewListNetworkACLListsParams() *ListNetworkACLListsParams
+	GetNetworkACLListID(name string, opts ...OptionFunc) (string, int, error)
+	GetNetworkACLListByName(name string, opts ...OptionFunc) (*NetworkACLList, int, error)
+	GetNetworkACLListByID(id string, opts ...OptionFunc) (*NetworkACLList, int, error)
+	ListNetworkACLLists(p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error)
+	ListNetworkACLListsWithContext(ctx context.Context, p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error)
+	NewListNetworkACLListsIterator(p *ListNetworkACLListsParams, pagesize int) *ListNetworkACLListsIterator
+	NewListNetworkACLsParams() *ListNetworkACLsParams
+	GetNetworkACLByID(id string, opts ...OptionFunc) (*NetworkACL, int, error)
+	ListNetworkACLs(p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error)
+	ListNetworkACLsWithContext(ctx context.Context, p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error)
+	NewListNetworkACLsIterator(p *ListNetworkACLsParams, pagesize int) *ListNetworkACLsIterator
+}
+
 type CreateNetworkACLParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateNetworkACLParams) command() string {
+	return "createNetworkACL"
+}
+
 func (p *CreateNetworkACLParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -174,7 +224,13 @@ func (s *NetworkACLService) NewCreateNetworkACLParams(protocol string) *CreateNe
 
 // Creates a ACL rule in the given network (the network has to belong to VPC)
 func (s *NetworkACLService) CreateNetworkACL(p *CreateNetworkACLParams) (*CreateNetworkACLResponse, error) {
-	resp, err := s.cs.newRequest("createNetworkACL", p.toURLValues())
+	return s.CreateNetworkACLWithContext(context.Background(), p)
+}
+
+// CreateNetworkACLWithContext is the same as CreateNetworkACL, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkACLService) CreateNetworkACLWithContext(ctx context.Context, p *CreateNetworkACLParams) (*CreateNetworkACLResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createNetworkACL", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -186,26 +242,56 @@ func (s *NetworkACLService) CreateNetworkACL(p *CreateNetworkACLParams) (*Create
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createNetworkACL", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "networkacl", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CreateNetworkACLJob is a handle to a running createNetworkACL async job
+type CreateNetworkACLJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateNetworkACLResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateNetworkACLJob) Result() (*CreateNetworkACLResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateNetworkACLResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "networkacl", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CreateNetworkACLAsync starts the createNetworkACL async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NetworkACLService) CreateNetworkACLAsync(ctx context.Context, p *CreateNetworkACLParams) (*CreateNetworkACLJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createNetworkACL", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateNetworkACLResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateNetworkACLJob{s.cs.newJobHandle("createNetworkACL", r.JobID)}, nil
+}
+
 type CreateNetworkACLResponse struct {
 	JobID      string `json:"jobid,omitempty"`
 	Aclid      string `json:"aclid,omitempty"`
@@ -239,6 +325,10 @@ type DeleteNetworkACLParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteNetworkACLParams) command() string {
+	return "deleteNetworkACL"
+}
+
 func (p *DeleteNetworkACLParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -268,7 +358,13 @@ func (s *NetworkACLService) NewDeleteNetworkACLParams(id string) *DeleteNetworkA
 
 // Deletes a network ACL
 func (s *NetworkACLService) DeleteNetworkACL(p *DeleteNetworkACLParams) (*DeleteNetworkACLResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkACL", p.toURLValues())
+	return s.DeleteNetworkACLWithContext(context.Background(), p)
+}
+
+// DeleteNetworkACLWithContext is the same as DeleteNetworkACL, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkACLService) DeleteNetworkACLWithContext(ctx context.Context, p *DeleteNetworkACLParams) (*DeleteNetworkACLResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteNetworkACL", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -280,9 +376,9 @@ func (s *NetworkACLService) DeleteNetworkACL(p *DeleteNetworkACLParams) (*Delete
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteNetworkACL", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -295,6 +391,41 @@ func (s *NetworkACLService) DeleteNetworkACL(p *DeleteNetworkACLParams) (*Delete
 	return &r, nil
 }
 
+// DeleteNetworkACLJob is a handle to a running deleteNetworkACL async job
+type DeleteNetworkACLJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteNetworkACLResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteNetworkACLJob) Result() (*DeleteNetworkACLResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteNetworkACLResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteNetworkACLAsync starts the deleteNetworkACL async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NetworkACLService) DeleteNetworkACLAsync(ctx context.Context, p *DeleteNetworkACLParams) (*DeleteNetworkACLJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteNetworkACL", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteNetworkACLResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteNetworkACLJob{s.cs.newJobHandle("deleteNetworkACL", r.JobID)}, nil
+}
+
 type DeleteNetworkACLResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -305,6 +436,10 @@ type UpdateNetworkACLItemParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateNetworkACLItemParams) command() string {
+	return "updateNetworkACLItem"
+}
+
 func (p *UpdateNetworkACLItemParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -451,7 +586,13 @@ func (s *NetworkACLService) NewUpdateNetworkACLItemParams(id string) *UpdateNetw
 
 // Updates ACL item with specified ID
 func (s *NetworkACLService) UpdateNetworkACLItem(p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemResponse, error) {
-	resp, err := s.cs.newRequest("updateNetworkACLItem", p.toURLValues())
+	return s.UpdateNetworkACLItemWithContext(context.Background(), p)
+}
+
+// UpdateNetworkACLItemWithContext is the same as UpdateNetworkACLItem, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkACLService) UpdateNetworkACLItemWithContext(ctx context.Context, p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateNetworkACLItem", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -463,26 +604,56 @@ func (s *NetworkACLService) UpdateNetworkACLItem(p *UpdateNetworkACLItemParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateNetworkACLItem", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "networkacl", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// UpdateNetworkACLItemJob is a handle to a running updateNetworkACLItem async job
+type UpdateNetworkACLItemJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateNetworkACLItemResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateNetworkACLItemJob) Result() (*UpdateNetworkACLItemResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateNetworkACLItemResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "networkacl", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// UpdateNetworkACLItemAsync starts the updateNetworkACLItem async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NetworkACLService) UpdateNetworkACLItemAsync(ctx context.Context, p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateNetworkACLItem", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateNetworkACLItemResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateNetworkACLItemJob{s.cs.newJobHandle("updateNetworkACLItem", r.JobID)}, nil
+}
+
 type UpdateNetworkACLItemResponse struct {
 	JobID      string `json:"jobid,omitempty"`
 	Aclid      string `json:"aclid,omitempty"`
@@ -516,6 +687,10 @@ type CreateNetworkACLListParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateNetworkACLListParams) command() string {
+	return "createNetworkACLList"
+}
+
 func (p *CreateNetworkACLListParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -577,7 +752,13 @@ func (s *NetworkACLService) NewCreateNetworkACLListParams(name string, vpcid str
 
 // Creates a network ACL for the given VPC
 func (s *NetworkACLService) CreateNetworkACLList(p *CreateNetworkACLListParams) (*CreateNetworkACLListResponse, error) {
-	resp, err := s.cs.newRequest("createNetworkACLList", p.toURLValues())
+	return s.CreateNetworkACLListWithContext(context.Background(), p)
+}
+
+// CreateNetworkACLListWithContext is the same as CreateNetworkACLList, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkACLService) CreateNetworkACLListWithContext(ctx context.Context, p *CreateNetworkACLListParams) (*CreateNetworkACLListResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createNetworkACLList", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -589,26 +770,56 @@ func (s *NetworkACLService) CreateNetworkACLList(p *CreateNetworkACLListParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createNetworkACLList", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "networkacllist", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CreateNetworkACLListJob is a handle to a running createNetworkACLList async job
+type CreateNetworkACLListJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateNetworkACLListResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateNetworkACLListJob) Result() (*CreateNetworkACLListResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateNetworkACLListResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "networkacllist", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CreateNetworkACLListAsync starts the createNetworkACLList async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NetworkACLService) CreateNetworkACLListAsync(ctx context.Context, p *CreateNetworkACLListParams) (*CreateNetworkACLListJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createNetworkACLList", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateNetworkACLListResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateNetworkACLListJob{s.cs.newJobHandle("createNetworkACLList", r.JobID)}, nil
+}
+
 type CreateNetworkACLListResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Description string `json:"description,omitempty"`
@@ -622,6 +833,10 @@ type DeleteNetworkACLListParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteNetworkACLListParams) command() string {
+	return "deleteNetworkACLList"
+}
+
 func (p *DeleteNetworkACLListParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -651,7 +866,13 @@ func (s *NetworkACLService) NewDeleteNetworkACLListParams(id string) *DeleteNetw
 
 // Deletes a network ACL
 func (s *NetworkACLService) DeleteNetworkACLList(p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkACLList", p.toURLValues())
+	return s.DeleteNetworkACLListWithContext(context.Background(), p)
+}
+
+// DeleteNetworkACLListWithContext is the same as DeleteNetworkACLList, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkACLService) DeleteNetworkACLListWithContext(ctx context.Context, p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteNetworkACLList", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -663,9 +884,9 @@ func (s *NetworkACLService) DeleteNetworkACLList(p *DeleteNetworkACLListParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteNetworkACLList", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -678,6 +899,41 @@ func (s *NetworkACLService) DeleteNetworkACLList(p *DeleteNetworkACLListParams)
 	return &r, nil
 }
 
+// DeleteNetworkACLListJob is a handle to a running deleteNetworkACLList async job
+type DeleteNetworkACLListJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteNetworkACLListResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteNetworkACLListJob) Result() (*DeleteNetworkACLListResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteNetworkACLListResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteNetworkACLListAsync starts the deleteNetworkACLList async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NetworkACLService) DeleteNetworkACLListAsync(ctx context.Context, p *DeleteNetworkACLListParams) (*DeleteNetworkACLListJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteNetworkACLList", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteNetworkACLListResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteNetworkACLListJob{s.cs.newJobHandle("deleteNetworkACLList", r.JobID)}, nil
+}
+
 type DeleteNetworkACLListResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -688,6 +944,10 @@ type ReplaceNetworkACLListParams struct {
 	p map[string]interface{}
 }
 
+func (p *ReplaceNetworkACLListParams) command() string {
+	return "replaceNetworkACLList"
+}
+
 func (p *ReplaceNetworkACLListParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -747,7 +1007,13 @@ func (s *NetworkACLService) NewReplaceNetworkACLListParams(aclid string) *Replac
 
 // Replaces ACL associated with a network or private gateway
 func (s *NetworkACLService) ReplaceNetworkACLList(p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListResponse, error) {
-	resp, err := s.cs.newRequest("replaceNetworkACLList", p.toURLValues())
+	return s.ReplaceNetworkACLListWithContext(context.Background(), p)
+}
+
+// ReplaceNetworkACLListWithContext is the same as ReplaceNetworkACLList, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkACLService) ReplaceNetworkACLListWithContext(ctx context.Context, p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "replaceNetworkACLList", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -759,9 +1025,9 @@ func (s *NetworkACLService) ReplaceNetworkACLList(p *ReplaceNetworkACLListParams
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "replaceNetworkACLList", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -774,6 +1040,41 @@ func (s *NetworkACLService) ReplaceNetworkACLList(p *ReplaceNetworkACLListParams
 	return &r, nil
 }
 
+// ReplaceNetworkACLListJob is a handle to a running replaceNetworkACLList async job
+type ReplaceNetworkACLListJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a ReplaceNetworkACLListResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *ReplaceNetworkACLListJob) Result() (*ReplaceNetworkACLListResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := ReplaceNetworkACLListResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ReplaceNetworkACLListAsync starts the replaceNetworkACLList async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NetworkACLService) ReplaceNetworkACLListAsync(ctx context.Context, p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListJob, error) {
+	resp, err := s.cs.newRequest(ctx, "replaceNetworkACLList", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ReplaceNetworkACLListResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &ReplaceNetworkACLListJob{s.cs.newJobHandle("replaceNetworkACLList", r.JobID)}, nil
+}
+
 type ReplaceNetworkACLListResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -784,6 +1085,10 @@ type UpdateNetworkACLListParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateNetworkACLListParams) command() string {
+	return "updateNetworkACLList"
+}
+
 func (p *UpdateNetworkACLListParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -854,7 +1159,13 @@ func (s *NetworkACLService) NewUpdateNetworkACLListParams(id string) *UpdateNetw
 
 // Updates network ACL list
 func (s *NetworkACLService) UpdateNetworkACLList(p *UpdateNetworkACLListParams) (*UpdateNetworkACLListResponse, error) {
-	resp, err := s.cs.newRequest("updateNetworkACLList", p.toURLValues())
+	return s.UpdateNetworkACLListWithContext(context.Background(), p)
+}
+
+// UpdateNetworkACLListWithContext is the same as UpdateNetworkACLList, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkACLService) UpdateNetworkACLListWithContext(ctx context.Context, p *UpdateNetworkACLListParams) (*UpdateNetworkACLListResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateNetworkACLList", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -866,9 +1177,9 @@ func (s *NetworkACLService) UpdateNetworkACLList(p *UpdateNetworkACLListParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateNetworkACLList", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -881,6 +1192,41 @@ func (s *NetworkACLService) UpdateNetworkACLList(p *UpdateNetworkACLListParams)
 	return &r, nil
 }
 
+// UpdateNetworkACLListJob is a handle to a running updateNetworkACLList async job
+type UpdateNetworkACLListJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateNetworkACLListResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateNetworkACLListJob) Result() (*UpdateNetworkACLListResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateNetworkACLListResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// UpdateNetworkACLListAsync starts the updateNetworkACLList async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NetworkACLService) UpdateNetworkACLListAsync(ctx context.Context, p *UpdateNetworkACLListParams) (*UpdateNetworkACLListJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateNetworkACLList", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateNetworkACLListResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateNetworkACLListJob{s.cs.newJobHandle("updateNetworkACLList", r.JobID)}, nil
+}
+
 type UpdateNetworkACLListResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -891,6 +1237,10 @@ type ListNetworkACLListsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListNetworkACLListsParams) command() string {
+	return "listNetworkACLLists"
+}
+
 func (p *ListNetworkACLListsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1061,7 +1411,7 @@ func (s *NetworkACLService) GetNetworkACLListID(name string, opts ...OptionFunc)
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -1075,7 +1425,7 @@ func (s *NetworkACLService) GetNetworkACLListID(name string, opts ...OptionFunc)
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -1107,16 +1457,14 @@ func (s *NetworkACLService) GetNetworkACLListByID(id string, opts ...OptionFunc)
 
 	l, err := s.ListNetworkACLLists(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -1128,10 +1476,17 @@ func (s *NetworkACLService) GetNetworkACLListByID(id string, opts ...OptionFunc)
 
 // Lists all network ACLs
 func (s *NetworkACLService) ListNetworkACLLists(p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error) {
+	return s.ListNetworkACLListsWithContext(context.Background(), p)
+}
+
+// ListNetworkACLListsWithContext is the same as ListNetworkACLLists, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkACLService) ListNetworkACLListsWithContext(ctx context.Context, p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error) {
 	var r ListNetworkACLListsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListNetworkACLListsResponse
-		resp, err := s.cs.newRequest("listNetworkACLLists", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listNetworkACLLists", values)
 		if err != nil {
 			return nil, err
 		}
@@ -1143,13 +1498,89 @@ func (s *NetworkACLService) ListNetworkACLLists(p *ListNetworkACLListsParams) (*
 		r.Count = l.Count
 		r.NetworkACLLists = append(r.NetworkACLLists, l.NetworkACLLists...)
 
-		if r.Count == len(r.NetworkACLLists) {
+		if r.Count == len(r.NetworkACLLists) || len(l.NetworkACLLists) == 0 {
+			s.cs.observePages("listNetworkACLLists", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.NetworkACLLists))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.NetworkACLLists)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listNetworkACLLists", values, len(l.NetworkACLLists), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListNetworkACLListsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.NetworkACLLists = append(r.NetworkACLLists, l.NetworkACLLists...)
+			}
+
+			if r.Count == len(r.NetworkACLLists) {
+				s.cs.observePages("listNetworkACLLists", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListNetworkACLListsIterator iterates over the items returned by listNetworkACLLists, requesting
+// the pages of the response one at a time while iterating.
+type ListNetworkACLListsIterator struct {
+	pager
+	items []*NetworkACLList
+	index int
+}
+
+// NewListNetworkACLListsIterator returns an iterator over the items returned by listNetworkACLLists, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *NetworkACLService) NewListNetworkACLListsIterator(p *ListNetworkACLListsParams, pagesize int) *ListNetworkACLListsIterator {
+	return &ListNetworkACLListsIterator{pager: newPager(s.cs, "listNetworkACLLists", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListNetworkACLListsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListNetworkACLListsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.NetworkACLLists))
+
+	i.items = l.NetworkACLLists
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListNetworkACLListsIterator) Value() *NetworkACLList {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListNetworkACLListsResponse struct {
@@ -1169,6 +1600,10 @@ type ListNetworkACLsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListNetworkACLsParams) command() string {
+	return "listNetworkACLs"
+}
+
 func (p *ListNetworkACLsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1370,16 +1805,14 @@ func (s *NetworkACLService) GetNetworkACLByID(id string, opts ...OptionFunc) (*N
 
 	l, err := s.ListNetworkACLs(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -1391,10 +1824,17 @@ func (s *NetworkACLService) GetNetworkACLByID(id string, opts ...OptionFunc) (*N
 
 // Lists all network ACL items
 func (s *NetworkACLService) ListNetworkACLs(p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error) {
+	return s.ListNetworkACLsWithContext(context.Background(), p)
+}
+
+// ListNetworkACLsWithContext is the same as ListNetworkACLs, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkACLService) ListNetworkACLsWithContext(ctx context.Context, p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error) {
 	var r ListNetworkACLsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListNetworkACLsResponse
-		resp, err := s.cs.newRequest("listNetworkACLs", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listNetworkACLs", values)
 		if err != nil {
 			return nil, err
 		}
@@ -1406,13 +1846,89 @@ func (s *NetworkACLService) ListNetworkACLs(p *ListNetworkACLsParams) (*ListNetw
 		r.Count = l.Count
 		r.NetworkACLs = append(r.NetworkACLs, l.NetworkACLs...)
 
-		if r.Count == len(r.NetworkACLs) {
+		if r.Count == len(r.NetworkACLs) || len(l.NetworkACLs) == 0 {
+			s.cs.observePages("listNetworkACLs", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.NetworkACLs))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.NetworkACLs)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listNetworkACLs", values, len(l.NetworkACLs), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListNetworkACLsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.NetworkACLs = append(r.NetworkACLs, l.NetworkACLs...)
+			}
+
+			if r.Count == len(r.NetworkACLs) {
+				s.cs.observePages("listNetworkACLs", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListNetworkACLsIterator iterates over the items returned by listNetworkACLs, requesting
+// the pages of the response one at a time while iterating.
+type ListNetworkACLsIterator struct {
+	pager
+	items []*NetworkACL
+	index int
+}
+
+// NewListNetworkACLsIterator returns an iterator over the items returned by listNetworkACLs, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *NetworkACLService) NewListNetworkACLsIterator(p *ListNetworkACLsParams, pagesize int) *ListNetworkACLsIterator {
+	return &ListNetworkACLsIterator{pager: newPager(s.cs, "listNetworkACLs", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListNetworkACLsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListNetworkACLsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.NetworkACLs))
+
+	i.items = l.NetworkACLs
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListNetworkACLsIterator) Value() *NetworkACL {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListNetworkACLsResponse struct {
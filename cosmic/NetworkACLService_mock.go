@@ -0,0 +1,309 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockNetworkACLService is a mock of NetworkACLServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockNetworkACLService struct {
+	mockCalls
+
+	CreateNetworkACLFunc           func(ctx context.Context, p *CreateNetworkACLParams) (*CreateNetworkACLResponse, error)
+	CreateNetworkACLAsyncFunc      func(ctx context.Context, p *CreateNetworkACLParams) (*CreateNetworkACLJob, error)
+	DeleteNetworkACLFunc           func(ctx context.Context, p *DeleteNetworkACLParams) (*DeleteNetworkACLResponse, error)
+	DeleteNetworkACLAsyncFunc      func(ctx context.Context, p *DeleteNetworkACLParams) (*DeleteNetworkACLJob, error)
+	UpdateNetworkACLItemFunc       func(ctx context.Context, p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemResponse, error)
+	UpdateNetworkACLItemAsyncFunc  func(ctx context.Context, p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemJob, error)
+	CreateNetworkACLListFunc       func(ctx context.Context, p *CreateNetworkACLListParams) (*CreateNetworkACLListResponse, error)
+	CreateNetworkACLListAsyncFunc  func(ctx context.Context, p *CreateNetworkACLListParams) (*CreateNetworkACLListJob, error)
+	DeleteNetworkACLListFunc       func(ctx context.Context, p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error)
+	DeleteNetworkACLListAsyncFunc  func(ctx context.Context, p *DeleteNetworkACLListParams) (*DeleteNetworkACLListJob, error)
+	ReplaceNetworkACLListFunc      func(ctx context.Context, p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListResponse, error)
+	ReplaceNetworkACLListAsyncFunc func(ctx context.Context, p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListJob, error)
+	UpdateNetworkACLListFunc       func(ctx context.Context, p *UpdateNetworkACLListParams) (*UpdateNetworkACLListResponse, error)
+	UpdateNetworkACLListAsyncFunc  func(ctx context.Context, p *UpdateNetworkACLListParams) (*UpdateNetworkACLListJob, error)
+	GetNetworkACLListIDFunc        func(name string, opts ...OptionFunc) (string, int, error)
+	GetNetworkACLListByNameFunc    func(name string, opts ...OptionFunc) (*NetworkACLList, int, error)
+	GetNetworkACLListByIDFunc      func(id string, opts ...OptionFunc) (*NetworkACLList, int, error)
+	ListNetworkACLListsFunc        func(ctx context.Context, p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error)
+	GetNetworkACLByIDFunc          func(id string, opts ...OptionFunc) (*NetworkACL, int, error)
+	ListNetworkACLsFunc            func(ctx context.Context, p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error)
+}
+
+var _ NetworkACLServiceIface = &MockNetworkACLService{}
+
+func (m *MockNetworkACLService) NewCreateNetworkACLParams(protocol string) *CreateNetworkACLParams {
+	return (&NetworkACLService{}).NewCreateNetworkACLParams(protocol)
+}
+
+func (m *MockNetworkACLService) CreateNetworkACL(p *CreateNetworkACLParams) (*CreateNetworkACLResponse, error) {
+	return m.CreateNetworkACLWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkACLService) CreateNetworkACLWithContext(ctx context.Context, p *CreateNetworkACLParams) (*CreateNetworkACLResponse, error) {
+	m.record("CreateNetworkACL", p)
+	if m.CreateNetworkACLFunc == nil {
+		return nil, notMocked("MockNetworkACLService", "CreateNetworkACL")
+	}
+	return m.CreateNetworkACLFunc(ctx, p)
+}
+
+func (m *MockNetworkACLService) CreateNetworkACLAsync(ctx context.Context, p *CreateNetworkACLParams) (*CreateNetworkACLJob, error) {
+	m.record("CreateNetworkACLAsync", p)
+	if m.CreateNetworkACLAsyncFunc == nil {
+		return nil, notMocked("MockNetworkACLService", "CreateNetworkACLAsync")
+	}
+	return m.CreateNetworkACLAsyncFunc(ctx, p)
+}
+
+func (m *MockNetworkACLService) NewDeleteNetworkACLParams(id string) *DeleteNetworkACLParams {
+	return (&NetworkACLService{}).NewDeleteNetworkACLParams(id)
+}
+
+func (m *MockNetworkACLService) DeleteNetworkACL(p *DeleteNetworkACLParams) (*DeleteNetworkACLResponse, error) {
+	return m.DeleteNetworkACLWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkACLService) DeleteNetworkACLWithContext(ctx context.Context, p *DeleteNetworkACLParams) (*DeleteNetworkACLResponse, error) {
+	m.record("DeleteNetworkACL", p)
+	if m.DeleteNetworkACLFunc == nil {
+		return nil, notMocked("MockNetworkACLService", "DeleteNetworkACL")
+	}
+	return m.DeleteNetworkACLFunc(ctx, p)
+}
+
+func (m *MockNetworkACLService) DeleteNetworkACLAsync(ctx context.Context, p *DeleteNetworkACLParams) (*DeleteNetworkACLJob, error) {
+	m.record("DeleteNetworkACLAsync", p)
+	if m.DeleteNetworkACLAsyncFunc == nil {
+		return nil, notMocked("MockNetworkACLService", "DeleteNetworkACLAsync")
+	}
+	return m.DeleteNetworkACLAsyncFunc(ctx, p)
+}
+
+func (m *MockNetworkACLService) NewUpdateNetworkACLItemParams(id string) *UpdateNetworkACLItemParams {
+	return (&NetworkACLService{}).NewUpdateNetworkACLItemParams(id)
+}
+
+func (m *MockNetworkACLService) UpdateNetworkACLItem(p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemResponse, error) {
+	return m.UpdateNetworkACLItemWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkACLService) UpdateNetworkACLItemWithContext(ctx context.Context, p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemResponse, error) {
+	m.record("UpdateNetworkACLItem", p)
+	if m.UpdateNetworkACLItemFunc == nil {
+		return nil, notMocked("MockNetworkACLService", "UpdateNetworkACLItem")
+	}
+	return m.UpdateNetworkACLItemFunc(ctx, p)
+}
+
+func (m *MockNetworkACLService) UpdateNetworkACLItemAsync(ctx context.Context, p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemJob, error) {
+	m.record("UpdateNetworkACLItemAsync", p)
+	if m.UpdateNetworkACLItemAsyncFunc == nil {
+		return nil, notMocked("MockNetworkACLService", "UpdateNetworkACLItemAsync")
+	}
+	return m.UpdateNetworkACLItemAsyncFunc(ctx, p)
+}
+
+func (m *MockNetworkACLService) NewCreateNetworkACLListParams(name string, vpcid string) *CreateNetworkACLListParams {
+	return (&NetworkACLService{}).NewCreateNetworkACLListParams(name, vpcid)
+}
+
+func (m *MockNetworkACLService) CreateNetworkACLList(p *CreateNetworkACLListParams) (*CreateNetworkACLListResponse, error) {
+	return m.CreateNetworkACLListWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkACLService) CreateNetworkACLListWithContext(ctx context.Context, p *CreateNetworkACLListParams) (*CreateNetworkACLListResponse, error) {
+	m.record("CreateNetworkACLList", p)
+	if m.CreateNetworkACLListFunc == nil {
+		return nil, notMocked("MockNetworkACLService", "CreateNetworkACLList")
+	}
+	return m.CreateNetworkACLListFunc(ctx, p)
+}
+
+func (m *MockNetworkACLService) CreateNetworkACLListAsync(ctx context.Context, p *CreateNetworkACLListParams) (*CreateNetworkACLListJob, error) {
+	m.record("CreateNetworkACLListAsync", p)
+	if m.CreateNetworkACLListAsyncFunc == nil {
+		return nil, notMocked("MockNetworkACLService", "CreateNetworkACLListAsync")
+	}
+	return m.CreateNetworkACLListAsyncFunc(ctx, p)
+}
+
+func (m *MockNetworkACLService) NewDeleteNetworkACLListParams(id string) *DeleteNetworkACLListParams {
+	return (&NetworkACLService{}).NewDeleteNetworkACLListParams(id)
+}
+
+func (m *MockNetworkACLService) DeleteNetworkACLList(p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error) {
+	return m.DeleteNetworkACLListWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkACLService) DeleteNetworkACLListWithContext(ctx context.Context, p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error) {
+	m.record("DeleteNetworkACLList", p)
+	if m.DeleteNetworkACLListFunc == nil {
+		return nil, notMocked("MockNetworkACLService", "DeleteNetworkACLList")
+	}
+	return m.DeleteNetworkACLListFunc(ctx, p)
+}
+
+func (m *MockNetworkACLService) DeleteNetworkACLListAsync(ctx context.Context, p *DeleteNetworkACLListParams) (*DeleteNetworkACLListJob, error) {
+	m.record("DeleteNetworkACLListAsync", p)
+	if m.DeleteNetworkACLListAsyncFunc == nil {
+		return nil, notMocked("MockNetworkACLService", "DeleteNetworkACLListAsync")
+	}
+	return m.DeleteNetworkACLListAsyncFunc(ctx, p)
+}
+
+func (m *MockNetworkACLService) NewReplaceNetworkACLListParams(aclid string) *ReplaceNetworkACLListParams {
+	return (&NetworkACLService{}).NewReplaceNetworkACLListParams(aclid)
+}
+
+func (m *MockNetworkACLService) ReplaceNetworkACLList(p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListResponse, error) {
+	return m.ReplaceNetworkACLListWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkACLService) ReplaceNetworkACLListWithContext(ctx context.Context, p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListResponse, error) {
+	m.record("ReplaceNetworkACLList", p)
+	if m.ReplaceNetworkACLListFunc == nil {
+		return nil, notMocked("MockNetworkACLService", "ReplaceNetworkACLList")
+	}
+	return m.ReplaceNetworkACLListFunc(ctx, p)
+}
+
+func (m *MockNetworkACLService) ReplaceNetworkACLListAsync(ctx context.Context, p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListJob, error) {
+	m.record("ReplaceNetworkACLListAsync", p)
+	if m.ReplaceNetworkACLListAsyncFunc == nil {
+		return nil, notMocked("MockNetworkACLService", "ReplaceNetworkACLListAsync")
+	}
+	return m.ReplaceNetworkACLListAsyncFunc(ctx, p)
+}
+
+func (m *MockNetworkACLService) NewUpdateNetworkACLListParams(id string) *UpdateNetworkACLListParams {
+	return (&NetworkACLService{}).NewUpdateNetworkACLListParams(id)
+}
+
+func (m *MockNetworkACLService) UpdateNetworkACLList(p *UpdateNetworkACLListParams) (*UpdateNetworkACLListResponse, error) {
+	return m.UpdateNetworkACLListWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkACLService) UpdateNetworkACLListWithContext(ctx context.Context, p *UpdateNetworkACLListParams) (*UpdateNetworkACLListResponse, error) {
+	m.record("UpdateNetworkACLList", p)
+	if m.UpdateNetworkACLListFunc == nil {
+		return nil, notMocked("MockNetworkACLService", "UpdateNetworkACLList")
+	}
+	return m.UpdateNetworkACLListFunc(ctx, p)
+}
+
+func (m *MockNetworkACLService) UpdateNetworkACLListAsync(ctx context.Context, p *UpdateNetworkACLListParams) (*UpdateNetworkACLListJob, error) {
+	m.record("UpdateNetworkACLListAsync", p)
+	if m.UpdateNetworkACLListAsyncFunc == nil {
+		return nil, notMocked("MockNetworkACLService", "UpdateNetworkACLListAsync")
+	}
+	return m.UpdateNetworkACLListAsyncFunc(ctx, p)
+}
+
+func (m *MockNetworkACLService) NewListNetworkACLListsParams() *ListNetworkACLListsParams {
+	return (&NetworkACLService{}).NewListNetworkACLListsParams()
+}
+
+func (m *MockNetworkACLService) GetNetworkACLListID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetNetworkACLListID", name, opts)
+	if m.GetNetworkACLListIDFunc == nil {
+		return "", -1, notMocked("MockNetworkACLService", "GetNetworkACLListID")
+	}
+	return m.GetNetworkACLListIDFunc(name, opts...)
+}
+
+func (m *MockNetworkACLService) GetNetworkACLListByName(name string, opts ...OptionFunc) (*NetworkACLList, int, error) {
+	m.record("GetNetworkACLListByName", name, opts)
+	if m.GetNetworkACLListByNameFunc == nil {
+		return nil, -1, notMocked("MockNetworkACLService", "GetNetworkACLListByName")
+	}
+	return m.GetNetworkACLListByNameFunc(name, opts...)
+}
+
+func (m *MockNetworkACLService) GetNetworkACLListByID(id string, opts ...OptionFunc) (*NetworkACLList, int, error) {
+	m.record("GetNetworkACLListByID", id, opts)
+	if m.GetNetworkACLListByIDFunc == nil {
+		return nil, -1, notMocked("MockNetworkACLService", "GetNetworkACLListByID")
+	}
+	return m.GetNetworkACLListByIDFunc(id, opts...)
+}
+
+func (m *MockNetworkACLService) ListNetworkACLLists(p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error) {
+	return m.ListNetworkACLListsWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkACLService) ListNetworkACLListsWithContext(ctx context.Context, p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error) {
+	m.record("ListNetworkACLLists", p)
+	if m.ListNetworkACLListsFunc == nil {
+		return nil, notMocked("MockNetworkACLService", "ListNetworkACLLists")
+	}
+	return m.ListNetworkACLListsFunc(ctx, p)
+}
+
+func (m *MockNetworkACLService) NewListNetworkACLListsIterator(p *ListNetworkACLListsParams, pagesize int) *ListNetworkACLListsIterator {
+	i := &ListNetworkACLListsIterator{pager: newPager(nil, "listNetworkACLLists", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListNetworkACLListsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListNetworkACLListsWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockNetworkACLService) NewListNetworkACLsParams() *ListNetworkACLsParams {
+	return (&NetworkACLService{}).NewListNetworkACLsParams()
+}
+
+func (m *MockNetworkACLService) GetNetworkACLByID(id string, opts ...OptionFunc) (*NetworkACL, int, error) {
+	m.record("GetNetworkACLByID", id, opts)
+	if m.GetNetworkACLByIDFunc == nil {
+		return nil, -1, notMocked("MockNetworkACLService", "GetNetworkACLByID")
+	}
+	return m.GetNetworkACLByIDFunc(id, opts...)
+}
+
+func (m *MockNetworkACLService) ListNetworkACLs(p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error) {
+	return m.ListNetworkACLsWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkACLService) ListNetworkACLsWithContext(ctx context.Context, p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error) {
+	m.record("ListNetworkACLs", p)
+	if m.ListNetworkACLsFunc == nil {
+		return nil, notMocked("MockNetworkACLService", "ListNetworkACLs")
+	}
+	return m.ListNetworkACLsFunc(ctx, p)
+}
+
+func (m *MockNetworkACLService) NewListNetworkACLsIterator(p *ListNetworkACLsParams, pagesize int) *ListNetworkACLsIterator {
+	i := &ListNetworkACLsIterator{pager: newPager(nil, "listNetworkACLs", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListNetworkACLsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListNetworkACLsWithContext(ctx, c)
+	}
+	return i
+}
@@ -0,0 +1,258 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCreateNetworkACLParams(t *testing.T) {
+	p := &CreateNetworkACLParams{}
+	want := url.Values{}
+	p.SetAclid("aclid-value")
+	want.Set("aclid", "aclid-value")
+	p.SetAction("action-value")
+	want.Set("action", "action-value")
+	p.SetCidrlist([]string{"a", "b"})
+	want.Set("cidrlist", "a,b")
+	p.SetEndport(42)
+	want.Set("endport", "42")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetIcmpcode(42)
+	want.Set("icmpcode", "42")
+	p.SetIcmptype(42)
+	want.Set("icmptype", "42")
+	p.SetNetworkid("networkid-value")
+	want.Set("networkid", "networkid-value")
+	p.SetNumber(42)
+	want.Set("number", "42")
+	p.SetProtocol("protocol-value")
+	want.Set("protocol", "protocol-value")
+	p.SetStartport(42)
+	want.Set("startport", "42")
+	p.SetTraffictype("traffictype-value")
+	want.Set("traffictype", "traffictype-value")
+
+	assertParams(t, p, "createNetworkACL", want)
+}
+
+func TestCreateNetworkACLResponse(t *testing.T) {
+	assertDecodes(t, `{"aclid":"aclid-value","action":"action-value","cidrlist":"cidrlist-value","endport":"endport-value","fordisplay":true,"icmpcode":42,"icmptype":42,"id":"id-value","jobid":"jobid-value","number":42,"protocol":"protocol-value","startport":"startport-value","state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"traffictype":"traffictype-value"}`, &CreateNetworkACLResponse{})
+}
+
+func TestDeleteNetworkACLParams(t *testing.T) {
+	p := &DeleteNetworkACLParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteNetworkACL", want)
+}
+
+func TestDeleteNetworkACLResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteNetworkACLResponse{})
+}
+
+func TestUpdateNetworkACLItemParams(t *testing.T) {
+	p := &UpdateNetworkACLItemParams{}
+	want := url.Values{}
+	p.SetAction("action-value")
+	want.Set("action", "action-value")
+	p.SetCidrlist([]string{"a", "b"})
+	want.Set("cidrlist", "a,b")
+	p.SetCustomid("customid-value")
+	want.Set("customid", "customid-value")
+	p.SetEndport(42)
+	want.Set("endport", "42")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetIcmpcode(42)
+	want.Set("icmpcode", "42")
+	p.SetIcmptype(42)
+	want.Set("icmptype", "42")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetNumber(42)
+	want.Set("number", "42")
+	p.SetProtocol("protocol-value")
+	want.Set("protocol", "protocol-value")
+	p.SetStartport(42)
+	want.Set("startport", "42")
+	p.SetTraffictype("traffictype-value")
+	want.Set("traffictype", "traffictype-value")
+
+	assertParams(t, p, "updateNetworkACLItem", want)
+}
+
+func TestUpdateNetworkACLItemResponse(t *testing.T) {
+	assertDecodes(t, `{"aclid":"aclid-value","action":"action-value","cidrlist":"cidrlist-value","endport":"endport-value","fordisplay":true,"icmpcode":42,"icmptype":42,"id":"id-value","jobid":"jobid-value","number":42,"protocol":"protocol-value","startport":"startport-value","state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"traffictype":"traffictype-value"}`, &UpdateNetworkACLItemResponse{})
+}
+
+func TestCreateNetworkACLListParams(t *testing.T) {
+	p := &CreateNetworkACLListParams{}
+	want := url.Values{}
+	p.SetDescription("description-value")
+	want.Set("description", "description-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetVpcid("vpcid-value")
+	want.Set("vpcid", "vpcid-value")
+
+	assertParams(t, p, "createNetworkACLList", want)
+}
+
+func TestCreateNetworkACLListResponse(t *testing.T) {
+	assertDecodes(t, `{"description":"description-value","fordisplay":true,"id":"id-value","jobid":"jobid-value","name":"name-value","vpcid":"vpcid-value"}`, &CreateNetworkACLListResponse{})
+}
+
+func TestDeleteNetworkACLListParams(t *testing.T) {
+	p := &DeleteNetworkACLListParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteNetworkACLList", want)
+}
+
+func TestDeleteNetworkACLListResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteNetworkACLListResponse{})
+}
+
+func TestReplaceNetworkACLListParams(t *testing.T) {
+	p := &ReplaceNetworkACLListParams{}
+	want := url.Values{}
+	p.SetAclid("aclid-value")
+	want.Set("aclid", "aclid-value")
+	p.SetGatewayid("gatewayid-value")
+	want.Set("gatewayid", "gatewayid-value")
+	p.SetNetworkid("networkid-value")
+	want.Set("networkid", "networkid-value")
+	p.SetPublicipid("publicipid-value")
+	want.Set("publicipid", "publicipid-value")
+
+	assertParams(t, p, "replaceNetworkACLList", want)
+}
+
+func TestReplaceNetworkACLListResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &ReplaceNetworkACLListResponse{})
+}
+
+func TestUpdateNetworkACLListParams(t *testing.T) {
+	p := &UpdateNetworkACLListParams{}
+	want := url.Values{}
+	p.SetCustomid("customid-value")
+	want.Set("customid", "customid-value")
+	p.SetDescription("description-value")
+	want.Set("description", "description-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+
+	assertParams(t, p, "updateNetworkACLList", want)
+}
+
+func TestUpdateNetworkACLListResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &UpdateNetworkACLListResponse{})
+}
+
+func TestListNetworkACLListsParams(t *testing.T) {
+	p := &ListNetworkACLListsParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetNetworkid("networkid-value")
+	want.Set("networkid", "networkid-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetVpcid("vpcid-value")
+	want.Set("vpcid", "vpcid-value")
+
+	assertParams(t, p, "listNetworkACLLists", want)
+}
+
+func TestListNetworkACLListsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"networkacllist":[{"description":"description-value","fordisplay":true,"id":"id-value","name":"name-value","vpcid":"vpcid-value"}]}`, &ListNetworkACLListsResponse{})
+}
+
+func TestListNetworkACLsParams(t *testing.T) {
+	p := &ListNetworkACLsParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetAclid("aclid-value")
+	want.Set("aclid", "aclid-value")
+	p.SetAction("action-value")
+	want.Set("action", "action-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetNetworkid("networkid-value")
+	want.Set("networkid", "networkid-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetProtocol("protocol-value")
+	want.Set("protocol", "protocol-value")
+	p.SetTags(map[string]string{"k": "v"})
+	want.Set("tags[0].key", "k")
+	want.Set("tags[0].value", "v")
+	p.SetTraffictype("traffictype-value")
+	want.Set("traffictype", "traffictype-value")
+
+	assertParams(t, p, "listNetworkACLs", want)
+}
+
+func TestListNetworkACLsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"networkacl":[{"aclid":"aclid-value","action":"action-value","cidrlist":"cidrlist-value","endport":"endport-value","fordisplay":true,"icmpcode":42,"icmptype":42,"id":"id-value","number":42,"protocol":"protocol-value","startport":"startport-value","state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"traffictype":"traffictype-value"}]}`, &ListNetworkACLsResponse{})
+}
@@ -17,16 +17,35 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 )
 
+// NetworkDeviceServiceIface is the interface implemented by NetworkDeviceService, and by MockNetworkDeviceService for tests
+type NetworkDeviceServiceIface interface {
+	NewAddNetworkDeviceParams() *AddNetworkDeviceParams
+	AddNetworkDevice(p *AddNetworkDeviceParams) (*AddNetworkDeviceResponse, error)
+	AddNetworkDeviceWithContext(ctx context.Context, p *AddNetworkDeviceParams) (*AddNetworkDeviceResponse, error)
+	NewDeleteNetworkDeviceParams(id string) *DeleteNetworkDeviceParams
+	DeleteNetworkDevice(p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error)
+	DeleteNetworkDeviceWithContext(ctx context.Context, p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error)
+	NewListNetworkDeviceParams() *ListNetworkDeviceParams
+	ListNetworkDevice(p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error)
+	ListNetworkDeviceWithContext(ctx context.Context, p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error)
+	NewListNetworkDeviceIterator(p *ListNetworkDeviceParams, pagesize int) *ListNetworkDeviceIterator
+}
+
 type AddNetworkDeviceParams struct {
 	p map[string]interface{}
 }
 
+func (p *AddNetworkDeviceParams) command() string {
+	return "addNetworkDevice"
+}
+
 func (p *AddNetworkDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -70,7 +89,13 @@ func (s *NetworkDeviceService) NewAddNetworkDeviceParams() *AddNetworkDevicePara
 
 // Adds a network device of one of the following types: ExternalDhcp, ExternalLoadBalancer
 func (s *NetworkDeviceService) AddNetworkDevice(p *AddNetworkDeviceParams) (*AddNetworkDeviceResponse, error) {
-	resp, err := s.cs.newRequest("addNetworkDevice", p.toURLValues())
+	return s.AddNetworkDeviceWithContext(context.Background(), p)
+}
+
+// AddNetworkDeviceWithContext is the same as AddNetworkDevice, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkDeviceService) AddNetworkDeviceWithContext(ctx context.Context, p *AddNetworkDeviceParams) (*AddNetworkDeviceResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "addNetworkDevice", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -90,6 +115,10 @@ type DeleteNetworkDeviceParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteNetworkDeviceParams) command() string {
+	return "deleteNetworkDevice"
+}
+
 func (p *DeleteNetworkDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -119,7 +148,13 @@ func (s *NetworkDeviceService) NewDeleteNetworkDeviceParams(id string) *DeleteNe
 
 // Deletes network device.
 func (s *NetworkDeviceService) DeleteNetworkDevice(p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkDevice", p.toURLValues())
+	return s.DeleteNetworkDeviceWithContext(context.Background(), p)
+}
+
+// DeleteNetworkDeviceWithContext is the same as DeleteNetworkDevice, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkDeviceService) DeleteNetworkDeviceWithContext(ctx context.Context, p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteNetworkDevice", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -140,6 +175,10 @@ type ListNetworkDeviceParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListNetworkDeviceParams) command() string {
+	return "listNetworkDevice"
+}
+
 func (p *ListNetworkDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -215,10 +254,17 @@ func (s *NetworkDeviceService) NewListNetworkDeviceParams() *ListNetworkDevicePa
 
 // List network devices
 func (s *NetworkDeviceService) ListNetworkDevice(p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error) {
+	return s.ListNetworkDeviceWithContext(context.Background(), p)
+}
+
+// ListNetworkDeviceWithContext is the same as ListNetworkDevice, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkDeviceService) ListNetworkDeviceWithContext(ctx context.Context, p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error) {
 	var r ListNetworkDeviceResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListNetworkDeviceResponse
-		resp, err := s.cs.newRequest("listNetworkDevice", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listNetworkDevice", values)
 		if err != nil {
 			return nil, err
 		}
@@ -230,13 +276,89 @@ func (s *NetworkDeviceService) ListNetworkDevice(p *ListNetworkDeviceParams) (*L
 		r.Count = l.Count
 		r.NetworkDevice = append(r.NetworkDevice, l.NetworkDevice...)
 
-		if r.Count == len(r.NetworkDevice) {
+		if r.Count == len(r.NetworkDevice) || len(l.NetworkDevice) == 0 {
+			s.cs.observePages("listNetworkDevice", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.NetworkDevice))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.NetworkDevice)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listNetworkDevice", values, len(l.NetworkDevice), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListNetworkDeviceResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.NetworkDevice = append(r.NetworkDevice, l.NetworkDevice...)
+			}
+
+			if r.Count == len(r.NetworkDevice) {
+				s.cs.observePages("listNetworkDevice", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListNetworkDeviceIterator iterates over the items returned by listNetworkDevice, requesting
+// the pages of the response one at a time while iterating.
+type ListNetworkDeviceIterator struct {
+	pager
+	items []*NetworkDevice
+	index int
+}
+
+// NewListNetworkDeviceIterator returns an iterator over the items returned by listNetworkDevice, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *NetworkDeviceService) NewListNetworkDeviceIterator(p *ListNetworkDeviceParams, pagesize int) *ListNetworkDeviceIterator {
+	return &ListNetworkDeviceIterator{pager: newPager(s.cs, "listNetworkDevice", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListNetworkDeviceIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListNetworkDeviceResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.NetworkDevice))
+
+	i.items = l.NetworkDevice
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListNetworkDeviceIterator) Value() *NetworkDevice {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListNetworkDeviceResponse struct {
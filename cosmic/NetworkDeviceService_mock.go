@@ -0,0 +1,94 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockNetworkDeviceService is a mock of NetworkDeviceServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockNetworkDeviceService struct {
+	mockCalls
+
+	AddNetworkDeviceFunc    func(ctx context.Context, p *AddNetworkDeviceParams) (*AddNetworkDeviceResponse, error)
+	DeleteNetworkDeviceFunc func(ctx context.Context, p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error)
+	ListNetworkDeviceFunc   func(ctx context.Context, p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error)
+}
+
+var _ NetworkDeviceServiceIface = &MockNetworkDeviceService{}
+
+func (m *MockNetworkDeviceService) NewAddNetworkDeviceParams() *AddNetworkDeviceParams {
+	return (&NetworkDeviceService{}).NewAddNetworkDeviceParams()
+}
+
+func (m *MockNetworkDeviceService) AddNetworkDevice(p *AddNetworkDeviceParams) (*AddNetworkDeviceResponse, error) {
+	return m.AddNetworkDeviceWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkDeviceService) AddNetworkDeviceWithContext(ctx context.Context, p *AddNetworkDeviceParams) (*AddNetworkDeviceResponse, error) {
+	m.record("AddNetworkDevice", p)
+	if m.AddNetworkDeviceFunc == nil {
+		return nil, notMocked("MockNetworkDeviceService", "AddNetworkDevice")
+	}
+	return m.AddNetworkDeviceFunc(ctx, p)
+}
+
+func (m *MockNetworkDeviceService) NewDeleteNetworkDeviceParams(id string) *DeleteNetworkDeviceParams {
+	return (&NetworkDeviceService{}).NewDeleteNetworkDeviceParams(id)
+}
+
+func (m *MockNetworkDeviceService) DeleteNetworkDevice(p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error) {
+	return m.DeleteNetworkDeviceWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkDeviceService) DeleteNetworkDeviceWithContext(ctx context.Context, p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error) {
+	m.record("DeleteNetworkDevice", p)
+	if m.DeleteNetworkDeviceFunc == nil {
+		return nil, notMocked("MockNetworkDeviceService", "DeleteNetworkDevice")
+	}
+	return m.DeleteNetworkDeviceFunc(ctx, p)
+}
+
+func (m *MockNetworkDeviceService) NewListNetworkDeviceParams() *ListNetworkDeviceParams {
+	return (&NetworkDeviceService{}).NewListNetworkDeviceParams()
+}
+
+func (m *MockNetworkDeviceService) ListNetworkDevice(p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error) {
+	return m.ListNetworkDeviceWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkDeviceService) ListNetworkDeviceWithContext(ctx context.Context, p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error) {
+	m.record("ListNetworkDevice", p)
+	if m.ListNetworkDeviceFunc == nil {
+		return nil, notMocked("MockNetworkDeviceService", "ListNetworkDevice")
+	}
+	return m.ListNetworkDeviceFunc(ctx, p)
+}
+
+func (m *MockNetworkDeviceService) NewListNetworkDeviceIterator(p *ListNetworkDeviceParams, pagesize int) *ListNetworkDeviceIterator {
+	i := &ListNetworkDeviceIterator{pager: newPager(nil, "listNetworkDevice", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListNetworkDeviceParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListNetworkDeviceWithContext(ctx, c)
+	}
+	return i
+}
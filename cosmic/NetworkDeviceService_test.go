@@ -0,0 +1,73 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestAddNetworkDeviceParams(t *testing.T) {
+	p := &AddNetworkDeviceParams{}
+	want := url.Values{}
+	p.SetNetworkdeviceparameterlist(map[string]string{"k": "v"})
+	want.Set("networkdeviceparameterlist[0].key", "k")
+	want.Set("networkdeviceparameterlist[0].value", "v")
+	p.SetNetworkdevicetype("networkdevicetype-value")
+	want.Set("networkdevicetype", "networkdevicetype-value")
+
+	assertParams(t, p, "addNetworkDevice", want)
+}
+
+func TestAddNetworkDeviceResponse(t *testing.T) {
+	assertDecodes(t, `{"id":"id-value"}`, &AddNetworkDeviceResponse{})
+}
+
+func TestDeleteNetworkDeviceParams(t *testing.T) {
+	p := &DeleteNetworkDeviceParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteNetworkDevice", want)
+}
+
+func TestDeleteNetworkDeviceResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &DeleteNetworkDeviceResponse{})
+}
+
+func TestListNetworkDeviceParams(t *testing.T) {
+	p := &ListNetworkDeviceParams{}
+	want := url.Values{}
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetNetworkdeviceparameterlist(map[string]string{"k": "v"})
+	want.Set("networkdeviceparameterlist[0].key", "k")
+	want.Set("networkdeviceparameterlist[0].value", "v")
+	p.SetNetworkdevicetype("networkdevicetype-value")
+	want.Set("networkdevicetype", "networkdevicetype-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+
+	assertParams(t, p, "listNetworkDevice", want)
+}
+
+func TestListNetworkDeviceResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"networkdevice":[{"id":"id-value"}]}`, &ListNetworkDeviceResponse{})
+}
@@ -17,17 +17,43 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 )
 
+// NetworkOfferingServiceIface is the interface implemented by NetworkOfferingService, and by MockNetworkOfferingService for tests
+type NetworkOfferingServiceIface interface {
+	NewCreateNetworkOfferingParams(displaytext string, guestiptype string, name string, supportedservices []string, traffictype string) *CreateNetworkOfferingParams
+	CreateNetworkOffering(p *CreateNetworkOfferingParams) (*CreateNetworkOfferingResponse, error)
+	CreateNetworkOfferingWithContext(ctx context.Context, p *CreateNetworkOfferingParams) (*CreateNetworkOfferingResponse, error)
+	NewDeleteNetworkOfferingParams(id string) *DeleteNetworkOfferingParams
+	DeleteNetworkOffering(p *DeleteNetworkOfferingParams) (*DeleteNetworkOfferingResponse, error)
+	DeleteNetworkOfferingWithContext(ctx context.Context, p *DeleteNetworkOfferingParams) (*DeleteNetworkOfferingResponse, error)
+	NewUpdateNetworkOfferingParams() *UpdateNetworkOfferingParams
+	UpdateNetworkOffering(p *UpdateNetworkOfferingParams) (*UpdateNetworkOfferingResponse, error)
+	UpdateNetworkOfferingWithContext(ctx context.Context, p *UpdateNetworkOfferingParams) (*UpdateNetworkOfferingResponse, error)
+	NewListNetworkOfferingsParams() *ListNetworkOfferingsParams
+	GetNetworkOfferingID(name string, opts ...OptionFunc) (string, int, error)
+	GetNetworkOfferingByName(name string, opts ...OptionFunc) (*NetworkOffering, int, error)
+	GetNetworkOfferingByID(id string, opts ...OptionFunc) (*NetworkOffering, int, error)
+	ListNetworkOfferings(p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error)
+	ListNetworkOfferingsWithContext(ctx context.Context, p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error)
+	NewListNetworkOfferingsIterator(p *ListNetworkOfferingsParams, pagesize int) *ListNetworkOfferingsIterator
+}
+
 type CreateNetworkOfferingParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateNetworkOfferingParams) command() string {
+	return "createNetworkOffering"
+}
+
 func (p *CreateNetworkOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -275,17 +301,19 @@ func (s *NetworkOfferingService) NewCreateNetworkOfferingParams(displaytext stri
 
 // Creates a network offering.
 func (s *NetworkOfferingService) CreateNetworkOffering(p *CreateNetworkOfferingParams) (*CreateNetworkOfferingResponse, error) {
-	resp, err := s.cs.newRequest("createNetworkOffering", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+	return s.CreateNetworkOfferingWithContext(context.Background(), p)
+}
 
-	if resp, err = getRawValue(resp); err != nil {
+// CreateNetworkOfferingWithContext is the same as CreateNetworkOffering, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkOfferingService) CreateNetworkOfferingWithContext(ctx context.Context, p *CreateNetworkOfferingParams) (*CreateNetworkOfferingResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createNetworkOffering", p.toURLValues())
+	if err != nil {
 		return nil, err
 	}
 
 	var r CreateNetworkOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := unmarshalValue(resp, "networkoffering", &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
@@ -339,6 +367,10 @@ type DeleteNetworkOfferingParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteNetworkOfferingParams) command() string {
+	return "deleteNetworkOffering"
+}
+
 func (p *DeleteNetworkOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -368,7 +400,13 @@ func (s *NetworkOfferingService) NewDeleteNetworkOfferingParams(id string) *Dele
 
 // Deletes a network offering.
 func (s *NetworkOfferingService) DeleteNetworkOffering(p *DeleteNetworkOfferingParams) (*DeleteNetworkOfferingResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkOffering", p.toURLValues())
+	return s.DeleteNetworkOfferingWithContext(context.Background(), p)
+}
+
+// DeleteNetworkOfferingWithContext is the same as DeleteNetworkOffering, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkOfferingService) DeleteNetworkOfferingWithContext(ctx context.Context, p *DeleteNetworkOfferingParams) (*DeleteNetworkOfferingResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteNetworkOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -389,6 +427,10 @@ type UpdateNetworkOfferingParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateNetworkOfferingParams) command() string {
+	return "updateNetworkOffering"
+}
+
 func (p *UpdateNetworkOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -490,7 +532,13 @@ func (s *NetworkOfferingService) NewUpdateNetworkOfferingParams() *UpdateNetwork
 
 // Updates a network offering.
 func (s *NetworkOfferingService) UpdateNetworkOffering(p *UpdateNetworkOfferingParams) (*UpdateNetworkOfferingResponse, error) {
-	resp, err := s.cs.newRequest("updateNetworkOffering", p.toURLValues())
+	return s.UpdateNetworkOfferingWithContext(context.Background(), p)
+}
+
+// UpdateNetworkOfferingWithContext is the same as UpdateNetworkOffering, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkOfferingService) UpdateNetworkOfferingWithContext(ctx context.Context, p *UpdateNetworkOfferingParams) (*UpdateNetworkOfferingResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateNetworkOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -550,6 +598,10 @@ type ListNetworkOfferingsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListNetworkOfferingsParams) command() string {
+	return "listNetworkOfferings"
+}
+
 func (p *ListNetworkOfferingsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -794,7 +846,7 @@ func (s *NetworkOfferingService) GetNetworkOfferingID(name string, opts ...Optio
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -808,7 +860,7 @@ func (s *NetworkOfferingService) GetNetworkOfferingID(name string, opts ...Optio
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -840,16 +892,14 @@ func (s *NetworkOfferingService) GetNetworkOfferingByID(id string, opts ...Optio
 
 	l, err := s.ListNetworkOfferings(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -861,10 +911,17 @@ func (s *NetworkOfferingService) GetNetworkOfferingByID(id string, opts ...Optio
 
 // Lists all available network offerings.
 func (s *NetworkOfferingService) ListNetworkOfferings(p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error) {
+	return s.ListNetworkOfferingsWithContext(context.Background(), p)
+}
+
+// ListNetworkOfferingsWithContext is the same as ListNetworkOfferings, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkOfferingService) ListNetworkOfferingsWithContext(ctx context.Context, p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error) {
 	var r ListNetworkOfferingsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListNetworkOfferingsResponse
-		resp, err := s.cs.newRequest("listNetworkOfferings", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listNetworkOfferings", values)
 		if err != nil {
 			return nil, err
 		}
@@ -876,13 +933,89 @@ func (s *NetworkOfferingService) ListNetworkOfferings(p *ListNetworkOfferingsPar
 		r.Count = l.Count
 		r.NetworkOfferings = append(r.NetworkOfferings, l.NetworkOfferings...)
 
-		if r.Count == len(r.NetworkOfferings) {
+		if r.Count == len(r.NetworkOfferings) || len(l.NetworkOfferings) == 0 {
+			s.cs.observePages("listNetworkOfferings", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.NetworkOfferings))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.NetworkOfferings)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listNetworkOfferings", values, len(l.NetworkOfferings), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListNetworkOfferingsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.NetworkOfferings = append(r.NetworkOfferings, l.NetworkOfferings...)
+			}
+
+			if r.Count == len(r.NetworkOfferings) {
+				s.cs.observePages("listNetworkOfferings", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListNetworkOfferingsIterator iterates over the items returned by listNetworkOfferings, requesting
+// the pages of the response one at a time while iterating.
+type ListNetworkOfferingsIterator struct {
+	pager
+	items []*NetworkOffering
+	index int
+}
+
+// NewListNetworkOfferingsIterator returns an iterator over the items returned by listNetworkOfferings, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *NetworkOfferingService) NewListNetworkOfferingsIterator(p *ListNetworkOfferingsParams, pagesize int) *ListNetworkOfferingsIterator {
+	return &ListNetworkOfferingsIterator{pager: newPager(s.cs, "listNetworkOfferings", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListNetworkOfferingsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListNetworkOfferingsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.NetworkOfferings))
+
+	i.items = l.NetworkOfferings
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListNetworkOfferingsIterator) Value() *NetworkOffering {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListNetworkOfferingsResponse struct {
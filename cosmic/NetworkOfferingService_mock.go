@@ -0,0 +1,138 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockNetworkOfferingService is a mock of NetworkOfferingServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockNetworkOfferingService struct {
+	mockCalls
+
+	CreateNetworkOfferingFunc    func(ctx context.Context, p *CreateNetworkOfferingParams) (*CreateNetworkOfferingResponse, error)
+	DeleteNetworkOfferingFunc    func(ctx context.Context, p *DeleteNetworkOfferingParams) (*DeleteNetworkOfferingResponse, error)
+	UpdateNetworkOfferingFunc    func(ctx context.Context, p *UpdateNetworkOfferingParams) (*UpdateNetworkOfferingResponse, error)
+	GetNetworkOfferingIDFunc     func(name string, opts ...OptionFunc) (string, int, error)
+	GetNetworkOfferingByNameFunc func(name string, opts ...OptionFunc) (*NetworkOffering, int, error)
+	GetNetworkOfferingByIDFunc   func(id string, opts ...OptionFunc) (*NetworkOffering, int, error)
+	ListNetworkOfferingsFunc     func(ctx context.Context, p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error)
+}
+
+var _ NetworkOfferingServiceIface = &MockNetworkOfferingService{}
+
+func (m *MockNetworkOfferingService) NewCreateNetworkOfferingParams(displaytext string, guestiptype string, name string, supportedservices []string, traffictype string) *CreateNetworkOfferingParams {
+	return (&NetworkOfferingService{}).NewCreateNetworkOfferingParams(displaytext, guestiptype, name, supportedservices, traffictype)
+}
+
+func (m *MockNetworkOfferingService) CreateNetworkOffering(p *CreateNetworkOfferingParams) (*CreateNetworkOfferingResponse, error) {
+	return m.CreateNetworkOfferingWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkOfferingService) CreateNetworkOfferingWithContext(ctx context.Context, p *CreateNetworkOfferingParams) (*CreateNetworkOfferingResponse, error) {
+	m.record("CreateNetworkOffering", p)
+	if m.CreateNetworkOfferingFunc == nil {
+		return nil, notMocked("MockNetworkOfferingService", "CreateNetworkOffering")
+	}
+	return m.CreateNetworkOfferingFunc(ctx, p)
+}
+
+func (m *MockNetworkOfferingService) NewDeleteNetworkOfferingParams(id string) *DeleteNetworkOfferingParams {
+	return (&NetworkOfferingService{}).NewDeleteNetworkOfferingParams(id)
+}
+
+func (m *MockNetworkOfferingService) DeleteNetworkOffering(p *DeleteNetworkOfferingParams) (*DeleteNetworkOfferingResponse, error) {
+	return m.DeleteNetworkOfferingWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkOfferingService) DeleteNetworkOfferingWithContext(ctx context.Context, p *DeleteNetworkOfferingParams) (*DeleteNetworkOfferingResponse, error) {
+	m.record("DeleteNetworkOffering", p)
+	if m.DeleteNetworkOfferingFunc == nil {
+		return nil, notMocked("MockNetworkOfferingService", "DeleteNetworkOffering")
+	}
+	return m.DeleteNetworkOfferingFunc(ctx, p)
+}
+
+func (m *MockNetworkOfferingService) NewUpdateNetworkOfferingParams() *UpdateNetworkOfferingParams {
+	return (&NetworkOfferingService{}).NewUpdateNetworkOfferingParams()
+}
+
+func (m *MockNetworkOfferingService) UpdateNetworkOffering(p *UpdateNetworkOfferingParams) (*UpdateNetworkOfferingResponse, error) {
+	return m.UpdateNetworkOfferingWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkOfferingService) UpdateNetworkOfferingWithContext(ctx context.Context, p *UpdateNetworkOfferingParams) (*UpdateNetworkOfferingResponse, error) {
+	m.record("UpdateNetworkOffering", p)
+	if m.UpdateNetworkOfferingFunc == nil {
+		return nil, notMocked("MockNetworkOfferingService", "UpdateNetworkOffering")
+	}
+	return m.UpdateNetworkOfferingFunc(ctx, p)
+}
+
+func (m *MockNetworkOfferingService) NewListNetworkOfferingsParams() *ListNetworkOfferingsParams {
+	return (&NetworkOfferingService{}).NewListNetworkOfferingsParams()
+}
+
+func (m *MockNetworkOfferingService) GetNetworkOfferingID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetNetworkOfferingID", name, opts)
+	if m.GetNetworkOfferingIDFunc == nil {
+		return "", -1, notMocked("MockNetworkOfferingService", "GetNetworkOfferingID")
+	}
+	return m.GetNetworkOfferingIDFunc(name, opts...)
+}
+
+func (m *MockNetworkOfferingService) GetNetworkOfferingByName(name string, opts ...OptionFunc) (*NetworkOffering, int, error) {
+	m.record("GetNetworkOfferingByName", name, opts)
+	if m.GetNetworkOfferingByNameFunc == nil {
+		return nil, -1, notMocked("MockNetworkOfferingService", "GetNetworkOfferingByName")
+	}
+	return m.GetNetworkOfferingByNameFunc(name, opts...)
+}
+
+func (m *MockNetworkOfferingService) GetNetworkOfferingByID(id string, opts ...OptionFunc) (*NetworkOffering, int, error) {
+	m.record("GetNetworkOfferingByID", id, opts)
+	if m.GetNetworkOfferingByIDFunc == nil {
+		return nil, -1, notMocked("MockNetworkOfferingService", "GetNetworkOfferingByID")
+	}
+	return m.GetNetworkOfferingByIDFunc(id, opts...)
+}
+
+func (m *MockNetworkOfferingService) ListNetworkOfferings(p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error) {
+	return m.ListNetworkOfferingsWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkOfferingService) ListNetworkOfferingsWithContext(ctx context.Context, p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error) {
+	m.record("ListNetworkOfferings", p)
+	if m.ListNetworkOfferingsFunc == nil {
+		return nil, notMocked("MockNetworkOfferingService", "ListNetworkOfferings")
+	}
+	return m.ListNetworkOfferingsFunc(ctx, p)
+}
+
+func (m *MockNetworkOfferingService) NewListNetworkOfferingsIterator(p *ListNetworkOfferingsParams, pagesize int) *ListNetworkOfferingsIterator {
+	i := &ListNetworkOfferingsIterator{pager: newPager(nil, "listNetworkOfferings", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListNetworkOfferingsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListNetworkOfferingsWithContext(ctx, c)
+	}
+	return i
+}
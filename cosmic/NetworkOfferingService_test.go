@@ -0,0 +1,167 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCreateNetworkOfferingParams(t *testing.T) {
+	p := &CreateNetworkOfferingParams{}
+	want := url.Values{}
+	p.SetAvailability("availability-value")
+	want.Set("availability", "availability-value")
+	p.SetConservemode(true)
+	want.Set("conservemode", "true")
+	p.SetDetails(map[string]string{"k": "v"})
+	want.Set("details[0].key", "k")
+	want.Set("details[0].value", "v")
+	p.SetDisplaytext("displaytext-value")
+	want.Set("displaytext", "displaytext-value")
+	p.SetEgressdefaultpolicy(true)
+	want.Set("egressdefaultpolicy", "true")
+	p.SetGuestiptype("guestiptype-value")
+	want.Set("guestiptype", "guestiptype-value")
+	p.SetIspersistent(true)
+	want.Set("ispersistent", "true")
+	p.SetKeepaliveenabled(true)
+	want.Set("keepaliveenabled", "true")
+	p.SetMaxconnections(42)
+	want.Set("maxconnections", "42")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetNetworkrate(42)
+	want.Set("networkrate", "42")
+	p.SetSecondaryserviceofferingid("secondaryserviceofferingid-value")
+	want.Set("secondaryserviceofferingid", "secondaryserviceofferingid-value")
+	p.SetServicecapabilitylist(map[string]string{"k": "v"})
+	want.Set("servicecapabilitylist[0].key", "k")
+	want.Set("servicecapabilitylist[0].value", "v")
+	p.SetServiceofferingid("serviceofferingid-value")
+	want.Set("serviceofferingid", "serviceofferingid-value")
+	p.SetServiceproviderlist(map[string]string{"k": "v"})
+	want.Set("serviceproviderlist[0].service", "k")
+	want.Set("serviceproviderlist[0].provider", "v")
+	p.SetSpecifyipranges(true)
+	want.Set("specifyipranges", "true")
+	p.SetSpecifyvlan(true)
+	want.Set("specifyvlan", "true")
+	p.SetSupportedservices([]string{"a", "b"})
+	want.Set("supportedservices", "a,b")
+	p.SetTags("tags-value")
+	want.Set("tags", "tags-value")
+	p.SetTraffictype("traffictype-value")
+	want.Set("traffictype", "traffictype-value")
+
+	assertParams(t, p, "createNetworkOffering", want)
+}
+
+func TestCreateNetworkOfferingResponse(t *testing.T) {
+	assertDecodes(t, `{"availability":"availability-value","conservemode":true,"created":"created-value","details":{"key":"value"},"displaytext":"displaytext-value","egressdefaultpolicy":true,"forvpc":true,"guestiptype":"guestiptype-value","id":"id-value","isdefault":true,"ispersistent":true,"maxconnections":42,"name":"name-value","networkrate":42,"secondaryserviceofferingid":"secondaryserviceofferingid-value","secondaryserviceofferingname":"secondaryserviceofferingname-value","service":[{"capability":[{"canchooseservicecapability":true,"name":"name-value","value":"value-value"}],"name":"name-value","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"destinationphysicalnetworkid-value","id":"id-value","name":"name-value","physicalnetworkid":"physicalnetworkid-value","servicelist":["a","b"],"state":"state-value"}]}],"serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","specifyipranges":true,"specifyvlan":true,"state":"state-value","supportsstrechedl2subnet":true,"tags":"tags-value","traffictype":"traffictype-value"}`, &CreateNetworkOfferingResponse{})
+}
+
+func TestDeleteNetworkOfferingParams(t *testing.T) {
+	p := &DeleteNetworkOfferingParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteNetworkOffering", want)
+}
+
+func TestDeleteNetworkOfferingResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &DeleteNetworkOfferingResponse{})
+}
+
+func TestUpdateNetworkOfferingParams(t *testing.T) {
+	p := &UpdateNetworkOfferingParams{}
+	want := url.Values{}
+	p.SetAvailability("availability-value")
+	want.Set("availability", "availability-value")
+	p.SetDisplaytext("displaytext-value")
+	want.Set("displaytext", "displaytext-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetKeepaliveenabled(true)
+	want.Set("keepaliveenabled", "true")
+	p.SetMaxconnections(42)
+	want.Set("maxconnections", "42")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetSortkey(42)
+	want.Set("sortkey", "42")
+	p.SetState("state-value")
+	want.Set("state", "state-value")
+
+	assertParams(t, p, "updateNetworkOffering", want)
+}
+
+func TestUpdateNetworkOfferingResponse(t *testing.T) {
+	assertDecodes(t, `{"availability":"availability-value","conservemode":true,"created":"created-value","details":{"key":"value"},"displaytext":"displaytext-value","egressdefaultpolicy":true,"forvpc":true,"guestiptype":"guestiptype-value","id":"id-value","isdefault":true,"ispersistent":true,"maxconnections":42,"name":"name-value","networkrate":42,"secondaryserviceofferingid":"secondaryserviceofferingid-value","secondaryserviceofferingname":"secondaryserviceofferingname-value","service":[{"capability":[{"canchooseservicecapability":true,"name":"name-value","value":"value-value"}],"name":"name-value","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"destinationphysicalnetworkid-value","id":"id-value","name":"name-value","physicalnetworkid":"physicalnetworkid-value","servicelist":["a","b"],"state":"state-value"}]}],"serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","specifyipranges":true,"specifyvlan":true,"state":"state-value","supportsstrechedl2subnet":true,"tags":"tags-value","traffictype":"traffictype-value"}`, &UpdateNetworkOfferingResponse{})
+}
+
+func TestListNetworkOfferingsParams(t *testing.T) {
+	p := &ListNetworkOfferingsParams{}
+	want := url.Values{}
+	p.SetAvailability("availability-value")
+	want.Set("availability", "availability-value")
+	p.SetDisplaytext("displaytext-value")
+	want.Set("displaytext", "displaytext-value")
+	p.SetForvpc(true)
+	want.Set("forvpc", "true")
+	p.SetGuestiptype("guestiptype-value")
+	want.Set("guestiptype", "guestiptype-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsdefault(true)
+	want.Set("isdefault", "true")
+	p.SetIstagged(true)
+	want.Set("istagged", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetNetworkid("networkid-value")
+	want.Set("networkid", "networkid-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetSourcenatsupported(true)
+	want.Set("sourcenatsupported", "true")
+	p.SetSpecifyipranges(true)
+	want.Set("specifyipranges", "true")
+	p.SetSpecifyvlan(true)
+	want.Set("specifyvlan", "true")
+	p.SetState("state-value")
+	want.Set("state", "state-value")
+	p.SetSupportedservices([]string{"a", "b"})
+	want.Set("supportedservices", "a,b")
+	p.SetTags("tags-value")
+	want.Set("tags", "tags-value")
+	p.SetTraffictype("traffictype-value")
+	want.Set("traffictype", "traffictype-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listNetworkOfferings", want)
+}
+
+func TestListNetworkOfferingsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"networkoffering":[{"availability":"availability-value","conservemode":true,"created":"created-value","details":{"key":"value"},"displaytext":"displaytext-value","egressdefaultpolicy":true,"forvpc":true,"guestiptype":"guestiptype-value","id":"id-value","isdefault":true,"ispersistent":true,"maxconnections":42,"name":"name-value","networkrate":42,"secondaryserviceofferingid":"secondaryserviceofferingid-value","secondaryserviceofferingname":"secondaryserviceofferingname-value","service":[{"capability":[{"canchooseservicecapability":true,"name":"name-value","value":"value-value"}],"name":"name-value","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"destinationphysicalnetworkid-value","id":"id-value","name":"name-value","physicalnetworkid":"physicalnetworkid-value","servicelist":["a","b"],"state":"state-value"}]}],"serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","specifyipranges":true,"specifyvlan":true,"state":"state-value","supportsstrechedl2subnet":true,"tags":"tags-value","traffictype":"traffictype-value"}]}`, &ListNetworkOfferingsResponse{})
+}
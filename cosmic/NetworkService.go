@@ -17,17 +17,121 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 )
 
+// NetworkServiceIface is the interface implemented by NetworkService, and by MockNetworkService for tests
+type NetworkServiceIface interface {
+	NewCreateNetworkParams(displaytext string, name string, networkofferingid string, zoneid string) *CreateNetworkParams
+	CreateNetwork(p *CreateNetworkParams) (*CreateNetworkResponse, error)
+	CreateNetworkWithContext(ctx context.Context, p *CreateNetworkParams) (*CreateNetworkResponse, error)
+	NewDeleteNetworkParams(id string) *DeleteNetworkParams
+	DeleteNetwork(p *DeleteNetworkParams) (*DeleteNetworkResponse, error)
+	DeleteNetworkWithContext(ctx context.Context, p *DeleteNetworkParams) (*DeleteNetworkResponse, error)
+	DeleteNetworkAsync(ctx context.Context, p *DeleteNetworkParams) (*DeleteNetworkJob, error)
+	NewRestartNetworkParams(id string) *RestartNetworkParams
+	RestartNetwork(p *RestartNetworkParams) (*RestartNetworkResponse, error)
+	RestartNetworkWithContext(ctx context.Context, p *RestartNetworkParams) (*RestartNetworkResponse, error)
+	RestartNetworkAsync(ctx context.Context, p *RestartNetworkParams) (*RestartNetworkJob, error)
+	NewUpdateNetworkParams(id string) *UpdateNetworkParams
+	UpdateNetwork(p *UpdateNetworkParams) (*UpdateNetworkResponse, error)
+	UpdateNetworkWithContext(ctx context.Context, p *UpdateNetworkParams) (*UpdateNetworkResponse, error)
+	UpdateNetworkAsync(ctx context.Context, p *UpdateNetworkParams) (*UpdateNetworkJob, error)
+	NewListNetworkIsolationMethodsParams() *ListNetworkIsolationMethodsParams
+	ListNetworkIsolationMethods(p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error)
+	ListNetworkIsolationMethodsWithContext(ctx context.Context, p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error)
+	NewListNetworkIsolationMethodsIterator(p *ListNetworkIsolationMethodsParams, pagesize int) *ListNetworkIsolationMethodsIterator
+	NewAddNetworkServiceProviderParams(name string, physicalnetworkid string) *AddNetworkServiceProviderParams
+	AddNetworkServiceProvider(p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderResponse, error)
+	AddNetworkServiceProviderWithContext(ctx context.Context, p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderResponse, error)
+	AddNetworkServiceProviderAsync(ctx context.Context, p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderJob, error)
+	NewDeleteNetworkServiceProviderParams(id string) *DeleteNetworkServiceProviderParams
+	DeleteNetworkServiceProvider(p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error)
+	DeleteNetworkServiceProviderWithContext(ctx context.Context, p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error)
+	DeleteNetworkServiceProviderAsync(ctx context.Context, p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderJob, error)
+	NewUpdateNetworkServiceProviderParams(id string) *UpdateNetworkServiceProviderParams
+	UpdateNetworkServiceProvider(p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error)
+	UpdateNetworkServiceProviderWithContext(ctx context.Context, p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error)
+	UpdateNetworkServiceProviderAsync(ctx context.Context, p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderJob, error)
+	NewListNetworkServiceProvidersParams() *ListNetworkServiceProvidersParams
+	GetNetworkServiceProviderID(name string, opts ...OptionFunc) (string, int, error)
+	ListNetworkServiceProviders(p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error)
+	ListNetworkServiceProvidersWithContext(ctx context.Context, p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error)
+	NewListNetworkServiceProvidersIterator(p *ListNetworkServiceProvidersParams, pagesize int) *ListNetworkServiceProvidersIterator
+	NewListNetworksParams() *ListNetworksParams
+	GetNetworkID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetNetworkByName(name string, opts ...OptionFunc) (*Network, int, error)
+	GetNetworkByID(id string, opts ...OptionFunc) (*Network, int, error)
+	ListNetworks(p *ListNetworksParams) (*ListNetworksResponse, error)
+	ListNetworksWithContext(ctx context.Context, p *ListNetworksParams) (*ListNetworksResponse, error)
+	NewListNetworksIterator(p *ListNetworksParams, pagesize int) *ListNetworksIterator
+	NewListNiciraNvpDeviceNetworksParams(nvpdeviceid string) *ListNiciraNvpDeviceNetworksParams
+	GetNiciraNvpDeviceNetworkID(keyword string, nvpdeviceid string, opts ...OptionFunc) (string, int, error)
+	ListNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error)
+	ListNiciraNvpDeviceNetworksWithContext(ctx context.Context, p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error)
+	NewListNiciraNvpDeviceNetworksIterator(p *ListNiciraNvpDeviceNetworksParams, pagesize int) *ListNiciraNvpDeviceNetworksIterator
+	NewCreatePhysicalNetworkParams(name string, zoneid string) *CreatePhysicalNetworkParams
+	CreatePhysicalNetwork(p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error)
+	CreatePhysicalNetworkWithContext(ctx context.Context, p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error)
+	CreatePhysicalNetworkAsync(ctx context.Context, p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkJob, error)
+	NewDeletePhysicalNetworkParams(id string) *DeletePhysicalNetworkParams
+	DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error)
+	DeletePhysicalNetworkWithContext(ctx context.Context, p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error)
+	DeletePhysicalNetworkAsync(ctx context.Context, p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkJob, error)
+	NewUpdatePhysicalNetworkParams(id string) *UpdatePhysicalNetworkParams
+	UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error)
+	UpdatePhysicalNetworkWithContext(ctx context.Context, p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error)
+	UpdatePhysicalNetworkAsync(ctx context.Context, p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkJob, error)
+	NewListPhysicalNetworksParams() *ListPhysicalNetworksParams
+	GetPhysicalNetworkID(name string, opts ...OptionFunc) (string, int, error)
+	GetPhysicalNetworkByName(name string, opts ...OptionFunc) (*PhysicalNetwork, int, error)
+	GetPhysicalNetworkByID(id string, opts ...OptionFunc) (*PhysicalNetwork, int, error)
+	ListPhysicalNetworks(p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error)
+	ListPhysicalNetworksWithContext(ctx context.Context, p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error)
+	NewListPhysicalNetworksIterator(p *ListPhysicalNetworksParams, pagesize int) *ListPhysicalNetworksIterator
+	NewDedicatePublicIpRangeParams(domainid string, id string) *DedicatePublicIpRangeParams
+	DedicatePublicIpRange(p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error)
+	DedicatePublicIpRangeWithContext(ctx context.Context, p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error)
+	NewReleasePublicIpRangeParams(id string) *ReleasePublicIpRangeParams
+	ReleasePublicIpRange(p *ReleasePublicIpRangeParams) (*ReleasePublicIpRangeResponse, error)
+	ReleasePublicIpRangeWithContext(ctx context.Context, p *ReleasePublicIpRangeParams) (*ReleasePublicIpRangeResponse, error)
+	NewCreateStorageNetworkIpRangeParams(gateway string, netmask string, podid string, startip string) *CreateStorageNetworkIpRangeParams
+	CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeResponse, error)
+	CreateStorageNetworkIpRangeWithContext(ctx context.Context, p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeResponse, error)
+	CreateStorageNetworkIpRangeAsync(ctx context.Context, p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeJob, error)
+	NewDeleteStorageNetworkIpRangeParams(id string) *DeleteStorageNetworkIpRangeParams
+	DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error)
+	DeleteStorageNetworkIpRangeWithContext(ctx context.Context, p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error)
+	DeleteStorageNetworkIpRangeAsync(ctx context.Context, p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeJob, error)
+	NewListStorageNetworkIpRangeParams() *ListStorageNetworkIpRangeParams
+	GetStorageNetworkIpRangeByID(id string, opts ...OptionFunc) (*StorageNetworkIpRange, int, error)
+	ListStorageNetworkIpRange(p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error)
+	ListStorageNetworkIpRangeWithContext(ctx context.Context, p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error)
+	NewListStorageNetworkIpRangeIterator(p *ListStorageNetworkIpRangeParams, pagesize int) *ListStorageNetworkIpRangeIterator
+	NewUpdateStorageNetworkIpRangeParams(id string) *UpdateStorageNetworkIpRangeParams
+	UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeResponse, error)
+	UpdateStorageNetworkIpRangeWithContext(ctx context.Context, p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeResponse, error)
+	UpdateStorageNetworkIpRangeAsync(ctx context.Context, p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeJob, error)
+	NewListSupportedNetworkServicesParams() *ListSupportedNetworkServicesParams
+	ListSupportedNetworkServices(p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error)
+	ListSupportedNetworkServicesWithContext(ctx context.Context, p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error)
+	NewListSupportedNetworkServicesIterator(p *ListSupportedNetworkServicesParams, pagesize int) *ListSupportedNetworkServicesIterator
+}
+
 type CreateNetworkParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateNetworkParams) command() string {
+	return "createNetwork"
+}
+
 func (p *CreateNetworkParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -352,17 +456,19 @@ func (s *NetworkService) NewCreateNetworkParams(displaytext string, name string,
 
 // Creates a network
 func (s *NetworkService) CreateNetwork(p *CreateNetworkParams) (*CreateNetworkResponse, error) {
-	resp, err := s.cs.newRequest("createNetwork", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+	return s.CreateNetworkWithContext(context.Background(), p)
+}
 
-	if resp, err = getRawValue(resp); err != nil {
+// CreateNetworkWithContext is the same as CreateNetwork, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkService) CreateNetworkWithContext(ctx context.Context, p *CreateNetworkParams) (*CreateNetworkResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createNetwork", p.toURLValues())
+	if err != nil {
 		return nil, err
 	}
 
 	var r CreateNetworkResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := unmarshalValue(resp, "network", &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
@@ -455,6 +561,10 @@ type DeleteNetworkParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteNetworkParams) command() string {
+	return "deleteNetwork"
+}
+
 func (p *DeleteNetworkParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -495,7 +605,13 @@ func (s *NetworkService) NewDeleteNetworkParams(id string) *DeleteNetworkParams
 
 // Deletes a network
 func (s *NetworkService) DeleteNetwork(p *DeleteNetworkParams) (*DeleteNetworkResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetwork", p.toURLValues())
+	return s.DeleteNetworkWithContext(context.Background(), p)
+}
+
+// DeleteNetworkWithContext is the same as DeleteNetwork, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkService) DeleteNetworkWithContext(ctx context.Context, p *DeleteNetworkParams) (*DeleteNetworkResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteNetwork", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -507,9 +623,9 @@ func (s *NetworkService) DeleteNetwork(p *DeleteNetworkParams) (*DeleteNetworkRe
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteNetwork", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -522,6 +638,41 @@ func (s *NetworkService) DeleteNetwork(p *DeleteNetworkParams) (*DeleteNetworkRe
 	return &r, nil
 }
 
+// DeleteNetworkJob is a handle to a running deleteNetwork async job
+type DeleteNetworkJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteNetworkResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteNetworkJob) Result() (*DeleteNetworkResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteNetworkResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteNetworkAsync starts the deleteNetwork async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NetworkService) DeleteNetworkAsync(ctx context.Context, p *DeleteNetworkParams) (*DeleteNetworkJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteNetwork", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteNetworkResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteNetworkJob{s.cs.newJobHandle("deleteNetwork", r.JobID)}, nil
+}
+
 type DeleteNetworkResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -532,6 +683,10 @@ type RestartNetworkParams struct {
 	p map[string]interface{}
 }
 
+func (p *RestartNetworkParams) command() string {
+	return "restartNetwork"
+}
+
 func (p *RestartNetworkParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -572,7 +727,13 @@ func (s *NetworkService) NewRestartNetworkParams(id string) *RestartNetworkParam
 
 // Restarts the network; includes 1) restarting network elements - virtual routers, DHCP servers 2) reapplying all public IPs 3) reapplying loadBalancing/portForwarding rules
 func (s *NetworkService) RestartNetwork(p *RestartNetworkParams) (*RestartNetworkResponse, error) {
-	resp, err := s.cs.newRequest("restartNetwork", p.toURLValues())
+	return s.RestartNetworkWithContext(context.Background(), p)
+}
+
+// RestartNetworkWithContext is the same as RestartNetwork, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkService) RestartNetworkWithContext(ctx context.Context, p *RestartNetworkParams) (*RestartNetworkResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "restartNetwork", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -584,26 +745,56 @@ func (s *NetworkService) RestartNetwork(p *RestartNetworkParams) (*RestartNetwor
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "restartNetwork", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "network", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// RestartNetworkJob is a handle to a running restartNetwork async job
+type RestartNetworkJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a RestartNetworkResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *RestartNetworkJob) Result() (*RestartNetworkResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := RestartNetworkResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "network", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// RestartNetworkAsync starts the restartNetwork async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NetworkService) RestartNetworkAsync(ctx context.Context, p *RestartNetworkParams) (*RestartNetworkJob, error) {
+	resp, err := s.cs.newRequest(ctx, "restartNetwork", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RestartNetworkResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &RestartNetworkJob{s.cs.newJobHandle("restartNetwork", r.JobID)}, nil
+}
+
 type RestartNetworkResponse struct {
 	JobID                 string `json:"jobid,omitempty"`
 	Account               string `json:"account,omitempty"`
@@ -654,6 +845,10 @@ type UpdateNetworkParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateNetworkParams) command() string {
+	return "updateNetwork"
+}
+
 func (p *UpdateNetworkParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -815,7 +1010,13 @@ func (s *NetworkService) NewUpdateNetworkParams(id string) *UpdateNetworkParams
 
 // Updates a network
 func (s *NetworkService) UpdateNetwork(p *UpdateNetworkParams) (*UpdateNetworkResponse, error) {
-	resp, err := s.cs.newRequest("updateNetwork", p.toURLValues())
+	return s.UpdateNetworkWithContext(context.Background(), p)
+}
+
+// UpdateNetworkWithContext is the same as UpdateNetwork, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkService) UpdateNetworkWithContext(ctx context.Context, p *UpdateNetworkParams) (*UpdateNetworkResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateNetwork", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -827,26 +1028,56 @@ func (s *NetworkService) UpdateNetwork(p *UpdateNetworkParams) (*UpdateNetworkRe
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateNetwork", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "network", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// UpdateNetworkJob is a handle to a running updateNetwork async job
+type UpdateNetworkJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateNetworkResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateNetworkJob) Result() (*UpdateNetworkResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateNetworkResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "network", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// UpdateNetworkAsync starts the updateNetwork async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NetworkService) UpdateNetworkAsync(ctx context.Context, p *UpdateNetworkParams) (*UpdateNetworkJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateNetwork", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateNetworkResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateNetworkJob{s.cs.newJobHandle("updateNetwork", r.JobID)}, nil
+}
+
 type UpdateNetworkResponse struct {
 	JobID                       string `json:"jobid,omitempty"`
 	Account                     string `json:"account,omitempty"`
@@ -935,6 +1166,10 @@ type ListNetworkIsolationMethodsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListNetworkIsolationMethodsParams) command() string {
+	return "listNetworkIsolationMethods"
+}
+
 func (p *ListNetworkIsolationMethodsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -985,10 +1220,17 @@ func (s *NetworkService) NewListNetworkIsolationMethodsParams() *ListNetworkIsol
 
 // Lists supported methods of network isolation
 func (s *NetworkService) ListNetworkIsolationMethods(p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error) {
+	return s.ListNetworkIsolationMethodsWithContext(context.Background(), p)
+}
+
+// ListNetworkIsolationMethodsWithContext is the same as ListNetworkIsolationMethods, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkService) ListNetworkIsolationMethodsWithContext(ctx context.Context, p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error) {
 	var r ListNetworkIsolationMethodsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListNetworkIsolationMethodsResponse
-		resp, err := s.cs.newRequest("listNetworkIsolationMethods", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listNetworkIsolationMethods", values)
 		if err != nil {
 			return nil, err
 		}
@@ -1000,13 +1242,89 @@ func (s *NetworkService) ListNetworkIsolationMethods(p *ListNetworkIsolationMeth
 		r.Count = l.Count
 		r.NetworkIsolationMethods = append(r.NetworkIsolationMethods, l.NetworkIsolationMethods...)
 
-		if r.Count == len(r.NetworkIsolationMethods) {
+		if r.Count == len(r.NetworkIsolationMethods) || len(l.NetworkIsolationMethods) == 0 {
+			s.cs.observePages("listNetworkIsolationMethods", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.NetworkIsolationMethods))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.NetworkIsolationMethods)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listNetworkIsolationMethods", values, len(l.NetworkIsolationMethods), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListNetworkIsolationMethodsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.NetworkIsolationMethods = append(r.NetworkIsolationMethods, l.NetworkIsolationMethods...)
+			}
+
+			if r.Count == len(r.NetworkIsolationMethods) {
+				s.cs.observePages("listNetworkIsolationMethods", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListNetworkIsolationMethodsIterator iterates over the items returned by listNetworkIsolationMethods, requesting
+// the pages of the response one at a time while iterating.
+type ListNetworkIsolationMethodsIterator struct {
+	pager
+	items []*NetworkIsolationMethod
+	index int
+}
+
+// NewListNetworkIsolationMethodsIterator returns an iterator over the items returned by listNetworkIsolationMethods, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *NetworkService) NewListNetworkIsolationMethodsIterator(p *ListNetworkIsolationMethodsParams, pagesize int) *ListNetworkIsolationMethodsIterator {
+	return &ListNetworkIsolationMethodsIterator{pager: newPager(s.cs, "listNetworkIsolationMethods", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListNetworkIsolationMethodsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListNetworkIsolationMethodsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.NetworkIsolationMethods))
+
+	i.items = l.NetworkIsolationMethods
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListNetworkIsolationMethodsIterator) Value() *NetworkIsolationMethod {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListNetworkIsolationMethodsResponse struct {
@@ -1022,6 +1340,10 @@ type AddNetworkServiceProviderParams struct {
 	p map[string]interface{}
 }
 
+func (p *AddNetworkServiceProviderParams) command() string {
+	return "addNetworkServiceProvider"
+}
+
 func (p *AddNetworkServiceProviderParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1083,7 +1405,13 @@ func (s *NetworkService) NewAddNetworkServiceProviderParams(name string, physica
 
 // Adds a network serviceProvider to a physical network
 func (s *NetworkService) AddNetworkServiceProvider(p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderResponse, error) {
-	resp, err := s.cs.newRequest("addNetworkServiceProvider", p.toURLValues())
+	return s.AddNetworkServiceProviderWithContext(context.Background(), p)
+}
+
+// AddNetworkServiceProviderWithContext is the same as AddNetworkServiceProvider, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkService) AddNetworkServiceProviderWithContext(ctx context.Context, p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "addNetworkServiceProvider", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1095,26 +1423,56 @@ func (s *NetworkService) AddNetworkServiceProvider(p *AddNetworkServiceProviderP
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "addNetworkServiceProvider", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "networkserviceprovider", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// AddNetworkServiceProviderJob is a handle to a running addNetworkServiceProvider async job
+type AddNetworkServiceProviderJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a AddNetworkServiceProviderResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *AddNetworkServiceProviderJob) Result() (*AddNetworkServiceProviderResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := AddNetworkServiceProviderResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "networkserviceprovider", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// AddNetworkServiceProviderAsync starts the addNetworkServiceProvider async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NetworkService) AddNetworkServiceProviderAsync(ctx context.Context, p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderJob, error) {
+	resp, err := s.cs.newRequest(ctx, "addNetworkServiceProvider", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddNetworkServiceProviderResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &AddNetworkServiceProviderJob{s.cs.newJobHandle("addNetworkServiceProvider", r.JobID)}, nil
+}
+
 type AddNetworkServiceProviderResponse struct {
 	JobID                        string   `json:"jobid,omitempty"`
 	Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
@@ -1130,6 +1488,10 @@ type DeleteNetworkServiceProviderParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteNetworkServiceProviderParams) command() string {
+	return "deleteNetworkServiceProvider"
+}
+
 func (p *DeleteNetworkServiceProviderParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1159,7 +1521,13 @@ func (s *NetworkService) NewDeleteNetworkServiceProviderParams(id string) *Delet
 
 // Deletes a Network Service Provider.
 func (s *NetworkService) DeleteNetworkServiceProvider(p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkServiceProvider", p.toURLValues())
+	return s.DeleteNetworkServiceProviderWithContext(context.Background(), p)
+}
+
+// DeleteNetworkServiceProviderWithContext is the same as DeleteNetworkServiceProvider, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkService) DeleteNetworkServiceProviderWithContext(ctx context.Context, p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteNetworkServiceProvider", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1171,9 +1539,9 @@ func (s *NetworkService) DeleteNetworkServiceProvider(p *DeleteNetworkServicePro
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteNetworkServiceProvider", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -1186,6 +1554,41 @@ func (s *NetworkService) DeleteNetworkServiceProvider(p *DeleteNetworkServicePro
 	return &r, nil
 }
 
+// DeleteNetworkServiceProviderJob is a handle to a running deleteNetworkServiceProvider async job
+type DeleteNetworkServiceProviderJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteNetworkServiceProviderResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteNetworkServiceProviderJob) Result() (*DeleteNetworkServiceProviderResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteNetworkServiceProviderResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteNetworkServiceProviderAsync starts the deleteNetworkServiceProvider async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NetworkService) DeleteNetworkServiceProviderAsync(ctx context.Context, p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteNetworkServiceProvider", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteNetworkServiceProviderResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteNetworkServiceProviderJob{s.cs.newJobHandle("deleteNetworkServiceProvider", r.JobID)}, nil
+}
+
 type DeleteNetworkServiceProviderResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -1196,6 +1599,10 @@ type UpdateNetworkServiceProviderParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateNetworkServiceProviderParams) command() string {
+	return "updateNetworkServiceProvider"
+}
+
 func (p *UpdateNetworkServiceProviderParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1246,7 +1653,13 @@ func (s *NetworkService) NewUpdateNetworkServiceProviderParams(id string) *Updat
 
 // Updates a network serviceProvider of a physical network
 func (s *NetworkService) UpdateNetworkServiceProvider(p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error) {
-	resp, err := s.cs.newRequest("updateNetworkServiceProvider", p.toURLValues())
+	return s.UpdateNetworkServiceProviderWithContext(context.Background(), p)
+}
+
+// UpdateNetworkServiceProviderWithContext is the same as UpdateNetworkServiceProvider, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkService) UpdateNetworkServiceProviderWithContext(ctx context.Context, p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateNetworkServiceProvider", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1258,26 +1671,56 @@ func (s *NetworkService) UpdateNetworkServiceProvider(p *UpdateNetworkServicePro
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateNetworkServiceProvider", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "networkserviceprovider", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// UpdateNetworkServiceProviderJob is a handle to a running updateNetworkServiceProvider async job
+type UpdateNetworkServiceProviderJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateNetworkServiceProviderResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateNetworkServiceProviderJob) Result() (*UpdateNetworkServiceProviderResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateNetworkServiceProviderResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "networkserviceprovider", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// UpdateNetworkServiceProviderAsync starts the updateNetworkServiceProvider async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NetworkService) UpdateNetworkServiceProviderAsync(ctx context.Context, p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateNetworkServiceProvider", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateNetworkServiceProviderResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateNetworkServiceProviderJob{s.cs.newJobHandle("updateNetworkServiceProvider", r.JobID)}, nil
+}
+
 type UpdateNetworkServiceProviderResponse struct {
 	JobID                        string   `json:"jobid,omitempty"`
 	Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
@@ -1293,6 +1736,10 @@ type ListNetworkServiceProvidersParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListNetworkServiceProvidersParams) command() string {
+	return "listNetworkServiceProviders"
+}
+
 func (p *ListNetworkServiceProvidersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1390,7 +1837,7 @@ func (s *NetworkService) GetNetworkServiceProviderID(name string, opts ...Option
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -1404,15 +1851,22 @@ func (s *NetworkService) GetNetworkServiceProviderID(name string, opts ...Option
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // Lists network serviceproviders for a given physical network.
 func (s *NetworkService) ListNetworkServiceProviders(p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error) {
+	return s.ListNetworkServiceProvidersWithContext(context.Background(), p)
+}
+
+// ListNetworkServiceProvidersWithContext is the same as ListNetworkServiceProviders, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkService) ListNetworkServiceProvidersWithContext(ctx context.Context, p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error) {
 	var r ListNetworkServiceProvidersResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListNetworkServiceProvidersResponse
-		resp, err := s.cs.newRequest("listNetworkServiceProviders", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listNetworkServiceProviders", values)
 		if err != nil {
 			return nil, err
 		}
@@ -1424,13 +1878,89 @@ func (s *NetworkService) ListNetworkServiceProviders(p *ListNetworkServiceProvid
 		r.Count = l.Count
 		r.NetworkServiceProviders = append(r.NetworkServiceProviders, l.NetworkServiceProviders...)
 
-		if r.Count == len(r.NetworkServiceProviders) {
+		if r.Count == len(r.NetworkServiceProviders) || len(l.NetworkServiceProviders) == 0 {
+			s.cs.observePages("listNetworkServiceProviders", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.NetworkServiceProviders))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.NetworkServiceProviders)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listNetworkServiceProviders", values, len(l.NetworkServiceProviders), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListNetworkServiceProvidersResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.NetworkServiceProviders = append(r.NetworkServiceProviders, l.NetworkServiceProviders...)
+			}
+
+			if r.Count == len(r.NetworkServiceProviders) {
+				s.cs.observePages("listNetworkServiceProviders", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListNetworkServiceProvidersIterator iterates over the items returned by listNetworkServiceProviders, requesting
+// the pages of the response one at a time while iterating.
+type ListNetworkServiceProvidersIterator struct {
+	pager
+	items []*NetworkServiceProvider
+	index int
+}
+
+// NewListNetworkServiceProvidersIterator returns an iterator over the items returned by listNetworkServiceProviders, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *NetworkService) NewListNetworkServiceProvidersIterator(p *ListNetworkServiceProvidersParams, pagesize int) *ListNetworkServiceProvidersIterator {
+	return &ListNetworkServiceProvidersIterator{pager: newPager(s.cs, "listNetworkServiceProviders", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListNetworkServiceProvidersIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListNetworkServiceProvidersResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
 	}
+	i.received(l.Count, len(l.NetworkServiceProviders))
+
+	i.items = l.NetworkServiceProviders
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListNetworkServiceProvidersIterator) Value() *NetworkServiceProvider {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
 }
 
 type ListNetworkServiceProvidersResponse struct {
@@ -1452,6 +1982,10 @@ type ListNetworksParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListNetworksParams) command() string {
+	return "listNetworks"
+}
+
 func (p *ListNetworksParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1733,7 +2267,7 @@ func (s *NetworkService) GetNetworkID(keyword string, opts ...OptionFunc) (strin
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -1747,7 +2281,7 @@ func (s *NetworkService) GetNetworkID(keyword string, opts ...OptionFunc) (strin
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -1779,16 +2313,14 @@ func (s *NetworkService) GetNetworkByID(id string, opts ...OptionFunc) (*Network
 
 	l, err := s.ListNetworks(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -1800,10 +2332,17 @@ func (s *NetworkService) GetNetworkByID(id string, opts ...OptionFunc) (*Network
 
 // Lists all available networks.
 func (s *NetworkService) ListNetworks(p *ListNetworksParams) (*ListNetworksResponse, error) {
+	return s.ListNetworksWithContext(context.Background(), p)
+}
+
+// ListNetworksWithContext is the same as ListNetworks, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkService) ListNetworksWithContext(ctx context.Context, p *ListNetworksParams) (*ListNetworksResponse, error) {
 	var r ListNetworksResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListNetworksResponse
-		resp, err := s.cs.newRequest("listNetworks", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listNetworks", values)
 		if err != nil {
 			return nil, err
 		}
@@ -1815,13 +2354,89 @@ func (s *NetworkService) ListNetworks(p *ListNetworksParams) (*ListNetworksRespo
 		r.Count = l.Count
 		r.Networks = append(r.Networks, l.Networks...)
 
-		if r.Count == len(r.Networks) {
+		if r.Count == len(r.Networks) || len(l.Networks) == 0 {
+			s.cs.observePages("listNetworks", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.Networks))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.Networks)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listNetworks", values, len(l.Networks), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListNetworksResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.Networks = append(r.Networks, l.Networks...)
+			}
+
+			if r.Count == len(r.Networks) {
+				s.cs.observePages("listNetworks", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListNetworksIterator iterates over the items returned by listNetworks, requesting
+// the pages of the response one at a time while iterating.
+type ListNetworksIterator struct {
+	pager
+	items []*Network
+	index int
+}
+
+// NewListNetworksIterator returns an iterator over the items returned by listNetworks, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *NetworkService) NewListNetworksIterator(p *ListNetworksParams, pagesize int) *ListNetworksIterator {
+	return &ListNetworksIterator{pager: newPager(s.cs, "listNetworks", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListNetworksIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListNetworksResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.Networks))
+
+	i.items = l.Networks
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListNetworksIterator) Value() *Network {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListNetworksResponse struct {
@@ -1916,6 +2531,10 @@ type ListNiciraNvpDeviceNetworksParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListNiciraNvpDeviceNetworksParams) command() string {
+	return "listNiciraNvpDeviceNetworks"
+}
+
 func (p *ListNiciraNvpDeviceNetworksParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1995,7 +2614,7 @@ func (s *NetworkService) GetNiciraNvpDeviceNetworkID(keyword string, nvpdeviceid
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -2009,15 +2628,22 @@ func (s *NetworkService) GetNiciraNvpDeviceNetworkID(keyword string, nvpdeviceid
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // lists network that are using a nicira nvp device
 func (s *NetworkService) ListNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error) {
+	return s.ListNiciraNvpDeviceNetworksWithContext(context.Background(), p)
+}
+
+// ListNiciraNvpDeviceNetworksWithContext is the same as ListNiciraNvpDeviceNetworks, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkService) ListNiciraNvpDeviceNetworksWithContext(ctx context.Context, p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error) {
 	var r ListNiciraNvpDeviceNetworksResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListNiciraNvpDeviceNetworksResponse
-		resp, err := s.cs.newRequest("listNiciraNvpDeviceNetworks", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listNiciraNvpDeviceNetworks", values)
 		if err != nil {
 			return nil, err
 		}
@@ -2029,13 +2655,89 @@ func (s *NetworkService) ListNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetwo
 		r.Count = l.Count
 		r.NiciraNvpDeviceNetworks = append(r.NiciraNvpDeviceNetworks, l.NiciraNvpDeviceNetworks...)
 
-		if r.Count == len(r.NiciraNvpDeviceNetworks) {
+		if r.Count == len(r.NiciraNvpDeviceNetworks) || len(l.NiciraNvpDeviceNetworks) == 0 {
+			s.cs.observePages("listNiciraNvpDeviceNetworks", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.NiciraNvpDeviceNetworks))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.NiciraNvpDeviceNetworks)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listNiciraNvpDeviceNetworks", values, len(l.NiciraNvpDeviceNetworks), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListNiciraNvpDeviceNetworksResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.NiciraNvpDeviceNetworks = append(r.NiciraNvpDeviceNetworks, l.NiciraNvpDeviceNetworks...)
+			}
+
+			if r.Count == len(r.NiciraNvpDeviceNetworks) {
+				s.cs.observePages("listNiciraNvpDeviceNetworks", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListNiciraNvpDeviceNetworksIterator iterates over the items returned by listNiciraNvpDeviceNetworks, requesting
+// the pages of the response one at a time while iterating.
+type ListNiciraNvpDeviceNetworksIterator struct {
+	pager
+	items []*NiciraNvpDeviceNetwork
+	index int
+}
+
+// NewListNiciraNvpDeviceNetworksIterator returns an iterator over the items returned by listNiciraNvpDeviceNetworks, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *NetworkService) NewListNiciraNvpDeviceNetworksIterator(p *ListNiciraNvpDeviceNetworksParams, pagesize int) *ListNiciraNvpDeviceNetworksIterator {
+	return &ListNiciraNvpDeviceNetworksIterator{pager: newPager(s.cs, "listNiciraNvpDeviceNetworks", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListNiciraNvpDeviceNetworksIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListNiciraNvpDeviceNetworksResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.NiciraNvpDeviceNetworks))
+
+	i.items = l.NiciraNvpDeviceNetworks
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListNiciraNvpDeviceNetworksIterator) Value() *NiciraNvpDeviceNetwork {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListNiciraNvpDeviceNetworksResponse struct {
@@ -2130,6 +2832,10 @@ type CreatePhysicalNetworkParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreatePhysicalNetworkParams) command() string {
+	return "createPhysicalNetwork"
+}
+
 func (p *CreatePhysicalNetworkParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2232,7 +2938,13 @@ func (s *NetworkService) NewCreatePhysicalNetworkParams(name string, zoneid stri
 
 // Creates a physical network
 func (s *NetworkService) CreatePhysicalNetwork(p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error) {
-	resp, err := s.cs.newRequest("createPhysicalNetwork", p.toURLValues())
+	return s.CreatePhysicalNetworkWithContext(context.Background(), p)
+}
+
+// CreatePhysicalNetworkWithContext is the same as CreatePhysicalNetwork, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkService) CreatePhysicalNetworkWithContext(ctx context.Context, p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createPhysicalNetwork", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2244,26 +2956,56 @@ func (s *NetworkService) CreatePhysicalNetwork(p *CreatePhysicalNetworkParams) (
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createPhysicalNetwork", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "physicalnetwork", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CreatePhysicalNetworkJob is a handle to a running createPhysicalNetwork async job
+type CreatePhysicalNetworkJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreatePhysicalNetworkResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreatePhysicalNetworkJob) Result() (*CreatePhysicalNetworkResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreatePhysicalNetworkResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "physicalnetwork", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CreatePhysicalNetworkAsync starts the createPhysicalNetwork async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NetworkService) CreatePhysicalNetworkAsync(ctx context.Context, p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createPhysicalNetwork", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreatePhysicalNetworkResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreatePhysicalNetworkJob{s.cs.newJobHandle("createPhysicalNetwork", r.JobID)}, nil
+}
+
 type CreatePhysicalNetworkResponse struct {
 	JobID                string `json:"jobid,omitempty"`
 	Broadcastdomainrange string `json:"broadcastdomainrange,omitempty"`
@@ -2282,6 +3024,10 @@ type DeletePhysicalNetworkParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeletePhysicalNetworkParams) command() string {
+	return "deletePhysicalNetwork"
+}
+
 func (p *DeletePhysicalNetworkParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2311,7 +3057,13 @@ func (s *NetworkService) NewDeletePhysicalNetworkParams(id string) *DeletePhysic
 
 // Deletes a Physical Network.
 func (s *NetworkService) DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error) {
-	resp, err := s.cs.newRequest("deletePhysicalNetwork", p.toURLValues())
+	return s.DeletePhysicalNetworkWithContext(context.Background(), p)
+}
+
+// DeletePhysicalNetworkWithContext is the same as DeletePhysicalNetwork, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkService) DeletePhysicalNetworkWithContext(ctx context.Context, p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deletePhysicalNetwork", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2323,9 +3075,9 @@ func (s *NetworkService) DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deletePhysicalNetwork", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -2338,6 +3090,41 @@ func (s *NetworkService) DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (
 	return &r, nil
 }
 
+// DeletePhysicalNetworkJob is a handle to a running deletePhysicalNetwork async job
+type DeletePhysicalNetworkJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeletePhysicalNetworkResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeletePhysicalNetworkJob) Result() (*DeletePhysicalNetworkResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeletePhysicalNetworkResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeletePhysicalNetworkAsync starts the deletePhysicalNetwork async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NetworkService) DeletePhysicalNetworkAsync(ctx context.Context, p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deletePhysicalNetwork", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeletePhysicalNetworkResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeletePhysicalNetworkJob{s.cs.newJobHandle("deletePhysicalNetwork", r.JobID)}, nil
+}
+
 type DeletePhysicalNetworkResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -2348,6 +3135,10 @@ type UpdatePhysicalNetworkParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdatePhysicalNetworkParams) command() string {
+	return "updatePhysicalNetwork"
+}
+
 func (p *UpdatePhysicalNetworkParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2418,7 +3209,13 @@ func (s *NetworkService) NewUpdatePhysicalNetworkParams(id string) *UpdatePhysic
 
 // Updates a physical network
 func (s *NetworkService) UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error) {
-	resp, err := s.cs.newRequest("updatePhysicalNetwork", p.toURLValues())
+	return s.UpdatePhysicalNetworkWithContext(context.Background(), p)
+}
+
+// UpdatePhysicalNetworkWithContext is the same as UpdatePhysicalNetwork, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkService) UpdatePhysicalNetworkWithContext(ctx context.Context, p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updatePhysicalNetwork", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2430,26 +3227,56 @@ func (s *NetworkService) UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams) (
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updatePhysicalNetwork", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "physicalnetwork", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// UpdatePhysicalNetworkJob is a handle to a running updatePhysicalNetwork async job
+type UpdatePhysicalNetworkJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdatePhysicalNetworkResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdatePhysicalNetworkJob) Result() (*UpdatePhysicalNetworkResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdatePhysicalNetworkResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "physicalnetwork", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// UpdatePhysicalNetworkAsync starts the updatePhysicalNetwork async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NetworkService) UpdatePhysicalNetworkAsync(ctx context.Context, p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updatePhysicalNetwork", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdatePhysicalNetworkResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdatePhysicalNetworkJob{s.cs.newJobHandle("updatePhysicalNetwork", r.JobID)}, nil
+}
+
 type UpdatePhysicalNetworkResponse struct {
 	JobID                string `json:"jobid,omitempty"`
 	Broadcastdomainrange string `json:"broadcastdomainrange,omitempty"`
@@ -2468,6 +3295,10 @@ type ListPhysicalNetworksParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListPhysicalNetworksParams) command() string {
+	return "listPhysicalNetworks"
+}
+
 func (p *ListPhysicalNetworksParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2565,7 +3396,7 @@ func (s *NetworkService) GetPhysicalNetworkID(name string, opts ...OptionFunc) (
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -2579,7 +3410,7 @@ func (s *NetworkService) GetPhysicalNetworkID(name string, opts ...OptionFunc) (
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -2611,16 +3442,14 @@ func (s *NetworkService) GetPhysicalNetworkByID(id string, opts ...OptionFunc) (
 
 	l, err := s.ListPhysicalNetworks(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -2632,10 +3461,17 @@ func (s *NetworkService) GetPhysicalNetworkByID(id string, opts ...OptionFunc) (
 
 // Lists physical networks
 func (s *NetworkService) ListPhysicalNetworks(p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error) {
+	return s.ListPhysicalNetworksWithContext(context.Background(), p)
+}
+
+// ListPhysicalNetworksWithContext is the same as ListPhysicalNetworks, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkService) ListPhysicalNetworksWithContext(ctx context.Context, p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error) {
 	var r ListPhysicalNetworksResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListPhysicalNetworksResponse
-		resp, err := s.cs.newRequest("listPhysicalNetworks", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listPhysicalNetworks", values)
 		if err != nil {
 			return nil, err
 		}
@@ -2647,15 +3483,91 @@ func (s *NetworkService) ListPhysicalNetworks(p *ListPhysicalNetworksParams) (*L
 		r.Count = l.Count
 		r.PhysicalNetworks = append(r.PhysicalNetworks, l.PhysicalNetworks...)
 
-		if r.Count == len(r.PhysicalNetworks) {
+		if r.Count == len(r.PhysicalNetworks) || len(l.PhysicalNetworks) == 0 {
+			s.cs.observePages("listPhysicalNetworks", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.PhysicalNetworks))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.PhysicalNetworks)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listPhysicalNetworks", values, len(l.PhysicalNetworks), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListPhysicalNetworksResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.PhysicalNetworks = append(r.PhysicalNetworks, l.PhysicalNetworks...)
+			}
+
+			if r.Count == len(r.PhysicalNetworks) {
+				s.cs.observePages("listPhysicalNetworks", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
 	}
 }
 
+// ListPhysicalNetworksIterator iterates over the items returned by listPhysicalNetworks, requesting
+// the pages of the response one at a time while iterating.
+type ListPhysicalNetworksIterator struct {
+	pager
+	items []*PhysicalNetwork
+	index int
+}
+
+// NewListPhysicalNetworksIterator returns an iterator over the items returned by listPhysicalNetworks, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *NetworkService) NewListPhysicalNetworksIterator(p *ListPhysicalNetworksParams, pagesize int) *ListPhysicalNetworksIterator {
+	return &ListPhysicalNetworksIterator{pager: newPager(s.cs, "listPhysicalNetworks", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListPhysicalNetworksIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListPhysicalNetworksResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.PhysicalNetworks))
+
+	i.items = l.PhysicalNetworks
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListPhysicalNetworksIterator) Value() *PhysicalNetwork {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
+}
+
 type ListPhysicalNetworksResponse struct {
 	Count            int                `json:"count"`
 	PhysicalNetworks []*PhysicalNetwork `json:"physicalnetwork"`
@@ -2678,6 +3590,10 @@ type DedicatePublicIpRangeParams struct {
 	p map[string]interface{}
 }
 
+func (p *DedicatePublicIpRangeParams) command() string {
+	return "dedicatePublicIpRange"
+}
+
 func (p *DedicatePublicIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2738,7 +3654,13 @@ func (s *NetworkService) NewDedicatePublicIpRangeParams(domainid string, id stri
 
 // Dedicates a Public IP range to an account
 func (s *NetworkService) DedicatePublicIpRange(p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("dedicatePublicIpRange", p.toURLValues())
+	return s.DedicatePublicIpRangeWithContext(context.Background(), p)
+}
+
+// DedicatePublicIpRangeWithContext is the same as DedicatePublicIpRange, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkService) DedicatePublicIpRangeWithContext(ctx context.Context, p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "dedicatePublicIpRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2779,6 +3701,10 @@ type ReleasePublicIpRangeParams struct {
 	p map[string]interface{}
 }
 
+func (p *ReleasePublicIpRangeParams) command() string {
+	return "releasePublicIpRange"
+}
+
 func (p *ReleasePublicIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2808,7 +3734,13 @@ func (s *NetworkService) NewReleasePublicIpRangeParams(id string) *ReleasePublic
 
 // Releases a Public IP range back to the system pool
 func (s *NetworkService) ReleasePublicIpRange(p *ReleasePublicIpRangeParams) (*ReleasePublicIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("releasePublicIpRange", p.toURLValues())
+	return s.ReleasePublicIpRangeWithContext(context.Background(), p)
+}
+
+// ReleasePublicIpRangeWithContext is the same as ReleasePublicIpRange, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkService) ReleasePublicIpRangeWithContext(ctx context.Context, p *ReleasePublicIpRangeParams) (*ReleasePublicIpRangeResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "releasePublicIpRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2829,6 +3761,10 @@ type CreateStorageNetworkIpRangeParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateStorageNetworkIpRangeParams) command() string {
+	return "createStorageNetworkIpRange"
+}
+
 func (p *CreateStorageNetworkIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2912,7 +3848,13 @@ func (s *NetworkService) NewCreateStorageNetworkIpRangeParams(gateway string, ne
 
 // Creates a Storage network IP range.
 func (s *NetworkService) CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("createStorageNetworkIpRange", p.toURLValues())
+	return s.CreateStorageNetworkIpRangeWithContext(context.Background(), p)
+}
+
+// CreateStorageNetworkIpRangeWithContext is the same as CreateStorageNetworkIpRange, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkService) CreateStorageNetworkIpRangeWithContext(ctx context.Context, p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createStorageNetworkIpRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2924,26 +3866,56 @@ func (s *NetworkService) CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createStorageNetworkIpRange", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "storagenetworkiprange", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CreateStorageNetworkIpRangeJob is a handle to a running createStorageNetworkIpRange async job
+type CreateStorageNetworkIpRangeJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateStorageNetworkIpRangeResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateStorageNetworkIpRangeJob) Result() (*CreateStorageNetworkIpRangeResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateStorageNetworkIpRangeResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "storagenetworkiprange", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CreateStorageNetworkIpRangeAsync starts the createStorageNetworkIpRange async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NetworkService) CreateStorageNetworkIpRangeAsync(ctx context.Context, p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createStorageNetworkIpRange", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateStorageNetworkIpRangeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateStorageNetworkIpRangeJob{s.cs.newJobHandle("createStorageNetworkIpRange", r.JobID)}, nil
+}
+
 type CreateStorageNetworkIpRangeResponse struct {
 	JobID     string `json:"jobid,omitempty"`
 	Endip     string `json:"endip,omitempty"`
@@ -2961,6 +3933,10 @@ type DeleteStorageNetworkIpRangeParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteStorageNetworkIpRangeParams) command() string {
+	return "deleteStorageNetworkIpRange"
+}
+
 func (p *DeleteStorageNetworkIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2990,7 +3966,13 @@ func (s *NetworkService) NewDeleteStorageNetworkIpRangeParams(id string) *Delete
 
 // Deletes a storage network IP Range.
 func (s *NetworkService) DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("deleteStorageNetworkIpRange", p.toURLValues())
+	return s.DeleteStorageNetworkIpRangeWithContext(context.Background(), p)
+}
+
+// DeleteStorageNetworkIpRangeWithContext is the same as DeleteStorageNetworkIpRange, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkService) DeleteStorageNetworkIpRangeWithContext(ctx context.Context, p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteStorageNetworkIpRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3002,9 +3984,9 @@ func (s *NetworkService) DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteStorageNetworkIpRange", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -3017,6 +3999,41 @@ func (s *NetworkService) DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRa
 	return &r, nil
 }
 
+// DeleteStorageNetworkIpRangeJob is a handle to a running deleteStorageNetworkIpRange async job
+type DeleteStorageNetworkIpRangeJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteStorageNetworkIpRangeResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteStorageNetworkIpRangeJob) Result() (*DeleteStorageNetworkIpRangeResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteStorageNetworkIpRangeResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteStorageNetworkIpRangeAsync starts the deleteStorageNetworkIpRange async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NetworkService) DeleteStorageNetworkIpRangeAsync(ctx context.Context, p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteStorageNetworkIpRange", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteStorageNetworkIpRangeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteStorageNetworkIpRangeJob{s.cs.newJobHandle("deleteStorageNetworkIpRange", r.JobID)}, nil
+}
+
 type DeleteStorageNetworkIpRangeResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -3027,6 +4044,10 @@ type ListStorageNetworkIpRangeParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListStorageNetworkIpRangeParams) command() string {
+	return "listStorageNetworkIpRange"
+}
+
 func (p *ListStorageNetworkIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3120,16 +4141,14 @@ func (s *NetworkService) GetStorageNetworkIpRangeByID(id string, opts ...OptionF
 
 	l, err := s.ListStorageNetworkIpRange(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -3141,10 +4160,17 @@ func (s *NetworkService) GetStorageNetworkIpRangeByID(id string, opts ...OptionF
 
 // List a storage network IP range.
 func (s *NetworkService) ListStorageNetworkIpRange(p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error) {
+	return s.ListStorageNetworkIpRangeWithContext(context.Background(), p)
+}
+
+// ListStorageNetworkIpRangeWithContext is the same as ListStorageNetworkIpRange, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkService) ListStorageNetworkIpRangeWithContext(ctx context.Context, p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error) {
 	var r ListStorageNetworkIpRangeResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListStorageNetworkIpRangeResponse
-		resp, err := s.cs.newRequest("listStorageNetworkIpRange", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listStorageNetworkIpRange", values)
 		if err != nil {
 			return nil, err
 		}
@@ -3156,15 +4182,91 @@ func (s *NetworkService) ListStorageNetworkIpRange(p *ListStorageNetworkIpRangeP
 		r.Count = l.Count
 		r.StorageNetworkIpRange = append(r.StorageNetworkIpRange, l.StorageNetworkIpRange...)
 
-		if r.Count == len(r.StorageNetworkIpRange) {
+		if r.Count == len(r.StorageNetworkIpRange) || len(l.StorageNetworkIpRange) == 0 {
+			s.cs.observePages("listStorageNetworkIpRange", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.StorageNetworkIpRange))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.StorageNetworkIpRange)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listStorageNetworkIpRange", values, len(l.StorageNetworkIpRange), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListStorageNetworkIpRangeResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.StorageNetworkIpRange = append(r.StorageNetworkIpRange, l.StorageNetworkIpRange...)
+			}
+
+			if r.Count == len(r.StorageNetworkIpRange) {
+				s.cs.observePages("listStorageNetworkIpRange", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
 	}
 }
 
+// ListStorageNetworkIpRangeIterator iterates over the items returned by listStorageNetworkIpRange, requesting
+// the pages of the response one at a time while iterating.
+type ListStorageNetworkIpRangeIterator struct {
+	pager
+	items []*StorageNetworkIpRange
+	index int
+}
+
+// NewListStorageNetworkIpRangeIterator returns an iterator over the items returned by listStorageNetworkIpRange, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *NetworkService) NewListStorageNetworkIpRangeIterator(p *ListStorageNetworkIpRangeParams, pagesize int) *ListStorageNetworkIpRangeIterator {
+	return &ListStorageNetworkIpRangeIterator{pager: newPager(s.cs, "listStorageNetworkIpRange", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListStorageNetworkIpRangeIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListStorageNetworkIpRangeResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.StorageNetworkIpRange))
+
+	i.items = l.StorageNetworkIpRange
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListStorageNetworkIpRangeIterator) Value() *StorageNetworkIpRange {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
+}
+
 type ListStorageNetworkIpRangeResponse struct {
 	Count                 int                      `json:"count"`
 	StorageNetworkIpRange []*StorageNetworkIpRange `json:"storagenetworkiprange"`
@@ -3186,6 +4288,10 @@ type UpdateStorageNetworkIpRangeParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateStorageNetworkIpRangeParams) command() string {
+	return "updateStorageNetworkIpRange"
+}
+
 func (p *UpdateStorageNetworkIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3256,7 +4362,13 @@ func (s *NetworkService) NewUpdateStorageNetworkIpRangeParams(id string) *Update
 
 // Update a Storage network IP range, only allowed when no IPs in this range have been allocated.
 func (s *NetworkService) UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("updateStorageNetworkIpRange", p.toURLValues())
+	return s.UpdateStorageNetworkIpRangeWithContext(context.Background(), p)
+}
+
+// UpdateStorageNetworkIpRangeWithContext is the same as UpdateStorageNetworkIpRange, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkService) UpdateStorageNetworkIpRangeWithContext(ctx context.Context, p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateStorageNetworkIpRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3268,26 +4380,56 @@ func (s *NetworkService) UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateStorageNetworkIpRange", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "storagenetworkiprange", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// UpdateStorageNetworkIpRangeJob is a handle to a running updateStorageNetworkIpRange async job
+type UpdateStorageNetworkIpRangeJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateStorageNetworkIpRangeResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateStorageNetworkIpRangeJob) Result() (*UpdateStorageNetworkIpRangeResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateStorageNetworkIpRangeResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "storagenetworkiprange", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// UpdateStorageNetworkIpRangeAsync starts the updateStorageNetworkIpRange async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NetworkService) UpdateStorageNetworkIpRangeAsync(ctx context.Context, p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateStorageNetworkIpRange", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateStorageNetworkIpRangeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateStorageNetworkIpRangeJob{s.cs.newJobHandle("updateStorageNetworkIpRange", r.JobID)}, nil
+}
+
 type UpdateStorageNetworkIpRangeResponse struct {
 	JobID     string `json:"jobid,omitempty"`
 	Endip     string `json:"endip,omitempty"`
@@ -3305,6 +4447,10 @@ type ListSupportedNetworkServicesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListSupportedNetworkServicesParams) command() string {
+	return "listSupportedNetworkServices"
+}
+
 func (p *ListSupportedNetworkServicesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3375,10 +4521,17 @@ func (s *NetworkService) NewListSupportedNetworkServicesParams() *ListSupportedN
 
 // Lists all network services provided by CloudStack or for the given Provider.
 func (s *NetworkService) ListSupportedNetworkServices(p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error) {
+	return s.ListSupportedNetworkServicesWithContext(context.Background(), p)
+}
+
+// ListSupportedNetworkServicesWithContext is the same as ListSupportedNetworkServices, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NetworkService) ListSupportedNetworkServicesWithContext(ctx context.Context, p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error) {
 	var r ListSupportedNetworkServicesResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListSupportedNetworkServicesResponse
-		resp, err := s.cs.newRequest("listSupportedNetworkServices", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listSupportedNetworkServices", values)
 		if err != nil {
 			return nil, err
 		}
@@ -3390,13 +4543,89 @@ func (s *NetworkService) ListSupportedNetworkServices(p *ListSupportedNetworkSer
 		r.Count = l.Count
 		r.SupportedNetworkServices = append(r.SupportedNetworkServices, l.SupportedNetworkServices...)
 
-		if r.Count == len(r.SupportedNetworkServices) {
+		if r.Count == len(r.SupportedNetworkServices) || len(l.SupportedNetworkServices) == 0 {
+			s.cs.observePages("listSupportedNetworkServices", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.SupportedNetworkServices))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.SupportedNetworkServices)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listSupportedNetworkServices", values, len(l.SupportedNetworkServices), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListSupportedNetworkServicesResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.SupportedNetworkServices = append(r.SupportedNetworkServices, l.SupportedNetworkServices...)
+			}
+
+			if r.Count == len(r.SupportedNetworkServices) {
+				s.cs.observePages("listSupportedNetworkServices", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListSupportedNetworkServicesIterator iterates over the items returned by listSupportedNetworkServices, requesting
+// the pages of the response one at a time while iterating.
+type ListSupportedNetworkServicesIterator struct {
+	pager
+	items []*SupportedNetworkService
+	index int
+}
+
+// NewListSupportedNetworkServicesIterator returns an iterator over the items returned by listSupportedNetworkServices, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *NetworkService) NewListSupportedNetworkServicesIterator(p *ListSupportedNetworkServicesParams, pagesize int) *ListSupportedNetworkServicesIterator {
+	return &ListSupportedNetworkServicesIterator{pager: newPager(s.cs, "listSupportedNetworkServices", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListSupportedNetworkServicesIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListSupportedNetworkServicesResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.SupportedNetworkServices))
+
+	i.items = l.SupportedNetworkServices
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListSupportedNetworkServicesIterator) Value() *SupportedNetworkService {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListSupportedNetworkServicesResponse struct {
@@ -0,0 +1,690 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockNetworkService is a mock of NetworkServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockNetworkService struct {
+	mockCalls
+
+	CreateNetworkFunc                     func(ctx context.Context, p *CreateNetworkParams) (*CreateNetworkResponse, error)
+	DeleteNetworkFunc                     func(ctx context.Context, p *DeleteNetworkParams) (*DeleteNetworkResponse, error)
+	DeleteNetworkAsyncFunc                func(ctx context.Context, p *DeleteNetworkParams) (*DeleteNetworkJob, error)
+	RestartNetworkFunc                    func(ctx context.Context, p *RestartNetworkParams) (*RestartNetworkResponse, error)
+	RestartNetworkAsyncFunc               func(ctx context.Context, p *RestartNetworkParams) (*RestartNetworkJob, error)
+	UpdateNetworkFunc                     func(ctx context.Context, p *UpdateNetworkParams) (*UpdateNetworkResponse, error)
+	UpdateNetworkAsyncFunc                func(ctx context.Context, p *UpdateNetworkParams) (*UpdateNetworkJob, error)
+	ListNetworkIsolationMethodsFunc       func(ctx context.Context, p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error)
+	AddNetworkServiceProviderFunc         func(ctx context.Context, p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderResponse, error)
+	AddNetworkServiceProviderAsyncFunc    func(ctx context.Context, p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderJob, error)
+	DeleteNetworkServiceProviderFunc      func(ctx context.Context, p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error)
+	DeleteNetworkServiceProviderAsyncFunc func(ctx context.Context, p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderJob, error)
+	UpdateNetworkServiceProviderFunc      func(ctx context.Context, p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error)
+	UpdateNetworkServiceProviderAsyncFunc func(ctx context.Context, p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderJob, error)
+	GetNetworkServiceProviderIDFunc       func(name string, opts ...OptionFunc) (string, int, error)
+	ListNetworkServiceProvidersFunc       func(ctx context.Context, p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error)
+	GetNetworkIDFunc                      func(keyword string, opts ...OptionFunc) (string, int, error)
+	GetNetworkByNameFunc                  func(name string, opts ...OptionFunc) (*Network, int, error)
+	GetNetworkByIDFunc                    func(id string, opts ...OptionFunc) (*Network, int, error)
+	ListNetworksFunc                      func(ctx context.Context, p *ListNetworksParams) (*ListNetworksResponse, error)
+	GetNiciraNvpDeviceNetworkIDFunc       func(keyword string, nvpdeviceid string, opts ...OptionFunc) (string, int, error)
+	ListNiciraNvpDeviceNetworksFunc       func(ctx context.Context, p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error)
+	CreatePhysicalNetworkFunc             func(ctx context.Context, p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error)
+	CreatePhysicalNetworkAsyncFunc        func(ctx context.Context, p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkJob, error)
+	DeletePhysicalNetworkFunc             func(ctx context.Context, p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error)
+	DeletePhysicalNetworkAsyncFunc        func(ctx context.Context, p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkJob, error)
+	UpdatePhysicalNetworkFunc             func(ctx context.Context, p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error)
+	UpdatePhysicalNetworkAsyncFunc        func(ctx context.Context, p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkJob, error)
+	GetPhysicalNetworkIDFunc              func(name string, opts ...OptionFunc) (string, int, error)
+	GetPhysicalNetworkByNameFunc          func(name string, opts ...OptionFunc) (*PhysicalNetwork, int, error)
+	GetPhysicalNetworkByIDFunc            func(id string, opts ...OptionFunc) (*PhysicalNetwork, int, error)
+	ListPhysicalNetworksFunc              func(ctx context.Context, p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error)
+	DedicatePublicIpRangeFunc             func(ctx context.Context, p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error)
+	ReleasePublicIpRangeFunc              func(ctx context.Context, p *ReleasePublicIpRangeParams) (*ReleasePublicIpRangeResponse, error)
+	CreateStorageNetworkIpRangeFunc       func(ctx context.Context, p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeResponse, error)
+	CreateStorageNetworkIpRangeAsyncFunc  func(ctx context.Context, p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeJob, error)
+	DeleteStorageNetworkIpRangeFunc       func(ctx context.Context, p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error)
+	DeleteStorageNetworkIpRangeAsyncFunc  func(ctx context.Context, p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeJob, error)
+	GetStorageNetworkIpRangeByIDFunc      func(id string, opts ...OptionFunc) (*StorageNetworkIpRange, int, error)
+	ListStorageNetworkIpRangeFunc         func(ctx context.Context, p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error)
+	UpdateStorageNetworkIpRangeFunc       func(ctx context.Context, p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeResponse, error)
+	UpdateStorageNetworkIpRangeAsyncFunc  func(ctx context.Context, p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeJob, error)
+	ListSupportedNetworkServicesFunc      func(ctx context.Context, p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error)
+}
+
+var _ NetworkServiceIface = &MockNetworkService{}
+
+func (m *MockNetworkService) NewCreateNetworkParams(displaytext string, name string, networkofferingid string, zoneid string) *CreateNetworkParams {
+	return (&NetworkService{}).NewCreateNetworkParams(displaytext, name, networkofferingid, zoneid)
+}
+
+func (m *MockNetworkService) CreateNetwork(p *CreateNetworkParams) (*CreateNetworkResponse, error) {
+	return m.CreateNetworkWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkService) CreateNetworkWithContext(ctx context.Context, p *CreateNetworkParams) (*CreateNetworkResponse, error) {
+	m.record("CreateNetwork", p)
+	if m.CreateNetworkFunc == nil {
+		return nil, notMocked("MockNetworkService", "CreateNetwork")
+	}
+	return m.CreateNetworkFunc(ctx, p)
+}
+
+func (m *MockNetworkService) NewDeleteNetworkParams(id string) *DeleteNetworkParams {
+	return (&NetworkService{}).NewDeleteNetworkParams(id)
+}
+
+func (m *MockNetworkService) DeleteNetwork(p *DeleteNetworkParams) (*DeleteNetworkResponse, error) {
+	return m.DeleteNetworkWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkService) DeleteNetworkWithContext(ctx context.Context, p *DeleteNetworkParams) (*DeleteNetworkResponse, error) {
+	m.record("DeleteNetwork", p)
+	if m.DeleteNetworkFunc == nil {
+		return nil, notMocked("MockNetworkService", "DeleteNetwork")
+	}
+	return m.DeleteNetworkFunc(ctx, p)
+}
+
+func (m *MockNetworkService) DeleteNetworkAsync(ctx context.Context, p *DeleteNetworkParams) (*DeleteNetworkJob, error) {
+	m.record("DeleteNetworkAsync", p)
+	if m.DeleteNetworkAsyncFunc == nil {
+		return nil, notMocked("MockNetworkService", "DeleteNetworkAsync")
+	}
+	return m.DeleteNetworkAsyncFunc(ctx, p)
+}
+
+func (m *MockNetworkService) NewRestartNetworkParams(id string) *RestartNetworkParams {
+	return (&NetworkService{}).NewRestartNetworkParams(id)
+}
+
+func (m *MockNetworkService) RestartNetwork(p *RestartNetworkParams) (*RestartNetworkResponse, error) {
+	return m.RestartNetworkWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkService) RestartNetworkWithContext(ctx context.Context, p *RestartNetworkParams) (*RestartNetworkResponse, error) {
+	m.record("RestartNetwork", p)
+	if m.RestartNetworkFunc == nil {
+		return nil, notMocked("MockNetworkService", "RestartNetwork")
+	}
+	return m.RestartNetworkFunc(ctx, p)
+}
+
+func (m *MockNetworkService) RestartNetworkAsync(ctx context.Context, p *RestartNetworkParams) (*RestartNetworkJob, error) {
+	m.record("RestartNetworkAsync", p)
+	if m.RestartNetworkAsyncFunc == nil {
+		return nil, notMocked("MockNetworkService", "RestartNetworkAsync")
+	}
+	return m.RestartNetworkAsyncFunc(ctx, p)
+}
+
+func (m *MockNetworkService) NewUpdateNetworkParams(id string) *UpdateNetworkParams {
+	return (&NetworkService{}).NewUpdateNetworkParams(id)
+}
+
+func (m *MockNetworkService) UpdateNetwork(p *UpdateNetworkParams) (*UpdateNetworkResponse, error) {
+	return m.UpdateNetworkWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkService) UpdateNetworkWithContext(ctx context.Context, p *UpdateNetworkParams) (*UpdateNetworkResponse, error) {
+	m.record("UpdateNetwork", p)
+	if m.UpdateNetworkFunc == nil {
+		return nil, notMocked("MockNetworkService", "UpdateNetwork")
+	}
+	return m.UpdateNetworkFunc(ctx, p)
+}
+
+func (m *MockNetworkService) UpdateNetworkAsync(ctx context.Context, p *UpdateNetworkParams) (*UpdateNetworkJob, error) {
+	m.record("UpdateNetworkAsync", p)
+	if m.UpdateNetworkAsyncFunc == nil {
+		return nil, notMocked("MockNetworkService", "UpdateNetworkAsync")
+	}
+	return m.UpdateNetworkAsyncFunc(ctx, p)
+}
+
+func (m *MockNetworkService) NewListNetworkIsolationMethodsParams() *ListNetworkIsolationMethodsParams {
+	return (&NetworkService{}).NewListNetworkIsolationMethodsParams()
+}
+
+func (m *MockNetworkService) ListNetworkIsolationMethods(p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error) {
+	return m.ListNetworkIsolationMethodsWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkService) ListNetworkIsolationMethodsWithContext(ctx context.Context, p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error) {
+	m.record("ListNetworkIsolationMethods", p)
+	if m.ListNetworkIsolationMethodsFunc == nil {
+		return nil, notMocked("MockNetworkService", "ListNetworkIsolationMethods")
+	}
+	return m.ListNetworkIsolationMethodsFunc(ctx, p)
+}
+
+func (m *MockNetworkService) NewListNetworkIsolationMethodsIterator(p *ListNetworkIsolationMethodsParams, pagesize int) *ListNetworkIsolationMethodsIterator {
+	i := &ListNetworkIsolationMethodsIterator{pager: newPager(nil, "listNetworkIsolationMethods", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListNetworkIsolationMethodsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListNetworkIsolationMethodsWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockNetworkService) NewAddNetworkServiceProviderParams(name string, physicalnetworkid string) *AddNetworkServiceProviderParams {
+	return (&NetworkService{}).NewAddNetworkServiceProviderParams(name, physicalnetworkid)
+}
+
+func (m *MockNetworkService) AddNetworkServiceProvider(p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderResponse, error) {
+	return m.AddNetworkServiceProviderWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkService) AddNetworkServiceProviderWithContext(ctx context.Context, p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderResponse, error) {
+	m.record("AddNetworkServiceProvider", p)
+	if m.AddNetworkServiceProviderFunc == nil {
+		return nil, notMocked("MockNetworkService", "AddNetworkServiceProvider")
+	}
+	return m.AddNetworkServiceProviderFunc(ctx, p)
+}
+
+func (m *MockNetworkService) AddNetworkServiceProviderAsync(ctx context.Context, p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderJob, error) {
+	m.record("AddNetworkServiceProviderAsync", p)
+	if m.AddNetworkServiceProviderAsyncFunc == nil {
+		return nil, notMocked("MockNetworkService", "AddNetworkServiceProviderAsync")
+	}
+	return m.AddNetworkServiceProviderAsyncFunc(ctx, p)
+}
+
+func (m *MockNetworkService) NewDeleteNetworkServiceProviderParams(id string) *DeleteNetworkServiceProviderParams {
+	return (&NetworkService{}).NewDeleteNetworkServiceProviderParams(id)
+}
+
+func (m *MockNetworkService) DeleteNetworkServiceProvider(p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error) {
+	return m.DeleteNetworkServiceProviderWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkService) DeleteNetworkServiceProviderWithContext(ctx context.Context, p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error) {
+	m.record("DeleteNetworkServiceProvider", p)
+	if m.DeleteNetworkServiceProviderFunc == nil {
+		return nil, notMocked("MockNetworkService", "DeleteNetworkServiceProvider")
+	}
+	return m.DeleteNetworkServiceProviderFunc(ctx, p)
+}
+
+func (m *MockNetworkService) DeleteNetworkServiceProviderAsync(ctx context.Context, p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderJob, error) {
+	m.record("DeleteNetworkServiceProviderAsync", p)
+	if m.DeleteNetworkServiceProviderAsyncFunc == nil {
+		return nil, notMocked("MockNetworkService", "DeleteNetworkServiceProviderAsync")
+	}
+	return m.DeleteNetworkServiceProviderAsyncFunc(ctx, p)
+}
+
+func (m *MockNetworkService) NewUpdateNetworkServiceProviderParams(id string) *UpdateNetworkServiceProviderParams {
+	return (&NetworkService{}).NewUpdateNetworkServiceProviderParams(id)
+}
+
+func (m *MockNetworkService) UpdateNetworkServiceProvider(p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error) {
+	return m.UpdateNetworkServiceProviderWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkService) UpdateNetworkServiceProviderWithContext(ctx context.Context, p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error) {
+	m.record("UpdateNetworkServiceProvider", p)
+	if m.UpdateNetworkServiceProviderFunc == nil {
+		return nil, notMocked("MockNetworkService", "UpdateNetworkServiceProvider")
+	}
+	return m.UpdateNetworkServiceProviderFunc(ctx, p)
+}
+
+func (m *MockNetworkService) UpdateNetworkServiceProviderAsync(ctx context.Context, p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderJob, error) {
+	m.record("UpdateNetworkServiceProviderAsync", p)
+	if m.UpdateNetworkServiceProviderAsyncFunc == nil {
+		return nil, notMocked("MockNetworkService", "UpdateNetworkServiceProviderAsync")
+	}
+	return m.UpdateNetworkServiceProviderAsyncFunc(ctx, p)
+}
+
+func (m *MockNetworkService) NewListNetworkServiceProvidersParams() *ListNetworkServiceProvidersParams {
+	return (&NetworkService{}).NewListNetworkServiceProvidersParams()
+}
+
+func (m *MockNetworkService) GetNetworkServiceProviderID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetNetworkServiceProviderID", name, opts)
+	if m.GetNetworkServiceProviderIDFunc == nil {
+		return "", -1, notMocked("MockNetworkService", "GetNetworkServiceProviderID")
+	}
+	return m.GetNetworkServiceProviderIDFunc(name, opts...)
+}
+
+func (m *MockNetworkService) ListNetworkServiceProviders(p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error) {
+	return m.ListNetworkServiceProvidersWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkService) ListNetworkServiceProvidersWithContext(ctx context.Context, p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error) {
+	m.record("ListNetworkServiceProviders", p)
+	if m.ListNetworkServiceProvidersFunc == nil {
+		return nil, notMocked("MockNetworkService", "ListNetworkServiceProviders")
+	}
+	return m.ListNetworkServiceProvidersFunc(ctx, p)
+}
+
+func (m *MockNetworkService) NewListNetworkServiceProvidersIterator(p *ListNetworkServiceProvidersParams, pagesize int) *ListNetworkServiceProvidersIterator {
+	i := &ListNetworkServiceProvidersIterator{pager: newPager(nil, "listNetworkServiceProviders", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListNetworkServiceProvidersParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListNetworkServiceProvidersWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockNetworkService) NewListNetworksParams() *ListNetworksParams {
+	return (&NetworkService{}).NewListNetworksParams()
+}
+
+func (m *MockNetworkService) GetNetworkID(keyword string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetNetworkID", keyword, opts)
+	if m.GetNetworkIDFunc == nil {
+		return "", -1, notMocked("MockNetworkService", "GetNetworkID")
+	}
+	return m.GetNetworkIDFunc(keyword, opts...)
+}
+
+func (m *MockNetworkService) GetNetworkByName(name string, opts ...OptionFunc) (*Network, int, error) {
+	m.record("GetNetworkByName", name, opts)
+	if m.GetNetworkByNameFunc == nil {
+		return nil, -1, notMocked("MockNetworkService", "GetNetworkByName")
+	}
+	return m.GetNetworkByNameFunc(name, opts...)
+}
+
+func (m *MockNetworkService) GetNetworkByID(id string, opts ...OptionFunc) (*Network, int, error) {
+	m.record("GetNetworkByID", id, opts)
+	if m.GetNetworkByIDFunc == nil {
+		return nil, -1, notMocked("MockNetworkService", "GetNetworkByID")
+	}
+	return m.GetNetworkByIDFunc(id, opts...)
+}
+
+func (m *MockNetworkService) ListNetworks(p *ListNetworksParams) (*ListNetworksResponse, error) {
+	return m.ListNetworksWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkService) ListNetworksWithContext(ctx context.Context, p *ListNetworksParams) (*ListNetworksResponse, error) {
+	m.record("ListNetworks", p)
+	if m.ListNetworksFunc == nil {
+		return nil, notMocked("MockNetworkService", "ListNetworks")
+	}
+	return m.ListNetworksFunc(ctx, p)
+}
+
+func (m *MockNetworkService) NewListNetworksIterator(p *ListNetworksParams, pagesize int) *ListNetworksIterator {
+	i := &ListNetworksIterator{pager: newPager(nil, "listNetworks", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListNetworksParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListNetworksWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockNetworkService) NewListNiciraNvpDeviceNetworksParams(nvpdeviceid string) *ListNiciraNvpDeviceNetworksParams {
+	return (&NetworkService{}).NewListNiciraNvpDeviceNetworksParams(nvpdeviceid)
+}
+
+func (m *MockNetworkService) GetNiciraNvpDeviceNetworkID(keyword string, nvpdeviceid string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetNiciraNvpDeviceNetworkID", keyword, nvpdeviceid, opts)
+	if m.GetNiciraNvpDeviceNetworkIDFunc == nil {
+		return "", -1, notMocked("MockNetworkService", "GetNiciraNvpDeviceNetworkID")
+	}
+	return m.GetNiciraNvpDeviceNetworkIDFunc(keyword, nvpdeviceid, opts...)
+}
+
+func (m *MockNetworkService) ListNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error) {
+	return m.ListNiciraNvpDeviceNetworksWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkService) ListNiciraNvpDeviceNetworksWithContext(ctx context.Context, p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error) {
+	m.record("ListNiciraNvpDeviceNetworks", p)
+	if m.ListNiciraNvpDeviceNetworksFunc == nil {
+		return nil, notMocked("MockNetworkService", "ListNiciraNvpDeviceNetworks")
+	}
+	return m.ListNiciraNvpDeviceNetworksFunc(ctx, p)
+}
+
+func (m *MockNetworkService) NewListNiciraNvpDeviceNetworksIterator(p *ListNiciraNvpDeviceNetworksParams, pagesize int) *ListNiciraNvpDeviceNetworksIterator {
+	i := &ListNiciraNvpDeviceNetworksIterator{pager: newPager(nil, "listNiciraNvpDeviceNetworks", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListNiciraNvpDeviceNetworksParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListNiciraNvpDeviceNetworksWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockNetworkService) NewCreatePhysicalNetworkParams(name string, zoneid string) *CreatePhysicalNetworkParams {
+	return (&NetworkService{}).NewCreatePhysicalNetworkParams(name, zoneid)
+}
+
+func (m *MockNetworkService) CreatePhysicalNetwork(p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error) {
+	return m.CreatePhysicalNetworkWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkService) CreatePhysicalNetworkWithContext(ctx context.Context, p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error) {
+	m.record("CreatePhysicalNetwork", p)
+	if m.CreatePhysicalNetworkFunc == nil {
+		return nil, notMocked("MockNetworkService", "CreatePhysicalNetwork")
+	}
+	return m.CreatePhysicalNetworkFunc(ctx, p)
+}
+
+func (m *MockNetworkService) CreatePhysicalNetworkAsync(ctx context.Context, p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkJob, error) {
+	m.record("CreatePhysicalNetworkAsync", p)
+	if m.CreatePhysicalNetworkAsyncFunc == nil {
+		return nil, notMocked("MockNetworkService", "CreatePhysicalNetworkAsync")
+	}
+	return m.CreatePhysicalNetworkAsyncFunc(ctx, p)
+}
+
+func (m *MockNetworkService) NewDeletePhysicalNetworkParams(id string) *DeletePhysicalNetworkParams {
+	return (&NetworkService{}).NewDeletePhysicalNetworkParams(id)
+}
+
+func (m *MockNetworkService) DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error) {
+	return m.DeletePhysicalNetworkWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkService) DeletePhysicalNetworkWithContext(ctx context.Context, p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error) {
+	m.record("DeletePhysicalNetwork", p)
+	if m.DeletePhysicalNetworkFunc == nil {
+		return nil, notMocked("MockNetworkService", "DeletePhysicalNetwork")
+	}
+	return m.DeletePhysicalNetworkFunc(ctx, p)
+}
+
+func (m *MockNetworkService) DeletePhysicalNetworkAsync(ctx context.Context, p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkJob, error) {
+	m.record("DeletePhysicalNetworkAsync", p)
+	if m.DeletePhysicalNetworkAsyncFunc == nil {
+		return nil, notMocked("MockNetworkService", "DeletePhysicalNetworkAsync")
+	}
+	return m.DeletePhysicalNetworkAsyncFunc(ctx, p)
+}
+
+func (m *MockNetworkService) NewUpdatePhysicalNetworkParams(id string) *UpdatePhysicalNetworkParams {
+	return (&NetworkService{}).NewUpdatePhysicalNetworkParams(id)
+}
+
+func (m *MockNetworkService) UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error) {
+	return m.UpdatePhysicalNetworkWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkService) UpdatePhysicalNetworkWithContext(ctx context.Context, p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error) {
+	m.record("UpdatePhysicalNetwork", p)
+	if m.UpdatePhysicalNetworkFunc == nil {
+		return nil, notMocked("MockNetworkService", "UpdatePhysicalNetwork")
+	}
+	return m.UpdatePhysicalNetworkFunc(ctx, p)
+}
+
+func (m *MockNetworkService) UpdatePhysicalNetworkAsync(ctx context.Context, p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkJob, error) {
+	m.record("UpdatePhysicalNetworkAsync", p)
+	if m.UpdatePhysicalNetworkAsyncFunc == nil {
+		return nil, notMocked("MockNetworkService", "UpdatePhysicalNetworkAsync")
+	}
+	return m.UpdatePhysicalNetworkAsyncFunc(ctx, p)
+}
+
+func (m *MockNetworkService) NewListPhysicalNetworksParams() *ListPhysicalNetworksParams {
+	return (&NetworkService{}).NewListPhysicalNetworksParams()
+}
+
+func (m *MockNetworkService) GetPhysicalNetworkID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetPhysicalNetworkID", name, opts)
+	if m.GetPhysicalNetworkIDFunc == nil {
+		return "", -1, notMocked("MockNetworkService", "GetPhysicalNetworkID")
+	}
+	return m.GetPhysicalNetworkIDFunc(name, opts...)
+}
+
+func (m *MockNetworkService) GetPhysicalNetworkByName(name string, opts ...OptionFunc) (*PhysicalNetwork, int, error) {
+	m.record("GetPhysicalNetworkByName", name, opts)
+	if m.GetPhysicalNetworkByNameFunc == nil {
+		return nil, -1, notMocked("MockNetworkService", "GetPhysicalNetworkByName")
+	}
+	return m.GetPhysicalNetworkByNameFunc(name, opts...)
+}
+
+func (m *MockNetworkService) GetPhysicalNetworkByID(id string, opts ...OptionFunc) (*PhysicalNetwork, int, error) {
+	m.record("GetPhysicalNetworkByID", id, opts)
+	if m.GetPhysicalNetworkByIDFunc == nil {
+		return nil, -1, notMocked("MockNetworkService", "GetPhysicalNetworkByID")
+	}
+	return m.GetPhysicalNetworkByIDFunc(id, opts...)
+}
+
+func (m *MockNetworkService) ListPhysicalNetworks(p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error) {
+	return m.ListPhysicalNetworksWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkService) ListPhysicalNetworksWithContext(ctx context.Context, p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error) {
+	m.record("ListPhysicalNetworks", p)
+	if m.ListPhysicalNetworksFunc == nil {
+		return nil, notMocked("MockNetworkService", "ListPhysicalNetworks")
+	}
+	return m.ListPhysicalNetworksFunc(ctx, p)
+}
+
+func (m *MockNetworkService) NewListPhysicalNetworksIterator(p *ListPhysicalNetworksParams, pagesize int) *ListPhysicalNetworksIterator {
+	i := &ListPhysicalNetworksIterator{pager: newPager(nil, "listPhysicalNetworks", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListPhysicalNetworksParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListPhysicalNetworksWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockNetworkService) NewDedicatePublicIpRangeParams(domainid string, id string) *DedicatePublicIpRangeParams {
+	return (&NetworkService{}).NewDedicatePublicIpRangeParams(domainid, id)
+}
+
+func (m *MockNetworkService) DedicatePublicIpRange(p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error) {
+	return m.DedicatePublicIpRangeWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkService) DedicatePublicIpRangeWithContext(ctx context.Context, p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error) {
+	m.record("DedicatePublicIpRange", p)
+	if m.DedicatePublicIpRangeFunc == nil {
+		return nil, notMocked("MockNetworkService", "DedicatePublicIpRange")
+	}
+	return m.DedicatePublicIpRangeFunc(ctx, p)
+}
+
+func (m *MockNetworkService) NewReleasePublicIpRangeParams(id string) *ReleasePublicIpRangeParams {
+	return (&NetworkService{}).NewReleasePublicIpRangeParams(id)
+}
+
+func (m *MockNetworkService) ReleasePublicIpRange(p *ReleasePublicIpRangeParams) (*ReleasePublicIpRangeResponse, error) {
+	return m.ReleasePublicIpRangeWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkService) ReleasePublicIpRangeWithContext(ctx context.Context, p *ReleasePublicIpRangeParams) (*ReleasePublicIpRangeResponse, error) {
+	m.record("ReleasePublicIpRange", p)
+	if m.ReleasePublicIpRangeFunc == nil {
+		return nil, notMocked("MockNetworkService", "ReleasePublicIpRange")
+	}
+	return m.ReleasePublicIpRangeFunc(ctx, p)
+}
+
+func (m *MockNetworkService) NewCreateStorageNetworkIpRangeParams(gateway string, netmask string, podid string, startip string) *CreateStorageNetworkIpRangeParams {
+	return (&NetworkService{}).NewCreateStorageNetworkIpRangeParams(gateway, netmask, podid, startip)
+}
+
+func (m *MockNetworkService) CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeResponse, error) {
+	return m.CreateStorageNetworkIpRangeWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkService) CreateStorageNetworkIpRangeWithContext(ctx context.Context, p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeResponse, error) {
+	m.record("CreateStorageNetworkIpRange", p)
+	if m.CreateStorageNetworkIpRangeFunc == nil {
+		return nil, notMocked("MockNetworkService", "CreateStorageNetworkIpRange")
+	}
+	return m.CreateStorageNetworkIpRangeFunc(ctx, p)
+}
+
+func (m *MockNetworkService) CreateStorageNetworkIpRangeAsync(ctx context.Context, p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeJob, error) {
+	m.record("CreateStorageNetworkIpRangeAsync", p)
+	if m.CreateStorageNetworkIpRangeAsyncFunc == nil {
+		return nil, notMocked("MockNetworkService", "CreateStorageNetworkIpRangeAsync")
+	}
+	return m.CreateStorageNetworkIpRangeAsyncFunc(ctx, p)
+}
+
+func (m *MockNetworkService) NewDeleteStorageNetworkIpRangeParams(id string) *DeleteStorageNetworkIpRangeParams {
+	return (&NetworkService{}).NewDeleteStorageNetworkIpRangeParams(id)
+}
+
+func (m *MockNetworkService) DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error) {
+	return m.DeleteStorageNetworkIpRangeWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkService) DeleteStorageNetworkIpRangeWithContext(ctx context.Context, p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error) {
+	m.record("DeleteStorageNetworkIpRange", p)
+	if m.DeleteStorageNetworkIpRangeFunc == nil {
+		return nil, notMocked("MockNetworkService", "DeleteStorageNetworkIpRange")
+	}
+	return m.DeleteStorageNetworkIpRangeFunc(ctx, p)
+}
+
+func (m *MockNetworkService) DeleteStorageNetworkIpRangeAsync(ctx context.Context, p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeJob, error) {
+	m.record("DeleteStorageNetworkIpRangeAsync", p)
+	if m.DeleteStorageNetworkIpRangeAsyncFunc == nil {
+		return nil, notMocked("MockNetworkService", "DeleteStorageNetworkIpRangeAsync")
+	}
+	return m.DeleteStorageNetworkIpRangeAsyncFunc(ctx, p)
+}
+
+func (m *MockNetworkService) NewListStorageNetworkIpRangeParams() *ListStorageNetworkIpRangeParams {
+	return (&NetworkService{}).NewListStorageNetworkIpRangeParams()
+}
+
+func (m *MockNetworkService) GetStorageNetworkIpRangeByID(id string, opts ...OptionFunc) (*StorageNetworkIpRange, int, error) {
+	m.record("GetStorageNetworkIpRangeByID", id, opts)
+	if m.GetStorageNetworkIpRangeByIDFunc == nil {
+		return nil, -1, notMocked("MockNetworkService", "GetStorageNetworkIpRangeByID")
+	}
+	return m.GetStorageNetworkIpRangeByIDFunc(id, opts...)
+}
+
+func (m *MockNetworkService) ListStorageNetworkIpRange(p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error) {
+	return m.ListStorageNetworkIpRangeWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkService) ListStorageNetworkIpRangeWithContext(ctx context.Context, p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error) {
+	m.record("ListStorageNetworkIpRange", p)
+	if m.ListStorageNetworkIpRangeFunc == nil {
+		return nil, notMocked("MockNetworkService", "ListStorageNetworkIpRange")
+	}
+	return m.ListStorageNetworkIpRangeFunc(ctx, p)
+}
+
+func (m *MockNetworkService) NewListStorageNetworkIpRangeIterator(p *ListStorageNetworkIpRangeParams, pagesize int) *ListStorageNetworkIpRangeIterator {
+	i := &ListStorageNetworkIpRangeIterator{pager: newPager(nil, "listStorageNetworkIpRange", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListStorageNetworkIpRangeParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListStorageNetworkIpRangeWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockNetworkService) NewUpdateStorageNetworkIpRangeParams(id string) *UpdateStorageNetworkIpRangeParams {
+	return (&NetworkService{}).NewUpdateStorageNetworkIpRangeParams(id)
+}
+
+func (m *MockNetworkService) UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeResponse, error) {
+	return m.UpdateStorageNetworkIpRangeWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkService) UpdateStorageNetworkIpRangeWithContext(ctx context.Context, p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeResponse, error) {
+	m.record("UpdateStorageNetworkIpRange", p)
+	if m.UpdateStorageNetworkIpRangeFunc == nil {
+		return nil, notMocked("MockNetworkService", "UpdateStorageNetworkIpRange")
+	}
+	return m.UpdateStorageNetworkIpRangeFunc(ctx, p)
+}
+
+func (m *MockNetworkService) UpdateStorageNetworkIpRangeAsync(ctx context.Context, p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeJob, error) {
+	m.record("UpdateStorageNetworkIpRangeAsync", p)
+	if m.UpdateStorageNetworkIpRangeAsyncFunc == nil {
+		return nil, notMocked("MockNetworkService", "UpdateStorageNetworkIpRangeAsync")
+	}
+	return m.UpdateStorageNetworkIpRangeAsyncFunc(ctx, p)
+}
+
+func (m *MockNetworkService) NewListSupportedNetworkServicesParams() *ListSupportedNetworkServicesParams {
+	return (&NetworkService{}).NewListSupportedNetworkServicesParams()
+}
+
+func (m *MockNetworkService) ListSupportedNetworkServices(p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error) {
+	return m.ListSupportedNetworkServicesWithContext(context.Background(), p)
+}
+
+func (m *MockNetworkService) ListSupportedNetworkServicesWithContext(ctx context.Context, p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error) {
+	m.record("ListSupportedNetworkServices", p)
+	if m.ListSupportedNetworkServicesFunc == nil {
+		return nil, notMocked("MockNetworkService", "ListSupportedNetworkServices")
+	}
+	return m.ListSupportedNetworkServicesFunc(ctx, p)
+}
+
+func (m *MockNetworkService) NewListSupportedNetworkServicesIterator(p *ListSupportedNetworkServicesParams, pagesize int) *ListSupportedNetworkServicesIterator {
+	i := &ListSupportedNetworkServicesIterator{pager: newPager(nil, "listSupportedNetworkServices", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListSupportedNetworkServicesParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListSupportedNetworkServicesWithContext(ctx, c)
+	}
+	return i
+}
@@ -0,0 +1,545 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCreateNetworkParams(t *testing.T) {
+	p := &CreateNetworkParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetAclid("aclid-value")
+	want.Set("aclid", "aclid-value")
+	p.SetAcltype("acltype-value")
+	want.Set("acltype", "acltype-value")
+	p.SetCidr("cidr-value")
+	want.Set("cidr", "cidr-value")
+	p.SetDhcpbootfilename("dhcpbootfilename-value")
+	want.Set("dhcpbootfilename", "dhcpbootfilename-value")
+	p.SetDhcptftpserver("dhcptftpserver-value")
+	want.Set("dhcptftpserver", "dhcptftpserver-value")
+	p.SetDisplaynetwork(true)
+	want.Set("displaynetwork", "true")
+	p.SetDisplaytext("displaytext-value")
+	want.Set("displaytext", "displaytext-value")
+	p.SetDns1("dns1-value")
+	want.Set("dns1", "dns1-value")
+	p.SetDns2("dns2-value")
+	want.Set("dns2", "dns2-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetEndip("endip-value")
+	want.Set("endip", "endip-value")
+	p.SetEndipv6("endipv6-value")
+	want.Set("endipv6", "endipv6-value")
+	p.SetGateway("gateway-value")
+	want.Set("gateway", "gateway-value")
+	p.SetIp6cidr("ip6cidr-value")
+	want.Set("ip6cidr", "ip6cidr-value")
+	p.SetIp6gateway("ip6gateway-value")
+	want.Set("ip6gateway", "ip6gateway-value")
+	p.SetIpexclusionlist("ipexclusionlist-value")
+	want.Set("ipexclusionlist", "ipexclusionlist-value")
+	p.SetIsolatedpvlan("isolatedpvlan-value")
+	want.Set("isolatedpvlan", "isolatedpvlan-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetNetmask("netmask-value")
+	want.Set("netmask", "netmask-value")
+	p.SetNetworkdomain("networkdomain-value")
+	want.Set("networkdomain", "networkdomain-value")
+	p.SetNetworkofferingid("networkofferingid-value")
+	want.Set("networkofferingid", "networkofferingid-value")
+	p.SetPhysicalnetworkid("physicalnetworkid-value")
+	want.Set("physicalnetworkid", "physicalnetworkid-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetStartip("startip-value")
+	want.Set("startip", "startip-value")
+	p.SetStartipv6("startipv6-value")
+	want.Set("startipv6", "startipv6-value")
+	p.SetSubdomainaccess(true)
+	want.Set("subdomainaccess", "true")
+	p.SetVlan("vlan-value")
+	want.Set("vlan", "vlan-value")
+	p.SetVpcid("vpcid-value")
+	want.Set("vpcid", "vpcid-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "createNetwork", want)
+}
+
+func TestCreateNetworkResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","aclid":"aclid-value","aclname":"aclname-value","acltype":"acltype-value","broadcastdomaintype":"broadcastdomaintype-value","broadcasturi":"broadcasturi-value","canusefordeploy":true,"cidr":"cidr-value","dhcpbootfilename":"dhcpbootfilename-value","dhcptftpserver":"dhcptftpserver-value","displaynetwork":true,"displaytext":"displaytext-value","dns1":"dns1-value","dns2":"dns2-value","domain":"domain-value","domainid":"domainid-value","gateway":"gateway-value","id":"id-value","ip6cidr":"ip6cidr-value","ip6gateway":"ip6gateway-value","ipexclusionlist":"ipexclusionlist-value","isdefault":true,"ispersistent":true,"issystem":true,"name":"name-value","netmask":"netmask-value","networkcidr":"networkcidr-value","networkdomain":"networkdomain-value","networkofferingavailability":"networkofferingavailability-value","networkofferingconservemode":true,"networkofferingdisplaytext":"networkofferingdisplaytext-value","networkofferingid":"networkofferingid-value","networkofferingname":"networkofferingname-value","physicalnetworkid":"physicalnetworkid-value","project":"project-value","projectid":"projectid-value","related":"related-value","reservediprange":"reservediprange-value","restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"name-value","value":"value-value"}],"name":"name-value","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"destinationphysicalnetworkid-value","id":"id-value","name":"name-value","physicalnetworkid":"physicalnetworkid-value","servicelist":["a","b"],"state":"state-value"}]}],"specifyipranges":true,"state":"state-value","strechedl2subnet":true,"subdomainaccess":true,"tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"traffictype":"traffictype-value","type":"type-value","vlan":"vlan-value","vpcid":"vpcid-value","vpcname":"vpcname-value","zoneid":"zoneid-value","zonename":"zonename-value","zonesnetworkspans":["a","b"]}`, &CreateNetworkResponse{})
+}
+
+func TestDeleteNetworkParams(t *testing.T) {
+	p := &DeleteNetworkParams{}
+	want := url.Values{}
+	p.SetForced(true)
+	want.Set("forced", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteNetwork", want)
+}
+
+func TestDeleteNetworkResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteNetworkResponse{})
+}
+
+func TestRestartNetworkParams(t *testing.T) {
+	p := &RestartNetworkParams{}
+	want := url.Values{}
+	p.SetCleanup(true)
+	want.Set("cleanup", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "restartNetwork", want)
+}
+
+func TestRestartNetworkResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","aclid":"aclid-value","aclname":"aclname-value","allocated":"allocated-value","associatednetworkid":"associatednetworkid-value","associatednetworkname":"associatednetworkname-value","domain":"domain-value","domainid":"domainid-value","fordisplay":true,"forvirtualnetwork":true,"id":"id-value","ipaddress":"ipaddress-value","issourcenat":true,"isstaticnat":true,"issystem":true,"jobid":"jobid-value","networkid":"networkid-value","physicalnetworkid":"physicalnetworkid-value","project":"project-value","projectid":"projectid-value","purpose":"purpose-value","state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"virtualmachinedisplayname":"virtualmachinedisplayname-value","virtualmachineid":"virtualmachineid-value","virtualmachinename":"virtualmachinename-value","vlanid":"vlanid-value","vlanname":"vlanname-value","vmipaddress":"vmipaddress-value","vpcid":"vpcid-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &RestartNetworkResponse{})
+}
+
+func TestUpdateNetworkParams(t *testing.T) {
+	p := &UpdateNetworkParams{}
+	want := url.Values{}
+	p.SetChangecidr(true)
+	want.Set("changecidr", "true")
+	p.SetCustomid("customid-value")
+	want.Set("customid", "customid-value")
+	p.SetDhcpbootfilename("dhcpbootfilename-value")
+	want.Set("dhcpbootfilename", "dhcpbootfilename-value")
+	p.SetDhcptftpserver("dhcptftpserver-value")
+	want.Set("dhcptftpserver", "dhcptftpserver-value")
+	p.SetDisplaynetwork(true)
+	want.Set("displaynetwork", "true")
+	p.SetDisplaytext("displaytext-value")
+	want.Set("displaytext", "displaytext-value")
+	p.SetDns1("dns1-value")
+	want.Set("dns1", "dns1-value")
+	p.SetDns2("dns2-value")
+	want.Set("dns2", "dns2-value")
+	p.SetGuestvmcidr("guestvmcidr-value")
+	want.Set("guestvmcidr", "guestvmcidr-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIpexclusionlist("ipexclusionlist-value")
+	want.Set("ipexclusionlist", "ipexclusionlist-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetNetworkdomain("networkdomain-value")
+	want.Set("networkdomain", "networkdomain-value")
+	p.SetNetworkofferingid("networkofferingid-value")
+	want.Set("networkofferingid", "networkofferingid-value")
+
+	assertParams(t, p, "updateNetwork", want)
+}
+
+func TestUpdateNetworkResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","aclid":"aclid-value","aclname":"aclname-value","acltype":"acltype-value","broadcastdomaintype":"broadcastdomaintype-value","broadcasturi":"broadcasturi-value","canusefordeploy":true,"cidr":"cidr-value","dhcpbootfilename":"dhcpbootfilename-value","dhcptftpserver":"dhcptftpserver-value","displaynetwork":true,"displaytext":"displaytext-value","dns1":"dns1-value","dns2":"dns2-value","domain":"domain-value","domainid":"domainid-value","gateway":"gateway-value","id":"id-value","ip6cidr":"ip6cidr-value","ip6gateway":"ip6gateway-value","ipexclusionlist":"ipexclusionlist-value","isdefault":true,"ispersistent":true,"issystem":true,"jobid":"jobid-value","name":"name-value","netmask":"netmask-value","networkcidr":"networkcidr-value","networkdomain":"networkdomain-value","networkofferingavailability":"networkofferingavailability-value","networkofferingconservemode":true,"networkofferingdisplaytext":"networkofferingdisplaytext-value","networkofferingid":"networkofferingid-value","networkofferingname":"networkofferingname-value","physicalnetworkid":"physicalnetworkid-value","project":"project-value","projectid":"projectid-value","related":"related-value","reservediprange":"reservediprange-value","restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"name-value","value":"value-value"}],"name":"name-value","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"destinationphysicalnetworkid-value","id":"id-value","name":"name-value","physicalnetworkid":"physicalnetworkid-value","servicelist":["a","b"],"state":"state-value"}]}],"specifyipranges":true,"state":"state-value","strechedl2subnet":true,"subdomainaccess":true,"tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"traffictype":"traffictype-value","type":"type-value","vlan":"vlan-value","vpcid":"vpcid-value","vpcname":"vpcname-value","zoneid":"zoneid-value","zonename":"zonename-value","zonesnetworkspans":["a","b"]}`, &UpdateNetworkResponse{})
+}
+
+func TestListNetworkIsolationMethodsParams(t *testing.T) {
+	p := &ListNetworkIsolationMethodsParams{}
+	want := url.Values{}
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+
+	assertParams(t, p, "listNetworkIsolationMethods", want)
+}
+
+func TestListNetworkIsolationMethodsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"networkisolationmethod":[{"name":"name-value"}]}`, &ListNetworkIsolationMethodsResponse{})
+}
+
+func TestAddNetworkServiceProviderParams(t *testing.T) {
+	p := &AddNetworkServiceProviderParams{}
+	want := url.Values{}
+	p.SetDestinationphysicalnetworkid("destinationphysicalnetworkid-value")
+	want.Set("destinationphysicalnetworkid", "destinationphysicalnetworkid-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPhysicalnetworkid("physicalnetworkid-value")
+	want.Set("physicalnetworkid", "physicalnetworkid-value")
+	p.SetServicelist([]string{"a", "b"})
+	want.Set("servicelist", "a,b")
+
+	assertParams(t, p, "addNetworkServiceProvider", want)
+}
+
+func TestAddNetworkServiceProviderResponse(t *testing.T) {
+	assertDecodes(t, `{"canenableindividualservice":true,"destinationphysicalnetworkid":"destinationphysicalnetworkid-value","id":"id-value","jobid":"jobid-value","name":"name-value","physicalnetworkid":"physicalnetworkid-value","servicelist":["a","b"],"state":"state-value"}`, &AddNetworkServiceProviderResponse{})
+}
+
+func TestDeleteNetworkServiceProviderParams(t *testing.T) {
+	p := &DeleteNetworkServiceProviderParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteNetworkServiceProvider", want)
+}
+
+func TestDeleteNetworkServiceProviderResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteNetworkServiceProviderResponse{})
+}
+
+func TestUpdateNetworkServiceProviderParams(t *testing.T) {
+	p := &UpdateNetworkServiceProviderParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetServicelist([]string{"a", "b"})
+	want.Set("servicelist", "a,b")
+	p.SetState("state-value")
+	want.Set("state", "state-value")
+
+	assertParams(t, p, "updateNetworkServiceProvider", want)
+}
+
+func TestUpdateNetworkServiceProviderResponse(t *testing.T) {
+	assertDecodes(t, `{"canenableindividualservice":true,"destinationphysicalnetworkid":"destinationphysicalnetworkid-value","id":"id-value","jobid":"jobid-value","name":"name-value","physicalnetworkid":"physicalnetworkid-value","servicelist":["a","b"],"state":"state-value"}`, &UpdateNetworkServiceProviderResponse{})
+}
+
+func TestListNetworkServiceProvidersParams(t *testing.T) {
+	p := &ListNetworkServiceProvidersParams{}
+	want := url.Values{}
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetPhysicalnetworkid("physicalnetworkid-value")
+	want.Set("physicalnetworkid", "physicalnetworkid-value")
+	p.SetState("state-value")
+	want.Set("state", "state-value")
+
+	assertParams(t, p, "listNetworkServiceProviders", want)
+}
+
+func TestListNetworkServiceProvidersResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"networkserviceprovider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"destinationphysicalnetworkid-value","id":"id-value","name":"name-value","physicalnetworkid":"physicalnetworkid-value","servicelist":["a","b"],"state":"state-value"}]}`, &ListNetworkServiceProvidersResponse{})
+}
+
+func TestListNetworksParams(t *testing.T) {
+	p := &ListNetworksParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetAcltype("acltype-value")
+	want.Set("acltype", "acltype-value")
+	p.SetCanusefordeploy(true)
+	want.Set("canusefordeploy", "true")
+	p.SetDisplaynetwork(true)
+	want.Set("displaynetwork", "true")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetForvpc(true)
+	want.Set("forvpc", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetIssystem(true)
+	want.Set("issystem", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetPhysicalnetworkid("physicalnetworkid-value")
+	want.Set("physicalnetworkid", "physicalnetworkid-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetRestartrequired(true)
+	want.Set("restartrequired", "true")
+	p.SetSpecifyipranges(true)
+	want.Set("specifyipranges", "true")
+	p.SetSupportedservices([]string{"a", "b"})
+	want.Set("supportedservices", "a,b")
+	p.SetTags(map[string]string{"k": "v"})
+	want.Set("tags[0].key", "k")
+	want.Set("tags[0].value", "v")
+	p.SetTraffictype("traffictype-value")
+	want.Set("traffictype", "traffictype-value")
+	p.SetType("type-value")
+	want.Set("type", "type-value")
+	p.SetVpcid("vpcid-value")
+	want.Set("vpcid", "vpcid-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listNetworks", want)
+}
+
+func TestListNetworksResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"network":[{"account":"account-value","aclid":"aclid-value","aclname":"aclname-value","acltype":"acltype-value","broadcastdomaintype":"broadcastdomaintype-value","broadcasturi":"broadcasturi-value","canusefordeploy":true,"cidr":"cidr-value","dhcpbootfilename":"dhcpbootfilename-value","dhcptftpserver":"dhcptftpserver-value","displaynetwork":true,"displaytext":"displaytext-value","dns1":"dns1-value","dns2":"dns2-value","domain":"domain-value","domainid":"domainid-value","gateway":"gateway-value","id":"id-value","ip6cidr":"ip6cidr-value","ip6gateway":"ip6gateway-value","ipexclusionlist":"ipexclusionlist-value","isdefault":true,"ispersistent":true,"issystem":true,"name":"name-value","netmask":"netmask-value","networkcidr":"networkcidr-value","networkdomain":"networkdomain-value","networkofferingavailability":"networkofferingavailability-value","networkofferingconservemode":true,"networkofferingdisplaytext":"networkofferingdisplaytext-value","networkofferingid":"networkofferingid-value","networkofferingname":"networkofferingname-value","physicalnetworkid":"physicalnetworkid-value","project":"project-value","projectid":"projectid-value","related":"related-value","reservediprange":"reservediprange-value","restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"name-value","value":"value-value"}],"name":"name-value","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"destinationphysicalnetworkid-value","id":"id-value","name":"name-value","physicalnetworkid":"physicalnetworkid-value","servicelist":["a","b"],"state":"state-value"}]}],"specifyipranges":true,"state":"state-value","strechedl2subnet":true,"subdomainaccess":true,"tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"traffictype":"traffictype-value","type":"type-value","vlan":"vlan-value","vpcid":"vpcid-value","vpcname":"vpcname-value","zoneid":"zoneid-value","zonename":"zonename-value","zonesnetworkspans":["a","b"]}]}`, &ListNetworksResponse{})
+}
+
+func TestListNiciraNvpDeviceNetworksParams(t *testing.T) {
+	p := &ListNiciraNvpDeviceNetworksParams{}
+	want := url.Values{}
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetNvpdeviceid("nvpdeviceid-value")
+	want.Set("nvpdeviceid", "nvpdeviceid-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+
+	assertParams(t, p, "listNiciraNvpDeviceNetworks", want)
+}
+
+func TestListNiciraNvpDeviceNetworksResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"niciranvpdevicenetwork":[{"account":"account-value","aclid":"aclid-value","aclname":"aclname-value","acltype":"acltype-value","broadcastdomaintype":"broadcastdomaintype-value","broadcasturi":"broadcasturi-value","canusefordeploy":true,"cidr":"cidr-value","dhcpbootfilename":"dhcpbootfilename-value","dhcptftpserver":"dhcptftpserver-value","displaynetwork":true,"displaytext":"displaytext-value","dns1":"dns1-value","dns2":"dns2-value","domain":"domain-value","domainid":"domainid-value","gateway":"gateway-value","id":"id-value","ip6cidr":"ip6cidr-value","ip6gateway":"ip6gateway-value","ipexclusionlist":"ipexclusionlist-value","isdefault":true,"ispersistent":true,"issystem":true,"name":"name-value","netmask":"netmask-value","networkcidr":"networkcidr-value","networkdomain":"networkdomain-value","networkofferingavailability":"networkofferingavailability-value","networkofferingconservemode":true,"networkofferingdisplaytext":"networkofferingdisplaytext-value","networkofferingid":"networkofferingid-value","networkofferingname":"networkofferingname-value","physicalnetworkid":"physicalnetworkid-value","project":"project-value","projectid":"projectid-value","related":"related-value","reservediprange":"reservediprange-value","restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"name-value","value":"value-value"}],"name":"name-value","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"destinationphysicalnetworkid-value","id":"id-value","name":"name-value","physicalnetworkid":"physicalnetworkid-value","servicelist":["a","b"],"state":"state-value"}]}],"specifyipranges":true,"state":"state-value","strechedl2subnet":true,"subdomainaccess":true,"tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"traffictype":"traffictype-value","type":"type-value","vlan":"vlan-value","vpcid":"vpcid-value","vpcname":"vpcname-value","zoneid":"zoneid-value","zonename":"zonename-value","zonesnetworkspans":["a","b"]}]}`, &ListNiciraNvpDeviceNetworksResponse{})
+}
+
+func TestCreatePhysicalNetworkParams(t *testing.T) {
+	p := &CreatePhysicalNetworkParams{}
+	want := url.Values{}
+	p.SetBroadcastdomainrange("broadcastdomainrange-value")
+	want.Set("broadcastdomainrange", "broadcastdomainrange-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetIsolationmethods([]string{"a", "b"})
+	want.Set("isolationmethods", "a,b")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetNetworkspeed("networkspeed-value")
+	want.Set("networkspeed", "networkspeed-value")
+	p.SetTags([]string{"a", "b"})
+	want.Set("tags", "a,b")
+	p.SetVlan("vlan-value")
+	want.Set("vlan", "vlan-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "createPhysicalNetwork", want)
+}
+
+func TestCreatePhysicalNetworkResponse(t *testing.T) {
+	assertDecodes(t, `{"broadcastdomainrange":"broadcastdomainrange-value","domainid":"domainid-value","id":"id-value","isolationmethods":"isolationmethods-value","jobid":"jobid-value","name":"name-value","networkspeed":"networkspeed-value","state":"state-value","tags":"tags-value","vlan":"vlan-value","zoneid":"zoneid-value"}`, &CreatePhysicalNetworkResponse{})
+}
+
+func TestDeletePhysicalNetworkParams(t *testing.T) {
+	p := &DeletePhysicalNetworkParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deletePhysicalNetwork", want)
+}
+
+func TestDeletePhysicalNetworkResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeletePhysicalNetworkResponse{})
+}
+
+func TestUpdatePhysicalNetworkParams(t *testing.T) {
+	p := &UpdatePhysicalNetworkParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetNetworkspeed("networkspeed-value")
+	want.Set("networkspeed", "networkspeed-value")
+	p.SetState("state-value")
+	want.Set("state", "state-value")
+	p.SetTags([]string{"a", "b"})
+	want.Set("tags", "a,b")
+	p.SetVlan("vlan-value")
+	want.Set("vlan", "vlan-value")
+
+	assertParams(t, p, "updatePhysicalNetwork", want)
+}
+
+func TestUpdatePhysicalNetworkResponse(t *testing.T) {
+	assertDecodes(t, `{"broadcastdomainrange":"broadcastdomainrange-value","domainid":"domainid-value","id":"id-value","isolationmethods":"isolationmethods-value","jobid":"jobid-value","name":"name-value","networkspeed":"networkspeed-value","state":"state-value","tags":"tags-value","vlan":"vlan-value","zoneid":"zoneid-value"}`, &UpdatePhysicalNetworkResponse{})
+}
+
+func TestListPhysicalNetworksParams(t *testing.T) {
+	p := &ListPhysicalNetworksParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listPhysicalNetworks", want)
+}
+
+func TestListPhysicalNetworksResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"physicalnetwork":[{"broadcastdomainrange":"broadcastdomainrange-value","domainid":"domainid-value","id":"id-value","isolationmethods":"isolationmethods-value","name":"name-value","networkspeed":"networkspeed-value","state":"state-value","tags":"tags-value","vlan":"vlan-value","zoneid":"zoneid-value"}]}`, &ListPhysicalNetworksResponse{})
+}
+
+func TestDedicatePublicIpRangeParams(t *testing.T) {
+	p := &DedicatePublicIpRangeParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+
+	assertParams(t, p, "dedicatePublicIpRange", want)
+}
+
+func TestDedicatePublicIpRangeResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","description":"description-value","domain":"domain-value","domainid":"domainid-value","endip":"endip-value","endipv6":"endipv6-value","forvirtualnetwork":true,"gateway":"gateway-value","id":"id-value","ip6cidr":"ip6cidr-value","ip6gateway":"ip6gateway-value","netmask":"netmask-value","networkid":"networkid-value","physicalnetworkid":"physicalnetworkid-value","podid":"podid-value","podname":"podname-value","project":"project-value","projectid":"projectid-value","startip":"startip-value","startipv6":"startipv6-value","vlan":"vlan-value","zoneid":"zoneid-value"}`, &DedicatePublicIpRangeResponse{})
+}
+
+func TestReleasePublicIpRangeParams(t *testing.T) {
+	p := &ReleasePublicIpRangeParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "releasePublicIpRange", want)
+}
+
+func TestReleasePublicIpRangeResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &ReleasePublicIpRangeResponse{})
+}
+
+func TestCreateStorageNetworkIpRangeParams(t *testing.T) {
+	p := &CreateStorageNetworkIpRangeParams{}
+	want := url.Values{}
+	p.SetEndip("endip-value")
+	want.Set("endip", "endip-value")
+	p.SetGateway("gateway-value")
+	want.Set("gateway", "gateway-value")
+	p.SetNetmask("netmask-value")
+	want.Set("netmask", "netmask-value")
+	p.SetPodid("podid-value")
+	want.Set("podid", "podid-value")
+	p.SetStartip("startip-value")
+	want.Set("startip", "startip-value")
+	p.SetVlan(42)
+	want.Set("vlan", "42")
+
+	assertParams(t, p, "createStorageNetworkIpRange", want)
+}
+
+func TestCreateStorageNetworkIpRangeResponse(t *testing.T) {
+	assertDecodes(t, `{"endip":"endip-value","gateway":"gateway-value","id":"id-value","jobid":"jobid-value","netmask":"netmask-value","networkid":"networkid-value","podid":"podid-value","startip":"startip-value","vlan":42,"zoneid":"zoneid-value"}`, &CreateStorageNetworkIpRangeResponse{})
+}
+
+func TestDeleteStorageNetworkIpRangeParams(t *testing.T) {
+	p := &DeleteStorageNetworkIpRangeParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteStorageNetworkIpRange", want)
+}
+
+func TestDeleteStorageNetworkIpRangeResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteStorageNetworkIpRangeResponse{})
+}
+
+func TestListStorageNetworkIpRangeParams(t *testing.T) {
+	p := &ListStorageNetworkIpRangeParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetPodid("podid-value")
+	want.Set("podid", "podid-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listStorageNetworkIpRange", want)
+}
+
+func TestListStorageNetworkIpRangeResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"storagenetworkiprange":[{"endip":"endip-value","gateway":"gateway-value","id":"id-value","netmask":"netmask-value","networkid":"networkid-value","podid":"podid-value","startip":"startip-value","vlan":42,"zoneid":"zoneid-value"}]}`, &ListStorageNetworkIpRangeResponse{})
+}
+
+func TestUpdateStorageNetworkIpRangeParams(t *testing.T) {
+	p := &UpdateStorageNetworkIpRangeParams{}
+	want := url.Values{}
+	p.SetEndip("endip-value")
+	want.Set("endip", "endip-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetNetmask("netmask-value")
+	want.Set("netmask", "netmask-value")
+	p.SetStartip("startip-value")
+	want.Set("startip", "startip-value")
+	p.SetVlan(42)
+	want.Set("vlan", "42")
+
+	assertParams(t, p, "updateStorageNetworkIpRange", want)
+}
+
+func TestUpdateStorageNetworkIpRangeResponse(t *testing.T) {
+	assertDecodes(t, `{"endip":"endip-value","gateway":"gateway-value","id":"id-value","jobid":"jobid-value","netmask":"netmask-value","networkid":"networkid-value","podid":"podid-value","startip":"startip-value","vlan":42,"zoneid":"zoneid-value"}`, &UpdateStorageNetworkIpRangeResponse{})
+}
+
+func TestListSupportedNetworkServicesParams(t *testing.T) {
+	p := &ListSupportedNetworkServicesParams{}
+	want := url.Values{}
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProvider("provider-value")
+	want.Set("provider", "provider-value")
+	p.SetService("service-value")
+	want.Set("service", "service-value")
+
+	assertParams(t, p, "listSupportedNetworkServices", want)
+}
+
+func TestListSupportedNetworkServicesResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"supportednetworkservice":[{"capability":[{"canchooseservicecapability":true,"name":"name-value","value":"value-value"}],"name":"name-value","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"destinationphysicalnetworkid-value","id":"id-value","name":"name-value","physicalnetworkid":"physicalnetworkid-value","servicelist":["a","b"],"state":"state-value"}]}]}`, &ListSupportedNetworkServicesResponse{})
+}
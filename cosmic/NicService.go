@@ -17,15 +17,40 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
 )
 
+// NicServiceIface is the interface implemented by NicService, and by MockNicService for tests
+type NicServiceIface interface {
+	NewRemoveIpFromNicParams(id string) *RemoveIpFromNicParams
+	RemoveIpFromNic(p *RemoveIpFromNicParams) (*RemoveIpFromNicResponse, error)
+	RemoveIpFromNicWithContext(ctx context.Context, p *RemoveIpFromNicParams) (*RemoveIpFromNicResponse, error)
+	RemoveIpFromNicAsync(ctx context.Context, p *RemoveIpFromNicParams) (*RemoveIpFromNicJob, error)
+	NewAddIpToNicParams(nicid string) *AddIpToNicParams
+	AddIpToNic(p *AddIpToNicParams) (*AddIpToNicResponse, error)
+	AddIpToNicWithContext(ctx context.Context, p *AddIpToNicParams) (*AddIpToNicResponse, error)
+	AddIpToNicAsync(ctx context.Context, p *AddIpToNicParams) (*AddIpToNicJob, error)
+	NewListNicsParams(virtualmachineid string) *ListNicsParams
+	ListNics(p *ListNicsParams) (*ListNicsResponse, error)
+	ListNicsWithContext(ctx context.Context, p *ListNicsParams) (*ListNicsResponse, error)
+	NewListNicsIterator(p *ListNicsParams, pagesize int) *ListNicsIterator
+	NewUpdateVmNicIpParams(nicid string) *UpdateVmNicIpParams
+	UpdateVmNicIp(p *UpdateVmNicIpParams) (*UpdateVmNicIpResponse, error)
+	UpdateVmNicIpWithContext(ctx context.Context, p *UpdateVmNicIpParams) (*UpdateVmNicIpResponse, error)
+	UpdateVmNicIpAsync(ctx context.Context, p *UpdateVmNicIpParams) (*UpdateVmNicIpJob, error)
+}
+
 type RemoveIpFromNicParams struct {
 	p map[string]interface{}
 }
 
+func (p *RemoveIpFromNicParams) command() string {
+	return "removeIpFromNic"
+}
+
 func (p *RemoveIpFromNicParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -55,7 +80,13 @@ func (s *NicService) NewRemoveIpFromNicParams(id string) *RemoveIpFromNicParams
 
 // Removes secondary IP from the NIC.
 func (s *NicService) RemoveIpFromNic(p *RemoveIpFromNicParams) (*RemoveIpFromNicResponse, error) {
-	resp, err := s.cs.newRequest("removeIpFromNic", p.toURLValues())
+	return s.RemoveIpFromNicWithContext(context.Background(), p)
+}
+
+// RemoveIpFromNicWithContext is the same as RemoveIpFromNic, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NicService) RemoveIpFromNicWithContext(ctx context.Context, p *RemoveIpFromNicParams) (*RemoveIpFromNicResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "removeIpFromNic", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -67,9 +98,9 @@ func (s *NicService) RemoveIpFromNic(p *RemoveIpFromNicParams) (*RemoveIpFromNic
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "removeIpFromNic", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -82,6 +113,41 @@ func (s *NicService) RemoveIpFromNic(p *RemoveIpFromNicParams) (*RemoveIpFromNic
 	return &r, nil
 }
 
+// RemoveIpFromNicJob is a handle to a running removeIpFromNic async job
+type RemoveIpFromNicJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a RemoveIpFromNicResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *RemoveIpFromNicJob) Result() (*RemoveIpFromNicResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := RemoveIpFromNicResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// RemoveIpFromNicAsync starts the removeIpFromNic async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NicService) RemoveIpFromNicAsync(ctx context.Context, p *RemoveIpFromNicParams) (*RemoveIpFromNicJob, error) {
+	resp, err := s.cs.newRequest(ctx, "removeIpFromNic", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RemoveIpFromNicResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &RemoveIpFromNicJob{s.cs.newJobHandle("removeIpFromNic", r.JobID)}, nil
+}
+
 type RemoveIpFromNicResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -92,6 +158,10 @@ type AddIpToNicParams struct {
 	p map[string]interface{}
 }
 
+func (p *AddIpToNicParams) command() string {
+	return "addIpToNic"
+}
+
 func (p *AddIpToNicParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -131,7 +201,13 @@ func (s *NicService) NewAddIpToNicParams(nicid string) *AddIpToNicParams {
 
 // Assigns secondary IP to NIC
 func (s *NicService) AddIpToNic(p *AddIpToNicParams) (*AddIpToNicResponse, error) {
-	resp, err := s.cs.newRequest("addIpToNic", p.toURLValues())
+	return s.AddIpToNicWithContext(context.Background(), p)
+}
+
+// AddIpToNicWithContext is the same as AddIpToNic, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NicService) AddIpToNicWithContext(ctx context.Context, p *AddIpToNicParams) (*AddIpToNicResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "addIpToNic", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -143,26 +219,56 @@ func (s *NicService) AddIpToNic(p *AddIpToNicParams) (*AddIpToNicResponse, error
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "addIpToNic", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "nicsecondaryip", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// AddIpToNicJob is a handle to a running addIpToNic async job
+type AddIpToNicJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a AddIpToNicResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *AddIpToNicJob) Result() (*AddIpToNicResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := AddIpToNicResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "nicsecondaryip", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// AddIpToNicAsync starts the addIpToNic async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NicService) AddIpToNicAsync(ctx context.Context, p *AddIpToNicParams) (*AddIpToNicJob, error) {
+	resp, err := s.cs.newRequest(ctx, "addIpToNic", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddIpToNicResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &AddIpToNicJob{s.cs.newJobHandle("addIpToNic", r.JobID)}, nil
+}
+
 type AddIpToNicResponse struct {
 	JobID            string `json:"jobid,omitempty"`
 	Id               string `json:"id,omitempty"`
@@ -176,6 +282,10 @@ type ListNicsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListNicsParams) command() string {
+	return "listNics"
+}
+
 func (p *ListNicsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -268,10 +378,17 @@ func (s *NicService) NewListNicsParams(virtualmachineid string) *ListNicsParams
 
 // list the vm nics  IP to NIC
 func (s *NicService) ListNics(p *ListNicsParams) (*ListNicsResponse, error) {
+	return s.ListNicsWithContext(context.Background(), p)
+}
+
+// ListNicsWithContext is the same as ListNics, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NicService) ListNicsWithContext(ctx context.Context, p *ListNicsParams) (*ListNicsResponse, error) {
 	var r ListNicsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListNicsResponse
-		resp, err := s.cs.newRequest("listNics", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listNics", values)
 		if err != nil {
 			return nil, err
 		}
@@ -283,13 +400,89 @@ func (s *NicService) ListNics(p *ListNicsParams) (*ListNicsResponse, error) {
 		r.Count = l.Count
 		r.Nics = append(r.Nics, l.Nics...)
 
-		if r.Count == len(r.Nics) {
+		if r.Count == len(r.Nics) || len(l.Nics) == 0 {
+			s.cs.observePages("listNics", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.Nics))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.Nics)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listNics", values, len(l.Nics), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListNicsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.Nics = append(r.Nics, l.Nics...)
+			}
+
+			if r.Count == len(r.Nics) {
+				s.cs.observePages("listNics", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListNicsIterator iterates over the items returned by listNics, requesting
+// the pages of the response one at a time while iterating.
+type ListNicsIterator struct {
+	pager
+	items []*Nic
+	index int
+}
+
+// NewListNicsIterator returns an iterator over the items returned by listNics, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *NicService) NewListNicsIterator(p *ListNicsParams, pagesize int) *ListNicsIterator {
+	return &ListNicsIterator{pager: newPager(s.cs, "listNics", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListNicsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
 	}
+
+	var l ListNicsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.Nics))
+
+	i.items = l.Nics
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListNicsIterator) Value() *Nic {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
 }
 
 type ListNicsResponse struct {
@@ -324,6 +517,10 @@ type UpdateVmNicIpParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateVmNicIpParams) command() string {
+	return "updateVmNicIp"
+}
+
 func (p *UpdateVmNicIpParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -363,7 +560,13 @@ func (s *NicService) NewUpdateVmNicIpParams(nicid string) *UpdateVmNicIpParams {
 
 // Update the default Ip of a VM Nic
 func (s *NicService) UpdateVmNicIp(p *UpdateVmNicIpParams) (*UpdateVmNicIpResponse, error) {
-	resp, err := s.cs.newRequest("updateVmNicIp", p.toURLValues())
+	return s.UpdateVmNicIpWithContext(context.Background(), p)
+}
+
+// UpdateVmNicIpWithContext is the same as UpdateVmNicIp, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NicService) UpdateVmNicIpWithContext(ctx context.Context, p *UpdateVmNicIpParams) (*UpdateVmNicIpResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateVmNicIp", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -375,26 +578,56 @@ func (s *NicService) UpdateVmNicIp(p *UpdateVmNicIpParams) (*UpdateVmNicIpRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateVmNicIp", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// UpdateVmNicIpJob is a handle to a running updateVmNicIp async job
+type UpdateVmNicIpJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateVmNicIpResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateVmNicIpJob) Result() (*UpdateVmNicIpResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateVmNicIpResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// UpdateVmNicIpAsync starts the updateVmNicIp async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NicService) UpdateVmNicIpAsync(ctx context.Context, p *UpdateVmNicIpParams) (*UpdateVmNicIpJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateVmNicIp", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateVmNicIpResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateVmNicIpJob{s.cs.newJobHandle("updateVmNicIp", r.JobID)}, nil
+}
+
 type UpdateVmNicIpResponse struct {
 	JobID         string `json:"jobid,omitempty"`
 	Account       string `json:"account,omitempty"`
@@ -0,0 +1,138 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockNicService is a mock of NicServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockNicService struct {
+	mockCalls
+
+	RemoveIpFromNicFunc      func(ctx context.Context, p *RemoveIpFromNicParams) (*RemoveIpFromNicResponse, error)
+	RemoveIpFromNicAsyncFunc func(ctx context.Context, p *RemoveIpFromNicParams) (*RemoveIpFromNicJob, error)
+	AddIpToNicFunc           func(ctx context.Context, p *AddIpToNicParams) (*AddIpToNicResponse, error)
+	AddIpToNicAsyncFunc      func(ctx context.Context, p *AddIpToNicParams) (*AddIpToNicJob, error)
+	ListNicsFunc             func(ctx context.Context, p *ListNicsParams) (*ListNicsResponse, error)
+	UpdateVmNicIpFunc        func(ctx context.Context, p *UpdateVmNicIpParams) (*UpdateVmNicIpResponse, error)
+	UpdateVmNicIpAsyncFunc   func(ctx context.Context, p *UpdateVmNicIpParams) (*UpdateVmNicIpJob, error)
+}
+
+var _ NicServiceIface = &MockNicService{}
+
+func (m *MockNicService) NewRemoveIpFromNicParams(id string) *RemoveIpFromNicParams {
+	return (&NicService{}).NewRemoveIpFromNicParams(id)
+}
+
+func (m *MockNicService) RemoveIpFromNic(p *RemoveIpFromNicParams) (*RemoveIpFromNicResponse, error) {
+	return m.RemoveIpFromNicWithContext(context.Background(), p)
+}
+
+func (m *MockNicService) RemoveIpFromNicWithContext(ctx context.Context, p *RemoveIpFromNicParams) (*RemoveIpFromNicResponse, error) {
+	m.record("RemoveIpFromNic", p)
+	if m.RemoveIpFromNicFunc == nil {
+		return nil, notMocked("MockNicService", "RemoveIpFromNic")
+	}
+	return m.RemoveIpFromNicFunc(ctx, p)
+}
+
+func (m *MockNicService) RemoveIpFromNicAsync(ctx context.Context, p *RemoveIpFromNicParams) (*RemoveIpFromNicJob, error) {
+	m.record("RemoveIpFromNicAsync", p)
+	if m.RemoveIpFromNicAsyncFunc == nil {
+		return nil, notMocked("MockNicService", "RemoveIpFromNicAsync")
+	}
+	return m.RemoveIpFromNicAsyncFunc(ctx, p)
+}
+
+func (m *MockNicService) NewAddIpToNicParams(nicid string) *AddIpToNicParams {
+	return (&NicService{}).NewAddIpToNicParams(nicid)
+}
+
+func (m *MockNicService) AddIpToNic(p *AddIpToNicParams) (*AddIpToNicResponse, error) {
+	return m.AddIpToNicWithContext(context.Background(), p)
+}
+
+func (m *MockNicService) AddIpToNicWithContext(ctx context.Context, p *AddIpToNicParams) (*AddIpToNicResponse, error) {
+	m.record("AddIpToNic", p)
+	if m.AddIpToNicFunc == nil {
+		return nil, notMocked("MockNicService", "AddIpToNic")
+	}
+	return m.AddIpToNicFunc(ctx, p)
+}
+
+func (m *MockNicService) AddIpToNicAsync(ctx context.Context, p *AddIpToNicParams) (*AddIpToNicJob, error) {
+	m.record("AddIpToNicAsync", p)
+	if m.AddIpToNicAsyncFunc == nil {
+		return nil, notMocked("MockNicService", "AddIpToNicAsync")
+	}
+	return m.AddIpToNicAsyncFunc(ctx, p)
+}
+
+func (m *MockNicService) NewListNicsParams(virtualmachineid string) *ListNicsParams {
+	return (&NicService{}).NewListNicsParams(virtualmachineid)
+}
+
+func (m *MockNicService) ListNics(p *ListNicsParams) (*ListNicsResponse, error) {
+	return m.ListNicsWithContext(context.Background(), p)
+}
+
+func (m *MockNicService) ListNicsWithContext(ctx context.Context, p *ListNicsParams) (*ListNicsResponse, error) {
+	m.record("ListNics", p)
+	if m.ListNicsFunc == nil {
+		return nil, notMocked("MockNicService", "ListNics")
+	}
+	return m.ListNicsFunc(ctx, p)
+}
+
+func (m *MockNicService) NewListNicsIterator(p *ListNicsParams, pagesize int) *ListNicsIterator {
+	i := &ListNicsIterator{pager: newPager(nil, "listNics", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListNicsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListNicsWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockNicService) NewUpdateVmNicIpParams(nicid string) *UpdateVmNicIpParams {
+	return (&NicService{}).NewUpdateVmNicIpParams(nicid)
+}
+
+func (m *MockNicService) UpdateVmNicIp(p *UpdateVmNicIpParams) (*UpdateVmNicIpResponse, error) {
+	return m.UpdateVmNicIpWithContext(context.Background(), p)
+}
+
+func (m *MockNicService) UpdateVmNicIpWithContext(ctx context.Context, p *UpdateVmNicIpParams) (*UpdateVmNicIpResponse, error) {
+	m.record("UpdateVmNicIp", p)
+	if m.UpdateVmNicIpFunc == nil {
+		return nil, notMocked("MockNicService", "UpdateVmNicIp")
+	}
+	return m.UpdateVmNicIpFunc(ctx, p)
+}
+
+func (m *MockNicService) UpdateVmNicIpAsync(ctx context.Context, p *UpdateVmNicIpParams) (*UpdateVmNicIpJob, error) {
+	m.record("UpdateVmNicIpAsync", p)
+	if m.UpdateVmNicIpAsyncFunc == nil {
+		return nil, notMocked("MockNicService", "UpdateVmNicIpAsync")
+	}
+	return m.UpdateVmNicIpAsyncFunc(ctx, p)
+}
@@ -0,0 +1,90 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestRemoveIpFromNicParams(t *testing.T) {
+	p := &RemoveIpFromNicParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "removeIpFromNic", want)
+}
+
+func TestRemoveIpFromNicResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &RemoveIpFromNicResponse{})
+}
+
+func TestAddIpToNicParams(t *testing.T) {
+	p := &AddIpToNicParams{}
+	want := url.Values{}
+	p.SetIpaddress("ipaddress-value")
+	want.Set("ipaddress", "ipaddress-value")
+	p.SetNicid("nicid-value")
+	want.Set("nicid", "nicid-value")
+
+	assertParams(t, p, "addIpToNic", want)
+}
+
+func TestAddIpToNicResponse(t *testing.T) {
+	assertDecodes(t, `{"id":"id-value","ipaddress":"ipaddress-value","jobid":"jobid-value","networkid":"networkid-value","nicid":"nicid-value","virtualmachineid":"virtualmachineid-value"}`, &AddIpToNicResponse{})
+}
+
+func TestListNicsParams(t *testing.T) {
+	p := &ListNicsParams{}
+	want := url.Values{}
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetNetworkid("networkid-value")
+	want.Set("networkid", "networkid-value")
+	p.SetNicid("nicid-value")
+	want.Set("nicid", "nicid-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetVirtualmachineid("virtualmachineid-value")
+	want.Set("virtualmachineid", "virtualmachineid-value")
+
+	assertParams(t, p, "listNics", want)
+}
+
+func TestListNicsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"nic":[{"broadcasturi":"broadcasturi-value","gateway":"gateway-value","id":"id-value","ip6address":"ip6address-value","ip6cidr":"ip6cidr-value","ip6gateway":"ip6gateway-value","ipaddress":"ipaddress-value","isdefault":true,"isolationuri":"isolationuri-value","macaddress":"macaddress-value","netmask":"netmask-value","networkid":"networkid-value","networkname":"networkname-value","secondaryip":[{"id":"id-value","ipaddress":"ipaddress-value"}],"traffictype":"traffictype-value","type":"type-value","virtualmachineid":"virtualmachineid-value"}]}`, &ListNicsResponse{})
+}
+
+func TestUpdateVmNicIpParams(t *testing.T) {
+	p := &UpdateVmNicIpParams{}
+	want := url.Values{}
+	p.SetIpaddress("ipaddress-value")
+	want.Set("ipaddress", "ipaddress-value")
+	p.SetNicid("nicid-value")
+	want.Set("nicid", "nicid-value")
+
+	assertParams(t, p, "updateVmNicIp", want)
+}
+
+func TestUpdateVmNicIpResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","affinitygroup":[{"account":"account-value","description":"description-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","name":"name-value","project":"project-value","projectid":"projectid-value","type":"type-value","virtualmachineIds":["a","b"]}],"bootmenutimeout":1099511627776,"cpunumber":42,"cpuused":"cpuused-value","created":"created-value","details":{"key":"value"},"diskioread":1099511627776,"diskiowrite":1099511627776,"diskkbsread":1099511627776,"diskkbswrite":1099511627776,"diskofferingid":"diskofferingid-value","diskofferingname":"diskofferingname-value","displayname":"displayname-value","displayvm":true,"domain":"domain-value","domainid":"domainid-value","forvirtualnetwork":true,"group":"group-value","groupid":"groupid-value","guestosid":"guestosid-value","haenable":true,"hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","instancename":"instancename-value","isdynamicallyscalable":true,"isodisplaytext":"isodisplaytext-value","isoid":"isoid-value","isoname":"isoname-value","jobid":"jobid-value","keypair":"keypair-value","laststartdate":"laststartdate-value","laststartversion":"laststartversion-value","maintenancepolicy":"maintenancepolicy-value","manufacturerstring":"manufacturerstring-value","memory":42,"name":"name-value","networkkbsread":1099511627776,"networkkbswrite":1099511627776,"nic":[{"broadcasturi":"broadcasturi-value","gateway":"gateway-value","id":"id-value","ip6address":"ip6address-value","ip6cidr":"ip6cidr-value","ip6gateway":"ip6gateway-value","ipaddress":"ipaddress-value","isdefault":true,"isolationuri":"isolationuri-value","macaddress":"macaddress-value","netmask":"netmask-value","networkid":"networkid-value","networkname":"networkname-value","secondaryip":[{"id":"id-value","ipaddress":"ipaddress-value"}],"traffictype":"traffictype-value","type":"type-value","virtualmachineid":"virtualmachineid-value"}],"optimisefor":"optimisefor-value","ostypeid":1099511627776,"password":"password-value","passwordenabled":true,"project":"project-value","projectid":"projectid-value","publicip":"publicip-value","publicipid":"publicipid-value","restartrequired":true,"rootdevicecontroller":"rootdevicecontroller-value","rootdeviceid":1099511627776,"rootdevicetype":"rootdevicetype-value","serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","servicestate":"servicestate-value","state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templatedisplaytext":"templatedisplaytext-value","templateid":"templateid-value","templatename":"templatename-value","userid":"userid-value","username":"username-value","vgpu":"vgpu-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &UpdateVmNicIpResponse{})
+}
@@ -17,15 +17,36 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
 )
 
+// NiciraNVPServiceIface is the interface implemented by NiciraNVPService, and by MockNiciraNVPService for tests
+type NiciraNVPServiceIface interface {
+	NewAddNiciraNvpDeviceParams(hostname string, password string, physicalnetworkid string, transportzoneuuid string, username string) *AddNiciraNvpDeviceParams
+	AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceResponse, error)
+	AddNiciraNvpDeviceWithContext(ctx context.Context, p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceResponse, error)
+	AddNiciraNvpDeviceAsync(ctx context.Context, p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceJob, error)
+	NewDeleteNiciraNvpDeviceParams(nvpdeviceid string) *DeleteNiciraNvpDeviceParams
+	DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error)
+	DeleteNiciraNvpDeviceWithContext(ctx context.Context, p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error)
+	DeleteNiciraNvpDeviceAsync(ctx context.Context, p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceJob, error)
+	NewListNiciraNvpDevicesParams() *ListNiciraNvpDevicesParams
+	ListNiciraNvpDevices(p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error)
+	ListNiciraNvpDevicesWithContext(ctx context.Context, p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error)
+	NewListNiciraNvpDevicesIterator(p *ListNiciraNvpDevicesParams, pagesize int) *ListNiciraNvpDevicesIterator
+}
+
 type AddNiciraNvpDeviceParams struct {
 	p map[string]interface{}
 }
 
+func (p *AddNiciraNvpDeviceParams) command() string {
+	return "addNiciraNvpDevice"
+}
+
 func (p *AddNiciraNvpDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -109,7 +130,13 @@ func (s *NiciraNVPService) NewAddNiciraNvpDeviceParams(hostname string, password
 
 // Adds a Nicira NVP device
 func (s *NiciraNVPService) AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceResponse, error) {
-	resp, err := s.cs.newRequest("addNiciraNvpDevice", p.toURLValues())
+	return s.AddNiciraNvpDeviceWithContext(context.Background(), p)
+}
+
+// AddNiciraNvpDeviceWithContext is the same as AddNiciraNvpDevice, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NiciraNVPService) AddNiciraNvpDeviceWithContext(ctx context.Context, p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "addNiciraNvpDevice", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -121,26 +148,56 @@ func (s *NiciraNVPService) AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams) (*Add
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "addNiciraNvpDevice", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "niciranvpdevice", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// AddNiciraNvpDeviceJob is a handle to a running addNiciraNvpDevice async job
+type AddNiciraNvpDeviceJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a AddNiciraNvpDeviceResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *AddNiciraNvpDeviceJob) Result() (*AddNiciraNvpDeviceResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := AddNiciraNvpDeviceResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "niciranvpdevice", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// AddNiciraNvpDeviceAsync starts the addNiciraNvpDevice async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NiciraNVPService) AddNiciraNvpDeviceAsync(ctx context.Context, p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceJob, error) {
+	resp, err := s.cs.newRequest(ctx, "addNiciraNvpDevice", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddNiciraNvpDeviceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &AddNiciraNvpDeviceJob{s.cs.newJobHandle("addNiciraNvpDevice", r.JobID)}, nil
+}
+
 type AddNiciraNvpDeviceResponse struct {
 	JobID                string `json:"jobid,omitempty"`
 	Hostname             string `json:"hostname,omitempty"`
@@ -156,6 +213,10 @@ type DeleteNiciraNvpDeviceParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteNiciraNvpDeviceParams) command() string {
+	return "deleteNiciraNvpDevice"
+}
+
 func (p *DeleteNiciraNvpDeviceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -183,9 +244,15 @@ func (s *NiciraNVPService) NewDeleteNiciraNvpDeviceParams(nvpdeviceid string) *D
 	return p
 }
 
-//  delete a nicira nvp device
+// delete a nicira nvp device
 func (s *NiciraNVPService) DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error) {
-	resp, err := s.cs.newRequest("deleteNiciraNvpDevice", p.toURLValues())
+	return s.DeleteNiciraNvpDeviceWithContext(context.Background(), p)
+}
+
+// DeleteNiciraNvpDeviceWithContext is the same as DeleteNiciraNvpDevice, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NiciraNVPService) DeleteNiciraNvpDeviceWithContext(ctx context.Context, p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteNiciraNvpDevice", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -197,9 +264,9 @@ func (s *NiciraNVPService) DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteNiciraNvpDevice", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -212,6 +279,41 @@ func (s *NiciraNVPService) DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams)
 	return &r, nil
 }
 
+// DeleteNiciraNvpDeviceJob is a handle to a running deleteNiciraNvpDevice async job
+type DeleteNiciraNvpDeviceJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteNiciraNvpDeviceResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteNiciraNvpDeviceJob) Result() (*DeleteNiciraNvpDeviceResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteNiciraNvpDeviceResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteNiciraNvpDeviceAsync starts the deleteNiciraNvpDevice async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *NiciraNVPService) DeleteNiciraNvpDeviceAsync(ctx context.Context, p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteNiciraNvpDevice", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteNiciraNvpDeviceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteNiciraNvpDeviceJob{s.cs.newJobHandle("deleteNiciraNvpDevice", r.JobID)}, nil
+}
+
 type DeleteNiciraNvpDeviceResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -222,6 +324,10 @@ type ListNiciraNvpDevicesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListNiciraNvpDevicesParams) command() string {
+	return "listNiciraNvpDevices"
+}
+
 func (p *ListNiciraNvpDevicesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -292,10 +398,17 @@ func (s *NiciraNVPService) NewListNiciraNvpDevicesParams() *ListNiciraNvpDevices
 
 // Lists Nicira NVP devices
 func (s *NiciraNVPService) ListNiciraNvpDevices(p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error) {
+	return s.ListNiciraNvpDevicesWithContext(context.Background(), p)
+}
+
+// ListNiciraNvpDevicesWithContext is the same as ListNiciraNvpDevices, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *NiciraNVPService) ListNiciraNvpDevicesWithContext(ctx context.Context, p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error) {
 	var r ListNiciraNvpDevicesResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListNiciraNvpDevicesResponse
-		resp, err := s.cs.newRequest("listNiciraNvpDevices", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listNiciraNvpDevices", values)
 		if err != nil {
 			return nil, err
 		}
@@ -307,13 +420,89 @@ func (s *NiciraNVPService) ListNiciraNvpDevices(p *ListNiciraNvpDevicesParams) (
 		r.Count = l.Count
 		r.NiciraNvpDevices = append(r.NiciraNvpDevices, l.NiciraNvpDevices...)
 
-		if r.Count == len(r.NiciraNvpDevices) {
+		if r.Count == len(r.NiciraNvpDevices) || len(l.NiciraNvpDevices) == 0 {
+			s.cs.observePages("listNiciraNvpDevices", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.NiciraNvpDevices))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.NiciraNvpDevices)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listNiciraNvpDevices", values, len(l.NiciraNvpDevices), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListNiciraNvpDevicesResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.NiciraNvpDevices = append(r.NiciraNvpDevices, l.NiciraNvpDevices...)
+			}
+
+			if r.Count == len(r.NiciraNvpDevices) {
+				s.cs.observePages("listNiciraNvpDevices", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListNiciraNvpDevicesIterator iterates over the items returned by listNiciraNvpDevices, requesting
+// the pages of the response one at a time while iterating.
+type ListNiciraNvpDevicesIterator struct {
+	pager
+	items []*NiciraNvpDevice
+	index int
+}
+
+// NewListNiciraNvpDevicesIterator returns an iterator over the items returned by listNiciraNvpDevices, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *NiciraNVPService) NewListNiciraNvpDevicesIterator(p *ListNiciraNvpDevicesParams, pagesize int) *ListNiciraNvpDevicesIterator {
+	return &ListNiciraNvpDevicesIterator{pager: newPager(s.cs, "listNiciraNvpDevices", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListNiciraNvpDevicesIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListNiciraNvpDevicesResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.NiciraNvpDevices))
+
+	i.items = l.NiciraNvpDevices
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListNiciraNvpDevicesIterator) Value() *NiciraNvpDevice {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListNiciraNvpDevicesResponse struct {
@@ -0,0 +1,112 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockNiciraNVPService is a mock of NiciraNVPServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockNiciraNVPService struct {
+	mockCalls
+
+	AddNiciraNvpDeviceFunc         func(ctx context.Context, p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceResponse, error)
+	AddNiciraNvpDeviceAsyncFunc    func(ctx context.Context, p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceJob, error)
+	DeleteNiciraNvpDeviceFunc      func(ctx context.Context, p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error)
+	DeleteNiciraNvpDeviceAsyncFunc func(ctx context.Context, p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceJob, error)
+	ListNiciraNvpDevicesFunc       func(ctx context.Context, p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error)
+}
+
+var _ NiciraNVPServiceIface = &MockNiciraNVPService{}
+
+func (m *MockNiciraNVPService) NewAddNiciraNvpDeviceParams(hostname string, password string, physicalnetworkid string, transportzoneuuid string, username string) *AddNiciraNvpDeviceParams {
+	return (&NiciraNVPService{}).NewAddNiciraNvpDeviceParams(hostname, password, physicalnetworkid, transportzoneuuid, username)
+}
+
+func (m *MockNiciraNVPService) AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceResponse, error) {
+	return m.AddNiciraNvpDeviceWithContext(context.Background(), p)
+}
+
+func (m *MockNiciraNVPService) AddNiciraNvpDeviceWithContext(ctx context.Context, p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceResponse, error) {
+	m.record("AddNiciraNvpDevice", p)
+	if m.AddNiciraNvpDeviceFunc == nil {
+		return nil, notMocked("MockNiciraNVPService", "AddNiciraNvpDevice")
+	}
+	return m.AddNiciraNvpDeviceFunc(ctx, p)
+}
+
+func (m *MockNiciraNVPService) AddNiciraNvpDeviceAsync(ctx context.Context, p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceJob, error) {
+	m.record("AddNiciraNvpDeviceAsync", p)
+	if m.AddNiciraNvpDeviceAsyncFunc == nil {
+		return nil, notMocked("MockNiciraNVPService", "AddNiciraNvpDeviceAsync")
+	}
+	return m.AddNiciraNvpDeviceAsyncFunc(ctx, p)
+}
+
+func (m *MockNiciraNVPService) NewDeleteNiciraNvpDeviceParams(nvpdeviceid string) *DeleteNiciraNvpDeviceParams {
+	return (&NiciraNVPService{}).NewDeleteNiciraNvpDeviceParams(nvpdeviceid)
+}
+
+func (m *MockNiciraNVPService) DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error) {
+	return m.DeleteNiciraNvpDeviceWithContext(context.Background(), p)
+}
+
+func (m *MockNiciraNVPService) DeleteNiciraNvpDeviceWithContext(ctx context.Context, p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error) {
+	m.record("DeleteNiciraNvpDevice", p)
+	if m.DeleteNiciraNvpDeviceFunc == nil {
+		return nil, notMocked("MockNiciraNVPService", "DeleteNiciraNvpDevice")
+	}
+	return m.DeleteNiciraNvpDeviceFunc(ctx, p)
+}
+
+func (m *MockNiciraNVPService) DeleteNiciraNvpDeviceAsync(ctx context.Context, p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceJob, error) {
+	m.record("DeleteNiciraNvpDeviceAsync", p)
+	if m.DeleteNiciraNvpDeviceAsyncFunc == nil {
+		return nil, notMocked("MockNiciraNVPService", "DeleteNiciraNvpDeviceAsync")
+	}
+	return m.DeleteNiciraNvpDeviceAsyncFunc(ctx, p)
+}
+
+func (m *MockNiciraNVPService) NewListNiciraNvpDevicesParams() *ListNiciraNvpDevicesParams {
+	return (&NiciraNVPService{}).NewListNiciraNvpDevicesParams()
+}
+
+func (m *MockNiciraNVPService) ListNiciraNvpDevices(p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error) {
+	return m.ListNiciraNvpDevicesWithContext(context.Background(), p)
+}
+
+func (m *MockNiciraNVPService) ListNiciraNvpDevicesWithContext(ctx context.Context, p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error) {
+	m.record("ListNiciraNvpDevices", p)
+	if m.ListNiciraNvpDevicesFunc == nil {
+		return nil, notMocked("MockNiciraNVPService", "ListNiciraNvpDevices")
+	}
+	return m.ListNiciraNvpDevicesFunc(ctx, p)
+}
+
+func (m *MockNiciraNVPService) NewListNiciraNvpDevicesIterator(p *ListNiciraNvpDevicesParams, pagesize int) *ListNiciraNvpDevicesIterator {
+	i := &ListNiciraNvpDevicesIterator{pager: newPager(nil, "listNiciraNvpDevices", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListNiciraNvpDevicesParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListNiciraNvpDevicesWithContext(ctx, c)
+	}
+	return i
+}
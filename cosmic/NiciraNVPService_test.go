@@ -0,0 +1,79 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestAddNiciraNvpDeviceParams(t *testing.T) {
+	p := &AddNiciraNvpDeviceParams{}
+	want := url.Values{}
+	p.SetHostname("hostname-value")
+	want.Set("hostname", "hostname-value")
+	p.SetL3gatewayserviceuuid("l3gatewayserviceuuid-value")
+	want.Set("l3gatewayserviceuuid", "l3gatewayserviceuuid-value")
+	p.SetPassword("password-value")
+	want.Set("password", "password-value")
+	p.SetPhysicalnetworkid("physicalnetworkid-value")
+	want.Set("physicalnetworkid", "physicalnetworkid-value")
+	p.SetTransportzoneuuid("transportzoneuuid-value")
+	want.Set("transportzoneuuid", "transportzoneuuid-value")
+	p.SetUsername("username-value")
+	want.Set("username", "username-value")
+
+	assertParams(t, p, "addNiciraNvpDevice", want)
+}
+
+func TestAddNiciraNvpDeviceResponse(t *testing.T) {
+	assertDecodes(t, `{"hostname":"hostname-value","jobid":"jobid-value","l3gatewayserviceuuid":"l3gatewayserviceuuid-value","niciradevicename":"niciradevicename-value","nvpdeviceid":"nvpdeviceid-value","physicalnetworkid":"physicalnetworkid-value","provider":"provider-value","transportzoneuuid":"transportzoneuuid-value"}`, &AddNiciraNvpDeviceResponse{})
+}
+
+func TestDeleteNiciraNvpDeviceParams(t *testing.T) {
+	p := &DeleteNiciraNvpDeviceParams{}
+	want := url.Values{}
+	p.SetNvpdeviceid("nvpdeviceid-value")
+	want.Set("nvpdeviceid", "nvpdeviceid-value")
+
+	assertParams(t, p, "deleteNiciraNvpDevice", want)
+}
+
+func TestDeleteNiciraNvpDeviceResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteNiciraNvpDeviceResponse{})
+}
+
+func TestListNiciraNvpDevicesParams(t *testing.T) {
+	p := &ListNiciraNvpDevicesParams{}
+	want := url.Values{}
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetNvpdeviceid("nvpdeviceid-value")
+	want.Set("nvpdeviceid", "nvpdeviceid-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetPhysicalnetworkid("physicalnetworkid-value")
+	want.Set("physicalnetworkid", "physicalnetworkid-value")
+
+	assertParams(t, p, "listNiciraNvpDevices", want)
+}
+
+func TestListNiciraNvpDevicesResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"niciranvpdevice":[{"hostname":"hostname-value","l3gatewayserviceuuid":"l3gatewayserviceuuid-value","niciradevicename":"niciradevicename-value","nvpdeviceid":"nvpdeviceid-value","physicalnetworkid":"physicalnetworkid-value","provider":"provider-value","transportzoneuuid":"transportzoneuuid-value"}]}`, &ListNiciraNvpDevicesResponse{})
+}
@@ -17,17 +17,54 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
+// PodServiceIface is the interface implemented by PodService, and by MockPodService for tests
+type PodServiceIface interface {
+	NewReleaseDedicatedPodParams(podid string) *ReleaseDedicatedPodParams
+	ReleaseDedicatedPod(p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodResponse, error)
+	ReleaseDedicatedPodWithContext(ctx context.Context, p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodResponse, error)
+	ReleaseDedicatedPodAsync(ctx context.Context, p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodJob, error)
+	NewListDedicatedPodsParams() *ListDedicatedPodsParams
+	ListDedicatedPods(p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error)
+	ListDedicatedPodsWithContext(ctx context.Context, p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error)
+	NewListDedicatedPodsIterator(p *ListDedicatedPodsParams, pagesize int) *ListDedicatedPodsIterator
+	NewCreatePodParams(gateway string, name string, netmask string, startip string, zoneid string) *CreatePodParams
+	CreatePod(p *CreatePodParams) (*CreatePodResponse, error)
+	CreatePodWithContext(ctx context.Context, p *CreatePodParams) (*CreatePodResponse, error)
+	NewDedicatePodParams(domainid string, podid string) *DedicatePodParams
+	DedicatePod(p *DedicatePodParams) (*DedicatePodResponse, error)
+	DedicatePodWithContext(ctx context.Context, p *DedicatePodParams) (*DedicatePodResponse, error)
+	DedicatePodAsync(ctx context.Context, p *DedicatePodParams) (*DedicatePodJob, error)
+	NewDeletePodParams(id string) *DeletePodParams
+	DeletePod(p *DeletePodParams) (*DeletePodResponse, error)
+	DeletePodWithContext(ctx context.Context, p *DeletePodParams) (*DeletePodResponse, error)
+	NewUpdatePodParams(id string) *UpdatePodParams
+	UpdatePod(p *UpdatePodParams) (*UpdatePodResponse, error)
+	UpdatePodWithContext(ctx context.Context, p *UpdatePodParams) (*UpdatePodResponse, error)
+	NewListPodsParams() *ListPodsParams
+	GetPodID(name string, opts ...OptionFunc) (string, int, error)
+	GetPodByName(name string, opts ...OptionFunc) (*Pod, int, error)
+	GetPodByID(id string, opts ...OptionFunc) (*Pod, int, error)
+	ListPods(p *ListPodsParams) (*ListPodsResponse, error)
+	ListPodsWithContext(ctx context.Context, p *ListPodsParams) (*ListPodsResponse, error)
+	NewListPodsIterator(p *ListPodsParams, pagesize int) *ListPodsIterator
+}
+
 type ReleaseDedicatedPodParams struct {
 	p map[string]interface{}
 }
 
+func (p *ReleaseDedicatedPodParams) command() string {
+	return "releaseDedicatedPod"
+}
+
 func (p *ReleaseDedicatedPodParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -57,7 +94,13 @@ func (s *PodService) NewReleaseDedicatedPodParams(podid string) *ReleaseDedicate
 
 // Release the dedication for the pod
 func (s *PodService) ReleaseDedicatedPod(p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedPod", p.toURLValues())
+	return s.ReleaseDedicatedPodWithContext(context.Background(), p)
+}
+
+// ReleaseDedicatedPodWithContext is the same as ReleaseDedicatedPod, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *PodService) ReleaseDedicatedPodWithContext(ctx context.Context, p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "releaseDedicatedPod", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -69,9 +112,9 @@ func (s *PodService) ReleaseDedicatedPod(p *ReleaseDedicatedPodParams) (*Release
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "releaseDedicatedPod", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -84,6 +127,41 @@ func (s *PodService) ReleaseDedicatedPod(p *ReleaseDedicatedPodParams) (*Release
 	return &r, nil
 }
 
+// ReleaseDedicatedPodJob is a handle to a running releaseDedicatedPod async job
+type ReleaseDedicatedPodJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a ReleaseDedicatedPodResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *ReleaseDedicatedPodJob) Result() (*ReleaseDedicatedPodResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := ReleaseDedicatedPodResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ReleaseDedicatedPodAsync starts the releaseDedicatedPod async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *PodService) ReleaseDedicatedPodAsync(ctx context.Context, p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodJob, error) {
+	resp, err := s.cs.newRequest(ctx, "releaseDedicatedPod", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ReleaseDedicatedPodResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &ReleaseDedicatedPodJob{s.cs.newJobHandle("releaseDedicatedPod", r.JobID)}, nil
+}
+
 type ReleaseDedicatedPodResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -94,6 +172,10 @@ type ListDedicatedPodsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListDedicatedPodsParams) command() string {
+	return "listDedicatedPods"
+}
+
 func (p *ListDedicatedPodsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -184,10 +266,17 @@ func (s *PodService) NewListDedicatedPodsParams() *ListDedicatedPodsParams {
 
 // Lists dedicated pods.
 func (s *PodService) ListDedicatedPods(p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error) {
+	return s.ListDedicatedPodsWithContext(context.Background(), p)
+}
+
+// ListDedicatedPodsWithContext is the same as ListDedicatedPods, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *PodService) ListDedicatedPodsWithContext(ctx context.Context, p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error) {
 	var r ListDedicatedPodsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListDedicatedPodsResponse
-		resp, err := s.cs.newRequest("listDedicatedPods", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listDedicatedPods", values)
 		if err != nil {
 			return nil, err
 		}
@@ -199,15 +288,91 @@ func (s *PodService) ListDedicatedPods(p *ListDedicatedPodsParams) (*ListDedicat
 		r.Count = l.Count
 		r.DedicatedPods = append(r.DedicatedPods, l.DedicatedPods...)
 
-		if r.Count == len(r.DedicatedPods) {
+		if r.Count == len(r.DedicatedPods) || len(l.DedicatedPods) == 0 {
+			s.cs.observePages("listDedicatedPods", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.DedicatedPods))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.DedicatedPods)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listDedicatedPods", values, len(l.DedicatedPods), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListDedicatedPodsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.DedicatedPods = append(r.DedicatedPods, l.DedicatedPods...)
+			}
+
+			if r.Count == len(r.DedicatedPods) {
+				s.cs.observePages("listDedicatedPods", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
 	}
 }
 
+// ListDedicatedPodsIterator iterates over the items returned by listDedicatedPods, requesting
+// the pages of the response one at a time while iterating.
+type ListDedicatedPodsIterator struct {
+	pager
+	items []*DedicatedPod
+	index int
+}
+
+// NewListDedicatedPodsIterator returns an iterator over the items returned by listDedicatedPods, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *PodService) NewListDedicatedPodsIterator(p *ListDedicatedPodsParams, pagesize int) *ListDedicatedPodsIterator {
+	return &ListDedicatedPodsIterator{pager: newPager(s.cs, "listDedicatedPods", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListDedicatedPodsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListDedicatedPodsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.DedicatedPods))
+
+	i.items = l.DedicatedPods
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListDedicatedPodsIterator) Value() *DedicatedPod {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
+}
+
 type ListDedicatedPodsResponse struct {
 	Count         int             `json:"count"`
 	DedicatedPods []*DedicatedPod `json:"dedicatedpod"`
@@ -228,6 +393,10 @@ type CreatePodParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreatePodParams) command() string {
+	return "createPod"
+}
+
 func (p *CreatePodParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -321,7 +490,13 @@ func (s *PodService) NewCreatePodParams(gateway string, name string, netmask str
 
 // Creates a new Pod.
 func (s *PodService) CreatePod(p *CreatePodParams) (*CreatePodResponse, error) {
-	resp, err := s.cs.newRequest("createPod", p.toURLValues())
+	return s.CreatePodWithContext(context.Background(), p)
+}
+
+// CreatePodWithContext is the same as CreatePod, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *PodService) CreatePodWithContext(ctx context.Context, p *CreatePodParams) (*CreatePodResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createPod", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -361,6 +536,10 @@ type DedicatePodParams struct {
 	p map[string]interface{}
 }
 
+func (p *DedicatePodParams) command() string {
+	return "dedicatePod"
+}
+
 func (p *DedicatePodParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -411,7 +590,13 @@ func (s *PodService) NewDedicatePodParams(domainid string, podid string) *Dedica
 
 // Dedicates a Pod.
 func (s *PodService) DedicatePod(p *DedicatePodParams) (*DedicatePodResponse, error) {
-	resp, err := s.cs.newRequest("dedicatePod", p.toURLValues())
+	return s.DedicatePodWithContext(context.Background(), p)
+}
+
+// DedicatePodWithContext is the same as DedicatePod, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *PodService) DedicatePodWithContext(ctx context.Context, p *DedicatePodParams) (*DedicatePodResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "dedicatePod", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -423,26 +608,56 @@ func (s *PodService) DedicatePod(p *DedicatePodParams) (*DedicatePodResponse, er
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "dedicatePod", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "pod", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// DedicatePodJob is a handle to a running dedicatePod async job
+type DedicatePodJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DedicatePodResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DedicatePodJob) Result() (*DedicatePodResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DedicatePodResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "pod", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// DedicatePodAsync starts the dedicatePod async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *PodService) DedicatePodAsync(ctx context.Context, p *DedicatePodParams) (*DedicatePodJob, error) {
+	resp, err := s.cs.newRequest(ctx, "dedicatePod", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DedicatePodResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DedicatePodJob{s.cs.newJobHandle("dedicatePod", r.JobID)}, nil
+}
+
 type DedicatePodResponse struct {
 	JobID           string `json:"jobid,omitempty"`
 	Accountid       string `json:"accountid,omitempty"`
@@ -459,6 +674,10 @@ type DeletePodParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeletePodParams) command() string {
+	return "deletePod"
+}
+
 func (p *DeletePodParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -488,7 +707,13 @@ func (s *PodService) NewDeletePodParams(id string) *DeletePodParams {
 
 // Deletes a Pod.
 func (s *PodService) DeletePod(p *DeletePodParams) (*DeletePodResponse, error) {
-	resp, err := s.cs.newRequest("deletePod", p.toURLValues())
+	return s.DeletePodWithContext(context.Background(), p)
+}
+
+// DeletePodWithContext is the same as DeletePod, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *PodService) DeletePodWithContext(ctx context.Context, p *DeletePodParams) (*DeletePodResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deletePod", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -509,6 +734,10 @@ type UpdatePodParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdatePodParams) command() string {
+	return "updatePod"
+}
+
 func (p *UpdatePodParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -598,7 +827,13 @@ func (s *PodService) NewUpdatePodParams(id string) *UpdatePodParams {
 
 // Updates a Pod.
 func (s *PodService) UpdatePod(p *UpdatePodParams) (*UpdatePodResponse, error) {
-	resp, err := s.cs.newRequest("updatePod", p.toURLValues())
+	return s.UpdatePodWithContext(context.Background(), p)
+}
+
+// UpdatePodWithContext is the same as UpdatePod, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *PodService) UpdatePodWithContext(ctx context.Context, p *UpdatePodParams) (*UpdatePodResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updatePod", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -638,6 +873,10 @@ type ListPodsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListPodsParams) command() string {
+	return "listPods"
+}
+
 func (p *ListPodsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -756,7 +995,7 @@ func (s *PodService) GetPodID(name string, opts ...OptionFunc) (string, int, err
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -770,7 +1009,7 @@ func (s *PodService) GetPodID(name string, opts ...OptionFunc) (string, int, err
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -802,16 +1041,14 @@ func (s *PodService) GetPodByID(id string, opts ...OptionFunc) (*Pod, int, error
 
 	l, err := s.ListPods(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -823,10 +1060,17 @@ func (s *PodService) GetPodByID(id string, opts ...OptionFunc) (*Pod, int, error
 
 // Lists all Pods.
 func (s *PodService) ListPods(p *ListPodsParams) (*ListPodsResponse, error) {
+	return s.ListPodsWithContext(context.Background(), p)
+}
+
+// ListPodsWithContext is the same as ListPods, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *PodService) ListPodsWithContext(ctx context.Context, p *ListPodsParams) (*ListPodsResponse, error) {
 	var r ListPodsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListPodsResponse
-		resp, err := s.cs.newRequest("listPods", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listPods", values)
 		if err != nil {
 			return nil, err
 		}
@@ -838,13 +1082,89 @@ func (s *PodService) ListPods(p *ListPodsParams) (*ListPodsResponse, error) {
 		r.Count = l.Count
 		r.Pods = append(r.Pods, l.Pods...)
 
-		if r.Count == len(r.Pods) {
+		if r.Count == len(r.Pods) || len(l.Pods) == 0 {
+			s.cs.observePages("listPods", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.Pods))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.Pods)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listPods", values, len(l.Pods), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListPodsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.Pods = append(r.Pods, l.Pods...)
+			}
+
+			if r.Count == len(r.Pods) {
+				s.cs.observePages("listPods", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListPodsIterator iterates over the items returned by listPods, requesting
+// the pages of the response one at a time while iterating.
+type ListPodsIterator struct {
+	pager
+	items []*Pod
+	index int
+}
+
+// NewListPodsIterator returns an iterator over the items returned by listPods, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *PodService) NewListPodsIterator(p *ListPodsParams, pagesize int) *ListPodsIterator {
+	return &ListPodsIterator{pager: newPager(s.cs, "listPods", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListPodsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListPodsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.Pods))
+
+	i.items = l.Pods
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListPodsIterator) Value() *Pod {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListPodsResponse struct {
@@ -0,0 +1,221 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockPodService is a mock of PodServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockPodService struct {
+	mockCalls
+
+	ReleaseDedicatedPodFunc      func(ctx context.Context, p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodResponse, error)
+	ReleaseDedicatedPodAsyncFunc func(ctx context.Context, p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodJob, error)
+	ListDedicatedPodsFunc        func(ctx context.Context, p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error)
+	CreatePodFunc                func(ctx context.Context, p *CreatePodParams) (*CreatePodResponse, error)
+	DedicatePodFunc              func(ctx context.Context, p *DedicatePodParams) (*DedicatePodResponse, error)
+	DedicatePodAsyncFunc         func(ctx context.Context, p *DedicatePodParams) (*DedicatePodJob, error)
+	DeletePodFunc                func(ctx context.Context, p *DeletePodParams) (*DeletePodResponse, error)
+	UpdatePodFunc                func(ctx context.Context, p *UpdatePodParams) (*UpdatePodResponse, error)
+	GetPodIDFunc                 func(name string, opts ...OptionFunc) (string, int, error)
+	GetPodByNameFunc             func(name string, opts ...OptionFunc) (*Pod, int, error)
+	GetPodByIDFunc               func(id string, opts ...OptionFunc) (*Pod, int, error)
+	ListPodsFunc                 func(ctx context.Context, p *ListPodsParams) (*ListPodsResponse, error)
+}
+
+var _ PodServiceIface = &MockPodService{}
+
+func (m *MockPodService) NewReleaseDedicatedPodParams(podid string) *ReleaseDedicatedPodParams {
+	return (&PodService{}).NewReleaseDedicatedPodParams(podid)
+}
+
+func (m *MockPodService) ReleaseDedicatedPod(p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodResponse, error) {
+	return m.ReleaseDedicatedPodWithContext(context.Background(), p)
+}
+
+func (m *MockPodService) ReleaseDedicatedPodWithContext(ctx context.Context, p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodResponse, error) {
+	m.record("ReleaseDedicatedPod", p)
+	if m.ReleaseDedicatedPodFunc == nil {
+		return nil, notMocked("MockPodService", "ReleaseDedicatedPod")
+	}
+	return m.ReleaseDedicatedPodFunc(ctx, p)
+}
+
+func (m *MockPodService) ReleaseDedicatedPodAsync(ctx context.Context, p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodJob, error) {
+	m.record("ReleaseDedicatedPodAsync", p)
+	if m.ReleaseDedicatedPodAsyncFunc == nil {
+		return nil, notMocked("MockPodService", "ReleaseDedicatedPodAsync")
+	}
+	return m.ReleaseDedicatedPodAsyncFunc(ctx, p)
+}
+
+func (m *MockPodService) NewListDedicatedPodsParams() *ListDedicatedPodsParams {
+	return (&PodService{}).NewListDedicatedPodsParams()
+}
+
+func (m *MockPodService) ListDedicatedPods(p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error) {
+	return m.ListDedicatedPodsWithContext(context.Background(), p)
+}
+
+func (m *MockPodService) ListDedicatedPodsWithContext(ctx context.Context, p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error) {
+	m.record("ListDedicatedPods", p)
+	if m.ListDedicatedPodsFunc == nil {
+		return nil, notMocked("MockPodService", "ListDedicatedPods")
+	}
+	return m.ListDedicatedPodsFunc(ctx, p)
+}
+
+func (m *MockPodService) NewListDedicatedPodsIterator(p *ListDedicatedPodsParams, pagesize int) *ListDedicatedPodsIterator {
+	i := &ListDedicatedPodsIterator{pager: newPager(nil, "listDedicatedPods", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListDedicatedPodsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListDedicatedPodsWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockPodService) NewCreatePodParams(gateway string, name string, netmask string, startip string, zoneid string) *CreatePodParams {
+	return (&PodService{}).NewCreatePodParams(gateway, name, netmask, startip, zoneid)
+}
+
+func (m *MockPodService) CreatePod(p *CreatePodParams) (*CreatePodResponse, error) {
+	return m.CreatePodWithContext(context.Background(), p)
+}
+
+func (m *MockPodService) CreatePodWithContext(ctx context.Context, p *CreatePodParams) (*CreatePodResponse, error) {
+	m.record("CreatePod", p)
+	if m.CreatePodFunc == nil {
+		return nil, notMocked("MockPodService", "CreatePod")
+	}
+	return m.CreatePodFunc(ctx, p)
+}
+
+func (m *MockPodService) NewDedicatePodParams(domainid string, podid string) *DedicatePodParams {
+	return (&PodService{}).NewDedicatePodParams(domainid, podid)
+}
+
+func (m *MockPodService) DedicatePod(p *DedicatePodParams) (*DedicatePodResponse, error) {
+	return m.DedicatePodWithContext(context.Background(), p)
+}
+
+func (m *MockPodService) DedicatePodWithContext(ctx context.Context, p *DedicatePodParams) (*DedicatePodResponse, error) {
+	m.record("DedicatePod", p)
+	if m.DedicatePodFunc == nil {
+		return nil, notMocked("MockPodService", "DedicatePod")
+	}
+	return m.DedicatePodFunc(ctx, p)
+}
+
+func (m *MockPodService) DedicatePodAsync(ctx context.Context, p *DedicatePodParams) (*DedicatePodJob, error) {
+	m.record("DedicatePodAsync", p)
+	if m.DedicatePodAsyncFunc == nil {
+		return nil, notMocked("MockPodService", "DedicatePodAsync")
+	}
+	return m.DedicatePodAsyncFunc(ctx, p)
+}
+
+func (m *MockPodService) NewDeletePodParams(id string) *DeletePodParams {
+	return (&PodService{}).NewDeletePodParams(id)
+}
+
+func (m *MockPodService) DeletePod(p *DeletePodParams) (*DeletePodResponse, error) {
+	return m.DeletePodWithContext(context.Background(), p)
+}
+
+func (m *MockPodService) DeletePodWithContext(ctx context.Context, p *DeletePodParams) (*DeletePodResponse, error) {
+	m.record("DeletePod", p)
+	if m.DeletePodFunc == nil {
+		return nil, notMocked("MockPodService", "DeletePod")
+	}
+	return m.DeletePodFunc(ctx, p)
+}
+
+func (m *MockPodService) NewUpdatePodParams(id string) *UpdatePodParams {
+	return (&PodService{}).NewUpdatePodParams(id)
+}
+
+func (m *MockPodService) UpdatePod(p *UpdatePodParams) (*UpdatePodResponse, error) {
+	return m.UpdatePodWithContext(context.Background(), p)
+}
+
+func (m *MockPodService) UpdatePodWithContext(ctx context.Context, p *UpdatePodParams) (*UpdatePodResponse, error) {
+	m.record("UpdatePod", p)
+	if m.UpdatePodFunc == nil {
+		return nil, notMocked("MockPodService", "UpdatePod")
+	}
+	return m.UpdatePodFunc(ctx, p)
+}
+
+func (m *MockPodService) NewListPodsParams() *ListPodsParams {
+	return (&PodService{}).NewListPodsParams()
+}
+
+func (m *MockPodService) GetPodID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetPodID", name, opts)
+	if m.GetPodIDFunc == nil {
+		return "", -1, notMocked("MockPodService", "GetPodID")
+	}
+	return m.GetPodIDFunc(name, opts...)
+}
+
+func (m *MockPodService) GetPodByName(name string, opts ...OptionFunc) (*Pod, int, error) {
+	m.record("GetPodByName", name, opts)
+	if m.GetPodByNameFunc == nil {
+		return nil, -1, notMocked("MockPodService", "GetPodByName")
+	}
+	return m.GetPodByNameFunc(name, opts...)
+}
+
+func (m *MockPodService) GetPodByID(id string, opts ...OptionFunc) (*Pod, int, error) {
+	m.record("GetPodByID", id, opts)
+	if m.GetPodByIDFunc == nil {
+		return nil, -1, notMocked("MockPodService", "GetPodByID")
+	}
+	return m.GetPodByIDFunc(id, opts...)
+}
+
+func (m *MockPodService) ListPods(p *ListPodsParams) (*ListPodsResponse, error) {
+	return m.ListPodsWithContext(context.Background(), p)
+}
+
+func (m *MockPodService) ListPodsWithContext(ctx context.Context, p *ListPodsParams) (*ListPodsResponse, error) {
+	m.record("ListPods", p)
+	if m.ListPodsFunc == nil {
+		return nil, notMocked("MockPodService", "ListPods")
+	}
+	return m.ListPodsFunc(ctx, p)
+}
+
+func (m *MockPodService) NewListPodsIterator(p *ListPodsParams, pagesize int) *ListPodsIterator {
+	i := &ListPodsIterator{pager: newPager(nil, "listPods", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListPodsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListPodsWithContext(ctx, c)
+	}
+	return i
+}
@@ -0,0 +1,167 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestReleaseDedicatedPodParams(t *testing.T) {
+	p := &ReleaseDedicatedPodParams{}
+	want := url.Values{}
+	p.SetPodid("podid-value")
+	want.Set("podid", "podid-value")
+
+	assertParams(t, p, "releaseDedicatedPod", want)
+}
+
+func TestReleaseDedicatedPodResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &ReleaseDedicatedPodResponse{})
+}
+
+func TestListDedicatedPodsParams(t *testing.T) {
+	p := &ListDedicatedPodsParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetAffinitygroupid("affinitygroupid-value")
+	want.Set("affinitygroupid", "affinitygroupid-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetPodid("podid-value")
+	want.Set("podid", "podid-value")
+
+	assertParams(t, p, "listDedicatedPods", want)
+}
+
+func TestListDedicatedPodsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"dedicatedpod":[{"accountid":"accountid-value","accountname":"accountname-value","affinitygroupid":"affinitygroupid-value","domainid":"domainid-value","domainname":"domainname-value","id":"id-value","podid":"podid-value","podname":"podname-value"}]}`, &ListDedicatedPodsResponse{})
+}
+
+func TestCreatePodParams(t *testing.T) {
+	p := &CreatePodParams{}
+	want := url.Values{}
+	p.SetAllocationstate("allocationstate-value")
+	want.Set("allocationstate", "allocationstate-value")
+	p.SetEndip("endip-value")
+	want.Set("endip", "endip-value")
+	p.SetGateway("gateway-value")
+	want.Set("gateway", "gateway-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetNetmask("netmask-value")
+	want.Set("netmask", "netmask-value")
+	p.SetStartip("startip-value")
+	want.Set("startip", "startip-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "createPod", want)
+}
+
+func TestCreatePodResponse(t *testing.T) {
+	assertDecodes(t, `{"allocationstate":"allocationstate-value","capacity":[{"capacitytotal":1099511627776,"capacityused":1099511627776,"clusterid":"clusterid-value","clustername":"clustername-value","percentageallocated":"percentageallocated-value","podid":"podid-value","podname":"podname-value","type":42,"zoneid":"zoneid-value","zonename":"zonename-value"}],"endip":"endip-value","gateway":"gateway-value","id":"id-value","name":"name-value","netmask":"netmask-value","startip":"startip-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &CreatePodResponse{})
+}
+
+func TestDedicatePodParams(t *testing.T) {
+	p := &DedicatePodParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetPodid("podid-value")
+	want.Set("podid", "podid-value")
+
+	assertParams(t, p, "dedicatePod", want)
+}
+
+func TestDedicatePodResponse(t *testing.T) {
+	assertDecodes(t, `{"accountid":"accountid-value","accountname":"accountname-value","affinitygroupid":"affinitygroupid-value","domainid":"domainid-value","domainname":"domainname-value","id":"id-value","jobid":"jobid-value","podid":"podid-value","podname":"podname-value"}`, &DedicatePodResponse{})
+}
+
+func TestDeletePodParams(t *testing.T) {
+	p := &DeletePodParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deletePod", want)
+}
+
+func TestDeletePodResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &DeletePodResponse{})
+}
+
+func TestUpdatePodParams(t *testing.T) {
+	p := &UpdatePodParams{}
+	want := url.Values{}
+	p.SetAllocationstate("allocationstate-value")
+	want.Set("allocationstate", "allocationstate-value")
+	p.SetEndip("endip-value")
+	want.Set("endip", "endip-value")
+	p.SetGateway("gateway-value")
+	want.Set("gateway", "gateway-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetNetmask("netmask-value")
+	want.Set("netmask", "netmask-value")
+	p.SetStartip("startip-value")
+	want.Set("startip", "startip-value")
+
+	assertParams(t, p, "updatePod", want)
+}
+
+func TestUpdatePodResponse(t *testing.T) {
+	assertDecodes(t, `{"allocationstate":"allocationstate-value","capacity":[{"capacitytotal":1099511627776,"capacityused":1099511627776,"clusterid":"clusterid-value","clustername":"clustername-value","percentageallocated":"percentageallocated-value","podid":"podid-value","podname":"podname-value","type":42,"zoneid":"zoneid-value","zonename":"zonename-value"}],"endip":"endip-value","gateway":"gateway-value","id":"id-value","name":"name-value","netmask":"netmask-value","startip":"startip-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &UpdatePodResponse{})
+}
+
+func TestListPodsParams(t *testing.T) {
+	p := &ListPodsParams{}
+	want := url.Values{}
+	p.SetAllocationstate("allocationstate-value")
+	want.Set("allocationstate", "allocationstate-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetShowcapacities(true)
+	want.Set("showcapacities", "true")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listPods", want)
+}
+
+func TestListPodsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"pod":[{"allocationstate":"allocationstate-value","capacity":[{"capacitytotal":1099511627776,"capacityused":1099511627776,"clusterid":"clusterid-value","clustername":"clustername-value","percentageallocated":"percentageallocated-value","podid":"podid-value","podname":"podname-value","type":42,"zoneid":"zoneid-value","zonename":"zonename-value"}],"endip":"endip-value","gateway":"gateway-value","id":"id-value","name":"name-value","netmask":"netmask-value","startip":"startip-value","zoneid":"zoneid-value","zonename":"zonename-value"}]}`, &ListPodsResponse{})
+}
@@ -17,17 +17,66 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
+// ProjectServiceIface is the interface implemented by ProjectService, and by MockProjectService for tests
+type ProjectServiceIface interface {
+	NewActivateProjectParams(id string) *ActivateProjectParams
+	ActivateProject(p *ActivateProjectParams) (*ActivateProjectResponse, error)
+	ActivateProjectWithContext(ctx context.Context, p *ActivateProjectParams) (*ActivateProjectResponse, error)
+	ActivateProjectAsync(ctx context.Context, p *ActivateProjectParams) (*ActivateProjectJob, error)
+	NewCreateProjectParams(displaytext string, name string) *CreateProjectParams
+	CreateProject(p *CreateProjectParams) (*CreateProjectResponse, error)
+	CreateProjectWithContext(ctx context.Context, p *CreateProjectParams) (*CreateProjectResponse, error)
+	CreateProjectAsync(ctx context.Context, p *CreateProjectParams) (*CreateProjectJob, error)
+	NewDeleteProjectParams(id string) *DeleteProjectParams
+	DeleteProject(p *DeleteProjectParams) (*DeleteProjectResponse, error)
+	DeleteProjectWithContext(ctx context.Context, p *DeleteProjectParams) (*DeleteProjectResponse, error)
+	DeleteProjectAsync(ctx context.Context, p *DeleteProjectParams) (*DeleteProjectJob, error)
+	NewSuspendProjectParams(id string) *SuspendProjectParams
+	SuspendProject(p *SuspendProjectParams) (*SuspendProjectResponse, error)
+	SuspendProjectWithContext(ctx context.Context, p *SuspendProjectParams) (*SuspendProjectResponse, error)
+	SuspendProjectAsync(ctx context.Context, p *SuspendProjectParams) (*SuspendProjectJob, error)
+	NewUpdateProjectParams(id string) *UpdateProjectParams
+	UpdateProject(p *UpdateProjectParams) (*UpdateProjectResponse, error)
+	UpdateProjectWithContext(ctx context.Context, p *UpdateProjectParams) (*UpdateProjectResponse, error)
+	UpdateProjectAsync(ctx context.Context, p *UpdateProjectParams) (*UpdateProjectJob, error)
+	NewDeleteProjectInvitationParams(id string) *DeleteProjectInvitationParams
+	DeleteProjectInvitation(p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error)
+	DeleteProjectInvitationWithContext(ctx context.Context, p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error)
+	DeleteProjectInvitationAsync(ctx context.Context, p *DeleteProjectInvitationParams) (*DeleteProjectInvitationJob, error)
+	NewUpdateProjectInvitationParams(projectid string) *UpdateProjectInvitationParams
+	UpdateProjectInvitation(p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error)
+	UpdateProjectInvitationWithContext(ctx context.Context, p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error)
+	UpdateProjectInvitationAsync(ctx context.Context, p *UpdateProjectInvitationParams) (*UpdateProjectInvitationJob, error)
+	NewListProjectInvitationsParams() *ListProjectInvitationsParams
+	GetProjectInvitationByID(id string, opts ...OptionFunc) (*ProjectInvitation, int, error)
+	ListProjectInvitations(p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error)
+	ListProjectInvitationsWithContext(ctx context.Context, p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error)
+	NewListProjectInvitationsIterator(p *ListProjectInvitationsParams, pagesize int) *ListProjectInvitationsIterator
+	NewListProjectsParams() *ListProjectsParams
+	GetProjectID(name string, opts ...OptionFunc) (string, int, error)
+	GetProjectByName(name string, opts ...OptionFunc) (*Project, int, error)
+	GetProjectByID(id string, opts ...OptionFunc) (*Project, int, error)
+	ListProjects(p *ListProjectsParams) (*ListProjectsResponse, error)
+	ListProjectsWithContext(ctx context.Context, p *ListProjectsParams) (*ListProjectsResponse, error)
+	NewListProjectsIterator(p *ListProjectsParams, pagesize int) *ListProjectsIterator
+}
+
 type ActivateProjectParams struct {
 	p map[string]interface{}
 }
 
+func (p *ActivateProjectParams) command() string {
+	return "activateProject"
+}
+
 func (p *ActivateProjectParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -57,7 +106,13 @@ func (s *ProjectService) NewActivateProjectParams(id string) *ActivateProjectPar
 
 // Activates a project
 func (s *ProjectService) ActivateProject(p *ActivateProjectParams) (*ActivateProjectResponse, error) {
-	resp, err := s.cs.newRequest("activateProject", p.toURLValues())
+	return s.ActivateProjectWithContext(context.Background(), p)
+}
+
+// ActivateProjectWithContext is the same as ActivateProject, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ProjectService) ActivateProjectWithContext(ctx context.Context, p *ActivateProjectParams) (*ActivateProjectResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "activateProject", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -69,26 +124,56 @@ func (s *ProjectService) ActivateProject(p *ActivateProjectParams) (*ActivatePro
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "activateProject", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "project", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// ActivateProjectJob is a handle to a running activateProject async job
+type ActivateProjectJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a ActivateProjectResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *ActivateProjectJob) Result() (*ActivateProjectResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := ActivateProjectResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "project", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// ActivateProjectAsync starts the activateProject async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *ProjectService) ActivateProjectAsync(ctx context.Context, p *ActivateProjectParams) (*ActivateProjectJob, error) {
+	resp, err := s.cs.newRequest(ctx, "activateProject", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ActivateProjectResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &ActivateProjectJob{s.cs.newJobHandle("activateProject", r.JobID)}, nil
+}
+
 type ActivateProjectResponse struct {
 	JobID                     string `json:"jobid,omitempty"`
 	Account                   string `json:"account,omitempty"`
@@ -151,6 +236,10 @@ type CreateProjectParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateProjectParams) command() string {
+	return "createProject"
+}
+
 func (p *CreateProjectParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -211,7 +300,13 @@ func (s *ProjectService) NewCreateProjectParams(displaytext string, name string)
 
 // Creates a project
 func (s *ProjectService) CreateProject(p *CreateProjectParams) (*CreateProjectResponse, error) {
-	resp, err := s.cs.newRequest("createProject", p.toURLValues())
+	return s.CreateProjectWithContext(context.Background(), p)
+}
+
+// CreateProjectWithContext is the same as CreateProject, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ProjectService) CreateProjectWithContext(ctx context.Context, p *CreateProjectParams) (*CreateProjectResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createProject", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -223,26 +318,56 @@ func (s *ProjectService) CreateProject(p *CreateProjectParams) (*CreateProjectRe
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createProject", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "project", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CreateProjectJob is a handle to a running createProject async job
+type CreateProjectJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateProjectResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateProjectJob) Result() (*CreateProjectResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateProjectResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "project", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CreateProjectAsync starts the createProject async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *ProjectService) CreateProjectAsync(ctx context.Context, p *CreateProjectParams) (*CreateProjectJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createProject", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateProjectResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateProjectJob{s.cs.newJobHandle("createProject", r.JobID)}, nil
+}
+
 type CreateProjectResponse struct {
 	JobID                     string `json:"jobid,omitempty"`
 	Account                   string `json:"account,omitempty"`
@@ -305,6 +430,10 @@ type DeleteProjectParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteProjectParams) command() string {
+	return "deleteProject"
+}
+
 func (p *DeleteProjectParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -334,7 +463,13 @@ func (s *ProjectService) NewDeleteProjectParams(id string) *DeleteProjectParams
 
 // Deletes a project
 func (s *ProjectService) DeleteProject(p *DeleteProjectParams) (*DeleteProjectResponse, error) {
-	resp, err := s.cs.newRequest("deleteProject", p.toURLValues())
+	return s.DeleteProjectWithContext(context.Background(), p)
+}
+
+// DeleteProjectWithContext is the same as DeleteProject, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ProjectService) DeleteProjectWithContext(ctx context.Context, p *DeleteProjectParams) (*DeleteProjectResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteProject", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -346,9 +481,9 @@ func (s *ProjectService) DeleteProject(p *DeleteProjectParams) (*DeleteProjectRe
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteProject", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -361,6 +496,41 @@ func (s *ProjectService) DeleteProject(p *DeleteProjectParams) (*DeleteProjectRe
 	return &r, nil
 }
 
+// DeleteProjectJob is a handle to a running deleteProject async job
+type DeleteProjectJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteProjectResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteProjectJob) Result() (*DeleteProjectResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteProjectResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteProjectAsync starts the deleteProject async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *ProjectService) DeleteProjectAsync(ctx context.Context, p *DeleteProjectParams) (*DeleteProjectJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteProject", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteProjectResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteProjectJob{s.cs.newJobHandle("deleteProject", r.JobID)}, nil
+}
+
 type DeleteProjectResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -371,6 +541,10 @@ type SuspendProjectParams struct {
 	p map[string]interface{}
 }
 
+func (p *SuspendProjectParams) command() string {
+	return "suspendProject"
+}
+
 func (p *SuspendProjectParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -400,7 +574,13 @@ func (s *ProjectService) NewSuspendProjectParams(id string) *SuspendProjectParam
 
 // Suspends a project
 func (s *ProjectService) SuspendProject(p *SuspendProjectParams) (*SuspendProjectResponse, error) {
-	resp, err := s.cs.newRequest("suspendProject", p.toURLValues())
+	return s.SuspendProjectWithContext(context.Background(), p)
+}
+
+// SuspendProjectWithContext is the same as SuspendProject, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ProjectService) SuspendProjectWithContext(ctx context.Context, p *SuspendProjectParams) (*SuspendProjectResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "suspendProject", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -412,26 +592,56 @@ func (s *ProjectService) SuspendProject(p *SuspendProjectParams) (*SuspendProjec
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "suspendProject", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "project", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// SuspendProjectJob is a handle to a running suspendProject async job
+type SuspendProjectJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a SuspendProjectResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *SuspendProjectJob) Result() (*SuspendProjectResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := SuspendProjectResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "project", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// SuspendProjectAsync starts the suspendProject async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *ProjectService) SuspendProjectAsync(ctx context.Context, p *SuspendProjectParams) (*SuspendProjectJob, error) {
+	resp, err := s.cs.newRequest(ctx, "suspendProject", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r SuspendProjectResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &SuspendProjectJob{s.cs.newJobHandle("suspendProject", r.JobID)}, nil
+}
+
 type SuspendProjectResponse struct {
 	JobID                     string `json:"jobid,omitempty"`
 	Account                   string `json:"account,omitempty"`
@@ -494,6 +704,10 @@ type UpdateProjectParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateProjectParams) command() string {
+	return "updateProject"
+}
+
 func (p *UpdateProjectParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -543,7 +757,13 @@ func (s *ProjectService) NewUpdateProjectParams(id string) *UpdateProjectParams
 
 // Updates a project
 func (s *ProjectService) UpdateProject(p *UpdateProjectParams) (*UpdateProjectResponse, error) {
-	resp, err := s.cs.newRequest("updateProject", p.toURLValues())
+	return s.UpdateProjectWithContext(context.Background(), p)
+}
+
+// UpdateProjectWithContext is the same as UpdateProject, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ProjectService) UpdateProjectWithContext(ctx context.Context, p *UpdateProjectParams) (*UpdateProjectResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateProject", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -555,26 +775,56 @@ func (s *ProjectService) UpdateProject(p *UpdateProjectParams) (*UpdateProjectRe
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateProject", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "project", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// UpdateProjectJob is a handle to a running updateProject async job
+type UpdateProjectJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateProjectResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateProjectJob) Result() (*UpdateProjectResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateProjectResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "project", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// UpdateProjectAsync starts the updateProject async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *ProjectService) UpdateProjectAsync(ctx context.Context, p *UpdateProjectParams) (*UpdateProjectJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateProject", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateProjectResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateProjectJob{s.cs.newJobHandle("updateProject", r.JobID)}, nil
+}
+
 type UpdateProjectResponse struct {
 	JobID                     string `json:"jobid,omitempty"`
 	Account                   string `json:"account,omitempty"`
@@ -637,6 +887,10 @@ type DeleteProjectInvitationParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteProjectInvitationParams) command() string {
+	return "deleteProjectInvitation"
+}
+
 func (p *DeleteProjectInvitationParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -666,7 +920,13 @@ func (s *ProjectService) NewDeleteProjectInvitationParams(id string) *DeleteProj
 
 // Deletes project invitation
 func (s *ProjectService) DeleteProjectInvitation(p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error) {
-	resp, err := s.cs.newRequest("deleteProjectInvitation", p.toURLValues())
+	return s.DeleteProjectInvitationWithContext(context.Background(), p)
+}
+
+// DeleteProjectInvitationWithContext is the same as DeleteProjectInvitation, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ProjectService) DeleteProjectInvitationWithContext(ctx context.Context, p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteProjectInvitation", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -678,9 +938,9 @@ func (s *ProjectService) DeleteProjectInvitation(p *DeleteProjectInvitationParam
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteProjectInvitation", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -693,6 +953,41 @@ func (s *ProjectService) DeleteProjectInvitation(p *DeleteProjectInvitationParam
 	return &r, nil
 }
 
+// DeleteProjectInvitationJob is a handle to a running deleteProjectInvitation async job
+type DeleteProjectInvitationJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteProjectInvitationResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteProjectInvitationJob) Result() (*DeleteProjectInvitationResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteProjectInvitationResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteProjectInvitationAsync starts the deleteProjectInvitation async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *ProjectService) DeleteProjectInvitationAsync(ctx context.Context, p *DeleteProjectInvitationParams) (*DeleteProjectInvitationJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteProjectInvitation", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteProjectInvitationResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteProjectInvitationJob{s.cs.newJobHandle("deleteProjectInvitation", r.JobID)}, nil
+}
+
 type DeleteProjectInvitationResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -703,6 +998,10 @@ type UpdateProjectInvitationParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateProjectInvitationParams) command() string {
+	return "updateProjectInvitation"
+}
+
 func (p *UpdateProjectInvitationParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -763,7 +1062,13 @@ func (s *ProjectService) NewUpdateProjectInvitationParams(projectid string) *Upd
 
 // Accepts or declines project invitation
 func (s *ProjectService) UpdateProjectInvitation(p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error) {
-	resp, err := s.cs.newRequest("updateProjectInvitation", p.toURLValues())
+	return s.UpdateProjectInvitationWithContext(context.Background(), p)
+}
+
+// UpdateProjectInvitationWithContext is the same as UpdateProjectInvitation, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ProjectService) UpdateProjectInvitationWithContext(ctx context.Context, p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateProjectInvitation", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -775,9 +1080,9 @@ func (s *ProjectService) UpdateProjectInvitation(p *UpdateProjectInvitationParam
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateProjectInvitation", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -790,6 +1095,41 @@ func (s *ProjectService) UpdateProjectInvitation(p *UpdateProjectInvitationParam
 	return &r, nil
 }
 
+// UpdateProjectInvitationJob is a handle to a running updateProjectInvitation async job
+type UpdateProjectInvitationJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateProjectInvitationResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateProjectInvitationJob) Result() (*UpdateProjectInvitationResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateProjectInvitationResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// UpdateProjectInvitationAsync starts the updateProjectInvitation async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *ProjectService) UpdateProjectInvitationAsync(ctx context.Context, p *UpdateProjectInvitationParams) (*UpdateProjectInvitationJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateProjectInvitation", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateProjectInvitationResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateProjectInvitationJob{s.cs.newJobHandle("updateProjectInvitation", r.JobID)}, nil
+}
+
 type UpdateProjectInvitationResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -800,6 +1140,10 @@ type ListProjectInvitationsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListProjectInvitationsParams) command() string {
+	return "listProjectInvitations"
+}
+
 func (p *ListProjectInvitationsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -946,16 +1290,14 @@ func (s *ProjectService) GetProjectInvitationByID(id string, opts ...OptionFunc)
 
 	l, err := s.ListProjectInvitations(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -967,10 +1309,17 @@ func (s *ProjectService) GetProjectInvitationByID(id string, opts ...OptionFunc)
 
 // Lists project invitations and provides detailed information for listed invitations
 func (s *ProjectService) ListProjectInvitations(p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error) {
+	return s.ListProjectInvitationsWithContext(context.Background(), p)
+}
+
+// ListProjectInvitationsWithContext is the same as ListProjectInvitations, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ProjectService) ListProjectInvitationsWithContext(ctx context.Context, p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error) {
 	var r ListProjectInvitationsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListProjectInvitationsResponse
-		resp, err := s.cs.newRequest("listProjectInvitations", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listProjectInvitations", values)
 		if err != nil {
 			return nil, err
 		}
@@ -982,15 +1331,91 @@ func (s *ProjectService) ListProjectInvitations(p *ListProjectInvitationsParams)
 		r.Count = l.Count
 		r.ProjectInvitations = append(r.ProjectInvitations, l.ProjectInvitations...)
 
-		if r.Count == len(r.ProjectInvitations) {
+		if r.Count == len(r.ProjectInvitations) || len(l.ProjectInvitations) == 0 {
+			s.cs.observePages("listProjectInvitations", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.ProjectInvitations))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.ProjectInvitations)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listProjectInvitations", values, len(l.ProjectInvitations), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListProjectInvitationsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.ProjectInvitations = append(r.ProjectInvitations, l.ProjectInvitations...)
+			}
+
+			if r.Count == len(r.ProjectInvitations) {
+				s.cs.observePages("listProjectInvitations", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
 	}
 }
 
+// ListProjectInvitationsIterator iterates over the items returned by listProjectInvitations, requesting
+// the pages of the response one at a time while iterating.
+type ListProjectInvitationsIterator struct {
+	pager
+	items []*ProjectInvitation
+	index int
+}
+
+// NewListProjectInvitationsIterator returns an iterator over the items returned by listProjectInvitations, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *ProjectService) NewListProjectInvitationsIterator(p *ListProjectInvitationsParams, pagesize int) *ListProjectInvitationsIterator {
+	return &ListProjectInvitationsIterator{pager: newPager(s.cs, "listProjectInvitations", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListProjectInvitationsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListProjectInvitationsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.ProjectInvitations))
+
+	i.items = l.ProjectInvitations
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListProjectInvitationsIterator) Value() *ProjectInvitation {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
+}
+
 type ListProjectInvitationsResponse struct {
 	Count              int                  `json:"count"`
 	ProjectInvitations []*ProjectInvitation `json:"projectinvitation"`
@@ -1011,6 +1436,10 @@ type ListProjectsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListProjectsParams) command() string {
+	return "listProjects"
+}
+
 func (p *ListProjectsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1175,7 +1604,7 @@ func (s *ProjectService) GetProjectID(name string, opts ...OptionFunc) (string,
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -1189,7 +1618,7 @@ func (s *ProjectService) GetProjectID(name string, opts ...OptionFunc) (string,
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -1221,16 +1650,14 @@ func (s *ProjectService) GetProjectByID(id string, opts ...OptionFunc) (*Project
 
 	l, err := s.ListProjects(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -1242,10 +1669,17 @@ func (s *ProjectService) GetProjectByID(id string, opts ...OptionFunc) (*Project
 
 // Lists projects and provides detailed information for listed projects
 func (s *ProjectService) ListProjects(p *ListProjectsParams) (*ListProjectsResponse, error) {
+	return s.ListProjectsWithContext(context.Background(), p)
+}
+
+// ListProjectsWithContext is the same as ListProjects, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ProjectService) ListProjectsWithContext(ctx context.Context, p *ListProjectsParams) (*ListProjectsResponse, error) {
 	var r ListProjectsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListProjectsResponse
-		resp, err := s.cs.newRequest("listProjects", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listProjects", values)
 		if err != nil {
 			return nil, err
 		}
@@ -1257,13 +1691,89 @@ func (s *ProjectService) ListProjects(p *ListProjectsParams) (*ListProjectsRespo
 		r.Count = l.Count
 		r.Projects = append(r.Projects, l.Projects...)
 
-		if r.Count == len(r.Projects) {
+		if r.Count == len(r.Projects) || len(l.Projects) == 0 {
+			s.cs.observePages("listProjects", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.Projects))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.Projects)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listProjects", values, len(l.Projects), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListProjectsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.Projects = append(r.Projects, l.Projects...)
+			}
+
+			if r.Count == len(r.Projects) {
+				s.cs.observePages("listProjects", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListProjectsIterator iterates over the items returned by listProjects, requesting
+// the pages of the response one at a time while iterating.
+type ListProjectsIterator struct {
+	pager
+	items []*Project
+	index int
+}
+
+// NewListProjectsIterator returns an iterator over the items returned by listProjects, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *ProjectService) NewListProjectsIterator(p *ListProjectsParams, pagesize int) *ListProjectsIterator {
+	return &ListProjectsIterator{pager: newPager(s.cs, "listProjects", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListProjectsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListProjectsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.Projects))
+
+	i.items = l.Projects
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListProjectsIterator) Value() *Project {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListProjectsResponse struct {
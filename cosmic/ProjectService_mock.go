@@ -0,0 +1,309 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockProjectService is a mock of ProjectServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockProjectService struct {
+	mockCalls
+
+	ActivateProjectFunc              func(ctx context.Context, p *ActivateProjectParams) (*ActivateProjectResponse, error)
+	ActivateProjectAsyncFunc         func(ctx context.Context, p *ActivateProjectParams) (*ActivateProjectJob, error)
+	CreateProjectFunc                func(ctx context.Context, p *CreateProjectParams) (*CreateProjectResponse, error)
+	CreateProjectAsyncFunc           func(ctx context.Context, p *CreateProjectParams) (*CreateProjectJob, error)
+	DeleteProjectFunc                func(ctx context.Context, p *DeleteProjectParams) (*DeleteProjectResponse, error)
+	DeleteProjectAsyncFunc           func(ctx context.Context, p *DeleteProjectParams) (*DeleteProjectJob, error)
+	SuspendProjectFunc               func(ctx context.Context, p *SuspendProjectParams) (*SuspendProjectResponse, error)
+	SuspendProjectAsyncFunc          func(ctx context.Context, p *SuspendProjectParams) (*SuspendProjectJob, error)
+	UpdateProjectFunc                func(ctx context.Context, p *UpdateProjectParams) (*UpdateProjectResponse, error)
+	UpdateProjectAsyncFunc           func(ctx context.Context, p *UpdateProjectParams) (*UpdateProjectJob, error)
+	DeleteProjectInvitationFunc      func(ctx context.Context, p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error)
+	DeleteProjectInvitationAsyncFunc func(ctx context.Context, p *DeleteProjectInvitationParams) (*DeleteProjectInvitationJob, error)
+	UpdateProjectInvitationFunc      func(ctx context.Context, p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error)
+	UpdateProjectInvitationAsyncFunc func(ctx context.Context, p *UpdateProjectInvitationParams) (*UpdateProjectInvitationJob, error)
+	GetProjectInvitationByIDFunc     func(id string, opts ...OptionFunc) (*ProjectInvitation, int, error)
+	ListProjectInvitationsFunc       func(ctx context.Context, p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error)
+	GetProjectIDFunc                 func(name string, opts ...OptionFunc) (string, int, error)
+	GetProjectByNameFunc             func(name string, opts ...OptionFunc) (*Project, int, error)
+	GetProjectByIDFunc               func(id string, opts ...OptionFunc) (*Project, int, error)
+	ListProjectsFunc                 func(ctx context.Context, p *ListProjectsParams) (*ListProjectsResponse, error)
+}
+
+var _ ProjectServiceIface = &MockProjectService{}
+
+func (m *MockProjectService) NewActivateProjectParams(id string) *ActivateProjectParams {
+	return (&ProjectService{}).NewActivateProjectParams(id)
+}
+
+func (m *MockProjectService) ActivateProject(p *ActivateProjectParams) (*ActivateProjectResponse, error) {
+	return m.ActivateProjectWithContext(context.Background(), p)
+}
+
+func (m *MockProjectService) ActivateProjectWithContext(ctx context.Context, p *ActivateProjectParams) (*ActivateProjectResponse, error) {
+	m.record("ActivateProject", p)
+	if m.ActivateProjectFunc == nil {
+		return nil, notMocked("MockProjectService", "ActivateProject")
+	}
+	return m.ActivateProjectFunc(ctx, p)
+}
+
+func (m *MockProjectService) ActivateProjectAsync(ctx context.Context, p *ActivateProjectParams) (*ActivateProjectJob, error) {
+	m.record("ActivateProjectAsync", p)
+	if m.ActivateProjectAsyncFunc == nil {
+		return nil, notMocked("MockProjectService", "ActivateProjectAsync")
+	}
+	return m.ActivateProjectAsyncFunc(ctx, p)
+}
+
+func (m *MockProjectService) NewCreateProjectParams(displaytext string, name string) *CreateProjectParams {
+	return (&ProjectService{}).NewCreateProjectParams(displaytext, name)
+}
+
+func (m *MockProjectService) CreateProject(p *CreateProjectParams) (*CreateProjectResponse, error) {
+	return m.CreateProjectWithContext(context.Background(), p)
+}
+
+func (m *MockProjectService) CreateProjectWithContext(ctx context.Context, p *CreateProjectParams) (*CreateProjectResponse, error) {
+	m.record("CreateProject", p)
+	if m.CreateProjectFunc == nil {
+		return nil, notMocked("MockProjectService", "CreateProject")
+	}
+	return m.CreateProjectFunc(ctx, p)
+}
+
+func (m *MockProjectService) CreateProjectAsync(ctx context.Context, p *CreateProjectParams) (*CreateProjectJob, error) {
+	m.record("CreateProjectAsync", p)
+	if m.CreateProjectAsyncFunc == nil {
+		return nil, notMocked("MockProjectService", "CreateProjectAsync")
+	}
+	return m.CreateProjectAsyncFunc(ctx, p)
+}
+
+func (m *MockProjectService) NewDeleteProjectParams(id string) *DeleteProjectParams {
+	return (&ProjectService{}).NewDeleteProjectParams(id)
+}
+
+func (m *MockProjectService) DeleteProject(p *DeleteProjectParams) (*DeleteProjectResponse, error) {
+	return m.DeleteProjectWithContext(context.Background(), p)
+}
+
+func (m *MockProjectService) DeleteProjectWithContext(ctx context.Context, p *DeleteProjectParams) (*DeleteProjectResponse, error) {
+	m.record("DeleteProject", p)
+	if m.DeleteProjectFunc == nil {
+		return nil, notMocked("MockProjectService", "DeleteProject")
+	}
+	return m.DeleteProjectFunc(ctx, p)
+}
+
+func (m *MockProjectService) DeleteProjectAsync(ctx context.Context, p *DeleteProjectParams) (*DeleteProjectJob, error) {
+	m.record("DeleteProjectAsync", p)
+	if m.DeleteProjectAsyncFunc == nil {
+		return nil, notMocked("MockProjectService", "DeleteProjectAsync")
+	}
+	return m.DeleteProjectAsyncFunc(ctx, p)
+}
+
+func (m *MockProjectService) NewSuspendProjectParams(id string) *SuspendProjectParams {
+	return (&ProjectService{}).NewSuspendProjectParams(id)
+}
+
+func (m *MockProjectService) SuspendProject(p *SuspendProjectParams) (*SuspendProjectResponse, error) {
+	return m.SuspendProjectWithContext(context.Background(), p)
+}
+
+func (m *MockProjectService) SuspendProjectWithContext(ctx context.Context, p *SuspendProjectParams) (*SuspendProjectResponse, error) {
+	m.record("SuspendProject", p)
+	if m.SuspendProjectFunc == nil {
+		return nil, notMocked("MockProjectService", "SuspendProject")
+	}
+	return m.SuspendProjectFunc(ctx, p)
+}
+
+func (m *MockProjectService) SuspendProjectAsync(ctx context.Context, p *SuspendProjectParams) (*SuspendProjectJob, error) {
+	m.record("SuspendProjectAsync", p)
+	if m.SuspendProjectAsyncFunc == nil {
+		return nil, notMocked("MockProjectService", "SuspendProjectAsync")
+	}
+	return m.SuspendProjectAsyncFunc(ctx, p)
+}
+
+func (m *MockProjectService) NewUpdateProjectParams(id string) *UpdateProjectParams {
+	return (&ProjectService{}).NewUpdateProjectParams(id)
+}
+
+func (m *MockProjectService) UpdateProject(p *UpdateProjectParams) (*UpdateProjectResponse, error) {
+	return m.UpdateProjectWithContext(context.Background(), p)
+}
+
+func (m *MockProjectService) UpdateProjectWithContext(ctx context.Context, p *UpdateProjectParams) (*UpdateProjectResponse, error) {
+	m.record("UpdateProject", p)
+	if m.UpdateProjectFunc == nil {
+		return nil, notMocked("MockProjectService", "UpdateProject")
+	}
+	return m.UpdateProjectFunc(ctx, p)
+}
+
+func (m *MockProjectService) UpdateProjectAsync(ctx context.Context, p *UpdateProjectParams) (*UpdateProjectJob, error) {
+	m.record("UpdateProjectAsync", p)
+	if m.UpdateProjectAsyncFunc == nil {
+		return nil, notMocked("MockProjectService", "UpdateProjectAsync")
+	}
+	return m.UpdateProjectAsyncFunc(ctx, p)
+}
+
+func (m *MockProjectService) NewDeleteProjectInvitationParams(id string) *DeleteProjectInvitationParams {
+	return (&ProjectService{}).NewDeleteProjectInvitationParams(id)
+}
+
+func (m *MockProjectService) DeleteProjectInvitation(p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error) {
+	return m.DeleteProjectInvitationWithContext(context.Background(), p)
+}
+
+func (m *MockProjectService) DeleteProjectInvitationWithContext(ctx context.Context, p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error) {
+	m.record("DeleteProjectInvitation", p)
+	if m.DeleteProjectInvitationFunc == nil {
+		return nil, notMocked("MockProjectService", "DeleteProjectInvitation")
+	}
+	return m.DeleteProjectInvitationFunc(ctx, p)
+}
+
+func (m *MockProjectService) DeleteProjectInvitationAsync(ctx context.Context, p *DeleteProjectInvitationParams) (*DeleteProjectInvitationJob, error) {
+	m.record("DeleteProjectInvitationAsync", p)
+	if m.DeleteProjectInvitationAsyncFunc == nil {
+		return nil, notMocked("MockProjectService", "DeleteProjectInvitationAsync")
+	}
+	return m.DeleteProjectInvitationAsyncFunc(ctx, p)
+}
+
+func (m *MockProjectService) NewUpdateProjectInvitationParams(projectid string) *UpdateProjectInvitationParams {
+	return (&ProjectService{}).NewUpdateProjectInvitationParams(projectid)
+}
+
+func (m *MockProjectService) UpdateProjectInvitation(p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error) {
+	return m.UpdateProjectInvitationWithContext(context.Background(), p)
+}
+
+func (m *MockProjectService) UpdateProjectInvitationWithContext(ctx context.Context, p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error) {
+	m.record("UpdateProjectInvitation", p)
+	if m.UpdateProjectInvitationFunc == nil {
+		return nil, notMocked("MockProjectService", "UpdateProjectInvitation")
+	}
+	return m.UpdateProjectInvitationFunc(ctx, p)
+}
+
+func (m *MockProjectService) UpdateProjectInvitationAsync(ctx context.Context, p *UpdateProjectInvitationParams) (*UpdateProjectInvitationJob, error) {
+	m.record("UpdateProjectInvitationAsync", p)
+	if m.UpdateProjectInvitationAsyncFunc == nil {
+		return nil, notMocked("MockProjectService", "UpdateProjectInvitationAsync")
+	}
+	return m.UpdateProjectInvitationAsyncFunc(ctx, p)
+}
+
+func (m *MockProjectService) NewListProjectInvitationsParams() *ListProjectInvitationsParams {
+	return (&ProjectService{}).NewListProjectInvitationsParams()
+}
+
+func (m *MockProjectService) GetProjectInvitationByID(id string, opts ...OptionFunc) (*ProjectInvitation, int, error) {
+	m.record("GetProjectInvitationByID", id, opts)
+	if m.GetProjectInvitationByIDFunc == nil {
+		return nil, -1, notMocked("MockProjectService", "GetProjectInvitationByID")
+	}
+	return m.GetProjectInvitationByIDFunc(id, opts...)
+}
+
+func (m *MockProjectService) ListProjectInvitations(p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error) {
+	return m.ListProjectInvitationsWithContext(context.Background(), p)
+}
+
+func (m *MockProjectService) ListProjectInvitationsWithContext(ctx context.Context, p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error) {
+	m.record("ListProjectInvitations", p)
+	if m.ListProjectInvitationsFunc == nil {
+		return nil, notMocked("MockProjectService", "ListProjectInvitations")
+	}
+	return m.ListProjectInvitationsFunc(ctx, p)
+}
+
+func (m *MockProjectService) NewListProjectInvitationsIterator(p *ListProjectInvitationsParams, pagesize int) *ListProjectInvitationsIterator {
+	i := &ListProjectInvitationsIterator{pager: newPager(nil, "listProjectInvitations", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListProjectInvitationsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListProjectInvitationsWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockProjectService) NewListProjectsParams() *ListProjectsParams {
+	return (&ProjectService{}).NewListProjectsParams()
+}
+
+func (m *MockProjectService) GetProjectID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetProjectID", name, opts)
+	if m.GetProjectIDFunc == nil {
+		return "", -1, notMocked("MockProjectService", "GetProjectID")
+	}
+	return m.GetProjectIDFunc(name, opts...)
+}
+
+func (m *MockProjectService) GetProjectByName(name string, opts ...OptionFunc) (*Project, int, error) {
+	m.record("GetProjectByName", name, opts)
+	if m.GetProjectByNameFunc == nil {
+		return nil, -1, notMocked("MockProjectService", "GetProjectByName")
+	}
+	return m.GetProjectByNameFunc(name, opts...)
+}
+
+func (m *MockProjectService) GetProjectByID(id string, opts ...OptionFunc) (*Project, int, error) {
+	m.record("GetProjectByID", id, opts)
+	if m.GetProjectByIDFunc == nil {
+		return nil, -1, notMocked("MockProjectService", "GetProjectByID")
+	}
+	return m.GetProjectByIDFunc(id, opts...)
+}
+
+func (m *MockProjectService) ListProjects(p *ListProjectsParams) (*ListProjectsResponse, error) {
+	return m.ListProjectsWithContext(context.Background(), p)
+}
+
+func (m *MockProjectService) ListProjectsWithContext(ctx context.Context, p *ListProjectsParams) (*ListProjectsResponse, error) {
+	m.record("ListProjects", p)
+	if m.ListProjectsFunc == nil {
+		return nil, notMocked("MockProjectService", "ListProjects")
+	}
+	return m.ListProjectsFunc(ctx, p)
+}
+
+func (m *MockProjectService) NewListProjectsIterator(p *ListProjectsParams, pagesize int) *ListProjectsIterator {
+	i := &ListProjectsIterator{pager: newPager(nil, "listProjects", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListProjectsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListProjectsWithContext(ctx, c)
+	}
+	return i
+}
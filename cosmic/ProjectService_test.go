@@ -0,0 +1,198 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestActivateProjectParams(t *testing.T) {
+	p := &ActivateProjectParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "activateProject", want)
+}
+
+func TestActivateProjectResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","cpuavailable":"cpuavailable-value","cpulimit":"cpulimit-value","cputotal":1099511627776,"displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","ipavailable":"ipavailable-value","iplimit":"iplimit-value","iptotal":1099511627776,"jobid":"jobid-value","memoryavailable":"memoryavailable-value","memorylimit":"memorylimit-value","memorytotal":1099511627776,"name":"name-value","networkavailable":"networkavailable-value","networklimit":"networklimit-value","networktotal":1099511627776,"primarystorageavailable":"primarystorageavailable-value","primarystoragelimit":"primarystoragelimit-value","primarystoragetotal":1099511627776,"secondarystorageavailable":"secondarystorageavailable-value","secondarystoragelimit":"secondarystoragelimit-value","secondarystoragetotal":1099511627776,"snapshotavailable":"snapshotavailable-value","snapshotlimit":"snapshotlimit-value","snapshottotal":1099511627776,"state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templateavailable":"templateavailable-value","templatelimit":"templatelimit-value","templatetotal":1099511627776,"vmavailable":"vmavailable-value","vmlimit":"vmlimit-value","vmrunning":42,"vmstopped":42,"vmtotal":1099511627776,"volumeavailable":"volumeavailable-value","volumelimit":"volumelimit-value","volumetotal":1099511627776,"vpcavailable":"vpcavailable-value","vpclimit":"vpclimit-value","vpctotal":1099511627776}`, &ActivateProjectResponse{})
+}
+
+func TestCreateProjectParams(t *testing.T) {
+	p := &CreateProjectParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDisplaytext("displaytext-value")
+	want.Set("displaytext", "displaytext-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+
+	assertParams(t, p, "createProject", want)
+}
+
+func TestCreateProjectResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","cpuavailable":"cpuavailable-value","cpulimit":"cpulimit-value","cputotal":1099511627776,"displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","ipavailable":"ipavailable-value","iplimit":"iplimit-value","iptotal":1099511627776,"jobid":"jobid-value","memoryavailable":"memoryavailable-value","memorylimit":"memorylimit-value","memorytotal":1099511627776,"name":"name-value","networkavailable":"networkavailable-value","networklimit":"networklimit-value","networktotal":1099511627776,"primarystorageavailable":"primarystorageavailable-value","primarystoragelimit":"primarystoragelimit-value","primarystoragetotal":1099511627776,"secondarystorageavailable":"secondarystorageavailable-value","secondarystoragelimit":"secondarystoragelimit-value","secondarystoragetotal":1099511627776,"snapshotavailable":"snapshotavailable-value","snapshotlimit":"snapshotlimit-value","snapshottotal":1099511627776,"state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templateavailable":"templateavailable-value","templatelimit":"templatelimit-value","templatetotal":1099511627776,"vmavailable":"vmavailable-value","vmlimit":"vmlimit-value","vmrunning":42,"vmstopped":42,"vmtotal":1099511627776,"volumeavailable":"volumeavailable-value","volumelimit":"volumelimit-value","volumetotal":1099511627776,"vpcavailable":"vpcavailable-value","vpclimit":"vpclimit-value","vpctotal":1099511627776}`, &CreateProjectResponse{})
+}
+
+func TestDeleteProjectParams(t *testing.T) {
+	p := &DeleteProjectParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteProject", want)
+}
+
+func TestDeleteProjectResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteProjectResponse{})
+}
+
+func TestSuspendProjectParams(t *testing.T) {
+	p := &SuspendProjectParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "suspendProject", want)
+}
+
+func TestSuspendProjectResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","cpuavailable":"cpuavailable-value","cpulimit":"cpulimit-value","cputotal":1099511627776,"displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","ipavailable":"ipavailable-value","iplimit":"iplimit-value","iptotal":1099511627776,"jobid":"jobid-value","memoryavailable":"memoryavailable-value","memorylimit":"memorylimit-value","memorytotal":1099511627776,"name":"name-value","networkavailable":"networkavailable-value","networklimit":"networklimit-value","networktotal":1099511627776,"primarystorageavailable":"primarystorageavailable-value","primarystoragelimit":"primarystoragelimit-value","primarystoragetotal":1099511627776,"secondarystorageavailable":"secondarystorageavailable-value","secondarystoragelimit":"secondarystoragelimit-value","secondarystoragetotal":1099511627776,"snapshotavailable":"snapshotavailable-value","snapshotlimit":"snapshotlimit-value","snapshottotal":1099511627776,"state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templateavailable":"templateavailable-value","templatelimit":"templatelimit-value","templatetotal":1099511627776,"vmavailable":"vmavailable-value","vmlimit":"vmlimit-value","vmrunning":42,"vmstopped":42,"vmtotal":1099511627776,"volumeavailable":"volumeavailable-value","volumelimit":"volumelimit-value","volumetotal":1099511627776,"vpcavailable":"vpcavailable-value","vpclimit":"vpclimit-value","vpctotal":1099511627776}`, &SuspendProjectResponse{})
+}
+
+func TestUpdateProjectParams(t *testing.T) {
+	p := &UpdateProjectParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDisplaytext("displaytext-value")
+	want.Set("displaytext", "displaytext-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "updateProject", want)
+}
+
+func TestUpdateProjectResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","cpuavailable":"cpuavailable-value","cpulimit":"cpulimit-value","cputotal":1099511627776,"displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","ipavailable":"ipavailable-value","iplimit":"iplimit-value","iptotal":1099511627776,"jobid":"jobid-value","memoryavailable":"memoryavailable-value","memorylimit":"memorylimit-value","memorytotal":1099511627776,"name":"name-value","networkavailable":"networkavailable-value","networklimit":"networklimit-value","networktotal":1099511627776,"primarystorageavailable":"primarystorageavailable-value","primarystoragelimit":"primarystoragelimit-value","primarystoragetotal":1099511627776,"secondarystorageavailable":"secondarystorageavailable-value","secondarystoragelimit":"secondarystoragelimit-value","secondarystoragetotal":1099511627776,"snapshotavailable":"snapshotavailable-value","snapshotlimit":"snapshotlimit-value","snapshottotal":1099511627776,"state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templateavailable":"templateavailable-value","templatelimit":"templatelimit-value","templatetotal":1099511627776,"vmavailable":"vmavailable-value","vmlimit":"vmlimit-value","vmrunning":42,"vmstopped":42,"vmtotal":1099511627776,"volumeavailable":"volumeavailable-value","volumelimit":"volumelimit-value","volumetotal":1099511627776,"vpcavailable":"vpcavailable-value","vpclimit":"vpclimit-value","vpctotal":1099511627776}`, &UpdateProjectResponse{})
+}
+
+func TestDeleteProjectInvitationParams(t *testing.T) {
+	p := &DeleteProjectInvitationParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteProjectInvitation", want)
+}
+
+func TestDeleteProjectInvitationResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteProjectInvitationResponse{})
+}
+
+func TestUpdateProjectInvitationParams(t *testing.T) {
+	p := &UpdateProjectInvitationParams{}
+	want := url.Values{}
+	p.SetAccept(true)
+	want.Set("accept", "true")
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetToken("token-value")
+	want.Set("token", "token-value")
+
+	assertParams(t, p, "updateProjectInvitation", want)
+}
+
+func TestUpdateProjectInvitationResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &UpdateProjectInvitationResponse{})
+}
+
+func TestListProjectInvitationsParams(t *testing.T) {
+	p := &ListProjectInvitationsParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetActiveonly(true)
+	want.Set("activeonly", "true")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetState("state-value")
+	want.Set("state", "state-value")
+
+	assertParams(t, p, "listProjectInvitations", want)
+}
+
+func TestListProjectInvitationsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"projectinvitation":[{"account":"account-value","domain":"domain-value","domainid":"domainid-value","email":"email-value","id":"id-value","project":"project-value","projectid":"projectid-value","state":"state-value"}]}`, &ListProjectInvitationsResponse{})
+}
+
+func TestListProjectsParams(t *testing.T) {
+	p := &ListProjectsParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDisplaytext("displaytext-value")
+	want.Set("displaytext", "displaytext-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetState("state-value")
+	want.Set("state", "state-value")
+	p.SetTags(map[string]string{"k": "v"})
+	want.Set("tags[0].key", "k")
+	want.Set("tags[0].value", "v")
+
+	assertParams(t, p, "listProjects", want)
+}
+
+func TestListProjectsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"project":[{"account":"account-value","cpuavailable":"cpuavailable-value","cpulimit":"cpulimit-value","cputotal":1099511627776,"displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","ipavailable":"ipavailable-value","iplimit":"iplimit-value","iptotal":1099511627776,"memoryavailable":"memoryavailable-value","memorylimit":"memorylimit-value","memorytotal":1099511627776,"name":"name-value","networkavailable":"networkavailable-value","networklimit":"networklimit-value","networktotal":1099511627776,"primarystorageavailable":"primarystorageavailable-value","primarystoragelimit":"primarystoragelimit-value","primarystoragetotal":1099511627776,"secondarystorageavailable":"secondarystorageavailable-value","secondarystoragelimit":"secondarystoragelimit-value","secondarystoragetotal":1099511627776,"snapshotavailable":"snapshotavailable-value","snapshotlimit":"snapshotlimit-value","snapshottotal":1099511627776,"state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templateavailable":"templateavailable-value","templatelimit":"templatelimit-value","templatetotal":1099511627776,"vmavailable":"vmavailable-value","vmlimit":"vmlimit-value","vmrunning":42,"vmstopped":42,"vmtotal":1099511627776,"volumeavailable":"volumeavailable-value","volumelimit":"volumelimit-value","volumetotal":1099511627776,"vpcavailable":"vpcavailable-value","vpclimit":"vpclimit-value","vpctotal":1099511627776}]}`, &ListProjectsResponse{})
+}
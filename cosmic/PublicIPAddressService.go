@@ -17,17 +17,43 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
+// PublicIPAddressServiceIface is the interface implemented by PublicIPAddressService, and by MockPublicIPAddressService for tests
+type PublicIPAddressServiceIface interface {
+	NewAssociateIpAddressParams() *AssociateIpAddressParams
+	AssociateIpAddress(p *AssociateIpAddressParams) (*AssociateIpAddressResponse, error)
+	AssociateIpAddressWithContext(ctx context.Context, p *AssociateIpAddressParams) (*AssociateIpAddressResponse, error)
+	AssociateIpAddressAsync(ctx context.Context, p *AssociateIpAddressParams) (*AssociateIpAddressJob, error)
+	NewDisassociateIpAddressParams(id string) *DisassociateIpAddressParams
+	DisassociateIpAddress(p *DisassociateIpAddressParams) (*DisassociateIpAddressResponse, error)
+	DisassociateIpAddressWithContext(ctx context.Context, p *DisassociateIpAddressParams) (*DisassociateIpAddressResponse, error)
+	DisassociateIpAddressAsync(ctx context.Context, p *DisassociateIpAddressParams) (*DisassociateIpAddressJob, error)
+	NewUpdateIpAddressParams(id string) *UpdateIpAddressParams
+	UpdateIpAddress(p *UpdateIpAddressParams) (*UpdateIpAddressResponse, error)
+	UpdateIpAddressWithContext(ctx context.Context, p *UpdateIpAddressParams) (*UpdateIpAddressResponse, error)
+	UpdateIpAddressAsync(ctx context.Context, p *UpdateIpAddressParams) (*UpdateIpAddressJob, error)
+	NewListPublicIpAddressesParams() *ListPublicIpAddressesParams
+	GetPublicIpAddressByID(id string, opts ...OptionFunc) (*PublicIpAddress, int, error)
+	ListPublicIpAddresses(p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error)
+	ListPublicIpAddressesWithContext(ctx context.Context, p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error)
+	NewListPublicIpAddressesIterator(p *ListPublicIpAddressesParams, pagesize int) *ListPublicIpAddressesIterator
+}
+
 type AssociateIpAddressParams struct {
 	p map[string]interface{}
 }
 
+func (p *AssociateIpAddressParams) command() string {
+	return "associateIpAddress"
+}
+
 func (p *AssociateIpAddressParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -117,7 +143,13 @@ func (s *PublicIPAddressService) NewAssociateIpAddressParams() *AssociateIpAddre
 
 // Acquires and associates a public IP to an account.
 func (s *PublicIPAddressService) AssociateIpAddress(p *AssociateIpAddressParams) (*AssociateIpAddressResponse, error) {
-	resp, err := s.cs.newRequest("associateIpAddress", p.toURLValues())
+	return s.AssociateIpAddressWithContext(context.Background(), p)
+}
+
+// AssociateIpAddressWithContext is the same as AssociateIpAddress, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *PublicIPAddressService) AssociateIpAddressWithContext(ctx context.Context, p *AssociateIpAddressParams) (*AssociateIpAddressResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "associateIpAddress", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -129,26 +161,56 @@ func (s *PublicIPAddressService) AssociateIpAddress(p *AssociateIpAddressParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "associateIpAddress", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "ipaddress", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// AssociateIpAddressJob is a handle to a running associateIpAddress async job
+type AssociateIpAddressJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a AssociateIpAddressResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *AssociateIpAddressJob) Result() (*AssociateIpAddressResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := AssociateIpAddressResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "ipaddress", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// AssociateIpAddressAsync starts the associateIpAddress async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *PublicIPAddressService) AssociateIpAddressAsync(ctx context.Context, p *AssociateIpAddressParams) (*AssociateIpAddressJob, error) {
+	resp, err := s.cs.newRequest(ctx, "associateIpAddress", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AssociateIpAddressResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &AssociateIpAddressJob{s.cs.newJobHandle("associateIpAddress", r.JobID)}, nil
+}
+
 type AssociateIpAddressResponse struct {
 	JobID                 string `json:"jobid,omitempty"`
 	Account               string `json:"account,omitempty"`
@@ -199,6 +261,10 @@ type DisassociateIpAddressParams struct {
 	p map[string]interface{}
 }
 
+func (p *DisassociateIpAddressParams) command() string {
+	return "disassociateIpAddress"
+}
+
 func (p *DisassociateIpAddressParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -228,7 +294,13 @@ func (s *PublicIPAddressService) NewDisassociateIpAddressParams(id string) *Disa
 
 // Disassociates an IP address from the account.
 func (s *PublicIPAddressService) DisassociateIpAddress(p *DisassociateIpAddressParams) (*DisassociateIpAddressResponse, error) {
-	resp, err := s.cs.newRequest("disassociateIpAddress", p.toURLValues())
+	return s.DisassociateIpAddressWithContext(context.Background(), p)
+}
+
+// DisassociateIpAddressWithContext is the same as DisassociateIpAddress, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *PublicIPAddressService) DisassociateIpAddressWithContext(ctx context.Context, p *DisassociateIpAddressParams) (*DisassociateIpAddressResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "disassociateIpAddress", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -240,9 +312,9 @@ func (s *PublicIPAddressService) DisassociateIpAddress(p *DisassociateIpAddressP
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "disassociateIpAddress", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -255,6 +327,41 @@ func (s *PublicIPAddressService) DisassociateIpAddress(p *DisassociateIpAddressP
 	return &r, nil
 }
 
+// DisassociateIpAddressJob is a handle to a running disassociateIpAddress async job
+type DisassociateIpAddressJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DisassociateIpAddressResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DisassociateIpAddressJob) Result() (*DisassociateIpAddressResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DisassociateIpAddressResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DisassociateIpAddressAsync starts the disassociateIpAddress async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *PublicIPAddressService) DisassociateIpAddressAsync(ctx context.Context, p *DisassociateIpAddressParams) (*DisassociateIpAddressJob, error) {
+	resp, err := s.cs.newRequest(ctx, "disassociateIpAddress", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DisassociateIpAddressResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DisassociateIpAddressJob{s.cs.newJobHandle("disassociateIpAddress", r.JobID)}, nil
+}
+
 type DisassociateIpAddressResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -265,6 +372,10 @@ type UpdateIpAddressParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateIpAddressParams) command() string {
+	return "updateIpAddress"
+}
+
 func (p *UpdateIpAddressParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -315,7 +426,13 @@ func (s *PublicIPAddressService) NewUpdateIpAddressParams(id string) *UpdateIpAd
 
 // Updates an IP address
 func (s *PublicIPAddressService) UpdateIpAddress(p *UpdateIpAddressParams) (*UpdateIpAddressResponse, error) {
-	resp, err := s.cs.newRequest("updateIpAddress", p.toURLValues())
+	return s.UpdateIpAddressWithContext(context.Background(), p)
+}
+
+// UpdateIpAddressWithContext is the same as UpdateIpAddress, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *PublicIPAddressService) UpdateIpAddressWithContext(ctx context.Context, p *UpdateIpAddressParams) (*UpdateIpAddressResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateIpAddress", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -327,26 +444,56 @@ func (s *PublicIPAddressService) UpdateIpAddress(p *UpdateIpAddressParams) (*Upd
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateIpAddress", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "ipaddress", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// UpdateIpAddressJob is a handle to a running updateIpAddress async job
+type UpdateIpAddressJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateIpAddressResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateIpAddressJob) Result() (*UpdateIpAddressResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateIpAddressResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "ipaddress", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// UpdateIpAddressAsync starts the updateIpAddress async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *PublicIPAddressService) UpdateIpAddressAsync(ctx context.Context, p *UpdateIpAddressParams) (*UpdateIpAddressJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateIpAddress", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateIpAddressResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateIpAddressJob{s.cs.newJobHandle("updateIpAddress", r.JobID)}, nil
+}
+
 type UpdateIpAddressResponse struct {
 	JobID                 string `json:"jobid,omitempty"`
 	Account               string `json:"account,omitempty"`
@@ -397,6 +544,10 @@ type ListPublicIpAddressesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListPublicIpAddressesParams) command() string {
+	return "listPublicIpAddresses"
+}
+
 func (p *ListPublicIpAddressesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -673,16 +824,14 @@ func (s *PublicIPAddressService) GetPublicIpAddressByID(id string, opts ...Optio
 
 	l, err := s.ListPublicIpAddresses(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -694,10 +843,17 @@ func (s *PublicIPAddressService) GetPublicIpAddressByID(id string, opts ...Optio
 
 // Lists all public ip addresses
 func (s *PublicIPAddressService) ListPublicIpAddresses(p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error) {
+	return s.ListPublicIpAddressesWithContext(context.Background(), p)
+}
+
+// ListPublicIpAddressesWithContext is the same as ListPublicIpAddresses, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *PublicIPAddressService) ListPublicIpAddressesWithContext(ctx context.Context, p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error) {
 	var r ListPublicIpAddressesResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListPublicIpAddressesResponse
-		resp, err := s.cs.newRequest("listPublicIpAddresses", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listPublicIpAddresses", values)
 		if err != nil {
 			return nil, err
 		}
@@ -709,13 +865,89 @@ func (s *PublicIPAddressService) ListPublicIpAddresses(p *ListPublicIpAddressesP
 		r.Count = l.Count
 		r.PublicIpAddresses = append(r.PublicIpAddresses, l.PublicIpAddresses...)
 
-		if r.Count == len(r.PublicIpAddresses) {
+		if r.Count == len(r.PublicIpAddresses) || len(l.PublicIpAddresses) == 0 {
+			s.cs.observePages("listPublicIpAddresses", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.PublicIpAddresses))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.PublicIpAddresses)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listPublicIpAddresses", values, len(l.PublicIpAddresses), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListPublicIpAddressesResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.PublicIpAddresses = append(r.PublicIpAddresses, l.PublicIpAddresses...)
+			}
+
+			if r.Count == len(r.PublicIpAddresses) {
+				s.cs.observePages("listPublicIpAddresses", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListPublicIpAddressesIterator iterates over the items returned by listPublicIpAddresses, requesting
+// the pages of the response one at a time while iterating.
+type ListPublicIpAddressesIterator struct {
+	pager
+	items []*PublicIpAddress
+	index int
+}
+
+// NewListPublicIpAddressesIterator returns an iterator over the items returned by listPublicIpAddresses, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *PublicIPAddressService) NewListPublicIpAddressesIterator(p *ListPublicIpAddressesParams, pagesize int) *ListPublicIpAddressesIterator {
+	return &ListPublicIpAddressesIterator{pager: newPager(s.cs, "listPublicIpAddresses", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListPublicIpAddressesIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListPublicIpAddressesResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.PublicIpAddresses))
+
+	i.items = l.PublicIpAddresses
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListPublicIpAddressesIterator) Value() *PublicIpAddress {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListPublicIpAddressesResponse struct {
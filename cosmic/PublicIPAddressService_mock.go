@@ -0,0 +1,147 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockPublicIPAddressService is a mock of PublicIPAddressServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockPublicIPAddressService struct {
+	mockCalls
+
+	AssociateIpAddressFunc         func(ctx context.Context, p *AssociateIpAddressParams) (*AssociateIpAddressResponse, error)
+	AssociateIpAddressAsyncFunc    func(ctx context.Context, p *AssociateIpAddressParams) (*AssociateIpAddressJob, error)
+	DisassociateIpAddressFunc      func(ctx context.Context, p *DisassociateIpAddressParams) (*DisassociateIpAddressResponse, error)
+	DisassociateIpAddressAsyncFunc func(ctx context.Context, p *DisassociateIpAddressParams) (*DisassociateIpAddressJob, error)
+	UpdateIpAddressFunc            func(ctx context.Context, p *UpdateIpAddressParams) (*UpdateIpAddressResponse, error)
+	UpdateIpAddressAsyncFunc       func(ctx context.Context, p *UpdateIpAddressParams) (*UpdateIpAddressJob, error)
+	GetPublicIpAddressByIDFunc     func(id string, opts ...OptionFunc) (*PublicIpAddress, int, error)
+	ListPublicIpAddressesFunc      func(ctx context.Context, p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error)
+}
+
+var _ PublicIPAddressServiceIface = &MockPublicIPAddressService{}
+
+func (m *MockPublicIPAddressService) NewAssociateIpAddressParams() *AssociateIpAddressParams {
+	return (&PublicIPAddressService{}).NewAssociateIpAddressParams()
+}
+
+func (m *MockPublicIPAddressService) AssociateIpAddress(p *AssociateIpAddressParams) (*AssociateIpAddressResponse, error) {
+	return m.AssociateIpAddressWithContext(context.Background(), p)
+}
+
+func (m *MockPublicIPAddressService) AssociateIpAddressWithContext(ctx context.Context, p *AssociateIpAddressParams) (*AssociateIpAddressResponse, error) {
+	m.record("AssociateIpAddress", p)
+	if m.AssociateIpAddressFunc == nil {
+		return nil, notMocked("MockPublicIPAddressService", "AssociateIpAddress")
+	}
+	return m.AssociateIpAddressFunc(ctx, p)
+}
+
+func (m *MockPublicIPAddressService) AssociateIpAddressAsync(ctx context.Context, p *AssociateIpAddressParams) (*AssociateIpAddressJob, error) {
+	m.record("AssociateIpAddressAsync", p)
+	if m.AssociateIpAddressAsyncFunc == nil {
+		return nil, notMocked("MockPublicIPAddressService", "AssociateIpAddressAsync")
+	}
+	return m.AssociateIpAddressAsyncFunc(ctx, p)
+}
+
+func (m *MockPublicIPAddressService) NewDisassociateIpAddressParams(id string) *DisassociateIpAddressParams {
+	return (&PublicIPAddressService{}).NewDisassociateIpAddressParams(id)
+}
+
+func (m *MockPublicIPAddressService) DisassociateIpAddress(p *DisassociateIpAddressParams) (*DisassociateIpAddressResponse, error) {
+	return m.DisassociateIpAddressWithContext(context.Background(), p)
+}
+
+func (m *MockPublicIPAddressService) DisassociateIpAddressWithContext(ctx context.Context, p *DisassociateIpAddressParams) (*DisassociateIpAddressResponse, error) {
+	m.record("DisassociateIpAddress", p)
+	if m.DisassociateIpAddressFunc == nil {
+		return nil, notMocked("MockPublicIPAddressService", "DisassociateIpAddress")
+	}
+	return m.DisassociateIpAddressFunc(ctx, p)
+}
+
+func (m *MockPublicIPAddressService) DisassociateIpAddressAsync(ctx context.Context, p *DisassociateIpAddressParams) (*DisassociateIpAddressJob, error) {
+	m.record("DisassociateIpAddressAsync", p)
+	if m.DisassociateIpAddressAsyncFunc == nil {
+		return nil, notMocked("MockPublicIPAddressService", "DisassociateIpAddressAsync")
+	}
+	return m.DisassociateIpAddressAsyncFunc(ctx, p)
+}
+
+func (m *MockPublicIPAddressService) NewUpdateIpAddressParams(id string) *UpdateIpAddressParams {
+	return (&PublicIPAddressService{}).NewUpdateIpAddressParams(id)
+}
+
+func (m *MockPublicIPAddressService) UpdateIpAddress(p *UpdateIpAddressParams) (*UpdateIpAddressResponse, error) {
+	return m.UpdateIpAddressWithContext(context.Background(), p)
+}
+
+func (m *MockPublicIPAddressService) UpdateIpAddressWithContext(ctx context.Context, p *UpdateIpAddressParams) (*UpdateIpAddressResponse, error) {
+	m.record("UpdateIpAddress", p)
+	if m.UpdateIpAddressFunc == nil {
+		return nil, notMocked("MockPublicIPAddressService", "UpdateIpAddress")
+	}
+	return m.UpdateIpAddressFunc(ctx, p)
+}
+
+func (m *MockPublicIPAddressService) UpdateIpAddressAsync(ctx context.Context, p *UpdateIpAddressParams) (*UpdateIpAddressJob, error) {
+	m.record("UpdateIpAddressAsync", p)
+	if m.UpdateIpAddressAsyncFunc == nil {
+		return nil, notMocked("MockPublicIPAddressService", "UpdateIpAddressAsync")
+	}
+	return m.UpdateIpAddressAsyncFunc(ctx, p)
+}
+
+func (m *MockPublicIPAddressService) NewListPublicIpAddressesParams() *ListPublicIpAddressesParams {
+	return (&PublicIPAddressService{}).NewListPublicIpAddressesParams()
+}
+
+func (m *MockPublicIPAddressService) GetPublicIpAddressByID(id string, opts ...OptionFunc) (*PublicIpAddress, int, error) {
+	m.record("GetPublicIpAddressByID", id, opts)
+	if m.GetPublicIpAddressByIDFunc == nil {
+		return nil, -1, notMocked("MockPublicIPAddressService", "GetPublicIpAddressByID")
+	}
+	return m.GetPublicIpAddressByIDFunc(id, opts...)
+}
+
+func (m *MockPublicIPAddressService) ListPublicIpAddresses(p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error) {
+	return m.ListPublicIpAddressesWithContext(context.Background(), p)
+}
+
+func (m *MockPublicIPAddressService) ListPublicIpAddressesWithContext(ctx context.Context, p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error) {
+	m.record("ListPublicIpAddresses", p)
+	if m.ListPublicIpAddressesFunc == nil {
+		return nil, notMocked("MockPublicIPAddressService", "ListPublicIpAddresses")
+	}
+	return m.ListPublicIpAddressesFunc(ctx, p)
+}
+
+func (m *MockPublicIPAddressService) NewListPublicIpAddressesIterator(p *ListPublicIpAddressesParams, pagesize int) *ListPublicIpAddressesIterator {
+	i := &ListPublicIpAddressesIterator{pager: newPager(nil, "listPublicIpAddresses", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListPublicIpAddressesParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListPublicIpAddressesWithContext(ctx, c)
+	}
+	return i
+}
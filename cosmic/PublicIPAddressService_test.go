@@ -0,0 +1,135 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestAssociateIpAddressParams(t *testing.T) {
+	p := &AssociateIpAddressParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetNetworkid("networkid-value")
+	want.Set("networkid", "networkid-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetVpcid("vpcid-value")
+	want.Set("vpcid", "vpcid-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "associateIpAddress", want)
+}
+
+func TestAssociateIpAddressResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","aclid":"aclid-value","aclname":"aclname-value","allocated":"allocated-value","associatednetworkid":"associatednetworkid-value","associatednetworkname":"associatednetworkname-value","domain":"domain-value","domainid":"domainid-value","fordisplay":true,"forvirtualnetwork":true,"id":"id-value","ipaddress":"ipaddress-value","issourcenat":true,"isstaticnat":true,"issystem":true,"jobid":"jobid-value","networkid":"networkid-value","physicalnetworkid":"physicalnetworkid-value","project":"project-value","projectid":"projectid-value","purpose":"purpose-value","state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"virtualmachinedisplayname":"virtualmachinedisplayname-value","virtualmachineid":"virtualmachineid-value","virtualmachinename":"virtualmachinename-value","vlanid":"vlanid-value","vlanname":"vlanname-value","vmipaddress":"vmipaddress-value","vpcid":"vpcid-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &AssociateIpAddressResponse{})
+}
+
+func TestDisassociateIpAddressParams(t *testing.T) {
+	p := &DisassociateIpAddressParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "disassociateIpAddress", want)
+}
+
+func TestDisassociateIpAddressResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DisassociateIpAddressResponse{})
+}
+
+func TestUpdateIpAddressParams(t *testing.T) {
+	p := &UpdateIpAddressParams{}
+	want := url.Values{}
+	p.SetCustomid("customid-value")
+	want.Set("customid", "customid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "updateIpAddress", want)
+}
+
+func TestUpdateIpAddressResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","aclid":"aclid-value","aclname":"aclname-value","allocated":"allocated-value","associatednetworkid":"associatednetworkid-value","associatednetworkname":"associatednetworkname-value","domain":"domain-value","domainid":"domainid-value","fordisplay":true,"forvirtualnetwork":true,"id":"id-value","ipaddress":"ipaddress-value","issourcenat":true,"isstaticnat":true,"issystem":true,"jobid":"jobid-value","networkid":"networkid-value","physicalnetworkid":"physicalnetworkid-value","project":"project-value","projectid":"projectid-value","purpose":"purpose-value","state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"virtualmachinedisplayname":"virtualmachinedisplayname-value","virtualmachineid":"virtualmachineid-value","virtualmachinename":"virtualmachinename-value","vlanid":"vlanid-value","vlanname":"vlanname-value","vmipaddress":"vmipaddress-value","vpcid":"vpcid-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &UpdateIpAddressResponse{})
+}
+
+func TestListPublicIpAddressesParams(t *testing.T) {
+	p := &ListPublicIpAddressesParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetAllocatedonly(true)
+	want.Set("allocatedonly", "true")
+	p.SetAssociatednetworkid("associatednetworkid-value")
+	want.Set("associatednetworkid", "associatednetworkid-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetForloadbalancing(true)
+	want.Set("forloadbalancing", "true")
+	p.SetForvirtualnetwork(true)
+	want.Set("forvirtualnetwork", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIpaddress("ipaddress-value")
+	want.Set("ipaddress", "ipaddress-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetIssourcenat(true)
+	want.Set("issourcenat", "true")
+	p.SetIsstaticnat(true)
+	want.Set("isstaticnat", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetPhysicalnetworkid("physicalnetworkid-value")
+	want.Set("physicalnetworkid", "physicalnetworkid-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetState("state-value")
+	want.Set("state", "state-value")
+	p.SetTags(map[string]string{"k": "v"})
+	want.Set("tags[0].key", "k")
+	want.Set("tags[0].value", "v")
+	p.SetVlanid("vlanid-value")
+	want.Set("vlanid", "vlanid-value")
+	p.SetVpcid("vpcid-value")
+	want.Set("vpcid", "vpcid-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listPublicIpAddresses", want)
+}
+
+func TestListPublicIpAddressesResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"publicipaddress":[{"account":"account-value","aclid":"aclid-value","aclname":"aclname-value","allocated":"allocated-value","associatednetworkid":"associatednetworkid-value","associatednetworkname":"associatednetworkname-value","domain":"domain-value","domainid":"domainid-value","fordisplay":true,"forvirtualnetwork":true,"id":"id-value","ipaddress":"ipaddress-value","issourcenat":true,"isstaticnat":true,"issystem":true,"networkid":"networkid-value","physicalnetworkid":"physicalnetworkid-value","project":"project-value","projectid":"projectid-value","purpose":"purpose-value","state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"virtualmachinedisplayname":"virtualmachinedisplayname-value","virtualmachineid":"virtualmachineid-value","virtualmachinename":"virtualmachinename-value","vlanid":"vlanid-value","vlanname":"vlanname-value","vmipaddress":"vmipaddress-value","vpcid":"vpcid-value","zoneid":"zoneid-value","zonename":"zonename-value"}]}`, &ListPublicIpAddressesResponse{})
+}
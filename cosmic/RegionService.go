@@ -17,15 +17,37 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
 )
 
+// RegionServiceIface is the interface implemented by RegionService, and by MockRegionService for tests
+type RegionServiceIface interface {
+	NewAddRegionParams(endpoint string, id int, name string) *AddRegionParams
+	AddRegion(p *AddRegionParams) (*AddRegionResponse, error)
+	AddRegionWithContext(ctx context.Context, p *AddRegionParams) (*AddRegionResponse, error)
+	NewRemoveRegionParams(id int) *RemoveRegionParams
+	RemoveRegion(p *RemoveRegionParams) (*RemoveRegionResponse, error)
+	RemoveRegionWithContext(ctx context.Context, p *RemoveRegionParams) (*RemoveRegionResponse, error)
+	NewUpdateRegionParams(id int) *UpdateRegionParams
+	UpdateRegion(p *UpdateRegionParams) (*UpdateRegionResponse, error)
+	UpdateRegionWithContext(ctx context.Context, p *UpdateRegionParams) (*UpdateRegionResponse, error)
+	NewListRegionsParams() *ListRegionsParams
+	ListRegions(p *ListRegionsParams) (*ListRegionsResponse, error)
+	ListRegionsWithContext(ctx context.Context, p *ListRegionsParams) (*ListRegionsResponse, error)
+	NewListRegionsIterator(p *ListRegionsParams, pagesize int) *ListRegionsIterator
+}
+
 type AddRegionParams struct {
 	p map[string]interface{}
 }
 
+func (p *AddRegionParams) command() string {
+	return "addRegion"
+}
+
 func (p *AddRegionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -78,7 +100,13 @@ func (s *RegionService) NewAddRegionParams(endpoint string, id int, name string)
 
 // Adds a Region
 func (s *RegionService) AddRegion(p *AddRegionParams) (*AddRegionResponse, error) {
-	resp, err := s.cs.newRequest("addRegion", p.toURLValues())
+	return s.AddRegionWithContext(context.Background(), p)
+}
+
+// AddRegionWithContext is the same as AddRegion, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *RegionService) AddRegionWithContext(ctx context.Context, p *AddRegionParams) (*AddRegionResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "addRegion", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -100,6 +128,10 @@ type RemoveRegionParams struct {
 	p map[string]interface{}
 }
 
+func (p *RemoveRegionParams) command() string {
+	return "removeRegion"
+}
+
 func (p *RemoveRegionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -130,7 +162,13 @@ func (s *RegionService) NewRemoveRegionParams(id int) *RemoveRegionParams {
 
 // Removes specified region
 func (s *RegionService) RemoveRegion(p *RemoveRegionParams) (*RemoveRegionResponse, error) {
-	resp, err := s.cs.newRequest("removeRegion", p.toURLValues())
+	return s.RemoveRegionWithContext(context.Background(), p)
+}
+
+// RemoveRegionWithContext is the same as RemoveRegion, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *RegionService) RemoveRegionWithContext(ctx context.Context, p *RemoveRegionParams) (*RemoveRegionResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "removeRegion", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -151,6 +189,10 @@ type UpdateRegionParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateRegionParams) command() string {
+	return "updateRegion"
+}
+
 func (p *UpdateRegionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -201,7 +243,13 @@ func (s *RegionService) NewUpdateRegionParams(id int) *UpdateRegionParams {
 
 // Updates a region
 func (s *RegionService) UpdateRegion(p *UpdateRegionParams) (*UpdateRegionResponse, error) {
-	resp, err := s.cs.newRequest("updateRegion", p.toURLValues())
+	return s.UpdateRegionWithContext(context.Background(), p)
+}
+
+// UpdateRegionWithContext is the same as UpdateRegion, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *RegionService) UpdateRegionWithContext(ctx context.Context, p *UpdateRegionParams) (*UpdateRegionResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateRegion", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -223,6 +271,10 @@ type ListRegionsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListRegionsParams) command() string {
+	return "listRegions"
+}
+
 func (p *ListRegionsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -294,10 +346,17 @@ func (s *RegionService) NewListRegionsParams() *ListRegionsParams {
 
 // Lists Regions
 func (s *RegionService) ListRegions(p *ListRegionsParams) (*ListRegionsResponse, error) {
+	return s.ListRegionsWithContext(context.Background(), p)
+}
+
+// ListRegionsWithContext is the same as ListRegions, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *RegionService) ListRegionsWithContext(ctx context.Context, p *ListRegionsParams) (*ListRegionsResponse, error) {
 	var r ListRegionsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListRegionsResponse
-		resp, err := s.cs.newRequest("listRegions", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listRegions", values)
 		if err != nil {
 			return nil, err
 		}
@@ -309,13 +368,89 @@ func (s *RegionService) ListRegions(p *ListRegionsParams) (*ListRegionsResponse,
 		r.Count = l.Count
 		r.Regions = append(r.Regions, l.Regions...)
 
-		if r.Count == len(r.Regions) {
+		if r.Count == len(r.Regions) || len(l.Regions) == 0 {
+			s.cs.observePages("listRegions", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.Regions))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.Regions)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listRegions", values, len(l.Regions), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListRegionsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.Regions = append(r.Regions, l.Regions...)
+			}
+
+			if r.Count == len(r.Regions) {
+				s.cs.observePages("listRegions", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListRegionsIterator iterates over the items returned by listRegions, requesting
+// the pages of the response one at a time while iterating.
+type ListRegionsIterator struct {
+	pager
+	items []*Region
+	index int
+}
+
+// NewListRegionsIterator returns an iterator over the items returned by listRegions, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *RegionService) NewListRegionsIterator(p *ListRegionsParams, pagesize int) *ListRegionsIterator {
+	return &ListRegionsIterator{pager: newPager(s.cs, "listRegions", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListRegionsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListRegionsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.Regions))
+
+	i.items = l.Regions
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListRegionsIterator) Value() *Region {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListRegionsResponse struct {
@@ -0,0 +1,111 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockRegionService is a mock of RegionServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockRegionService struct {
+	mockCalls
+
+	AddRegionFunc    func(ctx context.Context, p *AddRegionParams) (*AddRegionResponse, error)
+	RemoveRegionFunc func(ctx context.Context, p *RemoveRegionParams) (*RemoveRegionResponse, error)
+	UpdateRegionFunc func(ctx context.Context, p *UpdateRegionParams) (*UpdateRegionResponse, error)
+	ListRegionsFunc  func(ctx context.Context, p *ListRegionsParams) (*ListRegionsResponse, error)
+}
+
+var _ RegionServiceIface = &MockRegionService{}
+
+func (m *MockRegionService) NewAddRegionParams(endpoint string, id int, name string) *AddRegionParams {
+	return (&RegionService{}).NewAddRegionParams(endpoint, id, name)
+}
+
+func (m *MockRegionService) AddRegion(p *AddRegionParams) (*AddRegionResponse, error) {
+	return m.AddRegionWithContext(context.Background(), p)
+}
+
+func (m *MockRegionService) AddRegionWithContext(ctx context.Context, p *AddRegionParams) (*AddRegionResponse, error) {
+	m.record("AddRegion", p)
+	if m.AddRegionFunc == nil {
+		return nil, notMocked("MockRegionService", "AddRegion")
+	}
+	return m.AddRegionFunc(ctx, p)
+}
+
+func (m *MockRegionService) NewRemoveRegionParams(id int) *RemoveRegionParams {
+	return (&RegionService{}).NewRemoveRegionParams(id)
+}
+
+func (m *MockRegionService) RemoveRegion(p *RemoveRegionParams) (*RemoveRegionResponse, error) {
+	return m.RemoveRegionWithContext(context.Background(), p)
+}
+
+func (m *MockRegionService) RemoveRegionWithContext(ctx context.Context, p *RemoveRegionParams) (*RemoveRegionResponse, error) {
+	m.record("RemoveRegion", p)
+	if m.RemoveRegionFunc == nil {
+		return nil, notMocked("MockRegionService", "RemoveRegion")
+	}
+	return m.RemoveRegionFunc(ctx, p)
+}
+
+func (m *MockRegionService) NewUpdateRegionParams(id int) *UpdateRegionParams {
+	return (&RegionService{}).NewUpdateRegionParams(id)
+}
+
+func (m *MockRegionService) UpdateRegion(p *UpdateRegionParams) (*UpdateRegionResponse, error) {
+	return m.UpdateRegionWithContext(context.Background(), p)
+}
+
+func (m *MockRegionService) UpdateRegionWithContext(ctx context.Context, p *UpdateRegionParams) (*UpdateRegionResponse, error) {
+	m.record("UpdateRegion", p)
+	if m.UpdateRegionFunc == nil {
+		return nil, notMocked("MockRegionService", "UpdateRegion")
+	}
+	return m.UpdateRegionFunc(ctx, p)
+}
+
+func (m *MockRegionService) NewListRegionsParams() *ListRegionsParams {
+	return (&RegionService{}).NewListRegionsParams()
+}
+
+func (m *MockRegionService) ListRegions(p *ListRegionsParams) (*ListRegionsResponse, error) {
+	return m.ListRegionsWithContext(context.Background(), p)
+}
+
+func (m *MockRegionService) ListRegionsWithContext(ctx context.Context, p *ListRegionsParams) (*ListRegionsResponse, error) {
+	m.record("ListRegions", p)
+	if m.ListRegionsFunc == nil {
+		return nil, notMocked("MockRegionService", "ListRegions")
+	}
+	return m.ListRegionsFunc(ctx, p)
+}
+
+func (m *MockRegionService) NewListRegionsIterator(p *ListRegionsParams, pagesize int) *ListRegionsIterator {
+	i := &ListRegionsIterator{pager: newPager(nil, "listRegions", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListRegionsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListRegionsWithContext(ctx, c)
+	}
+	return i
+}
@@ -0,0 +1,90 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestAddRegionParams(t *testing.T) {
+	p := &AddRegionParams{}
+	want := url.Values{}
+	p.SetEndpoint("endpoint-value")
+	want.Set("endpoint", "endpoint-value")
+	p.SetId(42)
+	want.Set("id", "42")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+
+	assertParams(t, p, "addRegion", want)
+}
+
+func TestAddRegionResponse(t *testing.T) {
+	assertDecodes(t, `{"endpoint":"endpoint-value","id":42,"name":"name-value"}`, &AddRegionResponse{})
+}
+
+func TestRemoveRegionParams(t *testing.T) {
+	p := &RemoveRegionParams{}
+	want := url.Values{}
+	p.SetId(42)
+	want.Set("id", "42")
+
+	assertParams(t, p, "removeRegion", want)
+}
+
+func TestRemoveRegionResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &RemoveRegionResponse{})
+}
+
+func TestUpdateRegionParams(t *testing.T) {
+	p := &UpdateRegionParams{}
+	want := url.Values{}
+	p.SetEndpoint("endpoint-value")
+	want.Set("endpoint", "endpoint-value")
+	p.SetId(42)
+	want.Set("id", "42")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+
+	assertParams(t, p, "updateRegion", want)
+}
+
+func TestUpdateRegionResponse(t *testing.T) {
+	assertDecodes(t, `{"endpoint":"endpoint-value","id":42,"name":"name-value"}`, &UpdateRegionResponse{})
+}
+
+func TestListRegionsParams(t *testing.T) {
+	p := &ListRegionsParams{}
+	want := url.Values{}
+	p.SetId(42)
+	want.Set("id", "42")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+
+	assertParams(t, p, "listRegions", want)
+}
+
+func TestListRegionsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"region":[{"endpoint":"endpoint-value","id":42,"name":"name-value"}]}`, &ListRegionsResponse{})
+}
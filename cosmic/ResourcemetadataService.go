@@ -17,16 +17,37 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 )
 
+// ResourcemetadataServiceIface is the interface implemented by ResourcemetadataService, and by MockResourcemetadataService for tests
+type ResourcemetadataServiceIface interface {
+	NewAddResourceDetailParams(details map[string]string, resourceid string, resourcetype string) *AddResourceDetailParams
+	AddResourceDetail(p *AddResourceDetailParams) (*AddResourceDetailResponse, error)
+	AddResourceDetailWithContext(ctx context.Context, p *AddResourceDetailParams) (*AddResourceDetailResponse, error)
+	AddResourceDetailAsync(ctx context.Context, p *AddResourceDetailParams) (*AddResourceDetailJob, error)
+	NewRemoveResourceDetailParams(resourceid string, resourcetype string) *RemoveResourceDetailParams
+	RemoveResourceDetail(p *RemoveResourceDetailParams) (*RemoveResourceDetailResponse, error)
+	RemoveResourceDetailWithContext(ctx context.Context, p *RemoveResourceDetailParams) (*RemoveResourceDetailResponse, error)
+	RemoveResourceDetailAsync(ctx context.Context, p *RemoveResourceDetailParams) (*RemoveResourceDetailJob, error)
+	NewListResourceDetailsParams(resourcetype string) *ListResourceDetailsParams
+	ListResourceDetails(p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error)
+	ListResourceDetailsWithContext(ctx context.Context, p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error)
+	NewListResourceDetailsIterator(p *ListResourceDetailsParams, pagesize int) *ListResourceDetailsIterator
+}
+
 type AddResourceDetailParams struct {
 	p map[string]interface{}
 }
 
+func (p *AddResourceDetailParams) command() string {
+	return "addResourceDetail"
+}
+
 func (p *AddResourceDetailParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -94,7 +115,13 @@ func (s *ResourcemetadataService) NewAddResourceDetailParams(details map[string]
 
 // Adds detail for the Resource.
 func (s *ResourcemetadataService) AddResourceDetail(p *AddResourceDetailParams) (*AddResourceDetailResponse, error) {
-	resp, err := s.cs.newRequest("addResourceDetail", p.toURLValues())
+	return s.AddResourceDetailWithContext(context.Background(), p)
+}
+
+// AddResourceDetailWithContext is the same as AddResourceDetail, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ResourcemetadataService) AddResourceDetailWithContext(ctx context.Context, p *AddResourceDetailParams) (*AddResourceDetailResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "addResourceDetail", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -106,9 +133,9 @@ func (s *ResourcemetadataService) AddResourceDetail(p *AddResourceDetailParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "addResourceDetail", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -121,6 +148,41 @@ func (s *ResourcemetadataService) AddResourceDetail(p *AddResourceDetailParams)
 	return &r, nil
 }
 
+// AddResourceDetailJob is a handle to a running addResourceDetail async job
+type AddResourceDetailJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a AddResourceDetailResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *AddResourceDetailJob) Result() (*AddResourceDetailResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := AddResourceDetailResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// AddResourceDetailAsync starts the addResourceDetail async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *ResourcemetadataService) AddResourceDetailAsync(ctx context.Context, p *AddResourceDetailParams) (*AddResourceDetailJob, error) {
+	resp, err := s.cs.newRequest(ctx, "addResourceDetail", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddResourceDetailResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &AddResourceDetailJob{s.cs.newJobHandle("addResourceDetail", r.JobID)}, nil
+}
+
 type AddResourceDetailResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -131,6 +193,10 @@ type RemoveResourceDetailParams struct {
 	p map[string]interface{}
 }
 
+func (p *RemoveResourceDetailParams) command() string {
+	return "removeResourceDetail"
+}
+
 func (p *RemoveResourceDetailParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -181,7 +247,13 @@ func (s *ResourcemetadataService) NewRemoveResourceDetailParams(resourceid strin
 
 // Removes detail for the Resource.
 func (s *ResourcemetadataService) RemoveResourceDetail(p *RemoveResourceDetailParams) (*RemoveResourceDetailResponse, error) {
-	resp, err := s.cs.newRequest("removeResourceDetail", p.toURLValues())
+	return s.RemoveResourceDetailWithContext(context.Background(), p)
+}
+
+// RemoveResourceDetailWithContext is the same as RemoveResourceDetail, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ResourcemetadataService) RemoveResourceDetailWithContext(ctx context.Context, p *RemoveResourceDetailParams) (*RemoveResourceDetailResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "removeResourceDetail", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -193,9 +265,9 @@ func (s *ResourcemetadataService) RemoveResourceDetail(p *RemoveResourceDetailPa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "removeResourceDetail", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -208,6 +280,41 @@ func (s *ResourcemetadataService) RemoveResourceDetail(p *RemoveResourceDetailPa
 	return &r, nil
 }
 
+// RemoveResourceDetailJob is a handle to a running removeResourceDetail async job
+type RemoveResourceDetailJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a RemoveResourceDetailResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *RemoveResourceDetailJob) Result() (*RemoveResourceDetailResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := RemoveResourceDetailResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// RemoveResourceDetailAsync starts the removeResourceDetail async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *ResourcemetadataService) RemoveResourceDetailAsync(ctx context.Context, p *RemoveResourceDetailParams) (*RemoveResourceDetailJob, error) {
+	resp, err := s.cs.newRequest(ctx, "removeResourceDetail", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RemoveResourceDetailResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &RemoveResourceDetailJob{s.cs.newJobHandle("removeResourceDetail", r.JobID)}, nil
+}
+
 type RemoveResourceDetailResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -218,6 +325,10 @@ type ListResourceDetailsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListResourceDetailsParams) command() string {
+	return "listResourceDetails"
+}
+
 func (p *ListResourceDetailsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -372,10 +483,17 @@ func (s *ResourcemetadataService) NewListResourceDetailsParams(resourcetype stri
 
 // List resource detail(s)
 func (s *ResourcemetadataService) ListResourceDetails(p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error) {
+	return s.ListResourceDetailsWithContext(context.Background(), p)
+}
+
+// ListResourceDetailsWithContext is the same as ListResourceDetails, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ResourcemetadataService) ListResourceDetailsWithContext(ctx context.Context, p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error) {
 	var r ListResourceDetailsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListResourceDetailsResponse
-		resp, err := s.cs.newRequest("listResourceDetails", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listResourceDetails", values)
 		if err != nil {
 			return nil, err
 		}
@@ -387,13 +505,89 @@ func (s *ResourcemetadataService) ListResourceDetails(p *ListResourceDetailsPara
 		r.Count = l.Count
 		r.ResourceDetails = append(r.ResourceDetails, l.ResourceDetails...)
 
-		if r.Count == len(r.ResourceDetails) {
+		if r.Count == len(r.ResourceDetails) || len(l.ResourceDetails) == 0 {
+			s.cs.observePages("listResourceDetails", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.ResourceDetails))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.ResourceDetails)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listResourceDetails", values, len(l.ResourceDetails), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListResourceDetailsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.ResourceDetails = append(r.ResourceDetails, l.ResourceDetails...)
+			}
+
+			if r.Count == len(r.ResourceDetails) {
+				s.cs.observePages("listResourceDetails", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListResourceDetailsIterator iterates over the items returned by listResourceDetails, requesting
+// the pages of the response one at a time while iterating.
+type ListResourceDetailsIterator struct {
+	pager
+	items []*ResourceDetail
+	index int
+}
+
+// NewListResourceDetailsIterator returns an iterator over the items returned by listResourceDetails, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *ResourcemetadataService) NewListResourceDetailsIterator(p *ListResourceDetailsParams, pagesize int) *ListResourceDetailsIterator {
+	return &ListResourceDetailsIterator{pager: newPager(s.cs, "listResourceDetails", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListResourceDetailsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListResourceDetailsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.ResourceDetails))
+
+	i.items = l.ResourceDetails
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListResourceDetailsIterator) Value() *ResourceDetail {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListResourceDetailsResponse struct {
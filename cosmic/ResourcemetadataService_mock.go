@@ -0,0 +1,112 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockResourcemetadataService is a mock of ResourcemetadataServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockResourcemetadataService struct {
+	mockCalls
+
+	AddResourceDetailFunc         func(ctx context.Context, p *AddResourceDetailParams) (*AddResourceDetailResponse, error)
+	AddResourceDetailAsyncFunc    func(ctx context.Context, p *AddResourceDetailParams) (*AddResourceDetailJob, error)
+	RemoveResourceDetailFunc      func(ctx context.Context, p *RemoveResourceDetailParams) (*RemoveResourceDetailResponse, error)
+	RemoveResourceDetailAsyncFunc func(ctx context.Context, p *RemoveResourceDetailParams) (*RemoveResourceDetailJob, error)
+	ListResourceDetailsFunc       func(ctx context.Context, p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error)
+}
+
+var _ ResourcemetadataServiceIface = &MockResourcemetadataService{}
+
+func (m *MockResourcemetadataService) NewAddResourceDetailParams(details map[string]string, resourceid string, resourcetype string) *AddResourceDetailParams {
+	return (&ResourcemetadataService{}).NewAddResourceDetailParams(details, resourceid, resourcetype)
+}
+
+func (m *MockResourcemetadataService) AddResourceDetail(p *AddResourceDetailParams) (*AddResourceDetailResponse, error) {
+	return m.AddResourceDetailWithContext(context.Background(), p)
+}
+
+func (m *MockResourcemetadataService) AddResourceDetailWithContext(ctx context.Context, p *AddResourceDetailParams) (*AddResourceDetailResponse, error) {
+	m.record("AddResourceDetail", p)
+	if m.AddResourceDetailFunc == nil {
+		return nil, notMocked("MockResourcemetadataService", "AddResourceDetail")
+	}
+	return m.AddResourceDetailFunc(ctx, p)
+}
+
+func (m *MockResourcemetadataService) AddResourceDetailAsync(ctx context.Context, p *AddResourceDetailParams) (*AddResourceDetailJob, error) {
+	m.record("AddResourceDetailAsync", p)
+	if m.AddResourceDetailAsyncFunc == nil {
+		return nil, notMocked("MockResourcemetadataService", "AddResourceDetailAsync")
+	}
+	return m.AddResourceDetailAsyncFunc(ctx, p)
+}
+
+func (m *MockResourcemetadataService) NewRemoveResourceDetailParams(resourceid string, resourcetype string) *RemoveResourceDetailParams {
+	return (&ResourcemetadataService{}).NewRemoveResourceDetailParams(resourceid, resourcetype)
+}
+
+func (m *MockResourcemetadataService) RemoveResourceDetail(p *RemoveResourceDetailParams) (*RemoveResourceDetailResponse, error) {
+	return m.RemoveResourceDetailWithContext(context.Background(), p)
+}
+
+func (m *MockResourcemetadataService) RemoveResourceDetailWithContext(ctx context.Context, p *RemoveResourceDetailParams) (*RemoveResourceDetailResponse, error) {
+	m.record("RemoveResourceDetail", p)
+	if m.RemoveResourceDetailFunc == nil {
+		return nil, notMocked("MockResourcemetadataService", "RemoveResourceDetail")
+	}
+	return m.RemoveResourceDetailFunc(ctx, p)
+}
+
+func (m *MockResourcemetadataService) RemoveResourceDetailAsync(ctx context.Context, p *RemoveResourceDetailParams) (*RemoveResourceDetailJob, error) {
+	m.record("RemoveResourceDetailAsync", p)
+	if m.RemoveResourceDetailAsyncFunc == nil {
+		return nil, notMocked("MockResourcemetadataService", "RemoveResourceDetailAsync")
+	}
+	return m.RemoveResourceDetailAsyncFunc(ctx, p)
+}
+
+func (m *MockResourcemetadataService) NewListResourceDetailsParams(resourcetype string) *ListResourceDetailsParams {
+	return (&ResourcemetadataService{}).NewListResourceDetailsParams(resourcetype)
+}
+
+func (m *MockResourcemetadataService) ListResourceDetails(p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error) {
+	return m.ListResourceDetailsWithContext(context.Background(), p)
+}
+
+func (m *MockResourcemetadataService) ListResourceDetailsWithContext(ctx context.Context, p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error) {
+	m.record("ListResourceDetails", p)
+	if m.ListResourceDetailsFunc == nil {
+		return nil, notMocked("MockResourcemetadataService", "ListResourceDetails")
+	}
+	return m.ListResourceDetailsFunc(ctx, p)
+}
+
+func (m *MockResourcemetadataService) NewListResourceDetailsIterator(p *ListResourceDetailsParams, pagesize int) *ListResourceDetailsIterator {
+	i := &ListResourceDetailsIterator{pager: newPager(nil, "listResourceDetails", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListResourceDetailsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListResourceDetailsWithContext(ctx, c)
+	}
+	return i
+}
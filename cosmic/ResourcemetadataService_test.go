@@ -0,0 +1,96 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestAddResourceDetailParams(t *testing.T) {
+	p := &AddResourceDetailParams{}
+	want := url.Values{}
+	p.SetDetails(map[string]string{"k": "v"})
+	want.Set("details[0].key", "k")
+	want.Set("details[0].value", "v")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetResourceid("resourceid-value")
+	want.Set("resourceid", "resourceid-value")
+	p.SetResourcetype("resourcetype-value")
+	want.Set("resourcetype", "resourcetype-value")
+
+	assertParams(t, p, "addResourceDetail", want)
+}
+
+func TestAddResourceDetailResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &AddResourceDetailResponse{})
+}
+
+func TestRemoveResourceDetailParams(t *testing.T) {
+	p := &RemoveResourceDetailParams{}
+	want := url.Values{}
+	p.SetKey("key-value")
+	want.Set("key", "key-value")
+	p.SetResourceid("resourceid-value")
+	want.Set("resourceid", "resourceid-value")
+	p.SetResourcetype("resourcetype-value")
+	want.Set("resourcetype", "resourcetype-value")
+
+	assertParams(t, p, "removeResourceDetail", want)
+}
+
+func TestRemoveResourceDetailResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &RemoveResourceDetailResponse{})
+}
+
+func TestListResourceDetailsParams(t *testing.T) {
+	p := &ListResourceDetailsParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKey("key-value")
+	want.Set("key", "key-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetResourceid("resourceid-value")
+	want.Set("resourceid", "resourceid-value")
+	p.SetResourcetype("resourcetype-value")
+	want.Set("resourcetype", "resourcetype-value")
+	p.SetValue("value-value")
+	want.Set("value", "value-value")
+
+	assertParams(t, p, "listResourceDetails", want)
+}
+
+func TestListResourceDetailsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"resourcedetail":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}]}`, &ListResourceDetailsResponse{})
+}
@@ -17,6 +17,7 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -24,10 +25,35 @@ import (
 	"strings"
 )
 
+// ResourcetagsServiceIface is the interface implemented by ResourcetagsService, and by MockResourcetagsService for tests
+type ResourcetagsServiceIface interface {
+	NewListStorageTagsParams() *ListStorageTagsParams
+	GetStorageTagID(keyword string, opts ...OptionFunc) (string, int, error)
+	ListStorageTags(p *ListStorageTagsParams) (*ListStorageTagsResponse, error)
+	ListStorageTagsWithContext(ctx context.Context, p *ListStorageTagsParams) (*ListStorageTagsResponse, error)
+	NewListStorageTagsIterator(p *ListStorageTagsParams, pagesize int) *ListStorageTagsIterator
+	NewCreateTagsParams(resourceids []string, resourcetype string, tags map[string]string) *CreateTagsParams
+	CreateTags(p *CreateTagsParams) (*CreateTagsResponse, error)
+	CreateTagsWithContext(ctx context.Context, p *CreateTagsParams) (*CreateTagsResponse, error)
+	CreateTagsAsync(ctx context.Context, p *CreateTagsParams) (*CreateTagsJob, error)
+	NewDeleteTagsParams(resourceids []string, resourcetype string) *DeleteTagsParams
+	DeleteTags(p *DeleteTagsParams) (*DeleteTagsResponse, error)
+	DeleteTagsWithContext(ctx context.Context, p *DeleteTagsParams) (*DeleteTagsResponse, error)
+	DeleteTagsAsync(ctx context.Context, p *DeleteTagsParams) (*DeleteTagsJob, error)
+	NewListTagsParams() *ListTagsParams
+	ListTags(p *ListTagsParams) (*ListTagsResponse, error)
+	ListTagsWithContext(ctx context.Context, p *ListTagsParams) (*ListTagsResponse, error)
+	NewListTagsIterator(p *ListTagsParams, pagesize int) *ListTagsIterator
+}
+
 type ListStorageTagsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListStorageTagsParams) command() string {
+	return "listStorageTags"
+}
+
 func (p *ListStorageTagsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -95,7 +121,7 @@ func (s *ResourcetagsService) GetStorageTagID(keyword string, opts ...OptionFunc
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -109,15 +135,22 @@ func (s *ResourcetagsService) GetStorageTagID(keyword string, opts ...OptionFunc
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // Lists storage tags
 func (s *ResourcetagsService) ListStorageTags(p *ListStorageTagsParams) (*ListStorageTagsResponse, error) {
+	return s.ListStorageTagsWithContext(context.Background(), p)
+}
+
+// ListStorageTagsWithContext is the same as ListStorageTags, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ResourcetagsService) ListStorageTagsWithContext(ctx context.Context, p *ListStorageTagsParams) (*ListStorageTagsResponse, error) {
 	var r ListStorageTagsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListStorageTagsResponse
-		resp, err := s.cs.newRequest("listStorageTags", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listStorageTags", values)
 		if err != nil {
 			return nil, err
 		}
@@ -129,13 +162,89 @@ func (s *ResourcetagsService) ListStorageTags(p *ListStorageTagsParams) (*ListSt
 		r.Count = l.Count
 		r.StorageTags = append(r.StorageTags, l.StorageTags...)
 
-		if r.Count == len(r.StorageTags) {
+		if r.Count == len(r.StorageTags) || len(l.StorageTags) == 0 {
+			s.cs.observePages("listStorageTags", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.StorageTags))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.StorageTags)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listStorageTags", values, len(l.StorageTags), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListStorageTagsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.StorageTags = append(r.StorageTags, l.StorageTags...)
+			}
+
+			if r.Count == len(r.StorageTags) {
+				s.cs.observePages("listStorageTags", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListStorageTagsIterator iterates over the items returned by listStorageTags, requesting
+// the pages of the response one at a time while iterating.
+type ListStorageTagsIterator struct {
+	pager
+	items []*StorageTag
+	index int
+}
+
+// NewListStorageTagsIterator returns an iterator over the items returned by listStorageTags, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *ResourcetagsService) NewListStorageTagsIterator(p *ListStorageTagsParams, pagesize int) *ListStorageTagsIterator {
+	return &ListStorageTagsIterator{pager: newPager(s.cs, "listStorageTags", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListStorageTagsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListStorageTagsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.StorageTags))
+
+	i.items = l.StorageTags
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListStorageTagsIterator) Value() *StorageTag {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListStorageTagsResponse struct {
@@ -153,6 +262,10 @@ type CreateTagsParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateTagsParams) command() string {
+	return "createTags"
+}
+
 func (p *CreateTagsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -220,7 +333,13 @@ func (s *ResourcetagsService) NewCreateTagsParams(resourceids []string, resource
 
 // Creates resource tag(s)
 func (s *ResourcetagsService) CreateTags(p *CreateTagsParams) (*CreateTagsResponse, error) {
-	resp, err := s.cs.newRequest("createTags", p.toURLValues())
+	return s.CreateTagsWithContext(context.Background(), p)
+}
+
+// CreateTagsWithContext is the same as CreateTags, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ResourcetagsService) CreateTagsWithContext(ctx context.Context, p *CreateTagsParams) (*CreateTagsResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createTags", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -232,9 +351,9 @@ func (s *ResourcetagsService) CreateTags(p *CreateTagsParams) (*CreateTagsRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createTags", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -247,6 +366,41 @@ func (s *ResourcetagsService) CreateTags(p *CreateTagsParams) (*CreateTagsRespon
 	return &r, nil
 }
 
+// CreateTagsJob is a handle to a running createTags async job
+type CreateTagsJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateTagsResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateTagsJob) Result() (*CreateTagsResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateTagsResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// CreateTagsAsync starts the createTags async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *ResourcetagsService) CreateTagsAsync(ctx context.Context, p *CreateTagsParams) (*CreateTagsJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createTags", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateTagsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateTagsJob{s.cs.newJobHandle("createTags", r.JobID)}, nil
+}
+
 type CreateTagsResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -257,6 +411,10 @@ type DeleteTagsParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteTagsParams) command() string {
+	return "deleteTags"
+}
+
 func (p *DeleteTagsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -313,7 +471,13 @@ func (s *ResourcetagsService) NewDeleteTagsParams(resourceids []string, resource
 
 // Deleting resource tag(s)
 func (s *ResourcetagsService) DeleteTags(p *DeleteTagsParams) (*DeleteTagsResponse, error) {
-	resp, err := s.cs.newRequest("deleteTags", p.toURLValues())
+	return s.DeleteTagsWithContext(context.Background(), p)
+}
+
+// DeleteTagsWithContext is the same as DeleteTags, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ResourcetagsService) DeleteTagsWithContext(ctx context.Context, p *DeleteTagsParams) (*DeleteTagsResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteTags", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -325,9 +489,9 @@ func (s *ResourcetagsService) DeleteTags(p *DeleteTagsParams) (*DeleteTagsRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteTags", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -340,6 +504,41 @@ func (s *ResourcetagsService) DeleteTags(p *DeleteTagsParams) (*DeleteTagsRespon
 	return &r, nil
 }
 
+// DeleteTagsJob is a handle to a running deleteTags async job
+type DeleteTagsJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteTagsResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteTagsJob) Result() (*DeleteTagsResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteTagsResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteTagsAsync starts the deleteTags async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *ResourcetagsService) DeleteTagsAsync(ctx context.Context, p *DeleteTagsParams) (*DeleteTagsJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteTags", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteTagsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteTagsJob{s.cs.newJobHandle("deleteTags", r.JobID)}, nil
+}
+
 type DeleteTagsResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -350,6 +549,10 @@ type ListTagsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListTagsParams) command() string {
+	return "listTags"
+}
+
 func (p *ListTagsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -502,10 +705,17 @@ func (s *ResourcetagsService) NewListTagsParams() *ListTagsParams {
 
 // List resource tag(s)
 func (s *ResourcetagsService) ListTags(p *ListTagsParams) (*ListTagsResponse, error) {
+	return s.ListTagsWithContext(context.Background(), p)
+}
+
+// ListTagsWithContext is the same as ListTags, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ResourcetagsService) ListTagsWithContext(ctx context.Context, p *ListTagsParams) (*ListTagsResponse, error) {
 	var r ListTagsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListTagsResponse
-		resp, err := s.cs.newRequest("listTags", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listTags", values)
 		if err != nil {
 			return nil, err
 		}
@@ -517,13 +727,89 @@ func (s *ResourcetagsService) ListTags(p *ListTagsParams) (*ListTagsResponse, er
 		r.Count = l.Count
 		r.Tags = append(r.Tags, l.Tags...)
 
-		if r.Count == len(r.Tags) {
+		if r.Count == len(r.Tags) || len(l.Tags) == 0 {
+			s.cs.observePages("listTags", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.Tags))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.Tags)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listTags", values, len(l.Tags), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListTagsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.Tags = append(r.Tags, l.Tags...)
+			}
+
+			if r.Count == len(r.Tags) {
+				s.cs.observePages("listTags", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListTagsIterator iterates over the items returned by listTags, requesting
+// the pages of the response one at a time while iterating.
+type ListTagsIterator struct {
+	pager
+	items []*Tag
+	index int
+}
+
+// NewListTagsIterator returns an iterator over the items returned by listTags, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *ResourcetagsService) NewListTagsIterator(p *ListTagsParams, pagesize int) *ListTagsIterator {
+	return &ListTagsIterator{pager: newPager(s.cs, "listTags", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListTagsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListTagsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.Tags))
+
+	i.items = l.Tags
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListTagsIterator) Value() *Tag {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListTagsResponse struct {
@@ -0,0 +1,152 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockResourcetagsService is a mock of ResourcetagsServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockResourcetagsService struct {
+	mockCalls
+
+	GetStorageTagIDFunc func(keyword string, opts ...OptionFunc) (string, int, error)
+	ListStorageTagsFunc func(ctx context.Context, p *ListStorageTagsParams) (*ListStorageTagsResponse, error)
+	CreateTagsFunc      func(ctx context.Context, p *CreateTagsParams) (*CreateTagsResponse, error)
+	CreateTagsAsyncFunc func(ctx context.Context, p *CreateTagsParams) (*CreateTagsJob, error)
+	DeleteTagsFunc      func(ctx context.Context, p *DeleteTagsParams) (*DeleteTagsResponse, error)
+	DeleteTagsAsyncFunc func(ctx context.Context, p *DeleteTagsParams) (*DeleteTagsJob, error)
+	ListTagsFunc        func(ctx context.Context, p *ListTagsParams) (*ListTagsResponse, error)
+}
+
+var _ ResourcetagsServiceIface = &MockResourcetagsService{}
+
+func (m *MockResourcetagsService) NewListStorageTagsParams() *ListStorageTagsParams {
+	return (&ResourcetagsService{}).NewListStorageTagsParams()
+}
+
+func (m *MockResourcetagsService) GetStorageTagID(keyword string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetStorageTagID", keyword, opts)
+	if m.GetStorageTagIDFunc == nil {
+		return "", -1, notMocked("MockResourcetagsService", "GetStorageTagID")
+	}
+	return m.GetStorageTagIDFunc(keyword, opts...)
+}
+
+func (m *MockResourcetagsService) ListStorageTags(p *ListStorageTagsParams) (*ListStorageTagsResponse, error) {
+	return m.ListStorageTagsWithContext(context.Background(), p)
+}
+
+func (m *MockResourcetagsService) ListStorageTagsWithContext(ctx context.Context, p *ListStorageTagsParams) (*ListStorageTagsResponse, error) {
+	m.record("ListStorageTags", p)
+	if m.ListStorageTagsFunc == nil {
+		return nil, notMocked("MockResourcetagsService", "ListStorageTags")
+	}
+	return m.ListStorageTagsFunc(ctx, p)
+}
+
+func (m *MockResourcetagsService) NewListStorageTagsIterator(p *ListStorageTagsParams, pagesize int) *ListStorageTagsIterator {
+	i := &ListStorageTagsIterator{pager: newPager(nil, "listStorageTags", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListStorageTagsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListStorageTagsWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockResourcetagsService) NewCreateTagsParams(resourceids []string, resourcetype string, tags map[string]string) *CreateTagsParams {
+	return (&ResourcetagsService{}).NewCreateTagsParams(resourceids, resourcetype, tags)
+}
+
+func (m *MockResourcetagsService) CreateTags(p *CreateTagsParams) (*CreateTagsResponse, error) {
+	return m.CreateTagsWithContext(context.Background(), p)
+}
+
+func (m *MockResourcetagsService) CreateTagsWithContext(ctx context.Context, p *CreateTagsParams) (*CreateTagsResponse, error) {
+	m.record("CreateTags", p)
+	if m.CreateTagsFunc == nil {
+		return nil, notMocked("MockResourcetagsService", "CreateTags")
+	}
+	return m.CreateTagsFunc(ctx, p)
+}
+
+func (m *MockResourcetagsService) CreateTagsAsync(ctx context.Context, p *CreateTagsParams) (*CreateTagsJob, error) {
+	m.record("CreateTagsAsync", p)
+	if m.CreateTagsAsyncFunc == nil {
+		return nil, notMocked("MockResourcetagsService", "CreateTagsAsync")
+	}
+	return m.CreateTagsAsyncFunc(ctx, p)
+}
+
+func (m *MockResourcetagsService) NewDeleteTagsParams(resourceids []string, resourcetype string) *DeleteTagsParams {
+	return (&ResourcetagsService{}).NewDeleteTagsParams(resourceids, resourcetype)
+}
+
+func (m *MockResourcetagsService) DeleteTags(p *DeleteTagsParams) (*DeleteTagsResponse, error) {
+	return m.DeleteTagsWithContext(context.Background(), p)
+}
+
+func (m *MockResourcetagsService) DeleteTagsWithContext(ctx context.Context, p *DeleteTagsParams) (*DeleteTagsResponse, error) {
+	m.record("DeleteTags", p)
+	if m.DeleteTagsFunc == nil {
+		return nil, notMocked("MockResourcetagsService", "DeleteTags")
+	}
+	return m.DeleteTagsFunc(ctx, p)
+}
+
+func (m *MockResourcetagsService) DeleteTagsAsync(ctx context.Context, p *DeleteTagsParams) (*DeleteTagsJob, error) {
+	m.record("DeleteTagsAsync", p)
+	if m.DeleteTagsAsyncFunc == nil {
+		return nil, notMocked("MockResourcetagsService", "DeleteTagsAsync")
+	}
+	return m.DeleteTagsAsyncFunc(ctx, p)
+}
+
+func (m *MockResourcetagsService) NewListTagsParams() *ListTagsParams {
+	return (&ResourcetagsService{}).NewListTagsParams()
+}
+
+func (m *MockResourcetagsService) ListTags(p *ListTagsParams) (*ListTagsResponse, error) {
+	return m.ListTagsWithContext(context.Background(), p)
+}
+
+func (m *MockResourcetagsService) ListTagsWithContext(ctx context.Context, p *ListTagsParams) (*ListTagsResponse, error) {
+	m.record("ListTags", p)
+	if m.ListTagsFunc == nil {
+		return nil, notMocked("MockResourcetagsService", "ListTags")
+	}
+	return m.ListTagsFunc(ctx, p)
+}
+
+func (m *MockResourcetagsService) NewListTagsIterator(p *ListTagsParams, pagesize int) *ListTagsIterator {
+	i := &ListTagsIterator{pager: newPager(nil, "listTags", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListTagsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListTagsWithContext(ctx, c)
+	}
+	return i
+}
@@ -0,0 +1,114 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestListStorageTagsParams(t *testing.T) {
+	p := &ListStorageTagsParams{}
+	want := url.Values{}
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+
+	assertParams(t, p, "listStorageTags", want)
+}
+
+func TestListStorageTagsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"storagetag":[{"id":"id-value","name":"name-value","poolid":1099511627776}]}`, &ListStorageTagsResponse{})
+}
+
+func TestCreateTagsParams(t *testing.T) {
+	p := &CreateTagsParams{}
+	want := url.Values{}
+	p.SetCustomer("customer-value")
+	want.Set("customer", "customer-value")
+	p.SetResourceids([]string{"a", "b"})
+	want.Set("resourceids", "a,b")
+	p.SetResourcetype("resourcetype-value")
+	want.Set("resourcetype", "resourcetype-value")
+	p.SetTags(map[string]string{"k": "v"})
+	want.Set("tags[0].key", "k")
+	want.Set("tags[0].value", "v")
+
+	assertParams(t, p, "createTags", want)
+}
+
+func TestCreateTagsResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &CreateTagsResponse{})
+}
+
+func TestDeleteTagsParams(t *testing.T) {
+	p := &DeleteTagsParams{}
+	want := url.Values{}
+	p.SetResourceids([]string{"a", "b"})
+	want.Set("resourceids", "a,b")
+	p.SetResourcetype("resourcetype-value")
+	want.Set("resourcetype", "resourcetype-value")
+	p.SetTags(map[string]string{"k": "v"})
+	want.Set("tags[0].key", "k")
+	want.Set("tags[0].value", "v")
+
+	assertParams(t, p, "deleteTags", want)
+}
+
+func TestDeleteTagsResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteTagsResponse{})
+}
+
+func TestListTagsParams(t *testing.T) {
+	p := &ListTagsParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetCustomer("customer-value")
+	want.Set("customer", "customer-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKey("key-value")
+	want.Set("key", "key-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetResourceid("resourceid-value")
+	want.Set("resourceid", "resourceid-value")
+	p.SetResourcetype("resourcetype-value")
+	want.Set("resourcetype", "resourcetype-value")
+	p.SetValue("value-value")
+	want.Set("value", "value-value")
+
+	assertParams(t, p, "listTags", want)
+}
+
+func TestListTagsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"tag":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}]}`, &ListTagsResponse{})
+}
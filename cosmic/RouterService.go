@@ -17,17 +17,65 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
+// RouterServiceIface is the interface implemented by RouterService, and by MockRouterService for tests
+type RouterServiceIface interface {
+	NewDestroyRouterParams(id string) *DestroyRouterParams
+	DestroyRouter(p *DestroyRouterParams) (*DestroyRouterResponse, error)
+	DestroyRouterWithContext(ctx context.Context, p *DestroyRouterParams) (*DestroyRouterResponse, error)
+	DestroyRouterAsync(ctx context.Context, p *DestroyRouterParams) (*DestroyRouterJob, error)
+	NewRebootRouterParams(id string) *RebootRouterParams
+	RebootRouter(p *RebootRouterParams) (*RebootRouterResponse, error)
+	RebootRouterWithContext(ctx context.Context, p *RebootRouterParams) (*RebootRouterResponse, error)
+	RebootRouterAsync(ctx context.Context, p *RebootRouterParams) (*RebootRouterJob, error)
+	NewStartRouterParams(id string) *StartRouterParams
+	StartRouter(p *StartRouterParams) (*StartRouterResponse, error)
+	StartRouterWithContext(ctx context.Context, p *StartRouterParams) (*StartRouterResponse, error)
+	StartRouterAsync(ctx context.Context, p *StartRouterParams) (*StartRouterJob, error)
+	NewStopRouterParams(id string) *StopRouterParams
+	StopRouter(p *StopRouterParams) (*StopRouterResponse, error)
+	StopRouterWithContext(ctx context.Context, p *StopRouterParams) (*StopRouterResponse, error)
+	StopRouterAsync(ctx context.Context, p *StopRouterParams) (*StopRouterJob, error)
+	NewListRoutersParams() *ListRoutersParams
+	GetRouterID(name string, opts ...OptionFunc) (string, int, error)
+	GetRouterByName(name string, opts ...OptionFunc) (*Router, int, error)
+	GetRouterByID(id string, opts ...OptionFunc) (*Router, int, error)
+	ListRouters(p *ListRoutersParams) (*ListRoutersResponse, error)
+	ListRoutersWithContext(ctx context.Context, p *ListRoutersParams) (*ListRoutersResponse, error)
+	NewListRoutersIterator(p *ListRoutersParams, pagesize int) *ListRoutersIterator
+	NewChangeServiceForRouterParams(id string, serviceofferingid string) *ChangeServiceForRouterParams
+	ChangeServiceForRouter(p *ChangeServiceForRouterParams) (*ChangeServiceForRouterResponse, error)
+	ChangeServiceForRouterWithContext(ctx context.Context, p *ChangeServiceForRouterParams) (*ChangeServiceForRouterResponse, error)
+	NewConfigureVirtualRouterElementParams(enabled bool, id string) *ConfigureVirtualRouterElementParams
+	ConfigureVirtualRouterElement(p *ConfigureVirtualRouterElementParams) (*ConfigureVirtualRouterElementResponse, error)
+	ConfigureVirtualRouterElementWithContext(ctx context.Context, p *ConfigureVirtualRouterElementParams) (*ConfigureVirtualRouterElementResponse, error)
+	ConfigureVirtualRouterElementAsync(ctx context.Context, p *ConfigureVirtualRouterElementParams) (*ConfigureVirtualRouterElementJob, error)
+	NewCreateVirtualRouterElementParams(nspid string) *CreateVirtualRouterElementParams
+	CreateVirtualRouterElement(p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementResponse, error)
+	CreateVirtualRouterElementWithContext(ctx context.Context, p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementResponse, error)
+	CreateVirtualRouterElementAsync(ctx context.Context, p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementJob, error)
+	NewListVirtualRouterElementsParams() *ListVirtualRouterElementsParams
+	GetVirtualRouterElementByID(id string, opts ...OptionFunc) (*VirtualRouterElement, int, error)
+	ListVirtualRouterElements(p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error)
+	ListVirtualRouterElementsWithContext(ctx context.Context, p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error)
+	NewListVirtualRouterElementsIterator(p *ListVirtualRouterElementsParams, pagesize int) *ListVirtualRouterElementsIterator
+}
+
 type DestroyRouterParams struct {
 	p map[string]interface{}
 }
 
+func (p *DestroyRouterParams) command() string {
+	return "destroyRouter"
+}
+
 func (p *DestroyRouterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -57,7 +105,13 @@ func (s *RouterService) NewDestroyRouterParams(id string) *DestroyRouterParams {
 
 // Destroys a router.
 func (s *RouterService) DestroyRouter(p *DestroyRouterParams) (*DestroyRouterResponse, error) {
-	resp, err := s.cs.newRequest("destroyRouter", p.toURLValues())
+	return s.DestroyRouterWithContext(context.Background(), p)
+}
+
+// DestroyRouterWithContext is the same as DestroyRouter, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *RouterService) DestroyRouterWithContext(ctx context.Context, p *DestroyRouterParams) (*DestroyRouterResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "destroyRouter", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -69,26 +123,56 @@ func (s *RouterService) DestroyRouter(p *DestroyRouterParams) (*DestroyRouterRes
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "destroyRouter", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "router", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// DestroyRouterJob is a handle to a running destroyRouter async job
+type DestroyRouterJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DestroyRouterResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DestroyRouterJob) Result() (*DestroyRouterResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DestroyRouterResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "router", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// DestroyRouterAsync starts the destroyRouter async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *RouterService) DestroyRouterAsync(ctx context.Context, p *DestroyRouterParams) (*DestroyRouterJob, error) {
+	resp, err := s.cs.newRequest(ctx, "destroyRouter", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DestroyRouterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DestroyRouterJob{s.cs.newJobHandle("destroyRouter", r.JobID)}, nil
+}
+
 type DestroyRouterResponse struct {
 	JobID               string `json:"jobid,omitempty"`
 	Account             string `json:"account,omitempty"`
@@ -167,6 +251,10 @@ type RebootRouterParams struct {
 	p map[string]interface{}
 }
 
+func (p *RebootRouterParams) command() string {
+	return "rebootRouter"
+}
+
 func (p *RebootRouterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -196,7 +284,13 @@ func (s *RouterService) NewRebootRouterParams(id string) *RebootRouterParams {
 
 // Starts a router.
 func (s *RouterService) RebootRouter(p *RebootRouterParams) (*RebootRouterResponse, error) {
-	resp, err := s.cs.newRequest("rebootRouter", p.toURLValues())
+	return s.RebootRouterWithContext(context.Background(), p)
+}
+
+// RebootRouterWithContext is the same as RebootRouter, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *RouterService) RebootRouterWithContext(ctx context.Context, p *RebootRouterParams) (*RebootRouterResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "rebootRouter", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -208,26 +302,56 @@ func (s *RouterService) RebootRouter(p *RebootRouterParams) (*RebootRouterRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "rebootRouter", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "router", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// RebootRouterJob is a handle to a running rebootRouter async job
+type RebootRouterJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a RebootRouterResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *RebootRouterJob) Result() (*RebootRouterResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := RebootRouterResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "router", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// RebootRouterAsync starts the rebootRouter async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *RouterService) RebootRouterAsync(ctx context.Context, p *RebootRouterParams) (*RebootRouterJob, error) {
+	resp, err := s.cs.newRequest(ctx, "rebootRouter", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RebootRouterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &RebootRouterJob{s.cs.newJobHandle("rebootRouter", r.JobID)}, nil
+}
+
 type RebootRouterResponse struct {
 	JobID               string `json:"jobid,omitempty"`
 	Account             string `json:"account,omitempty"`
@@ -306,6 +430,10 @@ type StartRouterParams struct {
 	p map[string]interface{}
 }
 
+func (p *StartRouterParams) command() string {
+	return "startRouter"
+}
+
 func (p *StartRouterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -335,7 +463,13 @@ func (s *RouterService) NewStartRouterParams(id string) *StartRouterParams {
 
 // Starts a router.
 func (s *RouterService) StartRouter(p *StartRouterParams) (*StartRouterResponse, error) {
-	resp, err := s.cs.newRequest("startRouter", p.toURLValues())
+	return s.StartRouterWithContext(context.Background(), p)
+}
+
+// StartRouterWithContext is the same as StartRouter, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *RouterService) StartRouterWithContext(ctx context.Context, p *StartRouterParams) (*StartRouterResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "startRouter", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -347,26 +481,56 @@ func (s *RouterService) StartRouter(p *StartRouterParams) (*StartRouterResponse,
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "startRouter", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "router", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// StartRouterJob is a handle to a running startRouter async job
+type StartRouterJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a StartRouterResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *StartRouterJob) Result() (*StartRouterResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := StartRouterResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "router", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// StartRouterAsync starts the startRouter async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *RouterService) StartRouterAsync(ctx context.Context, p *StartRouterParams) (*StartRouterJob, error) {
+	resp, err := s.cs.newRequest(ctx, "startRouter", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r StartRouterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &StartRouterJob{s.cs.newJobHandle("startRouter", r.JobID)}, nil
+}
+
 type StartRouterResponse struct {
 	JobID               string `json:"jobid,omitempty"`
 	Account             string `json:"account,omitempty"`
@@ -445,6 +609,10 @@ type StopRouterParams struct {
 	p map[string]interface{}
 }
 
+func (p *StopRouterParams) command() string {
+	return "stopRouter"
+}
+
 func (p *StopRouterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -485,7 +653,13 @@ func (s *RouterService) NewStopRouterParams(id string) *StopRouterParams {
 
 // Stops a router.
 func (s *RouterService) StopRouter(p *StopRouterParams) (*StopRouterResponse, error) {
-	resp, err := s.cs.newRequest("stopRouter", p.toURLValues())
+	return s.StopRouterWithContext(context.Background(), p)
+}
+
+// StopRouterWithContext is the same as StopRouter, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *RouterService) StopRouterWithContext(ctx context.Context, p *StopRouterParams) (*StopRouterResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "stopRouter", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -497,26 +671,56 @@ func (s *RouterService) StopRouter(p *StopRouterParams) (*StopRouterResponse, er
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "stopRouter", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "router", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// StopRouterJob is a handle to a running stopRouter async job
+type StopRouterJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a StopRouterResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *StopRouterJob) Result() (*StopRouterResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := StopRouterResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "router", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// StopRouterAsync starts the stopRouter async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *RouterService) StopRouterAsync(ctx context.Context, p *StopRouterParams) (*StopRouterJob, error) {
+	resp, err := s.cs.newRequest(ctx, "stopRouter", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r StopRouterResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &StopRouterJob{s.cs.newJobHandle("stopRouter", r.JobID)}, nil
+}
+
 type StopRouterResponse struct {
 	JobID               string `json:"jobid,omitempty"`
 	Account             string `json:"account,omitempty"`
@@ -595,6 +799,10 @@ type ListRoutersParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListRoutersParams) command() string {
+	return "listRouters"
+}
+
 func (p *ListRoutersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -825,7 +1033,7 @@ func (s *RouterService) GetRouterID(name string, opts ...OptionFunc) (string, in
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -839,7 +1047,7 @@ func (s *RouterService) GetRouterID(name string, opts ...OptionFunc) (string, in
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -871,16 +1079,14 @@ func (s *RouterService) GetRouterByID(id string, opts ...OptionFunc) (*Router, i
 
 	l, err := s.ListRouters(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -892,10 +1098,17 @@ func (s *RouterService) GetRouterByID(id string, opts ...OptionFunc) (*Router, i
 
 // List routers.
 func (s *RouterService) ListRouters(p *ListRoutersParams) (*ListRoutersResponse, error) {
+	return s.ListRoutersWithContext(context.Background(), p)
+}
+
+// ListRoutersWithContext is the same as ListRouters, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *RouterService) ListRoutersWithContext(ctx context.Context, p *ListRoutersParams) (*ListRoutersResponse, error) {
 	var r ListRoutersResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListRoutersResponse
-		resp, err := s.cs.newRequest("listRouters", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listRouters", values)
 		if err != nil {
 			return nil, err
 		}
@@ -907,13 +1120,89 @@ func (s *RouterService) ListRouters(p *ListRoutersParams) (*ListRoutersResponse,
 		r.Count = l.Count
 		r.Routers = append(r.Routers, l.Routers...)
 
-		if r.Count == len(r.Routers) {
+		if r.Count == len(r.Routers) || len(l.Routers) == 0 {
+			s.cs.observePages("listRouters", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.Routers))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.Routers)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listRouters", values, len(l.Routers), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListRoutersResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.Routers = append(r.Routers, l.Routers...)
+			}
+
+			if r.Count == len(r.Routers) {
+				s.cs.observePages("listRouters", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListRoutersIterator iterates over the items returned by listRouters, requesting
+// the pages of the response one at a time while iterating.
+type ListRoutersIterator struct {
+	pager
+	items []*Router
+	index int
+}
+
+// NewListRoutersIterator returns an iterator over the items returned by listRouters, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *RouterService) NewListRoutersIterator(p *ListRoutersParams, pagesize int) *ListRoutersIterator {
+	return &ListRoutersIterator{pager: newPager(s.cs, "listRouters", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListRoutersIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListRoutersResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
 	}
+	i.received(l.Count, len(l.Routers))
+
+	i.items = l.Routers
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListRoutersIterator) Value() *Router {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
 }
 
 type ListRoutersResponse struct {
@@ -998,6 +1287,10 @@ type ChangeServiceForRouterParams struct {
 	p map[string]interface{}
 }
 
+func (p *ChangeServiceForRouterParams) command() string {
+	return "changeServiceForRouter"
+}
+
 func (p *ChangeServiceForRouterParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1038,7 +1331,13 @@ func (s *RouterService) NewChangeServiceForRouterParams(id string, serviceofferi
 
 // Upgrades domain router to a new service offering
 func (s *RouterService) ChangeServiceForRouter(p *ChangeServiceForRouterParams) (*ChangeServiceForRouterResponse, error) {
-	resp, err := s.cs.newRequest("changeServiceForRouter", p.toURLValues())
+	return s.ChangeServiceForRouterWithContext(context.Background(), p)
+}
+
+// ChangeServiceForRouterWithContext is the same as ChangeServiceForRouter, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *RouterService) ChangeServiceForRouterWithContext(ctx context.Context, p *ChangeServiceForRouterParams) (*ChangeServiceForRouterResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "changeServiceForRouter", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1127,6 +1426,10 @@ type ConfigureVirtualRouterElementParams struct {
 	p map[string]interface{}
 }
 
+func (p *ConfigureVirtualRouterElementParams) command() string {
+	return "configureVirtualRouterElement"
+}
+
 func (p *ConfigureVirtualRouterElementParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1168,7 +1471,13 @@ func (s *RouterService) NewConfigureVirtualRouterElementParams(enabled bool, id
 
 // Configures a virtual router element.
 func (s *RouterService) ConfigureVirtualRouterElement(p *ConfigureVirtualRouterElementParams) (*ConfigureVirtualRouterElementResponse, error) {
-	resp, err := s.cs.newRequest("configureVirtualRouterElement", p.toURLValues())
+	return s.ConfigureVirtualRouterElementWithContext(context.Background(), p)
+}
+
+// ConfigureVirtualRouterElementWithContext is the same as ConfigureVirtualRouterElement, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *RouterService) ConfigureVirtualRouterElementWithContext(ctx context.Context, p *ConfigureVirtualRouterElementParams) (*ConfigureVirtualRouterElementResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "configureVirtualRouterElement", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1180,26 +1489,56 @@ func (s *RouterService) ConfigureVirtualRouterElement(p *ConfigureVirtualRouterE
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "configureVirtualRouterElement", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "virtualrouterelement", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// ConfigureVirtualRouterElementJob is a handle to a running configureVirtualRouterElement async job
+type ConfigureVirtualRouterElementJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a ConfigureVirtualRouterElementResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *ConfigureVirtualRouterElementJob) Result() (*ConfigureVirtualRouterElementResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := ConfigureVirtualRouterElementResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "virtualrouterelement", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// ConfigureVirtualRouterElementAsync starts the configureVirtualRouterElement async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *RouterService) ConfigureVirtualRouterElementAsync(ctx context.Context, p *ConfigureVirtualRouterElementParams) (*ConfigureVirtualRouterElementJob, error) {
+	resp, err := s.cs.newRequest(ctx, "configureVirtualRouterElement", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ConfigureVirtualRouterElementResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &ConfigureVirtualRouterElementJob{s.cs.newJobHandle("configureVirtualRouterElement", r.JobID)}, nil
+}
+
 type ConfigureVirtualRouterElementResponse struct {
 	JobID     string `json:"jobid,omitempty"`
 	Account   string `json:"account,omitempty"`
@@ -1216,6 +1555,10 @@ type CreateVirtualRouterElementParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateVirtualRouterElementParams) command() string {
+	return "createVirtualRouterElement"
+}
+
 func (p *CreateVirtualRouterElementParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1255,7 +1598,13 @@ func (s *RouterService) NewCreateVirtualRouterElementParams(nspid string) *Creat
 
 // Create a virtual router element.
 func (s *RouterService) CreateVirtualRouterElement(p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementResponse, error) {
-	resp, err := s.cs.newRequest("createVirtualRouterElement", p.toURLValues())
+	return s.CreateVirtualRouterElementWithContext(context.Background(), p)
+}
+
+// CreateVirtualRouterElementWithContext is the same as CreateVirtualRouterElement, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *RouterService) CreateVirtualRouterElementWithContext(ctx context.Context, p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createVirtualRouterElement", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1267,26 +1616,56 @@ func (s *RouterService) CreateVirtualRouterElement(p *CreateVirtualRouterElement
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createVirtualRouterElement", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "virtualrouterelement", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CreateVirtualRouterElementJob is a handle to a running createVirtualRouterElement async job
+type CreateVirtualRouterElementJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateVirtualRouterElementResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateVirtualRouterElementJob) Result() (*CreateVirtualRouterElementResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateVirtualRouterElementResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "virtualrouterelement", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CreateVirtualRouterElementAsync starts the createVirtualRouterElement async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *RouterService) CreateVirtualRouterElementAsync(ctx context.Context, p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createVirtualRouterElement", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateVirtualRouterElementResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateVirtualRouterElementJob{s.cs.newJobHandle("createVirtualRouterElement", r.JobID)}, nil
+}
+
 type CreateVirtualRouterElementResponse struct {
 	JobID     string `json:"jobid,omitempty"`
 	Account   string `json:"account,omitempty"`
@@ -1303,6 +1682,10 @@ type ListVirtualRouterElementsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListVirtualRouterElementsParams) command() string {
+	return "listVirtualRouterElements"
+}
+
 func (p *ListVirtualRouterElementsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1397,16 +1780,14 @@ func (s *RouterService) GetVirtualRouterElementByID(id string, opts ...OptionFun
 
 	l, err := s.ListVirtualRouterElements(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -1418,10 +1799,17 @@ func (s *RouterService) GetVirtualRouterElementByID(id string, opts ...OptionFun
 
 // Lists all available virtual router elements.
 func (s *RouterService) ListVirtualRouterElements(p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error) {
+	return s.ListVirtualRouterElementsWithContext(context.Background(), p)
+}
+
+// ListVirtualRouterElementsWithContext is the same as ListVirtualRouterElements, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *RouterService) ListVirtualRouterElementsWithContext(ctx context.Context, p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error) {
 	var r ListVirtualRouterElementsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListVirtualRouterElementsResponse
-		resp, err := s.cs.newRequest("listVirtualRouterElements", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listVirtualRouterElements", values)
 		if err != nil {
 			return nil, err
 		}
@@ -1433,13 +1821,89 @@ func (s *RouterService) ListVirtualRouterElements(p *ListVirtualRouterElementsPa
 		r.Count = l.Count
 		r.VirtualRouterElements = append(r.VirtualRouterElements, l.VirtualRouterElements...)
 
-		if r.Count == len(r.VirtualRouterElements) {
+		if r.Count == len(r.VirtualRouterElements) || len(l.VirtualRouterElements) == 0 {
+			s.cs.observePages("listVirtualRouterElements", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.VirtualRouterElements))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.VirtualRouterElements)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listVirtualRouterElements", values, len(l.VirtualRouterElements), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListVirtualRouterElementsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.VirtualRouterElements = append(r.VirtualRouterElements, l.VirtualRouterElements...)
+			}
+
+			if r.Count == len(r.VirtualRouterElements) {
+				s.cs.observePages("listVirtualRouterElements", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListVirtualRouterElementsIterator iterates over the items returned by listVirtualRouterElements, requesting
+// the pages of the response one at a time while iterating.
+type ListVirtualRouterElementsIterator struct {
+	pager
+	items []*VirtualRouterElement
+	index int
+}
+
+// NewListVirtualRouterElementsIterator returns an iterator over the items returned by listVirtualRouterElements, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *RouterService) NewListVirtualRouterElementsIterator(p *ListVirtualRouterElementsParams, pagesize int) *ListVirtualRouterElementsIterator {
+	return &ListVirtualRouterElementsIterator{pager: newPager(s.cs, "listVirtualRouterElements", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListVirtualRouterElementsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListVirtualRouterElementsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.VirtualRouterElements))
+
+	i.items = l.VirtualRouterElements
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListVirtualRouterElementsIterator) Value() *VirtualRouterElement {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListVirtualRouterElementsResponse struct {
@@ -0,0 +1,300 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockRouterService is a mock of RouterServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockRouterService struct {
+	mockCalls
+
+	DestroyRouterFunc                      func(ctx context.Context, p *DestroyRouterParams) (*DestroyRouterResponse, error)
+	DestroyRouterAsyncFunc                 func(ctx context.Context, p *DestroyRouterParams) (*DestroyRouterJob, error)
+	RebootRouterFunc                       func(ctx context.Context, p *RebootRouterParams) (*RebootRouterResponse, error)
+	RebootRouterAsyncFunc                  func(ctx context.Context, p *RebootRouterParams) (*RebootRouterJob, error)
+	StartRouterFunc                        func(ctx context.Context, p *StartRouterParams) (*StartRouterResponse, error)
+	StartRouterAsyncFunc                   func(ctx context.Context, p *StartRouterParams) (*StartRouterJob, error)
+	StopRouterFunc                         func(ctx context.Context, p *StopRouterParams) (*StopRouterResponse, error)
+	StopRouterAsyncFunc                    func(ctx context.Context, p *StopRouterParams) (*StopRouterJob, error)
+	GetRouterIDFunc                        func(name string, opts ...OptionFunc) (string, int, error)
+	GetRouterByNameFunc                    func(name string, opts ...OptionFunc) (*Router, int, error)
+	GetRouterByIDFunc                      func(id string, opts ...OptionFunc) (*Router, int, error)
+	ListRoutersFunc                        func(ctx context.Context, p *ListRoutersParams) (*ListRoutersResponse, error)
+	ChangeServiceForRouterFunc             func(ctx context.Context, p *ChangeServiceForRouterParams) (*ChangeServiceForRouterResponse, error)
+	ConfigureVirtualRouterElementFunc      func(ctx context.Context, p *ConfigureVirtualRouterElementParams) (*ConfigureVirtualRouterElementResponse, error)
+	ConfigureVirtualRouterElementAsyncFunc func(ctx context.Context, p *ConfigureVirtualRouterElementParams) (*ConfigureVirtualRouterElementJob, error)
+	CreateVirtualRouterElementFunc         func(ctx context.Context, p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementResponse, error)
+	CreateVirtualRouterElementAsyncFunc    func(ctx context.Context, p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementJob, error)
+	GetVirtualRouterElementByIDFunc        func(id string, opts ...OptionFunc) (*VirtualRouterElement, int, error)
+	ListVirtualRouterElementsFunc          func(ctx context.Context, p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error)
+}
+
+var _ RouterServiceIface = &MockRouterService{}
+
+func (m *MockRouterService) NewDestroyRouterParams(id string) *DestroyRouterParams {
+	return (&RouterService{}).NewDestroyRouterParams(id)
+}
+
+func (m *MockRouterService) DestroyRouter(p *DestroyRouterParams) (*DestroyRouterResponse, error) {
+	return m.DestroyRouterWithContext(context.Background(), p)
+}
+
+func (m *MockRouterService) DestroyRouterWithContext(ctx context.Context, p *DestroyRouterParams) (*DestroyRouterResponse, error) {
+	m.record("DestroyRouter", p)
+	if m.DestroyRouterFunc == nil {
+		return nil, notMocked("MockRouterService", "DestroyRouter")
+	}
+	return m.DestroyRouterFunc(ctx, p)
+}
+
+func (m *MockRouterService) DestroyRouterAsync(ctx context.Context, p *DestroyRouterParams) (*DestroyRouterJob, error) {
+	m.record("DestroyRouterAsync", p)
+	if m.DestroyRouterAsyncFunc == nil {
+		return nil, notMocked("MockRouterService", "DestroyRouterAsync")
+	}
+	return m.DestroyRouterAsyncFunc(ctx, p)
+}
+
+func (m *MockRouterService) NewRebootRouterParams(id string) *RebootRouterParams {
+	return (&RouterService{}).NewRebootRouterParams(id)
+}
+
+func (m *MockRouterService) RebootRouter(p *RebootRouterParams) (*RebootRouterResponse, error) {
+	return m.RebootRouterWithContext(context.Background(), p)
+}
+
+func (m *MockRouterService) RebootRouterWithContext(ctx context.Context, p *RebootRouterParams) (*RebootRouterResponse, error) {
+	m.record("RebootRouter", p)
+	if m.RebootRouterFunc == nil {
+		return nil, notMocked("MockRouterService", "RebootRouter")
+	}
+	return m.RebootRouterFunc(ctx, p)
+}
+
+func (m *MockRouterService) RebootRouterAsync(ctx context.Context, p *RebootRouterParams) (*RebootRouterJob, error) {
+	m.record("RebootRouterAsync", p)
+	if m.RebootRouterAsyncFunc == nil {
+		return nil, notMocked("MockRouterService", "RebootRouterAsync")
+	}
+	return m.RebootRouterAsyncFunc(ctx, p)
+}
+
+func (m *MockRouterService) NewStartRouterParams(id string) *StartRouterParams {
+	return (&RouterService{}).NewStartRouterParams(id)
+}
+
+func (m *MockRouterService) StartRouter(p *StartRouterParams) (*StartRouterResponse, error) {
+	return m.StartRouterWithContext(context.Background(), p)
+}
+
+func (m *MockRouterService) StartRouterWithContext(ctx context.Context, p *StartRouterParams) (*StartRouterResponse, error) {
+	m.record("StartRouter", p)
+	if m.StartRouterFunc == nil {
+		return nil, notMocked("MockRouterService", "StartRouter")
+	}
+	return m.StartRouterFunc(ctx, p)
+}
+
+func (m *MockRouterService) StartRouterAsync(ctx context.Context, p *StartRouterParams) (*StartRouterJob, error) {
+	m.record("StartRouterAsync", p)
+	if m.StartRouterAsyncFunc == nil {
+		return nil, notMocked("MockRouterService", "StartRouterAsync")
+	}
+	return m.StartRouterAsyncFunc(ctx, p)
+}
+
+func (m *MockRouterService) NewStopRouterParams(id string) *StopRouterParams {
+	return (&RouterService{}).NewStopRouterParams(id)
+}
+
+func (m *MockRouterService) StopRouter(p *StopRouterParams) (*StopRouterResponse, error) {
+	return m.StopRouterWithContext(context.Background(), p)
+}
+
+func (m *MockRouterService) StopRouterWithContext(ctx context.Context, p *StopRouterParams) (*StopRouterResponse, error) {
+	m.record("StopRouter", p)
+	if m.StopRouterFunc == nil {
+		return nil, notMocked("MockRouterService", "StopRouter")
+	}
+	return m.StopRouterFunc(ctx, p)
+}
+
+func (m *MockRouterService) StopRouterAsync(ctx context.Context, p *StopRouterParams) (*StopRouterJob, error) {
+	m.record("StopRouterAsync", p)
+	if m.StopRouterAsyncFunc == nil {
+		return nil, notMocked("MockRouterService", "StopRouterAsync")
+	}
+	return m.StopRouterAsyncFunc(ctx, p)
+}
+
+func (m *MockRouterService) NewListRoutersParams() *ListRoutersParams {
+	return (&RouterService{}).NewListRoutersParams()
+}
+
+func (m *MockRouterService) GetRouterID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetRouterID", name, opts)
+	if m.GetRouterIDFunc == nil {
+		return "", -1, notMocked("MockRouterService", "GetRouterID")
+	}
+	return m.GetRouterIDFunc(name, opts...)
+}
+
+func (m *MockRouterService) GetRouterByName(name string, opts ...OptionFunc) (*Router, int, error) {
+	m.record("GetRouterByName", name, opts)
+	if m.GetRouterByNameFunc == nil {
+		return nil, -1, notMocked("MockRouterService", "GetRouterByName")
+	}
+	return m.GetRouterByNameFunc(name, opts...)
+}
+
+func (m *MockRouterService) GetRouterByID(id string, opts ...OptionFunc) (*Router, int, error) {
+	m.record("GetRouterByID", id, opts)
+	if m.GetRouterByIDFunc == nil {
+		return nil, -1, notMocked("MockRouterService", "GetRouterByID")
+	}
+	return m.GetRouterByIDFunc(id, opts...)
+}
+
+func (m *MockRouterService) ListRouters(p *ListRoutersParams) (*ListRoutersResponse, error) {
+	return m.ListRoutersWithContext(context.Background(), p)
+}
+
+func (m *MockRouterService) ListRoutersWithContext(ctx context.Context, p *ListRoutersParams) (*ListRoutersResponse, error) {
+	m.record("ListRouters", p)
+	if m.ListRoutersFunc == nil {
+		return nil, notMocked("MockRouterService", "ListRouters")
+	}
+	return m.ListRoutersFunc(ctx, p)
+}
+
+func (m *MockRouterService) NewListRoutersIterator(p *ListRoutersParams, pagesize int) *ListRoutersIterator {
+	i := &ListRoutersIterator{pager: newPager(nil, "listRouters", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListRoutersParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListRoutersWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockRouterService) NewChangeServiceForRouterParams(id string, serviceofferingid string) *ChangeServiceForRouterParams {
+	return (&RouterService{}).NewChangeServiceForRouterParams(id, serviceofferingid)
+}
+
+func (m *MockRouterService) ChangeServiceForRouter(p *ChangeServiceForRouterParams) (*ChangeServiceForRouterResponse, error) {
+	return m.ChangeServiceForRouterWithContext(context.Background(), p)
+}
+
+func (m *MockRouterService) ChangeServiceForRouterWithContext(ctx context.Context, p *ChangeServiceForRouterParams) (*ChangeServiceForRouterResponse, error) {
+	m.record("ChangeServiceForRouter", p)
+	if m.ChangeServiceForRouterFunc == nil {
+		return nil, notMocked("MockRouterService", "ChangeServiceForRouter")
+	}
+	return m.ChangeServiceForRouterFunc(ctx, p)
+}
+
+func (m *MockRouterService) NewConfigureVirtualRouterElementParams(enabled bool, id string) *ConfigureVirtualRouterElementParams {
+	return (&RouterService{}).NewConfigureVirtualRouterElementParams(enabled, id)
+}
+
+func (m *MockRouterService) ConfigureVirtualRouterElement(p *ConfigureVirtualRouterElementParams) (*ConfigureVirtualRouterElementResponse, error) {
+	return m.ConfigureVirtualRouterElementWithContext(context.Background(), p)
+}
+
+func (m *MockRouterService) ConfigureVirtualRouterElementWithContext(ctx context.Context, p *ConfigureVirtualRouterElementParams) (*ConfigureVirtualRouterElementResponse, error) {
+	m.record("ConfigureVirtualRouterElement", p)
+	if m.ConfigureVirtualRouterElementFunc == nil {
+		return nil, notMocked("MockRouterService", "ConfigureVirtualRouterElement")
+	}
+	return m.ConfigureVirtualRouterElementFunc(ctx, p)
+}
+
+func (m *MockRouterService) ConfigureVirtualRouterElementAsync(ctx context.Context, p *ConfigureVirtualRouterElementParams) (*ConfigureVirtualRouterElementJob, error) {
+	m.record("ConfigureVirtualRouterElementAsync", p)
+	if m.ConfigureVirtualRouterElementAsyncFunc == nil {
+		return nil, notMocked("MockRouterService", "ConfigureVirtualRouterElementAsync")
+	}
+	return m.ConfigureVirtualRouterElementAsyncFunc(ctx, p)
+}
+
+func (m *MockRouterService) NewCreateVirtualRouterElementParams(nspid string) *CreateVirtualRouterElementParams {
+	return (&RouterService{}).NewCreateVirtualRouterElementParams(nspid)
+}
+
+func (m *MockRouterService) CreateVirtualRouterElement(p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementResponse, error) {
+	return m.CreateVirtualRouterElementWithContext(context.Background(), p)
+}
+
+func (m *MockRouterService) CreateVirtualRouterElementWithContext(ctx context.Context, p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementResponse, error) {
+	m.record("CreateVirtualRouterElement", p)
+	if m.CreateVirtualRouterElementFunc == nil {
+		return nil, notMocked("MockRouterService", "CreateVirtualRouterElement")
+	}
+	return m.CreateVirtualRouterElementFunc(ctx, p)
+}
+
+func (m *MockRouterService) CreateVirtualRouterElementAsync(ctx context.Context, p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementJob, error) {
+	m.record("CreateVirtualRouterElementAsync", p)
+	if m.CreateVirtualRouterElementAsyncFunc == nil {
+		return nil, notMocked("MockRouterService", "CreateVirtualRouterElementAsync")
+	}
+	return m.CreateVirtualRouterElementAsyncFunc(ctx, p)
+}
+
+func (m *MockRouterService) NewListVirtualRouterElementsParams() *ListVirtualRouterElementsParams {
+	return (&RouterService{}).NewListVirtualRouterElementsParams()
+}
+
+func (m *MockRouterService) GetVirtualRouterElementByID(id string, opts ...OptionFunc) (*VirtualRouterElement, int, error) {
+	m.record("GetVirtualRouterElementByID", id, opts)
+	if m.GetVirtualRouterElementByIDFunc == nil {
+		return nil, -1, notMocked("MockRouterService", "GetVirtualRouterElementByID")
+	}
+	return m.GetVirtualRouterElementByIDFunc(id, opts...)
+}
+
+func (m *MockRouterService) ListVirtualRouterElements(p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error) {
+	return m.ListVirtualRouterElementsWithContext(context.Background(), p)
+}
+
+func (m *MockRouterService) ListVirtualRouterElementsWithContext(ctx context.Context, p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error) {
+	m.record("ListVirtualRouterElements", p)
+	if m.ListVirtualRouterElementsFunc == nil {
+		return nil, notMocked("MockRouterService", "ListVirtualRouterElements")
+	}
+	return m.ListVirtualRouterElementsFunc(ctx, p)
+}
+
+func (m *MockRouterService) NewListVirtualRouterElementsIterator(p *ListVirtualRouterElementsParams, pagesize int) *ListVirtualRouterElementsIterator {
+	i := &ListVirtualRouterElementsIterator{pager: newPager(nil, "listVirtualRouterElements", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListVirtualRouterElementsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListVirtualRouterElementsWithContext(ctx, c)
+	}
+	return i
+}
@@ -0,0 +1,193 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestDestroyRouterParams(t *testing.T) {
+	p := &DestroyRouterParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "destroyRouter", want)
+}
+
+func TestDestroyRouterResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","created":"created-value","dns1":"dns1-value","dns2":"dns2-value","domain":"domain-value","domainid":"domainid-value","gateway":"gateway-value","guestipaddress":"guestipaddress-value","guestmacaddress":"guestmacaddress-value","guestnetmask":"guestnetmask-value","guestnetworkid":"guestnetworkid-value","guestnetworkname":"guestnetworkname-value","hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","ip6dns1":"ip6dns1-value","ip6dns2":"ip6dns2-value","isredundantrouter":true,"jobid":"jobid-value","laststartdate":"laststartdate-value","laststartversion":"laststartversion-value","linklocalip":"linklocalip-value","linklocalmacaddress":"linklocalmacaddress-value","linklocalnetmask":"linklocalnetmask-value","linklocalnetworkid":"linklocalnetworkid-value","name":"name-value","networkdomain":"networkdomain-value","nic":[{"broadcasturi":"broadcasturi-value","gateway":"gateway-value","id":"id-value","ip6address":"ip6address-value","ip6cidr":"ip6cidr-value","ip6gateway":"ip6gateway-value","ipaddress":"ipaddress-value","isdefault":true,"isolationuri":"isolationuri-value","macaddress":"macaddress-value","netmask":"netmask-value","networkid":"networkid-value","networkname":"networkname-value","secondaryip":[{"id":"id-value","ipaddress":"ipaddress-value"}],"traffictype":"traffictype-value","type":"type-value","virtualmachineid":"virtualmachineid-value"}],"podid":"podid-value","project":"project-value","projectid":"projectid-value","publicip":"publicip-value","publicmacaddress":"publicmacaddress-value","publicnetmask":"publicnetmask-value","publicnetworkid":"publicnetworkid-value","redundantstate":"redundantstate-value","requiresupgrade":true,"role":"role-value","routerunicastid":1099511627776,"scriptsversion":"scriptsversion-value","serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","state":"state-value","templateid":"templateid-value","version":"version-value","vpcid":"vpcid-value","vpcname":"vpcname-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &DestroyRouterResponse{})
+}
+
+func TestRebootRouterParams(t *testing.T) {
+	p := &RebootRouterParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "rebootRouter", want)
+}
+
+func TestRebootRouterResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","created":"created-value","dns1":"dns1-value","dns2":"dns2-value","domain":"domain-value","domainid":"domainid-value","gateway":"gateway-value","guestipaddress":"guestipaddress-value","guestmacaddress":"guestmacaddress-value","guestnetmask":"guestnetmask-value","guestnetworkid":"guestnetworkid-value","guestnetworkname":"guestnetworkname-value","hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","ip6dns1":"ip6dns1-value","ip6dns2":"ip6dns2-value","isredundantrouter":true,"jobid":"jobid-value","laststartdate":"laststartdate-value","laststartversion":"laststartversion-value","linklocalip":"linklocalip-value","linklocalmacaddress":"linklocalmacaddress-value","linklocalnetmask":"linklocalnetmask-value","linklocalnetworkid":"linklocalnetworkid-value","name":"name-value","networkdomain":"networkdomain-value","nic":[{"broadcasturi":"broadcasturi-value","gateway":"gateway-value","id":"id-value","ip6address":"ip6address-value","ip6cidr":"ip6cidr-value","ip6gateway":"ip6gateway-value","ipaddress":"ipaddress-value","isdefault":true,"isolationuri":"isolationuri-value","macaddress":"macaddress-value","netmask":"netmask-value","networkid":"networkid-value","networkname":"networkname-value","secondaryip":[{"id":"id-value","ipaddress":"ipaddress-value"}],"traffictype":"traffictype-value","type":"type-value","virtualmachineid":"virtualmachineid-value"}],"podid":"podid-value","project":"project-value","projectid":"projectid-value","publicip":"publicip-value","publicmacaddress":"publicmacaddress-value","publicnetmask":"publicnetmask-value","publicnetworkid":"publicnetworkid-value","redundantstate":"redundantstate-value","requiresupgrade":true,"role":"role-value","routerunicastid":1099511627776,"scriptsversion":"scriptsversion-value","serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","state":"state-value","templateid":"templateid-value","version":"version-value","vpcid":"vpcid-value","vpcname":"vpcname-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &RebootRouterResponse{})
+}
+
+func TestStartRouterParams(t *testing.T) {
+	p := &StartRouterParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "startRouter", want)
+}
+
+func TestStartRouterResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","created":"created-value","dns1":"dns1-value","dns2":"dns2-value","domain":"domain-value","domainid":"domainid-value","gateway":"gateway-value","guestipaddress":"guestipaddress-value","guestmacaddress":"guestmacaddress-value","guestnetmask":"guestnetmask-value","guestnetworkid":"guestnetworkid-value","guestnetworkname":"guestnetworkname-value","hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","ip6dns1":"ip6dns1-value","ip6dns2":"ip6dns2-value","isredundantrouter":true,"jobid":"jobid-value","laststartdate":"laststartdate-value","laststartversion":"laststartversion-value","linklocalip":"linklocalip-value","linklocalmacaddress":"linklocalmacaddress-value","linklocalnetmask":"linklocalnetmask-value","linklocalnetworkid":"linklocalnetworkid-value","name":"name-value","networkdomain":"networkdomain-value","nic":[{"broadcasturi":"broadcasturi-value","gateway":"gateway-value","id":"id-value","ip6address":"ip6address-value","ip6cidr":"ip6cidr-value","ip6gateway":"ip6gateway-value","ipaddress":"ipaddress-value","isdefault":true,"isolationuri":"isolationuri-value","macaddress":"macaddress-value","netmask":"netmask-value","networkid":"networkid-value","networkname":"networkname-value","secondaryip":[{"id":"id-value","ipaddress":"ipaddress-value"}],"traffictype":"traffictype-value","type":"type-value","virtualmachineid":"virtualmachineid-value"}],"podid":"podid-value","project":"project-value","projectid":"projectid-value","publicip":"publicip-value","publicmacaddress":"publicmacaddress-value","publicnetmask":"publicnetmask-value","publicnetworkid":"publicnetworkid-value","redundantstate":"redundantstate-value","requiresupgrade":true,"role":"role-value","routerunicastid":1099511627776,"scriptsversion":"scriptsversion-value","serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","state":"state-value","templateid":"templateid-value","version":"version-value","vpcid":"vpcid-value","vpcname":"vpcname-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &StartRouterResponse{})
+}
+
+func TestStopRouterParams(t *testing.T) {
+	p := &StopRouterParams{}
+	want := url.Values{}
+	p.SetForced(true)
+	want.Set("forced", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "stopRouter", want)
+}
+
+func TestStopRouterResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","created":"created-value","dns1":"dns1-value","dns2":"dns2-value","domain":"domain-value","domainid":"domainid-value","gateway":"gateway-value","guestipaddress":"guestipaddress-value","guestmacaddress":"guestmacaddress-value","guestnetmask":"guestnetmask-value","guestnetworkid":"guestnetworkid-value","guestnetworkname":"guestnetworkname-value","hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","ip6dns1":"ip6dns1-value","ip6dns2":"ip6dns2-value","isredundantrouter":true,"jobid":"jobid-value","laststartdate":"laststartdate-value","laststartversion":"laststartversion-value","linklocalip":"linklocalip-value","linklocalmacaddress":"linklocalmacaddress-value","linklocalnetmask":"linklocalnetmask-value","linklocalnetworkid":"linklocalnetworkid-value","name":"name-value","networkdomain":"networkdomain-value","nic":[{"broadcasturi":"broadcasturi-value","gateway":"gateway-value","id":"id-value","ip6address":"ip6address-value","ip6cidr":"ip6cidr-value","ip6gateway":"ip6gateway-value","ipaddress":"ipaddress-value","isdefault":true,"isolationuri":"isolationuri-value","macaddress":"macaddress-value","netmask":"netmask-value","networkid":"networkid-value","networkname":"networkname-value","secondaryip":[{"id":"id-value","ipaddress":"ipaddress-value"}],"traffictype":"traffictype-value","type":"type-value","virtualmachineid":"virtualmachineid-value"}],"podid":"podid-value","project":"project-value","projectid":"projectid-value","publicip":"publicip-value","publicmacaddress":"publicmacaddress-value","publicnetmask":"publicnetmask-value","publicnetworkid":"publicnetworkid-value","redundantstate":"redundantstate-value","requiresupgrade":true,"role":"role-value","routerunicastid":1099511627776,"scriptsversion":"scriptsversion-value","serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","state":"state-value","templateid":"templateid-value","version":"version-value","vpcid":"vpcid-value","vpcname":"vpcname-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &StopRouterResponse{})
+}
+
+func TestListRoutersParams(t *testing.T) {
+	p := &ListRoutersParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetClusterid("clusterid-value")
+	want.Set("clusterid", "clusterid-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetForvpc(true)
+	want.Set("forvpc", "true")
+	p.SetHostid("hostid-value")
+	want.Set("hostid", "hostid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetNetworkid("networkid-value")
+	want.Set("networkid", "networkid-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetPodid("podid-value")
+	want.Set("podid", "podid-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetState("state-value")
+	want.Set("state", "state-value")
+	p.SetVersion("version-value")
+	want.Set("version", "version-value")
+	p.SetVpcid("vpcid-value")
+	want.Set("vpcid", "vpcid-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listRouters", want)
+}
+
+func TestListRoutersResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"router":[{"account":"account-value","created":"created-value","dns1":"dns1-value","dns2":"dns2-value","domain":"domain-value","domainid":"domainid-value","gateway":"gateway-value","guestipaddress":"guestipaddress-value","guestmacaddress":"guestmacaddress-value","guestnetmask":"guestnetmask-value","guestnetworkid":"guestnetworkid-value","guestnetworkname":"guestnetworkname-value","hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","ip6dns1":"ip6dns1-value","ip6dns2":"ip6dns2-value","isredundantrouter":true,"laststartdate":"laststartdate-value","laststartversion":"laststartversion-value","linklocalip":"linklocalip-value","linklocalmacaddress":"linklocalmacaddress-value","linklocalnetmask":"linklocalnetmask-value","linklocalnetworkid":"linklocalnetworkid-value","name":"name-value","networkdomain":"networkdomain-value","nic":[{"broadcasturi":"broadcasturi-value","gateway":"gateway-value","id":"id-value","ip6address":"ip6address-value","ip6cidr":"ip6cidr-value","ip6gateway":"ip6gateway-value","ipaddress":"ipaddress-value","isdefault":true,"isolationuri":"isolationuri-value","macaddress":"macaddress-value","netmask":"netmask-value","networkid":"networkid-value","networkname":"networkname-value","secondaryip":[{"id":"id-value","ipaddress":"ipaddress-value"}],"traffictype":"traffictype-value","type":"type-value","virtualmachineid":"virtualmachineid-value"}],"podid":"podid-value","project":"project-value","projectid":"projectid-value","publicip":"publicip-value","publicmacaddress":"publicmacaddress-value","publicnetmask":"publicnetmask-value","publicnetworkid":"publicnetworkid-value","redundantstate":"redundantstate-value","requiresupgrade":true,"role":"role-value","routerunicastid":1099511627776,"scriptsversion":"scriptsversion-value","serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","state":"state-value","templateid":"templateid-value","version":"version-value","vpcid":"vpcid-value","vpcname":"vpcname-value","zoneid":"zoneid-value","zonename":"zonename-value"}]}`, &ListRoutersResponse{})
+}
+
+func TestChangeServiceForRouterParams(t *testing.T) {
+	p := &ChangeServiceForRouterParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetServiceofferingid("serviceofferingid-value")
+	want.Set("serviceofferingid", "serviceofferingid-value")
+
+	assertParams(t, p, "changeServiceForRouter", want)
+}
+
+func TestChangeServiceForRouterResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","created":"created-value","dns1":"dns1-value","dns2":"dns2-value","domain":"domain-value","domainid":"domainid-value","gateway":"gateway-value","guestipaddress":"guestipaddress-value","guestmacaddress":"guestmacaddress-value","guestnetmask":"guestnetmask-value","guestnetworkid":"guestnetworkid-value","guestnetworkname":"guestnetworkname-value","hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","ip6dns1":"ip6dns1-value","ip6dns2":"ip6dns2-value","isredundantrouter":true,"laststartdate":"laststartdate-value","laststartversion":"laststartversion-value","linklocalip":"linklocalip-value","linklocalmacaddress":"linklocalmacaddress-value","linklocalnetmask":"linklocalnetmask-value","linklocalnetworkid":"linklocalnetworkid-value","name":"name-value","networkdomain":"networkdomain-value","nic":[{"broadcasturi":"broadcasturi-value","gateway":"gateway-value","id":"id-value","ip6address":"ip6address-value","ip6cidr":"ip6cidr-value","ip6gateway":"ip6gateway-value","ipaddress":"ipaddress-value","isdefault":true,"isolationuri":"isolationuri-value","macaddress":"macaddress-value","netmask":"netmask-value","networkid":"networkid-value","networkname":"networkname-value","secondaryip":[{"id":"id-value","ipaddress":"ipaddress-value"}],"traffictype":"traffictype-value","type":"type-value","virtualmachineid":"virtualmachineid-value"}],"podid":"podid-value","project":"project-value","projectid":"projectid-value","publicip":"publicip-value","publicmacaddress":"publicmacaddress-value","publicnetmask":"publicnetmask-value","publicnetworkid":"publicnetworkid-value","redundantstate":"redundantstate-value","requiresupgrade":true,"role":"role-value","routerunicastid":1099511627776,"scriptsversion":"scriptsversion-value","serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","state":"state-value","templateid":"templateid-value","version":"version-value","vpcid":"vpcid-value","vpcname":"vpcname-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &ChangeServiceForRouterResponse{})
+}
+
+func TestConfigureVirtualRouterElementParams(t *testing.T) {
+	p := &ConfigureVirtualRouterElementParams{}
+	want := url.Values{}
+	p.SetEnabled(true)
+	want.Set("enabled", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "configureVirtualRouterElement", want)
+}
+
+func TestConfigureVirtualRouterElementResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","domain":"domain-value","domainid":"domainid-value","enabled":true,"id":"id-value","jobid":"jobid-value","nspid":"nspid-value","project":"project-value","projectid":"projectid-value"}`, &ConfigureVirtualRouterElementResponse{})
+}
+
+func TestCreateVirtualRouterElementParams(t *testing.T) {
+	p := &CreateVirtualRouterElementParams{}
+	want := url.Values{}
+	p.SetNspid("nspid-value")
+	want.Set("nspid", "nspid-value")
+	p.SetProvidertype("providertype-value")
+	want.Set("providertype", "providertype-value")
+
+	assertParams(t, p, "createVirtualRouterElement", want)
+}
+
+func TestCreateVirtualRouterElementResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","domain":"domain-value","domainid":"domainid-value","enabled":true,"id":"id-value","jobid":"jobid-value","nspid":"nspid-value","project":"project-value","projectid":"projectid-value"}`, &CreateVirtualRouterElementResponse{})
+}
+
+func TestListVirtualRouterElementsParams(t *testing.T) {
+	p := &ListVirtualRouterElementsParams{}
+	want := url.Values{}
+	p.SetEnabled(true)
+	want.Set("enabled", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetNspid("nspid-value")
+	want.Set("nspid", "nspid-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+
+	assertParams(t, p, "listVirtualRouterElements", want)
+}
+
+func TestListVirtualRouterElementsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"virtualrouterelement":[{"account":"account-value","domain":"domain-value","domainid":"domainid-value","enabled":true,"id":"id-value","nspid":"nspid-value","project":"project-value","projectid":"projectid-value"}]}`, &ListVirtualRouterElementsResponse{})
+}
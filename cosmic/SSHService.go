@@ -17,15 +17,41 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
 )
 
+// SSHServiceIface is the interface implemented by SSHService, and by MockSSHService for tests
+type SSHServiceIface interface {
+	NewResetSSHKeyForVirtualMachineParams(id string, keypair string) *ResetSSHKeyForVirtualMachineParams
+	ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineResponse, error)
+	ResetSSHKeyForVirtualMachineWithContext(ctx context.Context, p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineResponse, error)
+	ResetSSHKeyForVirtualMachineAsync(ctx context.Context, p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineJob, error)
+	NewCreateSSHKeyPairParams(name string) *CreateSSHKeyPairParams
+	CreateSSHKeyPair(p *CreateSSHKeyPairParams) (*CreateSSHKeyPairResponse, error)
+	CreateSSHKeyPairWithContext(ctx context.Context, p *CreateSSHKeyPairParams) (*CreateSSHKeyPairResponse, error)
+	NewDeleteSSHKeyPairParams(name string) *DeleteSSHKeyPairParams
+	DeleteSSHKeyPair(p *DeleteSSHKeyPairParams) (*DeleteSSHKeyPairResponse, error)
+	DeleteSSHKeyPairWithContext(ctx context.Context, p *DeleteSSHKeyPairParams) (*DeleteSSHKeyPairResponse, error)
+	NewRegisterSSHKeyPairParams(name string, publickey string) *RegisterSSHKeyPairParams
+	RegisterSSHKeyPair(p *RegisterSSHKeyPairParams) (*RegisterSSHKeyPairResponse, error)
+	RegisterSSHKeyPairWithContext(ctx context.Context, p *RegisterSSHKeyPairParams) (*RegisterSSHKeyPairResponse, error)
+	NewListSSHKeyPairsParams() *ListSSHKeyPairsParams
+	ListSSHKeyPairs(p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error)
+	ListSSHKeyPairsWithContext(ctx context.Context, p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error)
+	NewListSSHKeyPairsIterator(p *ListSSHKeyPairsParams, pagesize int) *ListSSHKeyPairsIterator
+}
+
 type ResetSSHKeyForVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+func (p *ResetSSHKeyForVirtualMachineParams) command() string {
+	return "resetSSHKeyForVirtualMachine"
+}
+
 func (p *ResetSSHKeyForVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -96,7 +122,13 @@ func (s *SSHService) NewResetSSHKeyForVirtualMachineParams(id string, keypair st
 
 // Resets the SSH Key for virtual machine. The virtual machine must be in a "Stopped" state. [async]
 func (s *SSHService) ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("resetSSHKeyForVirtualMachine", p.toURLValues())
+	return s.ResetSSHKeyForVirtualMachineWithContext(context.Background(), p)
+}
+
+// ResetSSHKeyForVirtualMachineWithContext is the same as ResetSSHKeyForVirtualMachine, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SSHService) ResetSSHKeyForVirtualMachineWithContext(ctx context.Context, p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "resetSSHKeyForVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -108,26 +140,56 @@ func (s *SSHService) ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachin
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "resetSSHKeyForVirtualMachine", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// ResetSSHKeyForVirtualMachineJob is a handle to a running resetSSHKeyForVirtualMachine async job
+type ResetSSHKeyForVirtualMachineJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a ResetSSHKeyForVirtualMachineResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *ResetSSHKeyForVirtualMachineJob) Result() (*ResetSSHKeyForVirtualMachineResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := ResetSSHKeyForVirtualMachineResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// ResetSSHKeyForVirtualMachineAsync starts the resetSSHKeyForVirtualMachine async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *SSHService) ResetSSHKeyForVirtualMachineAsync(ctx context.Context, p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineJob, error) {
+	resp, err := s.cs.newRequest(ctx, "resetSSHKeyForVirtualMachine", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ResetSSHKeyForVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &ResetSSHKeyForVirtualMachineJob{s.cs.newJobHandle("resetSSHKeyForVirtualMachine", r.JobID)}, nil
+}
+
 type ResetSSHKeyForVirtualMachineResponse struct {
 	JobID         string `json:"jobid,omitempty"`
 	Account       string `json:"account,omitempty"`
@@ -245,6 +307,10 @@ type CreateSSHKeyPairParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateSSHKeyPairParams) command() string {
+	return "createSSHKeyPair"
+}
+
 func (p *CreateSSHKeyPairParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -304,17 +370,19 @@ func (s *SSHService) NewCreateSSHKeyPairParams(name string) *CreateSSHKeyPairPar
 
 // Create a new keypair and returns the private key
 func (s *SSHService) CreateSSHKeyPair(p *CreateSSHKeyPairParams) (*CreateSSHKeyPairResponse, error) {
-	resp, err := s.cs.newRequest("createSSHKeyPair", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+	return s.CreateSSHKeyPairWithContext(context.Background(), p)
+}
 
-	if resp, err = getRawValue(resp); err != nil {
+// CreateSSHKeyPairWithContext is the same as CreateSSHKeyPair, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SSHService) CreateSSHKeyPairWithContext(ctx context.Context, p *CreateSSHKeyPairParams) (*CreateSSHKeyPairResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createSSHKeyPair", p.toURLValues())
+	if err != nil {
 		return nil, err
 	}
 
 	var r CreateSSHKeyPairResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := unmarshalValue(resp, "keypair", &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
@@ -328,6 +396,10 @@ type DeleteSSHKeyPairParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteSSHKeyPairParams) command() string {
+	return "deleteSSHKeyPair"
+}
+
 func (p *DeleteSSHKeyPairParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -387,7 +459,13 @@ func (s *SSHService) NewDeleteSSHKeyPairParams(name string) *DeleteSSHKeyPairPar
 
 // Deletes a keypair by name
 func (s *SSHService) DeleteSSHKeyPair(p *DeleteSSHKeyPairParams) (*DeleteSSHKeyPairResponse, error) {
-	resp, err := s.cs.newRequest("deleteSSHKeyPair", p.toURLValues())
+	return s.DeleteSSHKeyPairWithContext(context.Background(), p)
+}
+
+// DeleteSSHKeyPairWithContext is the same as DeleteSSHKeyPair, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SSHService) DeleteSSHKeyPairWithContext(ctx context.Context, p *DeleteSSHKeyPairParams) (*DeleteSSHKeyPairResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteSSHKeyPair", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -408,6 +486,10 @@ type RegisterSSHKeyPairParams struct {
 	p map[string]interface{}
 }
 
+func (p *RegisterSSHKeyPairParams) command() string {
+	return "registerSSHKeyPair"
+}
+
 func (p *RegisterSSHKeyPairParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -478,17 +560,19 @@ func (s *SSHService) NewRegisterSSHKeyPairParams(name string, publickey string)
 
 // Register a public key in a keypair under a certain name
 func (s *SSHService) RegisterSSHKeyPair(p *RegisterSSHKeyPairParams) (*RegisterSSHKeyPairResponse, error) {
-	resp, err := s.cs.newRequest("registerSSHKeyPair", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+	return s.RegisterSSHKeyPairWithContext(context.Background(), p)
+}
 
-	if resp, err = getRawValue(resp); err != nil {
+// RegisterSSHKeyPairWithContext is the same as RegisterSSHKeyPair, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SSHService) RegisterSSHKeyPairWithContext(ctx context.Context, p *RegisterSSHKeyPairParams) (*RegisterSSHKeyPairResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "registerSSHKeyPair", p.toURLValues())
+	if err != nil {
 		return nil, err
 	}
 
 	var r RegisterSSHKeyPairResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := unmarshalValue(resp, "keypair", &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
@@ -506,6 +590,10 @@ type ListSSHKeyPairsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListSSHKeyPairsParams) command() string {
+	return "listSSHKeyPairs"
+}
+
 func (p *ListSSHKeyPairsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -628,10 +716,17 @@ func (s *SSHService) NewListSSHKeyPairsParams() *ListSSHKeyPairsParams {
 
 // List registered keypairs
 func (s *SSHService) ListSSHKeyPairs(p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error) {
+	return s.ListSSHKeyPairsWithContext(context.Background(), p)
+}
+
+// ListSSHKeyPairsWithContext is the same as ListSSHKeyPairs, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SSHService) ListSSHKeyPairsWithContext(ctx context.Context, p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error) {
 	var r ListSSHKeyPairsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListSSHKeyPairsResponse
-		resp, err := s.cs.newRequest("listSSHKeyPairs", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listSSHKeyPairs", values)
 		if err != nil {
 			return nil, err
 		}
@@ -643,13 +738,89 @@ func (s *SSHService) ListSSHKeyPairs(p *ListSSHKeyPairsParams) (*ListSSHKeyPairs
 		r.Count = l.Count
 		r.SSHKeyPairs = append(r.SSHKeyPairs, l.SSHKeyPairs...)
 
-		if r.Count == len(r.SSHKeyPairs) {
+		if r.Count == len(r.SSHKeyPairs) || len(l.SSHKeyPairs) == 0 {
+			s.cs.observePages("listSSHKeyPairs", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.SSHKeyPairs))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.SSHKeyPairs)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listSSHKeyPairs", values, len(l.SSHKeyPairs), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListSSHKeyPairsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.SSHKeyPairs = append(r.SSHKeyPairs, l.SSHKeyPairs...)
+			}
+
+			if r.Count == len(r.SSHKeyPairs) {
+				s.cs.observePages("listSSHKeyPairs", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListSSHKeyPairsIterator iterates over the items returned by listSSHKeyPairs, requesting
+// the pages of the response one at a time while iterating.
+type ListSSHKeyPairsIterator struct {
+	pager
+	items []*SSHKeyPair
+	index int
+}
+
+// NewListSSHKeyPairsIterator returns an iterator over the items returned by listSSHKeyPairs, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *SSHService) NewListSSHKeyPairsIterator(p *ListSSHKeyPairsParams, pagesize int) *ListSSHKeyPairsIterator {
+	return &ListSSHKeyPairsIterator{pager: newPager(s.cs, "listSSHKeyPairs", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListSSHKeyPairsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListSSHKeyPairsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.SSHKeyPairs))
+
+	i.items = l.SSHKeyPairs
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListSSHKeyPairsIterator) Value() *SSHKeyPair {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListSSHKeyPairsResponse struct {
@@ -0,0 +1,137 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockSSHService is a mock of SSHServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockSSHService struct {
+	mockCalls
+
+	ResetSSHKeyForVirtualMachineFunc      func(ctx context.Context, p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineResponse, error)
+	ResetSSHKeyForVirtualMachineAsyncFunc func(ctx context.Context, p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineJob, error)
+	CreateSSHKeyPairFunc                  func(ctx context.Context, p *CreateSSHKeyPairParams) (*CreateSSHKeyPairResponse, error)
+	DeleteSSHKeyPairFunc                  func(ctx context.Context, p *DeleteSSHKeyPairParams) (*DeleteSSHKeyPairResponse, error)
+	RegisterSSHKeyPairFunc                func(ctx context.Context, p *RegisterSSHKeyPairParams) (*RegisterSSHKeyPairResponse, error)
+	ListSSHKeyPairsFunc                   func(ctx context.Context, p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error)
+}
+
+var _ SSHServiceIface = &MockSSHService{}
+
+func (m *MockSSHService) NewResetSSHKeyForVirtualMachineParams(id string, keypair string) *ResetSSHKeyForVirtualMachineParams {
+	return (&SSHService{}).NewResetSSHKeyForVirtualMachineParams(id, keypair)
+}
+
+func (m *MockSSHService) ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineResponse, error) {
+	return m.ResetSSHKeyForVirtualMachineWithContext(context.Background(), p)
+}
+
+func (m *MockSSHService) ResetSSHKeyForVirtualMachineWithContext(ctx context.Context, p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineResponse, error) {
+	m.record("ResetSSHKeyForVirtualMachine", p)
+	if m.ResetSSHKeyForVirtualMachineFunc == nil {
+		return nil, notMocked("MockSSHService", "ResetSSHKeyForVirtualMachine")
+	}
+	return m.ResetSSHKeyForVirtualMachineFunc(ctx, p)
+}
+
+func (m *MockSSHService) ResetSSHKeyForVirtualMachineAsync(ctx context.Context, p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineJob, error) {
+	m.record("ResetSSHKeyForVirtualMachineAsync", p)
+	if m.ResetSSHKeyForVirtualMachineAsyncFunc == nil {
+		return nil, notMocked("MockSSHService", "ResetSSHKeyForVirtualMachineAsync")
+	}
+	return m.ResetSSHKeyForVirtualMachineAsyncFunc(ctx, p)
+}
+
+func (m *MockSSHService) NewCreateSSHKeyPairParams(name string) *CreateSSHKeyPairParams {
+	return (&SSHService{}).NewCreateSSHKeyPairParams(name)
+}
+
+func (m *MockSSHService) CreateSSHKeyPair(p *CreateSSHKeyPairParams) (*CreateSSHKeyPairResponse, error) {
+	return m.CreateSSHKeyPairWithContext(context.Background(), p)
+}
+
+func (m *MockSSHService) CreateSSHKeyPairWithContext(ctx context.Context, p *CreateSSHKeyPairParams) (*CreateSSHKeyPairResponse, error) {
+	m.record("CreateSSHKeyPair", p)
+	if m.CreateSSHKeyPairFunc == nil {
+		return nil, notMocked("MockSSHService", "CreateSSHKeyPair")
+	}
+	return m.CreateSSHKeyPairFunc(ctx, p)
+}
+
+func (m *MockSSHService) NewDeleteSSHKeyPairParams(name string) *DeleteSSHKeyPairParams {
+	return (&SSHService{}).NewDeleteSSHKeyPairParams(name)
+}
+
+func (m *MockSSHService) DeleteSSHKeyPair(p *DeleteSSHKeyPairParams) (*DeleteSSHKeyPairResponse, error) {
+	return m.DeleteSSHKeyPairWithContext(context.Background(), p)
+}
+
+func (m *MockSSHService) DeleteSSHKeyPairWithContext(ctx context.Context, p *DeleteSSHKeyPairParams) (*DeleteSSHKeyPairResponse, error) {
+	m.record("DeleteSSHKeyPair", p)
+	if m.DeleteSSHKeyPairFunc == nil {
+		return nil, notMocked("MockSSHService", "DeleteSSHKeyPair")
+	}
+	return m.DeleteSSHKeyPairFunc(ctx, p)
+}
+
+func (m *MockSSHService) NewRegisterSSHKeyPairParams(name string, publickey string) *RegisterSSHKeyPairParams {
+	return (&SSHService{}).NewRegisterSSHKeyPairParams(name, publickey)
+}
+
+func (m *MockSSHService) RegisterSSHKeyPair(p *RegisterSSHKeyPairParams) (*RegisterSSHKeyPairResponse, error) {
+	return m.RegisterSSHKeyPairWithContext(context.Background(), p)
+}
+
+func (m *MockSSHService) RegisterSSHKeyPairWithContext(ctx context.Context, p *RegisterSSHKeyPairParams) (*RegisterSSHKeyPairResponse, error) {
+	m.record("RegisterSSHKeyPair", p)
+	if m.RegisterSSHKeyPairFunc == nil {
+		return nil, notMocked("MockSSHService", "RegisterSSHKeyPair")
+	}
+	return m.RegisterSSHKeyPairFunc(ctx, p)
+}
+
+func (m *MockSSHService) NewListSSHKeyPairsParams() *ListSSHKeyPairsParams {
+	return (&SSHService{}).NewListSSHKeyPairsParams()
+}
+
+func (m *MockSSHService) ListSSHKeyPairs(p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error) {
+	return m.ListSSHKeyPairsWithContext(context.Background(), p)
+}
+
+func (m *MockSSHService) ListSSHKeyPairsWithContext(ctx context.Context, p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error) {
+	m.record("ListSSHKeyPairs", p)
+	if m.ListSSHKeyPairsFunc == nil {
+		return nil, notMocked("MockSSHService", "ListSSHKeyPairs")
+	}
+	return m.ListSSHKeyPairsFunc(ctx, p)
+}
+
+func (m *MockSSHService) NewListSSHKeyPairsIterator(p *ListSSHKeyPairsParams, pagesize int) *ListSSHKeyPairsIterator {
+	i := &ListSSHKeyPairsIterator{pager: newPager(nil, "listSSHKeyPairs", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListSSHKeyPairsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListSSHKeyPairsWithContext(ctx, c)
+	}
+	return i
+}
@@ -0,0 +1,133 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestResetSSHKeyForVirtualMachineParams(t *testing.T) {
+	p := &ResetSSHKeyForVirtualMachineParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetKeypair("keypair-value")
+	want.Set("keypair", "keypair-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+
+	assertParams(t, p, "resetSSHKeyForVirtualMachine", want)
+}
+
+func TestResetSSHKeyForVirtualMachineResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","affinitygroup":[{"account":"account-value","description":"description-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","name":"name-value","project":"project-value","projectid":"projectid-value","type":"type-value","virtualmachineIds":["a","b"]}],"bootmenutimeout":1099511627776,"cpunumber":42,"cpuused":"cpuused-value","created":"created-value","details":{"key":"value"},"diskioread":1099511627776,"diskiowrite":1099511627776,"diskkbsread":1099511627776,"diskkbswrite":1099511627776,"diskofferingid":"diskofferingid-value","diskofferingname":"diskofferingname-value","displayname":"displayname-value","displayvm":true,"domain":"domain-value","domainid":"domainid-value","forvirtualnetwork":true,"group":"group-value","groupid":"groupid-value","guestosid":"guestosid-value","haenable":true,"hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","instancename":"instancename-value","isdynamicallyscalable":true,"isodisplaytext":"isodisplaytext-value","isoid":"isoid-value","isoname":"isoname-value","jobid":"jobid-value","keypair":"keypair-value","laststartdate":"laststartdate-value","laststartversion":"laststartversion-value","maintenancepolicy":"maintenancepolicy-value","manufacturerstring":"manufacturerstring-value","memory":42,"name":"name-value","networkkbsread":1099511627776,"networkkbswrite":1099511627776,"nic":[{"broadcasturi":"broadcasturi-value","gateway":"gateway-value","id":"id-value","ip6address":"ip6address-value","ip6cidr":"ip6cidr-value","ip6gateway":"ip6gateway-value","ipaddress":"ipaddress-value","isdefault":true,"isolationuri":"isolationuri-value","macaddress":"macaddress-value","netmask":"netmask-value","networkid":"networkid-value","networkname":"networkname-value","secondaryip":[{"id":"id-value","ipaddress":"ipaddress-value"}],"traffictype":"traffictype-value","type":"type-value","virtualmachineid":"virtualmachineid-value"}],"optimisefor":"optimisefor-value","ostypeid":1099511627776,"password":"password-value","passwordenabled":true,"project":"project-value","projectid":"projectid-value","publicip":"publicip-value","publicipid":"publicipid-value","restartrequired":true,"rootdevicecontroller":"rootdevicecontroller-value","rootdeviceid":1099511627776,"rootdevicetype":"rootdevicetype-value","serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","servicestate":"servicestate-value","state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templatedisplaytext":"templatedisplaytext-value","templateid":"templateid-value","templatename":"templatename-value","userid":"userid-value","username":"username-value","vgpu":"vgpu-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &ResetSSHKeyForVirtualMachineResponse{})
+}
+
+func TestCreateSSHKeyPairParams(t *testing.T) {
+	p := &CreateSSHKeyPairParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+
+	assertParams(t, p, "createSSHKeyPair", want)
+}
+
+func TestCreateSSHKeyPairResponse(t *testing.T) {
+	assertDecodes(t, `{"privatekey":"privatekey-value"}`, &CreateSSHKeyPairResponse{})
+}
+
+func TestDeleteSSHKeyPairParams(t *testing.T) {
+	p := &DeleteSSHKeyPairParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+
+	assertParams(t, p, "deleteSSHKeyPair", want)
+}
+
+func TestDeleteSSHKeyPairResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &DeleteSSHKeyPairResponse{})
+}
+
+func TestRegisterSSHKeyPairParams(t *testing.T) {
+	p := &RegisterSSHKeyPairParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetPublickey("publickey-value")
+	want.Set("publickey", "publickey-value")
+
+	assertParams(t, p, "registerSSHKeyPair", want)
+}
+
+func TestRegisterSSHKeyPairResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","domain":"domain-value","domainid":"domainid-value","fingerprint":"fingerprint-value","name":"name-value"}`, &RegisterSSHKeyPairResponse{})
+}
+
+func TestListSSHKeyPairsParams(t *testing.T) {
+	p := &ListSSHKeyPairsParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetFingerprint("fingerprint-value")
+	want.Set("fingerprint", "fingerprint-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+
+	assertParams(t, p, "listSSHKeyPairs", want)
+}
+
+func TestListSSHKeyPairsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"sshkeypair":[{"account":"account-value","domain":"domain-value","domainid":"domainid-value","fingerprint":"fingerprint-value","name":"name-value"}]}`, &ListSSHKeyPairsResponse{})
+}
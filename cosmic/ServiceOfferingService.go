@@ -17,17 +17,42 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
+// ServiceOfferingServiceIface is the interface implemented by ServiceOfferingService, and by MockServiceOfferingService for tests
+type ServiceOfferingServiceIface interface {
+	NewCreateServiceOfferingParams(displaytext string, name string) *CreateServiceOfferingParams
+	CreateServiceOffering(p *CreateServiceOfferingParams) (*CreateServiceOfferingResponse, error)
+	CreateServiceOfferingWithContext(ctx context.Context, p *CreateServiceOfferingParams) (*CreateServiceOfferingResponse, error)
+	NewDeleteServiceOfferingParams(id string) *DeleteServiceOfferingParams
+	DeleteServiceOffering(p *DeleteServiceOfferingParams) (*DeleteServiceOfferingResponse, error)
+	DeleteServiceOfferingWithContext(ctx context.Context, p *DeleteServiceOfferingParams) (*DeleteServiceOfferingResponse, error)
+	NewUpdateServiceOfferingParams(id string) *UpdateServiceOfferingParams
+	UpdateServiceOffering(p *UpdateServiceOfferingParams) (*UpdateServiceOfferingResponse, error)
+	UpdateServiceOfferingWithContext(ctx context.Context, p *UpdateServiceOfferingParams) (*UpdateServiceOfferingResponse, error)
+	NewListServiceOfferingsParams() *ListServiceOfferingsParams
+	GetServiceOfferingID(name string, opts ...OptionFunc) (string, int, error)
+	GetServiceOfferingByName(name string, opts ...OptionFunc) (*ServiceOffering, int, error)
+	GetServiceOfferingByID(id string, opts ...OptionFunc) (*ServiceOffering, int, error)
+	ListServiceOfferings(p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error)
+	ListServiceOfferingsWithContext(ctx context.Context, p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error)
+	NewListServiceOfferingsIterator(p *ListServiceOfferingsParams, pagesize int) *ListServiceOfferingsIterator
+}
+
 type CreateServiceOfferingParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateServiceOfferingParams) command() string {
+	return "createServiceOffering"
+}
+
 func (p *CreateServiceOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -340,17 +365,19 @@ func (s *ServiceOfferingService) NewCreateServiceOfferingParams(displaytext stri
 
 // Creates a service offering.
 func (s *ServiceOfferingService) CreateServiceOffering(p *CreateServiceOfferingParams) (*CreateServiceOfferingResponse, error) {
-	resp, err := s.cs.newRequest("createServiceOffering", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+	return s.CreateServiceOfferingWithContext(context.Background(), p)
+}
 
-	if resp, err = getRawValue(resp); err != nil {
+// CreateServiceOfferingWithContext is the same as CreateServiceOffering, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ServiceOfferingService) CreateServiceOfferingWithContext(ctx context.Context, p *CreateServiceOfferingParams) (*CreateServiceOfferingResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createServiceOffering", p.toURLValues())
+	if err != nil {
 		return nil, err
 	}
 
 	var r CreateServiceOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := unmarshalValue(resp, "serviceoffering", &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
@@ -395,6 +422,10 @@ type DeleteServiceOfferingParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteServiceOfferingParams) command() string {
+	return "deleteServiceOffering"
+}
+
 func (p *DeleteServiceOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -424,7 +455,13 @@ func (s *ServiceOfferingService) NewDeleteServiceOfferingParams(id string) *Dele
 
 // Deletes a service offering.
 func (s *ServiceOfferingService) DeleteServiceOffering(p *DeleteServiceOfferingParams) (*DeleteServiceOfferingResponse, error) {
-	resp, err := s.cs.newRequest("deleteServiceOffering", p.toURLValues())
+	return s.DeleteServiceOfferingWithContext(context.Background(), p)
+}
+
+// DeleteServiceOfferingWithContext is the same as DeleteServiceOffering, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ServiceOfferingService) DeleteServiceOfferingWithContext(ctx context.Context, p *DeleteServiceOfferingParams) (*DeleteServiceOfferingResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteServiceOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -445,6 +482,10 @@ type UpdateServiceOfferingParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateServiceOfferingParams) command() string {
+	return "updateServiceOffering"
+}
+
 func (p *UpdateServiceOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -505,7 +546,13 @@ func (s *ServiceOfferingService) NewUpdateServiceOfferingParams(id string) *Upda
 
 // Updates a service offering.
 func (s *ServiceOfferingService) UpdateServiceOffering(p *UpdateServiceOfferingParams) (*UpdateServiceOfferingResponse, error) {
-	resp, err := s.cs.newRequest("updateServiceOffering", p.toURLValues())
+	return s.UpdateServiceOfferingWithContext(context.Background(), p)
+}
+
+// UpdateServiceOfferingWithContext is the same as UpdateServiceOffering, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ServiceOfferingService) UpdateServiceOfferingWithContext(ctx context.Context, p *UpdateServiceOfferingParams) (*UpdateServiceOfferingResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateServiceOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -556,6 +603,10 @@ type ListServiceOfferingsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListServiceOfferingsParams) command() string {
+	return "listServiceOfferings"
+}
+
 func (p *ListServiceOfferingsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -706,7 +757,7 @@ func (s *ServiceOfferingService) GetServiceOfferingID(name string, opts ...Optio
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -720,7 +771,7 @@ func (s *ServiceOfferingService) GetServiceOfferingID(name string, opts ...Optio
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -752,16 +803,14 @@ func (s *ServiceOfferingService) GetServiceOfferingByID(id string, opts ...Optio
 
 	l, err := s.ListServiceOfferings(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -773,10 +822,17 @@ func (s *ServiceOfferingService) GetServiceOfferingByID(id string, opts ...Optio
 
 // Lists all available service offerings.
 func (s *ServiceOfferingService) ListServiceOfferings(p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error) {
+	return s.ListServiceOfferingsWithContext(context.Background(), p)
+}
+
+// ListServiceOfferingsWithContext is the same as ListServiceOfferings, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ServiceOfferingService) ListServiceOfferingsWithContext(ctx context.Context, p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error) {
 	var r ListServiceOfferingsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListServiceOfferingsResponse
-		resp, err := s.cs.newRequest("listServiceOfferings", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listServiceOfferings", values)
 		if err != nil {
 			return nil, err
 		}
@@ -788,13 +844,89 @@ func (s *ServiceOfferingService) ListServiceOfferings(p *ListServiceOfferingsPar
 		r.Count = l.Count
 		r.ServiceOfferings = append(r.ServiceOfferings, l.ServiceOfferings...)
 
-		if r.Count == len(r.ServiceOfferings) {
+		if r.Count == len(r.ServiceOfferings) || len(l.ServiceOfferings) == 0 {
+			s.cs.observePages("listServiceOfferings", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.ServiceOfferings))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.ServiceOfferings)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listServiceOfferings", values, len(l.ServiceOfferings), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListServiceOfferingsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.ServiceOfferings = append(r.ServiceOfferings, l.ServiceOfferings...)
+			}
+
+			if r.Count == len(r.ServiceOfferings) {
+				s.cs.observePages("listServiceOfferings", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListServiceOfferingsIterator iterates over the items returned by listServiceOfferings, requesting
+// the pages of the response one at a time while iterating.
+type ListServiceOfferingsIterator struct {
+	pager
+	items []*ServiceOffering
+	index int
+}
+
+// NewListServiceOfferingsIterator returns an iterator over the items returned by listServiceOfferings, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *ServiceOfferingService) NewListServiceOfferingsIterator(p *ListServiceOfferingsParams, pagesize int) *ListServiceOfferingsIterator {
+	return &ListServiceOfferingsIterator{pager: newPager(s.cs, "listServiceOfferings", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListServiceOfferingsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListServiceOfferingsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.ServiceOfferings))
+
+	i.items = l.ServiceOfferings
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListServiceOfferingsIterator) Value() *ServiceOffering {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListServiceOfferingsResponse struct {
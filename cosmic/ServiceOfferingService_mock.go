@@ -0,0 +1,138 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockServiceOfferingService is a mock of ServiceOfferingServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockServiceOfferingService struct {
+	mockCalls
+
+	CreateServiceOfferingFunc    func(ctx context.Context, p *CreateServiceOfferingParams) (*CreateServiceOfferingResponse, error)
+	DeleteServiceOfferingFunc    func(ctx context.Context, p *DeleteServiceOfferingParams) (*DeleteServiceOfferingResponse, error)
+	UpdateServiceOfferingFunc    func(ctx context.Context, p *UpdateServiceOfferingParams) (*UpdateServiceOfferingResponse, error)
+	GetServiceOfferingIDFunc     func(name string, opts ...OptionFunc) (string, int, error)
+	GetServiceOfferingByNameFunc func(name string, opts ...OptionFunc) (*ServiceOffering, int, error)
+	GetServiceOfferingByIDFunc   func(id string, opts ...OptionFunc) (*ServiceOffering, int, error)
+	ListServiceOfferingsFunc     func(ctx context.Context, p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error)
+}
+
+var _ ServiceOfferingServiceIface = &MockServiceOfferingService{}
+
+func (m *MockServiceOfferingService) NewCreateServiceOfferingParams(displaytext string, name string) *CreateServiceOfferingParams {
+	return (&ServiceOfferingService{}).NewCreateServiceOfferingParams(displaytext, name)
+}
+
+func (m *MockServiceOfferingService) CreateServiceOffering(p *CreateServiceOfferingParams) (*CreateServiceOfferingResponse, error) {
+	return m.CreateServiceOfferingWithContext(context.Background(), p)
+}
+
+func (m *MockServiceOfferingService) CreateServiceOfferingWithContext(ctx context.Context, p *CreateServiceOfferingParams) (*CreateServiceOfferingResponse, error) {
+	m.record("CreateServiceOffering", p)
+	if m.CreateServiceOfferingFunc == nil {
+		return nil, notMocked("MockServiceOfferingService", "CreateServiceOffering")
+	}
+	return m.CreateServiceOfferingFunc(ctx, p)
+}
+
+func (m *MockServiceOfferingService) NewDeleteServiceOfferingParams(id string) *DeleteServiceOfferingParams {
+	return (&ServiceOfferingService{}).NewDeleteServiceOfferingParams(id)
+}
+
+func (m *MockServiceOfferingService) DeleteServiceOffering(p *DeleteServiceOfferingParams) (*DeleteServiceOfferingResponse, error) {
+	return m.DeleteServiceOfferingWithContext(context.Background(), p)
+}
+
+func (m *MockServiceOfferingService) DeleteServiceOfferingWithContext(ctx context.Context, p *DeleteServiceOfferingParams) (*DeleteServiceOfferingResponse, error) {
+	m.record("DeleteServiceOffering", p)
+	if m.DeleteServiceOfferingFunc == nil {
+		return nil, notMocked("MockServiceOfferingService", "DeleteServiceOffering")
+	}
+	return m.DeleteServiceOfferingFunc(ctx, p)
+}
+
+func (m *MockServiceOfferingService) NewUpdateServiceOfferingParams(id string) *UpdateServiceOfferingParams {
+	return (&ServiceOfferingService{}).NewUpdateServiceOfferingParams(id)
+}
+
+func (m *MockServiceOfferingService) UpdateServiceOffering(p *UpdateServiceOfferingParams) (*UpdateServiceOfferingResponse, error) {
+	return m.UpdateServiceOfferingWithContext(context.Background(), p)
+}
+
+func (m *MockServiceOfferingService) UpdateServiceOfferingWithContext(ctx context.Context, p *UpdateServiceOfferingParams) (*UpdateServiceOfferingResponse, error) {
+	m.record("UpdateServiceOffering", p)
+	if m.UpdateServiceOfferingFunc == nil {
+		return nil, notMocked("MockServiceOfferingService", "UpdateServiceOffering")
+	}
+	return m.UpdateServiceOfferingFunc(ctx, p)
+}
+
+func (m *MockServiceOfferingService) NewListServiceOfferingsParams() *ListServiceOfferingsParams {
+	return (&ServiceOfferingService{}).NewListServiceOfferingsParams()
+}
+
+func (m *MockServiceOfferingService) GetServiceOfferingID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetServiceOfferingID", name, opts)
+	if m.GetServiceOfferingIDFunc == nil {
+		return "", -1, notMocked("MockServiceOfferingService", "GetServiceOfferingID")
+	}
+	return m.GetServiceOfferingIDFunc(name, opts...)
+}
+
+func (m *MockServiceOfferingService) GetServiceOfferingByName(name string, opts ...OptionFunc) (*ServiceOffering, int, error) {
+	m.record("GetServiceOfferingByName", name, opts)
+	if m.GetServiceOfferingByNameFunc == nil {
+		return nil, -1, notMocked("MockServiceOfferingService", "GetServiceOfferingByName")
+	}
+	return m.GetServiceOfferingByNameFunc(name, opts...)
+}
+
+func (m *MockServiceOfferingService) GetServiceOfferingByID(id string, opts ...OptionFunc) (*ServiceOffering, int, error) {
+	m.record("GetServiceOfferingByID", id, opts)
+	if m.GetServiceOfferingByIDFunc == nil {
+		return nil, -1, notMocked("MockServiceOfferingService", "GetServiceOfferingByID")
+	}
+	return m.GetServiceOfferingByIDFunc(id, opts...)
+}
+
+func (m *MockServiceOfferingService) ListServiceOfferings(p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error) {
+	return m.ListServiceOfferingsWithContext(context.Background(), p)
+}
+
+func (m *MockServiceOfferingService) ListServiceOfferingsWithContext(ctx context.Context, p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error) {
+	m.record("ListServiceOfferings", p)
+	if m.ListServiceOfferingsFunc == nil {
+		return nil, notMocked("MockServiceOfferingService", "ListServiceOfferings")
+	}
+	return m.ListServiceOfferingsFunc(ctx, p)
+}
+
+func (m *MockServiceOfferingService) NewListServiceOfferingsIterator(p *ListServiceOfferingsParams, pagesize int) *ListServiceOfferingsIterator {
+	i := &ListServiceOfferingsIterator{pager: newPager(nil, "listServiceOfferings", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListServiceOfferingsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListServiceOfferingsWithContext(ctx, c)
+	}
+	return i
+}
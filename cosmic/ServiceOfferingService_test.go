@@ -0,0 +1,153 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCreateServiceOfferingParams(t *testing.T) {
+	p := &CreateServiceOfferingParams{}
+	want := url.Values{}
+	p.SetBytesreadrate(int64(1) << 40)
+	want.Set("bytesreadrate", "1099511627776")
+	p.SetByteswriterate(int64(1) << 40)
+	want.Set("byteswriterate", "1099511627776")
+	p.SetCpunumber(42)
+	want.Set("cpunumber", "42")
+	p.SetCustomizediops(true)
+	want.Set("customizediops", "true")
+	p.SetDeploymentplanner("deploymentplanner-value")
+	want.Set("deploymentplanner", "deploymentplanner-value")
+	p.SetDisplaytext("displaytext-value")
+	want.Set("displaytext", "displaytext-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetHosttags("hosttags-value")
+	want.Set("hosttags", "hosttags-value")
+	p.SetHypervisorsnapshotreserve(42)
+	want.Set("hypervisorsnapshotreserve", "42")
+	p.SetIopsratepergb(true)
+	want.Set("iopsratepergb", "true")
+	p.SetIopsreadrate(int64(1) << 40)
+	want.Set("iopsreadrate", "1099511627776")
+	p.SetIopstotalrate(int64(1) << 40)
+	want.Set("iopstotalrate", "1099511627776")
+	p.SetIopswriterate(int64(1) << 40)
+	want.Set("iopswriterate", "1099511627776")
+	p.SetIssystem(true)
+	want.Set("issystem", "true")
+	p.SetIsvolatile(true)
+	want.Set("isvolatile", "true")
+	p.SetLimitcpuuse(true)
+	want.Set("limitcpuuse", "true")
+	p.SetMaxiops(int64(1) << 40)
+	want.Set("maxiops", "1099511627776")
+	p.SetMemory(42)
+	want.Set("memory", "42")
+	p.SetMiniops(int64(1) << 40)
+	want.Set("miniops", "1099511627776")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetNetworkrate(42)
+	want.Set("networkrate", "42")
+	p.SetOfferha(true)
+	want.Set("offerha", "true")
+	p.SetProvisioningtype("provisioningtype-value")
+	want.Set("provisioningtype", "provisioningtype-value")
+	p.SetServiceofferingdetails(map[string]string{"k": "v"})
+	want.Set("serviceofferingdetails[0].key", "k")
+	want.Set("serviceofferingdetails[0].value", "v")
+	p.SetStoragetype("storagetype-value")
+	want.Set("storagetype", "storagetype-value")
+	p.SetSystemvmtype("systemvmtype-value")
+	want.Set("systemvmtype", "systemvmtype-value")
+	p.SetTags("tags-value")
+	want.Set("tags", "tags-value")
+
+	assertParams(t, p, "createServiceOffering", want)
+}
+
+func TestCreateServiceOfferingResponse(t *testing.T) {
+	assertDecodes(t, `{"cpunumber":42,"created":"created-value","defaultuse":true,"deploymentplanner":"deploymentplanner-value","diskBytesReadRate":1099511627776,"diskBytesWriteRate":1099511627776,"diskIopsRatePerGb":true,"diskIopsReadRate":1099511627776,"diskIopsTotalRate":1099511627776,"diskIopsWriteRate":1099511627776,"displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","hosttags":"hosttags-value","hypervisorsnapshotreserve":42,"id":"id-value","iscustomized":true,"iscustomizediops":true,"issystem":true,"isvolatile":true,"limitcpuuse":true,"maxiops":1099511627776,"memory":42,"miniops":1099511627776,"name":"name-value","networkrate":42,"offerha":true,"provisioningtype":"provisioningtype-value","serviceofferingdetails":{"key":"value"},"storagetype":"storagetype-value","systemvmtype":"systemvmtype-value","tags":"tags-value"}`, &CreateServiceOfferingResponse{})
+}
+
+func TestDeleteServiceOfferingParams(t *testing.T) {
+	p := &DeleteServiceOfferingParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteServiceOffering", want)
+}
+
+func TestDeleteServiceOfferingResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &DeleteServiceOfferingResponse{})
+}
+
+func TestUpdateServiceOfferingParams(t *testing.T) {
+	p := &UpdateServiceOfferingParams{}
+	want := url.Values{}
+	p.SetDisplaytext("displaytext-value")
+	want.Set("displaytext", "displaytext-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetSortkey(42)
+	want.Set("sortkey", "42")
+
+	assertParams(t, p, "updateServiceOffering", want)
+}
+
+func TestUpdateServiceOfferingResponse(t *testing.T) {
+	assertDecodes(t, `{"cpunumber":42,"created":"created-value","defaultuse":true,"deploymentplanner":"deploymentplanner-value","diskBytesReadRate":1099511627776,"diskBytesWriteRate":1099511627776,"diskIopsRatePerGb":true,"diskIopsReadRate":1099511627776,"diskIopsTotalRate":1099511627776,"diskIopsWriteRate":1099511627776,"displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","hosttags":"hosttags-value","hypervisorsnapshotreserve":42,"id":"id-value","iscustomized":true,"iscustomizediops":true,"issystem":true,"isvolatile":true,"limitcpuuse":true,"maxiops":1099511627776,"memory":42,"miniops":1099511627776,"name":"name-value","networkrate":42,"offerha":true,"provisioningtype":"provisioningtype-value","serviceofferingdetails":{"key":"value"},"storagetype":"storagetype-value","systemvmtype":"systemvmtype-value","tags":"tags-value"}`, &UpdateServiceOfferingResponse{})
+}
+
+func TestListServiceOfferingsParams(t *testing.T) {
+	p := &ListServiceOfferingsParams{}
+	want := url.Values{}
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetIssystem(true)
+	want.Set("issystem", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetSystemvmtype("systemvmtype-value")
+	want.Set("systemvmtype", "systemvmtype-value")
+	p.SetVirtualmachineid("virtualmachineid-value")
+	want.Set("virtualmachineid", "virtualmachineid-value")
+
+	assertParams(t, p, "listServiceOfferings", want)
+}
+
+func TestListServiceOfferingsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"serviceoffering":[{"cpunumber":42,"created":"created-value","defaultuse":true,"deploymentplanner":"deploymentplanner-value","diskBytesReadRate":1099511627776,"diskBytesWriteRate":1099511627776,"diskIopsRatePerGb":true,"diskIopsReadRate":1099511627776,"diskIopsTotalRate":1099511627776,"diskIopsWriteRate":1099511627776,"displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","hosttags":"hosttags-value","hypervisorsnapshotreserve":42,"id":"id-value","iscustomized":true,"iscustomizediops":true,"issystem":true,"isvolatile":true,"limitcpuuse":true,"maxiops":1099511627776,"memory":42,"miniops":1099511627776,"name":"name-value","networkrate":42,"offerha":true,"provisioningtype":"provisioningtype-value","serviceofferingdetails":{"key":"value"},"storagetype":"storagetype-value","systemvmtype":"systemvmtype-value","tags":"tags-value"}]}`, &ListServiceOfferingsResponse{})
+}
@@ -17,17 +17,66 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
+// SnapshotServiceIface is the interface implemented by SnapshotService, and by MockSnapshotService for tests
+type SnapshotServiceIface interface {
+	NewCreateSnapshotParams(volumeid string) *CreateSnapshotParams
+	CreateSnapshot(p *CreateSnapshotParams) (*CreateSnapshotResponse, error)
+	CreateSnapshotWithContext(ctx context.Context, p *CreateSnapshotParams) (*CreateSnapshotResponse, error)
+	CreateSnapshotAsync(ctx context.Context, p *CreateSnapshotParams) (*CreateSnapshotJob, error)
+	NewDeleteSnapshotParams(id string) *DeleteSnapshotParams
+	DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error)
+	DeleteSnapshotWithContext(ctx context.Context, p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error)
+	DeleteSnapshotAsync(ctx context.Context, p *DeleteSnapshotParams) (*DeleteSnapshotJob, error)
+	NewRevertSnapshotParams(id string) *RevertSnapshotParams
+	RevertSnapshot(p *RevertSnapshotParams) (*RevertSnapshotResponse, error)
+	RevertSnapshotWithContext(ctx context.Context, p *RevertSnapshotParams) (*RevertSnapshotResponse, error)
+	RevertSnapshotAsync(ctx context.Context, p *RevertSnapshotParams) (*RevertSnapshotJob, error)
+	NewCreateSnapshotFromVMSnapshotParams(vmsnapshotid string, volumeid string) *CreateSnapshotFromVMSnapshotParams
+	CreateSnapshotFromVMSnapshot(p *CreateSnapshotFromVMSnapshotParams) (*CreateSnapshotFromVMSnapshotResponse, error)
+	CreateSnapshotFromVMSnapshotWithContext(ctx context.Context, p *CreateSnapshotFromVMSnapshotParams) (*CreateSnapshotFromVMSnapshotResponse, error)
+	CreateSnapshotFromVMSnapshotAsync(ctx context.Context, p *CreateSnapshotFromVMSnapshotParams) (*CreateSnapshotFromVMSnapshotJob, error)
+	NewListSnapshotsParams() *ListSnapshotsParams
+	GetSnapshotID(name string, opts ...OptionFunc) (string, int, error)
+	GetSnapshotByName(name string, opts ...OptionFunc) (*Snapshot, int, error)
+	GetSnapshotByID(id string, opts ...OptionFunc) (*Snapshot, int, error)
+	ListSnapshots(p *ListSnapshotsParams) (*ListSnapshotsResponse, error)
+	ListSnapshotsWithContext(ctx context.Context, p *ListSnapshotsParams) (*ListSnapshotsResponse, error)
+	NewListSnapshotsIterator(p *ListSnapshotsParams, pagesize int) *ListSnapshotsIterator
+	NewRevertToVMSnapshotParams(vmsnapshotid string) *RevertToVMSnapshotParams
+	RevertToVMSnapshot(p *RevertToVMSnapshotParams) (*RevertToVMSnapshotResponse, error)
+	RevertToVMSnapshotWithContext(ctx context.Context, p *RevertToVMSnapshotParams) (*RevertToVMSnapshotResponse, error)
+	RevertToVMSnapshotAsync(ctx context.Context, p *RevertToVMSnapshotParams) (*RevertToVMSnapshotJob, error)
+	NewCreateVMSnapshotParams(virtualmachineid string) *CreateVMSnapshotParams
+	CreateVMSnapshot(p *CreateVMSnapshotParams) (*CreateVMSnapshotResponse, error)
+	CreateVMSnapshotWithContext(ctx context.Context, p *CreateVMSnapshotParams) (*CreateVMSnapshotResponse, error)
+	CreateVMSnapshotAsync(ctx context.Context, p *CreateVMSnapshotParams) (*CreateVMSnapshotJob, error)
+	NewDeleteVMSnapshotParams(vmsnapshotid string) *DeleteVMSnapshotParams
+	DeleteVMSnapshot(p *DeleteVMSnapshotParams) (*DeleteVMSnapshotResponse, error)
+	DeleteVMSnapshotWithContext(ctx context.Context, p *DeleteVMSnapshotParams) (*DeleteVMSnapshotResponse, error)
+	DeleteVMSnapshotAsync(ctx context.Context, p *DeleteVMSnapshotParams) (*DeleteVMSnapshotJob, error)
+	NewListVMSnapshotParams() *ListVMSnapshotParams
+	GetVMSnapshotID(name string, opts ...OptionFunc) (string, int, error)
+	ListVMSnapshot(p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error)
+	ListVMSnapshotWithContext(ctx context.Context, p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error)
+	NewListVMSnapshotIterator(p *ListVMSnapshotParams, pagesize int) *ListVMSnapshotIterator
+}
+
 type CreateSnapshotParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateSnapshotParams) command() string {
+	return "createSnapshot"
+}
+
 func (p *CreateSnapshotParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -87,7 +136,13 @@ func (s *SnapshotService) NewCreateSnapshotParams(volumeid string) *CreateSnapsh
 
 // Creates an instant snapshot of a volume.
 func (s *SnapshotService) CreateSnapshot(p *CreateSnapshotParams) (*CreateSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("createSnapshot", p.toURLValues())
+	return s.CreateSnapshotWithContext(context.Background(), p)
+}
+
+// CreateSnapshotWithContext is the same as CreateSnapshot, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SnapshotService) CreateSnapshotWithContext(ctx context.Context, p *CreateSnapshotParams) (*CreateSnapshotResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createSnapshot", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -99,26 +154,56 @@ func (s *SnapshotService) CreateSnapshot(p *CreateSnapshotParams) (*CreateSnapsh
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createSnapshot", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "snapshot", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CreateSnapshotJob is a handle to a running createSnapshot async job
+type CreateSnapshotJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateSnapshotResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateSnapshotJob) Result() (*CreateSnapshotResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateSnapshotResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "snapshot", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CreateSnapshotAsync starts the createSnapshot async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *SnapshotService) CreateSnapshotAsync(ctx context.Context, p *CreateSnapshotParams) (*CreateSnapshotJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createSnapshot", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateSnapshotResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateSnapshotJob{s.cs.newJobHandle("createSnapshot", r.JobID)}, nil
+}
+
 type CreateSnapshotResponse struct {
 	JobID        string `json:"jobid,omitempty"`
 	Account      string `json:"account,omitempty"`
@@ -156,6 +241,10 @@ type DeleteSnapshotParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteSnapshotParams) command() string {
+	return "deleteSnapshot"
+}
+
 func (p *DeleteSnapshotParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -185,7 +274,13 @@ func (s *SnapshotService) NewDeleteSnapshotParams(id string) *DeleteSnapshotPara
 
 // Deletes a snapshot of a disk volume.
 func (s *SnapshotService) DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("deleteSnapshot", p.toURLValues())
+	return s.DeleteSnapshotWithContext(context.Background(), p)
+}
+
+// DeleteSnapshotWithContext is the same as DeleteSnapshot, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SnapshotService) DeleteSnapshotWithContext(ctx context.Context, p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteSnapshot", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -197,9 +292,9 @@ func (s *SnapshotService) DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapsh
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteSnapshot", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -212,6 +307,41 @@ func (s *SnapshotService) DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapsh
 	return &r, nil
 }
 
+// DeleteSnapshotJob is a handle to a running deleteSnapshot async job
+type DeleteSnapshotJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteSnapshotResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteSnapshotJob) Result() (*DeleteSnapshotResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteSnapshotResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteSnapshotAsync starts the deleteSnapshot async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *SnapshotService) DeleteSnapshotAsync(ctx context.Context, p *DeleteSnapshotParams) (*DeleteSnapshotJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteSnapshot", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteSnapshotResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteSnapshotJob{s.cs.newJobHandle("deleteSnapshot", r.JobID)}, nil
+}
+
 type DeleteSnapshotResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -222,6 +352,10 @@ type RevertSnapshotParams struct {
 	p map[string]interface{}
 }
 
+func (p *RevertSnapshotParams) command() string {
+	return "revertSnapshot"
+}
+
 func (p *RevertSnapshotParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -251,7 +385,13 @@ func (s *SnapshotService) NewRevertSnapshotParams(id string) *RevertSnapshotPara
 
 // revert a volume snapshot.
 func (s *SnapshotService) RevertSnapshot(p *RevertSnapshotParams) (*RevertSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("revertSnapshot", p.toURLValues())
+	return s.RevertSnapshotWithContext(context.Background(), p)
+}
+
+// RevertSnapshotWithContext is the same as RevertSnapshot, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SnapshotService) RevertSnapshotWithContext(ctx context.Context, p *RevertSnapshotParams) (*RevertSnapshotResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "revertSnapshot", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -263,26 +403,56 @@ func (s *SnapshotService) RevertSnapshot(p *RevertSnapshotParams) (*RevertSnapsh
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "revertSnapshot", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "snapshot", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// RevertSnapshotJob is a handle to a running revertSnapshot async job
+type RevertSnapshotJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a RevertSnapshotResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *RevertSnapshotJob) Result() (*RevertSnapshotResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := RevertSnapshotResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "snapshot", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// RevertSnapshotAsync starts the revertSnapshot async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *SnapshotService) RevertSnapshotAsync(ctx context.Context, p *RevertSnapshotParams) (*RevertSnapshotJob, error) {
+	resp, err := s.cs.newRequest(ctx, "revertSnapshot", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RevertSnapshotResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &RevertSnapshotJob{s.cs.newJobHandle("revertSnapshot", r.JobID)}, nil
+}
+
 type RevertSnapshotResponse struct {
 	JobID        string `json:"jobid,omitempty"`
 	Account      string `json:"account,omitempty"`
@@ -320,6 +490,10 @@ type CreateSnapshotFromVMSnapshotParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateSnapshotFromVMSnapshotParams) command() string {
+	return "createSnapshotFromVMSnapshot"
+}
+
 func (p *CreateSnapshotFromVMSnapshotParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -370,7 +544,13 @@ func (s *SnapshotService) NewCreateSnapshotFromVMSnapshotParams(vmsnapshotid str
 
 // Creates an instant snapshot of a volume from existing vm snapshot.
 func (s *SnapshotService) CreateSnapshotFromVMSnapshot(p *CreateSnapshotFromVMSnapshotParams) (*CreateSnapshotFromVMSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("createSnapshotFromVMSnapshot", p.toURLValues())
+	return s.CreateSnapshotFromVMSnapshotWithContext(context.Background(), p)
+}
+
+// CreateSnapshotFromVMSnapshotWithContext is the same as CreateSnapshotFromVMSnapshot, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SnapshotService) CreateSnapshotFromVMSnapshotWithContext(ctx context.Context, p *CreateSnapshotFromVMSnapshotParams) (*CreateSnapshotFromVMSnapshotResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createSnapshotFromVMSnapshot", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -382,26 +562,56 @@ func (s *SnapshotService) CreateSnapshotFromVMSnapshot(p *CreateSnapshotFromVMSn
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createSnapshotFromVMSnapshot", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "snapshot", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CreateSnapshotFromVMSnapshotJob is a handle to a running createSnapshotFromVMSnapshot async job
+type CreateSnapshotFromVMSnapshotJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateSnapshotFromVMSnapshotResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateSnapshotFromVMSnapshotJob) Result() (*CreateSnapshotFromVMSnapshotResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateSnapshotFromVMSnapshotResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "snapshot", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CreateSnapshotFromVMSnapshotAsync starts the createSnapshotFromVMSnapshot async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *SnapshotService) CreateSnapshotFromVMSnapshotAsync(ctx context.Context, p *CreateSnapshotFromVMSnapshotParams) (*CreateSnapshotFromVMSnapshotJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createSnapshotFromVMSnapshot", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateSnapshotFromVMSnapshotResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateSnapshotFromVMSnapshotJob{s.cs.newJobHandle("createSnapshotFromVMSnapshot", r.JobID)}, nil
+}
+
 type CreateSnapshotFromVMSnapshotResponse struct {
 	JobID        string `json:"jobid,omitempty"`
 	Account      string `json:"account,omitempty"`
@@ -439,6 +649,10 @@ type ListSnapshotsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListSnapshotsParams) command() string {
+	return "listSnapshots"
+}
+
 func (p *ListSnapshotsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -633,7 +847,7 @@ func (s *SnapshotService) GetSnapshotID(name string, opts ...OptionFunc) (string
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -647,7 +861,7 @@ func (s *SnapshotService) GetSnapshotID(name string, opts ...OptionFunc) (string
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -679,16 +893,14 @@ func (s *SnapshotService) GetSnapshotByID(id string, opts ...OptionFunc) (*Snaps
 
 	l, err := s.ListSnapshots(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -700,10 +912,17 @@ func (s *SnapshotService) GetSnapshotByID(id string, opts ...OptionFunc) (*Snaps
 
 // Lists all available snapshots for the account.
 func (s *SnapshotService) ListSnapshots(p *ListSnapshotsParams) (*ListSnapshotsResponse, error) {
+	return s.ListSnapshotsWithContext(context.Background(), p)
+}
+
+// ListSnapshotsWithContext is the same as ListSnapshots, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SnapshotService) ListSnapshotsWithContext(ctx context.Context, p *ListSnapshotsParams) (*ListSnapshotsResponse, error) {
 	var r ListSnapshotsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListSnapshotsResponse
-		resp, err := s.cs.newRequest("listSnapshots", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listSnapshots", values)
 		if err != nil {
 			return nil, err
 		}
@@ -715,13 +934,89 @@ func (s *SnapshotService) ListSnapshots(p *ListSnapshotsParams) (*ListSnapshotsR
 		r.Count = l.Count
 		r.Snapshots = append(r.Snapshots, l.Snapshots...)
 
-		if r.Count == len(r.Snapshots) {
+		if r.Count == len(r.Snapshots) || len(l.Snapshots) == 0 {
+			s.cs.observePages("listSnapshots", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.Snapshots))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.Snapshots)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listSnapshots", values, len(l.Snapshots), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListSnapshotsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.Snapshots = append(r.Snapshots, l.Snapshots...)
+			}
+
+			if r.Count == len(r.Snapshots) {
+				s.cs.observePages("listSnapshots", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListSnapshotsIterator iterates over the items returned by listSnapshots, requesting
+// the pages of the response one at a time while iterating.
+type ListSnapshotsIterator struct {
+	pager
+	items []*Snapshot
+	index int
+}
+
+// NewListSnapshotsIterator returns an iterator over the items returned by listSnapshots, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *SnapshotService) NewListSnapshotsIterator(p *ListSnapshotsParams, pagesize int) *ListSnapshotsIterator {
+	return &ListSnapshotsIterator{pager: newPager(s.cs, "listSnapshots", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListSnapshotsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListSnapshotsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.Snapshots))
+
+	i.items = l.Snapshots
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListSnapshotsIterator) Value() *Snapshot {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListSnapshotsResponse struct {
@@ -765,6 +1060,10 @@ type RevertToVMSnapshotParams struct {
 	p map[string]interface{}
 }
 
+func (p *RevertToVMSnapshotParams) command() string {
+	return "revertToVMSnapshot"
+}
+
 func (p *RevertToVMSnapshotParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -794,7 +1093,13 @@ func (s *SnapshotService) NewRevertToVMSnapshotParams(vmsnapshotid string) *Reve
 
 // Revert VM from a vmsnapshot.
 func (s *SnapshotService) RevertToVMSnapshot(p *RevertToVMSnapshotParams) (*RevertToVMSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("revertToVMSnapshot", p.toURLValues())
+	return s.RevertToVMSnapshotWithContext(context.Background(), p)
+}
+
+// RevertToVMSnapshotWithContext is the same as RevertToVMSnapshot, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SnapshotService) RevertToVMSnapshotWithContext(ctx context.Context, p *RevertToVMSnapshotParams) (*RevertToVMSnapshotResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "revertToVMSnapshot", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -806,26 +1111,56 @@ func (s *SnapshotService) RevertToVMSnapshot(p *RevertToVMSnapshotParams) (*Reve
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "revertToVMSnapshot", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// RevertToVMSnapshotJob is a handle to a running revertToVMSnapshot async job
+type RevertToVMSnapshotJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a RevertToVMSnapshotResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *RevertToVMSnapshotJob) Result() (*RevertToVMSnapshotResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := RevertToVMSnapshotResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// RevertToVMSnapshotAsync starts the revertToVMSnapshot async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *SnapshotService) RevertToVMSnapshotAsync(ctx context.Context, p *RevertToVMSnapshotParams) (*RevertToVMSnapshotJob, error) {
+	resp, err := s.cs.newRequest(ctx, "revertToVMSnapshot", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RevertToVMSnapshotResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &RevertToVMSnapshotJob{s.cs.newJobHandle("revertToVMSnapshot", r.JobID)}, nil
+}
+
 type RevertToVMSnapshotResponse struct {
 	JobID         string `json:"jobid,omitempty"`
 	Account       string `json:"account,omitempty"`
@@ -943,6 +1278,10 @@ type CreateVMSnapshotParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateVMSnapshotParams) command() string {
+	return "createVMSnapshot"
+}
+
 func (p *CreateVMSnapshotParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -992,7 +1331,13 @@ func (s *SnapshotService) NewCreateVMSnapshotParams(virtualmachineid string) *Cr
 
 // Creates snapshot for a vm.
 func (s *SnapshotService) CreateVMSnapshot(p *CreateVMSnapshotParams) (*CreateVMSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("createVMSnapshot", p.toURLValues())
+	return s.CreateVMSnapshotWithContext(context.Background(), p)
+}
+
+// CreateVMSnapshotWithContext is the same as CreateVMSnapshot, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SnapshotService) CreateVMSnapshotWithContext(ctx context.Context, p *CreateVMSnapshotParams) (*CreateVMSnapshotResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createVMSnapshot", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1004,26 +1349,56 @@ func (s *SnapshotService) CreateVMSnapshot(p *CreateVMSnapshotParams) (*CreateVM
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createVMSnapshot", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "vmsnapshot", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CreateVMSnapshotJob is a handle to a running createVMSnapshot async job
+type CreateVMSnapshotJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateVMSnapshotResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateVMSnapshotJob) Result() (*CreateVMSnapshotResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateVMSnapshotResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "vmsnapshot", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CreateVMSnapshotAsync starts the createVMSnapshot async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *SnapshotService) CreateVMSnapshotAsync(ctx context.Context, p *CreateVMSnapshotParams) (*CreateVMSnapshotJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createVMSnapshot", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateVMSnapshotResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateVMSnapshotJob{s.cs.newJobHandle("createVMSnapshot", r.JobID)}, nil
+}
+
 type CreateVMSnapshotResponse struct {
 	JobID            string `json:"jobid,omitempty"`
 	Account          string `json:"account,omitempty"`
@@ -1049,6 +1424,10 @@ type DeleteVMSnapshotParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteVMSnapshotParams) command() string {
+	return "deleteVMSnapshot"
+}
+
 func (p *DeleteVMSnapshotParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1078,7 +1457,13 @@ func (s *SnapshotService) NewDeleteVMSnapshotParams(vmsnapshotid string) *Delete
 
 // Deletes a vmsnapshot.
 func (s *SnapshotService) DeleteVMSnapshot(p *DeleteVMSnapshotParams) (*DeleteVMSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("deleteVMSnapshot", p.toURLValues())
+	return s.DeleteVMSnapshotWithContext(context.Background(), p)
+}
+
+// DeleteVMSnapshotWithContext is the same as DeleteVMSnapshot, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SnapshotService) DeleteVMSnapshotWithContext(ctx context.Context, p *DeleteVMSnapshotParams) (*DeleteVMSnapshotResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteVMSnapshot", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1090,9 +1475,9 @@ func (s *SnapshotService) DeleteVMSnapshot(p *DeleteVMSnapshotParams) (*DeleteVM
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteVMSnapshot", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -1105,6 +1490,41 @@ func (s *SnapshotService) DeleteVMSnapshot(p *DeleteVMSnapshotParams) (*DeleteVM
 	return &r, nil
 }
 
+// DeleteVMSnapshotJob is a handle to a running deleteVMSnapshot async job
+type DeleteVMSnapshotJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteVMSnapshotResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteVMSnapshotJob) Result() (*DeleteVMSnapshotResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteVMSnapshotResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteVMSnapshotAsync starts the deleteVMSnapshot async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *SnapshotService) DeleteVMSnapshotAsync(ctx context.Context, p *DeleteVMSnapshotParams) (*DeleteVMSnapshotJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteVMSnapshot", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteVMSnapshotResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteVMSnapshotJob{s.cs.newJobHandle("deleteVMSnapshot", r.JobID)}, nil
+}
+
 type DeleteVMSnapshotResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -1115,6 +1535,10 @@ type ListVMSnapshotParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListVMSnapshotParams) command() string {
+	return "listVMSnapshot"
+}
+
 func (p *ListVMSnapshotParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1289,7 +1713,7 @@ func (s *SnapshotService) GetVMSnapshotID(name string, opts ...OptionFunc) (stri
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -1303,15 +1727,22 @@ func (s *SnapshotService) GetVMSnapshotID(name string, opts ...OptionFunc) (stri
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // List virtual machine snapshot by conditions
 func (s *SnapshotService) ListVMSnapshot(p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error) {
+	return s.ListVMSnapshotWithContext(context.Background(), p)
+}
+
+// ListVMSnapshotWithContext is the same as ListVMSnapshot, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SnapshotService) ListVMSnapshotWithContext(ctx context.Context, p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error) {
 	var r ListVMSnapshotResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListVMSnapshotResponse
-		resp, err := s.cs.newRequest("listVMSnapshot", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listVMSnapshot", values)
 		if err != nil {
 			return nil, err
 		}
@@ -1323,13 +1754,89 @@ func (s *SnapshotService) ListVMSnapshot(p *ListVMSnapshotParams) (*ListVMSnapsh
 		r.Count = l.Count
 		r.VMSnapshot = append(r.VMSnapshot, l.VMSnapshot...)
 
-		if r.Count == len(r.VMSnapshot) {
+		if r.Count == len(r.VMSnapshot) || len(l.VMSnapshot) == 0 {
+			s.cs.observePages("listVMSnapshot", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.VMSnapshot))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.VMSnapshot)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listVMSnapshot", values, len(l.VMSnapshot), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListVMSnapshotResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.VMSnapshot = append(r.VMSnapshot, l.VMSnapshot...)
+			}
+
+			if r.Count == len(r.VMSnapshot) {
+				s.cs.observePages("listVMSnapshot", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListVMSnapshotIterator iterates over the items returned by listVMSnapshot, requesting
+// the pages of the response one at a time while iterating.
+type ListVMSnapshotIterator struct {
+	pager
+	items []*VMSnapshot
+	index int
+}
+
+// NewListVMSnapshotIterator returns an iterator over the items returned by listVMSnapshot, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *SnapshotService) NewListVMSnapshotIterator(p *ListVMSnapshotParams, pagesize int) *ListVMSnapshotIterator {
+	return &ListVMSnapshotIterator{pager: newPager(s.cs, "listVMSnapshot", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListVMSnapshotIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListVMSnapshotResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.VMSnapshot))
+
+	i.items = l.VMSnapshot
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListVMSnapshotIterator) Value() *VMSnapshot {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListVMSnapshotResponse struct {
@@ -0,0 +1,309 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockSnapshotService is a mock of SnapshotServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockSnapshotService struct {
+	mockCalls
+
+	CreateSnapshotFunc                    func(ctx context.Context, p *CreateSnapshotParams) (*CreateSnapshotResponse, error)
+	CreateSnapshotAsyncFunc               func(ctx context.Context, p *CreateSnapshotParams) (*CreateSnapshotJob, error)
+	DeleteSnapshotFunc                    func(ctx context.Context, p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error)
+	DeleteSnapshotAsyncFunc               func(ctx context.Context, p *DeleteSnapshotParams) (*DeleteSnapshotJob, error)
+	RevertSnapshotFunc                    func(ctx context.Context, p *RevertSnapshotParams) (*RevertSnapshotResponse, error)
+	RevertSnapshotAsyncFunc               func(ctx context.Context, p *RevertSnapshotParams) (*RevertSnapshotJob, error)
+	CreateSnapshotFromVMSnapshotFunc      func(ctx context.Context, p *CreateSnapshotFromVMSnapshotParams) (*CreateSnapshotFromVMSnapshotResponse, error)
+	CreateSnapshotFromVMSnapshotAsyncFunc func(ctx context.Context, p *CreateSnapshotFromVMSnapshotParams) (*CreateSnapshotFromVMSnapshotJob, error)
+	GetSnapshotIDFunc                     func(name string, opts ...OptionFunc) (string, int, error)
+	GetSnapshotByNameFunc                 func(name string, opts ...OptionFunc) (*Snapshot, int, error)
+	GetSnapshotByIDFunc                   func(id string, opts ...OptionFunc) (*Snapshot, int, error)
+	ListSnapshotsFunc                     func(ctx context.Context, p *ListSnapshotsParams) (*ListSnapshotsResponse, error)
+	RevertToVMSnapshotFunc                func(ctx context.Context, p *RevertToVMSnapshotParams) (*RevertToVMSnapshotResponse, error)
+	RevertToVMSnapshotAsyncFunc           func(ctx context.Context, p *RevertToVMSnapshotParams) (*RevertToVMSnapshotJob, error)
+	CreateVMSnapshotFunc                  func(ctx context.Context, p *CreateVMSnapshotParams) (*CreateVMSnapshotResponse, error)
+	CreateVMSnapshotAsyncFunc             func(ctx context.Context, p *CreateVMSnapshotParams) (*CreateVMSnapshotJob, error)
+	DeleteVMSnapshotFunc                  func(ctx context.Context, p *DeleteVMSnapshotParams) (*DeleteVMSnapshotResponse, error)
+	DeleteVMSnapshotAsyncFunc             func(ctx context.Context, p *DeleteVMSnapshotParams) (*DeleteVMSnapshotJob, error)
+	GetVMSnapshotIDFunc                   func(name string, opts ...OptionFunc) (string, int, error)
+	ListVMSnapshotFunc                    func(ctx context.Context, p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error)
+}
+
+var _ SnapshotServiceIface = &MockSnapshotService{}
+
+func (m *MockSnapshotService) NewCreateSnapshotParams(volumeid string) *CreateSnapshotParams {
+	return (&SnapshotService{}).NewCreateSnapshotParams(volumeid)
+}
+
+func (m *MockSnapshotService) CreateSnapshot(p *CreateSnapshotParams) (*CreateSnapshotResponse, error) {
+	return m.CreateSnapshotWithContext(context.Background(), p)
+}
+
+func (m *MockSnapshotService) CreateSnapshotWithContext(ctx context.Context, p *CreateSnapshotParams) (*CreateSnapshotResponse, error) {
+	m.record("CreateSnapshot", p)
+	if m.CreateSnapshotFunc == nil {
+		return nil, notMocked("MockSnapshotService", "CreateSnapshot")
+	}
+	return m.CreateSnapshotFunc(ctx, p)
+}
+
+func (m *MockSnapshotService) CreateSnapshotAsync(ctx context.Context, p *CreateSnapshotParams) (*CreateSnapshotJob, error) {
+	m.record("CreateSnapshotAsync", p)
+	if m.CreateSnapshotAsyncFunc == nil {
+		return nil, notMocked("MockSnapshotService", "CreateSnapshotAsync")
+	}
+	return m.CreateSnapshotAsyncFunc(ctx, p)
+}
+
+func (m *MockSnapshotService) NewDeleteSnapshotParams(id string) *DeleteSnapshotParams {
+	return (&SnapshotService{}).NewDeleteSnapshotParams(id)
+}
+
+func (m *MockSnapshotService) DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error) {
+	return m.DeleteSnapshotWithContext(context.Background(), p)
+}
+
+func (m *MockSnapshotService) DeleteSnapshotWithContext(ctx context.Context, p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error) {
+	m.record("DeleteSnapshot", p)
+	if m.DeleteSnapshotFunc == nil {
+		return nil, notMocked("MockSnapshotService", "DeleteSnapshot")
+	}
+	return m.DeleteSnapshotFunc(ctx, p)
+}
+
+func (m *MockSnapshotService) DeleteSnapshotAsync(ctx context.Context, p *DeleteSnapshotParams) (*DeleteSnapshotJob, error) {
+	m.record("DeleteSnapshotAsync", p)
+	if m.DeleteSnapshotAsyncFunc == nil {
+		return nil, notMocked("MockSnapshotService", "DeleteSnapshotAsync")
+	}
+	return m.DeleteSnapshotAsyncFunc(ctx, p)
+}
+
+func (m *MockSnapshotService) NewRevertSnapshotParams(id string) *RevertSnapshotParams {
+	return (&SnapshotService{}).NewRevertSnapshotParams(id)
+}
+
+func (m *MockSnapshotService) RevertSnapshot(p *RevertSnapshotParams) (*RevertSnapshotResponse, error) {
+	return m.RevertSnapshotWithContext(context.Background(), p)
+}
+
+func (m *MockSnapshotService) RevertSnapshotWithContext(ctx context.Context, p *RevertSnapshotParams) (*RevertSnapshotResponse, error) {
+	m.record("RevertSnapshot", p)
+	if m.RevertSnapshotFunc == nil {
+		return nil, notMocked("MockSnapshotService", "RevertSnapshot")
+	}
+	return m.RevertSnapshotFunc(ctx, p)
+}
+
+func (m *MockSnapshotService) RevertSnapshotAsync(ctx context.Context, p *RevertSnapshotParams) (*RevertSnapshotJob, error) {
+	m.record("RevertSnapshotAsync", p)
+	if m.RevertSnapshotAsyncFunc == nil {
+		return nil, notMocked("MockSnapshotService", "RevertSnapshotAsync")
+	}
+	return m.RevertSnapshotAsyncFunc(ctx, p)
+}
+
+func (m *MockSnapshotService) NewCreateSnapshotFromVMSnapshotParams(vmsnapshotid string, volumeid string) *CreateSnapshotFromVMSnapshotParams {
+	return (&SnapshotService{}).NewCreateSnapshotFromVMSnapshotParams(vmsnapshotid, volumeid)
+}
+
+func (m *MockSnapshotService) CreateSnapshotFromVMSnapshot(p *CreateSnapshotFromVMSnapshotParams) (*CreateSnapshotFromVMSnapshotResponse, error) {
+	return m.CreateSnapshotFromVMSnapshotWithContext(context.Background(), p)
+}
+
+func (m *MockSnapshotService) CreateSnapshotFromVMSnapshotWithContext(ctx context.Context, p *CreateSnapshotFromVMSnapshotParams) (*CreateSnapshotFromVMSnapshotResponse, error) {
+	m.record("CreateSnapshotFromVMSnapshot", p)
+	if m.CreateSnapshotFromVMSnapshotFunc == nil {
+		return nil, notMocked("MockSnapshotService", "CreateSnapshotFromVMSnapshot")
+	}
+	return m.CreateSnapshotFromVMSnapshotFunc(ctx, p)
+}
+
+func (m *MockSnapshotService) CreateSnapshotFromVMSnapshotAsync(ctx context.Context, p *CreateSnapshotFromVMSnapshotParams) (*CreateSnapshotFromVMSnapshotJob, error) {
+	m.record("CreateSnapshotFromVMSnapshotAsync", p)
+	if m.CreateSnapshotFromVMSnapshotAsyncFunc == nil {
+		return nil, notMocked("MockSnapshotService", "CreateSnapshotFromVMSnapshotAsync")
+	}
+	return m.CreateSnapshotFromVMSnapshotAsyncFunc(ctx, p)
+}
+
+func (m *MockSnapshotService) NewListSnapshotsParams() *ListSnapshotsParams {
+	return (&SnapshotService{}).NewListSnapshotsParams()
+}
+
+func (m *MockSnapshotService) GetSnapshotID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetSnapshotID", name, opts)
+	if m.GetSnapshotIDFunc == nil {
+		return "", -1, notMocked("MockSnapshotService", "GetSnapshotID")
+	}
+	return m.GetSnapshotIDFunc(name, opts...)
+}
+
+func (m *MockSnapshotService) GetSnapshotByName(name string, opts ...OptionFunc) (*Snapshot, int, error) {
+	m.record("GetSnapshotByName", name, opts)
+	if m.GetSnapshotByNameFunc == nil {
+		return nil, -1, notMocked("MockSnapshotService", "GetSnapshotByName")
+	}
+	return m.GetSnapshotByNameFunc(name, opts...)
+}
+
+func (m *MockSnapshotService) GetSnapshotByID(id string, opts ...OptionFunc) (*Snapshot, int, error) {
+	m.record("GetSnapshotByID", id, opts)
+	if m.GetSnapshotByIDFunc == nil {
+		return nil, -1, notMocked("MockSnapshotService", "GetSnapshotByID")
+	}
+	return m.GetSnapshotByIDFunc(id, opts...)
+}
+
+func (m *MockSnapshotService) ListSnapshots(p *ListSnapshotsParams) (*ListSnapshotsResponse, error) {
+	return m.ListSnapshotsWithContext(context.Background(), p)
+}
+
+func (m *MockSnapshotService) ListSnapshotsWithContext(ctx context.Context, p *ListSnapshotsParams) (*ListSnapshotsResponse, error) {
+	m.record("ListSnapshots", p)
+	if m.ListSnapshotsFunc == nil {
+		return nil, notMocked("MockSnapshotService", "ListSnapshots")
+	}
+	return m.ListSnapshotsFunc(ctx, p)
+}
+
+func (m *MockSnapshotService) NewListSnapshotsIterator(p *ListSnapshotsParams, pagesize int) *ListSnapshotsIterator {
+	i := &ListSnapshotsIterator{pager: newPager(nil, "listSnapshots", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListSnapshotsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListSnapshotsWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockSnapshotService) NewRevertToVMSnapshotParams(vmsnapshotid string) *RevertToVMSnapshotParams {
+	return (&SnapshotService{}).NewRevertToVMSnapshotParams(vmsnapshotid)
+}
+
+func (m *MockSnapshotService) RevertToVMSnapshot(p *RevertToVMSnapshotParams) (*RevertToVMSnapshotResponse, error) {
+	return m.RevertToVMSnapshotWithContext(context.Background(), p)
+}
+
+func (m *MockSnapshotService) RevertToVMSnapshotWithContext(ctx context.Context, p *RevertToVMSnapshotParams) (*RevertToVMSnapshotResponse, error) {
+	m.record("RevertToVMSnapshot", p)
+	if m.RevertToVMSnapshotFunc == nil {
+		return nil, notMocked("MockSnapshotService", "RevertToVMSnapshot")
+	}
+	return m.RevertToVMSnapshotFunc(ctx, p)
+}
+
+func (m *MockSnapshotService) RevertToVMSnapshotAsync(ctx context.Context, p *RevertToVMSnapshotParams) (*RevertToVMSnapshotJob, error) {
+	m.record("RevertToVMSnapshotAsync", p)
+	if m.RevertToVMSnapshotAsyncFunc == nil {
+		return nil, notMocked("MockSnapshotService", "RevertToVMSnapshotAsync")
+	}
+	return m.RevertToVMSnapshotAsyncFunc(ctx, p)
+}
+
+func (m *MockSnapshotService) NewCreateVMSnapshotParams(virtualmachineid string) *CreateVMSnapshotParams {
+	return (&SnapshotService{}).NewCreateVMSnapshotParams(virtualmachineid)
+}
+
+func (m *MockSnapshotService) CreateVMSnapshot(p *CreateVMSnapshotParams) (*CreateVMSnapshotResponse, error) {
+	return m.CreateVMSnapshotWithContext(context.Background(), p)
+}
+
+func (m *MockSnapshotService) CreateVMSnapshotWithContext(ctx context.Context, p *CreateVMSnapshotParams) (*CreateVMSnapshotResponse, error) {
+	m.record("CreateVMSnapshot", p)
+	if m.CreateVMSnapshotFunc == nil {
+		return nil, notMocked("MockSnapshotService", "CreateVMSnapshot")
+	}
+	return m.CreateVMSnapshotFunc(ctx, p)
+}
+
+func (m *MockSnapshotService) CreateVMSnapshotAsync(ctx context.Context, p *CreateVMSnapshotParams) (*CreateVMSnapshotJob, error) {
+	m.record("CreateVMSnapshotAsync", p)
+	if m.CreateVMSnapshotAsyncFunc == nil {
+		return nil, notMocked("MockSnapshotService", "CreateVMSnapshotAsync")
+	}
+	return m.CreateVMSnapshotAsyncFunc(ctx, p)
+}
+
+func (m *MockSnapshotService) NewDeleteVMSnapshotParams(vmsnapshotid string) *DeleteVMSnapshotParams {
+	return (&SnapshotService{}).NewDeleteVMSnapshotParams(vmsnapshotid)
+}
+
+func (m *MockSnapshotService) DeleteVMSnapshot(p *DeleteVMSnapshotParams) (*DeleteVMSnapshotResponse, error) {
+	return m.DeleteVMSnapshotWithContext(context.Background(), p)
+}
+
+func (m *MockSnapshotService) DeleteVMSnapshotWithContext(ctx context.Context, p *DeleteVMSnapshotParams) (*DeleteVMSnapshotResponse, error) {
+	m.record("DeleteVMSnapshot", p)
+	if m.DeleteVMSnapshotFunc == nil {
+		return nil, notMocked("MockSnapshotService", "DeleteVMSnapshot")
+	}
+	return m.DeleteVMSnapshotFunc(ctx, p)
+}
+
+func (m *MockSnapshotService) DeleteVMSnapshotAsync(ctx context.Context, p *DeleteVMSnapshotParams) (*DeleteVMSnapshotJob, error) {
+	m.record("DeleteVMSnapshotAsync", p)
+	if m.DeleteVMSnapshotAsyncFunc == nil {
+		return nil, notMocked("MockSnapshotService", "DeleteVMSnapshotAsync")
+	}
+	return m.DeleteVMSnapshotAsyncFunc(ctx, p)
+}
+
+func (m *MockSnapshotService) NewListVMSnapshotParams() *ListVMSnapshotParams {
+	return (&SnapshotService{}).NewListVMSnapshotParams()
+}
+
+func (m *MockSnapshotService) GetVMSnapshotID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetVMSnapshotID", name, opts)
+	if m.GetVMSnapshotIDFunc == nil {
+		return "", -1, notMocked("MockSnapshotService", "GetVMSnapshotID")
+	}
+	return m.GetVMSnapshotIDFunc(name, opts...)
+}
+
+func (m *MockSnapshotService) ListVMSnapshot(p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error) {
+	return m.ListVMSnapshotWithContext(context.Background(), p)
+}
+
+func (m *MockSnapshotService) ListVMSnapshotWithContext(ctx context.Context, p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error) {
+	m.record("ListVMSnapshot", p)
+	if m.ListVMSnapshotFunc == nil {
+		return nil, notMocked("MockSnapshotService", "ListVMSnapshot")
+	}
+	return m.ListVMSnapshotFunc(ctx, p)
+}
+
+func (m *MockSnapshotService) NewListVMSnapshotIterator(p *ListVMSnapshotParams, pagesize int) *ListVMSnapshotIterator {
+	i := &ListVMSnapshotIterator{pager: newPager(nil, "listVMSnapshot", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListVMSnapshotParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListVMSnapshotWithContext(ctx, c)
+	}
+	return i
+}
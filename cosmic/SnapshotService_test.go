@@ -0,0 +1,207 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCreateSnapshotParams(t *testing.T) {
+	p := &CreateSnapshotParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetVolumeid("volumeid-value")
+	want.Set("volumeid", "volumeid-value")
+
+	assertParams(t, p, "createSnapshot", want)
+}
+
+func TestCreateSnapshotResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","intervaltype":"intervaltype-value","jobid":"jobid-value","name":"name-value","physicalsize":1099511627776,"project":"project-value","projectid":"projectid-value","revertable":true,"snapshottype":"snapshottype-value","state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"volumeid":"volumeid-value","volumename":"volumename-value","volumetype":"volumetype-value","zoneid":"zoneid-value"}`, &CreateSnapshotResponse{})
+}
+
+func TestDeleteSnapshotParams(t *testing.T) {
+	p := &DeleteSnapshotParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteSnapshot", want)
+}
+
+func TestDeleteSnapshotResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteSnapshotResponse{})
+}
+
+func TestRevertSnapshotParams(t *testing.T) {
+	p := &RevertSnapshotParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "revertSnapshot", want)
+}
+
+func TestRevertSnapshotResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","intervaltype":"intervaltype-value","jobid":"jobid-value","name":"name-value","physicalsize":1099511627776,"project":"project-value","projectid":"projectid-value","revertable":true,"snapshottype":"snapshottype-value","state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"volumeid":"volumeid-value","volumename":"volumename-value","volumetype":"volumetype-value","zoneid":"zoneid-value"}`, &RevertSnapshotResponse{})
+}
+
+func TestCreateSnapshotFromVMSnapshotParams(t *testing.T) {
+	p := &CreateSnapshotFromVMSnapshotParams{}
+	want := url.Values{}
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetVmsnapshotid("vmsnapshotid-value")
+	want.Set("vmsnapshotid", "vmsnapshotid-value")
+	p.SetVolumeid("volumeid-value")
+	want.Set("volumeid", "volumeid-value")
+
+	assertParams(t, p, "createSnapshotFromVMSnapshot", want)
+}
+
+func TestCreateSnapshotFromVMSnapshotResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","intervaltype":"intervaltype-value","jobid":"jobid-value","name":"name-value","physicalsize":1099511627776,"project":"project-value","projectid":"projectid-value","revertable":true,"snapshottype":"snapshottype-value","state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"volumeid":"volumeid-value","volumename":"volumename-value","volumetype":"volumetype-value","zoneid":"zoneid-value"}`, &CreateSnapshotFromVMSnapshotResponse{})
+}
+
+func TestListSnapshotsParams(t *testing.T) {
+	p := &ListSnapshotsParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIntervaltype("intervaltype-value")
+	want.Set("intervaltype", "intervaltype-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetSnapshottype("snapshottype-value")
+	want.Set("snapshottype", "snapshottype-value")
+	p.SetTags(map[string]string{"k": "v"})
+	want.Set("tags[0].key", "k")
+	want.Set("tags[0].value", "v")
+	p.SetVolumeid("volumeid-value")
+	want.Set("volumeid", "volumeid-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listSnapshots", want)
+}
+
+func TestListSnapshotsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"snapshot":[{"account":"account-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","intervaltype":"intervaltype-value","name":"name-value","physicalsize":1099511627776,"project":"project-value","projectid":"projectid-value","revertable":true,"snapshottype":"snapshottype-value","state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"volumeid":"volumeid-value","volumename":"volumename-value","volumetype":"volumetype-value","zoneid":"zoneid-value"}]}`, &ListSnapshotsResponse{})
+}
+
+func TestRevertToVMSnapshotParams(t *testing.T) {
+	p := &RevertToVMSnapshotParams{}
+	want := url.Values{}
+	p.SetVmsnapshotid("vmsnapshotid-value")
+	want.Set("vmsnapshotid", "vmsnapshotid-value")
+
+	assertParams(t, p, "revertToVMSnapshot", want)
+}
+
+func TestRevertToVMSnapshotResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","affinitygroup":[{"account":"account-value","description":"description-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","name":"name-value","project":"project-value","projectid":"projectid-value","type":"type-value","virtualmachineIds":["a","b"]}],"bootmenutimeout":1099511627776,"cpunumber":42,"cpuused":"cpuused-value","created":"created-value","details":{"key":"value"},"diskioread":1099511627776,"diskiowrite":1099511627776,"diskkbsread":1099511627776,"diskkbswrite":1099511627776,"diskofferingid":"diskofferingid-value","diskofferingname":"diskofferingname-value","displayname":"displayname-value","displayvm":true,"domain":"domain-value","domainid":"domainid-value","forvirtualnetwork":true,"group":"group-value","groupid":"groupid-value","guestosid":"guestosid-value","haenable":true,"hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","instancename":"instancename-value","isdynamicallyscalable":true,"isodisplaytext":"isodisplaytext-value","isoid":"isoid-value","isoname":"isoname-value","jobid":"jobid-value","keypair":"keypair-value","laststartdate":"laststartdate-value","laststartversion":"laststartversion-value","maintenancepolicy":"maintenancepolicy-value","manufacturerstring":"manufacturerstring-value","memory":42,"name":"name-value","networkkbsread":1099511627776,"networkkbswrite":1099511627776,"nic":[{"broadcasturi":"broadcasturi-value","gateway":"gateway-value","id":"id-value","ip6address":"ip6address-value","ip6cidr":"ip6cidr-value","ip6gateway":"ip6gateway-value","ipaddress":"ipaddress-value","isdefault":true,"isolationuri":"isolationuri-value","macaddress":"macaddress-value","netmask":"netmask-value","networkid":"networkid-value","networkname":"networkname-value","secondaryip":[{"id":"id-value","ipaddress":"ipaddress-value"}],"traffictype":"traffictype-value","type":"type-value","virtualmachineid":"virtualmachineid-value"}],"optimisefor":"optimisefor-value","ostypeid":1099511627776,"password":"password-value","passwordenabled":true,"project":"project-value","projectid":"projectid-value","publicip":"publicip-value","publicipid":"publicipid-value","restartrequired":true,"rootdevicecontroller":"rootdevicecontroller-value","rootdeviceid":1099511627776,"rootdevicetype":"rootdevicetype-value","serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","servicestate":"servicestate-value","state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templatedisplaytext":"templatedisplaytext-value","templateid":"templateid-value","templatename":"templatename-value","userid":"userid-value","username":"username-value","vgpu":"vgpu-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &RevertToVMSnapshotResponse{})
+}
+
+func TestCreateVMSnapshotParams(t *testing.T) {
+	p := &CreateVMSnapshotParams{}
+	want := url.Values{}
+	p.SetDescription("description-value")
+	want.Set("description", "description-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetVirtualmachineid("virtualmachineid-value")
+	want.Set("virtualmachineid", "virtualmachineid-value")
+
+	assertParams(t, p, "createVMSnapshot", want)
+}
+
+func TestCreateVMSnapshotResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","created":"created-value","current":true,"description":"description-value","displayname":"displayname-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","jobid":"jobid-value","name":"name-value","parent":"parent-value","parentName":"parentName-value","project":"project-value","projectid":"projectid-value","state":"state-value","type":"type-value","virtualmachineid":"virtualmachineid-value","zoneid":"zoneid-value"}`, &CreateVMSnapshotResponse{})
+}
+
+func TestDeleteVMSnapshotParams(t *testing.T) {
+	p := &DeleteVMSnapshotParams{}
+	want := url.Values{}
+	p.SetVmsnapshotid("vmsnapshotid-value")
+	want.Set("vmsnapshotid", "vmsnapshotid-value")
+
+	assertParams(t, p, "deleteVMSnapshot", want)
+}
+
+func TestDeleteVMSnapshotResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteVMSnapshotResponse{})
+}
+
+func TestListVMSnapshotParams(t *testing.T) {
+	p := &ListVMSnapshotParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetState("state-value")
+	want.Set("state", "state-value")
+	p.SetTags(map[string]string{"k": "v"})
+	want.Set("tags[0].key", "k")
+	want.Set("tags[0].value", "v")
+	p.SetVirtualmachineid("virtualmachineid-value")
+	want.Set("virtualmachineid", "virtualmachineid-value")
+	p.SetVmsnapshotid("vmsnapshotid-value")
+	want.Set("vmsnapshotid", "vmsnapshotid-value")
+
+	assertParams(t, p, "listVMSnapshot", want)
+}
+
+func TestListVMSnapshotResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"vmsnapshot":[{"account":"account-value","created":"created-value","current":true,"description":"description-value","displayname":"displayname-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","name":"name-value","parent":"parent-value","parentName":"parentName-value","project":"project-value","projectid":"projectid-value","state":"state-value","type":"type-value","virtualmachineid":"virtualmachineid-value","zoneid":"zoneid-value"}]}`, &ListVMSnapshotResponse{})
+}
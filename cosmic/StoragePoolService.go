@@ -17,17 +17,58 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 )
 
+// StoragePoolServiceIface is the interface implemented by StoragePoolService, and by MockStoragePoolService for tests
+type StoragePoolServiceIface interface {
+	NewCancelStorageMaintenanceParams(id string) *CancelStorageMaintenanceParams
+	CancelStorageMaintenance(p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceResponse, error)
+	CancelStorageMaintenanceWithContext(ctx context.Context, p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceResponse, error)
+	CancelStorageMaintenanceAsync(ctx context.Context, p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceJob, error)
+	NewEnableStorageMaintenanceParams(id string) *EnableStorageMaintenanceParams
+	EnableStorageMaintenance(p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceResponse, error)
+	EnableStorageMaintenanceWithContext(ctx context.Context, p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceResponse, error)
+	EnableStorageMaintenanceAsync(ctx context.Context, p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceJob, error)
+	NewCreateStoragePoolParams(name string, url string, zoneid string) *CreateStoragePoolParams
+	CreateStoragePool(p *CreateStoragePoolParams) (*CreateStoragePoolResponse, error)
+	CreateStoragePoolWithContext(ctx context.Context, p *CreateStoragePoolParams) (*CreateStoragePoolResponse, error)
+	NewDeleteStoragePoolParams(id string) *DeleteStoragePoolParams
+	DeleteStoragePool(p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error)
+	DeleteStoragePoolWithContext(ctx context.Context, p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error)
+	NewUpdateStoragePoolParams(id string) *UpdateStoragePoolParams
+	UpdateStoragePool(p *UpdateStoragePoolParams) (*UpdateStoragePoolResponse, error)
+	UpdateStoragePoolWithContext(ctx context.Context, p *UpdateStoragePoolParams) (*UpdateStoragePoolResponse, error)
+	NewListStoragePoolsParams() *ListStoragePoolsParams
+	GetStoragePoolID(name string, opts ...OptionFunc) (string, int, error)
+	GetStoragePoolByName(name string, opts ...OptionFunc) (*StoragePool, int, error)
+	GetStoragePoolByID(id string, opts ...OptionFunc) (*StoragePool, int, error)
+	ListStoragePools(p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error)
+	ListStoragePoolsWithContext(ctx context.Context, p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error)
+	NewListStoragePoolsIterator(p *ListStoragePoolsParams, pagesize int) *ListStoragePoolsIterator
+	NewFindStoragePoolsForMigrationParams(id string) *FindStoragePoolsForMigrationParams
+	FindStoragePoolsForMigration(p *FindStoragePoolsForMigrationParams) (*FindStoragePoolsForMigrationResponse, error)
+	FindStoragePoolsForMigrationWithContext(ctx context.Context, p *FindStoragePoolsForMigrationParams) (*FindStoragePoolsForMigrationResponse, error)
+	NewListStorageProvidersParams(storagePoolType string) *ListStorageProvidersParams
+	ListStorageProviders(p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error)
+	ListStorageProvidersWithContext(ctx context.Context, p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error)
+	NewListStorageProvidersIterator(p *ListStorageProvidersParams, pagesize int) *ListStorageProvidersIterator
+}
+
 type CancelStorageMaintenanceParams struct {
 	p map[string]interface{}
 }
 
+func (p *CancelStorageMaintenanceParams) command() string {
+	return "cancelStorageMaintenance"
+}
+
 func (p *CancelStorageMaintenanceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -57,7 +98,13 @@ func (s *StoragePoolService) NewCancelStorageMaintenanceParams(id string) *Cance
 
 // Cancels maintenance for primary storage
 func (s *StoragePoolService) CancelStorageMaintenance(p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceResponse, error) {
-	resp, err := s.cs.newRequest("cancelStorageMaintenance", p.toURLValues())
+	return s.CancelStorageMaintenanceWithContext(context.Background(), p)
+}
+
+// CancelStorageMaintenanceWithContext is the same as CancelStorageMaintenance, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *StoragePoolService) CancelStorageMaintenanceWithContext(ctx context.Context, p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "cancelStorageMaintenance", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -69,26 +116,56 @@ func (s *StoragePoolService) CancelStorageMaintenance(p *CancelStorageMaintenanc
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "cancelStorageMaintenance", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "storagepool", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CancelStorageMaintenanceJob is a handle to a running cancelStorageMaintenance async job
+type CancelStorageMaintenanceJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CancelStorageMaintenanceResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CancelStorageMaintenanceJob) Result() (*CancelStorageMaintenanceResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CancelStorageMaintenanceResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "storagepool", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CancelStorageMaintenanceAsync starts the cancelStorageMaintenance async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *StoragePoolService) CancelStorageMaintenanceAsync(ctx context.Context, p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceJob, error) {
+	resp, err := s.cs.newRequest(ctx, "cancelStorageMaintenance", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CancelStorageMaintenanceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CancelStorageMaintenanceJob{s.cs.newJobHandle("cancelStorageMaintenance", r.JobID)}, nil
+}
+
 type CancelStorageMaintenanceResponse struct {
 	JobID                string            `json:"jobid,omitempty"`
 	Capacityiops         int64             `json:"capacityiops,omitempty"`
@@ -120,6 +197,10 @@ type EnableStorageMaintenanceParams struct {
 	p map[string]interface{}
 }
 
+func (p *EnableStorageMaintenanceParams) command() string {
+	return "enableStorageMaintenance"
+}
+
 func (p *EnableStorageMaintenanceParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -149,7 +230,13 @@ func (s *StoragePoolService) NewEnableStorageMaintenanceParams(id string) *Enabl
 
 // Puts storage pool into maintenance state
 func (s *StoragePoolService) EnableStorageMaintenance(p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceResponse, error) {
-	resp, err := s.cs.newRequest("enableStorageMaintenance", p.toURLValues())
+	return s.EnableStorageMaintenanceWithContext(context.Background(), p)
+}
+
+// EnableStorageMaintenanceWithContext is the same as EnableStorageMaintenance, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *StoragePoolService) EnableStorageMaintenanceWithContext(ctx context.Context, p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "enableStorageMaintenance", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -161,26 +248,56 @@ func (s *StoragePoolService) EnableStorageMaintenance(p *EnableStorageMaintenanc
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "enableStorageMaintenance", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "storagepool", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// EnableStorageMaintenanceJob is a handle to a running enableStorageMaintenance async job
+type EnableStorageMaintenanceJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a EnableStorageMaintenanceResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *EnableStorageMaintenanceJob) Result() (*EnableStorageMaintenanceResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := EnableStorageMaintenanceResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "storagepool", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// EnableStorageMaintenanceAsync starts the enableStorageMaintenance async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *StoragePoolService) EnableStorageMaintenanceAsync(ctx context.Context, p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceJob, error) {
+	resp, err := s.cs.newRequest(ctx, "enableStorageMaintenance", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r EnableStorageMaintenanceResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &EnableStorageMaintenanceJob{s.cs.newJobHandle("enableStorageMaintenance", r.JobID)}, nil
+}
+
 type EnableStorageMaintenanceResponse struct {
 	JobID                string            `json:"jobid,omitempty"`
 	Capacityiops         int64             `json:"capacityiops,omitempty"`
@@ -212,6 +329,10 @@ type CreateStoragePoolParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateStoragePoolParams) command() string {
+	return "createStoragePool"
+}
+
 func (p *CreateStoragePoolParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -371,7 +492,13 @@ func (s *StoragePoolService) NewCreateStoragePoolParams(name string, url string,
 
 // Creates a storage pool.
 func (s *StoragePoolService) CreateStoragePool(p *CreateStoragePoolParams) (*CreateStoragePoolResponse, error) {
-	resp, err := s.cs.newRequest("createStoragePool", p.toURLValues())
+	return s.CreateStoragePoolWithContext(context.Background(), p)
+}
+
+// CreateStoragePoolWithContext is the same as CreateStoragePool, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *StoragePoolService) CreateStoragePoolWithContext(ctx context.Context, p *CreateStoragePoolParams) (*CreateStoragePoolResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createStoragePool", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -413,6 +540,10 @@ type DeleteStoragePoolParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteStoragePoolParams) command() string {
+	return "deleteStoragePool"
+}
+
 func (p *DeleteStoragePoolParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -453,7 +584,13 @@ func (s *StoragePoolService) NewDeleteStoragePoolParams(id string) *DeleteStorag
 
 // Deletes a storage pool.
 func (s *StoragePoolService) DeleteStoragePool(p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error) {
-	resp, err := s.cs.newRequest("deleteStoragePool", p.toURLValues())
+	return s.DeleteStoragePoolWithContext(context.Background(), p)
+}
+
+// DeleteStoragePoolWithContext is the same as DeleteStoragePool, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *StoragePoolService) DeleteStoragePoolWithContext(ctx context.Context, p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteStoragePool", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -474,6 +611,10 @@ type UpdateStoragePoolParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateStoragePoolParams) command() string {
+	return "updateStoragePool"
+}
+
 func (p *UpdateStoragePoolParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -547,7 +688,13 @@ func (s *StoragePoolService) NewUpdateStoragePoolParams(id string) *UpdateStorag
 
 // Updates a storage pool.
 func (s *StoragePoolService) UpdateStoragePool(p *UpdateStoragePoolParams) (*UpdateStoragePoolResponse, error) {
-	resp, err := s.cs.newRequest("updateStoragePool", p.toURLValues())
+	return s.UpdateStoragePoolWithContext(context.Background(), p)
+}
+
+// UpdateStoragePoolWithContext is the same as UpdateStoragePool, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *StoragePoolService) UpdateStoragePoolWithContext(ctx context.Context, p *UpdateStoragePoolParams) (*UpdateStoragePoolResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateStoragePool", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -589,6 +736,10 @@ type ListStoragePoolsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListStoragePoolsParams) command() string {
+	return "listStoragePools"
+}
+
 func (p *ListStoragePoolsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -736,7 +887,7 @@ func (s *StoragePoolService) GetStoragePoolID(name string, opts ...OptionFunc) (
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -750,7 +901,7 @@ func (s *StoragePoolService) GetStoragePoolID(name string, opts ...OptionFunc) (
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -782,16 +933,14 @@ func (s *StoragePoolService) GetStoragePoolByID(id string, opts ...OptionFunc) (
 
 	l, err := s.ListStoragePools(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -803,10 +952,17 @@ func (s *StoragePoolService) GetStoragePoolByID(id string, opts ...OptionFunc) (
 
 // Lists storage pools.
 func (s *StoragePoolService) ListStoragePools(p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error) {
+	return s.ListStoragePoolsWithContext(context.Background(), p)
+}
+
+// ListStoragePoolsWithContext is the same as ListStoragePools, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *StoragePoolService) ListStoragePoolsWithContext(ctx context.Context, p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error) {
 	var r ListStoragePoolsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListStoragePoolsResponse
-		resp, err := s.cs.newRequest("listStoragePools", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listStoragePools", values)
 		if err != nil {
 			return nil, err
 		}
@@ -818,13 +974,89 @@ func (s *StoragePoolService) ListStoragePools(p *ListStoragePoolsParams) (*ListS
 		r.Count = l.Count
 		r.StoragePools = append(r.StoragePools, l.StoragePools...)
 
-		if r.Count == len(r.StoragePools) {
+		if r.Count == len(r.StoragePools) || len(l.StoragePools) == 0 {
+			s.cs.observePages("listStoragePools", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.StoragePools))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.StoragePools)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listStoragePools", values, len(l.StoragePools), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListStoragePoolsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.StoragePools = append(r.StoragePools, l.StoragePools...)
+			}
+
+			if r.Count == len(r.StoragePools) {
+				s.cs.observePages("listStoragePools", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListStoragePoolsIterator iterates over the items returned by listStoragePools, requesting
+// the pages of the response one at a time while iterating.
+type ListStoragePoolsIterator struct {
+	pager
+	items []*StoragePool
+	index int
+}
+
+// NewListStoragePoolsIterator returns an iterator over the items returned by listStoragePools, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *StoragePoolService) NewListStoragePoolsIterator(p *ListStoragePoolsParams, pagesize int) *ListStoragePoolsIterator {
+	return &ListStoragePoolsIterator{pager: newPager(s.cs, "listStoragePools", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListStoragePoolsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListStoragePoolsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.StoragePools))
+
+	i.items = l.StoragePools
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListStoragePoolsIterator) Value() *StoragePool {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListStoragePoolsResponse struct {
@@ -862,6 +1094,10 @@ type FindStoragePoolsForMigrationParams struct {
 	p map[string]interface{}
 }
 
+func (p *FindStoragePoolsForMigrationParams) command() string {
+	return "findStoragePoolsForMigration"
+}
+
 func (p *FindStoragePoolsForMigrationParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -923,7 +1159,13 @@ func (s *StoragePoolService) NewFindStoragePoolsForMigrationParams(id string) *F
 
 // Lists storage pools available for migration of a volume.
 func (s *StoragePoolService) FindStoragePoolsForMigration(p *FindStoragePoolsForMigrationParams) (*FindStoragePoolsForMigrationResponse, error) {
-	resp, err := s.cs.newRequest("findStoragePoolsForMigration", p.toURLValues())
+	return s.FindStoragePoolsForMigrationWithContext(context.Background(), p)
+}
+
+// FindStoragePoolsForMigrationWithContext is the same as FindStoragePoolsForMigration, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *StoragePoolService) FindStoragePoolsForMigrationWithContext(ctx context.Context, p *FindStoragePoolsForMigrationParams) (*FindStoragePoolsForMigrationResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "findStoragePoolsForMigration", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -965,6 +1207,10 @@ type ListStorageProvidersParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListStorageProvidersParams) command() string {
+	return "listStorageProviders"
+}
+
 func (p *ListStorageProvidersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1026,10 +1272,17 @@ func (s *StoragePoolService) NewListStorageProvidersParams(storagePoolType strin
 
 // Lists storage providers.
 func (s *StoragePoolService) ListStorageProviders(p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error) {
+	return s.ListStorageProvidersWithContext(context.Background(), p)
+}
+
+// ListStorageProvidersWithContext is the same as ListStorageProviders, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *StoragePoolService) ListStorageProvidersWithContext(ctx context.Context, p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error) {
 	var r ListStorageProvidersResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListStorageProvidersResponse
-		resp, err := s.cs.newRequest("listStorageProviders", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listStorageProviders", values)
 		if err != nil {
 			return nil, err
 		}
@@ -1041,13 +1294,89 @@ func (s *StoragePoolService) ListStorageProviders(p *ListStorageProvidersParams)
 		r.Count = l.Count
 		r.StorageProviders = append(r.StorageProviders, l.StorageProviders...)
 
-		if r.Count == len(r.StorageProviders) {
+		if r.Count == len(r.StorageProviders) || len(l.StorageProviders) == 0 {
+			s.cs.observePages("listStorageProviders", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.StorageProviders))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.StorageProviders)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listStorageProviders", values, len(l.StorageProviders), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListStorageProvidersResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.StorageProviders = append(r.StorageProviders, l.StorageProviders...)
+			}
+
+			if r.Count == len(r.StorageProviders) {
+				s.cs.observePages("listStorageProviders", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListStorageProvidersIterator iterates over the items returned by listStorageProviders, requesting
+// the pages of the response one at a time while iterating.
+type ListStorageProvidersIterator struct {
+	pager
+	items []*StorageProvider
+	index int
+}
+
+// NewListStorageProvidersIterator returns an iterator over the items returned by listStorageProviders, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *StoragePoolService) NewListStorageProvidersIterator(p *ListStorageProvidersParams, pagesize int) *ListStorageProvidersIterator {
+	return &ListStorageProvidersIterator{pager: newPager(s.cs, "listStorageProviders", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListStorageProvidersIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListStorageProvidersResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.StorageProviders))
+
+	i.items = l.StorageProviders
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListStorageProvidersIterator) Value() *StorageProvider {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListStorageProvidersResponse struct {
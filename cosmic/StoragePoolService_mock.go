@@ -0,0 +1,238 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockStoragePoolService is a mock of StoragePoolServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockStoragePoolService struct {
+	mockCalls
+
+	CancelStorageMaintenanceFunc      func(ctx context.Context, p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceResponse, error)
+	CancelStorageMaintenanceAsyncFunc func(ctx context.Context, p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceJob, error)
+	EnableStorageMaintenanceFunc      func(ctx context.Context, p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceResponse, error)
+	EnableStorageMaintenanceAsyncFunc func(ctx context.Context, p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceJob, error)
+	CreateStoragePoolFunc             func(ctx context.Context, p *CreateStoragePoolParams) (*CreateStoragePoolResponse, error)
+	DeleteStoragePoolFunc             func(ctx context.Context, p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error)
+	UpdateStoragePoolFunc             func(ctx context.Context, p *UpdateStoragePoolParams) (*UpdateStoragePoolResponse, error)
+	GetStoragePoolIDFunc              func(name string, opts ...OptionFunc) (string, int, error)
+	GetStoragePoolByNameFunc          func(name string, opts ...OptionFunc) (*StoragePool, int, error)
+	GetStoragePoolByIDFunc            func(id string, opts ...OptionFunc) (*StoragePool, int, error)
+	ListStoragePoolsFunc              func(ctx context.Context, p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error)
+	FindStoragePoolsForMigrationFunc  func(ctx context.Context, p *FindStoragePoolsForMigrationParams) (*FindStoragePoolsForMigrationResponse, error)
+	ListStorageProvidersFunc          func(ctx context.Context, p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error)
+}
+
+var _ StoragePoolServiceIface = &MockStoragePoolService{}
+
+func (m *MockStoragePoolService) NewCancelStorageMaintenanceParams(id string) *CancelStorageMaintenanceParams {
+	return (&StoragePoolService{}).NewCancelStorageMaintenanceParams(id)
+}
+
+func (m *MockStoragePoolService) CancelStorageMaintenance(p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceResponse, error) {
+	return m.CancelStorageMaintenanceWithContext(context.Background(), p)
+}
+
+func (m *MockStoragePoolService) CancelStorageMaintenanceWithContext(ctx context.Context, p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceResponse, error) {
+	m.record("CancelStorageMaintenance", p)
+	if m.CancelStorageMaintenanceFunc == nil {
+		return nil, notMocked("MockStoragePoolService", "CancelStorageMaintenance")
+	}
+	return m.CancelStorageMaintenanceFunc(ctx, p)
+}
+
+func (m *MockStoragePoolService) CancelStorageMaintenanceAsync(ctx context.Context, p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceJob, error) {
+	m.record("CancelStorageMaintenanceAsync", p)
+	if m.CancelStorageMaintenanceAsyncFunc == nil {
+		return nil, notMocked("MockStoragePoolService", "CancelStorageMaintenanceAsync")
+	}
+	return m.CancelStorageMaintenanceAsyncFunc(ctx, p)
+}
+
+func (m *MockStoragePoolService) NewEnableStorageMaintenanceParams(id string) *EnableStorageMaintenanceParams {
+	return (&StoragePoolService{}).NewEnableStorageMaintenanceParams(id)
+}
+
+func (m *MockStoragePoolService) EnableStorageMaintenance(p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceResponse, error) {
+	return m.EnableStorageMaintenanceWithContext(context.Background(), p)
+}
+
+func (m *MockStoragePoolService) EnableStorageMaintenanceWithContext(ctx context.Context, p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceResponse, error) {
+	m.record("EnableStorageMaintenance", p)
+	if m.EnableStorageMaintenanceFunc == nil {
+		return nil, notMocked("MockStoragePoolService", "EnableStorageMaintenance")
+	}
+	return m.EnableStorageMaintenanceFunc(ctx, p)
+}
+
+func (m *MockStoragePoolService) EnableStorageMaintenanceAsync(ctx context.Context, p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceJob, error) {
+	m.record("EnableStorageMaintenanceAsync", p)
+	if m.EnableStorageMaintenanceAsyncFunc == nil {
+		return nil, notMocked("MockStoragePoolService", "EnableStorageMaintenanceAsync")
+	}
+	return m.EnableStorageMaintenanceAsyncFunc(ctx, p)
+}
+
+func (m *MockStoragePoolService) NewCreateStoragePoolParams(name string, url string, zoneid string) *CreateStoragePoolParams {
+	return (&StoragePoolService{}).NewCreateStoragePoolParams(name, url, zoneid)
+}
+
+func (m *MockStoragePoolService) CreateStoragePool(p *CreateStoragePoolParams) (*CreateStoragePoolResponse, error) {
+	return m.CreateStoragePoolWithContext(context.Background(), p)
+}
+
+func (m *MockStoragePoolService) CreateStoragePoolWithContext(ctx context.Context, p *CreateStoragePoolParams) (*CreateStoragePoolResponse, error) {
+	m.record("CreateStoragePool", p)
+	if m.CreateStoragePoolFunc == nil {
+		return nil, notMocked("MockStoragePoolService", "CreateStoragePool")
+	}
+	return m.CreateStoragePoolFunc(ctx, p)
+}
+
+func (m *MockStoragePoolService) NewDeleteStoragePoolParams(id string) *DeleteStoragePoolParams {
+	return (&StoragePoolService{}).NewDeleteStoragePoolParams(id)
+}
+
+func (m *MockStoragePoolService) DeleteStoragePool(p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error) {
+	return m.DeleteStoragePoolWithContext(context.Background(), p)
+}
+
+func (m *MockStoragePoolService) DeleteStoragePoolWithContext(ctx context.Context, p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error) {
+	m.record("DeleteStoragePool", p)
+	if m.DeleteStoragePoolFunc == nil {
+		return nil, notMocked("MockStoragePoolService", "DeleteStoragePool")
+	}
+	return m.DeleteStoragePoolFunc(ctx, p)
+}
+
+func (m *MockStoragePoolService) NewUpdateStoragePoolParams(id string) *UpdateStoragePoolParams {
+	return (&StoragePoolService{}).NewUpdateStoragePoolParams(id)
+}
+
+func (m *MockStoragePoolService) UpdateStoragePool(p *UpdateStoragePoolParams) (*UpdateStoragePoolResponse, error) {
+	return m.UpdateStoragePoolWithContext(context.Background(), p)
+}
+
+func (m *MockStoragePoolService) UpdateStoragePoolWithContext(ctx context.Context, p *UpdateStoragePoolParams) (*UpdateStoragePoolResponse, error) {
+	m.record("UpdateStoragePool", p)
+	if m.UpdateStoragePoolFunc == nil {
+		return nil, notMocked("MockStoragePoolService", "UpdateStoragePool")
+	}
+	return m.UpdateStoragePoolFunc(ctx, p)
+}
+
+func (m *MockStoragePoolService) NewListStoragePoolsParams() *ListStoragePoolsParams {
+	return (&StoragePoolService{}).NewListStoragePoolsParams()
+}
+
+func (m *MockStoragePoolService) GetStoragePoolID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetStoragePoolID", name, opts)
+	if m.GetStoragePoolIDFunc == nil {
+		return "", -1, notMocked("MockStoragePoolService", "GetStoragePoolID")
+	}
+	return m.GetStoragePoolIDFunc(name, opts...)
+}
+
+func (m *MockStoragePoolService) GetStoragePoolByName(name string, opts ...OptionFunc) (*StoragePool, int, error) {
+	m.record("GetStoragePoolByName", name, opts)
+	if m.GetStoragePoolByNameFunc == nil {
+		return nil, -1, notMocked("MockStoragePoolService", "GetStoragePoolByName")
+	}
+	return m.GetStoragePoolByNameFunc(name, opts...)
+}
+
+func (m *MockStoragePoolService) GetStoragePoolByID(id string, opts ...OptionFunc) (*StoragePool, int, error) {
+	m.record("GetStoragePoolByID", id, opts)
+	if m.GetStoragePoolByIDFunc == nil {
+		return nil, -1, notMocked("MockStoragePoolService", "GetStoragePoolByID")
+	}
+	return m.GetStoragePoolByIDFunc(id, opts...)
+}
+
+func (m *MockStoragePoolService) ListStoragePools(p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error) {
+	return m.ListStoragePoolsWithContext(context.Background(), p)
+}
+
+func (m *MockStoragePoolService) ListStoragePoolsWithContext(ctx context.Context, p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error) {
+	m.record("ListStoragePools", p)
+	if m.ListStoragePoolsFunc == nil {
+		return nil, notMocked("MockStoragePoolService", "ListStoragePools")
+	}
+	return m.ListStoragePoolsFunc(ctx, p)
+}
+
+func (m *MockStoragePoolService) NewListStoragePoolsIterator(p *ListStoragePoolsParams, pagesize int) *ListStoragePoolsIterator {
+	i := &ListStoragePoolsIterator{pager: newPager(nil, "listStoragePools", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListStoragePoolsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListStoragePoolsWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockStoragePoolService) NewFindStoragePoolsForMigrationParams(id string) *FindStoragePoolsForMigrationParams {
+	return (&StoragePoolService{}).NewFindStoragePoolsForMigrationParams(id)
+}
+
+func (m *MockStoragePoolService) FindStoragePoolsForMigration(p *FindStoragePoolsForMigrationParams) (*FindStoragePoolsForMigrationResponse, error) {
+	return m.FindStoragePoolsForMigrationWithContext(context.Background(), p)
+}
+
+func (m *MockStoragePoolService) FindStoragePoolsForMigrationWithContext(ctx context.Context, p *FindStoragePoolsForMigrationParams) (*FindStoragePoolsForMigrationResponse, error) {
+	m.record("FindStoragePoolsForMigration", p)
+	if m.FindStoragePoolsForMigrationFunc == nil {
+		return nil, notMocked("MockStoragePoolService", "FindStoragePoolsForMigration")
+	}
+	return m.FindStoragePoolsForMigrationFunc(ctx, p)
+}
+
+func (m *MockStoragePoolService) NewListStorageProvidersParams(storagePoolType string) *ListStorageProvidersParams {
+	return (&StoragePoolService{}).NewListStorageProvidersParams(storagePoolType)
+}
+
+func (m *MockStoragePoolService) ListStorageProviders(p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error) {
+	return m.ListStorageProvidersWithContext(context.Background(), p)
+}
+
+func (m *MockStoragePoolService) ListStorageProvidersWithContext(ctx context.Context, p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error) {
+	m.record("ListStorageProviders", p)
+	if m.ListStorageProvidersFunc == nil {
+		return nil, notMocked("MockStoragePoolService", "ListStorageProviders")
+	}
+	return m.ListStorageProvidersFunc(ctx, p)
+}
+
+func (m *MockStoragePoolService) NewListStorageProvidersIterator(p *ListStorageProvidersParams, pagesize int) *ListStorageProvidersIterator {
+	i := &ListStorageProvidersIterator{pager: newPager(nil, "listStorageProviders", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListStorageProvidersParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListStorageProvidersWithContext(ctx, c)
+	}
+	return i
+}
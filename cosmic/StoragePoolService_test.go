@@ -0,0 +1,193 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCancelStorageMaintenanceParams(t *testing.T) {
+	p := &CancelStorageMaintenanceParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "cancelStorageMaintenance", want)
+}
+
+func TestCancelStorageMaintenanceResponse(t *testing.T) {
+	assertDecodes(t, `{"capacityiops":1099511627776,"clusterid":"clusterid-value","clustername":"clustername-value","created":"created-value","disksizeallocated":1099511627776,"disksizetotal":1099511627776,"disksizeused":1099511627776,"hypervisor":"hypervisor-value","id":"id-value","ipaddress":"ipaddress-value","jobid":"jobid-value","name":"name-value","overprovisionfactor":"overprovisionfactor-value","path":"path-value","podid":"podid-value","podname":"podname-value","scope":"scope-value","state":"state-value","storagecapabilities":{"key":"value"},"suitableformigration":true,"tags":"tags-value","type":"type-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &CancelStorageMaintenanceResponse{})
+}
+
+func TestEnableStorageMaintenanceParams(t *testing.T) {
+	p := &EnableStorageMaintenanceParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "enableStorageMaintenance", want)
+}
+
+func TestEnableStorageMaintenanceResponse(t *testing.T) {
+	assertDecodes(t, `{"capacityiops":1099511627776,"clusterid":"clusterid-value","clustername":"clustername-value","created":"created-value","disksizeallocated":1099511627776,"disksizetotal":1099511627776,"disksizeused":1099511627776,"hypervisor":"hypervisor-value","id":"id-value","ipaddress":"ipaddress-value","jobid":"jobid-value","name":"name-value","overprovisionfactor":"overprovisionfactor-value","path":"path-value","podid":"podid-value","podname":"podname-value","scope":"scope-value","state":"state-value","storagecapabilities":{"key":"value"},"suitableformigration":true,"tags":"tags-value","type":"type-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &EnableStorageMaintenanceResponse{})
+}
+
+func TestCreateStoragePoolParams(t *testing.T) {
+	p := &CreateStoragePoolParams{}
+	want := url.Values{}
+	p.SetCapacitybytes(int64(1) << 40)
+	want.Set("capacitybytes", "1099511627776")
+	p.SetCapacityiops(int64(1) << 40)
+	want.Set("capacityiops", "1099511627776")
+	p.SetClusterid("clusterid-value")
+	want.Set("clusterid", "clusterid-value")
+	p.SetDetails(map[string]string{"k": "v"})
+	want.Set("details[0].key", "k")
+	want.Set("details[0].value", "v")
+	p.SetHypervisor("hypervisor-value")
+	want.Set("hypervisor", "hypervisor-value")
+	p.SetManaged(true)
+	want.Set("managed", "true")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPodid("podid-value")
+	want.Set("podid", "podid-value")
+	p.SetProvider("provider-value")
+	want.Set("provider", "provider-value")
+	p.SetScope("scope-value")
+	want.Set("scope", "scope-value")
+	p.SetTags("tags-value")
+	want.Set("tags", "tags-value")
+	p.SetUrl("url-value")
+	want.Set("url", "url-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "createStoragePool", want)
+}
+
+func TestCreateStoragePoolResponse(t *testing.T) {
+	assertDecodes(t, `{"capacityiops":1099511627776,"clusterid":"clusterid-value","clustername":"clustername-value","created":"created-value","disksizeallocated":1099511627776,"disksizetotal":1099511627776,"disksizeused":1099511627776,"hypervisor":"hypervisor-value","id":"id-value","ipaddress":"ipaddress-value","name":"name-value","overprovisionfactor":"overprovisionfactor-value","path":"path-value","podid":"podid-value","podname":"podname-value","scope":"scope-value","state":"state-value","storagecapabilities":{"key":"value"},"suitableformigration":true,"tags":"tags-value","type":"type-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &CreateStoragePoolResponse{})
+}
+
+func TestDeleteStoragePoolParams(t *testing.T) {
+	p := &DeleteStoragePoolParams{}
+	want := url.Values{}
+	p.SetForced(true)
+	want.Set("forced", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteStoragePool", want)
+}
+
+func TestDeleteStoragePoolResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &DeleteStoragePoolResponse{})
+}
+
+func TestUpdateStoragePoolParams(t *testing.T) {
+	p := &UpdateStoragePoolParams{}
+	want := url.Values{}
+	p.SetCapacitybytes(int64(1) << 40)
+	want.Set("capacitybytes", "1099511627776")
+	p.SetCapacityiops(int64(1) << 40)
+	want.Set("capacityiops", "1099511627776")
+	p.SetEnabled(true)
+	want.Set("enabled", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetTags([]string{"a", "b"})
+	want.Set("tags", "a,b")
+
+	assertParams(t, p, "updateStoragePool", want)
+}
+
+func TestUpdateStoragePoolResponse(t *testing.T) {
+	assertDecodes(t, `{"capacityiops":1099511627776,"clusterid":"clusterid-value","clustername":"clustername-value","created":"created-value","disksizeallocated":1099511627776,"disksizetotal":1099511627776,"disksizeused":1099511627776,"hypervisor":"hypervisor-value","id":"id-value","ipaddress":"ipaddress-value","name":"name-value","overprovisionfactor":"overprovisionfactor-value","path":"path-value","podid":"podid-value","podname":"podname-value","scope":"scope-value","state":"state-value","storagecapabilities":{"key":"value"},"suitableformigration":true,"tags":"tags-value","type":"type-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &UpdateStoragePoolResponse{})
+}
+
+func TestListStoragePoolsParams(t *testing.T) {
+	p := &ListStoragePoolsParams{}
+	want := url.Values{}
+	p.SetClusterid("clusterid-value")
+	want.Set("clusterid", "clusterid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIpaddress("ipaddress-value")
+	want.Set("ipaddress", "ipaddress-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetPath("path-value")
+	want.Set("path", "path-value")
+	p.SetPodid("podid-value")
+	want.Set("podid", "podid-value")
+	p.SetScope("scope-value")
+	want.Set("scope", "scope-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listStoragePools", want)
+}
+
+func TestListStoragePoolsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"storagepool":[{"capacityiops":1099511627776,"clusterid":"clusterid-value","clustername":"clustername-value","created":"created-value","disksizeallocated":1099511627776,"disksizetotal":1099511627776,"disksizeused":1099511627776,"hypervisor":"hypervisor-value","id":"id-value","ipaddress":"ipaddress-value","name":"name-value","overprovisionfactor":"overprovisionfactor-value","path":"path-value","podid":"podid-value","podname":"podname-value","scope":"scope-value","state":"state-value","storagecapabilities":{"key":"value"},"suitableformigration":true,"tags":"tags-value","type":"type-value","zoneid":"zoneid-value","zonename":"zonename-value"}]}`, &ListStoragePoolsResponse{})
+}
+
+func TestFindStoragePoolsForMigrationParams(t *testing.T) {
+	p := &FindStoragePoolsForMigrationParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+
+	assertParams(t, p, "findStoragePoolsForMigration", want)
+}
+
+func TestFindStoragePoolsForMigrationResponse(t *testing.T) {
+	assertDecodes(t, `{"capacityiops":1099511627776,"clusterid":"clusterid-value","clustername":"clustername-value","created":"created-value","disksizeallocated":1099511627776,"disksizetotal":1099511627776,"disksizeused":1099511627776,"hypervisor":"hypervisor-value","id":"id-value","ipaddress":"ipaddress-value","name":"name-value","overprovisionfactor":"overprovisionfactor-value","path":"path-value","podid":"podid-value","podname":"podname-value","scope":"scope-value","state":"state-value","storagecapabilities":{"key":"value"},"suitableformigration":true,"tags":"tags-value","type":"type-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &FindStoragePoolsForMigrationResponse{})
+}
+
+func TestListStorageProvidersParams(t *testing.T) {
+	p := &ListStorageProvidersParams{}
+	want := url.Values{}
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetType("type-value")
+	want.Set("type", "type-value")
+
+	assertParams(t, p, "listStorageProviders", want)
+}
+
+func TestListStorageProvidersResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"storageprovider":[{"name":"name-value","type":"type-value"}]}`, &ListStorageProvidersResponse{})
+}
@@ -17,15 +17,34 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
 )
 
+// SystemServiceIface is the interface implemented by SystemService, and by MockSystemService for tests
+type SystemServiceIface interface {
+	NewListApisParams() *ListApisParams
+	ListApis(p *ListApisParams) (*ListApisResponse, error)
+	ListApisWithContext(ctx context.Context, p *ListApisParams) (*ListApisResponse, error)
+	NewListCapacityParams() *ListCapacityParams
+	ListCapacity(p *ListCapacityParams) (*ListCapacityResponse, error)
+	ListCapacityWithContext(ctx context.Context, p *ListCapacityParams) (*ListCapacityResponse, error)
+	NewListCapacityIterator(p *ListCapacityParams, pagesize int) *ListCapacityIterator
+	NewGetCloudIdentifierParams(userid string) *GetCloudIdentifierParams
+	GetCloudIdentifier(p *GetCloudIdentifierParams) (*GetCloudIdentifierResponse, error)
+	GetCloudIdentifierWithContext(ctx context.Context, p *GetCloudIdentifierParams) (*GetCloudIdentifierResponse, error)
+}
+
 type ListApisParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListApisParams) command() string {
+	return "listApis"
+}
+
 func (p *ListApisParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -54,7 +73,13 @@ func (s *SystemService) NewListApisParams() *ListApisParams {
 
 // lists all available apis on the server, provided by the Api Discovery plugin
 func (s *SystemService) ListApis(p *ListApisParams) (*ListApisResponse, error) {
-	resp, err := s.cs.newRequest("listApis", p.toURLValues())
+	return s.ListApisWithContext(context.Background(), p)
+}
+
+// ListApisWithContext is the same as ListApis, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SystemService) ListApisWithContext(ctx context.Context, p *ListApisParams) (*ListApisResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "listApis", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +126,10 @@ type ListCapacityParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListCapacityParams) command() string {
+	return "listCapacity"
+}
+
 func (p *ListCapacityParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -213,10 +242,17 @@ func (s *SystemService) NewListCapacityParams() *ListCapacityParams {
 
 // Lists all the system wide capacities.
 func (s *SystemService) ListCapacity(p *ListCapacityParams) (*ListCapacityResponse, error) {
+	return s.ListCapacityWithContext(context.Background(), p)
+}
+
+// ListCapacityWithContext is the same as ListCapacity, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SystemService) ListCapacityWithContext(ctx context.Context, p *ListCapacityParams) (*ListCapacityResponse, error) {
 	var r ListCapacityResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListCapacityResponse
-		resp, err := s.cs.newRequest("listCapacity", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listCapacity", values)
 		if err != nil {
 			return nil, err
 		}
@@ -228,13 +264,89 @@ func (s *SystemService) ListCapacity(p *ListCapacityParams) (*ListCapacityRespon
 		r.Count = l.Count
 		r.Capacity = append(r.Capacity, l.Capacity...)
 
-		if r.Count == len(r.Capacity) {
+		if r.Count == len(r.Capacity) || len(l.Capacity) == 0 {
+			s.cs.observePages("listCapacity", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.Capacity))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.Capacity)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listCapacity", values, len(l.Capacity), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListCapacityResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.Capacity = append(r.Capacity, l.Capacity...)
+			}
+
+			if r.Count == len(r.Capacity) {
+				s.cs.observePages("listCapacity", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListCapacityIterator iterates over the items returned by listCapacity, requesting
+// the pages of the response one at a time while iterating.
+type ListCapacityIterator struct {
+	pager
+	items []*Capacity
+	index int
+}
+
+// NewListCapacityIterator returns an iterator over the items returned by listCapacity, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *SystemService) NewListCapacityIterator(p *ListCapacityParams, pagesize int) *ListCapacityIterator {
+	return &ListCapacityIterator{pager: newPager(s.cs, "listCapacity", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListCapacityIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListCapacityResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
 	}
+	i.received(l.Count, len(l.Capacity))
+
+	i.items = l.Capacity
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListCapacityIterator) Value() *Capacity {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
 }
 
 type ListCapacityResponse struct {
@@ -259,6 +371,10 @@ type GetCloudIdentifierParams struct {
 	p map[string]interface{}
 }
 
+func (p *GetCloudIdentifierParams) command() string {
+	return "getCloudIdentifier"
+}
+
 func (p *GetCloudIdentifierParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -288,7 +404,13 @@ func (s *SystemService) NewGetCloudIdentifierParams(userid string) *GetCloudIden
 
 // Retrieves a cloud identifier.
 func (s *SystemService) GetCloudIdentifier(p *GetCloudIdentifierParams) (*GetCloudIdentifierResponse, error) {
-	resp, err := s.cs.newRequest("getCloudIdentifier", p.toURLValues())
+	return s.GetCloudIdentifierWithContext(context.Background(), p)
+}
+
+// GetCloudIdentifierWithContext is the same as GetCloudIdentifier, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SystemService) GetCloudIdentifierWithContext(ctx context.Context, p *GetCloudIdentifierParams) (*GetCloudIdentifierResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "getCloudIdentifier", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
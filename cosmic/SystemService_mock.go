@@ -0,0 +1,94 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockSystemService is a mock of SystemServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockSystemService struct {
+	mockCalls
+
+	ListApisFunc           func(ctx context.Context, p *ListApisParams) (*ListApisResponse, error)
+	ListCapacityFunc       func(ctx context.Context, p *ListCapacityParams) (*ListCapacityResponse, error)
+	GetCloudIdentifierFunc func(ctx context.Context, p *GetCloudIdentifierParams) (*GetCloudIdentifierResponse, error)
+}
+
+var _ SystemServiceIface = &MockSystemService{}
+
+func (m *MockSystemService) NewListApisParams() *ListApisParams {
+	return (&SystemService{}).NewListApisParams()
+}
+
+func (m *MockSystemService) ListApis(p *ListApisParams) (*ListApisResponse, error) {
+	return m.ListApisWithContext(context.Background(), p)
+}
+
+func (m *MockSystemService) ListApisWithContext(ctx context.Context, p *ListApisParams) (*ListApisResponse, error) {
+	m.record("ListApis", p)
+	if m.ListApisFunc == nil {
+		return nil, notMocked("MockSystemService", "ListApis")
+	}
+	return m.ListApisFunc(ctx, p)
+}
+
+func (m *MockSystemService) NewListCapacityParams() *ListCapacityParams {
+	return (&SystemService{}).NewListCapacityParams()
+}
+
+func (m *MockSystemService) ListCapacity(p *ListCapacityParams) (*ListCapacityResponse, error) {
+	return m.ListCapacityWithContext(context.Background(), p)
+}
+
+func (m *MockSystemService) ListCapacityWithContext(ctx context.Context, p *ListCapacityParams) (*ListCapacityResponse, error) {
+	m.record("ListCapacity", p)
+	if m.ListCapacityFunc == nil {
+		return nil, notMocked("MockSystemService", "ListCapacity")
+	}
+	return m.ListCapacityFunc(ctx, p)
+}
+
+func (m *MockSystemService) NewListCapacityIterator(p *ListCapacityParams, pagesize int) *ListCapacityIterator {
+	i := &ListCapacityIterator{pager: newPager(nil, "listCapacity", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListCapacityParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListCapacityWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockSystemService) NewGetCloudIdentifierParams(userid string) *GetCloudIdentifierParams {
+	return (&SystemService{}).NewGetCloudIdentifierParams(userid)
+}
+
+func (m *MockSystemService) GetCloudIdentifier(p *GetCloudIdentifierParams) (*GetCloudIdentifierResponse, error) {
+	return m.GetCloudIdentifierWithContext(context.Background(), p)
+}
+
+func (m *MockSystemService) GetCloudIdentifierWithContext(ctx context.Context, p *GetCloudIdentifierParams) (*GetCloudIdentifierResponse, error) {
+	m.record("GetCloudIdentifier", p)
+	if m.GetCloudIdentifierFunc == nil {
+		return nil, notMocked("MockSystemService", "GetCloudIdentifier")
+	}
+	return m.GetCloudIdentifierFunc(ctx, p)
+}
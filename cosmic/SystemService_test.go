@@ -0,0 +1,77 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestListApisParams(t *testing.T) {
+	p := &ListApisParams{}
+	want := url.Values{}
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+
+	assertParams(t, p, "listApis", want)
+}
+
+func TestListApisResponse(t *testing.T) {
+	assertDecodes(t, `{"api":[{"description":"description-value","groupdescription":"groupdescription-value","groupname":"groupname-value","isasync":true,"name":"name-value","params":[{"description":"description-value","length":42,"name":"name-value","related":"related-value","required":true,"since":"since-value","type":"type-value"}],"related":"related-value","response":[{"description":"description-value","name":"name-value","response":["a","b"],"type":"type-value"}],"since":"since-value","type":"type-value"}],"count":1}`, &ListApisResponse{})
+}
+
+func TestListCapacityParams(t *testing.T) {
+	p := &ListCapacityParams{}
+	want := url.Values{}
+	p.SetClusterid("clusterid-value")
+	want.Set("clusterid", "clusterid-value")
+	p.SetFetchlatest(true)
+	want.Set("fetchlatest", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetPodid("podid-value")
+	want.Set("podid", "podid-value")
+	p.SetSortby("sortby-value")
+	want.Set("sortby", "sortby-value")
+	p.SetType(42)
+	want.Set("type", "42")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listCapacity", want)
+}
+
+func TestListCapacityResponse(t *testing.T) {
+	assertDecodes(t, `{"capacity":[{"capacitytotal":1099511627776,"capacityused":1099511627776,"clusterid":"clusterid-value","clustername":"clustername-value","percentageallocated":"percentageallocated-value","podid":"podid-value","podname":"podname-value","type":42,"zoneid":"zoneid-value","zonename":"zonename-value"}],"count":1}`, &ListCapacityResponse{})
+}
+
+func TestGetCloudIdentifierParams(t *testing.T) {
+	p := &GetCloudIdentifierParams{}
+	want := url.Values{}
+	p.SetUserid("userid-value")
+	want.Set("userid", "userid-value")
+
+	assertParams(t, p, "getCloudIdentifier", want)
+}
+
+func TestGetCloudIdentifierResponse(t *testing.T) {
+	assertDecodes(t, `{"cloudidentifier":"cloudidentifier-value","signature":"signature-value","userid":"userid-value"}`, &GetCloudIdentifierResponse{})
+}
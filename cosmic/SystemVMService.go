@@ -17,17 +17,60 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
+// SystemVMServiceIface is the interface implemented by SystemVMService, and by MockSystemVMService for tests
+type SystemVMServiceIface interface {
+	NewChangeServiceForSystemVmParams(id string, serviceofferingid string) *ChangeServiceForSystemVmParams
+	ChangeServiceForSystemVm(p *ChangeServiceForSystemVmParams) (*ChangeServiceForSystemVmResponse, error)
+	ChangeServiceForSystemVmWithContext(ctx context.Context, p *ChangeServiceForSystemVmParams) (*ChangeServiceForSystemVmResponse, error)
+	NewDestroySystemVmParams(id string) *DestroySystemVmParams
+	DestroySystemVm(p *DestroySystemVmParams) (*DestroySystemVmResponse, error)
+	DestroySystemVmWithContext(ctx context.Context, p *DestroySystemVmParams) (*DestroySystemVmResponse, error)
+	DestroySystemVmAsync(ctx context.Context, p *DestroySystemVmParams) (*DestroySystemVmJob, error)
+	NewMigrateSystemVmParams(hostid string, virtualmachineid string) *MigrateSystemVmParams
+	MigrateSystemVm(p *MigrateSystemVmParams) (*MigrateSystemVmResponse, error)
+	MigrateSystemVmWithContext(ctx context.Context, p *MigrateSystemVmParams) (*MigrateSystemVmResponse, error)
+	MigrateSystemVmAsync(ctx context.Context, p *MigrateSystemVmParams) (*MigrateSystemVmJob, error)
+	NewRebootSystemVmParams(id string) *RebootSystemVmParams
+	RebootSystemVm(p *RebootSystemVmParams) (*RebootSystemVmResponse, error)
+	RebootSystemVmWithContext(ctx context.Context, p *RebootSystemVmParams) (*RebootSystemVmResponse, error)
+	RebootSystemVmAsync(ctx context.Context, p *RebootSystemVmParams) (*RebootSystemVmJob, error)
+	NewScaleSystemVmParams(id string, serviceofferingid string) *ScaleSystemVmParams
+	ScaleSystemVm(p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error)
+	ScaleSystemVmWithContext(ctx context.Context, p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error)
+	ScaleSystemVmAsync(ctx context.Context, p *ScaleSystemVmParams) (*ScaleSystemVmJob, error)
+	NewStartSystemVmParams(id string) *StartSystemVmParams
+	StartSystemVm(p *StartSystemVmParams) (*StartSystemVmResponse, error)
+	StartSystemVmWithContext(ctx context.Context, p *StartSystemVmParams) (*StartSystemVmResponse, error)
+	StartSystemVmAsync(ctx context.Context, p *StartSystemVmParams) (*StartSystemVmJob, error)
+	NewStopSystemVmParams(id string) *StopSystemVmParams
+	StopSystemVm(p *StopSystemVmParams) (*StopSystemVmResponse, error)
+	StopSystemVmWithContext(ctx context.Context, p *StopSystemVmParams) (*StopSystemVmResponse, error)
+	StopSystemVmAsync(ctx context.Context, p *StopSystemVmParams) (*StopSystemVmJob, error)
+	NewListSystemVmsParams() *ListSystemVmsParams
+	GetSystemVmID(name string, opts ...OptionFunc) (string, int, error)
+	GetSystemVmByName(name string, opts ...OptionFunc) (*SystemVm, int, error)
+	GetSystemVmByID(id string, opts ...OptionFunc) (*SystemVm, int, error)
+	ListSystemVms(p *ListSystemVmsParams) (*ListSystemVmsResponse, error)
+	ListSystemVmsWithContext(ctx context.Context, p *ListSystemVmsParams) (*ListSystemVmsResponse, error)
+	NewListSystemVmsIterator(p *ListSystemVmsParams, pagesize int) *ListSystemVmsIterator
+}
+
 type ChangeServiceForSystemVmParams struct {
 	p map[string]interface{}
 }
 
+func (p *ChangeServiceForSystemVmParams) command() string {
+	return "changeServiceForSystemVm"
+}
+
 func (p *ChangeServiceForSystemVmParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -83,7 +126,13 @@ func (s *SystemVMService) NewChangeServiceForSystemVmParams(id string, serviceof
 
 // Changes the service offering for a system vm (console proxy or secondary storage). The system vm must be in a "Stopped" state for this command to take effect.
 func (s *SystemVMService) ChangeServiceForSystemVm(p *ChangeServiceForSystemVmParams) (*ChangeServiceForSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("changeServiceForSystemVm", p.toURLValues())
+	return s.ChangeServiceForSystemVmWithContext(context.Background(), p)
+}
+
+// ChangeServiceForSystemVmWithContext is the same as ChangeServiceForSystemVm, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SystemVMService) ChangeServiceForSystemVmWithContext(ctx context.Context, p *ChangeServiceForSystemVmParams) (*ChangeServiceForSystemVmResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "changeServiceForSystemVm", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -130,6 +179,10 @@ type DestroySystemVmParams struct {
 	p map[string]interface{}
 }
 
+func (p *DestroySystemVmParams) command() string {
+	return "destroySystemVm"
+}
+
 func (p *DestroySystemVmParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -159,7 +212,13 @@ func (s *SystemVMService) NewDestroySystemVmParams(id string) *DestroySystemVmPa
 
 // Destroyes a system virtual machine.
 func (s *SystemVMService) DestroySystemVm(p *DestroySystemVmParams) (*DestroySystemVmResponse, error) {
-	resp, err := s.cs.newRequest("destroySystemVm", p.toURLValues())
+	return s.DestroySystemVmWithContext(context.Background(), p)
+}
+
+// DestroySystemVmWithContext is the same as DestroySystemVm, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SystemVMService) DestroySystemVmWithContext(ctx context.Context, p *DestroySystemVmParams) (*DestroySystemVmResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "destroySystemVm", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -171,26 +230,56 @@ func (s *SystemVMService) DestroySystemVm(p *DestroySystemVmParams) (*DestroySys
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "destroySystemVm", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "systemvm", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// DestroySystemVmJob is a handle to a running destroySystemVm async job
+type DestroySystemVmJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DestroySystemVmResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DestroySystemVmJob) Result() (*DestroySystemVmResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DestroySystemVmResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "systemvm", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// DestroySystemVmAsync starts the destroySystemVm async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *SystemVMService) DestroySystemVmAsync(ctx context.Context, p *DestroySystemVmParams) (*DestroySystemVmJob, error) {
+	resp, err := s.cs.newRequest(ctx, "destroySystemVm", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DestroySystemVmResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DestroySystemVmJob{s.cs.newJobHandle("destroySystemVm", r.JobID)}, nil
+}
+
 type DestroySystemVmResponse struct {
 	JobID                string `json:"jobid,omitempty"`
 	Activeviewersessions int    `json:"activeviewersessions,omitempty"`
@@ -202,7 +291,6 @@ type DestroySystemVmResponse struct {
 	Hostname             string `json:"hostname,omitempty"`
 	Hypervisor           string `json:"hypervisor,omitempty"`
 	Id                   string `json:"id,omitempty"`
-	Jobid                string `json:"jobid,omitempty"`
 	Jobstatus            int    `json:"jobstatus,omitempty"`
 	Linklocalip          string `json:"linklocalip,omitempty"`
 	Linklocalmacaddress  string `json:"linklocalmacaddress,omitempty"`
@@ -227,6 +315,10 @@ type MigrateSystemVmParams struct {
 	p map[string]interface{}
 }
 
+func (p *MigrateSystemVmParams) command() string {
+	return "migrateSystemVm"
+}
+
 func (p *MigrateSystemVmParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -267,7 +359,13 @@ func (s *SystemVMService) NewMigrateSystemVmParams(hostid string, virtualmachine
 
 // Attempts Migration of a system virtual machine to the host specified.
 func (s *SystemVMService) MigrateSystemVm(p *MigrateSystemVmParams) (*MigrateSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("migrateSystemVm", p.toURLValues())
+	return s.MigrateSystemVmWithContext(context.Background(), p)
+}
+
+// MigrateSystemVmWithContext is the same as MigrateSystemVm, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SystemVMService) MigrateSystemVmWithContext(ctx context.Context, p *MigrateSystemVmParams) (*MigrateSystemVmResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "migrateSystemVm", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -279,26 +377,56 @@ func (s *SystemVMService) MigrateSystemVm(p *MigrateSystemVmParams) (*MigrateSys
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "migrateSystemVm", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "systemvm", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// MigrateSystemVmJob is a handle to a running migrateSystemVm async job
+type MigrateSystemVmJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a MigrateSystemVmResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *MigrateSystemVmJob) Result() (*MigrateSystemVmResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := MigrateSystemVmResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "systemvm", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// MigrateSystemVmAsync starts the migrateSystemVm async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *SystemVMService) MigrateSystemVmAsync(ctx context.Context, p *MigrateSystemVmParams) (*MigrateSystemVmJob, error) {
+	resp, err := s.cs.newRequest(ctx, "migrateSystemVm", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r MigrateSystemVmResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &MigrateSystemVmJob{s.cs.newJobHandle("migrateSystemVm", r.JobID)}, nil
+}
+
 type MigrateSystemVmResponse struct {
 	JobID                string `json:"jobid,omitempty"`
 	Activeviewersessions int    `json:"activeviewersessions,omitempty"`
@@ -310,7 +438,6 @@ type MigrateSystemVmResponse struct {
 	Hostname             string `json:"hostname,omitempty"`
 	Hypervisor           string `json:"hypervisor,omitempty"`
 	Id                   string `json:"id,omitempty"`
-	Jobid                string `json:"jobid,omitempty"`
 	Jobstatus            int    `json:"jobstatus,omitempty"`
 	Linklocalip          string `json:"linklocalip,omitempty"`
 	Linklocalmacaddress  string `json:"linklocalmacaddress,omitempty"`
@@ -335,6 +462,10 @@ type RebootSystemVmParams struct {
 	p map[string]interface{}
 }
 
+func (p *RebootSystemVmParams) command() string {
+	return "rebootSystemVm"
+}
+
 func (p *RebootSystemVmParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -364,7 +495,13 @@ func (s *SystemVMService) NewRebootSystemVmParams(id string) *RebootSystemVmPara
 
 // Reboots a system VM.
 func (s *SystemVMService) RebootSystemVm(p *RebootSystemVmParams) (*RebootSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("rebootSystemVm", p.toURLValues())
+	return s.RebootSystemVmWithContext(context.Background(), p)
+}
+
+// RebootSystemVmWithContext is the same as RebootSystemVm, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SystemVMService) RebootSystemVmWithContext(ctx context.Context, p *RebootSystemVmParams) (*RebootSystemVmResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "rebootSystemVm", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -376,26 +513,56 @@ func (s *SystemVMService) RebootSystemVm(p *RebootSystemVmParams) (*RebootSystem
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "rebootSystemVm", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "systemvm", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// RebootSystemVmJob is a handle to a running rebootSystemVm async job
+type RebootSystemVmJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a RebootSystemVmResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *RebootSystemVmJob) Result() (*RebootSystemVmResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := RebootSystemVmResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "systemvm", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// RebootSystemVmAsync starts the rebootSystemVm async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *SystemVMService) RebootSystemVmAsync(ctx context.Context, p *RebootSystemVmParams) (*RebootSystemVmJob, error) {
+	resp, err := s.cs.newRequest(ctx, "rebootSystemVm", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RebootSystemVmResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &RebootSystemVmJob{s.cs.newJobHandle("rebootSystemVm", r.JobID)}, nil
+}
+
 type RebootSystemVmResponse struct {
 	JobID                string `json:"jobid,omitempty"`
 	Activeviewersessions int    `json:"activeviewersessions,omitempty"`
@@ -407,7 +574,6 @@ type RebootSystemVmResponse struct {
 	Hostname             string `json:"hostname,omitempty"`
 	Hypervisor           string `json:"hypervisor,omitempty"`
 	Id                   string `json:"id,omitempty"`
-	Jobid                string `json:"jobid,omitempty"`
 	Jobstatus            int    `json:"jobstatus,omitempty"`
 	Linklocalip          string `json:"linklocalip,omitempty"`
 	Linklocalmacaddress  string `json:"linklocalmacaddress,omitempty"`
@@ -432,6 +598,10 @@ type ScaleSystemVmParams struct {
 	p map[string]interface{}
 }
 
+func (p *ScaleSystemVmParams) command() string {
+	return "scaleSystemVm"
+}
+
 func (p *ScaleSystemVmParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -487,7 +657,13 @@ func (s *SystemVMService) NewScaleSystemVmParams(id string, serviceofferingid st
 
 // Scale the service offering for a system vm (console proxy or secondary storage). The system vm must be in a "Stopped" state for this command to take effect.
 func (s *SystemVMService) ScaleSystemVm(p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("scaleSystemVm", p.toURLValues())
+	return s.ScaleSystemVmWithContext(context.Background(), p)
+}
+
+// ScaleSystemVmWithContext is the same as ScaleSystemVm, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SystemVMService) ScaleSystemVmWithContext(ctx context.Context, p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "scaleSystemVm", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -499,26 +675,56 @@ func (s *SystemVMService) ScaleSystemVm(p *ScaleSystemVmParams) (*ScaleSystemVmR
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "scaleSystemVm", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "systemvm", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// ScaleSystemVmJob is a handle to a running scaleSystemVm async job
+type ScaleSystemVmJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a ScaleSystemVmResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *ScaleSystemVmJob) Result() (*ScaleSystemVmResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := ScaleSystemVmResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "systemvm", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// ScaleSystemVmAsync starts the scaleSystemVm async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *SystemVMService) ScaleSystemVmAsync(ctx context.Context, p *ScaleSystemVmParams) (*ScaleSystemVmJob, error) {
+	resp, err := s.cs.newRequest(ctx, "scaleSystemVm", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ScaleSystemVmResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &ScaleSystemVmJob{s.cs.newJobHandle("scaleSystemVm", r.JobID)}, nil
+}
+
 type ScaleSystemVmResponse struct {
 	JobID                string `json:"jobid,omitempty"`
 	Activeviewersessions int    `json:"activeviewersessions,omitempty"`
@@ -530,7 +736,6 @@ type ScaleSystemVmResponse struct {
 	Hostname             string `json:"hostname,omitempty"`
 	Hypervisor           string `json:"hypervisor,omitempty"`
 	Id                   string `json:"id,omitempty"`
-	Jobid                string `json:"jobid,omitempty"`
 	Jobstatus            int    `json:"jobstatus,omitempty"`
 	Linklocalip          string `json:"linklocalip,omitempty"`
 	Linklocalmacaddress  string `json:"linklocalmacaddress,omitempty"`
@@ -555,6 +760,10 @@ type StartSystemVmParams struct {
 	p map[string]interface{}
 }
 
+func (p *StartSystemVmParams) command() string {
+	return "startSystemVm"
+}
+
 func (p *StartSystemVmParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -584,7 +793,13 @@ func (s *SystemVMService) NewStartSystemVmParams(id string) *StartSystemVmParams
 
 // Starts a system virtual machine.
 func (s *SystemVMService) StartSystemVm(p *StartSystemVmParams) (*StartSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("startSystemVm", p.toURLValues())
+	return s.StartSystemVmWithContext(context.Background(), p)
+}
+
+// StartSystemVmWithContext is the same as StartSystemVm, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SystemVMService) StartSystemVmWithContext(ctx context.Context, p *StartSystemVmParams) (*StartSystemVmResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "startSystemVm", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -596,26 +811,56 @@ func (s *SystemVMService) StartSystemVm(p *StartSystemVmParams) (*StartSystemVmR
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "startSystemVm", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "systemvm", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// StartSystemVmJob is a handle to a running startSystemVm async job
+type StartSystemVmJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a StartSystemVmResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *StartSystemVmJob) Result() (*StartSystemVmResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := StartSystemVmResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "systemvm", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// StartSystemVmAsync starts the startSystemVm async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *SystemVMService) StartSystemVmAsync(ctx context.Context, p *StartSystemVmParams) (*StartSystemVmJob, error) {
+	resp, err := s.cs.newRequest(ctx, "startSystemVm", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r StartSystemVmResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &StartSystemVmJob{s.cs.newJobHandle("startSystemVm", r.JobID)}, nil
+}
+
 type StartSystemVmResponse struct {
 	JobID                string `json:"jobid,omitempty"`
 	Activeviewersessions int    `json:"activeviewersessions,omitempty"`
@@ -627,7 +872,6 @@ type StartSystemVmResponse struct {
 	Hostname             string `json:"hostname,omitempty"`
 	Hypervisor           string `json:"hypervisor,omitempty"`
 	Id                   string `json:"id,omitempty"`
-	Jobid                string `json:"jobid,omitempty"`
 	Jobstatus            int    `json:"jobstatus,omitempty"`
 	Linklocalip          string `json:"linklocalip,omitempty"`
 	Linklocalmacaddress  string `json:"linklocalmacaddress,omitempty"`
@@ -652,6 +896,10 @@ type StopSystemVmParams struct {
 	p map[string]interface{}
 }
 
+func (p *StopSystemVmParams) command() string {
+	return "stopSystemVm"
+}
+
 func (p *StopSystemVmParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -692,7 +940,13 @@ func (s *SystemVMService) NewStopSystemVmParams(id string) *StopSystemVmParams {
 
 // Stops a system VM.
 func (s *SystemVMService) StopSystemVm(p *StopSystemVmParams) (*StopSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("stopSystemVm", p.toURLValues())
+	return s.StopSystemVmWithContext(context.Background(), p)
+}
+
+// StopSystemVmWithContext is the same as StopSystemVm, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SystemVMService) StopSystemVmWithContext(ctx context.Context, p *StopSystemVmParams) (*StopSystemVmResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "stopSystemVm", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -704,26 +958,56 @@ func (s *SystemVMService) StopSystemVm(p *StopSystemVmParams) (*StopSystemVmResp
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "stopSystemVm", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "systemvm", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// StopSystemVmJob is a handle to a running stopSystemVm async job
+type StopSystemVmJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a StopSystemVmResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *StopSystemVmJob) Result() (*StopSystemVmResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := StopSystemVmResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "systemvm", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// StopSystemVmAsync starts the stopSystemVm async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *SystemVMService) StopSystemVmAsync(ctx context.Context, p *StopSystemVmParams) (*StopSystemVmJob, error) {
+	resp, err := s.cs.newRequest(ctx, "stopSystemVm", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r StopSystemVmResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &StopSystemVmJob{s.cs.newJobHandle("stopSystemVm", r.JobID)}, nil
+}
+
 type StopSystemVmResponse struct {
 	JobID                string `json:"jobid,omitempty"`
 	Activeviewersessions int    `json:"activeviewersessions,omitempty"`
@@ -735,7 +1019,6 @@ type StopSystemVmResponse struct {
 	Hostname             string `json:"hostname,omitempty"`
 	Hypervisor           string `json:"hypervisor,omitempty"`
 	Id                   string `json:"id,omitempty"`
-	Jobid                string `json:"jobid,omitempty"`
 	Jobstatus            int    `json:"jobstatus,omitempty"`
 	Linklocalip          string `json:"linklocalip,omitempty"`
 	Linklocalmacaddress  string `json:"linklocalmacaddress,omitempty"`
@@ -760,6 +1043,10 @@ type ListSystemVmsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListSystemVmsParams) command() string {
+	return "listSystemVms"
+}
+
 func (p *ListSystemVmsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -907,7 +1194,7 @@ func (s *SystemVMService) GetSystemVmID(name string, opts ...OptionFunc) (string
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -921,7 +1208,7 @@ func (s *SystemVMService) GetSystemVmID(name string, opts ...OptionFunc) (string
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -953,16 +1240,14 @@ func (s *SystemVMService) GetSystemVmByID(id string, opts ...OptionFunc) (*Syste
 
 	l, err := s.ListSystemVms(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -974,10 +1259,17 @@ func (s *SystemVMService) GetSystemVmByID(id string, opts ...OptionFunc) (*Syste
 
 // List system virtual machines.
 func (s *SystemVMService) ListSystemVms(p *ListSystemVmsParams) (*ListSystemVmsResponse, error) {
+	return s.ListSystemVmsWithContext(context.Background(), p)
+}
+
+// ListSystemVmsWithContext is the same as ListSystemVms, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *SystemVMService) ListSystemVmsWithContext(ctx context.Context, p *ListSystemVmsParams) (*ListSystemVmsResponse, error) {
 	var r ListSystemVmsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListSystemVmsResponse
-		resp, err := s.cs.newRequest("listSystemVms", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listSystemVms", values)
 		if err != nil {
 			return nil, err
 		}
@@ -989,13 +1281,89 @@ func (s *SystemVMService) ListSystemVms(p *ListSystemVmsParams) (*ListSystemVmsR
 		r.Count = l.Count
 		r.SystemVms = append(r.SystemVms, l.SystemVms...)
 
-		if r.Count == len(r.SystemVms) {
+		if r.Count == len(r.SystemVms) || len(l.SystemVms) == 0 {
+			s.cs.observePages("listSystemVms", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.SystemVms))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.SystemVms)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listSystemVms", values, len(l.SystemVms), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListSystemVmsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.SystemVms = append(r.SystemVms, l.SystemVms...)
+			}
+
+			if r.Count == len(r.SystemVms) {
+				s.cs.observePages("listSystemVms", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListSystemVmsIterator iterates over the items returned by listSystemVms, requesting
+// the pages of the response one at a time while iterating.
+type ListSystemVmsIterator struct {
+	pager
+	items []*SystemVm
+	index int
+}
+
+// NewListSystemVmsIterator returns an iterator over the items returned by listSystemVms, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *SystemVMService) NewListSystemVmsIterator(p *ListSystemVmsParams, pagesize int) *ListSystemVmsIterator {
+	return &ListSystemVmsIterator{pager: newPager(s.cs, "listSystemVms", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListSystemVmsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListSystemVmsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.SystemVms))
+
+	i.items = l.SystemVms
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListSystemVmsIterator) Value() *SystemVm {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListSystemVmsResponse struct {
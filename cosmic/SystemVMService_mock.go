@@ -0,0 +1,260 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockSystemVMService is a mock of SystemVMServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockSystemVMService struct {
+	mockCalls
+
+	ChangeServiceForSystemVmFunc func(ctx context.Context, p *ChangeServiceForSystemVmParams) (*ChangeServiceForSystemVmResponse, error)
+	DestroySystemVmFunc          func(ctx context.Context, p *DestroySystemVmParams) (*DestroySystemVmResponse, error)
+	DestroySystemVmAsyncFunc     func(ctx context.Context, p *DestroySystemVmParams) (*DestroySystemVmJob, error)
+	MigrateSystemVmFunc          func(ctx context.Context, p *MigrateSystemVmParams) (*MigrateSystemVmResponse, error)
+	MigrateSystemVmAsyncFunc     func(ctx context.Context, p *MigrateSystemVmParams) (*MigrateSystemVmJob, error)
+	RebootSystemVmFunc           func(ctx context.Context, p *RebootSystemVmParams) (*RebootSystemVmResponse, error)
+	RebootSystemVmAsyncFunc      func(ctx context.Context, p *RebootSystemVmParams) (*RebootSystemVmJob, error)
+	ScaleSystemVmFunc            func(ctx context.Context, p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error)
+	ScaleSystemVmAsyncFunc       func(ctx context.Context, p *ScaleSystemVmParams) (*ScaleSystemVmJob, error)
+	StartSystemVmFunc            func(ctx context.Context, p *StartSystemVmParams) (*StartSystemVmResponse, error)
+	StartSystemVmAsyncFunc       func(ctx context.Context, p *StartSystemVmParams) (*StartSystemVmJob, error)
+	StopSystemVmFunc             func(ctx context.Context, p *StopSystemVmParams) (*StopSystemVmResponse, error)
+	StopSystemVmAsyncFunc        func(ctx context.Context, p *StopSystemVmParams) (*StopSystemVmJob, error)
+	GetSystemVmIDFunc            func(name string, opts ...OptionFunc) (string, int, error)
+	GetSystemVmByNameFunc        func(name string, opts ...OptionFunc) (*SystemVm, int, error)
+	GetSystemVmByIDFunc          func(id string, opts ...OptionFunc) (*SystemVm, int, error)
+	ListSystemVmsFunc            func(ctx context.Context, p *ListSystemVmsParams) (*ListSystemVmsResponse, error)
+}
+
+var _ SystemVMServiceIface = &MockSystemVMService{}
+
+func (m *MockSystemVMService) NewChangeServiceForSystemVmParams(id string, serviceofferingid string) *ChangeServiceForSystemVmParams {
+	return (&SystemVMService{}).NewChangeServiceForSystemVmParams(id, serviceofferingid)
+}
+
+func (m *MockSystemVMService) ChangeServiceForSystemVm(p *ChangeServiceForSystemVmParams) (*ChangeServiceForSystemVmResponse, error) {
+	return m.ChangeServiceForSystemVmWithContext(context.Background(), p)
+}
+
+func (m *MockSystemVMService) ChangeServiceForSystemVmWithContext(ctx context.Context, p *ChangeServiceForSystemVmParams) (*ChangeServiceForSystemVmResponse, error) {
+	m.record("ChangeServiceForSystemVm", p)
+	if m.ChangeServiceForSystemVmFunc == nil {
+		return nil, notMocked("MockSystemVMService", "ChangeServiceForSystemVm")
+	}
+	return m.ChangeServiceForSystemVmFunc(ctx, p)
+}
+
+func (m *MockSystemVMService) NewDestroySystemVmParams(id string) *DestroySystemVmParams {
+	return (&SystemVMService{}).NewDestroySystemVmParams(id)
+}
+
+func (m *MockSystemVMService) DestroySystemVm(p *DestroySystemVmParams) (*DestroySystemVmResponse, error) {
+	return m.DestroySystemVmWithContext(context.Background(), p)
+}
+
+func (m *MockSystemVMService) DestroySystemVmWithContext(ctx context.Context, p *DestroySystemVmParams) (*DestroySystemVmResponse, error) {
+	m.record("DestroySystemVm", p)
+	if m.DestroySystemVmFunc == nil {
+		return nil, notMocked("MockSystemVMService", "DestroySystemVm")
+	}
+	return m.DestroySystemVmFunc(ctx, p)
+}
+
+func (m *MockSystemVMService) DestroySystemVmAsync(ctx context.Context, p *DestroySystemVmParams) (*DestroySystemVmJob, error) {
+	m.record("DestroySystemVmAsync", p)
+	if m.DestroySystemVmAsyncFunc == nil {
+		return nil, notMocked("MockSystemVMService", "DestroySystemVmAsync")
+	}
+	return m.DestroySystemVmAsyncFunc(ctx, p)
+}
+
+func (m *MockSystemVMService) NewMigrateSystemVmParams(hostid string, virtualmachineid string) *MigrateSystemVmParams {
+	return (&SystemVMService{}).NewMigrateSystemVmParams(hostid, virtualmachineid)
+}
+
+func (m *MockSystemVMService) MigrateSystemVm(p *MigrateSystemVmParams) (*MigrateSystemVmResponse, error) {
+	return m.MigrateSystemVmWithContext(context.Background(), p)
+}
+
+func (m *MockSystemVMService) MigrateSystemVmWithContext(ctx context.Context, p *MigrateSystemVmParams) (*MigrateSystemVmResponse, error) {
+	m.record("MigrateSystemVm", p)
+	if m.MigrateSystemVmFunc == nil {
+		return nil, notMocked("MockSystemVMService", "MigrateSystemVm")
+	}
+	return m.MigrateSystemVmFunc(ctx, p)
+}
+
+func (m *MockSystemVMService) MigrateSystemVmAsync(ctx context.Context, p *MigrateSystemVmParams) (*MigrateSystemVmJob, error) {
+	m.record("MigrateSystemVmAsync", p)
+	if m.MigrateSystemVmAsyncFunc == nil {
+		return nil, notMocked("MockSystemVMService", "MigrateSystemVmAsync")
+	}
+	return m.MigrateSystemVmAsyncFunc(ctx, p)
+}
+
+func (m *MockSystemVMService) NewRebootSystemVmParams(id string) *RebootSystemVmParams {
+	return (&SystemVMService{}).NewRebootSystemVmParams(id)
+}
+
+func (m *MockSystemVMService) RebootSystemVm(p *RebootSystemVmParams) (*RebootSystemVmResponse, error) {
+	return m.RebootSystemVmWithContext(context.Background(), p)
+}
+
+func (m *MockSystemVMService) RebootSystemVmWithContext(ctx context.Context, p *RebootSystemVmParams) (*RebootSystemVmResponse, error) {
+	m.record("RebootSystemVm", p)
+	if m.RebootSystemVmFunc == nil {
+		return nil, notMocked("MockSystemVMService", "RebootSystemVm")
+	}
+	return m.RebootSystemVmFunc(ctx, p)
+}
+
+func (m *MockSystemVMService) RebootSystemVmAsync(ctx context.Context, p *RebootSystemVmParams) (*RebootSystemVmJob, error) {
+	m.record("RebootSystemVmAsync", p)
+	if m.RebootSystemVmAsyncFunc == nil {
+		return nil, notMocked("MockSystemVMService", "RebootSystemVmAsync")
+	}
+	return m.RebootSystemVmAsyncFunc(ctx, p)
+}
+
+func (m *MockSystemVMService) NewScaleSystemVmParams(id string, serviceofferingid string) *ScaleSystemVmParams {
+	return (&SystemVMService{}).NewScaleSystemVmParams(id, serviceofferingid)
+}
+
+func (m *MockSystemVMService) ScaleSystemVm(p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error) {
+	return m.ScaleSystemVmWithContext(context.Background(), p)
+}
+
+func (m *MockSystemVMService) ScaleSystemVmWithContext(ctx context.Context, p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error) {
+	m.record("ScaleSystemVm", p)
+	if m.ScaleSystemVmFunc == nil {
+		return nil, notMocked("MockSystemVMService", "ScaleSystemVm")
+	}
+	return m.ScaleSystemVmFunc(ctx, p)
+}
+
+func (m *MockSystemVMService) ScaleSystemVmAsync(ctx context.Context, p *ScaleSystemVmParams) (*ScaleSystemVmJob, error) {
+	m.record("ScaleSystemVmAsync", p)
+	if m.ScaleSystemVmAsyncFunc == nil {
+		return nil, notMocked("MockSystemVMService", "ScaleSystemVmAsync")
+	}
+	return m.ScaleSystemVmAsyncFunc(ctx, p)
+}
+
+func (m *MockSystemVMService) NewStartSystemVmParams(id string) *StartSystemVmParams {
+	return (&SystemVMService{}).NewStartSystemVmParams(id)
+}
+
+func (m *MockSystemVMService) StartSystemVm(p *StartSystemVmParams) (*StartSystemVmResponse, error) {
+	return m.StartSystemVmWithContext(context.Background(), p)
+}
+
+func (m *MockSystemVMService) StartSystemVmWithContext(ctx context.Context, p *StartSystemVmParams) (*StartSystemVmResponse, error) {
+	m.record("StartSystemVm", p)
+	if m.StartSystemVmFunc == nil {
+		return nil, notMocked("MockSystemVMService", "StartSystemVm")
+	}
+	return m.StartSystemVmFunc(ctx, p)
+}
+
+func (m *MockSystemVMService) StartSystemVmAsync(ctx context.Context, p *StartSystemVmParams) (*StartSystemVmJob, error) {
+	m.record("StartSystemVmAsync", p)
+	if m.StartSystemVmAsyncFunc == nil {
+		return nil, notMocked("MockSystemVMService", "StartSystemVmAsync")
+	}
+	return m.StartSystemVmAsyncFunc(ctx, p)
+}
+
+func (m *MockSystemVMService) NewStopSystemVmParams(id string) *StopSystemVmParams {
+	return (&SystemVMService{}).NewStopSystemVmParams(id)
+}
+
+func (m *MockSystemVMService) StopSystemVm(p *StopSystemVmParams) (*StopSystemVmResponse, error) {
+	return m.StopSystemVmWithContext(context.Background(), p)
+}
+
+func (m *MockSystemVMService) StopSystemVmWithContext(ctx context.Context, p *StopSystemVmParams) (*StopSystemVmResponse, error) {
+	m.record("StopSystemVm", p)
+	if m.StopSystemVmFunc == nil {
+		return nil, notMocked("MockSystemVMService", "StopSystemVm")
+	}
+	return m.StopSystemVmFunc(ctx, p)
+}
+
+func (m *MockSystemVMService) StopSystemVmAsync(ctx context.Context, p *StopSystemVmParams) (*StopSystemVmJob, error) {
+	m.record("StopSystemVmAsync", p)
+	if m.StopSystemVmAsyncFunc == nil {
+		return nil, notMocked("MockSystemVMService", "StopSystemVmAsync")
+	}
+	return m.StopSystemVmAsyncFunc(ctx, p)
+}
+
+func (m *MockSystemVMService) NewListSystemVmsParams() *ListSystemVmsParams {
+	return (&SystemVMService{}).NewListSystemVmsParams()
+}
+
+func (m *MockSystemVMService) GetSystemVmID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetSystemVmID", name, opts)
+	if m.GetSystemVmIDFunc == nil {
+		return "", -1, notMocked("MockSystemVMService", "GetSystemVmID")
+	}
+	return m.GetSystemVmIDFunc(name, opts...)
+}
+
+func (m *MockSystemVMService) GetSystemVmByName(name string, opts ...OptionFunc) (*SystemVm, int, error) {
+	m.record("GetSystemVmByName", name, opts)
+	if m.GetSystemVmByNameFunc == nil {
+		return nil, -1, notMocked("MockSystemVMService", "GetSystemVmByName")
+	}
+	return m.GetSystemVmByNameFunc(name, opts...)
+}
+
+func (m *MockSystemVMService) GetSystemVmByID(id string, opts ...OptionFunc) (*SystemVm, int, error) {
+	m.record("GetSystemVmByID", id, opts)
+	if m.GetSystemVmByIDFunc == nil {
+		return nil, -1, notMocked("MockSystemVMService", "GetSystemVmByID")
+	}
+	return m.GetSystemVmByIDFunc(id, opts...)
+}
+
+func (m *MockSystemVMService) ListSystemVms(p *ListSystemVmsParams) (*ListSystemVmsResponse, error) {
+	return m.ListSystemVmsWithContext(context.Background(), p)
+}
+
+func (m *MockSystemVMService) ListSystemVmsWithContext(ctx context.Context, p *ListSystemVmsParams) (*ListSystemVmsResponse, error) {
+	m.record("ListSystemVms", p)
+	if m.ListSystemVmsFunc == nil {
+		return nil, notMocked("MockSystemVMService", "ListSystemVms")
+	}
+	return m.ListSystemVmsFunc(ctx, p)
+}
+
+func (m *MockSystemVMService) NewListSystemVmsIterator(p *ListSystemVmsParams, pagesize int) *ListSystemVmsIterator {
+	i := &ListSystemVmsIterator{pager: newPager(nil, "listSystemVms", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListSystemVmsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListSystemVmsWithContext(ctx, c)
+	}
+	return i
+}
@@ -0,0 +1,160 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestChangeServiceForSystemVmParams(t *testing.T) {
+	p := &ChangeServiceForSystemVmParams{}
+	want := url.Values{}
+	p.SetDetails(map[string]string{"k": "v"})
+	want.Set("details[0].key", "k")
+	want.Set("details[0].value", "v")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetServiceofferingid("serviceofferingid-value")
+	want.Set("serviceofferingid", "serviceofferingid-value")
+
+	assertParams(t, p, "changeServiceForSystemVm", want)
+}
+
+func TestChangeServiceForSystemVmResponse(t *testing.T) {
+	assertDecodes(t, `{"activeviewersessions":42,"created":"created-value","dns1":"dns1-value","dns2":"dns2-value","gateway":"gateway-value","hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","jobid":"jobid-value","jobstatus":42,"linklocalip":"linklocalip-value","linklocalmacaddress":"linklocalmacaddress-value","linklocalnetmask":"linklocalnetmask-value","name":"name-value","networkdomain":"networkdomain-value","podid":"podid-value","privateip":"privateip-value","privatemacaddress":"privatemacaddress-value","privatenetmask":"privatenetmask-value","publicip":"publicip-value","publicmacaddress":"publicmacaddress-value","publicnetmask":"publicnetmask-value","state":"state-value","systemvmtype":"systemvmtype-value","templateid":"templateid-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &ChangeServiceForSystemVmResponse{})
+}
+
+func TestDestroySystemVmParams(t *testing.T) {
+	p := &DestroySystemVmParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "destroySystemVm", want)
+}
+
+func TestDestroySystemVmResponse(t *testing.T) {
+	assertDecodes(t, `{"activeviewersessions":42,"created":"created-value","dns1":"dns1-value","dns2":"dns2-value","gateway":"gateway-value","hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","jobid":"jobid-value","jobstatus":42,"linklocalip":"linklocalip-value","linklocalmacaddress":"linklocalmacaddress-value","linklocalnetmask":"linklocalnetmask-value","name":"name-value","networkdomain":"networkdomain-value","podid":"podid-value","privateip":"privateip-value","privatemacaddress":"privatemacaddress-value","privatenetmask":"privatenetmask-value","publicip":"publicip-value","publicmacaddress":"publicmacaddress-value","publicnetmask":"publicnetmask-value","state":"state-value","systemvmtype":"systemvmtype-value","templateid":"templateid-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &DestroySystemVmResponse{})
+}
+
+func TestMigrateSystemVmParams(t *testing.T) {
+	p := &MigrateSystemVmParams{}
+	want := url.Values{}
+	p.SetHostid("hostid-value")
+	want.Set("hostid", "hostid-value")
+	p.SetVirtualmachineid("virtualmachineid-value")
+	want.Set("virtualmachineid", "virtualmachineid-value")
+
+	assertParams(t, p, "migrateSystemVm", want)
+}
+
+func TestMigrateSystemVmResponse(t *testing.T) {
+	assertDecodes(t, `{"activeviewersessions":42,"created":"created-value","dns1":"dns1-value","dns2":"dns2-value","gateway":"gateway-value","hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","jobid":"jobid-value","jobstatus":42,"linklocalip":"linklocalip-value","linklocalmacaddress":"linklocalmacaddress-value","linklocalnetmask":"linklocalnetmask-value","name":"name-value","networkdomain":"networkdomain-value","podid":"podid-value","privateip":"privateip-value","privatemacaddress":"privatemacaddress-value","privatenetmask":"privatenetmask-value","publicip":"publicip-value","publicmacaddress":"publicmacaddress-value","publicnetmask":"publicnetmask-value","state":"state-value","systemvmtype":"systemvmtype-value","templateid":"templateid-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &MigrateSystemVmResponse{})
+}
+
+func TestRebootSystemVmParams(t *testing.T) {
+	p := &RebootSystemVmParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "rebootSystemVm", want)
+}
+
+func TestRebootSystemVmResponse(t *testing.T) {
+	assertDecodes(t, `{"activeviewersessions":42,"created":"created-value","dns1":"dns1-value","dns2":"dns2-value","gateway":"gateway-value","hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","jobid":"jobid-value","jobstatus":42,"linklocalip":"linklocalip-value","linklocalmacaddress":"linklocalmacaddress-value","linklocalnetmask":"linklocalnetmask-value","name":"name-value","networkdomain":"networkdomain-value","podid":"podid-value","privateip":"privateip-value","privatemacaddress":"privatemacaddress-value","privatenetmask":"privatenetmask-value","publicip":"publicip-value","publicmacaddress":"publicmacaddress-value","publicnetmask":"publicnetmask-value","state":"state-value","systemvmtype":"systemvmtype-value","templateid":"templateid-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &RebootSystemVmResponse{})
+}
+
+func TestScaleSystemVmParams(t *testing.T) {
+	p := &ScaleSystemVmParams{}
+	want := url.Values{}
+	p.SetDetails(map[string]string{"k": "v"})
+	want.Set("details[0].key", "k")
+	want.Set("details[0].value", "v")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetServiceofferingid("serviceofferingid-value")
+	want.Set("serviceofferingid", "serviceofferingid-value")
+
+	assertParams(t, p, "scaleSystemVm", want)
+}
+
+func TestScaleSystemVmResponse(t *testing.T) {
+	assertDecodes(t, `{"activeviewersessions":42,"created":"created-value","dns1":"dns1-value","dns2":"dns2-value","gateway":"gateway-value","hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","jobid":"jobid-value","jobstatus":42,"linklocalip":"linklocalip-value","linklocalmacaddress":"linklocalmacaddress-value","linklocalnetmask":"linklocalnetmask-value","name":"name-value","networkdomain":"networkdomain-value","podid":"podid-value","privateip":"privateip-value","privatemacaddress":"privatemacaddress-value","privatenetmask":"privatenetmask-value","publicip":"publicip-value","publicmacaddress":"publicmacaddress-value","publicnetmask":"publicnetmask-value","state":"state-value","systemvmtype":"systemvmtype-value","templateid":"templateid-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &ScaleSystemVmResponse{})
+}
+
+func TestStartSystemVmParams(t *testing.T) {
+	p := &StartSystemVmParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "startSystemVm", want)
+}
+
+func TestStartSystemVmResponse(t *testing.T) {
+	assertDecodes(t, `{"activeviewersessions":42,"created":"created-value","dns1":"dns1-value","dns2":"dns2-value","gateway":"gateway-value","hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","jobid":"jobid-value","jobstatus":42,"linklocalip":"linklocalip-value","linklocalmacaddress":"linklocalmacaddress-value","linklocalnetmask":"linklocalnetmask-value","name":"name-value","networkdomain":"networkdomain-value","podid":"podid-value","privateip":"privateip-value","privatemacaddress":"privatemacaddress-value","privatenetmask":"privatenetmask-value","publicip":"publicip-value","publicmacaddress":"publicmacaddress-value","publicnetmask":"publicnetmask-value","state":"state-value","systemvmtype":"systemvmtype-value","templateid":"templateid-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &StartSystemVmResponse{})
+}
+
+func TestStopSystemVmParams(t *testing.T) {
+	p := &StopSystemVmParams{}
+	want := url.Values{}
+	p.SetForced(true)
+	want.Set("forced", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "stopSystemVm", want)
+}
+
+func TestStopSystemVmResponse(t *testing.T) {
+	assertDecodes(t, `{"activeviewersessions":42,"created":"created-value","dns1":"dns1-value","dns2":"dns2-value","gateway":"gateway-value","hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","jobid":"jobid-value","jobstatus":42,"linklocalip":"linklocalip-value","linklocalmacaddress":"linklocalmacaddress-value","linklocalnetmask":"linklocalnetmask-value","name":"name-value","networkdomain":"networkdomain-value","podid":"podid-value","privateip":"privateip-value","privatemacaddress":"privatemacaddress-value","privatenetmask":"privatenetmask-value","publicip":"publicip-value","publicmacaddress":"publicmacaddress-value","publicnetmask":"publicnetmask-value","state":"state-value","systemvmtype":"systemvmtype-value","templateid":"templateid-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &StopSystemVmResponse{})
+}
+
+func TestListSystemVmsParams(t *testing.T) {
+	p := &ListSystemVmsParams{}
+	want := url.Values{}
+	p.SetHostid("hostid-value")
+	want.Set("hostid", "hostid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetPodid("podid-value")
+	want.Set("podid", "podid-value")
+	p.SetState("state-value")
+	want.Set("state", "state-value")
+	p.SetStorageid("storageid-value")
+	want.Set("storageid", "storageid-value")
+	p.SetSystemvmtype("systemvmtype-value")
+	want.Set("systemvmtype", "systemvmtype-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listSystemVms", want)
+}
+
+func TestListSystemVmsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"systemvm":[{"activeviewersessions":42,"created":"created-value","dns1":"dns1-value","dns2":"dns2-value","gateway":"gateway-value","hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","jobid":"jobid-value","jobstatus":42,"linklocalip":"linklocalip-value","linklocalmacaddress":"linklocalmacaddress-value","linklocalnetmask":"linklocalnetmask-value","name":"name-value","networkdomain":"networkdomain-value","podid":"podid-value","privateip":"privateip-value","privatemacaddress":"privatemacaddress-value","privatenetmask":"privatenetmask-value","publicip":"publicip-value","publicmacaddress":"publicmacaddress-value","publicnetmask":"publicnetmask-value","state":"state-value","systemvmtype":"systemvmtype-value","templateid":"templateid-value","zoneid":"zoneid-value","zonename":"zonename-value"}]}`, &ListSystemVmsResponse{})
+}
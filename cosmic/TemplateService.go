@@ -17,17 +17,72 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 )
 
+// TemplateServiceIface is the interface implemented by TemplateService, and by MockTemplateService for tests
+type TemplateServiceIface interface {
+	NewUpgradeRouterTemplateParams() *UpgradeRouterTemplateParams
+	UpgradeRouterTemplate(p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error)
+	UpgradeRouterTemplateWithContext(ctx context.Context, p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error)
+	NewCopyTemplateParams(destzoneid string, id string) *CopyTemplateParams
+	CopyTemplate(p *CopyTemplateParams) (*CopyTemplateResponse, error)
+	CopyTemplateWithContext(ctx context.Context, p *CopyTemplateParams) (*CopyTemplateResponse, error)
+	CopyTemplateAsync(ctx context.Context, p *CopyTemplateParams) (*CopyTemplateJob, error)
+	NewCreateTemplateParams(displaytext string, name string) *CreateTemplateParams
+	CreateTemplate(p *CreateTemplateParams) (*CreateTemplateResponse, error)
+	CreateTemplateWithContext(ctx context.Context, p *CreateTemplateParams) (*CreateTemplateResponse, error)
+	CreateTemplateAsync(ctx context.Context, p *CreateTemplateParams) (*CreateTemplateJob, error)
+	NewDeleteTemplateParams(id string) *DeleteTemplateParams
+	DeleteTemplate(p *DeleteTemplateParams) (*DeleteTemplateResponse, error)
+	DeleteTemplateWithContext(ctx context.Context, p *DeleteTemplateParams) (*DeleteTemplateResponse, error)
+	DeleteTemplateAsync(ctx context.Context, p *DeleteTemplateParams) (*DeleteTemplateJob, error)
+	NewExtractTemplateParams(id string, mode string) *ExtractTemplateParams
+	ExtractTemplate(p *ExtractTemplateParams) (*ExtractTemplateResponse, error)
+	ExtractTemplateWithContext(ctx context.Context, p *ExtractTemplateParams) (*ExtractTemplateResponse, error)
+	ExtractTemplateAsync(ctx context.Context, p *ExtractTemplateParams) (*ExtractTemplateJob, error)
+	NewPrepareTemplateParams(templateid string, zoneid string) *PrepareTemplateParams
+	PrepareTemplate(p *PrepareTemplateParams) (*PrepareTemplateResponse, error)
+	PrepareTemplateWithContext(ctx context.Context, p *PrepareTemplateParams) (*PrepareTemplateResponse, error)
+	NewRegisterTemplateParams(displaytext string, format string, hypervisor string, name string, ostypeid string, url string, zoneid string) *RegisterTemplateParams
+	RegisterTemplate(p *RegisterTemplateParams) (*RegisterTemplateResponse, error)
+	RegisterTemplateWithContext(ctx context.Context, p *RegisterTemplateParams) (*RegisterTemplateResponse, error)
+	NewUpdateTemplateParams(id string) *UpdateTemplateParams
+	UpdateTemplate(p *UpdateTemplateParams) (*UpdateTemplateResponse, error)
+	UpdateTemplateWithContext(ctx context.Context, p *UpdateTemplateParams) (*UpdateTemplateResponse, error)
+	NewListTemplatePermissionsParams(id string) *ListTemplatePermissionsParams
+	GetTemplatePermissionByID(id string, opts ...OptionFunc) (*TemplatePermission, int, error)
+	ListTemplatePermissions(p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error)
+	ListTemplatePermissionsWithContext(ctx context.Context, p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error)
+	NewUpdateTemplatePermissionsParams(id string) *UpdateTemplatePermissionsParams
+	UpdateTemplatePermissions(p *UpdateTemplatePermissionsParams) (*UpdateTemplatePermissionsResponse, error)
+	UpdateTemplatePermissionsWithContext(ctx context.Context, p *UpdateTemplatePermissionsParams) (*UpdateTemplatePermissionsResponse, error)
+	NewListTemplatesParams(templatefilter string) *ListTemplatesParams
+	GetTemplateID(name string, templatefilter string, zoneid string, opts ...OptionFunc) (string, int, error)
+	GetTemplateByName(name string, templatefilter string, zoneid string, opts ...OptionFunc) (*Template, int, error)
+	GetTemplateByID(id string, templatefilter string, opts ...OptionFunc) (*Template, int, error)
+	ListTemplates(p *ListTemplatesParams) (*ListTemplatesResponse, error)
+	ListTemplatesWithContext(ctx context.Context, p *ListTemplatesParams) (*ListTemplatesResponse, error)
+	NewListTemplatesIterator(p *ListTemplatesParams, pagesize int) *ListTemplatesIterator
+	NewGetUploadParamsForTemplateParams(displaytext string, format string, hypervisor string, name string, ostypeid string, zoneid string) *GetUploadParamsForTemplateParams
+	GetUploadParamsForTemplate(p *GetUploadParamsForTemplateParams) (*GetUploadParamsForTemplateResponse, error)
+	GetUploadParamsForTemplateWithContext(ctx context.Context, p *GetUploadParamsForTemplateParams) (*GetUploadParamsForTemplateResponse, error)
+}
+
 type UpgradeRouterTemplateParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpgradeRouterTemplateParams) command() string {
+	return "upgradeRouterTemplate"
+}
+
 func (p *UpgradeRouterTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -106,7 +161,13 @@ func (s *TemplateService) NewUpgradeRouterTemplateParams() *UpgradeRouterTemplat
 
 // Upgrades router to use newer template
 func (s *TemplateService) UpgradeRouterTemplate(p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error) {
-	resp, err := s.cs.newRequest("upgradeRouterTemplate", p.toURLValues())
+	return s.UpgradeRouterTemplateWithContext(context.Background(), p)
+}
+
+// UpgradeRouterTemplateWithContext is the same as UpgradeRouterTemplate, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *TemplateService) UpgradeRouterTemplateWithContext(ctx context.Context, p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "upgradeRouterTemplate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -127,6 +188,10 @@ type CopyTemplateParams struct {
 	p map[string]interface{}
 }
 
+func (p *CopyTemplateParams) command() string {
+	return "copyTemplate"
+}
+
 func (p *CopyTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -177,7 +242,13 @@ func (s *TemplateService) NewCopyTemplateParams(destzoneid string, id string) *C
 
 // Copies a template from one zone to another.
 func (s *TemplateService) CopyTemplate(p *CopyTemplateParams) (*CopyTemplateResponse, error) {
-	resp, err := s.cs.newRequest("copyTemplate", p.toURLValues())
+	return s.CopyTemplateWithContext(context.Background(), p)
+}
+
+// CopyTemplateWithContext is the same as CopyTemplate, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *TemplateService) CopyTemplateWithContext(ctx context.Context, p *CopyTemplateParams) (*CopyTemplateResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "copyTemplate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -189,26 +260,56 @@ func (s *TemplateService) CopyTemplate(p *CopyTemplateParams) (*CopyTemplateResp
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "copyTemplate", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "template", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CopyTemplateJob is a handle to a running copyTemplate async job
+type CopyTemplateJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CopyTemplateResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CopyTemplateJob) Result() (*CopyTemplateResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CopyTemplateResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "template", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CopyTemplateAsync starts the copyTemplate async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *TemplateService) CopyTemplateAsync(ctx context.Context, p *CopyTemplateParams) (*CopyTemplateJob, error) {
+	resp, err := s.cs.newRequest(ctx, "copyTemplate", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CopyTemplateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CopyTemplateJob{s.cs.newJobHandle("copyTemplate", r.JobID)}, nil
+}
+
 type CopyTemplateResponse struct {
 	JobID                 string            `json:"jobid,omitempty"`
 	Account               string            `json:"account,omitempty"`
@@ -270,6 +371,10 @@ type CreateTemplateParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateTemplateParams) command() string {
+	return "createTemplate"
+}
+
 func (p *CreateTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -510,7 +615,13 @@ func (s *TemplateService) NewCreateTemplateParams(displaytext string, name strin
 
 // Creates a template of a virtual machine. The virtual machine must be in a STOPPED state. A template created from this command is automatically designated as a private template visible to the account that created it.
 func (s *TemplateService) CreateTemplate(p *CreateTemplateParams) (*CreateTemplateResponse, error) {
-	resp, err := s.cs.newRequest("createTemplate", p.toURLValues())
+	return s.CreateTemplateWithContext(context.Background(), p)
+}
+
+// CreateTemplateWithContext is the same as CreateTemplate, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *TemplateService) CreateTemplateWithContext(ctx context.Context, p *CreateTemplateParams) (*CreateTemplateResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createTemplate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -522,26 +633,56 @@ func (s *TemplateService) CreateTemplate(p *CreateTemplateParams) (*CreateTempla
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createTemplate", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "template", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CreateTemplateJob is a handle to a running createTemplate async job
+type CreateTemplateJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateTemplateResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateTemplateJob) Result() (*CreateTemplateResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateTemplateResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "template", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CreateTemplateAsync starts the createTemplate async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *TemplateService) CreateTemplateAsync(ctx context.Context, p *CreateTemplateParams) (*CreateTemplateJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createTemplate", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateTemplateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateTemplateJob{s.cs.newJobHandle("createTemplate", r.JobID)}, nil
+}
+
 type CreateTemplateResponse struct {
 	JobID                 string            `json:"jobid,omitempty"`
 	Account               string            `json:"account,omitempty"`
@@ -603,6 +744,10 @@ type DeleteTemplateParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteTemplateParams) command() string {
+	return "deleteTemplate"
+}
+
 func (p *DeleteTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -642,7 +787,13 @@ func (s *TemplateService) NewDeleteTemplateParams(id string) *DeleteTemplatePara
 
 // Deletes a template from the system. All virtual machines using the deleted template will not be affected.
 func (s *TemplateService) DeleteTemplate(p *DeleteTemplateParams) (*DeleteTemplateResponse, error) {
-	resp, err := s.cs.newRequest("deleteTemplate", p.toURLValues())
+	return s.DeleteTemplateWithContext(context.Background(), p)
+}
+
+// DeleteTemplateWithContext is the same as DeleteTemplate, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *TemplateService) DeleteTemplateWithContext(ctx context.Context, p *DeleteTemplateParams) (*DeleteTemplateResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteTemplate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -654,9 +805,9 @@ func (s *TemplateService) DeleteTemplate(p *DeleteTemplateParams) (*DeleteTempla
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteTemplate", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -669,6 +820,41 @@ func (s *TemplateService) DeleteTemplate(p *DeleteTemplateParams) (*DeleteTempla
 	return &r, nil
 }
 
+// DeleteTemplateJob is a handle to a running deleteTemplate async job
+type DeleteTemplateJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteTemplateResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteTemplateJob) Result() (*DeleteTemplateResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteTemplateResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteTemplateAsync starts the deleteTemplate async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *TemplateService) DeleteTemplateAsync(ctx context.Context, p *DeleteTemplateParams) (*DeleteTemplateJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteTemplate", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteTemplateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteTemplateJob{s.cs.newJobHandle("deleteTemplate", r.JobID)}, nil
+}
+
 type DeleteTemplateResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -679,6 +865,10 @@ type ExtractTemplateParams struct {
 	p map[string]interface{}
 }
 
+func (p *ExtractTemplateParams) command() string {
+	return "extractTemplate"
+}
+
 func (p *ExtractTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -739,7 +929,13 @@ func (s *TemplateService) NewExtractTemplateParams(id string, mode string) *Extr
 
 // Extracts a template
 func (s *TemplateService) ExtractTemplate(p *ExtractTemplateParams) (*ExtractTemplateResponse, error) {
-	resp, err := s.cs.newRequest("extractTemplate", p.toURLValues())
+	return s.ExtractTemplateWithContext(context.Background(), p)
+}
+
+// ExtractTemplateWithContext is the same as ExtractTemplate, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *TemplateService) ExtractTemplateWithContext(ctx context.Context, p *ExtractTemplateParams) (*ExtractTemplateResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "extractTemplate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -751,26 +947,56 @@ func (s *TemplateService) ExtractTemplate(p *ExtractTemplateParams) (*ExtractTem
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "extractTemplate", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "template", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// ExtractTemplateJob is a handle to a running extractTemplate async job
+type ExtractTemplateJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a ExtractTemplateResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *ExtractTemplateJob) Result() (*ExtractTemplateResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := ExtractTemplateResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "template", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// ExtractTemplateAsync starts the extractTemplate async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *TemplateService) ExtractTemplateAsync(ctx context.Context, p *ExtractTemplateParams) (*ExtractTemplateJob, error) {
+	resp, err := s.cs.newRequest(ctx, "extractTemplate", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ExtractTemplateResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &ExtractTemplateJob{s.cs.newJobHandle("extractTemplate", r.JobID)}, nil
+}
+
 type ExtractTemplateResponse struct {
 	JobID            string `json:"jobid,omitempty"`
 	Accountid        string `json:"accountid,omitempty"`
@@ -793,6 +1019,10 @@ type PrepareTemplateParams struct {
 	p map[string]interface{}
 }
 
+func (p *PrepareTemplateParams) command() string {
+	return "prepareTemplate"
+}
+
 func (p *PrepareTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -843,7 +1073,13 @@ func (s *TemplateService) NewPrepareTemplateParams(templateid string, zoneid str
 
 // load template into primary storage
 func (s *TemplateService) PrepareTemplate(p *PrepareTemplateParams) (*PrepareTemplateResponse, error) {
-	resp, err := s.cs.newRequest("prepareTemplate", p.toURLValues())
+	return s.PrepareTemplateWithContext(context.Background(), p)
+}
+
+// PrepareTemplateWithContext is the same as PrepareTemplate, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *TemplateService) PrepareTemplateWithContext(ctx context.Context, p *PrepareTemplateParams) (*PrepareTemplateResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "prepareTemplate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -915,6 +1151,10 @@ type RegisterTemplateParams struct {
 	p map[string]interface{}
 }
 
+func (p *RegisterTemplateParams) command() string {
+	return "registerTemplate"
+}
+
 func (p *RegisterTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1193,7 +1433,13 @@ func (s *TemplateService) NewRegisterTemplateParams(displaytext string, format s
 
 // Registers an existing template into the CloudStack cloud.
 func (s *TemplateService) RegisterTemplate(p *RegisterTemplateParams) (*RegisterTemplateResponse, error) {
-	resp, err := s.cs.newRequest("registerTemplate", p.toURLValues())
+	return s.RegisterTemplateWithContext(context.Background(), p)
+}
+
+// RegisterTemplateWithContext is the same as RegisterTemplate, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *TemplateService) RegisterTemplateWithContext(ctx context.Context, p *RegisterTemplateParams) (*RegisterTemplateResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "registerTemplate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1270,6 +1516,10 @@ type UpdateTemplateParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateTemplateParams) command() string {
+	return "updateTemplate"
+}
+
 func (p *UpdateTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1469,7 +1719,13 @@ func (s *TemplateService) NewUpdateTemplateParams(id string) *UpdateTemplatePara
 
 // Updates attributes of a template.
 func (s *TemplateService) UpdateTemplate(p *UpdateTemplateParams) (*UpdateTemplateResponse, error) {
-	resp, err := s.cs.newRequest("updateTemplate", p.toURLValues())
+	return s.UpdateTemplateWithContext(context.Background(), p)
+}
+
+// UpdateTemplateWithContext is the same as UpdateTemplate, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *TemplateService) UpdateTemplateWithContext(ctx context.Context, p *UpdateTemplateParams) (*UpdateTemplateResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateTemplate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1541,6 +1797,10 @@ type ListTemplatePermissionsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListTemplatePermissionsParams) command() string {
+	return "listTemplatePermissions"
+}
+
 func (p *ListTemplatePermissionsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1583,16 +1843,14 @@ func (s *TemplateService) GetTemplatePermissionByID(id string, opts ...OptionFun
 
 	l, err := s.ListTemplatePermissions(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -1604,7 +1862,13 @@ func (s *TemplateService) GetTemplatePermissionByID(id string, opts ...OptionFun
 
 // List template visibility and all accounts that have permissions to view this template.
 func (s *TemplateService) ListTemplatePermissions(p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error) {
-	resp, err := s.cs.newRequest("listTemplatePermissions", p.toURLValues())
+	return s.ListTemplatePermissionsWithContext(context.Background(), p)
+}
+
+// ListTemplatePermissionsWithContext is the same as ListTemplatePermissions, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *TemplateService) ListTemplatePermissionsWithContext(ctx context.Context, p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "listTemplatePermissions", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1633,6 +1897,10 @@ type UpdateTemplatePermissionsParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateTemplatePermissionsParams) command() string {
+	return "updateTemplatePermissions"
+}
+
 func (p *UpdateTemplatePermissionsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1727,7 +1995,13 @@ func (s *TemplateService) NewUpdateTemplatePermissionsParams(id string) *UpdateT
 
 // Updates a template visibility permissions. A public template is visible to all accounts within the same domain. A private template is visible only to the owner of the template. A priviledged template is a private template with account permissions added. Only accounts specified under the template permissions are visible to them.
 func (s *TemplateService) UpdateTemplatePermissions(p *UpdateTemplatePermissionsParams) (*UpdateTemplatePermissionsResponse, error) {
-	resp, err := s.cs.newRequest("updateTemplatePermissions", p.toURLValues())
+	return s.UpdateTemplatePermissionsWithContext(context.Background(), p)
+}
+
+// UpdateTemplatePermissionsWithContext is the same as UpdateTemplatePermissions, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *TemplateService) UpdateTemplatePermissionsWithContext(ctx context.Context, p *UpdateTemplatePermissionsParams) (*UpdateTemplatePermissionsResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateTemplatePermissions", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1748,6 +2022,10 @@ type ListTemplatesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListTemplatesParams) command() string {
+	return "listTemplates"
+}
+
 func (p *ListTemplatesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1946,7 +2224,7 @@ func (s *TemplateService) GetTemplateID(name string, templatefilter string, zone
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -1960,7 +2238,7 @@ func (s *TemplateService) GetTemplateID(name string, templatefilter string, zone
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -1993,16 +2271,14 @@ func (s *TemplateService) GetTemplateByID(id string, templatefilter string, opts
 
 	l, err := s.ListTemplates(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -2014,10 +2290,17 @@ func (s *TemplateService) GetTemplateByID(id string, templatefilter string, opts
 
 // List all public, private, and privileged templates.
 func (s *TemplateService) ListTemplates(p *ListTemplatesParams) (*ListTemplatesResponse, error) {
+	return s.ListTemplatesWithContext(context.Background(), p)
+}
+
+// ListTemplatesWithContext is the same as ListTemplates, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *TemplateService) ListTemplatesWithContext(ctx context.Context, p *ListTemplatesParams) (*ListTemplatesResponse, error) {
 	var r ListTemplatesResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListTemplatesResponse
-		resp, err := s.cs.newRequest("listTemplates", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listTemplates", values)
 		if err != nil {
 			return nil, err
 		}
@@ -2029,13 +2312,89 @@ func (s *TemplateService) ListTemplates(p *ListTemplatesParams) (*ListTemplatesR
 		r.Count = l.Count
 		r.Templates = append(r.Templates, l.Templates...)
 
-		if r.Count == len(r.Templates) {
+		if r.Count == len(r.Templates) || len(l.Templates) == 0 {
+			s.cs.observePages("listTemplates", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.Templates))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.Templates)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listTemplates", values, len(l.Templates), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListTemplatesResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.Templates = append(r.Templates, l.Templates...)
+			}
+
+			if r.Count == len(r.Templates) {
+				s.cs.observePages("listTemplates", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListTemplatesIterator iterates over the items returned by listTemplates, requesting
+// the pages of the response one at a time while iterating.
+type ListTemplatesIterator struct {
+	pager
+	items []*Template
+	index int
+}
+
+// NewListTemplatesIterator returns an iterator over the items returned by listTemplates, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *TemplateService) NewListTemplatesIterator(p *ListTemplatesParams, pagesize int) *ListTemplatesIterator {
+	return &ListTemplatesIterator{pager: newPager(s.cs, "listTemplates", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListTemplatesIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
 	}
+
+	var l ListTemplatesResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.Templates))
+
+	i.items = l.Templates
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListTemplatesIterator) Value() *Template {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
 }
 
 type ListTemplatesResponse struct {
@@ -2103,6 +2462,10 @@ type GetUploadParamsForTemplateParams struct {
 	p map[string]interface{}
 }
 
+func (p *GetUploadParamsForTemplateParams) command() string {
+	return "getUploadParamsForTemplate"
+}
+
 func (p *GetUploadParamsForTemplateParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2340,7 +2703,13 @@ func (s *TemplateService) NewGetUploadParamsForTemplateParams(displaytext string
 
 // upload an existing template into the CloudStack cloud.
 func (s *TemplateService) GetUploadParamsForTemplate(p *GetUploadParamsForTemplateParams) (*GetUploadParamsForTemplateResponse, error) {
-	resp, err := s.cs.newRequest("getUploadParamsForTemplate", p.toURLValues())
+	return s.GetUploadParamsForTemplateWithContext(context.Background(), p)
+}
+
+// GetUploadParamsForTemplateWithContext is the same as GetUploadParamsForTemplate, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *TemplateService) GetUploadParamsForTemplateWithContext(ctx context.Context, p *GetUploadParamsForTemplateParams) (*GetUploadParamsForTemplateResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "getUploadParamsForTemplate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
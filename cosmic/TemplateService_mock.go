@@ -0,0 +1,319 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockTemplateService is a mock of TemplateServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockTemplateService struct {
+	mockCalls
+
+	UpgradeRouterTemplateFunc      func(ctx context.Context, p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error)
+	CopyTemplateFunc               func(ctx context.Context, p *CopyTemplateParams) (*CopyTemplateResponse, error)
+	CopyTemplateAsyncFunc          func(ctx context.Context, p *CopyTemplateParams) (*CopyTemplateJob, error)
+	CreateTemplateFunc             func(ctx context.Context, p *CreateTemplateParams) (*CreateTemplateResponse, error)
+	CreateTemplateAsyncFunc        func(ctx context.Context, p *CreateTemplateParams) (*CreateTemplateJob, error)
+	DeleteTemplateFunc             func(ctx context.Context, p *DeleteTemplateParams) (*DeleteTemplateResponse, error)
+	DeleteTemplateAsyncFunc        func(ctx context.Context, p *DeleteTemplateParams) (*DeleteTemplateJob, error)
+	ExtractTemplateFunc            func(ctx context.Context, p *ExtractTemplateParams) (*ExtractTemplateResponse, error)
+	ExtractTemplateAsyncFunc       func(ctx context.Context, p *ExtractTemplateParams) (*ExtractTemplateJob, error)
+	PrepareTemplateFunc            func(ctx context.Context, p *PrepareTemplateParams) (*PrepareTemplateResponse, error)
+	RegisterTemplateFunc           func(ctx context.Context, p *RegisterTemplateParams) (*RegisterTemplateResponse, error)
+	UpdateTemplateFunc             func(ctx context.Context, p *UpdateTemplateParams) (*UpdateTemplateResponse, error)
+	GetTemplatePermissionByIDFunc  func(id string, opts ...OptionFunc) (*TemplatePermission, int, error)
+	ListTemplatePermissionsFunc    func(ctx context.Context, p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error)
+	UpdateTemplatePermissionsFunc  func(ctx context.Context, p *UpdateTemplatePermissionsParams) (*UpdateTemplatePermissionsResponse, error)
+	GetTemplateIDFunc              func(name string, templatefilter string, zoneid string, opts ...OptionFunc) (string, int, error)
+	GetTemplateByNameFunc          func(name string, templatefilter string, zoneid string, opts ...OptionFunc) (*Template, int, error)
+	GetTemplateByIDFunc            func(id string, templatefilter string, opts ...OptionFunc) (*Template, int, error)
+	ListTemplatesFunc              func(ctx context.Context, p *ListTemplatesParams) (*ListTemplatesResponse, error)
+	GetUploadParamsForTemplateFunc func(ctx context.Context, p *GetUploadParamsForTemplateParams) (*GetUploadParamsForTemplateResponse, error)
+}
+
+var _ TemplateServiceIface = &MockTemplateService{}
+
+func (m *MockTemplateService) NewUpgradeRouterTemplateParams() *UpgradeRouterTemplateParams {
+	return (&TemplateService{}).NewUpgradeRouterTemplateParams()
+}
+
+func (m *MockTemplateService) UpgradeRouterTemplate(p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error) {
+	return m.UpgradeRouterTemplateWithContext(context.Background(), p)
+}
+
+func (m *MockTemplateService) UpgradeRouterTemplateWithContext(ctx context.Context, p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error) {
+	m.record("UpgradeRouterTemplate", p)
+	if m.UpgradeRouterTemplateFunc == nil {
+		return nil, notMocked("MockTemplateService", "UpgradeRouterTemplate")
+	}
+	return m.UpgradeRouterTemplateFunc(ctx, p)
+}
+
+func (m *MockTemplateService) NewCopyTemplateParams(destzoneid string, id string) *CopyTemplateParams {
+	return (&TemplateService{}).NewCopyTemplateParams(destzoneid, id)
+}
+
+func (m *MockTemplateService) CopyTemplate(p *CopyTemplateParams) (*CopyTemplateResponse, error) {
+	return m.CopyTemplateWithContext(context.Background(), p)
+}
+
+func (m *MockTemplateService) CopyTemplateWithContext(ctx context.Context, p *CopyTemplateParams) (*CopyTemplateResponse, error) {
+	m.record("CopyTemplate", p)
+	if m.CopyTemplateFunc == nil {
+		return nil, notMocked("MockTemplateService", "CopyTemplate")
+	}
+	return m.CopyTemplateFunc(ctx, p)
+}
+
+func (m *MockTemplateService) CopyTemplateAsync(ctx context.Context, p *CopyTemplateParams) (*CopyTemplateJob, error) {
+	m.record("CopyTemplateAsync", p)
+	if m.CopyTemplateAsyncFunc == nil {
+		return nil, notMocked("MockTemplateService", "CopyTemplateAsync")
+	}
+	return m.CopyTemplateAsyncFunc(ctx, p)
+}
+
+func (m *MockTemplateService) NewCreateTemplateParams(displaytext string, name string) *CreateTemplateParams {
+	return (&TemplateService{}).NewCreateTemplateParams(displaytext, name)
+}
+
+func (m *MockTemplateService) CreateTemplate(p *CreateTemplateParams) (*CreateTemplateResponse, error) {
+	return m.CreateTemplateWithContext(context.Background(), p)
+}
+
+func (m *MockTemplateService) CreateTemplateWithContext(ctx context.Context, p *CreateTemplateParams) (*CreateTemplateResponse, error) {
+	m.record("CreateTemplate", p)
+	if m.CreateTemplateFunc == nil {
+		return nil, notMocked("MockTemplateService", "CreateTemplate")
+	}
+	return m.CreateTemplateFunc(ctx, p)
+}
+
+func (m *MockTemplateService) CreateTemplateAsync(ctx context.Context, p *CreateTemplateParams) (*CreateTemplateJob, error) {
+	m.record("CreateTemplateAsync", p)
+	if m.CreateTemplateAsyncFunc == nil {
+		return nil, notMocked("MockTemplateService", "CreateTemplateAsync")
+	}
+	return m.CreateTemplateAsyncFunc(ctx, p)
+}
+
+func (m *MockTemplateService) NewDeleteTemplateParams(id string) *DeleteTemplateParams {
+	return (&TemplateService{}).NewDeleteTemplateParams(id)
+}
+
+func (m *MockTemplateService) DeleteTemplate(p *DeleteTemplateParams) (*DeleteTemplateResponse, error) {
+	return m.DeleteTemplateWithContext(context.Background(), p)
+}
+
+func (m *MockTemplateService) DeleteTemplateWithContext(ctx context.Context, p *DeleteTemplateParams) (*DeleteTemplateResponse, error) {
+	m.record("DeleteTemplate", p)
+	if m.DeleteTemplateFunc == nil {
+		return nil, notMocked("MockTemplateService", "DeleteTemplate")
+	}
+	return m.DeleteTemplateFunc(ctx, p)
+}
+
+func (m *MockTemplateService) DeleteTemplateAsync(ctx context.Context, p *DeleteTemplateParams) (*DeleteTemplateJob, error) {
+	m.record("DeleteTemplateAsync", p)
+	if m.DeleteTemplateAsyncFunc == nil {
+		return nil, notMocked("MockTemplateService", "DeleteTemplateAsync")
+	}
+	return m.DeleteTemplateAsyncFunc(ctx, p)
+}
+
+func (m *MockTemplateService) NewExtractTemplateParams(id string, mode string) *ExtractTemplateParams {
+	return (&TemplateService{}).NewExtractTemplateParams(id, mode)
+}
+
+func (m *MockTemplateService) ExtractTemplate(p *ExtractTemplateParams) (*ExtractTemplateResponse, error) {
+	return m.ExtractTemplateWithContext(context.Background(), p)
+}
+
+func (m *MockTemplateService) ExtractTemplateWithContext(ctx context.Context, p *ExtractTemplateParams) (*ExtractTemplateResponse, error) {
+	m.record("ExtractTemplate", p)
+	if m.ExtractTemplateFunc == nil {
+		return nil, notMocked("MockTemplateService", "ExtractTemplate")
+	}
+	return m.ExtractTemplateFunc(ctx, p)
+}
+
+func (m *MockTemplateService) ExtractTemplateAsync(ctx context.Context, p *ExtractTemplateParams) (*ExtractTemplateJob, error) {
+	m.record("ExtractTemplateAsync", p)
+	if m.ExtractTemplateAsyncFunc == nil {
+		return nil, notMocked("MockTemplateService", "ExtractTemplateAsync")
+	}
+	return m.ExtractTemplateAsyncFunc(ctx, p)
+}
+
+func (m *MockTemplateService) NewPrepareTemplateParams(templateid string, zoneid string) *PrepareTemplateParams {
+	return (&TemplateService{}).NewPrepareTemplateParams(templateid, zoneid)
+}
+
+func (m *MockTemplateService) PrepareTemplate(p *PrepareTemplateParams) (*PrepareTemplateResponse, error) {
+	return m.PrepareTemplateWithContext(context.Background(), p)
+}
+
+func (m *MockTemplateService) PrepareTemplateWithContext(ctx context.Context, p *PrepareTemplateParams) (*PrepareTemplateResponse, error) {
+	m.record("PrepareTemplate", p)
+	if m.PrepareTemplateFunc == nil {
+		return nil, notMocked("MockTemplateService", "PrepareTemplate")
+	}
+	return m.PrepareTemplateFunc(ctx, p)
+}
+
+func (m *MockTemplateService) NewRegisterTemplateParams(displaytext string, format string, hypervisor string, name string, ostypeid string, url string, zoneid string) *RegisterTemplateParams {
+	return (&TemplateService{}).NewRegisterTemplateParams(displaytext, format, hypervisor, name, ostypeid, url, zoneid)
+}
+
+func (m *MockTemplateService) RegisterTemplate(p *RegisterTemplateParams) (*RegisterTemplateResponse, error) {
+	return m.RegisterTemplateWithContext(context.Background(), p)
+}
+
+func (m *MockTemplateService) RegisterTemplateWithContext(ctx context.Context, p *RegisterTemplateParams) (*RegisterTemplateResponse, error) {
+	m.record("RegisterTemplate", p)
+	if m.RegisterTemplateFunc == nil {
+		return nil, notMocked("MockTemplateService", "RegisterTemplate")
+	}
+	return m.RegisterTemplateFunc(ctx, p)
+}
+
+func (m *MockTemplateService) NewUpdateTemplateParams(id string) *UpdateTemplateParams {
+	return (&TemplateService{}).NewUpdateTemplateParams(id)
+}
+
+func (m *MockTemplateService) UpdateTemplate(p *UpdateTemplateParams) (*UpdateTemplateResponse, error) {
+	return m.UpdateTemplateWithContext(context.Background(), p)
+}
+
+func (m *MockTemplateService) UpdateTemplateWithContext(ctx context.Context, p *UpdateTemplateParams) (*UpdateTemplateResponse, error) {
+	m.record("UpdateTemplate", p)
+	if m.UpdateTemplateFunc == nil {
+		return nil, notMocked("MockTemplateService", "UpdateTemplate")
+	}
+	return m.UpdateTemplateFunc(ctx, p)
+}
+
+func (m *MockTemplateService) NewListTemplatePermissionsParams(id string) *ListTemplatePermissionsParams {
+	return (&TemplateService{}).NewListTemplatePermissionsParams(id)
+}
+
+func (m *MockTemplateService) GetTemplatePermissionByID(id string, opts ...OptionFunc) (*TemplatePermission, int, error) {
+	m.record("GetTemplatePermissionByID", id, opts)
+	if m.GetTemplatePermissionByIDFunc == nil {
+		return nil, -1, notMocked("MockTemplateService", "GetTemplatePermissionByID")
+	}
+	return m.GetTemplatePermissionByIDFunc(id, opts...)
+}
+
+func (m *MockTemplateService) ListTemplatePermissions(p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error) {
+	return m.ListTemplatePermissionsWithContext(context.Background(), p)
+}
+
+func (m *MockTemplateService) ListTemplatePermissionsWithContext(ctx context.Context, p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error) {
+	m.record("ListTemplatePermissions", p)
+	if m.ListTemplatePermissionsFunc == nil {
+		return nil, notMocked("MockTemplateService", "ListTemplatePermissions")
+	}
+	return m.ListTemplatePermissionsFunc(ctx, p)
+}
+
+func (m *MockTemplateService) NewUpdateTemplatePermissionsParams(id string) *UpdateTemplatePermissionsParams {
+	return (&TemplateService{}).NewUpdateTemplatePermissionsParams(id)
+}
+
+func (m *MockTemplateService) UpdateTemplatePermissions(p *UpdateTemplatePermissionsParams) (*UpdateTemplatePermissionsResponse, error) {
+	return m.UpdateTemplatePermissionsWithContext(context.Background(), p)
+}
+
+func (m *MockTemplateService) UpdateTemplatePermissionsWithContext(ctx context.Context, p *UpdateTemplatePermissionsParams) (*UpdateTemplatePermissionsResponse, error) {
+	m.record("UpdateTemplatePermissions", p)
+	if m.UpdateTemplatePermissionsFunc == nil {
+		return nil, notMocked("MockTemplateService", "UpdateTemplatePermissions")
+	}
+	return m.UpdateTemplatePermissionsFunc(ctx, p)
+}
+
+func (m *MockTemplateService) NewListTemplatesParams(templatefilter string) *ListTemplatesParams {
+	return (&TemplateService{}).NewListTemplatesParams(templatefilter)
+}
+
+func (m *MockTemplateService) GetTemplateID(name string, templatefilter string, zoneid string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetTemplateID", name, templatefilter, zoneid, opts)
+	if m.GetTemplateIDFunc == nil {
+		return "", -1, notMocked("MockTemplateService", "GetTemplateID")
+	}
+	return m.GetTemplateIDFunc(name, templatefilter, zoneid, opts...)
+}
+
+func (m *MockTemplateService) GetTemplateByName(name string, templatefilter string, zoneid string, opts ...OptionFunc) (*Template, int, error) {
+	m.record("GetTemplateByName", name, templatefilter, zoneid, opts)
+	if m.GetTemplateByNameFunc == nil {
+		return nil, -1, notMocked("MockTemplateService", "GetTemplateByName")
+	}
+	return m.GetTemplateByNameFunc(name, templatefilter, zoneid, opts...)
+}
+
+func (m *MockTemplateService) GetTemplateByID(id string, templatefilter string, opts ...OptionFunc) (*Template, int, error) {
+	m.record("GetTemplateByID", id, templatefilter, opts)
+	if m.GetTemplateByIDFunc == nil {
+		return nil, -1, notMocked("MockTemplateService", "GetTemplateByID")
+	}
+	return m.GetTemplateByIDFunc(id, templatefilter, opts...)
+}
+
+func (m *MockTemplateService) ListTemplates(p *ListTemplatesParams) (*ListTemplatesResponse, error) {
+	return m.ListTemplatesWithContext(context.Background(), p)
+}
+
+func (m *MockTemplateService) ListTemplatesWithContext(ctx context.Context, p *ListTemplatesParams) (*ListTemplatesResponse, error) {
+	m.record("ListTemplates", p)
+	if m.ListTemplatesFunc == nil {
+		return nil, notMocked("MockTemplateService", "ListTemplates")
+	}
+	return m.ListTemplatesFunc(ctx, p)
+}
+
+func (m *MockTemplateService) NewListTemplatesIterator(p *ListTemplatesParams, pagesize int) *ListTemplatesIterator {
+	i := &ListTemplatesIterator{pager: newPager(nil, "listTemplates", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListTemplatesParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListTemplatesWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockTemplateService) NewGetUploadParamsForTemplateParams(displaytext string, format string, hypervisor string, name string, ostypeid string, zoneid string) *GetUploadParamsForTemplateParams {
+	return (&TemplateService{}).NewGetUploadParamsForTemplateParams(displaytext, format, hypervisor, name, ostypeid, zoneid)
+}
+
+func (m *MockTemplateService) GetUploadParamsForTemplate(p *GetUploadParamsForTemplateParams) (*GetUploadParamsForTemplateResponse, error) {
+	return m.GetUploadParamsForTemplateWithContext(context.Background(), p)
+}
+
+func (m *MockTemplateService) GetUploadParamsForTemplateWithContext(ctx context.Context, p *GetUploadParamsForTemplateParams) (*GetUploadParamsForTemplateResponse, error) {
+	m.record("GetUploadParamsForTemplate", p)
+	if m.GetUploadParamsForTemplateFunc == nil {
+		return nil, notMocked("MockTemplateService", "GetUploadParamsForTemplate")
+	}
+	return m.GetUploadParamsForTemplateFunc(ctx, p)
+}
@@ -0,0 +1,405 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestUpgradeRouterTemplateParams(t *testing.T) {
+	p := &UpgradeRouterTemplateParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetClusterid("clusterid-value")
+	want.Set("clusterid", "clusterid-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetPodid("podid-value")
+	want.Set("podid", "podid-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "upgradeRouterTemplate", want)
+}
+
+func TestUpgradeRouterTemplateResponse(t *testing.T) {
+	assertDecodes(t, `{"jobid":"jobid-value","jobstatus":42}`, &UpgradeRouterTemplateResponse{})
+}
+
+func TestCopyTemplateParams(t *testing.T) {
+	p := &CopyTemplateParams{}
+	want := url.Values{}
+	p.SetDestzoneid("destzoneid-value")
+	want.Set("destzoneid", "destzoneid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetSourcezoneid("sourcezoneid-value")
+	want.Set("sourcezoneid", "sourcezoneid-value")
+
+	assertParams(t, p, "copyTemplate", want)
+}
+
+func TestCopyTemplateResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","accountid":"accountid-value","bootable":true,"checksum":"checksum-value","cpuflags":"cpuflags-value","created":"created-value","crossZones":true,"details":{"key":"value"},"displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","format":"format-value","hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","isdynamicallyscalable":true,"isextractable":true,"isfeatured":true,"ispublic":true,"isready":true,"jobid":"jobid-value","maclearning":"maclearning-value","maintenancepolicy":"maintenancepolicy-value","manufacturerstring":"manufacturerstring-value","name":"name-value","optimisefor":"optimisefor-value","ostypeid":"ostypeid-value","ostypename":"ostypename-value","passwordenabled":true,"project":"project-value","projectid":"projectid-value","removed":"removed-value","size":1099511627776,"sourcetemplateid":"sourcetemplateid-value","sshkeyenabled":true,"status":"status-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templatetag":"templatetag-value","templatetype":"templatetype-value","url":"url-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &CopyTemplateResponse{})
+}
+
+func TestCreateTemplateParams(t *testing.T) {
+	p := &CreateTemplateParams{}
+	want := url.Values{}
+	p.SetBits(42)
+	want.Set("bits", "42")
+	p.SetCpuflags("cpuflags-value")
+	want.Set("cpuflags", "cpuflags-value")
+	p.SetDetails(map[string]string{"k": "v"})
+	want.Set("details[0].key", "k")
+	want.Set("details[0].value", "v")
+	p.SetDisplaytext("displaytext-value")
+	want.Set("displaytext", "displaytext-value")
+	p.SetHypervisor("hypervisor-value")
+	want.Set("hypervisor", "hypervisor-value")
+	p.SetIsdynamicallyscalable(true)
+	want.Set("isdynamicallyscalable", "true")
+	p.SetIsfeatured(true)
+	want.Set("isfeatured", "true")
+	p.SetIspublic(true)
+	want.Set("ispublic", "true")
+	p.SetMaclearning("maclearning-value")
+	want.Set("maclearning", "maclearning-value")
+	p.SetMaintenancepolicy("maintenancepolicy-value")
+	want.Set("maintenancepolicy", "maintenancepolicy-value")
+	p.SetManufacturerstring("manufacturerstring-value")
+	want.Set("manufacturerstring", "manufacturerstring-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetOptimisefor("optimisefor-value")
+	want.Set("optimisefor", "optimisefor-value")
+	p.SetOstypeid("ostypeid-value")
+	want.Set("ostypeid", "ostypeid-value")
+	p.SetPasswordenabled(true)
+	want.Set("passwordenabled", "true")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetSnapshotid("snapshotid-value")
+	want.Set("snapshotid", "snapshotid-value")
+	p.SetTemplatetag("templatetag-value")
+	want.Set("templatetag", "templatetag-value")
+	p.SetUrl("url-value")
+	want.Set("url", "url-value")
+	p.SetVirtualmachineid("virtualmachineid-value")
+	want.Set("virtualmachineid", "virtualmachineid-value")
+	p.SetVolumeid("volumeid-value")
+	want.Set("volumeid", "volumeid-value")
+
+	assertParams(t, p, "createTemplate", want)
+}
+
+func TestCreateTemplateResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","accountid":"accountid-value","bootable":true,"checksum":"checksum-value","cpuflags":"cpuflags-value","created":"created-value","crossZones":true,"details":{"key":"value"},"displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","format":"format-value","hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","isdynamicallyscalable":true,"isextractable":true,"isfeatured":true,"ispublic":true,"isready":true,"jobid":"jobid-value","maclearning":"maclearning-value","maintenancepolicy":"maintenancepolicy-value","manufacturerstring":"manufacturerstring-value","name":"name-value","optimisefor":"optimisefor-value","ostypeid":"ostypeid-value","ostypename":"ostypename-value","passwordenabled":true,"project":"project-value","projectid":"projectid-value","removed":"removed-value","size":1099511627776,"sourcetemplateid":"sourcetemplateid-value","sshkeyenabled":true,"status":"status-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templatetag":"templatetag-value","templatetype":"templatetype-value","url":"url-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &CreateTemplateResponse{})
+}
+
+func TestDeleteTemplateParams(t *testing.T) {
+	p := &DeleteTemplateParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "deleteTemplate", want)
+}
+
+func TestDeleteTemplateResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteTemplateResponse{})
+}
+
+func TestExtractTemplateParams(t *testing.T) {
+	p := &ExtractTemplateParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetMode("mode-value")
+	want.Set("mode", "mode-value")
+	p.SetUrl("url-value")
+	want.Set("url", "url-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "extractTemplate", want)
+}
+
+func TestExtractTemplateResponse(t *testing.T) {
+	assertDecodes(t, `{"accountid":"accountid-value","created":"created-value","extractId":"extractId-value","extractMode":"extractMode-value","id":"id-value","jobid":"jobid-value","name":"name-value","resultstring":"resultstring-value","state":"state-value","status":"status-value","storagetype":"storagetype-value","uploadpercentage":42,"url":"url-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &ExtractTemplateResponse{})
+}
+
+func TestPrepareTemplateParams(t *testing.T) {
+	p := &PrepareTemplateParams{}
+	want := url.Values{}
+	p.SetStorageid("storageid-value")
+	want.Set("storageid", "storageid-value")
+	p.SetTemplateid("templateid-value")
+	want.Set("templateid", "templateid-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "prepareTemplate", want)
+}
+
+func TestPrepareTemplateResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","accountid":"accountid-value","bootable":true,"checksum":"checksum-value","cpuflags":"cpuflags-value","created":"created-value","crossZones":true,"details":{"key":"value"},"displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","format":"format-value","hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","isdynamicallyscalable":true,"isextractable":true,"isfeatured":true,"ispublic":true,"isready":true,"maclearning":"maclearning-value","maintenancepolicy":"maintenancepolicy-value","manufacturerstring":"manufacturerstring-value","name":"name-value","optimisefor":"optimisefor-value","ostypeid":"ostypeid-value","ostypename":"ostypename-value","passwordenabled":true,"project":"project-value","projectid":"projectid-value","removed":"removed-value","size":1099511627776,"sourcetemplateid":"sourcetemplateid-value","sshkeyenabled":true,"status":"status-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templatetag":"templatetag-value","templatetype":"templatetype-value","url":"url-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &PrepareTemplateResponse{})
+}
+
+func TestRegisterTemplateParams(t *testing.T) {
+	p := &RegisterTemplateParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetBits(42)
+	want.Set("bits", "42")
+	p.SetChecksum("checksum-value")
+	want.Set("checksum", "checksum-value")
+	p.SetDetails(map[string]string{"k": "v"})
+	want.Set("details[0].key", "k")
+	want.Set("details[0].value", "v")
+	p.SetDisplaytext("displaytext-value")
+	want.Set("displaytext", "displaytext-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetFormat("format-value")
+	want.Set("format", "format-value")
+	p.SetHypervisor("hypervisor-value")
+	want.Set("hypervisor", "hypervisor-value")
+	p.SetIsdynamicallyscalable(true)
+	want.Set("isdynamicallyscalable", "true")
+	p.SetIsextractable(true)
+	want.Set("isextractable", "true")
+	p.SetIsfeatured(true)
+	want.Set("isfeatured", "true")
+	p.SetIspublic(true)
+	want.Set("ispublic", "true")
+	p.SetIsrouting(true)
+	want.Set("isrouting", "true")
+	p.SetMaintenancepolicy("maintenancepolicy-value")
+	want.Set("maintenancepolicy", "maintenancepolicy-value")
+	p.SetManufacturerstring("manufacturerstring-value")
+	want.Set("manufacturerstring", "manufacturerstring-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetOptimisefor("optimisefor-value")
+	want.Set("optimisefor", "optimisefor-value")
+	p.SetOstypeid("ostypeid-value")
+	want.Set("ostypeid", "ostypeid-value")
+	p.SetPasswordenabled(true)
+	want.Set("passwordenabled", "true")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetSshkeyenabled(true)
+	want.Set("sshkeyenabled", "true")
+	p.SetTemplatetag("templatetag-value")
+	want.Set("templatetag", "templatetag-value")
+	p.SetUrl("url-value")
+	want.Set("url", "url-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "registerTemplate", want)
+}
+
+func TestRegisterTemplateResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"template":[{"account":"account-value","accountid":"accountid-value","bootable":true,"checksum":"checksum-value","cpuflags":"cpuflags-value","created":"created-value","crossZones":true,"details":{"key":"value"},"displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","format":"format-value","hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","isdynamicallyscalable":true,"isextractable":true,"isfeatured":true,"ispublic":true,"isready":true,"maclearning":"maclearning-value","maintenancepolicy":"maintenancepolicy-value","manufacturerstring":"manufacturerstring-value","name":"name-value","optimisefor":"optimisefor-value","ostypeid":"ostypeid-value","ostypename":"ostypename-value","passwordenabled":true,"project":"project-value","projectid":"projectid-value","removed":"removed-value","size":1099511627776,"sourcetemplateid":"sourcetemplateid-value","sshkeyenabled":true,"status":"status-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templatetag":"templatetag-value","templatetype":"templatetype-value","url":"url-value","zoneid":"zoneid-value","zonename":"zonename-value"}]}`, &RegisterTemplateResponse{})
+}
+
+func TestUpdateTemplateParams(t *testing.T) {
+	p := &UpdateTemplateParams{}
+	want := url.Values{}
+	p.SetBootable(true)
+	want.Set("bootable", "true")
+	p.SetCpuflags("cpuflags-value")
+	want.Set("cpuflags", "cpuflags-value")
+	p.SetDetails(map[string]string{"k": "v"})
+	want.Set("details[0].key", "k")
+	want.Set("details[0].value", "v")
+	p.SetDisplaytext("displaytext-value")
+	want.Set("displaytext", "displaytext-value")
+	p.SetFormat("format-value")
+	want.Set("format", "format-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsdynamicallyscalable(true)
+	want.Set("isdynamicallyscalable", "true")
+	p.SetIsrouting(true)
+	want.Set("isrouting", "true")
+	p.SetMaclearning("maclearning-value")
+	want.Set("maclearning", "maclearning-value")
+	p.SetMaintenancepolicy("maintenancepolicy-value")
+	want.Set("maintenancepolicy", "maintenancepolicy-value")
+	p.SetManufacturerstring("manufacturerstring-value")
+	want.Set("manufacturerstring", "manufacturerstring-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetOptimisefor("optimisefor-value")
+	want.Set("optimisefor", "optimisefor-value")
+	p.SetOstypeid("ostypeid-value")
+	want.Set("ostypeid", "ostypeid-value")
+	p.SetPasswordenabled(true)
+	want.Set("passwordenabled", "true")
+	p.SetSortkey(42)
+	want.Set("sortkey", "42")
+	p.SetUrl("url-value")
+	want.Set("url", "url-value")
+
+	assertParams(t, p, "updateTemplate", want)
+}
+
+func TestUpdateTemplateResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","accountid":"accountid-value","bootable":true,"checksum":"checksum-value","cpuflags":"cpuflags-value","created":"created-value","crossZones":true,"details":{"key":"value"},"displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","format":"format-value","hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","isdynamicallyscalable":true,"isextractable":true,"isfeatured":true,"ispublic":true,"isready":true,"maclearning":"maclearning-value","maintenancepolicy":"maintenancepolicy-value","manufacturerstring":"manufacturerstring-value","name":"name-value","optimisefor":"optimisefor-value","ostypeid":"ostypeid-value","ostypename":"ostypename-value","passwordenabled":true,"project":"project-value","projectid":"projectid-value","removed":"removed-value","size":1099511627776,"sourcetemplateid":"sourcetemplateid-value","sshkeyenabled":true,"status":"status-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templatetag":"templatetag-value","templatetype":"templatetype-value","url":"url-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &UpdateTemplateResponse{})
+}
+
+func TestListTemplatePermissionsParams(t *testing.T) {
+	p := &ListTemplatePermissionsParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "listTemplatePermissions", want)
+}
+
+func TestListTemplatePermissionsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"templatepermission":[{"account":["a","b"],"domainid":"domainid-value","id":"id-value","ispublic":true,"projectids":["a","b"]}]}`, &ListTemplatePermissionsResponse{})
+}
+
+func TestUpdateTemplatePermissionsParams(t *testing.T) {
+	p := &UpdateTemplatePermissionsParams{}
+	want := url.Values{}
+	p.SetAccounts([]string{"a", "b"})
+	want.Set("accounts", "a,b")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsextractable(true)
+	want.Set("isextractable", "true")
+	p.SetIsfeatured(true)
+	want.Set("isfeatured", "true")
+	p.SetIspublic(true)
+	want.Set("ispublic", "true")
+	p.SetOp("op-value")
+	want.Set("op", "op-value")
+	p.SetProjectids([]string{"a", "b"})
+	want.Set("projectids", "a,b")
+
+	assertParams(t, p, "updateTemplatePermissions", want)
+}
+
+func TestUpdateTemplatePermissionsResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &UpdateTemplatePermissionsResponse{})
+}
+
+func TestListTemplatesParams(t *testing.T) {
+	p := &ListTemplatesParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetHypervisor("hypervisor-value")
+	want.Set("hypervisor", "hypervisor-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetShowremoved(true)
+	want.Set("showremoved", "true")
+	p.SetTags(map[string]string{"k": "v"})
+	want.Set("tags[0].key", "k")
+	want.Set("tags[0].value", "v")
+	p.SetTemplatefilter("templatefilter-value")
+	want.Set("templatefilter", "templatefilter-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listTemplates", want)
+}
+
+func TestListTemplatesResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"template":[{"account":"account-value","accountid":"accountid-value","bootable":true,"checksum":"checksum-value","cpuflags":"cpuflags-value","created":"created-value","crossZones":true,"details":{"key":"value"},"displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","format":"format-value","hostid":"hostid-value","hostname":"hostname-value","hypervisor":"hypervisor-value","id":"id-value","isdynamicallyscalable":true,"isextractable":true,"isfeatured":true,"ispublic":true,"isready":true,"maclearning":"maclearning-value","maintenancepolicy":"maintenancepolicy-value","manufacturerstring":"manufacturerstring-value","name":"name-value","optimisefor":"optimisefor-value","ostypeid":"ostypeid-value","ostypename":"ostypename-value","passwordenabled":true,"project":"project-value","projectid":"projectid-value","removed":"removed-value","size":1099511627776,"sourcetemplateid":"sourcetemplateid-value","sshkeyenabled":true,"status":"status-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templatetag":"templatetag-value","templatetype":"templatetype-value","url":"url-value","zoneid":"zoneid-value","zonename":"zonename-value"}]}`, &ListTemplatesResponse{})
+}
+
+func TestGetUploadParamsForTemplateParams(t *testing.T) {
+	p := &GetUploadParamsForTemplateParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetBits(42)
+	want.Set("bits", "42")
+	p.SetChecksum("checksum-value")
+	want.Set("checksum", "checksum-value")
+	p.SetDetails(map[string]string{"k": "v"})
+	want.Set("details[0].key", "k")
+	want.Set("details[0].value", "v")
+	p.SetDisplaytext("displaytext-value")
+	want.Set("displaytext", "displaytext-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetFormat("format-value")
+	want.Set("format", "format-value")
+	p.SetHypervisor("hypervisor-value")
+	want.Set("hypervisor", "hypervisor-value")
+	p.SetIsdynamicallyscalable(true)
+	want.Set("isdynamicallyscalable", "true")
+	p.SetIsextractable(true)
+	want.Set("isextractable", "true")
+	p.SetIsfeatured(true)
+	want.Set("isfeatured", "true")
+	p.SetIspublic(true)
+	want.Set("ispublic", "true")
+	p.SetIsrouting(true)
+	want.Set("isrouting", "true")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetOstypeid("ostypeid-value")
+	want.Set("ostypeid", "ostypeid-value")
+	p.SetPasswordenabled(true)
+	want.Set("passwordenabled", "true")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetSshkeyenabled(true)
+	want.Set("sshkeyenabled", "true")
+	p.SetTemplatetag("templatetag-value")
+	want.Set("templatetag", "templatetag-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "getUploadParamsForTemplate", want)
+}
+
+func TestGetUploadParamsForTemplateResponse(t *testing.T) {
+	assertDecodes(t, `{"expires":"expires-value","id":"id-value","metadata":"metadata-value","postURL":"postURL-value","signature":"signature-value"}`, &GetUploadParamsForTemplateResponse{})
+}
@@ -17,16 +17,37 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/url"
 	"strconv"
 )
 
+// UsageServiceIface is the interface implemented by UsageService, and by MockUsageService for tests
+type UsageServiceIface interface {
+	NewAddTrafficTypeParams(physicalnetworkid string, traffictype string) *AddTrafficTypeParams
+	AddTrafficType(p *AddTrafficTypeParams) (*AddTrafficTypeResponse, error)
+	AddTrafficTypeWithContext(ctx context.Context, p *AddTrafficTypeParams) (*AddTrafficTypeResponse, error)
+	AddTrafficTypeAsync(ctx context.Context, p *AddTrafficTypeParams) (*AddTrafficTypeJob, error)
+	NewDeleteTrafficTypeParams(id string) *DeleteTrafficTypeParams
+	DeleteTrafficType(p *DeleteTrafficTypeParams) (*DeleteTrafficTypeResponse, error)
+	DeleteTrafficTypeWithContext(ctx context.Context, p *DeleteTrafficTypeParams) (*DeleteTrafficTypeResponse, error)
+	DeleteTrafficTypeAsync(ctx context.Context, p *DeleteTrafficTypeParams) (*DeleteTrafficTypeJob, error)
+	NewListTrafficTypesParams(physicalnetworkid string) *ListTrafficTypesParams
+	GetTrafficTypeID(keyword string, physicalnetworkid string, opts ...OptionFunc) (string, int, error)
+	ListTrafficTypes(p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error)
+	ListTrafficTypesWithContext(ctx context.Context, p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error)
+	NewListTrafficTypesIterator(p *ListTrafficTypesParams, pagesize int) *ListTrafficTypesIterator
+}
+
 type AddTrafficTypeParams struct {
 	p map[string]interface{}
 }
 
+func (p *AddTrafficTypeParams) command() string {
+	return "addTrafficType"
+}
+
 func (p *AddTrafficTypeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -107,7 +128,13 @@ func (s *UsageService) NewAddTrafficTypeParams(physicalnetworkid string, traffic
 
 // Adds traffic type to a physical network
 func (s *UsageService) AddTrafficType(p *AddTrafficTypeParams) (*AddTrafficTypeResponse, error) {
-	resp, err := s.cs.newRequest("addTrafficType", p.toURLValues())
+	return s.AddTrafficTypeWithContext(context.Background(), p)
+}
+
+// AddTrafficTypeWithContext is the same as AddTrafficType, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *UsageService) AddTrafficTypeWithContext(ctx context.Context, p *AddTrafficTypeParams) (*AddTrafficTypeResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "addTrafficType", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -119,26 +146,56 @@ func (s *UsageService) AddTrafficType(p *AddTrafficTypeParams) (*AddTrafficTypeR
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "addTrafficType", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "traffictype", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// AddTrafficTypeJob is a handle to a running addTrafficType async job
+type AddTrafficTypeJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a AddTrafficTypeResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *AddTrafficTypeJob) Result() (*AddTrafficTypeResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := AddTrafficTypeResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "traffictype", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// AddTrafficTypeAsync starts the addTrafficType async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *UsageService) AddTrafficTypeAsync(ctx context.Context, p *AddTrafficTypeParams) (*AddTrafficTypeJob, error) {
+	resp, err := s.cs.newRequest(ctx, "addTrafficType", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddTrafficTypeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &AddTrafficTypeJob{s.cs.newJobHandle("addTrafficType", r.JobID)}, nil
+}
+
 type AddTrafficTypeResponse struct {
 	JobID             string `json:"jobid,omitempty"`
 	Id                string `json:"id,omitempty"`
@@ -152,6 +209,10 @@ type DeleteTrafficTypeParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteTrafficTypeParams) command() string {
+	return "deleteTrafficType"
+}
+
 func (p *DeleteTrafficTypeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -181,7 +242,13 @@ func (s *UsageService) NewDeleteTrafficTypeParams(id string) *DeleteTrafficTypeP
 
 // Deletes traffic type of a physical network
 func (s *UsageService) DeleteTrafficType(p *DeleteTrafficTypeParams) (*DeleteTrafficTypeResponse, error) {
-	resp, err := s.cs.newRequest("deleteTrafficType", p.toURLValues())
+	return s.DeleteTrafficTypeWithContext(context.Background(), p)
+}
+
+// DeleteTrafficTypeWithContext is the same as DeleteTrafficType, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *UsageService) DeleteTrafficTypeWithContext(ctx context.Context, p *DeleteTrafficTypeParams) (*DeleteTrafficTypeResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteTrafficType", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -193,9 +260,9 @@ func (s *UsageService) DeleteTrafficType(p *DeleteTrafficTypeParams) (*DeleteTra
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteTrafficType", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -208,6 +275,41 @@ func (s *UsageService) DeleteTrafficType(p *DeleteTrafficTypeParams) (*DeleteTra
 	return &r, nil
 }
 
+// DeleteTrafficTypeJob is a handle to a running deleteTrafficType async job
+type DeleteTrafficTypeJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteTrafficTypeResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteTrafficTypeJob) Result() (*DeleteTrafficTypeResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteTrafficTypeResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteTrafficTypeAsync starts the deleteTrafficType async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *UsageService) DeleteTrafficTypeAsync(ctx context.Context, p *DeleteTrafficTypeParams) (*DeleteTrafficTypeJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteTrafficType", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteTrafficTypeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteTrafficTypeJob{s.cs.newJobHandle("deleteTrafficType", r.JobID)}, nil
+}
+
 type DeleteTrafficTypeResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -218,6 +320,10 @@ type ListTrafficTypesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListTrafficTypesParams) command() string {
+	return "listTrafficTypes"
+}
+
 func (p *ListTrafficTypesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -297,7 +403,7 @@ func (s *UsageService) GetTrafficTypeID(keyword string, physicalnetworkid string
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -311,15 +417,22 @@ func (s *UsageService) GetTrafficTypeID(keyword string, physicalnetworkid string
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // Lists traffic types of a given physical network.
 func (s *UsageService) ListTrafficTypes(p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error) {
+	return s.ListTrafficTypesWithContext(context.Background(), p)
+}
+
+// ListTrafficTypesWithContext is the same as ListTrafficTypes, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *UsageService) ListTrafficTypesWithContext(ctx context.Context, p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error) {
 	var r ListTrafficTypesResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListTrafficTypesResponse
-		resp, err := s.cs.newRequest("listTrafficTypes", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listTrafficTypes", values)
 		if err != nil {
 			return nil, err
 		}
@@ -331,13 +444,89 @@ func (s *UsageService) ListTrafficTypes(p *ListTrafficTypesParams) (*ListTraffic
 		r.Count = l.Count
 		r.TrafficTypes = append(r.TrafficTypes, l.TrafficTypes...)
 
-		if r.Count == len(r.TrafficTypes) {
+		if r.Count == len(r.TrafficTypes) || len(l.TrafficTypes) == 0 {
+			s.cs.observePages("listTrafficTypes", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.TrafficTypes))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.TrafficTypes)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listTrafficTypes", values, len(l.TrafficTypes), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListTrafficTypesResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.TrafficTypes = append(r.TrafficTypes, l.TrafficTypes...)
+			}
+
+			if r.Count == len(r.TrafficTypes) {
+				s.cs.observePages("listTrafficTypes", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListTrafficTypesIterator iterates over the items returned by listTrafficTypes, requesting
+// the pages of the response one at a time while iterating.
+type ListTrafficTypesIterator struct {
+	pager
+	items []*TrafficType
+	index int
+}
+
+// NewListTrafficTypesIterator returns an iterator over the items returned by listTrafficTypes, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *UsageService) NewListTrafficTypesIterator(p *ListTrafficTypesParams, pagesize int) *ListTrafficTypesIterator {
+	return &ListTrafficTypesIterator{pager: newPager(s.cs, "listTrafficTypes", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListTrafficTypesIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListTrafficTypesResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.TrafficTypes))
+
+	i.items = l.TrafficTypes
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListTrafficTypesIterator) Value() *TrafficType {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListTrafficTypesResponse struct {
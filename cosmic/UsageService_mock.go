@@ -0,0 +1,121 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockUsageService is a mock of UsageServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockUsageService struct {
+	mockCalls
+
+	AddTrafficTypeFunc         func(ctx context.Context, p *AddTrafficTypeParams) (*AddTrafficTypeResponse, error)
+	AddTrafficTypeAsyncFunc    func(ctx context.Context, p *AddTrafficTypeParams) (*AddTrafficTypeJob, error)
+	DeleteTrafficTypeFunc      func(ctx context.Context, p *DeleteTrafficTypeParams) (*DeleteTrafficTypeResponse, error)
+	DeleteTrafficTypeAsyncFunc func(ctx context.Context, p *DeleteTrafficTypeParams) (*DeleteTrafficTypeJob, error)
+	GetTrafficTypeIDFunc       func(keyword string, physicalnetworkid string, opts ...OptionFunc) (string, int, error)
+	ListTrafficTypesFunc       func(ctx context.Context, p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error)
+}
+
+var _ UsageServiceIface = &MockUsageService{}
+
+func (m *MockUsageService) NewAddTrafficTypeParams(physicalnetworkid string, traffictype string) *AddTrafficTypeParams {
+	return (&UsageService{}).NewAddTrafficTypeParams(physicalnetworkid, traffictype)
+}
+
+func (m *MockUsageService) AddTrafficType(p *AddTrafficTypeParams) (*AddTrafficTypeResponse, error) {
+	return m.AddTrafficTypeWithContext(context.Background(), p)
+}
+
+func (m *MockUsageService) AddTrafficTypeWithContext(ctx context.Context, p *AddTrafficTypeParams) (*AddTrafficTypeResponse, error) {
+	m.record("AddTrafficType", p)
+	if m.AddTrafficTypeFunc == nil {
+		return nil, notMocked("MockUsageService", "AddTrafficType")
+	}
+	return m.AddTrafficTypeFunc(ctx, p)
+}
+
+func (m *MockUsageService) AddTrafficTypeAsync(ctx context.Context, p *AddTrafficTypeParams) (*AddTrafficTypeJob, error) {
+	m.record("AddTrafficTypeAsync", p)
+	if m.AddTrafficTypeAsyncFunc == nil {
+		return nil, notMocked("MockUsageService", "AddTrafficTypeAsync")
+	}
+	return m.AddTrafficTypeAsyncFunc(ctx, p)
+}
+
+func (m *MockUsageService) NewDeleteTrafficTypeParams(id string) *DeleteTrafficTypeParams {
+	return (&UsageService{}).NewDeleteTrafficTypeParams(id)
+}
+
+func (m *MockUsageService) DeleteTrafficType(p *DeleteTrafficTypeParams) (*DeleteTrafficTypeResponse, error) {
+	return m.DeleteTrafficTypeWithContext(context.Background(), p)
+}
+
+func (m *MockUsageService) DeleteTrafficTypeWithContext(ctx context.Context, p *DeleteTrafficTypeParams) (*DeleteTrafficTypeResponse, error) {
+	m.record("DeleteTrafficType", p)
+	if m.DeleteTrafficTypeFunc == nil {
+		return nil, notMocked("MockUsageService", "DeleteTrafficType")
+	}
+	return m.DeleteTrafficTypeFunc(ctx, p)
+}
+
+func (m *MockUsageService) DeleteTrafficTypeAsync(ctx context.Context, p *DeleteTrafficTypeParams) (*DeleteTrafficTypeJob, error) {
+	m.record("DeleteTrafficTypeAsync", p)
+	if m.DeleteTrafficTypeAsyncFunc == nil {
+		return nil, notMocked("MockUsageService", "DeleteTrafficTypeAsync")
+	}
+	return m.DeleteTrafficTypeAsyncFunc(ctx, p)
+}
+
+func (m *MockUsageService) NewListTrafficTypesParams(physicalnetworkid string) *ListTrafficTypesParams {
+	return (&UsageService{}).NewListTrafficTypesParams(physicalnetworkid)
+}
+
+func (m *MockUsageService) GetTrafficTypeID(keyword string, physicalnetworkid string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetTrafficTypeID", keyword, physicalnetworkid, opts)
+	if m.GetTrafficTypeIDFunc == nil {
+		return "", -1, notMocked("MockUsageService", "GetTrafficTypeID")
+	}
+	return m.GetTrafficTypeIDFunc(keyword, physicalnetworkid, opts...)
+}
+
+func (m *MockUsageService) ListTrafficTypes(p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error) {
+	return m.ListTrafficTypesWithContext(context.Background(), p)
+}
+
+func (m *MockUsageService) ListTrafficTypesWithContext(ctx context.Context, p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error) {
+	m.record("ListTrafficTypes", p)
+	if m.ListTrafficTypesFunc == nil {
+		return nil, notMocked("MockUsageService", "ListTrafficTypes")
+	}
+	return m.ListTrafficTypesFunc(ctx, p)
+}
+
+func (m *MockUsageService) NewListTrafficTypesIterator(p *ListTrafficTypesParams, pagesize int) *ListTrafficTypesIterator {
+	i := &ListTrafficTypesIterator{pager: newPager(nil, "listTrafficTypes", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListTrafficTypesParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListTrafficTypesWithContext(ctx, c)
+	}
+	return i
+}
@@ -0,0 +1,77 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestAddTrafficTypeParams(t *testing.T) {
+	p := &AddTrafficTypeParams{}
+	want := url.Values{}
+	p.SetIsolationmethod("isolationmethod-value")
+	want.Set("isolationmethod", "isolationmethod-value")
+	p.SetKvmnetworklabel("kvmnetworklabel-value")
+	want.Set("kvmnetworklabel", "kvmnetworklabel-value")
+	p.SetPhysicalnetworkid("physicalnetworkid-value")
+	want.Set("physicalnetworkid", "physicalnetworkid-value")
+	p.SetTraffictype("traffictype-value")
+	want.Set("traffictype", "traffictype-value")
+	p.SetVlan("vlan-value")
+	want.Set("vlan", "vlan-value")
+	p.SetXennetworklabel("xennetworklabel-value")
+	want.Set("xennetworklabel", "xennetworklabel-value")
+
+	assertParams(t, p, "addTrafficType", want)
+}
+
+func TestAddTrafficTypeResponse(t *testing.T) {
+	assertDecodes(t, `{"id":"id-value","jobid":"jobid-value","kvmnetworklabel":"kvmnetworklabel-value","physicalnetworkid":"physicalnetworkid-value","traffictype":"traffictype-value","xennetworklabel":"xennetworklabel-value"}`, &AddTrafficTypeResponse{})
+}
+
+func TestDeleteTrafficTypeParams(t *testing.T) {
+	p := &DeleteTrafficTypeParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteTrafficType", want)
+}
+
+func TestDeleteTrafficTypeResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteTrafficTypeResponse{})
+}
+
+func TestListTrafficTypesParams(t *testing.T) {
+	p := &ListTrafficTypesParams{}
+	want := url.Values{}
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetPhysicalnetworkid("physicalnetworkid-value")
+	want.Set("physicalnetworkid", "physicalnetworkid-value")
+
+	assertParams(t, p, "listTrafficTypes", want)
+}
+
+func TestListTrafficTypesResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"traffictype":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"destinationphysicalnetworkid-value","id":"id-value","name":"name-value","physicalnetworkid":"physicalnetworkid-value","servicelist":["a","b"],"state":"state-value"}]}`, &ListTrafficTypesResponse{})
+}
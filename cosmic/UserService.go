@@ -17,17 +17,59 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
+// UserServiceIface is the interface implemented by UserService, and by MockUserService for tests
+type UserServiceIface interface {
+	NewCreateUserParams(account string, domainid string, email string, firstname string, lastname string, password string, username string) *CreateUserParams
+	CreateUser(p *CreateUserParams) (*CreateUserResponse, error)
+	CreateUserWithContext(ctx context.Context, p *CreateUserParams) (*CreateUserResponse, error)
+	NewDeleteUserParams(id string) *DeleteUserParams
+	DeleteUser(p *DeleteUserParams) (*DeleteUserResponse, error)
+	DeleteUserWithContext(ctx context.Context, p *DeleteUserParams) (*DeleteUserResponse, error)
+	NewDisableUserParams(id string) *DisableUserParams
+	DisableUser(p *DisableUserParams) (*DisableUserResponse, error)
+	DisableUserWithContext(ctx context.Context, p *DisableUserParams) (*DisableUserResponse, error)
+	DisableUserAsync(ctx context.Context, p *DisableUserParams) (*DisableUserJob, error)
+	NewEnableUserParams(id string) *EnableUserParams
+	EnableUser(p *EnableUserParams) (*EnableUserResponse, error)
+	EnableUserWithContext(ctx context.Context, p *EnableUserParams) (*EnableUserResponse, error)
+	NewGetUserParams(userapikey string) *GetUserParams
+	GetUser(p *GetUserParams) (*GetUserResponse, error)
+	GetUserWithContext(ctx context.Context, p *GetUserParams) (*GetUserResponse, error)
+	NewLockUserParams(id string) *LockUserParams
+	LockUser(p *LockUserParams) (*LockUserResponse, error)
+	LockUserWithContext(ctx context.Context, p *LockUserParams) (*LockUserResponse, error)
+	NewUpdateUserParams(id string) *UpdateUserParams
+	UpdateUser(p *UpdateUserParams) (*UpdateUserResponse, error)
+	UpdateUserWithContext(ctx context.Context, p *UpdateUserParams) (*UpdateUserResponse, error)
+	NewRegisterUserKeysParams(id string) *RegisterUserKeysParams
+	RegisterUserKeys(p *RegisterUserKeysParams) (*RegisterUserKeysResponse, error)
+	RegisterUserKeysWithContext(ctx context.Context, p *RegisterUserKeysParams) (*RegisterUserKeysResponse, error)
+	NewListUsersParams() *ListUsersParams
+	GetUserByID(id string, opts ...OptionFunc) (*User, int, error)
+	ListUsers(p *ListUsersParams) (*ListUsersResponse, error)
+	ListUsersWithContext(ctx context.Context, p *ListUsersParams) (*ListUsersResponse, error)
+	NewListUsersIterator(p *ListUsersParams, pagesize int) *ListUsersIterator
+	NewGetVirtualMachineUserDataParams(virtualmachineid string) *GetVirtualMachineUserDataParams
+	GetVirtualMachineUserData(p *GetVirtualMachineUserDataParams) (*GetVirtualMachineUserDataResponse, error)
+	GetVirtualMachineUserDataWithContext(ctx context.Context, p *GetVirtualMachineUserDataParams) (*GetVirtualMachineUserDataResponse, error)
+}
+
 type CreateUserParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateUserParams) command() string {
+	return "createUser"
+}
+
 func (p *CreateUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -143,7 +185,13 @@ func (s *UserService) NewCreateUserParams(account string, domainid string, email
 
 // Creates a user for an account that already exists
 func (s *UserService) CreateUser(p *CreateUserParams) (*CreateUserResponse, error) {
-	resp, err := s.cs.newRequest("createUser", p.toURLValues())
+	return s.CreateUserWithContext(context.Background(), p)
+}
+
+// CreateUserWithContext is the same as CreateUser, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *UserService) CreateUserWithContext(ctx context.Context, p *CreateUserParams) (*CreateUserResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createUser", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -179,6 +227,10 @@ type DeleteUserParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteUserParams) command() string {
+	return "deleteUser"
+}
+
 func (p *DeleteUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -208,7 +260,13 @@ func (s *UserService) NewDeleteUserParams(id string) *DeleteUserParams {
 
 // Deletes a user for an account
 func (s *UserService) DeleteUser(p *DeleteUserParams) (*DeleteUserResponse, error) {
-	resp, err := s.cs.newRequest("deleteUser", p.toURLValues())
+	return s.DeleteUserWithContext(context.Background(), p)
+}
+
+// DeleteUserWithContext is the same as DeleteUser, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *UserService) DeleteUserWithContext(ctx context.Context, p *DeleteUserParams) (*DeleteUserResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteUser", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -229,6 +287,10 @@ type DisableUserParams struct {
 	p map[string]interface{}
 }
 
+func (p *DisableUserParams) command() string {
+	return "disableUser"
+}
+
 func (p *DisableUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -258,7 +320,13 @@ func (s *UserService) NewDisableUserParams(id string) *DisableUserParams {
 
 // Disables a user account
 func (s *UserService) DisableUser(p *DisableUserParams) (*DisableUserResponse, error) {
-	resp, err := s.cs.newRequest("disableUser", p.toURLValues())
+	return s.DisableUserWithContext(context.Background(), p)
+}
+
+// DisableUserWithContext is the same as DisableUser, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *UserService) DisableUserWithContext(ctx context.Context, p *DisableUserParams) (*DisableUserResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "disableUser", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -270,26 +338,56 @@ func (s *UserService) DisableUser(p *DisableUserParams) (*DisableUserResponse, e
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "disableUser", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "user", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// DisableUserJob is a handle to a running disableUser async job
+type DisableUserJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DisableUserResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DisableUserJob) Result() (*DisableUserResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DisableUserResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "user", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// DisableUserAsync starts the disableUser async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *UserService) DisableUserAsync(ctx context.Context, p *DisableUserParams) (*DisableUserJob, error) {
+	resp, err := s.cs.newRequest(ctx, "disableUser", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DisableUserResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DisableUserJob{s.cs.newJobHandle("disableUser", r.JobID)}, nil
+}
+
 type DisableUserResponse struct {
 	JobID               string `json:"jobid,omitempty"`
 	Account             string `json:"account,omitempty"`
@@ -315,6 +413,10 @@ type EnableUserParams struct {
 	p map[string]interface{}
 }
 
+func (p *EnableUserParams) command() string {
+	return "enableUser"
+}
+
 func (p *EnableUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -344,7 +446,13 @@ func (s *UserService) NewEnableUserParams(id string) *EnableUserParams {
 
 // Enables a user account
 func (s *UserService) EnableUser(p *EnableUserParams) (*EnableUserResponse, error) {
-	resp, err := s.cs.newRequest("enableUser", p.toURLValues())
+	return s.EnableUserWithContext(context.Background(), p)
+}
+
+// EnableUserWithContext is the same as EnableUser, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *UserService) EnableUserWithContext(ctx context.Context, p *EnableUserParams) (*EnableUserResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "enableUser", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -380,6 +488,10 @@ type GetUserParams struct {
 	p map[string]interface{}
 }
 
+func (p *GetUserParams) command() string {
+	return "getUser"
+}
+
 func (p *GetUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -409,7 +521,13 @@ func (s *UserService) NewGetUserParams(userapikey string) *GetUserParams {
 
 // Find user account by API key
 func (s *UserService) GetUser(p *GetUserParams) (*GetUserResponse, error) {
-	resp, err := s.cs.newRequest("getUser", p.toURLValues())
+	return s.GetUserWithContext(context.Background(), p)
+}
+
+// GetUserWithContext is the same as GetUser, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *UserService) GetUserWithContext(ctx context.Context, p *GetUserParams) (*GetUserResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "getUser", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -445,6 +563,10 @@ type LockUserParams struct {
 	p map[string]interface{}
 }
 
+func (p *LockUserParams) command() string {
+	return "lockUser"
+}
+
 func (p *LockUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -474,7 +596,13 @@ func (s *UserService) NewLockUserParams(id string) *LockUserParams {
 
 // Locks a user account
 func (s *UserService) LockUser(p *LockUserParams) (*LockUserResponse, error) {
-	resp, err := s.cs.newRequest("lockUser", p.toURLValues())
+	return s.LockUserWithContext(context.Background(), p)
+}
+
+// LockUserWithContext is the same as LockUser, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *UserService) LockUserWithContext(ctx context.Context, p *LockUserParams) (*LockUserResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "lockUser", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -510,6 +638,10 @@ type UpdateUserParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateUserParams) command() string {
+	return "updateUser"
+}
+
 func (p *UpdateUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -619,7 +751,13 @@ func (s *UserService) NewUpdateUserParams(id string) *UpdateUserParams {
 
 // Updates a user account
 func (s *UserService) UpdateUser(p *UpdateUserParams) (*UpdateUserResponse, error) {
-	resp, err := s.cs.newRequest("updateUser", p.toURLValues())
+	return s.UpdateUserWithContext(context.Background(), p)
+}
+
+// UpdateUserWithContext is the same as UpdateUser, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *UserService) UpdateUserWithContext(ctx context.Context, p *UpdateUserParams) (*UpdateUserResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateUser", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -655,6 +793,10 @@ type RegisterUserKeysParams struct {
 	p map[string]interface{}
 }
 
+func (p *RegisterUserKeysParams) command() string {
+	return "registerUserKeys"
+}
+
 func (p *RegisterUserKeysParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -684,7 +826,13 @@ func (s *UserService) NewRegisterUserKeysParams(id string) *RegisterUserKeysPara
 
 // This command allows a user to register for the developer API, returning a secret key and an API key. This request is made through the integration API port, so it is a privileged command and must be made on behalf of a user. It is up to the implementer just how the username and password are entered, and then how that translates to an integration API request. Both secret key and API key should be returned to the user
 func (s *UserService) RegisterUserKeys(p *RegisterUserKeysParams) (*RegisterUserKeysResponse, error) {
-	resp, err := s.cs.newRequest("registerUserKeys", p.toURLValues())
+	return s.RegisterUserKeysWithContext(context.Background(), p)
+}
+
+// RegisterUserKeysWithContext is the same as RegisterUserKeys, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *UserService) RegisterUserKeysWithContext(ctx context.Context, p *RegisterUserKeysParams) (*RegisterUserKeysResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "registerUserKeys", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -705,6 +853,10 @@ type ListUsersParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListUsersParams) command() string {
+	return "listUsers"
+}
+
 func (p *ListUsersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -851,16 +1003,14 @@ func (s *UserService) GetUserByID(id string, opts ...OptionFunc) (*User, int, er
 
 	l, err := s.ListUsers(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -872,10 +1022,17 @@ func (s *UserService) GetUserByID(id string, opts ...OptionFunc) (*User, int, er
 
 // Lists user accounts
 func (s *UserService) ListUsers(p *ListUsersParams) (*ListUsersResponse, error) {
+	return s.ListUsersWithContext(context.Background(), p)
+}
+
+// ListUsersWithContext is the same as ListUsers, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *UserService) ListUsersWithContext(ctx context.Context, p *ListUsersParams) (*ListUsersResponse, error) {
 	var r ListUsersResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListUsersResponse
-		resp, err := s.cs.newRequest("listUsers", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listUsers", values)
 		if err != nil {
 			return nil, err
 		}
@@ -887,13 +1044,89 @@ func (s *UserService) ListUsers(p *ListUsersParams) (*ListUsersResponse, error)
 		r.Count = l.Count
 		r.Users = append(r.Users, l.Users...)
 
-		if r.Count == len(r.Users) {
+		if r.Count == len(r.Users) || len(l.Users) == 0 {
+			s.cs.observePages("listUsers", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.Users))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.Users)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listUsers", values, len(l.Users), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListUsersResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.Users = append(r.Users, l.Users...)
+			}
+
+			if r.Count == len(r.Users) {
+				s.cs.observePages("listUsers", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListUsersIterator iterates over the items returned by listUsers, requesting
+// the pages of the response one at a time while iterating.
+type ListUsersIterator struct {
+	pager
+	items []*User
+	index int
+}
+
+// NewListUsersIterator returns an iterator over the items returned by listUsers, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *UserService) NewListUsersIterator(p *ListUsersParams, pagesize int) *ListUsersIterator {
+	return &ListUsersIterator{pager: newPager(s.cs, "listUsers", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListUsersIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListUsersResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
 	}
+	i.received(l.Count, len(l.Users))
+
+	i.items = l.Users
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListUsersIterator) Value() *User {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
 }
 
 type ListUsersResponse struct {
@@ -925,6 +1158,10 @@ type GetVirtualMachineUserDataParams struct {
 	p map[string]interface{}
 }
 
+func (p *GetVirtualMachineUserDataParams) command() string {
+	return "getVirtualMachineUserData"
+}
+
 func (p *GetVirtualMachineUserDataParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -954,7 +1191,13 @@ func (s *UserService) NewGetVirtualMachineUserDataParams(virtualmachineid string
 
 // Returns user data associated with the VM
 func (s *UserService) GetVirtualMachineUserData(p *GetVirtualMachineUserDataParams) (*GetVirtualMachineUserDataResponse, error) {
-	resp, err := s.cs.newRequest("getVirtualMachineUserData", p.toURLValues())
+	return s.GetVirtualMachineUserDataWithContext(context.Background(), p)
+}
+
+// GetVirtualMachineUserDataWithContext is the same as GetVirtualMachineUserData, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *UserService) GetVirtualMachineUserDataWithContext(ctx context.Context, p *GetVirtualMachineUserDataParams) (*GetVirtualMachineUserDataResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "getVirtualMachineUserData", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,231 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockUserService is a mock of UserServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockUserService struct {
+	mockCalls
+
+	CreateUserFunc                func(ctx context.Context, p *CreateUserParams) (*CreateUserResponse, error)
+	DeleteUserFunc                func(ctx context.Context, p *DeleteUserParams) (*DeleteUserResponse, error)
+	DisableUserFunc               func(ctx context.Context, p *DisableUserParams) (*DisableUserResponse, error)
+	DisableUserAsyncFunc          func(ctx context.Context, p *DisableUserParams) (*DisableUserJob, error)
+	EnableUserFunc                func(ctx context.Context, p *EnableUserParams) (*EnableUserResponse, error)
+	GetUserFunc                   func(ctx context.Context, p *GetUserParams) (*GetUserResponse, error)
+	LockUserFunc                  func(ctx context.Context, p *LockUserParams) (*LockUserResponse, error)
+	UpdateUserFunc                func(ctx context.Context, p *UpdateUserParams) (*UpdateUserResponse, error)
+	RegisterUserKeysFunc          func(ctx context.Context, p *RegisterUserKeysParams) (*RegisterUserKeysResponse, error)
+	GetUserByIDFunc               func(id string, opts ...OptionFunc) (*User, int, error)
+	ListUsersFunc                 func(ctx context.Context, p *ListUsersParams) (*ListUsersResponse, error)
+	GetVirtualMachineUserDataFunc func(ctx context.Context, p *GetVirtualMachineUserDataParams) (*GetVirtualMachineUserDataResponse, error)
+}
+
+var _ UserServiceIface = &MockUserService{}
+
+func (m *MockUserService) NewCreateUserParams(account string, domainid string, email string, firstname string, lastname string, password string, username string) *CreateUserParams {
+	return (&UserService{}).NewCreateUserParams(account, domainid, email, firstname, lastname, password, username)
+}
+
+func (m *MockUserService) CreateUser(p *CreateUserParams) (*CreateUserResponse, error) {
+	return m.CreateUserWithContext(context.Background(), p)
+}
+
+func (m *MockUserService) CreateUserWithContext(ctx context.Context, p *CreateUserParams) (*CreateUserResponse, error) {
+	m.record("CreateUser", p)
+	if m.CreateUserFunc == nil {
+		return nil, notMocked("MockUserService", "CreateUser")
+	}
+	return m.CreateUserFunc(ctx, p)
+}
+
+func (m *MockUserService) NewDeleteUserParams(id string) *DeleteUserParams {
+	return (&UserService{}).NewDeleteUserParams(id)
+}
+
+func (m *MockUserService) DeleteUser(p *DeleteUserParams) (*DeleteUserResponse, error) {
+	return m.DeleteUserWithContext(context.Background(), p)
+}
+
+func (m *MockUserService) DeleteUserWithContext(ctx context.Context, p *DeleteUserParams) (*DeleteUserResponse, error) {
+	m.record("DeleteUser", p)
+	if m.DeleteUserFunc == nil {
+		return nil, notMocked("MockUserService", "DeleteUser")
+	}
+	return m.DeleteUserFunc(ctx, p)
+}
+
+func (m *MockUserService) NewDisableUserParams(id string) *DisableUserParams {
+	return (&UserService{}).NewDisableUserParams(id)
+}
+
+func (m *MockUserService) DisableUser(p *DisableUserParams) (*DisableUserResponse, error) {
+	return m.DisableUserWithContext(context.Background(), p)
+}
+
+func (m *MockUserService) DisableUserWithContext(ctx context.Context, p *DisableUserParams) (*DisableUserResponse, error) {
+	m.record("DisableUser", p)
+	if m.DisableUserFunc == nil {
+		return nil, notMocked("MockUserService", "DisableUser")
+	}
+	return m.DisableUserFunc(ctx, p)
+}
+
+func (m *MockUserService) DisableUserAsync(ctx context.Context, p *DisableUserParams) (*DisableUserJob, error) {
+	m.record("DisableUserAsync", p)
+	if m.DisableUserAsyncFunc == nil {
+		return nil, notMocked("MockUserService", "DisableUserAsync")
+	}
+	return m.DisableUserAsyncFunc(ctx, p)
+}
+
+func (m *MockUserService) NewEnableUserParams(id string) *EnableUserParams {
+	return (&UserService{}).NewEnableUserParams(id)
+}
+
+func (m *MockUserService) EnableUser(p *EnableUserParams) (*EnableUserResponse, error) {
+	return m.EnableUserWithContext(context.Background(), p)
+}
+
+func (m *MockUserService) EnableUserWithContext(ctx context.Context, p *EnableUserParams) (*EnableUserResponse, error) {
+	m.record("EnableUser", p)
+	if m.EnableUserFunc == nil {
+		return nil, notMocked("MockUserService", "EnableUser")
+	}
+	return m.EnableUserFunc(ctx, p)
+}
+
+func (m *MockUserService) NewGetUserParams(userapikey string) *GetUserParams {
+	return (&UserService{}).NewGetUserParams(userapikey)
+}
+
+func (m *MockUserService) GetUser(p *GetUserParams) (*GetUserResponse, error) {
+	return m.GetUserWithContext(context.Background(), p)
+}
+
+func (m *MockUserService) GetUserWithContext(ctx context.Context, p *GetUserParams) (*GetUserResponse, error) {
+	m.record("GetUser", p)
+	if m.GetUserFunc == nil {
+		return nil, notMocked("MockUserService", "GetUser")
+	}
+	return m.GetUserFunc(ctx, p)
+}
+
+func (m *MockUserService) NewLockUserParams(id string) *LockUserParams {
+	return (&UserService{}).NewLockUserParams(id)
+}
+
+func (m *MockUserService) LockUser(p *LockUserParams) (*LockUserResponse, error) {
+	return m.LockUserWithContext(context.Background(), p)
+}
+
+func (m *MockUserService) LockUserWithContext(ctx context.Context, p *LockUserParams) (*LockUserResponse, error) {
+	m.record("LockUser", p)
+	if m.LockUserFunc == nil {
+		return nil, notMocked("MockUserService", "LockUser")
+	}
+	return m.LockUserFunc(ctx, p)
+}
+
+func (m *MockUserService) NewUpdateUserParams(id string) *UpdateUserParams {
+	return (&UserService{}).NewUpdateUserParams(id)
+}
+
+func (m *MockUserService) UpdateUser(p *UpdateUserParams) (*UpdateUserResponse, error) {
+	return m.UpdateUserWithContext(context.Background(), p)
+}
+
+func (m *MockUserService) UpdateUserWithContext(ctx context.Context, p *UpdateUserParams) (*UpdateUserResponse, error) {
+	m.record("UpdateUser", p)
+	if m.UpdateUserFunc == nil {
+		return nil, notMocked("MockUserService", "UpdateUser")
+	}
+	return m.UpdateUserFunc(ctx, p)
+}
+
+func (m *MockUserService) NewRegisterUserKeysParams(id string) *RegisterUserKeysParams {
+	return (&UserService{}).NewRegisterUserKeysParams(id)
+}
+
+func (m *MockUserService) RegisterUserKeys(p *RegisterUserKeysParams) (*RegisterUserKeysResponse, error) {
+	return m.RegisterUserKeysWithContext(context.Background(), p)
+}
+
+func (m *MockUserService) RegisterUserKeysWithContext(ctx context.Context, p *RegisterUserKeysParams) (*RegisterUserKeysResponse, error) {
+	m.record("RegisterUserKeys", p)
+	if m.RegisterUserKeysFunc == nil {
+		return nil, notMocked("MockUserService", "RegisterUserKeys")
+	}
+	return m.RegisterUserKeysFunc(ctx, p)
+}
+
+func (m *MockUserService) NewListUsersParams() *ListUsersParams {
+	return (&UserService{}).NewListUsersParams()
+}
+
+func (m *MockUserService) GetUserByID(id string, opts ...OptionFunc) (*User, int, error) {
+	m.record("GetUserByID", id, opts)
+	if m.GetUserByIDFunc == nil {
+		return nil, -1, notMocked("MockUserService", "GetUserByID")
+	}
+	return m.GetUserByIDFunc(id, opts...)
+}
+
+func (m *MockUserService) ListUsers(p *ListUsersParams) (*ListUsersResponse, error) {
+	return m.ListUsersWithContext(context.Background(), p)
+}
+
+func (m *MockUserService) ListUsersWithContext(ctx context.Context, p *ListUsersParams) (*ListUsersResponse, error) {
+	m.record("ListUsers", p)
+	if m.ListUsersFunc == nil {
+		return nil, notMocked("MockUserService", "ListUsers")
+	}
+	return m.ListUsersFunc(ctx, p)
+}
+
+func (m *MockUserService) NewListUsersIterator(p *ListUsersParams, pagesize int) *ListUsersIterator {
+	i := &ListUsersIterator{pager: newPager(nil, "listUsers", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListUsersParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListUsersWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockUserService) NewGetVirtualMachineUserDataParams(virtualmachineid string) *GetVirtualMachineUserDataParams {
+	return (&UserService{}).NewGetVirtualMachineUserDataParams(virtualmachineid)
+}
+
+func (m *MockUserService) GetVirtualMachineUserData(p *GetVirtualMachineUserDataParams) (*GetVirtualMachineUserDataResponse, error) {
+	return m.GetVirtualMachineUserDataWithContext(context.Background(), p)
+}
+
+func (m *MockUserService) GetVirtualMachineUserDataWithContext(ctx context.Context, p *GetVirtualMachineUserDataParams) (*GetVirtualMachineUserDataResponse, error) {
+	m.record("GetVirtualMachineUserData", p)
+	if m.GetVirtualMachineUserDataFunc == nil {
+		return nil, notMocked("MockUserService", "GetVirtualMachineUserData")
+	}
+	return m.GetVirtualMachineUserDataFunc(ctx, p)
+}
@@ -0,0 +1,204 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCreateUserParams(t *testing.T) {
+	p := &CreateUserParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetEmail("email-value")
+	want.Set("email", "email-value")
+	p.SetFirstname("firstname-value")
+	want.Set("firstname", "firstname-value")
+	p.SetLastname("lastname-value")
+	want.Set("lastname", "lastname-value")
+	p.SetPassword("password-value")
+	want.Set("password", "password-value")
+	p.SetTimezone("timezone-value")
+	want.Set("timezone", "timezone-value")
+	p.SetUserid("userid-value")
+	want.Set("userid", "userid-value")
+	p.SetUsername("username-value")
+	want.Set("username", "username-value")
+
+	assertParams(t, p, "createUser", want)
+}
+
+func TestCreateUserResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","accountid":"accountid-value","accounttype":42,"apikey":"apikey-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","email":"email-value","firstname":"firstname-value","id":"id-value","iscallerchilddomain":true,"isdefault":true,"lastname":"lastname-value","secretkey":"secretkey-value","state":"state-value","timezone":"timezone-value","username":"username-value"}`, &CreateUserResponse{})
+}
+
+func TestDeleteUserParams(t *testing.T) {
+	p := &DeleteUserParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteUser", want)
+}
+
+func TestDeleteUserResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &DeleteUserResponse{})
+}
+
+func TestDisableUserParams(t *testing.T) {
+	p := &DisableUserParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "disableUser", want)
+}
+
+func TestDisableUserResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","accountid":"accountid-value","accounttype":42,"apikey":"apikey-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","email":"email-value","firstname":"firstname-value","id":"id-value","iscallerchilddomain":true,"isdefault":true,"jobid":"jobid-value","lastname":"lastname-value","secretkey":"secretkey-value","state":"state-value","timezone":"timezone-value","username":"username-value"}`, &DisableUserResponse{})
+}
+
+func TestEnableUserParams(t *testing.T) {
+	p := &EnableUserParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "enableUser", want)
+}
+
+func TestEnableUserResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","accountid":"accountid-value","accounttype":42,"apikey":"apikey-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","email":"email-value","firstname":"firstname-value","id":"id-value","iscallerchilddomain":true,"isdefault":true,"lastname":"lastname-value","secretkey":"secretkey-value","state":"state-value","timezone":"timezone-value","username":"username-value"}`, &EnableUserResponse{})
+}
+
+func TestGetUserParams(t *testing.T) {
+	p := &GetUserParams{}
+	want := url.Values{}
+	p.SetUserapikey("userapikey-value")
+	want.Set("userapikey", "userapikey-value")
+
+	assertParams(t, p, "getUser", want)
+}
+
+func TestGetUserResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","accountid":"accountid-value","accounttype":42,"apikey":"apikey-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","email":"email-value","firstname":"firstname-value","id":"id-value","iscallerchilddomain":true,"isdefault":true,"lastname":"lastname-value","secretkey":"secretkey-value","state":"state-value","timezone":"timezone-value","username":"username-value"}`, &GetUserResponse{})
+}
+
+func TestLockUserParams(t *testing.T) {
+	p := &LockUserParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "lockUser", want)
+}
+
+func TestLockUserResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","accountid":"accountid-value","accounttype":42,"apikey":"apikey-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","email":"email-value","firstname":"firstname-value","id":"id-value","iscallerchilddomain":true,"isdefault":true,"lastname":"lastname-value","secretkey":"secretkey-value","state":"state-value","timezone":"timezone-value","username":"username-value"}`, &LockUserResponse{})
+}
+
+func TestUpdateUserParams(t *testing.T) {
+	p := &UpdateUserParams{}
+	want := url.Values{}
+	p.SetEmail("email-value")
+	want.Set("email", "email-value")
+	p.SetFirstname("firstname-value")
+	want.Set("firstname", "firstname-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetLastname("lastname-value")
+	want.Set("lastname", "lastname-value")
+	p.SetPassword("password-value")
+	want.Set("password", "password-value")
+	p.SetTimezone("timezone-value")
+	want.Set("timezone", "timezone-value")
+	p.SetUserapikey("userapikey-value")
+	want.Set("userapikey", "userapikey-value")
+	p.SetUsername("username-value")
+	want.Set("username", "username-value")
+	p.SetUsersecretkey("usersecretkey-value")
+	want.Set("usersecretkey", "usersecretkey-value")
+
+	assertParams(t, p, "updateUser", want)
+}
+
+func TestUpdateUserResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","accountid":"accountid-value","accounttype":42,"apikey":"apikey-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","email":"email-value","firstname":"firstname-value","id":"id-value","iscallerchilddomain":true,"isdefault":true,"lastname":"lastname-value","secretkey":"secretkey-value","state":"state-value","timezone":"timezone-value","username":"username-value"}`, &UpdateUserResponse{})
+}
+
+func TestRegisterUserKeysParams(t *testing.T) {
+	p := &RegisterUserKeysParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "registerUserKeys", want)
+}
+
+func TestRegisterUserKeysResponse(t *testing.T) {
+	assertDecodes(t, `{"apikey":"apikey-value","secretkey":"secretkey-value"}`, &RegisterUserKeysResponse{})
+}
+
+func TestListUsersParams(t *testing.T) {
+	p := &ListUsersParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetAccounttype(int64(1) << 40)
+	want.Set("accounttype", "1099511627776")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetState("state-value")
+	want.Set("state", "state-value")
+	p.SetUsername("username-value")
+	want.Set("username", "username-value")
+
+	assertParams(t, p, "listUsers", want)
+}
+
+func TestListUsersResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"user":[{"account":"account-value","accountid":"accountid-value","accounttype":42,"apikey":"apikey-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","email":"email-value","firstname":"firstname-value","id":"id-value","iscallerchilddomain":true,"isdefault":true,"lastname":"lastname-value","secretkey":"secretkey-value","state":"state-value","timezone":"timezone-value","username":"username-value"}]}`, &ListUsersResponse{})
+}
+
+func TestGetVirtualMachineUserDataParams(t *testing.T) {
+	p := &GetVirtualMachineUserDataParams{}
+	want := url.Values{}
+	p.SetVirtualmachineid("virtualmachineid-value")
+	want.Set("virtualmachineid", "virtualmachineid-value")
+
+	assertParams(t, p, "getVirtualMachineUserData", want)
+}
+
+func TestGetVirtualMachineUserDataResponse(t *testing.T) {
+	assertDecodes(t, `{"userdata":"userdata-value","virtualmachineid":"virtualmachineid-value"}`, &GetVirtualMachineUserDataResponse{})
+}
@@ -17,17 +17,49 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
+// VLANServiceIface is the interface implemented by VLANService, and by MockVLANService for tests
+type VLANServiceIface interface {
+	NewReleaseDedicatedGuestVlanRangeParams(id string) *ReleaseDedicatedGuestVlanRangeParams
+	ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeResponse, error)
+	ReleaseDedicatedGuestVlanRangeWithContext(ctx context.Context, p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeResponse, error)
+	ReleaseDedicatedGuestVlanRangeAsync(ctx context.Context, p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeJob, error)
+	NewListDedicatedGuestVlanRangesParams() *ListDedicatedGuestVlanRangesParams
+	GetDedicatedGuestVlanRangeByID(id string, opts ...OptionFunc) (*DedicatedGuestVlanRange, int, error)
+	ListDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error)
+	ListDedicatedGuestVlanRangesWithContext(ctx context.Context, p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error)
+	NewListDedicatedGuestVlanRangesIterator(p *ListDedicatedGuestVlanRangesParams, pagesize int) *ListDedicatedGuestVlanRangesIterator
+	NewDedicateGuestVlanRangeParams(account string, domainid string, physicalnetworkid string, vlanrange string) *DedicateGuestVlanRangeParams
+	DedicateGuestVlanRange(p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error)
+	DedicateGuestVlanRangeWithContext(ctx context.Context, p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error)
+	NewCreateVlanIpRangeParams() *CreateVlanIpRangeParams
+	CreateVlanIpRange(p *CreateVlanIpRangeParams) (*CreateVlanIpRangeResponse, error)
+	CreateVlanIpRangeWithContext(ctx context.Context, p *CreateVlanIpRangeParams) (*CreateVlanIpRangeResponse, error)
+	NewDeleteVlanIpRangeParams(id string) *DeleteVlanIpRangeParams
+	DeleteVlanIpRange(p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error)
+	DeleteVlanIpRangeWithContext(ctx context.Context, p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error)
+	NewListVlanIpRangesParams() *ListVlanIpRangesParams
+	GetVlanIpRangeByID(id string, opts ...OptionFunc) (*VlanIpRange, int, error)
+	ListVlanIpRanges(p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error)
+	ListVlanIpRangesWithContext(ctx context.Context, p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error)
+	NewListVlanIpRangesIterator(p *ListVlanIpRangesParams, pagesize int) *ListVlanIpRangesIterator
+}
+
 type ReleaseDedicatedGuestVlanRangeParams struct {
 	p map[string]interface{}
 }
 
+func (p *ReleaseDedicatedGuestVlanRangeParams) command() string {
+	return "releaseDedicatedGuestVlanRange"
+}
+
 func (p *ReleaseDedicatedGuestVlanRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -57,7 +89,13 @@ func (s *VLANService) NewReleaseDedicatedGuestVlanRangeParams(id string) *Releas
 
 // Releases a dedicated guest vlan range to the system
 func (s *VLANService) ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedGuestVlanRange", p.toURLValues())
+	return s.ReleaseDedicatedGuestVlanRangeWithContext(context.Background(), p)
+}
+
+// ReleaseDedicatedGuestVlanRangeWithContext is the same as ReleaseDedicatedGuestVlanRange, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VLANService) ReleaseDedicatedGuestVlanRangeWithContext(ctx context.Context, p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "releaseDedicatedGuestVlanRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -69,9 +107,9 @@ func (s *VLANService) ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVla
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "releaseDedicatedGuestVlanRange", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -84,6 +122,41 @@ func (s *VLANService) ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVla
 	return &r, nil
 }
 
+// ReleaseDedicatedGuestVlanRangeJob is a handle to a running releaseDedicatedGuestVlanRange async job
+type ReleaseDedicatedGuestVlanRangeJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a ReleaseDedicatedGuestVlanRangeResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *ReleaseDedicatedGuestVlanRangeJob) Result() (*ReleaseDedicatedGuestVlanRangeResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := ReleaseDedicatedGuestVlanRangeResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ReleaseDedicatedGuestVlanRangeAsync starts the releaseDedicatedGuestVlanRange async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VLANService) ReleaseDedicatedGuestVlanRangeAsync(ctx context.Context, p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeJob, error) {
+	resp, err := s.cs.newRequest(ctx, "releaseDedicatedGuestVlanRange", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ReleaseDedicatedGuestVlanRangeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &ReleaseDedicatedGuestVlanRangeJob{s.cs.newJobHandle("releaseDedicatedGuestVlanRange", r.JobID)}, nil
+}
+
 type ReleaseDedicatedGuestVlanRangeResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -94,6 +167,10 @@ type ListDedicatedGuestVlanRangesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListDedicatedGuestVlanRangesParams) command() string {
+	return "listDedicatedGuestVlanRanges"
+}
+
 func (p *ListDedicatedGuestVlanRangesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -227,16 +304,14 @@ func (s *VLANService) GetDedicatedGuestVlanRangeByID(id string, opts ...OptionFu
 
 	l, err := s.ListDedicatedGuestVlanRanges(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -248,10 +323,17 @@ func (s *VLANService) GetDedicatedGuestVlanRangeByID(id string, opts ...OptionFu
 
 // Lists dedicated guest vlan ranges
 func (s *VLANService) ListDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error) {
+	return s.ListDedicatedGuestVlanRangesWithContext(context.Background(), p)
+}
+
+// ListDedicatedGuestVlanRangesWithContext is the same as ListDedicatedGuestVlanRanges, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VLANService) ListDedicatedGuestVlanRangesWithContext(ctx context.Context, p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error) {
 	var r ListDedicatedGuestVlanRangesResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListDedicatedGuestVlanRangesResponse
-		resp, err := s.cs.newRequest("listDedicatedGuestVlanRanges", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listDedicatedGuestVlanRanges", values)
 		if err != nil {
 			return nil, err
 		}
@@ -263,15 +345,91 @@ func (s *VLANService) ListDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRang
 		r.Count = l.Count
 		r.DedicatedGuestVlanRanges = append(r.DedicatedGuestVlanRanges, l.DedicatedGuestVlanRanges...)
 
-		if r.Count == len(r.DedicatedGuestVlanRanges) {
+		if r.Count == len(r.DedicatedGuestVlanRanges) || len(l.DedicatedGuestVlanRanges) == 0 {
+			s.cs.observePages("listDedicatedGuestVlanRanges", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.DedicatedGuestVlanRanges))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.DedicatedGuestVlanRanges)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listDedicatedGuestVlanRanges", values, len(l.DedicatedGuestVlanRanges), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListDedicatedGuestVlanRangesResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.DedicatedGuestVlanRanges = append(r.DedicatedGuestVlanRanges, l.DedicatedGuestVlanRanges...)
+			}
+
+			if r.Count == len(r.DedicatedGuestVlanRanges) {
+				s.cs.observePages("listDedicatedGuestVlanRanges", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
 	}
 }
 
+// ListDedicatedGuestVlanRangesIterator iterates over the items returned by listDedicatedGuestVlanRanges, requesting
+// the pages of the response one at a time while iterating.
+type ListDedicatedGuestVlanRangesIterator struct {
+	pager
+	items []*DedicatedGuestVlanRange
+	index int
+}
+
+// NewListDedicatedGuestVlanRangesIterator returns an iterator over the items returned by listDedicatedGuestVlanRanges, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *VLANService) NewListDedicatedGuestVlanRangesIterator(p *ListDedicatedGuestVlanRangesParams, pagesize int) *ListDedicatedGuestVlanRangesIterator {
+	return &ListDedicatedGuestVlanRangesIterator{pager: newPager(s.cs, "listDedicatedGuestVlanRanges", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListDedicatedGuestVlanRangesIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListDedicatedGuestVlanRangesResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.DedicatedGuestVlanRanges))
+
+	i.items = l.DedicatedGuestVlanRanges
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListDedicatedGuestVlanRangesIterator) Value() *DedicatedGuestVlanRange {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
+}
+
 type ListDedicatedGuestVlanRangesResponse struct {
 	Count                    int                        `json:"count"`
 	DedicatedGuestVlanRanges []*DedicatedGuestVlanRange `json:"dedicatedguestvlanrange"`
@@ -293,6 +451,10 @@ type DedicateGuestVlanRangeParams struct {
 	p map[string]interface{}
 }
 
+func (p *DedicateGuestVlanRangeParams) command() string {
+	return "dedicateGuestVlanRange"
+}
+
 func (p *DedicateGuestVlanRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -365,7 +527,13 @@ func (s *VLANService) NewDedicateGuestVlanRangeParams(account string, domainid s
 
 // Dedicates a guest vlan range to an account
 func (s *VLANService) DedicateGuestVlanRange(p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error) {
-	resp, err := s.cs.newRequest("dedicateGuestVlanRange", p.toURLValues())
+	return s.DedicateGuestVlanRangeWithContext(context.Background(), p)
+}
+
+// DedicateGuestVlanRangeWithContext is the same as DedicateGuestVlanRange, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VLANService) DedicateGuestVlanRangeWithContext(ctx context.Context, p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "dedicateGuestVlanRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -393,6 +561,10 @@ type CreateVlanIpRangeParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateVlanIpRangeParams) command() string {
+	return "createVlanIpRange"
+}
+
 func (p *CreateVlanIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -582,7 +754,13 @@ func (s *VLANService) NewCreateVlanIpRangeParams() *CreateVlanIpRangeParams {
 
 // Creates a VLAN IP range.
 func (s *VLANService) CreateVlanIpRange(p *CreateVlanIpRangeParams) (*CreateVlanIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("createVlanIpRange", p.toURLValues())
+	return s.CreateVlanIpRangeWithContext(context.Background(), p)
+}
+
+// CreateVlanIpRangeWithContext is the same as CreateVlanIpRange, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VLANService) CreateVlanIpRangeWithContext(ctx context.Context, p *CreateVlanIpRangeParams) (*CreateVlanIpRangeResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createVlanIpRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -623,6 +801,10 @@ type DeleteVlanIpRangeParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteVlanIpRangeParams) command() string {
+	return "deleteVlanIpRange"
+}
+
 func (p *DeleteVlanIpRangeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -652,7 +834,13 @@ func (s *VLANService) NewDeleteVlanIpRangeParams(id string) *DeleteVlanIpRangePa
 
 // Creates a VLAN IP range.
 func (s *VLANService) DeleteVlanIpRange(p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("deleteVlanIpRange", p.toURLValues())
+	return s.DeleteVlanIpRangeWithContext(context.Background(), p)
+}
+
+// DeleteVlanIpRangeWithContext is the same as DeleteVlanIpRange, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VLANService) DeleteVlanIpRangeWithContext(ctx context.Context, p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteVlanIpRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -673,6 +861,10 @@ type ListVlanIpRangesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListVlanIpRangesParams) command() string {
+	return "listVlanIpRanges"
+}
+
 func (p *ListVlanIpRangesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -837,16 +1029,14 @@ func (s *VLANService) GetVlanIpRangeByID(id string, opts ...OptionFunc) (*VlanIp
 
 	l, err := s.ListVlanIpRanges(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -858,10 +1048,17 @@ func (s *VLANService) GetVlanIpRangeByID(id string, opts ...OptionFunc) (*VlanIp
 
 // Lists all VLAN IP ranges.
 func (s *VLANService) ListVlanIpRanges(p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error) {
+	return s.ListVlanIpRangesWithContext(context.Background(), p)
+}
+
+// ListVlanIpRangesWithContext is the same as ListVlanIpRanges, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VLANService) ListVlanIpRangesWithContext(ctx context.Context, p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error) {
 	var r ListVlanIpRangesResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListVlanIpRangesResponse
-		resp, err := s.cs.newRequest("listVlanIpRanges", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listVlanIpRanges", values)
 		if err != nil {
 			return nil, err
 		}
@@ -873,13 +1070,89 @@ func (s *VLANService) ListVlanIpRanges(p *ListVlanIpRangesParams) (*ListVlanIpRa
 		r.Count = l.Count
 		r.VlanIpRanges = append(r.VlanIpRanges, l.VlanIpRanges...)
 
-		if r.Count == len(r.VlanIpRanges) {
+		if r.Count == len(r.VlanIpRanges) || len(l.VlanIpRanges) == 0 {
+			s.cs.observePages("listVlanIpRanges", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.VlanIpRanges))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.VlanIpRanges)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listVlanIpRanges", values, len(l.VlanIpRanges), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListVlanIpRangesResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.VlanIpRanges = append(r.VlanIpRanges, l.VlanIpRanges...)
+			}
+
+			if r.Count == len(r.VlanIpRanges) {
+				s.cs.observePages("listVlanIpRanges", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListVlanIpRangesIterator iterates over the items returned by listVlanIpRanges, requesting
+// the pages of the response one at a time while iterating.
+type ListVlanIpRangesIterator struct {
+	pager
+	items []*VlanIpRange
+	index int
+}
+
+// NewListVlanIpRangesIterator returns an iterator over the items returned by listVlanIpRanges, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *VLANService) NewListVlanIpRangesIterator(p *ListVlanIpRangesParams, pagesize int) *ListVlanIpRangesIterator {
+	return &ListVlanIpRangesIterator{pager: newPager(s.cs, "listVlanIpRanges", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListVlanIpRangesIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListVlanIpRangesResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.VlanIpRanges))
+
+	i.items = l.VlanIpRanges
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListVlanIpRangesIterator) Value() *VlanIpRange {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListVlanIpRangesResponse struct {
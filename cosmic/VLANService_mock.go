@@ -0,0 +1,186 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockVLANService is a mock of VLANServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockVLANService struct {
+	mockCalls
+
+	ReleaseDedicatedGuestVlanRangeFunc      func(ctx context.Context, p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeResponse, error)
+	ReleaseDedicatedGuestVlanRangeAsyncFunc func(ctx context.Context, p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeJob, error)
+	GetDedicatedGuestVlanRangeByIDFunc      func(id string, opts ...OptionFunc) (*DedicatedGuestVlanRange, int, error)
+	ListDedicatedGuestVlanRangesFunc        func(ctx context.Context, p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error)
+	DedicateGuestVlanRangeFunc              func(ctx context.Context, p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error)
+	CreateVlanIpRangeFunc                   func(ctx context.Context, p *CreateVlanIpRangeParams) (*CreateVlanIpRangeResponse, error)
+	DeleteVlanIpRangeFunc                   func(ctx context.Context, p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error)
+	GetVlanIpRangeByIDFunc                  func(id string, opts ...OptionFunc) (*VlanIpRange, int, error)
+	ListVlanIpRangesFunc                    func(ctx context.Context, p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error)
+}
+
+var _ VLANServiceIface = &MockVLANService{}
+
+func (m *MockVLANService) NewReleaseDedicatedGuestVlanRangeParams(id string) *ReleaseDedicatedGuestVlanRangeParams {
+	return (&VLANService{}).NewReleaseDedicatedGuestVlanRangeParams(id)
+}
+
+func (m *MockVLANService) ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeResponse, error) {
+	return m.ReleaseDedicatedGuestVlanRangeWithContext(context.Background(), p)
+}
+
+func (m *MockVLANService) ReleaseDedicatedGuestVlanRangeWithContext(ctx context.Context, p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeResponse, error) {
+	m.record("ReleaseDedicatedGuestVlanRange", p)
+	if m.ReleaseDedicatedGuestVlanRangeFunc == nil {
+		return nil, notMocked("MockVLANService", "ReleaseDedicatedGuestVlanRange")
+	}
+	return m.ReleaseDedicatedGuestVlanRangeFunc(ctx, p)
+}
+
+func (m *MockVLANService) ReleaseDedicatedGuestVlanRangeAsync(ctx context.Context, p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeJob, error) {
+	m.record("ReleaseDedicatedGuestVlanRangeAsync", p)
+	if m.ReleaseDedicatedGuestVlanRangeAsyncFunc == nil {
+		return nil, notMocked("MockVLANService", "ReleaseDedicatedGuestVlanRangeAsync")
+	}
+	return m.ReleaseDedicatedGuestVlanRangeAsyncFunc(ctx, p)
+}
+
+func (m *MockVLANService) NewListDedicatedGuestVlanRangesParams() *ListDedicatedGuestVlanRangesParams {
+	return (&VLANService{}).NewListDedicatedGuestVlanRangesParams()
+}
+
+func (m *MockVLANService) GetDedicatedGuestVlanRangeByID(id string, opts ...OptionFunc) (*DedicatedGuestVlanRange, int, error) {
+	m.record("GetDedicatedGuestVlanRangeByID", id, opts)
+	if m.GetDedicatedGuestVlanRangeByIDFunc == nil {
+		return nil, -1, notMocked("MockVLANService", "GetDedicatedGuestVlanRangeByID")
+	}
+	return m.GetDedicatedGuestVlanRangeByIDFunc(id, opts...)
+}
+
+func (m *MockVLANService) ListDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error) {
+	return m.ListDedicatedGuestVlanRangesWithContext(context.Background(), p)
+}
+
+func (m *MockVLANService) ListDedicatedGuestVlanRangesWithContext(ctx context.Context, p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error) {
+	m.record("ListDedicatedGuestVlanRanges", p)
+	if m.ListDedicatedGuestVlanRangesFunc == nil {
+		return nil, notMocked("MockVLANService", "ListDedicatedGuestVlanRanges")
+	}
+	return m.ListDedicatedGuestVlanRangesFunc(ctx, p)
+}
+
+func (m *MockVLANService) NewListDedicatedGuestVlanRangesIterator(p *ListDedicatedGuestVlanRangesParams, pagesize int) *ListDedicatedGuestVlanRangesIterator {
+	i := &ListDedicatedGuestVlanRangesIterator{pager: newPager(nil, "listDedicatedGuestVlanRanges", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListDedicatedGuestVlanRangesParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListDedicatedGuestVlanRangesWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockVLANService) NewDedicateGuestVlanRangeParams(account string, domainid string, physicalnetworkid string, vlanrange string) *DedicateGuestVlanRangeParams {
+	return (&VLANService{}).NewDedicateGuestVlanRangeParams(account, domainid, physicalnetworkid, vlanrange)
+}
+
+func (m *MockVLANService) DedicateGuestVlanRange(p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error) {
+	return m.DedicateGuestVlanRangeWithContext(context.Background(), p)
+}
+
+func (m *MockVLANService) DedicateGuestVlanRangeWithContext(ctx context.Context, p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error) {
+	m.record("DedicateGuestVlanRange", p)
+	if m.DedicateGuestVlanRangeFunc == nil {
+		return nil, notMocked("MockVLANService", "DedicateGuestVlanRange")
+	}
+	return m.DedicateGuestVlanRangeFunc(ctx, p)
+}
+
+func (m *MockVLANService) NewCreateVlanIpRangeParams() *CreateVlanIpRangeParams {
+	return (&VLANService{}).NewCreateVlanIpRangeParams()
+}
+
+func (m *MockVLANService) CreateVlanIpRange(p *CreateVlanIpRangeParams) (*CreateVlanIpRangeResponse, error) {
+	return m.CreateVlanIpRangeWithContext(context.Background(), p)
+}
+
+func (m *MockVLANService) CreateVlanIpRangeWithContext(ctx context.Context, p *CreateVlanIpRangeParams) (*CreateVlanIpRangeResponse, error) {
+	m.record("CreateVlanIpRange", p)
+	if m.CreateVlanIpRangeFunc == nil {
+		return nil, notMocked("MockVLANService", "CreateVlanIpRange")
+	}
+	return m.CreateVlanIpRangeFunc(ctx, p)
+}
+
+func (m *MockVLANService) NewDeleteVlanIpRangeParams(id string) *DeleteVlanIpRangeParams {
+	return (&VLANService{}).NewDeleteVlanIpRangeParams(id)
+}
+
+func (m *MockVLANService) DeleteVlanIpRange(p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error) {
+	return m.DeleteVlanIpRangeWithContext(context.Background(), p)
+}
+
+func (m *MockVLANService) DeleteVlanIpRangeWithContext(ctx context.Context, p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error) {
+	m.record("DeleteVlanIpRange", p)
+	if m.DeleteVlanIpRangeFunc == nil {
+		return nil, notMocked("MockVLANService", "DeleteVlanIpRange")
+	}
+	return m.DeleteVlanIpRangeFunc(ctx, p)
+}
+
+func (m *MockVLANService) NewListVlanIpRangesParams() *ListVlanIpRangesParams {
+	return (&VLANService{}).NewListVlanIpRangesParams()
+}
+
+func (m *MockVLANService) GetVlanIpRangeByID(id string, opts ...OptionFunc) (*VlanIpRange, int, error) {
+	m.record("GetVlanIpRangeByID", id, opts)
+	if m.GetVlanIpRangeByIDFunc == nil {
+		return nil, -1, notMocked("MockVLANService", "GetVlanIpRangeByID")
+	}
+	return m.GetVlanIpRangeByIDFunc(id, opts...)
+}
+
+func (m *MockVLANService) ListVlanIpRanges(p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error) {
+	return m.ListVlanIpRangesWithContext(context.Background(), p)
+}
+
+func (m *MockVLANService) ListVlanIpRangesWithContext(ctx context.Context, p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error) {
+	m.record("ListVlanIpRanges", p)
+	if m.ListVlanIpRangesFunc == nil {
+		return nil, notMocked("MockVLANService", "ListVlanIpRanges")
+	}
+	return m.ListVlanIpRangesFunc(ctx, p)
+}
+
+func (m *MockVLANService) NewListVlanIpRangesIterator(p *ListVlanIpRangesParams, pagesize int) *ListVlanIpRangesIterator {
+	i := &ListVlanIpRangesIterator{pager: newPager(nil, "listVlanIpRanges", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListVlanIpRangesParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListVlanIpRangesWithContext(ctx, c)
+	}
+	return i
+}
@@ -0,0 +1,182 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestReleaseDedicatedGuestVlanRangeParams(t *testing.T) {
+	p := &ReleaseDedicatedGuestVlanRangeParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "releaseDedicatedGuestVlanRange", want)
+}
+
+func TestReleaseDedicatedGuestVlanRangeResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &ReleaseDedicatedGuestVlanRangeResponse{})
+}
+
+func TestListDedicatedGuestVlanRangesParams(t *testing.T) {
+	p := &ListDedicatedGuestVlanRangesParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetGuestvlanrange("guestvlanrange-value")
+	want.Set("guestvlanrange", "guestvlanrange-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetPhysicalnetworkid("physicalnetworkid-value")
+	want.Set("physicalnetworkid", "physicalnetworkid-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listDedicatedGuestVlanRanges", want)
+}
+
+func TestListDedicatedGuestVlanRangesResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"dedicatedguestvlanrange":[{"account":"account-value","domain":"domain-value","domainid":"domainid-value","guestvlanrange":"guestvlanrange-value","id":"id-value","physicalnetworkid":1099511627776,"project":"project-value","projectid":"projectid-value","zoneid":1099511627776}]}`, &ListDedicatedGuestVlanRangesResponse{})
+}
+
+func TestDedicateGuestVlanRangeParams(t *testing.T) {
+	p := &DedicateGuestVlanRangeParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetPhysicalnetworkid("physicalnetworkid-value")
+	want.Set("physicalnetworkid", "physicalnetworkid-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetVlanrange("vlanrange-value")
+	want.Set("vlanrange", "vlanrange-value")
+
+	assertParams(t, p, "dedicateGuestVlanRange", want)
+}
+
+func TestDedicateGuestVlanRangeResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","domain":"domain-value","domainid":"domainid-value","guestvlanrange":"guestvlanrange-value","id":"id-value","physicalnetworkid":1099511627776,"project":"project-value","projectid":"projectid-value","zoneid":1099511627776}`, &DedicateGuestVlanRangeResponse{})
+}
+
+func TestCreateVlanIpRangeParams(t *testing.T) {
+	p := &CreateVlanIpRangeParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetEndip("endip-value")
+	want.Set("endip", "endip-value")
+	p.SetEndipv6("endipv6-value")
+	want.Set("endipv6", "endipv6-value")
+	p.SetForvirtualnetwork(true)
+	want.Set("forvirtualnetwork", "true")
+	p.SetGateway("gateway-value")
+	want.Set("gateway", "gateway-value")
+	p.SetIp6cidr("ip6cidr-value")
+	want.Set("ip6cidr", "ip6cidr-value")
+	p.SetIp6gateway("ip6gateway-value")
+	want.Set("ip6gateway", "ip6gateway-value")
+	p.SetNetmask("netmask-value")
+	want.Set("netmask", "netmask-value")
+	p.SetNetworkid("networkid-value")
+	want.Set("networkid", "networkid-value")
+	p.SetPhysicalnetworkid("physicalnetworkid-value")
+	want.Set("physicalnetworkid", "physicalnetworkid-value")
+	p.SetPodid("podid-value")
+	want.Set("podid", "podid-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetStartip("startip-value")
+	want.Set("startip", "startip-value")
+	p.SetStartipv6("startipv6-value")
+	want.Set("startipv6", "startipv6-value")
+	p.SetVlan("vlan-value")
+	want.Set("vlan", "vlan-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "createVlanIpRange", want)
+}
+
+func TestCreateVlanIpRangeResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","description":"description-value","domain":"domain-value","domainid":"domainid-value","endip":"endip-value","endipv6":"endipv6-value","forvirtualnetwork":true,"gateway":"gateway-value","id":"id-value","ip6cidr":"ip6cidr-value","ip6gateway":"ip6gateway-value","netmask":"netmask-value","networkid":"networkid-value","physicalnetworkid":"physicalnetworkid-value","podid":"podid-value","podname":"podname-value","project":"project-value","projectid":"projectid-value","startip":"startip-value","startipv6":"startipv6-value","vlan":"vlan-value","zoneid":"zoneid-value"}`, &CreateVlanIpRangeResponse{})
+}
+
+func TestDeleteVlanIpRangeParams(t *testing.T) {
+	p := &DeleteVlanIpRangeParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteVlanIpRange", want)
+}
+
+func TestDeleteVlanIpRangeResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &DeleteVlanIpRangeResponse{})
+}
+
+func TestListVlanIpRangesParams(t *testing.T) {
+	p := &ListVlanIpRangesParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetForvirtualnetwork(true)
+	want.Set("forvirtualnetwork", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetNetworkid("networkid-value")
+	want.Set("networkid", "networkid-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetPhysicalnetworkid("physicalnetworkid-value")
+	want.Set("physicalnetworkid", "physicalnetworkid-value")
+	p.SetPodid("podid-value")
+	want.Set("podid", "podid-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetVlan("vlan-value")
+	want.Set("vlan", "vlan-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listVlanIpRanges", want)
+}
+
+func TestListVlanIpRangesResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"vlaniprange":[{"account":"account-value","description":"description-value","domain":"domain-value","domainid":"domainid-value","endip":"endip-value","endipv6":"endipv6-value","forvirtualnetwork":true,"gateway":"gateway-value","id":"id-value","ip6cidr":"ip6cidr-value","ip6gateway":"ip6gateway-value","netmask":"netmask-value","networkid":"networkid-value","physicalnetworkid":"physicalnetworkid-value","podid":"podid-value","podname":"podname-value","project":"project-value","projectid":"projectid-value","startip":"startip-value","startipv6":"startipv6-value","vlan":"vlan-value","zoneid":"zoneid-value"}]}`, &ListVlanIpRangesResponse{})
+}
@@ -17,17 +17,42 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
+// VMGroupServiceIface is the interface implemented by VMGroupService, and by MockVMGroupService for tests
+type VMGroupServiceIface interface {
+	NewCreateInstanceGroupParams(name string) *CreateInstanceGroupParams
+	CreateInstanceGroup(p *CreateInstanceGroupParams) (*CreateInstanceGroupResponse, error)
+	CreateInstanceGroupWithContext(ctx context.Context, p *CreateInstanceGroupParams) (*CreateInstanceGroupResponse, error)
+	NewDeleteInstanceGroupParams(id string) *DeleteInstanceGroupParams
+	DeleteInstanceGroup(p *DeleteInstanceGroupParams) (*DeleteInstanceGroupResponse, error)
+	DeleteInstanceGroupWithContext(ctx context.Context, p *DeleteInstanceGroupParams) (*DeleteInstanceGroupResponse, error)
+	NewUpdateInstanceGroupParams(id string) *UpdateInstanceGroupParams
+	UpdateInstanceGroup(p *UpdateInstanceGroupParams) (*UpdateInstanceGroupResponse, error)
+	UpdateInstanceGroupWithContext(ctx context.Context, p *UpdateInstanceGroupParams) (*UpdateInstanceGroupResponse, error)
+	NewListInstanceGroupsParams() *ListInstanceGroupsParams
+	GetInstanceGroupID(name string, opts ...OptionFunc) (string, int, error)
+	GetInstanceGroupByName(name string, opts ...OptionFunc) (*InstanceGroup, int, error)
+	GetInstanceGroupByID(id string, opts ...OptionFunc) (*InstanceGroup, int, error)
+	ListInstanceGroups(p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error)
+	ListInstanceGroupsWithContext(ctx context.Context, p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error)
+	NewListInstanceGroupsIterator(p *ListInstanceGroupsParams, pagesize int) *ListInstanceGroupsIterator
+}
+
 type CreateInstanceGroupParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateInstanceGroupParams) command() string {
+	return "createInstanceGroup"
+}
+
 func (p *CreateInstanceGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -87,7 +112,13 @@ func (s *VMGroupService) NewCreateInstanceGroupParams(name string) *CreateInstan
 
 // Creates a vm group
 func (s *VMGroupService) CreateInstanceGroup(p *CreateInstanceGroupParams) (*CreateInstanceGroupResponse, error) {
-	resp, err := s.cs.newRequest("createInstanceGroup", p.toURLValues())
+	return s.CreateInstanceGroupWithContext(context.Background(), p)
+}
+
+// CreateInstanceGroupWithContext is the same as CreateInstanceGroup, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VMGroupService) CreateInstanceGroupWithContext(ctx context.Context, p *CreateInstanceGroupParams) (*CreateInstanceGroupResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createInstanceGroup", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -114,6 +145,10 @@ type DeleteInstanceGroupParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteInstanceGroupParams) command() string {
+	return "deleteInstanceGroup"
+}
+
 func (p *DeleteInstanceGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -143,7 +178,13 @@ func (s *VMGroupService) NewDeleteInstanceGroupParams(id string) *DeleteInstance
 
 // Deletes a vm group
 func (s *VMGroupService) DeleteInstanceGroup(p *DeleteInstanceGroupParams) (*DeleteInstanceGroupResponse, error) {
-	resp, err := s.cs.newRequest("deleteInstanceGroup", p.toURLValues())
+	return s.DeleteInstanceGroupWithContext(context.Background(), p)
+}
+
+// DeleteInstanceGroupWithContext is the same as DeleteInstanceGroup, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VMGroupService) DeleteInstanceGroupWithContext(ctx context.Context, p *DeleteInstanceGroupParams) (*DeleteInstanceGroupResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteInstanceGroup", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -164,6 +205,10 @@ type UpdateInstanceGroupParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateInstanceGroupParams) command() string {
+	return "updateInstanceGroup"
+}
+
 func (p *UpdateInstanceGroupParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -203,7 +248,13 @@ func (s *VMGroupService) NewUpdateInstanceGroupParams(id string) *UpdateInstance
 
 // Updates a vm group
 func (s *VMGroupService) UpdateInstanceGroup(p *UpdateInstanceGroupParams) (*UpdateInstanceGroupResponse, error) {
-	resp, err := s.cs.newRequest("updateInstanceGroup", p.toURLValues())
+	return s.UpdateInstanceGroupWithContext(context.Background(), p)
+}
+
+// UpdateInstanceGroupWithContext is the same as UpdateInstanceGroup, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VMGroupService) UpdateInstanceGroupWithContext(ctx context.Context, p *UpdateInstanceGroupParams) (*UpdateInstanceGroupResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateInstanceGroup", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -230,6 +281,10 @@ type ListInstanceGroupsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListInstanceGroupsParams) command() string {
+	return "listInstanceGroups"
+}
+
 func (p *ListInstanceGroupsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -369,7 +424,7 @@ func (s *VMGroupService) GetInstanceGroupID(name string, opts ...OptionFunc) (st
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -383,7 +438,7 @@ func (s *VMGroupService) GetInstanceGroupID(name string, opts ...OptionFunc) (st
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -415,16 +470,14 @@ func (s *VMGroupService) GetInstanceGroupByID(id string, opts ...OptionFunc) (*I
 
 	l, err := s.ListInstanceGroups(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -436,10 +489,17 @@ func (s *VMGroupService) GetInstanceGroupByID(id string, opts ...OptionFunc) (*I
 
 // Lists vm groups
 func (s *VMGroupService) ListInstanceGroups(p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error) {
+	return s.ListInstanceGroupsWithContext(context.Background(), p)
+}
+
+// ListInstanceGroupsWithContext is the same as ListInstanceGroups, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VMGroupService) ListInstanceGroupsWithContext(ctx context.Context, p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error) {
 	var r ListInstanceGroupsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListInstanceGroupsResponse
-		resp, err := s.cs.newRequest("listInstanceGroups", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listInstanceGroups", values)
 		if err != nil {
 			return nil, err
 		}
@@ -451,13 +511,89 @@ func (s *VMGroupService) ListInstanceGroups(p *ListInstanceGroupsParams) (*ListI
 		r.Count = l.Count
 		r.InstanceGroups = append(r.InstanceGroups, l.InstanceGroups...)
 
-		if r.Count == len(r.InstanceGroups) {
+		if r.Count == len(r.InstanceGroups) || len(l.InstanceGroups) == 0 {
+			s.cs.observePages("listInstanceGroups", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.InstanceGroups))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.InstanceGroups)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listInstanceGroups", values, len(l.InstanceGroups), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListInstanceGroupsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.InstanceGroups = append(r.InstanceGroups, l.InstanceGroups...)
+			}
+
+			if r.Count == len(r.InstanceGroups) {
+				s.cs.observePages("listInstanceGroups", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListInstanceGroupsIterator iterates over the items returned by listInstanceGroups, requesting
+// the pages of the response one at a time while iterating.
+type ListInstanceGroupsIterator struct {
+	pager
+	items []*InstanceGroup
+	index int
+}
+
+// NewListInstanceGroupsIterator returns an iterator over the items returned by listInstanceGroups, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *VMGroupService) NewListInstanceGroupsIterator(p *ListInstanceGroupsParams, pagesize int) *ListInstanceGroupsIterator {
+	return &ListInstanceGroupsIterator{pager: newPager(s.cs, "listInstanceGroups", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListInstanceGroupsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListInstanceGroupsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.InstanceGroups))
+
+	i.items = l.InstanceGroups
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListInstanceGroupsIterator) Value() *InstanceGroup {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListInstanceGroupsResponse struct {
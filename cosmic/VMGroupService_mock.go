@@ -0,0 +1,138 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockVMGroupService is a mock of VMGroupServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockVMGroupService struct {
+	mockCalls
+
+	CreateInstanceGroupFunc    func(ctx context.Context, p *CreateInstanceGroupParams) (*CreateInstanceGroupResponse, error)
+	DeleteInstanceGroupFunc    func(ctx context.Context, p *DeleteInstanceGroupParams) (*DeleteInstanceGroupResponse, error)
+	UpdateInstanceGroupFunc    func(ctx context.Context, p *UpdateInstanceGroupParams) (*UpdateInstanceGroupResponse, error)
+	GetInstanceGroupIDFunc     func(name string, opts ...OptionFunc) (string, int, error)
+	GetInstanceGroupByNameFunc func(name string, opts ...OptionFunc) (*InstanceGroup, int, error)
+	GetInstanceGroupByIDFunc   func(id string, opts ...OptionFunc) (*InstanceGroup, int, error)
+	ListInstanceGroupsFunc     func(ctx context.Context, p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error)
+}
+
+var _ VMGroupServiceIface = &MockVMGroupService{}
+
+func (m *MockVMGroupService) NewCreateInstanceGroupParams(name string) *CreateInstanceGroupParams {
+	return (&VMGroupService{}).NewCreateInstanceGroupParams(name)
+}
+
+func (m *MockVMGroupService) CreateInstanceGroup(p *CreateInstanceGroupParams) (*CreateInstanceGroupResponse, error) {
+	return m.CreateInstanceGroupWithContext(context.Background(), p)
+}
+
+func (m *MockVMGroupService) CreateInstanceGroupWithContext(ctx context.Context, p *CreateInstanceGroupParams) (*CreateInstanceGroupResponse, error) {
+	m.record("CreateInstanceGroup", p)
+	if m.CreateInstanceGroupFunc == nil {
+		return nil, notMocked("MockVMGroupService", "CreateInstanceGroup")
+	}
+	return m.CreateInstanceGroupFunc(ctx, p)
+}
+
+func (m *MockVMGroupService) NewDeleteInstanceGroupParams(id string) *DeleteInstanceGroupParams {
+	return (&VMGroupService{}).NewDeleteInstanceGroupParams(id)
+}
+
+func (m *MockVMGroupService) DeleteInstanceGroup(p *DeleteInstanceGroupParams) (*DeleteInstanceGroupResponse, error) {
+	return m.DeleteInstanceGroupWithContext(context.Background(), p)
+}
+
+func (m *MockVMGroupService) DeleteInstanceGroupWithContext(ctx context.Context, p *DeleteInstanceGroupParams) (*DeleteInstanceGroupResponse, error) {
+	m.record("DeleteInstanceGroup", p)
+	if m.DeleteInstanceGroupFunc == nil {
+		return nil, notMocked("MockVMGroupService", "DeleteInstanceGroup")
+	}
+	return m.DeleteInstanceGroupFunc(ctx, p)
+}
+
+func (m *MockVMGroupService) NewUpdateInstanceGroupParams(id string) *UpdateInstanceGroupParams {
+	return (&VMGroupService{}).NewUpdateInstanceGroupParams(id)
+}
+
+func (m *MockVMGroupService) UpdateInstanceGroup(p *UpdateInstanceGroupParams) (*UpdateInstanceGroupResponse, error) {
+	return m.UpdateInstanceGroupWithContext(context.Background(), p)
+}
+
+func (m *MockVMGroupService) UpdateInstanceGroupWithContext(ctx context.Context, p *UpdateInstanceGroupParams) (*UpdateInstanceGroupResponse, error) {
+	m.record("UpdateInstanceGroup", p)
+	if m.UpdateInstanceGroupFunc == nil {
+		return nil, notMocked("MockVMGroupService", "UpdateInstanceGroup")
+	}
+	return m.UpdateInstanceGroupFunc(ctx, p)
+}
+
+func (m *MockVMGroupService) NewListInstanceGroupsParams() *ListInstanceGroupsParams {
+	return (&VMGroupService{}).NewListInstanceGroupsParams()
+}
+
+func (m *MockVMGroupService) GetInstanceGroupID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetInstanceGroupID", name, opts)
+	if m.GetInstanceGroupIDFunc == nil {
+		return "", -1, notMocked("MockVMGroupService", "GetInstanceGroupID")
+	}
+	return m.GetInstanceGroupIDFunc(name, opts...)
+}
+
+func (m *MockVMGroupService) GetInstanceGroupByName(name string, opts ...OptionFunc) (*InstanceGroup, int, error) {
+	m.record("GetInstanceGroupByName", name, opts)
+	if m.GetInstanceGroupByNameFunc == nil {
+		return nil, -1, notMocked("MockVMGroupService", "GetInstanceGroupByName")
+	}
+	return m.GetInstanceGroupByNameFunc(name, opts...)
+}
+
+func (m *MockVMGroupService) GetInstanceGroupByID(id string, opts ...OptionFunc) (*InstanceGroup, int, error) {
+	m.record("GetInstanceGroupByID", id, opts)
+	if m.GetInstanceGroupByIDFunc == nil {
+		return nil, -1, notMocked("MockVMGroupService", "GetInstanceGroupByID")
+	}
+	return m.GetInstanceGroupByIDFunc(id, opts...)
+}
+
+func (m *MockVMGroupService) ListInstanceGroups(p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error) {
+	return m.ListInstanceGroupsWithContext(context.Background(), p)
+}
+
+func (m *MockVMGroupService) ListInstanceGroupsWithContext(ctx context.Context, p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error) {
+	m.record("ListInstanceGroups", p)
+	if m.ListInstanceGroupsFunc == nil {
+		return nil, notMocked("MockVMGroupService", "ListInstanceGroups")
+	}
+	return m.ListInstanceGroupsFunc(ctx, p)
+}
+
+func (m *MockVMGroupService) NewListInstanceGroupsIterator(p *ListInstanceGroupsParams, pagesize int) *ListInstanceGroupsIterator {
+	i := &ListInstanceGroupsIterator{pager: newPager(nil, "listInstanceGroups", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListInstanceGroupsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListInstanceGroupsWithContext(ctx, c)
+	}
+	return i
+}
@@ -0,0 +1,100 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCreateInstanceGroupParams(t *testing.T) {
+	p := &CreateInstanceGroupParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+
+	assertParams(t, p, "createInstanceGroup", want)
+}
+
+func TestCreateInstanceGroupResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","name":"name-value","project":"project-value","projectid":"projectid-value"}`, &CreateInstanceGroupResponse{})
+}
+
+func TestDeleteInstanceGroupParams(t *testing.T) {
+	p := &DeleteInstanceGroupParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteInstanceGroup", want)
+}
+
+func TestDeleteInstanceGroupResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &DeleteInstanceGroupResponse{})
+}
+
+func TestUpdateInstanceGroupParams(t *testing.T) {
+	p := &UpdateInstanceGroupParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+
+	assertParams(t, p, "updateInstanceGroup", want)
+}
+
+func TestUpdateInstanceGroupResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","name":"name-value","project":"project-value","projectid":"projectid-value"}`, &UpdateInstanceGroupResponse{})
+}
+
+func TestListInstanceGroupsParams(t *testing.T) {
+	p := &ListInstanceGroupsParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+
+	assertParams(t, p, "listInstanceGroups", want)
+}
+
+func TestListInstanceGroupsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"instancegroup":[{"account":"account-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","name":"name-value","project":"project-value","projectid":"projectid-value"}]}`, &ListInstanceGroupsResponse{})
+}
@@ -17,17 +17,95 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 )
 
+// VPCServiceIface is the interface implemented by VPCService, and by MockVPCService for tests
+type VPCServiceIface interface {
+	NewCreatePrivateGatewayParams(ipaddress string, networkid string, vpcid string) *CreatePrivateGatewayParams
+	CreatePrivateGateway(p *CreatePrivateGatewayParams) (*CreatePrivateGatewayResponse, error)
+	CreatePrivateGatewayWithContext(ctx context.Context, p *CreatePrivateGatewayParams) (*CreatePrivateGatewayResponse, error)
+	CreatePrivateGatewayAsync(ctx context.Context, p *CreatePrivateGatewayParams) (*CreatePrivateGatewayJob, error)
+	NewDeletePrivateGatewayParams(id string) *DeletePrivateGatewayParams
+	DeletePrivateGateway(p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error)
+	DeletePrivateGatewayWithContext(ctx context.Context, p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error)
+	DeletePrivateGatewayAsync(ctx context.Context, p *DeletePrivateGatewayParams) (*DeletePrivateGatewayJob, error)
+	NewListPrivateGatewaysParams() *ListPrivateGatewaysParams
+	GetPrivateGatewayByID(id string, opts ...OptionFunc) (*PrivateGateway, int, error)
+	ListPrivateGateways(p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error)
+	ListPrivateGatewaysWithContext(ctx context.Context, p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error)
+	NewListPrivateGatewaysIterator(p *ListPrivateGatewaysParams, pagesize int) *ListPrivateGatewaysIterator
+	NewCreateStaticRouteParams(cidr string, nexthop string, vpcid string) *CreateStaticRouteParams
+	CreateStaticRoute(p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error)
+	CreateStaticRouteWithContext(ctx context.Context, p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error)
+	CreateStaticRouteAsync(ctx context.Context, p *CreateStaticRouteParams) (*CreateStaticRouteJob, error)
+	NewDeleteStaticRouteParams(id string) *DeleteStaticRouteParams
+	DeleteStaticRoute(p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error)
+	DeleteStaticRouteWithContext(ctx context.Context, p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error)
+	DeleteStaticRouteAsync(ctx context.Context, p *DeleteStaticRouteParams) (*DeleteStaticRouteJob, error)
+	NewListStaticRoutesParams() *ListStaticRoutesParams
+	GetStaticRouteByID(id string, opts ...OptionFunc) (*StaticRoute, int, error)
+	ListStaticRoutes(p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error)
+	ListStaticRoutesWithContext(ctx context.Context, p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error)
+	NewListStaticRoutesIterator(p *ListStaticRoutesParams, pagesize int) *ListStaticRoutesIterator
+	NewCreateVPCParams(cidr string, displaytext string, name string, vpcofferingid string, zoneid string) *CreateVPCParams
+	CreateVPC(p *CreateVPCParams) (*CreateVPCResponse, error)
+	CreateVPCWithContext(ctx context.Context, p *CreateVPCParams) (*CreateVPCResponse, error)
+	CreateVPCAsync(ctx context.Context, p *CreateVPCParams) (*CreateVPCJob, error)
+	NewDeleteVPCParams(id string) *DeleteVPCParams
+	DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error)
+	DeleteVPCWithContext(ctx context.Context, p *DeleteVPCParams) (*DeleteVPCResponse, error)
+	DeleteVPCAsync(ctx context.Context, p *DeleteVPCParams) (*DeleteVPCJob, error)
+	NewRestartVPCParams(id string) *RestartVPCParams
+	RestartVPC(p *RestartVPCParams) (*RestartVPCResponse, error)
+	RestartVPCWithContext(ctx context.Context, p *RestartVPCParams) (*RestartVPCResponse, error)
+	RestartVPCAsync(ctx context.Context, p *RestartVPCParams) (*RestartVPCJob, error)
+	NewUpdateVPCParams(id string) *UpdateVPCParams
+	UpdateVPC(p *UpdateVPCParams) (*UpdateVPCResponse, error)
+	UpdateVPCWithContext(ctx context.Context, p *UpdateVPCParams) (*UpdateVPCResponse, error)
+	UpdateVPCAsync(ctx context.Context, p *UpdateVPCParams) (*UpdateVPCJob, error)
+	NewCreateVPCOfferingParams(displaytext string, name string, supportedservices []string) *CreateVPCOfferingParams
+	CreateVPCOffering(p *CreateVPCOfferingParams) (*CreateVPCOfferingResponse, error)
+	CreateVPCOfferingWithContext(ctx context.Context, p *CreateVPCOfferingParams) (*CreateVPCOfferingResponse, error)
+	CreateVPCOfferingAsync(ctx context.Context, p *CreateVPCOfferingParams) (*CreateVPCOfferingJob, error)
+	NewDeleteVPCOfferingParams(id string) *DeleteVPCOfferingParams
+	DeleteVPCOffering(p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error)
+	DeleteVPCOfferingWithContext(ctx context.Context, p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error)
+	DeleteVPCOfferingAsync(ctx context.Context, p *DeleteVPCOfferingParams) (*DeleteVPCOfferingJob, error)
+	NewUpdateVPCOfferingParams(id string) *UpdateVPCOfferingParams
+	UpdateVPCOffering(p *UpdateVPCOfferingParams) (*UpdateVPCOfferingResponse, error)
+	UpdateVPCOfferingWithContext(ctx context.Context, p *UpdateVPCOfferingParams) (*UpdateVPCOfferingResponse, error)
+	UpdateVPCOfferingAsync(ctx context.Context, p *UpdateVPCOfferingParams) (*UpdateVPCOfferingJob, error)
+	NewListVPCOfferingsParams() *ListVPCOfferingsParams
+	GetVPCOfferingID(name string, opts ...OptionFunc) (string, int, error)
+	GetVPCOfferingByName(name string, opts ...OptionFunc) (*VPCOffering, int, error)
+	GetVPCOfferingByID(id string, opts ...OptionFunc) (*VPCOffering, int, error)
+	ListVPCOfferings(p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error)
+	ListVPCOfferingsWithContext(ctx context.Context, p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error)
+	NewListVPCOfferingsIterator(p *ListVPCOfferingsParams, pagesize int) *ListVPCOfferingsIterator
+	NewListVPCsParams() *ListVPCsParams
+	GetVPCID(name string, opts ...OptionFunc) (string, int, error)
+	GetVPCByName(name string, opts ...OptionFunc) (*VPC, int, error)
+	GetVPCByID(id string, opts ...OptionFunc) (*VPC, int, error)
+	ListVPCs(p *ListVPCsParams) (*ListVPCsResponse, error)
+	ListVPCsWithContext(ctx context.Context, p *ListVPCsParams) (*ListVPCsResponse, error)
+	NewListVPCsIterator(p *ListVPCsParams, pagesize int) *ListVPCsIterator
+}
+
 type CreatePrivateGatewayParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreatePrivateGatewayParams) command() string {
+	return "createPrivateGateway"
+}
+
 func (p *CreatePrivateGatewayParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -120,7 +198,13 @@ func (s *VPCService) NewCreatePrivateGatewayParams(ipaddress string, networkid s
 
 // Creates a private gateway
 func (s *VPCService) CreatePrivateGateway(p *CreatePrivateGatewayParams) (*CreatePrivateGatewayResponse, error) {
-	resp, err := s.cs.newRequest("createPrivateGateway", p.toURLValues())
+	return s.CreatePrivateGatewayWithContext(context.Background(), p)
+}
+
+// CreatePrivateGatewayWithContext is the same as CreatePrivateGateway, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPCService) CreatePrivateGatewayWithContext(ctx context.Context, p *CreatePrivateGatewayParams) (*CreatePrivateGatewayResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createPrivateGateway", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -132,26 +216,56 @@ func (s *VPCService) CreatePrivateGateway(p *CreatePrivateGatewayParams) (*Creat
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createPrivateGateway", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "privategateway", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CreatePrivateGatewayJob is a handle to a running createPrivateGateway async job
+type CreatePrivateGatewayJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreatePrivateGatewayResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreatePrivateGatewayJob) Result() (*CreatePrivateGatewayResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreatePrivateGatewayResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "privategateway", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CreatePrivateGatewayAsync starts the createPrivateGateway async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPCService) CreatePrivateGatewayAsync(ctx context.Context, p *CreatePrivateGatewayParams) (*CreatePrivateGatewayJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createPrivateGateway", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreatePrivateGatewayResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreatePrivateGatewayJob{s.cs.newJobHandle("createPrivateGateway", r.JobID)}, nil
+}
+
 type CreatePrivateGatewayResponse struct {
 	JobID              string `json:"jobid,omitempty"`
 	Account            string `json:"account,omitempty"`
@@ -177,6 +291,10 @@ type DeletePrivateGatewayParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeletePrivateGatewayParams) command() string {
+	return "deletePrivateGateway"
+}
+
 func (p *DeletePrivateGatewayParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -206,7 +324,13 @@ func (s *VPCService) NewDeletePrivateGatewayParams(id string) *DeletePrivateGate
 
 // Deletes a Private gateway
 func (s *VPCService) DeletePrivateGateway(p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error) {
-	resp, err := s.cs.newRequest("deletePrivateGateway", p.toURLValues())
+	return s.DeletePrivateGatewayWithContext(context.Background(), p)
+}
+
+// DeletePrivateGatewayWithContext is the same as DeletePrivateGateway, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPCService) DeletePrivateGatewayWithContext(ctx context.Context, p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deletePrivateGateway", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -218,9 +342,9 @@ func (s *VPCService) DeletePrivateGateway(p *DeletePrivateGatewayParams) (*Delet
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deletePrivateGateway", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -233,6 +357,41 @@ func (s *VPCService) DeletePrivateGateway(p *DeletePrivateGatewayParams) (*Delet
 	return &r, nil
 }
 
+// DeletePrivateGatewayJob is a handle to a running deletePrivateGateway async job
+type DeletePrivateGatewayJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeletePrivateGatewayResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeletePrivateGatewayJob) Result() (*DeletePrivateGatewayResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeletePrivateGatewayResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeletePrivateGatewayAsync starts the deletePrivateGateway async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPCService) DeletePrivateGatewayAsync(ctx context.Context, p *DeletePrivateGatewayParams) (*DeletePrivateGatewayJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deletePrivateGateway", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeletePrivateGatewayResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeletePrivateGatewayJob{s.cs.newJobHandle("deletePrivateGateway", r.JobID)}, nil
+}
+
 type DeletePrivateGatewayResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -243,6 +402,10 @@ type ListPrivateGatewaysParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListPrivateGatewaysParams) command() string {
+	return "listPrivateGateways"
+}
+
 func (p *ListPrivateGatewaysParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -408,16 +571,14 @@ func (s *VPCService) GetPrivateGatewayByID(id string, opts ...OptionFunc) (*Priv
 
 	l, err := s.ListPrivateGateways(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -429,10 +590,17 @@ func (s *VPCService) GetPrivateGatewayByID(id string, opts ...OptionFunc) (*Priv
 
 // List private gateways
 func (s *VPCService) ListPrivateGateways(p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error) {
+	return s.ListPrivateGatewaysWithContext(context.Background(), p)
+}
+
+// ListPrivateGatewaysWithContext is the same as ListPrivateGateways, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPCService) ListPrivateGatewaysWithContext(ctx context.Context, p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error) {
 	var r ListPrivateGatewaysResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListPrivateGatewaysResponse
-		resp, err := s.cs.newRequest("listPrivateGateways", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listPrivateGateways", values)
 		if err != nil {
 			return nil, err
 		}
@@ -444,15 +612,91 @@ func (s *VPCService) ListPrivateGateways(p *ListPrivateGatewaysParams) (*ListPri
 		r.Count = l.Count
 		r.PrivateGateways = append(r.PrivateGateways, l.PrivateGateways...)
 
-		if r.Count == len(r.PrivateGateways) {
+		if r.Count == len(r.PrivateGateways) || len(l.PrivateGateways) == 0 {
+			s.cs.observePages("listPrivateGateways", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.PrivateGateways))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.PrivateGateways)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listPrivateGateways", values, len(l.PrivateGateways), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListPrivateGatewaysResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.PrivateGateways = append(r.PrivateGateways, l.PrivateGateways...)
+			}
+
+			if r.Count == len(r.PrivateGateways) {
+				s.cs.observePages("listPrivateGateways", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
 	}
 }
 
+// ListPrivateGatewaysIterator iterates over the items returned by listPrivateGateways, requesting
+// the pages of the response one at a time while iterating.
+type ListPrivateGatewaysIterator struct {
+	pager
+	items []*PrivateGateway
+	index int
+}
+
+// NewListPrivateGatewaysIterator returns an iterator over the items returned by listPrivateGateways, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *VPCService) NewListPrivateGatewaysIterator(p *ListPrivateGatewaysParams, pagesize int) *ListPrivateGatewaysIterator {
+	return &ListPrivateGatewaysIterator{pager: newPager(s.cs, "listPrivateGateways", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListPrivateGatewaysIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListPrivateGatewaysResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.PrivateGateways))
+
+	i.items = l.PrivateGateways
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListPrivateGatewaysIterator) Value() *PrivateGateway {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
+}
+
 type ListPrivateGatewaysResponse struct {
 	Count           int               `json:"count"`
 	PrivateGateways []*PrivateGateway `json:"privategateway"`
@@ -482,6 +726,10 @@ type CreateStaticRouteParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateStaticRouteParams) command() string {
+	return "createStaticRoute"
+}
+
 func (p *CreateStaticRouteParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -543,7 +791,13 @@ func (s *VPCService) NewCreateStaticRouteParams(cidr string, nexthop string, vpc
 
 // Creates a static route
 func (s *VPCService) CreateStaticRoute(p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error) {
-	resp, err := s.cs.newRequest("createStaticRoute", p.toURLValues())
+	return s.CreateStaticRouteWithContext(context.Background(), p)
+}
+
+// CreateStaticRouteWithContext is the same as CreateStaticRoute, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPCService) CreateStaticRouteWithContext(ctx context.Context, p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createStaticRoute", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -555,26 +809,56 @@ func (s *VPCService) CreateStaticRoute(p *CreateStaticRouteParams) (*CreateStati
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createStaticRoute", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "staticroute", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CreateStaticRouteJob is a handle to a running createStaticRoute async job
+type CreateStaticRouteJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateStaticRouteResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateStaticRouteJob) Result() (*CreateStaticRouteResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateStaticRouteResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "staticroute", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CreateStaticRouteAsync starts the createStaticRoute async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPCService) CreateStaticRouteAsync(ctx context.Context, p *CreateStaticRouteParams) (*CreateStaticRouteJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createStaticRoute", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateStaticRouteResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateStaticRouteJob{s.cs.newJobHandle("createStaticRoute", r.JobID)}, nil
+}
+
 type CreateStaticRouteResponse struct {
 	JobID     string `json:"jobid,omitempty"`
 	Account   string `json:"account,omitempty"`
@@ -605,6 +889,10 @@ type DeleteStaticRouteParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteStaticRouteParams) command() string {
+	return "deleteStaticRoute"
+}
+
 func (p *DeleteStaticRouteParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -634,7 +922,13 @@ func (s *VPCService) NewDeleteStaticRouteParams(id string) *DeleteStaticRoutePar
 
 // Deletes a static route
 func (s *VPCService) DeleteStaticRoute(p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error) {
-	resp, err := s.cs.newRequest("deleteStaticRoute", p.toURLValues())
+	return s.DeleteStaticRouteWithContext(context.Background(), p)
+}
+
+// DeleteStaticRouteWithContext is the same as DeleteStaticRoute, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPCService) DeleteStaticRouteWithContext(ctx context.Context, p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteStaticRoute", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -646,9 +940,9 @@ func (s *VPCService) DeleteStaticRoute(p *DeleteStaticRouteParams) (*DeleteStati
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteStaticRoute", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -661,6 +955,41 @@ func (s *VPCService) DeleteStaticRoute(p *DeleteStaticRouteParams) (*DeleteStati
 	return &r, nil
 }
 
+// DeleteStaticRouteJob is a handle to a running deleteStaticRoute async job
+type DeleteStaticRouteJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteStaticRouteResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteStaticRouteJob) Result() (*DeleteStaticRouteResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteStaticRouteResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteStaticRouteAsync starts the deleteStaticRoute async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPCService) DeleteStaticRouteAsync(ctx context.Context, p *DeleteStaticRouteParams) (*DeleteStaticRouteJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteStaticRoute", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteStaticRouteResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteStaticRouteJob{s.cs.newJobHandle("deleteStaticRoute", r.JobID)}, nil
+}
+
 type DeleteStaticRouteResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -671,6 +1000,10 @@ type ListStaticRoutesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListStaticRoutesParams) command() string {
+	return "listStaticRoutes"
+}
+
 func (p *ListStaticRoutesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -851,16 +1184,14 @@ func (s *VPCService) GetStaticRouteByID(id string, opts ...OptionFunc) (*StaticR
 
 	l, err := s.ListStaticRoutes(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -872,10 +1203,17 @@ func (s *VPCService) GetStaticRouteByID(id string, opts ...OptionFunc) (*StaticR
 
 // Lists all static routes
 func (s *VPCService) ListStaticRoutes(p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error) {
+	return s.ListStaticRoutesWithContext(context.Background(), p)
+}
+
+// ListStaticRoutesWithContext is the same as ListStaticRoutes, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPCService) ListStaticRoutesWithContext(ctx context.Context, p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error) {
 	var r ListStaticRoutesResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListStaticRoutesResponse
-		resp, err := s.cs.newRequest("listStaticRoutes", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listStaticRoutes", values)
 		if err != nil {
 			return nil, err
 		}
@@ -887,13 +1225,89 @@ func (s *VPCService) ListStaticRoutes(p *ListStaticRoutesParams) (*ListStaticRou
 		r.Count = l.Count
 		r.StaticRoutes = append(r.StaticRoutes, l.StaticRoutes...)
 
-		if r.Count == len(r.StaticRoutes) {
+		if r.Count == len(r.StaticRoutes) || len(l.StaticRoutes) == 0 {
+			s.cs.observePages("listStaticRoutes", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.StaticRoutes))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.StaticRoutes)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listStaticRoutes", values, len(l.StaticRoutes), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListStaticRoutesResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.StaticRoutes = append(r.StaticRoutes, l.StaticRoutes...)
+			}
+
+			if r.Count == len(r.StaticRoutes) {
+				s.cs.observePages("listStaticRoutes", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListStaticRoutesIterator iterates over the items returned by listStaticRoutes, requesting
+// the pages of the response one at a time while iterating.
+type ListStaticRoutesIterator struct {
+	pager
+	items []*StaticRoute
+	index int
+}
+
+// NewListStaticRoutesIterator returns an iterator over the items returned by listStaticRoutes, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *VPCService) NewListStaticRoutesIterator(p *ListStaticRoutesParams, pagesize int) *ListStaticRoutesIterator {
+	return &ListStaticRoutesIterator{pager: newPager(s.cs, "listStaticRoutes", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListStaticRoutesIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
 	}
+
+	var l ListStaticRoutesResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.StaticRoutes))
+
+	i.items = l.StaticRoutes
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListStaticRoutesIterator) Value() *StaticRoute {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
 }
 
 type ListStaticRoutesResponse struct {
@@ -930,6 +1344,10 @@ type CreateVPCParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateVPCParams) command() string {
+	return "createVPC"
+}
+
 func (p *CreateVPCParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1106,7 +1524,13 @@ func (s *VPCService) NewCreateVPCParams(cidr string, displaytext string, name st
 
 // Creates a VPC
 func (s *VPCService) CreateVPC(p *CreateVPCParams) (*CreateVPCResponse, error) {
-	resp, err := s.cs.newRequest("createVPC", p.toURLValues())
+	return s.CreateVPCWithContext(context.Background(), p)
+}
+
+// CreateVPCWithContext is the same as CreateVPC, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPCService) CreateVPCWithContext(ctx context.Context, p *CreateVPCParams) (*CreateVPCResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createVPC", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1118,26 +1542,56 @@ func (s *VPCService) CreateVPC(p *CreateVPCParams) (*CreateVPCResponse, error) {
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createVPC", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "vpc", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CreateVPCJob is a handle to a running createVPC async job
+type CreateVPCJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateVPCResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateVPCJob) Result() (*CreateVPCResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateVPCResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "vpc", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CreateVPCAsync starts the createVPC async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPCService) CreateVPCAsync(ctx context.Context, p *CreateVPCParams) (*CreateVPCJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createVPC", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateVPCResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateVPCJob{s.cs.newJobHandle("createVPC", r.JobID)}, nil
+}
+
 type CreateVPCResponse struct {
 	JobID          string `json:"jobid,omitempty"`
 	Account        string `json:"account,omitempty"`
@@ -1281,6 +1735,10 @@ type DeleteVPCParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteVPCParams) command() string {
+	return "deleteVPC"
+}
+
 func (p *DeleteVPCParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1310,7 +1768,13 @@ func (s *VPCService) NewDeleteVPCParams(id string) *DeleteVPCParams {
 
 // Deletes a VPC
 func (s *VPCService) DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error) {
-	resp, err := s.cs.newRequest("deleteVPC", p.toURLValues())
+	return s.DeleteVPCWithContext(context.Background(), p)
+}
+
+// DeleteVPCWithContext is the same as DeleteVPC, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPCService) DeleteVPCWithContext(ctx context.Context, p *DeleteVPCParams) (*DeleteVPCResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteVPC", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1322,9 +1786,9 @@ func (s *VPCService) DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error) {
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteVPC", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -1337,6 +1801,41 @@ func (s *VPCService) DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error) {
 	return &r, nil
 }
 
+// DeleteVPCJob is a handle to a running deleteVPC async job
+type DeleteVPCJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteVPCResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteVPCJob) Result() (*DeleteVPCResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteVPCResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteVPCAsync starts the deleteVPC async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPCService) DeleteVPCAsync(ctx context.Context, p *DeleteVPCParams) (*DeleteVPCJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteVPC", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteVPCResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteVPCJob{s.cs.newJobHandle("deleteVPC", r.JobID)}, nil
+}
+
 type DeleteVPCResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -1347,6 +1846,10 @@ type RestartVPCParams struct {
 	p map[string]interface{}
 }
 
+func (p *RestartVPCParams) command() string {
+	return "restartVPC"
+}
+
 func (p *RestartVPCParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1387,7 +1890,13 @@ func (s *VPCService) NewRestartVPCParams(id string) *RestartVPCParams {
 
 // Restarts a VPC
 func (s *VPCService) RestartVPC(p *RestartVPCParams) (*RestartVPCResponse, error) {
-	resp, err := s.cs.newRequest("restartVPC", p.toURLValues())
+	return s.RestartVPCWithContext(context.Background(), p)
+}
+
+// RestartVPCWithContext is the same as RestartVPC, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPCService) RestartVPCWithContext(ctx context.Context, p *RestartVPCParams) (*RestartVPCResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "restartVPC", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1399,26 +1908,56 @@ func (s *VPCService) RestartVPC(p *RestartVPCParams) (*RestartVPCResponse, error
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "restartVPC", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "vpc", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// RestartVPCJob is a handle to a running restartVPC async job
+type RestartVPCJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a RestartVPCResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *RestartVPCJob) Result() (*RestartVPCResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := RestartVPCResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "vpc", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// RestartVPCAsync starts the restartVPC async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPCService) RestartVPCAsync(ctx context.Context, p *RestartVPCParams) (*RestartVPCJob, error) {
+	resp, err := s.cs.newRequest(ctx, "restartVPC", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RestartVPCResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &RestartVPCJob{s.cs.newJobHandle("restartVPC", r.JobID)}, nil
+}
+
 type RestartVPCResponse struct {
 	JobID          string `json:"jobid,omitempty"`
 	Account        string `json:"account,omitempty"`
@@ -1562,6 +2101,10 @@ type UpdateVPCParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateVPCParams) command() string {
+	return "updateVPC"
+}
+
 func (p *UpdateVPCParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1683,7 +2226,13 @@ func (s *VPCService) NewUpdateVPCParams(id string) *UpdateVPCParams {
 
 // Updates a VPC
 func (s *VPCService) UpdateVPC(p *UpdateVPCParams) (*UpdateVPCResponse, error) {
-	resp, err := s.cs.newRequest("updateVPC", p.toURLValues())
+	return s.UpdateVPCWithContext(context.Background(), p)
+}
+
+// UpdateVPCWithContext is the same as UpdateVPC, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPCService) UpdateVPCWithContext(ctx context.Context, p *UpdateVPCParams) (*UpdateVPCResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateVPC", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1695,26 +2244,56 @@ func (s *VPCService) UpdateVPC(p *UpdateVPCParams) (*UpdateVPCResponse, error) {
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateVPC", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "vpc", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// UpdateVPCJob is a handle to a running updateVPC async job
+type UpdateVPCJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateVPCResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateVPCJob) Result() (*UpdateVPCResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateVPCResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "vpc", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// UpdateVPCAsync starts the updateVPC async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPCService) UpdateVPCAsync(ctx context.Context, p *UpdateVPCParams) (*UpdateVPCJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateVPC", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateVPCResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateVPCJob{s.cs.newJobHandle("updateVPC", r.JobID)}, nil
+}
+
 type UpdateVPCResponse struct {
 	JobID          string `json:"jobid,omitempty"`
 	Account        string `json:"account,omitempty"`
@@ -1858,6 +2437,10 @@ type CreateVPCOfferingParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateVPCOfferingParams) command() string {
+	return "createVPCOffering"
+}
+
 func (p *CreateVPCOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1960,7 +2543,13 @@ func (s *VPCService) NewCreateVPCOfferingParams(displaytext string, name string,
 
 // Creates VPC offering
 func (s *VPCService) CreateVPCOffering(p *CreateVPCOfferingParams) (*CreateVPCOfferingResponse, error) {
-	resp, err := s.cs.newRequest("createVPCOffering", p.toURLValues())
+	return s.CreateVPCOfferingWithContext(context.Background(), p)
+}
+
+// CreateVPCOfferingWithContext is the same as CreateVPCOffering, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPCService) CreateVPCOfferingWithContext(ctx context.Context, p *CreateVPCOfferingParams) (*CreateVPCOfferingResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createVPCOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1972,26 +2561,56 @@ func (s *VPCService) CreateVPCOffering(p *CreateVPCOfferingParams) (*CreateVPCOf
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createVPCOffering", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "vpcoffering", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CreateVPCOfferingJob is a handle to a running createVPCOffering async job
+type CreateVPCOfferingJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateVPCOfferingResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateVPCOfferingJob) Result() (*CreateVPCOfferingResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateVPCOfferingResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "vpcoffering", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CreateVPCOfferingAsync starts the createVPCOffering async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPCService) CreateVPCOfferingAsync(ctx context.Context, p *CreateVPCOfferingParams) (*CreateVPCOfferingJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createVPCOffering", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateVPCOfferingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateVPCOfferingJob{s.cs.newJobHandle("createVPCOffering", r.JobID)}, nil
+}
+
 type CreateVPCOfferingResponse struct {
 	JobID                        string `json:"jobid,omitempty"`
 	Created                      string `json:"created,omitempty"`
@@ -2027,6 +2646,10 @@ type DeleteVPCOfferingParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteVPCOfferingParams) command() string {
+	return "deleteVPCOffering"
+}
+
 func (p *DeleteVPCOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2056,7 +2679,13 @@ func (s *VPCService) NewDeleteVPCOfferingParams(id string) *DeleteVPCOfferingPar
 
 // Deletes VPC offering
 func (s *VPCService) DeleteVPCOffering(p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error) {
-	resp, err := s.cs.newRequest("deleteVPCOffering", p.toURLValues())
+	return s.DeleteVPCOfferingWithContext(context.Background(), p)
+}
+
+// DeleteVPCOfferingWithContext is the same as DeleteVPCOffering, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPCService) DeleteVPCOfferingWithContext(ctx context.Context, p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteVPCOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2068,9 +2697,9 @@ func (s *VPCService) DeleteVPCOffering(p *DeleteVPCOfferingParams) (*DeleteVPCOf
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteVPCOffering", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -2083,6 +2712,41 @@ func (s *VPCService) DeleteVPCOffering(p *DeleteVPCOfferingParams) (*DeleteVPCOf
 	return &r, nil
 }
 
+// DeleteVPCOfferingJob is a handle to a running deleteVPCOffering async job
+type DeleteVPCOfferingJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteVPCOfferingResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteVPCOfferingJob) Result() (*DeleteVPCOfferingResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteVPCOfferingResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteVPCOfferingAsync starts the deleteVPCOffering async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPCService) DeleteVPCOfferingAsync(ctx context.Context, p *DeleteVPCOfferingParams) (*DeleteVPCOfferingJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteVPCOffering", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteVPCOfferingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteVPCOfferingJob{s.cs.newJobHandle("deleteVPCOffering", r.JobID)}, nil
+}
+
 type DeleteVPCOfferingResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -2093,6 +2757,10 @@ type UpdateVPCOfferingParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateVPCOfferingParams) command() string {
+	return "updateVPCOffering"
+}
+
 func (p *UpdateVPCOfferingParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2152,7 +2820,13 @@ func (s *VPCService) NewUpdateVPCOfferingParams(id string) *UpdateVPCOfferingPar
 
 // Updates VPC offering
 func (s *VPCService) UpdateVPCOffering(p *UpdateVPCOfferingParams) (*UpdateVPCOfferingResponse, error) {
-	resp, err := s.cs.newRequest("updateVPCOffering", p.toURLValues())
+	return s.UpdateVPCOfferingWithContext(context.Background(), p)
+}
+
+// UpdateVPCOfferingWithContext is the same as UpdateVPCOffering, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPCService) UpdateVPCOfferingWithContext(ctx context.Context, p *UpdateVPCOfferingParams) (*UpdateVPCOfferingResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateVPCOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2164,26 +2838,56 @@ func (s *VPCService) UpdateVPCOffering(p *UpdateVPCOfferingParams) (*UpdateVPCOf
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateVPCOffering", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "vpcoffering", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// UpdateVPCOfferingJob is a handle to a running updateVPCOffering async job
+type UpdateVPCOfferingJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateVPCOfferingResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateVPCOfferingJob) Result() (*UpdateVPCOfferingResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateVPCOfferingResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "vpcoffering", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// UpdateVPCOfferingAsync starts the updateVPCOffering async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPCService) UpdateVPCOfferingAsync(ctx context.Context, p *UpdateVPCOfferingParams) (*UpdateVPCOfferingJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateVPCOffering", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateVPCOfferingResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateVPCOfferingJob{s.cs.newJobHandle("updateVPCOffering", r.JobID)}, nil
+}
+
 type UpdateVPCOfferingResponse struct {
 	JobID                        string `json:"jobid,omitempty"`
 	Created                      string `json:"created,omitempty"`
@@ -2219,6 +2923,10 @@ type ListVPCOfferingsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListVPCOfferingsParams) command() string {
+	return "listVPCOfferings"
+}
+
 func (p *ListVPCOfferingsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2348,7 +3056,7 @@ func (s *VPCService) GetVPCOfferingID(name string, opts ...OptionFunc) (string,
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -2362,7 +3070,7 @@ func (s *VPCService) GetVPCOfferingID(name string, opts ...OptionFunc) (string,
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -2394,16 +3102,14 @@ func (s *VPCService) GetVPCOfferingByID(id string, opts ...OptionFunc) (*VPCOffe
 
 	l, err := s.ListVPCOfferings(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -2415,10 +3121,17 @@ func (s *VPCService) GetVPCOfferingByID(id string, opts ...OptionFunc) (*VPCOffe
 
 // Lists VPC offerings
 func (s *VPCService) ListVPCOfferings(p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error) {
+	return s.ListVPCOfferingsWithContext(context.Background(), p)
+}
+
+// ListVPCOfferingsWithContext is the same as ListVPCOfferings, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPCService) ListVPCOfferingsWithContext(ctx context.Context, p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error) {
 	var r ListVPCOfferingsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListVPCOfferingsResponse
-		resp, err := s.cs.newRequest("listVPCOfferings", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listVPCOfferings", values)
 		if err != nil {
 			return nil, err
 		}
@@ -2430,13 +3143,89 @@ func (s *VPCService) ListVPCOfferings(p *ListVPCOfferingsParams) (*ListVPCOfferi
 		r.Count = l.Count
 		r.VPCOfferings = append(r.VPCOfferings, l.VPCOfferings...)
 
-		if r.Count == len(r.VPCOfferings) {
+		if r.Count == len(r.VPCOfferings) || len(l.VPCOfferings) == 0 {
+			s.cs.observePages("listVPCOfferings", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.VPCOfferings))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.VPCOfferings)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listVPCOfferings", values, len(l.VPCOfferings), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListVPCOfferingsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.VPCOfferings = append(r.VPCOfferings, l.VPCOfferings...)
+			}
+
+			if r.Count == len(r.VPCOfferings) {
+				s.cs.observePages("listVPCOfferings", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListVPCOfferingsIterator iterates over the items returned by listVPCOfferings, requesting
+// the pages of the response one at a time while iterating.
+type ListVPCOfferingsIterator struct {
+	pager
+	items []*VPCOffering
+	index int
+}
+
+// NewListVPCOfferingsIterator returns an iterator over the items returned by listVPCOfferings, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *VPCService) NewListVPCOfferingsIterator(p *ListVPCOfferingsParams, pagesize int) *ListVPCOfferingsIterator {
+	return &ListVPCOfferingsIterator{pager: newPager(s.cs, "listVPCOfferings", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListVPCOfferingsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
 	}
+
+	var l ListVPCOfferingsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.VPCOfferings))
+
+	i.items = l.VPCOfferings
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListVPCOfferingsIterator) Value() *VPCOffering {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
 }
 
 type ListVPCOfferingsResponse struct {
@@ -2478,6 +3267,10 @@ type ListVPCsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListVPCsParams) command() string {
+	return "listVPCs"
+}
+
 func (p *ListVPCsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2715,7 +3508,7 @@ func (s *VPCService) GetVPCID(name string, opts ...OptionFunc) (string, int, err
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -2729,7 +3522,7 @@ func (s *VPCService) GetVPCID(name string, opts ...OptionFunc) (string, int, err
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -2761,16 +3554,14 @@ func (s *VPCService) GetVPCByID(id string, opts ...OptionFunc) (*VPC, int, error
 
 	l, err := s.ListVPCs(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -2782,10 +3573,17 @@ func (s *VPCService) GetVPCByID(id string, opts ...OptionFunc) (*VPC, int, error
 
 // Lists VPCs
 func (s *VPCService) ListVPCs(p *ListVPCsParams) (*ListVPCsResponse, error) {
+	return s.ListVPCsWithContext(context.Background(), p)
+}
+
+// ListVPCsWithContext is the same as ListVPCs, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPCService) ListVPCsWithContext(ctx context.Context, p *ListVPCsParams) (*ListVPCsResponse, error) {
 	var r ListVPCsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListVPCsResponse
-		resp, err := s.cs.newRequest("listVPCs", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listVPCs", values)
 		if err != nil {
 			return nil, err
 		}
@@ -2797,13 +3595,89 @@ func (s *VPCService) ListVPCs(p *ListVPCsParams) (*ListVPCsResponse, error) {
 		r.Count = l.Count
 		r.VPCs = append(r.VPCs, l.VPCs...)
 
-		if r.Count == len(r.VPCs) {
+		if r.Count == len(r.VPCs) || len(l.VPCs) == 0 {
+			s.cs.observePages("listVPCs", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.VPCs))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.VPCs)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listVPCs", values, len(l.VPCs), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListVPCsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.VPCs = append(r.VPCs, l.VPCs...)
+			}
+
+			if r.Count == len(r.VPCs) {
+				s.cs.observePages("listVPCs", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListVPCsIterator iterates over the items returned by listVPCs, requesting
+// the pages of the response one at a time while iterating.
+type ListVPCsIterator struct {
+	pager
+	items []*VPC
+	index int
+}
+
+// NewListVPCsIterator returns an iterator over the items returned by listVPCs, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *VPCService) NewListVPCsIterator(p *ListVPCsParams, pagesize int) *ListVPCsIterator {
+	return &ListVPCsIterator{pager: newPager(s.cs, "listVPCs", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListVPCsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListVPCsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.VPCs))
+
+	i.items = l.VPCs
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListVPCsIterator) Value() *VPC {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListVPCsResponse struct {
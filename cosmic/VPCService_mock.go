@@ -0,0 +1,511 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockVPCService is a mock of VPCServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockVPCService struct {
+	mockCalls
+
+	CreatePrivateGatewayFunc      func(ctx context.Context, p *CreatePrivateGatewayParams) (*CreatePrivateGatewayResponse, error)
+	CreatePrivateGatewayAsyncFunc func(ctx context.Context, p *CreatePrivateGatewayParams) (*CreatePrivateGatewayJob, error)
+	DeletePrivateGatewayFunc      func(ctx context.Context, p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error)
+	DeletePrivateGatewayAsyncFunc func(ctx context.Context, p *DeletePrivateGatewayParams) (*DeletePrivateGatewayJob, error)
+	GetPrivateGatewayByIDFunc     func(id string, opts ...OptionFunc) (*PrivateGateway, int, error)
+	ListPrivateGatewaysFunc       func(ctx context.Context, p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error)
+	CreateStaticRouteFunc         func(ctx context.Context, p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error)
+	CreateStaticRouteAsyncFunc    func(ctx context.Context, p *CreateStaticRouteParams) (*CreateStaticRouteJob, error)
+	DeleteStaticRouteFunc         func(ctx context.Context, p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error)
+	DeleteStaticRouteAsyncFunc    func(ctx context.Context, p *DeleteStaticRouteParams) (*DeleteStaticRouteJob, error)
+	GetStaticRouteByIDFunc        func(id string, opts ...OptionFunc) (*StaticRoute, int, error)
+	ListStaticRoutesFunc          func(ctx context.Context, p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error)
+	CreateVPCFunc                 func(ctx context.Context, p *CreateVPCParams) (*CreateVPCResponse, error)
+	CreateVPCAsyncFunc            func(ctx context.Context, p *CreateVPCParams) (*CreateVPCJob, error)
+	DeleteVPCFunc                 func(ctx context.Context, p *DeleteVPCParams) (*DeleteVPCResponse, error)
+	DeleteVPCAsyncFunc            func(ctx context.Context, p *DeleteVPCParams) (*DeleteVPCJob, error)
+	RestartVPCFunc                func(ctx context.Context, p *RestartVPCParams) (*RestartVPCResponse, error)
+	RestartVPCAsyncFunc           func(ctx context.Context, p *RestartVPCParams) (*RestartVPCJob, error)
+	UpdateVPCFunc                 func(ctx context.Context, p *UpdateVPCParams) (*UpdateVPCResponse, error)
+	UpdateVPCAsyncFunc            func(ctx context.Context, p *UpdateVPCParams) (*UpdateVPCJob, error)
+	CreateVPCOfferingFunc         func(ctx context.Context, p *CreateVPCOfferingParams) (*CreateVPCOfferingResponse, error)
+	CreateVPCOfferingAsyncFunc    func(ctx context.Context, p *CreateVPCOfferingParams) (*CreateVPCOfferingJob, error)
+	DeleteVPCOfferingFunc         func(ctx context.Context, p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error)
+	DeleteVPCOfferingAsyncFunc    func(ctx context.Context, p *DeleteVPCOfferingParams) (*DeleteVPCOfferingJob, error)
+	UpdateVPCOfferingFunc         func(ctx context.Context, p *UpdateVPCOfferingParams) (*UpdateVPCOfferingResponse, error)
+	UpdateVPCOfferingAsyncFunc    func(ctx context.Context, p *UpdateVPCOfferingParams) (*UpdateVPCOfferingJob, error)
+	GetVPCOfferingIDFunc          func(name string, opts ...OptionFunc) (string, int, error)
+	GetVPCOfferingByNameFunc      func(name string, opts ...OptionFunc) (*VPCOffering, int, error)
+	GetVPCOfferingByIDFunc        func(id string, opts ...OptionFunc) (*VPCOffering, int, error)
+	ListVPCOfferingsFunc          func(ctx context.Context, p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error)
+	GetVPCIDFunc                  func(name string, opts ...OptionFunc) (string, int, error)
+	GetVPCByNameFunc              func(name string, opts ...OptionFunc) (*VPC, int, error)
+	GetVPCByIDFunc                func(id string, opts ...OptionFunc) (*VPC, int, error)
+	ListVPCsFunc                  func(ctx context.Context, p *ListVPCsParams) (*ListVPCsResponse, error)
+}
+
+var _ VPCServiceIface = &MockVPCService{}
+
+func (m *MockVPCService) NewCreatePrivateGatewayParams(ipaddress string, networkid string, vpcid string) *CreatePrivateGatewayParams {
+	return (&VPCService{}).NewCreatePrivateGatewayParams(ipaddress, networkid, vpcid)
+}
+
+func (m *MockVPCService) CreatePrivateGateway(p *CreatePrivateGatewayParams) (*CreatePrivateGatewayResponse, error) {
+	return m.CreatePrivateGatewayWithContext(context.Background(), p)
+}
+
+func (m *MockVPCService) CreatePrivateGatewayWithContext(ctx context.Context, p *CreatePrivateGatewayParams) (*CreatePrivateGatewayResponse, error) {
+	m.record("CreatePrivateGateway", p)
+	if m.CreatePrivateGatewayFunc == nil {
+		return nil, notMocked("MockVPCService", "CreatePrivateGateway")
+	}
+	return m.CreatePrivateGatewayFunc(ctx, p)
+}
+
+func (m *MockVPCService) CreatePrivateGatewayAsync(ctx context.Context, p *CreatePrivateGatewayParams) (*CreatePrivateGatewayJob, error) {
+	m.record("CreatePrivateGatewayAsync", p)
+	if m.CreatePrivateGatewayAsyncFunc == nil {
+		return nil, notMocked("MockVPCService", "CreatePrivateGatewayAsync")
+	}
+	return m.CreatePrivateGatewayAsyncFunc(ctx, p)
+}
+
+func (m *MockVPCService) NewDeletePrivateGatewayParams(id string) *DeletePrivateGatewayParams {
+	return (&VPCService{}).NewDeletePrivateGatewayParams(id)
+}
+
+func (m *MockVPCService) DeletePrivateGateway(p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error) {
+	return m.DeletePrivateGatewayWithContext(context.Background(), p)
+}
+
+func (m *MockVPCService) DeletePrivateGatewayWithContext(ctx context.Context, p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error) {
+	m.record("DeletePrivateGateway", p)
+	if m.DeletePrivateGatewayFunc == nil {
+		return nil, notMocked("MockVPCService", "DeletePrivateGateway")
+	}
+	return m.DeletePrivateGatewayFunc(ctx, p)
+}
+
+func (m *MockVPCService) DeletePrivateGatewayAsync(ctx context.Context, p *DeletePrivateGatewayParams) (*DeletePrivateGatewayJob, error) {
+	m.record("DeletePrivateGatewayAsync", p)
+	if m.DeletePrivateGatewayAsyncFunc == nil {
+		return nil, notMocked("MockVPCService", "DeletePrivateGatewayAsync")
+	}
+	return m.DeletePrivateGatewayAsyncFunc(ctx, p)
+}
+
+func (m *MockVPCService) NewListPrivateGatewaysParams() *ListPrivateGatewaysParams {
+	return (&VPCService{}).NewListPrivateGatewaysParams()
+}
+
+func (m *MockVPCService) GetPrivateGatewayByID(id string, opts ...OptionFunc) (*PrivateGateway, int, error) {
+	m.record("GetPrivateGatewayByID", id, opts)
+	if m.GetPrivateGatewayByIDFunc == nil {
+		return nil, -1, notMocked("MockVPCService", "GetPrivateGatewayByID")
+	}
+	return m.GetPrivateGatewayByIDFunc(id, opts...)
+}
+
+func (m *MockVPCService) ListPrivateGateways(p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error) {
+	return m.ListPrivateGatewaysWithContext(context.Background(), p)
+}
+
+func (m *MockVPCService) ListPrivateGatewaysWithContext(ctx context.Context, p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error) {
+	m.record("ListPrivateGateways", p)
+	if m.ListPrivateGatewaysFunc == nil {
+		return nil, notMocked("MockVPCService", "ListPrivateGateways")
+	}
+	return m.ListPrivateGatewaysFunc(ctx, p)
+}
+
+func (m *MockVPCService) NewListPrivateGatewaysIterator(p *ListPrivateGatewaysParams, pagesize int) *ListPrivateGatewaysIterator {
+	i := &ListPrivateGatewaysIterator{pager: newPager(nil, "listPrivateGateways", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListPrivateGatewaysParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListPrivateGatewaysWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockVPCService) NewCreateStaticRouteParams(cidr string, nexthop string, vpcid string) *CreateStaticRouteParams {
+	return (&VPCService{}).NewCreateStaticRouteParams(cidr, nexthop, vpcid)
+}
+
+func (m *MockVPCService) CreateStaticRoute(p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error) {
+	return m.CreateStaticRouteWithContext(context.Background(), p)
+}
+
+func (m *MockVPCService) CreateStaticRouteWithContext(ctx context.Context, p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error) {
+	m.record("CreateStaticRoute", p)
+	if m.CreateStaticRouteFunc == nil {
+		return nil, notMocked("MockVPCService", "CreateStaticRoute")
+	}
+	return m.CreateStaticRouteFunc(ctx, p)
+}
+
+func (m *MockVPCService) CreateStaticRouteAsync(ctx context.Context, p *CreateStaticRouteParams) (*CreateStaticRouteJob, error) {
+	m.record("CreateStaticRouteAsync", p)
+	if m.CreateStaticRouteAsyncFunc == nil {
+		return nil, notMocked("MockVPCService", "CreateStaticRouteAsync")
+	}
+	return m.CreateStaticRouteAsyncFunc(ctx, p)
+}
+
+func (m *MockVPCService) NewDeleteStaticRouteParams(id string) *DeleteStaticRouteParams {
+	return (&VPCService{}).NewDeleteStaticRouteParams(id)
+}
+
+func (m *MockVPCService) DeleteStaticRoute(p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error) {
+	return m.DeleteStaticRouteWithContext(context.Background(), p)
+}
+
+func (m *MockVPCService) DeleteStaticRouteWithContext(ctx context.Context, p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error) {
+	m.record("DeleteStaticRoute", p)
+	if m.DeleteStaticRouteFunc == nil {
+		return nil, notMocked("MockVPCService", "DeleteStaticRoute")
+	}
+	return m.DeleteStaticRouteFunc(ctx, p)
+}
+
+func (m *MockVPCService) DeleteStaticRouteAsync(ctx context.Context, p *DeleteStaticRouteParams) (*DeleteStaticRouteJob, error) {
+	m.record("DeleteStaticRouteAsync", p)
+	if m.DeleteStaticRouteAsyncFunc == nil {
+		return nil, notMocked("MockVPCService", "DeleteStaticRouteAsync")
+	}
+	return m.DeleteStaticRouteAsyncFunc(ctx, p)
+}
+
+func (m *MockVPCService) NewListStaticRoutesParams() *ListStaticRoutesParams {
+	return (&VPCService{}).NewListStaticRoutesParams()
+}
+
+func (m *MockVPCService) GetStaticRouteByID(id string, opts ...OptionFunc) (*StaticRoute, int, error) {
+	m.record("GetStaticRouteByID", id, opts)
+	if m.GetStaticRouteByIDFunc == nil {
+		return nil, -1, notMocked("MockVPCService", "GetStaticRouteByID")
+	}
+	return m.GetStaticRouteByIDFunc(id, opts...)
+}
+
+func (m *MockVPCService) ListStaticRoutes(p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error) {
+	return m.ListStaticRoutesWithContext(context.Background(), p)
+}
+
+func (m *MockVPCService) ListStaticRoutesWithContext(ctx context.Context, p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error) {
+	m.record("ListStaticRoutes", p)
+	if m.ListStaticRoutesFunc == nil {
+		return nil, notMocked("MockVPCService", "ListStaticRoutes")
+	}
+	return m.ListStaticRoutesFunc(ctx, p)
+}
+
+func (m *MockVPCService) NewListStaticRoutesIterator(p *ListStaticRoutesParams, pagesize int) *ListStaticRoutesIterator {
+	i := &ListStaticRoutesIterator{pager: newPager(nil, "listStaticRoutes", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListStaticRoutesParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListStaticRoutesWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockVPCService) NewCreateVPCParams(cidr string, displaytext string, name string, vpcofferingid string, zoneid string) *CreateVPCParams {
+	return (&VPCService{}).NewCreateVPCParams(cidr, displaytext, name, vpcofferingid, zoneid)
+}
+
+func (m *MockVPCService) CreateVPC(p *CreateVPCParams) (*CreateVPCResponse, error) {
+	return m.CreateVPCWithContext(context.Background(), p)
+}
+
+func (m *MockVPCService) CreateVPCWithContext(ctx context.Context, p *CreateVPCParams) (*CreateVPCResponse, error) {
+	m.record("CreateVPC", p)
+	if m.CreateVPCFunc == nil {
+		return nil, notMocked("MockVPCService", "CreateVPC")
+	}
+	return m.CreateVPCFunc(ctx, p)
+}
+
+func (m *MockVPCService) CreateVPCAsync(ctx context.Context, p *CreateVPCParams) (*CreateVPCJob, error) {
+	m.record("CreateVPCAsync", p)
+	if m.CreateVPCAsyncFunc == nil {
+		return nil, notMocked("MockVPCService", "CreateVPCAsync")
+	}
+	return m.CreateVPCAsyncFunc(ctx, p)
+}
+
+func (m *MockVPCService) NewDeleteVPCParams(id string) *DeleteVPCParams {
+	return (&VPCService{}).NewDeleteVPCParams(id)
+}
+
+func (m *MockVPCService) DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error) {
+	return m.DeleteVPCWithContext(context.Background(), p)
+}
+
+func (m *MockVPCService) DeleteVPCWithContext(ctx context.Context, p *DeleteVPCParams) (*DeleteVPCResponse, error) {
+	m.record("DeleteVPC", p)
+	if m.DeleteVPCFunc == nil {
+		return nil, notMocked("MockVPCService", "DeleteVPC")
+	}
+	return m.DeleteVPCFunc(ctx, p)
+}
+
+func (m *MockVPCService) DeleteVPCAsync(ctx context.Context, p *DeleteVPCParams) (*DeleteVPCJob, error) {
+	m.record("DeleteVPCAsync", p)
+	if m.DeleteVPCAsyncFunc == nil {
+		return nil, notMocked("MockVPCService", "DeleteVPCAsync")
+	}
+	return m.DeleteVPCAsyncFunc(ctx, p)
+}
+
+func (m *MockVPCService) NewRestartVPCParams(id string) *RestartVPCParams {
+	return (&VPCService{}).NewRestartVPCParams(id)
+}
+
+func (m *MockVPCService) RestartVPC(p *RestartVPCParams) (*RestartVPCResponse, error) {
+	return m.RestartVPCWithContext(context.Background(), p)
+}
+
+func (m *MockVPCService) RestartVPCWithContext(ctx context.Context, p *RestartVPCParams) (*RestartVPCResponse, error) {
+	m.record("RestartVPC", p)
+	if m.RestartVPCFunc == nil {
+		return nil, notMocked("MockVPCService", "RestartVPC")
+	}
+	return m.RestartVPCFunc(ctx, p)
+}
+
+func (m *MockVPCService) RestartVPCAsync(ctx context.Context, p *RestartVPCParams) (*RestartVPCJob, error) {
+	m.record("RestartVPCAsync", p)
+	if m.RestartVPCAsyncFunc == nil {
+		return nil, notMocked("MockVPCService", "RestartVPCAsync")
+	}
+	return m.RestartVPCAsyncFunc(ctx, p)
+}
+
+func (m *MockVPCService) NewUpdateVPCParams(id string) *UpdateVPCParams {
+	return (&VPCService{}).NewUpdateVPCParams(id)
+}
+
+func (m *MockVPCService) UpdateVPC(p *UpdateVPCParams) (*UpdateVPCResponse, error) {
+	return m.UpdateVPCWithContext(context.Background(), p)
+}
+
+func (m *MockVPCService) UpdateVPCWithContext(ctx context.Context, p *UpdateVPCParams) (*UpdateVPCResponse, error) {
+	m.record("UpdateVPC", p)
+	if m.UpdateVPCFunc == nil {
+		return nil, notMocked("MockVPCService", "UpdateVPC")
+	}
+	return m.UpdateVPCFunc(ctx, p)
+}
+
+func (m *MockVPCService) UpdateVPCAsync(ctx context.Context, p *UpdateVPCParams) (*UpdateVPCJob, error) {
+	m.record("UpdateVPCAsync", p)
+	if m.UpdateVPCAsyncFunc == nil {
+		return nil, notMocked("MockVPCService", "UpdateVPCAsync")
+	}
+	return m.UpdateVPCAsyncFunc(ctx, p)
+}
+
+func (m *MockVPCService) NewCreateVPCOfferingParams(displaytext string, name string, supportedservices []string) *CreateVPCOfferingParams {
+	return (&VPCService{}).NewCreateVPCOfferingParams(displaytext, name, supportedservices)
+}
+
+func (m *MockVPCService) CreateVPCOffering(p *CreateVPCOfferingParams) (*CreateVPCOfferingResponse, error) {
+	return m.CreateVPCOfferingWithContext(context.Background(), p)
+}
+
+func (m *MockVPCService) CreateVPCOfferingWithContext(ctx context.Context, p *CreateVPCOfferingParams) (*CreateVPCOfferingResponse, error) {
+	m.record("CreateVPCOffering", p)
+	if m.CreateVPCOfferingFunc == nil {
+		return nil, notMocked("MockVPCService", "CreateVPCOffering")
+	}
+	return m.CreateVPCOfferingFunc(ctx, p)
+}
+
+func (m *MockVPCService) CreateVPCOfferingAsync(ctx context.Context, p *CreateVPCOfferingParams) (*CreateVPCOfferingJob, error) {
+	m.record("CreateVPCOfferingAsync", p)
+	if m.CreateVPCOfferingAsyncFunc == nil {
+		return nil, notMocked("MockVPCService", "CreateVPCOfferingAsync")
+	}
+	return m.CreateVPCOfferingAsyncFunc(ctx, p)
+}
+
+func (m *MockVPCService) NewDeleteVPCOfferingParams(id string) *DeleteVPCOfferingParams {
+	return (&VPCService{}).NewDeleteVPCOfferingParams(id)
+}
+
+func (m *MockVPCService) DeleteVPCOffering(p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error) {
+	return m.DeleteVPCOfferingWithContext(context.Background(), p)
+}
+
+func (m *MockVPCService) DeleteVPCOfferingWithContext(ctx context.Context, p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error) {
+	m.record("DeleteVPCOffering", p)
+	if m.DeleteVPCOfferingFunc == nil {
+		return nil, notMocked("MockVPCService", "DeleteVPCOffering")
+	}
+	return m.DeleteVPCOfferingFunc(ctx, p)
+}
+
+func (m *MockVPCService) DeleteVPCOfferingAsync(ctx context.Context, p *DeleteVPCOfferingParams) (*DeleteVPCOfferingJob, error) {
+	m.record("DeleteVPCOfferingAsync", p)
+	if m.DeleteVPCOfferingAsyncFunc == nil {
+		return nil, notMocked("MockVPCService", "DeleteVPCOfferingAsync")
+	}
+	return m.DeleteVPCOfferingAsyncFunc(ctx, p)
+}
+
+func (m *MockVPCService) NewUpdateVPCOfferingParams(id string) *UpdateVPCOfferingParams {
+	return (&VPCService{}).NewUpdateVPCOfferingParams(id)
+}
+
+func (m *MockVPCService) UpdateVPCOffering(p *UpdateVPCOfferingParams) (*UpdateVPCOfferingResponse, error) {
+	return m.UpdateVPCOfferingWithContext(context.Background(), p)
+}
+
+func (m *MockVPCService) UpdateVPCOfferingWithContext(ctx context.Context, p *UpdateVPCOfferingParams) (*UpdateVPCOfferingResponse, error) {
+	m.record("UpdateVPCOffering", p)
+	if m.UpdateVPCOfferingFunc == nil {
+		return nil, notMocked("MockVPCService", "UpdateVPCOffering")
+	}
+	return m.UpdateVPCOfferingFunc(ctx, p)
+}
+
+func (m *MockVPCService) UpdateVPCOfferingAsync(ctx context.Context, p *UpdateVPCOfferingParams) (*UpdateVPCOfferingJob, error) {
+	m.record("UpdateVPCOfferingAsync", p)
+	if m.UpdateVPCOfferingAsyncFunc == nil {
+		return nil, notMocked("MockVPCService", "UpdateVPCOfferingAsync")
+	}
+	return m.UpdateVPCOfferingAsyncFunc(ctx, p)
+}
+
+func (m *MockVPCService) NewListVPCOfferingsParams() *ListVPCOfferingsParams {
+	return (&VPCService{}).NewListVPCOfferingsParams()
+}
+
+func (m *MockVPCService) GetVPCOfferingID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetVPCOfferingID", name, opts)
+	if m.GetVPCOfferingIDFunc == nil {
+		return "", -1, notMocked("MockVPCService", "GetVPCOfferingID")
+	}
+	return m.GetVPCOfferingIDFunc(name, opts...)
+}
+
+func (m *MockVPCService) GetVPCOfferingByName(name string, opts ...OptionFunc) (*VPCOffering, int, error) {
+	m.record("GetVPCOfferingByName", name, opts)
+	if m.GetVPCOfferingByNameFunc == nil {
+		return nil, -1, notMocked("MockVPCService", "GetVPCOfferingByName")
+	}
+	return m.GetVPCOfferingByNameFunc(name, opts...)
+}
+
+func (m *MockVPCService) GetVPCOfferingByID(id string, opts ...OptionFunc) (*VPCOffering, int, error) {
+	m.record("GetVPCOfferingByID", id, opts)
+	if m.GetVPCOfferingByIDFunc == nil {
+		return nil, -1, notMocked("MockVPCService", "GetVPCOfferingByID")
+	}
+	return m.GetVPCOfferingByIDFunc(id, opts...)
+}
+
+func (m *MockVPCService) ListVPCOfferings(p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error) {
+	return m.ListVPCOfferingsWithContext(context.Background(), p)
+}
+
+func (m *MockVPCService) ListVPCOfferingsWithContext(ctx context.Context, p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error) {
+	m.record("ListVPCOfferings", p)
+	if m.ListVPCOfferingsFunc == nil {
+		return nil, notMocked("MockVPCService", "ListVPCOfferings")
+	}
+	return m.ListVPCOfferingsFunc(ctx, p)
+}
+
+func (m *MockVPCService) NewListVPCOfferingsIterator(p *ListVPCOfferingsParams, pagesize int) *ListVPCOfferingsIterator {
+	i := &ListVPCOfferingsIterator{pager: newPager(nil, "listVPCOfferings", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListVPCOfferingsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListVPCOfferingsWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockVPCService) NewListVPCsParams() *ListVPCsParams {
+	return (&VPCService{}).NewListVPCsParams()
+}
+
+func (m *MockVPCService) GetVPCID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetVPCID", name, opts)
+	if m.GetVPCIDFunc == nil {
+		return "", -1, notMocked("MockVPCService", "GetVPCID")
+	}
+	return m.GetVPCIDFunc(name, opts...)
+}
+
+func (m *MockVPCService) GetVPCByName(name string, opts ...OptionFunc) (*VPC, int, error) {
+	m.record("GetVPCByName", name, opts)
+	if m.GetVPCByNameFunc == nil {
+		return nil, -1, notMocked("MockVPCService", "GetVPCByName")
+	}
+	return m.GetVPCByNameFunc(name, opts...)
+}
+
+func (m *MockVPCService) GetVPCByID(id string, opts ...OptionFunc) (*VPC, int, error) {
+	m.record("GetVPCByID", id, opts)
+	if m.GetVPCByIDFunc == nil {
+		return nil, -1, notMocked("MockVPCService", "GetVPCByID")
+	}
+	return m.GetVPCByIDFunc(id, opts...)
+}
+
+func (m *MockVPCService) ListVPCs(p *ListVPCsParams) (*ListVPCsResponse, error) {
+	return m.ListVPCsWithContext(context.Background(), p)
+}
+
+func (m *MockVPCService) ListVPCsWithContext(ctx context.Context, p *ListVPCsParams) (*ListVPCsResponse, error) {
+	m.record("ListVPCs", p)
+	if m.ListVPCsFunc == nil {
+		return nil, notMocked("MockVPCService", "ListVPCs")
+	}
+	return m.ListVPCsFunc(ctx, p)
+}
+
+func (m *MockVPCService) NewListVPCsIterator(p *ListVPCsParams, pagesize int) *ListVPCsIterator {
+	i := &ListVPCsIterator{pager: newPager(nil, "listVPCs", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListVPCsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListVPCsWithContext(ctx, c)
+	}
+	return i
+}
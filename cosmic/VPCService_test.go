@@ -0,0 +1,407 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCreatePrivateGatewayParams(t *testing.T) {
+	p := &CreatePrivateGatewayParams{}
+	want := url.Values{}
+	p.SetAclid("aclid-value")
+	want.Set("aclid", "aclid-value")
+	p.SetGateway("gateway-value")
+	want.Set("gateway", "gateway-value")
+	p.SetIpaddress("ipaddress-value")
+	want.Set("ipaddress", "ipaddress-value")
+	p.SetNetmask("netmask-value")
+	want.Set("netmask", "netmask-value")
+	p.SetNetworkid("networkid-value")
+	want.Set("networkid", "networkid-value")
+	p.SetSourcenatsupported(true)
+	want.Set("sourcenatsupported", "true")
+	p.SetVpcid("vpcid-value")
+	want.Set("vpcid", "vpcid-value")
+
+	assertParams(t, p, "createPrivateGateway", want)
+}
+
+func TestCreatePrivateGatewayResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","aclid":"aclid-value","cidr":"cidr-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","ipaddress":"ipaddress-value","jobid":"jobid-value","networkid":"networkid-value","networkname":"networkname-value","project":"project-value","projectid":"projectid-value","sourcenatsupported":true,"state":"state-value","vlan":"vlan-value","vpcid":"vpcid-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &CreatePrivateGatewayResponse{})
+}
+
+func TestDeletePrivateGatewayParams(t *testing.T) {
+	p := &DeletePrivateGatewayParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deletePrivateGateway", want)
+}
+
+func TestDeletePrivateGatewayResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeletePrivateGatewayResponse{})
+}
+
+func TestListPrivateGatewaysParams(t *testing.T) {
+	p := &ListPrivateGatewaysParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIpaddress("ipaddress-value")
+	want.Set("ipaddress", "ipaddress-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetNetworkid("networkid-value")
+	want.Set("networkid", "networkid-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetState("state-value")
+	want.Set("state", "state-value")
+	p.SetVpcid("vpcid-value")
+	want.Set("vpcid", "vpcid-value")
+
+	assertParams(t, p, "listPrivateGateways", want)
+}
+
+func TestListPrivateGatewaysResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"privategateway":[{"account":"account-value","aclid":"aclid-value","cidr":"cidr-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","ipaddress":"ipaddress-value","networkid":"networkid-value","networkname":"networkname-value","project":"project-value","projectid":"projectid-value","sourcenatsupported":true,"state":"state-value","vlan":"vlan-value","vpcid":"vpcid-value","zoneid":"zoneid-value","zonename":"zonename-value"}]}`, &ListPrivateGatewaysResponse{})
+}
+
+func TestCreateStaticRouteParams(t *testing.T) {
+	p := &CreateStaticRouteParams{}
+	want := url.Values{}
+	p.SetCidr("cidr-value")
+	want.Set("cidr", "cidr-value")
+	p.SetGatewayid("gatewayid-value")
+	want.Set("gatewayid", "gatewayid-value")
+	p.SetNexthop("nexthop-value")
+	want.Set("nexthop", "nexthop-value")
+	p.SetVpcid("vpcid-value")
+	want.Set("vpcid", "vpcid-value")
+
+	assertParams(t, p, "createStaticRoute", want)
+}
+
+func TestCreateStaticRouteResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","cidr":"cidr-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","jobid":"jobid-value","nexthop":"nexthop-value","project":"project-value","projectid":"projectid-value","state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"vpcid":"vpcid-value"}`, &CreateStaticRouteResponse{})
+}
+
+func TestDeleteStaticRouteParams(t *testing.T) {
+	p := &DeleteStaticRouteParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteStaticRoute", want)
+}
+
+func TestDeleteStaticRouteResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteStaticRouteResponse{})
+}
+
+func TestListStaticRoutesParams(t *testing.T) {
+	p := &ListStaticRoutesParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetCidr("cidr-value")
+	want.Set("cidr", "cidr-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetGatewayid("gatewayid-value")
+	want.Set("gatewayid", "gatewayid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetNexthop("nexthop-value")
+	want.Set("nexthop", "nexthop-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetTags(map[string]string{"k": "v"})
+	want.Set("tags[0].key", "k")
+	want.Set("tags[0].value", "v")
+	p.SetVpcid("vpcid-value")
+	want.Set("vpcid", "vpcid-value")
+
+	assertParams(t, p, "listStaticRoutes", want)
+}
+
+func TestListStaticRoutesResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"staticroute":[{"account":"account-value","cidr":"cidr-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","nexthop":"nexthop-value","project":"project-value","projectid":"projectid-value","state":"state-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"vpcid":"vpcid-value"}]}`, &ListStaticRoutesResponse{})
+}
+
+func TestCreateVPCParams(t *testing.T) {
+	p := &CreateVPCParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetAdvertinterval(int64(1) << 40)
+	want.Set("advertinterval", "1099511627776")
+	p.SetAdvertmethod("advertmethod-value")
+	want.Set("advertmethod", "advertmethod-value")
+	p.SetCidr("cidr-value")
+	want.Set("cidr", "cidr-value")
+	p.SetDisplaytext("displaytext-value")
+	want.Set("displaytext", "displaytext-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetNetworkdomain("networkdomain-value")
+	want.Set("networkdomain", "networkdomain-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetSourcenatlist("sourcenatlist-value")
+	want.Set("sourcenatlist", "sourcenatlist-value")
+	p.SetStart(true)
+	want.Set("start", "true")
+	p.SetSyslogserverlist("syslogserverlist-value")
+	want.Set("syslogserverlist", "syslogserverlist-value")
+	p.SetVpcofferingid("vpcofferingid-value")
+	want.Set("vpcofferingid", "vpcofferingid-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "createVPC", want)
+}
+
+func TestCreateVPCResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","advertinterval":1099511627776,"advertmethod":"advertmethod-value","cidr":"cidr-value","created":"created-value","displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","fordisplay":true,"id":"id-value","jobid":"jobid-value","name":"name-value","network":[{"account":"account-value","aclid":"aclid-value","aclname":"aclname-value","acltype":"acltype-value","broadcastdomaintype":"broadcastdomaintype-value","broadcasturi":"broadcasturi-value","canusefordeploy":true,"cidr":"cidr-value","dhcpbootfilename":"dhcpbootfilename-value","dhcptftpserver":"dhcptftpserver-value","displaynetwork":true,"displaytext":"displaytext-value","dns1":"dns1-value","dns2":"dns2-value","domain":"domain-value","domainid":"domainid-value","gateway":"gateway-value","id":"id-value","ip6cidr":"ip6cidr-value","ip6gateway":"ip6gateway-value","ipexclusionlist":"ipexclusionlist-value","isdefault":true,"ispersistent":true,"issystem":true,"name":"name-value","netmask":"netmask-value","networkcidr":"networkcidr-value","networkdomain":"networkdomain-value","networkofferingavailability":"networkofferingavailability-value","networkofferingconservemode":true,"networkofferingdisplaytext":"networkofferingdisplaytext-value","networkofferingid":"networkofferingid-value","networkofferingname":"networkofferingname-value","physicalnetworkid":"physicalnetworkid-value","project":"project-value","projectid":"projectid-value","related":"related-value","reservediprange":"reservediprange-value","restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"name-value","value":"value-value"}],"name":"name-value","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"destinationphysicalnetworkid-value","id":"id-value","name":"name-value","physicalnetworkid":"physicalnetworkid-value","servicelist":["a","b"],"state":"state-value"}]}],"specifyipranges":true,"state":"state-value","strechedl2subnet":true,"subdomainaccess":true,"tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"traffictype":"traffictype-value","type":"type-value","vlan":"vlan-value","vpcid":"vpcid-value","vpcname":"vpcname-value","zoneid":"zoneid-value","zonename":"zonename-value","zonesnetworkspans":["a","b"]}],"networkdomain":"networkdomain-value","project":"project-value","projectid":"projectid-value","redundantvpcrouter":true,"restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"name-value","value":"value-value"}],"name":"name-value","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"destinationphysicalnetworkid-value","id":"id-value","name":"name-value","physicalnetworkid":"physicalnetworkid-value","servicelist":["a","b"],"state":"state-value"}]}],"sourcenatlist":"sourcenatlist-value","state":"state-value","syslogserverlist":"syslogserverlist-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"vpcofferingdisplaytext":"vpcofferingdisplaytext-value","vpcofferingid":"vpcofferingid-value","vpcofferingname":"vpcofferingname-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &CreateVPCResponse{})
+}
+
+func TestDeleteVPCParams(t *testing.T) {
+	p := &DeleteVPCParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteVPC", want)
+}
+
+func TestDeleteVPCResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteVPCResponse{})
+}
+
+func TestRestartVPCParams(t *testing.T) {
+	p := &RestartVPCParams{}
+	want := url.Values{}
+	p.SetCleanup(true)
+	want.Set("cleanup", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "restartVPC", want)
+}
+
+func TestRestartVPCResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","advertinterval":1099511627776,"advertmethod":"advertmethod-value","cidr":"cidr-value","created":"created-value","displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","fordisplay":true,"id":"id-value","jobid":"jobid-value","name":"name-value","network":[{"account":"account-value","aclid":"aclid-value","aclname":"aclname-value","acltype":"acltype-value","broadcastdomaintype":"broadcastdomaintype-value","broadcasturi":"broadcasturi-value","canusefordeploy":true,"cidr":"cidr-value","dhcpbootfilename":"dhcpbootfilename-value","dhcptftpserver":"dhcptftpserver-value","displaynetwork":true,"displaytext":"displaytext-value","dns1":"dns1-value","dns2":"dns2-value","domain":"domain-value","domainid":"domainid-value","gateway":"gateway-value","id":"id-value","ip6cidr":"ip6cidr-value","ip6gateway":"ip6gateway-value","ipexclusionlist":"ipexclusionlist-value","isdefault":true,"ispersistent":true,"issystem":true,"name":"name-value","netmask":"netmask-value","networkcidr":"networkcidr-value","networkdomain":"networkdomain-value","networkofferingavailability":"networkofferingavailability-value","networkofferingconservemode":true,"networkofferingdisplaytext":"networkofferingdisplaytext-value","networkofferingid":"networkofferingid-value","networkofferingname":"networkofferingname-value","physicalnetworkid":"physicalnetworkid-value","project":"project-value","projectid":"projectid-value","related":"related-value","reservediprange":"reservediprange-value","restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"name-value","value":"value-value"}],"name":"name-value","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"destinationphysicalnetworkid-value","id":"id-value","name":"name-value","physicalnetworkid":"physicalnetworkid-value","servicelist":["a","b"],"state":"state-value"}]}],"specifyipranges":true,"state":"state-value","strechedl2subnet":true,"subdomainaccess":true,"tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"traffictype":"traffictype-value","type":"type-value","vlan":"vlan-value","vpcid":"vpcid-value","vpcname":"vpcname-value","zoneid":"zoneid-value","zonename":"zonename-value","zonesnetworkspans":["a","b"]}],"networkdomain":"networkdomain-value","project":"project-value","projectid":"projectid-value","redundantvpcrouter":true,"restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"name-value","value":"value-value"}],"name":"name-value","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"destinationphysicalnetworkid-value","id":"id-value","name":"name-value","physicalnetworkid":"physicalnetworkid-value","servicelist":["a","b"],"state":"state-value"}]}],"sourcenatlist":"sourcenatlist-value","state":"state-value","syslogserverlist":"syslogserverlist-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"vpcofferingdisplaytext":"vpcofferingdisplaytext-value","vpcofferingid":"vpcofferingid-value","vpcofferingname":"vpcofferingname-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &RestartVPCResponse{})
+}
+
+func TestUpdateVPCParams(t *testing.T) {
+	p := &UpdateVPCParams{}
+	want := url.Values{}
+	p.SetAdvertinterval(int64(1) << 40)
+	want.Set("advertinterval", "1099511627776")
+	p.SetAdvertmethod("advertmethod-value")
+	want.Set("advertmethod", "advertmethod-value")
+	p.SetCustomid("customid-value")
+	want.Set("customid", "customid-value")
+	p.SetDisplaytext("displaytext-value")
+	want.Set("displaytext", "displaytext-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetSourcenatlist("sourcenatlist-value")
+	want.Set("sourcenatlist", "sourcenatlist-value")
+	p.SetSyslogserverlist("syslogserverlist-value")
+	want.Set("syslogserverlist", "syslogserverlist-value")
+	p.SetVpcofferingid("vpcofferingid-value")
+	want.Set("vpcofferingid", "vpcofferingid-value")
+
+	assertParams(t, p, "updateVPC", want)
+}
+
+func TestUpdateVPCResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","advertinterval":1099511627776,"advertmethod":"advertmethod-value","cidr":"cidr-value","created":"created-value","displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","fordisplay":true,"id":"id-value","jobid":"jobid-value","name":"name-value","network":[{"account":"account-value","aclid":"aclid-value","aclname":"aclname-value","acltype":"acltype-value","broadcastdomaintype":"broadcastdomaintype-value","broadcasturi":"broadcasturi-value","canusefordeploy":true,"cidr":"cidr-value","dhcpbootfilename":"dhcpbootfilename-value","dhcptftpserver":"dhcptftpserver-value","displaynetwork":true,"displaytext":"displaytext-value","dns1":"dns1-value","dns2":"dns2-value","domain":"domain-value","domainid":"domainid-value","gateway":"gateway-value","id":"id-value","ip6cidr":"ip6cidr-value","ip6gateway":"ip6gateway-value","ipexclusionlist":"ipexclusionlist-value","isdefault":true,"ispersistent":true,"issystem":true,"name":"name-value","netmask":"netmask-value","networkcidr":"networkcidr-value","networkdomain":"networkdomain-value","networkofferingavailability":"networkofferingavailability-value","networkofferingconservemode":true,"networkofferingdisplaytext":"networkofferingdisplaytext-value","networkofferingid":"networkofferingid-value","networkofferingname":"networkofferingname-value","physicalnetworkid":"physicalnetworkid-value","project":"project-value","projectid":"projectid-value","related":"related-value","reservediprange":"reservediprange-value","restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"name-value","value":"value-value"}],"name":"name-value","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"destinationphysicalnetworkid-value","id":"id-value","name":"name-value","physicalnetworkid":"physicalnetworkid-value","servicelist":["a","b"],"state":"state-value"}]}],"specifyipranges":true,"state":"state-value","strechedl2subnet":true,"subdomainaccess":true,"tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"traffictype":"traffictype-value","type":"type-value","vlan":"vlan-value","vpcid":"vpcid-value","vpcname":"vpcname-value","zoneid":"zoneid-value","zonename":"zonename-value","zonesnetworkspans":["a","b"]}],"networkdomain":"networkdomain-value","project":"project-value","projectid":"projectid-value","redundantvpcrouter":true,"restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"name-value","value":"value-value"}],"name":"name-value","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"destinationphysicalnetworkid-value","id":"id-value","name":"name-value","physicalnetworkid":"physicalnetworkid-value","servicelist":["a","b"],"state":"state-value"}]}],"sourcenatlist":"sourcenatlist-value","state":"state-value","syslogserverlist":"syslogserverlist-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"vpcofferingdisplaytext":"vpcofferingdisplaytext-value","vpcofferingid":"vpcofferingid-value","vpcofferingname":"vpcofferingname-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &UpdateVPCResponse{})
+}
+
+func TestCreateVPCOfferingParams(t *testing.T) {
+	p := &CreateVPCOfferingParams{}
+	want := url.Values{}
+	p.SetDisplaytext("displaytext-value")
+	want.Set("displaytext", "displaytext-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetSecondaryserviceofferingid("secondaryserviceofferingid-value")
+	want.Set("secondaryserviceofferingid", "secondaryserviceofferingid-value")
+	p.SetServicecapabilitylist(map[string]string{"k": "v"})
+	want.Set("servicecapabilitylist[0].key", "k")
+	want.Set("servicecapabilitylist[0].value", "v")
+	p.SetServiceofferingid("serviceofferingid-value")
+	want.Set("serviceofferingid", "serviceofferingid-value")
+	p.SetServiceproviderlist(map[string]string{"k": "v"})
+	want.Set("serviceproviderlist[0].service", "k")
+	want.Set("serviceproviderlist[0].provider", "v")
+	p.SetSupportedservices([]string{"a", "b"})
+	want.Set("supportedservices", "a,b")
+
+	assertParams(t, p, "createVPCOffering", want)
+}
+
+func TestCreateVPCOfferingResponse(t *testing.T) {
+	assertDecodes(t, `{"created":"created-value","displaytext":"displaytext-value","id":"id-value","isdefault":true,"jobid":"jobid-value","name":"name-value","secondaryserviceofferingid":"secondaryserviceofferingid-value","secondaryserviceofferingname":"secondaryserviceofferingname-value","service":[{"capability":[{"canchooseservicecapability":true,"name":"name-value","value":"value-value"}],"name":"name-value","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"destinationphysicalnetworkid-value","id":"id-value","name":"name-value","physicalnetworkid":"physicalnetworkid-value","servicelist":["a","b"],"state":"state-value"}]}],"serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","state":"state-value"}`, &CreateVPCOfferingResponse{})
+}
+
+func TestDeleteVPCOfferingParams(t *testing.T) {
+	p := &DeleteVPCOfferingParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteVPCOffering", want)
+}
+
+func TestDeleteVPCOfferingResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteVPCOfferingResponse{})
+}
+
+func TestUpdateVPCOfferingParams(t *testing.T) {
+	p := &UpdateVPCOfferingParams{}
+	want := url.Values{}
+	p.SetDisplaytext("displaytext-value")
+	want.Set("displaytext", "displaytext-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetState("state-value")
+	want.Set("state", "state-value")
+
+	assertParams(t, p, "updateVPCOffering", want)
+}
+
+func TestUpdateVPCOfferingResponse(t *testing.T) {
+	assertDecodes(t, `{"created":"created-value","displaytext":"displaytext-value","id":"id-value","isdefault":true,"jobid":"jobid-value","name":"name-value","secondaryserviceofferingid":"secondaryserviceofferingid-value","secondaryserviceofferingname":"secondaryserviceofferingname-value","service":[{"capability":[{"canchooseservicecapability":true,"name":"name-value","value":"value-value"}],"name":"name-value","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"destinationphysicalnetworkid-value","id":"id-value","name":"name-value","physicalnetworkid":"physicalnetworkid-value","servicelist":["a","b"],"state":"state-value"}]}],"serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","state":"state-value"}`, &UpdateVPCOfferingResponse{})
+}
+
+func TestListVPCOfferingsParams(t *testing.T) {
+	p := &ListVPCOfferingsParams{}
+	want := url.Values{}
+	p.SetDisplaytext("displaytext-value")
+	want.Set("displaytext", "displaytext-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsdefault(true)
+	want.Set("isdefault", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetState("state-value")
+	want.Set("state", "state-value")
+	p.SetSupportedservices([]string{"a", "b"})
+	want.Set("supportedservices", "a,b")
+
+	assertParams(t, p, "listVPCOfferings", want)
+}
+
+func TestListVPCOfferingsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"vpcoffering":[{"created":"created-value","displaytext":"displaytext-value","id":"id-value","isdefault":true,"name":"name-value","secondaryserviceofferingid":"secondaryserviceofferingid-value","secondaryserviceofferingname":"secondaryserviceofferingname-value","service":[{"capability":[{"canchooseservicecapability":true,"name":"name-value","value":"value-value"}],"name":"name-value","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"destinationphysicalnetworkid-value","id":"id-value","name":"name-value","physicalnetworkid":"physicalnetworkid-value","servicelist":["a","b"],"state":"state-value"}]}],"serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","state":"state-value"}]}`, &ListVPCOfferingsResponse{})
+}
+
+func TestListVPCsParams(t *testing.T) {
+	p := &ListVPCsParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetCidr("cidr-value")
+	want.Set("cidr", "cidr-value")
+	p.SetDisplaytext("displaytext-value")
+	want.Set("displaytext", "displaytext-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetRestartrequired(true)
+	want.Set("restartrequired", "true")
+	p.SetState("state-value")
+	want.Set("state", "state-value")
+	p.SetSupportedservices([]string{"a", "b"})
+	want.Set("supportedservices", "a,b")
+	p.SetTags(map[string]string{"k": "v"})
+	want.Set("tags[0].key", "k")
+	want.Set("tags[0].value", "v")
+	p.SetVpcofferingid("vpcofferingid-value")
+	want.Set("vpcofferingid", "vpcofferingid-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listVPCs", want)
+}
+
+func TestListVPCsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"vpc":[{"account":"account-value","advertinterval":1099511627776,"advertmethod":"advertmethod-value","cidr":"cidr-value","created":"created-value","displaytext":"displaytext-value","domain":"domain-value","domainid":"domainid-value","fordisplay":true,"id":"id-value","name":"name-value","network":[{"account":"account-value","aclid":"aclid-value","aclname":"aclname-value","acltype":"acltype-value","broadcastdomaintype":"broadcastdomaintype-value","broadcasturi":"broadcasturi-value","canusefordeploy":true,"cidr":"cidr-value","dhcpbootfilename":"dhcpbootfilename-value","dhcptftpserver":"dhcptftpserver-value","displaynetwork":true,"displaytext":"displaytext-value","dns1":"dns1-value","dns2":"dns2-value","domain":"domain-value","domainid":"domainid-value","gateway":"gateway-value","id":"id-value","ip6cidr":"ip6cidr-value","ip6gateway":"ip6gateway-value","ipexclusionlist":"ipexclusionlist-value","isdefault":true,"ispersistent":true,"issystem":true,"name":"name-value","netmask":"netmask-value","networkcidr":"networkcidr-value","networkdomain":"networkdomain-value","networkofferingavailability":"networkofferingavailability-value","networkofferingconservemode":true,"networkofferingdisplaytext":"networkofferingdisplaytext-value","networkofferingid":"networkofferingid-value","networkofferingname":"networkofferingname-value","physicalnetworkid":"physicalnetworkid-value","project":"project-value","projectid":"projectid-value","related":"related-value","reservediprange":"reservediprange-value","restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"name-value","value":"value-value"}],"name":"name-value","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"destinationphysicalnetworkid-value","id":"id-value","name":"name-value","physicalnetworkid":"physicalnetworkid-value","servicelist":["a","b"],"state":"state-value"}]}],"specifyipranges":true,"state":"state-value","strechedl2subnet":true,"subdomainaccess":true,"tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"traffictype":"traffictype-value","type":"type-value","vlan":"vlan-value","vpcid":"vpcid-value","vpcname":"vpcname-value","zoneid":"zoneid-value","zonename":"zonename-value","zonesnetworkspans":["a","b"]}],"networkdomain":"networkdomain-value","project":"project-value","projectid":"projectid-value","redundantvpcrouter":true,"restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"name-value","value":"value-value"}],"name":"name-value","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"destinationphysicalnetworkid-value","id":"id-value","name":"name-value","physicalnetworkid":"physicalnetworkid-value","servicelist":["a","b"],"state":"state-value"}]}],"sourcenatlist":"sourcenatlist-value","state":"state-value","syslogserverlist":"syslogserverlist-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"vpcofferingdisplaytext":"vpcofferingdisplaytext-value","vpcofferingid":"vpcofferingid-value","vpcofferingname":"vpcofferingname-value","zoneid":"zoneid-value","zonename":"zonename-value"}]}`, &ListVPCsResponse{})
+}
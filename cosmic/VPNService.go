@@ -17,17 +17,114 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 )
 
+// VPNServiceIface is the interface implemented by VPNService, and by MockVPNService for tests
+type VPNServiceIface interface {
+	NewCreateRemoteAccessVpnParams(publicipid string) *CreateRemoteAccessVpnParams
+	CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnResponse, error)
+	CreateRemoteAccessVpnWithContext(ctx context.Context, p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnResponse, error)
+	CreateRemoteAccessVpnAsync(ctx context.Context, p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnJob, error)
+	NewDeleteRemoteAccessVpnParams(publicipid string) *DeleteRemoteAccessVpnParams
+	DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnResponse, error)
+	DeleteRemoteAccessVpnWithContext(ctx context.Context, p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnResponse, error)
+	DeleteRemoteAccessVpnAsync(ctx context.Context, p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnJob, error)
+	NewUpdateRemoteAccessVpnParams(id string) *UpdateRemoteAccessVpnParams
+	UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnResponse, error)
+	UpdateRemoteAccessVpnWithContext(ctx context.Context, p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnResponse, error)
+	UpdateRemoteAccessVpnAsync(ctx context.Context, p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnJob, error)
+	NewListRemoteAccessVpnsParams() *ListRemoteAccessVpnsParams
+	GetRemoteAccessVpnByID(id string, opts ...OptionFunc) (*RemoteAccessVpn, int, error)
+	ListRemoteAccessVpns(p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error)
+	ListRemoteAccessVpnsWithContext(ctx context.Context, p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error)
+	NewListRemoteAccessVpnsIterator(p *ListRemoteAccessVpnsParams, pagesize int) *ListRemoteAccessVpnsIterator
+	NewCreateVpnConnectionParams(s2scustomergatewayid string, s2svpngatewayid string) *CreateVpnConnectionParams
+	CreateVpnConnection(p *CreateVpnConnectionParams) (*CreateVpnConnectionResponse, error)
+	CreateVpnConnectionWithContext(ctx context.Context, p *CreateVpnConnectionParams) (*CreateVpnConnectionResponse, error)
+	CreateVpnConnectionAsync(ctx context.Context, p *CreateVpnConnectionParams) (*CreateVpnConnectionJob, error)
+	NewDeleteVpnConnectionParams(id string) *DeleteVpnConnectionParams
+	DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error)
+	DeleteVpnConnectionWithContext(ctx context.Context, p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error)
+	DeleteVpnConnectionAsync(ctx context.Context, p *DeleteVpnConnectionParams) (*DeleteVpnConnectionJob, error)
+	NewResetVpnConnectionParams(id string) *ResetVpnConnectionParams
+	ResetVpnConnection(p *ResetVpnConnectionParams) (*ResetVpnConnectionResponse, error)
+	ResetVpnConnectionWithContext(ctx context.Context, p *ResetVpnConnectionParams) (*ResetVpnConnectionResponse, error)
+	ResetVpnConnectionAsync(ctx context.Context, p *ResetVpnConnectionParams) (*ResetVpnConnectionJob, error)
+	NewUpdateVpnConnectionParams(id string) *UpdateVpnConnectionParams
+	UpdateVpnConnection(p *UpdateVpnConnectionParams) (*UpdateVpnConnectionResponse, error)
+	UpdateVpnConnectionWithContext(ctx context.Context, p *UpdateVpnConnectionParams) (*UpdateVpnConnectionResponse, error)
+	UpdateVpnConnectionAsync(ctx context.Context, p *UpdateVpnConnectionParams) (*UpdateVpnConnectionJob, error)
+	NewListVpnConnectionsParams() *ListVpnConnectionsParams
+	GetVpnConnectionByID(id string, opts ...OptionFunc) (*VpnConnection, int, error)
+	ListVpnConnections(p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error)
+	ListVpnConnectionsWithContext(ctx context.Context, p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error)
+	NewListVpnConnectionsIterator(p *ListVpnConnectionsParams, pagesize int) *ListVpnConnectionsIterator
+	NewCreateVpnCustomerGatewayParams(cidrlist []string, esppolicy string, gateway string, ikepolicy string, ipsecpsk string) *CreateVpnCustomerGatewayParams
+	CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error)
+	CreateVpnCustomerGatewayWithContext(ctx context.Context, p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error)
+	CreateVpnCustomerGatewayAsync(ctx context.Context, p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayJob, error)
+	NewDeleteVpnCustomerGatewayParams(id string) *DeleteVpnCustomerGatewayParams
+	DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error)
+	DeleteVpnCustomerGatewayWithContext(ctx context.Context, p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error)
+	DeleteVpnCustomerGatewayAsync(ctx context.Context, p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayJob, error)
+	NewUpdateVpnCustomerGatewayParams(cidrlist []string, esppolicy string, gateway string, id string, ikepolicy string, ipsecpsk string) *UpdateVpnCustomerGatewayParams
+	UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayResponse, error)
+	UpdateVpnCustomerGatewayWithContext(ctx context.Context, p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayResponse, error)
+	UpdateVpnCustomerGatewayAsync(ctx context.Context, p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayJob, error)
+	NewListVpnCustomerGatewaysParams() *ListVpnCustomerGatewaysParams
+	GetVpnCustomerGatewayID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetVpnCustomerGatewayByName(name string, opts ...OptionFunc) (*VpnCustomerGateway, int, error)
+	GetVpnCustomerGatewayByID(id string, opts ...OptionFunc) (*VpnCustomerGateway, int, error)
+	ListVpnCustomerGateways(p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error)
+	ListVpnCustomerGatewaysWithContext(ctx context.Context, p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error)
+	NewListVpnCustomerGatewaysIterator(p *ListVpnCustomerGatewaysParams, pagesize int) *ListVpnCustomerGatewaysIterator
+	NewCreateVpnGatewayParams(vpcid string) *CreateVpnGatewayParams
+	CreateVpnGateway(p *CreateVpnGatewayParams) (*CreateVpnGatewayResponse, error)
+	CreateVpnGatewayWithContext(ctx context.Context, p *CreateVpnGatewayParams) (*CreateVpnGatewayResponse, error)
+	CreateVpnGatewayAsync(ctx context.Context, p *CreateVpnGatewayParams) (*CreateVpnGatewayJob, error)
+	NewDeleteVpnGatewayParams(id string) *DeleteVpnGatewayParams
+	DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error)
+	DeleteVpnGatewayWithContext(ctx context.Context, p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error)
+	DeleteVpnGatewayAsync(ctx context.Context, p *DeleteVpnGatewayParams) (*DeleteVpnGatewayJob, error)
+	NewUpdateVpnGatewayParams(id string) *UpdateVpnGatewayParams
+	UpdateVpnGateway(p *UpdateVpnGatewayParams) (*UpdateVpnGatewayResponse, error)
+	UpdateVpnGatewayWithContext(ctx context.Context, p *UpdateVpnGatewayParams) (*UpdateVpnGatewayResponse, error)
+	UpdateVpnGatewayAsync(ctx context.Context, p *UpdateVpnGatewayParams) (*UpdateVpnGatewayJob, error)
+	NewListVpnGatewaysParams() *ListVpnGatewaysParams
+	GetVpnGatewayByID(id string, opts ...OptionFunc) (*VpnGateway, int, error)
+	ListVpnGateways(p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error)
+	ListVpnGatewaysWithContext(ctx context.Context, p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error)
+	NewListVpnGatewaysIterator(p *ListVpnGatewaysParams, pagesize int) *ListVpnGatewaysIterator
+	NewAddVpnUserParams(password string, username string) *AddVpnUserParams
+	AddVpnUser(p *AddVpnUserParams) (*AddVpnUserResponse, error)
+	AddVpnUserWithContext(ctx context.Context, p *AddVpnUserParams) (*AddVpnUserResponse, error)
+	AddVpnUserAsync(ctx context.Context, p *AddVpnUserParams) (*AddVpnUserJob, error)
+	NewRemoveVpnUserParams(username string) *RemoveVpnUserParams
+	RemoveVpnUser(p *RemoveVpnUserParams) (*RemoveVpnUserResponse, error)
+	RemoveVpnUserWithContext(ctx context.Context, p *RemoveVpnUserParams) (*RemoveVpnUserResponse, error)
+	RemoveVpnUserAsync(ctx context.Context, p *RemoveVpnUserParams) (*RemoveVpnUserJob, error)
+	NewListVpnUsersParams() *ListVpnUsersParams
+	GetVpnUserByID(id string, opts ...OptionFunc) (*VpnUser, int, error)
+	ListVpnUsers(p *ListVpnUsersParams) (*ListVpnUsersResponse, error)
+	ListVpnUsersWithContext(ctx context.Context, p *ListVpnUsersParams) (*ListVpnUsersResponse, error)
+	NewListVpnUsersIterator(p *ListVpnUsersParams, pagesize int) *ListVpnUsersIterator
+}
+
 type CreateRemoteAccessVpnParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateRemoteAccessVpnParams) command() string {
+	return "createRemoteAccessVpn"
+}
+
 func (p *CreateRemoteAccessVpnParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -109,7 +206,13 @@ func (s *VPNService) NewCreateRemoteAccessVpnParams(publicipid string) *CreateRe
 
 // Creates a l2tp/ipsec remote access vpn
 func (s *VPNService) CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnResponse, error) {
-	resp, err := s.cs.newRequest("createRemoteAccessVpn", p.toURLValues())
+	return s.CreateRemoteAccessVpnWithContext(context.Background(), p)
+}
+
+// CreateRemoteAccessVpnWithContext is the same as CreateRemoteAccessVpn, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPNService) CreateRemoteAccessVpnWithContext(ctx context.Context, p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createRemoteAccessVpn", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -121,26 +224,56 @@ func (s *VPNService) CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams) (*Cre
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createRemoteAccessVpn", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "remoteaccessvpn", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CreateRemoteAccessVpnJob is a handle to a running createRemoteAccessVpn async job
+type CreateRemoteAccessVpnJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateRemoteAccessVpnResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateRemoteAccessVpnJob) Result() (*CreateRemoteAccessVpnResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateRemoteAccessVpnResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "remoteaccessvpn", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CreateRemoteAccessVpnAsync starts the createRemoteAccessVpn async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPNService) CreateRemoteAccessVpnAsync(ctx context.Context, p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createRemoteAccessVpn", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateRemoteAccessVpnResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateRemoteAccessVpnJob{s.cs.newJobHandle("createRemoteAccessVpn", r.JobID)}, nil
+}
+
 type CreateRemoteAccessVpnResponse struct {
 	JobID        string `json:"jobid,omitempty"`
 	Account      string `json:"account,omitempty"`
@@ -161,6 +294,10 @@ type DeleteRemoteAccessVpnParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteRemoteAccessVpnParams) command() string {
+	return "deleteRemoteAccessVpn"
+}
+
 func (p *DeleteRemoteAccessVpnParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -190,7 +327,13 @@ func (s *VPNService) NewDeleteRemoteAccessVpnParams(publicipid string) *DeleteRe
 
 // Destroys a l2tp/ipsec remote access vpn
 func (s *VPNService) DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnResponse, error) {
-	resp, err := s.cs.newRequest("deleteRemoteAccessVpn", p.toURLValues())
+	return s.DeleteRemoteAccessVpnWithContext(context.Background(), p)
+}
+
+// DeleteRemoteAccessVpnWithContext is the same as DeleteRemoteAccessVpn, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPNService) DeleteRemoteAccessVpnWithContext(ctx context.Context, p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteRemoteAccessVpn", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -202,9 +345,9 @@ func (s *VPNService) DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams) (*Del
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteRemoteAccessVpn", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -217,6 +360,41 @@ func (s *VPNService) DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams) (*Del
 	return &r, nil
 }
 
+// DeleteRemoteAccessVpnJob is a handle to a running deleteRemoteAccessVpn async job
+type DeleteRemoteAccessVpnJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteRemoteAccessVpnResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteRemoteAccessVpnJob) Result() (*DeleteRemoteAccessVpnResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteRemoteAccessVpnResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteRemoteAccessVpnAsync starts the deleteRemoteAccessVpn async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPNService) DeleteRemoteAccessVpnAsync(ctx context.Context, p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteRemoteAccessVpn", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteRemoteAccessVpnResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteRemoteAccessVpnJob{s.cs.newJobHandle("deleteRemoteAccessVpn", r.JobID)}, nil
+}
+
 type DeleteRemoteAccessVpnResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -227,6 +405,10 @@ type UpdateRemoteAccessVpnParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateRemoteAccessVpnParams) command() string {
+	return "updateRemoteAccessVpn"
+}
+
 func (p *UpdateRemoteAccessVpnParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -277,7 +459,13 @@ func (s *VPNService) NewUpdateRemoteAccessVpnParams(id string) *UpdateRemoteAcce
 
 // Updates remote access vpn
 func (s *VPNService) UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnResponse, error) {
-	resp, err := s.cs.newRequest("updateRemoteAccessVpn", p.toURLValues())
+	return s.UpdateRemoteAccessVpnWithContext(context.Background(), p)
+}
+
+// UpdateRemoteAccessVpnWithContext is the same as UpdateRemoteAccessVpn, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPNService) UpdateRemoteAccessVpnWithContext(ctx context.Context, p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateRemoteAccessVpn", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -289,26 +477,56 @@ func (s *VPNService) UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams) (*Upd
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateRemoteAccessVpn", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "remoteaccessvpn", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// UpdateRemoteAccessVpnJob is a handle to a running updateRemoteAccessVpn async job
+type UpdateRemoteAccessVpnJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateRemoteAccessVpnResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateRemoteAccessVpnJob) Result() (*UpdateRemoteAccessVpnResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateRemoteAccessVpnResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "remoteaccessvpn", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// UpdateRemoteAccessVpnAsync starts the updateRemoteAccessVpn async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPNService) UpdateRemoteAccessVpnAsync(ctx context.Context, p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateRemoteAccessVpn", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateRemoteAccessVpnResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateRemoteAccessVpnJob{s.cs.newJobHandle("updateRemoteAccessVpn", r.JobID)}, nil
+}
+
 type UpdateRemoteAccessVpnResponse struct {
 	JobID        string `json:"jobid,omitempty"`
 	Account      string `json:"account,omitempty"`
@@ -329,6 +547,10 @@ type ListRemoteAccessVpnsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListRemoteAccessVpnsParams) command() string {
+	return "listRemoteAccessVpns"
+}
+
 func (p *ListRemoteAccessVpnsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -485,16 +707,14 @@ func (s *VPNService) GetRemoteAccessVpnByID(id string, opts ...OptionFunc) (*Rem
 
 	l, err := s.ListRemoteAccessVpns(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -506,10 +726,17 @@ func (s *VPNService) GetRemoteAccessVpnByID(id string, opts ...OptionFunc) (*Rem
 
 // Lists remote access vpns
 func (s *VPNService) ListRemoteAccessVpns(p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error) {
+	return s.ListRemoteAccessVpnsWithContext(context.Background(), p)
+}
+
+// ListRemoteAccessVpnsWithContext is the same as ListRemoteAccessVpns, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPNService) ListRemoteAccessVpnsWithContext(ctx context.Context, p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error) {
 	var r ListRemoteAccessVpnsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListRemoteAccessVpnsResponse
-		resp, err := s.cs.newRequest("listRemoteAccessVpns", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listRemoteAccessVpns", values)
 		if err != nil {
 			return nil, err
 		}
@@ -521,13 +748,89 @@ func (s *VPNService) ListRemoteAccessVpns(p *ListRemoteAccessVpnsParams) (*ListR
 		r.Count = l.Count
 		r.RemoteAccessVpns = append(r.RemoteAccessVpns, l.RemoteAccessVpns...)
 
-		if r.Count == len(r.RemoteAccessVpns) {
+		if r.Count == len(r.RemoteAccessVpns) || len(l.RemoteAccessVpns) == 0 {
+			s.cs.observePages("listRemoteAccessVpns", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.RemoteAccessVpns))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.RemoteAccessVpns)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listRemoteAccessVpns", values, len(l.RemoteAccessVpns), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListRemoteAccessVpnsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.RemoteAccessVpns = append(r.RemoteAccessVpns, l.RemoteAccessVpns...)
+			}
+
+			if r.Count == len(r.RemoteAccessVpns) {
+				s.cs.observePages("listRemoteAccessVpns", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListRemoteAccessVpnsIterator iterates over the items returned by listRemoteAccessVpns, requesting
+// the pages of the response one at a time while iterating.
+type ListRemoteAccessVpnsIterator struct {
+	pager
+	items []*RemoteAccessVpn
+	index int
+}
+
+// NewListRemoteAccessVpnsIterator returns an iterator over the items returned by listRemoteAccessVpns, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *VPNService) NewListRemoteAccessVpnsIterator(p *ListRemoteAccessVpnsParams, pagesize int) *ListRemoteAccessVpnsIterator {
+	return &ListRemoteAccessVpnsIterator{pager: newPager(s.cs, "listRemoteAccessVpns", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListRemoteAccessVpnsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListRemoteAccessVpnsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.RemoteAccessVpns))
+
+	i.items = l.RemoteAccessVpns
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListRemoteAccessVpnsIterator) Value() *RemoteAccessVpn {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListRemoteAccessVpnsResponse struct {
@@ -554,6 +857,10 @@ type CreateVpnConnectionParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateVpnConnectionParams) command() string {
+	return "createVpnConnection"
+}
+
 func (p *CreateVpnConnectionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -616,7 +923,13 @@ func (s *VPNService) NewCreateVpnConnectionParams(s2scustomergatewayid string, s
 
 // Create site to site vpn connection
 func (s *VPNService) CreateVpnConnection(p *CreateVpnConnectionParams) (*CreateVpnConnectionResponse, error) {
-	resp, err := s.cs.newRequest("createVpnConnection", p.toURLValues())
+	return s.CreateVpnConnectionWithContext(context.Background(), p)
+}
+
+// CreateVpnConnectionWithContext is the same as CreateVpnConnection, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPNService) CreateVpnConnectionWithContext(ctx context.Context, p *CreateVpnConnectionParams) (*CreateVpnConnectionResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createVpnConnection", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -628,26 +941,56 @@ func (s *VPNService) CreateVpnConnection(p *CreateVpnConnectionParams) (*CreateV
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createVpnConnection", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "vpnconnection", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CreateVpnConnectionJob is a handle to a running createVpnConnection async job
+type CreateVpnConnectionJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateVpnConnectionResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateVpnConnectionJob) Result() (*CreateVpnConnectionResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateVpnConnectionResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "vpnconnection", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CreateVpnConnectionAsync starts the createVpnConnection async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPNService) CreateVpnConnectionAsync(ctx context.Context, p *CreateVpnConnectionParams) (*CreateVpnConnectionJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createVpnConnection", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateVpnConnectionResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateVpnConnectionJob{s.cs.newJobHandle("createVpnConnection", r.JobID)}, nil
+}
+
 type CreateVpnConnectionResponse struct {
 	JobID                string `json:"jobid,omitempty"`
 	Account              string `json:"account,omitempty"`
@@ -679,6 +1022,10 @@ type DeleteVpnConnectionParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteVpnConnectionParams) command() string {
+	return "deleteVpnConnection"
+}
+
 func (p *DeleteVpnConnectionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -708,7 +1055,13 @@ func (s *VPNService) NewDeleteVpnConnectionParams(id string) *DeleteVpnConnectio
 
 // Delete site to site vpn connection
 func (s *VPNService) DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error) {
-	resp, err := s.cs.newRequest("deleteVpnConnection", p.toURLValues())
+	return s.DeleteVpnConnectionWithContext(context.Background(), p)
+}
+
+// DeleteVpnConnectionWithContext is the same as DeleteVpnConnection, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPNService) DeleteVpnConnectionWithContext(ctx context.Context, p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteVpnConnection", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -720,9 +1073,9 @@ func (s *VPNService) DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteV
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteVpnConnection", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -735,6 +1088,41 @@ func (s *VPNService) DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteV
 	return &r, nil
 }
 
+// DeleteVpnConnectionJob is a handle to a running deleteVpnConnection async job
+type DeleteVpnConnectionJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteVpnConnectionResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteVpnConnectionJob) Result() (*DeleteVpnConnectionResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteVpnConnectionResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteVpnConnectionAsync starts the deleteVpnConnection async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPNService) DeleteVpnConnectionAsync(ctx context.Context, p *DeleteVpnConnectionParams) (*DeleteVpnConnectionJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteVpnConnection", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteVpnConnectionResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteVpnConnectionJob{s.cs.newJobHandle("deleteVpnConnection", r.JobID)}, nil
+}
+
 type DeleteVpnConnectionResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -745,6 +1133,10 @@ type ResetVpnConnectionParams struct {
 	p map[string]interface{}
 }
 
+func (p *ResetVpnConnectionParams) command() string {
+	return "resetVpnConnection"
+}
+
 func (p *ResetVpnConnectionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -794,7 +1186,13 @@ func (s *VPNService) NewResetVpnConnectionParams(id string) *ResetVpnConnectionP
 
 // Reset site to site vpn connection
 func (s *VPNService) ResetVpnConnection(p *ResetVpnConnectionParams) (*ResetVpnConnectionResponse, error) {
-	resp, err := s.cs.newRequest("resetVpnConnection", p.toURLValues())
+	return s.ResetVpnConnectionWithContext(context.Background(), p)
+}
+
+// ResetVpnConnectionWithContext is the same as ResetVpnConnection, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPNService) ResetVpnConnectionWithContext(ctx context.Context, p *ResetVpnConnectionParams) (*ResetVpnConnectionResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "resetVpnConnection", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -806,26 +1204,56 @@ func (s *VPNService) ResetVpnConnection(p *ResetVpnConnectionParams) (*ResetVpnC
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "resetVpnConnection", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "vpnconnection", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// ResetVpnConnectionJob is a handle to a running resetVpnConnection async job
+type ResetVpnConnectionJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a ResetVpnConnectionResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *ResetVpnConnectionJob) Result() (*ResetVpnConnectionResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := ResetVpnConnectionResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "vpnconnection", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// ResetVpnConnectionAsync starts the resetVpnConnection async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPNService) ResetVpnConnectionAsync(ctx context.Context, p *ResetVpnConnectionParams) (*ResetVpnConnectionJob, error) {
+	resp, err := s.cs.newRequest(ctx, "resetVpnConnection", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ResetVpnConnectionResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &ResetVpnConnectionJob{s.cs.newJobHandle("resetVpnConnection", r.JobID)}, nil
+}
+
 type ResetVpnConnectionResponse struct {
 	JobID                string `json:"jobid,omitempty"`
 	Account              string `json:"account,omitempty"`
@@ -857,6 +1285,10 @@ type UpdateVpnConnectionParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateVpnConnectionParams) command() string {
+	return "updateVpnConnection"
+}
+
 func (p *UpdateVpnConnectionParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -907,7 +1339,13 @@ func (s *VPNService) NewUpdateVpnConnectionParams(id string) *UpdateVpnConnectio
 
 // Updates site to site vpn connection
 func (s *VPNService) UpdateVpnConnection(p *UpdateVpnConnectionParams) (*UpdateVpnConnectionResponse, error) {
-	resp, err := s.cs.newRequest("updateVpnConnection", p.toURLValues())
+	return s.UpdateVpnConnectionWithContext(context.Background(), p)
+}
+
+// UpdateVpnConnectionWithContext is the same as UpdateVpnConnection, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPNService) UpdateVpnConnectionWithContext(ctx context.Context, p *UpdateVpnConnectionParams) (*UpdateVpnConnectionResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateVpnConnection", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -919,26 +1357,56 @@ func (s *VPNService) UpdateVpnConnection(p *UpdateVpnConnectionParams) (*UpdateV
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateVpnConnection", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "vpnconnection", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// UpdateVpnConnectionJob is a handle to a running updateVpnConnection async job
+type UpdateVpnConnectionJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateVpnConnectionResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateVpnConnectionJob) Result() (*UpdateVpnConnectionResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateVpnConnectionResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "vpnconnection", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// UpdateVpnConnectionAsync starts the updateVpnConnection async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPNService) UpdateVpnConnectionAsync(ctx context.Context, p *UpdateVpnConnectionParams) (*UpdateVpnConnectionJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateVpnConnection", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateVpnConnectionResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateVpnConnectionJob{s.cs.newJobHandle("updateVpnConnection", r.JobID)}, nil
+}
+
 type UpdateVpnConnectionResponse struct {
 	JobID                string `json:"jobid,omitempty"`
 	Account              string `json:"account,omitempty"`
@@ -970,6 +1438,10 @@ type ListVpnConnectionsParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListVpnConnectionsParams) command() string {
+	return "listVpnConnections"
+}
+
 func (p *ListVpnConnectionsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1116,16 +1588,14 @@ func (s *VPNService) GetVpnConnectionByID(id string, opts ...OptionFunc) (*VpnCo
 
 	l, err := s.ListVpnConnections(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -1137,10 +1607,17 @@ func (s *VPNService) GetVpnConnectionByID(id string, opts ...OptionFunc) (*VpnCo
 
 // Lists site to site vpn connection gateways
 func (s *VPNService) ListVpnConnections(p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error) {
+	return s.ListVpnConnectionsWithContext(context.Background(), p)
+}
+
+// ListVpnConnectionsWithContext is the same as ListVpnConnections, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPNService) ListVpnConnectionsWithContext(ctx context.Context, p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error) {
 	var r ListVpnConnectionsResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListVpnConnectionsResponse
-		resp, err := s.cs.newRequest("listVpnConnections", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listVpnConnections", values)
 		if err != nil {
 			return nil, err
 		}
@@ -1152,13 +1629,89 @@ func (s *VPNService) ListVpnConnections(p *ListVpnConnectionsParams) (*ListVpnCo
 		r.Count = l.Count
 		r.VpnConnections = append(r.VpnConnections, l.VpnConnections...)
 
-		if r.Count == len(r.VpnConnections) {
+		if r.Count == len(r.VpnConnections) || len(l.VpnConnections) == 0 {
+			s.cs.observePages("listVpnConnections", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.VpnConnections))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.VpnConnections)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listVpnConnections", values, len(l.VpnConnections), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListVpnConnectionsResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.VpnConnections = append(r.VpnConnections, l.VpnConnections...)
+			}
+
+			if r.Count == len(r.VpnConnections) {
+				s.cs.observePages("listVpnConnections", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListVpnConnectionsIterator iterates over the items returned by listVpnConnections, requesting
+// the pages of the response one at a time while iterating.
+type ListVpnConnectionsIterator struct {
+	pager
+	items []*VpnConnection
+	index int
+}
+
+// NewListVpnConnectionsIterator returns an iterator over the items returned by listVpnConnections, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *VPNService) NewListVpnConnectionsIterator(p *ListVpnConnectionsParams, pagesize int) *ListVpnConnectionsIterator {
+	return &ListVpnConnectionsIterator{pager: newPager(s.cs, "listVpnConnections", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListVpnConnectionsIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
 	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListVpnConnectionsResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.VpnConnections))
+
+	i.items = l.VpnConnections
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListVpnConnectionsIterator) Value() *VpnConnection {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
 }
 
 type ListVpnConnectionsResponse struct {
@@ -1196,6 +1749,10 @@ type CreateVpnCustomerGatewayParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateVpnCustomerGatewayParams) command() string {
+	return "createVpnCustomerGateway"
+}
+
 func (p *CreateVpnCustomerGatewayParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1354,7 +1911,13 @@ func (s *VPNService) NewCreateVpnCustomerGatewayParams(cidrlist []string, esppol
 
 // Creates site to site vpn customer gateway
 func (s *VPNService) CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error) {
-	resp, err := s.cs.newRequest("createVpnCustomerGateway", p.toURLValues())
+	return s.CreateVpnCustomerGatewayWithContext(context.Background(), p)
+}
+
+// CreateVpnCustomerGatewayWithContext is the same as CreateVpnCustomerGateway, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPNService) CreateVpnCustomerGatewayWithContext(ctx context.Context, p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createVpnCustomerGateway", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1366,26 +1929,56 @@ func (s *VPNService) CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createVpnCustomerGateway", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "vpncustomergateway", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CreateVpnCustomerGatewayJob is a handle to a running createVpnCustomerGateway async job
+type CreateVpnCustomerGatewayJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateVpnCustomerGatewayResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateVpnCustomerGatewayJob) Result() (*CreateVpnCustomerGatewayResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateVpnCustomerGatewayResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "vpncustomergateway", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CreateVpnCustomerGatewayAsync starts the createVpnCustomerGateway async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPNService) CreateVpnCustomerGatewayAsync(ctx context.Context, p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createVpnCustomerGateway", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateVpnCustomerGatewayResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateVpnCustomerGatewayJob{s.cs.newJobHandle("createVpnCustomerGateway", r.JobID)}, nil
+}
+
 type CreateVpnCustomerGatewayResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Account     string `json:"account,omitempty"`
@@ -1412,6 +2005,10 @@ type DeleteVpnCustomerGatewayParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteVpnCustomerGatewayParams) command() string {
+	return "deleteVpnCustomerGateway"
+}
+
 func (p *DeleteVpnCustomerGatewayParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1441,7 +2038,13 @@ func (s *VPNService) NewDeleteVpnCustomerGatewayParams(id string) *DeleteVpnCust
 
 // Delete site to site vpn customer gateway
 func (s *VPNService) DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error) {
-	resp, err := s.cs.newRequest("deleteVpnCustomerGateway", p.toURLValues())
+	return s.DeleteVpnCustomerGatewayWithContext(context.Background(), p)
+}
+
+// DeleteVpnCustomerGatewayWithContext is the same as DeleteVpnCustomerGateway, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPNService) DeleteVpnCustomerGatewayWithContext(ctx context.Context, p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteVpnCustomerGateway", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1453,9 +2056,9 @@ func (s *VPNService) DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteVpnCustomerGateway", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -1468,6 +2071,41 @@ func (s *VPNService) DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams)
 	return &r, nil
 }
 
+// DeleteVpnCustomerGatewayJob is a handle to a running deleteVpnCustomerGateway async job
+type DeleteVpnCustomerGatewayJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteVpnCustomerGatewayResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteVpnCustomerGatewayJob) Result() (*DeleteVpnCustomerGatewayResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteVpnCustomerGatewayResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteVpnCustomerGatewayAsync starts the deleteVpnCustomerGateway async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPNService) DeleteVpnCustomerGatewayAsync(ctx context.Context, p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteVpnCustomerGateway", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteVpnCustomerGatewayResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteVpnCustomerGatewayJob{s.cs.newJobHandle("deleteVpnCustomerGateway", r.JobID)}, nil
+}
+
 type DeleteVpnCustomerGatewayResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -1478,6 +2116,10 @@ type UpdateVpnCustomerGatewayParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateVpnCustomerGatewayParams) command() string {
+	return "updateVpnCustomerGateway"
+}
+
 func (p *UpdateVpnCustomerGatewayParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1637,7 +2279,13 @@ func (s *VPNService) NewUpdateVpnCustomerGatewayParams(cidrlist []string, esppol
 
 // Update site to site vpn customer gateway
 func (s *VPNService) UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayResponse, error) {
-	resp, err := s.cs.newRequest("updateVpnCustomerGateway", p.toURLValues())
+	return s.UpdateVpnCustomerGatewayWithContext(context.Background(), p)
+}
+
+// UpdateVpnCustomerGatewayWithContext is the same as UpdateVpnCustomerGateway, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPNService) UpdateVpnCustomerGatewayWithContext(ctx context.Context, p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateVpnCustomerGateway", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1649,26 +2297,56 @@ func (s *VPNService) UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateVpnCustomerGateway", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "vpncustomergateway", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// UpdateVpnCustomerGatewayJob is a handle to a running updateVpnCustomerGateway async job
+type UpdateVpnCustomerGatewayJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateVpnCustomerGatewayResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateVpnCustomerGatewayJob) Result() (*UpdateVpnCustomerGatewayResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateVpnCustomerGatewayResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "vpncustomergateway", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// UpdateVpnCustomerGatewayAsync starts the updateVpnCustomerGateway async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPNService) UpdateVpnCustomerGatewayAsync(ctx context.Context, p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateVpnCustomerGateway", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateVpnCustomerGatewayResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateVpnCustomerGatewayJob{s.cs.newJobHandle("updateVpnCustomerGateway", r.JobID)}, nil
+}
+
 type UpdateVpnCustomerGatewayResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Account     string `json:"account,omitempty"`
@@ -1695,6 +2373,10 @@ type ListVpnCustomerGatewaysParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListVpnCustomerGatewaysParams) command() string {
+	return "listVpnCustomerGateways"
+}
+
 func (p *ListVpnCustomerGatewaysParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1824,7 +2506,7 @@ func (s *VPNService) GetVpnCustomerGatewayID(keyword string, opts ...OptionFunc)
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", keyword, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", keyword, l)
 	}
 
 	if l.Count == 1 {
@@ -1838,7 +2520,7 @@ func (s *VPNService) GetVpnCustomerGatewayID(keyword string, opts ...OptionFunc)
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -1870,16 +2552,14 @@ func (s *VPNService) GetVpnCustomerGatewayByID(id string, opts ...OptionFunc) (*
 
 	l, err := s.ListVpnCustomerGateways(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -1891,10 +2571,17 @@ func (s *VPNService) GetVpnCustomerGatewayByID(id string, opts ...OptionFunc) (*
 
 // Lists site to site vpn customer gateways
 func (s *VPNService) ListVpnCustomerGateways(p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error) {
+	return s.ListVpnCustomerGatewaysWithContext(context.Background(), p)
+}
+
+// ListVpnCustomerGatewaysWithContext is the same as ListVpnCustomerGateways, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPNService) ListVpnCustomerGatewaysWithContext(ctx context.Context, p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error) {
 	var r ListVpnCustomerGatewaysResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListVpnCustomerGatewaysResponse
-		resp, err := s.cs.newRequest("listVpnCustomerGateways", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listVpnCustomerGateways", values)
 		if err != nil {
 			return nil, err
 		}
@@ -1906,15 +2593,91 @@ func (s *VPNService) ListVpnCustomerGateways(p *ListVpnCustomerGatewaysParams) (
 		r.Count = l.Count
 		r.VpnCustomerGateways = append(r.VpnCustomerGateways, l.VpnCustomerGateways...)
 
-		if r.Count == len(r.VpnCustomerGateways) {
+		if r.Count == len(r.VpnCustomerGateways) || len(l.VpnCustomerGateways) == 0 {
+			s.cs.observePages("listVpnCustomerGateways", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.VpnCustomerGateways))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.VpnCustomerGateways)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listVpnCustomerGateways", values, len(l.VpnCustomerGateways), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListVpnCustomerGatewaysResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.VpnCustomerGateways = append(r.VpnCustomerGateways, l.VpnCustomerGateways...)
+			}
+
+			if r.Count == len(r.VpnCustomerGateways) {
+				s.cs.observePages("listVpnCustomerGateways", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
 	}
 }
 
+// ListVpnCustomerGatewaysIterator iterates over the items returned by listVpnCustomerGateways, requesting
+// the pages of the response one at a time while iterating.
+type ListVpnCustomerGatewaysIterator struct {
+	pager
+	items []*VpnCustomerGateway
+	index int
+}
+
+// NewListVpnCustomerGatewaysIterator returns an iterator over the items returned by listVpnCustomerGateways, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *VPNService) NewListVpnCustomerGatewaysIterator(p *ListVpnCustomerGatewaysParams, pagesize int) *ListVpnCustomerGatewaysIterator {
+	return &ListVpnCustomerGatewaysIterator{pager: newPager(s.cs, "listVpnCustomerGateways", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListVpnCustomerGatewaysIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListVpnCustomerGatewaysResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.VpnCustomerGateways))
+
+	i.items = l.VpnCustomerGateways
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListVpnCustomerGatewaysIterator) Value() *VpnCustomerGateway {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
+}
+
 type ListVpnCustomerGatewaysResponse struct {
 	Count               int                   `json:"count"`
 	VpnCustomerGateways []*VpnCustomerGateway `json:"vpncustomergateway"`
@@ -1945,6 +2708,10 @@ type CreateVpnGatewayParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateVpnGatewayParams) command() string {
+	return "createVpnGateway"
+}
+
 func (p *CreateVpnGatewayParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1985,7 +2752,13 @@ func (s *VPNService) NewCreateVpnGatewayParams(vpcid string) *CreateVpnGatewayPa
 
 // Creates site to site vpn local gateway
 func (s *VPNService) CreateVpnGateway(p *CreateVpnGatewayParams) (*CreateVpnGatewayResponse, error) {
-	resp, err := s.cs.newRequest("createVpnGateway", p.toURLValues())
+	return s.CreateVpnGatewayWithContext(context.Background(), p)
+}
+
+// CreateVpnGatewayWithContext is the same as CreateVpnGateway, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPNService) CreateVpnGatewayWithContext(ctx context.Context, p *CreateVpnGatewayParams) (*CreateVpnGatewayResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createVpnGateway", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1997,26 +2770,56 @@ func (s *VPNService) CreateVpnGateway(p *CreateVpnGatewayParams) (*CreateVpnGate
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createVpnGateway", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "vpngateway", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CreateVpnGatewayJob is a handle to a running createVpnGateway async job
+type CreateVpnGatewayJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateVpnGatewayResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateVpnGatewayJob) Result() (*CreateVpnGatewayResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateVpnGatewayResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "vpngateway", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CreateVpnGatewayAsync starts the createVpnGateway async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPNService) CreateVpnGatewayAsync(ctx context.Context, p *CreateVpnGatewayParams) (*CreateVpnGatewayJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createVpnGateway", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateVpnGatewayResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateVpnGatewayJob{s.cs.newJobHandle("createVpnGateway", r.JobID)}, nil
+}
+
 type CreateVpnGatewayResponse struct {
 	JobID      string `json:"jobid,omitempty"`
 	Account    string `json:"account,omitempty"`
@@ -2035,6 +2838,10 @@ type DeleteVpnGatewayParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteVpnGatewayParams) command() string {
+	return "deleteVpnGateway"
+}
+
 func (p *DeleteVpnGatewayParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2064,7 +2871,13 @@ func (s *VPNService) NewDeleteVpnGatewayParams(id string) *DeleteVpnGatewayParam
 
 // Delete site to site vpn gateway
 func (s *VPNService) DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error) {
-	resp, err := s.cs.newRequest("deleteVpnGateway", p.toURLValues())
+	return s.DeleteVpnGatewayWithContext(context.Background(), p)
+}
+
+// DeleteVpnGatewayWithContext is the same as DeleteVpnGateway, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPNService) DeleteVpnGatewayWithContext(ctx context.Context, p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteVpnGateway", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2076,9 +2889,9 @@ func (s *VPNService) DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGate
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deleteVpnGateway", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -2091,6 +2904,41 @@ func (s *VPNService) DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGate
 	return &r, nil
 }
 
+// DeleteVpnGatewayJob is a handle to a running deleteVpnGateway async job
+type DeleteVpnGatewayJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeleteVpnGatewayResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeleteVpnGatewayJob) Result() (*DeleteVpnGatewayResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeleteVpnGatewayResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// DeleteVpnGatewayAsync starts the deleteVpnGateway async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPNService) DeleteVpnGatewayAsync(ctx context.Context, p *DeleteVpnGatewayParams) (*DeleteVpnGatewayJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteVpnGateway", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteVpnGatewayResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeleteVpnGatewayJob{s.cs.newJobHandle("deleteVpnGateway", r.JobID)}, nil
+}
+
 type DeleteVpnGatewayResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -2101,6 +2949,10 @@ type UpdateVpnGatewayParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateVpnGatewayParams) command() string {
+	return "updateVpnGateway"
+}
+
 func (p *UpdateVpnGatewayParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2151,7 +3003,13 @@ func (s *VPNService) NewUpdateVpnGatewayParams(id string) *UpdateVpnGatewayParam
 
 // Updates site to site vpn local gateway
 func (s *VPNService) UpdateVpnGateway(p *UpdateVpnGatewayParams) (*UpdateVpnGatewayResponse, error) {
-	resp, err := s.cs.newRequest("updateVpnGateway", p.toURLValues())
+	return s.UpdateVpnGatewayWithContext(context.Background(), p)
+}
+
+// UpdateVpnGatewayWithContext is the same as UpdateVpnGateway, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPNService) UpdateVpnGatewayWithContext(ctx context.Context, p *UpdateVpnGatewayParams) (*UpdateVpnGatewayResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateVpnGateway", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2163,26 +3021,56 @@ func (s *VPNService) UpdateVpnGateway(p *UpdateVpnGatewayParams) (*UpdateVpnGate
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateVpnGateway", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "vpngateway", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// UpdateVpnGatewayJob is a handle to a running updateVpnGateway async job
+type UpdateVpnGatewayJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateVpnGatewayResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateVpnGatewayJob) Result() (*UpdateVpnGatewayResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateVpnGatewayResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "vpngateway", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// UpdateVpnGatewayAsync starts the updateVpnGateway async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPNService) UpdateVpnGatewayAsync(ctx context.Context, p *UpdateVpnGatewayParams) (*UpdateVpnGatewayJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateVpnGateway", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateVpnGatewayResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateVpnGatewayJob{s.cs.newJobHandle("updateVpnGateway", r.JobID)}, nil
+}
+
 type UpdateVpnGatewayResponse struct {
 	JobID      string `json:"jobid,omitempty"`
 	Account    string `json:"account,omitempty"`
@@ -2201,6 +3089,10 @@ type ListVpnGatewaysParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListVpnGatewaysParams) command() string {
+	return "listVpnGateways"
+}
+
 func (p *ListVpnGatewaysParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2347,16 +3239,14 @@ func (s *VPNService) GetVpnGatewayByID(id string, opts ...OptionFunc) (*VpnGatew
 
 	l, err := s.ListVpnGateways(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -2368,10 +3258,17 @@ func (s *VPNService) GetVpnGatewayByID(id string, opts ...OptionFunc) (*VpnGatew
 
 // Lists site 2 site vpn gateways
 func (s *VPNService) ListVpnGateways(p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error) {
+	return s.ListVpnGatewaysWithContext(context.Background(), p)
+}
+
+// ListVpnGatewaysWithContext is the same as ListVpnGateways, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPNService) ListVpnGatewaysWithContext(ctx context.Context, p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error) {
 	var r ListVpnGatewaysResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListVpnGatewaysResponse
-		resp, err := s.cs.newRequest("listVpnGateways", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listVpnGateways", values)
 		if err != nil {
 			return nil, err
 		}
@@ -2383,13 +3280,89 @@ func (s *VPNService) ListVpnGateways(p *ListVpnGatewaysParams) (*ListVpnGateways
 		r.Count = l.Count
 		r.VpnGateways = append(r.VpnGateways, l.VpnGateways...)
 
-		if r.Count == len(r.VpnGateways) {
+		if r.Count == len(r.VpnGateways) || len(l.VpnGateways) == 0 {
+			s.cs.observePages("listVpnGateways", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.VpnGateways))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.VpnGateways)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listVpnGateways", values, len(l.VpnGateways), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListVpnGatewaysResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.VpnGateways = append(r.VpnGateways, l.VpnGateways...)
+			}
+
+			if r.Count == len(r.VpnGateways) {
+				s.cs.observePages("listVpnGateways", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListVpnGatewaysIterator iterates over the items returned by listVpnGateways, requesting
+// the pages of the response one at a time while iterating.
+type ListVpnGatewaysIterator struct {
+	pager
+	items []*VpnGateway
+	index int
+}
+
+// NewListVpnGatewaysIterator returns an iterator over the items returned by listVpnGateways, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *VPNService) NewListVpnGatewaysIterator(p *ListVpnGatewaysParams, pagesize int) *ListVpnGatewaysIterator {
+	return &ListVpnGatewaysIterator{pager: newPager(s.cs, "listVpnGateways", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListVpnGatewaysIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListVpnGatewaysResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.VpnGateways))
+
+	i.items = l.VpnGateways
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListVpnGatewaysIterator) Value() *VpnGateway {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListVpnGatewaysResponse struct {
@@ -2414,6 +3387,10 @@ type AddVpnUserParams struct {
 	p map[string]interface{}
 }
 
+func (p *AddVpnUserParams) command() string {
+	return "addVpnUser"
+}
+
 func (p *AddVpnUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2484,7 +3461,13 @@ func (s *VPNService) NewAddVpnUserParams(password string, username string) *AddV
 
 // Adds vpn users
 func (s *VPNService) AddVpnUser(p *AddVpnUserParams) (*AddVpnUserResponse, error) {
-	resp, err := s.cs.newRequest("addVpnUser", p.toURLValues())
+	return s.AddVpnUserWithContext(context.Background(), p)
+}
+
+// AddVpnUserWithContext is the same as AddVpnUser, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPNService) AddVpnUserWithContext(ctx context.Context, p *AddVpnUserParams) (*AddVpnUserResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "addVpnUser", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2496,26 +3479,56 @@ func (s *VPNService) AddVpnUser(p *AddVpnUserParams) (*AddVpnUserResponse, error
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "addVpnUser", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "vpnuser", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// AddVpnUserJob is a handle to a running addVpnUser async job
+type AddVpnUserJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a AddVpnUserResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *AddVpnUserJob) Result() (*AddVpnUserResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := AddVpnUserResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "vpnuser", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// AddVpnUserAsync starts the addVpnUser async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPNService) AddVpnUserAsync(ctx context.Context, p *AddVpnUserParams) (*AddVpnUserJob, error) {
+	resp, err := s.cs.newRequest(ctx, "addVpnUser", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddVpnUserResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &AddVpnUserJob{s.cs.newJobHandle("addVpnUser", r.JobID)}, nil
+}
+
 type AddVpnUserResponse struct {
 	JobID     string `json:"jobid,omitempty"`
 	Account   string `json:"account,omitempty"`
@@ -2532,6 +3545,10 @@ type RemoveVpnUserParams struct {
 	p map[string]interface{}
 }
 
+func (p *RemoveVpnUserParams) command() string {
+	return "removeVpnUser"
+}
+
 func (p *RemoveVpnUserParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2591,7 +3608,13 @@ func (s *VPNService) NewRemoveVpnUserParams(username string) *RemoveVpnUserParam
 
 // Removes vpn user
 func (s *VPNService) RemoveVpnUser(p *RemoveVpnUserParams) (*RemoveVpnUserResponse, error) {
-	resp, err := s.cs.newRequest("removeVpnUser", p.toURLValues())
+	return s.RemoveVpnUserWithContext(context.Background(), p)
+}
+
+// RemoveVpnUserWithContext is the same as RemoveVpnUser, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPNService) RemoveVpnUserWithContext(ctx context.Context, p *RemoveVpnUserParams) (*RemoveVpnUserResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "removeVpnUser", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2603,9 +3626,9 @@ func (s *VPNService) RemoveVpnUser(p *RemoveVpnUserParams) (*RemoveVpnUserRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "removeVpnUser", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -2618,6 +3641,41 @@ func (s *VPNService) RemoveVpnUser(p *RemoveVpnUserParams) (*RemoveVpnUserRespon
 	return &r, nil
 }
 
+// RemoveVpnUserJob is a handle to a running removeVpnUser async job
+type RemoveVpnUserJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a RemoveVpnUserResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *RemoveVpnUserJob) Result() (*RemoveVpnUserResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := RemoveVpnUserResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// RemoveVpnUserAsync starts the removeVpnUser async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VPNService) RemoveVpnUserAsync(ctx context.Context, p *RemoveVpnUserParams) (*RemoveVpnUserJob, error) {
+	resp, err := s.cs.newRequest(ctx, "removeVpnUser", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RemoveVpnUserResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &RemoveVpnUserJob{s.cs.newJobHandle("removeVpnUser", r.JobID)}, nil
+}
+
 type RemoveVpnUserResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -2628,6 +3686,10 @@ type ListVpnUsersParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListVpnUsersParams) command() string {
+	return "listVpnUsers"
+}
+
 func (p *ListVpnUsersParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2763,16 +3825,14 @@ func (s *VPNService) GetVpnUserByID(id string, opts ...OptionFunc) (*VpnUser, in
 
 	l, err := s.ListVpnUsers(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -2784,10 +3844,17 @@ func (s *VPNService) GetVpnUserByID(id string, opts ...OptionFunc) (*VpnUser, in
 
 // Lists vpn users
 func (s *VPNService) ListVpnUsers(p *ListVpnUsersParams) (*ListVpnUsersResponse, error) {
+	return s.ListVpnUsersWithContext(context.Background(), p)
+}
+
+// ListVpnUsersWithContext is the same as ListVpnUsers, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VPNService) ListVpnUsersWithContext(ctx context.Context, p *ListVpnUsersParams) (*ListVpnUsersResponse, error) {
 	var r ListVpnUsersResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListVpnUsersResponse
-		resp, err := s.cs.newRequest("listVpnUsers", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listVpnUsers", values)
 		if err != nil {
 			return nil, err
 		}
@@ -2799,13 +3866,89 @@ func (s *VPNService) ListVpnUsers(p *ListVpnUsersParams) (*ListVpnUsersResponse,
 		r.Count = l.Count
 		r.VpnUsers = append(r.VpnUsers, l.VpnUsers...)
 
-		if r.Count == len(r.VpnUsers) {
+		if r.Count == len(r.VpnUsers) || len(l.VpnUsers) == 0 {
+			s.cs.observePages("listVpnUsers", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.VpnUsers))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.VpnUsers)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listVpnUsers", values, len(l.VpnUsers), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListVpnUsersResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.VpnUsers = append(r.VpnUsers, l.VpnUsers...)
+			}
+
+			if r.Count == len(r.VpnUsers) {
+				s.cs.observePages("listVpnUsers", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListVpnUsersIterator iterates over the items returned by listVpnUsers, requesting
+// the pages of the response one at a time while iterating.
+type ListVpnUsersIterator struct {
+	pager
+	items []*VpnUser
+	index int
+}
+
+// NewListVpnUsersIterator returns an iterator over the items returned by listVpnUsers, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *VPNService) NewListVpnUsersIterator(p *ListVpnUsersParams, pagesize int) *ListVpnUsersIterator {
+	return &ListVpnUsersIterator{pager: newPager(s.cs, "listVpnUsers", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListVpnUsersIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListVpnUsersResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.VpnUsers))
+
+	i.items = l.VpnUsers
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListVpnUsersIterator) Value() *VpnUser {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListVpnUsersResponse struct {
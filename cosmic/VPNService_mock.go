@@ -0,0 +1,637 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockVPNService is a mock of VPNServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockVPNService struct {
+	mockCalls
+
+	CreateRemoteAccessVpnFunc         func(ctx context.Context, p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnResponse, error)
+	CreateRemoteAccessVpnAsyncFunc    func(ctx context.Context, p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnJob, error)
+	DeleteRemoteAccessVpnFunc         func(ctx context.Context, p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnResponse, error)
+	DeleteRemoteAccessVpnAsyncFunc    func(ctx context.Context, p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnJob, error)
+	UpdateRemoteAccessVpnFunc         func(ctx context.Context, p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnResponse, error)
+	UpdateRemoteAccessVpnAsyncFunc    func(ctx context.Context, p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnJob, error)
+	GetRemoteAccessVpnByIDFunc        func(id string, opts ...OptionFunc) (*RemoteAccessVpn, int, error)
+	ListRemoteAccessVpnsFunc          func(ctx context.Context, p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error)
+	CreateVpnConnectionFunc           func(ctx context.Context, p *CreateVpnConnectionParams) (*CreateVpnConnectionResponse, error)
+	CreateVpnConnectionAsyncFunc      func(ctx context.Context, p *CreateVpnConnectionParams) (*CreateVpnConnectionJob, error)
+	DeleteVpnConnectionFunc           func(ctx context.Context, p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error)
+	DeleteVpnConnectionAsyncFunc      func(ctx context.Context, p *DeleteVpnConnectionParams) (*DeleteVpnConnectionJob, error)
+	ResetVpnConnectionFunc            func(ctx context.Context, p *ResetVpnConnectionParams) (*ResetVpnConnectionResponse, error)
+	ResetVpnConnectionAsyncFunc       func(ctx context.Context, p *ResetVpnConnectionParams) (*ResetVpnConnectionJob, error)
+	UpdateVpnConnectionFunc           func(ctx context.Context, p *UpdateVpnConnectionParams) (*UpdateVpnConnectionResponse, error)
+	UpdateVpnConnectionAsyncFunc      func(ctx context.Context, p *UpdateVpnConnectionParams) (*UpdateVpnConnectionJob, error)
+	GetVpnConnectionByIDFunc          func(id string, opts ...OptionFunc) (*VpnConnection, int, error)
+	ListVpnConnectionsFunc            func(ctx context.Context, p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error)
+	CreateVpnCustomerGatewayFunc      func(ctx context.Context, p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error)
+	CreateVpnCustomerGatewayAsyncFunc func(ctx context.Context, p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayJob, error)
+	DeleteVpnCustomerGatewayFunc      func(ctx context.Context, p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error)
+	DeleteVpnCustomerGatewayAsyncFunc func(ctx context.Context, p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayJob, error)
+	UpdateVpnCustomerGatewayFunc      func(ctx context.Context, p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayResponse, error)
+	UpdateVpnCustomerGatewayAsyncFunc func(ctx context.Context, p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayJob, error)
+	GetVpnCustomerGatewayIDFunc       func(keyword string, opts ...OptionFunc) (string, int, error)
+	GetVpnCustomerGatewayByNameFunc   func(name string, opts ...OptionFunc) (*VpnCustomerGateway, int, error)
+	GetVpnCustomerGatewayByIDFunc     func(id string, opts ...OptionFunc) (*VpnCustomerGateway, int, error)
+	ListVpnCustomerGatewaysFunc       func(ctx context.Context, p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error)
+	CreateVpnGatewayFunc              func(ctx context.Context, p *CreateVpnGatewayParams) (*CreateVpnGatewayResponse, error)
+	CreateVpnGatewayAsyncFunc         func(ctx context.Context, p *CreateVpnGatewayParams) (*CreateVpnGatewayJob, error)
+	DeleteVpnGatewayFunc              func(ctx context.Context, p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error)
+	DeleteVpnGatewayAsyncFunc         func(ctx context.Context, p *DeleteVpnGatewayParams) (*DeleteVpnGatewayJob, error)
+	UpdateVpnGatewayFunc              func(ctx context.Context, p *UpdateVpnGatewayParams) (*UpdateVpnGatewayResponse, error)
+	UpdateVpnGatewayAsyncFunc         func(ctx context.Context, p *UpdateVpnGatewayParams) (*UpdateVpnGatewayJob, error)
+	GetVpnGatewayByIDFunc             func(id string, opts ...OptionFunc) (*VpnGateway, int, error)
+	ListVpnGatewaysFunc               func(ctx context.Context, p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error)
+	AddVpnUserFunc                    func(ctx context.Context, p *AddVpnUserParams) (*AddVpnUserResponse, error)
+	AddVpnUserAsyncFunc               func(ctx context.Context, p *AddVpnUserParams) (*AddVpnUserJob, error)
+	RemoveVpnUserFunc                 func(ctx context.Context, p *RemoveVpnUserParams) (*RemoveVpnUserResponse, error)
+	RemoveVpnUserAsyncFunc            func(ctx context.Context, p *RemoveVpnUserParams) (*RemoveVpnUserJob, error)
+	GetVpnUserByIDFunc                func(id string, opts ...OptionFunc) (*VpnUser, int, error)
+	ListVpnUsersFunc                  func(ctx context.Context, p *ListVpnUsersParams) (*ListVpnUsersResponse, error)
+}
+
+var _ VPNServiceIface = &MockVPNService{}
+
+func (m *MockVPNService) NewCreateRemoteAccessVpnParams(publicipid string) *CreateRemoteAccessVpnParams {
+	return (&VPNService{}).NewCreateRemoteAccessVpnParams(publicipid)
+}
+
+func (m *MockVPNService) CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnResponse, error) {
+	return m.CreateRemoteAccessVpnWithContext(context.Background(), p)
+}
+
+func (m *MockVPNService) CreateRemoteAccessVpnWithContext(ctx context.Context, p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnResponse, error) {
+	m.record("CreateRemoteAccessVpn", p)
+	if m.CreateRemoteAccessVpnFunc == nil {
+		return nil, notMocked("MockVPNService", "CreateRemoteAccessVpn")
+	}
+	return m.CreateRemoteAccessVpnFunc(ctx, p)
+}
+
+func (m *MockVPNService) CreateRemoteAccessVpnAsync(ctx context.Context, p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnJob, error) {
+	m.record("CreateRemoteAccessVpnAsync", p)
+	if m.CreateRemoteAccessVpnAsyncFunc == nil {
+		return nil, notMocked("MockVPNService", "CreateRemoteAccessVpnAsync")
+	}
+	return m.CreateRemoteAccessVpnAsyncFunc(ctx, p)
+}
+
+func (m *MockVPNService) NewDeleteRemoteAccessVpnParams(publicipid string) *DeleteRemoteAccessVpnParams {
+	return (&VPNService{}).NewDeleteRemoteAccessVpnParams(publicipid)
+}
+
+func (m *MockVPNService) DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnResponse, error) {
+	return m.DeleteRemoteAccessVpnWithContext(context.Background(), p)
+}
+
+func (m *MockVPNService) DeleteRemoteAccessVpnWithContext(ctx context.Context, p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnResponse, error) {
+	m.record("DeleteRemoteAccessVpn", p)
+	if m.DeleteRemoteAccessVpnFunc == nil {
+		return nil, notMocked("MockVPNService", "DeleteRemoteAccessVpn")
+	}
+	return m.DeleteRemoteAccessVpnFunc(ctx, p)
+}
+
+func (m *MockVPNService) DeleteRemoteAccessVpnAsync(ctx context.Context, p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnJob, error) {
+	m.record("DeleteRemoteAccessVpnAsync", p)
+	if m.DeleteRemoteAccessVpnAsyncFunc == nil {
+		return nil, notMocked("MockVPNService", "DeleteRemoteAccessVpnAsync")
+	}
+	return m.DeleteRemoteAccessVpnAsyncFunc(ctx, p)
+}
+
+func (m *MockVPNService) NewUpdateRemoteAccessVpnParams(id string) *UpdateRemoteAccessVpnParams {
+	return (&VPNService{}).NewUpdateRemoteAccessVpnParams(id)
+}
+
+func (m *MockVPNService) UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnResponse, error) {
+	return m.UpdateRemoteAccessVpnWithContext(context.Background(), p)
+}
+
+func (m *MockVPNService) UpdateRemoteAccessVpnWithContext(ctx context.Context, p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnResponse, error) {
+	m.record("UpdateRemoteAccessVpn", p)
+	if m.UpdateRemoteAccessVpnFunc == nil {
+		return nil, notMocked("MockVPNService", "UpdateRemoteAccessVpn")
+	}
+	return m.UpdateRemoteAccessVpnFunc(ctx, p)
+}
+
+func (m *MockVPNService) UpdateRemoteAccessVpnAsync(ctx context.Context, p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnJob, error) {
+	m.record("UpdateRemoteAccessVpnAsync", p)
+	if m.UpdateRemoteAccessVpnAsyncFunc == nil {
+		return nil, notMocked("MockVPNService", "UpdateRemoteAccessVpnAsync")
+	}
+	return m.UpdateRemoteAccessVpnAsyncFunc(ctx, p)
+}
+
+func (m *MockVPNService) NewListRemoteAccessVpnsParams() *ListRemoteAccessVpnsParams {
+	return (&VPNService{}).NewListRemoteAccessVpnsParams()
+}
+
+func (m *MockVPNService) GetRemoteAccessVpnByID(id string, opts ...OptionFunc) (*RemoteAccessVpn, int, error) {
+	m.record("GetRemoteAccessVpnByID", id, opts)
+	if m.GetRemoteAccessVpnByIDFunc == nil {
+		return nil, -1, notMocked("MockVPNService", "GetRemoteAccessVpnByID")
+	}
+	return m.GetRemoteAccessVpnByIDFunc(id, opts...)
+}
+
+func (m *MockVPNService) ListRemoteAccessVpns(p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error) {
+	return m.ListRemoteAccessVpnsWithContext(context.Background(), p)
+}
+
+func (m *MockVPNService) ListRemoteAccessVpnsWithContext(ctx context.Context, p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error) {
+	m.record("ListRemoteAccessVpns", p)
+	if m.ListRemoteAccessVpnsFunc == nil {
+		return nil, notMocked("MockVPNService", "ListRemoteAccessVpns")
+	}
+	return m.ListRemoteAccessVpnsFunc(ctx, p)
+}
+
+func (m *MockVPNService) NewListRemoteAccessVpnsIterator(p *ListRemoteAccessVpnsParams, pagesize int) *ListRemoteAccessVpnsIterator {
+	i := &ListRemoteAccessVpnsIterator{pager: newPager(nil, "listRemoteAccessVpns", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListRemoteAccessVpnsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListRemoteAccessVpnsWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockVPNService) NewCreateVpnConnectionParams(s2scustomergatewayid string, s2svpngatewayid string) *CreateVpnConnectionParams {
+	return (&VPNService{}).NewCreateVpnConnectionParams(s2scustomergatewayid, s2svpngatewayid)
+}
+
+func (m *MockVPNService) CreateVpnConnection(p *CreateVpnConnectionParams) (*CreateVpnConnectionResponse, error) {
+	return m.CreateVpnConnectionWithContext(context.Background(), p)
+}
+
+func (m *MockVPNService) CreateVpnConnectionWithContext(ctx context.Context, p *CreateVpnConnectionParams) (*CreateVpnConnectionResponse, error) {
+	m.record("CreateVpnConnection", p)
+	if m.CreateVpnConnectionFunc == nil {
+		return nil, notMocked("MockVPNService", "CreateVpnConnection")
+	}
+	return m.CreateVpnConnectionFunc(ctx, p)
+}
+
+func (m *MockVPNService) CreateVpnConnectionAsync(ctx context.Context, p *CreateVpnConnectionParams) (*CreateVpnConnectionJob, error) {
+	m.record("CreateVpnConnectionAsync", p)
+	if m.CreateVpnConnectionAsyncFunc == nil {
+		return nil, notMocked("MockVPNService", "CreateVpnConnectionAsync")
+	}
+	return m.CreateVpnConnectionAsyncFunc(ctx, p)
+}
+
+func (m *MockVPNService) NewDeleteVpnConnectionParams(id string) *DeleteVpnConnectionParams {
+	return (&VPNService{}).NewDeleteVpnConnectionParams(id)
+}
+
+func (m *MockVPNService) DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error) {
+	return m.DeleteVpnConnectionWithContext(context.Background(), p)
+}
+
+func (m *MockVPNService) DeleteVpnConnectionWithContext(ctx context.Context, p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error) {
+	m.record("DeleteVpnConnection", p)
+	if m.DeleteVpnConnectionFunc == nil {
+		return nil, notMocked("MockVPNService", "DeleteVpnConnection")
+	}
+	return m.DeleteVpnConnectionFunc(ctx, p)
+}
+
+func (m *MockVPNService) DeleteVpnConnectionAsync(ctx context.Context, p *DeleteVpnConnectionParams) (*DeleteVpnConnectionJob, error) {
+	m.record("DeleteVpnConnectionAsync", p)
+	if m.DeleteVpnConnectionAsyncFunc == nil {
+		return nil, notMocked("MockVPNService", "DeleteVpnConnectionAsync")
+	}
+	return m.DeleteVpnConnectionAsyncFunc(ctx, p)
+}
+
+func (m *MockVPNService) NewResetVpnConnectionParams(id string) *ResetVpnConnectionParams {
+	return (&VPNService{}).NewResetVpnConnectionParams(id)
+}
+
+func (m *MockVPNService) ResetVpnConnection(p *ResetVpnConnectionParams) (*ResetVpnConnectionResponse, error) {
+	return m.ResetVpnConnectionWithContext(context.Background(), p)
+}
+
+func (m *MockVPNService) ResetVpnConnectionWithContext(ctx context.Context, p *ResetVpnConnectionParams) (*ResetVpnConnectionResponse, error) {
+	m.record("ResetVpnConnection", p)
+	if m.ResetVpnConnectionFunc == nil {
+		return nil, notMocked("MockVPNService", "ResetVpnConnection")
+	}
+	return m.ResetVpnConnectionFunc(ctx, p)
+}
+
+func (m *MockVPNService) ResetVpnConnectionAsync(ctx context.Context, p *ResetVpnConnectionParams) (*ResetVpnConnectionJob, error) {
+	m.record("ResetVpnConnectionAsync", p)
+	if m.ResetVpnConnectionAsyncFunc == nil {
+		return nil, notMocked("MockVPNService", "ResetVpnConnectionAsync")
+	}
+	return m.ResetVpnConnectionAsyncFunc(ctx, p)
+}
+
+func (m *MockVPNService) NewUpdateVpnConnectionParams(id string) *UpdateVpnConnectionParams {
+	return (&VPNService{}).NewUpdateVpnConnectionParams(id)
+}
+
+func (m *MockVPNService) UpdateVpnConnection(p *UpdateVpnConnectionParams) (*UpdateVpnConnectionResponse, error) {
+	return m.UpdateVpnConnectionWithContext(context.Background(), p)
+}
+
+func (m *MockVPNService) UpdateVpnConnectionWithContext(ctx context.Context, p *UpdateVpnConnectionParams) (*UpdateVpnConnectionResponse, error) {
+	m.record("UpdateVpnConnection", p)
+	if m.UpdateVpnConnectionFunc == nil {
+		return nil, notMocked("MockVPNService", "UpdateVpnConnection")
+	}
+	return m.UpdateVpnConnectionFunc(ctx, p)
+}
+
+func (m *MockVPNService) UpdateVpnConnectionAsync(ctx context.Context, p *UpdateVpnConnectionParams) (*UpdateVpnConnectionJob, error) {
+	m.record("UpdateVpnConnectionAsync", p)
+	if m.UpdateVpnConnectionAsyncFunc == nil {
+		return nil, notMocked("MockVPNService", "UpdateVpnConnectionAsync")
+	}
+	return m.UpdateVpnConnectionAsyncFunc(ctx, p)
+}
+
+func (m *MockVPNService) NewListVpnConnectionsParams() *ListVpnConnectionsParams {
+	return (&VPNService{}).NewListVpnConnectionsParams()
+}
+
+func (m *MockVPNService) GetVpnConnectionByID(id string, opts ...OptionFunc) (*VpnConnection, int, error) {
+	m.record("GetVpnConnectionByID", id, opts)
+	if m.GetVpnConnectionByIDFunc == nil {
+		return nil, -1, notMocked("MockVPNService", "GetVpnConnectionByID")
+	}
+	return m.GetVpnConnectionByIDFunc(id, opts...)
+}
+
+func (m *MockVPNService) ListVpnConnections(p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error) {
+	return m.ListVpnConnectionsWithContext(context.Background(), p)
+}
+
+func (m *MockVPNService) ListVpnConnectionsWithContext(ctx context.Context, p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error) {
+	m.record("ListVpnConnections", p)
+	if m.ListVpnConnectionsFunc == nil {
+		return nil, notMocked("MockVPNService", "ListVpnConnections")
+	}
+	return m.ListVpnConnectionsFunc(ctx, p)
+}
+
+func (m *MockVPNService) NewListVpnConnectionsIterator(p *ListVpnConnectionsParams, pagesize int) *ListVpnConnectionsIterator {
+	i := &ListVpnConnectionsIterator{pager: newPager(nil, "listVpnConnections", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListVpnConnectionsParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListVpnConnectionsWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockVPNService) NewCreateVpnCustomerGatewayParams(cidrlist []string, esppolicy string, gateway string, ikepolicy string, ipsecpsk string) *CreateVpnCustomerGatewayParams {
+	return (&VPNService{}).NewCreateVpnCustomerGatewayParams(cidrlist, esppolicy, gateway, ikepolicy, ipsecpsk)
+}
+
+func (m *MockVPNService) CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error) {
+	return m.CreateVpnCustomerGatewayWithContext(context.Background(), p)
+}
+
+func (m *MockVPNService) CreateVpnCustomerGatewayWithContext(ctx context.Context, p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error) {
+	m.record("CreateVpnCustomerGateway", p)
+	if m.CreateVpnCustomerGatewayFunc == nil {
+		return nil, notMocked("MockVPNService", "CreateVpnCustomerGateway")
+	}
+	return m.CreateVpnCustomerGatewayFunc(ctx, p)
+}
+
+func (m *MockVPNService) CreateVpnCustomerGatewayAsync(ctx context.Context, p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayJob, error) {
+	m.record("CreateVpnCustomerGatewayAsync", p)
+	if m.CreateVpnCustomerGatewayAsyncFunc == nil {
+		return nil, notMocked("MockVPNService", "CreateVpnCustomerGatewayAsync")
+	}
+	return m.CreateVpnCustomerGatewayAsyncFunc(ctx, p)
+}
+
+func (m *MockVPNService) NewDeleteVpnCustomerGatewayParams(id string) *DeleteVpnCustomerGatewayParams {
+	return (&VPNService{}).NewDeleteVpnCustomerGatewayParams(id)
+}
+
+func (m *MockVPNService) DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error) {
+	return m.DeleteVpnCustomerGatewayWithContext(context.Background(), p)
+}
+
+func (m *MockVPNService) DeleteVpnCustomerGatewayWithContext(ctx context.Context, p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error) {
+	m.record("DeleteVpnCustomerGateway", p)
+	if m.DeleteVpnCustomerGatewayFunc == nil {
+		return nil, notMocked("MockVPNService", "DeleteVpnCustomerGateway")
+	}
+	return m.DeleteVpnCustomerGatewayFunc(ctx, p)
+}
+
+func (m *MockVPNService) DeleteVpnCustomerGatewayAsync(ctx context.Context, p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayJob, error) {
+	m.record("DeleteVpnCustomerGatewayAsync", p)
+	if m.DeleteVpnCustomerGatewayAsyncFunc == nil {
+		return nil, notMocked("MockVPNService", "DeleteVpnCustomerGatewayAsync")
+	}
+	return m.DeleteVpnCustomerGatewayAsyncFunc(ctx, p)
+}
+
+func (m *MockVPNService) NewUpdateVpnCustomerGatewayParams(cidrlist []string, esppolicy string, gateway string, id string, ikepolicy string, ipsecpsk string) *UpdateVpnCustomerGatewayParams {
+	return (&VPNService{}).NewUpdateVpnCustomerGatewayParams(cidrlist, esppolicy, gateway, id, ikepolicy, ipsecpsk)
+}
+
+func (m *MockVPNService) UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayResponse, error) {
+	return m.UpdateVpnCustomerGatewayWithContext(context.Background(), p)
+}
+
+func (m *MockVPNService) UpdateVpnCustomerGatewayWithContext(ctx context.Context, p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayResponse, error) {
+	m.record("UpdateVpnCustomerGateway", p)
+	if m.UpdateVpnCustomerGatewayFunc == nil {
+		return nil, notMocked("MockVPNService", "UpdateVpnCustomerGateway")
+	}
+	return m.UpdateVpnCustomerGatewayFunc(ctx, p)
+}
+
+func (m *MockVPNService) UpdateVpnCustomerGatewayAsync(ctx context.Context, p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayJob, error) {
+	m.record("UpdateVpnCustomerGatewayAsync", p)
+	if m.UpdateVpnCustomerGatewayAsyncFunc == nil {
+		return nil, notMocked("MockVPNService", "UpdateVpnCustomerGatewayAsync")
+	}
+	return m.UpdateVpnCustomerGatewayAsyncFunc(ctx, p)
+}
+
+func (m *MockVPNService) NewListVpnCustomerGatewaysParams() *ListVpnCustomerGatewaysParams {
+	return (&VPNService{}).NewListVpnCustomerGatewaysParams()
+}
+
+func (m *MockVPNService) GetVpnCustomerGatewayID(keyword string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetVpnCustomerGatewayID", keyword, opts)
+	if m.GetVpnCustomerGatewayIDFunc == nil {
+		return "", -1, notMocked("MockVPNService", "GetVpnCustomerGatewayID")
+	}
+	return m.GetVpnCustomerGatewayIDFunc(keyword, opts...)
+}
+
+func (m *MockVPNService) GetVpnCustomerGatewayByName(name string, opts ...OptionFunc) (*VpnCustomerGateway, int, error) {
+	m.record("GetVpnCustomerGatewayByName", name, opts)
+	if m.GetVpnCustomerGatewayByNameFunc == nil {
+		return nil, -1, notMocked("MockVPNService", "GetVpnCustomerGatewayByName")
+	}
+	return m.GetVpnCustomerGatewayByNameFunc(name, opts...)
+}
+
+func (m *MockVPNService) GetVpnCustomerGatewayByID(id string, opts ...OptionFunc) (*VpnCustomerGateway, int, error) {
+	m.record("GetVpnCustomerGatewayByID", id, opts)
+	if m.GetVpnCustomerGatewayByIDFunc == nil {
+		return nil, -1, notMocked("MockVPNService", "GetVpnCustomerGatewayByID")
+	}
+	return m.GetVpnCustomerGatewayByIDFunc(id, opts...)
+}
+
+func (m *MockVPNService) ListVpnCustomerGateways(p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error) {
+	return m.ListVpnCustomerGatewaysWithContext(context.Background(), p)
+}
+
+func (m *MockVPNService) ListVpnCustomerGatewaysWithContext(ctx context.Context, p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error) {
+	m.record("ListVpnCustomerGateways", p)
+	if m.ListVpnCustomerGatewaysFunc == nil {
+		return nil, notMocked("MockVPNService", "ListVpnCustomerGateways")
+	}
+	return m.ListVpnCustomerGatewaysFunc(ctx, p)
+}
+
+func (m *MockVPNService) NewListVpnCustomerGatewaysIterator(p *ListVpnCustomerGatewaysParams, pagesize int) *ListVpnCustomerGatewaysIterator {
+	i := &ListVpnCustomerGatewaysIterator{pager: newPager(nil, "listVpnCustomerGateways", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListVpnCustomerGatewaysParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListVpnCustomerGatewaysWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockVPNService) NewCreateVpnGatewayParams(vpcid string) *CreateVpnGatewayParams {
+	return (&VPNService{}).NewCreateVpnGatewayParams(vpcid)
+}
+
+func (m *MockVPNService) CreateVpnGateway(p *CreateVpnGatewayParams) (*CreateVpnGatewayResponse, error) {
+	return m.CreateVpnGatewayWithContext(context.Background(), p)
+}
+
+func (m *MockVPNService) CreateVpnGatewayWithContext(ctx context.Context, p *CreateVpnGatewayParams) (*CreateVpnGatewayResponse, error) {
+	m.record("CreateVpnGateway", p)
+	if m.CreateVpnGatewayFunc == nil {
+		return nil, notMocked("MockVPNService", "CreateVpnGateway")
+	}
+	return m.CreateVpnGatewayFunc(ctx, p)
+}
+
+func (m *MockVPNService) CreateVpnGatewayAsync(ctx context.Context, p *CreateVpnGatewayParams) (*CreateVpnGatewayJob, error) {
+	m.record("CreateVpnGatewayAsync", p)
+	if m.CreateVpnGatewayAsyncFunc == nil {
+		return nil, notMocked("MockVPNService", "CreateVpnGatewayAsync")
+	}
+	return m.CreateVpnGatewayAsyncFunc(ctx, p)
+}
+
+func (m *MockVPNService) NewDeleteVpnGatewayParams(id string) *DeleteVpnGatewayParams {
+	return (&VPNService{}).NewDeleteVpnGatewayParams(id)
+}
+
+func (m *MockVPNService) DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error) {
+	return m.DeleteVpnGatewayWithContext(context.Background(), p)
+}
+
+func (m *MockVPNService) DeleteVpnGatewayWithContext(ctx context.Context, p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error) {
+	m.record("DeleteVpnGateway", p)
+	if m.DeleteVpnGatewayFunc == nil {
+		return nil, notMocked("MockVPNService", "DeleteVpnGateway")
+	}
+	return m.DeleteVpnGatewayFunc(ctx, p)
+}
+
+func (m *MockVPNService) DeleteVpnGatewayAsync(ctx context.Context, p *DeleteVpnGatewayParams) (*DeleteVpnGatewayJob, error) {
+	m.record("DeleteVpnGatewayAsync", p)
+	if m.DeleteVpnGatewayAsyncFunc == nil {
+		return nil, notMocked("MockVPNService", "DeleteVpnGatewayAsync")
+	}
+	return m.DeleteVpnGatewayAsyncFunc(ctx, p)
+}
+
+func (m *MockVPNService) NewUpdateVpnGatewayParams(id string) *UpdateVpnGatewayParams {
+	return (&VPNService{}).NewUpdateVpnGatewayParams(id)
+}
+
+func (m *MockVPNService) UpdateVpnGateway(p *UpdateVpnGatewayParams) (*UpdateVpnGatewayResponse, error) {
+	return m.UpdateVpnGatewayWithContext(context.Background(), p)
+}
+
+func (m *MockVPNService) UpdateVpnGatewayWithContext(ctx context.Context, p *UpdateVpnGatewayParams) (*UpdateVpnGatewayResponse, error) {
+	m.record("UpdateVpnGateway", p)
+	if m.UpdateVpnGatewayFunc == nil {
+		return nil, notMocked("MockVPNService", "UpdateVpnGateway")
+	}
+	return m.UpdateVpnGatewayFunc(ctx, p)
+}
+
+func (m *MockVPNService) UpdateVpnGatewayAsync(ctx context.Context, p *UpdateVpnGatewayParams) (*UpdateVpnGatewayJob, error) {
+	m.record("UpdateVpnGatewayAsync", p)
+	if m.UpdateVpnGatewayAsyncFunc == nil {
+		return nil, notMocked("MockVPNService", "UpdateVpnGatewayAsync")
+	}
+	return m.UpdateVpnGatewayAsyncFunc(ctx, p)
+}
+
+func (m *MockVPNService) NewListVpnGatewaysParams() *ListVpnGatewaysParams {
+	return (&VPNService{}).NewListVpnGatewaysParams()
+}
+
+func (m *MockVPNService) GetVpnGatewayByID(id string, opts ...OptionFunc) (*VpnGateway, int, error) {
+	m.record("GetVpnGatewayByID", id, opts)
+	if m.GetVpnGatewayByIDFunc == nil {
+		return nil, -1, notMocked("MockVPNService", "GetVpnGatewayByID")
+	}
+	return m.GetVpnGatewayByIDFunc(id, opts...)
+}
+
+func (m *MockVPNService) ListVpnGateways(p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error) {
+	return m.ListVpnGatewaysWithContext(context.Background(), p)
+}
+
+func (m *MockVPNService) ListVpnGatewaysWithContext(ctx context.Context, p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error) {
+	m.record("ListVpnGateways", p)
+	if m.ListVpnGatewaysFunc == nil {
+		return nil, notMocked("MockVPNService", "ListVpnGateways")
+	}
+	return m.ListVpnGatewaysFunc(ctx, p)
+}
+
+func (m *MockVPNService) NewListVpnGatewaysIterator(p *ListVpnGatewaysParams, pagesize int) *ListVpnGatewaysIterator {
+	i := &ListVpnGatewaysIterator{pager: newPager(nil, "listVpnGateways", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListVpnGatewaysParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListVpnGatewaysWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockVPNService) NewAddVpnUserParams(password string, username string) *AddVpnUserParams {
+	return (&VPNService{}).NewAddVpnUserParams(password, username)
+}
+
+func (m *MockVPNService) AddVpnUser(p *AddVpnUserParams) (*AddVpnUserResponse, error) {
+	return m.AddVpnUserWithContext(context.Background(), p)
+}
+
+func (m *MockVPNService) AddVpnUserWithContext(ctx context.Context, p *AddVpnUserParams) (*AddVpnUserResponse, error) {
+	m.record("AddVpnUser", p)
+	if m.AddVpnUserFunc == nil {
+		return nil, notMocked("MockVPNService", "AddVpnUser")
+	}
+	return m.AddVpnUserFunc(ctx, p)
+}
+
+func (m *MockVPNService) AddVpnUserAsync(ctx context.Context, p *AddVpnUserParams) (*AddVpnUserJob, error) {
+	m.record("AddVpnUserAsync", p)
+	if m.AddVpnUserAsyncFunc == nil {
+		return nil, notMocked("MockVPNService", "AddVpnUserAsync")
+	}
+	return m.AddVpnUserAsyncFunc(ctx, p)
+}
+
+func (m *MockVPNService) NewRemoveVpnUserParams(username string) *RemoveVpnUserParams {
+	return (&VPNService{}).NewRemoveVpnUserParams(username)
+}
+
+func (m *MockVPNService) RemoveVpnUser(p *RemoveVpnUserParams) (*RemoveVpnUserResponse, error) {
+	return m.RemoveVpnUserWithContext(context.Background(), p)
+}
+
+func (m *MockVPNService) RemoveVpnUserWithContext(ctx context.Context, p *RemoveVpnUserParams) (*RemoveVpnUserResponse, error) {
+	m.record("RemoveVpnUser", p)
+	if m.RemoveVpnUserFunc == nil {
+		return nil, notMocked("MockVPNService", "RemoveVpnUser")
+	}
+	return m.RemoveVpnUserFunc(ctx, p)
+}
+
+func (m *MockVPNService) RemoveVpnUserAsync(ctx context.Context, p *RemoveVpnUserParams) (*RemoveVpnUserJob, error) {
+	m.record("RemoveVpnUserAsync", p)
+	if m.RemoveVpnUserAsyncFunc == nil {
+		return nil, notMocked("MockVPNService", "RemoveVpnUserAsync")
+	}
+	return m.RemoveVpnUserAsyncFunc(ctx, p)
+}
+
+func (m *MockVPNService) NewListVpnUsersParams() *ListVpnUsersParams {
+	return (&VPNService{}).NewListVpnUsersParams()
+}
+
+func (m *MockVPNService) GetVpnUserByID(id string, opts ...OptionFunc) (*VpnUser, int, error) {
+	m.record("GetVpnUserByID", id, opts)
+	if m.GetVpnUserByIDFunc == nil {
+		return nil, -1, notMocked("MockVPNService", "GetVpnUserByID")
+	}
+	return m.GetVpnUserByIDFunc(id, opts...)
+}
+
+func (m *MockVPNService) ListVpnUsers(p *ListVpnUsersParams) (*ListVpnUsersResponse, error) {
+	return m.ListVpnUsersWithContext(context.Background(), p)
+}
+
+func (m *MockVPNService) ListVpnUsersWithContext(ctx context.Context, p *ListVpnUsersParams) (*ListVpnUsersResponse, error) {
+	m.record("ListVpnUsers", p)
+	if m.ListVpnUsersFunc == nil {
+		return nil, notMocked("MockVPNService", "ListVpnUsers")
+	}
+	return m.ListVpnUsersFunc(ctx, p)
+}
+
+func (m *MockVPNService) NewListVpnUsersIterator(p *ListVpnUsersParams, pagesize int) *ListVpnUsersIterator {
+	i := &ListVpnUsersIterator{pager: newPager(nil, "listVpnUsers", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListVpnUsersParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListVpnUsersWithContext(ctx, c)
+	}
+	return i
+}
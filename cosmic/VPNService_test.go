@@ -0,0 +1,474 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCreateRemoteAccessVpnParams(t *testing.T) {
+	p := &CreateRemoteAccessVpnParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetIprange("iprange-value")
+	want.Set("iprange", "iprange-value")
+	p.SetOpenfirewall(true)
+	want.Set("openfirewall", "true")
+	p.SetPublicipid("publicipid-value")
+	want.Set("publicipid", "publicipid-value")
+
+	assertParams(t, p, "createRemoteAccessVpn", want)
+}
+
+func TestCreateRemoteAccessVpnResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","domain":"domain-value","domainid":"domainid-value","fordisplay":true,"id":"id-value","iprange":"iprange-value","jobid":"jobid-value","presharedkey":"presharedkey-value","project":"project-value","projectid":"projectid-value","publicip":"publicip-value","publicipid":"publicipid-value","state":"state-value"}`, &CreateRemoteAccessVpnResponse{})
+}
+
+func TestDeleteRemoteAccessVpnParams(t *testing.T) {
+	p := &DeleteRemoteAccessVpnParams{}
+	want := url.Values{}
+	p.SetPublicipid("publicipid-value")
+	want.Set("publicipid", "publicipid-value")
+
+	assertParams(t, p, "deleteRemoteAccessVpn", want)
+}
+
+func TestDeleteRemoteAccessVpnResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteRemoteAccessVpnResponse{})
+}
+
+func TestUpdateRemoteAccessVpnParams(t *testing.T) {
+	p := &UpdateRemoteAccessVpnParams{}
+	want := url.Values{}
+	p.SetCustomid("customid-value")
+	want.Set("customid", "customid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "updateRemoteAccessVpn", want)
+}
+
+func TestUpdateRemoteAccessVpnResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","domain":"domain-value","domainid":"domainid-value","fordisplay":true,"id":"id-value","iprange":"iprange-value","jobid":"jobid-value","presharedkey":"presharedkey-value","project":"project-value","projectid":"projectid-value","publicip":"publicip-value","publicipid":"publicipid-value","state":"state-value"}`, &UpdateRemoteAccessVpnResponse{})
+}
+
+func TestListRemoteAccessVpnsParams(t *testing.T) {
+	p := &ListRemoteAccessVpnsParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetNetworkid("networkid-value")
+	want.Set("networkid", "networkid-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetPublicipid("publicipid-value")
+	want.Set("publicipid", "publicipid-value")
+
+	assertParams(t, p, "listRemoteAccessVpns", want)
+}
+
+func TestListRemoteAccessVpnsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"remoteaccessvpn":[{"account":"account-value","domain":"domain-value","domainid":"domainid-value","fordisplay":true,"id":"id-value","iprange":"iprange-value","presharedkey":"presharedkey-value","project":"project-value","projectid":"projectid-value","publicip":"publicip-value","publicipid":"publicipid-value","state":"state-value"}]}`, &ListRemoteAccessVpnsResponse{})
+}
+
+func TestCreateVpnConnectionParams(t *testing.T) {
+	p := &CreateVpnConnectionParams{}
+	want := url.Values{}
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetPassive(true)
+	want.Set("passive", "true")
+	p.SetS2scustomergatewayid("s2scustomergatewayid-value")
+	want.Set("s2scustomergatewayid", "s2scustomergatewayid-value")
+	p.SetS2svpngatewayid("s2svpngatewayid-value")
+	want.Set("s2svpngatewayid", "s2svpngatewayid-value")
+
+	assertParams(t, p, "createVpnConnection", want)
+}
+
+func TestCreateVpnConnectionResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","cidrlist":"cidrlist-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","dpd":true,"esplifetime":1099511627776,"esppolicy":"esppolicy-value","forceencap":true,"fordisplay":true,"gateway":"gateway-value","id":"id-value","ikelifetime":1099511627776,"ikepolicy":"ikepolicy-value","ipsecpsk":"ipsecpsk-value","jobid":"jobid-value","passive":true,"project":"project-value","projectid":"projectid-value","publicip":"publicip-value","removed":"removed-value","s2scustomergatewayid":"s2scustomergatewayid-value","s2svpngatewayid":"s2svpngatewayid-value","state":"state-value"}`, &CreateVpnConnectionResponse{})
+}
+
+func TestDeleteVpnConnectionParams(t *testing.T) {
+	p := &DeleteVpnConnectionParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteVpnConnection", want)
+}
+
+func TestDeleteVpnConnectionResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteVpnConnectionResponse{})
+}
+
+func TestResetVpnConnectionParams(t *testing.T) {
+	p := &ResetVpnConnectionParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "resetVpnConnection", want)
+}
+
+func TestResetVpnConnectionResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","cidrlist":"cidrlist-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","dpd":true,"esplifetime":1099511627776,"esppolicy":"esppolicy-value","forceencap":true,"fordisplay":true,"gateway":"gateway-value","id":"id-value","ikelifetime":1099511627776,"ikepolicy":"ikepolicy-value","ipsecpsk":"ipsecpsk-value","jobid":"jobid-value","passive":true,"project":"project-value","projectid":"projectid-value","publicip":"publicip-value","removed":"removed-value","s2scustomergatewayid":"s2scustomergatewayid-value","s2svpngatewayid":"s2svpngatewayid-value","state":"state-value"}`, &ResetVpnConnectionResponse{})
+}
+
+func TestUpdateVpnConnectionParams(t *testing.T) {
+	p := &UpdateVpnConnectionParams{}
+	want := url.Values{}
+	p.SetCustomid("customid-value")
+	want.Set("customid", "customid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "updateVpnConnection", want)
+}
+
+func TestUpdateVpnConnectionResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","cidrlist":"cidrlist-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","dpd":true,"esplifetime":1099511627776,"esppolicy":"esppolicy-value","forceencap":true,"fordisplay":true,"gateway":"gateway-value","id":"id-value","ikelifetime":1099511627776,"ikepolicy":"ikepolicy-value","ipsecpsk":"ipsecpsk-value","jobid":"jobid-value","passive":true,"project":"project-value","projectid":"projectid-value","publicip":"publicip-value","removed":"removed-value","s2scustomergatewayid":"s2scustomergatewayid-value","s2svpngatewayid":"s2svpngatewayid-value","state":"state-value"}`, &UpdateVpnConnectionResponse{})
+}
+
+func TestListVpnConnectionsParams(t *testing.T) {
+	p := &ListVpnConnectionsParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetVpcid("vpcid-value")
+	want.Set("vpcid", "vpcid-value")
+
+	assertParams(t, p, "listVpnConnections", want)
+}
+
+func TestListVpnConnectionsResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"vpnconnection":[{"account":"account-value","cidrlist":"cidrlist-value","created":"created-value","domain":"domain-value","domainid":"domainid-value","dpd":true,"esplifetime":1099511627776,"esppolicy":"esppolicy-value","forceencap":true,"fordisplay":true,"gateway":"gateway-value","id":"id-value","ikelifetime":1099511627776,"ikepolicy":"ikepolicy-value","ipsecpsk":"ipsecpsk-value","passive":true,"project":"project-value","projectid":"projectid-value","publicip":"publicip-value","removed":"removed-value","s2scustomergatewayid":"s2scustomergatewayid-value","s2svpngatewayid":"s2svpngatewayid-value","state":"state-value"}]}`, &ListVpnConnectionsResponse{})
+}
+
+func TestCreateVpnCustomerGatewayParams(t *testing.T) {
+	p := &CreateVpnCustomerGatewayParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetCidrlist([]string{"a", "b"})
+	want.Set("cidrlist", "a,b")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetDpd(true)
+	want.Set("dpd", "true")
+	p.SetEsplifetime(int64(1) << 40)
+	want.Set("esplifetime", "1099511627776")
+	p.SetEsppolicy("esppolicy-value")
+	want.Set("esppolicy", "esppolicy-value")
+	p.SetForceencap(true)
+	want.Set("forceencap", "true")
+	p.SetGateway("gateway-value")
+	want.Set("gateway", "gateway-value")
+	p.SetIkelifetime(int64(1) << 40)
+	want.Set("ikelifetime", "1099511627776")
+	p.SetIkepolicy("ikepolicy-value")
+	want.Set("ikepolicy", "ikepolicy-value")
+	p.SetIpsecpsk("ipsecpsk-value")
+	want.Set("ipsecpsk", "ipsecpsk-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+
+	assertParams(t, p, "createVpnCustomerGateway", want)
+}
+
+func TestCreateVpnCustomerGatewayResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","cidrlist":"cidrlist-value","domain":"domain-value","domainid":"domainid-value","dpd":true,"esplifetime":1099511627776,"esppolicy":"esppolicy-value","forceencap":true,"gateway":"gateway-value","id":"id-value","ikelifetime":1099511627776,"ikepolicy":"ikepolicy-value","ipaddress":"ipaddress-value","ipsecpsk":"ipsecpsk-value","jobid":"jobid-value","name":"name-value","project":"project-value","projectid":"projectid-value","removed":"removed-value"}`, &CreateVpnCustomerGatewayResponse{})
+}
+
+func TestDeleteVpnCustomerGatewayParams(t *testing.T) {
+	p := &DeleteVpnCustomerGatewayParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteVpnCustomerGateway", want)
+}
+
+func TestDeleteVpnCustomerGatewayResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteVpnCustomerGatewayResponse{})
+}
+
+func TestUpdateVpnCustomerGatewayParams(t *testing.T) {
+	p := &UpdateVpnCustomerGatewayParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetCidrlist([]string{"a", "b"})
+	want.Set("cidrlist", "a,b")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetDpd(true)
+	want.Set("dpd", "true")
+	p.SetEsplifetime(int64(1) << 40)
+	want.Set("esplifetime", "1099511627776")
+	p.SetEsppolicy("esppolicy-value")
+	want.Set("esppolicy", "esppolicy-value")
+	p.SetForceencap(true)
+	want.Set("forceencap", "true")
+	p.SetGateway("gateway-value")
+	want.Set("gateway", "gateway-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIkelifetime(int64(1) << 40)
+	want.Set("ikelifetime", "1099511627776")
+	p.SetIkepolicy("ikepolicy-value")
+	want.Set("ikepolicy", "ikepolicy-value")
+	p.SetIpsecpsk("ipsecpsk-value")
+	want.Set("ipsecpsk", "ipsecpsk-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+
+	assertParams(t, p, "updateVpnCustomerGateway", want)
+}
+
+func TestUpdateVpnCustomerGatewayResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","cidrlist":"cidrlist-value","domain":"domain-value","domainid":"domainid-value","dpd":true,"esplifetime":1099511627776,"esppolicy":"esppolicy-value","forceencap":true,"gateway":"gateway-value","id":"id-value","ikelifetime":1099511627776,"ikepolicy":"ikepolicy-value","ipaddress":"ipaddress-value","ipsecpsk":"ipsecpsk-value","jobid":"jobid-value","name":"name-value","project":"project-value","projectid":"projectid-value","removed":"removed-value"}`, &UpdateVpnCustomerGatewayResponse{})
+}
+
+func TestListVpnCustomerGatewaysParams(t *testing.T) {
+	p := &ListVpnCustomerGatewaysParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+
+	assertParams(t, p, "listVpnCustomerGateways", want)
+}
+
+func TestListVpnCustomerGatewaysResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"vpncustomergateway":[{"account":"account-value","cidrlist":"cidrlist-value","domain":"domain-value","domainid":"domainid-value","dpd":true,"esplifetime":1099511627776,"esppolicy":"esppolicy-value","forceencap":true,"gateway":"gateway-value","id":"id-value","ikelifetime":1099511627776,"ikepolicy":"ikepolicy-value","ipaddress":"ipaddress-value","ipsecpsk":"ipsecpsk-value","name":"name-value","project":"project-value","projectid":"projectid-value","removed":"removed-value"}]}`, &ListVpnCustomerGatewaysResponse{})
+}
+
+func TestCreateVpnGatewayParams(t *testing.T) {
+	p := &CreateVpnGatewayParams{}
+	want := url.Values{}
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetVpcid("vpcid-value")
+	want.Set("vpcid", "vpcid-value")
+
+	assertParams(t, p, "createVpnGateway", want)
+}
+
+func TestCreateVpnGatewayResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","domain":"domain-value","domainid":"domainid-value","fordisplay":true,"id":"id-value","jobid":"jobid-value","project":"project-value","projectid":"projectid-value","publicip":"publicip-value","removed":"removed-value","vpcid":"vpcid-value"}`, &CreateVpnGatewayResponse{})
+}
+
+func TestDeleteVpnGatewayParams(t *testing.T) {
+	p := &DeleteVpnGatewayParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteVpnGateway", want)
+}
+
+func TestDeleteVpnGatewayResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &DeleteVpnGatewayResponse{})
+}
+
+func TestUpdateVpnGatewayParams(t *testing.T) {
+	p := &UpdateVpnGatewayParams{}
+	want := url.Values{}
+	p.SetCustomid("customid-value")
+	want.Set("customid", "customid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "updateVpnGateway", want)
+}
+
+func TestUpdateVpnGatewayResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","domain":"domain-value","domainid":"domainid-value","fordisplay":true,"id":"id-value","jobid":"jobid-value","project":"project-value","projectid":"projectid-value","publicip":"publicip-value","removed":"removed-value","vpcid":"vpcid-value"}`, &UpdateVpnGatewayResponse{})
+}
+
+func TestListVpnGatewaysParams(t *testing.T) {
+	p := &ListVpnGatewaysParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetFordisplay(true)
+	want.Set("fordisplay", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetVpcid("vpcid-value")
+	want.Set("vpcid", "vpcid-value")
+
+	assertParams(t, p, "listVpnGateways", want)
+}
+
+func TestListVpnGatewaysResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"vpngateway":[{"account":"account-value","domain":"domain-value","domainid":"domainid-value","fordisplay":true,"id":"id-value","project":"project-value","projectid":"projectid-value","publicip":"publicip-value","removed":"removed-value","vpcid":"vpcid-value"}]}`, &ListVpnGatewaysResponse{})
+}
+
+func TestAddVpnUserParams(t *testing.T) {
+	p := &AddVpnUserParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetPassword("password-value")
+	want.Set("password", "password-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetUsername("username-value")
+	want.Set("username", "username-value")
+
+	assertParams(t, p, "addVpnUser", want)
+}
+
+func TestAddVpnUserResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","jobid":"jobid-value","project":"project-value","projectid":"projectid-value","state":"state-value","username":"username-value"}`, &AddVpnUserResponse{})
+}
+
+func TestRemoveVpnUserParams(t *testing.T) {
+	p := &RemoveVpnUserParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetUsername("username-value")
+	want.Set("username", "username-value")
+
+	assertParams(t, p, "removeVpnUser", want)
+}
+
+func TestRemoveVpnUserResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &RemoveVpnUserResponse{})
+}
+
+func TestListVpnUsersParams(t *testing.T) {
+	p := &ListVpnUsersParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetUsername("username-value")
+	want.Set("username", "username-value")
+
+	assertParams(t, p, "listVpnUsers", want)
+}
+
+func TestListVpnUsersResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"vpnuser":[{"account":"account-value","domain":"domain-value","domainid":"domainid-value","id":"id-value","project":"project-value","projectid":"projectid-value","state":"state-value","username":"username-value"}]}`, &ListVpnUsersResponse{})
+}
@@ -17,17 +17,109 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 )
 
+// VirtualMachineServiceIface is the interface implemented by VirtualMachineService, and by MockVirtualMachineService for tests
+type VirtualMachineServiceIface interface {
+	NewUpdateDefaultNicForVirtualMachineParams(nicid string, virtualmachineid string) *UpdateDefaultNicForVirtualMachineParams
+	UpdateDefaultNicForVirtualMachine(p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineResponse, error)
+	UpdateDefaultNicForVirtualMachineWithContext(ctx context.Context, p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineResponse, error)
+	UpdateDefaultNicForVirtualMachineAsync(ctx context.Context, p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineJob, error)
+	NewRemoveNicFromVirtualMachineParams(nicid string, virtualmachineid string) *RemoveNicFromVirtualMachineParams
+	RemoveNicFromVirtualMachine(p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineResponse, error)
+	RemoveNicFromVirtualMachineWithContext(ctx context.Context, p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineResponse, error)
+	RemoveNicFromVirtualMachineAsync(ctx context.Context, p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineJob, error)
+	NewAddNicToVirtualMachineParams(networkid string, virtualmachineid string) *AddNicToVirtualMachineParams
+	AddNicToVirtualMachine(p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineResponse, error)
+	AddNicToVirtualMachineWithContext(ctx context.Context, p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineResponse, error)
+	AddNicToVirtualMachineAsync(ctx context.Context, p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineJob, error)
+	NewResetPasswordForVirtualMachineParams(id string) *ResetPasswordForVirtualMachineParams
+	ResetPasswordForVirtualMachine(p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error)
+	ResetPasswordForVirtualMachineWithContext(ctx context.Context, p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error)
+	ResetPasswordForVirtualMachineAsync(ctx context.Context, p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineJob, error)
+	NewChangeServiceForVirtualMachineParams(id string, serviceofferingid string) *ChangeServiceForVirtualMachineParams
+	ChangeServiceForVirtualMachine(p *ChangeServiceForVirtualMachineParams) (*ChangeServiceForVirtualMachineResponse, error)
+	ChangeServiceForVirtualMachineWithContext(ctx context.Context, p *ChangeServiceForVirtualMachineParams) (*ChangeServiceForVirtualMachineResponse, error)
+	NewGetVMPasswordParams(id string) *GetVMPasswordParams
+	GetVMPassword(p *GetVMPasswordParams) (*GetVMPasswordResponse, error)
+	GetVMPasswordWithContext(ctx context.Context, p *GetVMPasswordParams) (*GetVMPasswordResponse, error)
+	NewCleanVMReservationsParams() *CleanVMReservationsParams
+	CleanVMReservations(p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error)
+	CleanVMReservationsWithContext(ctx context.Context, p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error)
+	CleanVMReservationsAsync(ctx context.Context, p *CleanVMReservationsParams) (*CleanVMReservationsJob, error)
+	NewAssignVirtualMachineParams(account string, domainid string, virtualmachineid string) *AssignVirtualMachineParams
+	AssignVirtualMachine(p *AssignVirtualMachineParams) (*AssignVirtualMachineResponse, error)
+	AssignVirtualMachineWithContext(ctx context.Context, p *AssignVirtualMachineParams) (*AssignVirtualMachineResponse, error)
+	NewDeployVirtualMachineParams(serviceofferingid string, templateid string, zoneid string) *DeployVirtualMachineParams
+	DeployVirtualMachine(p *DeployVirtualMachineParams) (*DeployVirtualMachineResponse, error)
+	DeployVirtualMachineWithContext(ctx context.Context, p *DeployVirtualMachineParams) (*DeployVirtualMachineResponse, error)
+	DeployVirtualMachineAsync(ctx context.Context, p *DeployVirtualMachineParams) (*DeployVirtualMachineJob, error)
+	NewDestroyVirtualMachineParams(id string) *DestroyVirtualMachineParams
+	DestroyVirtualMachine(p *DestroyVirtualMachineParams) (*DestroyVirtualMachineResponse, error)
+	DestroyVirtualMachineWithContext(ctx context.Context, p *DestroyVirtualMachineParams) (*DestroyVirtualMachineResponse, error)
+	DestroyVirtualMachineAsync(ctx context.Context, p *DestroyVirtualMachineParams) (*DestroyVirtualMachineJob, error)
+	NewExpungeVirtualMachineParams(id string) *ExpungeVirtualMachineParams
+	ExpungeVirtualMachine(p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error)
+	ExpungeVirtualMachineWithContext(ctx context.Context, p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error)
+	ExpungeVirtualMachineAsync(ctx context.Context, p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineJob, error)
+	NewMigrateVirtualMachineParams(virtualmachineid string) *MigrateVirtualMachineParams
+	MigrateVirtualMachine(p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error)
+	MigrateVirtualMachineWithContext(ctx context.Context, p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error)
+	MigrateVirtualMachineAsync(ctx context.Context, p *MigrateVirtualMachineParams) (*MigrateVirtualMachineJob, error)
+	NewRebootVirtualMachineParams(id string) *RebootVirtualMachineParams
+	RebootVirtualMachine(p *RebootVirtualMachineParams) (*RebootVirtualMachineResponse, error)
+	RebootVirtualMachineWithContext(ctx context.Context, p *RebootVirtualMachineParams) (*RebootVirtualMachineResponse, error)
+	RebootVirtualMachineAsync(ctx context.Context, p *RebootVirtualMachineParams) (*RebootVirtualMachineJob, error)
+	NewRecoverVirtualMachineParams(id string) *RecoverVirtualMachineParams
+	RecoverVirtualMachine(p *RecoverVirtualMachineParams) (*RecoverVirtualMachineResponse, error)
+	RecoverVirtualMachineWithContext(ctx context.Context, p *RecoverVirtualMachineParams) (*RecoverVirtualMachineResponse, error)
+	NewRestoreVirtualMachineParams(virtualmachineid string) *RestoreVirtualMachineParams
+	RestoreVirtualMachine(p *RestoreVirtualMachineParams) (*RestoreVirtualMachineResponse, error)
+	RestoreVirtualMachineWithContext(ctx context.Context, p *RestoreVirtualMachineParams) (*RestoreVirtualMachineResponse, error)
+	RestoreVirtualMachineAsync(ctx context.Context, p *RestoreVirtualMachineParams) (*RestoreVirtualMachineJob, error)
+	NewScaleVirtualMachineParams(id string, serviceofferingid string) *ScaleVirtualMachineParams
+	ScaleVirtualMachine(p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error)
+	ScaleVirtualMachineWithContext(ctx context.Context, p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error)
+	ScaleVirtualMachineAsync(ctx context.Context, p *ScaleVirtualMachineParams) (*ScaleVirtualMachineJob, error)
+	NewStartVirtualMachineParams(id string) *StartVirtualMachineParams
+	StartVirtualMachine(p *StartVirtualMachineParams) (*StartVirtualMachineResponse, error)
+	StartVirtualMachineWithContext(ctx context.Context, p *StartVirtualMachineParams) (*StartVirtualMachineResponse, error)
+	StartVirtualMachineAsync(ctx context.Context, p *StartVirtualMachineParams) (*StartVirtualMachineJob, error)
+	NewStopVirtualMachineParams(id string) *StopVirtualMachineParams
+	StopVirtualMachine(p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error)
+	StopVirtualMachineWithContext(ctx context.Context, p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error)
+	StopVirtualMachineAsync(ctx context.Context, p *StopVirtualMachineParams) (*StopVirtualMachineJob, error)
+	NewUpdateVirtualMachineParams(id string) *UpdateVirtualMachineParams
+	UpdateVirtualMachine(p *UpdateVirtualMachineParams) (*UpdateVirtualMachineResponse, error)
+	UpdateVirtualMachineWithContext(ctx context.Context, p *UpdateVirtualMachineParams) (*UpdateVirtualMachineResponse, error)
+	NewMigrateVirtualMachineWithVolumeParams(hostid string, virtualmachineid string) *MigrateVirtualMachineWithVolumeParams
+	MigrateVirtualMachineWithVolume(p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeResponse, error)
+	MigrateVirtualMachineWithVolumeWithContext(ctx context.Context, p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeResponse, error)
+	MigrateVirtualMachineWithVolumeAsync(ctx context.Context, p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeJob, error)
+	NewListVirtualMachinesParams() *ListVirtualMachinesParams
+	GetVirtualMachineID(name string, opts ...OptionFunc) (string, int, error)
+	GetVirtualMachineByName(name string, opts ...OptionFunc) (*VirtualMachine, int, error)
+	GetVirtualMachineByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error)
+	ListVirtualMachines(p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error)
+	ListVirtualMachinesWithContext(ctx context.Context, p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error)
+	NewListVirtualMachinesIterator(p *ListVirtualMachinesParams, pagesize int) *ListVirtualMachinesIterator
+}
+
 type UpdateDefaultNicForVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateDefaultNicForVirtualMachineParams) command() string {
+	return "updateDefaultNicForVirtualMachine"
+}
+
 func (p *UpdateDefaultNicForVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -68,7 +160,13 @@ func (s *VirtualMachineService) NewUpdateDefaultNicForVirtualMachineParams(nicid
 
 // Changes the default NIC on a VM
 func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachine(p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("updateDefaultNicForVirtualMachine", p.toURLValues())
+	return s.UpdateDefaultNicForVirtualMachineWithContext(context.Background(), p)
+}
+
+// UpdateDefaultNicForVirtualMachineWithContext is the same as UpdateDefaultNicForVirtualMachine, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachineWithContext(ctx context.Context, p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateDefaultNicForVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -80,26 +178,56 @@ func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachine(p *UpdateDefau
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateDefaultNicForVirtualMachine", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// UpdateDefaultNicForVirtualMachineJob is a handle to a running updateDefaultNicForVirtualMachine async job
+type UpdateDefaultNicForVirtualMachineJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateDefaultNicForVirtualMachineResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateDefaultNicForVirtualMachineJob) Result() (*UpdateDefaultNicForVirtualMachineResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateDefaultNicForVirtualMachineResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// UpdateDefaultNicForVirtualMachineAsync starts the updateDefaultNicForVirtualMachine async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachineAsync(ctx context.Context, p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateDefaultNicForVirtualMachine", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateDefaultNicForVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateDefaultNicForVirtualMachineJob{s.cs.newJobHandle("updateDefaultNicForVirtualMachine", r.JobID)}, nil
+}
+
 type UpdateDefaultNicForVirtualMachineResponse struct {
 	JobID         string `json:"jobid,omitempty"`
 	Account       string `json:"account,omitempty"`
@@ -217,6 +345,10 @@ type RemoveNicFromVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+func (p *RemoveNicFromVirtualMachineParams) command() string {
+	return "removeNicFromVirtualMachine"
+}
+
 func (p *RemoveNicFromVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -257,7 +389,13 @@ func (s *VirtualMachineService) NewRemoveNicFromVirtualMachineParams(nicid strin
 
 // Removes VM from specified network by deleting a NIC
 func (s *VirtualMachineService) RemoveNicFromVirtualMachine(p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("removeNicFromVirtualMachine", p.toURLValues())
+	return s.RemoveNicFromVirtualMachineWithContext(context.Background(), p)
+}
+
+// RemoveNicFromVirtualMachineWithContext is the same as RemoveNicFromVirtualMachine, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VirtualMachineService) RemoveNicFromVirtualMachineWithContext(ctx context.Context, p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "removeNicFromVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -269,26 +407,56 @@ func (s *VirtualMachineService) RemoveNicFromVirtualMachine(p *RemoveNicFromVirt
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "removeNicFromVirtualMachine", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// RemoveNicFromVirtualMachineJob is a handle to a running removeNicFromVirtualMachine async job
+type RemoveNicFromVirtualMachineJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a RemoveNicFromVirtualMachineResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *RemoveNicFromVirtualMachineJob) Result() (*RemoveNicFromVirtualMachineResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := RemoveNicFromVirtualMachineResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// RemoveNicFromVirtualMachineAsync starts the removeNicFromVirtualMachine async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VirtualMachineService) RemoveNicFromVirtualMachineAsync(ctx context.Context, p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineJob, error) {
+	resp, err := s.cs.newRequest(ctx, "removeNicFromVirtualMachine", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RemoveNicFromVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &RemoveNicFromVirtualMachineJob{s.cs.newJobHandle("removeNicFromVirtualMachine", r.JobID)}, nil
+}
+
 type RemoveNicFromVirtualMachineResponse struct {
 	JobID         string `json:"jobid,omitempty"`
 	Account       string `json:"account,omitempty"`
@@ -406,6 +574,10 @@ type AddNicToVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+func (p *AddNicToVirtualMachineParams) command() string {
+	return "addNicToVirtualMachine"
+}
+
 func (p *AddNicToVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -466,7 +638,13 @@ func (s *VirtualMachineService) NewAddNicToVirtualMachineParams(networkid string
 
 // Adds VM to specified network by creating a NIC
 func (s *VirtualMachineService) AddNicToVirtualMachine(p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("addNicToVirtualMachine", p.toURLValues())
+	return s.AddNicToVirtualMachineWithContext(context.Background(), p)
+}
+
+// AddNicToVirtualMachineWithContext is the same as AddNicToVirtualMachine, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VirtualMachineService) AddNicToVirtualMachineWithContext(ctx context.Context, p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "addNicToVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -478,26 +656,56 @@ func (s *VirtualMachineService) AddNicToVirtualMachine(p *AddNicToVirtualMachine
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "addNicToVirtualMachine", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// AddNicToVirtualMachineJob is a handle to a running addNicToVirtualMachine async job
+type AddNicToVirtualMachineJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a AddNicToVirtualMachineResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *AddNicToVirtualMachineJob) Result() (*AddNicToVirtualMachineResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := AddNicToVirtualMachineResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// AddNicToVirtualMachineAsync starts the addNicToVirtualMachine async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VirtualMachineService) AddNicToVirtualMachineAsync(ctx context.Context, p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineJob, error) {
+	resp, err := s.cs.newRequest(ctx, "addNicToVirtualMachine", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddNicToVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &AddNicToVirtualMachineJob{s.cs.newJobHandle("addNicToVirtualMachine", r.JobID)}, nil
+}
+
 type AddNicToVirtualMachineResponse struct {
 	JobID         string `json:"jobid,omitempty"`
 	Account       string `json:"account,omitempty"`
@@ -615,6 +823,10 @@ type ResetPasswordForVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+func (p *ResetPasswordForVirtualMachineParams) command() string {
+	return "resetPasswordForVirtualMachine"
+}
+
 func (p *ResetPasswordForVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -644,7 +856,13 @@ func (s *VirtualMachineService) NewResetPasswordForVirtualMachineParams(id strin
 
 // Resets the password for virtual machine. The virtual machine must be in a "Stopped" state and the template must already support this feature for this command to take effect. [async]
 func (s *VirtualMachineService) ResetPasswordForVirtualMachine(p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("resetPasswordForVirtualMachine", p.toURLValues())
+	return s.ResetPasswordForVirtualMachineWithContext(context.Background(), p)
+}
+
+// ResetPasswordForVirtualMachineWithContext is the same as ResetPasswordForVirtualMachine, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VirtualMachineService) ResetPasswordForVirtualMachineWithContext(ctx context.Context, p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "resetPasswordForVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -656,26 +874,56 @@ func (s *VirtualMachineService) ResetPasswordForVirtualMachine(p *ResetPasswordF
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "resetPasswordForVirtualMachine", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// ResetPasswordForVirtualMachineJob is a handle to a running resetPasswordForVirtualMachine async job
+type ResetPasswordForVirtualMachineJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a ResetPasswordForVirtualMachineResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *ResetPasswordForVirtualMachineJob) Result() (*ResetPasswordForVirtualMachineResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := ResetPasswordForVirtualMachineResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// ResetPasswordForVirtualMachineAsync starts the resetPasswordForVirtualMachine async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VirtualMachineService) ResetPasswordForVirtualMachineAsync(ctx context.Context, p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineJob, error) {
+	resp, err := s.cs.newRequest(ctx, "resetPasswordForVirtualMachine", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ResetPasswordForVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &ResetPasswordForVirtualMachineJob{s.cs.newJobHandle("resetPasswordForVirtualMachine", r.JobID)}, nil
+}
+
 type ResetPasswordForVirtualMachineResponse struct {
 	JobID         string `json:"jobid,omitempty"`
 	Account       string `json:"account,omitempty"`
@@ -793,6 +1041,10 @@ type ChangeServiceForVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+func (p *ChangeServiceForVirtualMachineParams) command() string {
+	return "changeServiceForVirtualMachine"
+}
+
 func (p *ChangeServiceForVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -848,7 +1100,13 @@ func (s *VirtualMachineService) NewChangeServiceForVirtualMachineParams(id strin
 
 // Changes the service offering for a virtual machine. The virtual machine must be in a "Stopped" state for this command to take effect.
 func (s *VirtualMachineService) ChangeServiceForVirtualMachine(p *ChangeServiceForVirtualMachineParams) (*ChangeServiceForVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("changeServiceForVirtualMachine", p.toURLValues())
+	return s.ChangeServiceForVirtualMachineWithContext(context.Background(), p)
+}
+
+// ChangeServiceForVirtualMachineWithContext is the same as ChangeServiceForVirtualMachine, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VirtualMachineService) ChangeServiceForVirtualMachineWithContext(ctx context.Context, p *ChangeServiceForVirtualMachineParams) (*ChangeServiceForVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "changeServiceForVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -976,6 +1234,10 @@ type GetVMPasswordParams struct {
 	p map[string]interface{}
 }
 
+func (p *GetVMPasswordParams) command() string {
+	return "getVMPassword"
+}
+
 func (p *GetVMPasswordParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1005,7 +1267,13 @@ func (s *VirtualMachineService) NewGetVMPasswordParams(id string) *GetVMPassword
 
 // Returns an encrypted password for the VM
 func (s *VirtualMachineService) GetVMPassword(p *GetVMPasswordParams) (*GetVMPasswordResponse, error) {
-	resp, err := s.cs.newRequest("getVMPassword", p.toURLValues())
+	return s.GetVMPasswordWithContext(context.Background(), p)
+}
+
+// GetVMPasswordWithContext is the same as GetVMPassword, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VirtualMachineService) GetVMPasswordWithContext(ctx context.Context, p *GetVMPasswordParams) (*GetVMPasswordResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "getVMPassword", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1025,6 +1293,10 @@ type CleanVMReservationsParams struct {
 	p map[string]interface{}
 }
 
+func (p *CleanVMReservationsParams) command() string {
+	return "cleanVMReservations"
+}
+
 func (p *CleanVMReservationsParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1043,7 +1315,13 @@ func (s *VirtualMachineService) NewCleanVMReservationsParams() *CleanVMReservati
 
 // Cleanups VM reservations in the database.
 func (s *VirtualMachineService) CleanVMReservations(p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error) {
-	resp, err := s.cs.newRequest("cleanVMReservations", p.toURLValues())
+	return s.CleanVMReservationsWithContext(context.Background(), p)
+}
+
+// CleanVMReservationsWithContext is the same as CleanVMReservations, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VirtualMachineService) CleanVMReservationsWithContext(ctx context.Context, p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "cleanVMReservations", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1055,9 +1333,9 @@ func (s *VirtualMachineService) CleanVMReservations(p *CleanVMReservationsParams
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "cleanVMReservations", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -1070,6 +1348,41 @@ func (s *VirtualMachineService) CleanVMReservations(p *CleanVMReservationsParams
 	return &r, nil
 }
 
+// CleanVMReservationsJob is a handle to a running cleanVMReservations async job
+type CleanVMReservationsJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CleanVMReservationsResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CleanVMReservationsJob) Result() (*CleanVMReservationsResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CleanVMReservationsResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// CleanVMReservationsAsync starts the cleanVMReservations async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VirtualMachineService) CleanVMReservationsAsync(ctx context.Context, p *CleanVMReservationsParams) (*CleanVMReservationsJob, error) {
+	resp, err := s.cs.newRequest(ctx, "cleanVMReservations", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CleanVMReservationsResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CleanVMReservationsJob{s.cs.newJobHandle("cleanVMReservations", r.JobID)}, nil
+}
+
 type CleanVMReservationsResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -1080,6 +1393,10 @@ type AssignVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+func (p *AssignVirtualMachineParams) command() string {
+	return "assignVirtualMachine"
+}
+
 func (p *AssignVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1142,7 +1459,13 @@ func (s *VirtualMachineService) NewAssignVirtualMachineParams(account string, do
 
 // Change ownership of a VM from one account to another. This API is available for Basic zones with security groups and Advanced zones with guest networks. A root administrator can reassign a VM from any account to any other account in any domain. A domain administrator can reassign a VM to any account in the same domain.
 func (s *VirtualMachineService) AssignVirtualMachine(p *AssignVirtualMachineParams) (*AssignVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("assignVirtualMachine", p.toURLValues())
+	return s.AssignVirtualMachineWithContext(context.Background(), p)
+}
+
+// AssignVirtualMachineWithContext is the same as AssignVirtualMachine, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VirtualMachineService) AssignVirtualMachineWithContext(ctx context.Context, p *AssignVirtualMachineParams) (*AssignVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "assignVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1270,6 +1593,10 @@ type DeployVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeployVirtualMachineParams) command() string {
+	return "deployVirtualMachine"
+}
+
 func (p *DeployVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1649,7 +1976,13 @@ func (s *VirtualMachineService) NewDeployVirtualMachineParams(serviceofferingid
 
 // Creates and automatically starts a virtual machine based on a service offering, disk offering, and template.
 func (s *VirtualMachineService) DeployVirtualMachine(p *DeployVirtualMachineParams) (*DeployVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("deployVirtualMachine", p.toURLValues())
+	return s.DeployVirtualMachineWithContext(context.Background(), p)
+}
+
+// DeployVirtualMachineWithContext is the same as DeployVirtualMachine, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VirtualMachineService) DeployVirtualMachineWithContext(ctx context.Context, p *DeployVirtualMachineParams) (*DeployVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deployVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1661,26 +1994,56 @@ func (s *VirtualMachineService) DeployVirtualMachine(p *DeployVirtualMachinePara
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "deployVirtualMachine", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// DeployVirtualMachineJob is a handle to a running deployVirtualMachine async job
+type DeployVirtualMachineJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DeployVirtualMachineResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DeployVirtualMachineJob) Result() (*DeployVirtualMachineResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DeployVirtualMachineResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// DeployVirtualMachineAsync starts the deployVirtualMachine async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VirtualMachineService) DeployVirtualMachineAsync(ctx context.Context, p *DeployVirtualMachineParams) (*DeployVirtualMachineJob, error) {
+	resp, err := s.cs.newRequest(ctx, "deployVirtualMachine", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeployVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DeployVirtualMachineJob{s.cs.newJobHandle("deployVirtualMachine", r.JobID)}, nil
+}
+
 type DeployVirtualMachineResponse struct {
 	JobID         string `json:"jobid,omitempty"`
 	Account       string `json:"account,omitempty"`
@@ -1798,6 +2161,10 @@ type DestroyVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+func (p *DestroyVirtualMachineParams) command() string {
+	return "destroyVirtualMachine"
+}
+
 func (p *DestroyVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1838,7 +2205,13 @@ func (s *VirtualMachineService) NewDestroyVirtualMachineParams(id string) *Destr
 
 // Destroys a virtual machine.
 func (s *VirtualMachineService) DestroyVirtualMachine(p *DestroyVirtualMachineParams) (*DestroyVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("destroyVirtualMachine", p.toURLValues())
+	return s.DestroyVirtualMachineWithContext(context.Background(), p)
+}
+
+// DestroyVirtualMachineWithContext is the same as DestroyVirtualMachine, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VirtualMachineService) DestroyVirtualMachineWithContext(ctx context.Context, p *DestroyVirtualMachineParams) (*DestroyVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "destroyVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1850,26 +2223,56 @@ func (s *VirtualMachineService) DestroyVirtualMachine(p *DestroyVirtualMachinePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "destroyVirtualMachine", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// DestroyVirtualMachineJob is a handle to a running destroyVirtualMachine async job
+type DestroyVirtualMachineJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DestroyVirtualMachineResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DestroyVirtualMachineJob) Result() (*DestroyVirtualMachineResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DestroyVirtualMachineResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// DestroyVirtualMachineAsync starts the destroyVirtualMachine async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VirtualMachineService) DestroyVirtualMachineAsync(ctx context.Context, p *DestroyVirtualMachineParams) (*DestroyVirtualMachineJob, error) {
+	resp, err := s.cs.newRequest(ctx, "destroyVirtualMachine", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DestroyVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DestroyVirtualMachineJob{s.cs.newJobHandle("destroyVirtualMachine", r.JobID)}, nil
+}
+
 type DestroyVirtualMachineResponse struct {
 	JobID         string `json:"jobid,omitempty"`
 	Account       string `json:"account,omitempty"`
@@ -1987,6 +2390,10 @@ type ExpungeVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+func (p *ExpungeVirtualMachineParams) command() string {
+	return "expungeVirtualMachine"
+}
+
 func (p *ExpungeVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2016,7 +2423,13 @@ func (s *VirtualMachineService) NewExpungeVirtualMachineParams(id string) *Expun
 
 // Expunge a virtual machine. Once expunged, it cannot be recoverd.
 func (s *VirtualMachineService) ExpungeVirtualMachine(p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("expungeVirtualMachine", p.toURLValues())
+	return s.ExpungeVirtualMachineWithContext(context.Background(), p)
+}
+
+// ExpungeVirtualMachineWithContext is the same as ExpungeVirtualMachine, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VirtualMachineService) ExpungeVirtualMachineWithContext(ctx context.Context, p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "expungeVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2028,9 +2441,9 @@ func (s *VirtualMachineService) ExpungeVirtualMachine(p *ExpungeVirtualMachinePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "expungeVirtualMachine", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -2043,6 +2456,41 @@ func (s *VirtualMachineService) ExpungeVirtualMachine(p *ExpungeVirtualMachinePa
 	return &r, nil
 }
 
+// ExpungeVirtualMachineJob is a handle to a running expungeVirtualMachine async job
+type ExpungeVirtualMachineJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a ExpungeVirtualMachineResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *ExpungeVirtualMachineJob) Result() (*ExpungeVirtualMachineResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := ExpungeVirtualMachineResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ExpungeVirtualMachineAsync starts the expungeVirtualMachine async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VirtualMachineService) ExpungeVirtualMachineAsync(ctx context.Context, p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineJob, error) {
+	resp, err := s.cs.newRequest(ctx, "expungeVirtualMachine", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ExpungeVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &ExpungeVirtualMachineJob{s.cs.newJobHandle("expungeVirtualMachine", r.JobID)}, nil
+}
+
 type ExpungeVirtualMachineResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -2053,6 +2501,10 @@ type MigrateVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+func (p *MigrateVirtualMachineParams) command() string {
+	return "migrateVirtualMachine"
+}
+
 func (p *MigrateVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2102,7 +2554,13 @@ func (s *VirtualMachineService) NewMigrateVirtualMachineParams(virtualmachineid
 
 // Attempts Migration of a VM to a different host or Root volume of the vm to a different storage pool
 func (s *VirtualMachineService) MigrateVirtualMachine(p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("migrateVirtualMachine", p.toURLValues())
+	return s.MigrateVirtualMachineWithContext(context.Background(), p)
+}
+
+// MigrateVirtualMachineWithContext is the same as MigrateVirtualMachine, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VirtualMachineService) MigrateVirtualMachineWithContext(ctx context.Context, p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "migrateVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2114,26 +2572,56 @@ func (s *VirtualMachineService) MigrateVirtualMachine(p *MigrateVirtualMachinePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "migrateVirtualMachine", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// MigrateVirtualMachineJob is a handle to a running migrateVirtualMachine async job
+type MigrateVirtualMachineJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a MigrateVirtualMachineResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *MigrateVirtualMachineJob) Result() (*MigrateVirtualMachineResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := MigrateVirtualMachineResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// MigrateVirtualMachineAsync starts the migrateVirtualMachine async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VirtualMachineService) MigrateVirtualMachineAsync(ctx context.Context, p *MigrateVirtualMachineParams) (*MigrateVirtualMachineJob, error) {
+	resp, err := s.cs.newRequest(ctx, "migrateVirtualMachine", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r MigrateVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &MigrateVirtualMachineJob{s.cs.newJobHandle("migrateVirtualMachine", r.JobID)}, nil
+}
+
 type MigrateVirtualMachineResponse struct {
 	JobID         string `json:"jobid,omitempty"`
 	Account       string `json:"account,omitempty"`
@@ -2251,6 +2739,10 @@ type RebootVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+func (p *RebootVirtualMachineParams) command() string {
+	return "rebootVirtualMachine"
+}
+
 func (p *RebootVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2280,7 +2772,13 @@ func (s *VirtualMachineService) NewRebootVirtualMachineParams(id string) *Reboot
 
 // Reboots a virtual machine.
 func (s *VirtualMachineService) RebootVirtualMachine(p *RebootVirtualMachineParams) (*RebootVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("rebootVirtualMachine", p.toURLValues())
+	return s.RebootVirtualMachineWithContext(context.Background(), p)
+}
+
+// RebootVirtualMachineWithContext is the same as RebootVirtualMachine, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VirtualMachineService) RebootVirtualMachineWithContext(ctx context.Context, p *RebootVirtualMachineParams) (*RebootVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "rebootVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2292,26 +2790,56 @@ func (s *VirtualMachineService) RebootVirtualMachine(p *RebootVirtualMachinePara
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "rebootVirtualMachine", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// RebootVirtualMachineJob is a handle to a running rebootVirtualMachine async job
+type RebootVirtualMachineJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a RebootVirtualMachineResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *RebootVirtualMachineJob) Result() (*RebootVirtualMachineResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := RebootVirtualMachineResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// RebootVirtualMachineAsync starts the rebootVirtualMachine async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VirtualMachineService) RebootVirtualMachineAsync(ctx context.Context, p *RebootVirtualMachineParams) (*RebootVirtualMachineJob, error) {
+	resp, err := s.cs.newRequest(ctx, "rebootVirtualMachine", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RebootVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &RebootVirtualMachineJob{s.cs.newJobHandle("rebootVirtualMachine", r.JobID)}, nil
+}
+
 type RebootVirtualMachineResponse struct {
 	JobID         string `json:"jobid,omitempty"`
 	Account       string `json:"account,omitempty"`
@@ -2429,6 +2957,10 @@ type RecoverVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+func (p *RecoverVirtualMachineParams) command() string {
+	return "recoverVirtualMachine"
+}
+
 func (p *RecoverVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2458,7 +2990,13 @@ func (s *VirtualMachineService) NewRecoverVirtualMachineParams(id string) *Recov
 
 // Recovers a virtual machine.
 func (s *VirtualMachineService) RecoverVirtualMachine(p *RecoverVirtualMachineParams) (*RecoverVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("recoverVirtualMachine", p.toURLValues())
+	return s.RecoverVirtualMachineWithContext(context.Background(), p)
+}
+
+// RecoverVirtualMachineWithContext is the same as RecoverVirtualMachine, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VirtualMachineService) RecoverVirtualMachineWithContext(ctx context.Context, p *RecoverVirtualMachineParams) (*RecoverVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "recoverVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2586,6 +3124,10 @@ type RestoreVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+func (p *RestoreVirtualMachineParams) command() string {
+	return "restoreVirtualMachine"
+}
+
 func (p *RestoreVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2625,7 +3167,13 @@ func (s *VirtualMachineService) NewRestoreVirtualMachineParams(virtualmachineid
 
 // Restore a VM to original template/ISO or new template/ISO
 func (s *VirtualMachineService) RestoreVirtualMachine(p *RestoreVirtualMachineParams) (*RestoreVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("restoreVirtualMachine", p.toURLValues())
+	return s.RestoreVirtualMachineWithContext(context.Background(), p)
+}
+
+// RestoreVirtualMachineWithContext is the same as RestoreVirtualMachine, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VirtualMachineService) RestoreVirtualMachineWithContext(ctx context.Context, p *RestoreVirtualMachineParams) (*RestoreVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "restoreVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2637,26 +3185,56 @@ func (s *VirtualMachineService) RestoreVirtualMachine(p *RestoreVirtualMachinePa
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "restoreVirtualMachine", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// RestoreVirtualMachineJob is a handle to a running restoreVirtualMachine async job
+type RestoreVirtualMachineJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a RestoreVirtualMachineResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *RestoreVirtualMachineJob) Result() (*RestoreVirtualMachineResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := RestoreVirtualMachineResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// RestoreVirtualMachineAsync starts the restoreVirtualMachine async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VirtualMachineService) RestoreVirtualMachineAsync(ctx context.Context, p *RestoreVirtualMachineParams) (*RestoreVirtualMachineJob, error) {
+	resp, err := s.cs.newRequest(ctx, "restoreVirtualMachine", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RestoreVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &RestoreVirtualMachineJob{s.cs.newJobHandle("restoreVirtualMachine", r.JobID)}, nil
+}
+
 type RestoreVirtualMachineResponse struct {
 	JobID         string `json:"jobid,omitempty"`
 	Account       string `json:"account,omitempty"`
@@ -2774,6 +3352,10 @@ type ScaleVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+func (p *ScaleVirtualMachineParams) command() string {
+	return "scaleVirtualMachine"
+}
+
 func (p *ScaleVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2829,7 +3411,13 @@ func (s *VirtualMachineService) NewScaleVirtualMachineParams(id string, serviceo
 
 // Scales the virtual machine to a new service offering.
 func (s *VirtualMachineService) ScaleVirtualMachine(p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("scaleVirtualMachine", p.toURLValues())
+	return s.ScaleVirtualMachineWithContext(context.Background(), p)
+}
+
+// ScaleVirtualMachineWithContext is the same as ScaleVirtualMachine, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VirtualMachineService) ScaleVirtualMachineWithContext(ctx context.Context, p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "scaleVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2841,9 +3429,9 @@ func (s *VirtualMachineService) ScaleVirtualMachine(p *ScaleVirtualMachineParams
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "scaleVirtualMachine", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -2856,6 +3444,41 @@ func (s *VirtualMachineService) ScaleVirtualMachine(p *ScaleVirtualMachineParams
 	return &r, nil
 }
 
+// ScaleVirtualMachineJob is a handle to a running scaleVirtualMachine async job
+type ScaleVirtualMachineJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a ScaleVirtualMachineResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *ScaleVirtualMachineJob) Result() (*ScaleVirtualMachineResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := ScaleVirtualMachineResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ScaleVirtualMachineAsync starts the scaleVirtualMachine async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VirtualMachineService) ScaleVirtualMachineAsync(ctx context.Context, p *ScaleVirtualMachineParams) (*ScaleVirtualMachineJob, error) {
+	resp, err := s.cs.newRequest(ctx, "scaleVirtualMachine", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ScaleVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &ScaleVirtualMachineJob{s.cs.newJobHandle("scaleVirtualMachine", r.JobID)}, nil
+}
+
 type ScaleVirtualMachineResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -2866,6 +3489,10 @@ type StartVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+func (p *StartVirtualMachineParams) command() string {
+	return "startVirtualMachine"
+}
+
 func (p *StartVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -2915,7 +3542,13 @@ func (s *VirtualMachineService) NewStartVirtualMachineParams(id string) *StartVi
 
 // Starts a virtual machine.
 func (s *VirtualMachineService) StartVirtualMachine(p *StartVirtualMachineParams) (*StartVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("startVirtualMachine", p.toURLValues())
+	return s.StartVirtualMachineWithContext(context.Background(), p)
+}
+
+// StartVirtualMachineWithContext is the same as StartVirtualMachine, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VirtualMachineService) StartVirtualMachineWithContext(ctx context.Context, p *StartVirtualMachineParams) (*StartVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "startVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2927,26 +3560,56 @@ func (s *VirtualMachineService) StartVirtualMachine(p *StartVirtualMachineParams
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "startVirtualMachine", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// StartVirtualMachineJob is a handle to a running startVirtualMachine async job
+type StartVirtualMachineJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a StartVirtualMachineResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *StartVirtualMachineJob) Result() (*StartVirtualMachineResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := StartVirtualMachineResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// StartVirtualMachineAsync starts the startVirtualMachine async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VirtualMachineService) StartVirtualMachineAsync(ctx context.Context, p *StartVirtualMachineParams) (*StartVirtualMachineJob, error) {
+	resp, err := s.cs.newRequest(ctx, "startVirtualMachine", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r StartVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &StartVirtualMachineJob{s.cs.newJobHandle("startVirtualMachine", r.JobID)}, nil
+}
+
 type StartVirtualMachineResponse struct {
 	JobID         string `json:"jobid,omitempty"`
 	Account       string `json:"account,omitempty"`
@@ -3064,6 +3727,10 @@ type StopVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+func (p *StopVirtualMachineParams) command() string {
+	return "stopVirtualMachine"
+}
+
 func (p *StopVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3104,7 +3771,13 @@ func (s *VirtualMachineService) NewStopVirtualMachineParams(id string) *StopVirt
 
 // Stops a virtual machine.
 func (s *VirtualMachineService) StopVirtualMachine(p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("stopVirtualMachine", p.toURLValues())
+	return s.StopVirtualMachineWithContext(context.Background(), p)
+}
+
+// StopVirtualMachineWithContext is the same as StopVirtualMachine, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VirtualMachineService) StopVirtualMachineWithContext(ctx context.Context, p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "stopVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3116,26 +3789,56 @@ func (s *VirtualMachineService) StopVirtualMachine(p *StopVirtualMachineParams)
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "stopVirtualMachine", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// StopVirtualMachineJob is a handle to a running stopVirtualMachine async job
+type StopVirtualMachineJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a StopVirtualMachineResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *StopVirtualMachineJob) Result() (*StopVirtualMachineResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := StopVirtualMachineResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// StopVirtualMachineAsync starts the stopVirtualMachine async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VirtualMachineService) StopVirtualMachineAsync(ctx context.Context, p *StopVirtualMachineParams) (*StopVirtualMachineJob, error) {
+	resp, err := s.cs.newRequest(ctx, "stopVirtualMachine", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r StopVirtualMachineResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &StopVirtualMachineJob{s.cs.newJobHandle("stopVirtualMachine", r.JobID)}, nil
+}
+
 type StopVirtualMachineResponse struct {
 	JobID         string `json:"jobid,omitempty"`
 	Account       string `json:"account,omitempty"`
@@ -3253,6 +3956,10 @@ type UpdateVirtualMachineParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateVirtualMachineParams) command() string {
+	return "updateVirtualMachine"
+}
+
 func (p *UpdateVirtualMachineParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3452,7 +4159,13 @@ func (s *VirtualMachineService) NewUpdateVirtualMachineParams(id string) *Update
 
 // Updates properties of a virtual machine. The VM has to be stopped and restarted for the new properties to take effect. UpdateVirtualMachine does not first check whether the VM is stopped. Therefore, stop the VM manually before issuing this call.
 func (s *VirtualMachineService) UpdateVirtualMachine(p *UpdateVirtualMachineParams) (*UpdateVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("updateVirtualMachine", p.toURLValues())
+	return s.UpdateVirtualMachineWithContext(context.Background(), p)
+}
+
+// UpdateVirtualMachineWithContext is the same as UpdateVirtualMachine, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VirtualMachineService) UpdateVirtualMachineWithContext(ctx context.Context, p *UpdateVirtualMachineParams) (*UpdateVirtualMachineResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3580,6 +4293,10 @@ type MigrateVirtualMachineWithVolumeParams struct {
 	p map[string]interface{}
 }
 
+func (p *MigrateVirtualMachineWithVolumeParams) command() string {
+	return "migrateVirtualMachineWithVolume"
+}
+
 func (p *MigrateVirtualMachineWithVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -3635,7 +4352,13 @@ func (s *VirtualMachineService) NewMigrateVirtualMachineWithVolumeParams(hostid
 
 // Attempts Migration of a VM with its volumes to a different host
 func (s *VirtualMachineService) MigrateVirtualMachineWithVolume(p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeResponse, error) {
-	resp, err := s.cs.newRequest("migrateVirtualMachineWithVolume", p.toURLValues())
+	return s.MigrateVirtualMachineWithVolumeWithContext(context.Background(), p)
+}
+
+// MigrateVirtualMachineWithVolumeWithContext is the same as MigrateVirtualMachineWithVolume, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VirtualMachineService) MigrateVirtualMachineWithVolumeWithContext(ctx context.Context, p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "migrateVirtualMachineWithVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3647,26 +4370,56 @@ func (s *VirtualMachineService) MigrateVirtualMachineWithVolume(p *MigrateVirtua
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "migrateVirtualMachineWithVolume", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// MigrateVirtualMachineWithVolumeJob is a handle to a running migrateVirtualMachineWithVolume async job
+type MigrateVirtualMachineWithVolumeJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a MigrateVirtualMachineWithVolumeResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *MigrateVirtualMachineWithVolumeJob) Result() (*MigrateVirtualMachineWithVolumeResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := MigrateVirtualMachineWithVolumeResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "virtualmachine", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// MigrateVirtualMachineWithVolumeAsync starts the migrateVirtualMachineWithVolume async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VirtualMachineService) MigrateVirtualMachineWithVolumeAsync(ctx context.Context, p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeJob, error) {
+	resp, err := s.cs.newRequest(ctx, "migrateVirtualMachineWithVolume", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r MigrateVirtualMachineWithVolumeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &MigrateVirtualMachineWithVolumeJob{s.cs.newJobHandle("migrateVirtualMachineWithVolume", r.JobID)}, nil
+}
+
 type MigrateVirtualMachineWithVolumeResponse struct {
 	JobID         string `json:"jobid,omitempty"`
 	Account       string `json:"account,omitempty"`
@@ -3784,6 +4537,10 @@ type ListVirtualMachinesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListVirtualMachinesParams) command() string {
+	return "listVirtualMachines"
+}
+
 func (p *ListVirtualMachinesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -4132,7 +4889,7 @@ func (s *VirtualMachineService) GetVirtualMachineID(name string, opts ...OptionF
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -4146,7 +4903,7 @@ func (s *VirtualMachineService) GetVirtualMachineID(name string, opts ...OptionF
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -4178,16 +4935,14 @@ func (s *VirtualMachineService) GetVirtualMachineByID(id string, opts ...OptionF
 
 	l, err := s.ListVirtualMachines(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -4199,10 +4954,17 @@ func (s *VirtualMachineService) GetVirtualMachineByID(id string, opts ...OptionF
 
 // List the virtual machines owned by the account.
 func (s *VirtualMachineService) ListVirtualMachines(p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error) {
+	return s.ListVirtualMachinesWithContext(context.Background(), p)
+}
+
+// ListVirtualMachinesWithContext is the same as ListVirtualMachines, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VirtualMachineService) ListVirtualMachinesWithContext(ctx context.Context, p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error) {
 	var r ListVirtualMachinesResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListVirtualMachinesResponse
-		resp, err := s.cs.newRequest("listVirtualMachines", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listVirtualMachines", values)
 		if err != nil {
 			return nil, err
 		}
@@ -4214,13 +4976,89 @@ func (s *VirtualMachineService) ListVirtualMachines(p *ListVirtualMachinesParams
 		r.Count = l.Count
 		r.VirtualMachines = append(r.VirtualMachines, l.VirtualMachines...)
 
-		if r.Count == len(r.VirtualMachines) {
+		if r.Count == len(r.VirtualMachines) || len(l.VirtualMachines) == 0 {
+			s.cs.observePages("listVirtualMachines", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.VirtualMachines))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.VirtualMachines)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listVirtualMachines", values, len(l.VirtualMachines), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListVirtualMachinesResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.VirtualMachines = append(r.VirtualMachines, l.VirtualMachines...)
+			}
+
+			if r.Count == len(r.VirtualMachines) {
+				s.cs.observePages("listVirtualMachines", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListVirtualMachinesIterator iterates over the items returned by listVirtualMachines, requesting
+// the pages of the response one at a time while iterating.
+type ListVirtualMachinesIterator struct {
+	pager
+	items []*VirtualMachine
+	index int
+}
+
+// NewListVirtualMachinesIterator returns an iterator over the items returned by listVirtualMachines, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *VirtualMachineService) NewListVirtualMachinesIterator(p *ListVirtualMachinesParams, pagesize int) *ListVirtualMachinesIterator {
+	return &ListVirtualMachinesIterator{pager: newPager(s.cs, "listVirtualMachines", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListVirtualMachinesIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListVirtualMachinesResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.VirtualMachines))
+
+	i.items = l.VirtualMachines
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListVirtualMachinesIterator) Value() *VirtualMachine {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListVirtualMachinesResponse struct {
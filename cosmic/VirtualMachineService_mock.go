@@ -0,0 +1,562 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockVirtualMachineService is a mock of VirtualMachineServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockVirtualMachineService struct {
+	mockCalls
+
+	UpdateDefaultNicForVirtualMachineFunc      func(ctx context.Context, p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineResponse, error)
+	UpdateDefaultNicForVirtualMachineAsyncFunc func(ctx context.Context, p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineJob, error)
+	RemoveNicFromVirtualMachineFunc            func(ctx context.Context, p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineResponse, error)
+	RemoveNicFromVirtualMachineAsyncFunc       func(ctx context.Context, p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineJob, error)
+	AddNicToVirtualMachineFunc                 func(ctx context.Context, p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineResponse, error)
+	AddNicToVirtualMachineAsyncFunc            func(ctx context.Context, p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineJob, error)
+	ResetPasswordForVirtualMachineFunc         func(ctx context.Context, p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error)
+	ResetPasswordForVirtualMachineAsyncFunc    func(ctx context.Context, p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineJob, error)
+	ChangeServiceForVirtualMachineFunc         func(ctx context.Context, p *ChangeServiceForVirtualMachineParams) (*ChangeServiceForVirtualMachineResponse, error)
+	GetVMPasswordFunc                          func(ctx context.Context, p *GetVMPasswordParams) (*GetVMPasswordResponse, error)
+	CleanVMReservationsFunc                    func(ctx context.Context, p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error)
+	CleanVMReservationsAsyncFunc               func(ctx context.Context, p *CleanVMReservationsParams) (*CleanVMReservationsJob, error)
+	AssignVirtualMachineFunc                   func(ctx context.Context, p *AssignVirtualMachineParams) (*AssignVirtualMachineResponse, error)
+	DeployVirtualMachineFunc                   func(ctx context.Context, p *DeployVirtualMachineParams) (*DeployVirtualMachineResponse, error)
+	DeployVirtualMachineAsyncFunc              func(ctx context.Context, p *DeployVirtualMachineParams) (*DeployVirtualMachineJob, error)
+	DestroyVirtualMachineFunc                  func(ctx context.Context, p *DestroyVirtualMachineParams) (*DestroyVirtualMachineResponse, error)
+	DestroyVirtualMachineAsyncFunc             func(ctx context.Context, p *DestroyVirtualMachineParams) (*DestroyVirtualMachineJob, error)
+	ExpungeVirtualMachineFunc                  func(ctx context.Context, p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error)
+	ExpungeVirtualMachineAsyncFunc             func(ctx context.Context, p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineJob, error)
+	MigrateVirtualMachineFunc                  func(ctx context.Context, p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error)
+	MigrateVirtualMachineAsyncFunc             func(ctx context.Context, p *MigrateVirtualMachineParams) (*MigrateVirtualMachineJob, error)
+	RebootVirtualMachineFunc                   func(ctx context.Context, p *RebootVirtualMachineParams) (*RebootVirtualMachineResponse, error)
+	RebootVirtualMachineAsyncFunc              func(ctx context.Context, p *RebootVirtualMachineParams) (*RebootVirtualMachineJob, error)
+	RecoverVirtualMachineFunc                  func(ctx context.Context, p *RecoverVirtualMachineParams) (*RecoverVirtualMachineResponse, error)
+	RestoreVirtualMachineFunc                  func(ctx context.Context, p *RestoreVirtualMachineParams) (*RestoreVirtualMachineResponse, error)
+	RestoreVirtualMachineAsyncFunc             func(ctx context.Context, p *RestoreVirtualMachineParams) (*RestoreVirtualMachineJob, error)
+	ScaleVirtualMachineFunc                    func(ctx context.Context, p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error)
+	ScaleVirtualMachineAsyncFunc               func(ctx context.Context, p *ScaleVirtualMachineParams) (*ScaleVirtualMachineJob, error)
+	StartVirtualMachineFunc                    func(ctx context.Context, p *StartVirtualMachineParams) (*StartVirtualMachineResponse, error)
+	StartVirtualMachineAsyncFunc               func(ctx context.Context, p *StartVirtualMachineParams) (*StartVirtualMachineJob, error)
+	StopVirtualMachineFunc                     func(ctx context.Context, p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error)
+	StopVirtualMachineAsyncFunc                func(ctx context.Context, p *StopVirtualMachineParams) (*StopVirtualMachineJob, error)
+	UpdateVirtualMachineFunc                   func(ctx context.Context, p *UpdateVirtualMachineParams) (*UpdateVirtualMachineResponse, error)
+	MigrateVirtualMachineWithVolumeFunc        func(ctx context.Context, p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeResponse, error)
+	MigrateVirtualMachineWithVolumeAsyncFunc   func(ctx context.Context, p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeJob, error)
+	GetVirtualMachineIDFunc                    func(name string, opts ...OptionFunc) (string, int, error)
+	GetVirtualMachineByNameFunc                func(name string, opts ...OptionFunc) (*VirtualMachine, int, error)
+	GetVirtualMachineByIDFunc                  func(id string, opts ...OptionFunc) (*VirtualMachine, int, error)
+	ListVirtualMachinesFunc                    func(ctx context.Context, p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error)
+}
+
+var _ VirtualMachineServiceIface = &MockVirtualMachineService{}
+
+func (m *MockVirtualMachineService) NewUpdateDefaultNicForVirtualMachineParams(nicid string, virtualmachineid string) *UpdateDefaultNicForVirtualMachineParams {
+	return (&VirtualMachineService{}).NewUpdateDefaultNicForVirtualMachineParams(nicid, virtualmachineid)
+}
+
+func (m *MockVirtualMachineService) UpdateDefaultNicForVirtualMachine(p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineResponse, error) {
+	return m.UpdateDefaultNicForVirtualMachineWithContext(context.Background(), p)
+}
+
+func (m *MockVirtualMachineService) UpdateDefaultNicForVirtualMachineWithContext(ctx context.Context, p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineResponse, error) {
+	m.record("UpdateDefaultNicForVirtualMachine", p)
+	if m.UpdateDefaultNicForVirtualMachineFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "UpdateDefaultNicForVirtualMachine")
+	}
+	return m.UpdateDefaultNicForVirtualMachineFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) UpdateDefaultNicForVirtualMachineAsync(ctx context.Context, p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineJob, error) {
+	m.record("UpdateDefaultNicForVirtualMachineAsync", p)
+	if m.UpdateDefaultNicForVirtualMachineAsyncFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "UpdateDefaultNicForVirtualMachineAsync")
+	}
+	return m.UpdateDefaultNicForVirtualMachineAsyncFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) NewRemoveNicFromVirtualMachineParams(nicid string, virtualmachineid string) *RemoveNicFromVirtualMachineParams {
+	return (&VirtualMachineService{}).NewRemoveNicFromVirtualMachineParams(nicid, virtualmachineid)
+}
+
+func (m *MockVirtualMachineService) RemoveNicFromVirtualMachine(p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineResponse, error) {
+	return m.RemoveNicFromVirtualMachineWithContext(context.Background(), p)
+}
+
+func (m *MockVirtualMachineService) RemoveNicFromVirtualMachineWithContext(ctx context.Context, p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineResponse, error) {
+	m.record("RemoveNicFromVirtualMachine", p)
+	if m.RemoveNicFromVirtualMachineFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "RemoveNicFromVirtualMachine")
+	}
+	return m.RemoveNicFromVirtualMachineFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) RemoveNicFromVirtualMachineAsync(ctx context.Context, p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineJob, error) {
+	m.record("RemoveNicFromVirtualMachineAsync", p)
+	if m.RemoveNicFromVirtualMachineAsyncFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "RemoveNicFromVirtualMachineAsync")
+	}
+	return m.RemoveNicFromVirtualMachineAsyncFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) NewAddNicToVirtualMachineParams(networkid string, virtualmachineid string) *AddNicToVirtualMachineParams {
+	return (&VirtualMachineService{}).NewAddNicToVirtualMachineParams(networkid, virtualmachineid)
+}
+
+func (m *MockVirtualMachineService) AddNicToVirtualMachine(p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineResponse, error) {
+	return m.AddNicToVirtualMachineWithContext(context.Background(), p)
+}
+
+func (m *MockVirtualMachineService) AddNicToVirtualMachineWithContext(ctx context.Context, p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineResponse, error) {
+	m.record("AddNicToVirtualMachine", p)
+	if m.AddNicToVirtualMachineFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "AddNicToVirtualMachine")
+	}
+	return m.AddNicToVirtualMachineFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) AddNicToVirtualMachineAsync(ctx context.Context, p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineJob, error) {
+	m.record("AddNicToVirtualMachineAsync", p)
+	if m.AddNicToVirtualMachineAsyncFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "AddNicToVirtualMachineAsync")
+	}
+	return m.AddNicToVirtualMachineAsyncFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) NewResetPasswordForVirtualMachineParams(id string) *ResetPasswordForVirtualMachineParams {
+	return (&VirtualMachineService{}).NewResetPasswordForVirtualMachineParams(id)
+}
+
+func (m *MockVirtualMachineService) ResetPasswordForVirtualMachine(p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error) {
+	return m.ResetPasswordForVirtualMachineWithContext(context.Background(), p)
+}
+
+func (m *MockVirtualMachineService) ResetPasswordForVirtualMachineWithContext(ctx context.Context, p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error) {
+	m.record("ResetPasswordForVirtualMachine", p)
+	if m.ResetPasswordForVirtualMachineFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "ResetPasswordForVirtualMachine")
+	}
+	return m.ResetPasswordForVirtualMachineFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) ResetPasswordForVirtualMachineAsync(ctx context.Context, p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineJob, error) {
+	m.record("ResetPasswordForVirtualMachineAsync", p)
+	if m.ResetPasswordForVirtualMachineAsyncFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "ResetPasswordForVirtualMachineAsync")
+	}
+	return m.ResetPasswordForVirtualMachineAsyncFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) NewChangeServiceForVirtualMachineParams(id string, serviceofferingid string) *ChangeServiceForVirtualMachineParams {
+	return (&VirtualMachineService{}).NewChangeServiceForVirtualMachineParams(id, serviceofferingid)
+}
+
+func (m *MockVirtualMachineService) ChangeServiceForVirtualMachine(p *ChangeServiceForVirtualMachineParams) (*ChangeServiceForVirtualMachineResponse, error) {
+	return m.ChangeServiceForVirtualMachineWithContext(context.Background(), p)
+}
+
+func (m *MockVirtualMachineService) ChangeServiceForVirtualMachineWithContext(ctx context.Context, p *ChangeServiceForVirtualMachineParams) (*ChangeServiceForVirtualMachineResponse, error) {
+	m.record("ChangeServiceForVirtualMachine", p)
+	if m.ChangeServiceForVirtualMachineFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "ChangeServiceForVirtualMachine")
+	}
+	return m.ChangeServiceForVirtualMachineFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) NewGetVMPasswordParams(id string) *GetVMPasswordParams {
+	return (&VirtualMachineService{}).NewGetVMPasswordParams(id)
+}
+
+func (m *MockVirtualMachineService) GetVMPassword(p *GetVMPasswordParams) (*GetVMPasswordResponse, error) {
+	return m.GetVMPasswordWithContext(context.Background(), p)
+}
+
+func (m *MockVirtualMachineService) GetVMPasswordWithContext(ctx context.Context, p *GetVMPasswordParams) (*GetVMPasswordResponse, error) {
+	m.record("GetVMPassword", p)
+	if m.GetVMPasswordFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "GetVMPassword")
+	}
+	return m.GetVMPasswordFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) NewCleanVMReservationsParams() *CleanVMReservationsParams {
+	return (&VirtualMachineService{}).NewCleanVMReservationsParams()
+}
+
+func (m *MockVirtualMachineService) CleanVMReservations(p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error) {
+	return m.CleanVMReservationsWithContext(context.Background(), p)
+}
+
+func (m *MockVirtualMachineService) CleanVMReservationsWithContext(ctx context.Context, p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error) {
+	m.record("CleanVMReservations", p)
+	if m.CleanVMReservationsFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "CleanVMReservations")
+	}
+	return m.CleanVMReservationsFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) CleanVMReservationsAsync(ctx context.Context, p *CleanVMReservationsParams) (*CleanVMReservationsJob, error) {
+	m.record("CleanVMReservationsAsync", p)
+	if m.CleanVMReservationsAsyncFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "CleanVMReservationsAsync")
+	}
+	return m.CleanVMReservationsAsyncFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) NewAssignVirtualMachineParams(account string, domainid string, virtualmachineid string) *AssignVirtualMachineParams {
+	return (&VirtualMachineService{}).NewAssignVirtualMachineParams(account, domainid, virtualmachineid)
+}
+
+func (m *MockVirtualMachineService) AssignVirtualMachine(p *AssignVirtualMachineParams) (*AssignVirtualMachineResponse, error) {
+	return m.AssignVirtualMachineWithContext(context.Background(), p)
+}
+
+func (m *MockVirtualMachineService) AssignVirtualMachineWithContext(ctx context.Context, p *AssignVirtualMachineParams) (*AssignVirtualMachineResponse, error) {
+	m.record("AssignVirtualMachine", p)
+	if m.AssignVirtualMachineFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "AssignVirtualMachine")
+	}
+	return m.AssignVirtualMachineFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) NewDeployVirtualMachineParams(serviceofferingid string, templateid string, zoneid string) *DeployVirtualMachineParams {
+	return (&VirtualMachineService{}).NewDeployVirtualMachineParams(serviceofferingid, templateid, zoneid)
+}
+
+func (m *MockVirtualMachineService) DeployVirtualMachine(p *DeployVirtualMachineParams) (*DeployVirtualMachineResponse, error) {
+	return m.DeployVirtualMachineWithContext(context.Background(), p)
+}
+
+func (m *MockVirtualMachineService) DeployVirtualMachineWithContext(ctx context.Context, p *DeployVirtualMachineParams) (*DeployVirtualMachineResponse, error) {
+	m.record("DeployVirtualMachine", p)
+	if m.DeployVirtualMachineFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "DeployVirtualMachine")
+	}
+	return m.DeployVirtualMachineFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) DeployVirtualMachineAsync(ctx context.Context, p *DeployVirtualMachineParams) (*DeployVirtualMachineJob, error) {
+	m.record("DeployVirtualMachineAsync", p)
+	if m.DeployVirtualMachineAsyncFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "DeployVirtualMachineAsync")
+	}
+	return m.DeployVirtualMachineAsyncFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) NewDestroyVirtualMachineParams(id string) *DestroyVirtualMachineParams {
+	return (&VirtualMachineService{}).NewDestroyVirtualMachineParams(id)
+}
+
+func (m *MockVirtualMachineService) DestroyVirtualMachine(p *DestroyVirtualMachineParams) (*DestroyVirtualMachineResponse, error) {
+	return m.DestroyVirtualMachineWithContext(context.Background(), p)
+}
+
+func (m *MockVirtualMachineService) DestroyVirtualMachineWithContext(ctx context.Context, p *DestroyVirtualMachineParams) (*DestroyVirtualMachineResponse, error) {
+	m.record("DestroyVirtualMachine", p)
+	if m.DestroyVirtualMachineFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "DestroyVirtualMachine")
+	}
+	return m.DestroyVirtualMachineFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) DestroyVirtualMachineAsync(ctx context.Context, p *DestroyVirtualMachineParams) (*DestroyVirtualMachineJob, error) {
+	m.record("DestroyVirtualMachineAsync", p)
+	if m.DestroyVirtualMachineAsyncFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "DestroyVirtualMachineAsync")
+	}
+	return m.DestroyVirtualMachineAsyncFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) NewExpungeVirtualMachineParams(id string) *ExpungeVirtualMachineParams {
+	return (&VirtualMachineService{}).NewExpungeVirtualMachineParams(id)
+}
+
+func (m *MockVirtualMachineService) ExpungeVirtualMachine(p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error) {
+	return m.ExpungeVirtualMachineWithContext(context.Background(), p)
+}
+
+func (m *MockVirtualMachineService) ExpungeVirtualMachineWithContext(ctx context.Context, p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error) {
+	m.record("ExpungeVirtualMachine", p)
+	if m.ExpungeVirtualMachineFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "ExpungeVirtualMachine")
+	}
+	return m.ExpungeVirtualMachineFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) ExpungeVirtualMachineAsync(ctx context.Context, p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineJob, error) {
+	m.record("ExpungeVirtualMachineAsync", p)
+	if m.ExpungeVirtualMachineAsyncFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "ExpungeVirtualMachineAsync")
+	}
+	return m.ExpungeVirtualMachineAsyncFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) NewMigrateVirtualMachineParams(virtualmachineid string) *MigrateVirtualMachineParams {
+	return (&VirtualMachineService{}).NewMigrateVirtualMachineParams(virtualmachineid)
+}
+
+func (m *MockVirtualMachineService) MigrateVirtualMachine(p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error) {
+	return m.MigrateVirtualMachineWithContext(context.Background(), p)
+}
+
+func (m *MockVirtualMachineService) MigrateVirtualMachineWithContext(ctx context.Context, p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error) {
+	m.record("MigrateVirtualMachine", p)
+	if m.MigrateVirtualMachineFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "MigrateVirtualMachine")
+	}
+	return m.MigrateVirtualMachineFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) MigrateVirtualMachineAsync(ctx context.Context, p *MigrateVirtualMachineParams) (*MigrateVirtualMachineJob, error) {
+	m.record("MigrateVirtualMachineAsync", p)
+	if m.MigrateVirtualMachineAsyncFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "MigrateVirtualMachineAsync")
+	}
+	return m.MigrateVirtualMachineAsyncFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) NewRebootVirtualMachineParams(id string) *RebootVirtualMachineParams {
+	return (&VirtualMachineService{}).NewRebootVirtualMachineParams(id)
+}
+
+func (m *MockVirtualMachineService) RebootVirtualMachine(p *RebootVirtualMachineParams) (*RebootVirtualMachineResponse, error) {
+	return m.RebootVirtualMachineWithContext(context.Background(), p)
+}
+
+func (m *MockVirtualMachineService) RebootVirtualMachineWithContext(ctx context.Context, p *RebootVirtualMachineParams) (*RebootVirtualMachineResponse, error) {
+	m.record("RebootVirtualMachine", p)
+	if m.RebootVirtualMachineFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "RebootVirtualMachine")
+	}
+	return m.RebootVirtualMachineFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) RebootVirtualMachineAsync(ctx context.Context, p *RebootVirtualMachineParams) (*RebootVirtualMachineJob, error) {
+	m.record("RebootVirtualMachineAsync", p)
+	if m.RebootVirtualMachineAsyncFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "RebootVirtualMachineAsync")
+	}
+	return m.RebootVirtualMachineAsyncFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) NewRecoverVirtualMachineParams(id string) *RecoverVirtualMachineParams {
+	return (&VirtualMachineService{}).NewRecoverVirtualMachineParams(id)
+}
+
+func (m *MockVirtualMachineService) RecoverVirtualMachine(p *RecoverVirtualMachineParams) (*RecoverVirtualMachineResponse, error) {
+	return m.RecoverVirtualMachineWithContext(context.Background(), p)
+}
+
+func (m *MockVirtualMachineService) RecoverVirtualMachineWithContext(ctx context.Context, p *RecoverVirtualMachineParams) (*RecoverVirtualMachineResponse, error) {
+	m.record("RecoverVirtualMachine", p)
+	if m.RecoverVirtualMachineFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "RecoverVirtualMachine")
+	}
+	return m.RecoverVirtualMachineFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) NewRestoreVirtualMachineParams(virtualmachineid string) *RestoreVirtualMachineParams {
+	return (&VirtualMachineService{}).NewRestoreVirtualMachineParams(virtualmachineid)
+}
+
+func (m *MockVirtualMachineService) RestoreVirtualMachine(p *RestoreVirtualMachineParams) (*RestoreVirtualMachineResponse, error) {
+	return m.RestoreVirtualMachineWithContext(context.Background(), p)
+}
+
+func (m *MockVirtualMachineService) RestoreVirtualMachineWithContext(ctx context.Context, p *RestoreVirtualMachineParams) (*RestoreVirtualMachineResponse, error) {
+	m.record("RestoreVirtualMachine", p)
+	if m.RestoreVirtualMachineFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "RestoreVirtualMachine")
+	}
+	return m.RestoreVirtualMachineFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) RestoreVirtualMachineAsync(ctx context.Context, p *RestoreVirtualMachineParams) (*RestoreVirtualMachineJob, error) {
+	m.record("RestoreVirtualMachineAsync", p)
+	if m.RestoreVirtualMachineAsyncFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "RestoreVirtualMachineAsync")
+	}
+	return m.RestoreVirtualMachineAsyncFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) NewScaleVirtualMachineParams(id string, serviceofferingid string) *ScaleVirtualMachineParams {
+	return (&VirtualMachineService{}).NewScaleVirtualMachineParams(id, serviceofferingid)
+}
+
+func (m *MockVirtualMachineService) ScaleVirtualMachine(p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error) {
+	return m.ScaleVirtualMachineWithContext(context.Background(), p)
+}
+
+func (m *MockVirtualMachineService) ScaleVirtualMachineWithContext(ctx context.Context, p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error) {
+	m.record("ScaleVirtualMachine", p)
+	if m.ScaleVirtualMachineFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "ScaleVirtualMachine")
+	}
+	return m.ScaleVirtualMachineFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) ScaleVirtualMachineAsync(ctx context.Context, p *ScaleVirtualMachineParams) (*ScaleVirtualMachineJob, error) {
+	m.record("ScaleVirtualMachineAsync", p)
+	if m.ScaleVirtualMachineAsyncFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "ScaleVirtualMachineAsync")
+	}
+	return m.ScaleVirtualMachineAsyncFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) NewStartVirtualMachineParams(id string) *StartVirtualMachineParams {
+	return (&VirtualMachineService{}).NewStartVirtualMachineParams(id)
+}
+
+func (m *MockVirtualMachineService) StartVirtualMachine(p *StartVirtualMachineParams) (*StartVirtualMachineResponse, error) {
+	return m.StartVirtualMachineWithContext(context.Background(), p)
+}
+
+func (m *MockVirtualMachineService) StartVirtualMachineWithContext(ctx context.Context, p *StartVirtualMachineParams) (*StartVirtualMachineResponse, error) {
+	m.record("StartVirtualMachine", p)
+	if m.StartVirtualMachineFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "StartVirtualMachine")
+	}
+	return m.StartVirtualMachineFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) StartVirtualMachineAsync(ctx context.Context, p *StartVirtualMachineParams) (*StartVirtualMachineJob, error) {
+	m.record("StartVirtualMachineAsync", p)
+	if m.StartVirtualMachineAsyncFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "StartVirtualMachineAsync")
+	}
+	return m.StartVirtualMachineAsyncFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) NewStopVirtualMachineParams(id string) *StopVirtualMachineParams {
+	return (&VirtualMachineService{}).NewStopVirtualMachineParams(id)
+}
+
+func (m *MockVirtualMachineService) StopVirtualMachine(p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error) {
+	return m.StopVirtualMachineWithContext(context.Background(), p)
+}
+
+func (m *MockVirtualMachineService) StopVirtualMachineWithContext(ctx context.Context, p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error) {
+	m.record("StopVirtualMachine", p)
+	if m.StopVirtualMachineFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "StopVirtualMachine")
+	}
+	return m.StopVirtualMachineFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) StopVirtualMachineAsync(ctx context.Context, p *StopVirtualMachineParams) (*StopVirtualMachineJob, error) {
+	m.record("StopVirtualMachineAsync", p)
+	if m.StopVirtualMachineAsyncFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "StopVirtualMachineAsync")
+	}
+	return m.StopVirtualMachineAsyncFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) NewUpdateVirtualMachineParams(id string) *UpdateVirtualMachineParams {
+	return (&VirtualMachineService{}).NewUpdateVirtualMachineParams(id)
+}
+
+func (m *MockVirtualMachineService) UpdateVirtualMachine(p *UpdateVirtualMachineParams) (*UpdateVirtualMachineResponse, error) {
+	return m.UpdateVirtualMachineWithContext(context.Background(), p)
+}
+
+func (m *MockVirtualMachineService) UpdateVirtualMachineWithContext(ctx context.Context, p *UpdateVirtualMachineParams) (*UpdateVirtualMachineResponse, error) {
+	m.record("UpdateVirtualMachine", p)
+	if m.UpdateVirtualMachineFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "UpdateVirtualMachine")
+	}
+	return m.UpdateVirtualMachineFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) NewMigrateVirtualMachineWithVolumeParams(hostid string, virtualmachineid string) *MigrateVirtualMachineWithVolumeParams {
+	return (&VirtualMachineService{}).NewMigrateVirtualMachineWithVolumeParams(hostid, virtualmachineid)
+}
+
+func (m *MockVirtualMachineService) MigrateVirtualMachineWithVolume(p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeResponse, error) {
+	return m.MigrateVirtualMachineWithVolumeWithContext(context.Background(), p)
+}
+
+func (m *MockVirtualMachineService) MigrateVirtualMachineWithVolumeWithContext(ctx context.Context, p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeResponse, error) {
+	m.record("MigrateVirtualMachineWithVolume", p)
+	if m.MigrateVirtualMachineWithVolumeFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "MigrateVirtualMachineWithVolume")
+	}
+	return m.MigrateVirtualMachineWithVolumeFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) MigrateVirtualMachineWithVolumeAsync(ctx context.Context, p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeJob, error) {
+	m.record("MigrateVirtualMachineWithVolumeAsync", p)
+	if m.MigrateVirtualMachineWithVolumeAsyncFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "MigrateVirtualMachineWithVolumeAsync")
+	}
+	return m.MigrateVirtualMachineWithVolumeAsyncFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) NewListVirtualMachinesParams() *ListVirtualMachinesParams {
+	return (&VirtualMachineService{}).NewListVirtualMachinesParams()
+}
+
+func (m *MockVirtualMachineService) GetVirtualMachineID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetVirtualMachineID", name, opts)
+	if m.GetVirtualMachineIDFunc == nil {
+		return "", -1, notMocked("MockVirtualMachineService", "GetVirtualMachineID")
+	}
+	return m.GetVirtualMachineIDFunc(name, opts...)
+}
+
+func (m *MockVirtualMachineService) GetVirtualMachineByName(name string, opts ...OptionFunc) (*VirtualMachine, int, error) {
+	m.record("GetVirtualMachineByName", name, opts)
+	if m.GetVirtualMachineByNameFunc == nil {
+		return nil, -1, notMocked("MockVirtualMachineService", "GetVirtualMachineByName")
+	}
+	return m.GetVirtualMachineByNameFunc(name, opts...)
+}
+
+func (m *MockVirtualMachineService) GetVirtualMachineByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error) {
+	m.record("GetVirtualMachineByID", id, opts)
+	if m.GetVirtualMachineByIDFunc == nil {
+		return nil, -1, notMocked("MockVirtualMachineService", "GetVirtualMachineByID")
+	}
+	return m.GetVirtualMachineByIDFunc(id, opts...)
+}
+
+func (m *MockVirtualMachineService) ListVirtualMachines(p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error) {
+	return m.ListVirtualMachinesWithContext(context.Background(), p)
+}
+
+func (m *MockVirtualMachineService) ListVirtualMachinesWithContext(ctx context.Context, p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error) {
+	m.record("ListVirtualMachines", p)
+	if m.ListVirtualMachinesFunc == nil {
+		return nil, notMocked("MockVirtualMachineService", "ListVirtualMachines")
+	}
+	return m.ListVirtualMachinesFunc(ctx, p)
+}
+
+func (m *MockVirtualMachineService) NewListVirtualMachinesIterator(p *ListVirtualMachinesParams, pagesize int) *ListVirtualMachinesIterator {
+	i := &ListVirtualMachinesIterator{pager: newPager(nil, "listVirtualMachines", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListVirtualMachinesParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListVirtualMachinesWithContext(ctx, c)
+	}
+	return i
+}
@@ -17,17 +17,71 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
+// VolumeServiceIface is the interface implemented by VolumeService, and by MockVolumeService for tests
+type VolumeServiceIface interface {
+	NewGetUploadParamsForVolumeParams(format string, name string, zoneid string) *GetUploadParamsForVolumeParams
+	GetUploadParamsForVolume(p *GetUploadParamsForVolumeParams) (*GetUploadParamsForVolumeResponse, error)
+	GetUploadParamsForVolumeWithContext(ctx context.Context, p *GetUploadParamsForVolumeParams) (*GetUploadParamsForVolumeResponse, error)
+	NewAttachVolumeParams(id string, virtualmachineid string) *AttachVolumeParams
+	AttachVolume(p *AttachVolumeParams) (*AttachVolumeResponse, error)
+	AttachVolumeWithContext(ctx context.Context, p *AttachVolumeParams) (*AttachVolumeResponse, error)
+	AttachVolumeAsync(ctx context.Context, p *AttachVolumeParams) (*AttachVolumeJob, error)
+	NewCreateVolumeParams() *CreateVolumeParams
+	CreateVolume(p *CreateVolumeParams) (*CreateVolumeResponse, error)
+	CreateVolumeWithContext(ctx context.Context, p *CreateVolumeParams) (*CreateVolumeResponse, error)
+	CreateVolumeAsync(ctx context.Context, p *CreateVolumeParams) (*CreateVolumeJob, error)
+	NewDeleteVolumeParams(id string) *DeleteVolumeParams
+	DeleteVolume(p *DeleteVolumeParams) (*DeleteVolumeResponse, error)
+	DeleteVolumeWithContext(ctx context.Context, p *DeleteVolumeParams) (*DeleteVolumeResponse, error)
+	NewDetachVolumeParams() *DetachVolumeParams
+	DetachVolume(p *DetachVolumeParams) (*DetachVolumeResponse, error)
+	DetachVolumeWithContext(ctx context.Context, p *DetachVolumeParams) (*DetachVolumeResponse, error)
+	DetachVolumeAsync(ctx context.Context, p *DetachVolumeParams) (*DetachVolumeJob, error)
+	NewExtractVolumeParams(id string, mode string, zoneid string) *ExtractVolumeParams
+	ExtractVolume(p *ExtractVolumeParams) (*ExtractVolumeResponse, error)
+	ExtractVolumeWithContext(ctx context.Context, p *ExtractVolumeParams) (*ExtractVolumeResponse, error)
+	ExtractVolumeAsync(ctx context.Context, p *ExtractVolumeParams) (*ExtractVolumeJob, error)
+	NewMigrateVolumeParams(storageid string, volumeid string) *MigrateVolumeParams
+	MigrateVolume(p *MigrateVolumeParams) (*MigrateVolumeResponse, error)
+	MigrateVolumeWithContext(ctx context.Context, p *MigrateVolumeParams) (*MigrateVolumeResponse, error)
+	MigrateVolumeAsync(ctx context.Context, p *MigrateVolumeParams) (*MigrateVolumeJob, error)
+	NewResizeVolumeParams(id string) *ResizeVolumeParams
+	ResizeVolume(p *ResizeVolumeParams) (*ResizeVolumeResponse, error)
+	ResizeVolumeWithContext(ctx context.Context, p *ResizeVolumeParams) (*ResizeVolumeResponse, error)
+	ResizeVolumeAsync(ctx context.Context, p *ResizeVolumeParams) (*ResizeVolumeJob, error)
+	NewUpdateVolumeParams() *UpdateVolumeParams
+	UpdateVolume(p *UpdateVolumeParams) (*UpdateVolumeResponse, error)
+	UpdateVolumeWithContext(ctx context.Context, p *UpdateVolumeParams) (*UpdateVolumeResponse, error)
+	UpdateVolumeAsync(ctx context.Context, p *UpdateVolumeParams) (*UpdateVolumeJob, error)
+	NewUploadVolumeParams(format string, name string, url string, zoneid string) *UploadVolumeParams
+	UploadVolume(p *UploadVolumeParams) (*UploadVolumeResponse, error)
+	UploadVolumeWithContext(ctx context.Context, p *UploadVolumeParams) (*UploadVolumeResponse, error)
+	UploadVolumeAsync(ctx context.Context, p *UploadVolumeParams) (*UploadVolumeJob, error)
+	NewListVolumesParams() *ListVolumesParams
+	GetVolumeID(name string, opts ...OptionFunc) (string, int, error)
+	GetVolumeByName(name string, opts ...OptionFunc) (*Volume, int, error)
+	GetVolumeByID(id string, opts ...OptionFunc) (*Volume, int, error)
+	ListVolumes(p *ListVolumesParams) (*ListVolumesResponse, error)
+	ListVolumesWithContext(ctx context.Context, p *ListVolumesParams) (*ListVolumesResponse, error)
+	NewListVolumesIterator(p *ListVolumesParams, pagesize int) *ListVolumesIterator
+}
+
 type GetUploadParamsForVolumeParams struct {
 	p map[string]interface{}
 }
 
+func (p *GetUploadParamsForVolumeParams) command() string {
+	return "getUploadParamsForVolume"
+}
+
 func (p *GetUploadParamsForVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -139,7 +193,13 @@ func (s *VolumeService) NewGetUploadParamsForVolumeParams(format string, name st
 
 // Upload a data disk to the cloudstack cloud.
 func (s *VolumeService) GetUploadParamsForVolume(p *GetUploadParamsForVolumeParams) (*GetUploadParamsForVolumeResponse, error) {
-	resp, err := s.cs.newRequest("getUploadParamsForVolume", p.toURLValues())
+	return s.GetUploadParamsForVolumeWithContext(context.Background(), p)
+}
+
+// GetUploadParamsForVolumeWithContext is the same as GetUploadParamsForVolume, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VolumeService) GetUploadParamsForVolumeWithContext(ctx context.Context, p *GetUploadParamsForVolumeParams) (*GetUploadParamsForVolumeResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "getUploadParamsForVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -163,6 +223,10 @@ type AttachVolumeParams struct {
 	p map[string]interface{}
 }
 
+func (p *AttachVolumeParams) command() string {
+	return "attachVolume"
+}
+
 func (p *AttachVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -214,7 +278,13 @@ func (s *VolumeService) NewAttachVolumeParams(id string, virtualmachineid string
 
 // Attaches a disk volume to a virtual machine.
 func (s *VolumeService) AttachVolume(p *AttachVolumeParams) (*AttachVolumeResponse, error) {
-	resp, err := s.cs.newRequest("attachVolume", p.toURLValues())
+	return s.AttachVolumeWithContext(context.Background(), p)
+}
+
+// AttachVolumeWithContext is the same as AttachVolume, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VolumeService) AttachVolumeWithContext(ctx context.Context, p *AttachVolumeParams) (*AttachVolumeResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "attachVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -226,26 +296,56 @@ func (s *VolumeService) AttachVolume(p *AttachVolumeParams) (*AttachVolumeRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "attachVolume", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "volume", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// AttachVolumeJob is a handle to a running attachVolume async job
+type AttachVolumeJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a AttachVolumeResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *AttachVolumeJob) Result() (*AttachVolumeResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := AttachVolumeResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "volume", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// AttachVolumeAsync starts the attachVolume async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VolumeService) AttachVolumeAsync(ctx context.Context, p *AttachVolumeParams) (*AttachVolumeJob, error) {
+	resp, err := s.cs.newRequest(ctx, "attachVolume", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AttachVolumeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &AttachVolumeJob{s.cs.newJobHandle("attachVolume", r.JobID)}, nil
+}
+
 type AttachVolumeResponse struct {
 	JobID                      string `json:"jobid,omitempty"`
 	Account                    string `json:"account,omitempty"`
@@ -319,6 +419,10 @@ type CreateVolumeParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateVolumeParams) command() string {
+	return "createVolume"
+}
+
 func (p *CreateVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -491,7 +595,13 @@ func (s *VolumeService) NewCreateVolumeParams() *CreateVolumeParams {
 
 // Creates a disk volume from a disk offering. This disk volume must still be attached to a virtual machine to make use of it.
 func (s *VolumeService) CreateVolume(p *CreateVolumeParams) (*CreateVolumeResponse, error) {
-	resp, err := s.cs.newRequest("createVolume", p.toURLValues())
+	return s.CreateVolumeWithContext(context.Background(), p)
+}
+
+// CreateVolumeWithContext is the same as CreateVolume, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VolumeService) CreateVolumeWithContext(ctx context.Context, p *CreateVolumeParams) (*CreateVolumeResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -503,26 +613,56 @@ func (s *VolumeService) CreateVolume(p *CreateVolumeParams) (*CreateVolumeRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "createVolume", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "volume", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// CreateVolumeJob is a handle to a running createVolume async job
+type CreateVolumeJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a CreateVolumeResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *CreateVolumeJob) Result() (*CreateVolumeResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateVolumeResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "volume", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// CreateVolumeAsync starts the createVolume async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VolumeService) CreateVolumeAsync(ctx context.Context, p *CreateVolumeParams) (*CreateVolumeJob, error) {
+	resp, err := s.cs.newRequest(ctx, "createVolume", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateVolumeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &CreateVolumeJob{s.cs.newJobHandle("createVolume", r.JobID)}, nil
+}
+
 type CreateVolumeResponse struct {
 	JobID                      string `json:"jobid,omitempty"`
 	Account                    string `json:"account,omitempty"`
@@ -596,6 +736,10 @@ type DeleteVolumeParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteVolumeParams) command() string {
+	return "deleteVolume"
+}
+
 func (p *DeleteVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -625,7 +769,13 @@ func (s *VolumeService) NewDeleteVolumeParams(id string) *DeleteVolumeParams {
 
 // Deletes a detached disk volume.
 func (s *VolumeService) DeleteVolume(p *DeleteVolumeParams) (*DeleteVolumeResponse, error) {
-	resp, err := s.cs.newRequest("deleteVolume", p.toURLValues())
+	return s.DeleteVolumeWithContext(context.Background(), p)
+}
+
+// DeleteVolumeWithContext is the same as DeleteVolume, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VolumeService) DeleteVolumeWithContext(ctx context.Context, p *DeleteVolumeParams) (*DeleteVolumeResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -646,6 +796,10 @@ type DetachVolumeParams struct {
 	p map[string]interface{}
 }
 
+func (p *DetachVolumeParams) command() string {
+	return "detachVolume"
+}
+
 func (p *DetachVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -695,7 +849,13 @@ func (s *VolumeService) NewDetachVolumeParams() *DetachVolumeParams {
 
 // Detaches a disk volume from a virtual machine.
 func (s *VolumeService) DetachVolume(p *DetachVolumeParams) (*DetachVolumeResponse, error) {
-	resp, err := s.cs.newRequest("detachVolume", p.toURLValues())
+	return s.DetachVolumeWithContext(context.Background(), p)
+}
+
+// DetachVolumeWithContext is the same as DetachVolume, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VolumeService) DetachVolumeWithContext(ctx context.Context, p *DetachVolumeParams) (*DetachVolumeResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "detachVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -707,26 +867,56 @@ func (s *VolumeService) DetachVolume(p *DetachVolumeParams) (*DetachVolumeRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "detachVolume", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "volume", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// DetachVolumeJob is a handle to a running detachVolume async job
+type DetachVolumeJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DetachVolumeResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DetachVolumeJob) Result() (*DetachVolumeResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DetachVolumeResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "volume", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// DetachVolumeAsync starts the detachVolume async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VolumeService) DetachVolumeAsync(ctx context.Context, p *DetachVolumeParams) (*DetachVolumeJob, error) {
+	resp, err := s.cs.newRequest(ctx, "detachVolume", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DetachVolumeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DetachVolumeJob{s.cs.newJobHandle("detachVolume", r.JobID)}, nil
+}
+
 type DetachVolumeResponse struct {
 	JobID                      string `json:"jobid,omitempty"`
 	Account                    string `json:"account,omitempty"`
@@ -800,6 +990,10 @@ type ExtractVolumeParams struct {
 	p map[string]interface{}
 }
 
+func (p *ExtractVolumeParams) command() string {
+	return "extractVolume"
+}
+
 func (p *ExtractVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -861,7 +1055,13 @@ func (s *VolumeService) NewExtractVolumeParams(id string, mode string, zoneid st
 
 // Extracts volume
 func (s *VolumeService) ExtractVolume(p *ExtractVolumeParams) (*ExtractVolumeResponse, error) {
-	resp, err := s.cs.newRequest("extractVolume", p.toURLValues())
+	return s.ExtractVolumeWithContext(context.Background(), p)
+}
+
+// ExtractVolumeWithContext is the same as ExtractVolume, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VolumeService) ExtractVolumeWithContext(ctx context.Context, p *ExtractVolumeParams) (*ExtractVolumeResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "extractVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -873,26 +1073,56 @@ func (s *VolumeService) ExtractVolume(p *ExtractVolumeParams) (*ExtractVolumeRes
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "extractVolume", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "volume", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// ExtractVolumeJob is a handle to a running extractVolume async job
+type ExtractVolumeJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a ExtractVolumeResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *ExtractVolumeJob) Result() (*ExtractVolumeResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := ExtractVolumeResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "volume", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// ExtractVolumeAsync starts the extractVolume async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VolumeService) ExtractVolumeAsync(ctx context.Context, p *ExtractVolumeParams) (*ExtractVolumeJob, error) {
+	resp, err := s.cs.newRequest(ctx, "extractVolume", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ExtractVolumeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &ExtractVolumeJob{s.cs.newJobHandle("extractVolume", r.JobID)}, nil
+}
+
 type ExtractVolumeResponse struct {
 	JobID            string `json:"jobid,omitempty"`
 	Accountid        string `json:"accountid,omitempty"`
@@ -915,6 +1145,10 @@ type MigrateVolumeParams struct {
 	p map[string]interface{}
 }
 
+func (p *MigrateVolumeParams) command() string {
+	return "migrateVolume"
+}
+
 func (p *MigrateVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -966,7 +1200,13 @@ func (s *VolumeService) NewMigrateVolumeParams(storageid string, volumeid string
 
 // Migrate volume
 func (s *VolumeService) MigrateVolume(p *MigrateVolumeParams) (*MigrateVolumeResponse, error) {
-	resp, err := s.cs.newRequest("migrateVolume", p.toURLValues())
+	return s.MigrateVolumeWithContext(context.Background(), p)
+}
+
+// MigrateVolumeWithContext is the same as MigrateVolume, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VolumeService) MigrateVolumeWithContext(ctx context.Context, p *MigrateVolumeParams) (*MigrateVolumeResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "migrateVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -978,26 +1218,56 @@ func (s *VolumeService) MigrateVolume(p *MigrateVolumeParams) (*MigrateVolumeRes
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "migrateVolume", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "volume", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// MigrateVolumeJob is a handle to a running migrateVolume async job
+type MigrateVolumeJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a MigrateVolumeResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *MigrateVolumeJob) Result() (*MigrateVolumeResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := MigrateVolumeResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "volume", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// MigrateVolumeAsync starts the migrateVolume async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VolumeService) MigrateVolumeAsync(ctx context.Context, p *MigrateVolumeParams) (*MigrateVolumeJob, error) {
+	resp, err := s.cs.newRequest(ctx, "migrateVolume", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r MigrateVolumeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &MigrateVolumeJob{s.cs.newJobHandle("migrateVolume", r.JobID)}, nil
+}
+
 type MigrateVolumeResponse struct {
 	JobID                      string `json:"jobid,omitempty"`
 	Account                    string `json:"account,omitempty"`
@@ -1071,6 +1341,10 @@ type ResizeVolumeParams struct {
 	p map[string]interface{}
 }
 
+func (p *ResizeVolumeParams) command() string {
+	return "resizeVolume"
+}
+
 func (p *ResizeVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1154,7 +1428,13 @@ func (s *VolumeService) NewResizeVolumeParams(id string) *ResizeVolumeParams {
 
 // Resizes a volume
 func (s *VolumeService) ResizeVolume(p *ResizeVolumeParams) (*ResizeVolumeResponse, error) {
-	resp, err := s.cs.newRequest("resizeVolume", p.toURLValues())
+	return s.ResizeVolumeWithContext(context.Background(), p)
+}
+
+// ResizeVolumeWithContext is the same as ResizeVolume, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VolumeService) ResizeVolumeWithContext(ctx context.Context, p *ResizeVolumeParams) (*ResizeVolumeResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "resizeVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1166,26 +1446,56 @@ func (s *VolumeService) ResizeVolume(p *ResizeVolumeParams) (*ResizeVolumeRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "resizeVolume", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "volume", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// ResizeVolumeJob is a handle to a running resizeVolume async job
+type ResizeVolumeJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a ResizeVolumeResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *ResizeVolumeJob) Result() (*ResizeVolumeResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := ResizeVolumeResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "volume", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// ResizeVolumeAsync starts the resizeVolume async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VolumeService) ResizeVolumeAsync(ctx context.Context, p *ResizeVolumeParams) (*ResizeVolumeJob, error) {
+	resp, err := s.cs.newRequest(ctx, "resizeVolume", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ResizeVolumeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &ResizeVolumeJob{s.cs.newJobHandle("resizeVolume", r.JobID)}, nil
+}
+
 type ResizeVolumeResponse struct {
 	JobID                      string `json:"jobid,omitempty"`
 	Account                    string `json:"account,omitempty"`
@@ -1259,6 +1569,10 @@ type UpdateVolumeParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateVolumeParams) command() string {
+	return "updateVolume"
+}
+
 func (p *UpdateVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1358,7 +1672,13 @@ func (s *VolumeService) NewUpdateVolumeParams() *UpdateVolumeParams {
 
 // Updates the volume.
 func (s *VolumeService) UpdateVolume(p *UpdateVolumeParams) (*UpdateVolumeResponse, error) {
-	resp, err := s.cs.newRequest("updateVolume", p.toURLValues())
+	return s.UpdateVolumeWithContext(context.Background(), p)
+}
+
+// UpdateVolumeWithContext is the same as UpdateVolume, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VolumeService) UpdateVolumeWithContext(ctx context.Context, p *UpdateVolumeParams) (*UpdateVolumeResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1370,26 +1690,56 @@ func (s *VolumeService) UpdateVolume(p *UpdateVolumeParams) (*UpdateVolumeRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "updateVolume", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "volume", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// UpdateVolumeJob is a handle to a running updateVolume async job
+type UpdateVolumeJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UpdateVolumeResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UpdateVolumeJob) Result() (*UpdateVolumeResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UpdateVolumeResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "volume", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// UpdateVolumeAsync starts the updateVolume async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VolumeService) UpdateVolumeAsync(ctx context.Context, p *UpdateVolumeParams) (*UpdateVolumeJob, error) {
+	resp, err := s.cs.newRequest(ctx, "updateVolume", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateVolumeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UpdateVolumeJob{s.cs.newJobHandle("updateVolume", r.JobID)}, nil
+}
+
 type UpdateVolumeResponse struct {
 	JobID                      string `json:"jobid,omitempty"`
 	Account                    string `json:"account,omitempty"`
@@ -1463,6 +1813,10 @@ type UploadVolumeParams struct {
 	p map[string]interface{}
 }
 
+func (p *UploadVolumeParams) command() string {
+	return "uploadVolume"
+}
+
 func (p *UploadVolumeParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1585,7 +1939,13 @@ func (s *VolumeService) NewUploadVolumeParams(format string, name string, url st
 
 // Uploads a data disk.
 func (s *VolumeService) UploadVolume(p *UploadVolumeParams) (*UploadVolumeResponse, error) {
-	resp, err := s.cs.newRequest("uploadVolume", p.toURLValues())
+	return s.UploadVolumeWithContext(context.Background(), p)
+}
+
+// UploadVolumeWithContext is the same as UploadVolume, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VolumeService) UploadVolumeWithContext(ctx context.Context, p *UploadVolumeParams) (*UploadVolumeResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "uploadVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1597,26 +1957,56 @@ func (s *VolumeService) UploadVolume(p *UploadVolumeParams) (*UploadVolumeRespon
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "uploadVolume", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "volume", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// UploadVolumeJob is a handle to a running uploadVolume async job
+type UploadVolumeJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a UploadVolumeResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *UploadVolumeJob) Result() (*UploadVolumeResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := UploadVolumeResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "volume", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// UploadVolumeAsync starts the uploadVolume async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *VolumeService) UploadVolumeAsync(ctx context.Context, p *UploadVolumeParams) (*UploadVolumeJob, error) {
+	resp, err := s.cs.newRequest(ctx, "uploadVolume", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UploadVolumeResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &UploadVolumeJob{s.cs.newJobHandle("uploadVolume", r.JobID)}, nil
+}
+
 type UploadVolumeResponse struct {
 	JobID                      string `json:"jobid,omitempty"`
 	Account                    string `json:"account,omitempty"`
@@ -1690,6 +2080,10 @@ type ListVolumesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListVolumesParams) command() string {
+	return "listVolumes"
+}
+
 func (p *ListVolumesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -1925,7 +2319,7 @@ func (s *VolumeService) GetVolumeID(name string, opts ...OptionFunc) (string, in
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -1939,7 +2333,7 @@ func (s *VolumeService) GetVolumeID(name string, opts ...OptionFunc) (string, in
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -1971,16 +2365,14 @@ func (s *VolumeService) GetVolumeByID(id string, opts ...OptionFunc) (*Volume, i
 
 	l, err := s.ListVolumes(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -1992,10 +2384,17 @@ func (s *VolumeService) GetVolumeByID(id string, opts ...OptionFunc) (*Volume, i
 
 // Lists all volumes.
 func (s *VolumeService) ListVolumes(p *ListVolumesParams) (*ListVolumesResponse, error) {
+	return s.ListVolumesWithContext(context.Background(), p)
+}
+
+// ListVolumesWithContext is the same as ListVolumes, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *VolumeService) ListVolumesWithContext(ctx context.Context, p *ListVolumesParams) (*ListVolumesResponse, error) {
 	var r ListVolumesResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListVolumesResponse
-		resp, err := s.cs.newRequest("listVolumes", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listVolumes", values)
 		if err != nil {
 			return nil, err
 		}
@@ -2007,13 +2406,89 @@ func (s *VolumeService) ListVolumes(p *ListVolumesParams) (*ListVolumesResponse,
 		r.Count = l.Count
 		r.Volumes = append(r.Volumes, l.Volumes...)
 
-		if r.Count == len(r.Volumes) {
+		if r.Count == len(r.Volumes) || len(l.Volumes) == 0 {
+			s.cs.observePages("listVolumes", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.Volumes))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.Volumes)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listVolumes", values, len(l.Volumes), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListVolumesResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.Volumes = append(r.Volumes, l.Volumes...)
+			}
+
+			if r.Count == len(r.Volumes) {
+				s.cs.observePages("listVolumes", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListVolumesIterator iterates over the items returned by listVolumes, requesting
+// the pages of the response one at a time while iterating.
+type ListVolumesIterator struct {
+	pager
+	items []*Volume
+	index int
+}
+
+// NewListVolumesIterator returns an iterator over the items returned by listVolumes, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *VolumeService) NewListVolumesIterator(p *ListVolumesParams, pagesize int) *ListVolumesIterator {
+	return &ListVolumesIterator{pager: newPager(s.cs, "listVolumes", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListVolumesIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListVolumesResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.Volumes))
+
+	i.items = l.Volumes
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListVolumesIterator) Value() *Volume {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListVolumesResponse struct {
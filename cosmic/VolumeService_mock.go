@@ -0,0 +1,329 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockVolumeService is a mock of VolumeServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockVolumeService struct {
+	mockCalls
+
+	GetUploadParamsForVolumeFunc func(ctx context.Context, p *GetUploadParamsForVolumeParams) (*GetUploadParamsForVolumeResponse, error)
+	AttachVolumeFunc             func(ctx context.Context, p *AttachVolumeParams) (*AttachVolumeResponse, error)
+	AttachVolumeAsyncFunc        func(ctx context.Context, p *AttachVolumeParams) (*AttachVolumeJob, error)
+	CreateVolumeFunc             func(ctx context.Context, p *CreateVolumeParams) (*CreateVolumeResponse, error)
+	CreateVolumeAsyncFunc        func(ctx context.Context, p *CreateVolumeParams) (*CreateVolumeJob, error)
+	DeleteVolumeFunc             func(ctx context.Context, p *DeleteVolumeParams) (*DeleteVolumeResponse, error)
+	DetachVolumeFunc             func(ctx context.Context, p *DetachVolumeParams) (*DetachVolumeResponse, error)
+	DetachVolumeAsyncFunc        func(ctx context.Context, p *DetachVolumeParams) (*DetachVolumeJob, error)
+	ExtractVolumeFunc            func(ctx context.Context, p *ExtractVolumeParams) (*ExtractVolumeResponse, error)
+	ExtractVolumeAsyncFunc       func(ctx context.Context, p *ExtractVolumeParams) (*ExtractVolumeJob, error)
+	MigrateVolumeFunc            func(ctx context.Context, p *MigrateVolumeParams) (*MigrateVolumeResponse, error)
+	MigrateVolumeAsyncFunc       func(ctx context.Context, p *MigrateVolumeParams) (*MigrateVolumeJob, error)
+	ResizeVolumeFunc             func(ctx context.Context, p *ResizeVolumeParams) (*ResizeVolumeResponse, error)
+	ResizeVolumeAsyncFunc        func(ctx context.Context, p *ResizeVolumeParams) (*ResizeVolumeJob, error)
+	UpdateVolumeFunc             func(ctx context.Context, p *UpdateVolumeParams) (*UpdateVolumeResponse, error)
+	UpdateVolumeAsyncFunc        func(ctx context.Context, p *UpdateVolumeParams) (*UpdateVolumeJob, error)
+	UploadVolumeFunc             func(ctx context.Context, p *UploadVolumeParams) (*UploadVolumeResponse, error)
+	UploadVolumeAsyncFunc        func(ctx context.Context, p *UploadVolumeParams) (*UploadVolumeJob, error)
+	GetVolumeIDFunc              func(name string, opts ...OptionFunc) (string, int, error)
+	GetVolumeByNameFunc          func(name string, opts ...OptionFunc) (*Volume, int, error)
+	GetVolumeByIDFunc            func(id string, opts ...OptionFunc) (*Volume, int, error)
+	ListVolumesFunc              func(ctx context.Context, p *ListVolumesParams) (*ListVolumesResponse, error)
+}
+
+var _ VolumeServiceIface = &MockVolumeService{}
+
+func (m *MockVolumeService) NewGetUploadParamsForVolumeParams(format string, name string, zoneid string) *GetUploadParamsForVolumeParams {
+	return (&VolumeService{}).NewGetUploadParamsForVolumeParams(format, name, zoneid)
+}
+
+func (m *MockVolumeService) GetUploadParamsForVolume(p *GetUploadParamsForVolumeParams) (*GetUploadParamsForVolumeResponse, error) {
+	return m.GetUploadParamsForVolumeWithContext(context.Background(), p)
+}
+
+func (m *MockVolumeService) GetUploadParamsForVolumeWithContext(ctx context.Context, p *GetUploadParamsForVolumeParams) (*GetUploadParamsForVolumeResponse, error) {
+	m.record("GetUploadParamsForVolume", p)
+	if m.GetUploadParamsForVolumeFunc == nil {
+		return nil, notMocked("MockVolumeService", "GetUploadParamsForVolume")
+	}
+	return m.GetUploadParamsForVolumeFunc(ctx, p)
+}
+
+func (m *MockVolumeService) NewAttachVolumeParams(id string, virtualmachineid string) *AttachVolumeParams {
+	return (&VolumeService{}).NewAttachVolumeParams(id, virtualmachineid)
+}
+
+func (m *MockVolumeService) AttachVolume(p *AttachVolumeParams) (*AttachVolumeResponse, error) {
+	return m.AttachVolumeWithContext(context.Background(), p)
+}
+
+func (m *MockVolumeService) AttachVolumeWithContext(ctx context.Context, p *AttachVolumeParams) (*AttachVolumeResponse, error) {
+	m.record("AttachVolume", p)
+	if m.AttachVolumeFunc == nil {
+		return nil, notMocked("MockVolumeService", "AttachVolume")
+	}
+	return m.AttachVolumeFunc(ctx, p)
+}
+
+func (m *MockVolumeService) AttachVolumeAsync(ctx context.Context, p *AttachVolumeParams) (*AttachVolumeJob, error) {
+	m.record("AttachVolumeAsync", p)
+	if m.AttachVolumeAsyncFunc == nil {
+		return nil, notMocked("MockVolumeService", "AttachVolumeAsync")
+	}
+	return m.AttachVolumeAsyncFunc(ctx, p)
+}
+
+func (m *MockVolumeService) NewCreateVolumeParams() *CreateVolumeParams {
+	return (&VolumeService{}).NewCreateVolumeParams()
+}
+
+func (m *MockVolumeService) CreateVolume(p *CreateVolumeParams) (*CreateVolumeResponse, error) {
+	return m.CreateVolumeWithContext(context.Background(), p)
+}
+
+func (m *MockVolumeService) CreateVolumeWithContext(ctx context.Context, p *CreateVolumeParams) (*CreateVolumeResponse, error) {
+	m.record("CreateVolume", p)
+	if m.CreateVolumeFunc == nil {
+		return nil, notMocked("MockVolumeService", "CreateVolume")
+	}
+	return m.CreateVolumeFunc(ctx, p)
+}
+
+func (m *MockVolumeService) CreateVolumeAsync(ctx context.Context, p *CreateVolumeParams) (*CreateVolumeJob, error) {
+	m.record("CreateVolumeAsync", p)
+	if m.CreateVolumeAsyncFunc == nil {
+		return nil, notMocked("MockVolumeService", "CreateVolumeAsync")
+	}
+	return m.CreateVolumeAsyncFunc(ctx, p)
+}
+
+func (m *MockVolumeService) NewDeleteVolumeParams(id string) *DeleteVolumeParams {
+	return (&VolumeService{}).NewDeleteVolumeParams(id)
+}
+
+func (m *MockVolumeService) DeleteVolume(p *DeleteVolumeParams) (*DeleteVolumeResponse, error) {
+	return m.DeleteVolumeWithContext(context.Background(), p)
+}
+
+func (m *MockVolumeService) DeleteVolumeWithContext(ctx context.Context, p *DeleteVolumeParams) (*DeleteVolumeResponse, error) {
+	m.record("DeleteVolume", p)
+	if m.DeleteVolumeFunc == nil {
+		return nil, notMocked("MockVolumeService", "DeleteVolume")
+	}
+	return m.DeleteVolumeFunc(ctx, p)
+}
+
+func (m *MockVolumeService) NewDetachVolumeParams() *DetachVolumeParams {
+	return (&VolumeService{}).NewDetachVolumeParams()
+}
+
+func (m *MockVolumeService) DetachVolume(p *DetachVolumeParams) (*DetachVolumeResponse, error) {
+	return m.DetachVolumeWithContext(context.Background(), p)
+}
+
+func (m *MockVolumeService) DetachVolumeWithContext(ctx context.Context, p *DetachVolumeParams) (*DetachVolumeResponse, error) {
+	m.record("DetachVolume", p)
+	if m.DetachVolumeFunc == nil {
+		return nil, notMocked("MockVolumeService", "DetachVolume")
+	}
+	return m.DetachVolumeFunc(ctx, p)
+}
+
+func (m *MockVolumeService) DetachVolumeAsync(ctx context.Context, p *DetachVolumeParams) (*DetachVolumeJob, error) {
+	m.record("DetachVolumeAsync", p)
+	if m.DetachVolumeAsyncFunc == nil {
+		return nil, notMocked("MockVolumeService", "DetachVolumeAsync")
+	}
+	return m.DetachVolumeAsyncFunc(ctx, p)
+}
+
+func (m *MockVolumeService) NewExtractVolumeParams(id string, mode string, zoneid string) *ExtractVolumeParams {
+	return (&VolumeService{}).NewExtractVolumeParams(id, mode, zoneid)
+}
+
+func (m *MockVolumeService) ExtractVolume(p *ExtractVolumeParams) (*ExtractVolumeResponse, error) {
+	return m.ExtractVolumeWithContext(context.Background(), p)
+}
+
+func (m *MockVolumeService) ExtractVolumeWithContext(ctx context.Context, p *ExtractVolumeParams) (*ExtractVolumeResponse, error) {
+	m.record("ExtractVolume", p)
+	if m.ExtractVolumeFunc == nil {
+		return nil, notMocked("MockVolumeService", "ExtractVolume")
+	}
+	return m.ExtractVolumeFunc(ctx, p)
+}
+
+func (m *MockVolumeService) ExtractVolumeAsync(ctx context.Context, p *ExtractVolumeParams) (*ExtractVolumeJob, error) {
+	m.record("ExtractVolumeAsync", p)
+	if m.ExtractVolumeAsyncFunc == nil {
+		return nil, notMocked("MockVolumeService", "ExtractVolumeAsync")
+	}
+	return m.ExtractVolumeAsyncFunc(ctx, p)
+}
+
+func (m *MockVolumeService) NewMigrateVolumeParams(storageid string, volumeid string) *MigrateVolumeParams {
+	return (&VolumeService{}).NewMigrateVolumeParams(storageid, volumeid)
+}
+
+func (m *MockVolumeService) MigrateVolume(p *MigrateVolumeParams) (*MigrateVolumeResponse, error) {
+	return m.MigrateVolumeWithContext(context.Background(), p)
+}
+
+func (m *MockVolumeService) MigrateVolumeWithContext(ctx context.Context, p *MigrateVolumeParams) (*MigrateVolumeResponse, error) {
+	m.record("MigrateVolume", p)
+	if m.MigrateVolumeFunc == nil {
+		return nil, notMocked("MockVolumeService", "MigrateVolume")
+	}
+	return m.MigrateVolumeFunc(ctx, p)
+}
+
+func (m *MockVolumeService) MigrateVolumeAsync(ctx context.Context, p *MigrateVolumeParams) (*MigrateVolumeJob, error) {
+	m.record("MigrateVolumeAsync", p)
+	if m.MigrateVolumeAsyncFunc == nil {
+		return nil, notMocked("MockVolumeService", "MigrateVolumeAsync")
+	}
+	return m.MigrateVolumeAsyncFunc(ctx, p)
+}
+
+func (m *MockVolumeService) NewResizeVolumeParams(id string) *ResizeVolumeParams {
+	return (&VolumeService{}).NewResizeVolumeParams(id)
+}
+
+func (m *MockVolumeService) ResizeVolume(p *ResizeVolumeParams) (*ResizeVolumeResponse, error) {
+	return m.ResizeVolumeWithContext(context.Background(), p)
+}
+
+func (m *MockVolumeService) ResizeVolumeWithContext(ctx context.Context, p *ResizeVolumeParams) (*ResizeVolumeResponse, error) {
+	m.record("ResizeVolume", p)
+	if m.ResizeVolumeFunc == nil {
+		return nil, notMocked("MockVolumeService", "ResizeVolume")
+	}
+	return m.ResizeVolumeFunc(ctx, p)
+}
+
+func (m *MockVolumeService) ResizeVolumeAsync(ctx context.Context, p *ResizeVolumeParams) (*ResizeVolumeJob, error) {
+	m.record("ResizeVolumeAsync", p)
+	if m.ResizeVolumeAsyncFunc == nil {
+		return nil, notMocked("MockVolumeService", "ResizeVolumeAsync")
+	}
+	return m.ResizeVolumeAsyncFunc(ctx, p)
+}
+
+func (m *MockVolumeService) NewUpdateVolumeParams() *UpdateVolumeParams {
+	return (&VolumeService{}).NewUpdateVolumeParams()
+}
+
+func (m *MockVolumeService) UpdateVolume(p *UpdateVolumeParams) (*UpdateVolumeResponse, error) {
+	return m.UpdateVolumeWithContext(context.Background(), p)
+}
+
+func (m *MockVolumeService) UpdateVolumeWithContext(ctx context.Context, p *UpdateVolumeParams) (*UpdateVolumeResponse, error) {
+	m.record("UpdateVolume", p)
+	if m.UpdateVolumeFunc == nil {
+		return nil, notMocked("MockVolumeService", "UpdateVolume")
+	}
+	return m.UpdateVolumeFunc(ctx, p)
+}
+
+func (m *MockVolumeService) UpdateVolumeAsync(ctx context.Context, p *UpdateVolumeParams) (*UpdateVolumeJob, error) {
+	m.record("UpdateVolumeAsync", p)
+	if m.UpdateVolumeAsyncFunc == nil {
+		return nil, notMocked("MockVolumeService", "UpdateVolumeAsync")
+	}
+	return m.UpdateVolumeAsyncFunc(ctx, p)
+}
+
+func (m *MockVolumeService) NewUploadVolumeParams(format string, name string, url string, zoneid string) *UploadVolumeParams {
+	return (&VolumeService{}).NewUploadVolumeParams(format, name, url, zoneid)
+}
+
+func (m *MockVolumeService) UploadVolume(p *UploadVolumeParams) (*UploadVolumeResponse, error) {
+	return m.UploadVolumeWithContext(context.Background(), p)
+}
+
+func (m *MockVolumeService) UploadVolumeWithContext(ctx context.Context, p *UploadVolumeParams) (*UploadVolumeResponse, error) {
+	m.record("UploadVolume", p)
+	if m.UploadVolumeFunc == nil {
+		return nil, notMocked("MockVolumeService", "UploadVolume")
+	}
+	return m.UploadVolumeFunc(ctx, p)
+}
+
+func (m *MockVolumeService) UploadVolumeAsync(ctx context.Context, p *UploadVolumeParams) (*UploadVolumeJob, error) {
+	m.record("UploadVolumeAsync", p)
+	if m.UploadVolumeAsyncFunc == nil {
+		return nil, notMocked("MockVolumeService", "UploadVolumeAsync")
+	}
+	return m.UploadVolumeAsyncFunc(ctx, p)
+}
+
+func (m *MockVolumeService) NewListVolumesParams() *ListVolumesParams {
+	return (&VolumeService{}).NewListVolumesParams()
+}
+
+func (m *MockVolumeService) GetVolumeID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetVolumeID", name, opts)
+	if m.GetVolumeIDFunc == nil {
+		return "", -1, notMocked("MockVolumeService", "GetVolumeID")
+	}
+	return m.GetVolumeIDFunc(name, opts...)
+}
+
+func (m *MockVolumeService) GetVolumeByName(name string, opts ...OptionFunc) (*Volume, int, error) {
+	m.record("GetVolumeByName", name, opts)
+	if m.GetVolumeByNameFunc == nil {
+		return nil, -1, notMocked("MockVolumeService", "GetVolumeByName")
+	}
+	return m.GetVolumeByNameFunc(name, opts...)
+}
+
+func (m *MockVolumeService) GetVolumeByID(id string, opts ...OptionFunc) (*Volume, int, error) {
+	m.record("GetVolumeByID", id, opts)
+	if m.GetVolumeByIDFunc == nil {
+		return nil, -1, notMocked("MockVolumeService", "GetVolumeByID")
+	}
+	return m.GetVolumeByIDFunc(id, opts...)
+}
+
+func (m *MockVolumeService) ListVolumes(p *ListVolumesParams) (*ListVolumesResponse, error) {
+	return m.ListVolumesWithContext(context.Background(), p)
+}
+
+func (m *MockVolumeService) ListVolumesWithContext(ctx context.Context, p *ListVolumesParams) (*ListVolumesResponse, error) {
+	m.record("ListVolumes", p)
+	if m.ListVolumesFunc == nil {
+		return nil, notMocked("MockVolumeService", "ListVolumes")
+	}
+	return m.ListVolumesFunc(ctx, p)
+}
+
+func (m *MockVolumeService) NewListVolumesIterator(p *ListVolumesParams, pagesize int) *ListVolumesIterator {
+	i := &ListVolumesIterator{pager: newPager(nil, "listVolumes", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListVolumesParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListVolumesWithContext(ctx, c)
+	}
+	return i
+}
@@ -0,0 +1,306 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestGetUploadParamsForVolumeParams(t *testing.T) {
+	p := &GetUploadParamsForVolumeParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetChecksum("checksum-value")
+	want.Set("checksum", "checksum-value")
+	p.SetDiskofferingid("diskofferingid-value")
+	want.Set("diskofferingid", "diskofferingid-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetFormat("format-value")
+	want.Set("format", "format-value")
+	p.SetImagestoreuuid("imagestoreuuid-value")
+	want.Set("imagestoreuuid", "imagestoreuuid-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "getUploadParamsForVolume", want)
+}
+
+func TestGetUploadParamsForVolumeResponse(t *testing.T) {
+	assertDecodes(t, `{"expires":"expires-value","id":"id-value","metadata":"metadata-value","postURL":"postURL-value","signature":"signature-value"}`, &GetUploadParamsForVolumeResponse{})
+}
+
+func TestAttachVolumeParams(t *testing.T) {
+	p := &AttachVolumeParams{}
+	want := url.Values{}
+	p.SetDeviceid(int64(1) << 40)
+	want.Set("deviceid", "1099511627776")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetVirtualmachineid("virtualmachineid-value")
+	want.Set("virtualmachineid", "virtualmachineid-value")
+
+	assertParams(t, p, "attachVolume", want)
+}
+
+func TestAttachVolumeResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","attached":"attached-value","chaininfo":"chaininfo-value","created":"created-value","destroyed":true,"deviceid":1099511627776,"diskBytesReadRate":1099511627776,"diskBytesWriteRate":1099511627776,"diskIopsRatePerGb":true,"diskIopsReadRate":1099511627776,"diskIopsTotalRate":1099511627776,"diskIopsWriteRate":1099511627776,"diskcontroller":"diskcontroller-value","diskofferingdisplaytext":"diskofferingdisplaytext-value","diskofferingid":"diskofferingid-value","diskofferingname":"diskofferingname-value","displayvolume":true,"domain":"domain-value","domainid":"domainid-value","format":"format-value","hypervisor":"hypervisor-value","id":"id-value","isextractable":true,"isodisplaytext":"isodisplaytext-value","isoid":"isoid-value","isoname":"isoname-value","jobid":"jobid-value","maxiops":1099511627776,"miniops":1099511627776,"name":"name-value","path":"path-value","project":"project-value","projectid":"projectid-value","provisioningtype":"provisioningtype-value","serviceofferingdisplaytext":"serviceofferingdisplaytext-value","serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","size":1099511627776,"snapshotid":"snapshotid-value","state":"state-value","status":"status-value","storage":"storage-value","storageid":"storageid-value","storagetype":"storagetype-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templatedisplaytext":"templatedisplaytext-value","templateid":"templateid-value","templatename":"templatename-value","type":"type-value","virtualmachineid":"virtualmachineid-value","vmdisplayname":"vmdisplayname-value","vmname":"vmname-value","vmstate":"vmstate-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &AttachVolumeResponse{})
+}
+
+func TestCreateVolumeParams(t *testing.T) {
+	p := &CreateVolumeParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetCustomid("customid-value")
+	want.Set("customid", "customid-value")
+	p.SetDiskcontroller("diskcontroller-value")
+	want.Set("diskcontroller", "diskcontroller-value")
+	p.SetDiskofferingid("diskofferingid-value")
+	want.Set("diskofferingid", "diskofferingid-value")
+	p.SetDisplayvolume(true)
+	want.Set("displayvolume", "true")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetFormat("format-value")
+	want.Set("format", "format-value")
+	p.SetMaxiops(int64(1) << 40)
+	want.Set("maxiops", "1099511627776")
+	p.SetMiniops(int64(1) << 40)
+	want.Set("miniops", "1099511627776")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetSize(int64(1) << 40)
+	want.Set("size", "1099511627776")
+	p.SetSnapshotid("snapshotid-value")
+	want.Set("snapshotid", "snapshotid-value")
+	p.SetVirtualmachineid("virtualmachineid-value")
+	want.Set("virtualmachineid", "virtualmachineid-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "createVolume", want)
+}
+
+func TestCreateVolumeResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","attached":"attached-value","chaininfo":"chaininfo-value","created":"created-value","destroyed":true,"deviceid":1099511627776,"diskBytesReadRate":1099511627776,"diskBytesWriteRate":1099511627776,"diskIopsRatePerGb":true,"diskIopsReadRate":1099511627776,"diskIopsTotalRate":1099511627776,"diskIopsWriteRate":1099511627776,"diskcontroller":"diskcontroller-value","diskofferingdisplaytext":"diskofferingdisplaytext-value","diskofferingid":"diskofferingid-value","diskofferingname":"diskofferingname-value","displayvolume":true,"domain":"domain-value","domainid":"domainid-value","format":"format-value","hypervisor":"hypervisor-value","id":"id-value","isextractable":true,"isodisplaytext":"isodisplaytext-value","isoid":"isoid-value","isoname":"isoname-value","jobid":"jobid-value","maxiops":1099511627776,"miniops":1099511627776,"name":"name-value","path":"path-value","project":"project-value","projectid":"projectid-value","provisioningtype":"provisioningtype-value","serviceofferingdisplaytext":"serviceofferingdisplaytext-value","serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","size":1099511627776,"snapshotid":"snapshotid-value","state":"state-value","status":"status-value","storage":"storage-value","storageid":"storageid-value","storagetype":"storagetype-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templatedisplaytext":"templatedisplaytext-value","templateid":"templateid-value","templatename":"templatename-value","type":"type-value","virtualmachineid":"virtualmachineid-value","vmdisplayname":"vmdisplayname-value","vmname":"vmname-value","vmstate":"vmstate-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &CreateVolumeResponse{})
+}
+
+func TestDeleteVolumeParams(t *testing.T) {
+	p := &DeleteVolumeParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteVolume", want)
+}
+
+func TestDeleteVolumeResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &DeleteVolumeResponse{})
+}
+
+func TestDetachVolumeParams(t *testing.T) {
+	p := &DetachVolumeParams{}
+	want := url.Values{}
+	p.SetDeviceid(int64(1) << 40)
+	want.Set("deviceid", "1099511627776")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetVirtualmachineid("virtualmachineid-value")
+	want.Set("virtualmachineid", "virtualmachineid-value")
+
+	assertParams(t, p, "detachVolume", want)
+}
+
+func TestDetachVolumeResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","attached":"attached-value","chaininfo":"chaininfo-value","created":"created-value","destroyed":true,"deviceid":1099511627776,"diskBytesReadRate":1099511627776,"diskBytesWriteRate":1099511627776,"diskIopsRatePerGb":true,"diskIopsReadRate":1099511627776,"diskIopsTotalRate":1099511627776,"diskIopsWriteRate":1099511627776,"diskcontroller":"diskcontroller-value","diskofferingdisplaytext":"diskofferingdisplaytext-value","diskofferingid":"diskofferingid-value","diskofferingname":"diskofferingname-value","displayvolume":true,"domain":"domain-value","domainid":"domainid-value","format":"format-value","hypervisor":"hypervisor-value","id":"id-value","isextractable":true,"isodisplaytext":"isodisplaytext-value","isoid":"isoid-value","isoname":"isoname-value","jobid":"jobid-value","maxiops":1099511627776,"miniops":1099511627776,"name":"name-value","path":"path-value","project":"project-value","projectid":"projectid-value","provisioningtype":"provisioningtype-value","serviceofferingdisplaytext":"serviceofferingdisplaytext-value","serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","size":1099511627776,"snapshotid":"snapshotid-value","state":"state-value","status":"status-value","storage":"storage-value","storageid":"storageid-value","storagetype":"storagetype-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templatedisplaytext":"templatedisplaytext-value","templateid":"templateid-value","templatename":"templatename-value","type":"type-value","virtualmachineid":"virtualmachineid-value","vmdisplayname":"vmdisplayname-value","vmname":"vmname-value","vmstate":"vmstate-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &DetachVolumeResponse{})
+}
+
+func TestExtractVolumeParams(t *testing.T) {
+	p := &ExtractVolumeParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetMode("mode-value")
+	want.Set("mode", "mode-value")
+	p.SetUrl("url-value")
+	want.Set("url", "url-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "extractVolume", want)
+}
+
+func TestExtractVolumeResponse(t *testing.T) {
+	assertDecodes(t, `{"accountid":"accountid-value","created":"created-value","extractId":"extractId-value","extractMode":"extractMode-value","id":"id-value","jobid":"jobid-value","name":"name-value","resultstring":"resultstring-value","state":"state-value","status":"status-value","storagetype":"storagetype-value","uploadpercentage":42,"url":"url-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &ExtractVolumeResponse{})
+}
+
+func TestMigrateVolumeParams(t *testing.T) {
+	p := &MigrateVolumeParams{}
+	want := url.Values{}
+	p.SetLivemigrate(true)
+	want.Set("livemigrate", "true")
+	p.SetStorageid("storageid-value")
+	want.Set("storageid", "storageid-value")
+	p.SetVolumeid("volumeid-value")
+	want.Set("volumeid", "volumeid-value")
+
+	assertParams(t, p, "migrateVolume", want)
+}
+
+func TestMigrateVolumeResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","attached":"attached-value","chaininfo":"chaininfo-value","created":"created-value","destroyed":true,"deviceid":1099511627776,"diskBytesReadRate":1099511627776,"diskBytesWriteRate":1099511627776,"diskIopsRatePerGb":true,"diskIopsReadRate":1099511627776,"diskIopsTotalRate":1099511627776,"diskIopsWriteRate":1099511627776,"diskcontroller":"diskcontroller-value","diskofferingdisplaytext":"diskofferingdisplaytext-value","diskofferingid":"diskofferingid-value","diskofferingname":"diskofferingname-value","displayvolume":true,"domain":"domain-value","domainid":"domainid-value","format":"format-value","hypervisor":"hypervisor-value","id":"id-value","isextractable":true,"isodisplaytext":"isodisplaytext-value","isoid":"isoid-value","isoname":"isoname-value","jobid":"jobid-value","maxiops":1099511627776,"miniops":1099511627776,"name":"name-value","path":"path-value","project":"project-value","projectid":"projectid-value","provisioningtype":"provisioningtype-value","serviceofferingdisplaytext":"serviceofferingdisplaytext-value","serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","size":1099511627776,"snapshotid":"snapshotid-value","state":"state-value","status":"status-value","storage":"storage-value","storageid":"storageid-value","storagetype":"storagetype-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templatedisplaytext":"templatedisplaytext-value","templateid":"templateid-value","templatename":"templatename-value","type":"type-value","virtualmachineid":"virtualmachineid-value","vmdisplayname":"vmdisplayname-value","vmname":"vmname-value","vmstate":"vmstate-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &MigrateVolumeResponse{})
+}
+
+func TestResizeVolumeParams(t *testing.T) {
+	p := &ResizeVolumeParams{}
+	want := url.Values{}
+	p.SetDiskofferingid("diskofferingid-value")
+	want.Set("diskofferingid", "diskofferingid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetMaxiops(int64(1) << 40)
+	want.Set("maxiops", "1099511627776")
+	p.SetMiniops(int64(1) << 40)
+	want.Set("miniops", "1099511627776")
+	p.SetShrinkok(true)
+	want.Set("shrinkok", "true")
+	p.SetSize(int64(1) << 40)
+	want.Set("size", "1099511627776")
+
+	assertParams(t, p, "resizeVolume", want)
+}
+
+func TestResizeVolumeResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","attached":"attached-value","chaininfo":"chaininfo-value","created":"created-value","destroyed":true,"deviceid":1099511627776,"diskBytesReadRate":1099511627776,"diskBytesWriteRate":1099511627776,"diskIopsRatePerGb":true,"diskIopsReadRate":1099511627776,"diskIopsTotalRate":1099511627776,"diskIopsWriteRate":1099511627776,"diskcontroller":"diskcontroller-value","diskofferingdisplaytext":"diskofferingdisplaytext-value","diskofferingid":"diskofferingid-value","diskofferingname":"diskofferingname-value","displayvolume":true,"domain":"domain-value","domainid":"domainid-value","format":"format-value","hypervisor":"hypervisor-value","id":"id-value","isextractable":true,"isodisplaytext":"isodisplaytext-value","isoid":"isoid-value","isoname":"isoname-value","jobid":"jobid-value","maxiops":1099511627776,"miniops":1099511627776,"name":"name-value","path":"path-value","project":"project-value","projectid":"projectid-value","provisioningtype":"provisioningtype-value","serviceofferingdisplaytext":"serviceofferingdisplaytext-value","serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","size":1099511627776,"snapshotid":"snapshotid-value","state":"state-value","status":"status-value","storage":"storage-value","storageid":"storageid-value","storagetype":"storagetype-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templatedisplaytext":"templatedisplaytext-value","templateid":"templateid-value","templatename":"templatename-value","type":"type-value","virtualmachineid":"virtualmachineid-value","vmdisplayname":"vmdisplayname-value","vmname":"vmname-value","vmstate":"vmstate-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &ResizeVolumeResponse{})
+}
+
+func TestUpdateVolumeParams(t *testing.T) {
+	p := &UpdateVolumeParams{}
+	want := url.Values{}
+	p.SetChaininfo("chaininfo-value")
+	want.Set("chaininfo", "chaininfo-value")
+	p.SetCustomid("customid-value")
+	want.Set("customid", "customid-value")
+	p.SetDiskcontroller("diskcontroller-value")
+	want.Set("diskcontroller", "diskcontroller-value")
+	p.SetDisplayvolume(true)
+	want.Set("displayvolume", "true")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetPath("path-value")
+	want.Set("path", "path-value")
+	p.SetState("state-value")
+	want.Set("state", "state-value")
+	p.SetStorageid("storageid-value")
+	want.Set("storageid", "storageid-value")
+
+	assertParams(t, p, "updateVolume", want)
+}
+
+func TestUpdateVolumeResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","attached":"attached-value","chaininfo":"chaininfo-value","created":"created-value","destroyed":true,"deviceid":1099511627776,"diskBytesReadRate":1099511627776,"diskBytesWriteRate":1099511627776,"diskIopsRatePerGb":true,"diskIopsReadRate":1099511627776,"diskIopsTotalRate":1099511627776,"diskIopsWriteRate":1099511627776,"diskcontroller":"diskcontroller-value","diskofferingdisplaytext":"diskofferingdisplaytext-value","diskofferingid":"diskofferingid-value","diskofferingname":"diskofferingname-value","displayvolume":true,"domain":"domain-value","domainid":"domainid-value","format":"format-value","hypervisor":"hypervisor-value","id":"id-value","isextractable":true,"isodisplaytext":"isodisplaytext-value","isoid":"isoid-value","isoname":"isoname-value","jobid":"jobid-value","maxiops":1099511627776,"miniops":1099511627776,"name":"name-value","path":"path-value","project":"project-value","projectid":"projectid-value","provisioningtype":"provisioningtype-value","serviceofferingdisplaytext":"serviceofferingdisplaytext-value","serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","size":1099511627776,"snapshotid":"snapshotid-value","state":"state-value","status":"status-value","storage":"storage-value","storageid":"storageid-value","storagetype":"storagetype-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templatedisplaytext":"templatedisplaytext-value","templateid":"templateid-value","templatename":"templatename-value","type":"type-value","virtualmachineid":"virtualmachineid-value","vmdisplayname":"vmdisplayname-value","vmname":"vmname-value","vmstate":"vmstate-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &UpdateVolumeResponse{})
+}
+
+func TestUploadVolumeParams(t *testing.T) {
+	p := &UploadVolumeParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetChecksum("checksum-value")
+	want.Set("checksum", "checksum-value")
+	p.SetDiskofferingid("diskofferingid-value")
+	want.Set("diskofferingid", "diskofferingid-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetFormat("format-value")
+	want.Set("format", "format-value")
+	p.SetImagestoreuuid("imagestoreuuid-value")
+	want.Set("imagestoreuuid", "imagestoreuuid-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetUrl("url-value")
+	want.Set("url", "url-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "uploadVolume", want)
+}
+
+func TestUploadVolumeResponse(t *testing.T) {
+	assertDecodes(t, `{"account":"account-value","attached":"attached-value","chaininfo":"chaininfo-value","created":"created-value","destroyed":true,"deviceid":1099511627776,"diskBytesReadRate":1099511627776,"diskBytesWriteRate":1099511627776,"diskIopsRatePerGb":true,"diskIopsReadRate":1099511627776,"diskIopsTotalRate":1099511627776,"diskIopsWriteRate":1099511627776,"diskcontroller":"diskcontroller-value","diskofferingdisplaytext":"diskofferingdisplaytext-value","diskofferingid":"diskofferingid-value","diskofferingname":"diskofferingname-value","displayvolume":true,"domain":"domain-value","domainid":"domainid-value","format":"format-value","hypervisor":"hypervisor-value","id":"id-value","isextractable":true,"isodisplaytext":"isodisplaytext-value","isoid":"isoid-value","isoname":"isoname-value","jobid":"jobid-value","maxiops":1099511627776,"miniops":1099511627776,"name":"name-value","path":"path-value","project":"project-value","projectid":"projectid-value","provisioningtype":"provisioningtype-value","serviceofferingdisplaytext":"serviceofferingdisplaytext-value","serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","size":1099511627776,"snapshotid":"snapshotid-value","state":"state-value","status":"status-value","storage":"storage-value","storageid":"storageid-value","storagetype":"storagetype-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templatedisplaytext":"templatedisplaytext-value","templateid":"templateid-value","templatename":"templatename-value","type":"type-value","virtualmachineid":"virtualmachineid-value","vmdisplayname":"vmdisplayname-value","vmname":"vmname-value","vmstate":"vmstate-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &UploadVolumeResponse{})
+}
+
+func TestListVolumesParams(t *testing.T) {
+	p := &ListVolumesParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDiskofferingid("diskofferingid-value")
+	want.Set("diskofferingid", "diskofferingid-value")
+	p.SetDisplayvolume(true)
+	want.Set("displayvolume", "true")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetHostid("hostid-value")
+	want.Set("hostid", "hostid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetIsrecursive(true)
+	want.Set("isrecursive", "true")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetListall(true)
+	want.Set("listall", "true")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetPodid("podid-value")
+	want.Set("podid", "podid-value")
+	p.SetProjectid("projectid-value")
+	want.Set("projectid", "projectid-value")
+	p.SetStorageid("storageid-value")
+	want.Set("storageid", "storageid-value")
+	p.SetTags(map[string]string{"k": "v"})
+	want.Set("tags[0].key", "k")
+	want.Set("tags[0].value", "v")
+	p.SetType("type-value")
+	want.Set("type", "type-value")
+	p.SetVirtualmachineid("virtualmachineid-value")
+	want.Set("virtualmachineid", "virtualmachineid-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listVolumes", want)
+}
+
+func TestListVolumesResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"volume":[{"account":"account-value","attached":"attached-value","chaininfo":"chaininfo-value","created":"created-value","destroyed":true,"deviceid":1099511627776,"diskBytesReadRate":1099511627776,"diskBytesWriteRate":1099511627776,"diskIopsRatePerGb":true,"diskIopsReadRate":1099511627776,"diskIopsTotalRate":1099511627776,"diskIopsWriteRate":1099511627776,"diskcontroller":"diskcontroller-value","diskofferingdisplaytext":"diskofferingdisplaytext-value","diskofferingid":"diskofferingid-value","diskofferingname":"diskofferingname-value","displayvolume":true,"domain":"domain-value","domainid":"domainid-value","format":"format-value","hypervisor":"hypervisor-value","id":"id-value","isextractable":true,"isodisplaytext":"isodisplaytext-value","isoid":"isoid-value","isoname":"isoname-value","maxiops":1099511627776,"miniops":1099511627776,"name":"name-value","path":"path-value","project":"project-value","projectid":"projectid-value","provisioningtype":"provisioningtype-value","serviceofferingdisplaytext":"serviceofferingdisplaytext-value","serviceofferingid":"serviceofferingid-value","serviceofferingname":"serviceofferingname-value","size":1099511627776,"snapshotid":"snapshotid-value","state":"state-value","status":"status-value","storage":"storage-value","storageid":"storageid-value","storagetype":"storagetype-value","tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"templatedisplaytext":"templatedisplaytext-value","templateid":"templateid-value","templatename":"templatename-value","type":"type-value","virtualmachineid":"virtualmachineid-value","vmdisplayname":"vmdisplayname-value","vmname":"vmname-value","vmstate":"vmstate-value","zoneid":"zoneid-value","zonename":"zonename-value"}]}`, &ListVolumesResponse{})
+}
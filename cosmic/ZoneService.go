@@ -17,17 +17,55 @@
 package cosmic
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
 )
 
+// ZoneServiceIface is the interface implemented by ZoneService, and by MockZoneService for tests
+type ZoneServiceIface interface {
+	NewReleaseDedicatedZoneParams(zoneid string) *ReleaseDedicatedZoneParams
+	ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneResponse, error)
+	ReleaseDedicatedZoneWithContext(ctx context.Context, p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneResponse, error)
+	ReleaseDedicatedZoneAsync(ctx context.Context, p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneJob, error)
+	NewListDedicatedZonesParams() *ListDedicatedZonesParams
+	ListDedicatedZones(p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error)
+	ListDedicatedZonesWithContext(ctx context.Context, p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error)
+	NewListDedicatedZonesIterator(p *ListDedicatedZonesParams, pagesize int) *ListDedicatedZonesIterator
+	NewCreateZoneParams(dns1 string, internaldns1 string, name string, networktype string) *CreateZoneParams
+	CreateZone(p *CreateZoneParams) (*CreateZoneResponse, error)
+	CreateZoneWithContext(ctx context.Context, p *CreateZoneParams) (*CreateZoneResponse, error)
+	NewDedicateZoneParams(domainid string, zoneid string) *DedicateZoneParams
+	DedicateZone(p *DedicateZoneParams) (*DedicateZoneResponse, error)
+	DedicateZoneWithContext(ctx context.Context, p *DedicateZoneParams) (*DedicateZoneResponse, error)
+	DedicateZoneAsync(ctx context.Context, p *DedicateZoneParams) (*DedicateZoneJob, error)
+	NewDeleteZoneParams(id string) *DeleteZoneParams
+	DeleteZone(p *DeleteZoneParams) (*DeleteZoneResponse, error)
+	DeleteZoneWithContext(ctx context.Context, p *DeleteZoneParams) (*DeleteZoneResponse, error)
+	NewUpdateZoneParams(id string) *UpdateZoneParams
+	UpdateZone(p *UpdateZoneParams) (*UpdateZoneResponse, error)
+	UpdateZoneWithContext(ctx context.Context, p *UpdateZoneParams) (*UpdateZoneResponse, error)
+	NewListZonesParams() *ListZonesParams
+	GetZoneID(name string, opts ...OptionFunc) (string, int, error)
+	GetZoneByName(name string, opts ...OptionFunc) (*Zone, int, error)
+	GetZoneByID(id string, opts ...OptionFunc) (*Zone, int, error)
+	ListZones(p *ListZonesParams) (*ListZonesResponse, error)
+	ListZonesWithContext(ctx context.Context, p *ListZonesParams) (*ListZonesResponse, error)
+	NewListZonesIterator(p *ListZonesParams, pagesize int) *ListZonesIterator
+}
+
 type ReleaseDedicatedZoneParams struct {
 	p map[string]interface{}
 }
 
+func (p *ReleaseDedicatedZoneParams) command() string {
+	return "releaseDedicatedZone"
+}
+
 func (p *ReleaseDedicatedZoneParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -57,7 +95,13 @@ func (s *ZoneService) NewReleaseDedicatedZoneParams(zoneid string) *ReleaseDedic
 
 // Release dedication of zone
 func (s *ZoneService) ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedZone", p.toURLValues())
+	return s.ReleaseDedicatedZoneWithContext(context.Background(), p)
+}
+
+// ReleaseDedicatedZoneWithContext is the same as ReleaseDedicatedZone, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ZoneService) ReleaseDedicatedZoneWithContext(ctx context.Context, p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "releaseDedicatedZone", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -69,9 +113,9 @@ func (s *ZoneService) ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams) (*Rele
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "releaseDedicatedZone", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
@@ -84,6 +128,41 @@ func (s *ZoneService) ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams) (*Rele
 	return &r, nil
 }
 
+// ReleaseDedicatedZoneJob is a handle to a running releaseDedicatedZone async job
+type ReleaseDedicatedZoneJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a ReleaseDedicatedZoneResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *ReleaseDedicatedZoneJob) Result() (*ReleaseDedicatedZoneResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := ReleaseDedicatedZoneResponse{JobID: j.JobID()}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ReleaseDedicatedZoneAsync starts the releaseDedicatedZone async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *ZoneService) ReleaseDedicatedZoneAsync(ctx context.Context, p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneJob, error) {
+	resp, err := s.cs.newRequest(ctx, "releaseDedicatedZone", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ReleaseDedicatedZoneResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &ReleaseDedicatedZoneJob{s.cs.newJobHandle("releaseDedicatedZone", r.JobID)}, nil
+}
+
 type ReleaseDedicatedZoneResponse struct {
 	JobID       string `json:"jobid,omitempty"`
 	Displaytext string `json:"displaytext,omitempty"`
@@ -94,6 +173,10 @@ type ListDedicatedZonesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListDedicatedZonesParams) command() string {
+	return "listDedicatedZones"
+}
+
 func (p *ListDedicatedZonesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -184,10 +267,17 @@ func (s *ZoneService) NewListDedicatedZonesParams() *ListDedicatedZonesParams {
 
 // List dedicated zones.
 func (s *ZoneService) ListDedicatedZones(p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error) {
+	return s.ListDedicatedZonesWithContext(context.Background(), p)
+}
+
+// ListDedicatedZonesWithContext is the same as ListDedicatedZones, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ZoneService) ListDedicatedZonesWithContext(ctx context.Context, p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error) {
 	var r ListDedicatedZonesResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListDedicatedZonesResponse
-		resp, err := s.cs.newRequest("listDedicatedZones", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listDedicatedZones", values)
 		if err != nil {
 			return nil, err
 		}
@@ -199,15 +289,91 @@ func (s *ZoneService) ListDedicatedZones(p *ListDedicatedZonesParams) (*ListDedi
 		r.Count = l.Count
 		r.DedicatedZones = append(r.DedicatedZones, l.DedicatedZones...)
 
-		if r.Count == len(r.DedicatedZones) {
+		if r.Count == len(r.DedicatedZones) || len(l.DedicatedZones) == 0 {
+			s.cs.observePages("listDedicatedZones", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.DedicatedZones))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.DedicatedZones)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listDedicatedZones", values, len(l.DedicatedZones), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListDedicatedZonesResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.DedicatedZones = append(r.DedicatedZones, l.DedicatedZones...)
+			}
+
+			if r.Count == len(r.DedicatedZones) {
+				s.cs.observePages("listDedicatedZones", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
 	}
 }
 
+// ListDedicatedZonesIterator iterates over the items returned by listDedicatedZones, requesting
+// the pages of the response one at a time while iterating.
+type ListDedicatedZonesIterator struct {
+	pager
+	items []*DedicatedZone
+	index int
+}
+
+// NewListDedicatedZonesIterator returns an iterator over the items returned by listDedicatedZones, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *ZoneService) NewListDedicatedZonesIterator(p *ListDedicatedZonesParams, pagesize int) *ListDedicatedZonesIterator {
+	return &ListDedicatedZonesIterator{pager: newPager(s.cs, "listDedicatedZones", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListDedicatedZonesIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListDedicatedZonesResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.DedicatedZones))
+
+	i.items = l.DedicatedZones
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListDedicatedZonesIterator) Value() *DedicatedZone {
+	if i.index >= len(i.items) {
+		return nil
+	}
+	return i.items[i.index]
+}
+
 type ListDedicatedZonesResponse struct {
 	Count          int              `json:"count"`
 	DedicatedZones []*DedicatedZone `json:"dedicatedzone"`
@@ -228,6 +394,10 @@ type CreateZoneParams struct {
 	p map[string]interface{}
 }
 
+func (p *CreateZoneParams) command() string {
+	return "createZone"
+}
+
 func (p *CreateZoneParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -381,7 +551,13 @@ func (s *ZoneService) NewCreateZoneParams(dns1 string, internaldns1 string, name
 
 // Creates a Zone.
 func (s *ZoneService) CreateZone(p *CreateZoneParams) (*CreateZoneResponse, error) {
-	resp, err := s.cs.newRequest("createZone", p.toURLValues())
+	return s.CreateZoneWithContext(context.Background(), p)
+}
+
+// CreateZoneWithContext is the same as CreateZone, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ZoneService) CreateZoneWithContext(ctx context.Context, p *CreateZoneParams) (*CreateZoneResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "createZone", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -444,6 +620,10 @@ type DedicateZoneParams struct {
 	p map[string]interface{}
 }
 
+func (p *DedicateZoneParams) command() string {
+	return "dedicateZone"
+}
+
 func (p *DedicateZoneParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -494,7 +674,13 @@ func (s *ZoneService) NewDedicateZoneParams(domainid string, zoneid string) *Ded
 
 // Dedicates a zones.
 func (s *ZoneService) DedicateZone(p *DedicateZoneParams) (*DedicateZoneResponse, error) {
-	resp, err := s.cs.newRequest("dedicateZone", p.toURLValues())
+	return s.DedicateZoneWithContext(context.Background(), p)
+}
+
+// DedicateZoneWithContext is the same as DedicateZone, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ZoneService) DedicateZoneWithContext(ctx context.Context, p *DedicateZoneParams) (*DedicateZoneResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "dedicateZone", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -506,26 +692,56 @@ func (s *ZoneService) DedicateZone(p *DedicateZoneParams) (*DedicateZoneResponse
 
 	// If we have a async client, we need to wait for the async result
 	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		b, err := s.cs.waitForAsyncJob(ctx, "dedicateZone", r.JobID, s.cs.timeout)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := unmarshalValue(b, "zone", &r); err != nil {
 			return nil, err
 		}
+	}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// DedicateZoneJob is a handle to a running dedicateZone async job
+type DedicateZoneJob struct {
+	*JobHandle
+}
+
+// Result returns the result of the finished job decoded into a DedicateZoneResponse. If the job is
+// not yet finished ErrJobNotFinished is returned, and if the job failed its error.
+func (j *DedicateZoneJob) Result() (*DedicateZoneResponse, error) {
+	b, err := j.RawResult()
+	if err != nil {
+		return nil, err
+	}
+
+	r := DedicateZoneResponse{JobID: j.JobID()}
+	if err := unmarshalValue(b, "zone", &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
+// DedicateZoneAsync starts the dedicateZone async job and returns a handle to the running job. It never
+// waits for the job to finish, not even when using an async client.
+func (s *ZoneService) DedicateZoneAsync(ctx context.Context, p *DedicateZoneParams) (*DedicateZoneJob, error) {
+	resp, err := s.cs.newRequest(ctx, "dedicateZone", p.toURLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DedicateZoneResponse
+	if err := json.Unmarshal(resp, &r); err != nil {
+		return nil, err
+	}
+	return &DedicateZoneJob{s.cs.newJobHandle("dedicateZone", r.JobID)}, nil
+}
+
 type DedicateZoneResponse struct {
 	JobID           string `json:"jobid,omitempty"`
 	Accountid       string `json:"accountid,omitempty"`
@@ -542,6 +758,10 @@ type DeleteZoneParams struct {
 	p map[string]interface{}
 }
 
+func (p *DeleteZoneParams) command() string {
+	return "deleteZone"
+}
+
 func (p *DeleteZoneParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -571,7 +791,13 @@ func (s *ZoneService) NewDeleteZoneParams(id string) *DeleteZoneParams {
 
 // Deletes a Zone.
 func (s *ZoneService) DeleteZone(p *DeleteZoneParams) (*DeleteZoneResponse, error) {
-	resp, err := s.cs.newRequest("deleteZone", p.toURLValues())
+	return s.DeleteZoneWithContext(context.Background(), p)
+}
+
+// DeleteZoneWithContext is the same as DeleteZone, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ZoneService) DeleteZoneWithContext(ctx context.Context, p *DeleteZoneParams) (*DeleteZoneResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "deleteZone", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -592,6 +818,10 @@ type UpdateZoneParams struct {
 	p map[string]interface{}
 }
 
+func (p *UpdateZoneParams) command() string {
+	return "updateZone"
+}
+
 func (p *UpdateZoneParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -768,7 +998,13 @@ func (s *ZoneService) NewUpdateZoneParams(id string) *UpdateZoneParams {
 
 // Updates a Zone.
 func (s *ZoneService) UpdateZone(p *UpdateZoneParams) (*UpdateZoneResponse, error) {
-	resp, err := s.cs.newRequest("updateZone", p.toURLValues())
+	return s.UpdateZoneWithContext(context.Background(), p)
+}
+
+// UpdateZoneWithContext is the same as UpdateZone, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ZoneService) UpdateZoneWithContext(ctx context.Context, p *UpdateZoneParams) (*UpdateZoneResponse, error) {
+	resp, err := s.cs.newRequest(ctx, "updateZone", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -831,6 +1067,10 @@ type ListZonesParams struct {
 	p map[string]interface{}
 }
 
+func (p *ListZonesParams) command() string {
+	return "listZones"
+}
+
 func (p *ListZonesParams) toURLValues() url.Values {
 	u := url.Values{}
 	if p.p == nil {
@@ -975,7 +1215,7 @@ func (s *ZoneService) GetZoneID(name string, opts ...OptionFunc) (string, int, e
 	}
 
 	if l.Count == 0 {
-		return "", l.Count, fmt.Errorf("No match found for %s: %+v", name, l)
+		return "", l.Count, notFoundErrorf("No match found for %s: %+v", name, l)
 	}
 
 	if l.Count == 1 {
@@ -989,7 +1229,7 @@ func (s *ZoneService) GetZoneID(name string, opts ...OptionFunc) (string, int, e
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return "", l.Count, notFoundErrorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
@@ -1021,16 +1261,14 @@ func (s *ZoneService) GetZoneByID(id string, opts ...OptionFunc) (*Zone, int, er
 
 	l, err := s.ListZones(p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if errors.Is(err, ErrNotFound) {
+			return nil, 0, notFoundErrorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return nil, l.Count, notFoundErrorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
@@ -1042,10 +1280,17 @@ func (s *ZoneService) GetZoneByID(id string, opts ...OptionFunc) (*Zone, int, er
 
 // Lists zones
 func (s *ZoneService) ListZones(p *ListZonesParams) (*ListZonesResponse, error) {
+	return s.ListZonesWithContext(context.Background(), p)
+}
+
+// ListZonesWithContext is the same as ListZones, but it accepts a context.Context that can be
+// used to cancel the request or to limit the time spend on it.
+func (s *ZoneService) ListZonesWithContext(ctx context.Context, p *ListZonesParams) (*ListZonesResponse, error) {
 	var r ListZonesResponse
+	values := p.toURLValues()
 	for page := 2; ; page++ {
 		var l ListZonesResponse
-		resp, err := s.cs.newRequest("listZones", p.toURLValues())
+		resp, err := s.cs.newRequest(ctx, "listZones", values)
 		if err != nil {
 			return nil, err
 		}
@@ -1057,13 +1302,89 @@ func (s *ZoneService) ListZones(p *ListZonesParams) (*ListZonesResponse, error)
 		r.Count = l.Count
 		r.Zones = append(r.Zones, l.Zones...)
 
-		if r.Count == len(r.Zones) {
+		if r.Count == len(r.Zones) || len(l.Zones) == 0 {
+			s.cs.observePages("listZones", page-1)
 			return &r, nil
 		}
 
-		p.SetPagesize(len(l.Zones))
-		p.SetPage(page)
+		// Request the next page on a copy of the params, leaving the params of the caller untouched
+		values.Set("pagesize", strconv.Itoa(len(l.Zones)))
+		values.Set("page", strconv.Itoa(page))
+
+		// Now the count is known, request the remaining pages concurrently if enabled
+		if page == 2 && s.cs.pageConcurrency > 1 {
+			pages, err := s.cs.fetchPages(ctx, "listZones", values, len(l.Zones), r.Count)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, resp := range pages {
+				var l ListZonesResponse
+				if err := json.Unmarshal(resp, &l); err != nil {
+					return nil, err
+				}
+
+				r.Count = l.Count
+				r.Zones = append(r.Zones, l.Zones...)
+			}
+
+			if r.Count == len(r.Zones) {
+				s.cs.observePages("listZones", page-1+len(pages))
+				return &r, nil
+			}
+
+			// The list changed while requesting the pages, so continue with the following pages
+			page += len(pages)
+			values.Set("page", strconv.Itoa(page))
+		}
+	}
+}
+
+// ListZonesIterator iterates over the items returned by listZones, requesting
+// the pages of the response one at a time while iterating.
+type ListZonesIterator struct {
+	pager
+	items []*Zone
+	index int
+}
+
+// NewListZonesIterator returns an iterator over the items returned by listZones, requesting pagesize
+// items per page. The iterator uses a copy of the params, so p is never changed.
+func (s *ZoneService) NewListZonesIterator(p *ListZonesParams, pagesize int) *ListZonesIterator {
+	return &ListZonesIterator{pager: newPager(s.cs, "listZones", p.toURLValues(), pagesize)}
+}
+
+// Next advances the iterator to the next item, requesting the next page when needed. It
+// returns false when there are no more items or an error occurred, which is returned by Err.
+func (i *ListZonesIterator) Next(ctx context.Context) bool {
+	i.index++
+	if i.index < len(i.items) {
+		return true
+	}
+
+	resp := i.nextPage(ctx)
+	if resp == nil {
+		return false
+	}
+
+	var l ListZonesResponse
+	if err := json.Unmarshal(resp, &l); err != nil {
+		i.fail(err)
+		return false
+	}
+	i.received(l.Count, len(l.Zones))
+
+	i.items = l.Zones
+	i.index = 0
+	return len(i.items) > 0
+}
+
+// Value returns the current item
+func (i *ListZonesIterator) Value() *Zone {
+	if i.index >= len(i.items) {
+		return nil
 	}
+	return i.items[i.index]
 }
 
 type ListZonesResponse struct {
@@ -0,0 +1,221 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import "context"
+
+// MockZoneService is a mock of ZoneServiceIface. A method is programmed by setting the function named after the
+// method with a Func suffix, which is shared by a method and its WithContext variant. When no
+// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.
+type MockZoneService struct {
+	mockCalls
+
+	ReleaseDedicatedZoneFunc      func(ctx context.Context, p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneResponse, error)
+	ReleaseDedicatedZoneAsyncFunc func(ctx context.Context, p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneJob, error)
+	ListDedicatedZonesFunc        func(ctx context.Context, p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error)
+	CreateZoneFunc                func(ctx context.Context, p *CreateZoneParams) (*CreateZoneResponse, error)
+	DedicateZoneFunc              func(ctx context.Context, p *DedicateZoneParams) (*DedicateZoneResponse, error)
+	DedicateZoneAsyncFunc         func(ctx context.Context, p *DedicateZoneParams) (*DedicateZoneJob, error)
+	DeleteZoneFunc                func(ctx context.Context, p *DeleteZoneParams) (*DeleteZoneResponse, error)
+	UpdateZoneFunc                func(ctx context.Context, p *UpdateZoneParams) (*UpdateZoneResponse, error)
+	GetZoneIDFunc                 func(name string, opts ...OptionFunc) (string, int, error)
+	GetZoneByNameFunc             func(name string, opts ...OptionFunc) (*Zone, int, error)
+	GetZoneByIDFunc               func(id string, opts ...OptionFunc) (*Zone, int, error)
+	ListZonesFunc                 func(ctx context.Context, p *ListZonesParams) (*ListZonesResponse, error)
+}
+
+var _ ZoneServiceIface = &MockZoneService{}
+
+func (m *MockZoneService) NewReleaseDedicatedZoneParams(zoneid string) *ReleaseDedicatedZoneParams {
+	return (&ZoneService{}).NewReleaseDedicatedZoneParams(zoneid)
+}
+
+func (m *MockZoneService) ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneResponse, error) {
+	return m.ReleaseDedicatedZoneWithContext(context.Background(), p)
+}
+
+func (m *MockZoneService) ReleaseDedicatedZoneWithContext(ctx context.Context, p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneResponse, error) {
+	m.record("ReleaseDedicatedZone", p)
+	if m.ReleaseDedicatedZoneFunc == nil {
+		return nil, notMocked("MockZoneService", "ReleaseDedicatedZone")
+	}
+	return m.ReleaseDedicatedZoneFunc(ctx, p)
+}
+
+func (m *MockZoneService) ReleaseDedicatedZoneAsync(ctx context.Context, p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneJob, error) {
+	m.record("ReleaseDedicatedZoneAsync", p)
+	if m.ReleaseDedicatedZoneAsyncFunc == nil {
+		return nil, notMocked("MockZoneService", "ReleaseDedicatedZoneAsync")
+	}
+	return m.ReleaseDedicatedZoneAsyncFunc(ctx, p)
+}
+
+func (m *MockZoneService) NewListDedicatedZonesParams() *ListDedicatedZonesParams {
+	return (&ZoneService{}).NewListDedicatedZonesParams()
+}
+
+func (m *MockZoneService) ListDedicatedZones(p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error) {
+	return m.ListDedicatedZonesWithContext(context.Background(), p)
+}
+
+func (m *MockZoneService) ListDedicatedZonesWithContext(ctx context.Context, p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error) {
+	m.record("ListDedicatedZones", p)
+	if m.ListDedicatedZonesFunc == nil {
+		return nil, notMocked("MockZoneService", "ListDedicatedZones")
+	}
+	return m.ListDedicatedZonesFunc(ctx, p)
+}
+
+func (m *MockZoneService) NewListDedicatedZonesIterator(p *ListDedicatedZonesParams, pagesize int) *ListDedicatedZonesIterator {
+	i := &ListDedicatedZonesIterator{pager: newPager(nil, "listDedicatedZones", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListDedicatedZonesParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListDedicatedZonesWithContext(ctx, c)
+	}
+	return i
+}
+
+func (m *MockZoneService) NewCreateZoneParams(dns1 string, internaldns1 string, name string, networktype string) *CreateZoneParams {
+	return (&ZoneService{}).NewCreateZoneParams(dns1, internaldns1, name, networktype)
+}
+
+func (m *MockZoneService) CreateZone(p *CreateZoneParams) (*CreateZoneResponse, error) {
+	return m.CreateZoneWithContext(context.Background(), p)
+}
+
+func (m *MockZoneService) CreateZoneWithContext(ctx context.Context, p *CreateZoneParams) (*CreateZoneResponse, error) {
+	m.record("CreateZone", p)
+	if m.CreateZoneFunc == nil {
+		return nil, notMocked("MockZoneService", "CreateZone")
+	}
+	return m.CreateZoneFunc(ctx, p)
+}
+
+func (m *MockZoneService) NewDedicateZoneParams(domainid string, zoneid string) *DedicateZoneParams {
+	return (&ZoneService{}).NewDedicateZoneParams(domainid, zoneid)
+}
+
+func (m *MockZoneService) DedicateZone(p *DedicateZoneParams) (*DedicateZoneResponse, error) {
+	return m.DedicateZoneWithContext(context.Background(), p)
+}
+
+func (m *MockZoneService) DedicateZoneWithContext(ctx context.Context, p *DedicateZoneParams) (*DedicateZoneResponse, error) {
+	m.record("DedicateZone", p)
+	if m.DedicateZoneFunc == nil {
+		return nil, notMocked("MockZoneService", "DedicateZone")
+	}
+	return m.DedicateZoneFunc(ctx, p)
+}
+
+func (m *MockZoneService) DedicateZoneAsync(ctx context.Context, p *DedicateZoneParams) (*DedicateZoneJob, error) {
+	m.record("DedicateZoneAsync", p)
+	if m.DedicateZoneAsyncFunc == nil {
+		return nil, notMocked("MockZoneService", "DedicateZoneAsync")
+	}
+	return m.DedicateZoneAsyncFunc(ctx, p)
+}
+
+func (m *MockZoneService) NewDeleteZoneParams(id string) *DeleteZoneParams {
+	return (&ZoneService{}).NewDeleteZoneParams(id)
+}
+
+func (m *MockZoneService) DeleteZone(p *DeleteZoneParams) (*DeleteZoneResponse, error) {
+	return m.DeleteZoneWithContext(context.Background(), p)
+}
+
+func (m *MockZoneService) DeleteZoneWithContext(ctx context.Context, p *DeleteZoneParams) (*DeleteZoneResponse, error) {
+	m.record("DeleteZone", p)
+	if m.DeleteZoneFunc == nil {
+		return nil, notMocked("MockZoneService", "DeleteZone")
+	}
+	return m.DeleteZoneFunc(ctx, p)
+}
+
+func (m *MockZoneService) NewUpdateZoneParams(id string) *UpdateZoneParams {
+	return (&ZoneService{}).NewUpdateZoneParams(id)
+}
+
+func (m *MockZoneService) UpdateZone(p *UpdateZoneParams) (*UpdateZoneResponse, error) {
+	return m.UpdateZoneWithContext(context.Background(), p)
+}
+
+func (m *MockZoneService) UpdateZoneWithContext(ctx context.Context, p *UpdateZoneParams) (*UpdateZoneResponse, error) {
+	m.record("UpdateZone", p)
+	if m.UpdateZoneFunc == nil {
+		return nil, notMocked("MockZoneService", "UpdateZone")
+	}
+	return m.UpdateZoneFunc(ctx, p)
+}
+
+func (m *MockZoneService) NewListZonesParams() *ListZonesParams {
+	return (&ZoneService{}).NewListZonesParams()
+}
+
+func (m *MockZoneService) GetZoneID(name string, opts ...OptionFunc) (string, int, error) {
+	m.record("GetZoneID", name, opts)
+	if m.GetZoneIDFunc == nil {
+		return "", -1, notMocked("MockZoneService", "GetZoneID")
+	}
+	return m.GetZoneIDFunc(name, opts...)
+}
+
+func (m *MockZoneService) GetZoneByName(name string, opts ...OptionFunc) (*Zone, int, error) {
+	m.record("GetZoneByName", name, opts)
+	if m.GetZoneByNameFunc == nil {
+		return nil, -1, notMocked("MockZoneService", "GetZoneByName")
+	}
+	return m.GetZoneByNameFunc(name, opts...)
+}
+
+func (m *MockZoneService) GetZoneByID(id string, opts ...OptionFunc) (*Zone, int, error) {
+	m.record("GetZoneByID", id, opts)
+	if m.GetZoneByIDFunc == nil {
+		return nil, -1, notMocked("MockZoneService", "GetZoneByID")
+	}
+	return m.GetZoneByIDFunc(id, opts...)
+}
+
+func (m *MockZoneService) ListZones(p *ListZonesParams) (*ListZonesResponse, error) {
+	return m.ListZonesWithContext(context.Background(), p)
+}
+
+func (m *MockZoneService) ListZonesWithContext(ctx context.Context, p *ListZonesParams) (*ListZonesResponse, error) {
+	m.record("ListZones", p)
+	if m.ListZonesFunc == nil {
+		return nil, notMocked("MockZoneService", "ListZones")
+	}
+	return m.ListZonesFunc(ctx, p)
+}
+
+func (m *MockZoneService) NewListZonesIterator(p *ListZonesParams, pagesize int) *ListZonesIterator {
+	i := &ListZonesIterator{pager: newPager(nil, "listZones", nil, pagesize)}
+	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {
+		c := &ListZonesParams{p: make(map[string]interface{}, len(p.p)+2)}
+		for k, v := range p.p {
+			c.p[k] = v
+		}
+		c.p["page"] = page
+		c.p["pagesize"] = pagesize
+		return m.ListZonesWithContext(ctx, c)
+	}
+	return i
+}
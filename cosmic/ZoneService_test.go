@@ -0,0 +1,201 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestReleaseDedicatedZoneParams(t *testing.T) {
+	p := &ReleaseDedicatedZoneParams{}
+	want := url.Values{}
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "releaseDedicatedZone", want)
+}
+
+func TestReleaseDedicatedZoneResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","jobid":"jobid-value","success":true}`, &ReleaseDedicatedZoneResponse{})
+}
+
+func TestListDedicatedZonesParams(t *testing.T) {
+	p := &ListDedicatedZonesParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetAffinitygroupid("affinitygroupid-value")
+	want.Set("affinitygroupid", "affinitygroupid-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "listDedicatedZones", want)
+}
+
+func TestListDedicatedZonesResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"dedicatedzone":[{"accountid":"accountid-value","accountname":"accountname-value","affinitygroupid":"affinitygroupid-value","domainid":"domainid-value","domainname":"domainname-value","id":"id-value","zoneid":"zoneid-value","zonename":"zonename-value"}]}`, &ListDedicatedZonesResponse{})
+}
+
+func TestCreateZoneParams(t *testing.T) {
+	p := &CreateZoneParams{}
+	want := url.Values{}
+	p.SetAllocationstate("allocationstate-value")
+	want.Set("allocationstate", "allocationstate-value")
+	p.SetDns1("dns1-value")
+	want.Set("dns1", "dns1-value")
+	p.SetDns2("dns2-value")
+	want.Set("dns2", "dns2-value")
+	p.SetDomain("domain-value")
+	want.Set("domain", "domain-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetGuestcidraddress("guestcidraddress-value")
+	want.Set("guestcidraddress", "guestcidraddress-value")
+	p.SetInternaldns1("internaldns1-value")
+	want.Set("internaldns1", "internaldns1-value")
+	p.SetInternaldns2("internaldns2-value")
+	want.Set("internaldns2", "internaldns2-value")
+	p.SetIp6dns1("ip6dns1-value")
+	want.Set("ip6dns1", "ip6dns1-value")
+	p.SetIp6dns2("ip6dns2-value")
+	want.Set("ip6dns2", "ip6dns2-value")
+	p.SetLocalstorageenabled(true)
+	want.Set("localstorageenabled", "true")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetNetworktype("networktype-value")
+	want.Set("networktype", "networktype-value")
+
+	assertParams(t, p, "createZone", want)
+}
+
+func TestCreateZoneResponse(t *testing.T) {
+	assertDecodes(t, `{"allocationstate":"allocationstate-value","capacity":[{"capacitytotal":1099511627776,"capacityused":1099511627776,"clusterid":"clusterid-value","clustername":"clustername-value","percentageallocated":"percentageallocated-value","podid":"podid-value","podname":"podname-value","type":42,"zoneid":"zoneid-value","zonename":"zonename-value"}],"description":"description-value","dhcpprovider":"dhcpprovider-value","displaytext":"displaytext-value","dns1":"dns1-value","dns2":"dns2-value","domain":"domain-value","domainid":"domainid-value","domainname":"domainname-value","guestcidraddress":"guestcidraddress-value","id":"id-value","internaldns1":"internaldns1-value","internaldns2":"internaldns2-value","ip6dns1":"ip6dns1-value","ip6dns2":"ip6dns2-value","localstorageenabled":true,"name":"name-value","networktype":"networktype-value","resourcedetails":{"key":"value"},"tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"zonetoken":"zonetoken-value"}`, &CreateZoneResponse{})
+}
+
+func TestDedicateZoneParams(t *testing.T) {
+	p := &DedicateZoneParams{}
+	want := url.Values{}
+	p.SetAccount("account-value")
+	want.Set("account", "account-value")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetZoneid("zoneid-value")
+	want.Set("zoneid", "zoneid-value")
+
+	assertParams(t, p, "dedicateZone", want)
+}
+
+func TestDedicateZoneResponse(t *testing.T) {
+	assertDecodes(t, `{"accountid":"accountid-value","accountname":"accountname-value","affinitygroupid":"affinitygroupid-value","domainid":"domainid-value","domainname":"domainname-value","id":"id-value","jobid":"jobid-value","zoneid":"zoneid-value","zonename":"zonename-value"}`, &DedicateZoneResponse{})
+}
+
+func TestDeleteZoneParams(t *testing.T) {
+	p := &DeleteZoneParams{}
+	want := url.Values{}
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+
+	assertParams(t, p, "deleteZone", want)
+}
+
+func TestDeleteZoneResponse(t *testing.T) {
+	assertDecodes(t, `{"displaytext":"displaytext-value","success":"true"}`, &DeleteZoneResponse{})
+}
+
+func TestUpdateZoneParams(t *testing.T) {
+	p := &UpdateZoneParams{}
+	want := url.Values{}
+	p.SetAllocationstate("allocationstate-value")
+	want.Set("allocationstate", "allocationstate-value")
+	p.SetDetails(map[string]string{"k": "v"})
+	want.Set("details[0].key", "k")
+	want.Set("details[0].value", "v")
+	p.SetDhcpprovider("dhcpprovider-value")
+	want.Set("dhcpprovider", "dhcpprovider-value")
+	p.SetDns1("dns1-value")
+	want.Set("dns1", "dns1-value")
+	p.SetDns2("dns2-value")
+	want.Set("dns2", "dns2-value")
+	p.SetDnssearchorder([]string{"a", "b"})
+	want.Set("dnssearchorder", "a,b")
+	p.SetDomain("domain-value")
+	want.Set("domain", "domain-value")
+	p.SetGuestcidraddress("guestcidraddress-value")
+	want.Set("guestcidraddress", "guestcidraddress-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetInternaldns1("internaldns1-value")
+	want.Set("internaldns1", "internaldns1-value")
+	p.SetInternaldns2("internaldns2-value")
+	want.Set("internaldns2", "internaldns2-value")
+	p.SetIp6dns1("ip6dns1-value")
+	want.Set("ip6dns1", "ip6dns1-value")
+	p.SetIp6dns2("ip6dns2-value")
+	want.Set("ip6dns2", "ip6dns2-value")
+	p.SetIspublic(true)
+	want.Set("ispublic", "true")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+
+	assertParams(t, p, "updateZone", want)
+}
+
+func TestUpdateZoneResponse(t *testing.T) {
+	assertDecodes(t, `{"allocationstate":"allocationstate-value","capacity":[{"capacitytotal":1099511627776,"capacityused":1099511627776,"clusterid":"clusterid-value","clustername":"clustername-value","percentageallocated":"percentageallocated-value","podid":"podid-value","podname":"podname-value","type":42,"zoneid":"zoneid-value","zonename":"zonename-value"}],"description":"description-value","dhcpprovider":"dhcpprovider-value","displaytext":"displaytext-value","dns1":"dns1-value","dns2":"dns2-value","domain":"domain-value","domainid":"domainid-value","domainname":"domainname-value","guestcidraddress":"guestcidraddress-value","id":"id-value","internaldns1":"internaldns1-value","internaldns2":"internaldns2-value","ip6dns1":"ip6dns1-value","ip6dns2":"ip6dns2-value","localstorageenabled":true,"name":"name-value","networktype":"networktype-value","resourcedetails":{"key":"value"},"tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"zonetoken":"zonetoken-value"}`, &UpdateZoneResponse{})
+}
+
+func TestListZonesParams(t *testing.T) {
+	p := &ListZonesParams{}
+	want := url.Values{}
+	p.SetAvailable(true)
+	want.Set("available", "true")
+	p.SetDomainid("domainid-value")
+	want.Set("domainid", "domainid-value")
+	p.SetId("id-value")
+	want.Set("id", "id-value")
+	p.SetKeyword("keyword-value")
+	want.Set("keyword", "keyword-value")
+	p.SetName("name-value")
+	want.Set("name", "name-value")
+	p.SetNetworktype("networktype-value")
+	want.Set("networktype", "networktype-value")
+	p.SetPage(42)
+	want.Set("page", "42")
+	p.SetPagesize(42)
+	want.Set("pagesize", "42")
+	p.SetShowcapacities(true)
+	want.Set("showcapacities", "true")
+	p.SetTags(map[string]string{"k": "v"})
+	want.Set("tags[0].key", "k")
+	want.Set("tags[0].value", "v")
+
+	assertParams(t, p, "listZones", want)
+}
+
+func TestListZonesResponse(t *testing.T) {
+	assertDecodes(t, `{"count":1,"zone":[{"allocationstate":"allocationstate-value","capacity":[{"capacitytotal":1099511627776,"capacityused":1099511627776,"clusterid":"clusterid-value","clustername":"clustername-value","percentageallocated":"percentageallocated-value","podid":"podid-value","podname":"podname-value","type":42,"zoneid":"zoneid-value","zonename":"zonename-value"}],"description":"description-value","dhcpprovider":"dhcpprovider-value","displaytext":"displaytext-value","dns1":"dns1-value","dns2":"dns2-value","domain":"domain-value","domainid":"domainid-value","domainname":"domainname-value","guestcidraddress":"guestcidraddress-value","id":"id-value","internaldns1":"internaldns1-value","internaldns2":"internaldns2-value","ip6dns1":"ip6dns1-value","ip6dns2":"ip6dns2-value","localstorageenabled":true,"name":"name-value","networktype":"networktype-value","resourcedetails":{"key":"value"},"tags":[{"account":"account-value","customer":"customer-value","domain":"domain-value","domainid":"domainid-value","key":"key-value","project":"project-value","projectid":"projectid-value","resourceid":"resourceid-value","resourcetype":"resourcetype-value","value":"value-value"}],"zonetoken":"zonetoken-value"}]}`, &ListZonesResponse{})
+}
@@ -0,0 +1,275 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Status of an async job as reported in the `jobstatus` field
+const (
+	JobStatusPending = 0
+	JobStatusSuccess = 1
+	JobStatusFailed  = 2
+)
+
+// ErrJobNotFinished is returned when asking for the result of a job that is not yet finished
+var ErrJobNotFinished = errors.New("The async job is not yet finished")
+
+// PollBackoff returns the time to wait before the given poll of a running async
+// job, where the first poll that has to wait for a previous one is poll 1.
+type PollBackoff func(poll int) time.Duration
+
+// LinearBackoff returns a PollBackoff that waits interval before the first poll and
+// increases the wait time with interval for every following poll, up to max.
+func LinearBackoff(interval time.Duration, max time.Duration) PollBackoff {
+	return func(poll int) time.Duration {
+		if wait := time.Duration(poll) * interval; wait < max {
+			return wait
+		}
+		return max
+	}
+}
+
+// ExponentialBackoff returns a PollBackoff that waits initial before the first poll
+// and doubles the wait time for every following poll, up to max.
+func ExponentialBackoff(initial time.Duration, max time.Duration) PollBackoff {
+	return func(poll int) time.Duration {
+		wait := initial
+		for i := 1; i < poll && wait < max; i++ {
+			wait *= 2
+		}
+		if wait > max {
+			return max
+		}
+		return wait
+	}
+}
+
+// JobHandle is a handle to a running async job. Use Poll to check the status of the job once,
+// or Wait to block until the job is finished. Every async command also has an ...Async variant
+// returning a typed handle, which can decode the result of the job into the response type.
+type JobHandle struct {
+	cs      *CosmicClient
+	command string // The command that started the job, if known
+	id      string
+	backoff PollBackoff
+
+	mu   sync.Mutex
+	last *QueryAsyncJobResultResponse // Result of the last poll
+}
+
+// NewJobHandle returns a handle for the async job with the given ID. The handle waits between polls
+// using the poll interval of the client, which can be changed with SetBackoff.
+func (cs *CosmicClient) NewJobHandle(jobid string) *JobHandle {
+	return cs.newJobHandle("", jobid)
+}
+
+// Returns a handle for the async job started by the given command. When the job is finished, the
+// cached responses invalidated by the command are dropped.
+func (cs *CosmicClient) newJobHandle(command string, jobid string) *JobHandle {
+	return &JobHandle{
+		cs:      cs,
+		command: command,
+		id:      jobid,
+		backoff: LinearBackoff(cs.pollInterval, cs.maxPollInterval),
+	}
+}
+
+// JobID returns the ID of the job
+func (j *JobHandle) JobID() string {
+	return j.id
+}
+
+// SetBackoff sets the backoff used by Wait to determine the time to wait between two polls
+func (j *JobHandle) SetBackoff(backoff PollBackoff) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.backoff = backoff
+}
+
+// Poll checks the status of the job once, without waiting for the job to finish. It returns
+// true if the job is finished, either successfully or not. Once the job is finished, it will
+// not poll again. The handle is not locked while polling, so the status of the job can be
+// read while a poll is in progress.
+func (j *JobHandle) Poll(ctx context.Context) (bool, error) {
+	j.mu.Lock()
+	finished := j.last != nil && j.last.Jobstatus != JobStatusPending
+	j.mu.Unlock()
+
+	if finished {
+		return true, nil
+	}
+
+	p := j.cs.Asyncjob.NewQueryAsyncJobResultParams(j.id)
+	r, err := j.cs.Asyncjob.QueryAsyncJobResultWithContext(ctx, p)
+	if err != nil {
+		return false, err
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	// A concurrent poll might already have seen the job finish, which should not be undone
+	if j.last == nil || j.last.Jobstatus == JobStatusPending {
+		j.last = r
+		if r.Jobstatus != JobStatusPending {
+			j.cs.asyncJobFinished(j.command)
+		}
+	}
+	return j.last.Jobstatus != JobStatusPending, nil
+}
+
+// Wait polls the job until it is finished or until the context is done. It returns
+// nil if the job finished successfully and the error of the job if it failed.
+func (j *JobHandle) Wait(ctx context.Context) error {
+	for poll := 0; ; poll++ {
+		if poll > 0 {
+			j.mu.Lock()
+			wait := j.backoff(poll)
+			j.mu.Unlock()
+
+			if err := sleepWithContext(ctx, wait); err != nil {
+				return err
+			}
+		}
+
+		done, err := j.Poll(ctx)
+		if err != nil {
+			return err
+		}
+		if done {
+			_, err := j.RawResult()
+			return err
+		}
+	}
+}
+
+// Status returns the job status reported by the last poll
+func (j *JobHandle) Status() int {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.last == nil {
+		return JobStatusPending
+	}
+	return j.last.Jobstatus
+}
+
+// Progress returns the progress of the job (the `jobprocstatus` field) reported by the last poll
+func (j *JobHandle) Progress() int {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.last == nil {
+		return 0
+	}
+	return j.last.Jobprocstatus
+}
+
+// RawResult returns the raw result of the finished job. If the job is not yet finished
+// ErrJobNotFinished is returned, and if the job failed its error.
+func (j *JobHandle) RawResult() (json.RawMessage, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.last == nil || j.last.Jobstatus == JobStatusPending {
+		return nil, ErrJobNotFinished
+	}
+	if j.last.Jobstatus == JobStatusFailed {
+		return nil, jobError(j.id, j.last)
+	}
+	return j.last.Jobresult, nil
+}
+
+// AsyncJobError is returned when an async job failed. It contains the details of the job
+// and the error reported in the job result. Use errors.As to get the details of the error,
+// or errors.Is with one of the sentinel errors to classify it.
+type AsyncJobError struct {
+	JobID        string
+	Cmd          string // The command class that was executed by the job
+	InstanceType string
+	InstanceID   string
+	ResultCode   int // The `jobresultcode` of the job
+
+	// The error reported in the job result; ErrorCode and CSErrorCode are 0 if the
+	// job result did not contain a structured error
+	ErrorCode   int
+	CSErrorCode int
+	ErrorText   string
+
+	// The raw job result
+	Result json.RawMessage
+}
+
+func (e *AsyncJobError) Error() string {
+	if e.ErrorCode == 0 {
+		return fmt.Sprintf("Async job %s failed: %s", e.JobID, e.ErrorText)
+	}
+	return fmt.Sprintf("Async job %s failed with Cosmic API error %d (CSExceptionErrorCode: %d): %s",
+		e.JobID, e.ErrorCode, e.CSErrorCode, e.ErrorText)
+}
+
+// Is reports whether the error matches the given sentinel error, using the same
+// classification as for errors returned directly by the API
+func (e *AsyncJobError) Is(target error) bool {
+	return (&CSError{ErrorCode: e.ErrorCode, CSErrorCode: e.CSErrorCode, ErrorText: e.ErrorText}).Is(target)
+}
+
+// Returns the error for a failed async job with the given ID
+func jobError(jobid string, r *QueryAsyncJobResultResponse) error {
+	e := &AsyncJobError{
+		JobID:        jobid,
+		Cmd:          r.Cmd,
+		InstanceType: r.Jobinstancetype,
+		InstanceID:   r.Jobinstanceid,
+		ResultCode:   r.Jobresultcode,
+		Result:       r.Jobresult,
+	}
+	if e.JobID == "" {
+		e.JobID = r.Jobid
+	}
+
+	// The job result is usually an object containing the error code and text, but
+	// it can also be a (JSON encoded) string when the result type is text
+	var result struct {
+		ErrorCode   int    `json:"errorcode"`
+		CSErrorCode int    `json:"cserrorcode"`
+		ErrorText   string `json:"errortext"`
+	}
+	var text string
+	switch {
+	case json.Unmarshal(r.Jobresult, &result) == nil && (result.ErrorCode != 0 || result.ErrorText != ""):
+		e.ErrorCode = result.ErrorCode
+		e.CSErrorCode = result.CSErrorCode
+		e.ErrorText = result.ErrorText
+	case json.Unmarshal(r.Jobresult, &text) == nil:
+		e.ErrorText = text
+	case r.Jobresulttype == "text":
+		e.ErrorText = string(r.Jobresult)
+	default:
+		e.ErrorText = fmt.Sprintf("Undefined error: %s", string(r.Jobresult))
+	}
+
+	return e
+}
@@ -0,0 +1,149 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPollBackoff(t *testing.T) {
+	linear := LinearBackoff(time.Second, 3*time.Second)
+	exponential := ExponentialBackoff(time.Second, 5*time.Second)
+
+	cases := []struct {
+		poll        int
+		linear      time.Duration
+		exponential time.Duration
+	}{
+		{1, time.Second, time.Second},
+		{2, 2 * time.Second, 2 * time.Second},
+		{3, 3 * time.Second, 4 * time.Second},
+		{4, 3 * time.Second, 5 * time.Second},
+		{10, 3 * time.Second, 5 * time.Second},
+	}
+
+	for _, c := range cases {
+		if wait := linear(c.poll); wait != c.linear {
+			t.Errorf("Expected linear poll %d to wait %v, got %v", c.poll, c.linear, wait)
+		}
+		if wait := exponential(c.poll); wait != c.exponential {
+			t.Errorf("Expected exponential poll %d to wait %v, got %v", c.poll, c.exponential, wait)
+		}
+	}
+}
+
+func TestJobHandleWait(t *testing.T) {
+	var polls int32
+	cs, s := newStubClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&polls, 1) < 3 {
+			respond(w, http.StatusOK, `{"queryasyncjobresultresponse":{"jobid":"job-1","jobstatus":0,"jobprocstatus":50}}`)
+			return
+		}
+		respond(w, http.StatusOK, `{"queryasyncjobresultresponse":{"jobid":"job-1","jobstatus":1,"jobresult":{"success":true}}}`)
+	})
+	defer s.Close()
+
+	j := cs.NewJobHandle("job-1")
+	if _, err := j.RawResult(); err != ErrJobNotFinished {
+		t.Errorf("Expected %v, got %v", ErrJobNotFinished, err)
+	}
+
+	if done, err := j.Poll(context.Background()); err != nil || done {
+		t.Fatalf("Expected the job to be pending, got %v (%v)", done, err)
+	}
+	if j.Status() != JobStatusPending || j.Progress() != 50 {
+		t.Errorf("Expected a pending job at 50%%, got %d at %d%%", j.Status(), j.Progress())
+	}
+
+	if err := j.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if b, err := j.RawResult(); err != nil || string(b) != `{"success":true}` {
+		t.Errorf("Unexpected result: %s (%v)", b, err)
+	}
+
+	// A finished job is not polled again
+	j.Poll(context.Background())
+	if polls != 3 {
+		t.Errorf("Expected 3 polls, got %d", polls)
+	}
+}
+
+func TestJobHandleFailed(t *testing.T) {
+	cs, s := newStubClient(t, func(w http.ResponseWriter, r *http.Request) {
+		respond(w, http.StatusOK, `{"queryasyncjobresultresponse":{"jobid":"job-1","jobstatus":2,"jobresultcode":530,
+			"jobresult":{"errorcode":431,"errortext":"Unable to find volume"}}}`)
+	})
+	defer s.Close()
+
+	j := cs.NewJobHandle("job-1")
+	err := j.Wait(context.Background())
+
+	var e *AsyncJobError
+	if !errors.As(err, &e) || e.JobID != "job-1" || e.ErrorCode != ErrorCodeParamError || e.ResultCode != 530 {
+		t.Fatalf("Expected the job to fail, got %v", err)
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected the error to be classified as not found, got %v", err)
+	}
+}
+
+func TestJobHandlePollDoesNotLock(t *testing.T) {
+	received := make(chan struct{})
+	release := make(chan struct{})
+	cs, s := newStubClient(t, func(w http.ResponseWriter, r *http.Request) {
+		close(received)
+		<-release
+		respond(w, http.StatusOK, `{"queryasyncjobresultresponse":{"jobid":"job-1","jobstatus":1,"jobresult":{}}}`)
+	})
+	defer s.Close()
+
+	j := cs.NewJobHandle("job-1")
+	polled := make(chan error)
+	go func() {
+		_, err := j.Poll(context.Background())
+		polled <- err
+	}()
+	<-received
+
+	// The status can be read while the poll is in progress
+	status := make(chan int)
+	go func() {
+		status <- j.Status()
+	}()
+	select {
+	case s := <-status:
+		if s != JobStatusPending {
+			t.Errorf("Expected the job to be pending, got %d", s)
+		}
+	case <-time.After(time.Second):
+		t.Error("Expected the status to be available while polling")
+	}
+
+	close(release)
+	if err := <-polled; err != nil {
+		t.Fatal(err)
+	}
+	if j.Status() != JobStatusSuccess {
+		t.Errorf("Expected the job to be finished, got %d", j.Status())
+	}
+}
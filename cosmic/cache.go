@@ -0,0 +1,307 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// ResponseCache caches the responses of read-only commands, keyed by the command and its
+// parameters. Only the commands a TTL is set for are cached. Cached responses of a command
+// are dropped as soon as a mutating command of the same resource family succeeds, so for
+// example a successful createZone or deleteZone invalidates all cached listZones responses.
+//
+// For async commands this happens both when the job is started and when it is finished. A
+// response is never stored when its family was invalidated while the request was in flight.
+//
+// Responses are only served to clients using the same API URL and credentials (API key, or
+// username and domain) as the client that requested them, so a cache can safely be shared by
+// clients of different accounts. Expired responses are dropped whenever a response is stored.
+type ResponseCache struct {
+	mu      sync.Mutex
+	ttls    map[string]time.Duration   // TTL by lowercased command
+	entries map[string]*cacheEntry     // Cached responses by key
+	keys    map[string]map[string]bool // Keys of the cached responses by lowercased command
+	family  map[string]string          // Resource family by lowercased command
+
+	gen         uint64            // Incremented by every invalidation
+	invalidated map[string]uint64 // Generation of the last invalidation by resource family
+	cleared     uint64            // Generation of the last call to InvalidateAll
+}
+
+type cacheEntry struct {
+	resp    json.RawMessage
+	expires time.Time
+}
+
+// NewResponseCache returns a cache that caches the responses of the given commands for
+// their TTL, for example map[string]time.Duration{"listZones": 10 * time.Minute}.
+func NewResponseCache(ttls map[string]time.Duration) *ResponseCache {
+	c := &ResponseCache{
+		ttls:    make(map[string]time.Duration),
+		entries: make(map[string]*cacheEntry),
+		keys:    make(map[string]map[string]bool),
+		family:  make(map[string]string),
+
+		invalidated: make(map[string]uint64),
+	}
+	for command, ttl := range ttls {
+		c.SetTTL(command, ttl)
+	}
+	return c
+}
+
+// WithResponseCache makes the client cache responses using the given cache. The cache is
+// shared by all clones of the client, but a clone using other credentials never gets the
+// cached responses of the client. Passing nil disables caching responses. Cached responses
+// are served after passing the request through all interceptors.
+func WithResponseCache(cache *ResponseCache) ClientOption {
+	return func(cs *CosmicClient) error {
+		cs.cache = cache
+		return nil
+	}
+}
+
+// Returns the scope of cached responses of the client, identifying the API and the account
+func (cs *CosmicClient) cacheScope() string {
+	if cs.session != nil {
+		return cs.baseURL + " user:" + cs.session.domain + "/" + cs.session.username
+	}
+	return cs.baseURL + " apikey:" + cs.apiKey
+}
+
+// Drops the cached responses invalidated by the command of a finished async job
+func (cs *CosmicClient) asyncJobFinished(command string) {
+	if cs.cache != nil && command != "" {
+		cs.cache.mutated(command)
+	}
+}
+
+// Params used to authenticate a request, which are never part of the key of a cached response
+var authParams = []string{"apiKey", "signature", "signatureVersion", "expires", "sessionkey", "command", "response"}
+
+// Returns the key of the cached response of the command with the given params in the scope
+func cacheKey(scope string, command string, params url.Values) string {
+	params = copyValues(params)
+	for _, name := range authParams {
+		params.Del(name)
+	}
+	return scope + " " + command + "?" + params.Encode()
+}
+
+// SetTTL sets the time the responses of the command are cached. A TTL of zero or
+// less disables caching the command and drops its cached responses.
+func (c *ResponseCache) SetTTL(command string, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	lower := strings.ToLower(command)
+	if ttl <= 0 {
+		delete(c.ttls, lower)
+		c.invalidate(lower)
+		c.markInvalidated(resourceFamily(command))
+		return
+	}
+	c.ttls[lower] = ttl
+}
+
+// Invalidate drops all cached responses of the given commands
+func (c *ResponseCache) Invalidate(commands ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, command := range commands {
+		c.invalidate(strings.ToLower(command))
+		c.markInvalidated(resourceFamily(command))
+	}
+}
+
+// InvalidateAll drops all cached responses
+func (c *ResponseCache) InvalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]*cacheEntry)
+	c.keys = make(map[string]map[string]bool)
+	c.family = make(map[string]string)
+	c.gen++
+	c.cleared = c.gen
+}
+
+// Returns a handler serving and storing cached responses in the given scope, calling next for
+// all requests that cannot be served from the cache
+func (c *ResponseCache) handler(scope string, next RequestHandler) RequestHandler {
+	return func(ctx context.Context, command string, params url.Values) (json.RawMessage, error) {
+		return c.serve(ctx, scope, command, params, next)
+	}
+}
+
+func (c *ResponseCache) serve(ctx context.Context, scope string, command string, params url.Values, next RequestHandler) (json.RawMessage, error) {
+	lower := strings.ToLower(command)
+	key := cacheKey(scope, lower, params)
+
+	c.mu.Lock()
+	ttl, cached := c.ttls[lower]
+	if e, ok := c.entries[key]; ok && cached && time.Now().Before(e.expires) {
+		c.mu.Unlock()
+		return e.resp, nil
+	}
+	gen := c.gen
+	c.mu.Unlock()
+
+	resp, err := next(ctx, command, params)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch {
+	case cached && !c.invalidatedSince(resourceFamily(command), gen):
+		c.sweep(time.Now())
+		if c.keys[lower] == nil {
+			c.keys[lower] = make(map[string]bool)
+		}
+		c.keys[lower][key] = true
+		c.family[lower] = resourceFamily(command)
+		c.entries[key] = &cacheEntry{resp: resp, expires: time.Now().Add(ttl)}
+	case !isReadOnlyCommand(lower):
+		c.invalidateFamily(resourceFamily(command))
+	}
+
+	return resp, nil
+}
+
+// Drops all cached responses of the resource family of the command, when the command is not
+// read-only. This is called again when the async job of the command is finished.
+func (c *ResponseCache) mutated(command string) {
+	if isReadOnlyCommand(strings.ToLower(command)) {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.invalidateFamily(resourceFamily(command))
+}
+
+// Drops all expired responses, so responses that are never requested again don't stay around
+// forever. Must be called with c.mu held.
+func (c *ResponseCache) sweep(now time.Time) {
+	for command, keys := range c.keys {
+		for key := range keys {
+			if e, ok := c.entries[key]; !ok || !now.Before(e.expires) {
+				delete(c.entries, key)
+				delete(keys, key)
+			}
+		}
+		if len(keys) == 0 {
+			delete(c.keys, command)
+			delete(c.family, command)
+		}
+	}
+}
+
+// Drops all cached responses of the command. Must be called with c.mu held.
+func (c *ResponseCache) invalidate(command string) {
+	for key := range c.keys[command] {
+		delete(c.entries, key)
+	}
+	delete(c.keys, command)
+	delete(c.family, command)
+}
+
+// Drops all cached responses of commands in the given resource family. Families match if
+// one contains the other, so for example associateIpAddress invalidates listPublicIpAddresses.
+// Must be called with c.mu held.
+func (c *ResponseCache) invalidateFamily(family string) {
+	if family == "" {
+		return
+	}
+	for command := range c.keys {
+		if sameFamily(c.family[command], family) {
+			c.invalidate(command)
+		}
+	}
+	c.markInvalidated(family)
+}
+
+// Records that the resource family is invalidated, so responses of requests that are in flight
+// are not stored. Must be called with c.mu held.
+func (c *ResponseCache) markInvalidated(family string) {
+	if family == "" {
+		return
+	}
+	c.gen++
+	c.invalidated[family] = c.gen
+}
+
+// Returns true if the resource family was invalidated after the given generation. Must be
+// called with c.mu held.
+func (c *ResponseCache) invalidatedSince(family string, gen uint64) bool {
+	if c.cleared > gen {
+		return true
+	}
+	for f, g := range c.invalidated {
+		if g > gen && sameFamily(f, family) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns true if the resource families match, which they do if one contains the other
+func sameFamily(a string, b string) bool {
+	return a != "" && b != "" && (strings.Contains(a, b) || strings.Contains(b, a))
+}
+
+// Returns true if the command does not change anything
+func isReadOnlyCommand(command string) bool {
+	for _, prefix := range []string{"list", "get", "query", "login", "logout"} {
+		if strings.HasPrefix(command, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the (lowercased and singular) resource a command acts on, by stripping the verb
+// of the command, so both listServiceOfferings and createServiceOffering return "serviceoffering"
+func resourceFamily(command string) string {
+	i := strings.IndexFunc(command, unicode.IsUpper)
+	if i < 0 {
+		return ""
+	}
+	family := strings.ToLower(command[i:])
+
+	switch {
+	case strings.HasSuffix(family, "sses"):
+		return strings.TrimSuffix(family, "es")
+	case strings.HasSuffix(family, "ies"):
+		return strings.TrimSuffix(family, "ies") + "y"
+	case strings.HasSuffix(family, "s") && !strings.HasSuffix(family, "ss"):
+		return strings.TrimSuffix(family, "s")
+	}
+	return family
+}
@@ -0,0 +1,243 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// Returns a handler counting the requests per command, rejecting requests that are not
+// signed using the API key and secret of newStubClient
+func countingHandler(counts map[string]int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		command := r.Form.Get("command")
+		counts[command]++
+
+		if r.Form.Get("apiKey") != "stub-api-key" || VerifySignature(r.Form, "stub-secret") != nil {
+			respond(w, http.StatusUnauthorized, `{"errorresponse":{"errorcode":401,"errortext":"unable to verify user credentials"}}`)
+			return
+		}
+		switch command {
+		case "listZones":
+			respond(w, http.StatusOK, `{"listzonesresponse":{"count":1,"zone":[{"id":"zone-id","name":"zone1"}]}}`)
+		case "createZone":
+			respond(w, http.StatusOK, `{"createzoneresponse":{"zone":{"id":"zone-id"}}}`)
+		case "dedicateZone":
+			respond(w, http.StatusOK, `{"dedicatezoneresponse":{"id":"zone-id","jobid":"job-id"}}`)
+		case "queryAsyncJobResult":
+			respond(w, http.StatusOK, `{"queryasyncjobresultresponse":{"jobid":"job-id","jobstatus":1,"jobresult":{"dedicatedzone":{"zoneid":"zone-id"}}}}`)
+		}
+	}
+}
+
+func TestResponseCache(t *testing.T) {
+	counts := map[string]int{}
+	cs, s := newStubClient(t, countingHandler(counts),
+		WithResponseCache(NewResponseCache(map[string]time.Duration{"listZones": time.Minute})))
+	defer s.Close()
+
+	for i := 0; i < 3; i++ {
+		if _, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if counts["listZones"] != 1 {
+		t.Errorf("Expected listZones to be requested once, got %d", counts["listZones"])
+	}
+
+	// Other params are cached separately
+	p := cs.Zone.NewListZonesParams()
+	p.SetName("zone1")
+	if _, err := cs.Zone.ListZones(p); err != nil {
+		t.Fatal(err)
+	}
+	if counts["listZones"] != 2 {
+		t.Errorf("Expected listZones to be requested twice, got %d", counts["listZones"])
+	}
+
+	// A mutating command of the same resource family invalidates the cached responses
+	if _, err := cs.Zone.CreateZone(cs.Zone.NewCreateZoneParams("8.8.8.8", "10.0.0.1", "zone2", "Advanced")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams()); err != nil {
+		t.Fatal(err)
+	}
+	if counts["listZones"] != 3 {
+		t.Errorf("Expected listZones to be requested again after createZone, got %d", counts["listZones"])
+	}
+}
+
+func TestResponseCacheScopedToCredentials(t *testing.T) {
+	counts := map[string]int{}
+	cs, s := newStubClient(t, countingHandler(counts),
+		WithResponseCache(NewResponseCache(map[string]time.Duration{"listZones": time.Minute})))
+	defer s.Close()
+
+	if _, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams()); err != nil {
+		t.Fatal(err)
+	}
+
+	other, err := cs.Clone(WithAPIKey("other", "wrong"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := other.Zone.ListZones(other.Zone.NewListZonesParams()); err == nil {
+		t.Error("Expected a clone using other credentials to not get the cached response")
+	}
+	if counts["listZones"] != 2 {
+		t.Errorf("Expected listZones to be requested by the clone, got %d requests", counts["listZones"])
+	}
+
+	// A clone using the same credentials shares the cached responses
+	same, err := cs.Clone(WithAsync(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := same.Zone.ListZones(same.Zone.NewListZonesParams()); err != nil {
+		t.Fatal(err)
+	}
+	if counts["listZones"] != 2 {
+		t.Errorf("Expected a clone using the same credentials to get the cached response, got %d requests", counts["listZones"])
+	}
+}
+
+func TestCacheKeyIgnoresAuthParams(t *testing.T) {
+	params := url.Values{"name": {"zone1"}}
+	signed := url.Values{
+		"name":             {"zone1"},
+		"apiKey":           {"key"},
+		"signature":        {"signature"},
+		"signatureVersion": {"3"},
+		"expires":          {"2020-01-01T00:00:00+0000"},
+		"sessionkey":       {"session"},
+		"command":          {"listZones"},
+		"response":         {"json"},
+	}
+
+	if cacheKey("scope", "listzones", params) != cacheKey("scope", "listzones", signed) {
+		t.Errorf("Expected the same key, got %q and %q",
+			cacheKey("scope", "listzones", params), cacheKey("scope", "listzones", signed))
+	}
+	if cacheKey("scope", "listzones", params) == cacheKey("other", "listzones", params) {
+		t.Error("Expected another key for another scope")
+	}
+}
+
+func TestResponseCacheDropsExpiredResponses(t *testing.T) {
+	c := NewResponseCache(map[string]time.Duration{"listZones": time.Millisecond, "listPods": time.Minute})
+	h := c.handler("scope", func(ctx context.Context, command string, params url.Values) (json.RawMessage, error) {
+		return json.RawMessage(`{}`), nil
+	})
+
+	for i := 0; i < 10; i++ {
+		h(context.Background(), "listZones", url.Values{"id": {strconv.Itoa(i)}})
+	}
+	time.Sleep(5 * time.Millisecond)
+	h(context.Background(), "listPods", url.Values{})
+
+	if len(c.entries) != 1 || len(c.keys) != 1 {
+		t.Errorf("Expected only the listPods response to be cached, got %d entries", len(c.entries))
+	}
+}
+
+func TestResponseCacheInvalidatedByFinishedJob(t *testing.T) {
+	counts := map[string]int{}
+	cs, s := newStubClient(t, countingHandler(counts),
+		WithResponseCache(NewResponseCache(map[string]time.Duration{"listZones": time.Minute})))
+	defer s.Close()
+
+	listZones := func(want int) {
+		t.Helper()
+		if _, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams()); err != nil {
+			t.Fatal(err)
+		}
+		if counts["listZones"] != want {
+			t.Errorf("Expected listZones to be requested %d times, got %d", want, counts["listZones"])
+		}
+	}
+
+	j, err := cs.Zone.DedicateZoneAsync(context.Background(), cs.Zone.NewDedicateZoneParams("domain-id", "zone-id"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Responses cached while the job is running are dropped when the job is finished
+	listZones(1)
+	listZones(1)
+	if err := j.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	listZones(2)
+
+	// The same goes for jobs waited for by an async client
+	listZones(2)
+	if _, err := cs.waitForAsyncJob(context.Background(), "dedicateZone", "job-id", 10); err != nil {
+		t.Fatal(err)
+	}
+	listZones(3)
+
+	// Jobs of which the command is not known don't invalidate anything
+	if err := cs.NewJobHandle("job-id").Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	listZones(3)
+}
+
+func TestResponseCacheSkipsStaleResponses(t *testing.T) {
+	c := NewResponseCache(map[string]time.Duration{"listZones": time.Minute})
+	requests := 0
+	var h RequestHandler
+	h = c.handler("scope", func(ctx context.Context, command string, params url.Values) (json.RawMessage, error) {
+		if command == "listZones" {
+			requests++
+			// The zones change while the first response is on its way
+			if requests == 1 {
+				h(ctx, "createZone", url.Values{})
+			}
+		}
+		return json.RawMessage(`{}`), nil
+	})
+
+	for i := 0; i < 3; i++ {
+		h(context.Background(), "listZones", url.Values{})
+	}
+	if requests != 2 {
+		t.Errorf("Expected the response requested before createZone to not be cached, got %d requests", requests)
+	}
+
+	// Other resource families are not affected
+	c.SetTTL("listPods", time.Minute)
+	h = c.handler("scope", func(ctx context.Context, command string, params url.Values) (json.RawMessage, error) {
+		if command == "listPods" {
+			requests++
+			h(ctx, "createZone", url.Values{})
+		}
+		return json.RawMessage(`{}`), nil
+	})
+	h(context.Background(), "listPods", url.Values{})
+	h(context.Background(), "listPods", url.Values{})
+	if requests != 3 {
+		t.Errorf("Expected the listPods response to be cached, got %d requests", requests-2)
+	}
+}
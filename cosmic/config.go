@@ -0,0 +1,310 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"bufio"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Environment variables read by ConfigFromEnv and NewFromEnvironment
+const (
+	EnvAPIURL    = "COSMIC_API_URL"
+	EnvAPIKey    = "COSMIC_API_KEY"
+	EnvSecretKey = "COSMIC_SECRET_KEY"
+	EnvUsername  = "COSMIC_USERNAME"
+	EnvPassword  = "COSMIC_PASSWORD"
+	EnvDomain    = "COSMIC_DOMAIN"
+	EnvVerifySSL = "COSMIC_VERIFY_SSL"
+	EnvTimeout   = "COSMIC_TIMEOUT"
+	EnvAsync     = "COSMIC_ASYNC"
+	EnvConfig    = "COSMIC_CONFIG"  // Path of the config file; defaults to ~/.cloudmonkey/config
+	EnvProfile   = "COSMIC_PROFILE" // Profile to use from the config file
+)
+
+// Config contains the settings needed to create a client, as loaded from the environment or a
+// cloudmonkey-style config file. Either APIKey and SecretKey, or Username and Password are required.
+type Config struct {
+	Profile string // Name of the profile the config was loaded from, if any
+
+	URL       string
+	APIKey    string
+	SecretKey string
+	Username  string
+	Password  string
+	Domain    string
+
+	VerifySSL    bool          // Verify the certificate of the API; defaults to true
+	AsyncTimeout time.Duration // Max time to wait for async jobs; when zero the client default is used
+	Async        bool          // Wait for async jobs to finish
+}
+
+// ConfigFromEnv loads the config from the COSMIC_* environment variables
+func ConfigFromEnv() (*Config, error) {
+	c := &Config{
+		URL:       os.Getenv(EnvAPIURL),
+		APIKey:    os.Getenv(EnvAPIKey),
+		SecretKey: os.Getenv(EnvSecretKey),
+		Username:  os.Getenv(EnvUsername),
+		Password:  os.Getenv(EnvPassword),
+		Domain:    os.Getenv(EnvDomain),
+		VerifySSL: true,
+	}
+
+	var err error
+	if v := os.Getenv(EnvVerifySSL); v != "" {
+		if c.VerifySSL, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("Invalid value %q for %s: expected true or false", v, EnvVerifySSL)
+		}
+	}
+	if v := os.Getenv(EnvAsync); v != "" {
+		if c.Async, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("Invalid value %q for %s: expected true or false", v, EnvAsync)
+		}
+	}
+	if v := os.Getenv(EnvTimeout); v != "" {
+		if c.AsyncTimeout, err = parseTimeout(v); err != nil {
+			return nil, fmt.Errorf("Invalid value %q for %s: expected a number of seconds", v, EnvTimeout)
+		}
+	}
+
+	if err := c.validate(fmt.Sprintf("environment variable %s", EnvAPIURL), "environment variables"); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// LoadConfig loads the given profile from a cloudmonkey-style config file. When profile is empty,
+// the profile set in the core section of the file is used. Settings in the core section (like
+// asyncblock, timeout and verifyssl) apply to all profiles, unless a profile overrides them.
+//
+// A config file looks like this:
+//
+//	[core]
+//	profile = production
+//	asyncblock = true
+//
+//	[production]
+//	url = https://cosmic.example.com/client/api
+//	apikey = ...
+//	secretkey = ...
+//	verifyssl = true
+//	timeout = 3600
+func LoadConfig(path string, profile string) (*Config, error) {
+	sections, err := readINIFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	core := sections["core"]
+	if profile == "" {
+		profile = core["profile"]
+	}
+	if profile == "" {
+		return nil, fmt.Errorf("No profile given and no default profile set in the core section of %s", path)
+	}
+
+	s, ok := sections[profile]
+	if !ok {
+		return nil, fmt.Errorf("Profile %q not found in %s", profile, path)
+	}
+
+	// Returns the setting from the profile, or from the core section if the profile doesn't have it
+	get := func(keys ...string) (string, string) {
+		for _, section := range []map[string]string{s, core} {
+			for _, key := range keys {
+				if v, ok := section[key]; ok {
+					return v, key
+				}
+			}
+		}
+		return "", ""
+	}
+
+	c := &Config{
+		Profile:   profile,
+		URL:       s["url"],
+		APIKey:    s["apikey"],
+		SecretKey: s["secretkey"],
+		Username:  s["username"],
+		Password:  s["password"],
+		Domain:    s["domain"],
+		VerifySSL: true,
+	}
+
+	if v, key := get("verifyssl", "verifycert", "verifysslcert"); v != "" {
+		if c.VerifySSL, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("Invalid value %q for %s in profile %q: expected true or false", v, key, profile)
+		}
+	}
+	if v, key := get("asyncblock", "async"); v != "" {
+		if c.Async, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("Invalid value %q for %s in profile %q: expected true or false", v, key, profile)
+		}
+	}
+	if v, key := get("timeout"); v != "" {
+		if c.AsyncTimeout, err = parseTimeout(v); err != nil {
+			return nil, fmt.Errorf("Invalid value %q for %s in profile %q: expected a number of seconds", v, key, profile)
+		}
+	}
+
+	where := fmt.Sprintf("profile %q of %s", profile, path)
+	if err := c.validate("url in "+where, where); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// DefaultConfigPath returns the path of the config file used by NewFromEnvironment when
+// COSMIC_CONFIG is not set, which is the config file of cloudmonkey (~/.cloudmonkey/config)
+func DefaultConfigPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".cloudmonkey", "config"), nil
+}
+
+// NewFromConfig creates a new client using the given config. Additional options
+// are applied after the options derived from the config.
+func NewFromConfig(c *Config, opts ...ClientOption) (*CosmicClient, error) {
+	return New(c.URL, append(c.Options(), opts...)...)
+}
+
+// NewFromEnvironment creates a new client using the COSMIC_* environment variables when
+// COSMIC_API_URL is set. Otherwise the profile named by COSMIC_PROFILE (or the default profile)
+// is loaded from the config file at COSMIC_CONFIG, or from the cloudmonkey config file.
+func NewFromEnvironment(opts ...ClientOption) (*CosmicClient, error) {
+	var c *Config
+	var err error
+
+	if os.Getenv(EnvAPIURL) != "" {
+		c, err = ConfigFromEnv()
+	} else {
+		path := os.Getenv(EnvConfig)
+		if path == "" {
+			if path, err = DefaultConfigPath(); err != nil {
+				return nil, err
+			}
+		}
+		c, err = LoadConfig(path, os.Getenv(EnvProfile))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFromConfig(c, opts...)
+}
+
+// Options returns the client options configuring a client according to the config
+func (c *Config) Options() []ClientOption {
+	var opts []ClientOption
+
+	if !c.VerifySSL {
+		opts = append(opts, WithTLSConfig(&tls.Config{InsecureSkipVerify: true}))
+	}
+	if c.APIKey != "" || c.SecretKey != "" {
+		opts = append(opts, WithAPIKey(c.APIKey, c.SecretKey))
+	} else {
+		opts = append(opts, WithLogin(c.Username, c.Password, c.Domain))
+	}
+	opts = append(opts, WithAsync(c.Async))
+	if c.AsyncTimeout > 0 {
+		opts = append(opts, WithAsyncTimeout(c.AsyncTimeout))
+	}
+
+	return opts
+}
+
+// Checks all required settings are present, using the given descriptions in the errors
+func (c *Config) validate(urlSource string, source string) error {
+	if c.URL == "" {
+		return fmt.Errorf("Missing API URL: set %s", urlSource)
+	}
+
+	switch {
+	case c.APIKey != "" && c.SecretKey == "":
+		return fmt.Errorf("Missing secret key in %s: an API key requires a secret key", source)
+	case c.SecretKey != "" && c.APIKey == "":
+		return fmt.Errorf("Missing API key in %s: a secret key requires an API key", source)
+	case c.APIKey == "" && (c.Username == "" || c.Password == ""):
+		return fmt.Errorf("Missing credentials in %s: set an API key and secret key, or a username and password", source)
+	}
+	return nil
+}
+
+// Parses a timeout given as a number of seconds, or as a duration like "10m"
+func parseTimeout(v string) (time.Duration, error) {
+	if seconds, err := strconv.ParseInt(v, 10, 64); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("Invalid timeout %q", v)
+	}
+	return d, nil
+}
+
+// Reads an INI file into a map of sections containing a map of settings. Keys are lowercased,
+// values are trimmed and both "key = value" and "key: value" are supported.
+func readINIFile(path string) (map[string]map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read config file: %v", err)
+	}
+	defer f.Close()
+
+	sections := make(map[string]map[string]string)
+	var section map[string]string
+
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if sections[name] == nil {
+				sections[name] = make(map[string]string)
+			}
+			section = sections[name]
+			continue
+		}
+
+		i := strings.IndexAny(line, "=:")
+		if i < 0 {
+			return nil, fmt.Errorf("Invalid line %d in %s: expected key = value", n, path)
+		}
+		if section == nil {
+			return nil, fmt.Errorf("Invalid line %d in %s: setting outside of a section", n, path)
+		}
+		section[strings.ToLower(strings.TrimSpace(line[:i]))] = strings.TrimSpace(line[i+1:])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("Unable to read config file: %v", err)
+	}
+
+	return sections, nil
+}
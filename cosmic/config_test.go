@@ -0,0 +1,296 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testConfig = `# Config file used by cloudmonkey
+[core]
+profile = production
+asyncblock = true
+timeout = 600
+
+[production]
+url = https://cosmic.example.com/client/api
+apikey = production-key
+secretkey = production-secret
+
+[staging]
+url: https://staging.example.com/client/api
+username: admin
+password: p@ss=word
+domain: /staging
+; Overrides the settings of the core section
+asyncblock = false
+verifycert = false
+timeout = 10m
+
+[invalid]
+url = https://cosmic.example.com/client/api
+apikey = key
+secretkey = secret
+verifyssl = maybe
+
+[keyonly]
+url = https://cosmic.example.com/client/api
+apikey = key
+
+[cloudmonkey]
+url = https://cloudmonkey.example.com/client/api
+apikey = cloudmonkey-key
+secretkey = cloudmonkey-secret
+verifysslcert = false
+`
+
+// Writes the content to a config file in a new temporary directory, and returns
+// the path of the file and a function removing the directory
+func writeConfig(t *testing.T, content string) (string, func()) {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "cosmic")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "config")
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return path, func() { os.RemoveAll(dir) }
+}
+
+// Sets the environment variables, and returns a function restoring their previous values
+func setEnv(env map[string]string) func() {
+	previous := make(map[string]*string)
+	for _, name := range []string{EnvAPIURL, EnvAPIKey, EnvSecretKey, EnvUsername, EnvPassword, EnvDomain,
+		EnvVerifySSL, EnvTimeout, EnvAsync, EnvConfig, EnvProfile} {
+		if v, ok := os.LookupEnv(name); ok {
+			previous[name] = &v
+		} else {
+			previous[name] = nil
+		}
+		os.Unsetenv(name)
+	}
+	for name, v := range env {
+		os.Setenv(name, v)
+	}
+
+	return func() {
+		for name, v := range previous {
+			if v == nil {
+				os.Unsetenv(name)
+			} else {
+				os.Setenv(name, *v)
+			}
+		}
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	path, remove := writeConfig(t, testConfig)
+	defer remove()
+
+	cases := []struct {
+		profile string
+		want    *Config
+	}{
+		{"", &Config{
+			Profile:      "production",
+			URL:          "https://cosmic.example.com/client/api",
+			APIKey:       "production-key",
+			SecretKey:    "production-secret",
+			VerifySSL:    true,
+			Async:        true,
+			AsyncTimeout: 10 * time.Minute,
+		}},
+		{"staging", &Config{
+			Profile:      "staging",
+			URL:          "https://staging.example.com/client/api",
+			Username:     "admin",
+			Password:     "p@ss=word",
+			Domain:       "/staging",
+			VerifySSL:    false,
+			Async:        false,
+			AsyncTimeout: 10 * time.Minute,
+		}},
+		{"cloudmonkey", &Config{
+			Profile:      "cloudmonkey",
+			URL:          "https://cloudmonkey.example.com/client/api",
+			APIKey:       "cloudmonkey-key",
+			SecretKey:    "cloudmonkey-secret",
+			VerifySSL:    false,
+			Async:        true,
+			AsyncTimeout: 10 * time.Minute,
+		}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.profile, func(t *testing.T) {
+			got, err := LoadConfig(path, c.profile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("Expected %+v, got %+v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestLoadConfigErrors(t *testing.T) {
+	path, remove := writeConfig(t, testConfig)
+	defer remove()
+
+	cases := []struct {
+		name    string
+		content string
+		profile string
+		err     string
+	}{
+		{"unknown profile", testConfig, "missing", `Profile "missing" not found`},
+		{"invalid boolean", testConfig, "invalid", `Invalid value "maybe" for verifyssl in profile "invalid"`},
+		{"missing secret key", testConfig, "keyonly", "Missing secret key"},
+		{"no default profile", "[test]\nurl = https://cosmic.local", "", "No profile given"},
+		{"missing URL", "[test]\napikey = key\nsecretkey = secret", "test", "Missing API URL"},
+		{"missing credentials", "[test]\nurl = https://cosmic.local\nusername = admin", "test", "Missing credentials"},
+		{"invalid timeout", "[test]\nurl = https://cosmic.local\napikey = key\nsecretkey = secret\ntimeout = soon", "test", `Invalid value "soon" for timeout`},
+		{"invalid line", "[test]\nverifyssl", "test", "Invalid line 2"},
+		{"setting outside section", "url = https://cosmic.local", "test", "setting outside of a section"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			p := path
+			if c.content != testConfig {
+				var remove func()
+				p, remove = writeConfig(t, c.content)
+				defer remove()
+			}
+
+			_, err := LoadConfig(p, c.profile)
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Errorf("Expected an error containing %q, got %v", c.err, err)
+			}
+		})
+	}
+
+	if _, err := LoadConfig(filepath.Join(filepath.Dir(path), "missing"), ""); err == nil {
+		t.Error("Expected an error for a missing config file")
+	}
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	defer setEnv(map[string]string{
+		EnvAPIURL:    "https://cosmic.example.com/client/api",
+		EnvUsername:  "admin",
+		EnvPassword:  "password",
+		EnvDomain:    "/",
+		EnvVerifySSL: "false",
+		EnvAsync:     "true",
+		EnvTimeout:   "120",
+	})()
+
+	c, err := ConfigFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Config{
+		URL:          "https://cosmic.example.com/client/api",
+		Username:     "admin",
+		Password:     "password",
+		Domain:       "/",
+		Async:        true,
+		AsyncTimeout: 2 * time.Minute,
+	}
+	if !reflect.DeepEqual(c, want) {
+		t.Errorf("Expected %+v, got %+v", want, c)
+	}
+
+	os.Setenv(EnvAsync, "yes")
+	if _, err := ConfigFromEnv(); err == nil || !strings.Contains(err.Error(), EnvAsync) {
+		t.Errorf("Expected an error for %s, got %v", EnvAsync, err)
+	}
+}
+
+func TestNewFromEnvironment(t *testing.T) {
+	path, remove := writeConfig(t, testConfig)
+	defer remove()
+
+	cases := []struct {
+		name    string
+		env     map[string]string
+		baseURL string
+		session bool
+	}{
+		{"environment", map[string]string{
+			EnvAPIURL:    "https://env.example.com/client/api",
+			EnvAPIKey:    "key",
+			EnvSecretKey: "secret",
+			EnvConfig:    path,
+		}, "https://env.example.com/client/api", false},
+		{"default profile", map[string]string{
+			EnvConfig: path,
+		}, "https://cosmic.example.com/client/api", false},
+		{"profile", map[string]string{
+			EnvConfig:  path,
+			EnvProfile: "staging",
+		}, "https://staging.example.com/client/api", true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			defer setEnv(c.env)()
+
+			cs, err := NewFromEnvironment()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cs.baseURL != c.baseURL || (cs.session != nil) != c.session {
+				t.Errorf("Expected a client for %s (session %v), got %s (session %v)", c.baseURL, c.session, cs.baseURL, cs.session != nil)
+			}
+		})
+	}
+}
+
+func TestNewFromConfig(t *testing.T) {
+	c := &Config{
+		URL:          "https://cosmic.example.com/client/api",
+		APIKey:       "key",
+		SecretKey:    "secret",
+		Async:        true,
+		AsyncTimeout: time.Hour,
+	}
+
+	cs, err := NewFromConfig(c, WithAsync(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cs.apiKey != "key" || cs.secret != "secret" || cs.timeout != 3600 {
+		t.Errorf("Unexpected client: %s, %s, %d", cs.apiKey, cs.secret, cs.timeout)
+	}
+	if cs.async {
+		t.Error("Expected the options passed to NewFromConfig to override the config")
+	}
+}
@@ -0,0 +1,115 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestContextDeadline(t *testing.T) {
+	cs, s := newStubClient(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+		respond(w, http.StatusOK, `{"listzonesresponse":{}}`)
+	})
+	defer s.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := cs.Zone.ListZonesWithContext(ctx, cs.Zone.NewListZonesParams())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected the request to be aborted, took %v", elapsed)
+	}
+}
+
+func TestContextCanceled(t *testing.T) {
+	var calls int32
+	cs, s := newStubClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		respond(w, http.StatusOK, `{"listzonesresponse":{}}`)
+	})
+	defer s.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := cs.Zone.ListZonesWithContext(ctx, cs.Zone.NewListZonesParams()); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected %v, got %v", context.Canceled, err)
+	}
+	if calls != 0 {
+		t.Errorf("Expected no requests, got %d", calls)
+	}
+}
+
+func TestContextStopsWaitingForJob(t *testing.T) {
+	var polls int32
+	cs, s := newStubClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.FormValue("command") {
+		case "deployVirtualMachine":
+			respond(w, http.StatusOK, `{"deployvirtualmachineresponse":{"id":"vm-1","jobid":"job-1"}}`)
+		default:
+			atomic.AddInt32(&polls, 1)
+			respond(w, http.StatusOK, `{"queryasyncjobresultresponse":{"jobid":"job-1","jobstatus":0}}`)
+		}
+	}, WithAsync(true))
+	defer s.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	p := cs.VirtualMachine.NewDeployVirtualMachineParams("offering", "template", "zone")
+	if _, err := cs.VirtualMachine.DeployVirtualMachineWithContext(ctx, p); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	if atomic.LoadInt32(&polls) == 0 {
+		t.Error("Expected the job to be polled until the context was done")
+	}
+}
+
+func TestContextStopsPaging(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var pages int32
+	cs, s := newStubClient(t, func(w http.ResponseWriter, r *http.Request) {
+		// Cancel the context while the second page is requested
+		if atomic.AddInt32(&pages, 1) == 2 {
+			cancel()
+		}
+		respond(w, http.StatusOK, `{"listzonesresponse":{"count":6,"zone":[{"id":"zone-1"},{"id":"zone-2"}]}}`)
+	})
+	defer s.Close()
+
+	if _, err := cs.Zone.ListZonesWithContext(ctx, cs.Zone.NewListZonesParams()); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected %v, got %v", context.Canceled, err)
+	}
+	if pages != 2 {
+		t.Errorf("Expected 2 pages to be requested, got %d", pages)
+	}
+}
@@ -18,6 +18,7 @@ package cosmic
 
 import (
 	"bytes"
+	"context"
 	"crypto/hmac"
 	"crypto/sha1"
 	"crypto/tls"
@@ -47,76 +48,89 @@ func IsID(id string) bool {
 // OptionFunc can be passed to the courtesy helper functions to set additional parameters
 type OptionFunc func(*CosmicClient, interface{}) error
 
-type CSError struct {
-	ErrorCode   int    `json:"errorcode"`
-	CSErrorCode int    `json:"cserrorcode"`
-	ErrorText   string `json:"errortext"`
-}
-
-func (e *CSError) Error() error {
-	return fmt.Errorf("Cosmic API error %d (CSExceptionErrorCode: %d): %s", e.ErrorCode, e.CSErrorCode, e.ErrorText)
-}
-
 type CosmicClient struct {
-	HTTPGETOnly bool // If `true` only use HTTP GET calls
-
-	client  *http.Client // The http client for communicating
-	baseURL string       // The base URL of the API
-	apiKey  string       // Api key
-	secret  string       // Secret key
-	async   bool         // Wait for async calls to finish
-	timeout int64        // Max waiting timeout in seconds for async jobs to finish; defaults to 300 seconds
-
-	Account          *AccountService
-	AffinityGroup    *AffinityGroupService
-	Alert            *AlertService
-	Asyncjob         *AsyncjobService
-	Authentication   *AuthenticationService
-	Certificate      *CertificateService
-	CloudOps         *CloudOpsService
-	Cluster          *ClusterService
-	Configuration    *ConfigurationService
-	DiskOffering     *DiskOfferingService
-	Domain           *DomainService
-	Event            *EventService
-	Firewall         *FirewallService
-	GuestOS          *GuestOSService
-	Host             *HostService
-	Hypervisor       *HypervisorService
-	ISO              *ISOService
-	ImageStore       *ImageStoreService
-	Limit            *LimitService
-	LoadBalancer     *LoadBalancerService
-	NAT              *NATService
-	NetworkACL       *NetworkACLService
-	NetworkDevice    *NetworkDeviceService
-	NetworkOffering  *NetworkOfferingService
-	Network          *NetworkService
-	Nic              *NicService
-	NiciraNVP        *NiciraNVPService
-	Pod              *PodService
-	Project          *ProjectService
-	PublicIPAddress  *PublicIPAddressService
-	Region           *RegionService
-	Resourcemetadata *ResourcemetadataService
-	Resourcetags     *ResourcetagsService
-	Router           *RouterService
-	SSH              *SSHService
-	ServiceOffering  *ServiceOfferingService
-	Snapshot         *SnapshotService
-	StoragePool      *StoragePoolService
-	System           *SystemService
-	SystemVM         *SystemVMService
-	Template         *TemplateService
-	Usage            *UsageService
-	User             *UserService
-	VLAN             *VLANService
-	VMGroup          *VMGroupService
-	VPC              *VPCService
-	VPN              *VPNService
-	VirtualMachine   *VirtualMachineService
-	Volume           *VolumeService
-	Zone             *ZoneService
+	// If `true` only use HTTP GET calls. This is still honored, but changing a client that is in use
+	// is not safe.
+	//
+	// Deprecated: use New with WithHTTPGETOnly instead.
+	HTTPGETOnly bool
+
+	client  *http.Client  // The http client for communicating
+	baseURL string        // The base URL of the API
+	apiKey  string        // Api key
+	secret  string        // Secret key
+	async   bool          // Wait for async calls to finish
+	timeout int64         // Max waiting timeout in seconds for async jobs to finish; defaults to 300 seconds
+	session *session      // Session used to authenticate requests instead of signing them
+	expires time.Duration // Validity of signed requests; when zero signed requests never expire
+	retry   RetryPolicy   // Policy used to retry failed requests; when nil requests are never retried
+
+	userAgent       string         // User agent send with every request; when empty the Go default is used
+	pollInterval    time.Duration  // Interval added to the wait time between two polls of a running async job
+	maxPollInterval time.Duration  // Max wait time between two polls of a running async job
+	endpoints       *endpointPool  // Additional management servers to fail over to; when nil only baseURL is used
+	limiter         RateLimiter    // Limiter used to throttle requests; when nil requests are not throttled
+	interceptors    []Interceptor  // Interceptors every request is passed through
+	pageConcurrency int            // Max number of pages of a list response requested concurrently
+	postCommands    []string       // Commands that are always called using a POST call
+	maxGETLength    int            // Max length of the URL of a GET call; when zero the length is not limited
+	metrics         Metrics        // Collector of metrics about requests; when nil no metrics are collected
+	cache           *ResponseCache // Cache of responses of read-only commands; when nil responses are not cached
+	logger          Logger         // Logger of requests; when nil requests are not logged
+	logLevel        LogLevel       // Level used to log requests
+	logBodySize     int            // Max size of a logged response body; when zero bodies are not truncated
+	getOnly         bool           // If true only use HTTP GET calls, except to login
+
+	Account          AccountServiceIface
+	AffinityGroup    AffinityGroupServiceIface
+	Alert            AlertServiceIface
+	Asyncjob         AsyncjobServiceIface
+	Authentication   AuthenticationServiceIface
+	Certificate      CertificateServiceIface
+	CloudOps         CloudOpsServiceIface
+	Cluster          ClusterServiceIface
+	Configuration    ConfigurationServiceIface
+	DiskOffering     DiskOfferingServiceIface
+	Domain           DomainServiceIface
+	Event            EventServiceIface
+	Firewall         FirewallServiceIface
+	GuestOS          GuestOSServiceIface
+	Host             HostServiceIface
+	Hypervisor       HypervisorServiceIface
+	ISO              ISOServiceIface
+	ImageStore       ImageStoreServiceIface
+	Limit            LimitServiceIface
+	LoadBalancer     LoadBalancerServiceIface
+	NAT              NATServiceIface
+	NetworkACL       NetworkACLServiceIface
+	NetworkDevice    NetworkDeviceServiceIface
+	NetworkOffering  NetworkOfferingServiceIface
+	Network          NetworkServiceIface
+	Nic              NicServiceIface
+	NiciraNVP        NiciraNVPServiceIface
+	Pod              PodServiceIface
+	Project          ProjectServiceIface
+	PublicIPAddress  PublicIPAddressServiceIface
+	Region           RegionServiceIface
+	Resourcemetadata ResourcemetadataServiceIface
+	Resourcetags     ResourcetagsServiceIface
+	Router           RouterServiceIface
+	SSH              SSHServiceIface
+	ServiceOffering  ServiceOfferingServiceIface
+	Snapshot         SnapshotServiceIface
+	StoragePool      StoragePoolServiceIface
+	System           SystemServiceIface
+	SystemVM         SystemVMServiceIface
+	Template         TemplateServiceIface
+	Usage            UsageServiceIface
+	User             UserServiceIface
+	VLAN             VLANServiceIface
+	VMGroup          VMGroupServiceIface
+	VPC              VPCServiceIface
+	VPN              VPNServiceIface
+	VirtualMachine   VirtualMachineServiceIface
+	Volume           VolumeServiceIface
+	Zone             ZoneServiceIface
 }
 
 // Creates a new client for communicating with Cosmic
@@ -134,7 +148,22 @@ func newClient(apiurl string, apikey string, secret string, async bool, tlsConfi
 		secret:  secret,
 		async:   async,
 		timeout: 300,
+		retry:   DefaultRetryPolicy(),
+
+		pollInterval:    time.Second,
+		maxPollInterval: 15 * time.Second,
+
+		// The userdata of virtual machines can be large, so always use POST calls for those
+		postCommands: []string{"deployVirtualMachine", "updateVirtualMachine"},
+		maxGETLength: DefaultMaxGETLength,
+		logBodySize:  DefaultLogBodySize,
 	}
+	cs.initServices()
+	return cs
+}
+
+// Creates all services using the client
+func (cs *CosmicClient) initServices() {
 	cs.Account = NewAccountService(cs)
 	cs.AffinityGroup = NewAffinityGroupService(cs)
 	cs.Alert = NewAlertService(cs)
@@ -185,7 +214,161 @@ func newClient(apiurl string, apikey string, secret string, async bool, tlsConfi
 	cs.VirtualMachine = NewVirtualMachineService(cs)
 	cs.Volume = NewVolumeService(cs)
 	cs.Zone = NewZoneService(cs)
-	return cs
+}
+
+// Points the services of the client that still use the client it was copied from at the client,
+// keeping all other (for example mocked) services
+func (cs *CosmicClient) cloneServices(from *CosmicClient) {
+	if s, ok := cs.Account.(*AccountService); ok && s.cs == from {
+		cs.Account = NewAccountService(cs)
+	}
+	if s, ok := cs.AffinityGroup.(*AffinityGroupService); ok && s.cs == from {
+		cs.AffinityGroup = NewAffinityGroupService(cs)
+	}
+	if s, ok := cs.Alert.(*AlertService); ok && s.cs == from {
+		cs.Alert = NewAlertService(cs)
+	}
+	if s, ok := cs.Asyncjob.(*AsyncjobService); ok && s.cs == from {
+		cs.Asyncjob = NewAsyncjobService(cs)
+	}
+	if s, ok := cs.Authentication.(*AuthenticationService); ok && s.cs == from {
+		cs.Authentication = NewAuthenticationService(cs)
+	}
+	if s, ok := cs.Certificate.(*CertificateService); ok && s.cs == from {
+		cs.Certificate = NewCertificateService(cs)
+	}
+	if s, ok := cs.CloudOps.(*CloudOpsService); ok && s.cs == from {
+		cs.CloudOps = NewCloudOpsService(cs)
+	}
+	if s, ok := cs.Cluster.(*ClusterService); ok && s.cs == from {
+		cs.Cluster = NewClusterService(cs)
+	}
+	if s, ok := cs.Configuration.(*ConfigurationService); ok && s.cs == from {
+		cs.Configuration = NewConfigurationService(cs)
+	}
+	if s, ok := cs.DiskOffering.(*DiskOfferingService); ok && s.cs == from {
+		cs.DiskOffering = NewDiskOfferingService(cs)
+	}
+	if s, ok := cs.Domain.(*DomainService); ok && s.cs == from {
+		cs.Domain = NewDomainService(cs)
+	}
+	if s, ok := cs.Event.(*EventService); ok && s.cs == from {
+		cs.Event = NewEventService(cs)
+	}
+	if s, ok := cs.Firewall.(*FirewallService); ok && s.cs == from {
+		cs.Firewall = NewFirewallService(cs)
+	}
+	if s, ok := cs.GuestOS.(*GuestOSService); ok && s.cs == from {
+		cs.GuestOS = NewGuestOSService(cs)
+	}
+	if s, ok := cs.Host.(*HostService); ok && s.cs == from {
+		cs.Host = NewHostService(cs)
+	}
+	if s, ok := cs.Hypervisor.(*HypervisorService); ok && s.cs == from {
+		cs.Hypervisor = NewHypervisorService(cs)
+	}
+	if s, ok := cs.ISO.(*ISOService); ok && s.cs == from {
+		cs.ISO = NewISOService(cs)
+	}
+	if s, ok := cs.ImageStore.(*ImageStoreService); ok && s.cs == from {
+		cs.ImageStore = NewImageStoreService(cs)
+	}
+	if s, ok := cs.Limit.(*LimitService); ok && s.cs == from {
+		cs.Limit = NewLimitService(cs)
+	}
+	if s, ok := cs.LoadBalancer.(*LoadBalancerService); ok && s.cs == from {
+		cs.LoadBalancer = NewLoadBalancerService(cs)
+	}
+	if s, ok := cs.NAT.(*NATService); ok && s.cs == from {
+		cs.NAT = NewNATService(cs)
+	}
+	if s, ok := cs.NetworkACL.(*NetworkACLService); ok && s.cs == from {
+		cs.NetworkACL = NewNetworkACLService(cs)
+	}
+	if s, ok := cs.NetworkDevice.(*NetworkDeviceService); ok && s.cs == from {
+		cs.NetworkDevice = NewNetworkDeviceService(cs)
+	}
+	if s, ok := cs.NetworkOffering.(*NetworkOfferingService); ok && s.cs == from {
+		cs.NetworkOffering = NewNetworkOfferingService(cs)
+	}
+	if s, ok := cs.Network.(*NetworkService); ok && s.cs == from {
+		cs.Network = NewNetworkService(cs)
+	}
+	if s, ok := cs.Nic.(*NicService); ok && s.cs == from {
+		cs.Nic = NewNicService(cs)
+	}
+	if s, ok := cs.NiciraNVP.(*NiciraNVPService); ok && s.cs == from {
+		cs.NiciraNVP = NewNiciraNVPService(cs)
+	}
+	if s, ok := cs.Pod.(*PodService); ok && s.cs == from {
+		cs.Pod = NewPodService(cs)
+	}
+	if s, ok := cs.Project.(*ProjectService); ok && s.cs == from {
+		cs.Project = NewProjectService(cs)
+	}
+	if s, ok := cs.PublicIPAddress.(*PublicIPAddressService); ok && s.cs == from {
+		cs.PublicIPAddress = NewPublicIPAddressService(cs)
+	}
+	if s, ok := cs.Region.(*RegionService); ok && s.cs == from {
+		cs.Region = NewRegionService(cs)
+	}
+	if s, ok := cs.Resourcemetadata.(*ResourcemetadataService); ok && s.cs == from {
+		cs.Resourcemetadata = NewResourcemetadataService(cs)
+	}
+	if s, ok := cs.Resourcetags.(*ResourcetagsService); ok && s.cs == from {
+		cs.Resourcetags = NewResourcetagsService(cs)
+	}
+	if s, ok := cs.Router.(*RouterService); ok && s.cs == from {
+		cs.Router = NewRouterService(cs)
+	}
+	if s, ok := cs.SSH.(*SSHService); ok && s.cs == from {
+		cs.SSH = NewSSHService(cs)
+	}
+	if s, ok := cs.ServiceOffering.(*ServiceOfferingService); ok && s.cs == from {
+		cs.ServiceOffering = NewServiceOfferingService(cs)
+	}
+	if s, ok := cs.Snapshot.(*SnapshotService); ok && s.cs == from {
+		cs.Snapshot = NewSnapshotService(cs)
+	}
+	if s, ok := cs.StoragePool.(*StoragePoolService); ok && s.cs == from {
+		cs.StoragePool = NewStoragePoolService(cs)
+	}
+	if s, ok := cs.System.(*SystemService); ok && s.cs == from {
+		cs.System = NewSystemService(cs)
+	}
+	if s, ok := cs.SystemVM.(*SystemVMService); ok && s.cs == from {
+		cs.SystemVM = NewSystemVMService(cs)
+	}
+	if s, ok := cs.Template.(*TemplateService); ok && s.cs == from {
+		cs.Template = NewTemplateService(cs)
+	}
+	if s, ok := cs.Usage.(*UsageService); ok && s.cs == from {
+		cs.Usage = NewUsageService(cs)
+	}
+	if s, ok := cs.User.(*UserService); ok && s.cs == from {
+		cs.User = NewUserService(cs)
+	}
+	if s, ok := cs.VLAN.(*VLANService); ok && s.cs == from {
+		cs.VLAN = NewVLANService(cs)
+	}
+	if s, ok := cs.VMGroup.(*VMGroupService); ok && s.cs == from {
+		cs.VMGroup = NewVMGroupService(cs)
+	}
+	if s, ok := cs.VPC.(*VPCService); ok && s.cs == from {
+		cs.VPC = NewVPCService(cs)
+	}
+	if s, ok := cs.VPN.(*VPNService); ok && s.cs == from {
+		cs.VPN = NewVPNService(cs)
+	}
+	if s, ok := cs.VirtualMachine.(*VirtualMachineService); ok && s.cs == from {
+		cs.VirtualMachine = NewVirtualMachineService(cs)
+	}
+	if s, ok := cs.Volume.(*VolumeService); ok && s.cs == from {
+		cs.Volume = NewVolumeService(cs)
+	}
+	if s, ok := cs.Zone.(*ZoneService); ok && s.cs == from {
+		cs.Zone = NewZoneService(cs)
+	}
 }
 
 // Default non-async client. So for async calls you need to implement and check the async job result yourself. When using
@@ -207,6 +390,8 @@ func NewAsyncClient(apiurl string, apikey string, secret string, tlsConfig *tls.
 
 // When using the async client an api call will wait for the async call to finish before returning. The default is to poll for 300 seconds
 // seconds, to check if the async job is finished.
+//
+// Deprecated: use New with WithAsyncTimeout instead, as changing a client that is in use is not safe.
 func (cs *CosmicClient) AsyncTimeout(timeoutInSeconds int64) {
 	cs.timeout = timeoutInSeconds
 }
@@ -216,107 +401,305 @@ var AsyncTimeoutErr = errors.New("Timeout while waiting for async job to finish"
 // A helper function that you can use to get the result of a running async job. If the job is not finished within the configured
 // timeout, the async job returns a AsyncTimeoutErr.
 func (cs *CosmicClient) GetAsyncJobResult(jobid string, timeout int64) (json.RawMessage, error) {
+	return cs.GetAsyncJobResultWithContext(context.Background(), jobid, timeout)
+}
+
+// GetAsyncJobResultWithContext is the same as GetAsyncJobResult, but it stops waiting and returns the error of
+// the context when the passed context is cancelled or its deadline is exceeded before the job is finished.
+func (cs *CosmicClient) GetAsyncJobResultWithContext(ctx context.Context, jobid string, timeout int64) (json.RawMessage, error) {
+	return cs.waitForAsyncJob(ctx, "", jobid, timeout)
+}
+
+// Waits for the async job started by the given command to finish. The command is only used to
+// report metrics, and when empty the command class reported by the job is used instead.
+func (cs *CosmicClient) waitForAsyncJob(ctx context.Context, command string, jobid string, timeout int64) (json.RawMessage, error) {
 	var timer time.Duration
-	currentTime := time.Now().Unix()
+	start := time.Now()
+	currentTime := start.Unix()
 
 	for {
 		p := cs.Asyncjob.NewQueryAsyncJobResultParams(jobid)
-		r, err := cs.Asyncjob.QueryAsyncJobResult(p)
+		r, err := cs.Asyncjob.QueryAsyncJobResultWithContext(ctx, p)
 		if err != nil {
 			return nil, err
 		}
 
 		// Status 1 means the job is finished successfully
 		if r.Jobstatus == 1 {
+			cs.asyncJobFinished(command)
+			cs.observeAsyncJob(command, r, time.Since(start))
 			return r.Jobresult, nil
 		}
 
 		// When the status is 2, the job has failed
 		if r.Jobstatus == 2 {
-			if r.Jobresulttype == "text" {
-				return nil, fmt.Errorf(string(r.Jobresult))
-			} else {
-				return nil, fmt.Errorf("Undefined error: %s", string(r.Jobresult))
-			}
+			cs.asyncJobFinished(command)
+			cs.observeAsyncJob(command, r, time.Since(start))
+			return nil, jobError(jobid, r)
 		}
 
 		if time.Now().Unix()-currentTime > timeout {
+			cs.observeAsyncJob(command, r, time.Since(start))
 			return nil, AsyncTimeoutErr
 		}
 
 		// Add an (extremely simple) exponential backoff like feature to prevent
 		// flooding the Cosmic API
-		if timer < 15 {
-			timer++
+		if timer < cs.maxPollInterval {
+			timer += cs.pollInterval
+			if timer > cs.maxPollInterval {
+				timer = cs.maxPollInterval
+			}
 		}
 
-		time.Sleep(timer * time.Second)
+		if err := sleepWithContext(ctx, timer); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Sleeps for the given duration, or returns the error of the context if it is done before that
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
 	}
 }
 
 // Execute the request against a CS API. Will return the raw JSON data returned by the API and nil if
 // no error occured. If the API returns an error the result will be nil and the HTTP error code and CS
 // error details. If a processing (code) error occurs the result will be nil and the generated error
-func (cs *CosmicClient) newRequest(api string, params url.Values) (json.RawMessage, error) {
-	params.Set("apiKey", cs.apiKey)
+func (cs *CosmicClient) newRequest(ctx context.Context, api string, params url.Values) (json.RawMessage, error) {
+	start := time.Now()
+
+	// Serve cached responses when configured, using a cache scoped to the credentials of this client
+	handler := cs.executeRequest
+	if cs.cache != nil {
+		handler = cs.cache.handler(cs.cacheScope(), handler)
+	}
+
+	// Pass the request through all configured interceptors before executing it
+	b, err := chainInterceptors(cs.interceptors, handler)(ctx, api, params)
+	cs.observeRequest(api, time.Since(start), err)
+
+	return b, err
+}
+
+// Executes the request, retrying failed attempts according to the configured retry policy
+func (cs *CosmicClient) executeRequest(ctx context.Context, api string, params url.Values) (json.RawMessage, error) {
+	// Never change the params of the caller, which can be reused for following requests
+	params = copyValues(params)
 	params.Set("command", api)
 	params.Set("response", "json")
 
-	// Generate signature for API call
-	// * Serialize parameters, URL encoding only values and sort them by key, done by encodeValues
-	// * Convert the entire argument string to lowercase
-	// * Replace all instances of '+' to '%20'
-	// * Calculate HMAC SHA1 of argument string with Cosmic secret
-	// * URL encode the string and convert to base64
+	for attempt := 1; ; attempt++ {
+		// Wait until the rate limiter allows sending another request
+		if cs.limiter != nil {
+			if err := cs.limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		b, err := cs.doRequest(ctx, api, params)
+		if err != nil && cs.limiter != nil {
+			// Let the rate limiter know if the API limit was exceeded, so it can back off
+			notifyLimitExceeded(cs.limiter, err)
+		}
+
+		if err == nil || cs.retry == nil || ctx.Err() != nil {
+			return b, err
+		}
+
+		// Ask the retry policy if (and when) this failed attempt should be retried
+		wait, retry := cs.retry.Retry(api, attempt, err)
+		if !retry {
+			if attempt > 1 {
+				return nil, &RetryError{Command: api, Attempts: attempt, Err: err}
+			}
+			return nil, err
+		}
+
+		if err := sleepWithContext(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Authenticates and executes a single attempt of the request
+func (cs *CosmicClient) doRequest(ctx context.Context, api string, params url.Values) (json.RawMessage, error) {
+	// Every attempt authenticates its own copy, so credentials never end up in the params of a retry
+	params = copyValues(params)
+
+	// A session based client authenticates using the session key and cookie
+	// returned by the login command, so there is no need to sign the request
+	if cs.session != nil {
+		return cs.session.newRequest(ctx, cs, api, params)
+	}
+
+	cs.signParams(params)
+
+	return cs.sendRequest(ctx, api, params)
+}
+
+// Adds the API key and the signature to the params
+func (cs *CosmicClient) signParams(params url.Values) {
+	params.Set("apiKey", cs.apiKey)
+
+	// Let the request expire after the configured validity, so it cannot be replayed
+	if cs.expires > 0 {
+		params.Set("signatureVersion", "3")
+		params.Set("expires", time.Now().Add(cs.expires).UTC().Format("2006-01-02T15:04:05-0700"))
+	}
+
+	// Make sure a signature of a previous attempt is not signed as well
+	params.Del("signature")
+
+	// Add the unescaped signature to the params
+	params.Set("signature", signValues(params, cs.secret))
+}
+
+// Generate signature for API call
+// * Serialize parameters, URL encoding only values and sort them by key, done by encodeValues
+// * Convert the entire argument string to lowercase
+// * Replace all instances of '+' to '%20'
+// * Calculate HMAC SHA1 of argument string with Cosmic secret
+// * URL encode the string and convert to base64
+func signValues(params url.Values, secret string) string {
 	s := encodeValues(params)
 	s2 := strings.ToLower(s)
 	s3 := strings.Replace(s2, "+", "%20", -1)
-	mac := hmac.New(sha1.New, []byte(cs.secret))
+	mac := hmac.New(sha1.New, []byte(secret))
 	mac.Write([]byte(s3))
-	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
 
-	var err error
-	var resp *http.Response
-	if !cs.HTTPGETOnly && (api == "deployVirtualMachine" || api == "updateVirtualMachine") {
-		// The deployVirtualMachine API should be called using a POST call
-		// so we don't have to worry about the userdata size
+// Returns true if the API should be called using a POST call
+func (cs *CosmicClient) usePOST(api string, params url.Values) bool {
+	if api == "login" {
+		// The login API should always be called using a POST call
+		// so the password doesn't end up in any (proxy) logs
+		return true
+	}
+	if cs.getOnly || cs.HTTPGETOnly {
+		return false
+	}
 
-		// Add the unescaped signature to the POST params
-		params.Set("signature", signature)
+	for _, command := range cs.postCommands {
+		if strings.EqualFold(command, api) {
+			return true
+		}
+	}
+
+	// Use a POST call when the URL of a GET call would be too long for some proxies or servers
+	return cs.maxGETLength > 0 && len(cs.baseURL)+1+len(encodeValues(params)) > cs.maxGETLength
+}
+
+// Sends the already authenticated request to one of the endpoints of the client, failing over to
+// another endpoint if the current one is unavailable and the request was not received by it.
+func (cs *CosmicClient) sendRequest(ctx context.Context, api string, params url.Values) (json.RawMessage, error) {
+	for attempt := 1; ; attempt++ {
+		baseURL, failover := cs.endpoint(ctx)
+
+		resp, err := cs.sendRequestTo(ctx, baseURL, api, params)
+		if !failover {
+			return resp, err
+		}
+
+		unavailable, notSent := isEndpointError(err)
+		switch {
+		case err == nil:
+			cs.endpoints.update(baseURL, nil)
+		case unavailable:
+			cs.endpoints.update(baseURL, err)
+		}
+
+		if !notSent || attempt >= len(cs.endpoints.endpoints) {
+			return resp, err
+		}
+	}
+}
 
+// Sends the already authenticated request to the API at baseURL and returns the raw JSON data returned
+// by the API. If the API returns an error the result will be nil and the error will be a *CSError
+// containing the HTTP status code and the CS error details.
+func (cs *CosmicClient) sendRequestTo(ctx context.Context, baseURL string, api string, params url.Values) (result json.RawMessage, err error) {
+	var req *http.Request
+	if cs.usePOST(api, params) {
 		// Make a POST call
-		resp, err = cs.client.PostForm(cs.baseURL, params)
+		req, err = http.NewRequest("POST", baseURL, strings.NewReader(params.Encode()))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	} else {
-		// Create the final URL before we issue the request
-		url := cs.baseURL + "?" + s + "&signature=" + url.QueryEscape(signature)
-
 		// Make a GET call
-		resp, err = cs.client.Get(url)
+		req, err = http.NewRequest("GET", baseURL+"?"+encodeValues(params), nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if cs.userAgent != "" {
+		req.Header.Set("User-Agent", cs.userAgent)
 	}
+
+	// Add the JSESSIONID cookie when using session based authentication
+	if cs.session != nil {
+		cs.session.addCookies(req)
+	}
+
+	// Log the request when finished, including the status and body of the response if received
+	start := time.Now()
+	status, body := 0, []byte(nil)
+	defer func() {
+		cs.logRequest(api, params, req.Method, status, time.Since(start), body, err)
+	}()
+
+	resp, err := cs.client.Do(req.WithContext(ctx))
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
+	status = resp.StatusCode
 
-	b, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
+	if cs.session != nil {
+		cs.session.saveCookies(req, resp)
 	}
 
-	// Need to get the raw value to make the result play nice
-	b, err = getRawValue(b)
+	b, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		return nil, err
 	}
+	body = b
 
 	if resp.StatusCode != 200 {
-		var e CSError
-		if err := json.Unmarshal(b, &e); err != nil {
-			return nil, err
+		e := &CSError{HTTPStatus: resp.StatusCode, Command: api}
+
+		// Not every error (e.g. one returned by a proxy) will contain the CS error details
+		raw, err := getErrorValue(b, api)
+		if err != nil || json.Unmarshal(raw, e) != nil {
+			e.ErrorCode = resp.StatusCode
+			e.ErrorText = strings.TrimSpace(string(b))
 		}
-		return nil, e.Error()
+		return nil, e
 	}
-	return b, nil
+
+	// Strip the response envelope to make the result play nice
+	return getRawValue(b, responseKey(api))
+}
+
+// Returns a deep copy of the values
+func copyValues(v url.Values) url.Values {
+	c := make(url.Values, len(v))
+	for k, vs := range v {
+		c[k] = append([]string(nil), vs...)
+	}
+	return c
 }
 
 // Custom version of net/url Encode that only URL escapes values
@@ -345,16 +728,83 @@ func encodeValues(v url.Values) string {
 	return buf.String()
 }
 
-// Generic function to get the first raw value from a response as json.RawMessage
-func getRawValue(b json.RawMessage) (json.RawMessage, error) {
-	var m map[string]json.RawMessage
-	if err := json.Unmarshal(b, &m); err != nil {
+// Returns the value of the given key from a JSON object as json.RawMessage. See unmarshalValue for
+// how the value is found.
+func getRawValue(b json.RawMessage, key string) (json.RawMessage, error) {
+	var raw json.RawMessage
+	if err := unmarshalValue(b, key, &raw); err != nil {
 		return nil, err
 	}
-	for _, v := range m {
-		return v, nil
+	return raw, nil
+}
+
+// Decodes the value of the given key from a JSON object into v. An error is returned when the
+// object does not contain the key.
+func unmarshalValue(b json.RawMessage, key string, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	if t, err := dec.Token(); err != nil || t != json.Delim('{') {
+		return fmt.Errorf("Unable to extract the raw value from:\n\n%s\n\n", string(b))
+	}
+
+	var keys []string
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		k, _ := t.(string)
+		if k == key {
+			return dec.Decode(v)
+		}
+		keys = append(keys, k)
+
+		var skip json.RawMessage
+		if err := dec.Decode(&skip); err != nil {
+			return err
+		}
+	}
+
+	return fmt.Errorf("Expected a response containing key %q, got keys %v", key, keys)
+}
+
+// Returns the error details from the body of an error response. Errors are usually returned in the
+// envelope of the command, but errors returned before the command is known use another envelope.
+func getErrorValue(b json.RawMessage, api string) (json.RawMessage, error) {
+	if raw, err := getRawValue(b, responseKey(api)); err == nil {
+		return raw, nil
+	}
+	return getRawValue(b, "errorresponse")
+}
+
+// Returns the key of the envelope the response of the command is wrapped in
+func responseKey(api string) string {
+	if key, ok := responseKeys[api]; ok {
+		return key
 	}
-	return nil, fmt.Errorf("Unable to extract the raw value from:\n\n%s\n\n", string(b))
+	return strings.ToLower(api) + "response"
+}
+
+// The keys of the envelopes of commands of which the envelope is not named after the command
+var responseKeys = map[string]string{
+	"updateVMAffinityGroup":        "updatevirtualmachineresponse",
+	"ldapCreateAccount":            "createaccountresponse",
+	"addLdapConfiguration":         "ldapconfigurationresponse",
+	"deleteLdapConfiguration":      "ldapconfigurationresponse",
+	"listLdapConfigurations":       "ldapconfigurationresponse",
+	"importLdapUsers":              "ldapuserresponse",
+	"listLdapUsers":                "ldapuserresponse",
+	"listNetworkDevice":            "listnetworkdevice",
+	"listNiciraNvpDeviceNetworks":  "listniciranvpdevicenetworks",
+	"addIpToNic":                   "addiptovmnicresponse",
+	"listNiciraNvpDevices":         "listniciranvpdeviceresponse",
+	"activateProject":              "activaterojectresponse",
+	"resetSSHKeyForVirtualMachine": "resetSSHKeyforvirtualmachineresponse",
+	"cancelStorageMaintenance":     "cancelprimarystoragemaintenanceresponse",
+	"enableStorageMaintenance":     "prepareprimarystorageformaintenanceresponse",
+	"scaleSystemVm":                "changeserviceforsystemvmresponse",
+	"getUploadParamsForTemplate":   "postuploadtemplateresponse",
+	"restoreVirtualMachine":        "restorevmresponse",
+	"getUploadParamsForVolume":     "postuploadvolumeresponse",
 }
 
 // ProjectIDSetter is an interface that every type that can set a project ID must implement
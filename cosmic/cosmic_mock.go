@@ -0,0 +1,183 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+// MockClient contains the mocks of all services of a client returned by NewMockClient
+type MockClient struct {
+	Account          *MockAccountService
+	AffinityGroup    *MockAffinityGroupService
+	Alert            *MockAlertService
+	Asyncjob         *MockAsyncjobService
+	Authentication   *MockAuthenticationService
+	Certificate      *MockCertificateService
+	CloudOps         *MockCloudOpsService
+	Cluster          *MockClusterService
+	Configuration    *MockConfigurationService
+	DiskOffering     *MockDiskOfferingService
+	Domain           *MockDomainService
+	Event            *MockEventService
+	Firewall         *MockFirewallService
+	GuestOS          *MockGuestOSService
+	Host             *MockHostService
+	Hypervisor       *MockHypervisorService
+	ISO              *MockISOService
+	ImageStore       *MockImageStoreService
+	Limit            *MockLimitService
+	LoadBalancer     *MockLoadBalancerService
+	NAT              *MockNATService
+	NetworkACL       *MockNetworkACLService
+	NetworkDevice    *MockNetworkDeviceService
+	NetworkOffering  *MockNetworkOfferingService
+	Network          *MockNetworkService
+	Nic              *MockNicService
+	NiciraNVP        *MockNiciraNVPService
+	Pod              *MockPodService
+	Project          *MockProjectService
+	PublicIPAddress  *MockPublicIPAddressService
+	Region           *MockRegionService
+	Resourcemetadata *MockResourcemetadataService
+	Resourcetags     *MockResourcetagsService
+	Router           *MockRouterService
+	SSH              *MockSSHService
+	ServiceOffering  *MockServiceOfferingService
+	Snapshot         *MockSnapshotService
+	StoragePool      *MockStoragePoolService
+	System           *MockSystemService
+	SystemVM         *MockSystemVMService
+	Template         *MockTemplateService
+	Usage            *MockUsageService
+	User             *MockUserService
+	VLAN             *MockVLANService
+	VMGroup          *MockVMGroupService
+	VPC              *MockVPCService
+	VPN              *MockVPNService
+	VirtualMachine   *MockVirtualMachineService
+	Volume           *MockVolumeService
+	Zone             *MockZoneService
+}
+
+// NewMockClient returns a client of which all services are mocks, together with the mocks
+// so they can be programmed. Helpers using the services (like waiting for async jobs) use
+// the mocks as well.
+func NewMockClient() (*CosmicClient, *MockClient) {
+	m := &MockClient{
+		Account:          &MockAccountService{},
+		AffinityGroup:    &MockAffinityGroupService{},
+		Alert:            &MockAlertService{},
+		Asyncjob:         &MockAsyncjobService{},
+		Authentication:   &MockAuthenticationService{},
+		Certificate:      &MockCertificateService{},
+		CloudOps:         &MockCloudOpsService{},
+		Cluster:          &MockClusterService{},
+		Configuration:    &MockConfigurationService{},
+		DiskOffering:     &MockDiskOfferingService{},
+		Domain:           &MockDomainService{},
+		Event:            &MockEventService{},
+		Firewall:         &MockFirewallService{},
+		GuestOS:          &MockGuestOSService{},
+		Host:             &MockHostService{},
+		Hypervisor:       &MockHypervisorService{},
+		ISO:              &MockISOService{},
+		ImageStore:       &MockImageStoreService{},
+		Limit:            &MockLimitService{},
+		LoadBalancer:     &MockLoadBalancerService{},
+		NAT:              &MockNATService{},
+		NetworkACL:       &MockNetworkACLService{},
+		NetworkDevice:    &MockNetworkDeviceService{},
+		NetworkOffering:  &MockNetworkOfferingService{},
+		Network:          &MockNetworkService{},
+		Nic:              &MockNicService{},
+		NiciraNVP:        &MockNiciraNVPService{},
+		Pod:              &MockPodService{},
+		Project:          &MockProjectService{},
+		PublicIPAddress:  &MockPublicIPAddressService{},
+		Region:           &MockRegionService{},
+		Resourcemetadata: &MockResourcemetadataService{},
+		Resourcetags:     &MockResourcetagsService{},
+		Router:           &MockRouterService{},
+		SSH:              &MockSSHService{},
+		ServiceOffering:  &MockServiceOfferingService{},
+		Snapshot:         &MockSnapshotService{},
+		StoragePool:      &MockStoragePoolService{},
+		System:           &MockSystemService{},
+		SystemVM:         &MockSystemVMService{},
+		Template:         &MockTemplateService{},
+		Usage:            &MockUsageService{},
+		User:             &MockUserService{},
+		VLAN:             &MockVLANService{},
+		VMGroup:          &MockVMGroupService{},
+		VPC:              &MockVPCService{},
+		VPN:              &MockVPNService{},
+		VirtualMachine:   &MockVirtualMachineService{},
+		Volume:           &MockVolumeService{},
+		Zone:             &MockZoneService{},
+	}
+
+	cs := newClient("", "", "", false, nil, 60)
+	cs.Account = m.Account
+	cs.AffinityGroup = m.AffinityGroup
+	cs.Alert = m.Alert
+	cs.Asyncjob = m.Asyncjob
+	cs.Authentication = m.Authentication
+	cs.Certificate = m.Certificate
+	cs.CloudOps = m.CloudOps
+	cs.Cluster = m.Cluster
+	cs.Configuration = m.Configuration
+	cs.DiskOffering = m.DiskOffering
+	cs.Domain = m.Domain
+	cs.Event = m.Event
+	cs.Firewall = m.Firewall
+	cs.GuestOS = m.GuestOS
+	cs.Host = m.Host
+	cs.Hypervisor = m.Hypervisor
+	cs.ISO = m.ISO
+	cs.ImageStore = m.ImageStore
+	cs.Limit = m.Limit
+	cs.LoadBalancer = m.LoadBalancer
+	cs.NAT = m.NAT
+	cs.NetworkACL = m.NetworkACL
+	cs.NetworkDevice = m.NetworkDevice
+	cs.NetworkOffering = m.NetworkOffering
+	cs.Network = m.Network
+	cs.Nic = m.Nic
+	cs.NiciraNVP = m.NiciraNVP
+	cs.Pod = m.Pod
+	cs.Project = m.Project
+	cs.PublicIPAddress = m.PublicIPAddress
+	cs.Region = m.Region
+	cs.Resourcemetadata = m.Resourcemetadata
+	cs.Resourcetags = m.Resourcetags
+	cs.Router = m.Router
+	cs.SSH = m.SSH
+	cs.ServiceOffering = m.ServiceOffering
+	cs.Snapshot = m.Snapshot
+	cs.StoragePool = m.StoragePool
+	cs.System = m.System
+	cs.SystemVM = m.SystemVM
+	cs.Template = m.Template
+	cs.Usage = m.Usage
+	cs.User = m.User
+	cs.VLAN = m.VLAN
+	cs.VMGroup = m.VMGroup
+	cs.VPC = m.VPC
+	cs.VPN = m.VPN
+	cs.VirtualMachine = m.VirtualMachine
+	cs.Volume = m.Volume
+	cs.Zone = m.Zone
+
+	return cs, m
+}
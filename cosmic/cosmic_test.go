@@ -0,0 +1,61 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+// Checks the params encode to the expected values for the expected command
+func assertParams(t *testing.T, p CommandParams, command string, want url.Values) {
+	t.Helper()
+
+	if got := p.command(); got != command {
+		t.Errorf("Expected command %q, got %q", command, got)
+	}
+	if got := p.toURLValues(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected params:\n%s\ngot:\n%s", want.Encode(), got.Encode())
+	}
+}
+
+// Decodes the response into v, and checks encoding v results in the same document, so
+// none of the fields of the response were lost while decoding
+func assertDecodes(t *testing.T, response string, v interface{}) {
+	t.Helper()
+
+	if err := json.Unmarshal([]byte(response), v); err != nil {
+		t.Fatalf("Unable to decode the response: %v", err)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Unable to encode the decoded response: %v", err)
+	}
+
+	var want, got interface{}
+	if err := json.Unmarshal([]byte(response), &want); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Decoding the response lost data, expected:\n%s\ngot:\n%s", response, b)
+	}
+}
@@ -0,0 +1,303 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// DefaultEndpointRecheck is the default time after which an unhealthy endpoint is tried again
+const DefaultEndpointRecheck = 30 * time.Second
+
+// EndpointStatus reports the health of an endpoint of a client
+type EndpointStatus struct {
+	URL       string
+	Healthy   bool
+	Active    bool      // True if requests are currently send to this endpoint
+	LastCheck time.Time // Time of the last failure or health check
+	Err       error     // Error of the last failure or health check, if unhealthy
+}
+
+// endpointPool contains the management servers a client can send requests to. Requests are send
+// to the current endpoint until it fails, after which the pool fails over to the next endpoint
+// that is healthy or has not been tried for a while.
+type endpointPool struct {
+	mu        sync.Mutex
+	endpoints []*endpoint
+	current   int
+	recheck   time.Duration // Time after which an unhealthy endpoint is tried again
+}
+
+type endpoint struct {
+	url     string
+	healthy bool
+	checked time.Time
+	err     error
+}
+
+// Context key used to send a request to a specific endpoint
+type endpointKey struct{}
+
+// WithEndpoints adds additional management servers to the client, which are used when the
+// server at the URL passed to New (or any other endpoint used before) becomes unavailable.
+// As async job IDs are cluster-wide, waiting for a job continues on any available server.
+// The endpoints and their health are shared by all clones of the client, unless a clone is
+// created with WithEndpoints or WithEndpointRecheck, which give the clone its own endpoints.
+func WithEndpoints(apiURLs ...string) ClientOption {
+	return func(cs *CosmicClient) error {
+		urls := []string{cs.baseURL}
+		if cs.endpoints != nil {
+			urls = cs.endpoints.urls()
+		}
+
+		for _, apiURL := range apiURLs {
+			u, err := url.Parse(apiURL)
+			if err != nil {
+				return fmt.Errorf("Invalid API URL %q: %v", apiURL, err)
+			}
+			if u.Scheme == "" || u.Host == "" {
+				return fmt.Errorf("Invalid API URL %q: missing scheme or host", apiURL)
+			}
+			urls = append(urls, apiURL)
+		}
+
+		pool := &endpointPool{recheck: DefaultEndpointRecheck}
+		if cs.endpoints != nil {
+			pool.recheck = cs.endpoints.recheck
+		}
+		for _, u := range urls {
+			pool.endpoints = append(pool.endpoints, &endpoint{url: u, healthy: true})
+		}
+		cs.endpoints = pool
+
+		return nil
+	}
+}
+
+// WithEndpointRecheck sets the time after which an endpoint that failed is tried again. It
+// must be passed after WithEndpoints. When passed to Clone, the clone gets its own copy of the
+// endpoints (starting with their current health), so the client it was cloned from is unchanged.
+func WithEndpointRecheck(recheck time.Duration) ClientOption {
+	return func(cs *CosmicClient) error {
+		if cs.endpoints == nil {
+			return errors.New("WithEndpointRecheck requires WithEndpoints")
+		}
+		pool := cs.endpoints.copy()
+		pool.recheck = recheck
+		cs.endpoints = pool
+
+		return nil
+	}
+}
+
+// CheckEndpoints checks the health of all endpoints of the client by calling listCapabilities on
+// each of them. Requests are then send to the first healthy endpoint, in the order the endpoints
+// were configured. An error is returned if none of the endpoints is healthy.
+func (cs *CosmicClient) CheckEndpoints(ctx context.Context) error {
+	if cs.endpoints == nil {
+		_, err := cs.checkEndpoint(ctx, cs.baseURL)
+		return err
+	}
+
+	var lastErr error
+	for _, u := range cs.endpoints.urls() {
+		_, err := cs.checkEndpoint(ctx, u)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			lastErr = err
+		}
+		cs.endpoints.update(u, err)
+	}
+
+	if !cs.endpoints.activateFirstHealthy() {
+		return fmt.Errorf("No healthy endpoint available: %v", lastErr)
+	}
+	return nil
+}
+
+// Endpoints returns the status of all endpoints of the client
+func (cs *CosmicClient) Endpoints() []EndpointStatus {
+	if cs.endpoints == nil {
+		return []EndpointStatus{{URL: cs.baseURL, Healthy: true, Active: true}}
+	}
+
+	p := cs.endpoints
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	status := make([]EndpointStatus, len(p.endpoints))
+	for i, e := range p.endpoints {
+		status[i] = EndpointStatus{
+			URL:       e.url,
+			Healthy:   e.healthy,
+			Active:    i == p.current,
+			LastCheck: e.checked,
+			Err:       e.err,
+		}
+	}
+	return status
+}
+
+// Calls listCapabilities on the endpoint with the given URL, bypassing any interceptors
+func (cs *CosmicClient) checkEndpoint(ctx context.Context, apiURL string) (json.RawMessage, error) {
+	params := url.Values{}
+	params.Set("command", "listCapabilities")
+	params.Set("response", "json")
+
+	return cs.doRequest(context.WithValue(ctx, endpointKey{}, apiURL), "listCapabilities", params)
+}
+
+// Returns the base URL to send the next request to, and true if the client can fail over to
+// another endpoint when sending the request fails
+func (cs *CosmicClient) endpoint(ctx context.Context) (string, bool) {
+	if u, ok := ctx.Value(endpointKey{}).(string); ok {
+		return u, false
+	}
+	if cs.endpoints == nil {
+		return cs.baseURL, false
+	}
+	return cs.endpoints.pick(), true
+}
+
+// Returns a new pool with the same endpoints and their current health
+func (p *endpointPool) copy() *endpointPool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	c := &endpointPool{current: p.current, recheck: p.recheck}
+	for _, e := range p.endpoints {
+		ec := *e
+		c.endpoints = append(c.endpoints, &ec)
+	}
+	return c
+}
+
+// Returns the URLs of all endpoints
+func (p *endpointPool) urls() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	urls := make([]string, len(p.endpoints))
+	for i, e := range p.endpoints {
+		urls[i] = e.url
+	}
+	return urls
+}
+
+// Returns the URL of the endpoint requests should be send to
+func (p *endpointPool) pick() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.endpoints[p.current].healthy {
+		p.failover()
+	}
+	return p.endpoints[p.current].url
+}
+
+// Makes the next endpoint that is healthy, or has not been tried for a while, the current
+// one. If there is no such endpoint, the current one is kept. Must be called with p.mu held.
+func (p *endpointPool) failover() {
+	for i := 1; i < len(p.endpoints); i++ {
+		idx := (p.current + i) % len(p.endpoints)
+		if e := p.endpoints[idx]; e.healthy || time.Since(e.checked) > p.recheck {
+			p.current = idx
+			return
+		}
+	}
+}
+
+// Records the result of a request or health check of the endpoint with the given URL
+func (p *endpointPool) update(apiURL string, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i, e := range p.endpoints {
+		if e.url != apiURL {
+			continue
+		}
+
+		e.healthy = err == nil
+		e.checked = time.Now()
+		e.err = err
+
+		if !e.healthy && i == p.current {
+			p.failover()
+		}
+		return
+	}
+}
+
+// Makes the first healthy endpoint the current one, and returns false if there is none
+func (p *endpointPool) activateFirstHealthy() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i, e := range p.endpoints {
+		if e.healthy {
+			p.current = i
+			return true
+		}
+	}
+	return false
+}
+
+// Returns true if the error shows the endpoint is unavailable. Only errors returned while
+// connecting guarantee the request was not received by the server, which is reported by the
+// second return value so the request can safely be send to another endpoint.
+func isEndpointError(err error) (unavailable bool, notSent bool) {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false, false
+	}
+
+	var e *CSError
+	if errors.As(err, &e) {
+		switch e.HTTPStatus {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true, false
+		}
+		return false, false
+	}
+
+	var oe *net.OpError
+	if errors.As(err, &oe) && oe.Op == "dial" {
+		return true, true
+	}
+
+	// The HTTP client wraps every error in a *url.Error, which itself implements net.Error
+	var ue *url.Error
+	if errors.As(err, &ue) {
+		err = ue.Err
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true, false
+	}
+
+	var ne net.Error
+	return errors.As(err, &ne), false
+}
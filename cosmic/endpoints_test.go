@@ -0,0 +1,256 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// Returns a handler that answers every command with an empty list of zones
+func healthyHandler(calls *int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		switch r.FormValue("command") {
+		case "listCapabilities":
+			respond(w, http.StatusOK, `{"listcapabilitiesresponse":{"capability":{"cloudstackversion":"5.0"}}}`)
+		default:
+			respond(w, http.StatusOK, `{"listzonesresponse":{}}`)
+		}
+	}
+}
+
+// Returns a handler that answers every command with a 503 response
+func unavailableHandler(calls *int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		respond(w, http.StatusServiceUnavailable, `{"errorresponse":{"errorcode":503,"errortext":"Unavailable"}}`)
+	}
+}
+
+// Returns the URL of a server that is no longer listening
+func closedServerURL() string {
+	s := httptest.NewServer(http.NotFoundHandler())
+	s.Close()
+	return s.URL
+}
+
+func newEndpointsClient(t *testing.T, apiURL string, opts ...ClientOption) *CosmicClient {
+	t.Helper()
+
+	cs, err := New(apiURL, append([]ClientOption{
+		WithAPIKey("stub-api-key", "stub-secret"),
+		WithRetryPolicy(nil),
+	}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cs
+}
+
+func activeEndpoint(cs *CosmicClient) string {
+	for _, e := range cs.Endpoints() {
+		if e.Active {
+			return e.URL
+		}
+	}
+	return ""
+}
+
+func TestEndpointFailoverWhenNotSent(t *testing.T) {
+	var calls int32
+	s := httptest.NewServer(healthyHandler(&calls))
+	defer s.Close()
+
+	down := closedServerURL()
+	cs := newEndpointsClient(t, down, WithEndpoints(s.URL))
+
+	// The request could not be delivered, so it is send to the next endpoint right away
+	if _, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams()); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 request to the second endpoint, got %d", calls)
+	}
+
+	status := cs.Endpoints()
+	if status[0].Healthy || status[0].Err == nil || status[0].Active {
+		t.Errorf("Expected the first endpoint to be unhealthy, got %+v", status[0])
+	}
+	if !status[1].Healthy || !status[1].Active {
+		t.Errorf("Expected the second endpoint to be active, got %+v", status[1])
+	}
+}
+
+func TestEndpointFailoverWhenUnavailable(t *testing.T) {
+	var first, second int32
+	s1 := httptest.NewServer(unavailableHandler(&first))
+	defer s1.Close()
+	s2 := httptest.NewServer(healthyHandler(&second))
+	defer s2.Close()
+
+	cs := newEndpointsClient(t, s1.URL, WithEndpoints(s2.URL))
+
+	// The request was received by the first endpoint, so it is not send again
+	if _, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams()); err == nil {
+		t.Fatal("Expected an error")
+	}
+	if first != 1 || second != 0 {
+		t.Fatalf("Expected 1 request to the first endpoint only, got %d and %d", first, second)
+	}
+
+	// But the next request is send to the second endpoint
+	if _, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams()); err != nil {
+		t.Fatal(err)
+	}
+	if first != 1 || second != 1 {
+		t.Errorf("Expected the second request to be send to the second endpoint, got %d and %d", first, second)
+	}
+	if active := activeEndpoint(cs); active != s2.URL {
+		t.Errorf("Expected %s to be active, got %s", s2.URL, active)
+	}
+}
+
+func TestEndpointRecheck(t *testing.T) {
+	var first, second int32
+	s1 := httptest.NewServer(unavailableHandler(&first))
+	defer s1.Close()
+	s2 := httptest.NewServer(unavailableHandler(&second))
+	defer s2.Close()
+
+	cs := newEndpointsClient(t, s1.URL, WithEndpoints(s2.URL), WithEndpointRecheck(time.Hour))
+
+	for i := 0; i < 3; i++ {
+		cs.Zone.ListZones(cs.Zone.NewListZonesParams())
+	}
+
+	// Without a healthy endpoint to fail over to, the client keeps using the current one
+	if first != 1 || second != 2 {
+		t.Errorf("Expected 1 and 2 requests, got %d and %d", first, second)
+	}
+}
+
+func TestEndpointRecheckOnClone(t *testing.T) {
+	var first, second int32
+	s1 := httptest.NewServer(unavailableHandler(&first))
+	defer s1.Close()
+	s2 := httptest.NewServer(healthyHandler(&second))
+	defer s2.Close()
+
+	cs := newEndpointsClient(t, s1.URL, WithEndpoints(s2.URL))
+
+	clone, err := cs.Clone(WithEndpointRecheck(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cs.endpoints.recheck != DefaultEndpointRecheck || clone.endpoints.recheck != time.Minute {
+		t.Errorf("Expected a recheck of %v and %v, got %v and %v",
+			DefaultEndpointRecheck, time.Minute, cs.endpoints.recheck, clone.endpoints.recheck)
+	}
+
+	// The clone has its own endpoints, so a failure does not affect the original client
+	clone.Zone.ListZones(clone.Zone.NewListZonesParams())
+	if active := activeEndpoint(clone); active != s2.URL {
+		t.Errorf("Expected the clone to fail over to %s, got %s", s2.URL, active)
+	}
+	if active := activeEndpoint(cs); active != s1.URL {
+		t.Errorf("Expected the original client to still use %s, got %s", s1.URL, active)
+	}
+
+	// While a clone without those options shares the endpoints
+	shared, err := cs.Clone()
+	if err != nil {
+		t.Fatal(err)
+	}
+	shared.Zone.ListZones(shared.Zone.NewListZonesParams())
+	if active := activeEndpoint(cs); active != s2.URL {
+		t.Errorf("Expected the original client to fail over to %s, got %s", s2.URL, active)
+	}
+}
+
+func TestCheckEndpoints(t *testing.T) {
+	var first, second int32
+	s1 := httptest.NewServer(unavailableHandler(&first))
+	defer s1.Close()
+	s2 := httptest.NewServer(healthyHandler(&second))
+	defer s2.Close()
+
+	cs := newEndpointsClient(t, s1.URL, WithEndpoints(s2.URL))
+	if err := cs.CheckEndpoints(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if active := activeEndpoint(cs); active != s2.URL {
+		t.Errorf("Expected %s to be active, got %s", s2.URL, active)
+	}
+
+	cs = newEndpointsClient(t, s1.URL, WithEndpoints(closedServerURL()))
+	if err := cs.CheckEndpoints(context.Background()); err == nil {
+		t.Error("Expected an error without a healthy endpoint")
+	}
+}
+
+func TestWithEndpointsInvalidURL(t *testing.T) {
+	for _, u := range []string{"", "cosmic.local", "://cosmic.local", "http://"} {
+		if _, err := New("http://cosmic.local", WithAPIKey("key", "secret"), WithEndpoints(u)); err == nil {
+			t.Errorf("Expected an error for %q", u)
+		}
+	}
+	if _, err := New("http://cosmic.local", WithAPIKey("key", "secret"), WithEndpointRecheck(time.Minute)); err == nil {
+		t.Error("Expected an error using WithEndpointRecheck without WithEndpoints")
+	}
+}
+
+func TestIsEndpointError(t *testing.T) {
+	urlError := func(err error) error {
+		return &url.Error{Op: "Post", URL: "http://cosmic/client/api", Err: err}
+	}
+
+	cases := []struct {
+		name        string
+		err         error
+		unavailable bool
+		notSent     bool
+	}{
+		{"connection refused", urlError(&net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}), true, true},
+		{"connection reset", urlError(&net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}), true, false},
+		{"connection closed", urlError(io.EOF), true, false},
+		{"service unavailable", &CSError{HTTPStatus: http.StatusServiceUnavailable}, true, false},
+		{"bad gateway", &CSError{HTTPStatus: http.StatusBadGateway}, true, false},
+		{"internal server error", &CSError{HTTPStatus: http.StatusInternalServerError}, false, false},
+		{"parameter error", &CSError{HTTPStatus: http.StatusBadRequest}, false, false},
+		{"transport error", urlError(errors.New("no recorded interaction")), false, false},
+		{"canceled", urlError(context.Canceled), false, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			unavailable, notSent := isEndpointError(c.err)
+			if unavailable != c.unavailable || notSent != c.notSent {
+				t.Errorf("Expected (%v, %v), got (%v, %v)", c.unavailable, c.notSent, unavailable, notSent)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestUnmarshalValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		body  string
+		key   string
+		want  string
+		error bool
+	}{
+		{"expected key", `{"zone":{"id":"1"}}`, "zone", `{"id":"1"}`, false},
+		{"expected key between others", `{"a":1,"zone":{"id":"1"},"b":2}`, "zone", `{"id":"1"}`, false},
+		{"single other key", `{"ldapconfiguration":{"id":"1"}}`, "zone", "", true},
+		{"multiple other keys", `{"a":1,"b":2}`, "zone", "", true},
+		{"empty object", `{}`, "zone", "", true},
+		{"not an object", `["zone"]`, "zone", "", true},
+		{"invalid JSON", `{"zone":`, "zone", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var raw json.RawMessage
+			err := unmarshalValue(json.RawMessage(tt.body), tt.key, &raw)
+			if tt.error {
+				if err == nil {
+					t.Fatalf("Expected an error, got value %s", raw)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(raw) != tt.want {
+				t.Errorf("Expected %s, got %s", tt.want, raw)
+			}
+		})
+	}
+}
+
+func TestResponseKey(t *testing.T) {
+	tests := map[string]string{
+		"listZones":                  "listzonesresponse",
+		"deployVirtualMachine":       "deployvirtualmachineresponse",
+		"listLdapConfigurations":     "ldapconfigurationresponse",
+		"ldapCreateAccount":          "createaccountresponse",
+		"getUploadParamsForTemplate": "postuploadtemplateresponse",
+		"activateProject":            "activaterojectresponse",
+		"restoreVirtualMachine":      "restorevmresponse",
+	}
+
+	for command, want := range tests {
+		if got := responseKey(command); got != want {
+			t.Errorf("Expected key %q for %s, got %q", want, command, got)
+		}
+	}
+}
+
+func TestIrregularResponseEnvelope(t *testing.T) {
+	cs, s := newStubClient(t, func(w http.ResponseWriter, r *http.Request) {
+		respond(w, http.StatusOK, `{"ldapconfigurationresponse":{"count":1,"ldapconfiguration":[{"hostname":"ldap.example.com","port":389}]}}`)
+	})
+	defer s.Close()
+
+	r, err := cs.Authentication.ListLdapConfigurations(cs.Authentication.NewListLdapConfigurationsParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Count != 1 || len(r.LdapConfigurations) != 1 || r.LdapConfigurations[0].Hostname != "ldap.example.com" {
+		t.Errorf("Unexpected response: %+v", r)
+	}
+}
+
+func TestAsyncResultKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		result string
+		error  bool
+	}{
+		{"expected key", `{"volume":{"id":"volume-id","name":"data"}}`, false},
+		{"expected key between others", `{"a":1,"volume":{"id":"volume-id","name":"data"}}`, false},
+		{"other key", `{"diskvolume":{"id":"volume-id","name":"data"}}`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs, s := newStubClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.FormValue("command") {
+				case "createVolume":
+					respond(w, http.StatusOK, `{"createvolumeresponse":{"id":"volume-id","jobid":"job-id"}}`)
+				case "queryAsyncJobResult":
+					respond(w, http.StatusOK, `{"queryasyncjobresultresponse":{"jobid":"job-id","jobstatus":1,"jobresult":`+tt.result+`}}`)
+				}
+			}, WithAsync(true))
+			defer s.Close()
+
+			r, err := cs.Volume.CreateVolume(cs.Volume.NewCreateVolumeParams())
+			if tt.error {
+				if err == nil {
+					t.Fatalf("Expected an error, got response %+v", r)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r.Id != "volume-id" || r.Name != "data" {
+				t.Errorf("Unexpected response: %+v", r)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Error codes returned by the API in the `errorcode` field of an error response
+const (
+	ErrorCodeUnauthorized            = 401
+	ErrorCodeAPILimitExceeded        = 429
+	ErrorCodeParamError              = 431
+	ErrorCodeUnsupportedAction       = 432
+	ErrorCodeInternalError           = 530
+	ErrorCodeAccountError            = 531
+	ErrorCodeAccountResourceLimit    = 532
+	ErrorCodeInsufficientCapacity    = 533
+	ErrorCodeResourceUnavailable     = 534
+	ErrorCodeResourceAllocationError = 535
+	ErrorCodeResourceInUse           = 536
+	ErrorCodeNetworkRuleConflict     = 537
+)
+
+// Sentinel errors that can be used with errors.Is to classify the errors returned by
+// this package, without having to inspect the error codes or texts yourself.
+var (
+	// ErrNotFound indicates the requested entity does not exist
+	ErrNotFound = errors.New("not found")
+
+	// ErrPermissionDenied indicates the caller is not allowed to execute the command
+	ErrPermissionDenied = errors.New("permission denied")
+
+	// ErrParameter indicates one or more parameters passed to the command are invalid
+	ErrParameter = errors.New("parameter error")
+
+	// ErrResourceUnavailable indicates there is not enough capacity or the resource is unavailable
+	ErrResourceUnavailable = errors.New("resource unavailable")
+
+	// ErrLimitExceeded indicates an API or account resource limit has been exceeded
+	ErrLimitExceeded = errors.New("limit exceeded")
+)
+
+// CSError represents an error returned by the Cosmic API. Use errors.As to get the
+// details of the error, or errors.Is with one of the sentinel errors to classify it.
+type CSError struct {
+	HTTPStatus  int    `json:"-"`
+	Command     string `json:"-"`
+	ErrorCode   int    `json:"errorcode"`
+	CSErrorCode int    `json:"cserrorcode"`
+	ErrorText   string `json:"errortext"`
+}
+
+func (e *CSError) Error() string {
+	return fmt.Sprintf("Cosmic API error %d (CSExceptionErrorCode: %d): %s", e.ErrorCode, e.CSErrorCode, e.ErrorText)
+}
+
+// Is reports whether the error matches the given sentinel error
+func (e *CSError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		// Entities that cannot be found are reported as a parameter error
+		text := strings.ToLower(e.ErrorText)
+		return e.ErrorCode == ErrorCodeParamError &&
+			(strings.Contains(text, "entity does not exist") || strings.Contains(text, "unable to find"))
+	case ErrPermissionDenied:
+		return e.ErrorCode == ErrorCodeUnauthorized || e.ErrorCode == ErrorCodeAccountError
+	case ErrParameter:
+		return e.ErrorCode == ErrorCodeParamError
+	case ErrResourceUnavailable:
+		return e.ErrorCode == ErrorCodeInsufficientCapacity ||
+			e.ErrorCode == ErrorCodeResourceUnavailable ||
+			e.ErrorCode == ErrorCodeResourceAllocationError
+	case ErrLimitExceeded:
+		return e.ErrorCode == ErrorCodeAPILimitExceeded || e.ErrorCode == ErrorCodeAccountResourceLimit
+	}
+	return false
+}
+
+// notFoundError is returned by the courtesy helper functions when no (exact) match is found
+type notFoundError struct {
+	msg string
+}
+
+// Returns a new error formatted according to the format specifier that matches ErrNotFound
+func notFoundErrorf(format string, a ...interface{}) error {
+	return &notFoundError{msg: fmt.Sprintf(format, a...)}
+}
+
+func (e *notFoundError) Error() string {
+	return e.msg
+}
+
+// Is reports whether the target is ErrNotFound
+func (e *notFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
@@ -0,0 +1,183 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCSErrorIs(t *testing.T) {
+	sentinels := []error{ErrNotFound, ErrPermissionDenied, ErrParameter, ErrResourceUnavailable, ErrLimitExceeded}
+
+	tests := []struct {
+		name string
+		err  *CSError
+		want []error
+	}{
+		{"entity does not exist", &CSError{ErrorCode: 431, ErrorText: "Entity does not exist"}, []error{ErrNotFound, ErrParameter}},
+		{"unable to find", &CSError{ErrorCode: 431, ErrorText: "Unable to find zone by id"}, []error{ErrNotFound, ErrParameter}},
+		{"parameter error", &CSError{ErrorCode: 431, ErrorText: "Unable to execute API command due to missing parameter"}, []error{ErrParameter}},
+		{"not found text with another code", &CSError{ErrorCode: 530, ErrorText: "Unable to find zone by id"}, nil},
+		{"unauthorized", &CSError{ErrorCode: 401}, []error{ErrPermissionDenied}},
+		{"account error", &CSError{ErrorCode: 531}, []error{ErrPermissionDenied}},
+		{"insufficient capacity", &CSError{ErrorCode: 533}, []error{ErrResourceUnavailable}},
+		{"resource unavailable", &CSError{ErrorCode: 534}, []error{ErrResourceUnavailable}},
+		{"resource allocation error", &CSError{ErrorCode: 535}, []error{ErrResourceUnavailable}},
+		{"API limit exceeded", &CSError{ErrorCode: 429}, []error{ErrLimitExceeded}},
+		{"account resource limit", &CSError{ErrorCode: 532}, []error{ErrLimitExceeded}},
+		{"internal error", &CSError{ErrorCode: 530}, nil},
+		{"resource in use", &CSError{ErrorCode: 536}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Wrap the error to make sure it is found in the chain
+			err := fmt.Errorf("Request failed: %w", tt.err)
+			for _, sentinel := range sentinels {
+				want := false
+				for _, w := range tt.want {
+					want = want || w == sentinel
+				}
+				if got := errors.Is(err, sentinel); got != want {
+					t.Errorf("Expected errors.Is(%v) to be %t, got %t", sentinel, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestNotFoundErrorf(t *testing.T) {
+	err := notFoundErrorf("No match found for %s: %+v", "zone1", 0)
+	if err.Error() != "No match found for zone1: 0" {
+		t.Errorf("Unexpected message: %q", err.Error())
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Error("Expected the error to match ErrNotFound")
+	}
+	if errors.Is(err, ErrParameter) {
+		t.Error("Expected the error to only match ErrNotFound")
+	}
+}
+
+func TestGetErrorValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		body  string
+		want  string
+		error bool
+	}{
+		{"command envelope", `{"listzonesresponse":{"errorcode":431,"errortext":"invalid"}}`, `{"errorcode":431,"errortext":"invalid"}`, false},
+		{"error envelope", `{"errorresponse":{"errorcode":401,"errortext":"unauthorized"}}`, `{"errorcode":401,"errortext":"unauthorized"}`, false},
+		{"other envelope", `{"createzoneresponse":{"errorcode":431}}`, "", true},
+		{"not JSON", `<html>Bad Gateway</html>`, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, err := getErrorValue(json.RawMessage(tt.body), "listZones")
+			if tt.error {
+				if err == nil {
+					t.Fatalf("Expected an error, got value %s", raw)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(raw) != tt.want {
+				t.Errorf("Expected %s, got %s", tt.want, raw)
+			}
+		})
+	}
+}
+
+func TestErrorResponse(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   CSError
+	}{
+		{
+			"API error",
+			431,
+			`{"createzoneresponse":{"errorcode":431,"cserrorcode":4350,"errortext":"Invalid parameter"}}`,
+			CSError{HTTPStatus: 431, Command: "createZone", ErrorCode: 431, CSErrorCode: 4350, ErrorText: "Invalid parameter"},
+		},
+		{
+			"proxy error",
+			http.StatusBadGateway,
+			"<html>Bad Gateway</html>\n",
+			CSError{HTTPStatus: 502, Command: "createZone", ErrorCode: 502, ErrorText: "<html>Bad Gateway</html>"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs, s := newStubClient(t, func(w http.ResponseWriter, r *http.Request) {
+				respond(w, tt.status, tt.body)
+			}, WithRetryPolicy(nil))
+			defer s.Close()
+
+			_, err := cs.Zone.CreateZone(cs.Zone.NewCreateZoneParams("8.8.8.8", "10.0.0.1", "zone1", "Advanced"))
+			var e *CSError
+			if !errors.As(err, &e) {
+				t.Fatalf("Expected a *CSError, got %v", err)
+			}
+			if *e != tt.want {
+				t.Errorf("Expected %+v, got %+v", tt.want, *e)
+			}
+		})
+	}
+}
+
+func TestGetByIDNotFound(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{"parameter error", 431, `{"listzonesresponse":{"errorcode":431,"errortext":"Unable to find zone by id zone-id"}}`},
+		{"empty list", http.StatusOK, `{"listzonesresponse":{}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs, s := newStubClient(t, func(w http.ResponseWriter, r *http.Request) {
+				respond(w, tt.status, tt.body)
+			})
+			defer s.Close()
+
+			zone, count, err := cs.Zone.GetZoneByID("zone-id")
+			if !errors.Is(err, ErrNotFound) {
+				t.Fatalf("Expected ErrNotFound, got %v", err)
+			}
+			if zone != nil || count != 0 {
+				t.Errorf("Expected no zone and a count of 0, got %+v and %d", zone, count)
+			}
+
+			var e *CSError
+			if errors.As(err, &e) {
+				t.Errorf("Expected the API error to be replaced, got %v", e)
+			}
+		})
+	}
+}
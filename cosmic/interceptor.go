@@ -0,0 +1,47 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// RequestHandler executes a request for the given command and returns the raw JSON value of the
+// response (without the outer response envelope), or the error returned by the API.
+type RequestHandler func(ctx context.Context, command string, params url.Values) (json.RawMessage, error)
+
+// Interceptor is called for every request made by the client, including every page requested
+// while listing and every queryAsyncJobResult call made while waiting for an async job. The
+// params contain the parameters of the command, before the request is authenticated and signed.
+//
+// An interceptor calls next to continue executing the request, and can inspect or modify the
+// command, parameters, response and error, or measure how long the request took. It can also
+// short-circuit the request by returning a response or error without calling next at all.
+type Interceptor func(ctx context.Context, command string, params url.Values, next RequestHandler) (json.RawMessage, error)
+
+// Returns a handler that passes a request through all interceptors before calling handler
+func chainInterceptors(interceptors []Interceptor, handler RequestHandler) RequestHandler {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], handler
+		handler = func(ctx context.Context, command string, params url.Values) (json.RawMessage, error) {
+			return interceptor(ctx, command, params, next)
+		}
+	}
+	return handler
+}
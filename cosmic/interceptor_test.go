@@ -0,0 +1,103 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestInterceptorSeesUnsignedParams(t *testing.T) {
+	attempts := 0
+	cs, s := newStubClient(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if len(r.Form["signature"]) != 1 || VerifySignature(r.Form, "stub-secret") != nil {
+			t.Errorf("Attempt %d has an invalid signature: %s", attempts+1, r.Form.Encode())
+		}
+
+		// Fail the first attempt, so the request is retried
+		if attempts++; attempts == 1 {
+			respond(w, http.StatusServiceUnavailable, `{"errorresponse":{"errorcode":503,"errortext":"unavailable"}}`)
+			return
+		}
+		respond(w, http.StatusOK, `{"listzonesresponse":{"count":0}}`)
+	}, WithRetryPolicy(&BackoffRetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+	defer s.Close()
+
+	var seen []url.Values
+	cs, err := cs.Clone(WithInterceptors(func(ctx context.Context, command string, params url.Values, next RequestHandler) (json.RawMessage, error) {
+		seen = append(seen, copyValues(params))
+		return next(ctx, command, params)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	params := url.Values{"name": {"zone1"}}
+	if _, err := cs.newRequest(context.Background(), "listZones", params); err != nil {
+		t.Fatal(err)
+	}
+
+	want := url.Values{"name": {"zone1"}}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("Expected the params of the caller to be unchanged, got: %s", params.Encode())
+	}
+	if len(seen) != 1 || !reflect.DeepEqual(seen[0], want) {
+		t.Errorf("Expected the interceptor to see %s once, got: %v", want.Encode(), seen)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestInterceptorShortCircuit(t *testing.T) {
+	cs, s := newStubClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request: %s", r.URL)
+	})
+	defer s.Close()
+
+	var order []string
+	cs, err := cs.Clone(WithInterceptors(
+		func(ctx context.Context, command string, params url.Values, next RequestHandler) (json.RawMessage, error) {
+			order = append(order, "outer")
+			return next(ctx, command, params)
+		},
+		func(ctx context.Context, command string, params url.Values, next RequestHandler) (json.RawMessage, error) {
+			order = append(order, "inner")
+			return json.RawMessage(`{"count":1,"zone":[{"id":"zone-id"}]}`), nil
+		},
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Count != 1 || r.Zones[0].Id != "zone-id" {
+		t.Errorf("Unexpected response: %+v", r)
+	}
+	if !reflect.DeepEqual(order, []string{"outer", "inner"}) {
+		t.Errorf("Expected the interceptors to be called outer first, got %v", order)
+	}
+}
@@ -0,0 +1,238 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// DefaultJobWaiterInterval is the default time a JobWaiter waits between two polls
+const DefaultJobWaiterInterval = 2 * time.Second
+
+// Margin subtracted from the time a job was added, to make sure the job is still
+// included when listing jobs even if the clocks of the client and server differ
+const jobWaiterStartMargin = 5 * time.Minute
+
+// JobResult reports the final status of a job tracked by a JobWaiter
+type JobResult struct {
+	JobID string
+
+	// The final status of the job, as returned by the API
+	Job *QueryAsyncJobResultResponse
+
+	// The raw result of the job if it finished successfully
+	Result json.RawMessage
+
+	// The error of the job if it failed
+	Err error
+}
+
+// JobWaiter waits for many async jobs at once. Instead of polling every job separately it
+// uses a single listAsyncJobs call per poll, only falling back to queryAsyncJobResult for jobs
+// that are not included in the list (e.g. jobs started by another account).
+type JobWaiter struct {
+	cs *CosmicClient
+
+	mu       sync.Mutex
+	interval time.Duration
+	pending  map[string]time.Time // Pending jobs and the time they were added
+}
+
+// NewJobWaiter returns a JobWaiter that polls the jobs added to it using the client
+func (cs *CosmicClient) NewJobWaiter() *JobWaiter {
+	return &JobWaiter{
+		cs:       cs,
+		interval: DefaultJobWaiterInterval,
+		pending:  make(map[string]time.Time),
+	}
+}
+
+// SetInterval sets the time to wait between two polls
+func (w *JobWaiter) SetInterval(interval time.Duration) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.interval = interval
+}
+
+// Add starts tracking the jobs with the given IDs. Jobs can also be added while waiting.
+func (w *JobWaiter) Add(jobids ...string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	now := time.Now()
+	for _, id := range jobids {
+		if _, ok := w.pending[id]; !ok {
+			w.pending[id] = now
+		}
+	}
+}
+
+// Pending returns the number of jobs that are not yet finished
+func (w *JobWaiter) Pending() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return len(w.pending)
+}
+
+// Wait polls all pending jobs until they are all finished, calling callback once for every
+// finished job. It returns early with an error if polling fails or the context is done, in which
+// case the unfinished jobs are still pending and Wait can be called again. Wait should not be
+// called concurrently.
+func (w *JobWaiter) Wait(ctx context.Context, callback func(JobResult)) error {
+	return w.wait(ctx, func(r JobResult) bool {
+		callback(r)
+		return true
+	})
+}
+
+// Polls all pending jobs until they are all finished, calling deliver for every finished job.
+// A job is only removed from the pending jobs after deliver returns true. If it returns false,
+// waiting stops with the error of the context, so the job is reported again by the next wait.
+func (w *JobWaiter) wait(ctx context.Context, deliver func(JobResult) bool) error {
+	for {
+		if w.Pending() == 0 {
+			return nil
+		}
+
+		results, err := w.poll(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, r := range results {
+			if !deliver(r) {
+				return ctx.Err()
+			}
+			w.done(r.JobID)
+		}
+
+		if w.Pending() == 0 {
+			return nil
+		}
+
+		w.mu.Lock()
+		interval := w.interval
+		w.mu.Unlock()
+
+		if err := sleepWithContext(ctx, interval); err != nil {
+			return err
+		}
+	}
+}
+
+// Results waits for all pending jobs in a separate goroutine and sends the result of
+// every finished job on the returned channel. The channel is closed when all jobs are
+// finished or waiting stopped early, in which case the error is send on errc. Jobs whose
+// result was not received before the context was done are still pending afterwards.
+func (w *JobWaiter) Results(ctx context.Context) (results <-chan JobResult, errc <-chan error) {
+	rc := make(chan JobResult)
+	ec := make(chan error, 1)
+
+	go func() {
+		defer close(rc)
+		defer close(ec)
+
+		err := w.wait(ctx, func(r JobResult) bool {
+			select {
+			case rc <- r:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+		if err != nil {
+			ec <- err
+		}
+	}()
+
+	return rc, ec
+}
+
+// Removes a finished job, after its result was delivered
+func (w *JobWaiter) done(jobid string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	delete(w.pending, jobid)
+}
+
+// Polls all pending jobs once and returns the results of the jobs that finished. The jobs
+// stay pending until they are marked as done.
+func (w *JobWaiter) poll(ctx context.Context) ([]JobResult, error) {
+	w.mu.Lock()
+	var since time.Time
+	ids := make(map[string]bool, len(w.pending))
+	for id, added := range w.pending {
+		if since.IsZero() || added.Before(since) {
+			since = added
+		}
+		ids[id] = true
+	}
+	w.mu.Unlock()
+
+	// Only list the jobs that could have been started since the oldest pending job
+	p := w.cs.Asyncjob.NewListAsyncJobsParams()
+	p.SetStartdate(since.Add(-jobWaiterStartMargin).UTC().Format("2006-01-02T15:04:05-0700"))
+
+	l, err := w.cs.Asyncjob.ListAsyncJobsWithContext(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	var finished []*QueryAsyncJobResultResponse
+	for _, j := range l.AsyncJobs {
+		if !ids[j.Jobid] {
+			continue
+		}
+		delete(ids, j.Jobid)
+
+		if j.Jobstatus != JobStatusPending {
+			r := QueryAsyncJobResultResponse(*j)
+			finished = append(finished, &r)
+		}
+	}
+
+	// Query the remaining jobs that were not included in the list separately
+	for id := range ids {
+		r, err := w.cs.Asyncjob.QueryAsyncJobResultWithContext(ctx, w.cs.Asyncjob.NewQueryAsyncJobResultParams(id))
+		if err != nil {
+			return nil, err
+		}
+		if r.Jobstatus != JobStatusPending {
+			r.Jobid = id
+			finished = append(finished, r)
+		}
+	}
+
+	results := make([]JobResult, 0, len(finished))
+	for _, r := range finished {
+		result := JobResult{JobID: r.Jobid, Job: r}
+		if r.Jobstatus == JobStatusFailed {
+			result.Err = jobError(r.Jobid, r)
+		} else {
+			result.Result = r.Jobresult
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,112 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"testing"
+	"time"
+)
+
+// Reports job-1 as finished and job-2 as failed when listing jobs, while job-3 is only
+// found using queryAsyncJobResult
+func jobWaiterHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.FormValue("command") {
+	case "listAsyncJobs":
+		respond(w, http.StatusOK, `{"listasyncjobsresponse":{"count":3,"asyncjobs":[
+			{"jobid":"job-1","jobstatus":1,"jobresult":{"zone":{"id":"zone-1"}}},
+			{"jobid":"job-2","jobstatus":2,"jobresultcode":530,"jobresult":{"errorcode":530,"errortext":"Failed"}},
+			{"jobid":"other","jobstatus":0}
+		]}}`)
+	case "queryAsyncJobResult":
+		respond(w, http.StatusOK, `{"queryasyncjobresultresponse":{"jobid":"job-3","jobstatus":1,"jobresult":{}}}`)
+	default:
+		respond(w, http.StatusBadRequest, `{"errorresponse":{"errorcode":431,"errortext":"Unexpected command"}}`)
+	}
+}
+
+func TestJobWaiterWait(t *testing.T) {
+	cs, s := newStubClient(t, jobWaiterHandler)
+	defer s.Close()
+
+	w := cs.NewJobWaiter()
+	w.SetInterval(time.Millisecond)
+	w.Add("job-1", "job-2", "job-3", "job-1")
+
+	results := make(map[string]JobResult)
+	if err := w.Wait(context.Background(), func(r JobResult) {
+		results[r.JobID] = r
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 3 || w.Pending() != 0 {
+		t.Fatalf("Expected 3 results and no pending jobs, got %d and %d", len(results), w.Pending())
+	}
+	if r := results["job-1"]; r.Err != nil || string(r.Result) != `{"zone":{"id":"zone-1"}}` {
+		t.Errorf("Unexpected result of job-1: %+v", r)
+	}
+	var e *AsyncJobError
+	if r := results["job-2"]; !errors.As(r.Err, &e) || e.JobID != "job-2" || e.ErrorCode != ErrorCodeInternalError {
+		t.Errorf("Expected job-2 to fail, got %v", r.Err)
+	}
+	if r := results["job-3"]; r.Err != nil || r.Job == nil || r.Job.Jobid != "job-3" {
+		t.Errorf("Unexpected result of job-3: %+v", r)
+	}
+}
+
+func TestJobWaiterResultsCanceled(t *testing.T) {
+	cs, s := newStubClient(t, jobWaiterHandler)
+	defer s.Close()
+
+	w := cs.NewJobWaiter()
+	w.Add("job-1", "job-2")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	results, errc := w.Results(ctx)
+
+	first := <-results
+	cancel()
+
+	// The result that was not received must still be pending
+	if err := <-errc; err != context.Canceled {
+		t.Errorf("Expected %v, got %v", context.Canceled, err)
+	}
+	if r, ok := <-results; ok {
+		t.Errorf("Expected no more results, got %+v", r)
+	}
+	if n := w.Pending(); n != 1 {
+		t.Fatalf("Expected 1 pending job, got %d", n)
+	}
+
+	// And it is reported when waiting again
+	var second JobResult
+	if err := w.Wait(context.Background(), func(r JobResult) {
+		second = r
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	ids := []string{first.JobID, second.JobID}
+	sort.Strings(ids)
+	if ids[0] != "job-1" || ids[1] != "job-2" {
+		t.Errorf("Expected the results of job-1 and job-2, got %v", ids)
+	}
+}
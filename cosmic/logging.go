@@ -0,0 +1,253 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultLogBodySize is the default max number of bytes of a response body logged at debug level
+const DefaultLogBodySize = 2048
+
+// LogLevel is the level of a log entry
+type LogLevel int
+
+// Log levels, from least to most verbose
+const (
+	// LogLevelInfo logs a single entry for every HTTP request
+	LogLevelInfo LogLevel = iota
+
+	// LogLevelDebug additionally logs the (redacted and truncated) body of every response
+	LogLevelDebug
+)
+
+func (l LogLevel) String() string {
+	switch l {
+	case LogLevelInfo:
+		return "info"
+	case LogLevelDebug:
+		return "debug"
+	default:
+		return "unknown"
+	}
+}
+
+// Logger logs structured entries. Implementations must be safe for concurrent use, as a client
+// can be used by multiple goroutines and clones share the logger.
+//
+// Every HTTP request made by the client is logged at info level with the fields command, params,
+// method, status (absent when no response was received), duration (a time.Duration), jobid (when
+// the response contains the ID of an async job) and error (when the request failed). When the
+// client is configured to log at debug level, the body of every response is logged as well, with
+// the fields command, status, size and body.
+//
+// The values of sensitive params like apiKey, signature, sessionkey, password, userdata, private
+// keys and certificates are redacted, both in the params and in logged response bodies.
+type Logger interface {
+	Log(level LogLevel, msg string, fields map[string]interface{})
+}
+
+// LoggerFunc is an adapter to allow the use of an ordinary function as Logger
+type LoggerFunc func(level LogLevel, msg string, fields map[string]interface{})
+
+// Log calls f(level, msg, fields)
+func (f LoggerFunc) Log(level LogLevel, msg string, fields map[string]interface{}) {
+	f(level, msg, fields)
+}
+
+// NewJSONLogger returns a Logger that writes every entry to w as a single line JSON object, with
+// the fields time, level and msg added to the fields of the entry.
+func NewJSONLogger(w io.Writer) Logger {
+	return &jsonLogger{w: w}
+}
+
+type jsonLogger struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (l *jsonLogger) Log(level LogLevel, msg string, fields map[string]interface{}) {
+	entry := map[string]interface{}{
+		"time":  time.Now().UTC().Format(time.RFC3339Nano),
+		"level": level.String(),
+		"msg":   msg,
+	}
+	for k, v := range fields {
+		if d, ok := v.(time.Duration); ok {
+			v = d.String()
+		}
+		entry[k] = v
+	}
+
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.w.Write(append(b, '\n'))
+}
+
+// Value logged instead of the value of a sensitive param or response field
+const redacted = "[REDACTED]"
+
+// Names (in lower case) of params and response fields of which the value is never logged
+var sensitiveNames = map[string]bool{
+	"apikey":       true,
+	"signature":    true,
+	"sessionkey":   true,
+	"userdata":     true,
+	"certificate":  true,
+	"certchain":    true,
+	"presharedkey": true,
+	"ipsecpsk":     true,
+	"token":        true,
+	"zonetoken":    true,
+	"userapikey":   true,
+}
+
+// IsSensitive returns true if the param or response field with the given name (case insensitive)
+// contains a secret, like a key, password, userdata or certificate, of which the value must never
+// be logged or stored. Flags like passwordenabled are not sensitive.
+func IsSensitive(name string) bool {
+	name = strings.ToLower(name)
+	if strings.HasSuffix(name, "enabled") {
+		return false
+	}
+	return sensitiveNames[name] ||
+		strings.Contains(name, "password") ||
+		strings.Contains(name, "secret") ||
+		strings.Contains(name, "privatekey")
+}
+
+// Returns the params with the values of sensitive params redacted
+func redactParams(params url.Values) map[string]string {
+	m := make(map[string]string, len(params))
+	for k, v := range params {
+		switch {
+		case k == "command" || k == "response":
+			continue
+		case IsSensitive(k):
+			m[k] = redacted
+		default:
+			m[k] = strings.Join(v, ",")
+		}
+	}
+	return m
+}
+
+// Returns the JSON body with the values of all sensitive fields redacted. A body that is not
+// valid JSON (e.g. an error returned by a proxy) is returned as is.
+func redactBody(b []byte) []byte {
+	r, err := RedactJSON(b, redacted)
+	if err != nil {
+		return b
+	}
+	return r
+}
+
+// RedactJSON returns the JSON document with the values of all fields for which IsSensitive
+// returns true replaced by the given replacement, at any depth. Numbers are kept as they are.
+func RedactJSON(b []byte, replacement string) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(redactValue(v, replacement))
+}
+
+func redactValue(v interface{}, replacement string) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, value := range v {
+			if IsSensitive(k) {
+				v[k] = replacement
+			} else {
+				v[k] = redactValue(value, replacement)
+			}
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactValue(value, replacement)
+		}
+	}
+	return v
+}
+
+// Returns the ID of the async job contained in the response body, if any
+func responseJobID(b []byte, api string) string {
+	var r struct {
+		JobID string `json:"jobid"`
+	}
+	if raw, err := getRawValue(b, responseKey(api)); err == nil && json.Unmarshal(raw, &r) == nil {
+		return r.JobID
+	}
+	return ""
+}
+
+// Logs a single HTTP request, and its response body when logging at debug level. The status is
+// zero when no response was received.
+func (cs *CosmicClient) logRequest(api string, params url.Values, method string, status int, duration time.Duration, body []byte, err error) {
+	if cs.logger == nil {
+		return
+	}
+
+	fields := map[string]interface{}{
+		"command":  api,
+		"params":   redactParams(params),
+		"method":   method,
+		"duration": duration,
+	}
+	if status != 0 {
+		fields["status"] = status
+	}
+	if jobid := responseJobID(body, api); jobid != "" {
+		fields["jobid"] = jobid
+	} else if jobid := params.Get("jobid"); jobid != "" {
+		fields["jobid"] = jobid
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+	}
+	cs.logger.Log(LogLevelInfo, "Cosmic API request", fields)
+
+	if cs.logLevel < LogLevelDebug || body == nil {
+		return
+	}
+
+	b := redactBody(body)
+	if cs.logBodySize > 0 && len(b) > cs.logBodySize {
+		b = b[:cs.logBodySize]
+	}
+	cs.logger.Log(LogLevelDebug, "Cosmic API response", map[string]interface{}{
+		"command": api,
+		"status":  status,
+		"size":    len(body),
+		"body":    strings.ToValidUTF8(string(b), ""),
+	})
+}
@@ -0,0 +1,175 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// Logger collecting all logged entries
+type testLogger struct {
+	entries []testLogEntry
+}
+
+type testLogEntry struct {
+	level  LogLevel
+	msg    string
+	fields map[string]interface{}
+}
+
+func (l *testLogger) Log(level LogLevel, msg string, fields map[string]interface{}) {
+	l.entries = append(l.entries, testLogEntry{level, msg, fields})
+}
+
+// Names of params and response fields that must never be logged, as sent by the client
+var sensitiveTestNames = []string{
+	"apiKey", "signature", "sessionkey", "password", "newpassword", "encryptedpassword", "userdata",
+	"privatekey", "certificate", "certchain", "secretkey", "usersecretkey", "userapikey",
+	"ipsecpsk", "presharedkey", "token", "zonetoken",
+}
+
+func TestLogRedactsSensitiveValues(t *testing.T) {
+	const secret = "s3cr3t-value"
+
+	// Respond with every sensitive name as a field of the response
+	var fields []string
+	for _, name := range sensitiveTestNames {
+		fields = append(fields, `"`+name+`":"`+secret+`"`)
+	}
+	body := `{"testresponse":{"nested":[{` + strings.Join(fields, ",") + `}],"name":"visible"}}`
+
+	l := &testLogger{}
+	cs, s := newStubClient(t, func(w http.ResponseWriter, r *http.Request) {
+		respond(w, http.StatusOK, body)
+	}, WithLogger(l, LogLevelDebug), WithLogBodySize(0))
+	defer s.Close()
+
+	params := url.Values{"name": {"visible"}}
+	for _, name := range sensitiveTestNames {
+		if name != "apiKey" && name != "signature" {
+			params.Set(name, secret)
+		}
+	}
+	if _, err := cs.newRequest(context.Background(), "test", params); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(l.entries) != 2 {
+		t.Fatalf("Expected a request and a response entry, got %d entries", len(l.entries))
+	}
+
+	logged := l.entries[0].fields["params"].(map[string]string)
+	for _, name := range sensitiveTestNames {
+		if logged[name] != redacted {
+			t.Errorf("Expected param %s to be redacted, got %q", name, logged[name])
+		}
+	}
+	if logged["name"] != "visible" {
+		t.Errorf("Expected param name to be logged, got %q", logged["name"])
+	}
+
+	response := l.entries[1].fields["body"].(string)
+	if strings.Contains(response, secret) {
+		t.Errorf("Expected all sensitive fields to be redacted, got %s", response)
+	}
+	if !strings.Contains(response, `"name":"visible"`) {
+		t.Errorf("Expected other fields to be logged, got %s", response)
+	}
+}
+
+func TestLogEntries(t *testing.T) {
+	l := &testLogger{}
+	cs, s := newStubClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.FormValue("command") {
+		case "deployVirtualMachine":
+			respond(w, http.StatusOK, `{"deployvirtualmachineresponse":{"id":"vm-id","jobid":"job-id"}}`)
+		default:
+			respond(w, 431, `{"listzonesresponse":{"errorcode":431,"errortext":"invalid parameter"}}`)
+		}
+	}, WithLogger(l, LogLevelInfo))
+	defer s.Close()
+
+	cs.VirtualMachine.DeployVirtualMachine(cs.VirtualMachine.NewDeployVirtualMachineParams("offering", "template", "zone"))
+	cs.Zone.ListZones(cs.Zone.NewListZonesParams())
+
+	if len(l.entries) != 2 {
+		t.Fatalf("Expected only request entries at info level, got %d entries", len(l.entries))
+	}
+
+	deploy := l.entries[0].fields
+	if deploy["command"] != "deployVirtualMachine" || deploy["method"] != "POST" || deploy["status"] != 200 || deploy["jobid"] != "job-id" {
+		t.Errorf("Unexpected fields: %v", deploy)
+	}
+	if _, ok := deploy["error"]; ok {
+		t.Errorf("Expected no error, got %v", deploy["error"])
+	}
+
+	list := l.entries[1].fields
+	if list["command"] != "listZones" || list["method"] != "GET" || list["status"] != 431 || list["error"] == nil {
+		t.Errorf("Unexpected fields: %v", list)
+	}
+}
+
+func TestLogBodySize(t *testing.T) {
+	l := &testLogger{}
+	cs, s := newStubClient(t, func(w http.ResponseWriter, r *http.Request) {
+		respond(w, http.StatusOK, `{"listzonesresponse":{"count":1,"zone":[{"id":"zone-id","name":"zone1"}]}}`)
+	}, WithLogger(l, LogLevelDebug), WithLogBodySize(10))
+	defer s.Close()
+
+	cs.Zone.ListZones(cs.Zone.NewListZonesParams())
+
+	if len(l.entries) != 2 || l.entries[1].level != LogLevelDebug {
+		t.Fatalf("Expected a request and a debug response entry, got %v", l.entries)
+	}
+	if body := l.entries[1].fields["body"]; body != `{"listzone` {
+		t.Errorf("Expected the body to be truncated to 10 bytes, got %q", body)
+	}
+}
+
+func TestIsSensitive(t *testing.T) {
+	for _, name := range append(sensitiveTestNames, "APIKEY", "Password") {
+		if !IsSensitive(name) {
+			t.Errorf("Expected %s to be sensitive", name)
+		}
+	}
+	for _, name := range []string{"name", "id", "passwordenabled", "sshkeyenabled", "keypair", "publickey", "keyword"} {
+		if IsSensitive(name) {
+			t.Errorf("Expected %s to not be sensitive", name)
+		}
+	}
+}
+
+func TestRedactJSON(t *testing.T) {
+	b, err := RedactJSON([]byte(`{"createuserresponse":{"user":{"id":"user-id","password":"p","count":12345678901234567890,"keys":[{"privatekey":"k"}]}}}`), "x")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"createuserresponse":{"user":{"count":12345678901234567890,"id":"user-id","keys":[{"privatekey":"x"}],"password":"x"}}}`
+	if string(b) != want {
+		t.Errorf("Expected %s, got %s", want, b)
+	}
+
+	if _, err := RedactJSON([]byte("<html>Bad Gateway</html>"), "x"); err == nil {
+		t.Error("Expected an error for a body that is not JSON")
+	}
+}
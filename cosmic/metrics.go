@@ -0,0 +1,77 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Metrics collects metrics about the requests made by a client. Implementations must be safe for
+// concurrent use, as a client can be used by multiple goroutines and clones share the collector.
+type Metrics interface {
+	// ObserveRequest is called after every request, including every page requested while listing
+	// and every queryAsyncJobResult call made while waiting for an async job. The errorcode is
+	// zero when the request succeeded, the error code returned by the API when it failed with an
+	// API error, and -1 when it failed for any other reason.
+	ObserveRequest(command string, duration time.Duration, errorcode int)
+
+	// ObserveAsyncJob is called when waiting for an async job is finished. The status is one of
+	// JobStatusSuccess and JobStatusFailed, or JobStatusPending when waiting timed out.
+	ObserveAsyncJob(command string, duration time.Duration, status int)
+
+	// ObservePages is called after all pages of a paginated list are requested
+	ObservePages(command string, pages int)
+}
+
+// Returns the error code reported as metric for the error
+func metricsErrorCode(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var e *CSError
+	if errors.As(err, &e) {
+		return e.ErrorCode
+	}
+	return -1
+}
+
+func (cs *CosmicClient) observeRequest(command string, duration time.Duration, err error) {
+	if cs.metrics != nil {
+		cs.metrics.ObserveRequest(command, duration, metricsErrorCode(err))
+	}
+}
+
+func (cs *CosmicClient) observeAsyncJob(command string, r *QueryAsyncJobResultResponse, duration time.Duration) {
+	if cs.metrics == nil {
+		return
+	}
+
+	// The job only knows the class implementing the command, like "org.apache...DeployVMCmd"
+	if command == "" {
+		command = r.Cmd[strings.LastIndex(r.Cmd, ".")+1:]
+	}
+	cs.metrics.ObserveAsyncJob(command, duration, r.Jobstatus)
+}
+
+func (cs *CosmicClient) observePages(command string, pages int) {
+	if cs.metrics != nil {
+		cs.metrics.ObservePages(command, pages)
+	}
+}
@@ -0,0 +1,82 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNotMocked is returned by a mock when no function is set for the called method
+var ErrNotMocked = errors.New("not mocked")
+
+// MockCall is a single call made to a mock
+type MockCall struct {
+	Method string
+	Args   []interface{} // Arguments of the call, without the context
+}
+
+// mockCalls records the calls made to a mock. It is embedded in all generated mocks.
+type mockCalls struct {
+	mu    sync.Mutex
+	calls []MockCall
+}
+
+// Records a call to the given method
+func (m *mockCalls) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls = append(m.calls, MockCall{Method: method, Args: args})
+}
+
+// Calls returns all calls made to the mock so far, in order
+func (m *mockCalls) Calls() []MockCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]MockCall(nil), m.calls...)
+}
+
+// CallsTo returns the calls made to the given method so far, in order. Calls to a method
+// and its WithContext variant are both recorded using the name of the method.
+func (m *mockCalls) CallsTo(method string) []MockCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var calls []MockCall
+	for _, c := range m.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// ResetCalls forgets all calls made to the mock so far
+func (m *mockCalls) ResetCalls() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls = nil
+}
+
+// Returns the error returned by a mock when no function is set for the called method
+func notMocked(mock string, method string) error {
+	return fmt.Errorf("No function set for %s.%s: %w", mock, method, ErrNotMocked)
+}
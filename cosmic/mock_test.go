@@ -0,0 +1,144 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestMockClient(t *testing.T) {
+	cs, m := NewMockClient()
+	m.Zone.ListZonesFunc = func(ctx context.Context, p *ListZonesParams) (*ListZonesResponse, error) {
+		name, _ := p.p["name"].(string)
+		return &ListZonesResponse{Count: 1, Zones: []*Zone{{Id: "zone-id", Name: name}}}, nil
+	}
+
+	p := cs.Zone.NewListZonesParams()
+	p.SetName("zone1")
+	r, err := cs.Zone.ListZones(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Count != 1 || r.Zones[0].Name != "zone1" {
+		t.Errorf("Unexpected response: %+v", r)
+	}
+
+	// Calls using a context are recorded using the name of the method as well
+	if _, err := cs.Zone.ListZonesWithContext(context.Background(), cs.Zone.NewListZonesParams()); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := m.Zone.CallsTo("ListZones")
+	if len(calls) != 2 {
+		t.Fatalf("Expected 2 calls to ListZones, got %d", len(calls))
+	}
+	if len(calls[0].Args) != 1 || calls[0].Args[0] != p {
+		t.Errorf("Expected the params to be recorded, got %v", calls[0].Args)
+	}
+	if len(m.Zone.CallsTo("CreateZone")) != 0 || len(m.VirtualMachine.Calls()) != 0 {
+		t.Error("Expected no calls to other methods or mocks")
+	}
+
+	m.Zone.ResetCalls()
+	if len(m.Zone.Calls()) != 0 {
+		t.Errorf("Expected no calls after ResetCalls, got %d", len(m.Zone.Calls()))
+	}
+}
+
+func TestMockNotMocked(t *testing.T) {
+	cs, m := NewMockClient()
+
+	_, err := cs.VirtualMachine.StartVirtualMachine(cs.VirtualMachine.NewStartVirtualMachineParams("vm-id"))
+	if !errors.Is(err, ErrNotMocked) {
+		t.Fatalf("Expected ErrNotMocked, got %v", err)
+	}
+	if len(m.VirtualMachine.CallsTo("StartVirtualMachine")) != 1 {
+		t.Error("Expected the call to be recorded even though it is not mocked")
+	}
+}
+
+func TestMockIterator(t *testing.T) {
+	cs, m := NewMockClient()
+
+	var zones []*Zone
+	for i := 0; i < 5; i++ {
+		zones = append(zones, &Zone{Id: fmt.Sprintf("zone-%d", i)})
+	}
+	m.Zone.ListZonesFunc = func(ctx context.Context, p *ListZonesParams) (*ListZonesResponse, error) {
+		page, pagesize := p.p["page"].(int), p.p["pagesize"].(int)
+		start := (page - 1) * pagesize
+		end := start + pagesize
+		if end > len(zones) {
+			end = len(zones)
+		}
+		return &ListZonesResponse{Count: len(zones), Zones: zones[start:end]}, nil
+	}
+
+	i := cs.Zone.NewListZonesIterator(cs.Zone.NewListZonesParams(), 2)
+	var ids []string
+	for i.Next(context.Background()) {
+		ids = append(ids, i.Value().Id)
+	}
+	if err := i.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if fmt.Sprint(ids) != "[zone-0 zone-1 zone-2 zone-3 zone-4]" {
+		t.Errorf("Unexpected zones: %v", ids)
+	}
+	if i.Count() != 5 {
+		t.Errorf("Expected a count of 5, got %d", i.Count())
+	}
+	if calls := m.Zone.CallsTo("ListZones"); len(calls) != 3 {
+		t.Errorf("Expected 3 pages to be requested, got %d", len(calls))
+	}
+}
+
+func TestMockIteratorError(t *testing.T) {
+	cs, _ := NewMockClient()
+
+	i := cs.Zone.NewListZonesIterator(cs.Zone.NewListZonesParams(), 2)
+	if i.Next(context.Background()) {
+		t.Fatal("Expected no items")
+	}
+	if !errors.Is(i.Err(), ErrNotMocked) {
+		t.Errorf("Expected ErrNotMocked, got %v", i.Err())
+	}
+}
+
+func TestCloneKeepsMockedServices(t *testing.T) {
+	cs := NewClient("https://cosmic.example.com/client/api", "key", "secret", nil, 10)
+	zone := &MockZoneService{}
+	cs.Zone = zone
+
+	c, err := cs.Clone(WithAsync(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Zone != zone {
+		t.Error("Expected the clone to keep the mocked service")
+	}
+	if s, ok := c.VirtualMachine.(*VirtualMachineService); !ok || s.cs != c {
+		t.Error("Expected the services of the clone to use the clone")
+	}
+	if s := cs.VirtualMachine.(*VirtualMachineService); s.cs != cs {
+		t.Error("Expected the services of the original client to keep using the original client")
+	}
+}
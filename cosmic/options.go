@@ -0,0 +1,326 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// DefaultMaxGETLength is the default max length of the URL of a GET call. Longer requests are
+// send using a POST call, as many proxies and servers don't accept URLs longer than 4 or 8 KB.
+const DefaultMaxGETLength = 4096
+
+// DefaultRequestTimeout is the timeout used for HTTP requests made by a client
+// created with New, unless another timeout is configured with WithRequestTimeout.
+const DefaultRequestTimeout = 60 * time.Second
+
+// ClientOption configures a client created with New or Clone
+type ClientOption func(*CosmicClient) error
+
+// New creates a new client for communicating with the Cosmic API at apiURL. Credentials must be
+// configured with either WithAPIKey or WithLogin. By default the client does not wait for async
+// jobs to finish; use WithAsync to change that.
+//
+// A client created with New should not be changed after it is created, which makes it safe to
+// share across goroutines. Use Clone to get a copy of the client with different settings.
+func New(apiURL string, opts ...ClientOption) (*CosmicClient, error) {
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid API URL %q: %v", apiURL, err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("Invalid API URL %q: missing scheme or host", apiURL)
+	}
+
+	cs := newClient(apiURL, "", "", false, nil, int64(DefaultRequestTimeout/time.Second))
+	if err := cs.applyOptions(opts); err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
+// Clone returns a copy of the client with the given options applied on top of the settings of
+// the client. Cloning a client is cheap, as the HTTP client, retry policy, rate limiter and (when
+// using WithLogin) the session are shared with the clone unless they are overridden. Services
+// replaced by the user (for example with mocks) are shared with the clone as well.
+func (cs *CosmicClient) Clone(opts ...ClientOption) (*CosmicClient, error) {
+	c := *cs
+	c.interceptors = append([]Interceptor(nil), cs.interceptors...)
+	c.cloneServices(cs)
+
+	// The clone might use other credentials, so it must seed the API limit of its own account
+	if l, ok := c.limiter.(*apiLimiter); ok {
+		c.limiter = l.forClient(&c)
+	}
+
+	if err := c.applyOptions(opts); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// Applies all options to the client and verifies the result is a usable client
+func (cs *CosmicClient) applyOptions(opts []ClientOption) error {
+	for _, opt := range opts {
+		if err := opt(cs); err != nil {
+			return err
+		}
+	}
+
+	if cs.session == nil && (cs.apiKey == "" || cs.secret == "") {
+		return errors.New("Missing credentials: use either WithAPIKey or WithLogin")
+	}
+	return nil
+}
+
+// Makes sure the client uses its own copy of the HTTP client, so it can be
+// changed without affecting the (user supplied) HTTP client it was copied from
+func (cs *CosmicClient) copyHTTPClient() {
+	c := *cs.client
+	cs.client = &c
+}
+
+// WithHTTPClient makes the client use the given HTTP client for all requests. As this replaces
+// the HTTP client, it should be passed before WithTLSConfig and WithRequestTimeout, which both
+// configure a copy of the HTTP client without changing the one passed here.
+func WithHTTPClient(client *http.Client) ClientOption {
+	return func(cs *CosmicClient) error {
+		if client == nil {
+			return errors.New("The HTTP client cannot be nil")
+		}
+		cs.client = client
+		return nil
+	}
+}
+
+// WithTLSConfig sets the TLS configuration used to connect to the API. This only works when the
+// HTTP client uses the default transport or a *http.Transport.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(cs *CosmicClient) error {
+		var transport *http.Transport
+		switch t := cs.client.Transport.(type) {
+		case nil:
+			transport = http.DefaultTransport.(*http.Transport).Clone()
+		case *http.Transport:
+			transport = t.Clone()
+		default:
+			return fmt.Errorf("Unable to set the TLS config on a transport of type %T", t)
+		}
+		transport.TLSClientConfig = config
+
+		cs.copyHTTPClient()
+		cs.client.Transport = transport
+
+		return nil
+	}
+}
+
+// WithRequestTimeout sets the timeout of a single HTTP request, which defaults to DefaultRequestTimeout.
+// A timeout of zero means requests will not time out at all.
+func WithRequestTimeout(timeout time.Duration) ClientOption {
+	return func(cs *CosmicClient) error {
+		if timeout < 0 {
+			return fmt.Errorf("Invalid request timeout: %s", timeout)
+		}
+		cs.copyHTTPClient()
+		cs.client.Timeout = timeout
+		return nil
+	}
+}
+
+// WithUserAgent sets the user agent send with every request
+func WithUserAgent(userAgent string) ClientOption {
+	return func(cs *CosmicClient) error {
+		cs.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithAPIKey makes the client sign every request using the given API key and secret
+func WithAPIKey(apiKey string, secret string) ClientOption {
+	return func(cs *CosmicClient) error {
+		if apiKey == "" || secret == "" {
+			return errors.New("Both the API key and secret are required")
+		}
+		cs.apiKey = apiKey
+		cs.secret = secret
+		cs.session = nil
+		return nil
+	}
+}
+
+// WithLogin makes the client authenticate using a session obtained by calling the login command
+// with the given username, password and domain path (e.g. "/" for the ROOT domain). Call Close when
+// the client is no longer needed to end the session.
+func WithLogin(username string, password string, domain string) ClientOption {
+	return func(cs *CosmicClient) error {
+		if username == "" || password == "" {
+			return errors.New("Both the username and password are required")
+		}
+		cs.apiKey = ""
+		cs.secret = ""
+		cs.session = newSession(username, password, domain)
+		return nil
+	}
+}
+
+// WithAsync configures if the client waits for async jobs to finish, just like a client created
+// with NewAsyncClient does. Waiting is disabled by default.
+func WithAsync(wait bool) ClientOption {
+	return func(cs *CosmicClient) error {
+		cs.async = wait
+		return nil
+	}
+}
+
+// WithAsyncTimeout sets the max time to wait for an async job to finish, which defaults to 300 seconds
+func WithAsyncTimeout(timeout time.Duration) ClientOption {
+	return func(cs *CosmicClient) error {
+		if timeout < time.Second {
+			return fmt.Errorf("Invalid async timeout: %s", timeout)
+		}
+		cs.timeout = int64(timeout / time.Second)
+		return nil
+	}
+}
+
+// WithPollInterval configures how often a running async job is polled. The wait time between two
+// polls starts at interval and is increased by interval after every poll, until it reaches max. The
+// default interval is 1 second with a max of 15 seconds.
+func WithPollInterval(interval time.Duration, max time.Duration) ClientOption {
+	return func(cs *CosmicClient) error {
+		if interval <= 0 || max < interval {
+			return fmt.Errorf("Invalid poll interval %s with max %s", interval, max)
+		}
+		cs.pollInterval = interval
+		cs.maxPollInterval = max
+		return nil
+	}
+}
+
+// WithHTTPGETOnly configures if only HTTP GET calls should be used, also for the commands
+// that would otherwise be called using a POST call
+func WithHTTPGETOnly(getOnly bool) ClientOption {
+	return func(cs *CosmicClient) error {
+		cs.getOnly = getOnly
+		cs.HTTPGETOnly = getOnly
+		return nil
+	}
+}
+
+// WithSignatureExpiry makes every signed request use signature version 3, including an expiration
+// time of now plus the given validity. A validity of zero disables expiring requests again.
+func WithSignatureExpiry(validity time.Duration) ClientOption {
+	return func(cs *CosmicClient) error {
+		if validity < 0 {
+			return fmt.Errorf("Invalid signature validity: %s", validity)
+		}
+		cs.expires = validity
+		return nil
+	}
+}
+
+// WithRetryPolicy sets the policy used to retry failed requests, which defaults to the policy
+// returned by DefaultRetryPolicy. Passing nil disables retrying requests altogether.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(cs *CosmicClient) error {
+		cs.retry = policy
+		return nil
+	}
+}
+
+// WithInterceptors appends interceptors to the chain every request is passed through.
+// The first interceptor is the outermost one.
+func WithInterceptors(interceptors ...Interceptor) ClientOption {
+	return func(cs *CosmicClient) error {
+		cs.interceptors = append(cs.interceptors, interceptors...)
+		return nil
+	}
+}
+
+// WithRateLimit throttles the client to requestsPerSecond requests per second on average,
+// with bursts of at most burst requests. The limit is shared by all clones of the client.
+func WithRateLimit(requestsPerSecond float64, burst int) ClientOption {
+	return func(cs *CosmicClient) error {
+		if requestsPerSecond <= 0 {
+			return fmt.Errorf("Invalid rate limit: %f", requestsPerSecond)
+		}
+		cs.limiter = NewRateLimiter(requestsPerSecond, burst)
+		return nil
+	}
+}
+
+// WithRateLimiter makes the client use the given limiter to throttle requests.
+// Passing nil disables throttling requests.
+func WithRateLimiter(limiter RateLimiter) ClientOption {
+	return func(cs *CosmicClient) error {
+		cs.limiter = limiter
+		return nil
+	}
+}
+
+// WithPOSTCommands makes the client always use POST calls for the given commands, in addition to
+// deployVirtualMachine and updateVirtualMachine. This is ignored when only GET calls are allowed.
+func WithPOSTCommands(commands ...string) ClientOption {
+	return func(cs *CosmicClient) error {
+		cs.postCommands = append(append([]string(nil), cs.postCommands...), commands...)
+		return nil
+	}
+}
+
+// WithMaxGETLength makes the client use a POST call instead of a GET call when the URL of the GET
+// call would be longer than length bytes. A length of zero or less disables switching to POST calls
+// based on the length. This is ignored when only GET calls are allowed.
+func WithMaxGETLength(length int) ClientOption {
+	return func(cs *CosmicClient) error {
+		cs.maxGETLength = length
+		return nil
+	}
+}
+
+// WithMetrics makes the client report metrics about requests, async jobs and paginated
+// lists to m. Passing nil disables collecting metrics.
+func WithMetrics(m Metrics) ClientOption {
+	return func(cs *CosmicClient) error {
+		cs.metrics = m
+		return nil
+	}
+}
+
+// WithLogger makes the client log every HTTP request to logger at info level, and also the body
+// of every response when level is LogLevelDebug. Passing a nil logger disables logging.
+func WithLogger(logger Logger, level LogLevel) ClientOption {
+	return func(cs *CosmicClient) error {
+		cs.logger = logger
+		cs.logLevel = level
+		return nil
+	}
+}
+
+// WithLogBodySize sets the max number of bytes of a response body logged at debug level.
+// A size of zero or less disables truncating logged response bodies.
+func WithLogBodySize(size int) ClientOption {
+	return func(cs *CosmicClient) error {
+		cs.logBodySize = size
+		return nil
+	}
+}
@@ -0,0 +1,114 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestUsePOST(t *testing.T) {
+	long := url.Values{"userdata": {strings.Repeat("a", 100)}}
+
+	cases := []struct {
+		name    string
+		opts    []ClientOption
+		command string
+		params  url.Values
+		post    bool
+	}{
+		{"list", nil, "listZones", nil, false},
+		{"login", nil, "login", nil, true},
+		{"deploy", nil, "deployVirtualMachine", nil, true},
+		{"update", nil, "updateVirtualMachine", nil, true},
+		{"get only", []ClientOption{WithHTTPGETOnly(true)}, "deployVirtualMachine", nil, false},
+		{"get only login", []ClientOption{WithHTTPGETOnly(true)}, "login", nil, true},
+		{"post command", []ClientOption{WithPOSTCommands("listZones")}, "listzones", nil, true},
+		{"long URL", []ClientOption{WithMaxGETLength(100)}, "listZones", long, true},
+		{"short URL", []ClientOption{WithMaxGETLength(200)}, "listZones", long, false},
+		{"get only long URL", []ClientOption{WithHTTPGETOnly(true), WithMaxGETLength(100)}, "listZones", long, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			cs, err := New("http://cosmic.local/client/api", append([]ClientOption{WithAPIKey("key", "secret")}, c.opts...)...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if post := cs.usePOST(c.command, c.params); post != c.post {
+				t.Errorf("Expected POST to be %v for %s, got %v", c.post, c.command, post)
+			}
+		})
+	}
+}
+
+func TestHTTPGETOnly(t *testing.T) {
+	var method string
+	cs, s := newStubClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		respond(w, http.StatusOK, `{"updatevirtualmachineresponse":{"virtualmachine":{"id":"vm-1"}}}`)
+	})
+	defer s.Close()
+
+	clone, err := cs.Clone(WithHTTPGETOnly(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !clone.HTTPGETOnly || cs.HTTPGETOnly {
+		t.Errorf("Expected the deprecated field to report the option, got %v and %v", clone.HTTPGETOnly, cs.HTTPGETOnly)
+	}
+
+	if _, err := clone.VirtualMachine.UpdateVirtualMachine(clone.VirtualMachine.NewUpdateVirtualMachineParams("vm-1")); err != nil {
+		t.Fatal(err)
+	}
+	if method != "GET" {
+		t.Errorf("Expected a GET call, got %s", method)
+	}
+
+	// The client it was cloned from still uses POST calls
+	if _, err := cs.VirtualMachine.UpdateVirtualMachine(cs.VirtualMachine.NewUpdateVirtualMachineParams("vm-1")); err != nil {
+		t.Fatal(err)
+	}
+	if method != "POST" {
+		t.Errorf("Expected a POST call, got %s", method)
+	}
+}
+
+func TestHTTPGETOnlyField(t *testing.T) {
+	var method string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		respond(w, http.StatusOK, `{"updatevirtualmachineresponse":{"virtualmachine":{"id":"vm-1"}}}`)
+	}))
+	defer s.Close()
+
+	cs := NewClient(s.URL, "stub-api-key", "stub-secret", nil, 10)
+	cs.HTTPGETOnly = true
+
+	if _, err := cs.VirtualMachine.UpdateVirtualMachine(cs.VirtualMachine.NewUpdateVirtualMachineParams("vm-1")); err != nil {
+		t.Fatal(err)
+	}
+	if method != "GET" {
+		t.Errorf("Expected a GET call, got %s", method)
+	}
+	if !cs.usePOST("login", nil) || cs.usePOST("listZones", url.Values{"userdata": {strings.Repeat("a", 8192)}}) {
+		t.Error("Expected only the login command to use a POST call")
+	}
+}
@@ -0,0 +1,204 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+// DefaultPageSize is the page size used by list iterators when no (valid) page size is given
+const DefaultPageSize = 500
+
+// pager contains the state shared by all generated list iterators. It requests the pages of
+// a list command one at a time, using a new copy of the parameters of the command for every page.
+type pager struct {
+	cs       *CosmicClient
+	command  string
+	params   url.Values
+	pagesize int
+
+	// Function requesting a single page instead of the client, used by the iterators of mocks
+	fetch func(ctx context.Context, page int, pagesize int) (interface{}, error)
+
+	page  int  // Last requested page
+	count int  // Total number of items as reported by the last page
+	seen  int  // Number of items received so far
+	done  bool // True if there are no more pages, or an error occurred
+	err   error
+}
+
+// Returns a new pager for the given command. The params are copied, so changing
+// them after creating the pager does not affect the requests made.
+func newPager(cs *CosmicClient, command string, params url.Values, pagesize int) pager {
+	if pagesize <= 0 {
+		pagesize = DefaultPageSize
+	}
+
+	return pager{
+		cs:       cs,
+		command:  command,
+		params:   copyValues(params),
+		pagesize: pagesize,
+	}
+}
+
+// Requests the next page and returns the raw response, or nil if there are no more
+// pages or the request failed, in which case the error is returned by Err.
+func (p *pager) nextPage(ctx context.Context) json.RawMessage {
+	if p.done {
+		return nil
+	}
+
+	p.page++
+	if p.fetch != nil {
+		return p.fetchPage(ctx)
+	}
+
+	// Every page is requested using its own params, so nothing of a previous request is reused
+	params := copyValues(p.params)
+	params.Set("page", strconv.Itoa(p.page))
+	params.Set("pagesize", strconv.Itoa(p.pagesize))
+
+	resp, err := p.cs.newRequest(ctx, p.command, params)
+	if err != nil {
+		p.fail(err)
+		return nil
+	}
+	return resp
+}
+
+// Requests the current page using the fetch function, and returns it encoded as a raw response
+func (p *pager) fetchPage(ctx context.Context) json.RawMessage {
+	v, err := p.fetch(ctx, p.page, p.pagesize)
+	if err != nil {
+		p.fail(err)
+		return nil
+	}
+
+	resp, err := json.Marshal(v)
+	if err != nil {
+		p.fail(err)
+		return nil
+	}
+	return resp
+}
+
+// Records a received page containing n of the count items of the list
+func (p *pager) received(count, n int) {
+	p.count = count
+	p.seen += n
+
+	// A short page means this was the last one, even if the count says otherwise
+	if n < p.pagesize || p.seen >= p.count {
+		p.done = true
+	}
+}
+
+// Stops the iteration because of the given error
+func (p *pager) fail(err error) {
+	p.err = err
+	p.done = true
+}
+
+// Count returns the total number of items as reported by the last received page
+func (p *pager) Count() int {
+	return p.count
+}
+
+// Err returns the error that stopped the iteration, if any
+func (p *pager) Err() error {
+	return p.err
+}
+
+// WithConcurrentPages makes list commands request the remaining pages of a response concurrently,
+// using at most n concurrent requests, once the first page reported the total number of items.
+// The items are still returned in the same order as when requesting the pages one by one.
+func WithConcurrentPages(n int) ClientOption {
+	return func(cs *CosmicClient) error {
+		if n < 1 {
+			return fmt.Errorf("Invalid number of concurrent pages: %d", n)
+		}
+		cs.pageConcurrency = n
+		return nil
+	}
+}
+
+// Concurrently requests all pages following the first page of a list response containing
+// count items, and returns the raw responses in page order. All pages are requested using
+// a copy of values with the given page size set. If any request fails, the remaining
+// requests are canceled and the first error is returned.
+func (cs *CosmicClient) fetchPages(ctx context.Context, command string, values url.Values, pagesize, count int) ([]json.RawMessage, error) {
+	if pagesize <= 0 || count <= pagesize {
+		return nil, nil
+	}
+	last := (count + pagesize - 1) / pagesize
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pages := make([]json.RawMessage, last-1)
+	sem := make(chan struct{}, cs.pageConcurrency)
+
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+
+	for page := 2; page <= last; page++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		// Every request needs its own params, as each one requests another page
+		params := copyValues(values)
+		params.Set("page", strconv.Itoa(page))
+		params.Set("pagesize", strconv.Itoa(pagesize))
+
+		wg.Add(1)
+		go func(page int, params url.Values) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			resp, err := cs.newRequest(ctx, command, params)
+			if err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			pages[page-2] = resp
+		}(page, params)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return pages, nil
+}
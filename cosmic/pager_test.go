@@ -0,0 +1,250 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Returns a handler listing n zones, paged using the page and pagesize params
+func listZonesHandler(n int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start, end := 0, n
+		if r.FormValue("page") != "" {
+			page, _ := strconv.Atoi(r.FormValue("page"))
+			pagesize, _ := strconv.Atoi(r.FormValue("pagesize"))
+			start, end = (page-1)*pagesize, page*pagesize
+			if start > n {
+				start = n
+			}
+			if end > n {
+				end = n
+			}
+		}
+
+		var zones []string
+		for i := start; i < end; i++ {
+			zones = append(zones, fmt.Sprintf(`{"id":"zone-%d"}`, i+1))
+		}
+		respond(w, http.StatusOK, fmt.Sprintf(`{"listzonesresponse":{"count":%d,"zone":[%s]}}`, n, strings.Join(zones, ",")))
+	}
+}
+
+// Returns an interceptor appending the params of every request to seen
+func recordParams(seen *[]url.Values) Interceptor {
+	return func(ctx context.Context, command string, params url.Values, next RequestHandler) (json.RawMessage, error) {
+		*seen = append(*seen, copyValues(params))
+		return next(ctx, command, params)
+	}
+}
+
+func TestListIterator(t *testing.T) {
+	var seen []url.Values
+	cs, s := newStubClient(t, listZonesHandler(5), WithInterceptors(recordParams(&seen)))
+	defer s.Close()
+
+	p := cs.Zone.NewListZonesParams()
+	p.SetAvailable(true)
+
+	var ids []string
+	it := cs.Zone.NewListZonesIterator(p, 2)
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Value().Id)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"zone-1", "zone-2", "zone-3", "zone-4", "zone-5"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("Expected zones %v, got %v", want, ids)
+	}
+	if len(seen) != 3 {
+		t.Fatalf("Expected 3 pages to be requested, got %d", len(seen))
+	}
+	for i, params := range seen {
+		want := url.Values{"available": {"true"}, "page": {strconv.Itoa(i + 1)}, "pagesize": {"2"}}
+		if !reflect.DeepEqual(params, want) {
+			t.Errorf("Expected page %d to be requested using %s, got %s", i+1, want.Encode(), params.Encode())
+		}
+	}
+	if got := p.toURLValues(); !reflect.DeepEqual(got, url.Values{"available": {"true"}}) {
+		t.Errorf("Expected the params of the caller to be unchanged, got %s", got.Encode())
+	}
+}
+
+func TestListIteratorStopsEarly(t *testing.T) {
+	var seen []url.Values
+	cs, s := newStubClient(t, listZonesHandler(10), WithInterceptors(recordParams(&seen)))
+	defer s.Close()
+
+	it := cs.Zone.NewListZonesIterator(cs.Zone.NewListZonesParams(), 2)
+	for i := 0; i < 3 && it.Next(context.Background()); i++ {
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(seen) != 2 {
+		t.Errorf("Expected only the 2 pages needed to be requested, got %d", len(seen))
+	}
+}
+
+func TestListIteratorError(t *testing.T) {
+	cs, s := newStubClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("page") == "2" {
+			respond(w, 431, `{"listzonesresponse":{"errorcode":431,"errortext":"invalid page"}}`)
+			return
+		}
+		listZonesHandler(5)(w, r)
+	})
+	defer s.Close()
+
+	n := 0
+	it := cs.Zone.NewListZonesIterator(cs.Zone.NewListZonesParams(), 2)
+	for it.Next(context.Background()) {
+		n++
+	}
+	if n != 2 {
+		t.Errorf("Expected the 2 zones of the first page, got %d", n)
+	}
+	if err := it.Err(); !errors.Is(err, ErrParameter) {
+		t.Errorf("Expected a parameter error, got %v", err)
+	}
+}
+
+// Returns a handler like listZonesHandler, that returns at most max zones when no page is
+// requested and tracks the requested pages and the number of concurrent requests
+func pagedZonesHandler(n int, max int, inflight *concurrency) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		inflight.start(r.FormValue("page") + "/" + r.FormValue("pagesize"))
+		defer inflight.done()
+
+		if r.FormValue("page") == "" {
+			r.Form.Set("page", "1")
+			r.Form.Set("pagesize", strconv.Itoa(max))
+		} else {
+			// Give the other pages the time to be requested concurrently
+			time.Sleep(10 * time.Millisecond)
+		}
+		listZonesHandler(n)(w, r)
+	}
+}
+
+// concurrency tracks the requested pages and the max number of requests handled at the same time
+type concurrency struct {
+	mu      sync.Mutex
+	current int
+	max     int
+	pages   []string
+}
+
+func (c *concurrency) start(page string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.pages = append(c.pages, page)
+	c.current++
+	if c.current > c.max {
+		c.max = c.current
+	}
+}
+
+func (c *concurrency) done() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.current--
+}
+
+func TestConcurrentPages(t *testing.T) {
+	cases := []struct {
+		name        string
+		concurrency int
+		maxInflight int
+	}{
+		{"sequential", 1, 1},
+		{"concurrent", 3, 3},
+		{"more workers than pages", 10, 4},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			inflight := &concurrency{}
+			cs, s := newStubClient(t, pagedZonesHandler(9, 2, inflight), WithConcurrentPages(c.concurrency))
+			defer s.Close()
+
+			l, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// The zones are returned in order, no matter the order the pages are received in
+			if l.Count != 9 || len(l.Zones) != 9 {
+				t.Fatalf("Expected 9 zones, got %d (count %d)", len(l.Zones), l.Count)
+			}
+			for i, z := range l.Zones {
+				if z.Id != fmt.Sprintf("zone-%d", i+1) {
+					t.Errorf("Expected zone-%d at index %d, got %s", i+1, i, z.Id)
+				}
+			}
+
+			sort.Strings(inflight.pages)
+			if !reflect.DeepEqual(inflight.pages, []string{"/", "2/2", "3/2", "4/2", "5/2"}) {
+				t.Errorf("Expected the first request and pages 2 to 5, got %v", inflight.pages)
+			}
+			if inflight.max > c.maxInflight || (c.maxInflight > 1 && inflight.max < 2) {
+				t.Errorf("Expected at most %d concurrent requests, got %d", c.maxInflight, inflight.max)
+			}
+		})
+	}
+}
+
+func TestConcurrentPagesError(t *testing.T) {
+	inflight := &concurrency{}
+	handler := pagedZonesHandler(9, 2, inflight)
+	cs, s := newStubClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("page") == "3" {
+			respond(w, 431, `{"listzonesresponse":{"errorcode":431,"errortext":"invalid page"}}`)
+			return
+		}
+		handler(w, r)
+	}, WithConcurrentPages(4))
+	defer s.Close()
+
+	if _, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams()); !errors.Is(err, ErrParameter) {
+		t.Errorf("Expected a parameter error, got %v", err)
+	}
+}
+
+func TestWithConcurrentPages(t *testing.T) {
+	for _, n := range []int{0, -1} {
+		if _, err := New("http://cosmic.local", WithAPIKey("key", "secret"), WithConcurrentPages(n)); err == nil {
+			t.Errorf("Expected an error for %d concurrent pages", n)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Upper bounds of the histogram buckets used by PrometheusMetrics
+var (
+	requestDurationBuckets = []float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+	jobDurationBuckets     = []float64{0.5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600, 1800}
+	pageBuckets            = []float64{1, 2, 5, 10, 20, 50, 100}
+)
+
+// PrometheusMetrics is a Metrics implementation that keeps the collected metrics in memory and
+// renders them in the Prometheus text exposition format, without depending on the Prometheus
+// client library. It exposes the following metrics, all labeled with the command:
+//
+//	cosmic_request_duration_seconds    histogram of the duration of requests
+//	cosmic_request_errors_total        counter of failed requests, also labeled with the errorcode
+//	cosmic_async_job_duration_seconds  histogram of the time spent waiting for async jobs, also
+//	                                   labeled with the status (success, failed or pending)
+//	cosmic_list_pages                  histogram of the number of pages requested per list
+//
+// A PrometheusMetrics is an http.Handler, so it can be served on a metrics endpoint directly.
+type PrometheusMetrics struct {
+	mu       sync.Mutex
+	requests map[string]*histogram
+	errors   map[commandLabel]uint64
+	jobs     map[commandLabel]*histogram
+	pages    map[string]*histogram
+}
+
+// NewPrometheusMetrics returns a new, empty PrometheusMetrics
+func NewPrometheusMetrics() *PrometheusMetrics {
+	return &PrometheusMetrics{
+		requests: make(map[string]*histogram),
+		errors:   make(map[commandLabel]uint64),
+		jobs:     make(map[commandLabel]*histogram),
+		pages:    make(map[string]*histogram),
+	}
+}
+
+// commandLabel identifies the metrics of a command with an additional label
+type commandLabel struct {
+	command string
+	label   string
+}
+
+type histogram struct {
+	buckets []float64
+	counts  []uint64 // Number of observations per bucket, not cumulative
+	count   uint64
+	sum     float64
+}
+
+func newHistogram(buckets []float64) *histogram {
+	return &histogram{buckets: buckets, counts: make([]uint64, len(buckets))}
+}
+
+func (h *histogram) observe(v float64) {
+	for i, le := range h.buckets {
+		if v <= le {
+			h.counts[i]++
+			break
+		}
+	}
+	h.count++
+	h.sum += v
+}
+
+// ObserveRequest implements Metrics
+func (m *PrometheusMetrics) ObserveRequest(command string, duration time.Duration, errorcode int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	h, ok := m.requests[command]
+	if !ok {
+		h = newHistogram(requestDurationBuckets)
+		m.requests[command] = h
+	}
+	h.observe(duration.Seconds())
+
+	if errorcode != 0 {
+		m.errors[commandLabel{command, strconv.Itoa(errorcode)}]++
+	}
+}
+
+// ObserveAsyncJob implements Metrics
+func (m *PrometheusMetrics) ObserveAsyncJob(command string, duration time.Duration, status int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var s string
+	switch status {
+	case JobStatusSuccess:
+		s = "success"
+	case JobStatusFailed:
+		s = "failed"
+	default:
+		s = "pending"
+	}
+	h, ok := m.jobs[commandLabel{command, s}]
+	if !ok {
+		h = newHistogram(jobDurationBuckets)
+		m.jobs[commandLabel{command, s}] = h
+	}
+	h.observe(duration.Seconds())
+}
+
+// ObservePages implements Metrics
+func (m *PrometheusMetrics) ObservePages(command string, pages int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	h, ok := m.pages[command]
+	if !ok {
+		h = newHistogram(pageBuckets)
+		m.pages[command] = h
+	}
+	h.observe(float64(pages))
+}
+
+// WriteTo writes all collected metrics to w in the Prometheus text exposition format
+func (m *PrometheusMetrics) WriteTo(w io.Writer) (int64, error) {
+	var b bytes.Buffer
+
+	m.mu.Lock()
+	header(&b, "cosmic_request_duration_seconds", "histogram", "Duration of requests to the Cosmic API.")
+	for _, command := range sortedKeys(m.requests) {
+		writeHistogram(&b, "cosmic_request_duration_seconds", labels("command", command), m.requests[command])
+	}
+
+	header(&b, "cosmic_request_errors_total", "counter", "Number of requests to the Cosmic API that returned an error.")
+	errors := make([]commandLabel, 0, len(m.errors))
+	for k := range m.errors {
+		errors = append(errors, k)
+	}
+	sortCommandLabels(errors)
+	for _, k := range errors {
+		fmt.Fprintf(&b, "cosmic_request_errors_total{%s} %d\n", labels("command", k.command, "errorcode", k.label), m.errors[k])
+	}
+
+	header(&b, "cosmic_async_job_duration_seconds", "histogram", "Time spent waiting for async jobs to finish.")
+	jobs := make([]commandLabel, 0, len(m.jobs))
+	for k := range m.jobs {
+		jobs = append(jobs, k)
+	}
+	sortCommandLabels(jobs)
+	for _, k := range jobs {
+		writeHistogram(&b, "cosmic_async_job_duration_seconds", labels("command", k.command, "status", k.label), m.jobs[k])
+	}
+
+	header(&b, "cosmic_list_pages", "histogram", "Number of pages requested to list all results.")
+	for _, command := range sortedKeys(m.pages) {
+		writeHistogram(&b, "cosmic_list_pages", labels("command", command), m.pages[command])
+	}
+	m.mu.Unlock()
+
+	return b.WriteTo(w)
+}
+
+// ServeHTTP implements http.Handler by writing all collected metrics
+func (m *PrometheusMetrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.WriteTo(w)
+}
+
+func header(b *bytes.Buffer, name, typ, help string) {
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+}
+
+func writeHistogram(b *bytes.Buffer, name string, labels string, h *histogram) {
+	var cumulative uint64
+	for i, le := range h.buckets {
+		cumulative += h.counts[i]
+		fmt.Fprintf(b, "%s_bucket{%s,le=\"%s\"} %d\n", name, labels, formatFloat(le), cumulative)
+	}
+	fmt.Fprintf(b, "%s_bucket{%s,le=\"+Inf\"} %d\n", name, labels, h.count)
+	fmt.Fprintf(b, "%s_sum{%s} %s\n", name, labels, formatFloat(h.sum))
+	fmt.Fprintf(b, "%s_count{%s} %d\n", name, labels, h.count)
+}
+
+// Returns the given label names and values formatted as a label set, without the braces
+func labels(pairs ...string) string {
+	var l []string
+	for i := 0; i < len(pairs); i += 2 {
+		l = append(l, fmt.Sprintf("%s=\"%s\"", pairs[i], labelEscaper.Replace(pairs[i+1])))
+	}
+	return strings.Join(l, ",")
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func sortedKeys(m map[string]*histogram) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortCommandLabels(keys []commandLabel) {
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].command != keys[j].command {
+			return keys[i].command < keys[j].command
+		}
+		return keys[i].label < keys[j].label
+	})
+}
@@ -0,0 +1,188 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const expectedMetrics = `# HELP cosmic_request_duration_seconds Duration of requests to the Cosmic API.
+# TYPE cosmic_request_duration_seconds histogram
+cosmic_request_duration_seconds_bucket{command="listZones",le="0.01"} 0
+cosmic_request_duration_seconds_bucket{command="listZones",le="0.025"} 1
+cosmic_request_duration_seconds_bucket{command="listZones",le="0.05"} 1
+cosmic_request_duration_seconds_bucket{command="listZones",le="0.1"} 1
+cosmic_request_duration_seconds_bucket{command="listZones",le="0.25"} 1
+cosmic_request_duration_seconds_bucket{command="listZones",le="0.5"} 1
+cosmic_request_duration_seconds_bucket{command="listZones",le="1"} 1
+cosmic_request_duration_seconds_bucket{command="listZones",le="2.5"} 1
+cosmic_request_duration_seconds_bucket{command="listZones",le="5"} 2
+cosmic_request_duration_seconds_bucket{command="listZones",le="10"} 2
+cosmic_request_duration_seconds_bucket{command="listZones",le="30"} 2
+cosmic_request_duration_seconds_bucket{command="listZones",le="+Inf"} 2
+cosmic_request_duration_seconds_sum{command="listZones"} 3.02
+cosmic_request_duration_seconds_count{command="listZones"} 2
+# HELP cosmic_request_errors_total Number of requests to the Cosmic API that returned an error.
+# TYPE cosmic_request_errors_total counter
+cosmic_request_errors_total{command="listZones",errorcode="431"} 1
+# HELP cosmic_async_job_duration_seconds Time spent waiting for async jobs to finish.
+# TYPE cosmic_async_job_duration_seconds histogram
+cosmic_async_job_duration_seconds_bucket{command="deployVirtualMachine",status="success",le="0.5"} 0
+cosmic_async_job_duration_seconds_bucket{command="deployVirtualMachine",status="success",le="1"} 0
+cosmic_async_job_duration_seconds_bucket{command="deployVirtualMachine",status="success",le="2.5"} 1
+cosmic_async_job_duration_seconds_bucket{command="deployVirtualMachine",status="success",le="5"} 1
+cosmic_async_job_duration_seconds_bucket{command="deployVirtualMachine",status="success",le="10"} 1
+cosmic_async_job_duration_seconds_bucket{command="deployVirtualMachine",status="success",le="30"} 1
+cosmic_async_job_duration_seconds_bucket{command="deployVirtualMachine",status="success",le="60"} 1
+cosmic_async_job_duration_seconds_bucket{command="deployVirtualMachine",status="success",le="120"} 1
+cosmic_async_job_duration_seconds_bucket{command="deployVirtualMachine",status="success",le="300"} 1
+cosmic_async_job_duration_seconds_bucket{command="deployVirtualMachine",status="success",le="600"} 1
+cosmic_async_job_duration_seconds_bucket{command="deployVirtualMachine",status="success",le="1800"} 1
+cosmic_async_job_duration_seconds_bucket{command="deployVirtualMachine",status="success",le="+Inf"} 1
+cosmic_async_job_duration_seconds_sum{command="deployVirtualMachine",status="success"} 2
+cosmic_async_job_duration_seconds_count{command="deployVirtualMachine",status="success"} 1
+# HELP cosmic_list_pages Number of pages requested to list all results.
+# TYPE cosmic_list_pages histogram
+cosmic_list_pages_bucket{command="listZones",le="1"} 0
+cosmic_list_pages_bucket{command="listZones",le="2"} 0
+cosmic_list_pages_bucket{command="listZones",le="5"} 1
+cosmic_list_pages_bucket{command="listZones",le="10"} 1
+cosmic_list_pages_bucket{command="listZones",le="20"} 1
+cosmic_list_pages_bucket{command="listZones",le="50"} 1
+cosmic_list_pages_bucket{command="listZones",le="100"} 1
+cosmic_list_pages_bucket{command="listZones",le="+Inf"} 1
+cosmic_list_pages_sum{command="listZones"} 3
+cosmic_list_pages_count{command="listZones"} 1
+`
+
+func TestPrometheusMetrics(t *testing.T) {
+	m := NewPrometheusMetrics()
+	m.ObserveRequest("listZones", 20*time.Millisecond, 0)
+	m.ObserveRequest("listZones", 3*time.Second, ErrorCodeParamError)
+	m.ObserveAsyncJob("deployVirtualMachine", 2*time.Second, JobStatusSuccess)
+	m.ObservePages("listZones", 3)
+
+	var b bytes.Buffer
+	if _, err := m.WriteTo(&b); err != nil {
+		t.Fatal(err)
+	}
+	if b.String() != expectedMetrics {
+		t.Errorf("Unexpected metrics:\n%s", b.String())
+	}
+}
+
+func TestPrometheusMetricsEmpty(t *testing.T) {
+	var b bytes.Buffer
+	if _, err := NewPrometheusMetrics().WriteTo(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the HELP and TYPE lines of the 4 metrics
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	if len(lines) != 8 {
+		t.Fatalf("Expected 8 lines, got %d:\n%s", len(lines), b.String())
+	}
+	for _, l := range lines {
+		if !strings.HasPrefix(l, "# HELP ") && !strings.HasPrefix(l, "# TYPE ") {
+			t.Errorf("Unexpected line: %s", l)
+		}
+	}
+}
+
+func TestPrometheusMetricsSorted(t *testing.T) {
+	m := NewPrometheusMetrics()
+	m.ObserveRequest("listZones", time.Millisecond, -1)
+	m.ObserveRequest("deployVirtualMachine", time.Millisecond, ErrorCodeInternalError)
+	m.ObserveRequest("listZones", time.Millisecond, ErrorCodeParamError)
+	m.ObserveAsyncJob("startVirtualMachine", time.Second, JobStatusFailed)
+	m.ObserveAsyncJob("startVirtualMachine", time.Second, JobStatusPending)
+
+	var b bytes.Buffer
+	m.WriteTo(&b)
+
+	var got []string
+	for _, l := range strings.Split(b.String(), "\n") {
+		if strings.HasPrefix(l, "cosmic_request_errors_total{") || strings.HasPrefix(l, "cosmic_async_job_duration_seconds_count{") {
+			got = append(got, l)
+		}
+	}
+	want := []string{
+		`cosmic_request_errors_total{command="deployVirtualMachine",errorcode="530"} 1`,
+		`cosmic_request_errors_total{command="listZones",errorcode="-1"} 1`,
+		`cosmic_request_errors_total{command="listZones",errorcode="431"} 1`,
+		`cosmic_async_job_duration_seconds_count{command="startVirtualMachine",status="failed"} 1`,
+		`cosmic_async_job_duration_seconds_count{command="startVirtualMachine",status="pending"} 1`,
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Expected:\n%s\ngot:\n%s", strings.Join(want, "\n"), strings.Join(got, "\n"))
+	}
+}
+
+func TestPrometheusLabels(t *testing.T) {
+	cases := []struct {
+		value string
+		want  string
+	}{
+		{"listZones", `command="listZones"`},
+		{`say "hi"`, `command="say \"hi\""`},
+		{`C:\cosmic`, `command="C:\\cosmic"`},
+		{"two\nlines", `command="two\nlines"`},
+	}
+
+	for _, c := range cases {
+		if got := labels("command", c.value); got != c.want {
+			t.Errorf("Expected %s, got %s", c.want, got)
+		}
+	}
+}
+
+func TestPrometheusHandler(t *testing.T) {
+	var calls int32
+	cs, s := newStubClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			respond(w, http.StatusOK, `{"listzonesresponse":{"count":1,"zone":[{"id":"zone-1"}]}}`)
+			return
+		}
+		respond(w, 431, `{"listzonesresponse":{"errorcode":431,"errortext":"invalid"}}`)
+	}, WithMetrics(NewPrometheusMetrics()))
+	defer s.Close()
+
+	cs.Zone.ListZones(cs.Zone.NewListZonesParams())
+	cs.Zone.ListZones(cs.Zone.NewListZonesParams())
+
+	rec := httptest.NewRecorder()
+	cs.metrics.(*PrometheusMetrics).ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	if ct := rec.Header().Get("Content-Type"); ct != "text/plain; version=0.0.4; charset=utf-8" {
+		t.Errorf("Unexpected content type: %s", ct)
+	}
+	for _, want := range []string{
+		`cosmic_request_duration_seconds_count{command="listZones"} 2`,
+		`cosmic_request_errors_total{command="listZones",errorcode="431"} 1`,
+		`cosmic_list_pages_count{command="listZones"} 1`,
+	} {
+		if !strings.Contains(rec.Body.String(), want+"\n") {
+			t.Errorf("Expected the metrics to contain %s, got:\n%s", want, rec.Body.String())
+		}
+	}
+}
@@ -0,0 +1,315 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// RateLimiter throttles the requests made by a client
+type RateLimiter interface {
+	// Wait blocks until the next request is allowed to be send, or until the
+	// context is done in which case the error of the context is returned.
+	Wait(ctx context.Context) error
+}
+
+// tokenBucket is a RateLimiter allowing bursts of up to burst requests,
+// while refilling at a rate of rate requests per second.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a RateLimiter that allows requestsPerSecond requests
+// per second on average, with bursts of at most burst requests.
+func NewRateLimiter(requestsPerSecond float64, burst int) RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait implements the RateLimiter interface
+func (tb *tokenBucket) Wait(ctx context.Context) error {
+	if tb.rate <= 0 {
+		return nil
+	}
+
+	tb.mu.Lock()
+	now := time.Now()
+
+	// Refill the bucket with the tokens gained since the last request
+	tb.tokens += now.Sub(tb.last).Seconds() * tb.rate
+	if tb.tokens > tb.burst {
+		tb.tokens = tb.burst
+	}
+	tb.last = now
+
+	// Take a token, possibly going into debt when the bucket is empty so
+	// concurrent callers queue up instead of all waking up at the same time
+	tb.tokens--
+	wait := time.Duration(-tb.tokens / tb.rate * float64(time.Second))
+	tb.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	if err := sleepWithContext(ctx, wait); err != nil {
+		// Give back the token, as it was not used
+		tb.mu.Lock()
+		tb.tokens++
+		tb.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// apiLimiter is a RateLimiter that keeps the client within the API limit of its account.
+// It seeds the budget of the account by calling getApiLimit at the start of every limit window
+// and then counts the requests made, blocking all callers when the budget is used until the
+// window is reset. This only works well if the account is not used by other clients at the
+// same time.
+type apiLimiter struct {
+	cs      *CosmicClient // Client used to call getApiLimit
+	windows *apiWindows   // Windows of all accounts, shared by all clones of the client
+}
+
+// apiWindows contains the current limit window of every account, keyed by the API URL and
+// the credentials used
+type apiWindows struct {
+	mu      sync.Mutex
+	windows map[string]*apiWindow
+}
+
+// apiWindow contains the budget of an account in the current limit window
+type apiWindow struct {
+	mu        sync.Mutex
+	remaining int           // Requests left in the current window; -1 means unlimited
+	reset     time.Time     // Time at which the current window is reset
+	backoff   time.Duration // Time to wait after the API still reported the limit being exceeded
+	seeding   chan struct{} // Closed when the running getApiLimit call finishes; nil if there is none
+}
+
+// WithAPILimit makes the client throttle its requests so it stays within the API limit
+// reported by the getApiLimit command. When API throttling is not enabled on the management
+// server, requests are not throttled at all. When getApiLimit fails for another reason, the
+// request fails with that error and the next request tries again. The limiter is shared by all clones of the
+// client, but keeps a separate budget for every account (API key or login) the clones use.
+func WithAPILimit() ClientOption {
+	return func(cs *CosmicClient) error {
+		cs.limiter = &apiLimiter{cs: cs, windows: &apiWindows{windows: make(map[string]*apiWindow)}}
+		return nil
+	}
+}
+
+// Returns a limiter sharing the windows of this limiter, that uses the given client to call
+// getApiLimit. It is called when cloning a client, as the clone might use other credentials.
+func (l *apiLimiter) forClient(cs *CosmicClient) *apiLimiter {
+	return &apiLimiter{cs: cs, windows: l.windows}
+}
+
+// Returns the window of the account used by the client
+func (l *apiLimiter) window() *apiWindow {
+	// The API limit applies to an account, which is exactly what the cache is scoped by
+	scope := l.cs.cacheScope()
+
+	l.windows.mu.Lock()
+	defer l.windows.mu.Unlock()
+
+	w, ok := l.windows.windows[scope]
+	if !ok {
+		w = &apiWindow{}
+		l.windows.windows[scope] = w
+	}
+	return w
+}
+
+// Wait implements the RateLimiter interface. If the budget of a new window cannot be
+// seeded, the error of the getApiLimit call is returned and the window stays unseeded.
+func (l *apiLimiter) Wait(ctx context.Context) error {
+	w := l.window()
+
+	for {
+		w.mu.Lock()
+		if !time.Now().Before(w.reset) {
+			if seeding := w.seeding; seeding != nil {
+				// Another caller is seeding the budget of the new window, so wait for it
+				w.mu.Unlock()
+				select {
+				case <-seeding:
+					continue
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+
+			// Seed the budget of the new window without holding the lock during the call
+			seeding := make(chan struct{})
+			w.seeding = seeding
+			w.mu.Unlock()
+
+			err := l.seed(ctx, w)
+
+			w.mu.Lock()
+			w.seeding = nil
+			close(seeding)
+			w.mu.Unlock()
+
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if w.remaining != 0 {
+			if w.remaining > 0 {
+				w.remaining--
+			}
+			w.mu.Unlock()
+			return nil
+		}
+
+		wait := time.Until(w.reset)
+		w.mu.Unlock()
+
+		if err := sleepWithContext(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// Calls getApiLimit to get the budget and reset time of the current window. Must be called
+// without holding w.mu. The window is left unchanged if an error is returned.
+func (l *apiLimiter) seed(ctx context.Context, w *apiWindow) error {
+	params := url.Values{}
+	params.Set("command", "getApiLimit")
+	params.Set("response", "json")
+
+	// Call doRequest directly, as going through the limiter again would deadlock
+	b, err := l.cs.doRequest(ctx, "getApiLimit", params)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if err != nil {
+		var e *CSError
+		switch {
+		case isAPILimitError(err):
+			w.exceeded()
+			return nil
+		case errors.As(err, &e) && e.ErrorCode == ErrorCodeUnsupportedAction:
+			// API throttling is not enabled, so don't throttle for a while
+			w.unlimited()
+			return nil
+		}
+		return err
+	}
+
+	r, err := decodeAPILimit(b)
+	if err != nil {
+		return fmt.Errorf("Unable to decode the getApiLimit response: %v", err)
+	}
+	if r.ApiAllowed <= 0 {
+		w.unlimited()
+		return nil
+	}
+
+	// The getApiLimit call itself might not be counted yet, so be on the safe side
+	w.remaining = r.ApiAllowed - r.ApiIssued - 1
+	if w.remaining < 0 {
+		w.remaining = 0
+	}
+
+	// The expireAfter field contains the number of milliseconds left until the window is reset
+	expireAfter := time.Duration(r.ExpireAfter) * time.Millisecond
+	if expireAfter < time.Second {
+		expireAfter = time.Second
+	}
+	w.reset = time.Now().Add(expireAfter)
+	w.backoff = 0
+
+	return nil
+}
+
+// Stops throttling for a minute, after which the API limit is checked again. Must be called with w.mu held.
+func (w *apiWindow) unlimited() {
+	w.remaining = -1
+	w.reset = time.Now().Add(time.Minute)
+}
+
+// Backs off after the API reported the limit is exceeded. Must be called with w.mu held.
+func (w *apiWindow) exceeded() {
+	if w.backoff < time.Second {
+		w.backoff = time.Second
+	} else if w.backoff < time.Minute {
+		w.backoff *= 2
+	}
+
+	w.remaining = 0
+	w.reset = time.Now().Add(w.backoff)
+}
+
+// Called when a request was rejected because the API limit was exceeded
+func (l *apiLimiter) limitExceeded() {
+	w := l.window()
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	// Our count is off, so back off before seeding the budget again
+	if w.remaining != 0 {
+		w.exceeded()
+	}
+}
+
+// Decodes the getApiLimit response, which is wrapped in an additional apilimit object
+// by some versions of the API
+func decodeAPILimit(b json.RawMessage) (*GetApiLimitResponse, error) {
+	var wrapped struct {
+		Apilimit *GetApiLimitResponse `json:"apilimit"`
+	}
+	if err := json.Unmarshal(b, &wrapped); err == nil && wrapped.Apilimit != nil {
+		return wrapped.Apilimit, nil
+	}
+
+	var r GetApiLimitResponse
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Notifies the limiter the API limit was exceeded, if that is what the error reports
+func notifyLimitExceeded(limiter RateLimiter, err error) {
+	if l, ok := limiter.(interface{ limitExceeded() }); ok && isAPILimitError(err) {
+		l.limitExceeded()
+	}
+}
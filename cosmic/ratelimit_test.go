@@ -0,0 +1,296 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	l := NewRateLimiter(20, 2)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := l.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The burst is allowed right away, the other 2 requests take 50ms each
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond || elapsed > time.Second {
+		t.Errorf("Expected 4 requests to take about 100ms, took %v", elapsed)
+	}
+}
+
+func TestRateLimiterCanceled(t *testing.T) {
+	l := NewRateLimiter(1, 1)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Expected %v, got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	l := NewRateLimiter(0, 0)
+	for i := 0; i < 100; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// apiLimitServer counts the requests per API key, and reports a limit of allowed requests
+// in a window of 1 second (the shortest window the limiter uses) to getApiLimit
+type apiLimitServer struct {
+	mu       sync.Mutex
+	allowed  int
+	status   int            // If set, getApiLimit fails with this HTTP status
+	block    chan struct{}  // If set, getApiLimit blocks until it is closed
+	requests map[string]int // Requests per API key, excluding getApiLimit
+	seeds    map[string]int // Calls of getApiLimit per API key
+}
+
+func (s *apiLimitServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := r.FormValue("apiKey")
+	if r.FormValue("command") == "getApiLimit" {
+		s.seeds[key]++
+		if s.block != nil {
+			block := s.block
+			s.mu.Unlock()
+			<-block
+			s.mu.Lock()
+		}
+		if s.status != 0 {
+			respond(w, s.status, fmt.Sprintf(`{"errorresponse":{"errorcode":%d,"errortext":"Failed"}}`, s.status))
+			return
+		}
+		if s.allowed == 0 {
+			respond(w, 432, `{"errorresponse":{"errorcode":432,"errortext":"The given command does not exist or it is not available for user"}}`)
+			return
+		}
+		respond(w, http.StatusOK, fmt.Sprintf(
+			`{"getapilimitresponse":{"apilimit":{"apiAllowed":%d,"apiIssued":0,"expireAfter":1000}}}`, s.allowed))
+		return
+	}
+
+	s.requests[key]++
+	respond(w, http.StatusOK, `{"listzonesresponse":{}}`)
+}
+
+func newAPILimitServer(allowed int) *apiLimitServer {
+	return &apiLimitServer{allowed: allowed, requests: make(map[string]int), seeds: make(map[string]int)}
+}
+
+func TestAPILimit(t *testing.T) {
+	api := newAPILimitServer(3)
+	cs, s := newStubClient(t, api.ServeHTTP, WithAPILimit())
+	defer s.Close()
+
+	// One request of the budget is reserved for getApiLimit, so the second window is
+	// needed for the third and fourth request
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if _, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Errorf("Expected the requests to wait for the next window, took %v", elapsed)
+	}
+	if api.seeds["stub-api-key"] != 2 || api.requests["stub-api-key"] != 4 {
+		t.Errorf("Expected 2 windows and 4 requests, got %d and %d", api.seeds["stub-api-key"], api.requests["stub-api-key"])
+	}
+}
+
+func TestAPILimitPerAPIKey(t *testing.T) {
+	api := newAPILimitServer(3)
+	cs, s := newStubClient(t, api.ServeHTTP, WithAPILimit())
+	defer s.Close()
+
+	clone, err := cs.Clone(WithAPIKey("other-api-key", "other-secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	same, err := cs.Clone()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Each account has its own budget, which is shared by clones using the same API key
+	start := time.Now()
+	for _, c := range []*CosmicClient{cs, clone, same, clone} {
+		if _, err := c.Zone.ListZones(c.Zone.NewListZonesParams()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("Expected the requests to fit within the budgets, took %v", elapsed)
+	}
+	for _, key := range []string{"stub-api-key", "other-api-key"} {
+		if api.seeds[key] != 1 || api.requests[key] != 2 {
+			t.Errorf("Expected 1 window and 2 requests for %s, got %d and %d", key, api.seeds[key], api.requests[key])
+		}
+	}
+}
+
+func TestAPILimitNotEnabled(t *testing.T) {
+	api := newAPILimitServer(0)
+	cs, s := newStubClient(t, api.ServeHTTP, WithAPILimit())
+	defer s.Close()
+
+	for i := 0; i < 10; i++ {
+		if _, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if api.seeds["stub-api-key"] != 1 {
+		t.Errorf("Expected getApiLimit to be called once, got %d", api.seeds["stub-api-key"])
+	}
+}
+
+func TestDecodeAPILimit(t *testing.T) {
+	cases := []struct {
+		name string
+		json string
+	}{
+		{"wrapped", `{"apilimit":{"apiAllowed":10,"apiIssued":4,"expireAfter":1000}}`},
+		{"unwrapped", `{"apiAllowed":10,"apiIssued":4,"expireAfter":1000}`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r, err := decodeAPILimit([]byte(c.json))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r.ApiAllowed != 10 || r.ApiIssued != 4 || r.ExpireAfter != 1000 {
+				t.Errorf("Unexpected API limit: %+v", r)
+			}
+		})
+	}
+}
+
+func TestAPILimitSeedCanceled(t *testing.T) {
+	api := newAPILimitServer(2)
+	cs, s := newStubClient(t, api.ServeHTTP, WithAPILimit())
+	defer s.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := cs.Zone.ListZonesWithContext(ctx, cs.Zone.NewListZonesParams()); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected %v, got %v", context.Canceled, err)
+	}
+
+	// The window is still seeded afterwards, so only a single request fits in the budget
+	ctx, cancel = context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	if _, err := cs.Zone.ListZonesWithContext(ctx, cs.Zone.NewListZonesParams()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cs.Zone.ListZonesWithContext(ctx, cs.Zone.NewListZonesParams()); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the second request to wait for the next window, got %v", err)
+	}
+	if api.requests["stub-api-key"] != 1 {
+		t.Errorf("Expected 1 request, got %d", api.requests["stub-api-key"])
+	}
+}
+
+func TestAPILimitSeedFailed(t *testing.T) {
+	api := newAPILimitServer(2)
+	api.status = http.StatusServiceUnavailable
+	cs, s := newStubClient(t, api.ServeHTTP, WithAPILimit(), WithRetryPolicy(nil))
+	defer s.Close()
+
+	var e *CSError
+	if _, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams()); !errors.As(err, &e) || e.HTTPStatus != http.StatusServiceUnavailable {
+		t.Fatalf("Expected the error of getApiLimit, got %v", err)
+	}
+	if api.requests["stub-api-key"] != 0 {
+		t.Fatalf("Expected no requests, got %d", api.requests["stub-api-key"])
+	}
+
+	// The next request seeds the window again
+	api.mu.Lock()
+	api.status = 0
+	api.mu.Unlock()
+
+	if _, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams()); err != nil {
+		t.Fatal(err)
+	}
+	if api.seeds["stub-api-key"] != 2 || api.requests["stub-api-key"] != 1 {
+		t.Errorf("Expected 2 seeds and 1 request, got %d and %d", api.seeds["stub-api-key"], api.requests["stub-api-key"])
+	}
+}
+
+func TestAPILimitWaitWhileSeeding(t *testing.T) {
+	api := newAPILimitServer(10)
+	api.block = make(chan struct{})
+	cs, s := newStubClient(t, api.ServeHTTP, WithAPILimit())
+	defer s.Close()
+
+	seeded := make(chan error)
+	go func() {
+		seeded <- cs.limiter.Wait(context.Background())
+	}()
+
+	// Wait until the first caller is seeding the window
+	for {
+		api.mu.Lock()
+		n := api.seeds["stub-api-key"]
+		api.mu.Unlock()
+		if n == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// Other callers waiting for the seed still stop when their context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := cs.limiter.Wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Expected %v, got %v", context.DeadlineExceeded, err)
+	}
+
+	close(api.block)
+	if err := <-seeded; err != nil {
+		t.Fatal(err)
+	}
+	if err := cs.limiter.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if api.seeds["stub-api-key"] != 1 {
+		t.Errorf("Expected the window to be seeded once, got %d", api.seeds["stub-api-key"])
+	}
+}
@@ -0,0 +1,173 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// RetryPolicy decides if, and after how long, a failed request should be retried
+type RetryPolicy interface {
+	// Retry is called after every failed attempt to execute the command. The first attempt is
+	// attempt 1. It returns true and the time to wait if the request should be retried.
+	Retry(command string, attempt int, err error) (time.Duration, bool)
+}
+
+// RetryError is returned when a request still failed after being retried. It reports the
+// number of attempts made and wraps the error returned by the last attempt.
+type RetryError struct {
+	Command  string
+	Attempts int
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("%s failed after %d attempts: %v", e.Command, e.Attempts, e.Err)
+}
+
+// Unwrap returns the error returned by the last attempt
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// BackoffRetryPolicy retries transient failures (connection errors and HTTP 500, 502, 503
+// and 504 responses) using an exponential backoff with jitter. By default only commands that
+// are safe to repeat (list*, get* and query*) are retried, except for the get* commands that
+// create a resource, like getUploadParamsForTemplate and getUploadParamsForVolume. Requests that are rejected because
+// the API limit is exceeded are never executed, so those are retried for every command.
+type BackoffRetryPolicy struct {
+	// Maximum number of attempts, including the first one
+	MaxAttempts int
+
+	// Wait time before the first retry, which is doubled for every following retry
+	BaseDelay time.Duration
+
+	// Maximum wait time between two attempts
+	MaxDelay time.Duration
+
+	// Additional (mutating) commands that are safe to retry, e.g. "startVirtualMachine"
+	Commands []string
+
+	// If set, Notify is called before every retry with the failed attempt and the time to wait
+	Notify func(command string, attempt int, err error, wait time.Duration)
+}
+
+// DefaultRetryPolicy returns the policy used by new clients. It makes at most 3 attempts,
+// starting with a delay of 500 milliseconds, and only retries list*, get* and query* commands.
+func DefaultRetryPolicy() *BackoffRetryPolicy {
+	return &BackoffRetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    10 * time.Second,
+	}
+}
+
+// Retry implements the RetryPolicy interface
+func (p *BackoffRetryPolicy) Retry(command string, attempt int, err error) (time.Duration, bool) {
+	if attempt >= p.MaxAttempts {
+		return 0, false
+	}
+	if !isAPILimitError(err) && (!p.retryable(command) || !isTransientError(err)) {
+		return 0, false
+	}
+
+	// Use an exponential backoff with "equal jitter": half of the delay is fixed and the
+	// other half is random, so concurrent clients don't retry at exactly the same time
+	delay := p.BaseDelay << uint(attempt-1)
+	if delay <= 0 || (p.MaxDelay > 0 && delay > p.MaxDelay) {
+		delay = p.MaxDelay
+	}
+	wait := delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+
+	if p.Notify != nil {
+		p.Notify(command, attempt, err, wait)
+	}
+
+	return wait, true
+}
+
+// Commands that match one of the retryable prefixes, but that are not safe to repeat. Each
+// call of getUploadParamsFor* creates a new (pending) template or volume.
+var mutatingCommands = map[string]bool{
+	"getUploadParamsForTemplate": true,
+	"getUploadParamsForVolume":   true,
+}
+
+// Returns true if the command is safe to retry
+func (p *BackoffRetryPolicy) retryable(command string) bool {
+	for _, c := range p.Commands {
+		if strings.EqualFold(c, command) {
+			return true
+		}
+	}
+	if mutatingCommands[command] {
+		return false
+	}
+	for _, prefix := range []string{"list", "get", "query"} {
+		if strings.HasPrefix(command, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns true if the request was rejected because the API limit was exceeded
+func isAPILimitError(err error) bool {
+	var e *CSError
+	return errors.As(err, &e) && e.HTTPStatus == http.StatusTooManyRequests
+}
+
+// Returns true if the error is likely to be transient, so the request might succeed when retried
+func isTransientError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var e *CSError
+	if errors.As(err, &e) {
+		switch e.HTTPStatus {
+		case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+
+	// The HTTP client wraps every error in a *url.Error, which itself implements net.Error,
+	// so unwrap it to find out what actually went wrong
+	var ue *url.Error
+	if errors.As(err, &ue) {
+		err = ue.Err
+	}
+
+	// Connections closed by the server before a response was received
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	// Connection errors, e.g. refused or reset connections and timeouts
+	var ne net.Error
+	return errors.As(err, &ne)
+}
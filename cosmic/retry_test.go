@@ -0,0 +1,178 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestRetryClassification(t *testing.T) {
+	status := func(code int) error {
+		return &CSError{HTTPStatus: code, ErrorCode: code}
+	}
+	urlError := func(err error) error {
+		return &url.Error{Op: "Post", URL: "http://cosmic/client/api", Err: err}
+	}
+	refused := &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+
+	cases := []struct {
+		name    string
+		command string
+		err     error
+		retry   bool
+	}{
+		{"internal server error", "listZones", status(http.StatusInternalServerError), true},
+		{"bad gateway", "listZones", status(http.StatusBadGateway), true},
+		{"service unavailable", "getVirtualMachineUserData", status(http.StatusServiceUnavailable), true},
+		{"gateway timeout", "queryAsyncJobResult", status(http.StatusGatewayTimeout), true},
+		{"parameter error", "listZones", status(http.StatusBadRequest), false},
+		{"not found", "listZones", status(http.StatusNotFound), false},
+		{"unauthorized", "listZones", status(http.StatusUnauthorized), false},
+		{"connection refused", "listZones", urlError(refused), true},
+		{"connection closed", "listZones", urlError(io.EOF), true},
+		{"unexpected EOF", "listZones", urlError(io.ErrUnexpectedEOF), true},
+		{"transport error", "listZones", urlError(errors.New("no recorded interaction")), false},
+		{"canceled", "listZones", urlError(context.Canceled), false},
+		{"deadline exceeded", "listZones", fmt.Errorf("Waiting: %w", context.DeadlineExceeded), false},
+		{"mutating command", "deployVirtualMachine", status(http.StatusServiceUnavailable), false},
+		{"upload template params", "getUploadParamsForTemplate", status(http.StatusServiceUnavailable), false},
+		{"upload volume params", "getUploadParamsForVolume", urlError(refused), false},
+		{"API limit exceeded", "deployVirtualMachine", status(http.StatusTooManyRequests), true},
+	}
+
+	p := DefaultRetryPolicy()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, retry := p.Retry(c.command, 1, c.err); retry != c.retry {
+				t.Errorf("Expected retry to be %v for %s: %v", c.retry, c.command, c.err)
+			}
+		})
+	}
+}
+
+func TestRetryCommands(t *testing.T) {
+	p := DefaultRetryPolicy()
+	p.Commands = []string{"StartVirtualMachine", "getUploadParamsForVolume"}
+
+	err := &CSError{HTTPStatus: http.StatusServiceUnavailable}
+	for _, command := range []string{"startVirtualMachine", "getUploadParamsForVolume"} {
+		if _, retry := p.Retry(command, 1, err); !retry {
+			t.Errorf("Expected %s to be retried", command)
+		}
+	}
+	if _, retry := p.Retry("stopVirtualMachine", 1, err); retry {
+		t.Error("Expected stopVirtualMachine not to be retried")
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	p := &BackoffRetryPolicy{
+		MaxAttempts: 5,
+		BaseDelay:   100 * time.Millisecond,
+		MaxDelay:    300 * time.Millisecond,
+	}
+	err := &CSError{HTTPStatus: http.StatusServiceUnavailable}
+
+	cases := []struct {
+		attempt  int
+		min, max time.Duration
+	}{
+		{1, 50 * time.Millisecond, 100 * time.Millisecond},
+		{2, 100 * time.Millisecond, 200 * time.Millisecond},
+		{3, 150 * time.Millisecond, 300 * time.Millisecond},
+		{4, 150 * time.Millisecond, 300 * time.Millisecond},
+	}
+	for _, c := range cases {
+		wait, retry := p.Retry("listZones", c.attempt, err)
+		if !retry || wait < c.min || wait > c.max {
+			t.Errorf("Expected attempt %d to be retried after %v to %v, got %v (%v)", c.attempt, c.min, c.max, wait, retry)
+		}
+	}
+	if _, retry := p.Retry("listZones", 5, err); retry {
+		t.Error("Expected no retry after the last attempt")
+	}
+}
+
+func TestRetryRequest(t *testing.T) {
+	var calls int32
+	cs, s := newStubClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			respond(w, http.StatusServiceUnavailable, `{"listzonesresponse":{"errorcode":503,"errortext":"Unavailable"}}`)
+			return
+		}
+		respond(w, http.StatusOK, `{"listzonesresponse":{"count":1,"zone":[{"id":"zone-1"}]}}`)
+	}, WithRetryPolicy(&BackoffRetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+	defer s.Close()
+
+	l, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Count != 1 || calls != 3 {
+		t.Errorf("Expected 1 zone after 3 attempts, got %d zones after %d attempts", l.Count, calls)
+	}
+}
+
+func TestRetryError(t *testing.T) {
+	var calls int32
+	cs, s := newStubClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		respond(w, http.StatusServiceUnavailable, `{"listzonesresponse":{"errorcode":503,"errortext":"Unavailable"}}`)
+	}, WithRetryPolicy(&BackoffRetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}))
+	defer s.Close()
+
+	_, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams())
+
+	var e *RetryError
+	if !errors.As(err, &e) || e.Attempts != 2 || e.Command != "listZones" {
+		t.Fatalf("Expected a RetryError after 2 attempts, got %v", err)
+	}
+	var last *CSError
+	if !errors.As(err, &last) || last.HTTPStatus != http.StatusServiceUnavailable {
+		t.Errorf("Expected the RetryError to wrap the last error, got %v", e.Err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 requests, got %d", calls)
+	}
+}
+
+func TestNoRetryForUploadParams(t *testing.T) {
+	var calls int32
+	cs, s := newStubClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		respond(w, http.StatusServiceUnavailable, `{"postuploadvolumeresponse":{"errorcode":503,"errortext":"Unavailable"}}`)
+	}, WithRetryPolicy(&BackoffRetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+	defer s.Close()
+
+	p := cs.Volume.NewGetUploadParamsForVolumeParams("QCOW2", "volume", "zone-1")
+	if _, err := cs.Volume.GetUploadParamsForVolume(p); err == nil {
+		t.Fatal("Expected an error")
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 request, got %d", calls)
+	}
+}
@@ -0,0 +1,200 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// session holds the state needed to authenticate requests with the session key and
+// JSESSIONID cookie returned by the login command. A session is shared by all clones
+// of the client that created it.
+type session struct {
+	username string
+	password string
+	domain   string
+
+	jar *cookiejar.Jar // Jar holding the JSESSIONID cookie of the session
+
+	mu       sync.Mutex
+	key      string        // Session key returned by the last successful login
+	timeout  time.Duration // Inactivity timeout of the session as reported by the login command
+	lastUsed time.Time     // Time the session was last used to make a request
+}
+
+// Creates a new session that will login using the given credentials
+func newSession(username string, password string, domain string) *session {
+	// The cookiejar only returns an error when passing invalid options
+	jar, _ := cookiejar.New(nil)
+
+	return &session{
+		username: username,
+		password: password,
+		domain:   domain,
+		jar:      jar,
+	}
+}
+
+// Creates a new session based client for communicating with Cosmic
+func newSessionClient(apiurl string, username string, password string, domain string, async bool, tlsConfig *tls.Config, timeout int64) *CosmicClient {
+	cs := newClient(apiurl, "", "", async, tlsConfig, timeout)
+	cs.session = newSession(username, password, domain)
+	return cs
+}
+
+// Non-async client that authenticates using a username and password instead of an API key and secret. The
+// login command is called when the first request is made and again whenever the session has timed out. The
+// domain is the path of the domain the user belongs to (e.g. "/" for the ROOT domain). Call Close when the
+// client is no longer needed to end the session. Timeout for the http request is in seconds.
+func NewSessionClient(apiurl string, username string, password string, domain string, tlsConfig *tls.Config, timeout int64) *CosmicClient {
+	cs := newSessionClient(apiurl, username, password, domain, false, tlsConfig, timeout)
+	return cs
+}
+
+// Async client that authenticates using a username and password instead of an API key and secret. For sync
+// API calls this client behaves exactly the same as a session client, but for async API calls it waits for
+// the async job to finish, just like a client created with NewAsyncClient. Call Close when the client is no
+// longer needed to end the session. Timeout for the http request is in seconds.
+func NewAsyncSessionClient(apiurl string, username string, password string, domain string, tlsConfig *tls.Config, timeout int64) *CosmicClient {
+	cs := newSessionClient(apiurl, username, password, domain, true, tlsConfig, timeout)
+	return cs
+}
+
+// Close ends the session of a session based client by calling the logout command. For clients
+// using an API key and secret this is a no-op.
+func (cs *CosmicClient) Close() error {
+	if cs.session == nil {
+		return nil
+	}
+	return cs.session.logout(context.Background(), cs)
+}
+
+// Executes the request using the session key, logging in first when there is no valid session. If
+// the API reports the session is no longer valid, it will login again and retry the request once.
+func (s *session) newRequest(ctx context.Context, cs *CosmicClient, api string, params url.Values) (json.RawMessage, error) {
+	for retried := false; ; retried = true {
+		key, err := s.sessionKey(ctx, cs)
+		if err != nil {
+			return nil, err
+		}
+		params.Set("sessionkey", key)
+
+		b, err := cs.sendRequest(ctx, api, params)
+
+		var e *CSError
+		if errors.As(err, &e) && e.HTTPStatus == http.StatusUnauthorized && !retried {
+			s.expire(key)
+			continue
+		}
+		return b, err
+	}
+}
+
+// Adds the cookies of the session to the request
+func (s *session) addCookies(req *http.Request) {
+	for _, c := range s.jar.Cookies(req.URL) {
+		req.AddCookie(c)
+	}
+}
+
+// Stores the cookies set by the response, so they are send with following requests
+func (s *session) saveCookies(req *http.Request, resp *http.Response) {
+	if cookies := resp.Cookies(); len(cookies) > 0 {
+		s.jar.SetCookies(req.URL, cookies)
+	}
+}
+
+// Returns the key of the current session, logging in first if there is no session or if it timed out
+func (s *session) sessionKey(ctx context.Context, cs *CosmicClient) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.key == "" || (s.timeout > 0 && time.Since(s.lastUsed) >= s.timeout) {
+		if err := s.login(ctx, cs); err != nil {
+			return "", err
+		}
+	}
+	s.lastUsed = time.Now()
+
+	return s.key, nil
+}
+
+// Calls the login command and stores the returned session details. Must be called with s.mu held.
+func (s *session) login(ctx context.Context, cs *CosmicClient) error {
+	params := url.Values{}
+	params.Set("command", "login")
+	params.Set("response", "json")
+	params.Set("username", s.username)
+	params.Set("password", s.password)
+	if s.domain != "" {
+		params.Set("domain", s.domain)
+	}
+
+	b, err := cs.sendRequest(ctx, "login", params)
+	if err != nil {
+		return err
+	}
+
+	var r LoginResponse
+	if err := json.Unmarshal(b, &r); err != nil {
+		return err
+	}
+
+	s.key = r.Sessionkey
+	s.timeout = time.Duration(r.Timeout) * time.Second
+
+	return nil
+}
+
+// Forgets the given session key, so the next request will login again
+func (s *session) expire(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.key == key {
+		s.key = ""
+	}
+}
+
+// Calls the logout command if there is a session that did not yet time out
+func (s *session) logout(ctx context.Context, cs *CosmicClient) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.key == "" || (s.timeout > 0 && time.Since(s.lastUsed) >= s.timeout) {
+		s.key = ""
+		return nil
+	}
+
+	params := url.Values{}
+	params.Set("command", "logout")
+	params.Set("response", "json")
+	params.Set("sessionkey", s.key)
+
+	s.key = ""
+
+	_, err := cs.sendRequest(ctx, "logout", params)
+	return err
+}
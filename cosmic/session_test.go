@@ -0,0 +1,230 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// sessionServer hands out a new session key and JSESSIONID cookie on every login, and only
+// accepts requests using the key and cookie of the last login
+type sessionServer struct {
+	mu      sync.Mutex
+	logins  int
+	logouts int
+	key     string // Key of the current session; when empty all requests are rejected
+	timeout int    // Session timeout reported by the login command, in seconds
+	other   int    // Requests other than login and logout
+}
+
+func (s *sessionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.FormValue("command") {
+	case "login":
+		if r.Method != "POST" || r.FormValue("username") != "admin" || r.FormValue("password") != "password" || r.FormValue("domain") != "/" {
+			respond(w, http.StatusUnauthorized, `{"loginresponse":{"errorcode":401,"errortext":"Failed to authenticate user"}}`)
+			return
+		}
+		s.logins++
+		s.key = fmt.Sprintf("key-%d", s.logins)
+		http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: s.key})
+		respond(w, http.StatusOK, fmt.Sprintf(`{"loginresponse":{"sessionkey":%q,"timeout":%d}}`, s.key, s.timeout))
+		return
+	}
+
+	c, err := r.Cookie("JSESSIONID")
+	if s.key == "" || r.FormValue("sessionkey") != s.key || err != nil || c.Value != s.key {
+		respond(w, http.StatusUnauthorized, `{"errorresponse":{"errorcode":401,"errortext":"Unable to verify user credentials"}}`)
+		return
+	}
+	if r.FormValue("apiKey") != "" || r.FormValue("signature") != "" {
+		respond(w, http.StatusBadRequest, `{"errorresponse":{"errorcode":431,"errortext":"Unexpected signature"}}`)
+		return
+	}
+
+	if r.FormValue("command") == "logout" {
+		s.logouts++
+		s.key = ""
+		respond(w, http.StatusOK, `{"logoutresponse":{"description":"success"}}`)
+		return
+	}
+
+	s.other++
+	respond(w, http.StatusOK, `{"listzonesresponse":{}}`)
+}
+
+func newSessionTestClient(t *testing.T, api *sessionServer) (*CosmicClient, *httptest.Server) {
+	t.Helper()
+
+	s := httptest.NewServer(api)
+	cs, err := New(s.URL, WithLogin("admin", "password", "/"), WithRetryPolicy(nil))
+	if err != nil {
+		s.Close()
+		t.Fatal(err)
+	}
+	return cs, s
+}
+
+func listZones(cs *CosmicClient) error {
+	_, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams())
+	return err
+}
+
+func TestSessionLogin(t *testing.T) {
+	api := &sessionServer{}
+	cs, s := newSessionTestClient(t, api)
+	defer s.Close()
+
+	for i := 0; i < 3; i++ {
+		if err := listZones(cs); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Clones share the session
+	clone, err := cs.Clone(WithAsync(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := listZones(clone); err != nil {
+		t.Fatal(err)
+	}
+
+	if api.logins != 1 || api.other != 4 {
+		t.Errorf("Expected 1 login and 4 requests, got %d and %d", api.logins, api.other)
+	}
+}
+
+func TestSessionRelogin(t *testing.T) {
+	cases := []struct {
+		name   string
+		expire func(api *sessionServer, cs *CosmicClient)
+	}{
+		{"rejected by the server", func(api *sessionServer, cs *CosmicClient) {
+			api.mu.Lock()
+			api.key = ""
+			api.mu.Unlock()
+		}},
+		{"timed out", func(api *sessionServer, cs *CosmicClient) {
+			cs.session.mu.Lock()
+			cs.session.lastUsed = time.Now().Add(-2 * time.Minute)
+			cs.session.mu.Unlock()
+		}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			api := &sessionServer{timeout: 60}
+			cs, s := newSessionTestClient(t, api)
+			defer s.Close()
+
+			if err := listZones(cs); err != nil {
+				t.Fatal(err)
+			}
+			c.expire(api, cs)
+			if err := listZones(cs); err != nil {
+				t.Fatal(err)
+			}
+
+			if api.logins != 2 || api.other != 2 {
+				t.Errorf("Expected 2 logins and 2 requests, got %d and %d", api.logins, api.other)
+			}
+		})
+	}
+}
+
+func TestSessionReloginOnce(t *testing.T) {
+	var logins int
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("command") == "login" {
+			logins++
+			respond(w, http.StatusOK, `{"loginresponse":{"sessionkey":"key","timeout":60}}`)
+			return
+		}
+		respond(w, http.StatusUnauthorized, `{"errorresponse":{"errorcode":401,"errortext":"Unable to verify user credentials"}}`)
+	}))
+	defer s.Close()
+
+	cs, err := New(s.URL, WithLogin("admin", "password", "/"), WithRetryPolicy(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := listZones(cs); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("Expected a permission denied error, got %v", err)
+	}
+	if logins != 2 {
+		t.Errorf("Expected 2 logins, got %d", logins)
+	}
+}
+
+func TestSessionLoginFailed(t *testing.T) {
+	api := &sessionServer{}
+	s := httptest.NewServer(api)
+	defer s.Close()
+
+	cs, err := New(s.URL, WithLogin("admin", "wrong", "/"), WithRetryPolicy(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := listZones(cs); !errors.Is(err, ErrPermissionDenied) {
+		t.Errorf("Expected a permission denied error, got %v", err)
+	}
+	if api.other != 0 {
+		t.Errorf("Expected no requests after the failed login, got %d", api.other)
+	}
+}
+
+func TestSessionClose(t *testing.T) {
+	api := &sessionServer{}
+	cs, s := newSessionTestClient(t, api)
+	defer s.Close()
+
+	// Without a session there is nothing to logout from
+	if err := cs.Close(); err != nil || api.logouts != 0 {
+		t.Fatalf("Expected no logout, got %d (%v)", api.logouts, err)
+	}
+
+	if err := listZones(cs); err != nil {
+		t.Fatal(err)
+	}
+	if err := cs.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := cs.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if api.logouts != 1 {
+		t.Errorf("Expected 1 logout, got %d", api.logouts)
+	}
+
+	// A closed client logs in again when it is used
+	if err := listZones(cs); err != nil {
+		t.Fatal(err)
+	}
+	if api.logins != 2 {
+		t.Errorf("Expected 2 logins, got %d", api.logins)
+	}
+}
@@ -0,0 +1,125 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Errors returned by VerifySignature
+var (
+	// ErrMissingSignature indicates the request does not contain a signature or API key
+	ErrMissingSignature = errors.New("missing signature")
+
+	// ErrInvalidSignature indicates the signature does not match the parameters of the request
+	ErrInvalidSignature = errors.New("invalid signature")
+
+	// ErrSignatureExpired indicates the request is signed with a signature that is expired
+	ErrSignatureExpired = errors.New("signature expired")
+)
+
+// CommandParams is implemented by all parameter structs (like *ListZonesParams)
+type CommandParams interface {
+	command() string
+	toURLValues() url.Values
+}
+
+// SignedRequest is a fully signed request that can be executed by any HTTP client
+type SignedRequest struct {
+	Method string     // Either GET or POST
+	URL    string     // The URL of the request, including the query string for a GET request
+	Form   url.Values // All parameters of the request, including the API key and signature
+}
+
+// Body returns the form encoded body of a POST request, or an empty string for a GET request
+func (r *SignedRequest) Body() string {
+	if r.Method != "POST" {
+		return ""
+	}
+	return r.Form.Encode()
+}
+
+// SignRequest returns the signed request for the command of the params, exactly as the
+// client would send it, without executing it. This requires a client using an API key.
+func (cs *CosmicClient) SignRequest(p CommandParams) (*SignedRequest, error) {
+	return cs.SignCommand(p.command(), p.toURLValues())
+}
+
+// SignCommand returns the signed request for the command with the given params, exactly as the
+// client would send it, without executing it. The params are not changed. This requires a client
+// using an API key.
+func (cs *CosmicClient) SignCommand(command string, params url.Values) (*SignedRequest, error) {
+	if cs.session != nil || cs.apiKey == "" || cs.secret == "" {
+		return nil, errors.New("Signing a request requires a client using an API key and secret key")
+	}
+
+	form := copyValues(params)
+	form.Set("command", command)
+	form.Set("response", "json")
+	cs.signParams(form)
+
+	baseURL, _ := cs.endpoint(context.Background())
+	if cs.usePOST(command, form) {
+		return &SignedRequest{Method: "POST", URL: baseURL, Form: form}, nil
+	}
+	return &SignedRequest{Method: "GET", URL: baseURL + "?" + encodeValues(form), Form: form}, nil
+}
+
+// VerifySignature verifies the signature of a request signed with the given secret key, using the
+// same algorithm as the client. The params must contain all parameters of the request, including
+// the signature, e.g. as returned by (*http.Request).Form after calling ParseForm. When the request
+// contains an expiration date (signature version 3), an expired request is rejected as well.
+func VerifySignature(params url.Values, secret string) error {
+	signature := params.Get("signature")
+	if signature == "" || params.Get("apiKey") == "" {
+		return ErrMissingSignature
+	}
+
+	values := make(url.Values, len(params))
+	for k, v := range params {
+		if k != "signature" {
+			values[k] = v
+		}
+	}
+
+	got, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	want, _ := base64.StdEncoding.DecodeString(signValues(values, secret))
+	if !hmac.Equal(got, want) {
+		return ErrInvalidSignature
+	}
+
+	if params.Get("signatureVersion") == "3" && params.Get("expires") != "" {
+		expires, err := time.Parse("2006-01-02T15:04:05-0700", params.Get("expires"))
+		if err != nil {
+			return fmt.Errorf("Invalid expiration date %q: %v", params.Get("expires"), err)
+		}
+		if time.Now().After(expires) {
+			return ErrSignatureExpired
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Credentials and signature of the example in the CloudStack developer's guide
+const (
+	exampleAPIKey    = "plgWJfZK4gyS3mOMTVmjUVg-X-jlWlnfaUJ9GAbBbf9EdM-kAYMmAiLqzzq1ElZLYq_u38zCm0bewzGUdP66mg"
+	exampleSecret    = "VDaACYb0LV9eNjTetIOElcVQkvJck_J_QljX_FcHRj87ZKiy0z0ty0ZsYBkoXkY9b7eq1EhwJaw7FF3akA3KBQ"
+	exampleSignature = "TTpdDq/7j/J58XCRHomKoQXEQds="
+)
+
+func TestSignValues(t *testing.T) {
+	params := url.Values{}
+	params.Set("command", "listUsers")
+	params.Set("response", "json")
+	params.Set("apiKey", exampleAPIKey)
+
+	if signature := signValues(params, exampleSecret); signature != exampleSignature {
+		t.Errorf("Expected signature %s, got %s", exampleSignature, signature)
+	}
+}
+
+func TestSignCommand(t *testing.T) {
+	cs, err := New("http://localhost:8080/client/api", WithAPIKey(exampleAPIKey, exampleSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	params := url.Values{}
+	r, err := cs.SignCommand("listUsers", params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Method != "GET" || r.Body() != "" {
+		t.Errorf("Expected a GET request without a body, got %s with %q", r.Method, r.Body())
+	}
+	if !strings.HasSuffix(r.URL, "&signature=TTpdDq%2F7j%2FJ58XCRHomKoQXEQds%3D") {
+		t.Errorf("Expected the URL to end with the escaped signature, got %s", r.URL)
+	}
+	if len(params) != 0 {
+		t.Errorf("Expected the params not to be changed, got %v", params)
+	}
+
+	// A POST request contains the same form in its body
+	r, err = cs.SignCommand("deployVirtualMachine", url.Values{"zoneid": {"zone-1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Method != "POST" || r.URL != "http://localhost:8080/client/api" || r.Body() != r.Form.Encode() {
+		t.Errorf("Expected a POST request with a form body, got %s %s with %q", r.Method, r.URL, r.Body())
+	}
+}
+
+func TestSignCommandRequiresAPIKey(t *testing.T) {
+	cs, err := New("http://localhost:8080/client/api", WithLogin("admin", "password", "/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cs.SignCommand("listUsers", nil); err == nil {
+		t.Error("Expected an error signing a request of a session client")
+	}
+}
+
+func TestSignedValuesRoundTrip(t *testing.T) {
+	cs, err := New("http://localhost:8080/client/api", WithAPIKey(exampleAPIKey, exampleSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Values that are escaped differently by the client and the server must still verify
+	for _, v := range []string{"with spaces", "a+b=c&d", "UPPER lower", "~*'()!", "ünïcödé", "#!/bin/sh\necho hi"} {
+		r, err := cs.SignCommand("listUsers", url.Values{"keyword": {v}})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		u, err := url.Parse(r.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := VerifySignature(u.Query(), exampleSecret); err != nil {
+			t.Errorf("Expected the signature of %q to be valid, got %v", v, err)
+		}
+	}
+}
+
+func TestSignatureExpiry(t *testing.T) {
+	cs, err := New("http://localhost:8080/client/api", WithAPIKey(exampleAPIKey, exampleSecret), WithSignatureExpiry(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := cs.SignCommand("listUsers", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Form.Get("signatureVersion") != "3" {
+		t.Errorf("Expected signature version 3, got %q", r.Form.Get("signatureVersion"))
+	}
+	expires, err := time.Parse("2006-01-02T15:04:05-0700", r.Form.Get("expires"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Until(expires); d <= 50*time.Second || d > time.Minute {
+		t.Errorf("Expected the request to expire in a minute, expires in %v", d)
+	}
+	if err := VerifySignature(r.Form, exampleSecret); err != nil {
+		t.Errorf("Expected a valid signature, got %v", err)
+	}
+
+	if _, err := New("http://localhost:8080/client/api", WithAPIKey("key", "secret"), WithSignatureExpiry(-time.Minute)); err == nil {
+		t.Error("Expected an error for a negative validity")
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	// Returns signed params, changed by change before being signed
+	signed := func(change func(url.Values)) url.Values {
+		params := url.Values{}
+		params.Set("command", "listUsers")
+		params.Set("response", "json")
+		params.Set("apiKey", exampleAPIKey)
+		change(params)
+		params.Set("signature", signValues(params, exampleSecret))
+		return params
+	}
+	expires := func(d time.Duration) func(url.Values) {
+		return func(params url.Values) {
+			params.Set("signatureVersion", "3")
+			params.Set("expires", time.Now().Add(d).UTC().Format("2006-01-02T15:04:05-0700"))
+		}
+	}
+	unchanged := func(url.Values) {}
+
+	tampered := signed(unchanged)
+	tampered.Set("command", "deleteUser")
+	unsigned := signed(unchanged)
+	unsigned.Del("signature")
+	noKey := signed(unchanged)
+	noKey.Del("apiKey")
+	notBase64 := signed(unchanged)
+	notBase64.Set("signature", "not base64!")
+
+	cases := []struct {
+		name   string
+		params url.Values
+		secret string
+		err    error
+	}{
+		{"valid", signed(unchanged), exampleSecret, nil},
+		{"not expired", signed(expires(time.Minute)), exampleSecret, nil},
+		{"expired", signed(expires(-time.Minute)), exampleSecret, ErrSignatureExpired},
+		{"expiry ignored without version 3", signed(func(params url.Values) {
+			params.Set("expires", time.Now().Add(-time.Minute).UTC().Format("2006-01-02T15:04:05-0700"))
+		}), exampleSecret, nil},
+		{"wrong secret", signed(unchanged), "wrong", ErrInvalidSignature},
+		{"tampered", tampered, exampleSecret, ErrInvalidSignature},
+		{"not base64", notBase64, exampleSecret, ErrInvalidSignature},
+		{"unsigned", unsigned, exampleSecret, ErrMissingSignature},
+		{"missing API key", noKey, exampleSecret, ErrMissingSignature},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if err := VerifySignature(c.params, c.secret); err != c.err {
+				t.Errorf("Expected %v, got %v", c.err, err)
+			}
+		})
+	}
+
+	invalid := signed(func(params url.Values) {
+		params.Set("signatureVersion", "3")
+		params.Set("expires", "tomorrow")
+	})
+	if err := VerifySignature(invalid, exampleSecret); err == nil {
+		t.Error("Expected an error for an invalid expiration date")
+	}
+}
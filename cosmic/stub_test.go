@@ -0,0 +1,48 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmic
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// Starts a stub server that handles every request using handler, and returns a client using
+// it that waits for async jobs without delay. The server must be closed when finished.
+func newStubClient(t *testing.T, handler http.HandlerFunc, opts ...ClientOption) (*CosmicClient, *httptest.Server) {
+	t.Helper()
+
+	s := httptest.NewServer(handler)
+	cs, err := New(s.URL, append([]ClientOption{
+		WithAPIKey("stub-api-key", "stub-secret"),
+		WithPollInterval(time.Millisecond, time.Millisecond),
+	}, opts...)...)
+	if err != nil {
+		s.Close()
+		t.Fatal(err)
+	}
+	return cs, s
+}
+
+// Writes the JSON body with the given HTTP status code
+func respond(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(status)
+	w.Write([]byte(body))
+}
@@ -0,0 +1,837 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmictest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/MissionCriticalCloud/go-cosmic/v6/cosmic"
+)
+
+// All handlers are called with s.mu held
+
+func (s *Server) registerHandlers() {
+	s.handlers = map[string]*handler{
+		"listCapabilities":      {fn: s.listCapabilities},
+		"listAsyncJobs":         {fn: s.listAsyncJobs},
+		"queryAsyncJobResult":   {fn: s.queryAsyncJobResult},
+		"listZones":             {fn: s.listZones},
+		"listServiceOfferings":  {fn: s.listServiceOfferings},
+		"listDiskOfferings":     {fn: s.listDiskOfferings},
+		"listTemplates":         {fn: s.listTemplates},
+		"listVirtualMachines":   {fn: s.listVirtualMachines},
+		"deployVirtualMachine":  {job: s.deployVirtualMachine, key: "virtualmachine", instanceType: "VirtualMachine"},
+		"startVirtualMachine":   {job: s.startVirtualMachine, key: "virtualmachine", instanceType: "VirtualMachine"},
+		"stopVirtualMachine":    {job: s.stopVirtualMachine, key: "virtualmachine", instanceType: "VirtualMachine"},
+		"destroyVirtualMachine": {job: s.destroyVirtualMachine, key: "virtualmachine", instanceType: "VirtualMachine"},
+		"listVolumes":           {fn: s.listVolumes},
+		"createVolume":          {job: s.createVolume, key: "volume", instanceType: "Volume"},
+		"attachVolume":          {job: s.attachVolume, key: "volume", instanceType: "Volume"},
+		"detachVolume":          {job: s.detachVolume, key: "volume", instanceType: "Volume"},
+		"deleteVolume":          {fn: s.deleteVolume},
+		"listNetworks":          {fn: s.listNetworks},
+		"createNetwork":         {fn: s.createNetwork},
+		"deleteNetwork":         {job: s.deleteNetwork, instanceType: "Network"},
+		"listVPCs":              {fn: s.listVPCs},
+		"createVPC":             {job: s.createVPC, key: "vpc", instanceType: "Vpc"},
+		"deleteVPC":             {job: s.deleteVPC, instanceType: "Vpc"},
+		"listPublicIpAddresses": {fn: s.listPublicIpAddresses},
+		"associateIpAddress":    {job: s.associateIpAddress, key: "ipaddress", instanceType: "IpAddress"},
+		"disassociateIpAddress": {job: s.disassociateIpAddress, instanceType: "IpAddress"},
+	}
+}
+
+// Result of async commands that only report success
+var success = map[string]bool{"success": true}
+
+// Returns the indexes of the items on the requested page that match the filters in the params,
+// and the total number of matching items
+func filter(p url.Values, n int, fields func(i int) map[string]string) ([]int, int, error) {
+	var idx []int
+	for i := 0; i < n; i++ {
+		if matches(p, fields(i)) {
+			idx = append(idx, i)
+		}
+	}
+
+	start, end, err := pageBounds(p, len(idx))
+	if err != nil {
+		return nil, 0, err
+	}
+	return idx[start:end], len(idx), nil
+}
+
+// Returns a list response containing the items under the given key
+func listResponse(key string, count int, items interface{}) map[string]interface{} {
+	r := map[string]interface{}{"count": count}
+	if count > 0 {
+		r[key] = items
+	}
+	return r
+}
+
+func notFound(kind string, id string) error {
+	return paramErrorf("Unable to find %s with id %s", kind, id)
+}
+
+func (s *Server) listCapabilities(p url.Values) (interface{}, error) {
+	return map[string]interface{}{
+		"capability": &cosmic.Capability{
+			Cloudstackversion: "5.0.0",
+			Apilimitinterval:  1,
+			Apilimitmax:       10000,
+		},
+	}, nil
+}
+
+func (s *Server) listAsyncJobs(p url.Values) (interface{}, error) {
+	idx, count, err := filter(p, len(s.jobs), func(i int) map[string]string {
+		return map[string]string{"jobid": s.jobs[i].result.Jobid}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	l := make([]*cosmic.AsyncJob, len(idx))
+	for i, j := range idx {
+		r := s.jobs[j].status()
+		l[i] = &cosmic.AsyncJob{
+			Cmd:             r.Cmd,
+			Created:         r.Created,
+			Jobid:           r.Jobid,
+			Jobinstanceid:   r.Jobinstanceid,
+			Jobinstancetype: r.Jobinstancetype,
+			Jobresult:       r.Jobresult,
+			Jobresultcode:   r.Jobresultcode,
+			Jobresulttype:   r.Jobresulttype,
+			Jobstatus:       r.Jobstatus,
+		}
+	}
+	return listResponse("asyncjobs", count, l), nil
+}
+
+func (s *Server) queryAsyncJobResult(p url.Values) (interface{}, error) {
+	if err := required(p, "queryAsyncJobResult", "jobid"); err != nil {
+		return nil, err
+	}
+	for _, j := range s.jobs {
+		if j.result.Jobid == p.Get("jobid") {
+			return j.status(), nil
+		}
+	}
+	return nil, notFound("job", p.Get("jobid"))
+}
+
+func (s *Server) listZones(p url.Values) (interface{}, error) {
+	idx, count, err := filter(p, len(s.zones), func(i int) map[string]string {
+		return map[string]string{"id": s.zones[i].Id, "name": s.zones[i].Name}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	l := make([]*cosmic.Zone, len(idx))
+	for i, j := range idx {
+		l[i] = s.zones[j]
+	}
+	return listResponse("zone", count, l), nil
+}
+
+func (s *Server) listServiceOfferings(p url.Values) (interface{}, error) {
+	idx, count, err := filter(p, len(s.serviceOfferings), func(i int) map[string]string {
+		return map[string]string{"id": s.serviceOfferings[i].Id, "name": s.serviceOfferings[i].Name}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	l := make([]*cosmic.ServiceOffering, len(idx))
+	for i, j := range idx {
+		l[i] = s.serviceOfferings[j]
+	}
+	return listResponse("serviceoffering", count, l), nil
+}
+
+func (s *Server) listDiskOfferings(p url.Values) (interface{}, error) {
+	idx, count, err := filter(p, len(s.diskOfferings), func(i int) map[string]string {
+		return map[string]string{"id": s.diskOfferings[i].Id, "name": s.diskOfferings[i].Name}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	l := make([]*cosmic.DiskOffering, len(idx))
+	for i, j := range idx {
+		l[i] = s.diskOfferings[j]
+	}
+	return listResponse("diskoffering", count, l), nil
+}
+
+func (s *Server) listTemplates(p url.Values) (interface{}, error) {
+	if err := required(p, "listTemplates", "templatefilter"); err != nil {
+		return nil, err
+	}
+
+	idx, count, err := filter(p, len(s.templates), func(i int) map[string]string {
+		t := s.templates[i]
+		return map[string]string{"id": t.Id, "name": t.Name, "zoneid": t.Zoneid}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	l := make([]*cosmic.Template, len(idx))
+	for i, j := range idx {
+		l[i] = s.templates[j]
+	}
+	return listResponse("template", count, l), nil
+}
+
+// nic is the subset of the fields of a NIC of a virtual machine kept by the server
+type nic struct {
+	Id          string `json:"id"`
+	Networkid   string `json:"networkid"`
+	Networkname string `json:"networkname"`
+	Ipaddress   string `json:"ipaddress"`
+	Isdefault   bool   `json:"isdefault"`
+}
+
+// Returns the IDs of the networks the virtual machine has a NIC in
+func vmNetworks(vm *cosmic.VirtualMachine) []string {
+	ids := make([]string, len(vm.Nic))
+	for i, n := range vm.Nic {
+		ids[i] = n.Networkid
+	}
+	return ids
+}
+
+func (s *Server) listVirtualMachines(p url.Values) (interface{}, error) {
+	idx, count, err := filter(p, len(s.virtualMachines), func(i int) map[string]string {
+		vm := s.virtualMachines[i]
+		fields := map[string]string{"id": vm.Id, "name": vm.Name, "zoneid": vm.Zoneid, "state": vm.State}
+		if networkid := p.Get("networkid"); networkid != "" {
+			fields["networkid"] = ""
+			for _, id := range vmNetworks(vm) {
+				if id == networkid {
+					fields["networkid"] = id
+				}
+			}
+		}
+		return fields
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	l := make([]*cosmic.VirtualMachine, len(idx))
+	for i, j := range idx {
+		l[i] = s.virtualMachines[j]
+	}
+	return listResponse("virtualmachine", count, l), nil
+}
+
+func (s *Server) deployVirtualMachine(p url.Values) (string, interface{}, error) {
+	if err := required(p, "deployVirtualMachine", "serviceofferingid", "templateid", "zoneid"); err != nil {
+		return "", nil, err
+	}
+
+	zone := s.zone(p.Get("zoneid"))
+	if zone == nil {
+		return "", nil, notFound("zone", p.Get("zoneid"))
+	}
+	offering := s.serviceOffering(p.Get("serviceofferingid"))
+	if offering == nil {
+		return "", nil, notFound("service offering", p.Get("serviceofferingid"))
+	}
+	template := s.template(p.Get("templateid"))
+	if template == nil {
+		return "", nil, notFound("template", p.Get("templateid"))
+	}
+
+	var diskOffering *cosmic.DiskOffering
+	if id := p.Get("diskofferingid"); id != "" {
+		if diskOffering = s.diskOffering(id); diskOffering == nil {
+			return "", nil, notFound("disk offering", id)
+		}
+	}
+
+	var nics []nic
+	if ids := p.Get("networkids"); ids != "" {
+		for i, id := range strings.Split(ids, ",") {
+			network := s.network(id)
+			if network == nil {
+				return "", nil, notFound("network", id)
+			}
+			nics = append(nics, nic{
+				Id:          s.newID(),
+				Networkid:   network.Id,
+				Networkname: network.Name,
+				Ipaddress:   fmt.Sprintf("10.0.%d.%d", i, 10+len(s.virtualMachines)),
+				Isdefault:   i == 0,
+			})
+		}
+	}
+
+	vm := &cosmic.VirtualMachine{
+		Id:                  s.newID(),
+		Name:                p.Get("name"),
+		Displayname:         p.Get("displayname"),
+		Zoneid:              zone.Id,
+		Zonename:            zone.Name,
+		Serviceofferingid:   offering.Id,
+		Serviceofferingname: offering.Name,
+		Cpunumber:           offering.Cpunumber,
+		Memory:              offering.Memory,
+		Templateid:          template.Id,
+		Templatename:        template.Name,
+		State:               "Running",
+		Created:             now(),
+	}
+	if vm.Name == "" {
+		vm.Name = "VM-" + vm.Id
+	}
+	if vm.Displayname == "" {
+		vm.Displayname = vm.Name
+	}
+	if startvm, err := strconv.ParseBool(p.Get("startvm")); err == nil && !startvm {
+		vm.State = "Stopped"
+	}
+
+	// The NICs are an anonymous struct, so they are set using their JSON encoding
+	b, _ := json.Marshal(map[string][]nic{"nic": nics})
+	if err := json.Unmarshal(b, vm); err != nil {
+		return "", nil, err
+	}
+	s.virtualMachines = append(s.virtualMachines, vm)
+
+	s.volumes = append(s.volumes, &cosmic.Volume{
+		Id:               s.newID(),
+		Name:             "ROOT-" + vm.Name,
+		Type:             "ROOT",
+		Size:             template.Size,
+		State:            "Ready",
+		Zoneid:           zone.Id,
+		Zonename:         zone.Name,
+		Templateid:       template.Id,
+		Virtualmachineid: vm.Id,
+		Vmname:           vm.Name,
+		Attached:         now(),
+		Created:          now(),
+	})
+
+	if diskOffering != nil {
+		size := diskOffering.Disksize
+		if v, err := strconv.ParseInt(p.Get("size"), 10, 64); err == nil {
+			size = v
+		}
+		s.volumes = append(s.volumes, &cosmic.Volume{
+			Id:               s.newID(),
+			Name:             "DATA-" + vm.Name,
+			Type:             "DATADISK",
+			Size:             size << 30,
+			State:            "Ready",
+			Zoneid:           zone.Id,
+			Zonename:         zone.Name,
+			Diskofferingid:   diskOffering.Id,
+			Virtualmachineid: vm.Id,
+			Vmname:           vm.Name,
+			Deviceid:         1,
+			Attached:         now(),
+			Created:          now(),
+		})
+	}
+
+	return vm.Id, vm, nil
+}
+
+func (s *Server) startVirtualMachine(p url.Values) (string, interface{}, error) {
+	if err := required(p, "startVirtualMachine", "id"); err != nil {
+		return "", nil, err
+	}
+	vm := s.virtualMachine(p.Get("id"))
+	if vm == nil {
+		return "", nil, notFound("virtual machine", p.Get("id"))
+	}
+
+	vm.State = "Running"
+	return vm.Id, vm, nil
+}
+
+func (s *Server) stopVirtualMachine(p url.Values) (string, interface{}, error) {
+	if err := required(p, "stopVirtualMachine", "id"); err != nil {
+		return "", nil, err
+	}
+	vm := s.virtualMachine(p.Get("id"))
+	if vm == nil {
+		return "", nil, notFound("virtual machine", p.Get("id"))
+	}
+
+	vm.State = "Stopped"
+	return vm.Id, vm, nil
+}
+
+// Destroys the virtual machine, and when expunged removes it together with its root volume
+// while detaching all other volumes
+func (s *Server) destroyVirtualMachine(p url.Values) (string, interface{}, error) {
+	if err := required(p, "destroyVirtualMachine", "id"); err != nil {
+		return "", nil, err
+	}
+	vm := s.virtualMachine(p.Get("id"))
+	if vm == nil {
+		return "", nil, notFound("virtual machine", p.Get("id"))
+	}
+
+	vm.State = "Destroyed"
+	if expunge, _ := strconv.ParseBool(p.Get("expunge")); !expunge {
+		return vm.Id, vm, nil
+	}
+	vm.State = "Expunging"
+
+	var vms []*cosmic.VirtualMachine
+	for _, v := range s.virtualMachines {
+		if v != vm {
+			vms = append(vms, v)
+		}
+	}
+	s.virtualMachines = vms
+
+	var volumes []*cosmic.Volume
+	for _, v := range s.volumes {
+		if v.Virtualmachineid == vm.Id {
+			if v.Type == "ROOT" {
+				continue
+			}
+			detach(v)
+		}
+		volumes = append(volumes, v)
+	}
+	s.volumes = volumes
+
+	return vm.Id, vm, nil
+}
+
+func (s *Server) listVolumes(p url.Values) (interface{}, error) {
+	idx, count, err := filter(p, len(s.volumes), func(i int) map[string]string {
+		v := s.volumes[i]
+		return map[string]string{
+			"id":               v.Id,
+			"name":             v.Name,
+			"zoneid":           v.Zoneid,
+			"virtualmachineid": v.Virtualmachineid,
+			"type":             v.Type,
+			"state":            v.State,
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	l := make([]*cosmic.Volume, len(idx))
+	for i, j := range idx {
+		l[i] = s.volumes[j]
+	}
+	return listResponse("volume", count, l), nil
+}
+
+func (s *Server) createVolume(p url.Values) (string, interface{}, error) {
+	if err := required(p, "createVolume", "diskofferingid", "zoneid"); err != nil {
+		return "", nil, err
+	}
+
+	zone := s.zone(p.Get("zoneid"))
+	if zone == nil {
+		return "", nil, notFound("zone", p.Get("zoneid"))
+	}
+	offering := s.diskOffering(p.Get("diskofferingid"))
+	if offering == nil {
+		return "", nil, notFound("disk offering", p.Get("diskofferingid"))
+	}
+
+	size := offering.Disksize
+	if offering.Iscustomized {
+		if err := required(p, "createVolume", "size"); err != nil {
+			return "", nil, err
+		}
+		v, err := strconv.ParseInt(p.Get("size"), 10, 64)
+		if err != nil || v < 1 {
+			return "", nil, paramErrorf("Invalid size: %q", p.Get("size"))
+		}
+		size = v
+	}
+
+	var vm *cosmic.VirtualMachine
+	if id := p.Get("virtualmachineid"); id != "" {
+		if vm = s.virtualMachine(id); vm == nil {
+			return "", nil, notFound("virtual machine", id)
+		}
+	}
+
+	v := &cosmic.Volume{
+		Id:             s.newID(),
+		Name:           p.Get("name"),
+		Type:           "DATADISK",
+		Size:           size << 30,
+		State:          "Allocated",
+		Zoneid:         zone.Id,
+		Zonename:       zone.Name,
+		Diskofferingid: offering.Id,
+		Created:        now(),
+	}
+	if v.Name == "" {
+		v.Name = "DATA-" + v.Id
+	}
+	s.volumes = append(s.volumes, v)
+
+	if vm != nil {
+		s.attach(v, vm)
+	}
+	return v.Id, v, nil
+}
+
+func (s *Server) attachVolume(p url.Values) (string, interface{}, error) {
+	if err := required(p, "attachVolume", "id", "virtualmachineid"); err != nil {
+		return "", nil, err
+	}
+	v := s.volume(p.Get("id"))
+	if v == nil {
+		return "", nil, notFound("volume", p.Get("id"))
+	}
+	vm := s.virtualMachine(p.Get("virtualmachineid"))
+	if vm == nil {
+		return "", nil, notFound("virtual machine", p.Get("virtualmachineid"))
+	}
+	if v.Virtualmachineid != "" {
+		return "", nil, paramErrorf("Volume %s is already attached to virtual machine %s", v.Id, v.Virtualmachineid)
+	}
+
+	s.attach(v, vm)
+	return v.Id, v, nil
+}
+
+func (s *Server) detachVolume(p url.Values) (string, interface{}, error) {
+	if err := required(p, "detachVolume", "id"); err != nil {
+		return "", nil, err
+	}
+	v := s.volume(p.Get("id"))
+	if v == nil {
+		return "", nil, notFound("volume", p.Get("id"))
+	}
+	if v.Virtualmachineid == "" {
+		return "", nil, paramErrorf("Volume %s is not attached to a virtual machine", v.Id)
+	}
+	if v.Type == "ROOT" {
+		return "", nil, paramErrorf("Root volume %s cannot be detached", v.Id)
+	}
+
+	detach(v)
+	return v.Id, v, nil
+}
+
+func (s *Server) deleteVolume(p url.Values) (interface{}, error) {
+	if err := required(p, "deleteVolume", "id"); err != nil {
+		return nil, err
+	}
+	v := s.volume(p.Get("id"))
+	if v == nil {
+		return nil, notFound("volume", p.Get("id"))
+	}
+	if v.Virtualmachineid != "" {
+		return nil, paramErrorf("Please specify a volume that is not attached to any VM.")
+	}
+
+	var volumes []*cosmic.Volume
+	for _, vol := range s.volumes {
+		if vol != v {
+			volumes = append(volumes, vol)
+		}
+	}
+	s.volumes = volumes
+
+	return map[string]string{"success": "true"}, nil
+}
+
+// Attaches the volume to the virtual machine, using the first free device ID
+func (s *Server) attach(v *cosmic.Volume, vm *cosmic.VirtualMachine) {
+	used := make(map[int64]bool)
+	for _, vol := range s.volumes {
+		if vol.Virtualmachineid == vm.Id {
+			used[vol.Deviceid] = true
+		}
+	}
+
+	v.Deviceid = 1
+	for used[v.Deviceid] {
+		v.Deviceid++
+	}
+	v.Virtualmachineid = vm.Id
+	v.Vmname = vm.Name
+	v.State = "Ready"
+	v.Attached = now()
+}
+
+func detach(v *cosmic.Volume) {
+	v.Virtualmachineid = ""
+	v.Vmname = ""
+	v.Deviceid = 0
+	v.Attached = ""
+}
+
+func (s *Server) listNetworks(p url.Values) (interface{}, error) {
+	idx, count, err := filter(p, len(s.networks), func(i int) map[string]string {
+		n := s.networks[i]
+		return map[string]string{"id": n.Id, "name": n.Name, "zoneid": n.Zoneid, "vpcid": n.Vpcid, "state": n.State}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	l := make([]*cosmic.Network, len(idx))
+	for i, j := range idx {
+		l[i] = s.networks[j]
+	}
+	return listResponse("network", count, l), nil
+}
+
+func (s *Server) createNetwork(p url.Values) (interface{}, error) {
+	if err := required(p, "createNetwork", "displaytext", "name", "networkofferingid", "zoneid"); err != nil {
+		return nil, err
+	}
+
+	zone := s.zone(p.Get("zoneid"))
+	if zone == nil {
+		return nil, notFound("zone", p.Get("zoneid"))
+	}
+
+	n := &cosmic.Network{
+		Id:                s.newID(),
+		Name:              p.Get("name"),
+		Displaytext:       p.Get("displaytext"),
+		Networkofferingid: p.Get("networkofferingid"),
+		Zoneid:            zone.Id,
+		Zonename:          zone.Name,
+		Type:              "Isolated",
+		State:             "Allocated",
+		Cidr:              p.Get("cidr"),
+	}
+	if id := p.Get("vpcid"); id != "" {
+		if s.vpc(id) == nil {
+			return nil, notFound("VPC", id)
+		}
+		n.Vpcid = id
+	}
+	s.networks = append(s.networks, n)
+
+	return map[string]interface{}{"network": n}, nil
+}
+
+// Deletes the network, failing the job while any virtual machine has a NIC in it
+func (s *Server) deleteNetwork(p url.Values) (string, interface{}, error) {
+	if err := required(p, "deleteNetwork", "id"); err != nil {
+		return "", nil, err
+	}
+	n := s.network(p.Get("id"))
+	if n == nil {
+		return "", nil, notFound("network", p.Get("id"))
+	}
+
+	for _, vm := range s.virtualMachines {
+		for _, id := range vmNetworks(vm) {
+			if id == n.Id {
+				return "", nil, &jobFailure{&apiError{
+					code: cosmic.ErrorCodeResourceInUse,
+					text: fmt.Sprintf("Network %s is in use by virtual machine %s", n.Id, vm.Id),
+				}}
+			}
+		}
+	}
+
+	var networks []*cosmic.Network
+	for _, network := range s.networks {
+		if network != n {
+			networks = append(networks, network)
+		}
+	}
+	s.networks = networks
+
+	return n.Id, success, nil
+}
+
+func (s *Server) listVPCs(p url.Values) (interface{}, error) {
+	idx, count, err := filter(p, len(s.vpcs), func(i int) map[string]string {
+		v := s.vpcs[i]
+		return map[string]string{"id": v.Id, "name": v.Name, "zoneid": v.Zoneid, "state": v.State}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	l := make([]*cosmic.VPC, len(idx))
+	for i, j := range idx {
+		l[i] = s.vpcs[j]
+	}
+	return listResponse("vpc", count, l), nil
+}
+
+func (s *Server) createVPC(p url.Values) (string, interface{}, error) {
+	if err := required(p, "createVPC", "cidr", "displaytext", "name", "vpcofferingid", "zoneid"); err != nil {
+		return "", nil, err
+	}
+
+	zone := s.zone(p.Get("zoneid"))
+	if zone == nil {
+		return "", nil, notFound("zone", p.Get("zoneid"))
+	}
+
+	v := &cosmic.VPC{
+		Id:            s.newID(),
+		Name:          p.Get("name"),
+		Displaytext:   p.Get("displaytext"),
+		Cidr:          p.Get("cidr"),
+		Vpcofferingid: p.Get("vpcofferingid"),
+		Zoneid:        zone.Id,
+		Zonename:      zone.Name,
+		State:         "Enabled",
+		Created:       now(),
+	}
+	s.vpcs = append(s.vpcs, v)
+
+	return v.Id, v, nil
+}
+
+// Deletes the VPC, failing the job while it still contains networks
+func (s *Server) deleteVPC(p url.Values) (string, interface{}, error) {
+	if err := required(p, "deleteVPC", "id"); err != nil {
+		return "", nil, err
+	}
+	v := s.vpc(p.Get("id"))
+	if v == nil {
+		return "", nil, notFound("VPC", p.Get("id"))
+	}
+
+	for _, n := range s.networks {
+		if n.Vpcid == v.Id {
+			return "", nil, &jobFailure{&apiError{
+				code: cosmic.ErrorCodeResourceInUse,
+				text: fmt.Sprintf("VPC %s still contains network %s", v.Id, n.Id),
+			}}
+		}
+	}
+
+	var vpcs []*cosmic.VPC
+	for _, vpc := range s.vpcs {
+		if vpc != v {
+			vpcs = append(vpcs, vpc)
+		}
+	}
+	s.vpcs = vpcs
+
+	var ips []*cosmic.PublicIpAddress
+	for _, ip := range s.publicIPs {
+		if ip.Vpcid != v.Id {
+			ips = append(ips, ip)
+		}
+	}
+	s.publicIPs = ips
+
+	return v.Id, success, nil
+}
+
+func (s *Server) listPublicIpAddresses(p url.Values) (interface{}, error) {
+	idx, count, err := filter(p, len(s.publicIPs), func(i int) map[string]string {
+		ip := s.publicIPs[i]
+		return map[string]string{
+			"id":                  ip.Id,
+			"ipaddress":           ip.Ipaddress,
+			"zoneid":              ip.Zoneid,
+			"associatednetworkid": ip.Associatednetworkid,
+			"vpcid":               ip.Vpcid,
+			"state":               ip.State,
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	l := make([]*cosmic.PublicIpAddress, len(idx))
+	for i, j := range idx {
+		l[i] = s.publicIPs[j]
+	}
+	return listResponse("publicipaddress", count, l), nil
+}
+
+func (s *Server) associateIpAddress(p url.Values) (string, interface{}, error) {
+	ip := &cosmic.PublicIpAddress{
+		Id:                s.newID(),
+		Ipaddress:         s.newIP(),
+		Forvirtualnetwork: true,
+		State:             "Allocated",
+		Allocated:         now(),
+	}
+
+	switch {
+	case p.Get("networkid") != "":
+		n := s.network(p.Get("networkid"))
+		if n == nil {
+			return "", nil, notFound("network", p.Get("networkid"))
+		}
+		ip.Associatednetworkid = n.Id
+		ip.Vpcid = n.Vpcid
+		ip.Zoneid = n.Zoneid
+	case p.Get("vpcid") != "":
+		v := s.vpc(p.Get("vpcid"))
+		if v == nil {
+			return "", nil, notFound("VPC", p.Get("vpcid"))
+		}
+		ip.Vpcid = v.Id
+		ip.Zoneid = v.Zoneid
+	case p.Get("zoneid") != "":
+		if s.zone(p.Get("zoneid")) == nil {
+			return "", nil, notFound("zone", p.Get("zoneid"))
+		}
+		ip.Zoneid = p.Get("zoneid")
+	default:
+		return "", nil, paramErrorf("Unable to execute API command associateipaddress: either networkid, vpcid or zoneid is required")
+	}
+	ip.Zonename = s.zoneName(ip.Zoneid)
+	s.publicIPs = append(s.publicIPs, ip)
+
+	return ip.Id, ip, nil
+}
+
+func (s *Server) disassociateIpAddress(p url.Values) (string, interface{}, error) {
+	if err := required(p, "disassociateIpAddress", "id"); err != nil {
+		return "", nil, err
+	}
+	ip := s.publicIP(p.Get("id"))
+	if ip == nil {
+		return "", nil, notFound("public IP address", p.Get("id"))
+	}
+
+	var ips []*cosmic.PublicIpAddress
+	for _, i := range s.publicIPs {
+		if i != ip {
+			ips = append(ips, i)
+		}
+	}
+	s.publicIPs = ips
+
+	return ip.Id, success, nil
+}
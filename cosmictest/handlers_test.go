@@ -0,0 +1,230 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmictest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/MissionCriticalCloud/go-cosmic/v6/cosmic"
+)
+
+// Returns a new server with a zone, service offering and template, and their IDs
+func newSeededServer() (*Server, string, string, string) {
+	s := NewServer()
+	zone := s.AddZone("zone1")
+	offering := s.AddServiceOffering("small", 1, 1024)
+	template := s.AddTemplate("ubuntu", zone.Id)
+	return s, offering.Id, template.Id, zone.Id
+}
+
+func TestDeployVirtualMachine(t *testing.T) {
+	s, offering, template, zone := newSeededServer()
+	defer s.Close()
+
+	cs, err := s.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := cs.VirtualMachine.NewDeployVirtualMachineParams(offering, template, zone)
+	p.SetName("vm1")
+	vm, err := cs.VirtualMachine.DeployVirtualMachine(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vm.Name != "vm1" || vm.State != "Running" || vm.Zoneid != zone || vm.Cpunumber != 1 || vm.Memory != 1024 {
+		t.Errorf("Unexpected virtual machine: %+v", vm)
+	}
+
+	volumes := s.Volumes()
+	if len(volumes) != 1 || volumes[0].Type != "ROOT" || volumes[0].Virtualmachineid != vm.Id {
+		t.Errorf("Expected a ROOT volume attached to the virtual machine, got %+v", volumes)
+	}
+
+	// Stopping and starting changes the state
+	if _, err := cs.VirtualMachine.StopVirtualMachine(cs.VirtualMachine.NewStopVirtualMachineParams(vm.Id)); err != nil {
+		t.Fatal(err)
+	}
+	if state := s.VirtualMachines()[0].State; state != "Stopped" {
+		t.Errorf("Expected the virtual machine to be stopped, got %s", state)
+	}
+
+	// Expunging removes the virtual machine and its ROOT volume
+	d := cs.VirtualMachine.NewDestroyVirtualMachineParams(vm.Id)
+	d.SetExpunge(true)
+	if _, err := cs.VirtualMachine.DestroyVirtualMachine(d); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.VirtualMachines()) != 0 || len(s.Volumes()) != 0 {
+		t.Errorf("Expected the virtual machine and its volume to be expunged, got %d and %d", len(s.VirtualMachines()), len(s.Volumes()))
+	}
+}
+
+func TestNotFound(t *testing.T) {
+	s, offering, template, _ := newSeededServer()
+	defer s.Close()
+
+	cs, err := s.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = cs.VirtualMachine.DeployVirtualMachine(cs.VirtualMachine.NewDeployVirtualMachineParams(offering, template, "missing"))
+	if !errors.Is(err, cosmic.ErrNotFound) {
+		t.Errorf("Expected a not found error for a missing zone, got %v", err)
+	}
+	_, err = cs.VirtualMachine.StartVirtualMachine(cs.VirtualMachine.NewStartVirtualMachineParams("missing"))
+	if !errors.Is(err, cosmic.ErrNotFound) {
+		t.Errorf("Expected a not found error for a missing virtual machine, got %v", err)
+	}
+	_, err = cs.Volume.DeleteVolume(cs.Volume.NewDeleteVolumeParams("missing"))
+	if !errors.Is(err, cosmic.ErrNotFound) {
+		t.Errorf("Expected a not found error for a missing volume, got %v", err)
+	}
+	_, _, err = cs.Zone.GetZoneByID("missing")
+	if err == nil {
+		t.Error("Expected an error for a missing zone")
+	}
+}
+
+func TestVolumes(t *testing.T) {
+	s, offering, template, zone := newSeededServer()
+	defer s.Close()
+	diskOffering := s.AddDiskOffering("custom", 0)
+
+	cs, err := s.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	vm, err := cs.VirtualMachine.DeployVirtualMachine(cs.VirtualMachine.NewDeployVirtualMachineParams(offering, template, zone))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A customized disk offering requires a size
+	p := cs.Volume.NewCreateVolumeParams()
+	p.SetName("data")
+	p.SetDiskofferingid(diskOffering.Id)
+	p.SetZoneid(zone)
+	if _, err := cs.Volume.CreateVolume(p); !errors.Is(err, cosmic.ErrParameter) {
+		t.Errorf("Expected a parameter error without a size, got %v", err)
+	}
+	p.SetSize(10)
+	volume, err := cs.Volume.CreateVolume(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attached, err := cs.Volume.AttachVolume(cs.Volume.NewAttachVolumeParams(volume.Id, vm.Id))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attached.Virtualmachineid != vm.Id || attached.Deviceid != 1 {
+		t.Errorf("Expected the volume to be attached as device 1, got %+v", attached)
+	}
+
+	// An attached volume cannot be deleted
+	if _, err := cs.Volume.DeleteVolume(cs.Volume.NewDeleteVolumeParams(volume.Id)); !errors.Is(err, cosmic.ErrParameter) {
+		t.Errorf("Expected a parameter error deleting an attached volume, got %v", err)
+	}
+
+	d := cs.Volume.NewDetachVolumeParams()
+	d.SetId(volume.Id)
+	if _, err := cs.Volume.DetachVolume(d); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cs.Volume.DeleteVolume(cs.Volume.NewDeleteVolumeParams(volume.Id)); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(s.Volumes()); n != 1 {
+		t.Errorf("Expected only the ROOT volume to remain, got %d volumes", n)
+	}
+}
+
+func TestNetworkInUse(t *testing.T) {
+	s, offering, template, zone := newSeededServer()
+	defer s.Close()
+
+	cs, err := s.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	network, err := cs.Network.CreateNetwork(cs.Network.NewCreateNetworkParams("network1", "network1", "offering", zone))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := cs.VirtualMachine.NewDeployVirtualMachineParams(offering, template, zone)
+	p.SetNetworkids([]string{network.Id})
+	vm, err := cs.VirtualMachine.DeployVirtualMachine(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vm.Nic) != 1 || vm.Nic[0].Networkid != network.Id || !vm.Nic[0].Isdefault {
+		t.Errorf("Expected a default NIC in the network, got %+v", vm.Nic)
+	}
+
+	_, err = cs.Network.DeleteNetwork(cs.Network.NewDeleteNetworkParams(network.Id))
+	var e *cosmic.AsyncJobError
+	if !errors.As(err, &e) || e.ErrorCode != cosmic.ErrorCodeResourceInUse {
+		t.Errorf("Expected the job to fail as the network is in use, got %v", err)
+	}
+
+	d := cs.VirtualMachine.NewDestroyVirtualMachineParams(vm.Id)
+	d.SetExpunge(true)
+	if _, err := cs.VirtualMachine.DestroyVirtualMachine(d); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cs.Network.DeleteNetwork(cs.Network.NewDeleteNetworkParams(network.Id)); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(s.Networks()); n != 0 {
+		t.Errorf("Expected the network to be deleted, got %d networks", n)
+	}
+}
+
+func TestPublicIPAddresses(t *testing.T) {
+	s, _, _, zone := newSeededServer()
+	defer s.Close()
+
+	cs, err := s.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cs.PublicIPAddress.AssociateIpAddress(cs.PublicIPAddress.NewAssociateIpAddressParams()); !errors.Is(err, cosmic.ErrParameter) {
+		t.Errorf("Expected a parameter error without a zone, network or VPC, got %v", err)
+	}
+
+	p := cs.PublicIPAddress.NewAssociateIpAddressParams()
+	p.SetZoneid(zone)
+	ip, err := cs.PublicIPAddress.AssociateIpAddress(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ip.Ipaddress == "" || ip.Zoneid != zone {
+		t.Errorf("Unexpected public IP address: %+v", ip)
+	}
+
+	if _, err := cs.PublicIPAddress.DisassociateIpAddress(cs.PublicIPAddress.NewDisassociateIpAddressParams(ip.Id)); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(s.PublicIPAddresses()); n != 0 {
+		t.Errorf("Expected the public IP address to be released, got %d", n)
+	}
+}
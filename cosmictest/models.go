@@ -0,0 +1,256 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmictest
+
+import (
+	"github.com/MissionCriticalCloud/go-cosmic/v6/cosmic"
+)
+
+// AddZone adds a new zone with the given name
+func (s *Server) AddZone(name string) *cosmic.Zone {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	z := &cosmic.Zone{
+		Id:              s.newID(),
+		Name:            name,
+		Displaytext:     name,
+		Allocationstate: "Enabled",
+		Networktype:     "Advanced",
+	}
+	s.zones = append(s.zones, z)
+
+	c := *z
+	return &c
+}
+
+// AddServiceOffering adds a new service offering with the given number of CPUs and memory (in MB)
+func (s *Server) AddServiceOffering(name string, cpus int, memory int) *cosmic.ServiceOffering {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o := &cosmic.ServiceOffering{
+		Id:          s.newID(),
+		Name:        name,
+		Displaytext: name,
+		Cpunumber:   cpus,
+		Memory:      memory,
+		Created:     now(),
+	}
+	s.serviceOfferings = append(s.serviceOfferings, o)
+
+	c := *o
+	return &c
+}
+
+// AddDiskOffering adds a new disk offering with the given size (in GB). When the size is zero
+// the offering is customized, and volumes created with it require a size.
+func (s *Server) AddDiskOffering(name string, size int64) *cosmic.DiskOffering {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o := &cosmic.DiskOffering{
+		Id:           s.newID(),
+		Name:         name,
+		Displaytext:  name,
+		Disksize:     size,
+		Iscustomized: size == 0,
+		Created:      now(),
+	}
+	s.diskOfferings = append(s.diskOfferings, o)
+
+	c := *o
+	return &c
+}
+
+// AddTemplate adds a new template that is ready to be used in the zone with the given ID
+func (s *Server) AddTemplate(name string, zoneid string) *cosmic.Template {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := &cosmic.Template{
+		Id:          s.newID(),
+		Name:        name,
+		Displaytext: name,
+		Zoneid:      zoneid,
+		Zonename:    s.zoneName(zoneid),
+		Isready:     true,
+		Isfeatured:  true,
+		Status:      "Download Complete",
+		Size:        10 << 30,
+		Created:     now(),
+	}
+	s.templates = append(s.templates, t)
+
+	c := *t
+	return &c
+}
+
+// VirtualMachines returns a copy of all virtual machines that are not expunged
+func (s *Server) VirtualMachines() []*cosmic.VirtualMachine {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l := make([]*cosmic.VirtualMachine, len(s.virtualMachines))
+	for i, vm := range s.virtualMachines {
+		c := *vm
+		l[i] = &c
+	}
+	return l
+}
+
+// Volumes returns a copy of all volumes
+func (s *Server) Volumes() []*cosmic.Volume {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l := make([]*cosmic.Volume, len(s.volumes))
+	for i, v := range s.volumes {
+		c := *v
+		l[i] = &c
+	}
+	return l
+}
+
+// Networks returns a copy of all networks
+func (s *Server) Networks() []*cosmic.Network {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l := make([]*cosmic.Network, len(s.networks))
+	for i, n := range s.networks {
+		c := *n
+		l[i] = &c
+	}
+	return l
+}
+
+// VPCs returns a copy of all VPCs
+func (s *Server) VPCs() []*cosmic.VPC {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l := make([]*cosmic.VPC, len(s.vpcs))
+	for i, v := range s.vpcs {
+		c := *v
+		l[i] = &c
+	}
+	return l
+}
+
+// PublicIPAddresses returns a copy of all associated public IP addresses
+func (s *Server) PublicIPAddresses() []*cosmic.PublicIpAddress {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l := make([]*cosmic.PublicIpAddress, len(s.publicIPs))
+	for i, ip := range s.publicIPs {
+		c := *ip
+		l[i] = &c
+	}
+	return l
+}
+
+// The lookup functions below must be called with s.mu held
+
+func (s *Server) zone(id string) *cosmic.Zone {
+	for _, z := range s.zones {
+		if z.Id == id {
+			return z
+		}
+	}
+	return nil
+}
+
+func (s *Server) zoneName(id string) string {
+	if z := s.zone(id); z != nil {
+		return z.Name
+	}
+	return ""
+}
+
+func (s *Server) serviceOffering(id string) *cosmic.ServiceOffering {
+	for _, o := range s.serviceOfferings {
+		if o.Id == id {
+			return o
+		}
+	}
+	return nil
+}
+
+func (s *Server) diskOffering(id string) *cosmic.DiskOffering {
+	for _, o := range s.diskOfferings {
+		if o.Id == id {
+			return o
+		}
+	}
+	return nil
+}
+
+func (s *Server) template(id string) *cosmic.Template {
+	for _, t := range s.templates {
+		if t.Id == id {
+			return t
+		}
+	}
+	return nil
+}
+
+func (s *Server) virtualMachine(id string) *cosmic.VirtualMachine {
+	for _, vm := range s.virtualMachines {
+		if vm.Id == id {
+			return vm
+		}
+	}
+	return nil
+}
+
+func (s *Server) volume(id string) *cosmic.Volume {
+	for _, v := range s.volumes {
+		if v.Id == id {
+			return v
+		}
+	}
+	return nil
+}
+
+func (s *Server) network(id string) *cosmic.Network {
+	for _, n := range s.networks {
+		if n.Id == id {
+			return n
+		}
+	}
+	return nil
+}
+
+func (s *Server) vpc(id string) *cosmic.VPC {
+	for _, v := range s.vpcs {
+		if v.Id == id {
+			return v
+		}
+	}
+	return nil
+}
+
+func (s *Server) publicIP(id string) *cosmic.PublicIpAddress {
+	for _, ip := range s.publicIPs {
+		if ip.Id == id {
+			return ip
+		}
+	}
+	return nil
+}
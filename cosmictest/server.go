@@ -0,0 +1,382 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package cosmictest provides an in-memory fake Cosmic management server for tests. The server
+// speaks the Cosmic wire protocol: it validates the signature of every request, wraps responses
+// in the usual JSON envelopes, pages list responses and executes async commands as jobs that
+// can be polled using queryAsyncJobResult. It keeps stateful models of zones, offerings,
+// templates, virtual machines, volumes, networks, VPCs and public IP addresses.
+//
+//	s := cosmictest.NewServer()
+//	defer s.Close()
+//
+//	zone := s.AddZone("zone1")
+//	offering := s.AddServiceOffering("small", 1, 1024)
+//	template := s.AddTemplate("ubuntu", zone.Id)
+//
+//	cs, _ := s.NewClient()
+//	vm, err := cs.VirtualMachine.DeployVirtualMachine(
+//		cs.VirtualMachine.NewDeployVirtualMachineParams(offering.Id, template.Id, zone.Id))
+//
+// Failures can be injected using Fail, and the state can be inspected using accessors like
+// VirtualMachines and Volumes.
+package cosmictest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MissionCriticalCloud/go-cosmic/v6/cosmic"
+)
+
+// Credentials accepted by a new server
+const (
+	DefaultAPIKey    = "cosmictest-api-key"
+	DefaultSecretKey = "cosmictest-secret-key"
+)
+
+// Server is a fake Cosmic management server
+type Server struct {
+	*httptest.Server
+
+	// Credentials used to verify the signature of requests
+	APIKey    string
+	SecretKey string
+
+	mu       sync.Mutex
+	handlers map[string]*handler
+	lastID   int
+	lastIP   int
+	requests []string
+	failures map[string]*apiError
+
+	// Number of times a job has to be polled before it is finished; zero means
+	// jobs are finished the first time they are polled
+	jobPolls int
+	jobs     []*job
+
+	zones            []*cosmic.Zone
+	serviceOfferings []*cosmic.ServiceOffering
+	diskOfferings    []*cosmic.DiskOffering
+	templates        []*cosmic.Template
+	virtualMachines  []*cosmic.VirtualMachine
+	volumes          []*cosmic.Volume
+	networks         []*cosmic.Network
+	vpcs             []*cosmic.VPC
+	publicIPs        []*cosmic.PublicIpAddress
+}
+
+// handler executes a single command. Sync commands are executed by fn, returning the value
+// wrapped in the response envelope. Async commands are executed by job, which returns the ID of
+// the affected instance and the job result. The job result is wrapped in an object with the
+// given key, unless the key is empty.
+type handler struct {
+	fn func(p url.Values) (interface{}, error)
+
+	job          func(p url.Values) (string, interface{}, error)
+	key          string
+	instanceType string
+}
+
+type job struct {
+	result *cosmic.QueryAsyncJobResultResponse
+	polls  int
+}
+
+// apiError is an error returned by the API, with the error code used as HTTP status code
+type apiError struct {
+	code int
+	text string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%d: %s", e.code, e.text)
+}
+
+// jobFailure is returned by the handler of an async command to start a job that fails with
+// the error, instead of failing the request itself
+type jobFailure struct {
+	*apiError
+}
+
+// Returns a new parameter error
+func paramErrorf(format string, a ...interface{}) error {
+	return &apiError{code: cosmic.ErrorCodeParamError, text: fmt.Sprintf(format, a...)}
+}
+
+// NewServer starts and returns a new server, which should be closed when finished
+func NewServer() *Server {
+	s := &Server{
+		APIKey:    DefaultAPIKey,
+		SecretKey: DefaultSecretKey,
+		failures:  make(map[string]*apiError),
+	}
+	s.registerHandlers()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// NewClient returns an async client for the server, which polls jobs without delay. The
+// given options are applied after the options configuring the client for the server.
+func (s *Server) NewClient(opts ...cosmic.ClientOption) (*cosmic.CosmicClient, error) {
+	return cosmic.New(s.URL, append([]cosmic.ClientOption{
+		cosmic.WithAPIKey(s.APIKey, s.SecretKey),
+		cosmic.WithAsync(true),
+		cosmic.WithPollInterval(time.Millisecond, 10*time.Millisecond),
+	}, opts...)...)
+}
+
+// SetJobPolls sets the number of times a job has to be polled before it is finished
+func (s *Server) SetJobPolls(polls int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.jobPolls = polls
+}
+
+// Fail makes the next call of the command fail with the given error code and text. For async
+// commands the job fails, for all other commands the request itself fails.
+func (s *Server) Fail(command string, errorcode int, errortext string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures[strings.ToLower(command)] = &apiError{code: errorcode, text: errortext}
+}
+
+// Requests returns the commands of all requests received so far, in order
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.requests...)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	p := r.Form
+	command := p.Get("command")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, command)
+
+	if p.Get("apiKey") != s.APIKey || cosmic.VerifySignature(p, s.SecretKey) != nil {
+		s.writeError(w, command, &apiError{
+			code: cosmic.ErrorCodeUnauthorized,
+			text: "unable to verify user credentials and/or request signature",
+		})
+		return
+	}
+
+	// Commands are case insensitive
+	var h *handler
+	for name, handler := range s.handlers {
+		if strings.EqualFold(name, command) {
+			command, h = name, handler
+			break
+		}
+	}
+	if h == nil {
+		s.writeError(w, command, &apiError{
+			code: cosmic.ErrorCodeUnsupportedAction,
+			text: fmt.Sprintf("The given command does not exist or it is not available for user: %s", command),
+		})
+		return
+	}
+
+	failure := s.failures[strings.ToLower(command)]
+	delete(s.failures, strings.ToLower(command))
+
+	if h.fn != nil {
+		if failure != nil {
+			s.writeError(w, command, failure)
+			return
+		}
+
+		v, err := h.fn(p)
+		if err != nil {
+			s.writeError(w, command, err)
+			return
+		}
+		s.write(w, command, http.StatusOK, v)
+		return
+	}
+
+	// A failing job doesn't change any state, so the command is only executed without a failure
+	id, result := p.Get("id"), interface{}(nil)
+	if failure == nil {
+		var err error
+		id, result, err = h.job(p)
+		if f, ok := err.(*jobFailure); ok {
+			id, failure = p.Get("id"), f.apiError
+		} else if err != nil {
+			s.writeError(w, command, err)
+			return
+		}
+	}
+	if h.key != "" {
+		result = map[string]interface{}{h.key: result}
+	}
+
+	j := s.startJob(command, h.instanceType, id, result, failure)
+	s.write(w, command, http.StatusOK, map[string]string{"jobid": j.result.Jobid, "id": id})
+}
+
+// Writes the value wrapped in the envelope of the command
+func (s *Server) write(w http.ResponseWriter, command string, status int, v interface{}) {
+	b, err := json.Marshal(map[string]interface{}{strings.ToLower(command) + "response": v})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(status)
+	w.Write(b)
+}
+
+// Writes the error using the error code as HTTP status code, just like the API does
+func (s *Server) writeError(w http.ResponseWriter, command string, err error) {
+	e, ok := err.(*apiError)
+	if !ok {
+		e = &apiError{code: cosmic.ErrorCodeInternalError, text: err.Error()}
+	}
+
+	s.write(w, command, e.code, map[string]interface{}{
+		"uuidList":    []string{},
+		"errorcode":   e.code,
+		"cserrorcode": 9999,
+		"errortext":   e.text,
+	})
+}
+
+// Starts a job with the given result, which fails with the given failure if not nil. Must be
+// called with s.mu held.
+func (s *Server) startJob(command, instanceType, instanceID string, result interface{}, failure *apiError) *job {
+	r := &cosmic.QueryAsyncJobResultResponse{
+		Cmd:             "org.apache.cloudstack.api.command.user." + command,
+		Created:         now(),
+		Jobid:           s.newID(),
+		Jobinstanceid:   instanceID,
+		Jobinstancetype: instanceType,
+		Jobresulttype:   "object",
+		Jobstatus:       cosmic.JobStatusSuccess,
+	}
+
+	if failure != nil {
+		r.Jobstatus = cosmic.JobStatusFailed
+		r.Jobresultcode = cosmic.ErrorCodeInternalError
+		result = map[string]interface{}{"errorcode": failure.code, "cserrorcode": 9999, "errortext": failure.text}
+	}
+	r.Jobresult, _ = json.Marshal(result)
+
+	j := &job{result: r, polls: s.jobPolls}
+	s.jobs = append(s.jobs, j)
+	return j
+}
+
+// Returns the status of the job as reported by the API. Must be called with s.mu held.
+func (j *job) status() *cosmic.QueryAsyncJobResultResponse {
+	if j.polls > 0 {
+		j.polls--
+		return &cosmic.QueryAsyncJobResultResponse{
+			Cmd:             j.result.Cmd,
+			Created:         j.result.Created,
+			Jobid:           j.result.Jobid,
+			Jobinstanceid:   j.result.Jobinstanceid,
+			Jobinstancetype: j.result.Jobinstancetype,
+			Jobstatus:       cosmic.JobStatusPending,
+		}
+	}
+	return j.result
+}
+
+// Returns a new unique ID. Must be called with s.mu held.
+func (s *Server) newID() string {
+	s.lastID++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012x", s.lastID)
+}
+
+// Returns a new unique public IP address. Must be called with s.mu held.
+func (s *Server) newIP() string {
+	s.lastIP++
+	return fmt.Sprintf("192.0.%d.%d", 2+s.lastIP/254, 1+s.lastIP%254)
+}
+
+// Returns the current time formatted as the API does
+func now() string {
+	return time.Now().UTC().Format("2006-01-02T15:04:05-0700")
+}
+
+// Returns an error if any of the given params is missing
+func required(p url.Values, command string, names ...string) error {
+	for _, name := range names {
+		if p.Get(name) == "" {
+			return paramErrorf("Unable to execute API command %s due to missing parameter %s", strings.ToLower(command), name)
+		}
+	}
+	return nil
+}
+
+// Returns true if the item with the given fields matches all filters in the params. Fields that
+// are empty in the item only match an empty filter, and the keyword filter matches part of the name.
+func matches(p url.Values, fields map[string]string) bool {
+	for name, value := range fields {
+		if filter := p.Get(name); filter != "" && !strings.EqualFold(filter, value) {
+			return false
+		}
+	}
+	if keyword := p.Get("keyword"); keyword != "" {
+		return strings.Contains(strings.ToLower(fields["name"]), strings.ToLower(keyword))
+	}
+	return true
+}
+
+// Returns the bounds of the requested page in a list of n items
+func pageBounds(p url.Values, n int) (int, int, error) {
+	if p.Get("page") == "" && p.Get("pagesize") == "" {
+		return 0, n, nil
+	}
+
+	page, err := strconv.Atoi(p.Get("page"))
+	if err != nil || page < 1 {
+		return 0, 0, paramErrorf("Invalid page: %q", p.Get("page"))
+	}
+	pagesize, err := strconv.Atoi(p.Get("pagesize"))
+	if err != nil || pagesize < 1 {
+		return 0, 0, paramErrorf("Invalid pagesize: %q", p.Get("pagesize"))
+	}
+
+	start, end := (page-1)*pagesize, page*pagesize
+	if start > n {
+		start = n
+	}
+	if end > n {
+		end = n
+	}
+	return start, end, nil
+}
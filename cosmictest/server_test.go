@@ -0,0 +1,233 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cosmictest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/MissionCriticalCloud/go-cosmic/v6/cosmic"
+)
+
+// Returns the number of requests for the given command received by the server
+func countRequests(s *Server, command string) int {
+	n := 0
+	for _, r := range s.Requests() {
+		if r == command {
+			n++
+		}
+	}
+	return n
+}
+
+func TestBadSignature(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	cs, err := s.NewClient(cosmic.WithAPIKey(DefaultAPIKey, "wrong-secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = cs.Zone.ListZones(cs.Zone.NewListZonesParams())
+
+	var e *cosmic.CSError
+	if !errors.As(err, &e) || e.HTTPStatus != 401 || !errors.Is(err, cosmic.ErrPermissionDenied) {
+		t.Errorf("Expected a 401 permission denied error, got %v", err)
+	}
+}
+
+func TestUnknownCommand(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	cs, err := s.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = cs.Pod.ListPods(cs.Pod.NewListPodsParams())
+
+	var e *cosmic.CSError
+	if !errors.As(err, &e) || e.ErrorCode != cosmic.ErrorCodeUnsupportedAction {
+		t.Errorf("Expected an unsupported action error, got %v", err)
+	}
+}
+
+func TestCommandsAreCaseInsensitive(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	s.AddZone("zone1")
+
+	cs, err := s.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := cs.SignCommand("LISTZONES", url.Values{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.PostForm(s.URL, req.Form)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", resp.StatusCode)
+	}
+}
+
+func TestFailSyncCommand(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	s.AddZone("zone1")
+
+	cs, err := s.NewClient(cosmic.WithRetryPolicy(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s.Fail("listZones", cosmic.ErrorCodeInternalError, "database unavailable")
+	_, err = cs.Zone.ListZones(cs.Zone.NewListZonesParams())
+
+	var e *cosmic.CSError
+	if !errors.As(err, &e) || e.ErrorCode != cosmic.ErrorCodeInternalError || e.ErrorText != "database unavailable" {
+		t.Errorf("Expected the injected error, got %v", err)
+	}
+
+	// Only the next call fails
+	if _, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams()); err != nil {
+		t.Errorf("Expected the following call to succeed, got %v", err)
+	}
+}
+
+func TestFailAsyncCommand(t *testing.T) {
+	s, offering, template, zone := newSeededServer()
+	defer s.Close()
+
+	cs, err := s.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s.Fail("deployVirtualMachine", cosmic.ErrorCodeInsufficientCapacity, "no capacity")
+	_, err = cs.VirtualMachine.DeployVirtualMachine(cs.VirtualMachine.NewDeployVirtualMachineParams(offering, template, zone))
+
+	var e *cosmic.AsyncJobError
+	if !errors.As(err, &e) || e.ErrorCode != cosmic.ErrorCodeInsufficientCapacity || !errors.Is(err, cosmic.ErrResourceUnavailable) {
+		t.Errorf("Expected the job to fail with the injected error, got %v", err)
+	}
+	if vms := s.VirtualMachines(); len(vms) != 0 {
+		t.Errorf("Expected a failed job to not deploy a virtual machine, got %d", len(vms))
+	}
+}
+
+func TestJobPolls(t *testing.T) {
+	s, offering, template, zone := newSeededServer()
+	defer s.Close()
+	s.SetJobPolls(2)
+
+	cs, err := s.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cs.VirtualMachine.DeployVirtualMachine(cs.VirtualMachine.NewDeployVirtualMachineParams(offering, template, zone)); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := countRequests(s, "queryAsyncJobResult"); n != 3 {
+		t.Errorf("Expected the job to be polled 3 times, got %d", n)
+	}
+}
+
+func TestListPaging(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	for _, name := range []string{"zone1", "zone2", "zone3", "zone4", "zone5"} {
+		s.AddZone(name)
+	}
+
+	cs, err := s.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	it := cs.Zone.NewListZonesIterator(cs.Zone.NewListZonesParams(), 2)
+	for it.Next(context.Background()) {
+		names = append(names, it.Value().Name)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 5 || names[0] != "zone1" || names[4] != "zone5" {
+		t.Errorf("Expected all 5 zones in order, got %v", names)
+	}
+	if n := countRequests(s, "listZones"); n != 3 {
+		t.Errorf("Expected 3 pages to be requested, got %d", n)
+	}
+
+	// Without paging all zones are returned at once
+	l, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Count != 5 || len(l.Zones) != 5 {
+		t.Errorf("Expected 5 zones, got %d of %d", len(l.Zones), l.Count)
+	}
+}
+
+func TestPageBounds(t *testing.T) {
+	tests := []struct {
+		page, pagesize string
+		start, end     int
+		error          bool
+	}{
+		{"", "", 0, 5, false},
+		{"1", "2", 0, 2, false},
+		{"3", "2", 4, 5, false},
+		{"4", "2", 5, 5, false},
+		{"0", "2", 0, 0, true},
+		{"1", "0", 0, 0, true},
+		{"x", "2", 0, 0, true},
+		{"1", "", 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		p := url.Values{}
+		if tt.page != "" {
+			p.Set("page", tt.page)
+		}
+		if tt.pagesize != "" {
+			p.Set("pagesize", tt.pagesize)
+		}
+
+		start, end, err := pageBounds(p, 5)
+		if tt.error {
+			if err == nil {
+				t.Errorf("Expected an error for page %q and pagesize %q", tt.page, tt.pagesize)
+			}
+			continue
+		}
+		if err != nil || start != tt.start || end != tt.end {
+			t.Errorf("Expected [%d:%d] for page %q and pagesize %q, got [%d:%d] (%v)",
+				tt.start, tt.end, tt.page, tt.pagesize, start, end, err)
+		}
+	}
+}
@@ -20,12 +20,17 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"go/ast"
 	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
 	"io/ioutil"
 	"log"
 	"os"
 	"os/exec"
 	"path"
+	"path/filepath"
 	"sort"
 	"strings"
 	"unicode"
@@ -107,8 +112,9 @@ func (s apis) Swap(i, j int) {
 }
 
 type service struct {
-	name string
-	apis apis
+	name    string
+	apis    apis
+	methods []*method // Exported methods of the generated service, as parsed from the generated code
 
 	p  func(format string, args ...interface{}) // print raw
 	pn func(format string, args ...interface{}) // print with indent and newline
@@ -183,7 +189,10 @@ func main() {
 		log.Fatal(err)
 	}
 
-	os.RemoveAll(outdir)
+	if err := removeGeneratedCode(outdir); err != nil {
+		log.Fatal(err)
+	}
+
 	allServices, err := getAllServices(*listApis)
 	if err != nil {
 		log.Fatal(err)
@@ -193,6 +202,14 @@ func main() {
 		log.Fatal(err)
 	}
 
+	if err = allServices.WriteGeneralMockCode(); err != nil {
+		log.Fatal(err)
+	}
+
+	if err = allServices.WriteGeneralTestCode(); err != nil {
+		log.Fatal(err)
+	}
+
 	var errors []error
 	for _, s := range allServices {
 		if err = s.WriteGeneratedCode(); err != nil {
@@ -229,6 +246,161 @@ func (as services) WriteGeneralCode() error {
 	return ioutil.WriteFile(file, code, 0644)
 }
 
+func (as services) WriteGeneralMockCode() error {
+	outdir, err := sourceDir()
+	if err != nil {
+		log.Fatalf("Failed to get source dir: %s", err)
+	}
+
+	code, err := as.GeneralMockCode()
+	if err != nil {
+		return err
+	}
+
+	file := path.Join(outdir, "cosmic_mock.go")
+	return ioutil.WriteFile(file, code, 0644)
+}
+
+func (as services) GeneralMockCode() ([]byte, error) {
+	var buf bytes.Buffer
+	pn := func(format string, args ...interface{}) {
+		fmt.Fprintf(&buf, format+"\n", args...)
+	}
+	pn("//")
+	pn("// Copyright 2018, Sander van Harmelen")
+	pn("//")
+	pn("// Licensed under the Apache License, Version 2.0 (the \"License\");")
+	pn("// you may not use this file except in compliance with the License.")
+	pn("// You may obtain a copy of the License at")
+	pn("//")
+	pn("//     http://www.apache.org/licenses/LICENSE-2.0")
+	pn("//")
+	pn("// Unless required by applicable law or agreed to in writing, software")
+	pn("// distributed under the License is distributed on an \"AS IS\" BASIS,")
+	pn("// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.")
+	pn("// See the License for the specific language governing permissions and")
+	pn("// limitations under the License.")
+	pn("//")
+	pn("")
+	pn("package %s", pkg)
+	pn("")
+	pn("// MockClient contains the mocks of all services of a client returned by NewMockClient")
+	pn("type MockClient struct {")
+	for _, s := range as {
+		pn("	%s *Mock%s", strings.TrimSuffix(s.name, "Service"), s.name)
+	}
+	pn("}")
+	pn("")
+	pn("// NewMockClient returns a client of which all services are mocks, together with the mocks")
+	pn("// so they can be programmed. Helpers using the services (like waiting for async jobs) use")
+	pn("// the mocks as well.")
+	pn("func NewMockClient() (*CosmicClient, *MockClient) {")
+	pn("	m := &MockClient{")
+	for _, s := range as {
+		pn("		%s: &Mock%s{},", strings.TrimSuffix(s.name, "Service"), s.name)
+	}
+	pn("	}")
+	pn("")
+	pn("	cs := newClient(\"\", \"\", \"\", false, nil, 60)")
+	for _, s := range as {
+		pn("	cs.%s = m.%s", strings.TrimSuffix(s.name, "Service"), strings.TrimSuffix(s.name, "Service"))
+	}
+	pn("")
+	pn("	return cs, m")
+	pn("}")
+
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		buf.WriteTo(os.Stdout)
+		return buf.Bytes(), err
+	}
+	return clean, nil
+}
+
+func (as services) WriteGeneralTestCode() error {
+	outdir, err := sourceDir()
+	if err != nil {
+		log.Fatalf("Failed to get source dir: %s", err)
+	}
+
+	code, err := as.GeneralTestCode()
+	if err != nil {
+		return err
+	}
+
+	file := path.Join(outdir, "cosmic_test.go")
+	return ioutil.WriteFile(file, code, 0644)
+}
+
+// GeneralTestCode returns the helpers used by the generated contract tests of all services
+func (as services) GeneralTestCode() ([]byte, error) {
+	var buf bytes.Buffer
+	pn := func(format string, args ...interface{}) {
+		fmt.Fprintf(&buf, format+"\n", args...)
+	}
+	pn("//")
+	pn("// Copyright 2018, Sander van Harmelen")
+	pn("//")
+	pn("// Licensed under the Apache License, Version 2.0 (the \"License\");")
+	pn("// you may not use this file except in compliance with the License.")
+	pn("// You may obtain a copy of the License at")
+	pn("//")
+	pn("//     http://www.apache.org/licenses/LICENSE-2.0")
+	pn("//")
+	pn("// Unless required by applicable law or agreed to in writing, software")
+	pn("// distributed under the License is distributed on an \"AS IS\" BASIS,")
+	pn("// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.")
+	pn("// See the License for the specific language governing permissions and")
+	pn("// limitations under the License.")
+	pn("//")
+	pn("")
+	pn("package %s", pkg)
+	pn("")
+	pn("// Checks the params encode to the expected values for the expected command")
+	pn("func assertParams(t *testing.T, p CommandParams, command string, want url.Values) {")
+	pn("	t.Helper()")
+	pn("")
+	pn("	if got := p.command(); got != command {")
+	pn("		t.Errorf(\"Expected command %%q, got %%q\", command, got)")
+	pn("	}")
+	pn("	if got := p.toURLValues(); !reflect.DeepEqual(got, want) {")
+	pn("		t.Errorf(\"Expected params:\\n%%s\\ngot:\\n%%s\", want.Encode(), got.Encode())")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// Decodes the response into v, and checks encoding v results in the same document, so")
+	pn("// none of the fields of the response were lost while decoding")
+	pn("func assertDecodes(t *testing.T, response string, v interface{}) {")
+	pn("	t.Helper()")
+	pn("")
+	pn("	if err := json.Unmarshal([]byte(response), v); err != nil {")
+	pn("		t.Fatalf(\"Unable to decode the response: %%v\", err)")
+	pn("	}")
+	pn("	b, err := json.Marshal(v)")
+	pn("	if err != nil {")
+	pn("		t.Fatalf(\"Unable to encode the decoded response: %%v\", err)")
+	pn("	}")
+	pn("")
+	pn("	var want, got interface{}")
+	pn("	if err := json.Unmarshal([]byte(response), &want); err != nil {")
+	pn("		t.Fatal(err)")
+	pn("	}")
+	pn("	if err := json.Unmarshal(b, &got); err != nil {")
+	pn("		t.Fatal(err)")
+	pn("	}")
+	pn("	if !reflect.DeepEqual(got, want) {")
+	pn("		t.Errorf(\"Decoding the response lost data, expected:\\n%%s\\ngot:\\n%%s\", response, b)")
+	pn("	}")
+	pn("}")
+
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		buf.WriteTo(os.Stdout)
+		return buf.Bytes(), err
+	}
+	return clean, nil
+}
+
 func (as services) GeneralCode() ([]byte, error) {
 	// Buffer the output in memory, for gofmt'ing later in the defer.
 	var buf bytes.Buffer
@@ -272,18 +444,12 @@ func (as services) GeneralCode() ([]byte, error) {
 	pn("// OptionFunc can be passed to the courtesy helper functions to set additional parameters")
 	pn("type OptionFunc func(*CosmicClient, interface{}) error")
 	pn("")
-	pn("type CSError struct {")
-	pn("	ErrorCode   int    `json:\"errorcode\"`")
-	pn("	CSErrorCode int    `json:\"cserrorcode\"`")
-	pn("	ErrorText   string `json:\"errortext\"`")
-	pn("}")
-	pn("")
-	pn("func (e *CSError) Error() error {")
-	pn("	return fmt.Errorf(\"Cosmic API error %%d (CSExceptionErrorCode: %%d): %%s\", e.ErrorCode, e.CSErrorCode, e.ErrorText)")
-	pn("}")
-	pn("")
 	pn("type CosmicClient struct {")
-	pn("	HTTPGETOnly bool // If `true` only use HTTP GET calls")
+	pn("	// If `true` only use HTTP GET calls. This is still honored, but changing a client that is in use")
+	pn("	// is not safe.")
+	pn("	//")
+	pn("	// Deprecated: use New with WithHTTPGETOnly instead.")
+	pn("	HTTPGETOnly bool")
 	pn("")
 	pn("	client  *http.Client // The http client for communicating")
 	pn("	baseURL string       // The base URL of the API")
@@ -291,9 +457,28 @@ func (as services) GeneralCode() ([]byte, error) {
 	pn("	secret  string       // Secret key")
 	pn("	async   bool         // Wait for async calls to finish")
 	pn("	timeout int64        // Max waiting timeout in seconds for async jobs to finish; defaults to 300 seconds")
+	pn("	session *session     // Session used to authenticate requests instead of signing them")
+	pn("	expires time.Duration // Validity of signed requests; when zero signed requests never expire")
+	pn("	retry   RetryPolicy   // Policy used to retry failed requests; when nil requests are never retried")
+	pn("")
+	pn("	userAgent       string        // User agent send with every request; when empty the Go default is used")
+	pn("	pollInterval    time.Duration // Interval added to the wait time between two polls of a running async job")
+	pn("	maxPollInterval time.Duration // Max wait time between two polls of a running async job")
+	pn("	endpoints       *endpointPool // Additional management servers to fail over to; when nil only baseURL is used")
+	pn("	limiter         RateLimiter   // Limiter used to throttle requests; when nil requests are not throttled")
+	pn("	interceptors    []Interceptor // Interceptors every request is passed through")
+	pn("	pageConcurrency int           // Max number of pages of a list response requested concurrently")
+	pn("	postCommands    []string      // Commands that are always called using a POST call")
+	pn("	maxGETLength    int           // Max length of the URL of a GET call; when zero the length is not limited")
+	pn("	metrics         Metrics       // Collector of metrics about requests; when nil no metrics are collected")
+	pn("	cache           *ResponseCache // Cache of responses of read-only commands; when nil responses are not cached")
+	pn("	logger          Logger        // Logger of requests; when nil requests are not logged")
+	pn("	logLevel        LogLevel      // Level used to log requests")
+	pn("	logBodySize     int           // Max size of a logged response body; when zero bodies are not truncated")
+	pn("	getOnly         bool          // If true only use HTTP GET calls, except to login")
 	pn("")
 	for _, s := range as {
-		pn("  %s *%s", strings.TrimSuffix(s.name, "Service"), s.name)
+		pn("  %s %sIface", strings.TrimSuffix(s.name, "Service"), s.name)
 	}
 	pn("}")
 	pn("")
@@ -312,11 +497,36 @@ func (as services) GeneralCode() ([]byte, error) {
 	pn("		secret:  secret,")
 	pn("		async:   async,")
 	pn("		timeout: 300,")
+	pn("		retry:   DefaultRetryPolicy(),")
+	pn("")
+	pn("		pollInterval:    time.Second,")
+	pn("		maxPollInterval: 15 * time.Second,")
+	pn("")
+	pn("		// The userdata of virtual machines can be large, so always use POST calls for those")
+	pn("		postCommands: []string{\"deployVirtualMachine\", \"updateVirtualMachine\"},")
+	pn("		maxGETLength: DefaultMaxGETLength,")
+	pn("		logBodySize:  DefaultLogBodySize,")
 	pn("	}")
+	pn("	cs.initServices()")
+	pn("	return cs")
+	pn("}")
+	pn("")
+	pn("// Creates all services using the client")
+	pn("func (cs *CosmicClient) initServices() {")
 	for _, s := range as {
 		pn("	cs.%s = New%s(cs)", strings.TrimSuffix(s.name, "Service"), s.name)
 	}
-	pn("	return cs")
+	pn("}")
+	pn("")
+	pn("// Points the services of the client that still use the client it was copied from at the client,")
+	pn("// keeping all other (for example mocked) services")
+	pn("func (cs *CosmicClient) cloneServices(from *CosmicClient) {")
+	for _, s := range as {
+		field := strings.TrimSuffix(s.name, "Service")
+		pn("	if s, ok := cs.%s.(*%s); ok && s.cs == from {", field, s.name)
+		pn("		cs.%s = New%s(cs)", field, s.name)
+		pn("	}")
+	}
 	pn("}")
 	pn("")
 	pn("// Default non-async client. So for async calls you need to implement and check the async job result yourself. When using")
@@ -338,6 +548,8 @@ func (as services) GeneralCode() ([]byte, error) {
 	pn("")
 	pn("// When using the async client an api call will wait for the async call to finish before returning. The default is to poll for 300 seconds")
 	pn("// seconds, to check if the async job is finished.")
+	pn("//")
+	pn("// Deprecated: use New with WithAsyncTimeout instead, as changing a client that is in use is not safe.")
 	pn("func (cs *CosmicClient) AsyncTimeout(timeoutInSeconds int64) {")
 	pn("	cs.timeout = timeoutInSeconds")
 	pn("}")
@@ -347,107 +559,304 @@ func (as services) GeneralCode() ([]byte, error) {
 	pn("// A helper function that you can use to get the result of a running async job. If the job is not finished within the configured")
 	pn("// timeout, the async job returns a AsyncTimeoutErr.")
 	pn("func (cs *CosmicClient) GetAsyncJobResult(jobid string, timeout int64) (json.RawMessage, error) {")
+	pn("	return cs.GetAsyncJobResultWithContext(context.Background(), jobid, timeout)")
+	pn("}")
+	pn("")
+	pn("// GetAsyncJobResultWithContext is the same as GetAsyncJobResult, but it stops waiting and returns the error of")
+	pn("// the context when the passed context is cancelled or its deadline is exceeded before the job is finished.")
+	pn("func (cs *CosmicClient) GetAsyncJobResultWithContext(ctx context.Context, jobid string, timeout int64) (json.RawMessage, error) {")
+	pn("	return cs.waitForAsyncJob(ctx, \"\", jobid, timeout)")
+	pn("}")
+	pn("")
+	pn("// Waits for the async job started by the given command to finish. The command is only used to")
+	pn("// report metrics, and when empty the command class reported by the job is used instead.")
+	pn("func (cs *CosmicClient) waitForAsyncJob(ctx context.Context, command string, jobid string, timeout int64) (json.RawMessage, error) {")
 	pn("	var timer time.Duration")
-	pn("	currentTime := time.Now().Unix()")
+	pn("	start := time.Now()")
+	pn("	currentTime := start.Unix()")
 	pn("")
 	pn("		for {")
 	pn("		p := cs.Asyncjob.NewQueryAsyncJobResultParams(jobid)")
-	pn("		r, err := cs.Asyncjob.QueryAsyncJobResult(p)")
+	pn("		r, err := cs.Asyncjob.QueryAsyncJobResultWithContext(ctx, p)")
 	pn("		if err != nil {")
 	pn("			return nil, err")
 	pn("		}")
 	pn("")
 	pn("		// Status 1 means the job is finished successfully")
 	pn("		if r.Jobstatus == 1 {")
+	pn("			cs.asyncJobFinished(command)")
+	pn("			cs.observeAsyncJob(command, r, time.Since(start))")
 	pn("			return r.Jobresult, nil")
 	pn("		}")
 	pn("")
 	pn("		// When the status is 2, the job has failed")
 	pn("		if r.Jobstatus == 2 {")
-	pn("			if r.Jobresulttype == \"text\" {")
-	pn("				return nil, fmt.Errorf(string(r.Jobresult))")
-	pn("			} else {")
-	pn("				return nil, fmt.Errorf(\"Undefined error: %%s\", string(r.Jobresult))")
-	pn("			}")
+	pn("			cs.asyncJobFinished(command)")
+	pn("			cs.observeAsyncJob(command, r, time.Since(start))")
+	pn("			return nil, jobError(jobid, r)")
 	pn("		}")
 	pn("")
 	pn("		if time.Now().Unix()-currentTime > timeout {")
+	pn("			cs.observeAsyncJob(command, r, time.Since(start))")
 	pn("			return nil, AsyncTimeoutErr")
 	pn("		}")
 	pn("")
 	pn("		// Add an (extremely simple) exponential backoff like feature to prevent")
 	pn("		// flooding the Cosmic API")
-	pn("		if timer < 15 {")
-	pn("			timer++")
+	pn("		if timer < cs.maxPollInterval {")
+	pn("			timer += cs.pollInterval")
+	pn("			if timer > cs.maxPollInterval {")
+	pn("				timer = cs.maxPollInterval")
+	pn("			}")
+	pn("		}")
+	pn("")
+	pn("		if err := sleepWithContext(ctx, timer); err != nil {")
+	pn("			return nil, err")
 	pn("		}")
+	pn("	}")
+	pn("}")
 	pn("")
-	pn("		time.Sleep(timer * time.Second)")
+	pn("// Sleeps for the given duration, or returns the error of the context if it is done before that")
+	pn("func sleepWithContext(ctx context.Context, d time.Duration) error {")
+	pn("	t := time.NewTimer(d)")
+	pn("	defer t.Stop()")
+	pn("")
+	pn("	select {")
+	pn("	case <-ctx.Done():")
+	pn("		return ctx.Err()")
+	pn("	case <-t.C:")
+	pn("		return nil")
 	pn("	}")
 	pn("}")
 	pn("")
 	pn("// Execute the request against a CS API. Will return the raw JSON data returned by the API and nil if")
 	pn("// no error occured. If the API returns an error the result will be nil and the HTTP error code and CS")
 	pn("// error details. If a processing (code) error occurs the result will be nil and the generated error")
-	pn("func (cs *CosmicClient) newRequest(api string, params url.Values) (json.RawMessage, error) {")
-	pn("	params.Set(\"apiKey\", cs.apiKey)")
+	pn("func (cs *CosmicClient) newRequest(ctx context.Context, api string, params url.Values) (json.RawMessage, error) {")
+	pn("	start := time.Now()")
+	pn("")
+	pn("	// Serve cached responses when configured, using a cache scoped to the credentials of this client")
+	pn("	handler := cs.executeRequest")
+	pn("	if cs.cache != nil {")
+	pn("		handler = cs.cache.handler(cs.cacheScope(), handler)")
+	pn("	}")
+	pn("")
+	pn("	// Pass the request through all configured interceptors before executing it")
+	pn("	b, err := chainInterceptors(cs.interceptors, handler)(ctx, api, params)")
+	pn("	cs.observeRequest(api, time.Since(start), err)")
+	pn("")
+	pn("	return b, err")
+	pn("}")
+	pn("")
+	pn("// Executes the request, retrying failed attempts according to the configured retry policy")
+	pn("func (cs *CosmicClient) executeRequest(ctx context.Context, api string, params url.Values) (json.RawMessage, error) {")
+	pn("	// Never change the params of the caller, which can be reused for following requests")
+	pn("	params = copyValues(params)")
 	pn("	params.Set(\"command\", api)")
 	pn("	params.Set(\"response\", \"json\")")
 	pn("")
-	pn("	// Generate signature for API call")
-	pn("	// * Serialize parameters, URL encoding only values and sort them by key, done by encodeValues")
-	pn("	// * Convert the entire argument string to lowercase")
-	pn("	// * Replace all instances of '+' to '%%20'")
-	pn("	// * Calculate HMAC SHA1 of argument string with Cosmic secret")
-	pn("	// * URL encode the string and convert to base64")
+	pn("	for attempt := 1; ; attempt++ {")
+	pn("		// Wait until the rate limiter allows sending another request")
+	pn("		if cs.limiter != nil {")
+	pn("			if err := cs.limiter.Wait(ctx); err != nil {")
+	pn("				return nil, err")
+	pn("			}")
+	pn("		}")
+	pn("")
+	pn("		b, err := cs.doRequest(ctx, api, params)")
+	pn("		if err != nil && cs.limiter != nil {")
+	pn("			// Let the rate limiter know if the API limit was exceeded, so it can back off")
+	pn("			notifyLimitExceeded(cs.limiter, err)")
+	pn("		}")
+	pn("")
+	pn("		if err == nil || cs.retry == nil || ctx.Err() != nil {")
+	pn("			return b, err")
+	pn("		}")
+	pn("")
+	pn("		// Ask the retry policy if (and when) this failed attempt should be retried")
+	pn("		wait, retry := cs.retry.Retry(api, attempt, err)")
+	pn("		if !retry {")
+	pn("			if attempt > 1 {")
+	pn("				return nil, &RetryError{Command: api, Attempts: attempt, Err: err}")
+	pn("			}")
+	pn("			return nil, err")
+	pn("		}")
+	pn("")
+	pn("		if err := sleepWithContext(ctx, wait); err != nil {")
+	pn("			return nil, err")
+	pn("		}")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// Authenticates and executes a single attempt of the request")
+	pn("func (cs *CosmicClient) doRequest(ctx context.Context, api string, params url.Values) (json.RawMessage, error) {")
+	pn("	// Every attempt authenticates its own copy, so credentials never end up in the params of a retry")
+	pn("	params = copyValues(params)")
+	pn("")
+	pn("	// A session based client authenticates using the session key and cookie")
+	pn("	// returned by the login command, so there is no need to sign the request")
+	pn("	if cs.session != nil {")
+	pn("		return cs.session.newRequest(ctx, cs, api, params)")
+	pn("	}")
+	pn("")
+	pn("	cs.signParams(params)")
+	pn("")
+	pn("	return cs.sendRequest(ctx, api, params)")
+	pn("}")
+	pn("")
+	pn("// Adds the API key and the signature to the params")
+	pn("func (cs *CosmicClient) signParams(params url.Values) {")
+	pn("	params.Set(\"apiKey\", cs.apiKey)")
+	pn("")
+	pn("	// Let the request expire after the configured validity, so it cannot be replayed")
+	pn("	if cs.expires > 0 {")
+	pn("		params.Set(\"signatureVersion\", \"3\")")
+	pn("		params.Set(\"expires\", time.Now().Add(cs.expires).UTC().Format(\"2006-01-02T15:04:05-0700\"))")
+	pn("	}")
+	pn("")
+	pn("	// Make sure a signature of a previous attempt is not signed as well")
+	pn("	params.Del(\"signature\")")
+	pn("")
+	pn("	// Add the unescaped signature to the params")
+	pn("	params.Set(\"signature\", signValues(params, cs.secret))")
+	pn("}")
+	pn("")
+	pn("// Generate signature for API call")
+	pn("// * Serialize parameters, URL encoding only values and sort them by key, done by encodeValues")
+	pn("// * Convert the entire argument string to lowercase")
+	pn("// * Replace all instances of '+' to '%%20'")
+	pn("// * Calculate HMAC SHA1 of argument string with Cosmic secret")
+	pn("// * URL encode the string and convert to base64")
+	pn("func signValues(params url.Values, secret string) string {")
 	pn("	s := encodeValues(params)")
 	pn("	s2 := strings.ToLower(s)")
 	pn("	s3 := strings.Replace(s2, \"+\", \"%%20\", -1)")
-	pn("	mac := hmac.New(sha1.New, []byte(cs.secret))")
+	pn("	mac := hmac.New(sha1.New, []byte(secret))")
 	pn("	mac.Write([]byte(s3))")
-	pn("	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))")
+	pn("	return base64.StdEncoding.EncodeToString(mac.Sum(nil))")
+	pn("}")
+	pn("// Returns true if the API should be called using a POST call")
+	pn("func (cs *CosmicClient) usePOST(api string, params url.Values) bool {")
+	pn("	if api == \"login\" {")
+	pn("		// The login API should always be called using a POST call")
+	pn("		// so the password doesn't end up in any (proxy) logs")
+	pn("		return true")
+	pn("	}")
+	pn("	if cs.getOnly || cs.HTTPGETOnly {")
+	pn("		return false")
+	pn("	}")
+	pn("")
+	pn("	for _, command := range cs.postCommands {")
+	pn("		if strings.EqualFold(command, api) {")
+	pn("			return true")
+	pn("		}")
+	pn("	}")
+	pn("")
+	pn("	// Use a POST call when the URL of a GET call would be too long for some proxies or servers")
+	pn("	return cs.maxGETLength > 0 && len(cs.baseURL)+1+len(encodeValues(params)) > cs.maxGETLength")
+	pn("}")
 	pn("")
-	pn("	var err error")
-	pn("	var resp *http.Response")
-	pn("	if !cs.HTTPGETOnly && (api == \"deployVirtualMachine\" || api == \"updateVirtualMachine\") {")
-	pn("		// The deployVirtualMachine API should be called using a POST call")
-	pn("  	// so we don't have to worry about the userdata size")
+	pn("// Sends the already authenticated request to one of the endpoints of the client, failing over to")
+	pn("// another endpoint if the current one is unavailable and the request was not received by it.")
+	pn("func (cs *CosmicClient) sendRequest(ctx context.Context, api string, params url.Values) (json.RawMessage, error) {")
+	pn("	for attempt := 1; ; attempt++ {")
+	pn("		baseURL, failover := cs.endpoint(ctx)")
 	pn("")
-	pn("		// Add the unescaped signature to the POST params")
-	pn("		params.Set(\"signature\", signature)")
+	pn("		resp, err := cs.sendRequestTo(ctx, baseURL, api, params)")
+	pn("		if !failover {")
+	pn("			return resp, err")
+	pn("		}")
+	pn("")
+	pn("		unavailable, notSent := isEndpointError(err)")
+	pn("		switch {")
+	pn("		case err == nil:")
+	pn("			cs.endpoints.update(baseURL, nil)")
+	pn("		case unavailable:")
+	pn("			cs.endpoints.update(baseURL, err)")
+	pn("		}")
 	pn("")
+	pn("		if !notSent || attempt >= len(cs.endpoints.endpoints) {")
+	pn("			return resp, err")
+	pn("		}")
+	pn("	}")
+	pn("}")
+	pn("")
+	pn("// Sends the already authenticated request to the API at baseURL and returns the raw JSON data returned")
+	pn("// by the API. If the API returns an error the result will be nil and the error will be a *CSError")
+	pn("// containing the HTTP status code and the CS error details.")
+	pn("func (cs *CosmicClient) sendRequestTo(ctx context.Context, baseURL string, api string, params url.Values) (result json.RawMessage, err error) {")
+	pn("	var req *http.Request")
+	pn("	if cs.usePOST(api, params) {")
 	pn("		// Make a POST call")
-	pn("		resp, err = cs.client.PostForm(cs.baseURL, params)")
+	pn("		req, err = http.NewRequest(\"POST\", baseURL, strings.NewReader(params.Encode()))")
+	pn("		if err != nil {")
+	pn("			return nil, err")
+	pn("		}")
+	pn("		req.Header.Set(\"Content-Type\", \"application/x-www-form-urlencoded\")")
 	pn("	} else {")
-	pn("		// Create the final URL before we issue the request")
-	pn("		url := cs.baseURL + \"?\" + s + \"&signature=\" + url.QueryEscape(signature)")
-	pn("")
 	pn("		// Make a GET call")
-	pn("		resp, err = cs.client.Get(url)")
+	pn("		req, err = http.NewRequest(\"GET\", baseURL+\"?\"+encodeValues(params), nil)")
+	pn("		if err != nil {")
+	pn("			return nil, err")
+	pn("		}")
 	pn("	}")
+	pn("")
+	pn("	if cs.userAgent != \"\" {")
+	pn("		req.Header.Set(\"User-Agent\", cs.userAgent)")
+	pn("	}")
+	pn("")
+	pn("	// Add the JSESSIONID cookie when using session based authentication")
+	pn("	if cs.session != nil {")
+	pn("		cs.session.addCookies(req)")
+	pn("	}")
+	pn("")
+	pn("	// Log the request when finished, including the status and body of the response if received")
+	pn("	start := time.Now()")
+	pn("	status, body := 0, []byte(nil)")
+	pn("	defer func() {")
+	pn("		cs.logRequest(api, params, req.Method, status, time.Since(start), body, err)")
+	pn("	}()")
+	pn("")
+	pn("	resp, err := cs.client.Do(req.WithContext(ctx))")
 	pn("	if err != nil {")
 	pn("		return nil, err")
 	pn("	}")
 	pn("	defer resp.Body.Close()")
+	pn("	status = resp.StatusCode")
 	pn("")
-	pn("	b, err := ioutil.ReadAll(resp.Body)")
-	pn("	if err != nil {")
-	pn("		return nil, err")
+	pn("	if cs.session != nil {")
+	pn("		cs.session.saveCookies(req, resp)")
 	pn("	}")
 	pn("")
-	pn("	// Need to get the raw value to make the result play nice")
-	pn("	b, err = getRawValue(b)")
+	pn("	b, err := ioutil.ReadAll(resp.Body)")
 	pn("	if err != nil {")
 	pn("		return nil, err")
 	pn("	}")
+	pn("	body = b")
 	pn("")
 	pn("	if resp.StatusCode != 200 {")
-	pn("		var e CSError")
-	pn("		if err := json.Unmarshal(b, &e); err != nil {")
-	pn("			return nil, err")
+	pn("		e := &CSError{HTTPStatus: resp.StatusCode, Command: api}")
+	pn("")
+	pn("		// Not every error (e.g. one returned by a proxy) will contain the CS error details")
+	pn("		raw, err := getErrorValue(b, api)")
+	pn("		if err != nil || json.Unmarshal(raw, e) != nil {")
+	pn("			e.ErrorCode = resp.StatusCode")
+	pn("			e.ErrorText = strings.TrimSpace(string(b))")
 	pn("		}")
-	pn("		return nil, e.Error()")
+	pn("		return nil, e")
 	pn("	}")
-	pn("	return b, nil")
+	pn("")
+	pn("	// Strip the response envelope to make the result play nice")
+	pn("	return getRawValue(b, responseKey(api))")
+	pn("}")
+	pn("")
+	pn("// Returns a deep copy of the values")
+	pn("func copyValues(v url.Values) url.Values {")
+	pn("	c := make(url.Values, len(v))")
+	pn("	for k, vs := range v {")
+	pn("		c[k] = append([]string(nil), vs...)")
+	pn("	}")
+	pn("	return c")
 	pn("}")
 	pn("")
 	pn("// Custom version of net/url Encode that only URL escapes values")
@@ -476,16 +885,71 @@ func (as services) GeneralCode() ([]byte, error) {
 	pn("	return buf.String()")
 	pn("}")
 	pn("")
-	pn("// Generic function to get the first raw value from a response as json.RawMessage")
-	pn("func getRawValue(b json.RawMessage) (json.RawMessage, error) {")
-	pn("	var m map[string]json.RawMessage")
-	pn("	if err := json.Unmarshal(b, &m); err != nil {")
+	pn("// Returns the value of the given key from a JSON object as json.RawMessage. See unmarshalValue for")
+	pn("// how the value is found.")
+	pn("func getRawValue(b json.RawMessage, key string) (json.RawMessage, error) {")
+	pn("	var raw json.RawMessage")
+	pn("	if err := unmarshalValue(b, key, &raw); err != nil {")
 	pn("		return nil, err")
 	pn("	}")
-	pn("	for _, v := range m {")
-	pn("		return v, nil")
+	pn("	return raw, nil")
+	pn("}")
+	pn("")
+	pn("// Decodes the value of the given key from a JSON object into v. An error is returned when the")
+	pn("// object does not contain the key.")
+	pn("func unmarshalValue(b json.RawMessage, key string, v interface{}) error {")
+	pn("	dec := json.NewDecoder(bytes.NewReader(b))")
+	pn("	if t, err := dec.Token(); err != nil || t != json.Delim('{') {")
+	pn("		return fmt.Errorf(\"Unable to extract the raw value from:\\n\\n%%s\\n\\n\", string(b))")
+	pn("	}")
+	pn("")
+	pn("	var keys []string")
+	pn("	for dec.More() {")
+	pn("		t, err := dec.Token()")
+	pn("		if err != nil {")
+	pn("			return err")
+	pn("		}")
+	pn("		k, _ := t.(string)")
+	pn("		if k == key {")
+	pn("			return dec.Decode(v)")
+	pn("		}")
+	pn("		keys = append(keys, k)")
+	pn("")
+	pn("		var skip json.RawMessage")
+	pn("		if err := dec.Decode(&skip); err != nil {")
+	pn("			return err")
+	pn("		}")
+	pn("	}")
+	pn("")
+	pn("	return fmt.Errorf(\"Expected a response containing key %%q, got keys %%v\", key, keys)")
+	pn("}")
+	pn("")
+	pn("// Returns the error details from the body of an error response. Errors are usually returned in the")
+	pn("// envelope of the command, but errors returned before the command is known use another envelope.")
+	pn("func getErrorValue(b json.RawMessage, api string) (json.RawMessage, error) {")
+	pn("	if raw, err := getRawValue(b, responseKey(api)); err == nil {")
+	pn("		return raw, nil")
+	pn("	}")
+	pn("	return getRawValue(b, \"errorresponse\")")
+	pn("}")
+	pn("")
+	pn("// Returns the key of the envelope the response of the command is wrapped in")
+	pn("func responseKey(api string) string {")
+	pn("	if key, ok := responseKeys[api]; ok {")
+	pn("		return key")
 	pn("	}")
-	pn("	return nil, fmt.Errorf(\"Unable to extract the raw value from:\\n\\n%%s\\n\\n\", string(b))")
+	pn("	return strings.ToLower(api) + \"response\"")
+	pn("}")
+	pn("")
+	pn("// The keys of the envelopes of commands of which the envelope is not named after the command")
+	pn("var responseKeys = map[string]string{")
+	for _, s := range as {
+		for _, a := range s.apis {
+			if key, ok := irregularResponseKeys[a.Name]; ok {
+				pn("	\"%s\": \"%s\",", a.Name, key)
+			}
+		}
+	}
 	pn("}")
 	pn("")
 	pn("// ProjectIDSetter is an interface that every type that can set a project ID must implement")
@@ -566,6 +1030,26 @@ func (s *service) WriteGeneratedCode() error {
 	}
 
 	file := path.Join(outdir, s.name+".go")
+	if err := ioutil.WriteFile(file, code, 0644); err != nil {
+		return err
+	}
+
+	code, err = s.GenerateMockCode()
+	if err != nil {
+		return err
+	}
+
+	file = path.Join(outdir, s.name+"_mock.go")
+	if err := ioutil.WriteFile(file, code, 0644); err != nil {
+		return err
+	}
+
+	code, err = s.GenerateTestCode()
+	if err != nil {
+		return err
+	}
+
+	file = path.Join(outdir, s.name+"_test.go")
 	return ioutil.WriteFile(file, code, 0644)
 }
 
@@ -683,19 +1167,470 @@ func (s *service) GenerateCode() ([]byte, error) {
 		pn("		return b, nil")
 		pn("	}")
 		pn("")
-		pn("	return json.Marshal(raw.Egressrule[0])")
+		pn("	return json.Marshal(raw.Egressrule[0])")
+		pn("}")
+		pn("")
+	}
+
+	for _, a := range s.apis {
+		s.generateParamType(a)
+		s.generateToURLValuesFunc(a)
+		s.generateParamSettersFunc(a)
+		s.generateNewParamTypeFunc(a)
+		s.generateHelperFuncs(a)
+		s.generateNewAPICallFunc(a)
+		if strings.HasPrefix(a.Name, "list") && hasPageParamField(a.Params) {
+			s.generateListIteratorFuncs(a)
+		}
+		if a.Isasync {
+			s.generateAsyncJobFuncs(a)
+		}
+		s.generateResponseType(a)
+	}
+
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		buf.WriteTo(os.Stdout)
+		return buf.Bytes(), err
+	}
+
+	// The interface contains all exported methods of the service, so they are taken from the
+	// generated code instead of repeating the logic deciding which methods are generated
+	if s.methods, err = parseMethods(clean, s.name); err != nil {
+		return clean, err
+	}
+
+	return s.insertInterface(clean)
+}
+
+// Inserts the interface of the service directly after the package clause
+func (s *service) insertInterface(code []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	pn := func(format string, args ...interface{}) {
+		fmt.Fprintf(&buf, format+"\n", args...)
+	}
+
+	pn("// %sIface is the interface implemented by %s, and by Mock%s for tests", s.name, s.name, s.name)
+	pn("type %sIface interface {", s.name)
+	for _, m := range s.methods {
+		pn("	%s", m.signature())
+	}
+	pn("}")
+	pn("")
+
+	clause := []byte("package " + pkg + "\n\n")
+	i := bytes.Index(code, clause)
+	if i < 0 {
+		return code, fmt.Errorf("Package clause not found in the code of %s", s.name)
+	}
+	i += len(clause)
+
+	out := append(append(append([]byte{}, code[:i]...), buf.Bytes()...), code[i:]...)
+	return format.Source(out)
+}
+
+// method is an exported method of a generated service
+type method struct {
+	name    string
+	params  []*methodParam
+	results []string
+}
+
+type methodParam struct {
+	name     string
+	typ      string
+	variadic bool
+}
+
+// Parses the exported methods of the service with the given name from the generated code
+func parseMethods(code []byte, name string) ([]*method, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), name+".go", code, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var methods []*method
+	for _, decl := range f.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Recv == nil || !fd.Name.IsExported() || types.ExprString(fd.Recv.List[0].Type) != "*"+name {
+			continue
+		}
+
+		m := &method{name: fd.Name.Name}
+		for _, field := range fd.Type.Params.List {
+			typ := field.Type
+			variadic := false
+			if e, ok := typ.(*ast.Ellipsis); ok {
+				typ, variadic = e.Elt, true
+			}
+			for _, n := range field.Names {
+				m.params = append(m.params, &methodParam{name: n.Name, typ: types.ExprString(typ), variadic: variadic})
+			}
+		}
+		if fd.Type.Results != nil {
+			for _, field := range fd.Type.Results.List {
+				m.results = append(m.results, types.ExprString(field.Type))
+			}
+		}
+		methods = append(methods, m)
+	}
+	return methods, nil
+}
+
+// Returns the signature of the method, as used in an interface
+func (m *method) signature() string {
+	params := make([]string, len(m.params))
+	for i, mp := range m.params {
+		if mp.variadic {
+			params[i] = mp.name + " ..." + mp.typ
+		} else {
+			params[i] = mp.name + " " + mp.typ
+		}
+	}
+
+	sig := fmt.Sprintf("%s(%s)", m.name, strings.Join(params, ", "))
+	switch len(m.results) {
+	case 0:
+	case 1:
+		sig += " " + m.results[0]
+	default:
+		sig += " (" + strings.Join(m.results, ", ") + ")"
+	}
+	return sig
+}
+
+// Returns the arguments used to pass all params of the method to another function, optionally
+// skipping the context
+func (m *method) args(withContext bool) string {
+	var args []string
+	for _, mp := range m.params {
+		switch {
+		case mp.typ == "context.Context" && !withContext:
+		case mp.variadic:
+			args = append(args, mp.name+"...")
+		default:
+			args = append(args, mp.name)
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+// Returns the zero values returned by a mock of the method together with the given error
+func (m *method) zeroResults(err string) string {
+	values := make([]string, len(m.results))
+	for i, r := range m.results {
+		switch r {
+		case "error":
+			values[i] = err
+		case "string":
+			values[i] = "\"\""
+		case "int":
+			values[i] = "-1"
+		case "bool":
+			values[i] = "false"
+		default:
+			values[i] = "nil"
+		}
+	}
+	return strings.Join(values, ", ")
+}
+
+// GenerateTestCode returns contract tests for all commands of the service, which check the
+// params are encoded as expected, and a synthetic response built from the API metadata is
+// decoded into the response type without losing any fields
+func (s *service) GenerateTestCode() ([]byte, error) {
+	var buf bytes.Buffer
+	pn := func(format string, args ...interface{}) {
+		fmt.Fprintf(&buf, format+"\n", args...)
+	}
+
+	pn("//")
+	pn("// Copyright 2018, Sander van Harmelen")
+	pn("//")
+	pn("// Licensed under the Apache License, Version 2.0 (the \"License\");")
+	pn("// you may not use this file except in compliance with the License.")
+	pn("// You may obtain a copy of the License at")
+	pn("//")
+	pn("//     http://www.apache.org/licenses/LICENSE-2.0")
+	pn("//")
+	pn("// Unless required by applicable law or agreed to in writing, software")
+	pn("// distributed under the License is distributed on an \"AS IS\" BASIS,")
+	pn("// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.")
+	pn("// See the License for the specific language governing permissions and")
+	pn("// limitations under the License.")
+	pn("//")
+	pn("")
+	pn("package %s", pkg)
+	pn("")
+
+	for _, a := range s.apis {
+		tn := capitalize(a.Name + "Params")
+		pn("func Test%s(t *testing.T) {", tn)
+		pn("	p := &%s{}", tn)
+		pn("	want := url.Values{}")
+
+		found := make(map[string]bool)
+		for _, ap := range a.Params {
+			if found[ap.Name] {
+				continue
+			}
+			found[ap.Name] = true
+
+			typ := mapType(ap.Type)
+			switch typ {
+			case "string":
+				pn("	p.Set%s(%q)", capitalize(ap.Name), sampleString(ap.Name))
+				pn("	want.Set(%q, %q)", ap.Name, sampleString(ap.Name))
+			case "int":
+				pn("	p.Set%s(42)", capitalize(ap.Name))
+				pn("	want.Set(%q, \"42\")", ap.Name)
+			case "int64":
+				// Larger than the max int32 to check no precision is lost
+				pn("	p.Set%s(int64(1) << 40)", capitalize(ap.Name))
+				pn("	want.Set(%q, \"1099511627776\")", ap.Name)
+			case "bool":
+				pn("	p.Set%s(true)", capitalize(ap.Name))
+				pn("	want.Set(%q, \"true\")", ap.Name)
+			case "[]string":
+				pn("	p.Set%s([]string{\"a\", \"b\"})", capitalize(ap.Name))
+				pn("	want.Set(%q, \"a,b\")", ap.Name)
+			case "map[string]string":
+				key, value := "key", "value"
+				switch ap.Name {
+				case "serviceproviderlist":
+					key, value = "service", "provider"
+				case "usersecuritygrouplist":
+					key, value = "account", "group"
+				}
+				pn("	p.Set%s(map[string]string{\"k\": \"v\"})", capitalize(ap.Name))
+				pn("	want.Set(\"%s[0].%s\", \"k\")", ap.Name, key)
+				pn("	want.Set(\"%s[0].%s\", \"v\")", ap.Name, value)
+			}
+		}
+		pn("")
+		pn("	assertParams(t, p, %q, want)", a.Name)
+		pn("}")
+		pn("")
+
+		// Responses of async commands are decoded from the job result, which doesn't
+		// contain the job ID, so the synthetic response contains all other fields
+		doc, err := json.Marshal(sampleResponse(a))
+		if err != nil {
+			return nil, err
+		}
+		rn := capitalize(a.Name + "Response")
+		pn("func Test%s(t *testing.T) {", rn)
+		pn("	assertDecodes(t, `%s`, &%s{})", doc, rn)
 		pn("}")
 		pn("")
 	}
 
-	for _, a := range s.apis {
-		s.generateParamType(a)
-		s.generateToURLValuesFunc(a)
-		s.generateParamSettersFunc(a)
-		s.generateNewParamTypeFunc(a)
-		s.generateHelperFuncs(a)
-		s.generateNewAPICallFunc(a)
-		s.generateResponseType(a)
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		buf.WriteTo(os.Stdout)
+		return buf.Bytes(), err
+	}
+	return clean, nil
+}
+
+// Returns the sample value of a string param or response field
+func sampleString(name string) string {
+	return name + "-value"
+}
+
+// Returns a synthetic response of the API, containing a sample value for every field
+func sampleResponse(a *API) interface{} {
+	obj := sampleObject(a.Response, a.Isasync)
+	if a.Isasync {
+		delete(obj, "jobid")
+		obj["jobid"] = sampleString("jobid")
+	}
+
+	if !strings.HasPrefix(a.Name, "list") && a.Name != "registerTemplate" {
+		return obj
+	}
+
+	// The items of a list are wrapped, using the same keys as generateResponseType
+	ln := capitalize(strings.TrimPrefix(a.Name, "list"))
+	switch a.Name {
+	case "listAsyncJobs":
+		return map[string]interface{}{"count": 1, "asyncjobs": []interface{}{obj}}
+	case "listCapabilities":
+		return map[string]interface{}{"count": 1, strings.ToLower(parseSingular(ln)): obj}
+	case "listEgressFirewallRules":
+		return map[string]interface{}{"count": 1, "firewallrule": []interface{}{obj}}
+	case "listLoadBalancerRuleInstances":
+		return map[string]interface{}{"count": 1, "lbrulevmidip": []interface{}{obj}}
+	case "registerTemplate":
+		return map[string]interface{}{"count": 1, "template": []interface{}{obj}}
+	default:
+		return map[string]interface{}{"count": 1, strings.ToLower(parseSingular(ln)): []interface{}{obj}}
+	}
+}
+
+// Returns an object containing a sample value for every field of the response, using the same
+// types as recursiveGenerateResponseType
+func sampleObject(resp APIResponses, async bool) map[string]interface{} {
+	obj := make(map[string]interface{})
+	for _, r := range resp {
+		if r.Name == "" {
+			continue
+		}
+		if r.Name == "secondaryip" {
+			obj[r.Name] = []interface{}{map[string]interface{}{"id": sampleString("id"), "ipaddress": sampleString("ipaddress")}}
+			continue
+		}
+		if r.Response != nil {
+			obj[r.Name] = []interface{}{sampleObject(r.Response, async)}
+			continue
+		}
+		if _, found := obj[r.Name]; found {
+			continue
+		}
+		if r.Name == "success" {
+			if async {
+				obj[r.Name] = true
+			} else {
+				obj[r.Name] = "true"
+			}
+			continue
+		}
+
+		switch mapType(r.Type) {
+		case "int":
+			obj[r.Name] = 42
+		case "int64":
+			obj[r.Name] = int64(1) << 40
+		case "bool":
+			obj[r.Name] = true
+		case "[]string":
+			obj[r.Name] = []string{"a", "b"}
+		case "map[string]string":
+			obj[r.Name] = map[string]string{"key": "value"}
+		case "json.RawMessage":
+			obj[r.Name] = map[string]interface{}{"key": "value"}
+		case "*VirtualMachine":
+			obj[r.Name] = map[string]interface{}{"id": sampleString("id")}
+		default:
+			obj[r.Name] = sampleString(r.Name)
+		}
+	}
+	return obj
+}
+
+func (s *service) GenerateMockCode() ([]byte, error) {
+	var buf bytes.Buffer
+	pn := func(format string, args ...interface{}) {
+		fmt.Fprintf(&buf, format+"\n", args...)
+	}
+	mn := "Mock" + s.name
+
+	pn("//")
+	pn("// Copyright 2018, Sander van Harmelen")
+	pn("//")
+	pn("// Licensed under the Apache License, Version 2.0 (the \"License\");")
+	pn("// you may not use this file except in compliance with the License.")
+	pn("// You may obtain a copy of the License at")
+	pn("//")
+	pn("//     http://www.apache.org/licenses/LICENSE-2.0")
+	pn("//")
+	pn("// Unless required by applicable law or agreed to in writing, software")
+	pn("// distributed under the License is distributed on an \"AS IS\" BASIS,")
+	pn("// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.")
+	pn("// See the License for the specific language governing permissions and")
+	pn("// limitations under the License.")
+	pn("//")
+	pn("")
+	pn("package %s", pkg)
+	pn("")
+
+	names := make(map[string]bool)
+	for _, m := range s.methods {
+		names[m.name] = true
+	}
+
+	// Returns true if the method is a constructor of params, which the mock doesn't replace
+	isParamsFunc := func(m *method) bool {
+		return strings.HasPrefix(m.name, "New") && strings.HasSuffix(m.name, "Params")
+	}
+	isIteratorFunc := func(m *method) bool {
+		return strings.HasPrefix(m.name, "New") && strings.HasSuffix(m.name, "Iterator")
+	}
+
+	pn("// %s is a mock of %sIface. A method is programmed by setting the function named after the", mn, s.name)
+	pn("// method with a Func suffix, which is shared by a method and its WithContext variant. When no")
+	pn("// function is set the method returns ErrNotMocked. All calls are recorded, see Calls.")
+	pn("type %s struct {", mn)
+	pn("	mockCalls")
+	pn("")
+	for _, m := range s.methods {
+		switch {
+		case isParamsFunc(m), isIteratorFunc(m), names[m.name+"WithContext"]:
+			continue
+		}
+
+		fn := strings.TrimSuffix(m.name, "WithContext")
+		params := make([]string, len(m.params))
+		for i, mp := range m.params {
+			if mp.variadic {
+				params[i] = mp.name + " ..." + mp.typ
+			} else {
+				params[i] = mp.name + " " + mp.typ
+			}
+		}
+		results := strings.Join(m.results, ", ")
+		if len(m.results) > 1 {
+			results = "(" + results + ")"
+		}
+		pn("	%sFunc func(%s) %s", fn, strings.Join(params, ", "), results)
+	}
+	pn("}")
+	pn("")
+	pn("var _ %sIface = &%s{}", s.name, mn)
+	pn("")
+
+	for _, m := range s.methods {
+		pn("func (m *%s) %s {", mn, m.signature())
+
+		switch {
+		case isParamsFunc(m):
+			pn("	return (&%s{}).%s(%s)", s.name, m.name, m.args(true))
+
+		case isIteratorFunc(m):
+			// Iterators request their pages using the (mocked) list function
+			it := strings.TrimPrefix(m.name, "New")
+			fn := strings.TrimSuffix(it, "Iterator")
+			tn := strings.TrimPrefix(m.params[0].typ, "*")
+			pn("	i := &%s{pager: newPager(nil, \"%s\", nil, pagesize)}", it, uncapitalize(fn))
+			pn("	i.fetch = func(ctx context.Context, page int, pagesize int) (interface{}, error) {")
+			pn("		c := &%s{p: make(map[string]interface{}, len(p.p)+2)}", tn)
+			pn("		for k, v := range p.p {")
+			pn("			c.p[k] = v")
+			pn("		}")
+			pn("		c.p[\"page\"] = page")
+			pn("		c.p[\"pagesize\"] = pagesize")
+			pn("		return m.%sWithContext(ctx, c)", fn)
+			pn("	}")
+			pn("	return i")
+
+		case names[m.name+"WithContext"]:
+			pn("	return m.%sWithContext(context.Background(), %s)", m.name, m.args(false))
+
+		default:
+			fn := strings.TrimSuffix(m.name, "WithContext")
+			if args := m.args(false); args != "" {
+				pn("	m.record(\"%s\", %s)", fn, strings.Replace(args, "...", "", -1))
+			} else {
+				pn("	m.record(\"%s\")", fn)
+			}
+			pn("	if m.%sFunc == nil {", fn)
+			pn("		return %s", m.zeroResults(fmt.Sprintf("notMocked(\"%s\", \"%s\")", mn, fn)))
+			pn("	}")
+			pn("	return m.%sFunc(%s)", fn, m.args(true))
+		}
+
+		pn("}")
+		pn("")
 	}
 
 	clean, err := format.Source(buf.Bytes())
@@ -718,6 +1653,10 @@ func (s *service) generateParamType(a *API) {
 func (s *service) generateToURLValuesFunc(a *API) {
 	pn := s.pn
 
+	pn("func (p *%s) command() string {", capitalize(a.Name+"Params"))
+	pn("	return \"%s\"", a.Name)
+	pn("}")
+	pn("")
 	pn("func (p *%s) toURLValues() url.Values {", capitalize(a.Name+"Params"))
 	pn("	u := url.Values{}")
 	pn("	if p.p == nil {")
@@ -894,7 +1833,7 @@ func (s *service) generateHelperFuncs(a *API) {
 				pn("")
 			}
 			pn("	if l.Count == 0 {")
-			pn("	  return \"\", l.Count, fmt.Errorf(\"No match found for %%s: %%+v\", %s, l)", v)
+			pn("	  return \"\", l.Count, notFoundErrorf(\"No match found for %%s: %%+v\", %s, l)", v)
 			pn("	}")
 			pn("")
 			pn("	if l.Count == 1 {")
@@ -908,7 +1847,7 @@ func (s *service) generateHelperFuncs(a *API) {
 			pn("      }")
 			pn("    }")
 			pn("	}")
-			pn("  return \"\", l.Count, fmt.Errorf(\"Could not find an exact match for %%s: %%+v\", %s, l)", v)
+			pn("  return \"\", l.Count, notFoundErrorf(\"Could not find an exact match for %%s: %%+v\", %s, l)", v)
 			pn("}\n")
 			pn("")
 
@@ -999,10 +1938,8 @@ func (s *service) generateHelperFuncs(a *API) {
 			pn("")
 			pn("	l, err := s.List%s(p)", ln)
 			pn("	if err != nil {")
-			pn("		if strings.Contains(err.Error(), fmt.Sprintf(")
-			pn("			\"Invalid parameter id value=%%s due to incorrect long value format, \"+")
-			pn("				\"or entity does not exist\", id)) {")
-			pn("			return nil, 0, fmt.Errorf(\"No match found for %%s: %%+v\", id, l)")
+			pn("		if errors.Is(err, ErrNotFound) {")
+			pn("			return nil, 0, notFoundErrorf(\"No match found for %%s: %%+v\", id, l)")
 			pn("		}")
 			pn("		return nil, -1, err")
 			pn("	}")
@@ -1014,7 +1951,7 @@ func (s *service) generateHelperFuncs(a *API) {
 				pn("")
 			}
 			pn("	if l.Count == 0 {")
-			pn("	  return nil, l.Count, fmt.Errorf(\"No match found for %%s: %%+v\", id, l)")
+			pn("	  return nil, l.Count, notFoundErrorf(\"No match found for %%s: %%+v\", id, l)")
 			pn("	}")
 			pn("")
 			pn("	if l.Count == 1 {")
@@ -1043,6 +1980,80 @@ func hasNameOrKeywordParamField(params APIParams) (v string, found bool) {
 	return v, found
 }
 
+// Some commands wrap their (sync) response in an additional object with the given key
+var wrappedResponseKeys = map[string]string{
+	"createNetwork":         "network",
+	"createNetworkOffering": "networkoffering",
+	"createSecurityGroup":   "securitygroup",
+	"createServiceOffering": "serviceoffering",
+	"createSSHKeyPair":      "keypair",
+	"registerSSHKeyPair":    "keypair",
+}
+
+// Keys of the envelopes of commands of which the envelope is not named after the command
+var irregularResponseKeys = map[string]string{
+	"activateProject":              "activaterojectresponse",
+	"addIpToNic":                   "addiptovmnicresponse",
+	"addLdapConfiguration":         "ldapconfigurationresponse",
+	"cancelStorageMaintenance":     "cancelprimarystoragemaintenanceresponse",
+	"deleteLdapConfiguration":      "ldapconfigurationresponse",
+	"enableStorageMaintenance":     "prepareprimarystorageformaintenanceresponse",
+	"getUploadParamsForTemplate":   "postuploadtemplateresponse",
+	"getUploadParamsForVolume":     "postuploadvolumeresponse",
+	"importLdapUsers":              "ldapuserresponse",
+	"ldapCreateAccount":            "createaccountresponse",
+	"listLdapConfigurations":       "ldapconfigurationresponse",
+	"listLdapUsers":                "ldapuserresponse",
+	"listNetworkDevice":            "listnetworkdevice",
+	"listNiciraNvpDeviceNetworks":  "listniciranvpdevicenetworks",
+	"listNiciraNvpDevices":         "listniciranvpdeviceresponse",
+	"resetSSHKeyForVirtualMachine": "resetSSHKeyforvirtualmachineresponse",
+	"restoreVirtualMachine":        "restorevmresponse",
+	"scaleSystemVm":                "changeserviceforsystemvmresponse",
+	"updateVMAffinityGroup":        "updatevirtualmachineresponse",
+}
+
+// Keys of the results of async commands of which the result is not named after the resource
+// in the name of the command
+var irregularAsyncResultKeys = map[string]string{
+	"addIpToNic":                        "nicsecondaryip",
+	"addNicToVirtualMachine":            "virtualmachine",
+	"attachIso":                         "virtualmachine",
+	"cancelHostMaintenance":             "host",
+	"cancelStorageMaintenance":          "storagepool",
+	"createEgressFirewallRule":          "firewallrule",
+	"createLBHealthCheckPolicy":         "healthcheckpolicies",
+	"createLBStickinessPolicy":          "stickinesspolicies",
+	"createNetworkACL":                  "networkacl",
+	"createSnapshotFromVMSnapshot":      "snapshot",
+	"detachIso":                         "virtualmachine",
+	"enableStorageMaintenance":          "storagepool",
+	"markDefaultZoneForAccount":         "account",
+	"migrateVirtualMachineWithVolume":   "virtualmachine",
+	"prepareHostForMaintenance":         "host",
+	"reconnectHost":                     "host",
+	"removeNicFromVirtualMachine":       "virtualmachine",
+	"resetPasswordForVirtualMachine":    "virtualmachine",
+	"resetSSHKeyForVirtualMachine":      "virtualmachine",
+	"revertToVMSnapshot":                "virtualmachine",
+	"updateDefaultNicForVirtualMachine": "virtualmachine",
+	"updateEgressFirewallRule":          "firewallrule",
+	"updateLBHealthCheckPolicy":         "healthcheckpolicies",
+	"updateLBStickinessPolicy":          "stickinesspolicies",
+	"updateNetworkACLItem":              "networkacl",
+	"updateVMAffinityGroup":             "virtualmachine",
+	"updateVmNicIp":                     "virtualmachine",
+}
+
+// Returns the key the result of a finished async job of the command is expected to be wrapped in,
+// which is usually the resource in the name of the command (e.g. "volume" for attachVolume)
+func asyncResultKey(name string) string {
+	if key, ok := irregularAsyncResultKeys[name]; ok {
+		return key
+	}
+	return strings.ToLower(strings.TrimLeftFunc(name, unicode.IsLower))
+}
+
 func hasPageParamField(params APIParams) bool {
 	for _, p := range params {
 		if p.Name == "page" && mapType(p.Type) == "int" {
@@ -1084,14 +2095,21 @@ func (s *service) generateNewAPICallFunc(a *API) {
 	// Generate the function signature
 	pn("// %s", a.Description)
 	pn("func (s *%s) %s(p *%s) (*%s, error) {", s.name, fn, fn+"Params", fn+"Response")
+	pn("	return s.%sWithContext(context.Background(), p)", fn)
+	pn("}")
+	pn("")
+	pn("// %sWithContext is the same as %s, but it accepts a context.Context that can be", fn, fn)
+	pn("// used to cancel the request or to limit the time spend on it.")
+	pn("func (s *%s) %sWithContext(ctx context.Context, p *%s) (*%s, error) {", s.name, fn, fn+"Params", fn+"Response")
 
 	// Generate the function body
 	switch {
 	case strings.HasPrefix(fn, "List") && hasPageParamField(a.Params):
 		pn("	var r %s", fn+"Response")
+		pn("	values := p.toURLValues()")
 		pn("	for page := 2; ; page++ {")
 		pn("		var l %s", fn+"Response")
-		pn("		resp, err := s.cs.newRequest(\"%s\", p.toURLValues())", a.Name)
+		pn("		resp, err := s.cs.newRequest(ctx, \"%s\", values)", a.Name)
 		pn("		if err != nil {")
 		pn("			return nil, err")
 		pn("		}")
@@ -1103,93 +2121,229 @@ func (s *service) generateNewAPICallFunc(a *API) {
 		pn("		r.Count = l.Count")
 		pn("		r.%s = append(r.%s, l.%s...)", ln, ln, ln)
 		pn("")
-		pn("		if r.Count == len(r.%s) {", ln)
+		pn("		if r.Count == len(r.%s) || len(l.%s) == 0 {", ln, ln)
+		pn("			s.cs.observePages(\"%s\", page-1)", a.Name)
 		pn("			return &r, nil")
 		pn("		}")
 		pn("")
-		pn("		p.SetPagesize(len(l.%s))", ln)
-		pn("		p.SetPage(page)")
-		pn("	}")
-		pn("}")
-		return
-	case fn == "QueryAsyncJobResult":
-		pn("	var resp json.RawMessage")
-		pn("	var err error")
+		pn("		// Request the next page on a copy of the params, leaving the params of the caller untouched")
+		pn("		values.Set(\"pagesize\", strconv.Itoa(len(l.%s)))", ln)
+		pn("		values.Set(\"page\", strconv.Itoa(page))")
+		pn("")
+		pn("		// Now the count is known, request the remaining pages concurrently if enabled")
+		pn("		if page == 2 && s.cs.pageConcurrency > 1 {")
+		pn("			pages, err := s.cs.fetchPages(ctx, \"%s\", values, len(l.%s), r.Count)", a.Name, ln)
+		pn("			if err != nil {")
+		pn("				return nil, err")
+		pn("			}")
+		pn("")
+		pn("			for _, resp := range pages {")
+		pn("				var l %s", fn+"Response")
+		pn("				if err := json.Unmarshal(resp, &l); err != nil {")
+		pn("					return nil, err")
+		pn("				}")
+		pn("")
+		pn("				r.Count = l.Count")
+		pn("				r.%s = append(r.%s, l.%s...)", ln, ln, ln)
+		pn("			}")
+		pn("")
+		pn("			if r.Count == len(r.%s) {", ln)
+		pn("				s.cs.observePages(\"%s\", page-1+len(pages))", a.Name)
+		pn("				return &r, nil")
+		pn("			}")
 		pn("")
-		pn("	// We should be able to retry on failure as this call is idempotent")
-		pn("	for i := 0; i < 3; i++ {")
-		pn("		resp, err = s.cs.newRequest(\"%s\", p.toURLValues())", a.Name)
-		pn("		if err == nil {")
-		pn("			break")
+		pn("			// The list changed while requesting the pages, so continue with the following pages")
+		pn("			page += len(pages)")
+		pn("			values.Set(\"page\", strconv.Itoa(page))")
 		pn("		}")
-		pn("		time.Sleep(500 * time.Millisecond)")
 		pn("	}")
+		pn("}")
+		return
 	default:
-		pn("	resp, err := s.cs.newRequest(\"%s\", p.toURLValues())", a.Name)
+		pn("	resp, err := s.cs.newRequest(ctx, \"%s\", p.toURLValues())", a.Name)
 	}
 	pn("	if err != nil {")
 	pn("		return nil, err")
 	pn("	}")
 	pn("")
-	switch fn {
-	case "CreateNetwork", "CreateNetworkOffering", "CreateSecurityGroup", "CreateServiceOffering", "CreateSSHKeyPair", "RegisterSSHKeyPair":
-		pn("	if resp, err = getRawValue(resp); err != nil {")
-		pn("		return nil, err")
-		pn("	}")
-		pn("")
-	}
 	pn("	var r %s", fn+"Response")
-	pn("	if err := json.Unmarshal(resp, &r); err != nil {")
+	if key, ok := wrappedResponseKeys[a.Name]; ok {
+		pn("	if err := unmarshalValue(resp, \"%s\", &r); err != nil {", key)
+	} else {
+		pn("	if err := json.Unmarshal(resp, &r); err != nil {")
+	}
 	pn("		return nil, err")
 	pn("	}")
 	if a.Isasync {
 		pn("")
 		pn("	// If we have a async client, we need to wait for the async result")
 		pn("	if s.cs.async {")
-		pn("		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)")
+		pn("		b, err := s.cs.waitForAsyncJob(ctx, \"%s\", r.JobID, s.cs.timeout)", a.Name)
 		pn("		if err != nil {")
-		pn("			if err == AsyncTimeoutErr {")
+		pn("			if err == AsyncTimeoutErr || ctx.Err() != nil {")
 		pn("				return &r, err")
 		pn("			}")
 		pn("			return nil, err")
 		pn("		}")
 		pn("")
-		if !isSuccessOnlyResponse(a.Response) {
-			pn("		b, err = getRawValue(b)")
-			pn("		if err != nil {")
-			pn("		  return nil, err")
-			pn("		}")
-			pn("")
-		}
-		if s.name == "FirewallService" {
-			pn("		b, err = convertFirewallServiceResponse(b)")
-			pn("		if err != nil {")
-			pn("			return nil, err")
-			pn("		}")
-			pn("")
-		}
-		if fn == "AuthorizeSecurityGroupIngress" {
-			pn("		b, err = convertAuthorizeSecurityGroupIngressResponse(b)")
-			pn("		if err != nil {")
-			pn("			return nil, err")
-			pn("		}")
-			pn("")
-		}
-		if fn == "AuthorizeSecurityGroupEgress" {
-			pn("		b, err = convertAuthorizeSecurityGroupEgressResponse(b)")
-			pn("		if err != nil {")
-			pn("			return nil, err")
-			pn("		}")
-			pn("")
-		}
-		pn("		if err := json.Unmarshal(b, &r); err != nil {")
-		pn("			return nil, err")
-		pn("		}")
+		s.generateAsyncResultDecode(a)
+		pn("	}")
+	}
+	pn("	return &r, nil")
+	pn("}")
+	pn("")
+}
+
+// Generates the code decoding the raw result of a finished async job (in b) into the response (r)
+func (s *service) generateAsyncResultDecode(a *API) {
+	pn := s.pn
+	fn := capitalize(a.Name)
+
+	if isSuccessOnlyResponse(a.Response) {
+		pn("	if err := json.Unmarshal(b, &r); err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		return
+	}
+
+	// Results that don't need to be converted first are decoded straight into the response
+	if s.name != "FirewallService" && fn != "AuthorizeSecurityGroupIngress" && fn != "AuthorizeSecurityGroupEgress" {
+		pn("	if err := unmarshalValue(b, \"%s\", &r); err != nil {", asyncResultKey(a.Name))
+		pn("		return nil, err")
+		pn("	}")
+		return
+	}
+
+	pn("	b, err = getRawValue(b, \"%s\")", asyncResultKey(a.Name))
+	pn("	if err != nil {")
+	pn("	  return nil, err")
+	pn("	}")
+	pn("")
+	if s.name == "FirewallService" {
+		pn("	b, err = convertFirewallServiceResponse(b)")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+	}
+	if fn == "AuthorizeSecurityGroupIngress" {
+		pn("	b, err = convertAuthorizeSecurityGroupIngressResponse(b)")
+		pn("	if err != nil {")
+		pn("		return nil, err")
+		pn("	}")
+		pn("")
+	}
+	if fn == "AuthorizeSecurityGroupEgress" {
+		pn("	b, err = convertAuthorizeSecurityGroupEgressResponse(b)")
+		pn("	if err != nil {")
+		pn("		return nil, err")
 		pn("	}")
+		pn("")
 	}
+	pn("	if err := json.Unmarshal(b, &r); err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+}
+
+func (s *service) generateListIteratorFuncs(a *API) {
+	pn := s.pn
+	fn := capitalize(a.Name)
+	ln := capitalize(strings.TrimPrefix(a.Name, "list"))
+	it := fn + "Iterator"
+
+	// The element type of the list, which does not always match the name of the list
+	et := parseSingular(ln)
+	if a.Name == "listLoadBalancerRuleInstances" {
+		et = "VirtualMachine"
+	}
+
+	pn("// %s iterates over the items returned by %s, requesting", it, a.Name)
+	pn("// the pages of the response one at a time while iterating.")
+	pn("type %s struct {", it)
+	pn("	pager")
+	pn("	items []*%s", et)
+	pn("	index int")
+	pn("}")
+	pn("")
+	pn("// New%s returns an iterator over the items returned by %s, requesting pagesize", it, a.Name)
+	pn("// items per page. The iterator uses a copy of the params, so p is never changed.")
+	pn("func (s *%s) New%s(p *%s, pagesize int) *%s {", s.name, it, fn+"Params", it)
+	pn("	return &%s{pager: newPager(s.cs, \"%s\", p.toURLValues(), pagesize)}", it, a.Name)
+	pn("}")
+	pn("")
+	pn("// Next advances the iterator to the next item, requesting the next page when needed. It")
+	pn("// returns false when there are no more items or an error occurred, which is returned by Err.")
+	pn("func (i *%s) Next(ctx context.Context) bool {", it)
+	pn("	i.index++")
+	pn("	if i.index < len(i.items) {")
+	pn("		return true")
+	pn("	}")
+	pn("")
+	pn("	resp := i.nextPage(ctx)")
+	pn("	if resp == nil {")
+	pn("		return false")
+	pn("	}")
+	pn("")
+	pn("	var l %s", fn+"Response")
+	pn("	if err := json.Unmarshal(resp, &l); err != nil {")
+	pn("		i.fail(err)")
+	pn("		return false")
+	pn("	}")
+	pn("	i.received(l.Count, len(l.%s))", ln)
+	pn("")
+	pn("	i.items = l.%s", ln)
+	pn("	i.index = 0")
+	pn("	return len(i.items) > 0")
+	pn("}")
+	pn("")
+	pn("// Value returns the current item")
+	pn("func (i *%s) Value() *%s {", it, et)
+	pn("	if i.index >= len(i.items) {")
+	pn("		return nil")
+	pn("	}")
+	pn("	return i.items[i.index]")
+	pn("}")
+	pn("")
+}
+
+func (s *service) generateAsyncJobFuncs(a *API) {
+	pn := s.pn
+	fn := capitalize(a.Name)
+	jn := fn + "Job"
+
+	pn("// %s is a handle to a running %s async job", jn, a.Name)
+	pn("type %s struct {", jn)
+	pn("	*JobHandle")
+	pn("}")
+	pn("")
+	pn("// Result returns the result of the finished job decoded into a %s. If the job is", fn+"Response")
+	pn("// not yet finished ErrJobNotFinished is returned, and if the job failed its error.")
+	pn("func (j *%s) Result() (*%s, error) {", jn, fn+"Response")
+	pn("	b, err := j.RawResult()")
+	pn("	if err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("")
+	pn("	r := %s{JobID: j.JobID()}", fn+"Response")
+	s.generateAsyncResultDecode(a)
 	pn("	return &r, nil")
 	pn("}")
 	pn("")
+	pn("// %sAsync starts the %s async job and returns a handle to the running job. It never", fn, a.Name)
+	pn("// waits for the job to finish, not even when using an async client.")
+	pn("func (s *%s) %sAsync(ctx context.Context, p *%s) (*%s, error) {", s.name, fn, fn+"Params", jn)
+	pn("	resp, err := s.cs.newRequest(ctx, \"%s\", p.toURLValues())", a.Name)
+	pn("	if err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("")
+	pn("	var r %s", fn+"Response")
+	pn("	if err := json.Unmarshal(resp, &r); err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("	return &%s{s.cs.newJobHandle(\"%s\", r.JobID)}, nil", jn, a.Name)
+	pn("}")
+	pn("")
 }
 
 func isSuccessOnlyResponse(resp APIResponses) bool {
@@ -1241,11 +2395,20 @@ func (s *service) generateResponseType(a *API) {
 	}
 
 	pn("type %s struct {", tn)
+	resp := a.Response
 	if a.Isasync {
 		pn("	JobID string `json:\"jobid,omitempty\"`")
+
+		// Some async responses also return a jobid field, which would clash with the JobID field
+		resp = APIResponses{}
+		for _, r := range a.Response {
+			if r.Name != "jobid" {
+				resp = append(resp, r)
+			}
+		}
 	}
-	sort.Sort(a.Response)
-	s.recursiveGenerateResponseType(a.Response, a.Isasync)
+	sort.Sort(resp)
+	s.recursiveGenerateResponseType(resp, a.Isasync)
 	pn("}")
 	pn("")
 	return
@@ -1319,6 +2482,16 @@ func getAllServices(filename string) (services, error) {
 	allAPIs := make(map[string]apis)
 	for _, api := range raw.APIs {
 		sort.Sort(api.Params)
+
+		// The listApis output doesn't document the jobid that is returned for every job
+		if api.Name == "listAsyncJobs" || api.Name == "queryAsyncJobResult" {
+			api.Response = append(api.Response, &APIResponse{
+				Name:        "jobid",
+				Description: "the ID of the async job",
+				Type:        "string",
+			})
+		}
+
 		allAPIs[api.GroupName] = append(allAPIs[api.GroupName], api)
 	}
 
@@ -1349,6 +2522,31 @@ func sourceDir() (string, error) {
 	return outdir, nil
 }
 
+// Removes all previously generated files from outdir, while leaving any
+// hand written files (which should never end with Service.go, Service_mock.go or Service_test.go)
+// untouched
+func removeGeneratedCode(outdir string) error {
+	files, err := filepath.Glob(path.Join(outdir, "*Service.go"))
+	if err != nil {
+		return err
+	}
+	for _, pattern := range []string{"*Service_mock.go", "*Service_test.go"} {
+		generated, err := filepath.Glob(path.Join(outdir, pattern))
+		if err != nil {
+			return err
+		}
+		files = append(files, generated...)
+	}
+	files = append(files, path.Join(outdir, "cosmic.go"), path.Join(outdir, "cosmic_mock.go"), path.Join(outdir, "cosmic_test.go"))
+
+	for _, file := range files {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
 func mapType(t string) string {
 	switch t {
 	case "boolean":
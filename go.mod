@@ -1,5 +1,5 @@
 module github.com/MissionCriticalCloud/go-cosmic/v6
 
-go 1.12
+go 1.13
 
 require github.com/fatih/camelcase v1.0.0
@@ -0,0 +1,300 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package replay provides HTTP transports that record exchanges with the Cosmic API to a fixture
+// file and replay them later, so code using the cosmic package can be tested without a live cloud.
+//
+// Record the exchanges once against a real API:
+//
+//	rec := replay.NewRecorder("testdata/deploy.json", nil)
+//	cs, _ := cosmic.New(apiURL, cosmic.WithHTTPClient(&http.Client{Transport: rec}), cosmic.WithAPIKey(key, secret))
+//	... // use the client
+//	rec.Save()
+//
+// And replay them in tests, using any URL and credentials:
+//
+//	rep, _ := replay.NewReplayer("testdata/deploy.json")
+//	cs, _ := cosmic.New("http://cosmic", cosmic.WithHTTPClient(&http.Client{Transport: rep}), cosmic.WithAPIKey("key", "secret"))
+//
+// Credentials (like the API key, signature and session key) are never written to the fixture file,
+// and requests are matched on their command and (sorted) parameters only. The values of all other
+// secrets (like passwords, userdata, private keys and certificates) are redacted, in both the
+// recorded parameters and responses, as decided by cosmic.IsSensitive.
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+
+	"github.com/MissionCriticalCloud/go-cosmic/v6/cosmic"
+)
+
+// Redacted replaces the values of secrets in recorded parameters and responses
+const Redacted = "REDACTED"
+
+// IgnoredParams are the request parameters that are neither recorded nor used to match requests,
+// as they depend on the credentials used or change with every request
+var IgnoredParams = []string{"apiKey", "signature", "signatureVersion", "expires", "sessionkey", "response"}
+
+// Interaction is a single recorded exchange with the API
+type Interaction struct {
+	Method  string `json:"method"`
+	Command string `json:"command"`
+	Params  string `json:"params"` // Normalized parameters, without the ignored params
+
+	Status      int             `json:"status"`
+	ContentType string          `json:"content_type,omitempty"`
+	Body        json.RawMessage `json:"body,omitempty"` // Body of the response if it is valid JSON
+	Text        string          `json:"text,omitempty"` // Body of the response otherwise
+}
+
+// Fixture contains all interactions recorded to a single file, in the order they happened
+type Fixture struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Recorder is an http.RoundTripper that records all exchanges made through it
+type Recorder struct {
+	path      string
+	transport http.RoundTripper
+
+	mu      sync.Mutex
+	fixture Fixture
+}
+
+// NewRecorder returns a Recorder that sends requests using transport, and saves the recorded
+// exchanges to the file at path when calling Save. If transport is nil, http.DefaultTransport is used.
+func NewRecorder(path string, transport http.RoundTripper) *Recorder {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &Recorder{path: path, transport: transport}
+}
+
+// RoundTrip implements the http.RoundTripper interface
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	i, err := newInteraction(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+
+	i.Status = resp.StatusCode
+	i.ContentType = resp.Header.Get("Content-Type")
+	if redacted, err := cosmic.RedactJSON(b, Redacted); err == nil {
+		i.Body = redacted
+	} else {
+		i.Text = string(b)
+	}
+
+	r.mu.Lock()
+	r.fixture.Interactions = append(r.fixture.Interactions, i)
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// Interactions returns the interactions recorded so far
+func (r *Recorder) Interactions() []*Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*Interaction(nil), r.fixture.Interactions...)
+}
+
+// Save writes all recorded interactions to the fixture file
+func (r *Recorder) Save() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, err := json.MarshalIndent(&r.fixture, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, append(b, '\n'), 0644)
+}
+
+// Replayer is an http.RoundTripper that replays the interactions recorded in a fixture file.
+// Requests are answered with the first unused interaction with the same method, command and
+// parameters, so repeated requests (like polling an async job or requesting the same list twice)
+// get the responses in the order they were recorded. Once all matching interactions are used,
+// the last one is repeated, so polling a job more often than recorded returns its final status.
+type Replayer struct {
+	mu           sync.Mutex
+	interactions []*Interaction
+	used         []bool
+}
+
+// NewReplayer returns a Replayer replaying the interactions in the fixture file at path
+func NewReplayer(path string) (*Replayer, error) {
+	interactions, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewReplayerFromInteractions(interactions), nil
+}
+
+// NewReplayerFromInteractions returns a Replayer replaying the given interactions
+func NewReplayerFromInteractions(interactions []*Interaction) *Replayer {
+	return &Replayer{
+		interactions: interactions,
+		used:         make([]bool, len(interactions)),
+	}
+}
+
+// RoundTrip implements the http.RoundTripper interface
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	want, err := newInteraction(req)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	last := -1
+	for idx, i := range r.interactions {
+		if i.Method != want.Method || i.Command != want.Command || i.Params != want.Params {
+			continue
+		}
+		last = idx
+		if !r.used[idx] {
+			break
+		}
+	}
+	if last < 0 {
+		return nil, fmt.Errorf("No recorded interaction for %s %s with params %q", want.Method, want.Command, want.Params)
+	}
+	r.used[last] = true
+
+	return r.interactions[last].response(req), nil
+}
+
+// Unused returns the recorded interactions that have not been replayed, which can be used
+// to verify the code under test made all the requests that were recorded
+func (r *Replayer) Unused() []*Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unused []*Interaction
+	for idx, i := range r.interactions {
+		if !r.used[idx] {
+			unused = append(unused, i)
+		}
+	}
+	return unused
+}
+
+// Returns the response to the request as recorded in the interaction
+func (i *Interaction) response(req *http.Request) *http.Response {
+	body := []byte(i.Text)
+	if len(i.Body) > 0 {
+		body = i.Body
+	}
+
+	header := make(http.Header)
+	if i.ContentType != "" {
+		header.Set("Content-Type", i.ContentType)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", i.Status, http.StatusText(i.Status)),
+		StatusCode:    i.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// Returns a new interaction containing the normalized request. The body of a POST
+// request is read and replaced, so the request can still be send afterwards.
+func newInteraction(req *http.Request) (*Interaction, error) {
+	params := req.URL.Query()
+
+	if req.Body != nil && req.Method == "POST" {
+		b, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(b))
+
+		form, err := url.ParseQuery(string(b))
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range form {
+			params[k] = append(params[k], v...)
+		}
+	}
+
+	command := params.Get("command")
+	params.Del("command")
+	for _, p := range IgnoredParams {
+		params.Del(p)
+	}
+
+	// Requests are still matched on the presence of secrets, but never on their values
+	for k, v := range params {
+		if cosmic.IsSensitive(k) {
+			for i := range v {
+				v[i] = Redacted
+			}
+		}
+	}
+
+	return &Interaction{
+		Method:  req.Method,
+		Command: command,
+		Params:  params.Encode(), // Encode sorts the params by key
+	}, nil
+}
+
+// Load returns the interactions recorded in the fixture file at path
+func Load(path string) ([]*Interaction, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var fixture Fixture
+	if err := json.NewDecoder(f).Decode(&fixture); err != nil {
+		return nil, fmt.Errorf("Invalid fixture file %s: %v", path, err)
+	}
+	return fixture.Interactions, nil
+}
@@ -0,0 +1,153 @@
+//
+// Copyright 2018, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package replay
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MissionCriticalCloud/go-cosmic/v6/cosmic"
+	"github.com/MissionCriticalCloud/go-cosmic/v6/cosmictest"
+)
+
+// Deploys a virtual machine and lists all virtual machines using the client, returning the
+// ID of the deployed virtual machine and the number of listed virtual machines
+func deployAndList(t *testing.T, cs *cosmic.CosmicClient, serviceofferingid, templateid, zoneid string) (string, int) {
+	t.Helper()
+
+	p := cs.VirtualMachine.NewDeployVirtualMachineParams(serviceofferingid, templateid, zoneid)
+	p.SetUserdata("c2VjcmV0LXVzZXJkYXRh")
+	vm, err := cs.VirtualMachine.DeployVirtualMachine(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l, err := cs.VirtualMachine.ListVirtualMachines(cs.VirtualMachine.NewListVirtualMachinesParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return vm.Id, l.Count
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "replay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "deploy.json")
+
+	// Record the exchanges with a fake management server, polling the job twice
+	s := cosmictest.NewServer()
+	zone := s.AddZone("zone1")
+	offering := s.AddServiceOffering("small", 1, 1024)
+	template := s.AddTemplate("ubuntu", zone.Id)
+	s.SetJobPolls(1)
+
+	rec := NewRecorder(path, nil)
+	cs, err := s.NewClient(cosmic.WithHTTPClient(&http.Client{Transport: rec}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	recordedID, recordedCount := deployAndList(t, cs, offering.Id, template.Id, zone.Id)
+	s.Close()
+
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{cosmictest.DefaultAPIKey, "c2VjcmV0LXVzZXJkYXRh", "signature="} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("Expected the fixture file to not contain %q", secret)
+		}
+	}
+
+	// Replay them offline, using another URL and other credentials
+	rep, err := NewReplayer(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs, err = cosmic.New("http://cosmic.invalid/client/api",
+		cosmic.WithHTTPClient(&http.Client{Transport: rep}),
+		cosmic.WithAPIKey("other-key", "other-secret"),
+		cosmic.WithAsync(true),
+		cosmic.WithPollInterval(time.Millisecond, time.Millisecond),
+		cosmic.WithRetryPolicy(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	replayedID, replayedCount := deployAndList(t, cs, offering.Id, template.Id, zone.Id)
+
+	if replayedID != recordedID || replayedCount != recordedCount {
+		t.Errorf("Expected virtual machine %s and %d listed, got %s and %d", recordedID, recordedCount, replayedID, replayedCount)
+	}
+	if unused := rep.Unused(); len(unused) != 0 {
+		t.Errorf("Expected all interactions to be replayed, got %d unused", len(unused))
+	}
+
+	// Requests that were not recorded fail
+	if _, err := cs.Zone.ListZones(cs.Zone.NewListZonesParams()); err == nil {
+		t.Error("Expected an error for a request that was not recorded")
+	}
+}
+
+func TestRecordRedactsSecrets(t *testing.T) {
+	s := cosmictest.NewServer()
+	defer s.Close()
+
+	// Record a request to a command that doesn't exist, as only the recorded params matter
+	rec := NewRecorder("", nil)
+	cs, err := s.NewClient(cosmic.WithHTTPClient(&http.Client{Transport: rec}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := cs.VPN.NewCreateVpnCustomerGatewayParams([]string{"10.0.0.0/24"}, "esp", "1.2.3.4", "ike", "psk-value")
+	p.SetName("gateway1")
+	cs.VPN.CreateVpnCustomerGateway(p)
+
+	i := rec.Interactions()
+	if len(i) != 1 {
+		t.Fatalf("Expected 1 recorded interaction, got %d", len(i))
+	}
+	if strings.Contains(i[0].Params, "psk-value") || !strings.Contains(i[0].Params, "ipsecpsk="+Redacted) {
+		t.Errorf("Expected the pre-shared key to be redacted, got params %s", i[0].Params)
+	}
+	if !strings.Contains(i[0].Params, "name=gateway1") {
+		t.Errorf("Expected other params to be recorded, got params %s", i[0].Params)
+	}
+}
+
+func TestRedactJSON(t *testing.T) {
+	b, err := cosmic.RedactJSON([]byte(`{"listusersresponse":{"user":[{"apikey":"a","secretkey":"s","usersecretkey":"u","ipsecpsk":"p","passwordenabled":true,"name":"user1"}]}}`), Redacted)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"listusersresponse":{"user":[{"apikey":"REDACTED","ipsecpsk":"REDACTED","name":"user1","passwordenabled":true,"secretkey":"REDACTED","usersecretkey":"REDACTED"}]}}`
+	if string(b) != want {
+		t.Errorf("Expected %s, got %s", want, b)
+	}
+}